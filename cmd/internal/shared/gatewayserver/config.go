@@ -55,4 +55,11 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 		ListenTLS:      ":8887",
 		WSPingInterval: 30 * time.Second,
 	},
+	Capture: gatewayserver.CaptureConfig{
+		MaxDuration: time.Hour,
+	},
+	TrafficStats: gatewayserver.TrafficStatsConfig{
+		Period:    time.Hour,
+		Retention: 30 * 24 * time.Hour,
+	},
 }
@@ -62,4 +62,15 @@ func init() {
 	DefaultIdentityServerConfig.ProfilePicture.UseGravatar = true
 	DefaultIdentityServerConfig.EndDevicePicture.Bucket = "end_device_pictures"
 	DefaultIdentityServerConfig.EndDevicePicture.BucketURL = path.Join(shared.DefaultAssetsBaseURL, "blob", "end_device_pictures")
+	DefaultIdentityServerConfig.GatewayMonitoring.OfflineTimeout = 15 * time.Minute
+	DefaultIdentityServerConfig.GatewayMonitoring.CheckInterval = time.Minute
+	DefaultIdentityServerConfig.DeletedEntities.PurgeInterval = time.Hour
+	DefaultIdentityServerConfig.APIKeyExpiry.ReminderBefore = 7 * 24 * time.Hour
+	DefaultIdentityServerConfig.APIKeyExpiry.CheckInterval = time.Hour
+	DefaultIdentityServerConfig.OAuth.MFA.TOTPIssuer = DefaultIdentityServerConfig.OAuth.UI.SiteName
+	DefaultIdentityServerConfig.OAuth.MFA.WebAuthn.RPID = shared.DefaultPublicHost
+	DefaultIdentityServerConfig.OAuth.MFA.WebAuthn.RPName = DefaultIdentityServerConfig.OAuth.UI.SiteName
+	DefaultIdentityServerConfig.OAuth.MFA.WebAuthn.Origin = shared.DefaultPublicURL
+	DefaultIdentityServerConfig.OAuth.Federation.DefaultUserState = "approved"
+	DefaultIdentityServerConfig.OAuth.OpenID.IDTokenTTL = time.Hour
 }
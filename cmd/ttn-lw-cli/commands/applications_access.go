@@ -18,6 +18,7 @@ import (
 	"context"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
@@ -25,7 +26,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
-func createApplicationAPIKey(ctx context.Context, ids ttnpb.ApplicationIdentifiers, name string, rights ...ttnpb.Right) (*ttnpb.APIKey, error) {
+func createApplicationAPIKey(ctx context.Context, ids ttnpb.ApplicationIdentifiers, name string, expiresAt *time.Time, allowedIPRanges []string, rights ...ttnpb.Right) (*ttnpb.APIKey, error) {
 	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 	if err != nil {
 		return nil, err
@@ -34,6 +35,8 @@ func createApplicationAPIKey(ctx context.Context, ids ttnpb.ApplicationIdentifie
 		ApplicationIdentifiers: ids,
 		Name:                   name,
 		Rights:                 rights,
+		ExpiresAt:              expiresAt,
+		AllowedIPRanges:        allowedIPRanges,
 	})
 }
 
@@ -204,7 +207,12 @@ var (
 				return errNoAPIKeyRights
 			}
 
-			res, err := createApplicationAPIKey(ctx, *appID, name, rights...)
+			expiresAt, allowedIPRanges, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := createApplicationAPIKey(ctx, *appID, name, expiresAt, allowedIPRanges, rights...)
 			if err != nil {
 				return err
 			}
@@ -312,6 +320,7 @@ func init() {
 	applicationAPIKeys.AddCommand(applicationAPIKeysList)
 	applicationAPIKeysCreate.Flags().String("name", "", "")
 	applicationAPIKeysCreate.Flags().AddFlagSet(applicationRightsFlags)
+	applicationAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionsFlags())
 	applicationAPIKeys.AddCommand(applicationAPIKeysCreate)
 	applicationAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	applicationAPIKeysUpdate.Flags().String("name", "", "")
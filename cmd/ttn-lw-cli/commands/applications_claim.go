@@ -41,7 +41,7 @@ key is provided, a new API key will be created.`,
 			key, _ := cmd.Flags().GetString("api-key")
 			if key == "" {
 				logger.Info("Creating API key")
-				apiKey, err := createApplicationAPIKey(ctx, *appID, "Device Claiming",
+				apiKey, err := createApplicationAPIKey(ctx, *appID, "Device Claiming", nil, nil,
 					ttnpb.RIGHT_APPLICATION_DEVICES_READ,
 					ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS,
 					ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
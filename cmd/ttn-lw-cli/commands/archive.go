@@ -0,0 +1,304 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"encoding/json"
+	stdio "io"
+	"io/ioutil"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// archiveFormatVersion is the version of the archive format written by the export commands.
+const archiveFormatVersion = 1
+
+const archiveManifestName = "manifest.json"
+
+var (
+	errArchiveManifest      = errors.DefineInvalidArgument("archive_manifest", "archive does not start with a manifest")
+	errArchiveFormatVersion = errors.DefineInvalidArgument("archive_format_version", "archive format version `{version}` is not supported")
+	errArchiveKEK           = errors.DefineInvalidArgument("archive_kek", "invalid KEK")
+	errNoArchiveKEK         = errors.DefineFailedPrecondition("no_archive_kek", "no KEK set for KEK label `{label}` of the archive")
+)
+
+// archiveManifest is the first file in an archive.
+type archiveManifest struct {
+	FormatVersion int       `json:"format_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	// KEKLabel is the label of the KEK that the keys in the archive are wrapped with.
+	// If empty, the keys in the archive are not wrapped.
+	KEKLabel string `json:"kek_label,omitempty"`
+}
+
+// archiveWriter writes an archive, which is a gzipped tarball of JSON files.
+// Entities are written before the entities that depend on them, so that the
+// archive can be imported in order:
+//
+//	manifest.json
+//	organizations/<organization-id>.json
+//	organizations/<organization-id>/collaborators.json
+//	applications/<application-id>.json
+//	applications/<application-id>/collaborators.json
+//	applications/<application-id>/webhooks/<webhook-id>.json
+//	applications/<application-id>/pubsubs/<pubsub-id>.json
+//	applications/<application-id>/devices/<device-id>.json
+//	applications/<application-id>/devices/<device-id>/associations/<f-port>.json
+//	gateways/<gateway-id>.json
+//	gateways/<gateway-id>/collaborators.json
+type archiveWriter struct {
+	gz *gzip.Writer
+	tw *tar.Writer
+}
+
+func newArchiveWriter(w stdio.Writer) *archiveWriter {
+	gz := gzip.NewWriter(w)
+	return &archiveWriter{
+		gz: gz,
+		tw: tar.NewWriter(gz),
+	}
+}
+
+func (w *archiveWriter) writeFile(name string, b []byte) error {
+	if err := w.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0600,
+		Size:     int64(len(b)),
+		ModTime:  time.Now(),
+	}); err != nil {
+		return err
+	}
+	_, err := w.tw.Write(b)
+	return err
+}
+
+func (w *archiveWriter) writeManifest(manifest archiveManifest) error {
+	b, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	return w.writeFile(archiveManifestName, b)
+}
+
+func (w *archiveWriter) write(name string, pb proto.Message) error {
+	b, err := jsonpb.TTN().Marshal(pb)
+	if err != nil {
+		return err
+	}
+	return w.writeFile(name, b)
+}
+
+func (w *archiveWriter) Close() error {
+	if err := w.tw.Close(); err != nil {
+		return err
+	}
+	return w.gz.Close()
+}
+
+// archiveEntry is a file in an archive.
+type archiveEntry struct {
+	name string
+	data []byte
+}
+
+// kind returns the kind of entity in the entry, such as "applications" or "applications/devices".
+func (e archiveEntry) kind() string {
+	parts := strings.Split(strings.TrimSuffix(e.name, ".json"), "/")
+	kind := make([]string, 0, len(parts)/2+1)
+	for i := 0; i < len(parts); i += 2 {
+		kind = append(kind, parts[i])
+	}
+	return path.Join(kind...)
+}
+
+// decode decodes the entry into v, and returns the field paths that are present in the entry.
+func (e archiveEntry) decode(v interface{}) ([]string, error) {
+	return io.NewJSONDecoder(bytes.NewReader(e.data)).Decode(v)
+}
+
+type archiveReader struct {
+	gz *gzip.Reader
+	tr *tar.Reader
+}
+
+func newArchiveReader(r stdio.Reader) (*archiveReader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return &archiveReader{
+		gz: gz,
+		tr: tar.NewReader(gz),
+	}, nil
+}
+
+// Next returns the next entry in the archive, or io.EOF if there are no more entries.
+func (r *archiveReader) Next() (*archiveEntry, error) {
+	for {
+		hdr, err := r.tr.Next()
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := ioutil.ReadAll(r.tr)
+		if err != nil {
+			return nil, err
+		}
+		return &archiveEntry{name: hdr.Name, data: data}, nil
+	}
+}
+
+// Manifest reads the manifest, which must be the first entry in the archive.
+func (r *archiveReader) Manifest() (*archiveManifest, error) {
+	entry, err := r.Next()
+	if err == stdio.EOF || err == nil && entry.name != archiveManifestName {
+		return nil, errArchiveManifest.New()
+	}
+	if err != nil {
+		return nil, err
+	}
+	var manifest archiveManifest
+	if err := json.Unmarshal(entry.data, &manifest); err != nil {
+		return nil, err
+	}
+	if manifest.FormatVersion != archiveFormatVersion {
+		return nil, errArchiveFormatVersion.WithAttributes("version", manifest.FormatVersion)
+	}
+	return &manifest, nil
+}
+
+func (r *archiveReader) Close() error {
+	return r.gz.Close()
+}
+
+func archiveKEKFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("kek-label", "", "label of the KEK that wraps the keys in the archive")
+	flagSet.String("kek", "", "KEK that wraps the keys in the archive (hex)")
+	return flagSet
+}
+
+// getArchiveKeyVault returns the KEK label and a key vault with the KEK that is set in the flags.
+// If no KEK label is set, the returned key vault is nil.
+func getArchiveKeyVault(flagSet *pflag.FlagSet) (string, crypto.KeyVault, error) {
+	kekLabel, _ := flagSet.GetString("kek-label")
+	if kekLabel == "" {
+		return "", nil, nil
+	}
+	kekHex, _ := flagSet.GetString("kek")
+	kek, err := hex.DecodeString(kekHex)
+	if err != nil {
+		return "", nil, errArchiveKEK.WithCause(err)
+	}
+	switch len(kek) {
+	case 16, 24, 32:
+	default:
+		return "", nil, errArchiveKEK.New()
+	}
+	return kekLabel, cryptoutil.NewMemKeyVault(map[string][]byte{kekLabel: kek}), nil
+}
+
+// endDeviceKeyEnvelopes returns the key envelopes of the end device that are set.
+func endDeviceKeyEnvelopes(dev *ttnpb.EndDevice) []*ttnpb.KeyEnvelope {
+	var envelopes []*ttnpb.KeyEnvelope
+	add := func(envs ...*ttnpb.KeyEnvelope) {
+		for _, env := range envs {
+			if env != nil {
+				envelopes = append(envelopes, env)
+			}
+		}
+	}
+	addSessionKeys := func(keys *ttnpb.SessionKeys) {
+		if keys != nil {
+			add(keys.FNwkSIntKey, keys.SNwkSIntKey, keys.NwkSEncKey, keys.AppSKey)
+		}
+	}
+	if dev.RootKeys != nil {
+		add(dev.RootKeys.AppKey, dev.RootKeys.NwkKey)
+	}
+	if dev.Session != nil {
+		addSessionKeys(&dev.Session.SessionKeys)
+	}
+	if dev.PendingSession != nil {
+		addSessionKeys(&dev.PendingSession.SessionKeys)
+	}
+	for _, macState := range []*ttnpb.MACState{dev.MACState, dev.PendingMACState} {
+		if macState != nil && macState.QueuedJoinAccept != nil {
+			addSessionKeys(&macState.QueuedJoinAccept.Keys)
+		}
+	}
+	return envelopes
+}
+
+// wrapEndDeviceKeys wraps the keys of the end device with the KEK.
+func wrapEndDeviceKeys(dev *ttnpb.EndDevice, kekLabel string, keyVault crypto.KeyVault) error {
+	for _, env := range endDeviceKeyEnvelopes(dev) {
+		if env.Key == nil {
+			continue
+		}
+		wrapped, err := cryptoutil.WrapAES128Key(ctx, *env.Key, kekLabel, keyVault)
+		if err != nil {
+			return err
+		}
+		*env = wrapped
+	}
+	return nil
+}
+
+// unwrapEndDeviceKeys unwraps the keys of the end device that are wrapped with the KEK,
+// and replaces the paths of the wrapped keys with the paths of the unwrapped keys.
+func unwrapEndDeviceKeys(dev *ttnpb.EndDevice, paths []string, kekLabel string, keyVault crypto.KeyVault) ([]string, error) {
+	for _, env := range endDeviceKeyEnvelopes(dev) {
+		if env.Key != nil || env.KEKLabel == "" {
+			continue
+		}
+		if keyVault == nil || env.KEKLabel != kekLabel {
+			return nil, errNoArchiveKEK.WithAttributes("label", env.KEKLabel)
+		}
+		key, err := cryptoutil.UnwrapAES128Key(ctx, *env, keyVault)
+		if err != nil {
+			return nil, err
+		}
+		*env = ttnpb.KeyEnvelope{Key: &key}
+	}
+	unwrappedPaths := make([]string, 0, len(paths))
+	for _, p := range paths {
+		switch {
+		case strings.HasSuffix(p, ".kek_label"):
+			continue
+		case strings.HasSuffix(p, ".encrypted_key"):
+			p = strings.TrimSuffix(p, ".encrypted_key") + ".key"
+		}
+		unwrappedPaths = append(unwrappedPaths, p)
+	}
+	return unwrappedPaths, nil
+}
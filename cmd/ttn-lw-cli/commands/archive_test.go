@@ -0,0 +1,287 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	stdio "io"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestArchiveEntryKind(t *testing.T) {
+	for _, tc := range []struct {
+		Name string
+		Kind string
+	}{
+		{Name: "organizations/test-org.json", Kind: "organizations"},
+		{Name: "organizations/test-org/collaborators.json", Kind: "organizations/collaborators"},
+		{Name: "applications/test-app.json", Kind: "applications"},
+		{Name: "applications/test-app/collaborators.json", Kind: "applications/collaborators"},
+		{Name: "applications/test-app/webhooks/test-webhook.json", Kind: "applications/webhooks"},
+		{Name: "applications/test-app/pubsubs/test-pubsub.json", Kind: "applications/pubsubs"},
+		{Name: "applications/test-app/devices/test-dev.json", Kind: "applications/devices"},
+		{Name: "applications/test-app/devices/test-dev/associations/1.json", Kind: "applications/devices/associations"},
+		{Name: "gateways/test-gtw.json", Kind: "gateways"},
+		{Name: "gateways/test-gtw/collaborators.json", Kind: "gateways/collaborators"},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(archiveEntry{name: tc.Name}.kind(), should.Equal, tc.Kind)
+		})
+	}
+}
+
+func TestArchive(t *testing.T) {
+	a := assertions.New(t)
+
+	manifest := archiveManifest{
+		FormatVersion: archiveFormatVersion,
+		CreatedAt:     time.Unix(1577836800, 0).UTC(),
+		KEKLabel:      "test",
+	}
+	app := &ttnpb.Application{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+		Name:                   "Test Application",
+		Attributes:             map[string]string{"foo": "bar"},
+	}
+	collaborators := &ttnpb.Collaborators{
+		Collaborators: []*ttnpb.Collaborator{
+			{
+				OrganizationOrUserIdentifiers: *ttnpb.UserIdentifiers{UserID: "test-user"}.OrganizationOrUserIdentifiers(),
+				Rights:                        []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	w := newArchiveWriter(&buf)
+	a.So(w.writeManifest(manifest), should.BeNil)
+	a.So(w.write("applications/test-app.json", app), should.BeNil)
+	a.So(w.write("applications/test-app/collaborators.json", collaborators), should.BeNil)
+	a.So(w.Close(), should.BeNil)
+
+	r, err := newArchiveReader(bytes.NewReader(buf.Bytes()))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer r.Close()
+
+	readManifest, err := r.Manifest()
+	if a.So(err, should.BeNil) {
+		a.So(*readManifest, should.Resemble, manifest)
+	}
+
+	entry, err := r.Next()
+	if a.So(err, should.BeNil) {
+		a.So(entry.name, should.Equal, "applications/test-app.json")
+		a.So(entry.kind(), should.Equal, "applications")
+		var readApp ttnpb.Application
+		paths, err := entry.decode(&readApp)
+		a.So(err, should.BeNil)
+		a.So(&readApp, should.Resemble, app)
+		a.So(paths, should.Contain, "name")
+		a.So(paths, should.Contain, "attributes.foo")
+	}
+
+	entry, err = r.Next()
+	if a.So(err, should.BeNil) {
+		a.So(entry.name, should.Equal, "applications/test-app/collaborators.json")
+		a.So(entry.kind(), should.Equal, "applications/collaborators")
+		var readCollaborators ttnpb.Collaborators
+		_, err := entry.decode(&readCollaborators)
+		a.So(err, should.BeNil)
+		a.So(&readCollaborators, should.Resemble, collaborators)
+	}
+
+	_, err = r.Next()
+	a.So(err, should.Equal, stdio.EOF)
+}
+
+func TestArchiveManifest(t *testing.T) {
+	for _, tc := range []struct {
+		Name      string
+		Write     func(*archiveWriter) error
+		Assertion func(error) bool
+	}{
+		{
+			Name: "Empty",
+			Write: func(*archiveWriter) error {
+				return nil
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "NoManifest",
+			Write: func(w *archiveWriter) error {
+				return w.write("applications/test-app.json", &ttnpb.Application{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+				})
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "UnsupportedVersion",
+			Write: func(w *archiveWriter) error {
+				b, err := json.Marshal(archiveManifest{FormatVersion: archiveFormatVersion + 1})
+				if err != nil {
+					return err
+				}
+				return w.writeFile(archiveManifestName, b)
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "Valid",
+			Write: func(w *archiveWriter) error {
+				return w.writeManifest(archiveManifest{FormatVersion: archiveFormatVersion})
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var buf bytes.Buffer
+			w := newArchiveWriter(&buf)
+			a.So(tc.Write(w), should.BeNil)
+			a.So(w.Close(), should.BeNil)
+
+			r, err := newArchiveReader(bytes.NewReader(buf.Bytes()))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			defer r.Close()
+
+			_, err = r.Manifest()
+			if tc.Assertion == nil {
+				a.So(err, should.BeNil)
+			} else if a.So(err, should.NotBeNil) {
+				a.So(tc.Assertion(err), should.BeTrue)
+			}
+		})
+	}
+}
+
+func TestEndDeviceKeysWrapping(t *testing.T) {
+	a := assertions.New(t)
+
+	appKey := types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	appSKey := types.AES128Key{0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18}
+	newDevice := func() *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+				DeviceID:               "test-dev",
+			},
+			RootKeys: &ttnpb.RootKeys{
+				AppKey: &ttnpb.KeyEnvelope{Key: &appKey},
+			},
+			Session: &ttnpb.Session{
+				DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+				SessionKeys: ttnpb.SessionKeys{
+					AppSKey: &ttnpb.KeyEnvelope{Key: &appSKey},
+				},
+			},
+		}
+	}
+
+	kekLabel := "test"
+	keyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		kekLabel: {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+	})
+	otherKeyVault := cryptoutil.NewMemKeyVault(map[string][]byte{
+		"other": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+	})
+
+	dev := newDevice()
+	a.So(wrapEndDeviceKeys(dev, kekLabel, keyVault), should.BeNil)
+	for _, env := range []*ttnpb.KeyEnvelope{dev.RootKeys.AppKey, dev.Session.AppSKey} {
+		a.So(env.Key, should.BeNil)
+		a.So(env.KEKLabel, should.Equal, kekLabel)
+		a.So(env.EncryptedKey, should.NotBeEmpty)
+	}
+
+	// Write the wrapped device to an archive entry, so that the decoded paths are those of an import.
+	b, err := jsonpb.TTN().Marshal(dev)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	decode := func() (*ttnpb.EndDevice, []string) {
+		var decoded ttnpb.EndDevice
+		paths, err := archiveEntry{name: "applications/test-app/devices/test-dev.json", data: b}.decode(&decoded)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return &decoded, paths
+	}
+
+	decoded, paths := decode()
+	a.So(paths, should.Contain, "root_keys.app_key.encrypted_key")
+	a.So(paths, should.Contain, "root_keys.app_key.kek_label")
+	a.So(paths, should.Contain, "session.keys.app_s_key.encrypted_key")
+
+	unwrappedPaths, err := unwrapEndDeviceKeys(decoded, paths, kekLabel, keyVault)
+	if a.So(err, should.BeNil) {
+		a.So(decoded.RootKeys.AppKey, should.Resemble, &ttnpb.KeyEnvelope{Key: &appKey})
+		a.So(decoded.Session.AppSKey, should.Resemble, &ttnpb.KeyEnvelope{Key: &appSKey})
+		a.So(unwrappedPaths, should.Contain, "root_keys.app_key.key")
+		a.So(unwrappedPaths, should.Contain, "session.keys.app_s_key.key")
+		a.So(unwrappedPaths, should.Contain, "session.dev_addr")
+		for _, p := range []string{
+			"root_keys.app_key.encrypted_key",
+			"root_keys.app_key.kek_label",
+			"session.keys.app_s_key.encrypted_key",
+			"session.keys.app_s_key.kek_label",
+		} {
+			a.So(unwrappedPaths, should.NotContain, p)
+		}
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		KEKLabel string
+		KeyVault crypto.KeyVault
+	}{
+		{Name: "NoKeyVault", KEKLabel: kekLabel},
+		{Name: "OtherKEKLabel", KEKLabel: "other", KeyVault: otherKeyVault},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			decoded, paths := decode()
+			_, err := unwrapEndDeviceKeys(decoded, paths, tc.KEKLabel, tc.KeyVault)
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+			}
+		})
+	}
+
+	// Keys that are not wrapped are kept as they are.
+	dev = newDevice()
+	unwrappedPaths, err = unwrapEndDeviceKeys(dev, []string{"root_keys.app_key.key"}, "", nil)
+	if a.So(err, should.BeNil) {
+		a.So(dev, should.Resemble, newDevice())
+		a.So(unwrappedPaths, should.Resemble, []string{"root_keys.app_key.key"})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	errMultipleIDs  = errors.DefineInvalidArgument("multiple_ids", "multiple IDs set")
+	errAuditLogTime = errors.DefineInvalidArgument("audit_log_time", "invalid time `{time}`")
+)
+
+func auditLogFilterFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("action", "", "only list entries with this action")
+	flagSet.String("actor-user-id", "", "only list changes made by this user")
+	flagSet.String("after", "", "only list entries created after this time (RFC3339)")
+	flagSet.String("before", "", "only list entries created before this time (RFC3339)")
+	return flagSet
+}
+
+func getAuditLogTime(flagSet *pflag.FlagSet, name string) (*time.Time, error) {
+	value, _ := flagSet.GetString(name)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errAuditLogTime.WithAttributes("time", value).WithCause(err)
+	}
+	return &t, nil
+}
+
+var (
+	auditLogCommand = &cobra.Command{
+		Use:   "audit-log",
+		Short: "Audit log of administrative changes in the Identity Server",
+	}
+	auditLogListCommand = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List audit log entries of an entity (or of all entities, for admins)",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			req := &ttnpb.ListAuditLogRequest{}
+			switch ids := getCombinedIdentifiers(cmd.Flags()).GetEntityIdentifiers(); len(ids) {
+			case 0:
+			case 1:
+				req.EntityIDs = ids[0]
+			default:
+				return errMultipleIDs.New()
+			}
+			req.Action, _ = cmd.Flags().GetString("action")
+			if actorUserID, _ := cmd.Flags().GetString("actor-user-id"); actorUserID != "" {
+				req.ActorUserIDs = &ttnpb.UserIdentifiers{UserID: actorUserID}
+			}
+			if req.After, err = getAuditLogTime(cmd.Flags(), "after"); err != nil {
+				return err
+			}
+			if req.Before, err = getAuditLogTime(cmd.Flags(), "before"); err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			req.Limit, req.Page = limit, page
+			res, err := ttnpb.NewAuditLogRegistryClient(is).List(ctx, req, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Entries)
+		},
+	}
+)
+
+func init() {
+	auditLogListCommand.Flags().AddFlagSet(combinedIdentifiersFlags())
+	auditLogListCommand.Flags().AddFlagSet(auditLogFilterFlags())
+	auditLogListCommand.Flags().AddFlagSet(paginationFlags())
+	auditLogCommand.AddCommand(auditLogListCommand)
+	Root.AddCommand(auditLogCommand)
+}
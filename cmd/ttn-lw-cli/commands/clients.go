@@ -80,6 +80,7 @@ var (
 				Limit:        limit,
 				Page:         page,
 				Order:        getOrder(cmd.Flags()),
+				Deleted:      getDeleted(cmd.Flags()),
 			}, opt)
 			if err != nil {
 				return err
@@ -246,6 +247,48 @@ var (
 			return nil
 		},
 	}
+	clientsRestoreCommand = &cobra.Command{
+		Use:   "restore [client-id]",
+		Short: "Restore a recently deleted client",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliID := getClientID(cmd.Flags(), args)
+			if cliID == nil {
+				return errNoClientID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewClientRegistryClient(is).Restore(ctx, cliID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	clientsPurgeCommand = &cobra.Command{
+		Use:   "purge [client-id]",
+		Short: "Purge a client",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliID := getClientID(cmd.Flags(), args)
+			if cliID == nil {
+				return errNoClientID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewClientRegistryClient(is).Purge(ctx, cliID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
 	clientsContactInfoCommand = contactInfoCommands("client", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
 		cliID := getClientID(cmd.Flags(), args)
 		if cliID == nil {
@@ -260,6 +303,7 @@ func init() {
 	clientsListCommand.Flags().AddFlagSet(selectClientFlags)
 	clientsListCommand.Flags().AddFlagSet(paginationFlags())
 	clientsListCommand.Flags().AddFlagSet(orderFlags())
+	clientsListCommand.Flags().AddFlagSet(deletedFlags())
 	clientsCommand.AddCommand(clientsListCommand)
 	clientsSearchCommand.Flags().AddFlagSet(searchFlags())
 	clientsSearchCommand.Flags().AddFlagSet(selectClientFlags)
@@ -280,6 +324,10 @@ func init() {
 	clientsCommand.AddCommand(clientsUpdateCommand)
 	clientsDeleteCommand.Flags().AddFlagSet(clientIDFlags())
 	clientsCommand.AddCommand(clientsDeleteCommand)
+	clientsRestoreCommand.Flags().AddFlagSet(clientIDFlags())
+	clientsCommand.AddCommand(clientsRestoreCommand)
+	clientsPurgeCommand.Flags().AddFlagSet(clientIDFlags())
+	clientsCommand.AddCommand(clientsPurgeCommand)
 	clientsContactInfoCommand.PersistentFlags().AddFlagSet(clientIDFlags())
 	clientsCommand.AddCommand(clientsContactInfoCommand)
 	Root.AddCommand(clientsCommand)
@@ -240,7 +240,10 @@ var (
 			}
 			paths := util.SelectFieldMask(cmd.Flags(), selectEndDeviceListFlags)
 
-			req, opt, getTotal := getSearchEndDevicesRequest(cmd.Flags())
+			req, opt, getTotal, err := getSearchEndDevicesRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
 			req.ApplicationIdentifiers = *appID
 			req.FieldMask.Paths = paths
 
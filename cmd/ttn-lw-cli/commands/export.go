@@ -0,0 +1,481 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// exportPageSize is the number of entities that are listed per request.
+const exportPageSize = 100
+
+var (
+	errNoArchiveFile = errors.DefineInvalidArgument("no_archive_file", "no archive file set")
+	errPictureStatus = errors.DefineUnavailable("picture_status", "failed to download picture: `{status}`")
+)
+
+func exportFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("file", "", "file to write the archive to")
+	flagSet.AddFlagSet(archiveKEKFlags())
+	return flagSet
+}
+
+// exporter writes entities with everything that belongs to them to an archive.
+type exporter struct {
+	w        *archiveWriter
+	kekLabel string
+	keyVault crypto.KeyVault
+}
+
+func runExport(flagSet *pflag.FlagSet, export func(*exporter) error) error {
+	fileName, _ := flagSet.GetString("file")
+	if fileName == "" {
+		return errNoArchiveFile
+	}
+	kekLabel, keyVault, err := getArchiveKeyVault(flagSet)
+	if err != nil {
+		return err
+	}
+	if kekLabel == "" {
+		logger.Warn("No KEK set, keys are written to the archive in the clear")
+	}
+	f, err := os.OpenFile(fileName, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	e := &exporter{
+		w:        newArchiveWriter(f),
+		kekLabel: kekLabel,
+		keyVault: keyVault,
+	}
+	if err := e.w.writeManifest(archiveManifest{
+		FormatVersion: archiveFormatVersion,
+		CreatedAt:     time.Now().UTC(),
+		KEKLabel:      kekLabel,
+	}); err != nil {
+		return err
+	}
+	if err := export(e); err != nil {
+		return err
+	}
+	if err := e.w.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func (e *exporter) exportOrganization(ids ttnpb.OrganizationIdentifiers) error {
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	logger.WithField("organization_id", ids.OrganizationID).Info("Export organization")
+	organization, err := ttnpb.NewOrganizationRegistryClient(is).Get(ctx, &ttnpb.GetOrganizationRequest{
+		OrganizationIdentifiers: ids,
+		FieldMask: types.FieldMask{Paths: nonImplicitPaths(ttnpb.AllowedFields(
+			ttnpb.OrganizationFieldPathsTopLevel,
+			ttnpb.AllowedFieldMaskPathsForRPC["/ttn.lorawan.v3.OrganizationRegistry/Get"],
+		)...)},
+	})
+	if err != nil {
+		return err
+	}
+	if err = e.w.write(path.Join("organizations", ids.OrganizationID+".json"), organization); err != nil {
+		return err
+	}
+	var collaborators ttnpb.Collaborators
+	for page := uint32(1); ; page++ {
+		res, err := ttnpb.NewOrganizationAccessClient(is).ListCollaborators(ctx, &ttnpb.ListOrganizationCollaboratorsRequest{
+			OrganizationIdentifiers: ids,
+			Limit:                   exportPageSize,
+			Page:                    page,
+		})
+		if err != nil {
+			return err
+		}
+		collaborators.Collaborators = append(collaborators.Collaborators, res.Collaborators...)
+		if len(res.Collaborators) < exportPageSize {
+			break
+		}
+	}
+	if err = e.w.write(path.Join("organizations", ids.OrganizationID, "collaborators.json"), &collaborators); err != nil {
+		return err
+	}
+
+	for page := uint32(1); ; page++ {
+		res, err := ttnpb.NewApplicationRegistryClient(is).List(ctx, &ttnpb.ListApplicationsRequest{
+			Collaborator: ids.OrganizationOrUserIdentifiers(),
+			Limit:        exportPageSize,
+			Page:         page,
+		})
+		if err != nil {
+			return err
+		}
+		for _, application := range res.Applications {
+			if err := e.exportApplication(application.ApplicationIdentifiers); err != nil {
+				return err
+			}
+		}
+		if len(res.Applications) < exportPageSize {
+			break
+		}
+	}
+	for page := uint32(1); ; page++ {
+		res, err := ttnpb.NewGatewayRegistryClient(is).List(ctx, &ttnpb.ListGatewaysRequest{
+			Collaborator: ids.OrganizationOrUserIdentifiers(),
+			Limit:        exportPageSize,
+			Page:         page,
+		})
+		if err != nil {
+			return err
+		}
+		for _, gateway := range res.Gateways {
+			if err := e.exportGateway(gateway.GatewayIdentifiers); err != nil {
+				return err
+			}
+		}
+		if len(res.Gateways) < exportPageSize {
+			break
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportApplication(ids ttnpb.ApplicationIdentifiers) error {
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	logger.WithField("application_id", ids.ApplicationID).Info("Export application")
+	application, err := ttnpb.NewApplicationRegistryClient(is).Get(ctx, &ttnpb.GetApplicationRequest{
+		ApplicationIdentifiers: ids,
+		FieldMask: types.FieldMask{Paths: nonImplicitPaths(ttnpb.AllowedFields(
+			ttnpb.ApplicationFieldPathsTopLevel,
+			ttnpb.AllowedFieldMaskPathsForRPC["/ttn.lorawan.v3.ApplicationRegistry/Get"],
+		)...)},
+	})
+	if err != nil {
+		return err
+	}
+	if err = e.w.write(path.Join("applications", ids.ApplicationID+".json"), application); err != nil {
+		return err
+	}
+	var collaborators ttnpb.Collaborators
+	for page := uint32(1); ; page++ {
+		res, err := ttnpb.NewApplicationAccessClient(is).ListCollaborators(ctx, &ttnpb.ListApplicationCollaboratorsRequest{
+			ApplicationIdentifiers: ids,
+			Limit:                  exportPageSize,
+			Page:                   page,
+		})
+		if err != nil {
+			return err
+		}
+		collaborators.Collaborators = append(collaborators.Collaborators, res.Collaborators...)
+		if len(res.Collaborators) < exportPageSize {
+			break
+		}
+	}
+	if err = e.w.write(path.Join("applications", ids.ApplicationID, "collaborators.json"), &collaborators); err != nil {
+		return err
+	}
+
+	if config.ApplicationServerEnabled {
+		as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		webhooks, err := ttnpb.NewApplicationWebhookRegistryClient(as).List(ctx, &ttnpb.ListApplicationWebhooksRequest{
+			ApplicationIdentifiers: ids,
+			FieldMask:              types.FieldMask{Paths: ttnpb.ApplicationWebhookFieldPathsTopLevel},
+		})
+		if err != nil {
+			return err
+		}
+		for _, webhook := range webhooks.Webhooks {
+			if err = e.w.write(path.Join("applications", ids.ApplicationID, "webhooks", webhook.WebhookID+".json"), webhook); err != nil {
+				return err
+			}
+		}
+		pubsubs, err := ttnpb.NewApplicationPubSubRegistryClient(as).List(ctx, &ttnpb.ListApplicationPubSubsRequest{
+			ApplicationIdentifiers: ids,
+			FieldMask:              types.FieldMask{Paths: ttnpb.ApplicationPubSubFieldPathsTopLevel},
+		})
+		if err != nil {
+			return err
+		}
+		for _, pubsub := range pubsubs.Pubsubs {
+			if err = e.w.write(path.Join("applications", ids.ApplicationID, "pubsubs", pubsub.PubSubID+".json"), pubsub); err != nil {
+				return err
+			}
+		}
+	}
+
+	isPaths, _, _, _ := splitEndDeviceGetPaths(ttnpb.EndDeviceFieldPathsTopLevel...)
+	for page := uint32(1); ; page++ {
+		res, err := ttnpb.NewEndDeviceRegistryClient(is).List(ctx, &ttnpb.ListEndDevicesRequest{
+			ApplicationIdentifiers: ids,
+			FieldMask:              types.FieldMask{Paths: isPaths},
+			Limit:                  exportPageSize,
+			Page:                   page,
+		})
+		if err != nil {
+			return err
+		}
+		for _, device := range res.EndDevices {
+			if err := e.exportEndDevice(device); err != nil {
+				return err
+			}
+		}
+		if len(res.EndDevices) < exportPageSize {
+			break
+		}
+	}
+	return nil
+}
+
+func (e *exporter) exportEndDevice(device *ttnpb.EndDevice) error {
+	logger := logger.WithField("device_uid", unique.ID(ctx, device.EndDeviceIdentifiers))
+	logger.Info("Export end device")
+
+	_, nsPaths, asPaths, jsPaths := splitEndDeviceGetPaths(ttnpb.EndDeviceFieldPathsTopLevel...)
+	if device.JoinServerAddress == "" {
+		jsPaths = nil
+	}
+	nsMismatch, asMismatch, jsMismatch := compareServerAddressesEndDevice(device, config)
+	if nsMismatch {
+		nsPaths = nil
+	}
+	if asMismatch {
+		asPaths = nil
+	}
+	if jsMismatch {
+		jsPaths = nil
+	}
+	res, err := getEndDevice(device.EndDeviceIdentifiers, nsPaths, asPaths, jsPaths, false)
+	if err != nil {
+		return err
+	}
+	device.SetFields(res, "ids.dev_addr")
+	device.SetFields(res, append(append(nsPaths, asPaths...), jsPaths...)...)
+
+	for _, env := range endDeviceKeyEnvelopes(device) {
+		if env.Key != nil {
+			env.EncryptedKey, env.KEKLabel = nil, ""
+		} else if env.KEKLabel != "" {
+			logger.WithField("kek_label", env.KEKLabel).Warn("Could not unwrap key, key is exported wrapped with the KEK of the source deployment")
+		}
+	}
+	if e.keyVault != nil {
+		if err := wrapEndDeviceKeys(device, e.kekLabel, e.keyVault); err != nil {
+			return err
+		}
+	}
+	if picture := device.Picture; picture != nil && picture.Embedded == nil && len(picture.Sizes) > 0 {
+		embedded, err := downloadPicture(picture)
+		if err != nil {
+			logger.WithError(err).Warn("Could not download picture, picture is exported by URL")
+		} else {
+			device.Picture = &ttnpb.Picture{Embedded: embedded}
+		}
+	}
+
+	name := path.Join("applications", device.ApplicationID, "devices", device.DeviceID)
+	if err := e.w.write(name+".json", device); err != nil {
+		return err
+	}
+
+	if config.ApplicationServerEnabled && !asMismatch {
+		as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		for page := uint32(1); ; page++ {
+			res, err := ttnpb.NewApplicationPackageRegistryClient(as).ListAssociations(ctx, &ttnpb.ListApplicationPackageAssociationRequest{
+				EndDeviceIdentifiers: device.EndDeviceIdentifiers,
+				FieldMask:            types.FieldMask{Paths: ttnpb.ApplicationPackageAssociationFieldPathsTopLevel},
+				Limit:                exportPageSize,
+				Page:                 page,
+			})
+			if err != nil {
+				return err
+			}
+			for _, association := range res.Associations {
+				if err := e.w.write(path.Join(name, "associations", strconv.Itoa(int(association.FPort))+".json"), association); err != nil {
+					return err
+				}
+			}
+			if len(res.Associations) < exportPageSize {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// downloadPicture downloads the original (or otherwise largest) size of the picture,
+// so that the picture can be imported in a deployment that does not have access
+// to the storage bucket of the picture.
+func downloadPicture(picture *ttnpb.Picture) (*ttnpb.Picture_Embedded, error) {
+	var size uint32
+	for s := range picture.Sizes {
+		if s == 0 {
+			size = 0
+			break
+		}
+		if s > size {
+			size = s
+		}
+	}
+	res, err := http.Get(picture.Sizes[size])
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errPictureStatus.WithAttributes("status", res.Status)
+	}
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.Picture_Embedded{
+		MimeType: res.Header.Get("Content-Type"),
+		Data:     data,
+	}, nil
+}
+
+func (e *exporter) exportGateway(ids ttnpb.GatewayIdentifiers) error {
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	logger.WithField("gateway_id", ids.GatewayID).Info("Export gateway")
+	gateway, err := ttnpb.NewGatewayRegistryClient(is).Get(ctx, &ttnpb.GetGatewayRequest{
+		GatewayIdentifiers: ids,
+		FieldMask: types.FieldMask{Paths: nonImplicitPaths(ttnpb.AllowedFields(
+			ttnpb.GatewayFieldPathsTopLevel,
+			ttnpb.AllowedFieldMaskPathsForRPC["/ttn.lorawan.v3.GatewayRegistry/Get"],
+		)...)},
+	})
+	if err != nil {
+		return err
+	}
+	if err = e.w.write(path.Join("gateways", gateway.GatewayID+".json"), gateway); err != nil {
+		return err
+	}
+	var collaborators ttnpb.Collaborators
+	for page := uint32(1); ; page++ {
+		res, err := ttnpb.NewGatewayAccessClient(is).ListCollaborators(ctx, &ttnpb.ListGatewayCollaboratorsRequest{
+			GatewayIdentifiers: gateway.GatewayIdentifiers,
+			Limit:              exportPageSize,
+			Page:               page,
+		})
+		if err != nil {
+			return err
+		}
+		collaborators.Collaborators = append(collaborators.Collaborators, res.Collaborators...)
+		if len(res.Collaborators) < exportPageSize {
+			break
+		}
+	}
+	return e.w.write(path.Join("gateways", gateway.GatewayID, "collaborators.json"), &collaborators)
+}
+
+var (
+	exportCommand = &cobra.Command{
+		Use:   "export",
+		Short: "Export entities to an archive",
+		Long: `Export entities to an archive
+
+The archive contains the entities with their collaborators, and for applications
+also the end devices (from all components, including sessions and keys),
+webhooks, pub/subs and package associations. The archive can be imported in
+another deployment with the import command.
+
+Keys in the archive are wrapped with the KEK that is set with the --kek-label
+and --kek flags. The same KEK needs to be set when importing the archive.`,
+	}
+	exportOrganizationCommand = &cobra.Command{
+		Use:     "organization [organization-id]",
+		Aliases: []string{"org", "o"},
+		Short:   "Export an organization with its applications and gateways",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgID := getOrganizationID(cmd.Flags(), args)
+			if orgID == nil {
+				return errNoOrganizationID
+			}
+			return runExport(cmd.Flags(), func(e *exporter) error {
+				return e.exportOrganization(*orgID)
+			})
+		},
+	}
+	exportApplicationCommand = &cobra.Command{
+		Use:     "application [application-id]",
+		Aliases: []string{"app", "a"},
+		Short:   "Export an application with its end devices",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID
+			}
+			return runExport(cmd.Flags(), func(e *exporter) error {
+				return e.exportApplication(*appID)
+			})
+		},
+	}
+	exportGatewayCommand = &cobra.Command{
+		Use:     "gateway [gateway-id]",
+		Aliases: []string{"gtw", "g"},
+		Short:   "Export a gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			return runExport(cmd.Flags(), func(e *exporter) error {
+				return e.exportGateway(*gtwID)
+			})
+		},
+	}
+)
+
+func init() {
+	exportOrganizationCommand.Flags().AddFlagSet(organizationIDFlags())
+	exportOrganizationCommand.Flags().AddFlagSet(exportFlags())
+	exportCommand.AddCommand(exportOrganizationCommand)
+	exportApplicationCommand.Flags().AddFlagSet(applicationIDFlags())
+	exportApplicationCommand.Flags().AddFlagSet(exportFlags())
+	exportCommand.AddCommand(exportApplicationCommand)
+	exportGatewayCommand.Flags().AddFlagSet(gatewayIDFlags())
+	exportGatewayCommand.Flags().AddFlagSet(exportFlags())
+	exportCommand.AddCommand(exportGatewayCommand)
+	Root.AddCommand(exportCommand)
+}
@@ -18,7 +18,9 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 	"go.thethings.network/lorawan-stack/pkg/errors"
@@ -60,6 +62,17 @@ func getCollaborator(flagSet *pflag.FlagSet) *ttnpb.OrganizationOrUserIdentifier
 	return ttnpb.OrganizationIdentifiers{OrganizationID: organizationID}.OrganizationOrUserIdentifiers()
 }
 
+func deletedFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.Bool("deleted", false, "list recently deleted entities (admin only)")
+	return flagSet
+}
+
+func getDeleted(flagSet *pflag.FlagSet) bool {
+	deleted, _ := flagSet.GetBool("deleted")
+	return deleted
+}
+
 func attributesFlags() *pflag.FlagSet {
 	flagSet := &pflag.FlagSet{}
 	flagSet.StringSlice("attributes", nil, "key=value")
@@ -112,8 +125,9 @@ func getRights(flagSet *pflag.FlagSet) (rights []ttnpb.Right) {
 }
 
 var (
-	errNoAPIKeyID     = errors.DefineInvalidArgument("no_api_key_id", "no API key ID set")
-	errNoAPIKeyRights = errors.DefineInvalidArgument("no_api_key_rights", "no API key rights set")
+	errNoAPIKeyID      = errors.DefineInvalidArgument("no_api_key_id", "no API key ID set")
+	errNoAPIKeyRights  = errors.DefineInvalidArgument("no_api_key_rights", "no API key rights set")
+	errAPIKeyExpiresAt = errors.DefineInvalidArgument("api_key_expires_at", "invalid API key expiry time `{expires_at}`")
 )
 
 func getAPIKeyID(flagSet *pflag.FlagSet, args []string, i int) string {
@@ -129,6 +143,25 @@ func getAPIKeyID(flagSet *pflag.FlagSet, args []string, i int) string {
 	return apiKeyID
 }
 
+func apiKeyRestrictionsFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("expires-at", "", "time after which the API key can no longer be used (RFC3339)")
+	flagSet.StringSlice("allowed-ip-ranges", nil, "IP address ranges (CIDR) from which the API key can be used")
+	return flagSet
+}
+
+func getAPIKeyRestrictions(flagSet *pflag.FlagSet) (expiresAt *time.Time, allowedIPRanges []string, err error) {
+	if value, _ := flagSet.GetString("expires-at"); value != "" {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, nil, errAPIKeyExpiresAt.WithAttributes("expires_at", value).WithCause(err)
+		}
+		expiresAt = &t
+	}
+	allowedIPRanges, _ = flagSet.GetStringSlice("allowed-ip-ranges")
+	return expiresAt, allowedIPRanges, nil
+}
+
 func searchFlags() *pflag.FlagSet {
 	flagSet := &pflag.FlagSet{}
 	flagSet.String("id-contains", "", "")
@@ -157,17 +190,131 @@ func getSearchEntitiesRequest(flagSet *pflag.FlagSet) (req *ttnpb.SearchEntities
 	}, opt, getTotal
 }
 
+var (
+	errSearchBoundingBox  = errors.DefineInvalidArgument("search_bounding_box", "invalid bounding box `{bounding_box}`")
+	errSearchRadius       = errors.DefineInvalidArgument("search_radius", "invalid radius `{radius}`")
+	errSearchUpdatedAfter = errors.DefineInvalidArgument("search_updated_after", "invalid time `{updated_after}`")
+)
+
+func searchFilterFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("bounding-box", "", "only find entities with a location in this bounding box (min-lat,min-lon,max-lat,max-lon)")
+	flagSet.String("radius", "", "only find entities with a location in this radius (lat,lon,meters)")
+	flagSet.String("brand-id", "", "")
+	flagSet.String("model-id", "", "")
+	flagSet.String("updated-after", "", "only find entities updated after this time (RFC3339)")
+	return flagSet
+}
+
+func parseFloats(value string, n int) ([]float64, bool) {
+	parts := strings.Split(value, ",")
+	if len(parts) != n {
+		return nil, false
+	}
+	floats := make([]float64, n)
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, false
+		}
+		floats[i] = f
+	}
+	return floats, true
+}
+
+type searchFilters struct {
+	boundingBox  *ttnpb.LocationBoundingBox
+	radius       *ttnpb.LocationRadius
+	brandID      string
+	modelID      string
+	updatedAfter *time.Time
+}
+
+func getSearchFilters(flagSet *pflag.FlagSet) (*searchFilters, error) {
+	filters := &searchFilters{}
+	if value, _ := flagSet.GetString("bounding-box"); value != "" {
+		v, ok := parseFloats(value, 4)
+		if !ok {
+			return nil, errSearchBoundingBox.WithAttributes("bounding_box", value)
+		}
+		filters.boundingBox = &ttnpb.LocationBoundingBox{
+			MinLatitude:  v[0],
+			MinLongitude: v[1],
+			MaxLatitude:  v[2],
+			MaxLongitude: v[3],
+		}
+	}
+	if value, _ := flagSet.GetString("radius"); value != "" {
+		v, ok := parseFloats(value, 3)
+		if !ok {
+			return nil, errSearchRadius.WithAttributes("radius", value)
+		}
+		filters.radius = &ttnpb.LocationRadius{
+			Latitude:  v[0],
+			Longitude: v[1],
+			Radius:    v[2],
+		}
+	}
+	filters.brandID, _ = flagSet.GetString("brand-id")
+	filters.modelID, _ = flagSet.GetString("model-id")
+	if value, _ := flagSet.GetString("updated-after"); value != "" {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, errSearchUpdatedAfter.WithAttributes("updated_after", value).WithCause(err)
+		}
+		filters.updatedAfter = &t
+	}
+	return filters, nil
+}
+
+func searchGatewaysFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("frequency-plan-id", "", "")
+	flagSet.AddFlagSet(searchFilterFlags())
+	flagSet.AddFlagSet(searchFlags())
+	return flagSet
+}
+
+func getSearchGatewaysRequest(flagSet *pflag.FlagSet) (req *ttnpb.SearchGatewaysRequest, opt grpc.CallOption, getTotal func() uint64, err error) {
+	filters, err := getSearchFilters(flagSet)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	baseReq, opt, getTotal := getSearchEntitiesRequest(flagSet)
+	frequencyPlanID, _ := flagSet.GetString("frequency-plan-id")
+	return &ttnpb.SearchGatewaysRequest{
+		IDContains:          baseReq.IDContains,
+		NameContains:        baseReq.NameContains,
+		DescriptionContains: baseReq.DescriptionContains,
+		AttributesContain:   baseReq.AttributesContain,
+		Limit:               baseReq.Limit,
+		Page:                baseReq.Page,
+		Order:               baseReq.Order,
+		LocationBoundingBox: filters.boundingBox,
+		LocationRadius:      filters.radius,
+		FrequencyPlanID:     frequencyPlanID,
+		BrandID:             filters.brandID,
+		ModelID:             filters.modelID,
+		UpdatedAfter:        filters.updatedAfter,
+	}, opt, getTotal, nil
+}
+
 func searchEndDevicesFlags() *pflag.FlagSet {
 	flagSet := &pflag.FlagSet{}
 	flagSet.String("dev-eui-contains", "", "")
 	flagSet.String("join-eui-contains", "", "")
 	flagSet.String("dev-addr-contains", "", "")
 	flagSet.Lookup("dev-addr-contains").Hidden = true // Part of the API but not actually supported.
+	flagSet.AddFlagSet(searchFilterFlags())
 	flagSet.AddFlagSet(searchFlags())
 	return flagSet
 }
 
-func getSearchEndDevicesRequest(flagSet *pflag.FlagSet) (req *ttnpb.SearchEndDevicesRequest, opt grpc.CallOption, getTotal func() uint64) {
+func getSearchEndDevicesRequest(flagSet *pflag.FlagSet) (req *ttnpb.SearchEndDevicesRequest, opt grpc.CallOption, getTotal func() uint64, err error) {
+	filters, err := getSearchFilters(flagSet)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 	baseReq, opt, getTotal := getSearchEntitiesRequest(flagSet)
 	devEUIContains, _ := flagSet.GetString("dev-eui-contains")
 	joinEUIContains, _ := flagSet.GetString("join-eui-contains")
@@ -183,7 +330,12 @@ func getSearchEndDevicesRequest(flagSet *pflag.FlagSet) (req *ttnpb.SearchEndDev
 		Limit:               baseReq.Limit,
 		Page:                baseReq.Page,
 		Order:               baseReq.Order,
-	}, opt, getTotal
+		LocationBoundingBox: filters.boundingBox,
+		LocationRadius:      filters.radius,
+		BrandID:             filters.brandID,
+		ModelID:             filters.modelID,
+		UpdatedAfter:        filters.updatedAfter,
+	}, opt, getTotal, nil
 }
 
 var errNoIDs = errors.DefineInvalidArgument("no_ids", "no IDs set")
@@ -16,6 +16,7 @@ package commands
 
 import (
 	"os"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
@@ -42,7 +43,10 @@ func gatewayIDFlags() *pflag.FlagSet {
 	return flagSet
 }
 
-var errNoGatewayID = errors.DefineInvalidArgument("no_gateway_id", "no gateway ID set")
+var (
+	errNoGatewayID = errors.DefineInvalidArgument("no_gateway_id", "no gateway ID set")
+	errInvalidTime = errors.DefineInvalidArgument("invalid_time", "invalid time in flag `{flag}`")
+)
 
 func getGatewayID(flagSet *pflag.FlagSet, args []string, requireID bool) (*ttnpb.GatewayIdentifiers, error) {
 	gatewayID, _ := flagSet.GetString("gateway-id")
@@ -116,6 +120,7 @@ var (
 				Limit:        limit,
 				Page:         page,
 				Order:        getOrder(cmd.Flags()),
+				Deleted:      getDeleted(cmd.Flags()),
 			}, opt)
 			if err != nil {
 				return err
@@ -131,7 +136,10 @@ var (
 		RunE: func(cmd *cobra.Command, args []string) error {
 			paths := util.SelectFieldMask(cmd.Flags(), selectGatewayFlags)
 
-			req, opt, getTotal := getSearchEntitiesRequest(cmd.Flags())
+			req, opt, getTotal, err := getSearchGatewaysRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
 			req.FieldMask.Paths = paths
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
@@ -355,6 +363,48 @@ var (
 			return nil
 		},
 	}
+	gatewaysRestoreCommand = &cobra.Command{
+		Use:   "restore [gateway-id]",
+		Short: "Restore a recently deleted gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewGatewayRegistryClient(is).Restore(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	gatewaysPurgeCommand = &cobra.Command{
+		Use:   "purge [gateway-id]",
+		Short: "Purge a gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewGatewayRegistryClient(is).Purge(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
 	gatewaysConnectionStats = &cobra.Command{
 		Use:   "connection-stats [gateway-id]",
 		Short: "Get connection stats for a gateway",
@@ -394,6 +444,45 @@ var (
 			return io.Write(os.Stdout, config.OutputFormat, res)
 		},
 	}
+	gatewaysTrafficStats = &cobra.Command{
+		Use:   "traffic-stats [gateway-id]",
+		Short: "Get traffic stats of a gateway over time",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			req := &ttnpb.GetGatewayTrafficStatsRequest{
+				GatewayIdentifiers: *gtwID,
+			}
+			for flag, dst := range map[string]**time.Time{
+				"from": &req.From,
+				"to":   &req.To,
+			} {
+				value, _ := cmd.Flags().GetString(flag)
+				if value == "" {
+					continue
+				}
+				t, err := time.Parse(time.RFC3339, value)
+				if err != nil {
+					return errInvalidTime.WithAttributes("flag", flag).WithCause(err)
+				}
+				*dst = &t
+			}
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+
+			res, err := ttnpb.NewGsClient(gs).GetGatewayTrafficStats(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
 	gatewaysContactInfoCommand = contactInfoCommands("gateway", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
 		gtwID, err := getGatewayID(cmd.Flags(), args, true)
 		if err != nil {
@@ -410,8 +499,9 @@ func init() {
 	gatewaysListCommand.Flags().AddFlagSet(selectGatewayFlags)
 	gatewaysListCommand.Flags().AddFlagSet(paginationFlags())
 	gatewaysListCommand.Flags().AddFlagSet(orderFlags())
+	gatewaysListCommand.Flags().AddFlagSet(deletedFlags())
 	gatewaysCommand.AddCommand(gatewaysListCommand)
-	gatewaysSearchCommand.Flags().AddFlagSet(searchFlags())
+	gatewaysSearchCommand.Flags().AddFlagSet(searchGatewaysFlags())
 	gatewaysSearchCommand.Flags().AddFlagSet(selectGatewayFlags)
 	gatewaysCommand.AddCommand(gatewaysSearchCommand)
 	gatewaysGetCommand.Flags().AddFlagSet(gatewayIDFlags())
@@ -434,8 +524,16 @@ func init() {
 	gatewaysCommand.AddCommand(gatewaysUpdateCommand)
 	gatewaysDeleteCommand.Flags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysDeleteCommand)
+	gatewaysRestoreCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCommand.AddCommand(gatewaysRestoreCommand)
+	gatewaysPurgeCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCommand.AddCommand(gatewaysPurgeCommand)
 	gatewaysConnectionStats.Flags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysConnectionStats)
+	gatewaysTrafficStats.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysTrafficStats.Flags().String("from", "", "start of the time range (RFC3339, defaults to 24 hours before the end)")
+	gatewaysTrafficStats.Flags().String("to", "", "end of the time range (RFC3339, defaults to now)")
+	gatewaysCommand.AddCommand(gatewaysTrafficStats)
 	gatewaysContactInfoCommand.PersistentFlags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysContactInfoCommand)
 	Root.AddCommand(gatewaysCommand)
@@ -191,6 +191,11 @@ var (
 				return errNoAPIKeyRights
 			}
 
+			expiresAt, allowedIPRanges, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
 				return err
@@ -199,6 +204,8 @@ var (
 				GatewayIdentifiers: *gtwID,
 				Name:               name,
 				Rights:             rights,
+				ExpiresAt:          expiresAt,
+				AllowedIPRanges:    allowedIPRanges,
 			})
 			if err != nil {
 				return err
@@ -307,6 +314,7 @@ func init() {
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysList)
 	gatewayAPIKeysCreate.Flags().String("name", "", "")
 	gatewayAPIKeysCreate.Flags().AddFlagSet(gatewayRightsFlags)
+	gatewayAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionsFlags())
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysCreate)
 	gatewayAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	gatewayAPIKeysUpdate.Flags().String("name", "", "")
@@ -0,0 +1,295 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	stdio "io"
+	"io/ioutil"
+	"net"
+	"os"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/gorilla/websocket"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/capture"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var (
+	errNoCaptureFile       = errors.DefineInvalidArgument("no_capture_file", "no capture file set")
+	errNoReplayAddress     = errors.DefineInvalidArgument("no_replay_address", "no address set for protocol `{protocol}`")
+	errUnsupportedProtocol = errors.DefineInvalidArgument("unsupported_protocol", "protocol `{protocol}` is not supported")
+	errNoGatewayEUI        = errors.DefineInvalidArgument("no_gateway_eui", "no gateway EUI in capture")
+	errReplayTimeout       = errors.DefineUnavailable("replay_timeout", "timeout replaying frame")
+)
+
+func replayFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("file", "", "capture file to replay")
+	flagSet.String("udp-address", "", "address of the UDP frontend of the Gateway Server (host:port)")
+	flagSet.String("basic-station-address", "", "address of the Basic Station frontend of the Gateway Server (ws:// or wss:// URL)")
+	flagSet.String("mqtt-address", "", "address of the MQTT frontend of the Gateway Server (tcp:// or ssl:// URL)")
+	flagSet.String("api-key", "", "gateway API key to connect to the MQTT frontend")
+	flagSet.Bool("original-timing", true, "replay frames with the original timing")
+	return flagSet
+}
+
+// replayer sends captured frames to the Gateway Server frontends.
+// The connections to the frontends are established on first use.
+type replayer struct {
+	flags *pflag.FlagSet
+	ids   ttnpb.GatewayIdentifiers
+
+	udp  net.Conn
+	ws   *websocket.Conn
+	mqtt mqtt.Client
+}
+
+func (r *replayer) address(protocol, flag string) (string, error) {
+	address, _ := r.flags.GetString(flag)
+	if address == "" {
+		return "", errNoReplayAddress.WithAttributes("protocol", protocol)
+	}
+	return address, nil
+}
+
+func (r *replayer) send(frame *capture.Frame) error {
+	switch frame.Protocol {
+	case "udp":
+		if r.udp == nil {
+			address, err := r.address(frame.Protocol, "udp-address")
+			if err != nil {
+				return err
+			}
+			if r.udp, err = net.Dial("udp", address); err != nil {
+				return err
+			}
+		}
+		_, err := r.udp.Write(frame.Payload)
+		return err
+
+	case "basicstation":
+		if r.ws == nil {
+			address, err := r.address(frame.Protocol, "basic-station-address")
+			if err != nil {
+				return err
+			}
+			eui := r.ids.EUI
+			if eui == nil {
+				return errNoGatewayEUI.New()
+			}
+			url := fmt.Sprintf("%s/traffic/eui-%s", address, eui.String())
+			if r.ws, _, err = websocket.DefaultDialer.Dial(url, nil); err != nil {
+				return err
+			}
+			go func() {
+				// Discard downstream messages; the reads are needed to process control messages.
+				for {
+					if _, _, err := r.ws.ReadMessage(); err != nil {
+						return
+					}
+				}
+			}()
+		}
+		return r.ws.WriteMessage(websocket.TextMessage, frame.Payload)
+
+	case "mqtt":
+		if r.mqtt == nil {
+			address, err := r.address(frame.Protocol, "mqtt-address")
+			if err != nil {
+				return err
+			}
+			apiKey, _ := r.flags.GetString("api-key")
+			clientOpts := mqtt.NewClientOptions()
+			clientOpts.AddBroker(address)
+			clientOpts.SetUsername(unique.ID(ctx, r.ids))
+			clientOpts.SetPassword(apiKey)
+			clientOpts.SetAutoReconnect(false)
+			client := mqtt.NewClient(clientOpts)
+			if token := client.Connect(); !token.WaitTimeout(10 * time.Second) {
+				return errReplayTimeout.New()
+			} else if err := token.Error(); err != nil {
+				return err
+			}
+			r.mqtt = client
+		}
+		token := r.mqtt.Publish(frame.Topic, 1, false, frame.Payload)
+		if !token.WaitTimeout(10 * time.Second) {
+			return errReplayTimeout.New()
+		}
+		return token.Error()
+
+	default:
+		return errUnsupportedProtocol.WithAttributes("protocol", frame.Protocol)
+	}
+}
+
+func (r *replayer) Close() {
+	if r.udp != nil {
+		r.udp.Close()
+	}
+	if r.ws != nil {
+		r.ws.Close()
+	}
+	if r.mqtt != nil {
+		r.mqtt.Disconnect(100)
+	}
+}
+
+var (
+	gatewaysCaptureCommand = &cobra.Command{
+		Use:   "capture [gateway-id]",
+		Short: "Capture the raw traffic of a gateway on the Gateway Server",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			duration, _ := cmd.Flags().GetDuration("duration")
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).CaptureGatewayTraffic(ctx, &ttnpb.CaptureGatewayTrafficRequest{
+				GatewayIdentifiers: *gtwID,
+				Duration:           duration,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysCaptureGetCommand = &cobra.Command{
+		Use:   "get [gateway-id] [file-name]",
+		Short: "Get a stopped capture of the raw traffic of a gateway from the Gateway Server",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var fileName string
+			if len(args) > 1 {
+				fileName, args = args[1], args[:1]
+			} else {
+				fileName, _ = cmd.Flags().GetString("file-name")
+			}
+			if fileName == "" {
+				return errNoCaptureFile.New()
+			}
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).GetGatewayTrafficCapture(ctx, &ttnpb.GetGatewayTrafficCaptureRequest{
+				GatewayIdentifiers: *gtwID,
+				FileName:           fileName,
+			})
+			if err != nil {
+				return err
+			}
+
+			outputFile, _ := cmd.Flags().GetString("output-file")
+			if outputFile == "" {
+				outputFile = res.FileName
+			}
+			if err := ioutil.WriteFile(outputFile, res.Data, 0600); err != nil {
+				return err
+			}
+			logger.WithField("file", outputFile).Info("Wrote capture")
+			return nil
+		},
+	}
+	gatewaysReplayCommand = &cobra.Command{
+		Use:   "replay",
+		Short: "Replay captured gateway traffic to the Gateway Server",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fileName, _ := cmd.Flags().GetString("file")
+			if fileName == "" {
+				return errNoCaptureFile.New()
+			}
+			f, err := os.Open(fileName)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			reader, err := capture.NewReader(f)
+			if err != nil {
+				return err
+			}
+			header := reader.Header()
+			logger.WithFields(log.Fields(
+				"gateway_uid", unique.ID(ctx, header.GatewayIdentifiers),
+				"started_at", header.StartedAt,
+			)).Info("Replay capture")
+
+			r := &replayer{
+				flags: cmd.Flags(),
+				ids:   header.GatewayIdentifiers,
+			}
+			defer r.Close()
+
+			originalTiming, _ := cmd.Flags().GetBool("original-timing")
+			start := time.Now()
+			var count int
+			for {
+				frame, err := reader.Read()
+				if err == stdio.EOF {
+					break
+				}
+				if err != nil {
+					return err
+				}
+				if originalTiming {
+					select {
+					case <-ctx.Done():
+						return ctx.Err()
+					case <-time.After(time.Until(start.Add(frame.Time.Sub(header.StartedAt)))):
+					}
+				}
+				if err := r.send(frame); err != nil {
+					return err
+				}
+				count++
+			}
+			logger.WithField("frames", count).Info("Replayed capture")
+			return nil
+		},
+	}
+)
+
+func init() {
+	gatewaysCaptureCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCaptureCommand.Flags().Duration("duration", 10*time.Minute, "duration of the capture")
+	gatewaysCaptureGetCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCaptureGetCommand.Flags().String("file-name", "", "name of the capture file on the Gateway Server")
+	gatewaysCaptureGetCommand.Flags().String("output-file", "", "file to write the capture to (defaults to the name of the capture file)")
+	gatewaysCaptureCommand.AddCommand(gatewaysCaptureGetCommand)
+	gatewaysCommand.AddCommand(gatewaysCaptureCommand)
+	gatewaysReplayCommand.Flags().AddFlagSet(replayFlags())
+	gatewaysCommand.AddCommand(gatewaysReplayCommand)
+}
@@ -0,0 +1,428 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	stdio "io"
+	"os"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	errUnknownArchiveEntry = errors.DefineInvalidArgument("unknown_archive_entry", "unknown archive entry `{name}`")
+	errImportCollaborator  = errors.Define("import_collaborator", "could not set collaborator `{collaborator}`")
+)
+
+func importFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("file", "", "archive file to import")
+	flagSet.String("progress-file", "", "file that keeps track of the imported entries, to resume an interrupted import (default <file>.progress)")
+	flagSet.AddFlagSet(collaboratorFlags())
+	flagSet.AddFlagSet(archiveKEKFlags())
+	return flagSet
+}
+
+// importer imports the entries of an archive. Entities that already exist are updated,
+// so that an archive can be imported again.
+type importer struct {
+	// collaborator is the collaborator of the created organizations, and of the
+	// created applications and gateways if the archive does not contain an organization.
+	collaborator *ttnpb.OrganizationOrUserIdentifiers
+	// organization is the organization in the archive, if any.
+	organization *ttnpb.OrganizationIdentifiers
+	kekLabel     string
+	keyVault     crypto.KeyVault
+}
+
+// owner returns the collaborator of created applications and gateways.
+func (imp *importer) owner() *ttnpb.OrganizationOrUserIdentifiers {
+	if imp.organization != nil {
+		return imp.organization.OrganizationOrUserIdentifiers()
+	}
+	return imp.collaborator
+}
+
+// updatePaths returns the paths of the decoded fields that can be updated with the given RPC.
+func updatePaths(paths []string, rpc string, flatten ...string) []string {
+	return ttnpb.AllowedFields(
+		nonImplicitPaths(ttnpb.FlattenPaths(paths, flatten)...),
+		ttnpb.AllowedFieldMaskPathsForRPC[rpc],
+	)
+}
+
+func (imp *importer) importEntry(entry *archiveEntry) error {
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	switch entry.kind() {
+	case "organizations":
+		var organization ttnpb.Organization
+		paths, err := entry.decode(&organization)
+		if err != nil {
+			return err
+		}
+		if imp.collaborator == nil {
+			return errNoCollaborator
+		}
+		_, err = ttnpb.NewOrganizationRegistryClient(is).Create(ctx, &ttnpb.CreateOrganizationRequest{
+			Organization: organization,
+			Collaborator: *imp.collaborator,
+		})
+		if errors.IsAlreadyExists(err) {
+			_, err = ttnpb.NewOrganizationRegistryClient(is).Update(ctx, &ttnpb.UpdateOrganizationRequest{
+				Organization: organization,
+				FieldMask:    types.FieldMask{Paths: updatePaths(paths, "/ttn.lorawan.v3.OrganizationRegistry/Update", "attributes")},
+			})
+		}
+		return err
+
+	case "organizations/collaborators":
+		ids := ttnpb.OrganizationIdentifiers{OrganizationID: strings.Split(entry.name, "/")[1]}
+		return importCollaborators(entry, func(collaborator ttnpb.Collaborator) error {
+			_, err := ttnpb.NewOrganizationAccessClient(is).SetCollaborator(ctx, &ttnpb.SetOrganizationCollaboratorRequest{
+				OrganizationIdentifiers: ids,
+				Collaborator:            collaborator,
+			})
+			return err
+		})
+
+	case "applications":
+		var application ttnpb.Application
+		paths, err := entry.decode(&application)
+		if err != nil {
+			return err
+		}
+		owner := imp.owner()
+		if owner == nil {
+			return errNoCollaborator
+		}
+		_, err = ttnpb.NewApplicationRegistryClient(is).Create(ctx, &ttnpb.CreateApplicationRequest{
+			Application:  application,
+			Collaborator: *owner,
+		})
+		if errors.IsAlreadyExists(err) {
+			_, err = ttnpb.NewApplicationRegistryClient(is).Update(ctx, &ttnpb.UpdateApplicationRequest{
+				Application: application,
+				FieldMask:   types.FieldMask{Paths: updatePaths(paths, "/ttn.lorawan.v3.ApplicationRegistry/Update", "attributes")},
+			})
+		}
+		return err
+
+	case "applications/collaborators":
+		ids := ttnpb.ApplicationIdentifiers{ApplicationID: strings.Split(entry.name, "/")[1]}
+		return importCollaborators(entry, func(collaborator ttnpb.Collaborator) error {
+			_, err := ttnpb.NewApplicationAccessClient(is).SetCollaborator(ctx, &ttnpb.SetApplicationCollaboratorRequest{
+				ApplicationIdentifiers: ids,
+				Collaborator:           collaborator,
+			})
+			return err
+		})
+
+	case "applications/webhooks":
+		if !config.ApplicationServerEnabled {
+			logger.WithField("name", entry.name).Warn("Application Server disabled, skipping webhook")
+			return nil
+		}
+		var webhook ttnpb.ApplicationWebhook
+		paths, err := entry.decode(&webhook)
+		if err != nil {
+			return err
+		}
+		as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		_, err = ttnpb.NewApplicationWebhookRegistryClient(as).Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+			ApplicationWebhook: webhook,
+			FieldMask:          types.FieldMask{Paths: updatePaths(paths, "/ttn.lorawan.v3.ApplicationWebhookRegistry/Set", "headers")},
+		})
+		return err
+
+	case "applications/pubsubs":
+		if !config.ApplicationServerEnabled {
+			logger.WithField("name", entry.name).Warn("Application Server disabled, skipping pub/sub")
+			return nil
+		}
+		var pubsub ttnpb.ApplicationPubSub
+		paths, err := entry.decode(&pubsub)
+		if err != nil {
+			return err
+		}
+		as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		_, err = ttnpb.NewApplicationPubSubRegistryClient(as).Set(ctx, &ttnpb.SetApplicationPubSubRequest{
+			ApplicationPubSub: pubsub,
+			FieldMask:         types.FieldMask{Paths: updatePaths(paths, "/ttn.lorawan.v3.ApplicationPubSubRegistry/Set")},
+		})
+		return err
+
+	case "applications/devices":
+		var device ttnpb.EndDevice
+		paths, err := entry.decode(&device)
+		if err != nil {
+			return err
+		}
+		return imp.importEndDevice(&device, paths)
+
+	case "applications/devices/associations":
+		if !config.ApplicationServerEnabled {
+			logger.WithField("name", entry.name).Warn("Application Server disabled, skipping package association")
+			return nil
+		}
+		var association ttnpb.ApplicationPackageAssociation
+		paths, err := entry.decode(&association)
+		if err != nil {
+			return err
+		}
+		as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		_, err = ttnpb.NewApplicationPackageRegistryClient(as).SetAssociation(ctx, &ttnpb.SetApplicationPackageAssociationRequest{
+			ApplicationPackageAssociation: association,
+			FieldMask:                     types.FieldMask{Paths: updatePaths(paths, "/ttn.lorawan.v3.ApplicationPackageRegistry/SetAssociation", "data")},
+		})
+		return err
+
+	case "gateways":
+		var gateway ttnpb.Gateway
+		paths, err := entry.decode(&gateway)
+		if err != nil {
+			return err
+		}
+		owner := imp.owner()
+		if owner == nil {
+			return errNoCollaborator
+		}
+		_, err = ttnpb.NewGatewayRegistryClient(is).Create(ctx, &ttnpb.CreateGatewayRequest{
+			Gateway:      gateway,
+			Collaborator: *owner,
+		})
+		if errors.IsAlreadyExists(err) {
+			_, err = ttnpb.NewGatewayRegistryClient(is).Update(ctx, &ttnpb.UpdateGatewayRequest{
+				Gateway:   gateway,
+				FieldMask: types.FieldMask{Paths: updatePaths(paths, "/ttn.lorawan.v3.GatewayRegistry/Update", "attributes")},
+			})
+		}
+		return err
+
+	case "gateways/collaborators":
+		ids := ttnpb.GatewayIdentifiers{GatewayID: strings.Split(entry.name, "/")[1]}
+		return importCollaborators(entry, func(collaborator ttnpb.Collaborator) error {
+			_, err := ttnpb.NewGatewayAccessClient(is).SetCollaborator(ctx, &ttnpb.SetGatewayCollaboratorRequest{
+				GatewayIdentifiers: ids,
+				Collaborator:       collaborator,
+			})
+			return err
+		})
+
+	default:
+		return errUnknownArchiveEntry.WithAttributes("name", entry.name)
+	}
+}
+
+// importCollaborators sets the collaborators in the entry with set. It returns on the first collaborator
+// that can not be set, so that the entry is not recorded as imported and is imported again on resume.
+func importCollaborators(entry *archiveEntry, set func(ttnpb.Collaborator) error) error {
+	var collaborators ttnpb.Collaborators
+	if _, err := entry.decode(&collaborators); err != nil {
+		return err
+	}
+	for _, collaborator := range collaborators.Collaborators {
+		if err := set(*collaborator); err != nil {
+			return errImportCollaborator.WithAttributes("collaborator", collaborator.IDString()).WithCause(err)
+		}
+	}
+	return nil
+}
+
+func (imp *importer) importEndDevice(device *ttnpb.EndDevice, paths []string) error {
+	paths, err := unwrapEndDeviceKeys(device, paths, imp.kekLabel, imp.keyVault)
+	if err != nil {
+		return err
+	}
+	paths = ttnpb.FlattenPaths(paths, []string{"attributes", "locations", "picture", "provisioning_data"})
+
+	// The registered server addresses are those of the source deployment.
+	if device.NetworkServerAddress != "" && config.NetworkServerEnabled {
+		device.NetworkServerAddress = getHost(config.NetworkServerGRPCAddress)
+	}
+	if device.ApplicationServerAddress != "" && config.ApplicationServerEnabled {
+		device.ApplicationServerAddress = getHost(config.ApplicationServerGRPCAddress)
+	}
+	if device.JoinServerAddress != "" && config.JoinServerEnabled {
+		device.JoinServerAddress = getHost(config.JoinServerGRPCAddress)
+	}
+
+	isPaths, nsPaths, asPaths, jsPaths := splitEndDeviceSetPaths(device.SupportsJoin, paths...)
+
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	_, err = ttnpb.NewEndDeviceRegistryClient(is).Create(ctx, &ttnpb.CreateEndDeviceRequest{
+		EndDevice: *device,
+	})
+	switch {
+	case errors.IsAlreadyExists(err):
+		_, err = setEndDevice(device, isPaths, nsPaths, asPaths, jsPaths, false, false)
+		return err
+	case err != nil:
+		return err
+	}
+	if _, err = setEndDevice(device, nil, nsPaths, asPaths, jsPaths, true, false); err != nil {
+		logger.WithError(err).Error("Could not import end device, rolling back...")
+		if err := deleteEndDevice(context.Background(), &device.EndDeviceIdentifiers); err != nil {
+			logger.WithError(err).Error("Could not roll back end device import")
+		}
+		return err
+	}
+	return nil
+}
+
+// readImportProgress returns the names of the entries that were already imported.
+func readImportProgress(fileName string) (map[string]bool, error) {
+	done := make(map[string]bool)
+	f, err := os.Open(fileName)
+	if os.IsNotExist(err) {
+		return done, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		done[scanner.Text()] = true
+	}
+	return done, scanner.Err()
+}
+
+var importCommand = &cobra.Command{
+	Use:   "import",
+	Short: "Import entities from an archive",
+	Long: `Import entities from an archive
+
+The archive is created with the export command. Entities that already exist are
+updated, so the same archive can be imported again. The names of the imported
+entries are written to the progress file, so that an interrupted import can be
+resumed by running the same command again. The import stops at the first entry
+that can not be imported, including entries of which a collaborator can not be
+set, so that the entry is imported again when the import is resumed.
+
+Organizations in the archive are created with the user that is set with the
+--user-id flag as collaborator. Applications and gateways are created with the
+organization in the archive as collaborator, or with the collaborator that is
+set with the --user-id or --organization-id flag if the archive does not contain
+an organization.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fileName, _ := cmd.Flags().GetString("file")
+		if fileName == "" {
+			return errNoArchiveFile
+		}
+		progressFileName, _ := cmd.Flags().GetString("progress-file")
+		if progressFileName == "" {
+			progressFileName = fileName + ".progress"
+		}
+		kekLabel, keyVault, err := getArchiveKeyVault(cmd.Flags())
+		if err != nil {
+			return err
+		}
+		imp := &importer{
+			collaborator: getCollaborator(cmd.Flags()),
+			kekLabel:     kekLabel,
+			keyVault:     keyVault,
+		}
+
+		f, err := os.Open(fileName)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r, err := newArchiveReader(f)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		manifest, err := r.Manifest()
+		if err != nil {
+			return err
+		}
+		if manifest.KEKLabel != "" && manifest.KEKLabel != kekLabel {
+			return errNoArchiveKEK.WithAttributes("label", manifest.KEKLabel)
+		}
+
+		done, err := readImportProgress(progressFileName)
+		if err != nil {
+			return err
+		}
+		progress, err := os.OpenFile(progressFileName, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+		if err != nil {
+			return err
+		}
+		defer progress.Close()
+
+		for {
+			entry, err := r.Next()
+			if err == stdio.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if entry.kind() == "organizations" {
+				imp.organization = &ttnpb.OrganizationIdentifiers{
+					OrganizationID: strings.TrimSuffix(strings.Split(entry.name, "/")[1], ".json"),
+				}
+			}
+			if done[entry.name] {
+				logger.WithField("name", entry.name).Debug("Skip imported entry")
+				continue
+			}
+			logger.WithField("name", entry.name).Info("Import entry")
+			if err := imp.importEntry(entry); err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintln(progress, entry.name); err != nil {
+				return err
+			}
+		}
+
+		logger.Info("Import complete")
+		if err := progress.Close(); err != nil {
+			return err
+		}
+		return os.Remove(progressFileName)
+	},
+}
+
+func init() {
+	importCommand.Flags().AddFlagSet(importFlags())
+	Root.AddCommand(importCommand)
+}
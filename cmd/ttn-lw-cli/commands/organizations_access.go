@@ -191,6 +191,11 @@ var (
 				return errNoAPIKeyRights
 			}
 
+			expiresAt, allowedIPRanges, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
 				return err
@@ -199,6 +204,8 @@ var (
 				OrganizationIdentifiers: *orgID,
 				Name:                    name,
 				Rights:                  rights,
+				ExpiresAt:               expiresAt,
+				AllowedIPRanges:         allowedIPRanges,
 			})
 			if err != nil {
 				return err
@@ -312,6 +319,7 @@ func init() {
 	organizationAPIKeys.AddCommand(organizationAPIKeysList)
 	organizationAPIKeysCreate.Flags().String("name", "", "")
 	organizationAPIKeysCreate.Flags().AddFlagSet(organizationRightsFlags)
+	organizationAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionsFlags())
 	organizationAPIKeys.AddCommand(organizationAPIKeysCreate)
 	organizationAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	organizationAPIKeysUpdate.Flags().String("name", "", "")
@@ -0,0 +1,137 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var setQuotasFlags = util.FieldFlags(&ttnpb.Quotas{})
+
+var (
+	quotasCommand = &cobra.Command{
+		Use:   "quotas",
+		Short: "Quotas of organizations and users",
+	}
+	quotasGetCommand = &cobra.Command{
+		Use:   "get",
+		Short: "Get the quotas and usage of an organization or user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			account := getCollaborator(cmd.Flags())
+			if account == nil {
+				return errNoCollaborator
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewQuotaRegistryClient(is).GetUsage(ctx, &ttnpb.GetQuotaUsageRequest{
+				Account: *account,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	quotasSetCommand = &cobra.Command{
+		Use:   "set",
+		Short: "Override quotas of an organization or user (admin only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			account := getCollaborator(cmd.Flags())
+			if account == nil {
+				return errNoCollaborator
+			}
+			paths := util.UpdateFieldMask(cmd.Flags(), setQuotasFlags)
+			if len(paths) == 0 {
+				logger.Warn("No quotas selected, won't update anything")
+				return nil
+			}
+			var quotas ttnpb.Quotas
+			if err := util.SetFields(&quotas, setQuotasFlags); err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			reg := ttnpb.NewQuotaRegistryClient(is)
+			current, err := reg.GetUsage(ctx, &ttnpb.GetQuotaUsageRequest{
+				Account: *account,
+			})
+			if err != nil {
+				return err
+			}
+			// Keep the quotas that are already overridden.
+			if err = current.Quotas.SetFields(&quotas, paths...); err != nil {
+				return err
+			}
+			res, err := reg.Set(ctx, &ttnpb.SetQuotasRequest{
+				Account:   *account,
+				Quotas:    current.Quotas,
+				FieldMask: types.FieldMask{Paths: append(current.Overrides.Paths, paths...)},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	quotasResetCommand = &cobra.Command{
+		Use:   "reset",
+		Short: "Reset the quotas of an organization or user to the defaults (admin only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			account := getCollaborator(cmd.Flags())
+			if account == nil {
+				return errNoCollaborator
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewQuotaRegistryClient(is).Set(ctx, &ttnpb.SetQuotasRequest{
+				Account: *account,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+)
+
+func init() {
+	quotasGetCommand.Flags().AddFlagSet(collaboratorFlags())
+	quotasCommand.AddCommand(quotasGetCommand)
+	quotasSetCommand.Flags().AddFlagSet(collaboratorFlags())
+	quotasSetCommand.Flags().AddFlagSet(setQuotasFlags)
+	quotasCommand.AddCommand(quotasSetCommand)
+	quotasResetCommand.Flags().AddFlagSet(collaboratorFlags())
+	quotasCommand.AddCommand(quotasResetCommand)
+	Root.AddCommand(quotasCommand)
+}
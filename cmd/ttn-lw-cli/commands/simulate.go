@@ -189,31 +189,14 @@ func simulate(cmd *cobra.Command, forUp func(*ttnpb.UplinkMessage) error, forDow
 		return err
 	}
 
-	gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
-	if err != nil {
-		return err
-	}
 	timeout, _ := cmd.Flags().GetDuration("timeout")
 	linkCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	md := rpcmetadata.MD{
-		ID: gtwID.GatewayID,
-	}
-	if apiKey, _ := cmd.Flags().GetString("gateway-api-key"); apiKey != "" {
-		md.AuthType = "Bearer"
-		md.AuthValue = apiKey
-	}
-	linkCtx = md.ToOutgoingContext(linkCtx)
-	link, err := ttnpb.NewGtwGsClient(gs).LinkGateway(linkCtx)
+	link, err := linkGateway(linkCtx, cmd, gtwID)
 	if err != nil {
 		return err
 	}
 
-	// Send dummy up to start stream:
-	if err = link.Send(&ttnpb.GatewayUp{}); err != nil {
-		return err
-	}
-
 	sendTime := time.Now()
 	if err = link.Send(&ttnpb.GatewayUp{UplinkMessages: []*ttnpb.UplinkMessage{upMsg}}); err != nil {
 		return err
@@ -242,6 +225,31 @@ func simulate(cmd *cobra.Command, forUp func(*ttnpb.UplinkMessage) error, forDow
 	return ctx.Err()
 }
 
+// linkGateway links the gateway to the Gateway Server and starts the stream.
+func linkGateway(ctx context.Context, cmd *cobra.Command, gtwID *ttnpb.GatewayIdentifiers) (ttnpb.GtwGs_LinkGatewayClient, error) {
+	gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	md := rpcmetadata.MD{
+		ID: gtwID.GatewayID,
+	}
+	if apiKey, _ := cmd.Flags().GetString("gateway-api-key"); apiKey != "" {
+		md.AuthType = "Bearer"
+		md.AuthValue = apiKey
+	}
+	link, err := ttnpb.NewGtwGsClient(gs).LinkGateway(md.ToOutgoingContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// Send dummy up to start stream:
+	if err = link.Send(&ttnpb.GatewayUp{}); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
 func processDownlink(dev *ttnpb.EndDevice) func(lastUpMsg *ttnpb.Message, downMsg *ttnpb.DownlinkMessage) error {
 	return func(lastUpMsg *ttnpb.Message, downMsg *ttnpb.DownlinkMessage) (err error) {
 		phy, err := band.GetByID(dev.FrequencyPlanID)
@@ -0,0 +1,980 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math/rand"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+var (
+	errInvalidSimulationFlag = errors.DefineInvalidArgument("simulation_flag", "invalid value of flag `{flag}`")
+	errNoSimulationDevices   = errors.DefineInvalidArgument("no_simulation_devices", "no simulated end devices")
+)
+
+// maxFOptsLength is the maximum length of the FOpts field.
+const maxFOptsLength = 15
+
+func simulateLoadFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.Uint("devices", 10, "number of simulated end devices")
+	flagSet.String("join-eui", "", "JoinEUI of the end devices (hex)")
+	flagSet.String("dev-eui", "", "DevEUI of the first end device; the DevEUIs of the other end devices are incremented from it (hex)")
+	flagSet.String("app-key", "", "AppKey of the end devices (hex)")
+	flagSet.String("nwk-key", "", "NwkKey of the end devices; the AppKey is used if not set (hex)")
+	flagSet.Uint16("dev-nonce", 0, "first DevNonce of the end devices; a random DevNonce is used if not set")
+	flagSet.String("lorawan-version", ttnpb.MAC_V1_0_3.String(), "LoRaWAN version of the end devices")
+	flagSet.String("lorawan-phy-version", ttnpb.PHY_V1_0_3_REV_A.String(), "LoRaWAN Regional Parameters version of the end devices")
+	flagSet.String("band-id", band.EU_863_870, "band of the end devices")
+	flagSet.UintSlice("data-rate-indices", nil, "data rate indices of the uplinks, chosen at random; all LoRa data rates of the band are used if not set")
+	flagSet.Bool("adr", false, "use the data rate that is requested by the Network Server with LinkADRReq")
+	flagSet.Duration("duration", 5*time.Minute, "duration of the load test")
+	flagSet.Duration("ramp-up", 30*time.Second, "period in which the end devices start joining")
+	flagSet.Duration("join-timeout", 10*time.Second, "how long to wait for a join-accept before sending a new join-request")
+	flagSet.Duration("uplink-interval", time.Minute, "interval between the uplinks of an end device")
+	flagSet.Float64("uplink-interval-jitter", 0.1, "relative jitter of the uplink interval")
+	flagSet.Float64("confirmed-ratio", 0, "ratio of confirmed uplinks (0-1)")
+	flagSet.Uint32("f-port", 1, "FPort of the uplinks")
+	flagSet.Uint("payload-size", 10, "size of the FRMPayload of the uplinks")
+	flagSet.Float32("rssi", -50, "RSSI of the uplinks")
+	flagSet.Float32("snr", 7, "SNR of the uplinks")
+	flagSet.Duration("drain-timeout", 10*time.Second, "how long to wait for downlinks after the load test")
+	flagSet.Duration("report-interval", 30*time.Second, "interval of intermediate statistics in the log (0 is disabled)")
+	return flagSet
+}
+
+type simulateLatencyReport struct {
+	Min    string `json:"min"`
+	Mean   string `json:"mean"`
+	P50    string `json:"p50"`
+	P95    string `json:"p95"`
+	P99    string `json:"p99"`
+	Max    string `json:"max"`
+	Number int    `json:"number"`
+}
+
+func newSimulateLatencyReport(latencies []time.Duration) simulateLatencyReport {
+	if len(latencies) == 0 {
+		return simulateLatencyReport{}
+	}
+	sorted := append(latencies[:0:0], latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var sum time.Duration
+	for _, l := range sorted {
+		sum += l
+	}
+	percentile := func(p int) time.Duration {
+		return sorted[(len(sorted)-1)*p/100]
+	}
+	return simulateLatencyReport{
+		Min:    sorted[0].String(),
+		Mean:   (sum / time.Duration(len(sorted))).String(),
+		P50:    percentile(50).String(),
+		P95:    percentile(95).String(),
+		P99:    percentile(99).String(),
+		Max:    sorted[len(sorted)-1].String(),
+		Number: len(sorted),
+	}
+}
+
+type simulateLoadReport struct {
+	Duration           string                `json:"duration"`
+	Devices            int                   `json:"devices"`
+	JoinedDevices      int                   `json:"joined_devices"`
+	JoinRequests       uint64                `json:"join_requests"`
+	JoinAccepts        uint64                `json:"join_accepts"`
+	JoinLoss           float64               `json:"join_loss"`
+	JoinLatency        simulateLatencyReport `json:"join_latency"`
+	Uplinks            uint64                `json:"uplinks"`
+	ConfirmedUplinks   uint64                `json:"confirmed_uplinks"`
+	Acknowledgments    uint64                `json:"acknowledgments"`
+	AcknowledgmentLoss float64               `json:"acknowledgment_loss"`
+	Downlinks          uint64                `json:"downlinks"`
+	DownlinkLatency    simulateLatencyReport `json:"downlink_latency"`
+	MACCommandAnswers  uint64                `json:"mac_command_answers"`
+	MICFailures        uint64                `json:"mic_failures"`
+	UnmatchedDownlinks uint64                `json:"unmatched_downlinks"`
+}
+
+// simulateLoadStats contains the statistics of a load test.
+type simulateLoadStats struct {
+	mu                 sync.Mutex
+	joinedDevices      int
+	joinRequests       uint64
+	joinAccepts        uint64
+	joinLatencies      []time.Duration
+	uplinks            uint64
+	confirmedUplinks   uint64
+	acknowledgments    uint64
+	downlinks          uint64
+	downlinkLatencies  []time.Duration
+	macCommandAnswers  uint64
+	micFailures        uint64
+	unmatchedDownlinks uint64
+}
+
+func (s *simulateLoadStats) update(f func(*simulateLoadStats)) {
+	s.mu.Lock()
+	f(s)
+	s.mu.Unlock()
+}
+
+func (s *simulateLoadStats) report(duration time.Duration, devices int) *simulateLoadReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	loss := func(sent, received uint64) float64 {
+		if sent == 0 || received > sent {
+			return 0
+		}
+		return float64(sent-received) / float64(sent)
+	}
+	return &simulateLoadReport{
+		Duration:           duration.String(),
+		Devices:            devices,
+		JoinedDevices:      s.joinedDevices,
+		JoinRequests:       s.joinRequests,
+		JoinAccepts:        s.joinAccepts,
+		JoinLoss:           loss(s.joinRequests, s.joinAccepts),
+		JoinLatency:        newSimulateLatencyReport(s.joinLatencies),
+		Uplinks:            s.uplinks,
+		ConfirmedUplinks:   s.confirmedUplinks,
+		Acknowledgments:    s.acknowledgments,
+		AcknowledgmentLoss: loss(s.confirmedUplinks, s.acknowledgments),
+		Downlinks:          s.downlinks,
+		DownlinkLatency:    newSimulateLatencyReport(s.downlinkLatencies),
+		MACCommandAnswers:  s.macCommandAnswers,
+		MICFailures:        s.micFailures,
+		UnmatchedDownlinks: s.unmatchedDownlinks,
+	}
+}
+
+// loadSimulator simulates end devices that send traffic through a virtual gateway.
+type loadSimulator struct {
+	gtwID     ttnpb.GatewayIdentifiers
+	link      ttnpb.GtwGs_LinkGatewayClient
+	sendMu    sync.Mutex
+	phy       band.Band
+	dataRates []ttnpb.DataRateIndex
+
+	macVersion           ttnpb.MACVersion
+	adr                  bool
+	joinTimeout          time.Duration
+	uplinkInterval       time.Duration
+	uplinkIntervalJitter float64
+	confirmedRatio       float64
+	fPort                uint32
+	payloadSize          int
+	rssi, snr            float32
+	devicesMu            sync.Mutex
+	pendingJoins         map[*simulatedDevice]struct{}
+	devicesByDevAddr     map[types.DevAddr][]*simulatedDevice
+	stats                simulateLoadStats
+}
+
+func (s *loadSimulator) send(up *ttnpb.GatewayUp) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+	return s.link.Send(up)
+}
+
+// jitter returns d with a random relative deviation of at most the configured uplink interval jitter.
+func (s *loadSimulator) jitter(d time.Duration) time.Duration {
+	return d + time.Duration((rand.Float64()*2-1)*s.uplinkIntervalJitter*float64(d))
+}
+
+// uplinkSettings returns a random data rate and channel for an uplink.
+func (s *loadSimulator) uplinkSettings(drIdx *ttnpb.DataRateIndex) (ttnpb.DataRateIndex, int) {
+	dr := s.dataRates[rand.Intn(len(s.dataRates))]
+	if drIdx != nil {
+		dr = *drIdx
+	}
+	var chs []int
+	for i, ch := range s.phy.UplinkChannels {
+		if ch.MinDataRate <= dr && dr <= ch.MaxDataRate {
+			chs = append(chs, i)
+		}
+	}
+	if len(chs) == 0 {
+		return dr, 0
+	}
+	return dr, chs[rand.Intn(len(chs))]
+}
+
+func (s *loadSimulator) sendUplink(raw []byte, drIdx ttnpb.DataRateIndex, chIdx int) (time.Time, error) {
+	now := time.Now()
+	timestamp := uint32(now.UnixNano() / 1000)
+	up := &ttnpb.UplinkMessage{
+		RawPayload: raw,
+		Settings: ttnpb.TxSettings{
+			DataRate:      s.phy.DataRates[drIdx].Rate,
+			DataRateIndex: drIdx,
+			CodingRate:    "4/5",
+			Frequency:     s.phy.UplinkChannels[chIdx].Frequency,
+			Timestamp:     timestamp,
+			Time:          &now,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: s.gtwID,
+				Time:               &now,
+				Timestamp:          timestamp,
+				RSSI:               s.rssi,
+				ChannelRSSI:        s.rssi,
+				SNR:                s.snr,
+				ChannelIndex:       uint32(chIdx),
+			},
+		},
+	}
+	return now, s.send(&ttnpb.GatewayUp{UplinkMessages: []*ttnpb.UplinkMessage{up}})
+}
+
+func (s *loadSimulator) addPendingJoin(dev *simulatedDevice) {
+	s.devicesMu.Lock()
+	s.pendingJoins[dev] = struct{}{}
+	s.devicesMu.Unlock()
+}
+
+func (s *loadSimulator) removePendingJoin(dev *simulatedDevice) {
+	s.devicesMu.Lock()
+	delete(s.pendingJoins, dev)
+	s.devicesMu.Unlock()
+}
+
+// handleDownlinks receives the downlinks of the virtual gateway and passes them to the end devices.
+func (s *loadSimulator) handleDownlinks() error {
+	for {
+		down, err := s.link.Recv()
+		if err != nil {
+			return err
+		}
+		msg := down.GetDownlinkMessage()
+		if msg == nil {
+			continue
+		}
+		// The virtual gateway transmits every downlink.
+		if err := s.send(&ttnpb.GatewayUp{
+			TxAcknowledgment: &ttnpb.TxAcknowledgment{
+				CorrelationIDs: msg.CorrelationIDs,
+				Result:         ttnpb.TxAcknowledgment_SUCCESS,
+			},
+		}); err != nil {
+			return err
+		}
+
+		pld := &ttnpb.Message{}
+		if err := lorawan.UnmarshalMessage(msg.RawPayload, pld); err != nil {
+			logger.WithError(err).Warn("Failed to unmarshal downlink")
+			continue
+		}
+		var devs []*simulatedDevice
+		s.devicesMu.Lock()
+		switch pld.MType {
+		case ttnpb.MType_JOIN_ACCEPT:
+			for dev := range s.pendingJoins {
+				devs = append(devs, dev)
+			}
+		case ttnpb.MType_UNCONFIRMED_DOWN, ttnpb.MType_CONFIRMED_DOWN:
+			devs = append(devs, s.devicesByDevAddr[pld.GetMACPayload().DevAddr]...)
+		}
+		s.devicesMu.Unlock()
+
+		matched := false
+		for _, dev := range devs {
+			if pld.MType == ttnpb.MType_JOIN_ACCEPT {
+				matched = dev.handleJoinAccept(msg.RawPayload)
+			} else {
+				matched = dev.handleDataDownlink(pld, msg.RawPayload)
+			}
+			if matched {
+				break
+			}
+		}
+		if !matched {
+			s.stats.update(func(stats *simulateLoadStats) {
+				stats.unmatchedDownlinks++
+				if len(devs) > 0 {
+					stats.micFailures++
+				}
+			})
+		}
+	}
+}
+
+// simulatedDevice is an OTAA end device that is simulated by the loadSimulator.
+type simulatedDevice struct {
+	sim             *loadSimulator
+	joinEUI, devEUI types.EUI64
+	appKey, nwkKey  types.AES128Key
+
+	mu           sync.Mutex
+	devNonce     types.DevNonce
+	joinSentAt   time.Time
+	joinAccepted chan struct{}
+
+	joined      bool
+	lorawan11   bool
+	devAddr     types.DevAddr
+	fNwkSIntKey types.AES128Key
+	sNwkSIntKey types.AES128Key
+	nwkSEncKey  types.AES128Key
+	appSKey     types.AES128Key
+	fCntUp      uint32
+	nFCntDown   uint32
+	aFCntDown   uint32
+	rekeyInd    bool
+	dataRate    *ttnpb.DataRateIndex
+
+	lastUpSentAt    time.Time
+	lastUpFCnt      uint32
+	lastUpConfirmed bool
+	awaitingAck     bool
+	ackFCntDown     *uint32
+	macAnswers      []ttnpb.MACCommand
+}
+
+func (d *simulatedDevice) run(ctx context.Context, delay time.Duration) {
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(delay):
+	}
+	for !d.join(ctx) {
+		if ctx.Err() != nil {
+			return
+		}
+	}
+	d.sim.stats.update(func(stats *simulateLoadStats) {
+		stats.joinedDevices++
+	})
+	for {
+		if err := d.sendDataUplink(); err != nil {
+			logger.WithField("dev_eui", d.devEUI).WithError(err).Warn("Failed to send uplink")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.sim.jitter(d.sim.uplinkInterval)):
+		}
+	}
+}
+
+// join sends a join-request and reports whether a join-accept has been received before the join timeout.
+func (d *simulatedDevice) join(ctx context.Context) bool {
+	accepted := make(chan struct{})
+	d.mu.Lock()
+	d.devNonce = nextDevNonce(d.devNonce)
+	joinRequest := ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_JOIN_REQUEST,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_JoinRequestPayload{
+			JoinRequestPayload: &ttnpb.JoinRequestPayload{
+				JoinEUI:  d.joinEUI,
+				DevEUI:   d.devEUI,
+				DevNonce: d.devNonce,
+			},
+		},
+	}
+	d.joinAccepted = accepted
+	d.mu.Unlock()
+
+	buf, err := lorawan.MarshalMessage(joinRequest)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal join-request")
+		return false
+	}
+	key := d.appKey
+	if d.sim.macVersion.UseNwkKey() {
+		key = d.nwkKey
+	}
+	mic, err := crypto.ComputeJoinRequestMIC(key, buf)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to compute join-request MIC")
+		return false
+	}
+
+	d.sim.addPendingJoin(d)
+	defer d.sim.removePendingJoin(d)
+
+	drIdx, chIdx := d.sim.uplinkSettings(nil)
+	sentAt, err := d.sim.sendUplink(append(buf, mic[:]...), drIdx, chIdx)
+	if err != nil {
+		logger.WithField("dev_eui", d.devEUI).WithError(err).Warn("Failed to send join-request")
+		return false
+	}
+	d.mu.Lock()
+	d.joinSentAt = sentAt
+	d.mu.Unlock()
+	d.sim.stats.update(func(stats *simulateLoadStats) {
+		stats.joinRequests++
+	})
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d.sim.joinTimeout):
+		return false
+	case <-accepted:
+		return true
+	}
+}
+
+// handleJoinAccept handles the join-accept and reports whether the join-accept is for the end device.
+func (d *simulatedDevice) handleJoinAccept(raw []byte) bool {
+	if len(raw) < 17 {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.joinAccepted == nil {
+		return false
+	}
+
+	key := d.appKey
+	if d.sim.macVersion.UseNwkKey() {
+		key = d.nwkKey
+	}
+	payload, err := crypto.DecryptJoinAccept(key, raw[1:])
+	if err != nil {
+		return false
+	}
+	joinAcceptBytes, mic := payload[:len(payload)-4], payload[len(payload)-4:]
+	joinAccept := &ttnpb.JoinAcceptPayload{}
+	if err := lorawan.UnmarshalJoinAcceptPayload(joinAcceptBytes, joinAccept); err != nil {
+		return false
+	}
+	lorawan11 := d.sim.macVersion.Compare(ttnpb.MAC_V1_1) >= 0 && joinAccept.OptNeg
+	var expectedMIC [4]byte
+	if lorawan11 {
+		expectedMIC, err = crypto.ComputeJoinAcceptMIC(
+			crypto.DeriveJSIntKey(key, d.devEUI),
+			0xff,
+			d.joinEUI,
+			d.devNonce,
+			append([]byte{raw[0]}, joinAcceptBytes...),
+		)
+	} else {
+		expectedMIC, err = crypto.ComputeLegacyJoinAcceptMIC(key, append([]byte{raw[0]}, joinAcceptBytes...))
+	}
+	if err != nil || !bytes.Equal(mic, expectedMIC[:]) {
+		return false
+	}
+
+	if lorawan11 {
+		d.appSKey = crypto.DeriveAppSKey(d.appKey, joinAccept.JoinNonce, d.joinEUI, d.devNonce)
+		d.fNwkSIntKey = crypto.DeriveFNwkSIntKey(d.nwkKey, joinAccept.JoinNonce, d.joinEUI, d.devNonce)
+		d.sNwkSIntKey = crypto.DeriveSNwkSIntKey(d.nwkKey, joinAccept.JoinNonce, d.joinEUI, d.devNonce)
+		d.nwkSEncKey = crypto.DeriveNwkSEncKey(d.nwkKey, joinAccept.JoinNonce, d.joinEUI, d.devNonce)
+	} else {
+		d.appSKey = crypto.DeriveLegacyAppSKey(key, joinAccept.JoinNonce, joinAccept.NetID, d.devNonce)
+		nwkSKey := crypto.DeriveLegacyNwkSKey(key, joinAccept.JoinNonce, joinAccept.NetID, d.devNonce)
+		d.fNwkSIntKey, d.sNwkSIntKey, d.nwkSEncKey = nwkSKey, nwkSKey, nwkSKey
+	}
+	d.joined = true
+	d.lorawan11 = lorawan11
+	d.devAddr = joinAccept.DevAddr
+	d.fCntUp, d.nFCntDown, d.aFCntDown = 0, 0, 0
+	d.rekeyInd = lorawan11
+	d.awaitingAck, d.ackFCntDown = false, nil
+	d.macAnswers = nil
+	close(d.joinAccepted)
+	d.joinAccepted = nil
+
+	latency := time.Since(d.joinSentAt)
+	d.sim.devicesMu.Lock()
+	d.sim.devicesByDevAddr[d.devAddr] = append(d.sim.devicesByDevAddr[d.devAddr], d)
+	d.sim.devicesMu.Unlock()
+	d.sim.stats.update(func(stats *simulateLoadStats) {
+		stats.joinAccepts++
+		stats.joinLatencies = append(stats.joinLatencies, latency)
+	})
+	return true
+}
+
+// nextDevNonce returns the DevNonce that follows dn, which wraps around after 0xffff.
+func nextDevNonce(dn types.DevNonce) types.DevNonce {
+	var next types.DevNonce
+	binary.BigEndian.PutUint16(next[:], binary.BigEndian.Uint16(dn[:])+1)
+	return next
+}
+
+// fullFCnt returns the 32-bit frame counter of the 16-bit fCnt, given the last frame counter.
+func fullFCnt(fCnt, last uint32) uint32 {
+	full := last&^0xffff | fCnt&0xffff
+	if full < last {
+		full += 0x10000
+	}
+	return full
+}
+
+// handleDataDownlink handles the data downlink and reports whether the downlink is for the end device.
+func (d *simulatedDevice) handleDataDownlink(msg *ttnpb.Message, raw []byte) bool {
+	pld := msg.GetMACPayload()
+	if pld == nil || len(raw) < 4 {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.joined {
+		return false
+	}
+
+	appDown := d.lorawan11 && pld.FPort != 0
+	fCnt := fullFCnt(pld.FCnt, d.nFCntDown)
+	if appDown {
+		fCnt = fullFCnt(pld.FCnt, d.aFCntDown)
+	}
+
+	var (
+		expectedMIC [4]byte
+		err         error
+	)
+	if d.lorawan11 {
+		var confFCnt uint32
+		if pld.Ack && d.lastUpConfirmed {
+			confFCnt = d.lastUpFCnt
+		}
+		expectedMIC, err = crypto.ComputeDownlinkMIC(d.sNwkSIntKey, d.devAddr, confFCnt, fCnt, raw[:len(raw)-4])
+	} else {
+		expectedMIC, err = crypto.ComputeLegacyDownlinkMIC(d.fNwkSIntKey, d.devAddr, fCnt, raw[:len(raw)-4])
+	}
+	if err != nil || !bytes.Equal(msg.MIC, expectedMIC[:]) {
+		return false
+	}
+
+	if appDown {
+		d.aFCntDown = fCnt
+	} else {
+		d.nFCntDown = fCnt
+	}
+	if msg.MType == ttnpb.MType_CONFIRMED_DOWN {
+		d.ackFCntDown = &fCnt
+	}
+	acknowledged := pld.Ack && d.awaitingAck
+	if acknowledged {
+		d.awaitingAck = false
+	}
+	latency := time.Since(d.lastUpSentAt)
+
+	var mac []byte
+	switch {
+	case pld.FPort == 0 && len(pld.FRMPayload) > 0:
+		mac, err = crypto.DecryptDownlink(d.nwkSEncKey, d.devAddr, fCnt, pld.FRMPayload)
+	case len(pld.FOpts) > 0 && d.sim.macVersion.EncryptFOpts():
+		mac, err = crypto.DecryptDownlink(d.nwkSEncKey, d.devAddr, d.nFCntDown, pld.FOpts)
+	default:
+		mac = pld.FOpts
+	}
+	if err != nil {
+		logger.WithField("dev_eui", d.devEUI).WithError(err).Warn("Failed to decrypt MAC commands")
+		mac = nil
+	}
+	answers := d.handleMACCommands(mac)
+
+	d.sim.stats.update(func(stats *simulateLoadStats) {
+		stats.downlinks++
+		stats.downlinkLatencies = append(stats.downlinkLatencies, latency)
+		if acknowledged {
+			stats.acknowledgments++
+		}
+		stats.macCommandAnswers += uint64(answers)
+	})
+	return true
+}
+
+// handleMACCommands handles the MAC commands in b, queues the answers and returns the number of answers.
+func (d *simulatedDevice) handleMACCommands(b []byte) int {
+	var answers int
+	for r := bytes.NewReader(b); r.Len() > 0; {
+		cmd := &ttnpb.MACCommand{}
+		if err := lorawan.DefaultMACCommands.ReadDownlink(d.sim.phy, r, cmd); err != nil {
+			logger.WithFields(log.Fields(
+				"dev_eui", d.devEUI,
+				"bytes_left", r.Len(),
+			)).WithError(err).Warn("Failed to unmarshal MAC command")
+			break
+		}
+		var ans *ttnpb.MACCommand
+		switch cmd.CID {
+		case ttnpb.CID_LINK_ADR:
+			if d.sim.adr {
+				drIdx := cmd.GetLinkADRReq().DataRateIndex
+				d.dataRate = &drIdx
+			}
+			ans = (&ttnpb.MACCommand_LinkADRAns{
+				ChannelMaskAck:   true,
+				DataRateIndexAck: true,
+				TxPowerIndexAck:  true,
+			}).MACCommand()
+		case ttnpb.CID_DUTY_CYCLE, ttnpb.CID_RX_TIMING_SETUP, ttnpb.CID_TX_PARAM_SETUP, ttnpb.CID_ADR_PARAM_SETUP:
+			ans = &ttnpb.MACCommand{CID: cmd.CID}
+		case ttnpb.CID_RX_PARAM_SETUP:
+			ans = (&ttnpb.MACCommand_RxParamSetupAns{
+				Rx2DataRateIndexAck:  true,
+				Rx1DataRateOffsetAck: true,
+				Rx2FrequencyAck:      true,
+			}).MACCommand()
+		case ttnpb.CID_DEV_STATUS:
+			ans = (&ttnpb.MACCommand_DevStatusAns{
+				Margin: int32(d.sim.snr),
+			}).MACCommand()
+		case ttnpb.CID_NEW_CHANNEL:
+			ans = (&ttnpb.MACCommand_NewChannelAns{
+				FrequencyAck: true,
+				DataRateAck:  true,
+			}).MACCommand()
+		case ttnpb.CID_DL_CHANNEL:
+			ans = (&ttnpb.MACCommand_DLChannelAns{
+				ChannelIndexAck: true,
+				FrequencyAck:    true,
+			}).MACCommand()
+		case ttnpb.CID_REJOIN_PARAM_SETUP:
+			ans = (&ttnpb.MACCommand_RejoinParamSetupAns{
+				MaxTimeExponentAck: true,
+			}).MACCommand()
+		case ttnpb.CID_REKEY:
+			d.rekeyInd = false
+		}
+		if ans != nil {
+			d.macAnswers = append(d.macAnswers, *ans)
+			answers++
+		}
+	}
+	return answers
+}
+
+// appendFOpts appends the queued MAC commands that fit in the FOpts to b.
+func (d *simulatedDevice) appendFOpts(b []byte) []byte {
+	cmds := d.macAnswers
+	if d.rekeyInd {
+		cmds = append([]ttnpb.MACCommand{*(&ttnpb.MACCommand_RekeyInd{
+			MinorVersion: ttnpb.MINOR_1,
+		}).MACCommand()}, cmds...)
+	}
+	var sent int
+	for _, cmd := range cmds {
+		buf, err := lorawan.DefaultMACCommands.AppendUplink(d.sim.phy, b, cmd)
+		if err != nil || len(buf) > maxFOptsLength {
+			break
+		}
+		b = buf
+		if cmd.CID != ttnpb.CID_REKEY {
+			sent++
+		}
+	}
+	d.macAnswers = d.macAnswers[sent:]
+	return b
+}
+
+func (d *simulatedDevice) sendDataUplink() error {
+	d.mu.Lock()
+	fOpts := d.appendFOpts(nil)
+	fCnt := d.fCntUp
+	confirmed := rand.Float64() < d.sim.confirmedRatio
+	ackFCntDown := d.ackFCntDown
+	d.ackFCntDown = nil
+	drIdx, chIdx := d.sim.uplinkSettings(d.dataRate)
+
+	var err error
+	if len(fOpts) > 0 && d.sim.macVersion.EncryptFOpts() {
+		fOpts, err = crypto.EncryptUplink(d.nwkSEncKey, d.devAddr, fCnt, fOpts)
+		if err != nil {
+			d.mu.Unlock()
+			return err
+		}
+	}
+	frmPayload := make([]byte, d.sim.payloadSize)
+	rand.Read(frmPayload)
+	frmPayload, err = crypto.EncryptUplink(d.appSKey, d.devAddr, fCnt, frmPayload)
+	if err != nil {
+		d.mu.Unlock()
+		return err
+	}
+	mType := ttnpb.MType_UNCONFIRMED_UP
+	if confirmed {
+		mType = ttnpb.MType_CONFIRMED_UP
+	}
+	buf, err := lorawan.MarshalMessage(ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: mType,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_MACPayload{
+			MACPayload: &ttnpb.MACPayload{
+				FHDR: ttnpb.FHDR{
+					DevAddr: d.devAddr,
+					FCtrl: ttnpb.FCtrl{
+						ADR: d.sim.adr,
+						Ack: ackFCntDown != nil,
+					},
+					FCnt:  fCnt,
+					FOpts: fOpts,
+				},
+				FPort:      d.sim.fPort,
+				FRMPayload: frmPayload,
+			},
+		},
+	})
+	if err != nil {
+		d.mu.Unlock()
+		return err
+	}
+	var mic [4]byte
+	if d.lorawan11 {
+		var confFCnt uint32
+		if ackFCntDown != nil {
+			confFCnt = *ackFCntDown
+		}
+		mic, err = crypto.ComputeUplinkMIC(d.sNwkSIntKey, d.fNwkSIntKey, confFCnt, uint8(drIdx), uint8(chIdx), d.devAddr, fCnt, buf)
+	} else {
+		mic, err = crypto.ComputeLegacyUplinkMIC(d.fNwkSIntKey, d.devAddr, fCnt, buf)
+	}
+	if err != nil {
+		d.mu.Unlock()
+		return err
+	}
+	d.fCntUp++
+	d.lastUpFCnt = fCnt
+	d.lastUpConfirmed = confirmed
+	d.awaitingAck = confirmed
+	d.mu.Unlock()
+
+	sentAt, err := d.sim.sendUplink(append(buf, mic[:]...), drIdx, chIdx)
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	d.lastUpSentAt = sentAt
+	d.mu.Unlock()
+	d.sim.stats.update(func(stats *simulateLoadStats) {
+		stats.uplinks++
+		if confirmed {
+			stats.confirmedUplinks++
+		}
+	})
+	return nil
+}
+
+func parseSimulationFlag(flagSet *pflag.FlagSet, name string, v interface{ UnmarshalText([]byte) error }) error {
+	s, _ := flagSet.GetString(name)
+	if err := v.UnmarshalText([]byte(s)); err != nil {
+		return errInvalidSimulationFlag.WithAttributes("flag", name).WithCause(err)
+	}
+	return nil
+}
+
+var simulateLoadCommand = &cobra.Command{
+	Use:   "load",
+	Short: "Simulate end devices for load testing (EXPERIMENTAL)",
+	Long: `Simulate end devices for load testing (EXPERIMENTAL)
+
+The simulated end devices join with OTAA and send uplinks through a virtual
+gateway that is connected to the Gateway Server. The end devices need to be
+registered with consecutive DevEUIs, starting at the given DevEUI, and the same
+root keys. The gateway needs to be registered with a frequency plan of the band.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gtwID, err := getGatewayID(cmd.Flags(), nil, true)
+		if err != nil {
+			return err
+		}
+		flags := cmd.Flags()
+
+		devices, _ := flags.GetUint("devices")
+		if devices == 0 {
+			return errNoSimulationDevices.New()
+		}
+		var (
+			joinEUI, devEUI types.EUI64
+			appKey, nwkKey  types.AES128Key
+			macVersion      ttnpb.MACVersion
+			phyVersion      ttnpb.PHYVersion
+		)
+		for name, v := range map[string]interface{ UnmarshalText([]byte) error }{
+			"join-eui":            &joinEUI,
+			"dev-eui":             &devEUI,
+			"app-key":             &appKey,
+			"lorawan-version":     &macVersion,
+			"lorawan-phy-version": &phyVersion,
+		} {
+			if err := parseSimulationFlag(flags, name, v); err != nil {
+				return err
+			}
+		}
+		nwkKey = appKey
+		if s, _ := flags.GetString("nwk-key"); s != "" {
+			if err := parseSimulationFlag(flags, "nwk-key", &nwkKey); err != nil {
+				return err
+			}
+		}
+		bandID, _ := flags.GetString("band-id")
+		phy, err := band.GetByID(bandID)
+		if err != nil {
+			return err
+		}
+		if phy, err = phy.Version(phyVersion); err != nil {
+			return err
+		}
+		drIdxs, _ := flags.GetUintSlice("data-rate-indices")
+		var dataRates []ttnpb.DataRateIndex
+		for _, i := range drIdxs {
+			dr, ok := phy.DataRates[ttnpb.DataRateIndex(i)]
+			if !ok || dr.Rate.GetLoRa() == nil {
+				return errInvalidDataRateIndex.New()
+			}
+			dataRates = append(dataRates, ttnpb.DataRateIndex(i))
+		}
+		if len(dataRates) == 0 {
+			for i, dr := range phy.DataRates {
+				if dr.Rate.GetLoRa() != nil && i >= phy.UplinkChannels[0].MinDataRate && i <= phy.UplinkChannels[0].MaxDataRate {
+					dataRates = append(dataRates, i)
+				}
+			}
+		}
+
+		duration, _ := flags.GetDuration("duration")
+		rampUp, _ := flags.GetDuration("ramp-up")
+		drainTimeout, _ := flags.GetDuration("drain-timeout")
+		reportInterval, _ := flags.GetDuration("report-interval")
+		devNonce, _ := flags.GetUint16("dev-nonce")
+		randomDevNonce := !flags.Changed("dev-nonce")
+
+		sim := &loadSimulator{
+			gtwID:            *gtwID,
+			phy:              phy,
+			dataRates:        dataRates,
+			macVersion:       macVersion,
+			pendingJoins:     make(map[*simulatedDevice]struct{}),
+			devicesByDevAddr: make(map[types.DevAddr][]*simulatedDevice),
+		}
+		sim.adr, _ = flags.GetBool("adr")
+		sim.joinTimeout, _ = flags.GetDuration("join-timeout")
+		sim.uplinkInterval, _ = flags.GetDuration("uplink-interval")
+		sim.uplinkIntervalJitter, _ = flags.GetFloat64("uplink-interval-jitter")
+		sim.confirmedRatio, _ = flags.GetFloat64("confirmed-ratio")
+		sim.fPort, _ = flags.GetUint32("f-port")
+		payloadSize, _ := flags.GetUint("payload-size")
+		sim.payloadSize = int(payloadSize)
+		sim.rssi, _ = flags.GetFloat32("rssi")
+		sim.snr, _ = flags.GetFloat32("snr")
+
+		linkCtx, cancelLink := context.WithCancel(ctx)
+		defer cancelLink()
+		sim.link, err = linkGateway(linkCtx, cmd, gtwID)
+		if err != nil {
+			return err
+		}
+		linkErr := make(chan error, 1)
+		go func() {
+			linkErr <- sim.handleDownlinks()
+		}()
+
+		logger.WithFields(log.Fields(
+			"devices", devices,
+			"duration", duration,
+		)).Info("Start load test")
+		start := time.Now()
+		loadCtx, cancelLoad := context.WithTimeout(ctx, duration)
+		defer cancelLoad()
+
+		first := binary.BigEndian.Uint64(devEUI[:])
+		wg := &sync.WaitGroup{}
+		for i := uint(0); i < devices; i++ {
+			dev := &simulatedDevice{
+				sim:     sim,
+				joinEUI: joinEUI,
+				appKey:  appKey,
+				nwkKey:  nwkKey,
+			}
+			binary.BigEndian.PutUint64(dev.devEUI[:], first+uint64(i))
+			dn := devNonce
+			if randomDevNonce {
+				dn = uint16(random.Intn(1 << 16))
+			}
+			// The DevNonce is incremented before sending the join-request.
+			binary.BigEndian.PutUint16(dev.devNonce[:], dn-1)
+
+			var delay time.Duration
+			if rampUp > 0 {
+				delay = time.Duration(rand.Int63n(int64(rampUp)))
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				dev.run(loadCtx, delay)
+			}()
+		}
+
+		if reportInterval > 0 {
+			go func() {
+				ticker := time.NewTicker(reportInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-loadCtx.Done():
+						return
+					case <-ticker.C:
+						report := sim.stats.report(time.Since(start), int(devices))
+						logger.WithFields(log.Fields(
+							"joined_devices", report.JoinedDevices,
+							"join_requests", report.JoinRequests,
+							"join_accepts", report.JoinAccepts,
+							"uplinks", report.Uplinks,
+							"downlinks", report.Downlinks,
+							"acknowledgment_loss", report.AcknowledgmentLoss,
+						)).Info("Load test statistics")
+					}
+				}
+			}()
+		}
+
+		wg.Wait()
+		select {
+		case err := <-linkErr:
+			if ctx.Err() == nil {
+				return err
+			}
+		case <-ctx.Done():
+		case <-time.After(drainTimeout):
+		}
+		cancelLink()
+
+		return io.Write(os.Stdout, config.OutputFormat, sim.stats.report(time.Since(start), int(devices)))
+	},
+}
+
+func init() {
+	simulateLoadCommand.Flags().AddFlagSet(gatewayIDFlags())
+	simulateLoadCommand.Flags().AddFlagSet(simulateLoadFlags())
+	simulateCommand.AddCommand(simulateLoadCommand)
+}
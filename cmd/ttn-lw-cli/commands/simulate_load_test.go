@@ -0,0 +1,63 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestFullFCnt(t *testing.T) {
+	for _, tc := range []struct {
+		FCnt     uint32
+		Last     uint32
+		Expected uint32
+	}{
+		{FCnt: 0, Last: 0, Expected: 0},
+		{FCnt: 42, Last: 0, Expected: 42},
+		{FCnt: 42, Last: 42, Expected: 42},
+		{FCnt: 43, Last: 42, Expected: 43},
+		{FCnt: 0x0002, Last: 0xfffe, Expected: 0x10002},
+		{FCnt: 0x0001, Last: 0x1fffe, Expected: 0x20001},
+		{FCnt: 0x1234, Last: 0x11000, Expected: 0x11234},
+		{FCnt: 0x11234, Last: 0x11000, Expected: 0x11234},
+	} {
+		t.Run(fmt.Sprintf("FCnt %#x/Last %#x", tc.FCnt, tc.Last), func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(fullFCnt(tc.FCnt, tc.Last), should.Equal, tc.Expected)
+		})
+	}
+}
+
+func TestNextDevNonce(t *testing.T) {
+	for _, tc := range []struct {
+		DevNonce types.DevNonce
+		Expected types.DevNonce
+	}{
+		{DevNonce: types.DevNonce{0x00, 0x00}, Expected: types.DevNonce{0x00, 0x01}},
+		{DevNonce: types.DevNonce{0x00, 0xff}, Expected: types.DevNonce{0x01, 0x00}},
+		{DevNonce: types.DevNonce{0xff, 0xfe}, Expected: types.DevNonce{0xff, 0xff}},
+		{DevNonce: types.DevNonce{0xff, 0xff}, Expected: types.DevNonce{0x00, 0x00}},
+	} {
+		t.Run(tc.DevNonce.String(), func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(nextDevNonce(tc.DevNonce), should.Equal, tc.Expected)
+		})
+	}
+}
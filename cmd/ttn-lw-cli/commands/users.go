@@ -83,6 +83,7 @@ var (
 				Limit:     limit,
 				Page:      page,
 				Order:     getOrder(cmd.Flags()),
+				Deleted:   getDeleted(cmd.Flags()),
 			}, opt)
 			if err != nil {
 				return err
@@ -343,6 +344,48 @@ var (
 			return nil
 		},
 	}
+	usersRestoreCommand = &cobra.Command{
+		Use:   "restore [user-id]",
+		Short: "Restore a recently deleted user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewUserRegistryClient(is).Restore(ctx, usrID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	usersPurgeCommand = &cobra.Command{
+		Use:   "purge [user-id]",
+		Short: "Purge a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewUserRegistryClient(is).Purge(ctx, usrID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
 	usersContactInfoCommand = contactInfoCommands("user", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
 		usrID := getUserID(cmd.Flags(), args)
 		if usrID == nil {
@@ -358,6 +401,7 @@ func init() {
 	usersListCommand.Flags().AddFlagSet(selectUserFlags)
 	usersListCommand.Flags().AddFlagSet(paginationFlags())
 	usersListCommand.Flags().AddFlagSet(orderFlags())
+	usersListCommand.Flags().AddFlagSet(deletedFlags())
 	usersCommand.AddCommand(usersListCommand)
 	usersSearchCommand.Flags().AddFlagSet(searchFlags())
 	usersSearchCommand.Flags().AddFlagSet(selectUserFlags)
@@ -387,6 +431,10 @@ func init() {
 	usersCommand.AddCommand(usersUpdatePasswordCommand)
 	usersDeleteCommand.Flags().AddFlagSet(userIDFlags())
 	usersCommand.AddCommand(usersDeleteCommand)
+	usersRestoreCommand.Flags().AddFlagSet(userIDFlags())
+	usersCommand.AddCommand(usersRestoreCommand)
+	usersPurgeCommand.Flags().AddFlagSet(userIDFlags())
+	usersCommand.AddCommand(usersPurgeCommand)
 	usersContactInfoCommand.PersistentFlags().AddFlagSet(userIDFlags())
 	usersCommand.AddCommand(usersContactInfoCommand)
 	Root.AddCommand(usersCommand)
@@ -93,6 +93,11 @@ var (
 				return errNoAPIKeyRights
 			}
 
+			expiresAt, allowedIPRanges, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
 				return err
@@ -101,6 +106,8 @@ var (
 				UserIdentifiers: *usrID,
 				Name:            name,
 				Rights:          rights,
+				ExpiresAt:       expiresAt,
+				AllowedIPRanges: allowedIPRanges,
 			})
 			if err != nil {
 				return err
@@ -204,6 +211,7 @@ func init() {
 	userAPIKeys.AddCommand(userAPIKeysList)
 	userAPIKeysCreate.Flags().String("name", "", "")
 	userAPIKeysCreate.Flags().AddFlagSet(userRightsFlags)
+	userAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionsFlags())
 	userAPIKeys.AddCommand(userAPIKeysCreate)
 	userAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	userAPIKeysUpdate.Flags().String("name", "", "")
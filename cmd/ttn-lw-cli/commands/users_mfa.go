@@ -0,0 +1,185 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var errNoMFACredentialID = errors.DefineInvalidArgument("no_mfa_credential_id", "no second factor credential ID set")
+
+func getMFACredentialID(flagSet *pflag.FlagSet, args []string, i int) string {
+	var credentialID string
+	if len(args) > 0+i {
+		if len(args) > 1+i {
+			logger.Warn("Multiple credential IDs found in arguments, considering only the first")
+		}
+		credentialID = args[0+i]
+	} else {
+		credentialID, _ = flagSet.GetString("credential-id")
+	}
+	return credentialID
+}
+
+var (
+	userMFA = &cobra.Command{
+		Use:     "mfa",
+		Aliases: []string{"2fa"},
+		Short:   "Manage second factors of a user",
+	}
+	userMFAList = &cobra.Command{
+		Use:     "list [user-id]",
+		Aliases: []string{"ls"},
+		Short:   "List the second factors of a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewUserRegistryClient(is).ListMFACredentials(ctx, usrID)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Credentials)
+		},
+	}
+	userMFAEnrollTOTP = &cobra.Command{
+		Use:   "enroll-totp [user-id]",
+		Short: "Enroll an authenticator app as second factor of a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID
+			}
+			name, _ := cmd.Flags().GetString("name")
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			client := ttnpb.NewUserRegistryClient(is)
+			enrollment, err := client.BeginMFAEnrollment(ctx, &ttnpb.BeginMFAEnrollmentRequest{
+				UserIdentifiers: *usrID,
+				Type:            ttnpb.MFA_CREDENTIAL_TOTP,
+				Name:            name,
+			})
+			if err != nil {
+				return err
+			}
+
+			logger.Infof("Add the following secret to your authenticator app: %s", enrollment.TOTPSecret)
+			logger.Infof("Or use the following URI: %s", enrollment.TOTPURI)
+			logger.Info("Please enter the code shown by your authenticator app and press enter")
+			fmt.Fprint(os.Stderr, "> ")
+			code, err := bufio.NewReader(os.Stdin).ReadString('\n')
+			if err != nil {
+				return err
+			}
+
+			res, err := client.FinishMFAEnrollment(ctx, &ttnpb.FinishMFAEnrollmentRequest{
+				UserIdentifiers: *usrID,
+				ID:              enrollment.ID,
+				TOTPCode:        strings.TrimSpace(code),
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	userMFADelete = &cobra.Command{
+		Use:     "delete [user-id] [credential-id]",
+		Aliases: []string{"remove"},
+		Short:   "Delete a second factor of a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), firstArgs(1, args...))
+			if usrID == nil {
+				return errNoUserID
+			}
+			id := getMFACredentialID(cmd.Flags(), args, 1)
+			if id == "" {
+				return errNoMFACredentialID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewUserRegistryClient(is).DeleteMFACredential(ctx, &ttnpb.MFACredentialIdentifiers{
+				UserIdentifiers: *usrID,
+				ID:              id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	userMFARecoveryCodes = &cobra.Command{
+		Use:   "recovery-codes [user-id]",
+		Short: "Create new recovery codes for a user",
+		Long: `Create new recovery codes for a user
+
+Recovery codes can be used to log in when other second factors are not
+available. Creating new recovery codes replaces any existing recovery codes.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewUserRegistryClient(is).CreateRecoveryCodes(ctx, usrID)
+			if err != nil {
+				return err
+			}
+
+			logger.Warn("Store these recovery codes in a safe place, they will not be shown again")
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+)
+
+func init() {
+	userMFA.AddCommand(userMFAList)
+	userMFAEnrollTOTP.Flags().String("name", "", "")
+	userMFA.AddCommand(userMFAEnrollTOTP)
+	userMFADelete.Flags().String("credential-id", "", "")
+	userMFA.AddCommand(userMFADelete)
+	userMFA.AddCommand(userMFARecoveryCodes)
+	userMFA.PersistentFlags().AddFlagSet(userIDFlags())
+	usersCommand.AddCommand(userMFA)
+}
@@ -0,0 +1,189 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver"
+	asredis "go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/joinserver"
+	jsredis "go.thethings.network/lorawan-stack/pkg/joinserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/networkserver"
+	nsredis "go.thethings.network/lorawan-stack/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	errSameKEKLabel             = errors.DefineInvalidArgument("same_kek_label", "old and new KEK label are the same")
+	errCursorMultipleComponents = errors.DefineInvalidArgument("cursor_multiple_components", "cursor can only be used with a single component")
+)
+
+var (
+	kekCommand = &cobra.Command{
+		Use:   "kek",
+		Short: "Manage key encryption keys (KEKs)",
+	}
+	kekRotateCommand = &cobra.Command{
+		Use:   "rotate [ns|as|js]...",
+		Short: "Re-wrap the keys that are stored by the Network Server, Application Server and Join Server with a new KEK",
+		Long: `Re-wrap the keys that are stored by the Network Server, Application Server and Join Server with a new KEK.
+
+Keys that are wrapped with the old KEK are unwrapped and wrapped with the new KEK. Keys that are wrapped with another
+KEK are left untouched. If the old KEK label is empty, keys that are stored in the clear are wrapped with the new KEK.
+If the new KEK label is empty, the keys are stored in the clear.
+
+Both the old and the new KEK must be available in the configured key vault. The keys are processed in batches. If the
+rotation is interrupted, it can be resumed from the last reported cursor.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oldKEKLabel, _ := cmd.Flags().GetString("old-kek-label")
+			newKEKLabel, _ := cmd.Flags().GetString("new-kek-label")
+			if oldKEKLabel == newKEKLabel {
+				return errSameKEKLabel.New()
+			}
+			cursor, _ := cmd.Flags().GetString("cursor")
+			batchSize, _ := cmd.Flags().GetUint32("batch-size")
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+			var rotate struct {
+				NetworkServer     bool
+				ApplicationServer bool
+				JoinServer        bool
+			}
+			if len(args) == 0 {
+				args = []string{"ns", "as", "js"}
+			}
+			for _, arg := range args {
+				switch strings.ToLower(arg) {
+				case "ns", "networkserver":
+					rotate.NetworkServer = true
+				case "as", "applicationserver":
+					rotate.ApplicationServer = true
+				case "js", "joinserver":
+					rotate.JoinServer = true
+				default:
+					return errUnknownComponent.WithAttributes("component", arg)
+				}
+			}
+			if cursor != "" && len(args) > 1 {
+				return errCursorMultipleComponents.New()
+			}
+
+			keyVault, err := config.KeyVault.KeyVault()
+			if err != nil {
+				return err
+			}
+			if closer, ok := keyVault.(io.Closer); ok {
+				defer closer.Close()
+			}
+
+			run := func(component string, f func(context.Context, *ttnpb.RotateKEKRequest) (*ttnpb.RotateKEKResponse, error)) error {
+				logger := logger.WithFields(log.Fields(
+					"component", component,
+					"old_kek_label", oldKEKLabel,
+					"new_kek_label", newKEKLabel,
+					"dry_run", dryRun,
+				))
+				logger.Info("Rotating KEK...")
+				req := &ttnpb.RotateKEKRequest{
+					OldKEKLabel: oldKEKLabel,
+					NewKEKLabel: newKEKLabel,
+					Cursor:      cursor,
+					Limit:       batchSize,
+					DryRun:      dryRun,
+				}
+				var processed, rewrapped uint64
+				for {
+					res, err := f(ctx, req)
+					if err != nil {
+						logger.WithField("cursor", req.Cursor).WithError(err).Error("Failed to rotate KEK, resume with the cursor")
+						return err
+					}
+					processed += uint64(res.Processed)
+					rewrapped += uint64(res.Rewrapped)
+					logger.WithFields(log.Fields(
+						"processed", processed,
+						"rewrapped", rewrapped,
+						"cursor", res.Cursor,
+					)).Info("Processed batch")
+					if res.Cursor == "" {
+						break
+					}
+					req.Cursor = res.Cursor
+				}
+				logger.WithFields(log.Fields(
+					"processed", processed,
+					"rewrapped", rewrapped,
+				)).Info("Rotated KEK")
+				return nil
+			}
+
+			if rotate.NetworkServer {
+				devices := &nsredis.DeviceRegistry{
+					Redis: redis.New(config.Redis.WithNamespace("ns", "devices")),
+				}
+				defer devices.Redis.Close()
+				if err := run("ns", func(ctx context.Context, req *ttnpb.RotateKEKRequest) (*ttnpb.RotateKEKResponse, error) {
+					return networkserver.RotateKEK(ctx, devices, keyVault, req)
+				}); err != nil {
+					return err
+				}
+			}
+			if rotate.ApplicationServer {
+				devices := &asredis.DeviceRegistry{
+					Redis: redis.New(config.Redis.WithNamespace("as", "devices")),
+				}
+				defer devices.Redis.Close()
+				if err := run("as", func(ctx context.Context, req *ttnpb.RotateKEKRequest) (*ttnpb.RotateKEKResponse, error) {
+					return applicationserver.RotateKEK(ctx, devices, keyVault, req)
+				}); err != nil {
+					return err
+				}
+			}
+			if rotate.JoinServer {
+				devices := &jsredis.DeviceRegistry{
+					Redis: redis.New(config.Redis.WithNamespace("js", "devices")),
+				}
+				defer devices.Redis.Close()
+				keys := &jsredis.KeyRegistry{
+					Redis: redis.New(config.Redis.WithNamespace("js", "keys")),
+				}
+				defer keys.Redis.Close()
+				if err := run("js", func(ctx context.Context, req *ttnpb.RotateKEKRequest) (*ttnpb.RotateKEKResponse, error) {
+					return joinserver.RotateKEK(ctx, devices, keys, keyVault, req)
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+)
+
+func init() {
+	kekRotateCommand.Flags().String("old-kek-label", "", "Label of the KEK that the keys are currently wrapped with (empty for keys in the clear)")
+	kekRotateCommand.Flags().String("new-kek-label", "", "Label of the KEK to wrap the keys with (empty to store keys in the clear)")
+	kekRotateCommand.Flags().String("cursor", "", "Cursor to resume the rotation from (only with a single component)")
+	kekRotateCommand.Flags().Uint32("batch-size", 100, "Number of devices or session keys to process per batch")
+	kekRotateCommand.Flags().Bool("dry-run", false, "Unwrap and wrap the keys, but do not store them")
+	kekCommand.AddCommand(kekRotateCommand)
+	Root.AddCommand(kekCommand)
+}
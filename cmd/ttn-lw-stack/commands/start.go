@@ -15,6 +15,7 @@
 package commands
 
 import (
+	"context"
 	"net/http"
 	"os"
 	"strconv"
@@ -27,6 +28,8 @@ import (
 	asiopsredis "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/redis"
 	asiowebredis "go.thethings.network/lorawan-stack/pkg/applicationserver/io/web/redis"
 	asredis "go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/cluster"
+	clusterredis "go.thethings.network/lorawan-stack/pkg/cluster/redis"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/console"
 	"go.thethings.network/lorawan-stack/pkg/devicetemplateconverter"
@@ -35,6 +38,7 @@ import (
 	events_grpc "go.thethings.network/lorawan-stack/pkg/events/grpc"
 	"go.thethings.network/lorawan-stack/pkg/gatewayconfigurationserver"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
+	gsudpredis "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp/redis"
 	gsredis "go.thethings.network/lorawan-stack/pkg/gatewayserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/identityserver"
 	"go.thethings.network/lorawan-stack/pkg/joinserver"
@@ -113,6 +117,18 @@ var startCommand = &cobra.Command{
 
 		var componentOptions []component.Option
 
+		switch config.Cluster.Claims {
+		case "redis":
+			claims := &clusterredis.ClaimRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("cluster", "claims")),
+			}
+			componentOptions = append(componentOptions, component.WithClusterNew(
+				func(ctx context.Context, conf *cluster.Config, options ...cluster.Option) (cluster.Cluster, error) {
+					return cluster.New(ctx, conf, append(options, cluster.WithClaimRegistry(claims, cluster.DefaultClaimTTL))...)
+				},
+			))
+		}
+
 		c, err := component.New(logger, &component.Config{ServiceBase: config.ServiceBase}, componentOptions...)
 		if err != nil {
 			return shared.ErrInitializeBaseComponent.WithCause(err)
@@ -149,6 +165,17 @@ var startCommand = &cobra.Command{
 					Redis: redis.New(config.Cache.Redis.WithNamespace("gs", "cache", "connstats")),
 				}
 			}
+			config.GS.TrafficStats.Registry = &gsredis.GatewayTrafficStatsRegistry{
+				Redis:     redis.New(config.Redis.WithNamespace("gs", "traffic-stats")),
+				Retention: config.GS.TrafficStats.Retention,
+			}
+			switch config.GS.UDP.FirewallBackend {
+			case "redis":
+				config.GS.UDP.Firewall = gsudpredis.NewFirewall(
+					redis.New(config.Redis.WithNamespace("gs", "udp", "firewall")),
+					config.GS.UDP.AddrChangeBlock, config.GS.UDP.RateLimiting,
+				)
+			}
 			gs, err := gatewayserver.New(c, &config.GS)
 			if err != nil {
 				return shared.ErrInitializeGatewayServer.WithCause(err)
@@ -168,7 +195,8 @@ var startCommand = &cobra.Command{
 				Redis: redis.New(config.Redis.WithNamespace("ns", "devices")),
 			}
 			config.NS.UplinkDeduplicator = &nsredis.UplinkDeduplicator{
-				Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "uplink-deduplication")),
+				Redis:    redis.New(config.Cache.Redis.WithNamespace("ns", "uplink-deduplication")),
+				Instance: redisConsumerID,
 			}
 			nsDownlinkTasks := nsredis.NewDownlinkTaskQueue(
 				redis.New(config.Redis.WithNamespace("ns", "tasks")),
@@ -219,6 +247,9 @@ var startCommand = &cobra.Command{
 			config.JS.Keys = &jsredis.KeyRegistry{
 				Redis: redis.New(config.Redis.WithNamespace("js", "keys")),
 			}
+			config.JS.ExternalActivations = &jsredis.ExternalActivationRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("js", "external-activations")),
+			}
 			js, err := joinserver.New(c, &config.JS)
 			if err != nil {
 				return shared.ErrInitializeJoinServer.WithCause(err)
@@ -36,6 +36,7 @@ import (
 	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/nats" // The NATS integration provider
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
@@ -48,6 +49,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors/cayennelpp"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 	"google.golang.org/grpc"
@@ -219,6 +221,8 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		c.RegisterGRPC(as.appPackages)
 	}
 
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.As", cluster.HookName, c.ClusterAuthUnaryHook())
+
 	c.RegisterGRPC(as)
 	if as.linkMode == LinkAll {
 		c.RegisterTask(as.Context(), "link_all", as.linkAll, component.TaskRestartOnFailure)
@@ -743,7 +747,10 @@ func (as *ApplicationServer) decryptDownlinkMessage(ctx context.Context, ids ttn
 	return nil
 }
 
-var errPayloadCryptoDisabled = errors.DefineAborted("payload_crypto_disabled", "payload crypto is disabled")
+var (
+	errPayloadCryptoDisabled = errors.DefineAborted("payload_crypto_disabled", "payload crypto is disabled")
+	errExpiredDownlink       = errors.DefineFailedPrecondition("downlink_expired", "downlink expired at `{expires_at}`")
+)
 
 // recalculateDownlinkQueue decrypts items in the given invalid downlink queue, encrypts the items with frame counters
 // starting from the given frame counter, and replaces the downlink queue in the Network Server.
@@ -797,6 +804,7 @@ func (as *ApplicationServer) recalculateDownlinkQueue(ctx context.Context, dev *
 		return err
 	}
 	valid := make([]*ttnpb.ApplicationDownlink, 0, len(invalid))
+	now := time.Now()
 	for _, oldItem := range invalid {
 		logger := logger.WithFields(log.Fields(
 			"f_port", oldItem.FPort,
@@ -828,6 +836,27 @@ func (as *ApplicationServer) recalculateDownlinkQueue(ctx context.Context, dev *
 			registerDropDownlink(ctx, dev.EndDeviceIdentifiers, oldItem, err)
 			continue
 		}
+		if oldItem.ExpiresAt != nil && oldItem.ExpiresAt.Before(now) {
+			logger.Debug("Drop downlink message; downlink message expired")
+			err := errExpiredDownlink.WithAttributes("expires_at", *oldItem.ExpiresAt)
+			failedItem := *oldItem
+			failedItem.FRMPayload = frmPayload
+			link.upCh <- &io.ContextualApplicationUp{
+				Context: ctx,
+				ApplicationUp: &ttnpb.ApplicationUp{
+					EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+					CorrelationIDs:       oldItem.CorrelationIDs,
+					Up: &ttnpb.ApplicationUp_DownlinkFailed{
+						DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+							ApplicationDownlink: failedItem,
+							Error:               *ttnpb.ErrorDetailsToProto(err),
+						},
+					},
+				},
+			}
+			registerDropDownlink(ctx, dev.EndDeviceIdentifiers, oldItem, err)
+			continue
+		}
 		newItem := &ttnpb.ApplicationDownlink{
 			SessionKeyID:   newSession.SessionKeyID,
 			FPort:          oldItem.FPort,
@@ -836,6 +865,8 @@ func (as *ApplicationServer) recalculateDownlinkQueue(ctx context.Context, dev *
 			ClassBC:        oldItem.ClassBC,
 			Priority:       oldItem.Priority,
 			CorrelationIDs: oldItem.CorrelationIDs,
+			ExpiresAt:      oldItem.ExpiresAt,
+			NotBefore:      oldItem.NotBefore,
 		}
 		newItem.FRMPayload, err = crypto.EncryptDownlink(newAppSKey, newSession.DevAddr, newItem.FCnt, frmPayload)
 		if err != nil {
@@ -1555,6 +1555,48 @@ hardware_versions:
 							})
 						},
 					},
+					{
+						Name: "RegisteredDevice/DownlinkQueueInvalidated/ExpiredDownlink",
+						IDs:  registeredDevice.EndDeviceIdentifiers,
+						Message: &ttnpb.ApplicationUp{
+							EndDeviceIdentifiers: withDevAddr(registeredDevice.EndDeviceIdentifiers, types.DevAddr{0x44, 0x44, 0x44, 0x44}),
+							Up: &ttnpb.ApplicationUp_DownlinkQueueInvalidated{
+								DownlinkQueueInvalidated: &ttnpb.ApplicationInvalidatedDownlinks{
+									Downlinks: []*ttnpb.ApplicationDownlink{
+										{
+											SessionKeyID: []byte{0x44},
+											FPort:        11,
+											FCnt:         11,
+											FRMPayload:   []byte{0x65, 0x98, 0xa7, 0xfc},
+											ExpiresAt:    timePtr(time.Unix(42, 0).UTC()),
+										},
+										{
+											SessionKeyID: []byte{0x44},
+											FPort:        22,
+											FCnt:         22,
+											FRMPayload:   []byte{0x1b, 0x4b, 0x97, 0xb9},
+										},
+									},
+									LastFCntDown: 86,
+								},
+							},
+						},
+						AssertUp: func(t *testing.T, up *ttnpb.ApplicationUp) {
+							a := assertions.New(t)
+							if !a.So(up.Up, should.HaveSameTypeAs, &ttnpb.ApplicationUp_DownlinkFailed{}) {
+								t.FailNow()
+							}
+							failed := up.GetDownlinkFailed()
+							a.So(failed.ApplicationDownlink, should.Resemble, ttnpb.ApplicationDownlink{
+								SessionKeyID: []byte{0x44},
+								FPort:        11,
+								FCnt:         11,
+								FRMPayload:   []byte{0x1, 0x1, 0x1, 0x1},
+								ExpiresAt:    timePtr(time.Unix(42, 0).UTC()),
+							})
+							a.So(failed.Error.Name, should.Equal, "downlink_expired")
+						},
+					},
 					{
 						Name: "RegisteredDevice/UplinkMessage/KnownSession",
 						IDs:  registeredDevice.EndDeviceIdentifiers,
@@ -123,6 +123,8 @@ func CleanDownlinks(items []*ttnpb.ApplicationDownlink) []*ttnpb.ApplicationDown
 			Priority:       item.Priority,
 			Confirmed:      item.Confirmed,
 			CorrelationIDs: item.CorrelationIDs,
+			ExpiresAt:      item.ExpiresAt,
+			NotBefore:      item.NotBefore,
 		})
 	}
 	return res
@@ -0,0 +1,117 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// DeviceIDScanner is a DeviceRegistry that can scan the identifiers of the stored devices.
+type DeviceIDScanner interface {
+	// ScanIDs scans the identifiers of the stored devices, starting at cursor.
+	// It returns the cursor to continue scanning from, which is empty if all devices have been scanned.
+	ScanIDs(ctx context.Context, cursor string, count int64) ([]ttnpb.EndDeviceIdentifiers, string, error)
+}
+
+var errScanNotSupported = errors.DefineUnimplemented("scan_not_supported", "device registry does not support scanning")
+
+// defaultKEKRotationLimit is the number of devices processed per RotateKEK call if no limit is given.
+const defaultKEKRotationLimit = 100
+
+var kekRotationPaths = [...]string{
+	"pending_session.keys",
+	"session.keys",
+}
+
+// RotateKEK re-wraps the session keys of the devices in the given registry that are wrapped with the old KEK label with
+// the new KEK label. It processes a batch of devices starting at the cursor of the request.
+// The returned cursor is empty if all devices have been processed.
+func RotateKEK(ctx context.Context, devices DeviceRegistry, keyVault crypto.KeyVault, req *ttnpb.RotateKEKRequest) (*ttnpb.RotateKEKResponse, error) {
+	scanner, ok := devices.(DeviceIDScanner)
+	if !ok {
+		return nil, errScanNotSupported.New()
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultKEKRotationLimit
+	}
+	ids, cursor, err := scanner.ScanIDs(ctx, req.Cursor, int64(limit))
+	if err != nil {
+		return nil, err
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"old_kek_label", req.OldKEKLabel,
+		"new_kek_label", req.NewKEKLabel,
+		"dry_run", req.DryRun,
+	))
+	res := &ttnpb.RotateKEKResponse{
+		Cursor: cursor,
+	}
+	for _, ids := range ids {
+		var rewrapped int
+		_, err := devices.Set(ctx, ids, kekRotationPaths[:], func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, nil
+			}
+			var sets []string
+			if dev.PendingSession != nil {
+				paths, err := cryptoutil.RewrapSessionKeys(ctx, &dev.PendingSession.SessionKeys, "pending_session.keys", req.OldKEKLabel, req.NewKEKLabel, keyVault)
+				if err != nil {
+					return nil, nil, err
+				}
+				sets = append(sets, paths...)
+			}
+			if dev.Session != nil {
+				paths, err := cryptoutil.RewrapSessionKeys(ctx, &dev.Session.SessionKeys, "session.keys", req.OldKEKLabel, req.NewKEKLabel, keyVault)
+				if err != nil {
+					return nil, nil, err
+				}
+				sets = append(sets, paths...)
+			}
+			rewrapped = len(sets)
+			if req.DryRun {
+				return dev, nil, nil
+			}
+			return dev, sets, nil
+		})
+		if err != nil {
+			logger.WithField("device_uid", unique.ID(ctx, ids)).WithError(err).Warn("Failed to rotate KEK of device")
+			return nil, err
+		}
+		res.Processed++
+		res.Rewrapped += uint32(rewrapped)
+	}
+	logger.WithFields(log.Fields(
+		"processed", res.Processed,
+		"rewrapped", res.Rewrapped,
+	)).Debug("Rotated KEK of devices")
+	return res, nil
+}
+
+// RotateKEK implements ttnpb.AsServer.
+func (as *ApplicationServer) RotateKEK(ctx context.Context, req *ttnpb.RotateKEKRequest) (*ttnpb.RotateKEKResponse, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	return RotateKEK(ctx, as.deviceRegistry, as.KeyVault, req)
+}
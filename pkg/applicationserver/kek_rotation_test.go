@@ -0,0 +1,157 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestRotateKEK(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	keys := map[string][]byte{
+		"old":   {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+		"new":   {0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x00},
+		"other": {0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f},
+	}
+	keyVault := cryptoutil.NewMemKeyVault(keys)
+
+	redisClient, flush := test.NewRedis(t, "applicationserver_test")
+	defer flush()
+	defer redisClient.Close()
+	deviceRegistry := &redis.DeviceRegistry{Redis: redisClient}
+
+	dev1 := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+		DeviceID:               "test-dev-1",
+	}
+	dev2 := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+		DeviceID:               "test-dev-2",
+	}
+	appSKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	for _, dev := range []struct {
+		ids      ttnpb.EndDeviceIdentifiers
+		kekLabel string
+	}{
+		{ids: dev1, kekLabel: "old"},
+		{ids: dev2, kekLabel: "other"},
+	} {
+		dev := dev
+		env, err := cryptoutil.WrapAES128Key(ctx, appSKey, dev.kekLabel, keyVault)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		_, err = deviceRegistry.Set(ctx, dev.ids, nil, func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			return &ttnpb.EndDevice{
+				EndDeviceIdentifiers: dev.ids,
+				Session: &ttnpb.Session{
+					DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+					SessionKeys: ttnpb.SessionKeys{
+						AppSKey: &env,
+					},
+				},
+			}, []string{
+				"ids.application_ids",
+				"ids.device_id",
+				"session.dev_addr",
+				"session.keys.app_s_key",
+			}, nil
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			KeyVault: config.KeyVault{
+				Provider: "static",
+				Static:   keys,
+			},
+		},
+	})
+	as, err := applicationserver.New(c, &applicationserver.Config{
+		LinkMode: "explicit",
+		Devices:  deviceRegistry,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	client := ttnpb.NewAsClient(c.LoopbackConn())
+
+	assertKEKLabel := func(ids ttnpb.EndDeviceIdentifiers, kekLabel string) {
+		dev, err := deviceRegistry.Get(ctx, ids, []string{"session.keys"})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(dev.Session.AppSKey.KEKLabel, should.Equal, kekLabel)
+		key, err := cryptoutil.UnwrapAES128Key(ctx, *dev.Session.AppSKey, keyVault)
+		a.So(err, should.BeNil)
+		a.So(key, should.Equal, appSKey)
+	}
+
+	_, err = client.RotateKEK(ctx, &ttnpb.RotateKEKRequest{
+		OldKEKLabel: "old",
+		NewKEKLabel: "new",
+	})
+	a.So(err, should.NotBeNil)
+
+	for _, dryRun := range []bool{true, false} {
+		req := &ttnpb.RotateKEKRequest{
+			OldKEKLabel: "old",
+			NewKEKLabel: "new",
+			Limit:       1,
+			DryRun:      dryRun,
+		}
+		var processed, rewrapped uint32
+		for {
+			res, err := client.RotateKEK(ctx, req, as.WithClusterAuth())
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			processed += res.Processed
+			rewrapped += res.Rewrapped
+			if res.Cursor == "" {
+				break
+			}
+			req.Cursor = res.Cursor
+		}
+		a.So(processed, should.Equal, 2)
+		a.So(rewrapped, should.Equal, 1)
+		if dryRun {
+			assertKEKLabel(dev1, "old")
+		} else {
+			assertKEKLabel(dev1, "new")
+		}
+		assertKEKLabel(dev2, "other")
+	}
+}
@@ -32,6 +32,7 @@ var (
 	errInvalidIdentifiers   = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
 	errDuplicateIdentifiers = errors.DefineAlreadyExists("duplicate_identifiers", "duplicate identifiers")
 	errReadOnlyField        = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errDeviceUID            = errors.DefineCorruption("device_uid", "invalid device UID `{device_uid}`")
 )
 
 // DeviceRegistry is a Redis device registry.
@@ -62,6 +63,27 @@ func (r *DeviceRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers
 	return ttnpb.FilterGetEndDevice(pb, paths...)
 }
 
+// ScanIDs scans the identifiers of the stored devices, starting at cursor.
+// It returns the cursor to continue scanning from, which is empty if all devices have been scanned.
+// Count is a hint for the number of devices to scan; the number of returned identifiers may differ.
+func (r *DeviceRegistry) ScanIDs(ctx context.Context, cursor string, count int64) ([]ttnpb.EndDeviceIdentifiers, string, error) {
+	defer trace.StartRegion(ctx, "scan end device identifiers").End()
+
+	uids, cursor, err := ttnredis.ScanKeys(r.Redis, r.uidKey(""), cursor, count)
+	if err != nil {
+		return nil, "", err
+	}
+	ids := make([]ttnpb.EndDeviceIdentifiers, 0, len(uids))
+	for _, uid := range uids {
+		devIDs, err := unique.ToDeviceID(uid)
+		if err != nil {
+			return nil, "", errDeviceUID.WithCause(err).WithAttributes("device_uid", uid)
+		}
+		ids = append(ids, devIDs)
+	}
+	return ids, cursor, nil
+}
+
 func equalEUI64(x, y *types.EUI64) bool {
 	if x == nil || y == nil {
 		return x == y
@@ -55,6 +55,9 @@ func withDevAddr(ids ttnpb.EndDeviceIdentifiers, devAddr types.DevAddr) ttnpb.En
 func aes128KeyPtr(key types.AES128Key) *types.AES128Key {
 	return &key
 }
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
 
 type mockNS struct {
 	linkCh          chan ttnpb.ApplicationIdentifiers
@@ -17,16 +17,23 @@ package cluster
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"crypto/subtle"
 	"encoding/hex"
+	"net"
+	"strings"
 
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 type (
 	clusterAuthKeyType        struct{}
 	clusterAuthKeyFailureType struct{}
+	forwardedForKeyType       struct{}
 )
 
 var (
@@ -35,6 +42,7 @@ var (
 
 	clusterAuthKey        = clusterAuthKeyType{}
 	clusterAuthFailureKey = clusterAuthKeyFailureType{}
+	forwardedForKey       = forwardedForKeyType{}
 
 	errNoClusterKey        = errors.DefineUnauthenticated("no_cluster_key", "no cluster key auth specified")
 	errUnsupportedAuthType = errors.DefineInvalidArgument("auth_type", "cluster auth type `{auth_type}` is not supported")
@@ -50,21 +58,37 @@ func NewContext(ctx context.Context, err error) context.Context {
 
 // VerifySource inspects whether the context contains one of the passed cluster keys,
 // and returns a context containing the result.
+// If the context contains forwarded-for metadata that is signed with one of the
+// passed cluster keys, the forwarded addresses are also stored in the context.
 func VerifySource(ctx context.Context, validKeys [][]byte) context.Context {
 	err := verifySource(ctx, validKeys)
-	return NewContext(ctx, err)
+	ctx = NewContext(ctx, err)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		forwardedFor, forwardedBy := md.Get(forwardedForMD), md.Get(forwardedByMD)
+		if len(forwardedFor) == 1 && len(forwardedBy) == 1 && verifyForwardedFor(forwardedFor[0], forwardedBy[0], validKeys) {
+			ctx = NewContextWithForwardedFor(ctx, splitAddresses(forwardedFor))
+		}
+	}
+	return ctx
 }
 
 func verifySource(ctx context.Context, validKeys [][]byte) error {
 	md := rpcmetadata.FromIncomingContext(ctx)
-	switch md.AuthType {
-	case AuthType:
-	case "":
+	if md.AuthType == "" {
 		return errNoClusterKey.New()
-	default:
-		return errUnsupportedAuthType.WithAttributes("auth_type", md.AuthType)
 	}
-	key, err := hex.DecodeString(md.AuthValue)
+	return verifyKey(md.AuthType+" "+md.AuthValue, validKeys)
+}
+
+func verifyKey(authorization string, validKeys [][]byte) error {
+	parts := strings.SplitN(authorization, " ", 2)
+	if len(parts) != 2 {
+		return errNoClusterKey.New()
+	}
+	if parts[0] != AuthType {
+		return errUnsupportedAuthType.WithAttributes("auth_type", parts[0])
+	}
+	key, err := hex.DecodeString(parts[1])
 	if err != nil {
 		return errInvalidClusterKey.WithCause(err)
 	}
@@ -88,3 +112,87 @@ func Authorized(ctx context.Context) error {
 	}
 	return ctx.Value(clusterAuthFailureKey).(error)
 }
+
+const (
+	forwardedForMD = "forwarded-for"
+	forwardedByMD  = "forwarded-by"
+)
+
+// signForwardedFor returns the signature of the forwarded-for metadata value with the given cluster key.
+func signForwardedFor(value string, key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
+
+func verifyForwardedFor(value, signature string, validKeys [][]byte) bool {
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	for _, key := range validKeys {
+		if hmac.Equal(signForwardedFor(value, key), sig) {
+			return true
+		}
+	}
+	return false
+}
+
+func splitAddresses(values []string) (addrs []string) {
+	for _, value := range values {
+		for _, addr := range strings.Split(value, ",") {
+			if addr = strings.TrimSpace(addr); addr != "" {
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+	return addrs
+}
+
+// NewContextWithForwardedFor returns a context containing the addresses of the original caller
+// of a call that was forwarded by a component from the cluster.
+func NewContextWithForwardedFor(ctx context.Context, addrs []string) context.Context {
+	return context.WithValue(ctx, forwardedForKey, addrs)
+}
+
+// ForwardedFor returns the addresses of the original caller of a call that was forwarded by a
+// component from the cluster, from the address of the client to the address of the last proxy.
+// It returns false if the call was not forwarded by a component from the cluster.
+func ForwardedFor(ctx context.Context) ([]string, bool) {
+	addrs, ok := ctx.Value(forwardedForKey).([]string)
+	return addrs, ok
+}
+
+// CallerAddresses returns the addresses of the caller of the incoming context ctx, from the
+// address of the client to the address of the peer. The addresses from the X-Forwarded-For
+// metadata are not verified, so they should only be trusted if the addresses after them are.
+func CallerAddresses(ctx context.Context) []string {
+	if addrs, ok := ForwardedFor(ctx); ok {
+		return addrs
+	}
+	var addrs []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		addrs = splitAddresses(md.Get("x-forwarded-for"))
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if addr, ok := p.Addr.(*net.TCPAddr); ok {
+			addrs = append(addrs, addr.IP.String())
+		}
+	}
+	return addrs
+}
+
+// NewOutgoingContextWithForwardedFor returns an outgoing context that forwards the addresses of
+// the caller of the incoming context ctx, signed with the given cluster key.
+// The key itself is not sent, so that it does not leak on connections without transport security.
+func NewOutgoingContextWithForwardedFor(ctx context.Context, key []byte) context.Context {
+	addrs := CallerAddresses(ctx)
+	if len(addrs) == 0 {
+		return ctx
+	}
+	value := strings.Join(addrs, ", ")
+	return metadata.AppendToOutgoingContext(ctx,
+		forwardedForMD, value,
+		forwardedByMD, hex.EncodeToString(signForwardedFor(value, key)),
+	)
+}
@@ -16,14 +16,17 @@ package cluster_test
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"net"
 	"testing"
 
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 func TestAuthorized(t *testing.T) {
@@ -74,6 +77,59 @@ func TestVerify(t *testing.T) {
 	}
 }
 
+func TestForwardedFor(t *testing.T) {
+	a := assertions.New(t)
+
+	keys := [][]byte{
+		{0x00, 0xaa, 0x12},
+	}
+
+	_, ok := cluster.ForwardedFor(context.Background())
+	a.So(ok, should.BeFalse)
+
+	incomingCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "192.0.2.1, 127.0.0.1"))
+	incomingCtx = peer.NewContext(incomingCtx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.1"), Port: 1234}})
+	a.So(cluster.CallerAddresses(incomingCtx), should.Resemble, []string{"192.0.2.1", "127.0.0.1", "198.51.100.1"})
+
+	for _, tc := range []struct {
+		key     []byte
+		success bool
+	}{
+		{
+			key:     keys[0],
+			success: true,
+		},
+		{
+			key:     []byte{0x00, 0x00},
+			success: false,
+		},
+	} {
+		outgoingCtx := cluster.NewOutgoingContextWithForwardedFor(incomingCtx, tc.key)
+		md, _ := metadata.FromOutgoingContext(outgoingCtx)
+		for _, values := range md {
+			for _, value := range values {
+				a.So(value, should.NotContainSubstring, hex.EncodeToString(tc.key))
+			}
+		}
+		ctx := cluster.VerifySource(metadata.NewIncomingContext(context.Background(), md), keys)
+		addrs, ok := cluster.ForwardedFor(ctx)
+		if tc.success {
+			a.So(ok, should.BeTrue)
+			a.So(addrs, should.Resemble, []string{"192.0.2.1", "127.0.0.1", "198.51.100.1"})
+			a.So(cluster.CallerAddresses(ctx), should.Resemble, addrs)
+		} else {
+			a.So(ok, should.BeFalse)
+		}
+		a.So(cluster.Authorized(ctx), should.NotBeNil)
+	}
+
+	outgoingCtx := cluster.NewOutgoingContextWithForwardedFor(incomingCtx, keys[0])
+	md, _ := metadata.FromOutgoingContext(outgoingCtx)
+	md.Set("forwarded-for", "203.0.113.1")
+	_, ok = cluster.ForwardedFor(cluster.VerifySource(metadata.NewIncomingContext(context.Background(), md), keys))
+	a.So(ok, should.BeFalse)
+}
+
 func ExampleAuthorized() {
 	var ( // Assume this comes from a hypothetical inter-cluster RPC call.
 		ctx context.Context
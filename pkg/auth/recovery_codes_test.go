@@ -0,0 +1,44 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	. "go.thethings.network/lorawan-stack/pkg/auth"
+)
+
+func TestRecoveryCodes(t *testing.T) {
+	a := assertions.New(t)
+
+	codes, err := GenerateRecoveryCodes()
+	a.So(err, should.BeNil)
+	a.So(codes, should.HaveLength, 10)
+
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		a.So(code, should.HaveLength, 11)
+		a.So(code[5], should.Equal, byte('-'))
+		a.So(seen[code], should.BeFalse)
+		seen[code] = true
+	}
+
+	hash := HashRecoveryCode(codes[0])
+	a.So(hash, should.HaveLength, 32)
+	a.So(HashRecoveryCode(" "+codes[0][:5]+codes[0][6:]+" "), should.Resemble, hash)
+	a.So(HashRecoveryCode(codes[1]), should.NotResemble, hash)
+}
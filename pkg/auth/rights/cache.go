@@ -16,9 +16,11 @@ package rights
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -29,13 +31,20 @@ var now = time.Now // override this in unit tests
 
 func newReq(ctx context.Context, id ttnpb.Identifiers) cachedReq {
 	md := rpcmetadata.FromIncomingContext(ctx)
-	return cachedReq{UniqueID: unique.ID(ctx, id), AuthType: md.AuthType, AuthValue: md.AuthValue}
+	return cachedReq{
+		UniqueID:  unique.ID(ctx, id),
+		AuthType:  md.AuthType,
+		AuthValue: md.AuthValue,
+		// NOTE: The rights of API keys may depend on the address of the caller.
+		CallerAddresses: strings.Join(clusterauth.CallerAddresses(ctx), ", "),
+	}
 }
 
 type cachedReq struct {
-	UniqueID  string
-	AuthType  string
-	AuthValue string
+	UniqueID        string
+	AuthType        string
+	AuthValue       string
+	CallerAddresses string
 }
 
 func newRes() *cachedRes {
@@ -16,6 +16,7 @@ package rights
 
 import (
 	"context"
+	"net"
 	"testing"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/smartystreets/assertions/should"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc/peer"
 )
 
 var currentTime time.Time
@@ -111,4 +113,15 @@ func TestCache(t *testing.T) {
 	a.So(c.applicationRights, should.BeEmpty)
 	a.So(c.gatewayRights, should.BeEmpty)
 	a.So(c.organizationRights, should.BeEmpty)
+
+	// Responses should not be shared between callers with different addresses.
+	ctxC := peer.NewContext(test.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 1234}})
+	res = fetchRights(ctxC, "foo", c)
+
+	a.So(mockFetcher.applicationCtx, should.Equal, ctxC)
+
+	ctxD := peer.NewContext(test.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.1"), Port: 1234}})
+	res = fetchRights(ctxD, "foo", c)
+
+	a.So(mockFetcher.applicationCtx, should.Equal, ctxD)
 }
@@ -97,15 +97,35 @@ func fetcherFromContext(ctx context.Context) (Fetcher, bool) {
 
 var errNoFetcher = errors.DefineInternal("no_fetcher", "no fetcher found in context")
 
+// AccessFetcherOption is an option for the access fetcher.
+type AccessFetcherOption func(*accessFetcher)
+
+// WithForwardedFor returns an AccessFetcherOption that prepares the context of the calls to the Access role
+// with the given function, so that the address of the caller can be forwarded along with its credentials.
+func WithForwardedFor(forwardedFor func(ctx context.Context) context.Context) AccessFetcherOption {
+	return func(f *accessFetcher) {
+		f.forwardedFor = forwardedFor
+	}
+}
+
 // NewAccessFetcher returns a new rights fetcher that fetches from the Access role returned by getConn.
 // The allowInsecure argument indicates whether it's allowed to send credentials over connections without TLS.
-func NewAccessFetcher(getConn func(ctx context.Context) *grpc.ClientConn, allowInsecure bool) Fetcher {
-	return &accessFetcher{getConn: getConn, allowInsecure: allowInsecure}
+func NewAccessFetcher(getConn func(ctx context.Context) *grpc.ClientConn, allowInsecure bool, opts ...AccessFetcherOption) Fetcher {
+	f := &accessFetcher{
+		getConn:       getConn,
+		allowInsecure: allowInsecure,
+		forwardedFor:  func(ctx context.Context) context.Context { return ctx },
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
 }
 
 type accessFetcher struct {
 	getConn       func(ctx context.Context) *grpc.ClientConn
 	allowInsecure bool
+	forwardedFor  func(ctx context.Context) context.Context
 }
 
 var errNoISConn = errors.DefineUnavailable("no_identity_server_conn", "no connection to Identity Server")
@@ -119,7 +139,7 @@ func (f accessFetcher) ApplicationRights(ctx context.Context, appID ttnpb.Applic
 	if err != nil {
 		return nil, err
 	}
-	rights, err := ttnpb.NewApplicationAccessClient(cc).ListRights(ctx, &appID, callOpt)
+	rights, err := ttnpb.NewApplicationAccessClient(cc).ListRights(f.forwardedFor(ctx), &appID, callOpt)
 	registerRightsFetch(ctx, "application", rights, err)
 	if err != nil {
 		return nil, err
@@ -136,7 +156,7 @@ func (f accessFetcher) ClientRights(ctx context.Context, clientID ttnpb.ClientId
 	if err != nil {
 		return nil, err
 	}
-	rights, err := ttnpb.NewClientAccessClient(cc).ListRights(ctx, &clientID, callOpt)
+	rights, err := ttnpb.NewClientAccessClient(cc).ListRights(f.forwardedFor(ctx), &clientID, callOpt)
 	registerRightsFetch(ctx, "client", rights, err)
 	if err != nil {
 		return nil, err
@@ -153,7 +173,7 @@ func (f accessFetcher) GatewayRights(ctx context.Context, gtwID ttnpb.GatewayIde
 	if err != nil {
 		return nil, err
 	}
-	rights, err := ttnpb.NewGatewayAccessClient(cc).ListRights(ctx, &gtwID, callOpt)
+	rights, err := ttnpb.NewGatewayAccessClient(cc).ListRights(f.forwardedFor(ctx), &gtwID, callOpt)
 	registerRightsFetch(ctx, "gateway", rights, err)
 	if err != nil {
 		return nil, err
@@ -170,7 +190,7 @@ func (f accessFetcher) OrganizationRights(ctx context.Context, orgID ttnpb.Organ
 	if err != nil {
 		return nil, err
 	}
-	rights, err := ttnpb.NewOrganizationAccessClient(cc).ListRights(ctx, &orgID, callOpt)
+	rights, err := ttnpb.NewOrganizationAccessClient(cc).ListRights(f.forwardedFor(ctx), &orgID, callOpt)
 	registerRightsFetch(ctx, "organization", rights, err)
 	if err != nil {
 		return nil, err
@@ -187,7 +207,7 @@ func (f accessFetcher) UserRights(ctx context.Context, userID ttnpb.UserIdentifi
 	if err != nil {
 		return nil, err
 	}
-	rights, err := ttnpb.NewUserAccessClient(cc).ListRights(ctx, &userID, callOpt)
+	rights, err := ttnpb.NewUserAccessClient(cc).ListRights(f.forwardedFor(ctx), &userID, callOpt)
 	registerRightsFetch(ctx, "user", rights, err)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,114 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package totp implements time-based one-time passwords as specified in RFC 6238.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Period is the time step of the one-time passwords.
+	Period = 30 * time.Second
+	// Digits is the number of digits of the one-time passwords.
+	Digits = 6
+	// Skew is the number of time steps before and after the current time step that are accepted.
+	Skew = 1
+
+	secretLength = 20
+	modulo       = 1000000
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret generates a new random secret.
+func GenerateSecret() ([]byte, error) {
+	secret := make([]byte, secretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// EncodeSecret encodes the secret to the base32 representation that is used by authenticator apps.
+func EncodeSecret(secret []byte) string {
+	return encoding.EncodeToString(secret)
+}
+
+// Generate generates the one-time password for the given secret at the given time.
+func Generate(secret []byte, t time.Time) string {
+	return generate(secret, uint64(t.Unix())/uint64(Period/time.Second))
+}
+
+func generate(secret []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0xf
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, code%modulo)
+}
+
+// Validate returns whether the code is a valid one-time password for the given secret at the given time.
+func Validate(secret []byte, code string, t time.Time) bool {
+	_, ok := Match(secret, code, t)
+	return ok
+}
+
+// Match returns the time step of the one-time password for the given secret at the given time, and whether the code
+// is valid. Callers that store the time step of the last accepted code can reject codes that are used again.
+func Match(secret []byte, code string, t time.Time) (uint64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != Digits {
+		return 0, false
+	}
+	counter := uint64(t.Unix()) / uint64(Period/time.Second)
+	for i := -Skew; i <= Skew; i++ {
+		expected := generate(secret, counter+uint64(i))
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return counter + uint64(i), true
+		}
+	}
+	return 0, false
+}
+
+// URI returns the otpauth:// URI that authenticator apps use to enroll the secret.
+func URI(issuer, account string, secret []byte) string {
+	label := url.PathEscape(account)
+	if issuer != "" {
+		label = url.PathEscape(issuer) + ":" + label
+	}
+	query := url.Values{
+		"secret":    []string{EncodeSecret(secret)},
+		"algorithm": []string{"SHA1"},
+		"digits":    []string{fmt.Sprint(Digits)},
+		"period":    []string{fmt.Sprint(int(Period / time.Second))},
+	}
+	if issuer != "" {
+		query.Set("issuer", issuer)
+	}
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
@@ -0,0 +1,68 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	. "go.thethings.network/lorawan-stack/pkg/auth/totp"
+)
+
+func TestTOTP(t *testing.T) {
+	a := assertions.New(t)
+
+	// Test vectors from RFC 6238 Appendix B, truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	for _, tc := range []struct {
+		Time int64
+		Code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	} {
+		now := time.Unix(tc.Time, 0)
+		a.So(Generate(secret, now), should.Equal, tc.Code)
+		a.So(Validate(secret, tc.Code, now), should.BeTrue)
+		a.So(Validate(secret, tc.Code, now.Add(Period)), should.BeTrue)
+		a.So(Validate(secret, tc.Code, now.Add(-Period)), should.BeTrue)
+		a.So(Validate(secret, tc.Code, now.Add(3*Period)), should.BeFalse)
+
+		step, ok := Match(secret, tc.Code, now.Add(Period))
+		a.So(ok, should.BeTrue)
+		a.So(step, should.Equal, uint64(tc.Time)/uint64(Period/time.Second))
+	}
+
+	a.So(Validate(secret, "", time.Now()), should.BeFalse)
+	a.So(Validate(secret, "1234567", time.Now()), should.BeFalse)
+
+	generated, err := GenerateSecret()
+	a.So(err, should.BeNil)
+	a.So(generated, should.HaveLength, 20)
+
+	key, _ := hex.DecodeString("3132333435363738393031323334353637383930")
+	uri := URI("The Things Stack", "admin", key)
+	a.So(strings.HasPrefix(uri, "otpauth://totp/The%20Things%20Stack:admin?"), should.BeTrue)
+	a.So(uri, should.ContainSubstring, "secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+	a.So(uri, should.ContainSubstring, "issuer=The+Things+Stack")
+}
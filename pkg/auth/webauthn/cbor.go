@@ -0,0 +1,178 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webauthn
+
+import (
+	"encoding/binary"
+	"math"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+var errCBOR = errors.DefineInvalidArgument("cbor", "invalid CBOR data")
+
+// cborDecoder decodes the subset of CBOR (RFC 7049) that is used by WebAuthn attestation objects and COSE keys.
+// Maps are decoded to map[interface{}]interface{}, integers to int64, byte strings to []byte and text strings to string.
+type cborDecoder struct {
+	buf []byte
+	pos int
+}
+
+func (d *cborDecoder) readByte() (byte, error) {
+	if d.pos >= len(d.buf) {
+		return 0, errCBOR.New()
+	}
+	b := d.buf[d.pos]
+	d.pos++
+	return b, nil
+}
+
+func (d *cborDecoder) readBytes(n uint64) ([]byte, error) {
+	if n > uint64(len(d.buf)-d.pos) {
+		return nil, errCBOR.New()
+	}
+	b := d.buf[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+	return b, nil
+}
+
+func (d *cborDecoder) readArgument(info byte) (uint64, error) {
+	switch {
+	case info < 24:
+		return uint64(info), nil
+	case info == 24:
+		b, err := d.readByte()
+		return uint64(b), err
+	case info == 25:
+		b, err := d.readBytes(2)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(binary.BigEndian.Uint16(b)), nil
+	case info == 26:
+		b, err := d.readBytes(4)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(binary.BigEndian.Uint32(b)), nil
+	case info == 27:
+		b, err := d.readBytes(8)
+		if err != nil {
+			return 0, err
+		}
+		return binary.BigEndian.Uint64(b), nil
+	default:
+		// Indefinite lengths are not used by authenticators.
+		return 0, errCBOR.New()
+	}
+}
+
+func (d *cborDecoder) decode() (interface{}, error) {
+	initial, err := d.readByte()
+	if err != nil {
+		return nil, err
+	}
+	major, info := initial>>5, initial&0x1f
+	if major == 7 {
+		switch info {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22, 23:
+			return nil, nil
+		default:
+			return nil, errCBOR.New()
+		}
+	}
+	arg, err := d.readArgument(info)
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case 0:
+		if arg > math.MaxInt64 {
+			return nil, errCBOR.New()
+		}
+		return int64(arg), nil
+	case 1:
+		if arg > math.MaxInt64 {
+			return nil, errCBOR.New()
+		}
+		return -1 - int64(arg), nil
+	case 2:
+		b, err := d.readBytes(arg)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte(nil), b...), nil
+	case 3:
+		b, err := d.readBytes(arg)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case 4:
+		if arg > uint64(len(d.buf)) {
+			return nil, errCBOR.New()
+		}
+		arr := make([]interface{}, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			v, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		return arr, nil
+	case 5:
+		if arg > uint64(len(d.buf)) {
+			return nil, errCBOR.New()
+		}
+		m := make(map[interface{}]interface{}, arg)
+		for i := uint64(0); i < arg; i++ {
+			k, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+			switch k.(type) {
+			case int64, string:
+			default:
+				return nil, errCBOR.New()
+			}
+			v, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+			m[k] = v
+		}
+		return m, nil
+	case 6:
+		// Ignore the tag and return the tagged value.
+		return d.decode()
+	default:
+		return nil, errCBOR.New()
+	}
+}
+
+// decodeCBOR decodes the first CBOR data item in b and returns it together with the number of bytes read.
+func decodeCBOR(b []byte) (interface{}, int, error) {
+	d := &cborDecoder{buf: b}
+	v, err := d.decode()
+	if err != nil {
+		return nil, 0, err
+	}
+	return v, d.pos, nil
+}
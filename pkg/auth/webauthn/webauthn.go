@@ -0,0 +1,278 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webauthn implements the relying party side of Web Authentication (WebAuthn) as used for second factor
+// authentication. Only ES256 (ECDSA P-256 with SHA-256) credentials are supported and attestation statements are
+// not verified, which corresponds to the "none" attestation conveyance preference.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+const (
+	challengeLength = 32
+
+	flagUserPresent            = 0x01
+	flagAttestedCredentialData = 0x40
+
+	typeCreate = "webauthn.create"
+	typeGet    = "webauthn.get"
+
+	coseKeyType      = 1
+	coseAlgorithm    = 3
+	coseCurve        = -1
+	coseX            = -2
+	coseY            = -3
+	coseKeyTypeEC2   = 2
+	coseAlgES256     = -7
+	coseCurveP256    = 1
+	authDataMinSize  = 37
+	aaguidLength     = 16
+	credIDLengthSize = 2
+)
+
+var (
+	errClientData        = errors.DefineInvalidArgument("client_data", "invalid client data")
+	errClientDataType    = errors.DefineInvalidArgument("client_data_type", "client data type `{type}` does not match expected `{expected}`")
+	errChallenge         = errors.DefineInvalidArgument("challenge", "challenge does not match")
+	errOrigin            = errors.DefineInvalidArgument("origin", "origin `{origin}` does not match expected `{expected}`")
+	errAttestationObject = errors.DefineInvalidArgument("attestation_object", "invalid attestation object")
+	errAuthenticatorData = errors.DefineInvalidArgument("authenticator_data", "invalid authenticator data")
+	errRPIDHash          = errors.DefineInvalidArgument("rp_id_hash", "relying party ID hash does not match")
+	errUserNotPresent    = errors.DefineInvalidArgument("user_not_present", "user not present")
+	errPublicKey         = errors.DefineInvalidArgument("public_key", "invalid or unsupported public key")
+	errSignature         = errors.DefineInvalidArgument("signature", "invalid signature")
+	errSignCount         = errors.DefineInvalidArgument("sign_count", "signature counter did not increase, the authenticator may be cloned")
+)
+
+// RelyingParty is a WebAuthn relying party.
+type RelyingParty struct {
+	// ID is the relying party identifier, which is the effective domain of the origin.
+	ID string
+	// Origin is the expected origin of the client data, for example https://example.com.
+	Origin string
+}
+
+// Credential is a registered WebAuthn credential.
+type Credential struct {
+	// ID is the credential ID that is generated by the authenticator.
+	ID []byte
+	// PublicKey is the COSE encoded public key of the credential.
+	PublicKey []byte
+	// SignCount is the last known signature counter of the authenticator.
+	SignCount uint32
+}
+
+// NewChallenge returns a new random challenge.
+func NewChallenge() ([]byte, error) {
+	challenge := make([]byte, challengeLength)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, err
+	}
+	return challenge, nil
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+func (rp RelyingParty) verifyClientData(clientDataJSON []byte, typ string, challenge []byte) error {
+	var cd clientData
+	if err := json.Unmarshal(clientDataJSON, &cd); err != nil {
+		return errClientData.WithCause(err)
+	}
+	if cd.Type != typ {
+		return errClientDataType.WithAttributes("type", cd.Type, "expected", typ)
+	}
+	received, err := base64.RawURLEncoding.DecodeString(cd.Challenge)
+	if err != nil {
+		return errClientData.WithCause(err)
+	}
+	if subtle.ConstantTimeCompare(received, challenge) != 1 {
+		return errChallenge.New()
+	}
+	if cd.Origin != rp.Origin {
+		return errOrigin.WithAttributes("origin", cd.Origin, "expected", rp.Origin)
+	}
+	return nil
+}
+
+type authenticatorData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	credentialID []byte
+	publicKey    []byte
+}
+
+func parseAuthenticatorData(b []byte) (*authenticatorData, error) {
+	if len(b) < authDataMinSize {
+		return nil, errAuthenticatorData.New()
+	}
+	data := &authenticatorData{
+		rpIDHash:  b[:32],
+		flags:     b[32],
+		signCount: binary.BigEndian.Uint32(b[33:37]),
+	}
+	if data.flags&flagAttestedCredentialData == 0 {
+		return data, nil
+	}
+	rest := b[authDataMinSize:]
+	if len(rest) < aaguidLength+credIDLengthSize {
+		return nil, errAuthenticatorData.New()
+	}
+	rest = rest[aaguidLength:]
+	n := int(binary.BigEndian.Uint16(rest))
+	rest = rest[credIDLengthSize:]
+	if len(rest) < n {
+		return nil, errAuthenticatorData.New()
+	}
+	data.credentialID, rest = rest[:n], rest[n:]
+	_, keyLength, err := decodeCBOR(rest)
+	if err != nil {
+		return nil, errAuthenticatorData.WithCause(err)
+	}
+	data.publicKey = rest[:keyLength]
+	return data, nil
+}
+
+func (rp RelyingParty) verifyAuthenticatorData(data *authenticatorData) error {
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if subtle.ConstantTimeCompare(data.rpIDHash, rpIDHash[:]) != 1 {
+		return errRPIDHash.New()
+	}
+	if data.flags&flagUserPresent == 0 {
+		return errUserNotPresent.New()
+	}
+	return nil
+}
+
+// parsePublicKey parses a COSE encoded ES256 public key.
+func parsePublicKey(b []byte) (*ecdsa.PublicKey, error) {
+	v, _, err := decodeCBOR(b)
+	if err != nil {
+		return nil, errPublicKey.WithCause(err)
+	}
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, errPublicKey.New()
+	}
+	if m[int64(coseKeyType)] != int64(coseKeyTypeEC2) ||
+		m[int64(coseAlgorithm)] != int64(coseAlgES256) ||
+		m[int64(coseCurve)] != int64(coseCurveP256) {
+		return nil, errPublicKey.New()
+	}
+	x, xOK := m[int64(coseX)].([]byte)
+	y, yOK := m[int64(coseY)].([]byte)
+	if !xOK || !yOK {
+		return nil, errPublicKey.New()
+	}
+	key := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}
+	if !key.Curve.IsOnCurve(key.X, key.Y) {
+		return nil, errPublicKey.New()
+	}
+	return key, nil
+}
+
+// ParseRegistration verifies the client data and attestation object that are returned by
+// navigator.credentials.create() and returns the registered credential.
+func (rp RelyingParty) ParseRegistration(challenge, clientDataJSON, attestationObject []byte) (*Credential, error) {
+	if err := rp.verifyClientData(clientDataJSON, typeCreate, challenge); err != nil {
+		return nil, err
+	}
+	v, _, err := decodeCBOR(attestationObject)
+	if err != nil {
+		return nil, errAttestationObject.WithCause(err)
+	}
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, errAttestationObject.New()
+	}
+	authData, ok := m["authData"].([]byte)
+	if !ok {
+		return nil, errAttestationObject.New()
+	}
+	data, err := parseAuthenticatorData(authData)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.verifyAuthenticatorData(data); err != nil {
+		return nil, err
+	}
+	if data.credentialID == nil {
+		return nil, errAuthenticatorData.New()
+	}
+	if _, err := parsePublicKey(data.publicKey); err != nil {
+		return nil, err
+	}
+	return &Credential{
+		ID:        append([]byte(nil), data.credentialID...),
+		PublicKey: append([]byte(nil), data.publicKey...),
+		SignCount: data.signCount,
+	}, nil
+}
+
+// VerifyAssertion verifies the client data, authenticator data and signature that are returned by
+// navigator.credentials.get() for the given credential, and returns the new signature counter.
+func (rp RelyingParty) VerifyAssertion(challenge []byte, cred Credential, clientDataJSON, authData, signature []byte) (uint32, error) {
+	if err := rp.verifyClientData(clientDataJSON, typeGet, challenge); err != nil {
+		return 0, err
+	}
+	data, err := parseAuthenticatorData(authData)
+	if err != nil {
+		return 0, err
+	}
+	if err := rp.verifyAuthenticatorData(data); err != nil {
+		return 0, err
+	}
+	key, err := parsePublicKey(cred.PublicKey)
+	if err != nil {
+		return 0, err
+	}
+	var sig struct {
+		R, S *big.Int
+	}
+	if rest, err := asn1.Unmarshal(signature, &sig); err != nil || len(rest) != 0 {
+		return 0, errSignature.New()
+	}
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(bytes.Join([][]byte{authData, clientDataHash[:]}, nil))
+	if !ecdsa.Verify(key, digest[:], sig.R, sig.S) {
+		return 0, errSignature.New()
+	}
+	// Authenticators that do not implement a signature counter always return 0.
+	if (data.signCount != 0 || cred.SignCount != 0) && data.signCount <= cred.SignCount {
+		return 0, errSignCount.New()
+	}
+	return data.signCount, nil
+}
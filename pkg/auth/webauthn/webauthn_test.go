@@ -0,0 +1,192 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+)
+
+// encodeCBOR encodes the subset of CBOR that is used in these tests.
+func encodeCBOR(v interface{}) []byte {
+	head := func(major byte, n uint64) []byte {
+		switch {
+		case n < 24:
+			return []byte{major<<5 | byte(n)}
+		case n < 1<<8:
+			return []byte{major<<5 | 24, byte(n)}
+		case n < 1<<16:
+			b := []byte{major<<5 | 25, 0, 0}
+			binary.BigEndian.PutUint16(b[1:], uint16(n))
+			return b
+		default:
+			b := []byte{major<<5 | 26, 0, 0, 0, 0}
+			binary.BigEndian.PutUint32(b[1:], uint32(n))
+			return b
+		}
+	}
+	switch v := v.(type) {
+	case int:
+		if v < 0 {
+			return head(1, uint64(-1-v))
+		}
+		return head(0, uint64(v))
+	case []byte:
+		return append(head(2, uint64(len(v))), v...)
+	case string:
+		return append(head(3, uint64(len(v))), v...)
+	case map[interface{}]interface{}:
+		var entries [][]byte
+		for k, val := range v {
+			entries = append(entries, append(encodeCBOR(k), encodeCBOR(val)...))
+		}
+		sort.Slice(entries, func(i, j int) bool { return string(entries[i]) < string(entries[j]) })
+		b := head(5, uint64(len(v)))
+		for _, e := range entries {
+			b = append(b, e...)
+		}
+		return b
+	default:
+		panic("unsupported type")
+	}
+}
+
+type testAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	signCount    uint32
+}
+
+func (a *testAuthenticator) publicKey() []byte {
+	return encodeCBOR(map[interface{}]interface{}{
+		coseKeyType:   coseKeyTypeEC2,
+		coseAlgorithm: coseAlgES256,
+		coseCurve:     coseCurveP256,
+		coseX:         a.key.X.Bytes(),
+		coseY:         a.key.Y.Bytes(),
+	})
+}
+
+func (a *testAuthenticator) authData(rpID string, flags byte, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	b := append([]byte(nil), rpIDHash[:]...)
+	b = append(b, flags, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(b[33:], a.signCount)
+	if attested {
+		b[32] |= flagAttestedCredentialData
+		b = append(b, make([]byte, aaguidLength)...)
+		b = append(b, byte(len(a.credentialID)>>8), byte(len(a.credentialID)))
+		b = append(b, a.credentialID...)
+		b = append(b, a.publicKey()...)
+	}
+	return b
+}
+
+func clientDataJSON(typ string, challenge []byte, origin string) []byte {
+	b, _ := json.Marshal(clientData{
+		Type:      typ,
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge),
+		Origin:    origin,
+	})
+	return b
+}
+
+func (a *testAuthenticator) create(rpID, origin string, challenge []byte) (clientData, attestationObject []byte) {
+	attestationObject = encodeCBOR(map[interface{}]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[interface{}]interface{}{},
+		"authData": a.authData(rpID, flagUserPresent, true),
+	})
+	return clientDataJSON(typeCreate, challenge, origin), attestationObject
+}
+
+func (a *testAuthenticator) get(rpID, origin string, challenge []byte) (clientData, authData, signature []byte) {
+	a.signCount++
+	clientData = clientDataJSON(typeGet, challenge, origin)
+	authData = a.authData(rpID, flagUserPresent, false)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientDataHash[:]...))
+	r, s, err := ecdsa.Sign(rand.Reader, a.key, digest[:])
+	if err != nil {
+		panic(err)
+	}
+	signature, _ = asn1.Marshal(struct{ R, S interface{} }{r, s})
+	return clientData, authData, signature
+}
+
+func TestWebAuthn(t *testing.T) {
+	a := assertions.New(t)
+
+	rp := RelyingParty{ID: "example.com", Origin: "https://example.com"}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	authenticator := &testAuthenticator{key: key, credentialID: []byte("credential")}
+
+	challenge, err := NewChallenge()
+	a.So(err, should.BeNil)
+	a.So(challenge, should.HaveLength, challengeLength)
+
+	clientData, attestationObject := authenticator.create(rp.ID, rp.Origin, challenge)
+
+	// Registration with the wrong challenge, origin or relying party fails.
+	_, err = rp.ParseRegistration([]byte("other"), clientData, attestationObject)
+	a.So(err, should.NotBeNil)
+	_, err = RelyingParty{ID: "example.com", Origin: "https://other.com"}.ParseRegistration(challenge, clientData, attestationObject)
+	a.So(err, should.NotBeNil)
+	_, err = RelyingParty{ID: "other.com", Origin: "https://example.com"}.ParseRegistration(challenge, clientData, attestationObject)
+	a.So(err, should.NotBeNil)
+	_, err = rp.ParseRegistration(challenge, clientData, attestationObject[:len(attestationObject)-10])
+	a.So(err, should.NotBeNil)
+
+	cred, err := rp.ParseRegistration(challenge, clientData, attestationObject)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(cred.ID, should.Resemble, []byte("credential"))
+	a.So(cred.PublicKey, should.Resemble, authenticator.publicKey())
+
+	challenge, _ = NewChallenge()
+	clientData, authData, signature := authenticator.get(rp.ID, rp.Origin, challenge)
+
+	// Assertions with the wrong challenge, type or signature fail.
+	_, err = rp.VerifyAssertion([]byte("other"), *cred, clientData, authData, signature)
+	a.So(err, should.NotBeNil)
+	_, err = rp.VerifyAssertion(challenge, *cred, clientDataJSON(typeCreate, challenge, rp.Origin), authData, signature)
+	a.So(err, should.NotBeNil)
+	_, err = rp.VerifyAssertion(challenge, *cred, clientData, authData, signature[:len(signature)-1])
+	a.So(err, should.NotBeNil)
+
+	signCount, err := rp.VerifyAssertion(challenge, *cred, clientData, authData, signature)
+	a.So(err, should.BeNil)
+	a.So(signCount, should.Equal, 1)
+
+	// Replaying the signature counter fails.
+	cred.SignCount = signCount
+	_, err = rp.VerifyAssertion(challenge, *cred, clientData, authData, signature)
+	a.So(err, should.NotBeNil)
+}
@@ -32,6 +32,10 @@ func (c *cluster) WithVerifiedSource(ctx context.Context) context.Context {
 	return clusterauth.VerifySource(ctx, c.keys)
 }
 
+func (c *cluster) WithForwardedFor(ctx context.Context) context.Context {
+	return clusterauth.NewOutgoingContextWithForwardedFor(ctx, c.keys[0])
+}
+
 func (c *cluster) Auth() grpc.CallOption {
 	md := rpcmetadata.MD{
 		ID:            c.self.name,
@@ -0,0 +1,199 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// ClaimRegistry stores which cluster peer claimed responsibility for identifiers in a cluster role.
+// Peers are identified by their cluster address.
+type ClaimRegistry interface {
+	// Get returns the address of the peer that claimed the identifiers in the role.
+	// Get returns a NotFound error if the identifiers are not claimed in the role.
+	Get(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (string, error)
+	// Claim claims the identifiers in the role for the peer with the given address. The claim expires after ttl.
+	// Claiming identifiers that are claimed by another peer transfers the claim.
+	Claim(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers, address string, ttl time.Duration) error
+	// Unclaim releases the claim of the identifiers in the role, if they are claimed by the peer with the given address.
+	Unclaim(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers, address string) error
+}
+
+// DefaultClaimTTL is the default time after which claims expire if they are not renewed.
+// Claims are renewed by the claiming peer at half this interval.
+const DefaultClaimTTL = time.Minute
+
+// WithClaimRegistry makes the cluster claim identifiers in the given registry, and use the registry to find the peer
+// that is responsible for identifiers. This allows for running multiple instances of the same component.
+// Identifiers are claimed in the roles of this peer, and claims are only used to find peers in the claimed role.
+func WithClaimRegistry(registry ClaimRegistry, ttl time.Duration) Option {
+	return optionFunc(func(c *cluster) {
+		if ttl <= 0 {
+			ttl = DefaultClaimTTL
+		}
+		c.claims = &claims{
+			registry: registry,
+			ttl:      ttl,
+			claimed:  make(map[string]ttnpb.Identifiers),
+			peers:    make(map[string]*peer),
+		}
+	})
+}
+
+type claims struct {
+	registry ClaimRegistry
+	ttl      time.Duration
+
+	claimedMu sync.Mutex
+	claimed   map[string]ttnpb.Identifiers
+
+	peersMu sync.Mutex
+	peers   map[string]*peer
+}
+
+func claimKey(ids ttnpb.Identifiers) string {
+	return ids.EntityType() + ":" + ids.IDString()
+}
+
+func (c *cluster) claimIDs(ctx context.Context, ids ttnpb.Identifiers) error {
+	if c.self.target == "" {
+		return errPeerEmptyTarget.New()
+	}
+	c.claims.claimedMu.Lock()
+	c.claims.claimed[claimKey(ids)] = ids
+	c.claims.claimedMu.Unlock()
+	for _, role := range c.self.roles {
+		if err := c.claims.registry.Claim(ctx, role, ids, c.self.target, c.claims.ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *cluster) unclaimIDs(ctx context.Context, ids ttnpb.Identifiers) error {
+	c.claims.claimedMu.Lock()
+	delete(c.claims.claimed, claimKey(ids))
+	c.claims.claimedMu.Unlock()
+	var unclaimErr error
+	for _, role := range c.self.roles {
+		// The context may already be done, so use the cluster context to release the claim.
+		if err := c.claims.registry.Unclaim(c.ctx, role, ids, c.self.target); err != nil && unclaimErr == nil {
+			unclaimErr = err
+		}
+	}
+	return unclaimErr
+}
+
+// renewClaims renews the claims of this peer until the cluster context is done.
+func (c *cluster) renewClaims() {
+	ticker := time.NewTicker(c.claims.ttl / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		c.claims.claimedMu.Lock()
+		claimed := make([]ttnpb.Identifiers, 0, len(c.claims.claimed))
+		for _, ids := range c.claims.claimed {
+			claimed = append(claimed, ids)
+		}
+		c.claims.claimedMu.Unlock()
+		for _, ids := range claimed {
+			for _, role := range c.self.roles {
+				if err := c.claims.registry.Claim(c.ctx, role, ids, c.self.target, c.claims.ttl); err != nil {
+					log.FromContext(c.ctx).WithError(err).WithFields(log.Fields(
+						"ids", ids.IDString(),
+						"role", role,
+					)).Warn("Failed to renew claim")
+				}
+			}
+		}
+	}
+}
+
+// claimedPeer returns the peer that claimed the identifiers in the role, if any.
+func (c *cluster) claimedPeer(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (Peer, bool, error) {
+	address, err := c.claims.registry.Get(ctx, role, ids)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	if address == c.self.target && c.self.HasRole(role) {
+		return c.self, true, nil
+	}
+
+	key := role.String() + ":" + address
+	c.claims.peersMu.Lock()
+	p, ok := c.claims.peers[key]
+	if !ok {
+		p = &peer{
+			name:   address,
+			target: address,
+			roles:  []ttnpb.ClusterRole{role},
+		}
+		p.ctx, p.cancel = context.WithCancel(c.ctx)
+		log.FromContext(ctx).WithField("target", address).Debug("Connecting to claiming peer...")
+		p.conn, p.connErr = grpc.DialContext(p.ctx, p.target, c.dialOptions()...)
+		if p.connErr != nil {
+			p.cancel()
+			c.claims.peersMu.Unlock()
+			return nil, false, errPeerConnection.WithCause(p.connErr).WithAttributes("name", p.name, "address", p.target)
+		}
+		c.claims.peers[key] = p
+	}
+	switch p.conn.GetState() {
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		// The claiming peer cannot be reached from this peer. Drop the connection, so that it is dialed again on the
+		// next call, and route the identifiers to another peer. The claim is left to expire, as the claiming peer may
+		// still be reachable from other peers.
+		p.conn.Close()
+		p.cancel()
+		delete(c.claims.peers, key)
+		c.claims.peersMu.Unlock()
+		log.FromContext(ctx).WithFields(log.Fields(
+			"ids", ids.IDString(),
+			"target", address,
+		)).Debug("Claiming peer unreachable")
+		return nil, false, nil
+	}
+	c.claims.peersMu.Unlock()
+	return p, true, nil
+}
+
+func (c *cluster) leaveClaims() error {
+	c.claims.peersMu.Lock()
+	defer c.claims.peersMu.Unlock()
+	var closeErr error
+	for key, p := range c.claims.peers {
+		if err := p.conn.Close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+		p.cancel()
+		delete(c.claims.peers, key)
+	}
+	return closeErr
+}
@@ -0,0 +1,173 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/cluster"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+var errNotClaimed = errors.DefineNotFound("not_claimed", "not claimed")
+
+type memClaimRegistry struct {
+	mu     sync.Mutex
+	claims map[string]string
+}
+
+func memClaimKey(role ttnpb.ClusterRole, ids ttnpb.Identifiers) string {
+	return role.String() + ":" + ids.IDString()
+}
+
+func (r *memClaimRegistry) Get(_ context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	address, ok := r.claims[memClaimKey(role, ids)]
+	if !ok {
+		return "", errNotClaimed.New()
+	}
+	return address, nil
+}
+
+func (r *memClaimRegistry) Claim(_ context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers, address string, _ time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.claims[memClaimKey(role, ids)] = address
+	return nil
+}
+
+func (r *memClaimRegistry) Unclaim(_ context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers, address string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.claims[memClaimKey(role, ids)] == address {
+		delete(r.claims, memClaimKey(role, ids))
+	}
+	return nil
+}
+
+type mockNetworkServer struct{}
+
+func (mockNetworkServer) Roles() []ttnpb.ClusterRole {
+	return []ttnpb.ClusterRole{ttnpb.ClusterRole_NETWORK_SERVER}
+}
+
+func (mockNetworkServer) RegisterServices(*grpc.Server) {}
+
+func (mockNetworkServer) RegisterHandlers(*runtime.ServeMux, *grpc.ClientConn) {}
+
+func TestClaims(t *testing.T) {
+	a := assertions.New(t)
+
+	var addrs []string
+	for i := 0; i < 2; i++ {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			panic(err)
+		}
+		defer lis.Close()
+		go grpc.NewServer().Serve(lis)
+		addrs = append(addrs, lis.Addr().String())
+	}
+
+	registry := &memClaimRegistry{
+		claims: make(map[string]string),
+	}
+	ctx := test.Context()
+
+	c, err := New(ctx, &Config{
+		Address:        "self:1884",
+		IdentityServer: addrs[0],
+		GatewayServer:  addrs[0],
+	}, WithServices(mockNetworkServer{}), WithClaimRegistry(registry, time.Minute))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(c.Join(), should.BeNil)
+	defer c.Leave()
+
+	claimed := ttnpb.GatewayIdentifiers{GatewayID: "claimed"}
+	unclaimed := ttnpb.GatewayIdentifiers{GatewayID: "unclaimed"}
+
+	// Identifiers claimed by this peer are stored with the address of this peer, only in the roles of this peer.
+	a.So(c.ClaimIDs(ctx, claimed), should.BeNil)
+	address, err := registry.Get(ctx, ttnpb.ClusterRole_NETWORK_SERVER, claimed)
+	a.So(err, should.BeNil)
+	a.So(address, should.Equal, "self:1884")
+	_, err = registry.Get(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, claimed)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	a.So(c.UnclaimIDs(ctx, claimed), should.BeNil)
+	_, err = registry.Get(ctx, ttnpb.ClusterRole_NETWORK_SERVER, claimed)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Identifiers claimed by another peer are routed to that peer.
+	a.So(registry.Claim(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, claimed, addrs[1], time.Minute), should.BeNil)
+	p, err := c.GetPeer(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, claimed)
+	if a.So(err, should.BeNil) {
+		a.So(p.Name(), should.Equal, addrs[1])
+		a.So(p.HasRole(ttnpb.ClusterRole_GATEWAY_SERVER), should.BeTrue)
+	}
+
+	// Unclaimed identifiers are routed to the configured peer.
+	for i := 0; i < 20; i++ {
+		time.Sleep(20 * time.Millisecond) // Wait for peers to join cluster.
+		p, err = c.GetPeer(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, unclaimed)
+		if err == nil {
+			break
+		}
+	}
+	if a.So(err, should.BeNil) {
+		a.So(p.Name(), should.Equal, "gs")
+	}
+
+	// Identifiers claimed in another role are routed to the configured peer.
+	p, err = c.GetPeer(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, claimed)
+	if a.So(err, should.BeNil) {
+		a.So(p.Name(), should.Equal, "is")
+	}
+
+	// Identifiers claimed by peers that cannot be reached are routed to the configured peer. The claims are kept, as
+	// the claiming peer may still be reachable from other peers.
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	unreachable := lis.Addr().String()
+	lis.Close()
+	a.So(registry.Claim(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, claimed, unreachable, time.Minute), should.BeNil)
+	for i := 0; i < 20; i++ {
+		time.Sleep(20 * time.Millisecond) // Wait for the connection to the unreachable peer to fail.
+		p, err = c.GetPeer(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, claimed)
+		if err == nil && p.Name() != unreachable {
+			break
+		}
+	}
+	if a.So(err, should.BeNil) {
+		a.So(p.Name(), should.Equal, "gs")
+	}
+	address, err = registry.Get(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, claimed)
+	a.So(err, should.BeNil)
+	a.So(address, should.Equal, unreachable)
+}
@@ -68,6 +68,9 @@ type Cluster interface {
 	// WithVerifiedSource verifies if the caller providing this context is a component from the cluster, and returns a
 	// new context with that information.
 	WithVerifiedSource(context.Context) context.Context
+	// WithForwardedFor returns an outgoing context that forwards the address of the caller providing this context,
+	// so that a component from the cluster can verify where a call with forwarded authentication originates from.
+	WithForwardedFor(context.Context) context.Context
 }
 
 // Option to apply at cluster initialization.
@@ -117,6 +120,7 @@ type Config struct {
 	CryptoServer      string   `name:"crypto-server" description:"Address for the Crypto Server"`
 	TLS               bool     `name:"tls" description:"Do cluster gRPC over TLS"`
 	Keys              []string `name:"keys" description:"Keys used to communicate between components of the cluster. The first one will be used by the cluster to identify itself"`
+	Claims            string   `name:"claims" description:"Registry that stores which cluster peer claimed identifiers (none, redis)"`
 }
 
 // CustomNew allows you to replace the clustering implementation. New will call CustomNew if not nil.
@@ -180,6 +184,7 @@ type cluster struct {
 	tlsConfig *tls.Config
 	peers     map[string]*peer
 	self      *peer
+	claims    *claims
 
 	keys [][]byte
 }
@@ -231,13 +236,18 @@ func (c *cluster) addPeer(name string, target string, roles ...ttnpb.ClusterRole
 	}
 }
 
-func (c *cluster) Join() (err error) {
+func (c *cluster) dialOptions() []grpc.DialOption {
 	options := rpcclient.DefaultDialOptions(c.ctx)
 	if c.tls {
 		options = append(options, grpc.WithTransportCredentials(credentials.NewTLS(c.tlsConfig)))
 	} else {
 		options = append(options, grpc.WithInsecure())
 	}
+	return options
+}
+
+func (c *cluster) Join() (err error) {
+	options := c.dialOptions()
 	for _, peer := range c.peers {
 		if peer.conn != nil {
 			continue
@@ -260,6 +270,9 @@ func (c *cluster) Join() (err error) {
 		}
 		logger.Debug("Connected to peer")
 	}
+	if c.claims != nil {
+		go c.renewClaims()
+	}
 	return nil
 }
 
@@ -274,6 +287,9 @@ func (c *cluster) Leave() error {
 			peer.cancel()
 		}
 	}
+	if c.claims != nil {
+		return c.leaveClaims()
+	}
 	return nil
 }
 
@@ -297,6 +313,15 @@ func (c *cluster) GetPeers(ctx context.Context, role ttnpb.ClusterRole) ([]Peer,
 var errPeerUnavailable = errors.DefineUnavailable("peer_unavailable", "{cluster_role} cluster peer unavailable")
 
 func (c *cluster) GetPeer(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (Peer, error) {
+	if c.claims != nil && ids != nil {
+		peer, ok, err := c.claimedPeer(ctx, role, ids)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return peer, nil
+		}
+	}
 	matches, err := c.GetPeers(ctx, role)
 	if err != nil {
 		return nil, err
@@ -305,6 +330,7 @@ func (c *cluster) GetPeer(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb
 		return matches[0], nil
 	}
 	// The reference cluster only has a single instance of each component, so we don't need to filter on IDs.
+	// Identifiers that are claimed by an instance are routed by the claim registry, if configured.
 	return nil, errPeerUnavailable.WithAttributes("cluster_role", strings.Title(strings.Replace(role.String(), "_", " ", -1)))
 }
 
@@ -316,14 +342,20 @@ func (c *cluster) GetPeerConn(ctx context.Context, role ttnpb.ClusterRole, ids t
 	return peer.Conn()
 }
 
-// ClaimIDs is a no-op in the reference implementation.
+// ClaimIDs is a no-op in the reference implementation, unless a claim registry is configured.
 // The reference cluster only has a single instance of each component, so we don't need to claim.
 func (c *cluster) ClaimIDs(ctx context.Context, ids ttnpb.Identifiers) error {
+	if c.claims != nil {
+		return c.claimIDs(ctx, ids)
+	}
 	return nil
 }
 
-// UnclaimIDs is a no-op in the reference implementation.
+// UnclaimIDs is a no-op in the reference implementation, unless a claim registry is configured.
 // The reference cluster only has a single instance of each component, so we don't need to unclaim.
 func (c *cluster) UnclaimIDs(ctx context.Context, ids ttnpb.Identifiers) error {
+	if c.claims != nil {
+		return c.unclaimIDs(ctx, ids)
+	}
 	return nil
 }
@@ -0,0 +1,73 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis provides a Redis implementation of the cluster claim registry.
+package redis
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// ClaimRegistry is a Redis implementation of cluster.ClaimRegistry.
+type ClaimRegistry struct {
+	Redis *ttnredis.Client
+}
+
+var errNotClaimed = errors.DefineNotFound("not_claimed", "identifiers `{ids}` are not claimed")
+
+func (r *ClaimRegistry) key(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) string {
+	return r.Redis.Key(strings.ToLower(role.String()), ids.EntityType(), unique.ID(ctx, ids))
+}
+
+// Get implements cluster.ClaimRegistry.
+func (r *ClaimRegistry) Get(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (string, error) {
+	address, err := r.Redis.Get(r.key(ctx, role, ids)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return "", errNotClaimed.WithAttributes("ids", ids.IDString())
+		}
+		return "", ttnredis.ConvertError(err)
+	}
+	return address, nil
+}
+
+// Claim implements cluster.ClaimRegistry.
+func (r *ClaimRegistry) Claim(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers, address string, ttl time.Duration) error {
+	if err := r.Redis.Set(r.key(ctx, role, ids), address, ttl).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// unclaimScript deletes KEYS[1] if its value equals ARGV[1].
+var unclaimScript = redis.NewScript(`if redis.call('get', KEYS[1]) == ARGV[1] then
+	return redis.call('del', KEYS[1])
+end
+return 0`)
+
+// Unclaim implements cluster.ClaimRegistry.
+func (r *ClaimRegistry) Unclaim(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers, address string) error {
+	if err := unclaimScript.Run(r.Redis, []string{r.key(ctx, role, ids)}, address).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/cluster/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestClaimRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "cluster_redis")
+	defer flush()
+	defer cl.Close()
+
+	registry := &ClaimRegistry{
+		Redis: cl,
+	}
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "foo-gtw"}
+
+	_, err := registry.Get(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	a.So(registry.Claim(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids, "gs1:8884", time.Minute), should.BeNil)
+	address, err := registry.Get(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids)
+	a.So(err, should.BeNil)
+	a.So(address, should.Equal, "gs1:8884")
+
+	// Claims are only valid in the claimed role.
+	_, err = registry.Get(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// The gateway migrates to another instance.
+	a.So(registry.Claim(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids, "gs2:8884", time.Minute), should.BeNil)
+	address, err = registry.Get(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids)
+	a.So(err, should.BeNil)
+	a.So(address, should.Equal, "gs2:8884")
+
+	// The previous instance does not release the claim of the new instance.
+	a.So(registry.Unclaim(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids, "gs1:8884"), should.BeNil)
+	address, err = registry.Get(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids)
+	a.So(err, should.BeNil)
+	a.So(address, should.Equal, "gs2:8884")
+
+	a.So(registry.Unclaim(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids, "gs2:8884"), should.BeNil)
+	_, err = registry.Get(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Claims expire.
+	a.So(registry.Claim(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids, "gs1:8884", test.Delay), should.BeNil)
+	time.Sleep(2 * test.Delay)
+	_, err = registry.Get(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
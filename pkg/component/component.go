@@ -18,6 +18,7 @@ package component
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"os/signal"
@@ -342,6 +343,12 @@ func (c *Component) Close() {
 		c.grpc.Stop()
 		c.logger.Debug("Stopped gRPC server")
 	}
+
+	if closer, ok := c.KeyVault.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			c.logger.WithError(err).Error("Error while closing key vault")
+		}
+	}
 }
 
 // AllowInsecureForCredentials returns `true` if the component was configured to allow transmission of credentials
@@ -72,6 +72,18 @@ func (gs *gsImplementation) GetGatewayConnectionStats(ctx context.Context, _ *tt
 	return &ttnpb.GatewayConnectionStats{}, nil
 }
 
+func (gs *gsImplementation) CaptureGatewayTraffic(ctx context.Context, _ *ttnpb.CaptureGatewayTrafficRequest) (*ttnpb.CaptureGatewayTrafficResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (gs *gsImplementation) GetGatewayTrafficStats(ctx context.Context, _ *ttnpb.GetGatewayTrafficStatsRequest) (*ttnpb.GatewayTrafficStatsHistory, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (gs *gsImplementation) GetGatewayTrafficCapture(ctx context.Context, _ *ttnpb.GetGatewayTrafficCaptureRequest) (*ttnpb.GatewayTrafficCapture, error) {
+	return nil, errors.New("not implemented")
+}
+
 func TestHooks(t *testing.T) {
 	a := assertions.New(t)
 
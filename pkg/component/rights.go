@@ -29,7 +29,9 @@ func (c *Component) initRights() {
 			return nil
 		}
 		return conn
-	}, c.config.GRPC.AllowInsecureForCredentials)
+	}, c.config.GRPC.AllowInsecureForCredentials, rights.WithForwardedFor(func(ctx context.Context) context.Context {
+		return c.cluster.WithForwardedFor(ctx)
+	}))
 
 	if c.config.Rights.TTL > 0 {
 		fetcher = rights.NewInMemoryCache(fetcher, c.config.Rights.TTL, c.config.Rights.TTL)
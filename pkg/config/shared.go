@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/tls"
 	"io/ioutil"
+	"net/http"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -129,10 +130,26 @@ type Rights struct {
 	TTL time.Duration `name:"ttl" description:"Validity of Identity Server responses"`
 }
 
+// KeyVaultPKCS11 represents configuration for PKCS#11 key vaults.
+type KeyVaultPKCS11 struct {
+	Library    string `name:"library" description:"Path to the PKCS#11 library of the HSM"`
+	TokenLabel string `name:"token-label" description:"Label of the token that contains the KEKs and certificates"`
+	PIN        string `name:"pin" description:"User PIN of the token"`
+}
+
+// KeyVaultKMS represents configuration for KMS key vaults.
+type KeyVaultKMS struct {
+	URL     string        `name:"url" description:"URL of the KMS API"`
+	Token   string        `name:"token" description:"Bearer token to authenticate with the KMS"`
+	Timeout time.Duration `name:"timeout" description:"Timeout of requests to the KMS"`
+}
+
 // KeyVault represents configuration for key vaults.
 type KeyVault struct {
-	Provider string            `name:"provider" description:"Provider (static)"`
+	Provider string            `name:"provider" description:"Provider (static, pkcs11, kms)"`
 	Static   map[string][]byte `name:"static"`
+	PKCS11   KeyVaultPKCS11    `name:"pkcs11"`
+	KMS      KeyVaultKMS       `name:"kms"`
 }
 
 // KeyVault returns an initialized crypto.KeyVault based on the configuration.
@@ -143,6 +160,21 @@ func (v KeyVault) KeyVault() (crypto.KeyVault, error) {
 		kv.Separator = ":"
 		kv.ReplaceOldNew = []string{":", "_"}
 		return kv, nil
+	case "pkcs11":
+		kv, err := cryptoutil.NewPKCS11KeyVault(v.PKCS11.Library, v.PKCS11.TokenLabel, v.PKCS11.PIN)
+		if err != nil {
+			return nil, err
+		}
+		kv.Separator = ":"
+		kv.ReplaceOldNew = []string{":", "_"}
+		return kv, nil
+	case "kms":
+		kv := cryptoutil.NewKMSKeyVault(v.KMS.URL, v.KMS.Token, &http.Client{
+			Timeout: v.KMS.Timeout,
+		})
+		kv.Separator = ":"
+		kv.ReplaceOldNew = []string{":", "_"}
+		return kv, nil
 	default:
 		return cryptoutil.EmptyKeyVault, nil
 	}
@@ -105,6 +105,27 @@ func TestCryptoServices(t *testing.T) {
 				}
 			})
 
+			t.Run("RejoinRequestMIC", func(t *testing.T) {
+				a := assertions.New(t)
+				dev := &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+				}
+				payload := bytes.Repeat([]byte{0x1}, 20)
+				expected, err := crypto.ComputeRejoinRequestMIC(
+					crypto.DeriveJSIntKey(types.AES128Key{0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1}, *ids.DevEUI),
+					payload,
+				)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				res, err := svc.RejoinRequestMIC(ctx, dev, ttnpb.MAC_V1_1, payload)
+				a.So(err, should.BeNil)
+				a.So(res, should.Resemble, expected)
+
+				_, err = svc.RejoinRequestMIC(ctx, dev, ttnpb.MAC_V1_0_3, payload)
+				a.So(err, should.NotBeNil)
+			})
+
 			t.Run("JoinAcceptMIC", func(t *testing.T) {
 				for _, tc := range []struct {
 					Version     ttnpb.MACVersion
@@ -390,6 +411,19 @@ func (s *mockNetworkRPCServer) JoinRequestMIC(ctx context.Context, req *ttnpb.Cr
 	}, nil
 }
 
+func (s *mockNetworkRPCServer) RejoinRequestMIC(ctx context.Context, req *ttnpb.CryptoServicePayloadRequest) (*ttnpb.CryptoServicePayloadResponse, error) {
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: req.EndDeviceIdentifiers,
+	}
+	mic, err := s.Network.RejoinRequestMIC(ctx, dev, req.LoRaWANVersion, req.Payload)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.CryptoServicePayloadResponse{
+		Payload: mic[:],
+	}, nil
+}
+
 func (s *mockNetworkRPCServer) JoinAcceptMIC(ctx context.Context, req *ttnpb.JoinAcceptMICRequest) (*ttnpb.CryptoServicePayloadResponse, error) {
 	dev := &ttnpb.EndDevice{
 		EndDeviceIdentifiers: req.EndDeviceIdentifiers,
@@ -55,6 +55,21 @@ func (s *networkRPCClient) JoinRequestMIC(ctx context.Context, dev *ttnpb.EndDev
 	return
 }
 
+func (s *networkRPCClient) RejoinRequestMIC(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, payload []byte) (mic [4]byte, err error) {
+	res, err := s.Client.RejoinRequestMIC(ctx, &ttnpb.CryptoServicePayloadRequest{
+		EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+		LoRaWANVersion:       version,
+		Payload:              payload,
+		ProvisionerID:        dev.ProvisionerID,
+		ProvisioningData:     dev.ProvisioningData,
+	}, s.callOpts...)
+	if err != nil {
+		return
+	}
+	copy(mic[:], res.Payload)
+	return
+}
+
 func (s *networkRPCClient) JoinAcceptMIC(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, joinReqType byte, dn types.DevNonce, payload []byte) (mic [4]byte, err error) {
 	res, err := s.Client.JoinAcceptMIC(ctx, &ttnpb.JoinAcceptMICRequest{
 		CryptoServicePayloadRequest: ttnpb.CryptoServicePayloadRequest{
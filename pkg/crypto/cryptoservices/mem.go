@@ -69,6 +69,26 @@ var (
 	errNoJoinEUI = errors.DefineCorruption("no_join_eui", "no JoinEUI specified")
 )
 
+var errRejoinRequestMACVersion = errors.DefineInvalidArgument("rejoin_request_mac_version", "rejoin-requests are not supported by LoRaWAN version `{version}`")
+
+func (d *mem) RejoinRequestMIC(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, payload []byte) ([4]byte, error) {
+	if version.Compare(ttnpb.MAC_V1_1) < 0 {
+		return [4]byte{}, errRejoinRequestMACVersion.WithAttributes("version", version)
+	}
+	if dev.DevEUI == nil || dev.DevEUI.IsZero() {
+		return [4]byte{}, errNoDevEUI.New()
+	}
+	key, err := d.getNwkKey(version)
+	if err != nil {
+		return [4]byte{}, err
+	}
+	if key == nil {
+		return [4]byte{}, errNoNwkKey.New()
+	}
+	jsIntKey := crypto.DeriveJSIntKey(*key, *dev.DevEUI)
+	return crypto.ComputeRejoinRequestMIC(jsIntKey, payload)
+}
+
 func (d *mem) JoinAcceptMIC(ctx context.Context, dev *ttnpb.EndDevice, version ttnpb.MACVersion, joinReqType byte, dn types.DevNonce, payload []byte) ([4]byte, error) {
 	if dev.JoinEUI == nil {
 		return [4]byte{}, errNoJoinEUI.New()
@@ -27,6 +27,13 @@ import (
 var (
 	errKEKNotFound         = errors.DefineNotFound("kek_not_found", "KEK with label `{label}` not found")
 	errCertificateNotFound = errors.DefineNotFound("certificate_not_found", "certificate with ID `{id}` not found")
+
+	errPKCS11               = errors.Define("pkcs11", "PKCS#11 operation `{operation}` failed")
+	errPKCS11Library        = errors.DefineInvalidArgument("pkcs11_library", "failed to load PKCS#11 library `{library}`")
+	errPKCS11TokenNotFound  = errors.DefineNotFound("pkcs11_token_not_found", "PKCS#11 token with label `{label}` not found")
+	errPKCS11Unavailable    = errors.DefineUnimplemented("pkcs11_unavailable", "PKCS#11 is not available in this build")
+	errKMSRequest           = errors.DefineUnavailable("kms_request", "KMS request failed with status `{code}`")
+	errUnsupportedSignature = errors.DefineInvalidArgument("unsupported_signature", "signature with hash `{hash}` is not supported")
 )
 
 // WrapAES128Key performs the RFC 3394 Wrap algorithm on the given key using the given key vault and KEK label.
@@ -114,3 +121,51 @@ func UnwrapSelectedSessionKeys(ctx context.Context, keyVault crypto.KeyVault, sk
 	}
 	return ret, nil
 }
+
+// RewrapAES128Key unwraps the given key envelope and wraps the key with the new KEK label, if the key envelope is
+// wrapped with the old KEK label. Key envelopes with the key in the clear are considered to be wrapped with the
+// empty KEK label.
+// It returns the re-wrapped key envelope, or nil if the key envelope is not wrapped with the old KEK label.
+func RewrapAES128Key(ctx context.Context, wrapped ttnpb.KeyEnvelope, oldKEKLabel, newKEKLabel string, v crypto.KeyVault) (*ttnpb.KeyEnvelope, error) {
+	if wrapped.KEKLabel != oldKEKLabel || wrapped.Key == nil && len(wrapped.EncryptedKey) == 0 {
+		return nil, nil
+	}
+	key, err := UnwrapAES128Key(ctx, wrapped, v)
+	if err != nil {
+		return nil, err
+	}
+	rewrapped, err := WrapAES128Key(ctx, key, newKEKLabel, v)
+	if err != nil {
+		return nil, err
+	}
+	return &rewrapped, nil
+}
+
+// RewrapSessionKeys re-wraps the keys of sk that are wrapped with the old KEK label with the new KEK label using RewrapAES128Key.
+// It returns the paths of the re-wrapped keys, prefixed with prefix.
+func RewrapSessionKeys(ctx context.Context, sk *ttnpb.SessionKeys, prefix, oldKEKLabel, newKEKLabel string, v crypto.KeyVault) ([]string, error) {
+	var paths []string
+	for _, k := range []struct {
+		path     string
+		envelope **ttnpb.KeyEnvelope
+	}{
+		{path: "app_s_key", envelope: &sk.AppSKey},
+		{path: "f_nwk_s_int_key", envelope: &sk.FNwkSIntKey},
+		{path: "nwk_s_enc_key", envelope: &sk.NwkSEncKey},
+		{path: "s_nwk_s_int_key", envelope: &sk.SNwkSIntKey},
+	} {
+		if *k.envelope == nil {
+			continue
+		}
+		rewrapped, err := RewrapAES128Key(ctx, **k.envelope, oldKEKLabel, newKEKLabel, v)
+		if err != nil {
+			return nil, err
+		}
+		if rewrapped == nil {
+			continue
+		}
+		*k.envelope = rewrapped
+		paths = append(paths, pathWithPrefix(prefix, k.path))
+	}
+	return paths, nil
+}
@@ -439,3 +439,77 @@ func TestUnwrapSelectedSessionKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestRewrapSessionKeys(t *testing.T) {
+	a := assertions.New(t)
+
+	var key types.AES128Key
+	test.Must(nil, key.UnmarshalText([]byte("00112233445566778899AABBCCDDEEFF")))
+	kekKey := test.Must(hex.DecodeString("000102030405060708090A0B0C0D0E0F")).([]byte)
+	cipherKey := test.Must(hex.DecodeString("1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5")).([]byte)
+	kekOther := test.Must(hex.DecodeString("000102030405060708090A0B0C0D0E0F1011121314151617")).([]byte)
+	cipherOther := test.Must(hex.DecodeString("96778B25AE6CA435F92B5B97C050AED2468AB8A17AD84E5D")).([]byte)
+
+	v := NewMemKeyVault(map[string][]byte{
+		"key":   kekKey,
+		"other": kekOther,
+	})
+
+	// Wrap keys that are stored in the clear.
+	sk := &ttnpb.SessionKeys{
+		SessionKeyID: []byte{0x01},
+		AppSKey: &ttnpb.KeyEnvelope{
+			Key: &key,
+		},
+		FNwkSIntKey: &ttnpb.KeyEnvelope{
+			EncryptedKey: key[:],
+		},
+		NwkSEncKey: &ttnpb.KeyEnvelope{
+			EncryptedKey: cipherOther,
+			KEKLabel:     "other",
+		},
+	}
+	paths, err := RewrapSessionKeys(test.Context(), sk, "session.keys", "", "key", v)
+	a.So(err, should.BeNil)
+	a.So(paths, should.Resemble, []string{
+		"session.keys.app_s_key",
+		"session.keys.f_nwk_s_int_key",
+	})
+	a.So(sk, should.Resemble, &ttnpb.SessionKeys{
+		SessionKeyID: []byte{0x01},
+		AppSKey: &ttnpb.KeyEnvelope{
+			EncryptedKey: cipherKey,
+			KEKLabel:     "key",
+		},
+		FNwkSIntKey: &ttnpb.KeyEnvelope{
+			EncryptedKey: cipherKey,
+			KEKLabel:     "key",
+		},
+		NwkSEncKey: &ttnpb.KeyEnvelope{
+			EncryptedKey: cipherOther,
+			KEKLabel:     "other",
+		},
+	})
+
+	// Re-wrap keys with another KEK.
+	paths, err = RewrapSessionKeys(test.Context(), sk, "", "key", "other", v)
+	a.So(err, should.BeNil)
+	a.So(paths, should.Resemble, []string{
+		"app_s_key",
+		"f_nwk_s_int_key",
+	})
+	a.So(sk.AppSKey, should.Resemble, &ttnpb.KeyEnvelope{
+		EncryptedKey: cipherOther,
+		KEKLabel:     "other",
+	})
+	a.So(sk.FNwkSIntKey, should.Resemble, sk.AppSKey)
+
+	// Nothing to re-wrap.
+	paths, err = RewrapSessionKeys(test.Context(), sk, "", "key", "other", v)
+	a.So(err, should.BeNil)
+	a.So(paths, should.BeEmpty)
+
+	// Unknown KEK.
+	_, err = RewrapSessionKeys(test.Context(), sk, "", "other", "unknown", v)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
@@ -0,0 +1,245 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoutil
+
+import (
+	"bytes"
+	"context"
+	stdcrypto "crypto"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// KMSKeyVault is a KeyVault that uses a KMS with an HTTP API.
+//
+// The KMS wraps and unwraps keys with its KEKs, identified by the KEK label:
+//
+//	POST {url}/keys/{label}/wrap {"plaintext":"<base64>"} -> {"ciphertext":"<base64>"}
+//	POST {url}/keys/{label}/unwrap {"ciphertext":"<base64>"} -> {"plaintext":"<base64>"}
+//
+// The KMS holds certificates and signs with their private keys, which never leave the KMS:
+//
+//	GET {url}/certificates/{id} -> {"certificate":"<PEM encoded certificate chain>"}
+//	POST {url}/certificates/{id}/sign {"digest":"<base64>","hash":"SHA-256","pss":false} -> {"signature":"<base64>"}
+//
+// The KMS responds with status 404 if a KEK or certificate is not found.
+type KMSKeyVault struct {
+	ComponentPrefixKEKLabeler
+
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NewKMSKeyVault returns a KMSKeyVault that uses the KMS API at the given URL.
+// If the token is not empty, it is used as bearer token to authenticate with the KMS.
+func NewKMSKeyVault(baseURL, token string, client *http.Client) *KMSKeyVault {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &KMSKeyVault{
+		url:    strings.TrimSuffix(baseURL, "/"),
+		token:  token,
+		client: client,
+	}
+}
+
+// do performs a request to the KMS. If the KMS responds with status 404, notFound is returned.
+func (v *KMSKeyVault) do(ctx context.Context, method string, path []string, req, res interface{}, notFound error) error {
+	escaped := make([]string, 0, len(path))
+	for _, p := range path {
+		escaped = append(escaped, url.PathEscape(p))
+	}
+	var body io.Reader
+	if req != nil {
+		buf, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(buf)
+	}
+	httpReq, err := http.NewRequest(method, fmt.Sprintf("%s/%s", v.url, strings.Join(escaped, "/")), body)
+	if err != nil {
+		return err
+	}
+	httpReq = httpReq.WithContext(ctx)
+	if req != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	if v.token != "" {
+		httpReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", v.token))
+	}
+	httpRes, err := v.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpRes.Body.Close()
+	switch {
+	case httpRes.StatusCode == http.StatusNotFound:
+		return notFound
+	case httpRes.StatusCode < 200 || httpRes.StatusCode > 299:
+		return errKMSRequest.WithAttributes("code", httpRes.StatusCode)
+	}
+	return json.NewDecoder(httpRes.Body).Decode(res)
+}
+
+// Wrap implements KeyVault.
+func (v *KMSKeyVault) Wrap(ctx context.Context, plaintext []byte, kekLabel string) ([]byte, error) {
+	var res struct {
+		Ciphertext []byte `json:"ciphertext"`
+	}
+	if err := v.do(ctx, http.MethodPost, []string{"keys", kekLabel, "wrap"}, struct {
+		Plaintext []byte `json:"plaintext"`
+	}{
+		Plaintext: plaintext,
+	}, &res, errKEKNotFound.WithAttributes("label", kekLabel)); err != nil {
+		return nil, err
+	}
+	return res.Ciphertext, nil
+}
+
+// Unwrap implements KeyVault.
+func (v *KMSKeyVault) Unwrap(ctx context.Context, ciphertext []byte, kekLabel string) ([]byte, error) {
+	var res struct {
+		Plaintext []byte `json:"plaintext"`
+	}
+	if err := v.do(ctx, http.MethodPost, []string{"keys", kekLabel, "unwrap"}, struct {
+		Ciphertext []byte `json:"ciphertext"`
+	}{
+		Ciphertext: ciphertext,
+	}, &res, errKEKNotFound.WithAttributes("label", kekLabel)); err != nil {
+		return nil, err
+	}
+	return res.Plaintext, nil
+}
+
+func (v *KMSKeyVault) getCertificateChain(ctx context.Context, id string) ([]*x509.Certificate, error) {
+	var res struct {
+		Certificate string `json:"certificate"`
+	}
+	if err := v.do(ctx, http.MethodGet, []string{"certificates", id}, nil, &res, errCertificateNotFound.WithAttributes("id", id)); err != nil {
+		return nil, err
+	}
+	var chain []*x509.Certificate
+	for raw := []byte(res.Certificate); ; {
+		var block *pem.Block
+		block, raw = pem.Decode(raw)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, cert)
+	}
+	if len(chain) == 0 {
+		return nil, errCertificateNotFound.WithAttributes("id", id)
+	}
+	return chain, nil
+}
+
+// GetCertificate implements KeyVault.
+func (v *KMSKeyVault) GetCertificate(ctx context.Context, id string) (*x509.Certificate, error) {
+	chain, err := v.getCertificateChain(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return chain[0], nil
+}
+
+// ExportCertificate implements KeyVault.
+// The private key of the returned certificate signs using the KMS, as the private key cannot be exported.
+func (v *KMSKeyVault) ExportCertificate(ctx context.Context, id string) (*tls.Certificate, error) {
+	chain, err := v.getCertificateChain(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	res := &tls.Certificate{
+		PrivateKey: &kmsSigner{
+			vault:     v,
+			id:        id,
+			publicKey: chain[0].PublicKey,
+		},
+		Leaf: chain[0],
+	}
+	for _, cert := range chain {
+		res.Certificate = append(res.Certificate, cert.Raw)
+	}
+	return res, nil
+}
+
+// hashNames contains the names of the hash functions supported for signing.
+var hashNames = map[stdcrypto.Hash]string{
+	stdcrypto.SHA1:   "SHA-1",
+	stdcrypto.SHA256: "SHA-256",
+	stdcrypto.SHA384: "SHA-384",
+	stdcrypto.SHA512: "SHA-512",
+}
+
+// kmsSigner is a crypto.Signer that signs with a private key in the KMS.
+type kmsSigner struct {
+	vault     *KMSKeyVault
+	id        string
+	publicKey stdcrypto.PublicKey
+}
+
+// Public implements crypto.Signer.
+func (s *kmsSigner) Public() stdcrypto.PublicKey {
+	return s.publicKey
+}
+
+// Sign implements crypto.Signer.
+// ECDSA signatures are ASN.1 encoded.
+func (s *kmsSigner) Sign(_ io.Reader, digest []byte, opts stdcrypto.SignerOpts) ([]byte, error) {
+	req := struct {
+		Digest     []byte `json:"digest"`
+		Hash       string `json:"hash"`
+		PSS        bool   `json:"pss"`
+		SaltLength int    `json:"salt_length,omitempty"`
+	}{
+		Digest: digest,
+	}
+	name, ok := hashNames[opts.HashFunc()]
+	if !ok {
+		return nil, errUnsupportedSignature.WithAttributes("hash", opts.HashFunc())
+	}
+	req.Hash = name
+	if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+		req.PSS = true
+		req.SaltLength = pssOpts.SaltLength
+		if req.SaltLength == rsa.PSSSaltLengthAuto || req.SaltLength == rsa.PSSSaltLengthEqualsHash {
+			req.SaltLength = opts.HashFunc().Size()
+		}
+	}
+	var res struct {
+		Signature []byte `json:"signature"`
+	}
+	if err := s.vault.do(context.Background(), http.MethodPost, []string{"certificates", s.id, "sign"}, req, &res, errCertificateNotFound.WithAttributes("id", s.id)); err != nil {
+		return nil, err
+	}
+	return res.Signature, nil
+}
@@ -0,0 +1,405 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+// +build cgo
+
+package cryptoutil
+
+import (
+	"context"
+	stdcrypto "crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/asn1"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+)
+
+// PKCS11KeyVault is a KeyVault that uses a PKCS#11 token, typically of an HSM.
+// KEKs are AES secret keys and certificates are X.509 certificates on the token, both identified by their label.
+// The private key of a certificate is a private key with the same label as the certificate and never leaves the token.
+type PKCS11KeyVault struct {
+	ComponentPrefixKEKLabeler
+
+	ctx  *pkcs11.Ctx
+	slot uint
+	pin  string
+
+	sessionsMu sync.Mutex
+	sessions   []pkcs11.SessionHandle
+}
+
+// NewPKCS11KeyVault returns a PKCS11KeyVault that uses the token with the given label, using the PKCS#11 library
+// at the given path. The user logs in to the token with the given PIN.
+func NewPKCS11KeyVault(library, tokenLabel, pin string) (*PKCS11KeyVault, error) {
+	ctx := pkcs11.New(library)
+	if ctx == nil {
+		return nil, errPKCS11Library.WithAttributes("library", library)
+	}
+	if err := ctx.Initialize(); err != nil {
+		if err, ok := err.(pkcs11.Error); !ok || err != pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED {
+			ctx.Destroy()
+			return nil, errPKCS11.WithAttributes("operation", "C_Initialize").WithCause(err)
+		}
+	}
+	v := &PKCS11KeyVault{
+		ctx: ctx,
+		pin: pin,
+	}
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		v.Close()
+		return nil, errPKCS11.WithAttributes("operation", "C_GetSlotList").WithCause(err)
+	}
+	found := false
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			v.Close()
+			return nil, errPKCS11.WithAttributes("operation", "C_GetTokenInfo").WithCause(err)
+		}
+		if info.Label == tokenLabel {
+			v.slot, found = slot, true
+			break
+		}
+	}
+	if !found {
+		v.Close()
+		return nil, errPKCS11TokenNotFound.WithAttributes("label", tokenLabel)
+	}
+	// Open the first session, which logs in the user for all sessions of the application.
+	sh, err := v.openSession()
+	if err != nil {
+		v.Close()
+		return nil, err
+	}
+	v.releaseSession(sh)
+	return v, nil
+}
+
+// Close closes the sessions and finalizes the PKCS#11 library.
+func (v *PKCS11KeyVault) Close() error {
+	v.sessionsMu.Lock()
+	for _, sh := range v.sessions {
+		v.ctx.CloseSession(sh)
+	}
+	v.sessions = nil
+	v.sessionsMu.Unlock()
+	err := v.ctx.Finalize()
+	v.ctx.Destroy()
+	return err
+}
+
+func (v *PKCS11KeyVault) openSession() (pkcs11.SessionHandle, error) {
+	sh, err := v.ctx.OpenSession(v.slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return 0, errPKCS11.WithAttributes("operation", "C_OpenSession").WithCause(err)
+	}
+	if err := v.ctx.Login(sh, pkcs11.CKU_USER, v.pin); err != nil {
+		if err, ok := err.(pkcs11.Error); !ok || err != pkcs11.CKR_USER_ALREADY_LOGGED_IN {
+			v.ctx.CloseSession(sh)
+			return 0, errPKCS11.WithAttributes("operation", "C_Login").WithCause(err)
+		}
+	}
+	return sh, nil
+}
+
+func (v *PKCS11KeyVault) releaseSession(sh pkcs11.SessionHandle) {
+	v.sessionsMu.Lock()
+	v.sessions = append(v.sessions, sh)
+	v.sessionsMu.Unlock()
+}
+
+// withSession calls f with a session of the token.
+// A session can only perform a single operation at a time, so sessions are pooled.
+func (v *PKCS11KeyVault) withSession(f func(pkcs11.SessionHandle) error) error {
+	v.sessionsMu.Lock()
+	var sh pkcs11.SessionHandle
+	if n := len(v.sessions); n > 0 {
+		sh, v.sessions = v.sessions[n-1], v.sessions[:n-1]
+		v.sessionsMu.Unlock()
+	} else {
+		v.sessionsMu.Unlock()
+		var err error
+		if sh, err = v.openSession(); err != nil {
+			return err
+		}
+	}
+	defer v.releaseSession(sh)
+	return f(sh)
+}
+
+// findObject returns the object of the given class with the given label.
+func (v *PKCS11KeyVault) findObject(sh pkcs11.SessionHandle, class uint, label string) (pkcs11.ObjectHandle, bool, error) {
+	if err := v.ctx.FindObjectsInit(sh, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}); err != nil {
+		return 0, false, errPKCS11.WithAttributes("operation", "C_FindObjectsInit").WithCause(err)
+	}
+	objs, _, err := v.ctx.FindObjects(sh, 1)
+	if err != nil {
+		v.ctx.FindObjectsFinal(sh)
+		return 0, false, errPKCS11.WithAttributes("operation", "C_FindObjects").WithCause(err)
+	}
+	if err := v.ctx.FindObjectsFinal(sh); err != nil {
+		return 0, false, errPKCS11.WithAttributes("operation", "C_FindObjectsFinal").WithCause(err)
+	}
+	if len(objs) == 0 {
+		return 0, false, nil
+	}
+	return objs[0], true, nil
+}
+
+// sessionKeyTemplate is the template of session objects that hold the plaintext of wrapped keys.
+func sessionKeyTemplate(attrs ...*pkcs11.Attribute) []*pkcs11.Attribute {
+	return append([]*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_GENERIC_SECRET),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, true),
+	}, attrs...)
+}
+
+// Wrap implements KeyVault.
+// The plaintext is wrapped with the RFC 3394 AES key wrap algorithm, so that the ciphertext is compatible with the
+// ciphertext of other key vaults.
+func (v *PKCS11KeyVault) Wrap(ctx context.Context, plaintext []byte, kekLabel string) ([]byte, error) {
+	var res []byte
+	err := v.withSession(func(sh pkcs11.SessionHandle) error {
+		kek, ok, err := v.findObject(sh, pkcs11.CKO_SECRET_KEY, kekLabel)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errKEKNotFound.WithAttributes("label", kekLabel)
+		}
+		key, err := v.ctx.CreateObject(sh, sessionKeyTemplate(
+			pkcs11.NewAttribute(pkcs11.CKA_VALUE, plaintext),
+		))
+		if err != nil {
+			return errPKCS11.WithAttributes("operation", "C_CreateObject").WithCause(err)
+		}
+		defer v.ctx.DestroyObject(sh, key)
+		res, err = v.ctx.WrapKey(sh, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_WRAP, nil)}, kek, key)
+		if err != nil {
+			return errPKCS11.WithAttributes("operation", "C_WrapKey").WithCause(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Unwrap implements KeyVault.
+func (v *PKCS11KeyVault) Unwrap(ctx context.Context, ciphertext []byte, kekLabel string) ([]byte, error) {
+	var res []byte
+	err := v.withSession(func(sh pkcs11.SessionHandle) error {
+		kek, ok, err := v.findObject(sh, pkcs11.CKO_SECRET_KEY, kekLabel)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errKEKNotFound.WithAttributes("label", kekLabel)
+		}
+		key, err := v.ctx.UnwrapKey(sh, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_WRAP, nil)}, kek, ciphertext, sessionKeyTemplate())
+		if err != nil {
+			return errPKCS11.WithAttributes("operation", "C_UnwrapKey").WithCause(err)
+		}
+		defer v.ctx.DestroyObject(sh, key)
+		attrs, err := v.ctx.GetAttributeValue(sh, key, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+		})
+		if err != nil {
+			return errPKCS11.WithAttributes("operation", "C_GetAttributeValue").WithCause(err)
+		}
+		res = attrs[0].Value
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (v *PKCS11KeyVault) getCertificate(sh pkcs11.SessionHandle, id string) (*x509.Certificate, error) {
+	obj, ok, err := v.findObject(sh, pkcs11.CKO_CERTIFICATE, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errCertificateNotFound.WithAttributes("id", id)
+	}
+	attrs, err := v.ctx.GetAttributeValue(sh, obj, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, nil),
+	})
+	if err != nil {
+		return nil, errPKCS11.WithAttributes("operation", "C_GetAttributeValue").WithCause(err)
+	}
+	return x509.ParseCertificate(attrs[0].Value)
+}
+
+// GetCertificate implements KeyVault.
+func (v *PKCS11KeyVault) GetCertificate(ctx context.Context, id string) (*x509.Certificate, error) {
+	var res *x509.Certificate
+	err := v.withSession(func(sh pkcs11.SessionHandle) (err error) {
+		res, err = v.getCertificate(sh, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ExportCertificate implements KeyVault.
+// The private key of the returned certificate signs using the token, as the private key cannot be exported.
+func (v *PKCS11KeyVault) ExportCertificate(ctx context.Context, id string) (*tls.Certificate, error) {
+	var cert *x509.Certificate
+	err := v.withSession(func(sh pkcs11.SessionHandle) (err error) {
+		cert, err = v.getCertificate(sh, id)
+		if err != nil {
+			return err
+		}
+		_, ok, err := v.findObject(sh, pkcs11.CKO_PRIVATE_KEY, id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errCertificateNotFound.WithAttributes("id", id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Certificate{
+		Certificate: [][]byte{cert.Raw},
+		PrivateKey: &pkcs11Signer{
+			vault:     v,
+			label:     id,
+			publicKey: cert.PublicKey,
+		},
+		Leaf: cert,
+	}, nil
+}
+
+// pkcs11Signer is a crypto.Signer that signs with a private key on the token.
+type pkcs11Signer struct {
+	vault     *PKCS11KeyVault
+	label     string
+	publicKey stdcrypto.PublicKey
+}
+
+// Public implements crypto.Signer.
+func (s *pkcs11Signer) Public() stdcrypto.PublicKey {
+	return s.publicKey
+}
+
+var pkcs11Hashes = map[stdcrypto.Hash]struct {
+	mechanism, mgf uint
+	// digestInfoPrefix is the DER encoded DigestInfo prefix of RSA PKCS #1 v1.5 signatures.
+	digestInfoPrefix []byte
+}{
+	stdcrypto.SHA1: {
+		mechanism:        pkcs11.CKM_SHA_1,
+		mgf:              pkcs11.CKG_MGF1_SHA1,
+		digestInfoPrefix: []byte{0x30, 0x21, 0x30, 0x09, 0x06, 0x05, 0x2b, 0x0e, 0x03, 0x02, 0x1a, 0x05, 0x00, 0x04, 0x14},
+	},
+	stdcrypto.SHA256: {
+		mechanism:        pkcs11.CKM_SHA256,
+		mgf:              pkcs11.CKG_MGF1_SHA256,
+		digestInfoPrefix: []byte{0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	},
+	stdcrypto.SHA384: {
+		mechanism:        pkcs11.CKM_SHA384,
+		mgf:              pkcs11.CKG_MGF1_SHA384,
+		digestInfoPrefix: []byte{0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	},
+	stdcrypto.SHA512: {
+		mechanism:        pkcs11.CKM_SHA512,
+		mgf:              pkcs11.CKG_MGF1_SHA512,
+		digestInfoPrefix: []byte{0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+	},
+}
+
+// Sign implements crypto.Signer.
+func (s *pkcs11Signer) Sign(_ io.Reader, digest []byte, opts stdcrypto.SignerOpts) ([]byte, error) {
+	var (
+		mechanism *pkcs11.Mechanism
+		data      = digest
+	)
+	switch s.publicKey.(type) {
+	case *rsa.PublicKey:
+		hash, ok := pkcs11Hashes[opts.HashFunc()]
+		if !ok {
+			return nil, errUnsupportedSignature.WithAttributes("hash", opts.HashFunc())
+		}
+		if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+			saltLength := pssOpts.SaltLength
+			if saltLength == rsa.PSSSaltLengthAuto || saltLength == rsa.PSSSaltLengthEqualsHash {
+				saltLength = opts.HashFunc().Size()
+			}
+			mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_PSS, pkcs11.NewPSSParams(hash.mechanism, hash.mgf, uint(saltLength)))
+		} else {
+			mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil)
+			data = append(append(make([]byte, 0, len(hash.digestInfoPrefix)+len(digest)), hash.digestInfoPrefix...), digest...)
+		}
+	case *ecdsa.PublicKey:
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)
+	default:
+		return nil, errUnsupportedSignature.WithAttributes("hash", opts.HashFunc())
+	}
+
+	var sig []byte
+	err := s.vault.withSession(func(sh pkcs11.SessionHandle) error {
+		key, ok, err := s.vault.findObject(sh, pkcs11.CKO_PRIVATE_KEY, s.label)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errCertificateNotFound.WithAttributes("id", s.label)
+		}
+		if err := s.vault.ctx.SignInit(sh, []*pkcs11.Mechanism{mechanism}, key); err != nil {
+			return errPKCS11.WithAttributes("operation", "C_SignInit").WithCause(err)
+		}
+		if sig, err = s.vault.ctx.Sign(sh, data); err != nil {
+			return errPKCS11.WithAttributes("operation", "C_Sign").WithCause(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.publicKey.(*ecdsa.PublicKey); ok {
+		// PKCS#11 ECDSA signatures are the concatenation of r and s, whereas Go expects an ASN.1 encoded signature.
+		n := len(sig) / 2
+		return asn1.Marshal(struct {
+			R, S *big.Int
+		}{
+			R: new(big.Int).SetBytes(sig[:n]),
+			S: new(big.Int).SetBytes(sig[n:]),
+		})
+	}
+	return sig, nil
+}
@@ -0,0 +1,34 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !cgo
+// +build !cgo
+
+package cryptoutil
+
+// PKCS11KeyVault is a KeyVault that uses a PKCS#11 token.
+// PKCS#11 libraries can only be loaded in binaries built with cgo.
+type PKCS11KeyVault struct {
+	emptyKeyVault
+}
+
+// NewPKCS11KeyVault returns an error, as PKCS#11 libraries can only be loaded in binaries built with cgo.
+func NewPKCS11KeyVault(library, tokenLabel, pin string) (*PKCS11KeyVault, error) {
+	return nil, errPKCS11Unavailable.New()
+}
+
+// Close implements io.Closer.
+func (v *PKCS11KeyVault) Close() error {
+	return nil
+}
@@ -0,0 +1,304 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+// +build cgo
+
+package cryptoutil_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/miekg/pkcs11"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+const (
+	softHSMTokenLabel = "lorawan-stack"
+	softHSMSOPIN      = "12345678"
+	softHSMPIN        = "1234"
+)
+
+// setupSoftHSM initializes a SoftHSM token in a temporary directory with the given AES KEKs.
+// The test is skipped if the SOFTHSM2_LIBRARY environment variable is not set to the path of the SoftHSM library.
+func setupSoftHSM(t *testing.T, keks map[string][]byte) (library string, flush func()) {
+	library = os.Getenv("SOFTHSM2_LIBRARY")
+	if library == "" {
+		t.Skip("Set SOFTHSM2_LIBRARY to the path of the SoftHSM library to run PKCS#11 tests")
+	}
+	dir, err := ioutil.TempDir("", "lorawan-stack-softhsm")
+	if err != nil {
+		t.Fatalf("Failed to create temporary directory: %v", err)
+	}
+	tokenDir := filepath.Join(dir, "tokens")
+	confPath := filepath.Join(dir, "softhsm2.conf")
+	if err := os.Mkdir(tokenDir, 0700); err != nil {
+		t.Fatalf("Failed to create token directory: %v", err)
+	}
+	if err := ioutil.WriteFile(confPath, []byte(fmt.Sprintf("directories.tokendir = %s\nobjectstore.backend = file\n", tokenDir)), 0600); err != nil {
+		t.Fatalf("Failed to write SoftHSM configuration: %v", err)
+	}
+	oldConf, hadConf := os.LookupEnv("SOFTHSM2_CONF")
+	os.Setenv("SOFTHSM2_CONF", confPath)
+	flush = func() {
+		if hadConf {
+			os.Setenv("SOFTHSM2_CONF", oldConf)
+		} else {
+			os.Unsetenv("SOFTHSM2_CONF")
+		}
+		os.RemoveAll(dir)
+	}
+
+	ctx := pkcs11.New(library)
+	if ctx == nil {
+		flush()
+		t.Fatalf("Failed to load PKCS#11 library %s", library)
+	}
+	defer ctx.Destroy()
+	must := func(err error) {
+		if err != nil {
+			flush()
+			t.Fatalf("Failed to set up SoftHSM token: %v", err)
+		}
+	}
+	must(ctx.Initialize())
+	defer ctx.Finalize()
+	slots, err := ctx.GetSlotList(false)
+	must(err)
+	if len(slots) == 0 {
+		must(fmt.Errorf("no slots"))
+	}
+	must(ctx.InitToken(slots[0], softHSMSOPIN, softHSMTokenLabel))
+	// SoftHSM reassigns the slot of an initialized token.
+	slots, err = ctx.GetSlotList(true)
+	must(err)
+	var slot uint
+	for _, s := range slots {
+		info, err := ctx.GetTokenInfo(s)
+		must(err)
+		if info.Label == softHSMTokenLabel {
+			slot = s
+		}
+	}
+	sh, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	must(err)
+	defer ctx.CloseSession(sh)
+	must(ctx.Login(sh, pkcs11.CKU_SO, softHSMSOPIN))
+	must(ctx.InitPIN(sh, softHSMPIN))
+	must(ctx.Logout(sh))
+	must(ctx.Login(sh, pkcs11.CKU_USER, softHSMPIN))
+	defer ctx.Logout(sh)
+	for label, kek := range keks {
+		_, err := ctx.CreateObject(sh, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+			pkcs11.NewAttribute(pkcs11.CKA_VALUE, kek),
+			pkcs11.NewAttribute(pkcs11.CKA_WRAP, true),
+			pkcs11.NewAttribute(pkcs11.CKA_UNWRAP, true),
+		})
+		must(err)
+	}
+
+	// Generate an ECDSA key pair on the token, and store a self-signed certificate that is signed by the token.
+	ecParams, err := asn1.Marshal(asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7})
+	must(err)
+	pub, _, err := ctx.GenerateKeyPair(sh, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_EC_KEY_PAIR_GEN, nil)}, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, "cert1"),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, ecParams),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+	}, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, "cert1"),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+	})
+	must(err)
+	attrs, err := ctx.GetAttributeValue(sh, pub, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	must(err)
+	var point []byte
+	_, err = asn1.Unmarshal(attrs[0].Value, &point)
+	must(err)
+	x, y := elliptic.Unmarshal(elliptic.P256(), point)
+	if x == nil {
+		must(fmt.Errorf("invalid EC point"))
+	}
+	publicKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "pkcs11_test_cert"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, publicKey, &tokenSigner{
+		ctx:       ctx,
+		session:   sh,
+		label:     "cert1",
+		publicKey: publicKey,
+	})
+	must(err)
+	_, err = ctx.CreateObject(sh, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_CERTIFICATE),
+		pkcs11.NewAttribute(pkcs11.CKA_CERTIFICATE_TYPE, pkcs11.CKC_X_509),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, "cert1"),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE, der),
+	})
+	must(err)
+	return library, flush
+}
+
+// tokenSigner signs with the private key on the token during token setup.
+type tokenSigner struct {
+	ctx       *pkcs11.Ctx
+	session   pkcs11.SessionHandle
+	label     string
+	publicKey crypto.PublicKey
+}
+
+func (s *tokenSigner) Public() crypto.PublicKey { return s.publicKey }
+
+func (s *tokenSigner) Sign(_ io.Reader, digest []byte, _ crypto.SignerOpts) ([]byte, error) {
+	if err := s.ctx.FindObjectsInit(s.session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, s.label),
+	}); err != nil {
+		return nil, err
+	}
+	objs, _, err := s.ctx.FindObjects(s.session, 1)
+	s.ctx.FindObjectsFinal(s.session)
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, fmt.Errorf("private key %s not found", s.label)
+	}
+	if err := s.ctx.SignInit(s.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)}, objs[0]); err != nil {
+		return nil, err
+	}
+	sig, err := s.ctx.Sign(s.session, digest)
+	if err != nil {
+		return nil, err
+	}
+	n := len(sig) / 2
+	return asn1.Marshal(struct {
+		R, S *big.Int
+	}{
+		R: new(big.Int).SetBytes(sig[:n]),
+		S: new(big.Int).SetBytes(sig[n:]),
+	})
+}
+
+func TestPKCS11KeyVault(t *testing.T) {
+	a := assertions.New(t)
+
+	plaintext, _ := hex.DecodeString("00112233445566778899AABBCCDDEEFF")
+	kek, _ := hex.DecodeString("000102030405060708090A0B0C0D0E0F")
+	ciphertext, _ := hex.DecodeString("1FA68B0A8112B447AEF34BD8FB5A7B829D3E862371D2CFE5")
+
+	library, flush := setupSoftHSM(t, map[string][]byte{
+		"kek1": kek,
+	})
+	defer flush()
+
+	_, err := cryptoutil.NewPKCS11KeyVault(library, "unknown", softHSMPIN)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	v, err := cryptoutil.NewPKCS11KeyVault(library, softHSMTokenLabel, softHSMPIN)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer v.Close()
+
+	// Existing KEK.
+	{
+		actual, err := v.Wrap(test.Context(), plaintext, "kek1")
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, ciphertext)
+	}
+	{
+		actual, err := v.Unwrap(test.Context(), ciphertext, "kek1")
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, plaintext)
+	}
+
+	// Non-existing KEK.
+	{
+		_, err := v.Wrap(test.Context(), plaintext, "kek2")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+	{
+		_, err := v.Unwrap(test.Context(), ciphertext, "kek2")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+
+	// Get existing certificate.
+	{
+		cert, err := v.GetCertificate(test.Context(), "cert1")
+		a.So(err, should.BeNil)
+		a.So(cert.Subject.CommonName, should.Equal, "pkcs11_test_cert")
+	}
+
+	// Get non-existing certificate.
+	{
+		_, err := v.GetCertificate(test.Context(), "cert2")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+
+	// Export existing certificate and sign with the token.
+	{
+		cert, err := v.ExportCertificate(test.Context(), "cert1")
+		if a.So(err, should.BeNil) {
+			signer, ok := cert.PrivateKey.(crypto.Signer)
+			if a.So(ok, should.BeTrue) {
+				data := []byte("test")
+				digest := sha256.Sum256(data)
+				signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+				a.So(err, should.BeNil)
+				a.So(cert.Leaf.CheckSignature(x509.ECDSAWithSHA256, data, signature), should.BeNil)
+			}
+		}
+	}
+
+	// Export non-existing certificate.
+	{
+		_, err := v.ExportCertificate(test.Context(), "cert2")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/capture"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"gocloud.dev/blob"
+	"gocloud.dev/gcerrors"
+)
+
+type captureEntry struct {
+	fileName string
+	writer   *capture.Writer
+}
+
+var (
+	errCaptureDisabled = errors.DefineFailedPrecondition("capture_disabled", "gateway traffic capture is disabled")
+	errCaptureDuration = errors.DefineInvalidArgument(
+		"capture_duration",
+		"capture duration `{duration}` must be positive and at most `{max_duration}`",
+	)
+	errCaptureActive   = errors.DefineAlreadyExists("capture_active", "gateway `{gateway_uid}` is already being captured")
+	errCaptureFile     = errors.DefineUnavailable("capture_file", "failed to create capture file")
+	errCaptureNotFound = errors.DefineNotFound("capture_not_found", "capture `{file_name}` not found")
+	errCaptureRead     = errors.DefineUnavailable("capture_read", "failed to read capture `{file_name}`")
+)
+
+// CaptureGatewayTraffic captures the raw traffic of the gateway to a capture file for the requested duration.
+func (gs *GatewayServer) CaptureGatewayTraffic(ctx context.Context, req *ttnpb.CaptureGatewayTrafficRequest) (*ttnpb.CaptureGatewayTrafficResponse, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_ALL); err != nil {
+		return nil, err
+	}
+	if gs.config.Capture.Directory == "" {
+		return nil, errCaptureDisabled.New()
+	}
+	if req.Duration <= 0 || gs.config.Capture.MaxDuration > 0 && req.Duration > gs.config.Capture.MaxDuration {
+		return nil, errCaptureDuration.WithAttributes(
+			"duration", req.Duration,
+			"max_duration", gs.config.Capture.MaxDuration,
+		)
+	}
+
+	uid := unique.ID(ctx, req.GatewayIdentifiers)
+	startedAt := time.Now().UTC()
+	fileName := fmt.Sprintf("%s-%s.jsonl", uid, startedAt.Format("20060102T150405.000Z"))
+	entry := &captureEntry{
+		fileName: fileName,
+	}
+	if _, loaded := gs.captures.LoadOrStore(uid, entry); loaded {
+		return nil, errCaptureActive.WithAttributes("gateway_uid", uid)
+	}
+
+	f, err := os.OpenFile(filepath.Join(gs.config.Capture.Directory, fileName), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		gs.captures.Delete(uid)
+		return nil, errCaptureFile.WithCause(err)
+	}
+	ids := req.GatewayIdentifiers
+	conn, connected := gs.connections.Load(uid)
+	if connected {
+		// The identifiers of the connection include the EUI, which is needed to replay Basic Station traffic.
+		ids = conn.(connectionEntry).Gateway().GatewayIdentifiers
+	}
+	w, err := capture.NewWriter(f, capture.Header{
+		GatewayIdentifiers: ids,
+		StartedAt:          startedAt,
+	})
+	if err != nil {
+		f.Close()
+		gs.captures.Delete(uid)
+		return nil, errCaptureFile.WithCause(err)
+	}
+	entry.writer = w
+	if connected {
+		conn.(connectionEntry).SetCapture(w)
+	}
+
+	logger := log.FromContext(gs.ctx).WithFields(log.Fields(
+		"gateway_uid", uid,
+		"file_name", fileName,
+	))
+	logger.WithField("duration", req.Duration).Info("Start capturing gateway traffic")
+	go func() {
+		select {
+		case <-gs.ctx.Done():
+		case <-time.After(req.Duration):
+		}
+		gs.captures.Delete(uid)
+		if conn, ok := gs.connections.Load(uid); ok {
+			conn.(connectionEntry).SetCapture(nil)
+		}
+		// Closing the writer closes the capture file. Traffic that is captured concurrently is discarded.
+		if err := w.Close(); err != nil {
+			logger.WithError(err).Warn("Failed to close capture file")
+			return
+		}
+		logger.Info("Stopped capturing gateway traffic")
+		if gs.config.Capture.Bucket != "" {
+			if err := gs.uploadCapture(gs.ctx, fileName); err != nil {
+				logger.WithError(err).Warn("Failed to upload capture file")
+				return
+			}
+			logger.Debug("Uploaded capture file")
+		}
+	}()
+
+	return &ttnpb.CaptureGatewayTrafficResponse{
+		FileName: fileName,
+		StopsAt:  startedAt.Add(req.Duration),
+	}, nil
+}
+
+func (gs *GatewayServer) captureBucket(ctx context.Context) (*blob.Bucket, error) {
+	return gs.Component.GetBaseConfig(ctx).Blob.Bucket(ctx, gs.config.Capture.Bucket)
+}
+
+// uploadCapture uploads the capture file to the capture bucket.
+func (gs *GatewayServer) uploadCapture(ctx context.Context, fileName string) (err error) {
+	f, err := os.Open(filepath.Join(gs.config.Capture.Directory, fileName))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	bucket, err := gs.captureBucket(ctx)
+	if err != nil {
+		return err
+	}
+	defer bucket.Close()
+	w, err := bucket.NewWriter(ctx, fileName, &blob.WriterOptions{
+		ContentType: "application/x-ndjson",
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		closeErr := w.Close()
+		if err == nil {
+			err = closeErr
+		}
+	}()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// readCapture reads the stopped capture file from the capture bucket if it is configured, and from the capture
+// directory otherwise.
+func (gs *GatewayServer) readCapture(ctx context.Context, fileName string) ([]byte, error) {
+	if gs.config.Capture.Bucket != "" {
+		bucket, err := gs.captureBucket(ctx)
+		if err != nil {
+			return nil, errCaptureRead.WithCause(err).WithAttributes("file_name", fileName)
+		}
+		defer bucket.Close()
+		data, err := bucket.ReadAll(ctx, fileName)
+		if err != nil {
+			if gcerrors.Code(err) == gcerrors.NotFound {
+				return nil, errCaptureNotFound.WithAttributes("file_name", fileName)
+			}
+			return nil, errCaptureRead.WithCause(err).WithAttributes("file_name", fileName)
+		}
+		return data, nil
+	}
+	data, err := ioutil.ReadFile(filepath.Join(gs.config.Capture.Directory, fileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errCaptureNotFound.WithAttributes("file_name", fileName)
+		}
+		return nil, errCaptureRead.WithCause(err).WithAttributes("file_name", fileName)
+	}
+	return data, nil
+}
+
+// GetGatewayTrafficCapture returns the contents of a stopped capture of the raw traffic of the gateway.
+func (gs *GatewayServer) GetGatewayTrafficCapture(ctx context.Context, req *ttnpb.GetGatewayTrafficCaptureRequest) (*ttnpb.GatewayTrafficCapture, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_ALL); err != nil {
+		return nil, err
+	}
+	if gs.config.Capture.Directory == "" {
+		return nil, errCaptureDisabled.New()
+	}
+	uid := unique.ID(ctx, req.GatewayIdentifiers)
+	// Only files of the gateway can be retrieved. The file name cannot refer to other directories.
+	if !strings.HasPrefix(req.FileName, uid+"-") || filepath.Base(req.FileName) != req.FileName {
+		return nil, errCaptureNotFound.WithAttributes("file_name", req.FileName)
+	}
+	if entry, ok := gs.captures.Load(uid); ok && entry.(*captureEntry).fileName == req.FileName {
+		// The capture file is still being written.
+		return nil, errCaptureActive.WithAttributes("gateway_uid", uid)
+	}
+	data, err := gs.readCapture(ctx, req.FileName)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.GatewayTrafficCapture{
+		FileName: req.FileName,
+		Data:     data,
+	}, nil
+}
@@ -0,0 +1,137 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/capture"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestCaptureGatewayTraffic(t *testing.T) {
+	a := assertions.New(t)
+
+	dir, err := ioutil.TempDir("", "lorawan-stack-capture")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	gs, err := gatewayserver.New(c, &gatewayserver.Config{
+		Capture: gatewayserver.CaptureConfig{
+			Directory:   dir,
+			MaxDuration: time.Minute,
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	ctx := test.Context()
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "foo-gateway"}
+	otherIDs := ttnpb.GatewayIdentifiers{GatewayID: "bar-gateway"}
+	ctx = rights.NewContext(ctx, rights.Rights{
+		GatewayRights: map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids):      ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_ALL),
+			unique.ID(ctx, otherIDs): ttnpb.RightsFrom(ttnpb.RIGHT_GATEWAY_ALL),
+		},
+	})
+
+	// The duration must be within the configured maximum.
+	_, err = gs.CaptureGatewayTraffic(ctx, &ttnpb.CaptureGatewayTrafficRequest{
+		GatewayIdentifiers: ids,
+		Duration:           time.Hour,
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	duration := (1 << 4) * test.Delay
+	res, err := gs.CaptureGatewayTraffic(ctx, &ttnpb.CaptureGatewayTrafficRequest{
+		GatewayIdentifiers: ids,
+		Duration:           duration,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(strings.HasPrefix(res.FileName, unique.ID(ctx, ids)+"-"), should.BeTrue)
+
+	// Only one capture per gateway can be active.
+	_, err = gs.CaptureGatewayTraffic(ctx, &ttnpb.CaptureGatewayTrafficRequest{
+		GatewayIdentifiers: ids,
+		Duration:           duration,
+	})
+	a.So(errors.IsAlreadyExists(err), should.BeTrue)
+
+	// The capture cannot be retrieved while it is active.
+	_, err = gs.GetGatewayTrafficCapture(ctx, &ttnpb.GetGatewayTrafficCaptureRequest{
+		GatewayIdentifiers: ids,
+		FileName:           res.FileName,
+	})
+	a.So(errors.IsAlreadyExists(err), should.BeTrue)
+
+	// Captures of other gateways and files outside of the capture directory cannot be retrieved.
+	for _, req := range []*ttnpb.GetGatewayTrafficCaptureRequest{
+		{GatewayIdentifiers: otherIDs, FileName: res.FileName},
+		{GatewayIdentifiers: ids, FileName: unique.ID(ctx, ids) + "-/../" + res.FileName},
+	} {
+		_, err = gs.GetGatewayTrafficCapture(ctx, req)
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+
+	// The capture is available when it is stopped.
+	var capt *ttnpb.GatewayTrafficCapture
+	for i := 0; i < 10; i++ {
+		time.Sleep(duration)
+		capt, err = gs.GetGatewayTrafficCapture(ctx, &ttnpb.GetGatewayTrafficCaptureRequest{
+			GatewayIdentifiers: ids,
+			FileName:           res.FileName,
+		})
+		if err == nil {
+			break
+		}
+	}
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(capt.FileName, should.Equal, res.FileName)
+	r, err := capture.NewReader(bytes.NewReader(capt.Data))
+	if a.So(err, should.BeNil) {
+		a.So(r.Header().GatewayIdentifiers, should.Resemble, ids)
+	}
+
+	// A new capture can be started when the previous capture is stopped.
+	_, err = gs.CaptureGatewayTraffic(ctx, &ttnpb.CaptureGatewayTrafficRequest{
+		GatewayIdentifiers: ids,
+		Duration:           duration,
+	})
+	a.So(err, should.BeNil)
+}
@@ -37,6 +37,20 @@ type BasicStationConfig struct {
 	WSPingInterval          time.Duration `name:"ws-ping-interval" description:"Interval to send WS ping messages"`
 }
 
+// CaptureConfig defines the gateway traffic capture configuration of the Gateway Server.
+type CaptureConfig struct {
+	Directory   string        `name:"directory" description:"Directory to write gateway traffic captures to (disabled when empty)"`
+	MaxDuration time.Duration `name:"max-duration" description:"Maximum duration of a gateway traffic capture"`
+	Bucket      string        `name:"bucket" description:"Blob bucket to upload stopped gateway traffic captures to, so that they can be retrieved from any Gateway Server instance (disabled when empty)"`
+}
+
+// TrafficStatsConfig defines the gateway traffic stats configuration of the Gateway Server.
+type TrafficStatsConfig struct {
+	Registry  GatewayTrafficStatsRegistry `name:"-"`
+	Period    time.Duration               `name:"period" description:"Period over which the gateway traffic stats are aggregated"`
+	Retention time.Duration               `name:"retention" description:"Time to retain the gateway traffic stats"`
+}
+
 // Config represents the Gateway Server configuration.
 type Config struct {
 	RequireRegisteredGateways         bool          `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
@@ -51,6 +65,9 @@ type Config struct {
 	MQTTV2       config.MQTT        `name:"mqtt-v2"`
 	UDP          UDPConfig          `name:"udp"`
 	BasicStation BasicStationConfig `name:"basic-station"`
+
+	Capture      CaptureConfig      `name:"capture"`
+	TrafficStats TrafficStatsConfig `name:"traffic-stats"`
 }
 
 // ForwardDevAddrPrefixes parses the configured forward map.
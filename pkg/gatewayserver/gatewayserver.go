@@ -71,6 +71,7 @@ type GatewayServer struct {
 	upstreamHandlers map[string]upstream.Handler
 
 	connections sync.Map // string to connectionEntry
+	captures    sync.Map // string to *captureEntry
 
 	statsRegistry                     GatewayConnectionStatsRegistry
 	updateConnectionStatsDebounceTime time.Duration
@@ -447,12 +448,25 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		case <-existingConnEntry.upstreamDone:
 		}
 	}
+	// If the frontend can claim downlinks, it claims the gateway identifiers when a downlink path is available.
+	// Otherwise, claim the gateway identifiers on connection, so that the cluster routes downlink to this instance.
+	if !frontend.SupportsDownlinkClaim() {
+		if err := gs.ClaimIDs(ctx, ids); err != nil {
+			logger.WithError(err).Warn("Failed to claim gateway identifiers")
+		}
+	}
+	if existing, ok := gs.captures.Load(uid); ok {
+		conn.SetCapture(existing.(*captureEntry).writer)
+	}
 	registerGatewayConnect(ctx, ids, frontend.Protocol())
 	logger.Info("Connected")
 	go gs.handleUpstream(connEntry)
 	if gs.statsRegistry != nil {
 		go gs.updateConnStats(connEntry)
 	}
+	if gs.trafficStatsEnabled() {
+		go gs.handleTrafficStats(connEntry)
+	}
 	if gtw.UpdateLocationFromStatus {
 		go gs.handleLocationUpdates(connEntry)
 	}
@@ -513,6 +527,11 @@ func (gs *GatewayServer) handleUpstream(conn connectionEntry) {
 	)
 	defer func() {
 		gs.connections.Delete(unique.ID(ctx, gtw.GatewayIdentifiers))
+		if !conn.Frontend().SupportsDownlinkClaim() {
+			if err := gs.UnclaimIDs(ctx, gtw.GatewayIdentifiers); err != nil {
+				logger.WithError(err).Warn("Failed to unclaim gateway identifiers")
+			}
+		}
 		registerGatewayDisconnect(ctx, gtw.GatewayIdentifiers, protocol)
 		logger.Info("Disconnected")
 		close(conn.upstreamDone)
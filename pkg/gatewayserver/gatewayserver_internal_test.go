@@ -15,3 +15,5 @@
 package gatewayserver
 
 var ErrSchedule = errSchedule
+
+var MergeTrafficStats = mergeTrafficStats
@@ -349,6 +349,7 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 			logger.WithError(err).Debug("Failed to read message")
 			return err
 		}
+		conn.CaptureRaw(time.Now(), "", data)
 
 		typ, err := messages.Type(data)
 		if err != nil {
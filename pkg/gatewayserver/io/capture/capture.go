@@ -0,0 +1,128 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package capture implements the file format of gateway traffic captures.
+//
+// A capture file consists of JSON lines. The first line is the Header, all subsequent lines are Frames.
+package capture
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// Header is the header of a capture file.
+type Header struct {
+	GatewayIdentifiers ttnpb.GatewayIdentifiers `json:"gateway_ids"`
+	StartedAt          time.Time                `json:"started_at"`
+}
+
+// Frame is raw traffic received from a gateway.
+type Frame struct {
+	// Time is the time when the Gateway Server received the frame.
+	Time time.Time `json:"time"`
+	// Protocol is the protocol of the frontend that received the frame.
+	Protocol string `json:"protocol"`
+	// Topic is the topic on which the frame was received, if the protocol uses topics.
+	Topic string `json:"topic,omitempty"`
+	// Payload is the raw frame.
+	Payload []byte `json:"payload"`
+}
+
+// Writer writes a capture.
+type Writer struct {
+	mu     sync.Mutex
+	enc    *json.Encoder
+	closer io.Closer
+	closed bool
+}
+
+// NewWriter returns a new Writer that writes the given header and subsequent frames to w.
+// If w is an io.Closer, it is closed when the Writer is closed.
+func NewWriter(w io.Writer, header Header) (*Writer, error) {
+	enc := json.NewEncoder(w)
+	if err := enc.Encode(header); err != nil {
+		return nil, err
+	}
+	closer, _ := w.(io.Closer)
+	return &Writer{
+		enc:    enc,
+		closer: closer,
+	}, nil
+}
+
+// Write writes the frame. Frames that are written after the Writer is closed are discarded.
+// Write is safe for concurrent use.
+func (w *Writer) Write(frame Frame) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	return w.enc.Encode(frame)
+}
+
+// Close closes the Writer.
+// Close is safe for concurrent use with Write.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if w.closer != nil {
+		return w.closer.Close()
+	}
+	return nil
+}
+
+var errInvalidHeader = errors.DefineInvalidArgument("invalid_header", "invalid capture header")
+
+// Reader reads a capture.
+type Reader struct {
+	header Header
+	dec    *json.Decoder
+}
+
+// NewReader returns a new Reader that reads the header and subsequent frames from r.
+func NewReader(r io.Reader) (*Reader, error) {
+	dec := json.NewDecoder(bufio.NewReader(r))
+	var header Header
+	if err := dec.Decode(&header); err != nil {
+		return nil, errInvalidHeader.WithCause(err)
+	}
+	return &Reader{
+		header: header,
+		dec:    dec,
+	}, nil
+}
+
+// Header returns the header of the capture.
+func (r *Reader) Header() Header { return r.header }
+
+// Read reads the next frame. Read returns io.EOF when there are no more frames.
+func (r *Reader) Read() (*Frame, error) {
+	frame := &Frame{}
+	if err := r.dec.Decode(frame); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
@@ -0,0 +1,81 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capture_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/capture"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestCapture(t *testing.T) {
+	a := assertions.New(t)
+
+	start := time.Unix(1580000000, 0).UTC()
+	header := Header{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "foo-gateway"},
+		StartedAt:          start,
+	}
+	frames := []Frame{
+		{
+			Time:     start.Add(time.Second),
+			Protocol: "udp",
+			Payload:  []byte{0x02, 0x01, 0x02, 0x00},
+		},
+		{
+			Time:     start.Add(2 * time.Second),
+			Protocol: "mqtt",
+			Topic:    "v3/foo-gateway/up",
+			Payload:  []byte{0x0a, 0x00},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, header)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	for _, frame := range frames {
+		a.So(w.Write(frame), should.BeNil)
+	}
+	a.So(w.Close(), should.BeNil)
+	// Frames that are written after the writer is closed are discarded.
+	a.So(w.Write(frames[0]), should.BeNil)
+
+	r, err := NewReader(buf)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(r.Header(), should.Resemble, header)
+	for _, expected := range frames {
+		frame, err := r.Read()
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(*frame, should.Resemble, expected)
+	}
+	_, err = r.Read()
+	a.So(err, should.Equal, io.EOF)
+
+	_, err = NewReader(bytes.NewBufferString("invalid"))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
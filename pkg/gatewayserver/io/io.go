@@ -26,6 +26,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/errorcontext"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/capture"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/scheduling"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -72,6 +73,7 @@ type Connection struct {
 	lastUplinkTime,
 	lastDownlinkTime int64
 	lastStatus atomic.Value
+	capture    atomic.Value
 
 	ctx       context.Context
 	cancelCtx errorcontext.CancelFunc
@@ -83,6 +85,7 @@ type Connection struct {
 	fps        *frequencyplans.Store
 	scheduler  *scheduling.Scheduler
 	rtts       *rtts
+	traffic    *trafficStats
 
 	upCh     chan *ttnpb.GatewayUplinkMessage
 	downCh   chan *ttnpb.DownlinkMessage
@@ -136,6 +139,7 @@ func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gatewa
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now()
 	return &Connection{
 		ctx:       ctx,
 		cancelCtx: cancelCtx,
@@ -147,12 +151,13 @@ func NewConnection(ctx context.Context, frontend Frontend, gateway *ttnpb.Gatewa
 		fps:         fps,
 		scheduler:   scheduler,
 		rtts:        newRTTs(maxRTTs),
+		traffic:     newTrafficStats(now),
 		upCh:        make(chan *ttnpb.GatewayUplinkMessage, bufferSize),
 		downCh:      make(chan *ttnpb.DownlinkMessage, bufferSize),
 		statusCh:    make(chan *ttnpb.GatewayStatus, bufferSize),
 		txAckCh:     make(chan *ttnpb.TxAcknowledgment, bufferSize),
 		locCh:       make(chan struct{}, 1),
-		connectTime: time.Now().UnixNano(),
+		connectTime: now.UnixNano(),
 
 		statsChangedCh: make(chan struct{}, 1),
 	}, nil
@@ -224,6 +229,7 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
 	case c.upCh <- msg:
+		c.traffic.RecordUp(up.Settings)
 		atomic.AddUint64(&c.uplinks, 1)
 		atomic.StoreInt64(&c.lastUplinkTime, up.ReceivedAt.UnixNano())
 		c.notifyStatsChanged()
@@ -239,6 +245,7 @@ func (c *Connection) HandleStatus(status *ttnpb.GatewayStatus) error {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
 	case c.statusCh <- status:
+		c.traffic.RecordStatus(status)
 		c.lastStatus.Store(deepcopy.Copy(status))
 		atomic.StoreInt64(&c.lastStatusTime, time.Now().UnixNano())
 		c.notifyStatsChanged()
@@ -268,6 +275,33 @@ func (c *Connection) HandleTxAck(ack *ttnpb.TxAcknowledgment) error {
 	return nil
 }
 
+type captureWriter struct {
+	*capture.Writer
+}
+
+// SetCapture sets the capture writer to which raw traffic is written.
+// Setting a nil writer stops capturing.
+func (c *Connection) SetCapture(w *capture.Writer) {
+	c.capture.Store(captureWriter{w})
+}
+
+// CaptureRaw writes the given raw traffic to the capture writer, if any.
+// The topic is optional and should only be set if the frontend uses topics.
+func (c *Connection) CaptureRaw(receivedAt time.Time, topic string, payload []byte) {
+	w, ok := c.capture.Load().(captureWriter)
+	if !ok || w.Writer == nil {
+		return
+	}
+	if err := w.Write(capture.Frame{
+		Time:     receivedAt,
+		Protocol: c.frontend.Protocol(),
+		Topic:    topic,
+		Payload:  payload,
+	}); err != nil {
+		log.FromContext(c.ctx).WithError(err).Warn("Failed to capture traffic")
+	}
+}
+
 // RecordRTT records the given round-trip time.
 func (c *Connection) RecordRTT(d time.Duration) {
 	c.rtts.Record(d)
@@ -312,6 +346,9 @@ func (c *Connection) SendDown(msg *ttnpb.DownlinkMessage) error {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
 	case c.downCh <- msg:
+		if scheduled := msg.GetScheduled(); scheduled != nil {
+			c.traffic.RecordDown(*scheduled)
+		}
 		atomic.AddUint64(&c.downlinks, 1)
 		atomic.StoreInt64(&c.lastDownlinkTime, time.Now().UnixNano())
 
@@ -604,6 +641,30 @@ func (c *Connection) Stats() *ttnpb.GatewayConnectionStats {
 	return stats
 }
 
+// TrafficStats returns the traffic statistics since the previous call, or since the connection was established, and
+// resets the traffic counters.
+func (c *Connection) TrafficStats() *ttnpb.GatewayTrafficStats {
+	stats := c.traffic.Flush(time.Now())
+	for _, sb := range c.scheduler.SubBands() {
+		stats.SubBands = append(stats.SubBands, &ttnpb.GatewayTrafficStats_SubBandStats{
+			MinFrequency:        sb.MinFrequency,
+			MaxFrequency:        sb.MaxFrequency,
+			DownlinkUtilization: sb.DutyCycleUtilization(),
+		})
+	}
+	if min, max, median, count := c.RTTStats(); count > 0 {
+		stats.RoundTripTimes = &ttnpb.GatewayTrafficStats_RoundTripTimes{
+			Min:    min,
+			Median: median,
+			P90:    c.rtts.Percentile(90),
+			P99:    c.rtts.Percentile(99),
+			Max:    max,
+			Count:  uint32(count),
+		}
+	}
+	return stats
+}
+
 // FrequencyPlans returns the frequency plans for the gateway.
 func (c *Connection) FrequencyPlans() map[string]*frequencyplans.FrequencyPlan { return c.gatewayFPs }
 
@@ -72,3 +72,19 @@ func (r *rtts) Stats() (min, max, median time.Duration, count int) {
 	count = len(sorted)
 	return
 }
+
+// Percentile returns the p-th percentile of the recorded round-trip times, using the nearest-rank method.
+func (r *rtts) Percentile(p int) time.Duration {
+	r.mu.RLock()
+	sorted := append(make([]time.Duration, 0, len(r.items)), r.items...)
+	r.mu.RUnlock()
+	if len(sorted) == 0 {
+		return 0
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
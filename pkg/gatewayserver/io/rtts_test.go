@@ -60,3 +60,17 @@ func TestRTTs(t *testing.T) {
 	a.So(median, should.Equal, 5*time.Second)
 	a.So(count, should.Equal, 5)
 }
+
+func TestRTTsPercentile(t *testing.T) {
+	a := assertions.New(t)
+
+	rtts := newRTTs(10)
+	a.So(rtts.Percentile(90), should.Equal, 0)
+
+	for i := 1; i <= 10; i++ {
+		rtts.Record(time.Duration(i) * time.Second)
+	}
+	a.So(rtts.Percentile(50), should.Equal, 5*time.Second)
+	a.So(rtts.Percentile(90), should.Equal, 9*time.Second)
+	a.So(rtts.Percentile(99), should.Equal, 10*time.Second)
+}
@@ -0,0 +1,120 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type channelKey struct {
+	frequency     uint64
+	dataRateIndex ttnpb.DataRateIndex
+}
+
+// trafficStats aggregates the traffic of a gateway connection.
+type trafficStats struct {
+	mu        sync.Mutex
+	start     time.Time
+	uplinks   uint64
+	downlinks uint64
+	crcErrors uint64
+	channels  map[channelKey]*ttnpb.GatewayTrafficStats_ChannelStats
+}
+
+func newTrafficStats(start time.Time) *trafficStats {
+	return &trafficStats{
+		start:    start,
+		channels: make(map[channelKey]*ttnpb.GatewayTrafficStats_ChannelStats),
+	}
+}
+
+// channel returns the channel stats for the given settings. The caller must hold the lock.
+func (s *trafficStats) channel(settings ttnpb.TxSettings) *ttnpb.GatewayTrafficStats_ChannelStats {
+	key := channelKey{
+		frequency:     settings.Frequency,
+		dataRateIndex: settings.DataRateIndex,
+	}
+	ch, ok := s.channels[key]
+	if !ok {
+		ch = &ttnpb.GatewayTrafficStats_ChannelStats{
+			Frequency:     key.frequency,
+			DataRateIndex: key.dataRateIndex,
+		}
+		s.channels[key] = ch
+	}
+	return ch
+}
+
+// RecordUp records an uplink message received with the given settings.
+func (s *trafficStats) RecordUp(settings ttnpb.TxSettings) {
+	s.mu.Lock()
+	s.uplinks++
+	s.channel(settings).UplinkCount++
+	s.mu.Unlock()
+}
+
+// RecordDown records a downlink message transmitted with the given settings.
+func (s *trafficStats) RecordDown(settings ttnpb.TxSettings) {
+	s.mu.Lock()
+	s.downlinks++
+	s.channel(settings).DownlinkCount++
+	s.mu.Unlock()
+}
+
+// RecordStatus records the CRC errors reported in the gateway status.
+// The gateway reports the number of received packets (rxin) and the number of packets with a valid CRC (rxok) since
+// the previous status message.
+func (s *trafficStats) RecordStatus(status *ttnpb.GatewayStatus) {
+	rxIn, okIn := status.Metrics["rxin"]
+	rxOK, okOK := status.Metrics["rxok"]
+	if !okIn || !okOK || rxIn <= rxOK {
+		return
+	}
+	s.mu.Lock()
+	s.crcErrors += uint64(rxIn - rxOK)
+	s.mu.Unlock()
+}
+
+// Flush returns the traffic stats since the previous flush and resets the counters.
+func (s *trafficStats) Flush(end time.Time) *ttnpb.GatewayTrafficStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats := &ttnpb.GatewayTrafficStats{
+		Start:         s.start,
+		End:           end,
+		UplinkCount:   s.uplinks,
+		DownlinkCount: s.downlinks,
+		CRCErrorCount: s.crcErrors,
+		Channels:      make([]*ttnpb.GatewayTrafficStats_ChannelStats, 0, len(s.channels)),
+	}
+	for _, ch := range s.channels {
+		stats.Channels = append(stats.Channels, ch)
+	}
+	sort.Slice(stats.Channels, func(i, j int) bool {
+		a, b := stats.Channels[i], stats.Channels[j]
+		if a.Frequency != b.Frequency {
+			return a.Frequency < b.Frequency
+		}
+		return a.DataRateIndex < b.DataRateIndex
+	})
+	s.start = end
+	s.uplinks, s.downlinks, s.crcErrors = 0, 0, 0
+	s.channels = make(map[channelKey]*ttnpb.GatewayTrafficStats_ChannelStats)
+	return stats
+}
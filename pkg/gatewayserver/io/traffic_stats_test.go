@@ -0,0 +1,76 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestTrafficStats(t *testing.T) {
+	a := assertions.New(t)
+
+	start := time.Unix(1580000000, 0)
+	stats := newTrafficStats(start)
+
+	stats.RecordUp(ttnpb.TxSettings{Frequency: 868300000, DataRateIndex: ttnpb.DATA_RATE_5})
+	stats.RecordUp(ttnpb.TxSettings{Frequency: 868300000, DataRateIndex: ttnpb.DATA_RATE_5})
+	stats.RecordUp(ttnpb.TxSettings{Frequency: 868100000, DataRateIndex: ttnpb.DATA_RATE_0})
+	stats.RecordDown(ttnpb.TxSettings{Frequency: 869525000, DataRateIndex: ttnpb.DATA_RATE_0})
+	stats.RecordStatus(&ttnpb.GatewayStatus{
+		Metrics: map[string]float32{
+			"rxin": 10,
+			"rxok": 7,
+		},
+	})
+	stats.RecordStatus(&ttnpb.GatewayStatus{})
+
+	end := start.Add(time.Hour)
+	a.So(stats.Flush(end), should.Resemble, &ttnpb.GatewayTrafficStats{
+		Start:         start,
+		End:           end,
+		UplinkCount:   3,
+		DownlinkCount: 1,
+		CRCErrorCount: 3,
+		Channels: []*ttnpb.GatewayTrafficStats_ChannelStats{
+			{
+				Frequency:     868100000,
+				DataRateIndex: ttnpb.DATA_RATE_0,
+				UplinkCount:   1,
+			},
+			{
+				Frequency:     868300000,
+				DataRateIndex: ttnpb.DATA_RATE_5,
+				UplinkCount:   2,
+			},
+			{
+				Frequency:     869525000,
+				DataRateIndex: ttnpb.DATA_RATE_0,
+				DownlinkCount: 1,
+			},
+		},
+	})
+
+	next := end.Add(time.Hour)
+	a.So(stats.Flush(next), should.Resemble, &ttnpb.GatewayTrafficStats{
+		Start:    end,
+		End:      next,
+		Channels: []*ttnpb.GatewayTrafficStats_ChannelStats{},
+	})
+}
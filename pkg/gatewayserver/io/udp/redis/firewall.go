@@ -0,0 +1,124 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis provides a Redis implementation of the UDP frontend firewall.
+package redis
+
+import (
+	"time"
+
+	"github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	encoding "go.thethings.network/lorawan-stack/pkg/ttnpb/udp"
+)
+
+// Firewall is a Firewall that keeps its state in Redis, so that it can be shared by multiple Gateway Server instances.
+type Firewall struct {
+	Redis *ttnredis.Client
+
+	addrChangeBlock time.Duration
+	rateLimiting    udp.RateLimitingConfig
+}
+
+// NewFirewall returns a new Firewall backed by Redis.
+// Address changes are blocked for addrChangeBlock, if it is non-zero. Rate limiting is applied if enabled.
+func NewFirewall(cl *ttnredis.Client, addrChangeBlock time.Duration, rateLimiting udp.RateLimitingConfig) *Firewall {
+	return &Firewall{
+		Redis:           cl,
+		addrChangeBlock: addrChangeBlock,
+		rateLimiting:    rateLimiting,
+	}
+}
+
+var (
+	errNoEUI            = errors.DefineInvalidArgument("no_eui", "packet has no gateway EUI")
+	errNoAddress        = errors.DefineInvalidArgument("no_address", "packet has no gateway address")
+	errAlreadyConnected = errors.DefineFailedPrecondition("already_connected", "gateway is already connected")
+	errRateExceeded     = errors.DefineResourceExhausted("rate_exceeded", "gateway traffic exceeded allowed rate")
+)
+
+// rateLimitScript pushes the timestamp in ARGV[1] to the list in KEYS[1], which retains at most ARGV[2] + 1 timestamps.
+// It returns the oldest timestamp if the list is full, and 0 otherwise. The list expires after ARGV[3] milliseconds.
+var rateLimitScript = redis.NewScript(`local n = tonumber(ARGV[2])
+redis.call('lpush', KEYS[1], ARGV[1])
+redis.call('ltrim', KEYS[1], 0, n)
+redis.call('pexpire', KEYS[1], ARGV[3])
+local oldest = redis.call('lindex', KEYS[1], n)
+if oldest then
+	return oldest
+end
+return 0`)
+
+// addrScript sets the address in KEYS[1] to ARGV[1] with an expiry of ARGV[2] milliseconds, unless a different
+// address is already set. It returns the address that is already set, or false if the address has been set.
+var addrScript = redis.NewScript(`local cur = redis.call('get', KEYS[1])
+if cur and cur ~= ARGV[1] then
+	return cur
+end
+redis.call('set', KEYS[1], ARGV[1], 'px', ARGV[2])
+return false`)
+
+func (f *Firewall) addrKey(eui string) string {
+	return f.Redis.Key("addr", "eui", eui)
+}
+
+func (f *Firewall) rateKey(eui string) string {
+	return f.Redis.Key("rate", "eui", eui)
+}
+
+// Filter implements udp.Firewall.
+func (f *Firewall) Filter(packet encoding.Packet) error {
+	if packet.GatewayEUI == nil {
+		return errNoEUI.New()
+	}
+	if packet.GatewayAddr == nil {
+		return errNoAddress.New()
+	}
+	now := time.Now().UTC()
+	eui := packet.GatewayEUI.String()
+
+	if f.rateLimiting.Enable && f.rateLimiting.Messages > 0 {
+		expiry := f.rateLimiting.Threshold
+		if expiry < time.Millisecond {
+			expiry = time.Millisecond
+		}
+		v, err := rateLimitScript.Run(f.Redis, []string{f.rateKey(eui)},
+			now.UnixNano(), f.rateLimiting.Messages, expiry.Milliseconds(),
+		).Int64()
+		if err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		if v != 0 && now.Sub(time.Unix(0, v)) < f.rateLimiting.Threshold {
+			return errRateExceeded.New()
+		}
+	}
+
+	if f.addrChangeBlock > 0 {
+		ip := packet.GatewayAddr.IP.String()
+		cur, err := addrScript.Run(f.Redis, []string{f.addrKey(eui)}, ip, f.addrChangeBlock.Milliseconds()).String()
+		switch {
+		case err == redis.Nil:
+		case err != nil:
+			return ttnredis.ConvertError(err)
+		default:
+			return errAlreadyConnected.WithAttributes(
+				"connected_ip", cur,
+				"connecting_ip", ip,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
+	. "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp/redis"
+	encoding "go.thethings.network/lorawan-stack/pkg/ttnpb/udp"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func isNoError(err error) bool { return err == nil }
+
+func TestFirewallAddressChange(t *testing.T) {
+	cl, flush := test.NewRedis(t, "gatewayserver_io_udp_redis")
+	defer flush()
+	defer cl.Close()
+
+	block := 10 * test.Delay
+	// Two instances share the same Redis namespace, as two Gateway Server instances behind a load balancer would.
+	f1 := NewFirewall(cl, block, udp.RateLimitingConfig{})
+	f2 := NewFirewall(cl, block, udp.RateLimitingConfig{})
+
+	eui1 := types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	eui2 := types.EUI64{0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02}
+	addr1 := net.UDPAddr{
+		IP:   []byte{0x01, 0x01, 0x01, 0x01},
+		Port: 1,
+	}
+	addr2 := net.UDPAddr{
+		IP:   []byte{0x02, 0x02, 0x02, 0x02},
+		Port: 1,
+	}
+	addr3 := net.UDPAddr{
+		IP:   []byte{0x03, 0x03, 0x03, 0x03},
+		Port: 3,
+	}
+
+	for i, tc := range []struct {
+		Firewall   udp.Firewall
+		Packet     encoding.Packet
+		ErrorCheck func(error) bool
+		WaitAfter  time.Duration
+	}{
+		{
+			Firewall: f1,
+			Packet: encoding.Packet{
+				GatewayAddr: &addr1,
+				PacketType:  encoding.PullData,
+			},
+			ErrorCheck: errors.IsInvalidArgument, // no EUI
+		},
+		{
+			Firewall: f1,
+			Packet: encoding.Packet{
+				GatewayEUI: &eui1,
+				PacketType: encoding.PullData,
+			},
+			ErrorCheck: errors.IsInvalidArgument, // no address
+		},
+		{
+			Firewall: f1,
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui1,
+				GatewayAddr: &addr1,
+				PacketType:  encoding.PullData,
+			},
+			ErrorCheck: isNoError, // first time 1 on instance 1
+		},
+		{
+			Firewall: f2,
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui1,
+				GatewayAddr: &addr1,
+				PacketType:  encoding.PushData,
+			},
+			ErrorCheck: isNoError, // 1 with same address on instance 2
+		},
+		{
+			Firewall: f2,
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui1,
+				GatewayAddr: &addr3,
+				PacketType:  encoding.PushData,
+			},
+			ErrorCheck: errors.IsFailedPrecondition, // spoofed 1 on instance 2
+		},
+		{
+			Firewall: f2,
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui2,
+				GatewayAddr: &addr2,
+				PacketType:  encoding.PullData,
+			},
+			ErrorCheck: isNoError, // first time 2 on instance 2
+		},
+		{
+			Firewall: f1,
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui2,
+				GatewayAddr: &addr3,
+				PacketType:  encoding.PullData,
+			},
+			WaitAfter:  block * 2,
+			ErrorCheck: errors.IsFailedPrecondition, // block change of address of 2 on instance 1
+		},
+		{
+			Firewall: f1,
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui2,
+				GatewayAddr: &addr3,
+				PacketType:  encoding.PullData,
+			},
+			ErrorCheck: isNoError, // permit change of address after block time
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			a := assertions.New(t)
+
+			err := tc.Firewall.Filter(tc.Packet)
+			if !a.So(tc.ErrorCheck(err), should.BeTrue) {
+				t.FailNow()
+			}
+
+			time.Sleep(tc.WaitAfter)
+		})
+	}
+}
+
+func TestFirewallRateLimiting(t *testing.T) {
+	cl, flush := test.NewRedis(t, "gatewayserver_io_udp_redis")
+	defer flush()
+	defer cl.Close()
+
+	eui := &types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	addr := &net.UDPAddr{
+		IP:   []byte{0x01, 0x01, 0x01, 0x01},
+		Port: 1,
+	}
+	rateLimiting := udp.RateLimitingConfig{
+		Enable:    true,
+		Messages:  3,
+		Threshold: time.Hour,
+	}
+	f1 := NewFirewall(cl, time.Hour, rateLimiting)
+	f2 := NewFirewall(cl, time.Hour, rateLimiting)
+
+	a := assertions.New(t)
+	// The rate limit is shared among instances, so that it does not multiply by the number of instances.
+	for i, f := range []udp.Firewall{f1, f2, f1} {
+		err := f.Filter(encoding.Packet{
+			GatewayEUI:  eui,
+			GatewayAddr: addr,
+		})
+		if !a.So(err, should.BeNil) {
+			t.Fatalf("Unexpected error on packet %d: %v", i, err)
+		}
+	}
+	err := f2.Filter(encoding.Packet{
+		GatewayEUI:  eui,
+		GatewayAddr: addr,
+	})
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+}
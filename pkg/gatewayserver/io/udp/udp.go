@@ -64,6 +64,11 @@ type Config struct {
 	AddrChangeBlock time.Duration `name:"addr-change-block" description:"Time to block traffic when a gateway's address changes"`
 	// RateLimitingConfig is the configuration for the rate limiting firewall capabilities.
 	RateLimiting RateLimitingConfig `name:"rate-limiting"`
+	// FirewallBackend defines where the firewall keeps its state. When the state is kept in Redis, it is shared by all
+	// Gateway Server instances.
+	FirewallBackend string `name:"firewall-backend" description:"Backend of the firewall state (memory, redis)"`
+	// Firewall is the firewall that is used instead of the in-memory firewall, if set.
+	Firewall Firewall `name:"-"`
 }
 
 // DefaultConfig contains the default configuration.
@@ -79,6 +84,13 @@ var DefaultConfig = Config{
 		Messages:  10,
 		Threshold: 10 * time.Millisecond,
 	},
+	FirewallBackend: "memory",
+}
+
+// rawPacket is a decoded packet with the raw datagram it was decoded from.
+type rawPacket struct {
+	encoding.Packet
+	raw []byte
 }
 
 type srv struct {
@@ -87,7 +99,7 @@ type srv struct {
 
 	server      io.Server
 	conn        *net.UDPConn
-	packetCh    chan encoding.Packet
+	packetCh    chan rawPacket
 	connections sync.Map
 	firewall    Firewall
 }
@@ -95,24 +107,34 @@ type srv struct {
 func (*srv) Protocol() string            { return "udp" }
 func (*srv) SupportsDownlinkClaim() bool { return true }
 
-var errUDPFrontendRecovered = errors.DefineInternal("udp_frontend_recovered", "internal server error")
+var (
+	errUDPFrontendRecovered = errors.DefineInternal("udp_frontend_recovered", "internal server error")
+	errFirewallBackend      = errors.DefineInvalidArgument("firewall_backend", "firewall backend `{backend}` is not configured")
+)
 
 // Serve serves the UDP frontend.
 func Serve(ctx context.Context, server io.Server, conn *net.UDPConn, config Config) error {
 	ctx = log.NewContextWithField(ctx, "namespace", "gatewayserver/io/udp")
-	var firewall Firewall
-	if config.AddrChangeBlock > 0 {
-		firewall = NewMemoryFirewall(ctx, config.AddrChangeBlock)
-	}
-	if config.RateLimiting.Enable == true {
-		firewall = NewRateLimitingFirewall(firewall, config.RateLimiting.Messages, config.RateLimiting.Threshold)
+	firewall := config.Firewall
+	if firewall == nil {
+		switch config.FirewallBackend {
+		case "", "memory":
+			if config.AddrChangeBlock > 0 {
+				firewall = NewMemoryFirewall(ctx, config.AddrChangeBlock)
+			}
+			if config.RateLimiting.Enable == true {
+				firewall = NewRateLimitingFirewall(firewall, config.RateLimiting.Messages, config.RateLimiting.Threshold)
+			}
+		default:
+			return errFirewallBackend.WithAttributes("backend", config.FirewallBackend)
+		}
 	}
 	s := &srv{
 		ctx:      ctx,
 		config:   config,
 		server:   server,
 		conn:     conn,
-		packetCh: make(chan encoding.Packet, config.PacketBuffer),
+		packetCh: make(chan rawPacket, config.PacketBuffer),
 		firewall: firewall,
 	}
 	go s.gc()
@@ -168,7 +190,7 @@ func (s *srv) read() (err error) {
 		}
 
 		select {
-		case s.packetCh <- packet:
+		case s.packetCh <- rawPacket{Packet: packet, raw: packetBuf}:
 		default:
 			log.FromContext(ctx).Warn("Packet handlers busy, dropping packet")
 		}
@@ -182,7 +204,8 @@ func (s *srv) handlePackets() {
 		case <-s.ctx.Done():
 			return
 
-		case packet := <-s.packetCh:
+		case raw := <-s.packetCh:
+			packet := raw.Packet
 			eui := *packet.GatewayEUI
 			ctx := log.NewContextWithField(s.ctx, "gateway_eui", eui)
 			logger := log.FromContext(ctx)
@@ -207,6 +230,7 @@ func (s *srv) handlePackets() {
 				break
 			}
 
+			cs.io.CaptureRaw(packet.ReceivedAt, "", raw.raw)
 			s.handleUp(cs.io.Context(), cs, packet)
 		}
 	}
@@ -0,0 +1,107 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// GatewayTrafficStatsRegistry implements the GatewayTrafficStatsRegistry interface.
+// The traffic stats are stored in a sorted set per gateway, scored by the end time of the period.
+type GatewayTrafficStatsRegistry struct {
+	Redis *ttnredis.Client
+	// Retention is the time to retain traffic stats. Zero retains the stats indefinitely.
+	Retention time.Duration
+}
+
+func (r *GatewayTrafficStatsRegistry) key(uid string) string {
+	return r.Redis.Key("traffic", "uid", uid)
+}
+
+// Add adds the traffic stats of a gateway and removes the traffic stats that are past retention.
+func (r *GatewayTrafficStatsRegistry) Add(ctx context.Context, ids ttnpb.GatewayIdentifiers, stats *ttnpb.GatewayTrafficStats) error {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "add gateway traffic stats").End()
+
+	s, err := ttnredis.MarshalProto(stats)
+	if err != nil {
+		return err
+	}
+	k := r.key(uid)
+	_, err = r.Redis.Pipelined(func(p redis.Pipeliner) error {
+		p.ZAdd(k, redis.Z{
+			Score:  float64(stats.End.UnixNano()),
+			Member: s,
+		})
+		if r.Retention > 0 {
+			p.ZRemRangeByScore(k, "-inf", "("+strconv.FormatInt(time.Now().Add(-r.Retention).UnixNano(), 10))
+			p.PExpire(k, r.Retention)
+		}
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Range returns the traffic stats of a gateway of the periods that end after from and start before to.
+func (r *GatewayTrafficStatsRegistry) Range(ctx context.Context, ids ttnpb.GatewayIdentifiers, from, to time.Time) ([]*ttnpb.GatewayTrafficStats, error) {
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "range gateway traffic stats").End()
+
+	k := r.key(uid)
+	toScore := strconv.FormatInt(to.UnixNano(), 10)
+	var inRange, next *redis.StringSliceCmd
+	_, err := r.Redis.Pipelined(func(p redis.Pipeliner) error {
+		inRange = p.ZRangeByScore(k, redis.ZRangeBy{
+			Min: "(" + strconv.FormatInt(from.UnixNano(), 10),
+			Max: toScore,
+		})
+		// The periods do not overlap, so only the first period that ends after to may start before to.
+		next = p.ZRangeByScore(k, redis.ZRangeBy{
+			Min:   "(" + toScore,
+			Max:   "+inf",
+			Count: 1,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	res := append(inRange.Val(), next.Val()...)
+	stats := make([]*ttnpb.GatewayTrafficStats, 0, len(res))
+	for _, s := range res {
+		pb := &ttnpb.GatewayTrafficStats{}
+		if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+			return nil, errInvalidStats.WithAttributes("type", "traffic").WithCause(err)
+		}
+		if !pb.Start.Before(to) {
+			continue
+		}
+		stats = append(stats, pb)
+	}
+	return stats, nil
+}
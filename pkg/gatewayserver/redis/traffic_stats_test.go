@@ -0,0 +1,88 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestTrafficStatsRegistry(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayID: "gtw1",
+	}
+	registry := &GatewayTrafficStatsRegistry{
+		Redis:     cl,
+		Retention: 24 * time.Hour,
+	}
+
+	now := time.Now().UTC().Truncate(time.Hour)
+	var stats []*ttnpb.GatewayTrafficStats
+	for i := 3; i > 0; i-- {
+		stats = append(stats, &ttnpb.GatewayTrafficStats{
+			Start:       now.Add(-time.Duration(i) * time.Hour),
+			End:         now.Add(-time.Duration(i-1) * time.Hour),
+			UplinkCount: uint64(i),
+			Channels: []*ttnpb.GatewayTrafficStats_ChannelStats{
+				{
+					Frequency:     868100000,
+					DataRateIndex: ttnpb.DATA_RATE_5,
+					UplinkCount:   uint64(i),
+				},
+			},
+		})
+	}
+	// Past retention.
+	old := &ttnpb.GatewayTrafficStats{
+		Start:       now.Add(-48 * time.Hour),
+		End:         now.Add(-47 * time.Hour),
+		UplinkCount: 10,
+	}
+	for _, s := range append([]*ttnpb.GatewayTrafficStats{old}, stats...) {
+		if !a.So(registry.Add(ctx, ids, s), should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	res, err := registry.Range(ctx, ids, now.Add(-72*time.Hour), now)
+	a.So(err, should.BeNil)
+	a.So(res, should.Resemble, stats)
+
+	res, err = registry.Range(ctx, ids, now.Add(-2*time.Hour), now.Add(-time.Hour))
+	a.So(err, should.BeNil)
+	a.So(res, should.Resemble, stats[1:2])
+
+	// The period that contains the end of the range is included.
+	res, err = registry.Range(ctx, ids, now.Add(-3*time.Hour), now.Add(-90*time.Minute))
+	a.So(err, should.BeNil)
+	a.So(res, should.Resemble, stats[0:2])
+
+	res, err = registry.Range(ctx, ttnpb.GatewayIdentifiers{GatewayID: "gtw2"}, now.Add(-72*time.Hour), now)
+	a.So(err, should.BeNil)
+	a.So(res, should.BeEmpty)
+}
@@ -16,6 +16,7 @@ package gatewayserver
 
 import (
 	"context"
+	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -27,3 +28,11 @@ type GatewayConnectionStatsRegistry interface {
 	// Set sets or clears the connection stats for a gateway.
 	Set(ctx context.Context, ids ttnpb.GatewayIdentifiers, stats *ttnpb.GatewayConnectionStats, up, down, status bool) error
 }
+
+// GatewayTrafficStatsRegistry stores and retrieves the traffic stats of gateways over time.
+type GatewayTrafficStatsRegistry interface {
+	// Add adds the traffic stats of a gateway over a period.
+	Add(ctx context.Context, ids ttnpb.GatewayIdentifiers, stats *ttnpb.GatewayTrafficStats) error
+	// Range returns the traffic stats of a gateway of the periods that end after from and start before to.
+	Range(ctx context.Context, ids ttnpb.GatewayIdentifiers, from, to time.Time) ([]*ttnpb.GatewayTrafficStats, error)
+}
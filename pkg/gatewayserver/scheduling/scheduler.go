@@ -236,6 +236,11 @@ func (s *Scheduler) SubBandCount() int {
 	return len(s.subBands)
 }
 
+// SubBands returns the sub bands in the scheduler.
+func (s *Scheduler) SubBands() []*SubBand {
+	return append([]*SubBand(nil), s.subBands...)
+}
+
 var (
 	errConflict              = errors.DefineResourceExhausted("conflict", "scheduling conflict")
 	errTooLate               = errors.DefineFailedPrecondition("too_late", "too late to transmission scheduled time (delta is `{delta}`)")
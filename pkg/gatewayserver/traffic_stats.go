@@ -0,0 +1,161 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// defaultTrafficStatsRange is the time range of traffic stats returned when no range is requested.
+const defaultTrafficStatsRange = 24 * time.Hour
+
+var (
+	errTrafficStatsDisabled = errors.DefineFailedPrecondition("traffic_stats_disabled", "gateway traffic stats are disabled")
+	errTrafficStatsRange    = errors.DefineInvalidArgument("traffic_stats_range", "`from` must be before `to`")
+)
+
+type trafficStatsChannelKey struct {
+	frequency     uint64
+	dataRateIndex ttnpb.DataRateIndex
+}
+
+func (gs *GatewayServer) trafficStatsEnabled() bool {
+	return gs.config.TrafficStats.Registry != nil && gs.config.TrafficStats.Period > 0
+}
+
+// handleTrafficStats stores the traffic stats of the connection at the end of every period and on disconnect.
+func (gs *GatewayServer) handleTrafficStats(conn connectionEntry) {
+	ctx := conn.Context()
+	logger := log.FromContext(ctx)
+	period := gs.config.TrafficStats.Period
+
+	add := func() {
+		if err := gs.config.TrafficStats.Registry.Add(ctx, conn.Gateway().GatewayIdentifiers, conn.TrafficStats()); err != nil {
+			logger.WithError(err).Warn("Failed to store traffic stats")
+		}
+	}
+	for {
+		now := time.Now()
+		timer := time.NewTimer(now.Truncate(period).Add(period).Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			add()
+			return
+		case <-timer.C:
+			add()
+		}
+	}
+}
+
+// mergeTrafficStats merges the traffic stats per period. A gateway that reconnects within a period results in multiple
+// traffic stats in that period; the counters are summed and the sub-bands and round-trip times of the last connection
+// are kept. The merged traffic stats are sorted by start time.
+func mergeTrafficStats(stats []*ttnpb.GatewayTrafficStats, period time.Duration) []*ttnpb.GatewayTrafficStats {
+	periods := make(map[time.Time]*ttnpb.GatewayTrafficStats)
+	channels := make(map[time.Time]map[trafficStatsChannelKey]*ttnpb.GatewayTrafficStats_ChannelStats)
+	for _, s := range stats {
+		key := s.Start.Truncate(period)
+		merged, ok := periods[key]
+		if !ok {
+			merged = &ttnpb.GatewayTrafficStats{
+				Start: s.Start,
+				End:   s.End,
+			}
+			periods[key] = merged
+			channels[key] = make(map[trafficStatsChannelKey]*ttnpb.GatewayTrafficStats_ChannelStats)
+		}
+		if s.Start.Before(merged.Start) {
+			merged.Start = s.Start
+		}
+		if !s.End.Before(merged.End) {
+			merged.End = s.End
+			merged.SubBands = s.SubBands
+			merged.RoundTripTimes = s.RoundTripTimes
+		}
+		merged.UplinkCount += s.UplinkCount
+		merged.DownlinkCount += s.DownlinkCount
+		merged.CRCErrorCount += s.CRCErrorCount
+		for _, ch := range s.Channels {
+			chKey := trafficStatsChannelKey{
+				frequency:     ch.Frequency,
+				dataRateIndex: ch.DataRateIndex,
+			}
+			mergedCh, ok := channels[key][chKey]
+			if !ok {
+				mergedCh = &ttnpb.GatewayTrafficStats_ChannelStats{
+					Frequency:     ch.Frequency,
+					DataRateIndex: ch.DataRateIndex,
+				}
+				channels[key][chKey] = mergedCh
+				merged.Channels = append(merged.Channels, mergedCh)
+			}
+			mergedCh.UplinkCount += ch.UplinkCount
+			mergedCh.DownlinkCount += ch.DownlinkCount
+		}
+	}
+	res := make([]*ttnpb.GatewayTrafficStats, 0, len(periods))
+	for _, s := range periods {
+		sort.Slice(s.Channels, func(i, j int) bool {
+			a, b := s.Channels[i], s.Channels[j]
+			if a.Frequency != b.Frequency {
+				return a.Frequency < b.Frequency
+			}
+			return a.DataRateIndex < b.DataRateIndex
+		})
+		res = append(res, s)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Start.Before(res[j].Start)
+	})
+	return res
+}
+
+// GetGatewayTrafficStats returns the traffic stats of the gateway over time.
+func (gs *GatewayServer) GetGatewayTrafficStats(ctx context.Context, req *ttnpb.GetGatewayTrafficStatsRequest) (*ttnpb.GatewayTrafficStatsHistory, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_STATUS_READ); err != nil {
+		return nil, err
+	}
+	if !gs.trafficStatsEnabled() {
+		return nil, errTrafficStatsDisabled.New()
+	}
+
+	to := time.Now()
+	if req.To != nil {
+		to = *req.To
+	}
+	from := to.Add(-defaultTrafficStatsRange)
+	if req.From != nil {
+		from = *req.From
+	}
+	if !from.Before(to) {
+		return nil, errTrafficStatsRange.New()
+	}
+
+	stats, err := gs.config.TrafficStats.Registry.Range(ctx, req.GatewayIdentifiers, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.GatewayTrafficStatsHistory{
+		Stats: mergeTrafficStats(stats, gs.config.TrafficStats.Period),
+	}, nil
+}
@@ -0,0 +1,86 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestMergeTrafficStats(t *testing.T) {
+	a := assertions.New(t)
+
+	start := time.Date(2020, time.April, 1, 12, 0, 0, 0, time.UTC)
+	stats := []*ttnpb.GatewayTrafficStats{
+		{
+			Start:       start.Add(time.Hour),
+			End:         start.Add(2 * time.Hour),
+			UplinkCount: 5,
+			Channels: []*ttnpb.GatewayTrafficStats_ChannelStats{
+				{Frequency: 868300000, DataRateIndex: ttnpb.DATA_RATE_5, UplinkCount: 5},
+			},
+		},
+		{
+			Start:         start,
+			End:           start.Add(20 * time.Minute),
+			UplinkCount:   2,
+			DownlinkCount: 1,
+			Channels: []*ttnpb.GatewayTrafficStats_ChannelStats{
+				{Frequency: 868100000, DataRateIndex: ttnpb.DATA_RATE_5, UplinkCount: 2, DownlinkCount: 1},
+			},
+			RoundTripTimes: &ttnpb.GatewayTrafficStats_RoundTripTimes{Count: 1},
+		},
+		{
+			// Reconnected within the same period.
+			Start:         start.Add(30 * time.Minute),
+			End:           start.Add(time.Hour),
+			UplinkCount:   3,
+			CRCErrorCount: 4,
+			Channels: []*ttnpb.GatewayTrafficStats_ChannelStats{
+				{Frequency: 868300000, DataRateIndex: ttnpb.DATA_RATE_0, UplinkCount: 1},
+				{Frequency: 868100000, DataRateIndex: ttnpb.DATA_RATE_5, UplinkCount: 2},
+			},
+			RoundTripTimes: &ttnpb.GatewayTrafficStats_RoundTripTimes{Count: 2},
+		},
+	}
+
+	a.So(MergeTrafficStats(stats, time.Hour), should.Resemble, []*ttnpb.GatewayTrafficStats{
+		{
+			Start:         start,
+			End:           start.Add(time.Hour),
+			UplinkCount:   5,
+			DownlinkCount: 1,
+			CRCErrorCount: 4,
+			Channels: []*ttnpb.GatewayTrafficStats_ChannelStats{
+				{Frequency: 868100000, DataRateIndex: ttnpb.DATA_RATE_5, UplinkCount: 4, DownlinkCount: 1},
+				{Frequency: 868300000, DataRateIndex: ttnpb.DATA_RATE_0, UplinkCount: 1},
+			},
+			RoundTripTimes: &ttnpb.GatewayTrafficStats_RoundTripTimes{Count: 2},
+		},
+		{
+			Start:       start.Add(time.Hour),
+			End:         start.Add(2 * time.Hour),
+			UplinkCount: 5,
+			Channels: []*ttnpb.GatewayTrafficStats_ChannelStats{
+				{Frequency: 868300000, DataRateIndex: ttnpb.DATA_RATE_5, UplinkCount: 5},
+			},
+		},
+	})
+}
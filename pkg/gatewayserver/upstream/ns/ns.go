@@ -29,8 +29,6 @@ import (
 type Cluster interface {
 	GetPeerConn(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (*grpc.ClientConn, error)
 	WithClusterAuth() grpc.CallOption
-	ClaimIDs(ctx context.Context, ids ttnpb.Identifiers) error
-	UnclaimIDs(ctx context.Context, ids ttnpb.Identifiers) error
 }
 
 // Handler is the upstream handler.
@@ -60,19 +58,9 @@ func (h *Handler) Setup(context.Context) error {
 }
 
 // ConnectGateway implements upstream.Handler.
-func (h *Handler) ConnectGateway(ctx context.Context, ids ttnpb.GatewayIdentifiers, conn *io.Connection) error {
-	// If the frontend can claim downlinks, don't claim automatically on connection.
-	if conn.Frontend().SupportsDownlinkClaim() {
-		return nil
-	}
-	h.cluster.ClaimIDs(ctx, ids)
-	select {
-	case <-ctx.Done():
-		h.cluster.UnclaimIDs(ctx, ids)
-		return ctx.Err()
-	default:
-		return nil
-	}
+// The gateway identifiers are claimed in the cluster by the Gateway Server on connect.
+func (h *Handler) ConnectGateway(context.Context, ttnpb.GatewayIdentifiers, *io.Connection) error {
+	return nil
 }
 
 var errNetworkServerNotFound = errors.DefineNotFound("network_server_not_found", "Network Server not found")
@@ -0,0 +1,97 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/email"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// APIKeyExpiryConfig is the configuration of the reminders for expiring API keys.
+type APIKeyExpiryConfig struct {
+	ReminderBefore time.Duration `name:"reminder-before" description:"Time before the expiry of API keys to send a reminder email (disabled when zero)"`
+	CheckInterval  time.Duration `name:"check-interval" description:"Interval to check for expiring API keys (disabled when zero)"`
+}
+
+// remindExpiringAPIKeys periodically sends reminder emails for API keys that are about to expire.
+func (is *IdentityServer) remindExpiringAPIKeys(ctx context.Context) error {
+	config := is.configFromContext(ctx).APIKeyExpiry
+	ticker := time.NewTicker(config.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-ticker.C:
+			if err := is.notifyExpiringAPIKeys(ctx, now, now.Add(config.ReminderBefore)); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to notify expiring API keys")
+			}
+		}
+	}
+}
+
+// notifyExpiringAPIKeys sends reminder emails to the contacts of the entities of which API keys expire in the
+// given time range. The reminder for each API key is only sent once, also when running multiple Identity Servers.
+func (is *IdentityServer) notifyExpiringAPIKeys(ctx context.Context, expiresAfter, expiresBefore time.Time) error {
+	var expiring []*ttnpb.AuthInfoResponse_APIKeyAccess
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		expiring, err = store.GetAPIKeyStore(db).FindExpiringAPIKeys(ctx, expiresAfter, expiresBefore)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	for _, expiringKey := range expiring {
+		key, entityIDs := expiringKey.APIKey, expiringKey.EntityIDs
+		logger := log.FromContext(ctx).WithFields(log.Fields(
+			"entity_type", entityIDs.EntityType(),
+			"entity_id", entityIDs.IDString(),
+			"api_key_id", key.ID,
+		))
+		// The API key is marked as notified in the same transaction as sending the email, so that the mark is
+		// rolled back if sending fails, and so that other Identity Servers wait until the email is sent.
+		var sent bool
+		err := is.withDatabase(ctx, func(db *gorm.DB) error {
+			first, err := store.GetAPIKeyStore(db).SetAPIKeyExpiryNotified(ctx, key.ID)
+			if err != nil || !first {
+				return err
+			}
+			err = is.SendContactsEmail(ctx, &entityIDs, func(data emails.Data) email.MessageData {
+				return &emails.APIKeyExpiring{Data: data, Identifier: key.PrettyName(), ExpiresAt: *key.ExpiresAt}
+			})
+			if err != nil {
+				return err
+			}
+			sent = true
+			return nil
+		})
+		if err != nil {
+			logger.WithError(err).Warn("Failed to send API key expiry reminder email")
+			continue
+		}
+		if !sent {
+			continue
+		}
+		logger.Info("Sent API key expiry reminder")
+	}
+	return nil
+}
@@ -16,12 +16,33 @@ package identityserver
 
 import (
 	"context"
+	"net"
+	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/auth"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc/peer"
 )
 
-func generateAPIKey(ctx context.Context, name string, rights ...ttnpb.Right) (key *ttnpb.APIKey, token string, err error) {
+var (
+	errAPIKeyExpiresAt = errors.DefineInvalidArgument("api_key_expires_at", "API key expiry time `{expires_at}` is not in the future")
+	errAPIKeyIPRange   = errors.DefineInvalidArgument("api_key_ip_range", "invalid IP address range `{ip_range}`")
+)
+
+func generateAPIKey(ctx context.Context, name string, expiresAt *time.Time, allowedIPRanges []string, rights ...ttnpb.Right) (key *ttnpb.APIKey, token string, err error) {
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", errAPIKeyExpiresAt.WithAttributes("expires_at", expiresAt.Format(time.RFC3339))
+	}
+	var ipRanges []string
+	for _, ipRange := range allowedIPRanges {
+		_, ipNet, err := net.ParseCIDR(ipRange)
+		if err != nil {
+			return nil, "", errAPIKeyIPRange.WithAttributes("ip_range", ipRange).WithCause(err)
+		}
+		ipRanges = append(ipRanges, ipNet.String())
+	}
 	token, err = auth.APIKey.Generate(ctx, "")
 	if err != nil {
 		return nil, "", err
@@ -39,6 +60,72 @@ func generateAPIKey(ctx context.Context, name string, rights ...ttnpb.Right) (ke
 		Key:    hashedKey,
 		Name:   name,
 		Rights: rights,
+
+		ExpiresAt:       expiresAt,
+		AllowedIPRanges: ipRanges,
 	}
 	return key, token, nil
 }
+
+// remoteIP returns the IP address of the client that made the request, or nil if it can not be determined.
+// Requests that come in through the HTTP API or through a reverse proxy have a loopback or proxy peer
+// address, so for those the address is taken from the X-Forwarded-For metadata, skipping the addresses
+// that were added by local or trusted proxies. For requests of which a component from the cluster
+// forwards the credentials, the addresses of the caller that were forwarded by that component are used.
+func remoteIP(ctx context.Context, trustedProxies []*net.IPNet) net.IP {
+	addrs, ok := clusterauth.ForwardedFor(ctx)
+	if !ok {
+		p, ok := peer.FromContext(ctx)
+		if !ok {
+			return nil
+		}
+		if _, ok := p.Addr.(*net.TCPAddr); !ok {
+			return nil
+		}
+		addrs = clusterauth.CallerAddresses(ctx)
+	}
+	for i := len(addrs) - 1; i >= 0; i-- {
+		ip := net.ParseIP(addrs[i])
+		if ip == nil {
+			return nil
+		}
+		if !ip.IsLoopback() && !isTrustedProxy(ip, trustedProxies) {
+			return ip
+		}
+	}
+	return nil
+}
+
+func isTrustedProxy(ip net.IP, trustedProxies []*net.IPNet) bool {
+	for _, trustedProxy := range trustedProxies {
+		if trustedProxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkAPIKeyRestrictions checks that the API key is not expired, and that the request comes from one of
+// the allowed IP address ranges of the API key.
+func checkAPIKeyRestrictions(ctx context.Context, key *ttnpb.APIKey, trustedProxies []*net.IPNet) error {
+	if key.ExpiresAt != nil && key.ExpiresAt.Before(time.Now()) {
+		return errAPIKeyExpired.New()
+	}
+	if len(key.AllowedIPRanges) == 0 {
+		return nil
+	}
+	ip := remoteIP(ctx, trustedProxies)
+	if ip == nil {
+		return errAPIKeyRemoteIPUnknown.New()
+	}
+	for _, ipRange := range key.AllowedIPRanges {
+		_, ipNet, err := net.ParseCIDR(ipRange)
+		if err != nil {
+			continue
+		}
+		if ipNet.Contains(ip) {
+			return nil
+		}
+	}
+	return errAPIKeyIPNotAllowed.WithAttributes("ip", ip.String())
+}
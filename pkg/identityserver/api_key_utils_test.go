@@ -0,0 +1,150 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestCheckAPIKeyRestrictions(t *testing.T) {
+	past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	allowedIPRanges := []string{"192.0.2.0/24", "2001:db8::/32"}
+	_, trustedProxy, _ := net.ParseCIDR("203.0.113.0/24")
+	trustedProxies := []*net.IPNet{trustedProxy}
+
+	withPeer := func(ctx context.Context, addr string) context.Context {
+		return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 1234}})
+	}
+	withForwardedFor := func(ctx context.Context, forwardedFor ...string) context.Context {
+		md := metadata.MD{}
+		for _, v := range forwardedFor {
+			md.Append("x-forwarded-for", v)
+		}
+		return metadata.NewIncomingContext(ctx, md)
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		Context   context.Context
+		Key       *ttnpb.APIKey
+		Assertion func(error) bool
+	}{
+		{
+			Name:    "Unrestricted",
+			Context: test.Context(),
+			Key:     &ttnpb.APIKey{},
+		},
+		{
+			Name:    "NotExpired",
+			Context: test.Context(),
+			Key:     &ttnpb.APIKey{ExpiresAt: &future},
+		},
+		{
+			Name:      "Expired",
+			Context:   test.Context(),
+			Key:       &ttnpb.APIKey{ExpiresAt: &past},
+			Assertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:    "AllowedPeer",
+			Context: withPeer(test.Context(), "192.0.2.1"),
+			Key:     &ttnpb.APIKey{AllowedIPRanges: allowedIPRanges},
+		},
+		{
+			Name:    "AllowedIPv6Peer",
+			Context: withPeer(test.Context(), "2001:db8::1"),
+			Key:     &ttnpb.APIKey{AllowedIPRanges: allowedIPRanges},
+		},
+		{
+			Name:      "DisallowedPeer",
+			Context:   withPeer(test.Context(), "198.51.100.1"),
+			Key:       &ttnpb.APIKey{AllowedIPRanges: allowedIPRanges},
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:      "DisallowedPeerForwardedForIgnored",
+			Context:   withPeer(withForwardedFor(test.Context(), "192.0.2.1"), "198.51.100.1"),
+			Key:       &ttnpb.APIKey{AllowedIPRanges: allowedIPRanges},
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:      "ForwardedForWithoutPeerIgnored",
+			Context:   withForwardedFor(test.Context(), "192.0.2.1"),
+			Key:       &ttnpb.APIKey{AllowedIPRanges: allowedIPRanges},
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:    "AllowedForwardedForTrustedProxy",
+			Context: withPeer(withForwardedFor(test.Context(), "198.51.100.1, 192.0.2.1"), "203.0.113.1"),
+			Key:     &ttnpb.APIKey{AllowedIPRanges: allowedIPRanges},
+		},
+		{
+			Name:      "DisallowedForwardedForUntrustedProxy",
+			Context:   withPeer(withForwardedFor(test.Context(), "192.0.2.1, 198.51.100.1"), "203.0.113.1"),
+			Key:       &ttnpb.APIKey{AllowedIPRanges: allowedIPRanges},
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:    "AllowedClusterForwardedFor",
+			Context: clusterauth.NewContextWithForwardedFor(withPeer(test.Context(), "198.51.100.1"), []string{"192.0.2.1"}),
+			Key:     &ttnpb.APIKey{AllowedIPRanges: allowedIPRanges},
+		},
+		{
+			Name:      "DisallowedClusterForwardedFor",
+			Context:   clusterauth.NewContextWithForwardedFor(withPeer(test.Context(), "192.0.2.1"), []string{"198.51.100.1"}),
+			Key:       &ttnpb.APIKey{AllowedIPRanges: allowedIPRanges},
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:    "AllowedForwardedForLocalProxy",
+			Context: withPeer(withForwardedFor(test.Context(), "198.51.100.1, 192.0.2.1, 127.0.0.1"), "127.0.0.1"),
+			Key:     &ttnpb.APIKey{AllowedIPRanges: allowedIPRanges},
+		},
+		{
+			Name:      "DisallowedForwardedFor",
+			Context:   withPeer(withForwardedFor(test.Context(), "192.0.2.1, 198.51.100.1"), "127.0.0.1"),
+			Key:       &ttnpb.APIKey{AllowedIPRanges: allowedIPRanges},
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:      "UnknownRemoteIP",
+			Context:   test.Context(),
+			Key:       &ttnpb.APIKey{AllowedIPRanges: allowedIPRanges},
+			Assertion: errors.IsPermissionDenied,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			err := checkAPIKeyRestrictions(tc.Context, tc.Key, trustedProxies)
+			if tc.Assertion == nil {
+				a.So(err, should.BeNil)
+			} else if a.So(err, should.NotBeNil) {
+				a.So(tc.Assertion(err), should.BeTrue)
+			}
+		})
+	}
+}
@@ -59,7 +59,7 @@ func (is *IdentityServer) listApplicationRights(ctx context.Context, ids *ttnpb.
 	if err != nil {
 		return nil, err
 	}
-	return appRights.Intersect(ttnpb.AllApplicationRights), nil
+	return withGrantedBy(appRights.Intersect(ttnpb.AllApplicationRights), appRights), nil
 }
 
 func (is *IdentityServer) createApplicationAPIKey(ctx context.Context, req *ttnpb.CreateApplicationAPIKeyRequest) (key *ttnpb.APIKey, err error) {
@@ -71,11 +71,14 @@ func (is *IdentityServer) createApplicationAPIKey(ctx context.Context, req *ttnp
 	if err = rights.RequireApplication(ctx, req.ApplicationIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
-	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
+	key, token, err := generateAPIKey(ctx, req.Name, req.ExpiresAt, req.AllowedIPRanges, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := is.checkAPIKeyQuota(ctx, db, req.ApplicationIdentifiers); err != nil {
+			return err
+		}
 		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.ApplicationIdentifiers, key)
 	})
 	if err != nil {
@@ -234,6 +237,11 @@ func (is *IdentityServer) setApplicationCollaborator(ctx context.Context, req *t
 			if err != nil && !errors.IsNotFound(err) {
 				return err
 			}
+			if existingRights == nil {
+				if err := is.checkCollaboratorQuota(ctx, db, req.ApplicationIdentifiers); err != nil {
+					return err
+				}
+			}
 			// Require the caller to have all added rights.
 			if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, newRights.Sub(existingRights).GetRights()...); err != nil {
 				return err
@@ -16,13 +16,18 @@ package identityserver
 
 import (
 	"testing"
+	"time"
 
+	"github.com/jinzhu/gorm"
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func init() {
@@ -559,3 +564,81 @@ func TestApplicationAccessRights(t *testing.T) {
 		}
 	})
 }
+
+func TestApplicationAPIKeyRestrictions(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		applicationID := userApplications(&userID).Applications[0].ApplicationIdentifiers
+
+		reg := ttnpb.NewApplicationAccessClient(cc)
+
+		past, expiresAt := time.Now().Add(-time.Hour), time.Now().Add(time.Hour).UTC().Truncate(time.Millisecond)
+
+		_, err := reg.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			Rights:                 []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+			ExpiresAt:              &past,
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		_, err = reg.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			Rights:                 []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+			AllowedIPRanges:        []string{"not-an-ip-range"},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		apiKey, err := reg.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: applicationID,
+			Rights:                 []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+			ExpiresAt:              &expiresAt,
+			AllowedIPRanges:        []string{"192.0.2.1/24"},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if !a.So(apiKey, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(*apiKey.ExpiresAt, should.Equal, expiresAt)
+		a.So(apiKey.AllowedIPRanges, should.Resemble, []string{"192.0.2.0/24"})
+
+		keyCreds := grpc.PerRPCCredentials(rpcmetadata.MD{
+			AuthType:      "bearer",
+			AuthValue:     apiKey.Key,
+			AllowInsecure: true,
+		})
+		entityAccess := ttnpb.NewEntityAccessClient(cc)
+
+		_, err = entityAccess.AuthInfo(metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", "192.0.2.42"), ttnpb.Empty, keyCreds)
+		a.So(err, should.BeNil)
+
+		_, err = entityAccess.AuthInfo(metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", "198.51.100.42"), ttnpb.Empty, keyCreds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = entityAccess.AuthInfo(ctx, ttnpb.Empty, keyCreds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		err = is.notifyExpiringAPIKeys(ctx, time.Now(), time.Now().Add(2*time.Hour))
+		a.So(err, should.BeNil)
+
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			first, err := store.GetAPIKeyStore(db).SetAPIKeyExpiryNotified(ctx, apiKey.ID)
+			a.So(first, should.BeFalse)
+			return err
+		})
+		a.So(err, should.BeNil)
+	})
+}
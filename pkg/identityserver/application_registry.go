@@ -40,6 +40,14 @@ var (
 		"application.delete", "delete application",
 		ttnpb.RIGHT_APPLICATION_INFO,
 	)
+	evtRestoreApplication = events.Define(
+		"application.restore", "restore application",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
+	evtPurgeApplication = events.Define(
+		"application.purge", "purge application",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
 )
 
 func (is *IdentityServer) createApplication(ctx context.Context, req *ttnpb.CreateApplicationRequest) (app *ttnpb.Application, err error) {
@@ -59,6 +67,9 @@ func (is *IdentityServer) createApplication(ctx context.Context, req *ttnpb.Crea
 		return nil, err
 	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		if err = is.checkOwnershipQuota(ctx, db, &req.Collaborator, "application"); err != nil {
+			return err
+		}
 		app, err = store.GetApplicationStore(db).CreateApplication(ctx, &req.Application)
 		if err != nil {
 			return err
@@ -120,6 +131,11 @@ func (is *IdentityServer) getApplication(ctx context.Context, req *ttnpb.GetAppl
 
 func (is *IdentityServer) listApplications(ctx context.Context, req *ttnpb.ListApplicationsRequest) (apps *ttnpb.Applications, err error) {
 	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.ApplicationFieldPathsNested, req.FieldMask.Paths, getPaths, nil)
+	if req.Deleted {
+		if err = is.RequireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
 	var includeIndirect bool
 	if req.Collaborator == nil {
 		authInfo, err := is.authInfo(ctx)
@@ -152,6 +168,10 @@ func (is *IdentityServer) listApplications(ctx context.Context, req *ttnpb.ListA
 	}()
 	apps = &ttnpb.Applications{}
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		if req.Deleted {
+			apps.Applications, err = store.GetApplicationStore(db).FindApplications(store.WithSoftDeleted(paginateCtx, true), nil, &req.FieldMask)
+			return err
+		}
 		ids, err := is.getMembershipStore(ctx, db).FindMemberships(paginateCtx, req.Collaborator, "application", includeIndirect)
 		if err != nil {
 			return err
@@ -241,6 +261,41 @@ func (is *IdentityServer) deleteApplication(ctx context.Context, ids *ttnpb.Appl
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) restoreApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetApplicationStore(db).RestoreApplication(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtRestoreApplication(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		total, err := store.GetEndDeviceStore(db).CountEndDevices(ctx, ids)
+		if err != nil {
+			return err
+		}
+		if total > 0 {
+			return errApplicationHasDevices.WithAttributes("count", int(total))
+		}
+		return store.GetApplicationStore(db).PurgeApplication(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtPurgeApplication(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
 type applicationRegistry struct {
 	*IdentityServer
 }
@@ -264,3 +319,11 @@ func (ar *applicationRegistry) Update(ctx context.Context, req *ttnpb.UpdateAppl
 func (ar *applicationRegistry) Delete(ctx context.Context, req *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
 	return ar.deleteApplication(ctx, req)
 }
+
+func (ar *applicationRegistry) Restore(ctx context.Context, req *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	return ar.restoreApplication(ctx, req)
+}
+
+func (ar *applicationRegistry) Purge(ctx context.Context, req *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	return ar.purgeApplication(ctx, req)
+}
@@ -200,6 +200,72 @@ func TestApplicationsCRUD(t *testing.T) {
 		_, err = reg.Delete(ctx, &created.ApplicationIdentifiers, creds)
 
 		a.So(err, should.BeNil)
+
+		adminCreds := userCreds(adminUserIdx)
+
+		_, err = reg.List(ctx, &ttnpb.ListApplicationsRequest{
+			FieldMask: types.FieldMask{Paths: []string{"name"}},
+			Deleted:   true,
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		list, err := reg.List(ctx, &ttnpb.ListApplicationsRequest{
+			FieldMask: types.FieldMask{Paths: []string{"name"}},
+			Deleted:   true,
+		}, adminCreds)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.NotBeNil) {
+			var found bool
+			for _, item := range list.Applications {
+				if item.ApplicationIdentifiers == created.ApplicationIdentifiers {
+					found = true
+				}
+			}
+			a.So(found, should.BeTrue)
+		}
+
+		_, err = reg.Restore(ctx, &created.ApplicationIdentifiers, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.Restore(ctx, &created.ApplicationIdentifiers, adminCreds)
+
+		a.So(err, should.BeNil)
+
+		_, err = reg.Get(ctx, &ttnpb.GetApplicationRequest{
+			ApplicationIdentifiers: created.ApplicationIdentifiers,
+			FieldMask:              types.FieldMask{Paths: []string{"name"}},
+		}, creds)
+
+		a.So(err, should.BeNil)
+
+		_, err = reg.Purge(ctx, &created.ApplicationIdentifiers, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.Purge(ctx, &created.ApplicationIdentifiers, adminCreds)
+
+		a.So(err, should.BeNil)
+
+		list, err = reg.List(ctx, &ttnpb.ListApplicationsRequest{
+			FieldMask: types.FieldMask{Paths: []string{"name"}},
+			Deleted:   true,
+		}, adminCreds)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.NotBeNil) {
+			for _, item := range list.Applications {
+				a.So(item.ApplicationIdentifiers, should.NotResemble, created.ApplicationIdentifiers)
+			}
+		}
 	})
 }
 
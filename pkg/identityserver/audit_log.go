@@ -0,0 +1,95 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// auditActor returns the actor of the request, which is used by the store
+// when writing to the audit log.
+func (is *IdentityServer) auditActor(ctx context.Context) *ttnpb.AuditLogActor {
+	actor := &ttnpb.AuditLogActor{}
+	if ip := remoteIP(ctx, is.trustedProxies); ip != nil {
+		actor.RemoteIP = ip.String()
+	}
+	authInfo, err := is.authInfo(ctx)
+	if err != nil {
+		return actor
+	}
+	if apiKey := authInfo.GetAPIKey(); apiKey != nil {
+		actor.APIKeyID = apiKey.ID
+		actor.UserIDs = apiKey.EntityIDs.GetUserIDs()
+	} else if accessToken := authInfo.GetOAuthAccessToken(); accessToken != nil {
+		userIDs, clientIDs := accessToken.UserIDs, accessToken.ClientIDs
+		actor.UserIDs, actor.ClientIDs = &userIDs, &clientIDs
+	}
+	return actor
+}
+
+func (is *IdentityServer) listAuditLog(ctx context.Context, req *ttnpb.ListAuditLogRequest) (entries *ttnpb.AuditLogEntries, err error) {
+	if req.EntityIDs == nil {
+		if err = is.RequireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	} else if !is.IsAdmin(ctx) {
+		switch id := req.EntityIDs.Identifiers().(type) {
+		case *ttnpb.ApplicationIdentifiers:
+			err = rights.RequireApplication(ctx, *id, ttnpb.RIGHT_APPLICATION_SETTINGS_COLLABORATORS)
+		case *ttnpb.ClientIdentifiers:
+			err = rights.RequireClient(ctx, *id, ttnpb.RIGHT_CLIENT_ALL)
+		case *ttnpb.EndDeviceIdentifiers:
+			err = rights.RequireApplication(ctx, id.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_COLLABORATORS)
+		case *ttnpb.GatewayIdentifiers:
+			err = rights.RequireGateway(ctx, *id, ttnpb.RIGHT_GATEWAY_SETTINGS_COLLABORATORS)
+		case *ttnpb.OrganizationIdentifiers:
+			err = rights.RequireOrganization(ctx, *id, ttnpb.RIGHT_ORGANIZATION_SETTINGS_MEMBERS)
+		case *ttnpb.UserIdentifiers:
+			err = rights.RequireUser(ctx, *id, ttnpb.RIGHT_USER_ALL)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var total uint64
+	paginateCtx := store.WithPagination(ctx, req.Limit, req.Page, &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, total)
+		}
+	}()
+	entries = &ttnpb.AuditLogEntries{}
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		entries.Entries, err = store.GetAuditLogStore(db).FindAuditLogEntries(paginateCtx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+type auditLogRegistry struct {
+	*IdentityServer
+}
+
+func (al *auditLogRegistry) List(ctx context.Context, req *ttnpb.ListAuditLogRequest) (*ttnpb.AuditLogEntries, error) {
+	return al.listAuditLog(ctx, req)
+}
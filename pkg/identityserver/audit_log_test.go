@@ -0,0 +1,102 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuditLog(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		gtwReg := ttnpb.NewGatewayRegistryClient(cc)
+		reg := ttnpb.NewAuditLogRegistryClient(cc)
+
+		gtw, err := gtwReg.Create(ctx, &ttnpb.CreateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "audit-log-gtw"},
+				Name:               "Audit Log Gateway",
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+		a.So(err, should.BeNil)
+		if !a.So(gtw, should.NotBeNil) {
+			t.FailNow()
+		}
+
+		_, err = gtwReg.Update(metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", "192.0.2.42"), &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: gtw.GatewayIdentifiers,
+				Name:               "Updated Audit Log Gateway",
+			},
+			FieldMask: types.FieldMask{Paths: []string{"name"}},
+		}, creds)
+		a.So(err, should.BeNil)
+
+		_, err = reg.List(ctx, &ttnpb.ListAuditLogRequest{}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.List(ctx, &ttnpb.ListAuditLogRequest{
+			EntityIDs: gtw.GatewayIdentifiers.EntityIdentifiers(),
+		}, userCreds(collaboratorUserIdx))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		entries, err := reg.List(ctx, &ttnpb.ListAuditLogRequest{
+			EntityIDs: gtw.GatewayIdentifiers.EntityIdentifiers(),
+		}, creds)
+		a.So(err, should.BeNil)
+		if a.So(entries.Entries, should.HaveLength, 3) {
+			update := entries.Entries[0]
+			a.So(update.Action, should.Equal, "update")
+			a.So(update.FieldMask.Paths, should.Resemble, []string{"name"})
+			a.So(update.OldValues.Fields["name"].GetStringValue(), should.Equal, "Audit Log Gateway")
+			a.So(update.NewValues.Fields["name"].GetStringValue(), should.Equal, "Updated Audit Log Gateway")
+			a.So(update.Actor.GetUserIDs().GetUserID(), should.Equal, userID.UserID)
+			a.So(update.Actor.APIKeyID, should.NotBeEmpty)
+			a.So(update.Actor.RemoteIP, should.Equal, "192.0.2.42")
+
+			// The gateway and its collaborator are created in the same transaction.
+			a.So([]string{entries.Entries[1].Action, entries.Entries[2].Action}, should.Contain, "create")
+			a.So([]string{entries.Entries[1].Action, entries.Entries[2].Action}, should.Contain, "collaborator.update")
+		}
+
+		entries, err = reg.List(ctx, &ttnpb.ListAuditLogRequest{
+			ActorUserIDs: &userID,
+			Action:       "update",
+		}, userCreds(adminUserIdx))
+		a.So(err, should.BeNil)
+		a.So(entries.Entries, should.NotBeEmpty)
+		for _, entry := range entries.Entries {
+			a.So(entry.Action, should.Equal, "update")
+			a.So(entry.Actor.GetUserIDs().GetUserID(), should.Equal, userID.UserID)
+		}
+	})
+}
@@ -47,7 +47,7 @@ func (is *IdentityServer) listClientRights(ctx context.Context, ids *ttnpb.Clien
 	if err != nil {
 		return nil, err
 	}
-	return cliRights.Intersect(ttnpb.AllClientRights), nil
+	return withGrantedBy(cliRights.Intersect(ttnpb.AllClientRights), cliRights), nil
 }
 
 func (is *IdentityServer) getClientCollaborator(ctx context.Context, req *ttnpb.GetClientCollaboratorRequest) (*ttnpb.GetCollaboratorResponse, error) {
@@ -95,6 +95,11 @@ func (is *IdentityServer) setClientCollaborator(ctx context.Context, req *ttnpb.
 			if err != nil && !errors.IsNotFound(err) {
 				return err
 			}
+			if existingRights == nil {
+				if err := is.checkCollaboratorQuota(ctx, db, req.ClientIdentifiers); err != nil {
+					return err
+				}
+			}
 			// Require the caller to have all added rights.
 			if err := rights.RequireClient(ctx, req.ClientIdentifiers, newRights.Sub(existingRights).GetRights()...); err != nil {
 				return err
@@ -45,6 +45,14 @@ var (
 		"client.delete", "delete OAuth client",
 		ttnpb.RIGHT_CLIENT_ALL,
 	)
+	evtRestoreClient = events.Define(
+		"client.restore", "restore OAuth client",
+		ttnpb.RIGHT_CLIENT_ALL,
+	)
+	evtPurgeClient = events.Define(
+		"client.purge", "purge OAuth client",
+		ttnpb.RIGHT_CLIENT_ALL,
+	)
 )
 
 func (is *IdentityServer) createClient(ctx context.Context, req *ttnpb.CreateClientRequest) (cli *ttnpb.Client, err error) {
@@ -150,6 +158,11 @@ func (is *IdentityServer) getClient(ctx context.Context, req *ttnpb.GetClientReq
 
 func (is *IdentityServer) listClients(ctx context.Context, req *ttnpb.ListClientsRequest) (clis *ttnpb.Clients, err error) {
 	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.ClientFieldPathsNested, req.FieldMask.Paths, getPaths, nil)
+	if req.Deleted {
+		if err = is.RequireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
 	var includeIndirect bool
 	if req.Collaborator == nil {
 		authInfo, err := is.authInfo(ctx)
@@ -182,6 +195,10 @@ func (is *IdentityServer) listClients(ctx context.Context, req *ttnpb.ListClient
 	}()
 	clis = &ttnpb.Clients{}
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		if req.Deleted {
+			clis.Clients, err = store.GetClientStore(db).FindClients(store.WithSoftDeleted(paginateCtx, true), nil, &req.FieldMask)
+			return err
+		}
 		ids, err := is.getMembershipStore(ctx, db).FindMemberships(paginateCtx, req.Collaborator, "client", includeIndirect)
 		if err != nil {
 			return err
@@ -284,6 +301,34 @@ func (is *IdentityServer) deleteClient(ctx context.Context, ids *ttnpb.ClientIde
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) restoreClient(ctx context.Context, ids *ttnpb.ClientIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetClientStore(db).RestoreClient(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtRestoreClient(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeClient(ctx context.Context, ids *ttnpb.ClientIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetClientStore(db).PurgeClient(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtPurgeClient(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
 type clientRegistry struct {
 	*IdentityServer
 }
@@ -307,3 +352,11 @@ func (cr *clientRegistry) Update(ctx context.Context, req *ttnpb.UpdateClientReq
 func (cr *clientRegistry) Delete(ctx context.Context, req *ttnpb.ClientIdentifiers) (*types.Empty, error) {
 	return cr.deleteClient(ctx, req)
 }
+
+func (cr *clientRegistry) Restore(ctx context.Context, req *ttnpb.ClientIdentifiers) (*types.Empty, error) {
+	return cr.restoreClient(ctx, req)
+}
+
+func (cr *clientRegistry) Purge(ctx context.Context, req *ttnpb.ClientIdentifiers) (*types.Empty, error) {
+	return cr.purgeClient(ctx, req)
+}
@@ -0,0 +1,157 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// DeletedEntitiesConfig is the configuration of the handling of deleted entities.
+type DeletedEntitiesConfig struct {
+	Retention     time.Duration `name:"retention" description:"Time after which deleted entities are purged and their IDs are released (disabled when zero)"`
+	PurgeInterval time.Duration `name:"purge-interval" description:"Interval to purge deleted entities that exceeded the retention period (disabled when zero)"`
+}
+
+var idsFieldMask = &types.FieldMask{Paths: []string{"ids"}}
+
+// purgeDeletedEntities periodically purges the entities that were deleted longer
+// than the retention period ago.
+func (is *IdentityServer) purgeDeletedEntities(ctx context.Context) error {
+	config := is.configFromContext(ctx).DeletedEntities
+	ticker := time.NewTicker(config.PurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-ticker.C:
+			if err := is.purgeExpiredEntities(ctx, now.Add(-config.Retention)); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to purge deleted entities")
+			}
+		}
+	}
+}
+
+// purgeExpiredEntities purges the entities that were deleted before the given time.
+func (is *IdentityServer) purgeExpiredEntities(ctx context.Context, deletedBefore time.Time) error {
+	var expired []ttnpb.Identifiers
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		deletedCtx := store.WithSoftDeletedBefore(ctx, deletedBefore)
+		apps, err := store.GetApplicationStore(db).FindApplications(deletedCtx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, app := range apps {
+			// End devices are also registered in the Network Server, Application Server and Join Server,
+			// so they are not purged with the application. Applications that still have end devices
+			// are skipped until those end devices are deleted.
+			total, err := store.GetEndDeviceStore(db).CountEndDevices(ctx, &app.ApplicationIdentifiers)
+			if err != nil {
+				return err
+			}
+			if total > 0 {
+				log.FromContext(ctx).WithFields(log.Fields(
+					"application_uid", unique.ID(ctx, app.ApplicationIdentifiers),
+					"device_count", total,
+				)).Warn("Skip purging application with end devices")
+				continue
+			}
+			expired = append(expired, app.ApplicationIdentifiers)
+		}
+		clis, err := store.GetClientStore(db).FindClients(deletedCtx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, cli := range clis {
+			expired = append(expired, cli.ClientIdentifiers)
+		}
+		gtws, err := store.GetGatewayStore(db).FindGateways(deletedCtx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, gtw := range gtws {
+			expired = append(expired, gtw.GatewayIdentifiers)
+		}
+		orgs, err := store.GetOrganizationStore(db).FindOrganizations(deletedCtx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, org := range orgs {
+			expired = append(expired, org.OrganizationIdentifiers)
+		}
+		usrs, err := store.GetUserStore(db).FindUsers(deletedCtx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, usr := range usrs {
+			expired = append(expired, usr.UserIdentifiers)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, ids := range expired {
+		logger := log.FromContext(ctx).WithFields(log.Fields(
+			"entity_type", ids.EntityType(),
+			"entity_id", ids.IDString(),
+		))
+		if err := is.purgeEntity(ctx, ids); err != nil {
+			logger.WithError(err).Warn("Failed to purge deleted entity")
+			continue
+		}
+		logger.Info("Purged deleted entity")
+	}
+	return nil
+}
+
+func (is *IdentityServer) purgeEntity(ctx context.Context, ids ttnpb.Identifiers) error {
+	var evt events.Event
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		switch ids := ids.(type) {
+		case ttnpb.ApplicationIdentifiers:
+			evt = evtPurgeApplication(ctx, ids, nil)
+			return store.GetApplicationStore(db).PurgeApplication(ctx, &ids)
+		case ttnpb.ClientIdentifiers:
+			evt = evtPurgeClient(ctx, ids, nil)
+			return store.GetClientStore(db).PurgeClient(ctx, &ids)
+		case ttnpb.GatewayIdentifiers:
+			evt = evtPurgeGateway(ctx, ids, nil)
+			return store.GetGatewayStore(db).PurgeGateway(ctx, &ids)
+		case ttnpb.OrganizationIdentifiers:
+			evt = evtPurgeOrganization(ctx, ids, nil)
+			return store.GetOrganizationStore(db).PurgeOrganization(ctx, &ids)
+		case ttnpb.UserIdentifiers:
+			evt = evtPurgeUser(ctx, ids, nil)
+			return store.GetUserStore(db).PurgeUser(ctx, &ids)
+		default:
+			panic("unsupported identifiers type")
+		}
+	})
+	if err != nil {
+		return err
+	}
+	events.Publish(evt)
+	return nil
+}
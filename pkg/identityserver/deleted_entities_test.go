@@ -0,0 +1,79 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestPurgeExpiredEntities(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		emptyIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "purge-empty-app"}
+		devicesIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "purge-devices-app"}
+
+		err := is.withDatabase(ctx, func(db *gorm.DB) error {
+			appStore := store.GetApplicationStore(db)
+			for _, ids := range []ttnpb.ApplicationIdentifiers{emptyIDs, devicesIDs} {
+				if _, err := appStore.CreateApplication(ctx, &ttnpb.Application{ApplicationIdentifiers: ids}); err != nil {
+					return err
+				}
+			}
+			_, err := store.GetEndDeviceStore(db).CreateEndDevice(ctx, &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: devicesIDs,
+					DeviceID:               "purge-device",
+				},
+			})
+			if err != nil {
+				return err
+			}
+			for _, ids := range []ttnpb.ApplicationIdentifiers{emptyIDs, devicesIDs} {
+				if err := appStore.DeleteApplication(ctx, &ids); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		a.So(err, should.BeNil)
+
+		err = is.purgeExpiredEntities(ctx, time.Now().Add(time.Hour))
+		a.So(err, should.BeNil)
+
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			deletedCtx := store.WithSoftDeleted(ctx, true)
+			appStore := store.GetApplicationStore(db)
+			_, err := appStore.GetApplication(deletedCtx, &emptyIDs, idsFieldMask)
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsNotFound(err), should.BeTrue)
+			}
+			_, err = appStore.GetApplication(deletedCtx, &devicesIDs, idsFieldMask)
+			return err
+		})
+		a.So(err, should.BeNil)
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emails
+
+import "time"
+
+// GatewayConnectionChanged is the email that is sent when a gateway went offline or came back online.
+type GatewayConnectionChanged struct {
+	Data
+	Online   bool
+	LastSeen time.Time
+}
+
+// TemplateName returns the name of the template to use for this email.
+func (GatewayConnectionChanged) TemplateName() string { return "gateway_connection_changed" }
+
+const gatewayConnectionChangedSubject = `Your gateway {{.Entity.ID}} is {{ if .Online }}back online{{ else }}offline{{ end }}`
+
+const gatewayConnectionChangedText = `Dear {{ .User.Name }},
+
+{{ if .Online -}}
+Your gateway "{{ .Entity.ID }}" on {{ .Network.Name }} is back online.
+{{- else -}}
+Your gateway "{{ .Entity.ID }}" on {{ .Network.Name }} is offline.
+
+The gateway was last seen at {{ .LastSeen.Format "2006-01-02 15:04:05 MST" }}.
+{{- end }}
+
+You are receiving this because you are a collaborator of gateway "{{ .Entity.ID }}" and alerts are enabled for this gateway.
+`
+
+// DefaultTemplates returns the default templates for this email.
+func (GatewayConnectionChanged) DefaultTemplates() (subject, html, text string) {
+	return gatewayConnectionChangedSubject, "", gatewayConnectionChangedText
+}
@@ -58,6 +58,9 @@ func (is *IdentityServer) createEndDevice(ctx context.Context, req *ttnpb.Create
 	defer func() { is.setFullEndDevicePictureURL(ctx, dev) }()
 
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		if err = is.checkEndDeviceQuota(ctx, db, req.EndDeviceIdentifiers.ApplicationIdentifiers); err != nil {
+			return err
+		}
 		dev, err = store.GetEndDeviceStore(db).CreateEndDevice(ctx, &req.EndDevice)
 		if err != nil {
 			return err
@@ -36,6 +36,9 @@ var (
 	errUnauthenticated          = errors.DefineUnauthenticated("unauthenticated", "unauthenticated")
 	errUnsupportedAuthorization = errors.DefineUnauthenticated("unsupported_authorization", "unsupported authorization method")
 	errAPIKeyNotFound           = errors.DefineUnauthenticated("api_key_not_found", "API key not found")
+	errAPIKeyExpired            = errors.DefineUnauthenticated("api_key_expired", "API key expired")
+	errAPIKeyRemoteIPUnknown    = errors.DefinePermissionDenied("api_key_remote_ip_unknown", "API key is restricted to IP address ranges, but the remote IP address is unknown")
+	errAPIKeyIPNotAllowed       = errors.DefinePermissionDenied("api_key_ip_not_allowed", "API key can not be used from IP address `{ip}`")
 	errInvalidAuthorization     = errors.DefineUnauthenticated("invalid_authorization", "invalid authorization")
 	errTokenNotFound            = errors.DefineUnauthenticated("token_not_found", "access token not found")
 	errTokenExpired             = errors.DefineUnauthenticated("token_expired", "access token expired")
@@ -120,6 +123,9 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 			if !valid {
 				return errInvalidAuthorization.New()
 			}
+			if err := checkAPIKeyRestrictions(ctx, apiKey, is.trustedProxies); err != nil {
+				return err
+			}
 			apiKey.Key = ""
 			apiKey.Rights = ttnpb.RightsFrom(apiKey.Rights...).Implied().GetRights()
 			res.AccessMethod = &ttnpb.AuthInfoResponse_APIKey{
@@ -59,7 +59,7 @@ func (is *IdentityServer) listGatewayRights(ctx context.Context, ids *ttnpb.Gate
 	if err != nil {
 		return nil, err
 	}
-	return gtwRights.Intersect(ttnpb.AllGatewayRights), nil
+	return withGrantedBy(gtwRights.Intersect(ttnpb.AllGatewayRights), gtwRights), nil
 }
 
 func (is *IdentityServer) createGatewayAPIKey(ctx context.Context, req *ttnpb.CreateGatewayAPIKeyRequest) (key *ttnpb.APIKey, err error) {
@@ -71,11 +71,14 @@ func (is *IdentityServer) createGatewayAPIKey(ctx context.Context, req *ttnpb.Cr
 	if err = rights.RequireGateway(ctx, req.GatewayIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
-	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
+	key, token, err := generateAPIKey(ctx, req.Name, req.ExpiresAt, req.AllowedIPRanges, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := is.checkAPIKeyQuota(ctx, db, req.GatewayIdentifiers); err != nil {
+			return err
+		}
 		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.GatewayIdentifiers, key)
 	})
 	if err != nil {
@@ -233,6 +236,11 @@ func (is *IdentityServer) setGatewayCollaborator(ctx context.Context, req *ttnpb
 			if err != nil && !errors.IsNotFound(err) {
 				return err
 			}
+			if existingRights == nil {
+				if err := is.checkCollaboratorQuota(ctx, db, req.GatewayIdentifiers); err != nil {
+					return err
+				}
+			}
 			// Require the caller to have all added rights.
 			if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, newRights.Sub(existingRights).GetRights()...); err != nil {
 				return err
@@ -0,0 +1,412 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/email"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/version"
+)
+
+// GatewayMonitoringConfig is the configuration of gateway online/offline alerts.
+type GatewayMonitoringConfig struct {
+	Enable         bool          `name:"enable" description:"Enable gateway online/offline alerts"`
+	OfflineTimeout time.Duration `name:"offline-timeout" description:"Time without connection or status messages after which a gateway is considered offline"`
+	CheckInterval  time.Duration `name:"check-interval" description:"Interval to check for offline gateways"`
+	Webhook        struct {
+		URL     string            `name:"url" description:"URL of the webhook to notify of gateway alerts (disabled when empty)"`
+		Headers map[string]string `name:"headers" description:"HTTP headers of the webhook requests"`
+	} `name:"webhook"`
+}
+
+const (
+	// gatewayAlertsAttribute is the gateway attribute that enables online/offline alerts for the gateway when true.
+	gatewayAlertsAttribute = "offline-alerts"
+	// gatewayAlertsTimeoutAttribute is the gateway attribute that extends the offline timeout for the gateway.
+	gatewayAlertsTimeoutAttribute = "offline-alerts-timeout"
+)
+
+// gatewayMonitoringEvents are the events that indicate whether a gateway is online.
+var gatewayMonitoringEvents = []string{
+	"gs.gateway.connect",
+	"gs.gateway.disconnect",
+	"gs.status.receive",
+	"gs.up.receive",
+}
+
+var (
+	errGatewayAlertsTimeout = errors.DefineInvalidArgument(
+		"gateway_alerts_timeout",
+		"invalid value `{value}` of gateway attribute `{attribute}`",
+	)
+	errWebhookStatus       = errors.DefineUnavailable("webhook_status", "webhook responded with status `{code}`")
+	errGatewayNotConnected = errors.DefineNotFound("gateway_not_connected", "gateway `{gateway_uid}` not connected")
+)
+
+type monitoredGateway struct {
+	ids       ttnpb.GatewayIdentifiers
+	lastSeen  time.Time
+	connected bool
+	// hasStatus indicates that the gateway sent a status message on the current connection.
+	// Gateways that do not send status messages are considered online as long as they are connected.
+	hasStatus bool
+	// alerted indicates that the offline state of the gateway has been handled.
+	alerted bool
+	// notified indicates that an offline alert was sent, so that a recovery message is sent when it comes back online.
+	notified bool
+}
+
+// gatewayMonitor keeps track of when gateways were last seen, and notifies when gateways go offline and come back
+// online. Gateways are monitored after they were seen by the monitor or after they were seeded on start.
+type gatewayMonitor struct {
+	defaultTimeout time.Duration
+	gateways       map[string]*monitoredGateway
+	// timeout returns the offline timeout of the gateway, and false if the gateway has alerts disabled.
+	timeout func(context.Context, ttnpb.GatewayIdentifiers) (time.Duration, bool, error)
+	// notify notifies that the gateway went offline or came back online.
+	notify func(ctx context.Context, ids ttnpb.GatewayIdentifiers, online bool, lastSeen time.Time) error
+}
+
+func (m *gatewayMonitor) handleEvent(ctx context.Context, evt events.Event) {
+	for _, entityIDs := range evt.Identifiers() {
+		ids := entityIDs.GetGatewayIDs()
+		if ids == nil {
+			continue
+		}
+		uid := unique.ID(ctx, ids)
+		gtw, ok := m.gateways[uid]
+		if !ok {
+			gtw = &monitoredGateway{ids: *ids}
+			m.gateways[uid] = gtw
+		}
+		if evt.Time().After(gtw.lastSeen) {
+			gtw.lastSeen = evt.Time()
+		}
+		switch evt.Name() {
+		case "gs.gateway.disconnect":
+			gtw.connected, gtw.hasStatus = false, false
+			continue
+		case "gs.gateway.connect":
+			gtw.connected, gtw.hasStatus = true, false
+		case "gs.status.receive":
+			gtw.connected, gtw.hasStatus = true, true
+		default:
+			gtw.connected = true
+		}
+		if !gtw.alerted {
+			continue
+		}
+		if gtw.notified {
+			if err := m.notify(ctx, gtw.ids, true, gtw.lastSeen); err != nil {
+				log.FromContext(ctx).WithError(err).WithField("gateway_uid", uid).Warn("Failed to notify gateway online")
+			}
+		}
+		gtw.alerted, gtw.notified = false, false
+	}
+}
+
+// seed starts monitoring the gateway if it is not monitored yet. If the gateway is connected, lastSeen is when the
+// gateway was last seen by the Gateway Server. If the gateway is not connected, lastSeen is when monitoring started,
+// so that gateways that are offline on start are alerted after the offline timeout.
+func (m *gatewayMonitor) seed(ctx context.Context, ids ttnpb.GatewayIdentifiers, lastSeen time.Time, connected, hasStatus bool) {
+	uid := unique.ID(ctx, ids)
+	if _, ok := m.gateways[uid]; ok {
+		return
+	}
+	m.gateways[uid] = &monitoredGateway{
+		ids:       ids,
+		lastSeen:  lastSeen,
+		connected: connected,
+		hasStatus: hasStatus,
+	}
+}
+
+func (m *gatewayMonitor) check(ctx context.Context, now time.Time) {
+	for uid, gtw := range m.gateways {
+		if gtw.alerted || gtw.connected && !gtw.hasStatus || now.Sub(gtw.lastSeen) < m.defaultTimeout {
+			continue
+		}
+		logger := log.FromContext(ctx).WithField("gateway_uid", uid)
+		timeout, enabled, err := m.timeout(ctx, gtw.ids)
+		if errors.IsNotFound(err) {
+			delete(m.gateways, uid)
+			continue
+		}
+		if err != nil {
+			logger.WithError(err).Warn("Failed to get gateway alert settings")
+			continue
+		}
+		if !enabled {
+			gtw.alerted = true
+			continue
+		}
+		if now.Sub(gtw.lastSeen) < timeout {
+			continue
+		}
+		if err := m.notify(ctx, gtw.ids, false, gtw.lastSeen); err != nil {
+			logger.WithError(err).Warn("Failed to notify gateway offline")
+			continue
+		}
+		gtw.alerted, gtw.notified = true, true
+	}
+}
+
+// monitorGateways monitors the connections of gateways and alerts when gateways go offline.
+func (is *IdentityServer) monitorGateways(ctx context.Context) error {
+	config := is.configFromContext(ctx).GatewayMonitoring
+	ch := make(events.Channel, 1024)
+	hdl := events.ContextHandler(ctx, ch)
+	for _, name := range gatewayMonitoringEvents {
+		if err := events.Subscribe(name, hdl); err != nil {
+			return err
+		}
+		defer events.Unsubscribe(name, hdl)
+	}
+	monitor := &gatewayMonitor{
+		defaultTimeout: config.OfflineTimeout,
+		gateways:       make(map[string]*monitoredGateway),
+		timeout:        is.gatewayAlertsTimeout,
+		notify:         is.notifyGatewayConnection,
+	}
+	if err := is.seedGatewayMonitor(ctx, monitor, time.Now()); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(config.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case evt := <-ch:
+			monitor.handleEvent(ctx, evt)
+		case now := <-ticker.C:
+			monitor.check(ctx, now)
+		}
+	}
+}
+
+// seedGatewayMonitor seeds the monitor with the gateways that have alerts enabled, using the connection statistics
+// of the Gateway Server to determine when they were last seen.
+func (is *IdentityServer) seedGatewayMonitor(ctx context.Context, monitor *gatewayMonitor, now time.Time) error {
+	var gtws []*ttnpb.Gateway
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		gtws, err = store.GetGatewayStore(db).FindGateways(ctx, nil, &types.FieldMask{Paths: []string{"attributes"}})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	for _, gtw := range gtws {
+		if enabled, _ := strconv.ParseBool(gtw.Attributes[gatewayAlertsAttribute]); !enabled {
+			continue
+		}
+		stats, err := is.gatewayConnectionStats(ctx, gtw.GatewayIdentifiers)
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				log.FromContext(ctx).WithError(err).WithField("gateway_uid", unique.ID(ctx, gtw.GatewayIdentifiers)).Warn("Failed to get gateway connection stats")
+			}
+			monitor.seed(ctx, gtw.GatewayIdentifiers, now, false, false)
+			continue
+		}
+		lastSeen := *stats.ConnectedAt
+		for _, t := range []*time.Time{stats.LastStatusReceivedAt, stats.LastUplinkReceivedAt} {
+			if t != nil && t.After(lastSeen) {
+				lastSeen = *t
+			}
+		}
+		monitor.seed(ctx, gtw.GatewayIdentifiers, lastSeen, true, stats.LastStatusReceivedAt != nil)
+	}
+	return nil
+}
+
+// gatewayConnectionStats returns the connection statistics of the gateway from the Gateway Server.
+func (is *IdentityServer) gatewayConnectionStats(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*ttnpb.GatewayConnectionStats, error) {
+	cc, err := is.GetPeerConn(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := ttnpb.NewGsClient(cc).GetGatewayConnectionStats(ctx, &ids, is.WithClusterAuth())
+	if err != nil {
+		return nil, err
+	}
+	if stats == nil || stats.ConnectedAt == nil {
+		return nil, errGatewayNotConnected.WithAttributes("gateway_uid", unique.ID(ctx, ids))
+	}
+	return stats, nil
+}
+
+// gatewayAlertsTimeout returns the offline timeout of the gateway, and whether the gateway has alerts enabled.
+func (is *IdentityServer) gatewayAlertsTimeout(ctx context.Context, ids ttnpb.GatewayIdentifiers) (time.Duration, bool, error) {
+	var gtw *ttnpb.Gateway
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		gtw, err = store.GetGatewayStore(db).GetGateway(ctx, &ids, &types.FieldMask{Paths: []string{"attributes"}})
+		return err
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	if enabled, _ := strconv.ParseBool(gtw.Attributes[gatewayAlertsAttribute]); !enabled {
+		return 0, false, nil
+	}
+	timeout := is.configFromContext(ctx).GatewayMonitoring.OfflineTimeout
+	if value, ok := gtw.Attributes[gatewayAlertsTimeoutAttribute]; ok {
+		d, err := time.ParseDuration(value)
+		if err != nil || d <= 0 {
+			return 0, false, errGatewayAlertsTimeout.WithAttributes(
+				"attribute", gatewayAlertsTimeoutAttribute,
+				"value", value,
+			).WithCause(err)
+		}
+		if d > timeout {
+			timeout = d
+		}
+	}
+	return timeout, true, nil
+}
+
+// notifyGatewayConnection notifies the collaborators of the gateway, and the webhook if configured, that the gateway
+// went offline or came back online. When running with Redis, each notification is sent once by the cluster.
+func (is *IdentityServer) notifyGatewayConnection(ctx context.Context, ids ttnpb.GatewayIdentifiers, online bool, lastSeen time.Time) error {
+	if is.redis != nil {
+		state := "offline"
+		if online {
+			state = "online"
+		}
+		key := is.redis.Key("gateway-alerts", unique.ID(ctx, ids), state, strconv.FormatInt(lastSeen.UnixNano(), 10))
+		first, err := is.redis.SetNX(key, 1, is.configFromContext(ctx).GatewayMonitoring.OfflineTimeout).Result()
+		if err != nil {
+			return err
+		}
+		if !first {
+			return nil
+		}
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"gateway_uid", unique.ID(ctx, ids),
+		"online", online,
+	))
+	logger.Info("Notify gateway connection change")
+
+	if url := is.configFromContext(ctx).GatewayMonitoring.Webhook.URL; url != "" {
+		if err := is.sendGatewayAlertWebhook(ctx, url, ids, online, lastSeen); err != nil {
+			logger.WithError(err).Warn("Failed to send gateway alert webhook")
+		}
+	}
+	return is.sendGatewayCollaboratorsEmail(ctx, &ids, func(data emails.Data) email.MessageData {
+		return &emails.GatewayConnectionChanged{Data: data, Online: online, LastSeen: lastSeen}
+	})
+}
+
+// sendGatewayCollaboratorsEmail sends an email to the users that are collaborator of the gateway, directly or through
+// an organization, with the right to read the gateway status.
+func (is *IdentityServer) sendGatewayCollaboratorsEmail(ctx context.Context, ids *ttnpb.GatewayIdentifiers, makeMessage func(emails.Data) email.MessageData) error {
+	users := make(map[string]*ttnpb.UserIdentifiers)
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		membershipStore := store.GetMembershipStore(db)
+		members, err := membershipStore.FindMembers(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for member, rights := range members {
+			if !rights.Implied().IncludesAll(ttnpb.RIGHT_GATEWAY_STATUS_READ) {
+				continue
+			}
+			if userIDs := member.GetUserIDs(); userIDs != nil {
+				users[userIDs.UserID] = userIDs
+				continue
+			}
+			orgMembers, err := membershipStore.FindMembers(ctx, member.GetOrganizationIDs())
+			if err != nil {
+				return err
+			}
+			for orgMember, orgRights := range orgMembers {
+				userIDs := orgMember.GetUserIDs()
+				if userIDs == nil || !orgRights.Implied().IncludesAll(ttnpb.RIGHT_GATEWAY_STATUS_READ) {
+					continue
+				}
+				users[userIDs.UserID] = userIDs
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, userIDs := range users {
+		err := is.SendUserEmail(ctx, userIDs, func(data emails.Data) email.MessageData {
+			data.SetEntity(ids.EntityIdentifiers())
+			return makeMessage(data)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type gatewayAlert struct {
+	GatewayIDs ttnpb.GatewayIdentifiers `json:"gateway_ids"`
+	Online     bool                     `json:"online"`
+	LastSeenAt time.Time                `json:"last_seen_at"`
+	Time       time.Time                `json:"time"`
+}
+
+var webhookUserAgent = "ttn-lw-identity-server/" + version.TTN
+
+func (is *IdentityServer) sendGatewayAlertWebhook(ctx context.Context, url string, ids ttnpb.GatewayIdentifiers, online bool, lastSeen time.Time) error {
+	buf, err := json.Marshal(gatewayAlert{
+		GatewayIDs: ids,
+		Online:     online,
+		LastSeenAt: lastSeen,
+		Time:       time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(buf))
+	if err != nil {
+		return err
+	}
+	for key, value := range is.configFromContext(ctx).GatewayMonitoring.Webhook.Headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", webhookUserAgent)
+	client := &http.Client{Timeout: 10 * time.Second}
+	res, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errWebhookStatus.WithAttributes("code", res.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestGatewayMonitor(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	type notification struct {
+		GatewayID string
+		Online    bool
+	}
+	var notifications []notification
+
+	monitor := &gatewayMonitor{
+		defaultTimeout: 10 * time.Minute,
+		gateways:       make(map[string]*monitoredGateway),
+		timeout: func(_ context.Context, ids ttnpb.GatewayIdentifiers) (time.Duration, bool, error) {
+			switch ids.GatewayID {
+			case "enabled", "seeded":
+				return 10 * time.Minute, true, nil
+			case "extended":
+				return time.Hour, true, nil
+			default:
+				return 0, false, nil
+			}
+		},
+		notify: func(_ context.Context, ids ttnpb.GatewayIdentifiers, online bool, _ time.Time) error {
+			notifications = append(notifications, notification{GatewayID: ids.GatewayID, Online: online})
+			return nil
+		},
+	}
+
+	publish := func(name string, gatewayIDs ...string) time.Time {
+		var now time.Time
+		for _, gatewayID := range gatewayIDs {
+			evt := events.New(ctx, name, ttnpb.GatewayIdentifiers{GatewayID: gatewayID}, nil)
+			now = evt.Time()
+			monitor.handleEvent(ctx, evt)
+		}
+		return now
+	}
+
+	now := publish("gs.gateway.connect", "enabled", "extended", "disabled", "no-status")
+	publish("gs.status.receive", "enabled", "extended", "disabled")
+
+	// Gateways that send status messages are offline when the status messages stop.
+	monitor.check(ctx, now.Add(5*time.Minute))
+	a.So(notifications, should.BeEmpty)
+	monitor.check(ctx, now.Add(15*time.Minute))
+	a.So(notifications, should.Resemble, []notification{{"enabled", false}})
+
+	// Alerts are not repeated.
+	monitor.check(ctx, now.Add(20*time.Minute))
+	a.So(notifications, should.HaveLength, 1)
+
+	// The timeout can be extended per gateway.
+	monitor.check(ctx, now.Add(2*time.Hour))
+	a.So(notifications, should.Resemble, []notification{{"enabled", false}, {"extended", false}})
+
+	// Gateways that come back online are recovered.
+	notifications = nil
+	now = publish("gs.up.receive", "enabled", "disabled")
+	a.So(notifications, should.Resemble, []notification{{"enabled", true}})
+
+	// Gateways that disconnect are offline after the timeout.
+	notifications = nil
+	now = publish("gs.gateway.disconnect", "enabled", "no-status")
+	monitor.check(ctx, now.Add(5*time.Minute))
+	a.So(notifications, should.BeEmpty)
+	monitor.check(ctx, now.Add(15*time.Minute))
+	a.So(notifications, should.Resemble, []notification{{"enabled", false}})
+
+	// Reconnecting sends a recovery message.
+	notifications = nil
+	publish("gs.gateway.connect", "enabled")
+	a.So(notifications, should.Resemble, []notification{{"enabled", true}})
+
+	// Gateways that are offline on start are offline after the timeout.
+	notifications = nil
+	now = time.Now()
+	monitor.seed(ctx, ttnpb.GatewayIdentifiers{GatewayID: "seeded"}, now, false, false)
+	monitor.check(ctx, now.Add(5*time.Minute))
+	a.So(notifications, should.BeEmpty)
+	monitor.check(ctx, now.Add(15*time.Minute))
+	a.So(notifications, should.Resemble, []notification{{"seeded", false}})
+
+	// Seeding does not override gateways that are already monitored.
+	notifications = nil
+	monitor.seed(ctx, ttnpb.GatewayIdentifiers{GatewayID: "enabled"}, now.Add(time.Hour), true, true)
+	monitor.check(ctx, now.Add(2*time.Hour))
+	a.So(notifications, should.BeEmpty)
+}
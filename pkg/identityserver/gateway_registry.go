@@ -39,6 +39,14 @@ var (
 		"gateway.delete", "delete gateway",
 		ttnpb.RIGHT_GATEWAY_INFO,
 	)
+	evtRestoreGateway = events.Define(
+		"gateway.restore", "restore gateway",
+		ttnpb.RIGHT_GATEWAY_INFO,
+	)
+	evtPurgeGateway = events.Define(
+		"gateway.purge", "purge gateway",
+		ttnpb.RIGHT_GATEWAY_INFO,
+	)
 )
 
 func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGatewayRequest) (gtw *ttnpb.Gateway, err error) {
@@ -62,6 +70,9 @@ func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGa
 	}
 
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		if err = is.checkOwnershipQuota(ctx, db, &req.Collaborator, "gateway"); err != nil {
+			return err
+		}
 		gtw, err = store.GetGatewayStore(db).CreateGateway(ctx, &req.Gateway)
 		if err != nil {
 			return err
@@ -164,6 +175,11 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 	}
 	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.GatewayFieldPathsNested, req.FieldMask.Paths, getPaths, []string{"frequency_plan_id"})
 
+	if req.Deleted {
+		if err = is.RequireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
 	var includeIndirect bool
 	if req.Collaborator == nil {
 		authInfo, err := is.authInfo(ctx)
@@ -196,6 +212,10 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 	}()
 	gtws = &ttnpb.Gateways{}
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		if req.Deleted {
+			gtws.Gateways, err = store.GetGatewayStore(db).FindGateways(store.WithSoftDeleted(paginateCtx, true), nil, &req.FieldMask)
+			return err
+		}
 		ids, err := is.getMembershipStore(ctx, db).FindMemberships(paginateCtx, req.Collaborator, "gateway", includeIndirect)
 		if err != nil {
 			return err
@@ -292,6 +312,34 @@ func (is *IdentityServer) deleteGateway(ctx context.Context, ids *ttnpb.GatewayI
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) restoreGateway(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetGatewayStore(db).RestoreGateway(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtRestoreGateway(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeGateway(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetGatewayStore(db).PurgeGateway(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtPurgeGateway(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
 type gatewayRegistry struct {
 	*IdentityServer
 }
@@ -319,3 +367,11 @@ func (gr *gatewayRegistry) Update(ctx context.Context, req *ttnpb.UpdateGatewayR
 func (gr *gatewayRegistry) Delete(ctx context.Context, req *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
 	return gr.deleteGateway(ctx, req)
 }
+
+func (gr *gatewayRegistry) Restore(ctx context.Context, req *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	return gr.restoreGateway(ctx, req)
+}
+
+func (gr *gatewayRegistry) Purge(ctx context.Context, req *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	return gr.purgeGateway(ctx, req)
+}
@@ -218,6 +218,72 @@ func TestGatewaysCRUD(t *testing.T) {
 		_, err = reg.Delete(ctx, &created.GatewayIdentifiers, creds)
 
 		a.So(err, should.BeNil)
+
+		adminCreds := userCreds(adminUserIdx)
+
+		_, err = reg.List(ctx, &ttnpb.ListGatewaysRequest{
+			FieldMask: ptypes.FieldMask{Paths: []string{"name"}},
+			Deleted:   true,
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		list, err := reg.List(ctx, &ttnpb.ListGatewaysRequest{
+			FieldMask: ptypes.FieldMask{Paths: []string{"name"}},
+			Deleted:   true,
+		}, adminCreds)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.NotBeNil) {
+			var found bool
+			for _, item := range list.Gateways {
+				if item.GatewayID == created.GatewayID {
+					found = true
+				}
+			}
+			a.So(found, should.BeTrue)
+		}
+
+		_, err = reg.Restore(ctx, &created.GatewayIdentifiers, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.Restore(ctx, &created.GatewayIdentifiers, adminCreds)
+
+		a.So(err, should.BeNil)
+
+		_, err = reg.Get(ctx, &ttnpb.GetGatewayRequest{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: created.GatewayID},
+			FieldMask:          ptypes.FieldMask{Paths: []string{"name"}},
+		}, creds)
+
+		a.So(err, should.BeNil)
+
+		_, err = reg.Purge(ctx, &created.GatewayIdentifiers, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.Purge(ctx, &created.GatewayIdentifiers, adminCreds)
+
+		a.So(err, should.BeNil)
+
+		list, err = reg.List(ctx, &ttnpb.ListGatewaysRequest{
+			FieldMask: ptypes.FieldMask{Paths: []string{"name"}},
+			Deleted:   true,
+		}, adminCreds)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.NotBeNil) {
+			for _, item := range list.Gateways {
+				a.So(item.GatewayID, should.NotEqual, created.GatewayID)
+			}
+		}
 	})
 }
 
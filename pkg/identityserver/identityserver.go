@@ -16,6 +16,7 @@ package identityserver
 
 import (
 	"context"
+	"net"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -60,7 +61,8 @@ type Config struct {
 			MinSpecial   int `name:"min-special" description:"Minimum number of special characters"`
 		} `name:"password-requirements"`
 	} `name:"user-registration"`
-	AuthCache struct {
+	TrustedProxies []string `name:"trusted-proxies" description:"CIDRs of trusted reverse proxies that set the X-Forwarded-For header"`
+	AuthCache      struct {
 		MembershipTTL time.Duration `name:"membership-ttl" description:"TTL of membership caches"`
 	} `name:"auth-cache"`
 	OAuth          oauth.Config `name:"oauth"`
@@ -79,6 +81,10 @@ type Config struct {
 		SMTP         smtp.Config          `name:"smtp"`
 		Templates    emailTemplatesConfig `name:"templates"`
 	} `name:"email"`
+	GatewayMonitoring GatewayMonitoringConfig `name:"gateway-monitoring"`
+	DeletedEntities   DeletedEntitiesConfig   `name:"deleted-entities"`
+	APIKeyExpiry      APIKeyExpiryConfig      `name:"api-key-expiry"`
+	Quotas            QuotasConfig            `name:"quotas"`
 }
 
 // IdentityServer implements the Identity Server component.
@@ -93,6 +99,7 @@ type IdentityServer struct {
 	redis          *redis.Client
 	emailTemplates *email.TemplateRegistry
 	oauth          oauth.Server
+	trustedProxies []*net.IPNet
 }
 
 // Context returns the context of the Identity Server.
@@ -116,7 +123,10 @@ func (is *IdentityServer) configFromContext(ctx context.Context) *Config {
 	return is.config
 }
 
-var errDBNeedsMigration = errors.Define("db_needs_migration", "the database needs to be migrated")
+var (
+	errDBNeedsMigration = errors.Define("db_needs_migration", "the database needs to be migrated")
+	errTrustedProxy     = errors.DefineInvalidArgument("trusted_proxy", "invalid trusted proxy `{trusted_proxy}`")
+)
 
 // New returns new *IdentityServer.
 func New(c *component.Component, config *Config) (is *IdentityServer, err error) {
@@ -125,6 +135,13 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 		ctx:       log.NewContextWithField(c.Context(), "namespace", "identityserver"),
 		config:    config,
 	}
+	for _, trustedProxy := range is.config.TrustedProxies {
+		_, ipNet, err := net.ParseCIDR(trustedProxy)
+		if err != nil {
+			return nil, errTrustedProxy.WithAttributes("trusted_proxy", trustedProxy).WithCause(err)
+		}
+		is.trustedProxies = append(is.trustedProxies, ipNet)
+	}
 	is.db, err = store.Open(is.Context(), is.config.DatabaseURI)
 	if err != nil {
 		return nil, err
@@ -148,19 +165,26 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 	is.oauth = oauth.NewServer(is.Context(), struct {
 		store.UserStore
 		store.UserSessionStore
+		store.MFAStore
+		store.MFALoginStore
+		store.ExternalUserStore
 		store.ClientStore
 		store.OAuthStore
 	}{
-		UserStore:        store.GetUserStore(is.db),
-		UserSessionStore: store.GetUserSessionStore(is.db),
-		ClientStore:      store.GetClientStore(is.db),
-		OAuthStore:       store.GetOAuthStore(is.db),
+		UserStore:         store.GetUserStore(is.db),
+		UserSessionStore:  store.GetUserSessionStore(is.db),
+		MFAStore:          store.GetMFAStore(is.db),
+		MFALoginStore:     store.GetMFALoginStore(is.db),
+		ExternalUserStore: store.GetExternalUserStore(is.db),
+		ClientStore:       store.GetClientStore(is.db),
+		OAuthStore:        store.GetOAuthStore(is.db),
 	}, is.config.OAuth)
 
 	c.AddContextFiller(func(ctx context.Context) context.Context {
 		ctx = is.withRequestAccessCache(ctx)
 		ctx = rights.NewContextWithFetcher(ctx, is)
 		ctx = rights.NewContextWithCache(ctx)
+		ctx = store.WithAuditActor(ctx, is.auditActor)
 		return ctx
 	})
 
@@ -182,11 +206,25 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 		hooks.RegisterUnaryHook("/ttn.lorawan.v3.OrganizationAccess", hook.name, hook.middleware)
 		hooks.RegisterUnaryHook("/ttn.lorawan.v3.UserRegistry", hook.name, hook.middleware)
 		hooks.RegisterUnaryHook("/ttn.lorawan.v3.UserAccess", hook.name, hook.middleware)
+		hooks.RegisterUnaryHook("/ttn.lorawan.v3.AuditLogRegistry", hook.name, hook.middleware)
+		hooks.RegisterUnaryHook("/ttn.lorawan.v3.QuotaRegistry", hook.name, hook.middleware)
 	}
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EntityAccess", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("identityserver"))
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EntityAccess", cluster.HookName, c.ClusterAuthUnaryHook())
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.OAuthAuthorizationRegistry", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("identityserver"))
 
+	if is.config.GatewayMonitoring.Enable {
+		is.RegisterTask(is.Context(), "monitor_gateways", is.monitorGateways, component.TaskRestartOnFailure)
+	}
+
+	if is.config.DeletedEntities.Retention > 0 && is.config.DeletedEntities.PurgeInterval > 0 {
+		is.RegisterTask(is.Context(), "purge_deleted_entities", is.purgeDeletedEntities, component.TaskRestartOnFailure)
+	}
+
+	if is.config.APIKeyExpiry.ReminderBefore > 0 && is.config.APIKeyExpiry.CheckInterval > 0 {
+		is.RegisterTask(is.Context(), "remind_expiring_api_keys", is.remindExpiringAPIKeys, component.TaskRestartOnFailure)
+	}
+
 	c.RegisterGRPC(is)
 	c.RegisterWeb(is.oauth)
 
@@ -216,6 +254,8 @@ func (is *IdentityServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterEndDeviceRegistrySearchServer(s, &registrySearch{IdentityServer: is})
 	ttnpb.RegisterOAuthAuthorizationRegistryServer(s, &oauthRegistry{IdentityServer: is})
 	ttnpb.RegisterContactInfoRegistryServer(s, &contactInfoRegistry{IdentityServer: is})
+	ttnpb.RegisterAuditLogRegistryServer(s, &auditLogRegistry{IdentityServer: is})
+	ttnpb.RegisterQuotaRegistryServer(s, &quotaRegistry{IdentityServer: is})
 }
 
 // RegisterHandlers registers gRPC handlers.
@@ -237,6 +277,8 @@ func (is *IdentityServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Clien
 	ttnpb.RegisterEndDeviceRegistrySearchHandler(is.Context(), s, conn)
 	ttnpb.RegisterOAuthAuthorizationRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterContactInfoRegistryHandler(is.Context(), s, conn)
+	ttnpb.RegisterAuditLogRegistryHandler(is.Context(), s, conn)
+	ttnpb.RegisterQuotaRegistryHandler(is.Context(), s, conn)
 }
 
 // Roles returns the roles that the Identity Server fulfills.
@@ -248,7 +290,7 @@ func (is *IdentityServer) getMembershipStore(ctx context.Context, db *gorm.DB) s
 	s := store.GetMembershipStore(db)
 	if is.redis != nil {
 		if membershipTTL := is.configFromContext(ctx).AuthCache.MembershipTTL; membershipTTL > 0 {
-			s = store.GetMembershipCache(s, is.redis, membershipTTL)
+			s = store.GetMembershipCache(db, s, is.redis, membershipTTL)
 		}
 	}
 	return s
@@ -59,7 +59,7 @@ func (is *IdentityServer) listOrganizationRights(ctx context.Context, ids *ttnpb
 	if err != nil {
 		return nil, err
 	}
-	return orgRights.Intersect(ttnpb.AllEntityRights.Union(ttnpb.AllOrganizationRights)), nil
+	return withGrantedBy(orgRights.Intersect(ttnpb.AllEntityRights.Union(ttnpb.AllOrganizationRights)), orgRights), nil
 }
 
 func (is *IdentityServer) createOrganizationAPIKey(ctx context.Context, req *ttnpb.CreateOrganizationAPIKeyRequest) (key *ttnpb.APIKey, err error) {
@@ -71,11 +71,14 @@ func (is *IdentityServer) createOrganizationAPIKey(ctx context.Context, req *ttn
 	if err = rights.RequireOrganization(ctx, req.OrganizationIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
-	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
+	key, token, err := generateAPIKey(ctx, req.Name, req.ExpiresAt, req.AllowedIPRanges, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := is.checkAPIKeyQuota(ctx, db, req.OrganizationIdentifiers); err != nil {
+			return err
+		}
 		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.OrganizationIdentifiers, key)
 	})
 	if err != nil {
@@ -234,6 +237,11 @@ func (is *IdentityServer) setOrganizationCollaborator(ctx context.Context, req *
 			if err != nil && !errors.IsNotFound(err) {
 				return err
 			}
+			if existingRights == nil {
+				if err := is.checkCollaboratorQuota(ctx, db, req.OrganizationIdentifiers); err != nil {
+					return err
+				}
+			}
 			// Require the caller to have all added rights.
 			if err := rights.RequireOrganization(ctx, req.OrganizationIdentifiers, newRights.Sub(existingRights).GetRights()...); err != nil {
 				return err
@@ -20,7 +20,6 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
-	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/blacklist"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
@@ -40,10 +39,16 @@ var (
 		"organization.delete", "delete organization",
 		ttnpb.RIGHT_ORGANIZATION_INFO,
 	)
+	evtRestoreOrganization = events.Define(
+		"organization.restore", "restore organization",
+		ttnpb.RIGHT_ORGANIZATION_INFO,
+	)
+	evtPurgeOrganization = events.Define(
+		"organization.purge", "purge organization",
+		ttnpb.RIGHT_ORGANIZATION_INFO,
+	)
 )
 
-var errNestedOrganizations = errors.DefineInvalidArgument("nested_organizations", "organizations can not be nested")
-
 func (is *IdentityServer) createOrganization(ctx context.Context, req *ttnpb.CreateOrganizationRequest) (org *ttnpb.Organization, err error) {
 	if err = blacklist.Check(ctx, req.OrganizationID); err != nil {
 		return nil, err
@@ -53,12 +58,17 @@ func (is *IdentityServer) createOrganization(ctx context.Context, req *ttnpb.Cre
 			return nil, err
 		}
 	} else if orgIDs := req.Collaborator.GetOrganizationIDs(); orgIDs != nil {
-		return nil, errNestedOrganizations.New()
+		if err = rights.RequireOrganization(ctx, *orgIDs, ttnpb.RIGHT_ORGANIZATION_SETTINGS_MEMBERS); err != nil {
+			return nil, err
+		}
 	}
 	if err := validateContactInfo(req.Organization.ContactInfo); err != nil {
 		return nil, err
 	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		if err = is.checkOwnershipQuota(ctx, db, &req.Collaborator, "organization"); err != nil {
+			return err
+		}
 		org, err = store.GetOrganizationStore(db).CreateOrganization(ctx, &req.Organization)
 		if err != nil {
 			return err
@@ -120,6 +130,11 @@ func (is *IdentityServer) getOrganization(ctx context.Context, req *ttnpb.GetOrg
 
 func (is *IdentityServer) listOrganizations(ctx context.Context, req *ttnpb.ListOrganizationsRequest) (orgs *ttnpb.Organizations, err error) {
 	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.OrganizationFieldPathsNested, req.FieldMask.Paths, getPaths, nil)
+	if req.Deleted {
+		if err = is.RequireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
 	var includeIndirect bool
 	if req.Collaborator == nil {
 		authInfo, err := is.authInfo(ctx)
@@ -138,7 +153,9 @@ func (is *IdentityServer) listOrganizations(ctx context.Context, req *ttnpb.List
 			return nil, err
 		}
 	} else if orgIDs := req.Collaborator.GetOrganizationIDs(); orgIDs != nil {
-		return nil, errNestedOrganizations.New()
+		if err = rights.RequireOrganization(ctx, *orgIDs, ttnpb.RIGHT_ORGANIZATION_INFO); err != nil {
+			return nil, err
+		}
 	}
 	ctx = store.WithOrder(ctx, req.Order)
 	var total uint64
@@ -150,6 +167,10 @@ func (is *IdentityServer) listOrganizations(ctx context.Context, req *ttnpb.List
 	}()
 	orgs = &ttnpb.Organizations{}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		if req.Deleted {
+			orgs.Organizations, err = store.GetOrganizationStore(db).FindOrganizations(store.WithSoftDeleted(paginateCtx, true), nil, &req.FieldMask)
+			return err
+		}
 		ids, err := is.getMembershipStore(ctx, db).FindMemberships(paginateCtx, req.Collaborator, "organization", includeIndirect)
 		if err != nil {
 			return err
@@ -230,6 +251,34 @@ func (is *IdentityServer) deleteOrganization(ctx context.Context, ids *ttnpb.Org
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) restoreOrganization(ctx context.Context, ids *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetOrganizationStore(db).RestoreOrganization(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtRestoreOrganization(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeOrganization(ctx context.Context, ids *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetOrganizationStore(db).PurgeOrganization(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtPurgeOrganization(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
 type organizationRegistry struct {
 	*IdentityServer
 }
@@ -253,3 +302,11 @@ func (or *organizationRegistry) Update(ctx context.Context, req *ttnpb.UpdateOrg
 func (or *organizationRegistry) Delete(ctx context.Context, req *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
 	return or.deleteOrganization(ctx, req)
 }
+
+func (or *organizationRegistry) Restore(ctx context.Context, req *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	return or.restoreOrganization(ctx, req)
+}
+
+func (or *organizationRegistry) Purge(ctx context.Context, req *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	return or.purgeOrganization(ctx, req)
+}
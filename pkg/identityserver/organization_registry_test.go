@@ -53,7 +53,7 @@ func init() {
 	}
 }
 
-func TestOrganizationsNestedError(t *testing.T) {
+func TestOrganizationsNested(t *testing.T) {
 	a := assertions.New(t)
 	ctx := test.Context()
 
@@ -63,26 +63,59 @@ func TestOrganizationsNestedError(t *testing.T) {
 		org := userOrganizations(&userID).Organizations[0]
 
 		reg := ttnpb.NewOrganizationRegistryClient(cc)
+		access := ttnpb.NewOrganizationAccessClient(cc)
 
-		_, err := reg.Create(ctx, &ttnpb.CreateOrganizationRequest{
+		nested, err := reg.Create(ctx, &ttnpb.CreateOrganizationRequest{
 			Organization: ttnpb.Organization{
-				OrganizationIdentifiers: ttnpb.OrganizationIdentifiers{OrganizationID: "foo-org"},
+				OrganizationIdentifiers: ttnpb.OrganizationIdentifiers{OrganizationID: "nested-org"},
 			},
 			Collaborator: *org.OrganizationOrUserIdentifiers(),
 		}, creds)
 
-		if a.So(err, should.NotBeNil) {
-			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		a.So(err, should.BeNil)
+		if !a.So(nested, should.NotBeNil) {
+			t.FailNow()
 		}
 
-		_, err = reg.List(ctx, &ttnpb.ListOrganizationsRequest{
+		list, err := reg.List(ctx, &ttnpb.ListOrganizationsRequest{
 			FieldMask:    types.FieldMask{Paths: []string{"name"}},
 			Collaborator: org.OrganizationOrUserIdentifiers(),
 		}, creds)
 
+		a.So(err, should.BeNil)
+		if a.So(list, should.NotBeNil) && a.So(list.Organizations, should.HaveLength, 1) {
+			a.So(list.Organizations[0].OrganizationIdentifiers, should.Resemble, nested.OrganizationIdentifiers)
+		}
+
+		rights, err := access.ListRights(ctx, &nested.OrganizationIdentifiers, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(rights, should.NotBeNil) {
+			a.So(rights.Rights, should.Contain, ttnpb.RIGHT_ORGANIZATION_INFO)
+			if a.So(rights.GrantedBy, should.HaveLength, 1) {
+				a.So(rights.GrantedBy[0].Path, should.Resemble, []ttnpb.OrganizationOrUserIdentifiers{
+					*userID.OrganizationOrUserIdentifiers(),
+					*org.OrganizationOrUserIdentifiers(),
+				})
+				a.So(rights.GrantedBy[0].Rights, should.Resemble, rights.Sorted().Rights)
+			}
+		}
+
+		_, err = access.SetCollaborator(ctx, &ttnpb.SetOrganizationCollaboratorRequest{
+			OrganizationIdentifiers: org.OrganizationIdentifiers,
+			Collaborator: ttnpb.Collaborator{
+				OrganizationOrUserIdentifiers: *nested.OrganizationOrUserIdentifiers(),
+				Rights:                        []ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_INFO},
+			},
+		}, creds)
+
 		if a.So(err, should.NotBeNil) {
 			a.So(errors.IsInvalidArgument(err), should.BeTrue)
 		}
+
+		_, err = reg.Delete(ctx, &nested.OrganizationIdentifiers, creds)
+
+		a.So(err, should.BeNil)
 	})
 }
 
@@ -0,0 +1,286 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// QuotasConfig is the configuration of the default quotas of organizations and users.
+// Admins can override the quotas of individual organizations and users.
+type QuotasConfig struct {
+	Applications             uint32 `name:"applications" description:"Maximum number of applications of an organization or user (0 is unlimited)"`
+	Gateways                 uint32 `name:"gateways" description:"Maximum number of gateways of an organization or user (0 is unlimited)"`
+	EndDevicesPerApplication uint32 `name:"end-devices-per-application" description:"Maximum number of end devices in each application (0 is unlimited)"`
+	APIKeys                  uint32 `name:"api-keys" description:"Maximum number of API keys of each entity (0 is unlimited)"`
+	Collaborators            uint32 `name:"collaborators" description:"Maximum number of collaborators of each entity (0 is unlimited)"`
+	Organizations            uint32 `name:"organizations" description:"Maximum number of organizations of an organization or user (0 is unlimited)"`
+}
+
+func (c QuotasConfig) toPB() *ttnpb.Quotas {
+	return &ttnpb.Quotas{
+		Applications:             c.Applications,
+		Gateways:                 c.Gateways,
+		EndDevicesPerApplication: c.EndDevicesPerApplication,
+		APIKeys:                  c.APIKeys,
+		Collaborators:            c.Collaborators,
+		Organizations:            c.Organizations,
+	}
+}
+
+var errQuotaExceeded = errors.DefineResourceExhausted("quota_exceeded", "quota of {quota} {resource} exceeded for {entity_type} `{entity_id}`")
+
+func checkQuota(entityID ttnpb.Identifiers, resource string, quota uint32, usage uint64) error {
+	if quota == 0 || usage < uint64(quota) {
+		return nil
+	}
+	return errQuotaExceeded.WithAttributes(
+		"quota", quota,
+		"resource", resource,
+		"entity_type", entityID.EntityType(),
+		"entity_id", entityID.IDString(),
+	)
+}
+
+// getQuotas returns the quotas of the organization or user, and the paths of
+// the quotas that are overridden.
+func (is *IdentityServer) getQuotas(ctx context.Context, db *gorm.DB, id *ttnpb.OrganizationOrUserIdentifiers) (*ttnpb.Quotas, []string, error) {
+	quotas := is.configFromContext(ctx).Quotas.toPB()
+	overrides, paths, err := store.GetQuotaStore(db).GetQuotas(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = quotas.SetFields(overrides, paths...); err != nil {
+		return nil, nil, err
+	}
+	return quotas, paths, nil
+}
+
+// getEntityQuota returns the quota that applies to the entity. The quotas of
+// organizations and users apply to themselves. Other entities are charged to
+// their owner, which is the organization or user that was first given all rights.
+// Adding collaborators to an entity does not change the quota that applies to it.
+func (is *IdentityServer) getEntityQuota(ctx context.Context, db *gorm.DB, entityID ttnpb.Identifiers, quota func(*ttnpb.Quotas) uint32) (uint32, error) {
+	var owner *ttnpb.OrganizationOrUserIdentifiers
+	switch entityID.EntityType() {
+	case "organization":
+		owner = ttnpb.OrganizationIdentifiers{OrganizationID: entityID.IDString()}.OrganizationOrUserIdentifiers()
+	case "user":
+		owner = ttnpb.UserIdentifiers{UserID: entityID.IDString()}.OrganizationOrUserIdentifiers()
+	default:
+		var err error
+		owner, err = store.GetQuotaStore(db).FindOwner(ctx, entityID)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if owner == nil {
+		return quota(is.configFromContext(ctx).Quotas.toPB()), nil
+	}
+	quotas, _, err := is.getQuotas(ctx, db, owner)
+	if err != nil {
+		return 0, err
+	}
+	return quota(quotas), nil
+}
+
+// lockQuotaUsage locks the entity until the end of the transaction in db, so that
+// the usage counted by concurrent quota checks can not change before the entity
+// that is checked for is created.
+func lockQuotaUsage(ctx context.Context, db *gorm.DB, entityID ttnpb.Identifiers) error {
+	return store.GetQuotaStore(db).LockEntity(ctx, entityID)
+}
+
+// checkOwnershipQuota returns an error if the organization or user can not
+// own another entity of the given type.
+func (is *IdentityServer) checkOwnershipQuota(ctx context.Context, db *gorm.DB, id *ttnpb.OrganizationOrUserIdentifiers, entityType string) error {
+	quotas, _, err := is.getQuotas(ctx, db, id)
+	if err != nil {
+		return err
+	}
+	var quota uint32
+	switch entityType {
+	case "application":
+		quota = quotas.Applications
+	case "gateway":
+		quota = quotas.Gateways
+	case "organization":
+		quota = quotas.Organizations
+	}
+	if quota == 0 {
+		return nil
+	}
+	if err = lockQuotaUsage(ctx, db, id); err != nil {
+		return err
+	}
+	usage, err := store.GetQuotaStore(db).CountOwnedEntities(ctx, id, entityType)
+	if err != nil {
+		return err
+	}
+	return checkQuota(id, entityType+"s", quota, usage)
+}
+
+// checkEndDeviceQuota returns an error if no more end devices can be added to the application.
+func (is *IdentityServer) checkEndDeviceQuota(ctx context.Context, db *gorm.DB, ids ttnpb.ApplicationIdentifiers) error {
+	quota, err := is.getEntityQuota(ctx, db, ids, (*ttnpb.Quotas).GetEndDevicesPerApplication)
+	if err != nil || quota == 0 {
+		return err
+	}
+	if err = lockQuotaUsage(ctx, db, ids); err != nil {
+		return err
+	}
+	usage, err := store.GetEndDeviceStore(db).CountEndDevices(ctx, &ids)
+	if err != nil {
+		return err
+	}
+	return checkQuota(ids, "end devices", quota, usage)
+}
+
+// checkAPIKeyQuota returns an error if no more API keys can be added to the entity.
+func (is *IdentityServer) checkAPIKeyQuota(ctx context.Context, db *gorm.DB, entityID ttnpb.Identifiers) error {
+	quota, err := is.getEntityQuota(ctx, db, entityID, (*ttnpb.Quotas).GetAPIKeys)
+	if err != nil || quota == 0 {
+		return err
+	}
+	if err = lockQuotaUsage(ctx, db, entityID); err != nil {
+		return err
+	}
+	keys, err := store.GetAPIKeyStore(db).FindAPIKeys(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	return checkQuota(entityID, "API keys", quota, uint64(len(keys)))
+}
+
+// checkCollaboratorQuota returns an error if no more collaborators can be added to the entity.
+func (is *IdentityServer) checkCollaboratorQuota(ctx context.Context, db *gorm.DB, entityID ttnpb.Identifiers) error {
+	quota, err := is.getEntityQuota(ctx, db, entityID, (*ttnpb.Quotas).GetCollaborators)
+	if err != nil || quota == 0 {
+		return err
+	}
+	if err = lockQuotaUsage(ctx, db, entityID); err != nil {
+		return err
+	}
+	members, err := is.getMembershipStore(ctx, db).FindMembers(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	return checkQuota(entityID, "collaborators", quota, uint64(len(members)))
+}
+
+func (is *IdentityServer) requireAccountRights(ctx context.Context, id ttnpb.OrganizationOrUserIdentifiers) error {
+	if is.IsAdmin(ctx) {
+		return nil
+	}
+	if usrIDs := id.GetUserIDs(); usrIDs != nil {
+		return rights.RequireUser(ctx, *usrIDs, ttnpb.RIGHT_USER_INFO)
+	}
+	return rights.RequireOrganization(ctx, *id.GetOrganizationIDs(), ttnpb.RIGHT_ORGANIZATION_INFO)
+}
+
+func (is *IdentityServer) quotaUsage(ctx context.Context, db *gorm.DB, id *ttnpb.OrganizationOrUserIdentifiers) (*ttnpb.QuotaUsage, error) {
+	quotas, paths, err := is.getQuotas(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.QuotaUsage{Quotas: *quotas}
+	res.Overrides.Paths = paths
+	quotaStore := store.GetQuotaStore(db)
+	applications, err := quotaStore.CountOwnedEntities(ctx, id, "application")
+	if err != nil {
+		return nil, err
+	}
+	gateways, err := quotaStore.CountOwnedEntities(ctx, id, "gateway")
+	if err != nil {
+		return nil, err
+	}
+	organizations, err := quotaStore.CountOwnedEntities(ctx, id, "organization")
+	if err != nil {
+		return nil, err
+	}
+	endDevices, err := quotaStore.MaxEndDevicesPerApplication(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := store.GetAPIKeyStore(db).FindAPIKeys(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	res.Usage = ttnpb.Quotas{
+		Applications:             uint32(applications),
+		Gateways:                 uint32(gateways),
+		EndDevicesPerApplication: uint32(endDevices),
+		APIKeys:                  uint32(len(keys)),
+		Organizations:            uint32(organizations),
+	}
+	if orgIDs := id.GetOrganizationIDs(); orgIDs != nil {
+		members, err := is.getMembershipStore(ctx, db).FindMembers(ctx, orgIDs)
+		if err != nil {
+			return nil, err
+		}
+		res.Usage.Collaborators = uint32(len(members))
+	}
+	return res, nil
+}
+
+func (is *IdentityServer) getQuotaUsage(ctx context.Context, req *ttnpb.GetQuotaUsageRequest) (res *ttnpb.QuotaUsage, err error) {
+	if err = is.requireAccountRights(ctx, req.Account); err != nil {
+		return nil, err
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		res, err = is.quotaUsage(ctx, db, &req.Account)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (is *IdentityServer) setQuotas(ctx context.Context, req *ttnpb.SetQuotasRequest) (res *ttnpb.QuotaUsage, err error) {
+	if err = is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	paths := cleanFieldMaskPaths(ttnpb.QuotasFieldPathsTopLevel, req.FieldMask.Paths, nil, nil)
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		if err = store.GetQuotaStore(db).SetQuotas(ctx, &req.Account, &req.Quotas, paths); err != nil {
+			return err
+		}
+		res, err = is.quotaUsage(ctx, db, &req.Account)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type quotaRegistry struct {
+	*IdentityServer
+}
+
+func (qr *quotaRegistry) GetUsage(ctx context.Context, req *ttnpb.GetQuotaUsageRequest) (*ttnpb.QuotaUsage, error) {
+	return qr.getQuotaUsage(ctx, req)
+}
+
+func (qr *quotaRegistry) Set(ctx context.Context, req *ttnpb.SetQuotasRequest) (*ttnpb.QuotaUsage, error) {
+	return qr.setQuotas(ctx, req)
+}
@@ -0,0 +1,184 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestQuotas(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		adminCreds := userCreds(adminUserIdx)
+		reg := ttnpb.NewQuotaRegistryClient(cc)
+
+		org, err := ttnpb.NewOrganizationRegistryClient(cc).Create(ctx, &ttnpb.CreateOrganizationRequest{
+			Organization: ttnpb.Organization{
+				OrganizationIdentifiers: ttnpb.OrganizationIdentifiers{OrganizationID: "quota-org"},
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+		a.So(err, should.BeNil)
+		if !a.So(org, should.NotBeNil) {
+			t.FailNow()
+		}
+		orgID := *org.OrganizationOrUserIdentifiers()
+
+		_, err = reg.Set(ctx, &ttnpb.SetQuotasRequest{
+			Account:   orgID,
+			Quotas:    ttnpb.Quotas{Applications: 1},
+			FieldMask: types.FieldMask{Paths: []string{"applications"}},
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		usage, err := reg.Set(ctx, &ttnpb.SetQuotasRequest{
+			Account: orgID,
+			Quotas: ttnpb.Quotas{
+				Applications:             1,
+				EndDevicesPerApplication: 1,
+				APIKeys:                  1,
+				Collaborators:            1,
+			},
+			FieldMask: types.FieldMask{Paths: []string{
+				"applications", "end_devices_per_application", "api_keys", "collaborators",
+			}},
+		}, adminCreds)
+		a.So(err, should.BeNil)
+		if a.So(usage, should.NotBeNil) {
+			a.So(usage.Quotas.Applications, should.Equal, 1)
+			a.So(usage.Overrides.Paths, should.Resemble, []string{
+				"api_keys", "applications", "collaborators", "end_devices_per_application",
+			})
+			a.So(usage.Usage.Collaborators, should.Equal, 1)
+		}
+
+		_, err = reg.GetUsage(ctx, &ttnpb.GetQuotaUsageRequest{Account: orgID}, userCreds(collaboratorUserIdx))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		appReg := ttnpb.NewApplicationRegistryClient(cc)
+		for i, appID := range []string{"quota-app-1", "quota-app-2"} {
+			_, err = appReg.Create(ctx, &ttnpb.CreateApplicationRequest{
+				Application: ttnpb.Application{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: appID},
+				},
+				Collaborator: orgID,
+			}, creds)
+			if i == 0 {
+				a.So(err, should.BeNil)
+			} else if a.So(err, should.NotBeNil) {
+				a.So(errors.IsResourceExhausted(err), should.BeTrue)
+			}
+		}
+
+		devReg := ttnpb.NewEndDeviceRegistryClient(cc)
+		for i, devID := range []string{"quota-dev-1", "quota-dev-2"} {
+			_, err = devReg.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+				EndDevice: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "quota-app-1"},
+						DeviceID:               devID,
+					},
+				},
+			}, creds)
+			if i == 0 {
+				a.So(err, should.BeNil)
+			} else if a.So(err, should.NotBeNil) {
+				a.So(errors.IsResourceExhausted(err), should.BeTrue)
+			}
+		}
+
+		orgAccess := ttnpb.NewOrganizationAccessClient(cc)
+		for i := 0; i < 2; i++ {
+			_, err = orgAccess.CreateAPIKey(ctx, &ttnpb.CreateOrganizationAPIKeyRequest{
+				OrganizationIdentifiers: org.OrganizationIdentifiers,
+				Rights:                  []ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_INFO},
+			}, creds)
+			if i == 0 {
+				a.So(err, should.BeNil)
+			} else if a.So(err, should.NotBeNil) {
+				a.So(errors.IsResourceExhausted(err), should.BeTrue)
+			}
+		}
+
+		_, err = orgAccess.SetCollaborator(ctx, &ttnpb.SetOrganizationCollaboratorRequest{
+			OrganizationIdentifiers: org.OrganizationIdentifiers,
+			Collaborator: ttnpb.Collaborator{
+				OrganizationOrUserIdentifiers: *collaboratorUser.OrganizationOrUserIdentifiers(),
+				Rights:                        []ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_INFO},
+			},
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsResourceExhausted(err), should.BeTrue)
+		}
+
+		usage, err = reg.GetUsage(ctx, &ttnpb.GetQuotaUsageRequest{Account: orgID}, creds)
+		a.So(err, should.BeNil)
+		if a.So(usage, should.NotBeNil) {
+			a.So(usage.Usage, should.Resemble, ttnpb.Quotas{
+				Applications:             1,
+				EndDevicesPerApplication: 1,
+				APIKeys:                  1,
+				Collaborators:            1,
+			})
+		}
+
+		usage, err = reg.Set(ctx, &ttnpb.SetQuotasRequest{Account: orgID}, adminCreds)
+		a.So(err, should.BeNil)
+		if a.So(usage, should.NotBeNil) {
+			a.So(usage.Quotas, should.Resemble, ttnpb.Quotas{})
+			a.So(usage.Overrides.Paths, should.BeEmpty)
+		}
+
+		// Limit the user to the organizations that it already owns.
+		usage, err = reg.GetUsage(ctx, &ttnpb.GetQuotaUsageRequest{Account: *userID.OrganizationOrUserIdentifiers()}, creds)
+		if !a.So(err, should.BeNil) || !a.So(usage.Usage.Organizations, should.BeGreaterThanOrEqualTo, 1) {
+			t.FailNow()
+		}
+		_, err = reg.Set(ctx, &ttnpb.SetQuotasRequest{
+			Account:   *userID.OrganizationOrUserIdentifiers(),
+			Quotas:    ttnpb.Quotas{Organizations: usage.Usage.Organizations},
+			FieldMask: types.FieldMask{Paths: []string{"organizations"}},
+		}, adminCreds)
+		a.So(err, should.BeNil)
+
+		_, err = ttnpb.NewOrganizationRegistryClient(cc).Create(ctx, &ttnpb.CreateOrganizationRequest{
+			Organization: ttnpb.Organization{
+				OrganizationIdentifiers: ttnpb.OrganizationIdentifiers{OrganizationID: "quota-org-2"},
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsResourceExhausted(err), should.BeTrue)
+		}
+
+		_, err = reg.Set(ctx, &ttnpb.SetQuotasRequest{Account: *userID.OrganizationOrUserIdentifiers()}, adminCreds)
+		a.So(err, should.BeNil)
+	})
+}
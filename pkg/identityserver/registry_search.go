@@ -16,6 +16,8 @@ package identityserver
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
@@ -28,7 +30,14 @@ type registrySearch struct {
 	*IdentityServer
 }
 
-var errSearchForbidden = errors.DefinePermissionDenied("search_forbidden", "search is forbidden")
+var (
+	errSearchForbidden = errors.DefinePermissionDenied("search_forbidden", "search is forbidden")
+	errOrderByDistance = errors.DefineInvalidArgument("order_by_distance", "ordering by distance requires a location radius")
+)
+
+func orderByDistance(order string) bool {
+	return strings.TrimPrefix(order, "-") == "distance"
+}
 
 func (rs *registrySearch) memberForSearch(ctx context.Context) (*ttnpb.OrganizationOrUserIdentifiers, error) {
 	authInfo, err := rs.authInfo(ctx)
@@ -131,11 +140,14 @@ func (rs *registrySearch) SearchClients(ctx context.Context, req *ttnpb.SearchEn
 	return res, nil
 }
 
-func (rs *registrySearch) SearchGateways(ctx context.Context, req *ttnpb.SearchEntitiesRequest) (*ttnpb.Gateways, error) {
+func (rs *registrySearch) SearchGateways(ctx context.Context, req *ttnpb.SearchGatewaysRequest) (*ttnpb.Gateways, error) {
 	member, err := rs.memberForSearch(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if orderByDistance(req.Order) && req.LocationRadius == nil {
+		return nil, errOrderByDistance.New()
+	}
 	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.GatewayFieldPathsNested, req.FieldMask.Paths, getPaths, nil)
 	ctx = store.WithOrder(ctx, req.Order)
 	var total uint64
@@ -147,13 +159,12 @@ func (rs *registrySearch) SearchGateways(ctx context.Context, req *ttnpb.SearchE
 	}()
 	res := &ttnpb.Gateways{}
 	err = rs.withDatabase(ctx, func(db *gorm.DB) error {
-		entityIDs, err := store.GetEntitySearch(db).FindEntities(ctx, member, req, "gateway")
+		gtwIDs, err := store.GetEntitySearch(db).FindGateways(ctx, member, req)
 		if err != nil {
 			return err
 		}
 		var ids []*ttnpb.GatewayIdentifiers
-		for _, id := range entityIDs {
-			id := id.Identifiers().(*ttnpb.GatewayIdentifiers)
+		for _, id := range gtwIDs {
 			if rights.RequireGateway(ctx, *id, ttnpb.RIGHT_GATEWAY_INFO) == nil {
 				ids = append(ids, id)
 			}
@@ -161,11 +172,23 @@ func (rs *registrySearch) SearchGateways(ctx context.Context, req *ttnpb.SearchE
 		if len(ids) == 0 {
 			return nil
 		}
-		ctx = store.WithPagination(ctx, 0, 0, nil) // Reset pagination (already done in FindEntities).
+		ctx = store.WithPagination(ctx, 0, 0, nil) // Reset pagination (already done in FindGateways).
+		if orderByDistance(req.Order) {
+			ctx = store.WithOrder(ctx, "") // Reset order (the gateways are sorted by distance below).
+		}
 		res.Gateways, err = store.GetGatewayStore(db).FindGateways(ctx, ids, &req.FieldMask)
 		if err != nil {
 			return err
 		}
+		if orderByDistance(req.Order) {
+			index := make(map[string]int, len(ids))
+			for i, id := range ids {
+				index[id.GatewayID] = i
+			}
+			sort.Slice(res.Gateways, func(i, j int) bool {
+				return index[res.Gateways[i].GatewayID] < index[res.Gateways[j].GatewayID]
+			})
+		}
 		return nil
 	})
 	if err != nil {
@@ -268,6 +291,9 @@ func (rs *registrySearch) SearchEndDevices(ctx context.Context, req *ttnpb.Searc
 	if err != nil {
 		return nil, err
 	}
+	if orderByDistance(req.Order) && req.LocationRadius == nil {
+		return nil, errOrderByDistance.New()
+	}
 	req.FieldMask.Paths = cleanFieldMaskPaths(ttnpb.EndDeviceFieldPathsNested, req.FieldMask.Paths, getPaths, nil)
 	ctx = store.WithOrder(ctx, req.Order)
 	var total uint64
@@ -287,10 +313,22 @@ func (rs *registrySearch) SearchEndDevices(ctx context.Context, req *ttnpb.Searc
 			return nil
 		}
 		ctx = store.WithPagination(ctx, 0, 0, nil) // Reset pagination (already done in FindEndDevices).
+		if orderByDistance(req.Order) {
+			ctx = store.WithOrder(ctx, "") // Reset order (the end devices are sorted by distance below).
+		}
 		res.EndDevices, err = store.GetEndDeviceStore(db).FindEndDevices(ctx, ids, &req.FieldMask)
 		if err != nil {
 			return err
 		}
+		if orderByDistance(req.Order) {
+			index := make(map[string]int, len(ids))
+			for i, id := range ids {
+				index[id.DeviceID] = i
+			}
+			sort.Slice(res.EndDevices, func(i, j int) bool {
+				return index[res.EndDevices[i].DeviceID] < index[res.EndDevices[j].DeviceID]
+			})
+		}
 		return nil
 	})
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"google.golang.org/grpc"
@@ -54,7 +55,7 @@ func TestRegistrySearch(t *testing.T) {
 			a.So(clis.Clients, should.NotBeEmpty)
 		}
 
-		gtws, err := cli.SearchGateways(ctx, &ttnpb.SearchEntitiesRequest{
+		gtws, err := cli.SearchGateways(ctx, &ttnpb.SearchGatewaysRequest{
 			DescriptionContains: "random",
 			FieldMask:           types.FieldMask{Paths: []string{"ids"}},
 		}, creds)
@@ -64,6 +65,14 @@ func TestRegistrySearch(t *testing.T) {
 			a.So(gtws.Gateways, should.NotBeEmpty)
 		}
 
+		_, err = cli.SearchGateways(ctx, &ttnpb.SearchGatewaysRequest{
+			Order: "distance",
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
 		orgs, err := cli.SearchOrganizations(ctx, &ttnpb.SearchEntitiesRequest{
 			DescriptionContains: "random",
 			FieldMask:           types.FieldMask{Paths: []string{"ids"}},
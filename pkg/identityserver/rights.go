@@ -88,38 +88,49 @@ func (is *IdentityServer) getRights(ctx context.Context, entityID ttnpb.Identifi
 		// If entityRights already includes all potential rights,
 		// there's nothing more to do.
 		if len(allPotentialRights.Sub(entityRights).GetRights()) == 0 {
+			entityRights.GrantedBy = []*ttnpb.MembershipPath{{
+				Path:   []ttnpb.OrganizationOrUserIdentifiers{*ouID},
+				Rights: entityRights.Sorted().GetRights(),
+			}}
 			return nil
 		}
 
-		// If the caller is not a user, there's nothing more to do.
-		usrID := ouID.GetUserIDs()
-		if usrID == nil {
-			return nil
-		}
-
-		// Find indirect memberships (through organizations).
-		// TODO: Cache this (https://github.com/TheThingsNetwork/lorawan-stack/issues/443).
-		commonOrganizations, err := membershipStore.FindIndirectMemberships(ctx, usrID, entityID)
+		// Find direct and inherited rights (through nested organizations).
+		memberRights, err := membershipStore.GetMemberRights(ctx, ouID, entityID)
 		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
 			return err
 		}
-		for _, commonOrganization := range commonOrganizations {
-			rightsOnOrganization := commonOrganization.RightsOnOrganization.Implied()
-			organizationRights := commonOrganization.OrganizationRights.Implied()
-			entityRights = entityRights.Union(rightsOnOrganization.Intersect(organizationRights))
-		}
-
+		entityRights = memberRights
 		return nil
 	})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	entityRights = entityRights.Intersect(authInfoRights)
+	entityRights = withGrantedBy(entityRights.Intersect(authInfoRights), entityRights)
 
 	return entityRights, universalRights, err
 }
 
+// withGrantedBy adds the memberships of granted through which (some of) the
+// given rights are granted.
+func withGrantedBy(rights, granted *ttnpb.Rights) *ttnpb.Rights {
+	for _, membershipPath := range granted.GetGrantedBy() {
+		pathRights := ttnpb.RightsFrom(membershipPath.Rights...).Intersect(rights)
+		if len(pathRights.GetRights()) == 0 {
+			continue
+		}
+		rights.GrantedBy = append(rights.GrantedBy, &ttnpb.MembershipPath{
+			Path:   membershipPath.Path,
+			Rights: pathRights.Sorted().GetRights(),
+		})
+	}
+	return rights
+}
+
 // ApplicationRights returns the rights the caller has on the given application.
 func (is *IdentityServer) ApplicationRights(ctx context.Context, appIDs ttnpb.ApplicationIdentifiers) (*ttnpb.Rights, error) {
 	entity, universal, err := is.getRights(ctx, appIDs)
@@ -127,7 +138,7 @@ func (is *IdentityServer) ApplicationRights(ctx context.Context, appIDs ttnpb.Ap
 		return nil, err
 	}
 	if entity != nil {
-		return entity.Union(universal), nil
+		return withGrantedBy(entity.Union(universal), entity), nil
 	}
 	if !is.IsAdmin(ctx) && universal == nil {
 		return &ttnpb.Rights{}, nil
@@ -149,7 +160,7 @@ func (is *IdentityServer) ClientRights(ctx context.Context, cliIDs ttnpb.ClientI
 		return nil, err
 	}
 	if entity != nil {
-		return entity.Union(universal), nil
+		return withGrantedBy(entity.Union(universal), entity), nil
 	}
 	if !is.IsAdmin(ctx) && universal == nil {
 		return &ttnpb.Rights{}, nil
@@ -174,7 +185,7 @@ func (is *IdentityServer) GatewayRights(ctx context.Context, gtwIDs ttnpb.Gatewa
 		return nil, err
 	}
 	if entity != nil {
-		return entity.Union(universal), nil
+		return withGrantedBy(entity.Union(universal), entity), nil
 	}
 	if !is.IsAdmin(ctx) && universal == nil {
 		return &ttnpb.Rights{}, nil
@@ -199,7 +210,7 @@ func (is *IdentityServer) OrganizationRights(ctx context.Context, orgIDs ttnpb.O
 		return nil, err
 	}
 	if entity != nil {
-		return entity.Union(universal), nil
+		return withGrantedBy(entity.Union(universal), entity), nil
 	}
 	if !is.IsAdmin(ctx) && universal == nil {
 		return &ttnpb.Rights{}, nil
@@ -224,7 +235,7 @@ func (is *IdentityServer) UserRights(ctx context.Context, userIDs ttnpb.UserIden
 		return nil, err
 	}
 	if entity != nil {
-		return entity.Union(universal), nil
+		return withGrantedBy(entity.Union(universal), entity), nil
 	}
 	if !is.IsAdmin(ctx) && universal == nil {
 		return &ttnpb.Rights{}, nil
@@ -14,7 +14,12 @@
 
 package store
 
-import "go.thethings.network/lorawan-stack/pkg/ttnpb"
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
 
 // APIKey model.
 type APIKey struct {
@@ -26,6 +31,12 @@ type APIKey struct {
 	Rights Rights `gorm:"type:INT ARRAY"`
 	Name   string `gorm:"type:VARCHAR"`
 
+	ExpiresAt       *time.Time     `gorm:"index:api_key_expires_at_index"`
+	AllowedIPRanges pq.StringArray `gorm:"type:VARCHAR ARRAY;column:allowed_ip_ranges"`
+
+	// ExpiryNotifiedAt is the time at which the expiry reminder of the API key was sent.
+	ExpiryNotifiedAt *time.Time
+
 	EntityID   string `gorm:"type:UUID;index:api_key_entity_index;not null"`
 	EntityType string `gorm:"type:VARCHAR(32);index:api_key_entity_index;not null"`
 }
@@ -40,5 +51,8 @@ func (k APIKey) toPB() *ttnpb.APIKey {
 		Key:    k.Key,
 		Name:   k.Name,
 		Rights: k.Rights.Rights,
+
+		ExpiresAt:       cleanTimePtr(k.ExpiresAt),
+		AllowedIPRanges: k.AllowedIPRanges,
 	}
 }
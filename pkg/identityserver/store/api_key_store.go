@@ -17,6 +17,7 @@ package store
 import (
 	"context"
 	"runtime/trace"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/errors"
@@ -45,8 +46,19 @@ func (s *apiKeyStore) CreateAPIKey(ctx context.Context, entityID ttnpb.Identifie
 		Name:       key.Name,
 		EntityID:   entity.PrimaryKey(),
 		EntityType: entityTypeForID(entityID),
+
+		ExpiresAt:       cleanTimePtr(key.ExpiresAt),
+		AllowedIPRanges: key.AllowedIPRanges,
+	}
+	if err = s.createEntity(ctx, model); err != nil {
+		return err
 	}
-	return s.createEntity(ctx, model)
+	return s.writeAuditLog(ctx, auditLogChange{
+		entityID: entityID,
+		action:   "api-key.create",
+		new:      model.toPB(),
+		subject:  map[string]interface{}{"id": key.ID},
+	})
 }
 
 func (s *apiKeyStore) FindAPIKeys(ctx context.Context, entityID ttnpb.Identifiers) ([]*ttnpb.APIKey, error) {
@@ -122,13 +134,78 @@ func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifie
 		}
 		return nil, err
 	}
+	old := keyModel.toPB()
 	if len(key.Rights) == 0 {
-		return nil, query.Delete(&keyModel).Error
+		if err = query.Delete(&keyModel).Error; err != nil {
+			return nil, err
+		}
+		return nil, s.writeAuditLog(ctx, auditLogChange{
+			entityID: entityID,
+			action:   "api-key.delete",
+			old:      old,
+			subject:  map[string]interface{}{"id": key.ID},
+		})
 	}
 	keyModel.Name = key.Name
 	keyModel.Rights = Rights{Rights: key.Rights}
 	if err = query.Select("name", "rights", "updated_at").Save(&keyModel).Error; err != nil {
 		return nil, err
 	}
-	return keyModel.toPB(), nil
+	updated := keyModel.toPB()
+	if err = s.writeAuditLog(ctx, auditLogChange{
+		entityID: entityID,
+		action:   "api-key.update",
+		old:      old,
+		new:      updated,
+		paths:    []string{"name", "rights"},
+		subject:  map[string]interface{}{"id": key.ID},
+	}); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (s *apiKeyStore) FindExpiringAPIKeys(ctx context.Context, expiresAfter, expiresBefore time.Time) ([]*ttnpb.AuthInfoResponse_APIKeyAccess, error) {
+	defer trace.StartRegion(ctx, "find expiring api keys").End()
+	var keyModels []APIKey
+	err := s.query(ctx, APIKey{}).
+		Where("expires_at > ? AND expires_at <= ?", cleanTime(expiresAfter), cleanTime(expiresBefore)).
+		Where("expiry_notified_at IS NULL").
+		Order("expires_at").
+		Find(&keyModels).Error
+	if err != nil {
+		return nil, err
+	}
+	entities := make([]polymorphicEntity, len(keyModels))
+	for i, keyModel := range keyModels {
+		entities[i] = polymorphicEntity{EntityType: keyModel.EntityType, EntityUUID: keyModel.EntityID}
+	}
+	identifiers, err := s.findIdentifiers(entities...)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*ttnpb.AuthInfoResponse_APIKeyAccess, 0, len(keyModels))
+	for i, keyModel := range keyModels {
+		ids, ok := identifiers[entities[i]]
+		if !ok {
+			continue // The entity was deleted.
+		}
+		keys = append(keys, &ttnpb.AuthInfoResponse_APIKeyAccess{
+			APIKey:    *keyModel.toPB(),
+			EntityIDs: *ids.EntityIdentifiers(),
+		})
+	}
+	return keys, nil
+}
+
+func (s *apiKeyStore) SetAPIKeyExpiryNotified(ctx context.Context, id string) (bool, error) {
+	defer trace.StartRegion(ctx, "set api key expiry notified").End()
+	res := s.query(ctx, &APIKey{}).
+		Where(APIKey{APIKeyID: id}).
+		Where("expiry_notified_at IS NULL").
+		UpdateColumn("expiry_notified_at", gorm.NowFunc())
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
 }
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"github.com/smartystreets/assertions"
@@ -145,3 +146,61 @@ func TestAPIKeyStore(t *testing.T) {
 		}
 	})
 }
+
+func TestAPIKeyExpiry(t *testing.T) {
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db,
+			&APIKey{},
+			&Application{},
+		)
+		a := assertions.New(t)
+
+		s := newStore(db)
+		store := GetAPIKeyStore(db)
+
+		s.createEntity(ctx, &Application{ApplicationID: "test-app"})
+		appIDs := &ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+
+		now := cleanTime(time.Now())
+		soon, later := now.Add(time.Hour), now.Add(30*24*time.Hour)
+
+		for _, key := range []*ttnpb.APIKey{
+			{ID: "NOEXPIRYKEYID", Key: "NOEXPIRYKEY", Rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL}},
+			{ID: "SOONKEYID", Key: "SOONKEY", Rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL}, ExpiresAt: &soon},
+			{
+				ID: "LATERKEYID", Key: "LATERKEY", Rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL}, ExpiresAt: &later,
+				AllowedIPRanges: []string{"192.0.2.0/24", "2001:db8::/32"},
+			},
+		} {
+			err := store.CreateAPIKey(ctx, appIDs, key)
+			a.So(err, should.BeNil)
+		}
+
+		_, got, err := store.GetAPIKey(ctx, "LATERKEYID")
+		if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+			a.So(*got.ExpiresAt, should.Equal, later)
+			a.So(got.AllowedIPRanges, should.Resemble, []string{"192.0.2.0/24", "2001:db8::/32"})
+		}
+
+		expiring, err := store.FindExpiringAPIKeys(ctx, now, now.Add(24*time.Hour))
+		a.So(err, should.BeNil)
+		if a.So(expiring, should.HaveLength, 1) {
+			a.So(expiring[0].APIKey.ID, should.Equal, "SOONKEYID")
+			a.So(expiring[0].EntityIDs.GetApplicationIDs(), should.Resemble, appIDs)
+		}
+
+		notified, err := store.SetAPIKeyExpiryNotified(ctx, "SOONKEYID")
+		a.So(err, should.BeNil)
+		a.So(notified, should.BeTrue)
+
+		notified, err = store.SetAPIKeyExpiryNotified(ctx, "SOONKEYID")
+		a.So(err, should.BeNil)
+		a.So(notified, should.BeFalse)
+
+		expiring, err = store.FindExpiringAPIKeys(ctx, now, now.Add(24*time.Hour))
+		a.So(err, should.BeNil)
+		a.So(expiring, should.BeEmpty)
+	})
+}
@@ -74,6 +74,13 @@ func (s *applicationStore) CreateApplication(ctx context.Context, app *ttnpb.App
 	}
 	var appProto ttnpb.Application
 	appModel.toPB(&appProto, nil)
+	if err := s.writeAuditLog(ctx, auditLogChange{
+		entityID: app.ApplicationIdentifiers,
+		action:   "create",
+		new:      &appProto,
+	}); err != nil {
+		return nil, err
+	}
 	return &appProto, nil
 }
 
@@ -135,6 +142,8 @@ func (s *applicationStore) UpdateApplication(ctx context.Context, app *ttnpb.App
 	if err := ctx.Err(); err != nil { // Early exit if context canceled
 		return nil, err
 	}
+	old := &ttnpb.Application{}
+	appModel.toPB(old, fieldMask)
 	oldAttributes := appModel.Attributes
 	columns := appModel.fromPB(app, fieldMask)
 	if err = s.updateEntity(ctx, &appModel, columns...); err != nil {
@@ -147,6 +156,15 @@ func (s *applicationStore) UpdateApplication(ctx context.Context, app *ttnpb.App
 	}
 	updated = &ttnpb.Application{}
 	appModel.toPB(updated, fieldMask)
+	if err = s.writeAuditLog(ctx, auditLogChange{
+		entityID: app.ApplicationIdentifiers,
+		action:   "update",
+		old:      old,
+		new:      updated,
+		paths:    fieldMask.GetPaths(),
+	}); err != nil {
+		return nil, err
+	}
 	return updated, nil
 }
 
@@ -154,3 +172,13 @@ func (s *applicationStore) DeleteApplication(ctx context.Context, id *ttnpb.Appl
 	defer trace.StartRegion(ctx, "delete application").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *applicationStore) RestoreApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error {
+	defer trace.StartRegion(ctx, "restore application").End()
+	return s.restoreEntity(ctx, id)
+}
+
+func (s *applicationStore) PurgeApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error {
+	defer trace.StartRegion(ctx, "purge application").End()
+	return s.purgeEntity(ctx, id)
+}
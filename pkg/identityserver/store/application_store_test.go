@@ -128,5 +128,49 @@ func TestApplicationStore(t *testing.T) {
 
 		a.So(err, should.BeNil)
 		a.So(list, should.BeEmpty)
+
+		list, err = store.FindApplications(WithSoftDeleted(ctx, true), nil, nil)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.HaveLength, 1) {
+			a.So(list[0].ApplicationID, should.Equal, "foo")
+		}
+
+		list, err = store.FindApplications(WithSoftDeletedBefore(ctx, time.Now().Add(-1*time.Hour)), nil, nil)
+
+		a.So(err, should.BeNil)
+		a.So(list, should.BeEmpty)
+
+		err = store.RestoreApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		got, err = store.GetApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}, nil)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.ApplicationID, should.Equal, "foo")
+		}
+
+		err = store.RestoreApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"})
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.PurgeApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"})
+
+		a.So(err, should.BeNil)
+
+		list, err = store.FindApplications(WithSoftDeleted(ctx, false), nil, nil)
+
+		a.So(err, should.BeNil)
+		a.So(list, should.BeEmpty)
+
+		_, err = store.CreateApplication(ctx, &ttnpb.Application{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo"},
+		})
+
+		a.So(err, should.BeNil)
 	})
 }
@@ -0,0 +1,147 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// AuditLogEntry model.
+type AuditLogEntry struct {
+	ID        string    `gorm:"type:UUID;primary_key;default:gen_random_uuid()"`
+	CreatedAt time.Time `gorm:"index:audit_log_entry_created_at_index;not null"`
+
+	// EntityType and EntityUID identify the entity by its (friendly) unique ID,
+	// so that the entries remain meaningful after the entity is purged.
+	EntityType string `gorm:"type:VARCHAR(32);index:audit_log_entry_entity_index;not null"`
+	EntityUID  string `gorm:"type:VARCHAR;index:audit_log_entry_entity_index;not null"`
+
+	Action string `gorm:"type:VARCHAR(50);not null"`
+
+	ActorUserID   string `gorm:"type:VARCHAR;index:audit_log_entry_actor_user_index"`
+	ActorAPIKeyID string `gorm:"type:VARCHAR"`
+	ActorClientID string `gorm:"type:VARCHAR"`
+	RemoteIP      string `gorm:"type:VARCHAR"`
+
+	FieldMask pq.StringArray `gorm:"type:VARCHAR ARRAY"`
+	OldValues string         `gorm:"type:VARCHAR"`
+	NewValues string         `gorm:"type:VARCHAR"`
+}
+
+func init() {
+	registerModel(&AuditLogEntry{})
+}
+
+func entityIdentifiersForUID(entityType, uid string) *ttnpb.EntityIdentifiers {
+	switch entityType {
+	case "application":
+		return ttnpb.ApplicationIdentifiers{ApplicationID: uid}.EntityIdentifiers()
+	case "client":
+		return ttnpb.ClientIdentifiers{ClientID: uid}.EntityIdentifiers()
+	case "end_device":
+		appID, devID := splitEndDeviceIDString(uid)
+		return ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: appID},
+			DeviceID:               devID,
+		}.EntityIdentifiers()
+	case "gateway":
+		return ttnpb.GatewayIdentifiers{GatewayID: uid}.EntityIdentifiers()
+	case "organization":
+		return ttnpb.OrganizationIdentifiers{OrganizationID: uid}.EntityIdentifiers()
+	case "user":
+		return ttnpb.UserIdentifiers{UserID: uid}.EntityIdentifiers()
+	default:
+		return nil
+	}
+}
+
+func auditLogValuesToPB(values string) *types.Struct {
+	if values == "" {
+		return nil
+	}
+	var pb types.Struct
+	if err := jsonpb.TTN().Unmarshal([]byte(values), &pb); err != nil {
+		return nil
+	}
+	return &pb
+}
+
+func (e AuditLogEntry) toPB() *ttnpb.AuditLogEntry {
+	pb := &ttnpb.AuditLogEntry{
+		CreatedAt: cleanTime(e.CreatedAt),
+		Actor: &ttnpb.AuditLogActor{
+			APIKeyID: e.ActorAPIKeyID,
+			RemoteIP: e.RemoteIP,
+		},
+		EntityIDs: entityIdentifiersForUID(e.EntityType, e.EntityUID),
+		Action:    e.Action,
+		FieldMask: types.FieldMask{Paths: e.FieldMask},
+		OldValues: auditLogValuesToPB(e.OldValues),
+		NewValues: auditLogValuesToPB(e.NewValues),
+	}
+	if e.ActorUserID != "" {
+		pb.Actor.UserIDs = &ttnpb.UserIdentifiers{UserID: e.ActorUserID}
+	}
+	if e.ActorClientID != "" {
+		pb.Actor.ClientIDs = &ttnpb.ClientIdentifiers{ClientID: e.ActorClientID}
+	}
+	return pb
+}
+
+type auditActorKeyType struct{}
+
+var auditActorKey auditActorKeyType
+
+// WithAuditActor returns a context in which the changes made by the store are
+// attributed to the actor returned by f. The function is called (with the
+// context of the store call) only when an audit log entry is written.
+func WithAuditActor(ctx context.Context, f func(context.Context) *ttnpb.AuditLogActor) context.Context {
+	return context.WithValue(ctx, auditActorKey, f)
+}
+
+func auditActorFromContext(ctx context.Context) *ttnpb.AuditLogActor {
+	if f, ok := ctx.Value(auditActorKey).(func(context.Context) *ttnpb.AuditLogActor); ok {
+		return f(ctx)
+	}
+	return nil
+}
+
+// auditLogSecretFields are the (top-level) fields of which the values are never
+// written to the audit log.
+var auditLogSecretFields = map[string]bool{
+	"claim_authentication_code": true,
+	"key":                       true,
+	"password":                  true,
+	"secret":                    true,
+	"temporary_password":        true,
+}
+
+// auditLogIgnoredFields are the (top-level) fields that are not interesting
+// for the audit log.
+var auditLogIgnoredFields = map[string]bool{
+	"created_at": true,
+	"ids":        true,
+	"updated_at": true,
+}
+
+func auditLogField(path string) bool {
+	return !auditLogSecretFields[path] && !auditLogIgnoredFields[path]
+}
@@ -0,0 +1,193 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"runtime/trace"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// GetAuditLogStore returns an AuditLogStore on the given db (or transaction).
+func GetAuditLogStore(db *gorm.DB) AuditLogStore {
+	return &auditLogStore{store: newStore(db)}
+}
+
+type auditLogStore struct {
+	*store
+}
+
+// auditLogValues returns the JSON values of the top-level fields of pb.
+// Enums are marshaled as strings, so that the audit log remains readable.
+func auditLogValues(pb proto.Message) (map[string]interface{}, error) {
+	if pb == nil {
+		return nil, nil
+	}
+	b, err := (&jsonpb.GoGoJSONPb{OrigName: true}).Marshal(pb)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]interface{}
+	if err = json.Unmarshal(b, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func marshalAuditLogValues(values map[string]interface{}) (string, error) {
+	if len(values) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// auditLogChange is a change to an entity that is written to the audit log.
+type auditLogChange struct {
+	entityID ttnpb.Identifiers
+	action   string
+	// old and new are the entity (or API key, or collaborator) before and after the change.
+	old, new proto.Message
+	// paths are the paths that may have changed. If empty, all fields of old and new are considered.
+	paths []string
+	// subject is added to the values to identify the API key or collaborator that was changed.
+	subject map[string]interface{}
+}
+
+// writeAuditLog writes the change to the audit log. The values of secret fields
+// are never written, and updates that do not change any field are not written.
+func (s *store) writeAuditLog(ctx context.Context, change auditLogChange) error {
+	oldValues, err := auditLogValues(change.old)
+	if err != nil {
+		return err
+	}
+	newValues, err := auditLogValues(change.new)
+	if err != nil {
+		return err
+	}
+	paths := ttnpb.TopLevelFields(change.paths)
+	if len(paths) == 0 {
+		for path := range oldValues {
+			paths = append(paths, path)
+		}
+		for path := range newValues {
+			if _, ok := oldValues[path]; !ok {
+				paths = append(paths, path)
+			}
+		}
+		sort.Strings(paths)
+	}
+	var changed []string
+	changedOld, changedNew := make(map[string]interface{}), make(map[string]interface{})
+	for _, path := range paths {
+		if !auditLogField(path) {
+			continue
+		}
+		oldValue, newValue := oldValues[path], newValues[path]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		changed = append(changed, path)
+		if change.old != nil {
+			changedOld[path] = oldValue
+		}
+		if change.new != nil {
+			changedNew[path] = newValue
+		}
+	}
+	if len(changed) == 0 && change.old != nil && change.new != nil {
+		return nil // Nothing changed.
+	}
+	for k, v := range change.subject {
+		if change.old != nil {
+			changedOld[k] = v
+		}
+		if change.new != nil {
+			changedNew[k] = v
+		}
+	}
+	entry := &AuditLogEntry{
+		// Not truncated to milliseconds like other timestamps, so that entries
+		// that are written in quick succession are still ordered correctly.
+		CreatedAt:  time.Now().UTC(),
+		EntityType: entityTypeForID(change.entityID),
+		EntityUID:  change.entityID.IDString(),
+		Action:     change.action,
+		FieldMask:  changed,
+	}
+	if entry.OldValues, err = marshalAuditLogValues(changedOld); err != nil {
+		return err
+	}
+	if entry.NewValues, err = marshalAuditLogValues(changedNew); err != nil {
+		return err
+	}
+	if actor := auditActorFromContext(ctx); actor != nil {
+		entry.ActorUserID = actor.GetUserIDs().GetUserID()
+		entry.ActorAPIKeyID = actor.APIKeyID
+		entry.ActorClientID = actor.GetClientIDs().GetClientID()
+		entry.RemoteIP = actor.RemoteIP
+	}
+	return s.DB.Create(entry).Error
+}
+
+func (s *auditLogStore) FindAuditLogEntries(ctx context.Context, req *ttnpb.ListAuditLogRequest) ([]*ttnpb.AuditLogEntry, error) {
+	defer trace.StartRegion(ctx, "find audit log entries").End()
+	query := s.query(ctx, AuditLogEntry{})
+	if ids := req.GetEntityIDs(); ids != nil {
+		entityID := ids.Identifiers()
+		query = query.Where(&AuditLogEntry{
+			EntityType: entityTypeForID(entityID),
+			EntityUID:  entityID.IDString(),
+		})
+	}
+	if req.Action != "" {
+		query = query.Where(&AuditLogEntry{Action: req.Action})
+	}
+	if ids := req.GetActorUserIDs(); ids != nil {
+		query = query.Where(&AuditLogEntry{ActorUserID: ids.GetUserID()})
+	}
+	if req.After != nil {
+		query = query.Where("created_at > ?", cleanTime(*req.After))
+	}
+	if req.Before != nil {
+		query = query.Where("created_at < ?", cleanTime(*req.Before))
+	}
+	query = query.Order("created_at DESC")
+	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
+		countTotal(ctx, query)
+		query = query.Limit(limit).Offset(offset)
+	}
+	var entryModels []AuditLogEntry
+	if err := query.Find(&entryModels).Error; err != nil {
+		return nil, err
+	}
+	setTotal(ctx, uint64(len(entryModels)))
+	entryProtos := make([]*ttnpb.AuditLogEntry, len(entryModels))
+	for i, entry := range entryModels {
+		entryProtos[i] = entry.toPB()
+	}
+	return entryProtos, nil
+}
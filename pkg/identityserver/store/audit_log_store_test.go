@@ -0,0 +1,137 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestAuditLogStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := WithAuditActor(test.Context(), func(context.Context) *ttnpb.AuditLogActor {
+		return &ttnpb.AuditLogActor{
+			UserIDs:  &ttnpb.UserIdentifiers{UserID: "test-user"},
+			RemoteIP: "192.0.2.1",
+		}
+	})
+
+	start := time.Now().Add(-time.Second)
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &AuditLogEntry{}, &Gateway{}, &APIKey{})
+
+		gtwStore := GetGatewayStore(db)
+		keyStore := GetAPIKeyStore(db)
+		s := GetAuditLogStore(db)
+
+		ids := ttnpb.GatewayIdentifiers{GatewayID: "foo"}
+
+		_, err := gtwStore.CreateGateway(ctx, &ttnpb.Gateway{
+			GatewayIdentifiers: ids,
+			Name:               "Foo Gateway",
+		})
+		a.So(err, should.BeNil)
+
+		_, err = gtwStore.UpdateGateway(ctx, &ttnpb.Gateway{
+			GatewayIdentifiers: ids,
+			Name:               "Bar Gateway",
+			Description:        "The Bar Gateway",
+		}, &types.FieldMask{Paths: []string{"name", "description"}})
+		a.So(err, should.BeNil)
+
+		// Updates that don't change anything are not logged.
+		_, err = gtwStore.UpdateGateway(ctx, &ttnpb.Gateway{
+			GatewayIdentifiers: ids,
+			Name:               "Bar Gateway",
+		}, &types.FieldMask{Paths: []string{"name"}})
+		a.So(err, should.BeNil)
+
+		err = keyStore.CreateAPIKey(ctx, ids, &ttnpb.APIKey{
+			ID:     "ABCDEFGHIJKLMNOP",
+			Key:    "secret",
+			Name:   "Foo Key",
+			Rights: []ttnpb.Right{ttnpb.RIGHT_GATEWAY_INFO},
+		})
+		a.So(err, should.BeNil)
+
+		_, err = keyStore.UpdateAPIKey(ctx, ids, &ttnpb.APIKey{
+			ID:     "ABCDEFGHIJKLMNOP",
+			Name:   "Foo Key",
+			Rights: []ttnpb.Right{ttnpb.RIGHT_GATEWAY_ALL},
+		})
+		a.So(err, should.BeNil)
+
+		err = gtwStore.DeleteGateway(ctx, &ids)
+		a.So(err, should.BeNil)
+
+		entries, err := s.FindAuditLogEntries(ctx, &ttnpb.ListAuditLogRequest{
+			EntityIDs: ids.EntityIdentifiers(),
+		})
+		a.So(err, should.BeNil)
+		if !a.So(entries, should.HaveLength, 5) {
+			t.FailNow()
+		}
+
+		for _, entry := range entries {
+			a.So(entry.EntityIDs.GetGatewayIDs().GetGatewayID(), should.Equal, "foo")
+			a.So(entry.Actor.GetUserIDs().GetUserID(), should.Equal, "test-user")
+			a.So(entry.Actor.RemoteIP, should.Equal, "192.0.2.1")
+			a.So(entry.CreatedAt, should.HappenAfter, start)
+			a.So(entry.FieldMask.Paths, should.NotContain, "key")
+		}
+
+		// Newest first.
+		a.So(entries[0].Action, should.Equal, "delete")
+		a.So(entries[1].Action, should.Equal, "api-key.update")
+		a.So(entries[1].FieldMask.Paths, should.Resemble, []string{"rights"})
+		a.So(entries[1].OldValues.Fields["id"].GetStringValue(), should.Equal, "ABCDEFGHIJKLMNOP")
+		a.So(entries[1].NewValues.Fields["rights"].GetListValue().GetValues()[0].GetStringValue(), should.Equal, "RIGHT_GATEWAY_ALL")
+		a.So(entries[2].Action, should.Equal, "api-key.create")
+		a.So(entries[2].NewValues.Fields, should.NotContainKey, "key")
+		a.So(entries[3].Action, should.Equal, "update")
+		a.So(entries[3].FieldMask.Paths, should.Resemble, []string{"name", "description"})
+		a.So(entries[3].OldValues.Fields["name"].GetStringValue(), should.Equal, "Foo Gateway")
+		a.So(entries[3].NewValues.Fields["name"].GetStringValue(), should.Equal, "Bar Gateway")
+		a.So(entries[4].Action, should.Equal, "create")
+
+		entries, err = s.FindAuditLogEntries(ctx, &ttnpb.ListAuditLogRequest{
+			EntityIDs: ids.EntityIdentifiers(),
+			Action:    "update",
+		})
+		a.So(err, should.BeNil)
+		a.So(entries, should.HaveLength, 1)
+
+		entries, err = s.FindAuditLogEntries(ctx, &ttnpb.ListAuditLogRequest{
+			ActorUserIDs: &ttnpb.UserIdentifiers{UserID: "other-user"},
+		})
+		a.So(err, should.BeNil)
+		a.So(entries, should.BeEmpty)
+
+		var total uint64
+		entries, err = s.FindAuditLogEntries(WithPagination(ctx, 2, 1, &total), &ttnpb.ListAuditLogRequest{})
+		a.So(err, should.BeNil)
+		a.So(entries, should.HaveLength, 2)
+		a.So(total, should.Equal, 5)
+	})
+}
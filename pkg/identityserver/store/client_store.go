@@ -74,6 +74,13 @@ func (s *clientStore) CreateClient(ctx context.Context, cli *ttnpb.Client) (*ttn
 	}
 	var cliProto ttnpb.Client
 	cliModel.toPB(&cliProto, nil)
+	if err := s.writeAuditLog(ctx, auditLogChange{
+		entityID: cli.ClientIdentifiers,
+		action:   "create",
+		new:      &cliProto,
+	}); err != nil {
+		return nil, err
+	}
 	return &cliProto, nil
 }
 
@@ -135,6 +142,8 @@ func (s *clientStore) UpdateClient(ctx context.Context, cli *ttnpb.Client, field
 	if err := ctx.Err(); err != nil { // Early exit if context canceled
 		return nil, err
 	}
+	old := &ttnpb.Client{}
+	cliModel.toPB(old, fieldMask)
 	oldAttributes := cliModel.Attributes
 	columns := cliModel.fromPB(cli, fieldMask)
 	if err = s.updateEntity(ctx, &cliModel, columns...); err != nil {
@@ -147,6 +156,15 @@ func (s *clientStore) UpdateClient(ctx context.Context, cli *ttnpb.Client, field
 	}
 	updated = &ttnpb.Client{}
 	cliModel.toPB(updated, fieldMask)
+	if err = s.writeAuditLog(ctx, auditLogChange{
+		entityID: cli.ClientIdentifiers,
+		action:   "update",
+		old:      old,
+		new:      updated,
+		paths:    fieldMask.GetPaths(),
+	}); err != nil {
+		return nil, err
+	}
 	return updated, nil
 }
 
@@ -154,3 +172,13 @@ func (s *clientStore) DeleteClient(ctx context.Context, id *ttnpb.ClientIdentifi
 	defer trace.StartRegion(ctx, "delete client").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *clientStore) RestoreClient(ctx context.Context, id *ttnpb.ClientIdentifiers) error {
+	defer trace.StartRegion(ctx, "restore client").End()
+	return s.restoreEntity(ctx, id)
+}
+
+func (s *clientStore) PurgeClient(ctx context.Context, id *ttnpb.ClientIdentifiers) error {
+	defer trace.StartRegion(ctx, "purge client").End()
+	return s.purgeEntity(ctx, id)
+}
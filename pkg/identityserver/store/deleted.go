@@ -0,0 +1,170 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type deletedOptionsKeyType struct{}
+
+var deletedOptionsKey deletedOptionsKeyType
+
+type deletedOptions struct {
+	onlyDeleted   bool
+	deletedBefore *time.Time
+}
+
+// WithSoftDeleted instructs the store to include soft-deleted entities in the
+// results. If onlyDeleted is true, only soft-deleted entities are returned.
+func WithSoftDeleted(ctx context.Context, onlyDeleted bool) context.Context {
+	return context.WithValue(ctx, deletedOptionsKey, deletedOptions{
+		onlyDeleted: onlyDeleted,
+	})
+}
+
+// WithSoftDeletedBefore instructs the store to only return entities that were
+// soft-deleted before the given time.
+func WithSoftDeletedBefore(ctx context.Context, deletedBefore time.Time) context.Context {
+	return context.WithValue(ctx, deletedOptionsKey, deletedOptions{
+		onlyDeleted:   true,
+		deletedBefore: &deletedBefore,
+	})
+}
+
+func withSoftDeleted(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		opts, ok := ctx.Value(deletedOptionsKey).(deletedOptions)
+		if !ok {
+			return db
+		}
+		db = db.Unscoped()
+		if !opts.onlyDeleted {
+			return db
+		}
+		scope := db.NewScope(db.Value)
+		if !scope.HasColumn("deleted_at") {
+			return db
+		}
+		column := fmt.Sprintf("%s.deleted_at", scope.QuotedTableName())
+		db = db.Where(fmt.Sprintf("%s IS NOT NULL", column))
+		if opts.deletedBefore != nil {
+			db = db.Where(fmt.Sprintf("%s < ?", column), cleanTime(*opts.deletedBefore))
+		}
+		return db
+	}
+}
+
+func (s *store) restoreEntity(ctx context.Context, entityID ttnpb.Identifiers) error {
+	model, err := s.findEntity(WithSoftDeleted(ctx, true), entityID, "id")
+	if err != nil {
+		return err
+	}
+	db := s.DB.Unscoped()
+	if err = db.Model(model).UpdateColumn("deleted_at", gorm.Expr("NULL")).Error; err != nil {
+		return err
+	}
+	switch entityType := entityTypeForID(entityID); entityType {
+	case "organization", "user":
+		err = db.Model(&Account{}).
+			Where(Account{AccountType: entityType, AccountID: model.PrimaryKey()}).
+			UpdateColumn("deleted_at", gorm.Expr("NULL")).Error
+		if err != nil {
+			return err
+		}
+	}
+	return s.writeAuditLog(ctx, auditLogChange{entityID: entityID, action: "restore"})
+}
+
+// purgeEntity permanently deletes the entity (regardless of it being
+// soft-deleted or not), along with the data that is associated with it.
+func (s *store) purgeEntity(ctx context.Context, entityID ttnpb.Identifiers) error {
+	model, err := s.findEntity(WithSoftDeleted(ctx, false), entityID, "id")
+	if err != nil {
+		return err
+	}
+	entityType, entityUUID := entityTypeForID(entityID), model.PrimaryKey()
+	db := s.DB.Unscoped()
+	entityScope := db.Where("entity_type = ? AND entity_id = ?", entityType, entityUUID)
+	for _, related := range []interface{}{
+		&APIKey{}, &Attribute{}, &ContactInfo{}, &ContactInfoValidation{}, &Membership{},
+	} {
+		if err = entityScope.Delete(related).Error; err != nil {
+			return err
+		}
+	}
+	switch entityType {
+	case "client":
+		for _, related := range []interface{}{
+			&ClientAuthorization{}, &AuthorizationCode{}, &AccessToken{},
+		} {
+			if err = db.Where("client_id = ?", entityUUID).Delete(related).Error; err != nil {
+				return err
+			}
+		}
+	case "gateway":
+		if err = db.Where("gateway_id = ?", entityUUID).Delete(&GatewayAntenna{}).Error; err != nil {
+			return err
+		}
+	case "organization", "user":
+		accountScope := db.Where(Account{AccountType: entityType, AccountID: entityUUID})
+		accountIDs := accountScope.Model(&Account{}).Select("id").QueryExpr()
+		for _, related := range []interface{}{&Membership{}, &AccountQuota{}} {
+			if err = db.Where("account_id IN (?)", accountIDs).Delete(related).Error; err != nil {
+				return err
+			}
+		}
+		if entityType == "user" {
+			if err = s.purgeUserData(entityUUID); err != nil {
+				return err
+			}
+		}
+		if err = accountScope.Delete(&Account{}).Error; err != nil {
+			return err
+		}
+	}
+	if err = db.Delete(model).Error; err != nil {
+		return err
+	}
+	return s.writeAuditLog(ctx, auditLogChange{entityID: entityID, action: "purge"})
+}
+
+func (s *store) purgeUserData(userUUID string) error {
+	db := s.DB.Unscoped()
+	for _, related := range []interface{}{
+		&UserSession{}, &MFACredential{}, &MFALogin{}, &ExternalUser{},
+		&ClientAuthorization{}, &AuthorizationCode{}, &AccessToken{},
+	} {
+		if err := db.Where("user_id = ?", userUUID).Delete(related).Error; err != nil {
+			return err
+		}
+	}
+	err := db.Model(&Invitation{}).Where("accepted_by_id = ?", userUUID).
+		UpdateColumn("accepted_by_id", gorm.Expr("NULL")).Error
+	if err != nil {
+		return err
+	}
+	// Pictures are soft-deleted, so that they can be cleaned up from the storage bucket.
+	// Users are the only purged entities that have a picture; end device pictures are
+	// deleted together with the end device, and end devices are never purged with
+	// their application.
+	profilePictureIDs := db.Model(&User{}).Select("profile_picture_id").Where("id = ?", userUUID).QueryExpr()
+	return s.DB.Where("id IN (?)", profilePictureIDs).Delete(&Picture{}).Error
+}
@@ -30,6 +30,7 @@ type EndDeviceLocation struct {
 
 func init() {
 	registerModel(&EndDeviceLocation{})
+	registerIndex(&EndDeviceLocation{}, "end_device_location_coordinates_index", "latitude", "longitude")
 }
 
 func (l EndDeviceLocation) toPB() *ttnpb.Location {
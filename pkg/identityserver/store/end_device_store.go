@@ -81,6 +81,13 @@ func (s *deviceStore) CreateEndDevice(ctx context.Context, dev *ttnpb.EndDevice)
 	}
 	var devProto ttnpb.EndDevice
 	devModel.toPB(&devProto, nil)
+	if err := s.writeAuditLog(ctx, auditLogChange{
+		entityID: dev.EndDeviceIdentifiers,
+		action:   "create",
+		new:      &devProto,
+	}); err != nil {
+		return nil, err
+	}
 	return &devProto, nil
 }
 
@@ -172,6 +179,8 @@ func (s *deviceStore) UpdateEndDevice(ctx context.Context, dev *ttnpb.EndDevice,
 	if err := ctx.Err(); err != nil { // Early exit if context canceled
 		return nil, err
 	}
+	old := &ttnpb.EndDevice{}
+	devModel.toPB(old, fieldMask)
 	oldAttributes, oldLocations, oldPicture := devModel.Attributes, devModel.Locations, devModel.Picture
 	columns := devModel.fromPB(dev, fieldMask)
 	newPicture := devModel.Picture
@@ -203,6 +212,15 @@ func (s *deviceStore) UpdateEndDevice(ctx context.Context, dev *ttnpb.EndDevice,
 	devModel.Picture = newPicture
 	updated = &ttnpb.EndDevice{}
 	devModel.toPB(updated, fieldMask)
+	if err = s.writeAuditLog(ctx, auditLogChange{
+		entityID: dev.EndDeviceIdentifiers,
+		action:   "update",
+		old:      old,
+		new:      updated,
+		paths:    fieldMask.GetPaths(),
+	}); err != nil {
+		return nil, err
+	}
 	return updated, nil
 }
 
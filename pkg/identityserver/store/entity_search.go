@@ -17,7 +17,9 @@ package store
 import (
 	"context"
 	"fmt"
+	"math"
 	"runtime/trace"
+	"strings"
 
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -32,6 +34,13 @@ type entitySearch struct {
 	*store
 }
 
+var likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes the wildcards in v for use in a LIKE pattern with ESCAPE '\'.
+func escapeLike(v string) string {
+	return likeReplacer.Replace(v)
+}
+
 type metaFields interface {
 	GetIDContains() string
 	GetNameContains() string
@@ -82,9 +91,7 @@ func (s *entitySearch) queryMetaFields(ctx context.Context, query *gorm.DB, enti
 	return query
 }
 
-func (s *entitySearch) FindEntities(ctx context.Context, member *ttnpb.OrganizationOrUserIdentifiers, req *ttnpb.SearchEntitiesRequest, entityType string) ([]ttnpb.Identifiers, error) {
-	defer trace.StartRegion(ctx, "find entities").End()
-
+func (s *entitySearch) queryEntities(ctx context.Context, member *ttnpb.OrganizationOrUserIdentifiers, entityType string) (*gorm.DB, error) {
 	query := s.query(ctx, modelForEntityType(entityType))
 	switch entityType {
 	case "organization":
@@ -101,17 +108,22 @@ func (s *entitySearch) FindEntities(ctx context.Context, member *ttnpb.Organizat
 	}
 
 	if member != nil {
-		membershipsQuery := (&membershipStore{store: s.store}).queryMemberships(ctx, member, entityType, true).Select("entity_id").QueryExpr()
+		membershipsQuery, err := (&membershipStore{store: s.store}).queryMemberships(ctx, member, entityType, true)
+		if err != nil {
+			return nil, err
+		}
+		membershipsExpr := membershipsQuery.Select("entity_id").QueryExpr()
 		if entityType == "organization" {
-			query = query.Where(`"accounts"."account_type" = ? AND "accounts"."account_id" IN (?)`, entityType, membershipsQuery)
+			query = query.Where(`"accounts"."account_type" = ? AND "accounts"."account_id" IN (?)`, entityType, membershipsExpr)
 		} else {
-			query = query.Where(fmt.Sprintf(`"%[1]ss"."id" IN (?)`, entityType), membershipsQuery)
+			query = query.Where(fmt.Sprintf(`"%[1]ss"."id" IN (?)`, entityType), membershipsExpr)
 		}
 	}
+	return query, nil
+}
 
-	query = s.queryMetaFields(ctx, query, entityType, req)
-
-	query = query.Order(orderFromContext(ctx, fmt.Sprintf("%ss", entityType), "friendly_id", "ASC"))
+// findFriendlyIDs paginates the query and returns the friendly IDs of the results.
+func (s *entitySearch) findFriendlyIDs(ctx context.Context, query *gorm.DB) ([]string, error) {
 	page := query
 	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
 		page = query.Limit(limit).Offset(offset)
@@ -127,9 +139,70 @@ func (s *entitySearch) FindEntities(ctx context.Context, member *ttnpb.Organizat
 	} else {
 		setTotal(ctx, uint64(len(results)))
 	}
-	identifiers := make([]ttnpb.Identifiers, len(results))
+	friendlyIDs := make([]string, len(results))
 	for i, result := range results {
-		identifiers[i] = buildIdentifiers(entityType, result.FriendlyID)
+		friendlyIDs[i] = result.FriendlyID
+	}
+	return friendlyIDs, nil
+}
+
+func (s *entitySearch) FindEntities(ctx context.Context, member *ttnpb.OrganizationOrUserIdentifiers, req *ttnpb.SearchEntitiesRequest, entityType string) ([]ttnpb.Identifiers, error) {
+	defer trace.StartRegion(ctx, "find entities").End()
+
+	query, err := s.queryEntities(ctx, member, entityType)
+	if err != nil {
+		return nil, err
+	}
+	query = s.queryMetaFields(ctx, query, entityType, req)
+
+	query = query.Order(orderFromContext(ctx, fmt.Sprintf("%ss", entityType), "friendly_id", "ASC"))
+	friendlyIDs, err := s.findFriendlyIDs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	identifiers := make([]ttnpb.Identifiers, len(friendlyIDs))
+	for i, friendlyID := range friendlyIDs {
+		identifiers[i] = buildIdentifiers(entityType, friendlyID)
+	}
+	return identifiers, nil
+}
+
+func (s *entitySearch) FindGateways(ctx context.Context, member *ttnpb.OrganizationOrUserIdentifiers, req *ttnpb.SearchGatewaysRequest) ([]*ttnpb.GatewayIdentifiers, error) {
+	defer trace.StartRegion(ctx, "find gateways").End()
+
+	query, err := s.queryEntities(ctx, member, "gateway")
+	if err != nil {
+		return nil, err
+	}
+	query = s.queryMetaFields(ctx, query, "gateway", req)
+
+	if v := req.FrequencyPlanID; v != "" {
+		// The frequency plan IDs are separated by spaces.
+		query = query.Where(`' ' || "gateways"."frequency_plan_id" || ' ' LIKE ? ESCAPE '\'`, "% "+escapeLike(v)+" %")
+	}
+	if v := req.BrandID; v != "" {
+		query = query.Where(`"gateways"."brand_id" = ?`, v)
+	}
+	if v := req.ModelID; v != "" {
+		query = query.Where(`"gateways"."model_id" = ?`, v)
+	}
+	if v := req.UpdatedAfter; v != nil {
+		query = query.Where(`"gateways"."updated_at" > ?`, cleanTime(*v))
+	}
+	query = s.queryLocations(ctx, query, &GatewayAntenna{}, "gateways", "gateway_id", req.LocationBoundingBox, req.LocationRadius)
+
+	if order, ok := distanceOrderFromContext(ctx); ok && req.LocationRadius != nil {
+		query = query.Order(order)
+	} else {
+		query = query.Order(orderFromContext(ctx, "gateways", "friendly_id", "ASC"))
+	}
+	friendlyIDs, err := s.findFriendlyIDs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	identifiers := make([]*ttnpb.GatewayIdentifiers, len(friendlyIDs))
+	for i, friendlyID := range friendlyIDs {
+		identifiers[i] = &ttnpb.GatewayIdentifiers{GatewayID: friendlyID}
 	}
 	return identifiers, nil
 }
@@ -150,28 +223,111 @@ func (s *entitySearch) FindEndDevices(ctx context.Context, req *ttnpb.SearchEndD
 	}
 	// DevAddrContains
 
-	query = query.Order(orderFromContext(ctx, "end_devices", "device_id", "ASC"))
-	page := query
-	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
-		page = query.Limit(limit).Offset(offset)
+	if v := req.BrandID; v != "" {
+		query = query.Where(`"end_devices"."brand_id" = ?`, v)
 	}
-	var results []struct {
-		FriendlyID string
+	if v := req.ModelID; v != "" {
+		query = query.Where(`"end_devices"."model_id" = ?`, v)
 	}
-	if err := page.Scan(&results).Error; err != nil {
-		return nil, err
+	if v := req.UpdatedAfter; v != nil {
+		query = query.Where(`"end_devices"."updated_at" > ?`, cleanTime(*v))
 	}
-	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 && (offset > 0 || len(results) == int(limit)) {
-		countTotal(ctx, query)
+	query = s.queryLocations(ctx, query, &EndDeviceLocation{}, "end_devices", "end_device_id", req.LocationBoundingBox, req.LocationRadius)
+
+	if order, ok := distanceOrderFromContext(ctx); ok && req.LocationRadius != nil {
+		query = query.Order(order)
 	} else {
-		setTotal(ctx, uint64(len(results)))
+		query = query.Order(orderFromContext(ctx, "end_devices", "device_id", "ASC"))
 	}
-	identifiers := make([]*ttnpb.EndDeviceIdentifiers, len(results))
-	for i, result := range results {
+	friendlyIDs, err := s.findFriendlyIDs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	identifiers := make([]*ttnpb.EndDeviceIdentifiers, len(friendlyIDs))
+	for i, friendlyID := range friendlyIDs {
 		identifiers[i] = &ttnpb.EndDeviceIdentifiers{
 			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: req.ApplicationID},
-			DeviceID:               result.FriendlyID,
+			DeviceID:               friendlyID,
 		}
 	}
 	return identifiers, nil
 }
+
+// earthRadius is the mean radius of the earth in meters.
+const earthRadius = 6371008.8
+
+// distanceExpr is the great-circle distance (in meters) between the location
+// of the row and the location in the arguments (latitude, latitude, longitude).
+var distanceExpr = fmt.Sprintf(
+	"(2 * %.1f * ASIN(LEAST(1, SQRT(POWER(SIN(RADIANS(latitude - ?) / 2), 2) + COS(RADIANS(?)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - ?) / 2), 2)))))",
+	earthRadius,
+)
+
+// boundingBoxForRadius returns the smallest bounding box that contains all
+// locations within the radius. This allows the database to use the index on
+// the latitude and longitude before calculating the exact distance.
+func boundingBoxForRadius(radius *ttnpb.LocationRadius) *ttnpb.LocationBoundingBox {
+	angle := radius.Radius / earthRadius
+	deltaLatitude := angle * 180 / math.Pi
+	box := &ttnpb.LocationBoundingBox{
+		MinLatitude:  radius.Latitude - deltaLatitude,
+		MinLongitude: -180,
+		MaxLatitude:  radius.Latitude + deltaLatitude,
+		MaxLongitude: 180,
+	}
+	if box.MinLatitude <= -90 || box.MaxLatitude >= 90 {
+		// The radius includes a pole, so it includes all longitudes.
+		box.MinLatitude, box.MaxLatitude = math.Max(box.MinLatitude, -90), math.Min(box.MaxLatitude, 90)
+		return box
+	}
+	deltaLongitude := math.Asin(math.Sin(angle)/math.Cos(radius.Latitude*math.Pi/180)) * 180 / math.Pi
+	box.MinLongitude, box.MaxLongitude = radius.Longitude-deltaLongitude, radius.Longitude+deltaLongitude
+	if box.MinLongitude < -180 {
+		box.MinLongitude += 360
+	}
+	if box.MaxLongitude > 180 {
+		box.MaxLongitude -= 360
+	}
+	return box
+}
+
+func whereInBoundingBox(query *gorm.DB, box *ttnpb.LocationBoundingBox) *gorm.DB {
+	query = query.Where("latitude BETWEEN ? AND ?", box.MinLatitude, box.MaxLatitude)
+	if box.MinLongitude > box.MaxLongitude {
+		// The bounding box crosses the antimeridian.
+		return query.Where("(longitude >= ? OR longitude <= ?)", box.MinLongitude, box.MaxLongitude)
+	}
+	return query.Where("longitude BETWEEN ? AND ?", box.MinLongitude, box.MaxLongitude)
+}
+
+// queryLocations filters the query on the locations of the entities, which are
+// stored in the table of the given model, referencing the entity in column.
+// If a radius is given, the query is joined with the distances to the nearest
+// location, so that the results can be ordered by distance.
+func (s *entitySearch) queryLocations(ctx context.Context, query *gorm.DB, model interface{}, entityTable, column string, box *ttnpb.LocationBoundingBox, radius *ttnpb.LocationRadius) *gorm.DB {
+	if box != nil {
+		sub := whereInBoundingBox(s.query(ctx, model).Select(column), box)
+		query = query.Where(fmt.Sprintf(`"%s"."id" IN (?)`, entityTable), sub.QueryExpr())
+	}
+	if radius != nil {
+		distanceArgs := []interface{}{radius.Latitude, radius.Latitude, radius.Longitude}
+		sub := whereInBoundingBox(s.query(ctx, model), boundingBoxForRadius(radius)).
+			Select(fmt.Sprintf("%s, MIN(%s) AS distance", column, distanceExpr), distanceArgs...).
+			Group(column).
+			Having(fmt.Sprintf("MIN(%s) <= ?", distanceExpr), append(distanceArgs, radius.Radius)...)
+		query = query.Joins(
+			fmt.Sprintf(`JOIN (?) AS "locations" ON "locations"."%s" = "%s"."id"`, column, entityTable),
+			sub.QueryExpr(),
+		)
+	}
+	return query
+}
+
+// distanceOrderFromContext returns the order by distance (see queryLocations),
+// if the results should be ordered by distance.
+func distanceOrderFromContext(ctx context.Context) (string, bool) {
+	if opts, ok := ctx.Value(orderOptionsKey).(orderOptions); ok && opts.field == "distance" {
+		return fmt.Sprintf(`"locations"."distance" %s`, opts.order), true
+	}
+	return "", false
+}
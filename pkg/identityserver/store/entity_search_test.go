@@ -17,6 +17,7 @@ package store
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"github.com/smartystreets/assertions"
@@ -30,11 +31,20 @@ func TestEntitySearch(t *testing.T) {
 	ctx := test.Context()
 
 	WithDB(t, func(t *testing.T, db *gorm.DB) {
-		prepareTest(db, &Attribute{}, &Application{}, &Client{}, &Gateway{}, &Account{}, &User{}, &Organization{})
+		prepareTest(db, &Attribute{}, &Application{}, &Client{}, &Gateway{}, &GatewayAntenna{}, &EndDevice{}, &EndDeviceLocation{}, &Account{}, &User{}, &Organization{})
 
 		store := newStore(db)
 		s := GetEntitySearch(db)
 
+		locations := map[string]Location{
+			"foo": {Latitude: 52.3676, Longitude: 4.9041},    // Amsterdam.
+			"bar": {Latitude: -18.1416, Longitude: 178.4419}, // Suva, close to the antimeridian.
+		}
+		frequencyPlans := map[string]string{
+			"foo": "EU_863_870 EU_863_870_TTN",
+			"bar": "AS_920_923",
+		}
+
 		for _, name := range []string{"foo", "bar"} {
 			store.createEntity(ctx, &Application{
 				ApplicationID: fmt.Sprintf("the-%s-app", name),
@@ -54,6 +64,10 @@ func TestEntitySearch(t *testing.T) {
 					Attributes: []Attribute{
 						{Key: "test", Value: devName},
 					},
+					BrandID: fmt.Sprintf("the-%s-brand", devName),
+					Locations: []EndDeviceLocation{
+						{Service: "registry", Location: locations[name]},
+					},
 				})
 			}
 
@@ -73,6 +87,11 @@ func TestEntitySearch(t *testing.T) {
 				Attributes: []Attribute{
 					{Key: "test", Value: name},
 				},
+				BrandID:         fmt.Sprintf("the-%s-brand", name),
+				FrequencyPlanID: frequencyPlans[name],
+				Antennas: []GatewayAntenna{
+					{Location: locations[name]},
+				},
 			})
 
 			store.createEntity(ctx, &User{
@@ -192,6 +211,137 @@ func TestEntitySearch(t *testing.T) {
 
 			a.So(err, should.BeNil)
 			a.So(ids, should.HaveLength, 1)
+
+			ids, err = s.FindEndDevices(ctx, &ttnpb.SearchEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "the-foo-app"},
+				BrandID:                "the-baz-brand",
+			})
+
+			a.So(err, should.BeNil)
+			a.So(ids, should.HaveLength, 1)
+
+			ids, err = s.FindEndDevices(ctx, &ttnpb.SearchEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "the-foo-app"},
+				LocationRadius: &ttnpb.LocationRadius{
+					Latitude: 52.3791, Longitude: 4.9003, Radius: 10000,
+				},
+			})
+
+			a.So(err, should.BeNil)
+			a.So(ids, should.HaveLength, 2)
+
+			ids, err = s.FindEndDevices(ctx, &ttnpb.SearchEndDevicesRequest{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "the-bar-app"},
+				LocationRadius: &ttnpb.LocationRadius{
+					Latitude: 52.3791, Longitude: 4.9003, Radius: 10000,
+				},
+			})
+
+			a.So(err, should.BeNil)
+			a.So(ids, should.BeEmpty)
+		})
+
+		t.Run("gateway", func(t *testing.T) {
+			inOneHour := time.Now().Add(time.Hour)
+
+			for _, tc := range []struct {
+				Name     string
+				Request  *ttnpb.SearchGatewaysRequest
+				Expected []string
+			}{
+				{
+					Name:     "FrequencyPlan",
+					Request:  &ttnpb.SearchGatewaysRequest{FrequencyPlanID: "EU_863_870"},
+					Expected: []string{"the-foo-gtw"},
+				},
+				{
+					Name:     "FrequencyPlanWildcard",
+					Request:  &ttnpb.SearchGatewaysRequest{FrequencyPlanID: "%"},
+					Expected: []string{},
+				},
+				{
+					Name:     "FrequencyPlanSingleCharacterWildcard",
+					Request:  &ttnpb.SearchGatewaysRequest{FrequencyPlanID: "AS_920_92_"},
+					Expected: []string{},
+				},
+				{
+					Name:     "Brand",
+					Request:  &ttnpb.SearchGatewaysRequest{BrandID: "the-bar-brand"},
+					Expected: []string{"the-bar-gtw"},
+				},
+				{
+					Name:     "UpdatedAfter",
+					Request:  &ttnpb.SearchGatewaysRequest{UpdatedAfter: &inOneHour},
+					Expected: []string{},
+				},
+				{
+					Name: "BoundingBox",
+					Request: &ttnpb.SearchGatewaysRequest{
+						LocationBoundingBox: &ttnpb.LocationBoundingBox{
+							MinLatitude: 52, MinLongitude: 4, MaxLatitude: 53, MaxLongitude: 5,
+						},
+					},
+					Expected: []string{"the-foo-gtw"},
+				},
+				{
+					Name: "BoundingBoxAcrossAntimeridian",
+					Request: &ttnpb.SearchGatewaysRequest{
+						LocationBoundingBox: &ttnpb.LocationBoundingBox{
+							MinLatitude: -20, MinLongitude: 170, MaxLatitude: -10, MaxLongitude: -170,
+						},
+					},
+					Expected: []string{"the-bar-gtw"},
+				},
+				{
+					Name: "Radius",
+					Request: &ttnpb.SearchGatewaysRequest{
+						LocationRadius: &ttnpb.LocationRadius{
+							Latitude: 52.3791, Longitude: 4.9003, Radius: 10000,
+						},
+					},
+					Expected: []string{"the-foo-gtw"},
+				},
+				{
+					Name: "RadiusAcrossAntimeridian",
+					Request: &ttnpb.SearchGatewaysRequest{
+						LocationRadius: &ttnpb.LocationRadius{
+							Latitude: -18.1416, Longitude: -179.9, Radius: 300000,
+						},
+					},
+					Expected: []string{"the-bar-gtw"},
+				},
+				{
+					Name: "TooFar",
+					Request: &ttnpb.SearchGatewaysRequest{
+						LocationRadius: &ttnpb.LocationRadius{
+							Latitude: 52.3791, Longitude: 4.9003, Radius: 100,
+						},
+					},
+					Expected: []string{},
+				},
+			} {
+				t.Run(tc.Name, func(t *testing.T) {
+					a := assertions.New(t)
+					ids, err := s.FindGateways(ctx, nil, tc.Request)
+					if a.So(err, should.BeNil) {
+						gtwIDs := make([]string, len(ids))
+						for i, id := range ids {
+							gtwIDs[i] = id.GatewayID
+						}
+						a.So(gtwIDs, should.Resemble, tc.Expected)
+					}
+				})
+			}
+
+			ids, err := s.FindGateways(WithOrder(ctx, "-distance"), nil, &ttnpb.SearchGatewaysRequest{
+				LocationRadius: &ttnpb.LocationRadius{
+					Latitude: 0, Longitude: 90, Radius: 20037509,
+				},
+			})
+			if a.So(err, should.BeNil) && a.So(ids, should.HaveLength, 2) {
+				a.So(ids[0].GatewayID, should.Equal, "the-bar-gtw") // 9840 km.
+				a.So(ids[1].GatewayID, should.Equal, "the-foo-gtw") // 9680 km.
+			}
 		})
 	})
 }
@@ -0,0 +1,79 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// GetExternalUserStore returns an ExternalUserStore on the given db (or transaction).
+func GetExternalUserStore(db *gorm.DB) ExternalUserStore {
+	return &externalUserStore{store: newStore(db)}
+}
+
+type externalUserStore struct {
+	*store
+}
+
+func (s *externalUserStore) CreateExternalUser(ctx context.Context, userIDs *ttnpb.UserIdentifiers, provider, subject string) error {
+	defer trace.StartRegion(ctx, "create external user").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	return s.createEntity(ctx, &ExternalUser{
+		UserID:   user.PrimaryKey(),
+		Provider: provider,
+		Subject:  subject,
+	})
+}
+
+func (s *externalUserStore) GetExternalUser(ctx context.Context, provider, subject string) (*ttnpb.UserIdentifiers, error) {
+	defer trace.StartRegion(ctx, "get external user").End()
+	var externalUserModel ExternalUser
+	err := s.query(ctx, ExternalUser{}).
+		Where(ExternalUser{Provider: provider, Subject: subject}).
+		First(&externalUserModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errExternalUserNotFound.WithAttributes("provider", provider, "subject", subject)
+		}
+		return nil, err
+	}
+	var accountModel Account
+	err = s.query(ctx, Account{}).
+		Where(Account{AccountID: externalUserModel.UserID, AccountType: "user"}).
+		First(&accountModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errExternalUserNotFound.WithAttributes("provider", provider, "subject", subject)
+		}
+		return nil, err
+	}
+	return &ttnpb.UserIdentifiers{UserID: accountModel.UID}, nil
+}
+
+func (s *externalUserStore) DeleteExternalUsers(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error {
+	defer trace.StartRegion(ctx, "delete external users").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return err
+	}
+	return s.query(ctx, ExternalUser{}).Where(ExternalUser{UserID: user.PrimaryKey()}).Delete(&ExternalUser{}).Error
+}
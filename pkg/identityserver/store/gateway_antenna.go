@@ -33,6 +33,7 @@ type GatewayAntenna struct {
 
 func init() {
 	registerModel(&GatewayAntenna{})
+	registerIndex(&GatewayAntenna{}, "gateway_antenna_coordinates_index", "latitude", "longitude")
 }
 
 func (a GatewayAntenna) toPB() ttnpb.GatewayAntenna {
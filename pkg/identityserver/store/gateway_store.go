@@ -76,6 +76,13 @@ func (s *gatewayStore) CreateGateway(ctx context.Context, gtw *ttnpb.Gateway) (*
 	}
 	var gtwProto ttnpb.Gateway
 	gtwModel.toPB(&gtwProto, nil)
+	if err := s.writeAuditLog(ctx, auditLogChange{
+		entityID: gtw.GatewayIdentifiers,
+		action:   "create",
+		new:      &gtwProto,
+	}); err != nil {
+		return nil, err
+	}
 	return &gtwProto, nil
 }
 
@@ -140,6 +147,8 @@ func (s *gatewayStore) UpdateGateway(ctx context.Context, gtw *ttnpb.Gateway, fi
 	if err := ctx.Err(); err != nil { // Early exit if context canceled
 		return nil, err
 	}
+	old := &ttnpb.Gateway{}
+	gtwModel.toPB(old, fieldMask)
 	oldAttributes, oldAntennas := gtwModel.Attributes, gtwModel.Antennas
 	columns := gtwModel.fromPB(gtw, fieldMask)
 	if err = s.updateEntity(ctx, &gtwModel, columns...); err != nil {
@@ -157,6 +166,15 @@ func (s *gatewayStore) UpdateGateway(ctx context.Context, gtw *ttnpb.Gateway, fi
 	}
 	updated = &ttnpb.Gateway{}
 	gtwModel.toPB(updated, fieldMask)
+	if err = s.writeAuditLog(ctx, auditLogChange{
+		entityID: gtw.GatewayIdentifiers,
+		action:   "update",
+		old:      old,
+		new:      updated,
+		paths:    fieldMask.GetPaths(),
+	}); err != nil {
+		return nil, err
+	}
 	return updated, nil
 }
 
@@ -164,3 +182,13 @@ func (s *gatewayStore) DeleteGateway(ctx context.Context, id *ttnpb.GatewayIdent
 	defer trace.StartRegion(ctx, "delete gateway").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *gatewayStore) RestoreGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error {
+	defer trace.StartRegion(ctx, "restore gateway").End()
+	return s.restoreEntity(ctx, id)
+}
+
+func (s *gatewayStore) PurgeGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error {
+	defer trace.StartRegion(ctx, "purge gateway").End()
+	return s.purgeEntity(ctx, id)
+}
@@ -18,36 +18,58 @@ import (
 	"context"
 	"time"
 
+	"github.com/go-redis/redis"
+	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
-	"go.thethings.network/lorawan-stack/pkg/redis"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 )
 
 type membershipCache struct {
 	MembershipStore
-	redis *redis.Client
+	db    *gorm.DB
+	redis *ttnredis.Client
 	ttl   time.Duration
 }
 
 // GetMembershipCache wraps the MembershipStore with a cache.
-// Make sure to not call FindIndirectMemberships or GetMember after calling
+// The cache is invalidated after the transaction of db is committed.
+// Make sure to not call GetMember or GetMemberRights after calling
 // SetMember in the same transaction, this may result in an inconsistent cache.
-func GetMembershipCache(store MembershipStore, redis *redis.Client, ttl time.Duration) MembershipStore {
+func GetMembershipCache(db *gorm.DB, store MembershipStore, redis *ttnredis.Client, ttl time.Duration) MembershipStore {
 	return &membershipCache{
 		MembershipStore: store,
+		db:              db,
 		redis:           redis,
 		ttl:             ttl,
 	}
 }
 
-// TODO: Add FindIndirectMemberships (https://github.com/TheThingsNetwork/lorawan-stack/issues/443).
-
 func (c *membershipCache) cacheKey(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers) string {
 	return c.redis.Key("membership", id.EntityType(), unique.ID(ctx, id), entityID.EntityType(), unique.ID(ctx, entityID))
 }
 
+// generationKey is the key of the generation of the memberships of the
+// organization or user. Since inherited rights depend on any number of
+// memberships, the cached rights of an organization or user are invalidated by
+// incrementing its generation when one of its (indirect) memberships changes.
+func (c *membershipCache) generationKey(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers) string {
+	return c.redis.Key("membership_generation", id.EntityType(), unique.ID(ctx, id))
+}
+
+func (c *membershipCache) rightsCacheKey(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers) (string, error) {
+	generation, err := c.redis.Get(c.generationKey(ctx, id)).Result()
+	if err != nil {
+		if err = ttnredis.ConvertError(err); !errors.IsNotFound(err) {
+			return "", err
+		}
+		generation = "0"
+	}
+	return c.redis.Key("membership_rights", id.EntityType(), unique.ID(ctx, id), generation, entityID.EntityType(), unique.ID(ctx, entityID)), nil
+}
+
 func (c *membershipCache) GetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers) (*ttnpb.Rights, error) {
 	cacheKey := c.cacheKey(ctx, id, entityID)
 	if cached, err := c.redis.Get(cacheKey).Bytes(); err == nil {
@@ -80,15 +102,85 @@ func (c *membershipCache) GetMember(ctx context.Context, id *ttnpb.OrganizationO
 	return rights, err
 }
 
+func (c *membershipCache) GetMemberRights(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers) (*ttnpb.Rights, error) {
+	cacheKey, err := c.rightsCacheKey(ctx, id, entityID)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to get membership generation")
+		return c.MembershipStore.GetMemberRights(ctx, id, entityID)
+	}
+	if cached, err := c.redis.Get(cacheKey).Bytes(); err == nil {
+		var rights ttnpb.Rights
+		if err = rights.Unmarshal(cached); err == nil {
+			return &rights, nil
+		}
+	}
+	rights, err := c.MembershipStore.GetMemberRights(ctx, id, entityID)
+	if err != nil {
+		return nil, err
+	}
+	if cache, err := rights.Marshal(); err == nil {
+		if cacheErr := c.redis.Set(cacheKey, cache, c.ttl).Err(); cacheErr != nil {
+			log.FromContext(ctx).WithError(cacheErr).Error("Failed to set membership cache")
+		}
+	}
+	return rights, nil
+}
+
+// findIndirectMembers returns the organization or user and the organizations and
+// users that are (indirectly) a member of it.
+func (c *membershipCache) findIndirectMembers(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers) ([]*ttnpb.OrganizationOrUserIdentifiers, error) {
+	members := []*ttnpb.OrganizationOrUserIdentifiers{id}
+	seen := map[string]bool{unique.ID(ctx, id): true}
+	next := members
+	for depth := 0; depth < maxMembershipDepth && len(next) > 0; depth++ {
+		current := next
+		next = nil
+		for _, id := range current {
+			orgIDs := id.GetOrganizationIDs()
+			if orgIDs == nil {
+				continue
+			}
+			orgMembers, err := c.MembershipStore.FindMembers(ctx, orgIDs)
+			if err != nil {
+				return nil, err
+			}
+			for member := range orgMembers {
+				if uid := unique.ID(ctx, member); !seen[uid] {
+					seen[uid] = true
+					next = append(next, member)
+				}
+			}
+		}
+		members = append(members, next...)
+	}
+	return members, nil
+}
+
 func (c *membershipCache) SetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers, rights *ttnpb.Rights) error {
 	err := c.MembershipStore.SetMember(ctx, id, entityID, rights)
 	if err != nil {
 		return err
 	}
-	// NOTE: Only invalidate. We can't set the new rights, since we don't know if
-	// the transaction will succeed.
-	if cacheErr := c.redis.Del(c.cacheKey(ctx, id, entityID)).Err(); cacheErr != nil {
-		log.FromContext(ctx).WithError(cacheErr).Error("Failed to invalidate membership cache")
+	// The rights of the organization or user, and of the organizations and users
+	// that are (indirectly) a member of it, change with this membership.
+	affected, err := c.findIndirectMembers(ctx, id)
+	if err != nil {
+		return err
 	}
+	// NOTE: Only invalidate, and only after the transaction is committed. We can't
+	// set the new rights, since we don't know if the transaction will succeed, and
+	// concurrent readers may cache the old rights until the transaction is committed.
+	AfterCommit(c.db, func() {
+		_, cacheErr := c.redis.Pipelined(func(p redis.Pipeliner) error {
+			p.Del(c.cacheKey(ctx, id, entityID))
+			for _, id := range affected {
+				p.Incr(c.generationKey(ctx, id))
+			}
+			return nil
+		})
+		if cacheErr != nil {
+			log.FromContext(ctx).WithError(cacheErr).Error("Failed to invalidate membership cache")
+		}
+	})
 	return nil
 }
@@ -33,39 +33,46 @@ type membershipStore struct {
 	*store
 }
 
-func (s *membershipStore) queryMemberships(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityType string, includeIndirect bool) *gorm.DB {
+func (s *membershipStore) queryMemberships(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityType string, includeIndirect bool) (*gorm.DB, error) {
 	accountQuery := s.query(ctx, Account{}).
 		Select(`"accounts"."id"`).
 		Where(fmt.Sprintf(`"accounts"."account_type" = '%s' AND "accounts"."uid" = ?`, id.EntityType()), id.IDString()).
 		QueryExpr()
-	organizationQuery := s.query(ctx, Account{}).
-		Select(`"accounts"."id"`).
-		Joins(`JOIN "memberships" ON "memberships"."entity_type" = "accounts"."account_type" AND "memberships"."entity_id" = "accounts"."account_id"`).
-		Where(`"memberships"."account_id" = (?)`, accountQuery).
-		QueryExpr()
 	query := s.query(ctx, &Membership{})
-	if includeIndirect && id.EntityType() == "user" && entityType != "organization" {
-		query = query.Where("entity_type = ? AND (account_id = (?) OR account_id IN (?))", entityType, accountQuery, organizationQuery)
-	} else {
-		query = query.Where("entity_type = ? AND (account_id = (?))", entityType, accountQuery)
+	if includeIndirect {
+		chains, err := s.findMembershipChains(ctx, id)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		if len(chains) > 0 {
+			accountIDs := make([]string, len(chains))
+			for i, chain := range chains {
+				accountIDs[i] = chain.accountID()
+			}
+			return query.Where("entity_type = ? AND account_id IN (?)", entityType, accountIDs), nil
+		}
 	}
-	return query
+	return query.Where("entity_type = ? AND (account_id = (?))", entityType, accountQuery), nil
 }
 
 func (s *membershipStore) FindMemberships(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityType string, includeIndirect bool) ([]ttnpb.Identifiers, error) {
 	defer trace.StartRegion(ctx, fmt.Sprintf("find %s memberships of %s", entityType, id.IDString())).End()
 
-	membershipsQuery := s.queryMemberships(ctx, id, entityType, includeIndirect).Select("entity_id").QueryExpr()
+	membershipsQuery, err := s.queryMemberships(ctx, id, entityType, includeIndirect)
+	if err != nil {
+		return nil, err
+	}
+	membershipsExpr := membershipsQuery.Select("entity_id").QueryExpr()
 	query := s.query(ctx, modelForEntityType(entityType))
 	switch entityType {
 	case "organization":
 		query = query.
 			Joins(`JOIN "accounts" ON "accounts"."account_type" = 'organization' AND "accounts"."account_id" = "organizations"."id"`).
-			Where(`"accounts"."account_type" = ? AND "accounts"."account_id" IN (?)`, entityType, membershipsQuery).
+			Where(`"accounts"."account_type" = ? AND "accounts"."account_id" IN (?)`, entityType, membershipsExpr).
 			Select(`"accounts"."uid" AS "friendly_id"`)
 	default:
 		query = query.
-			Where(fmt.Sprintf(`"%[1]ss"."id" IN (?)`, entityType), membershipsQuery).
+			Where(fmt.Sprintf(`"%[1]ss"."id" IN (?)`, entityType), membershipsExpr).
 			Select(fmt.Sprintf(`"%[1]ss"."%[1]s_id" AS "friendly_id"`, entityType))
 	}
 
@@ -134,6 +141,156 @@ func (s *membershipStore) FindIndirectMemberships(ctx context.Context, userID *t
 	return commonOrganizations, nil
 }
 
+// maxMembershipDepth is the maximum number of nested memberships through which
+// rights are inherited. Memberships that would exceed this are rejected.
+const maxMembershipDepth = 8
+
+// membershipChain is a chain of organization memberships, starting with an
+// organization or user, where each account is a member of the next.
+type membershipChain struct {
+	accountIDs []string
+	path       []ttnpb.OrganizationOrUserIdentifiers
+	// rights that are passed on through the chain. This is nil for a chain
+	// without organization memberships.
+	rights *ttnpb.Rights
+}
+
+// accountID returns the ID of the last account in the chain.
+func (c membershipChain) accountID() string {
+	return c.accountIDs[len(c.accountIDs)-1]
+}
+
+func (c membershipChain) contains(accountID string) bool {
+	for _, id := range c.accountIDs {
+		if id == accountID {
+			return true
+		}
+	}
+	return false
+}
+
+func (c membershipChain) extend(accountID string, id ttnpb.OrganizationOrUserIdentifiers, rights *ttnpb.Rights) membershipChain {
+	if c.rights != nil {
+		rights = c.rights.Intersect(rights)
+	}
+	return membershipChain{
+		accountIDs: append(append(make([]string, 0, len(c.accountIDs)+1), c.accountIDs...), accountID),
+		path:       append(append(make([]ttnpb.OrganizationOrUserIdentifiers, 0, len(c.path)+1), c.path...), id),
+		rights:     rights,
+	}
+}
+
+// findMembershipChains returns the chains of organization memberships that
+// start with the given organization or user, including the chain that only
+// contains the organization or user itself.
+func (s *membershipStore) findMembershipChains(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers) ([]membershipChain, error) {
+	var account Account
+	err := s.query(ctx, Account{}).Where(Account{
+		UID:         id.IDString(),
+		AccountType: id.EntityType(),
+	}).Find(&account).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errNotFoundForID(id)
+		}
+		return nil, err
+	}
+	chains := []membershipChain{{
+		accountIDs: []string{account.PrimaryKey()},
+		path:       []ttnpb.OrganizationOrUserIdentifiers{*id},
+	}}
+	next := chains
+	for depth := 0; depth < maxMembershipDepth && len(next) > 0; depth++ {
+		accountIDs := make([]string, len(next))
+		for i, chain := range next {
+			accountIDs[i] = chain.accountID()
+		}
+		var memberships []struct {
+			MemberID              string
+			OrganizationAccountID string
+			OrganizationID        string
+			Rights                Rights
+		}
+		err = s.query(ctx, Account{}).
+			Select(`"memberships"."account_id" AS "member_id", "accounts"."id" AS "organization_account_id", "accounts"."uid" AS "organization_id", "memberships"."rights" AS "rights"`).
+			Joins(`JOIN "memberships" ON "memberships"."entity_type" = 'organization' AND "memberships"."entity_id" = "accounts"."account_id"`).
+			Where(`"accounts"."account_type" = 'organization' AND "memberships"."account_id" IN (?)`, accountIDs).
+			Scan(&memberships).Error
+		if err != nil {
+			return nil, err
+		}
+		current := next
+		next = nil
+		for _, chain := range current {
+			for _, membership := range memberships {
+				// Skip memberships of other chains, and skip organizations that
+				// are already in the chain, so that existing cycles are not followed.
+				if membership.MemberID != chain.accountID() || chain.contains(membership.OrganizationAccountID) {
+					continue
+				}
+				rights := ttnpb.Rights(membership.Rights)
+				next = append(next, chain.extend(
+					membership.OrganizationAccountID,
+					*Account{AccountType: "organization", UID: membership.OrganizationID}.OrganizationOrUserIdentifiers(),
+					rights.Implied(),
+				))
+			}
+		}
+		chains = append(chains, next...)
+	}
+	return chains, nil
+}
+
+func (s *membershipStore) GetMemberRights(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers) (*ttnpb.Rights, error) {
+	defer trace.StartRegion(ctx, fmt.Sprintf("get member rights on %s", entityID.EntityType())).End()
+	chains, err := s.findMembershipChains(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	accountIDs := make([]string, len(chains))
+	for i, chain := range chains {
+		accountIDs[i] = chain.accountID()
+	}
+	entityQuery := s.query(ctx, modelForID(entityID), withID(entityID)).
+		Select(fmt.Sprintf(`"%ss"."id"`, entityID.EntityType())).
+		QueryExpr()
+	var memberships []Membership
+	err = s.query(ctx, &Membership{}).
+		Select(`"memberships"."account_id", "memberships"."rights"`).
+		Where(`"memberships"."account_id" IN (?)`, accountIDs).
+		Where(fmt.Sprintf(`"memberships"."entity_type" = '%s' AND "memberships"."entity_id" = (?)`, entityID.EntityType()), entityQuery).
+		Find(&memberships).Error
+	if err != nil {
+		return nil, err
+	}
+	memberRights := make(map[string]*ttnpb.Rights, len(memberships))
+	for _, membership := range memberships {
+		rights := ttnpb.Rights(membership.Rights)
+		memberRights[membership.AccountID] = rights.Implied()
+	}
+	res := &ttnpb.Rights{}
+	var grantedBy []*ttnpb.MembershipPath
+	for _, chain := range chains {
+		rights, ok := memberRights[chain.accountID()]
+		if !ok {
+			continue
+		}
+		if chain.rights != nil {
+			rights = chain.rights.Intersect(rights)
+		}
+		if len(rights.GetRights()) == 0 {
+			continue
+		}
+		res = res.Union(rights)
+		grantedBy = append(grantedBy, &ttnpb.MembershipPath{
+			Path:   chain.path,
+			Rights: rights.Sorted().GetRights(),
+		})
+	}
+	res.GrantedBy = grantedBy
+	return res, nil
+}
+
 func (s *membershipStore) FindMembers(ctx context.Context, entityID ttnpb.Identifiers) (map[*ttnpb.OrganizationOrUserIdentifiers]*ttnpb.Rights, error) {
 	defer trace.StartRegion(ctx, fmt.Sprintf("find members of %s", entityID.EntityType())).End()
 	entityQuery := s.query(ctx, modelForID(entityID), withID(entityID)).
@@ -204,15 +361,53 @@ func (s *membershipStore) GetMember(ctx context.Context, id *ttnpb.OrganizationO
 	return &rights, nil
 }
 
-var errAccountType = errors.DefineInvalidArgument(
-	"account_type",
-	"account of type `{account_type}` can not collaborate on `{entity_type}`",
+var (
+	errMembershipCycle = errors.DefineInvalidArgument(
+		"membership_cycle",
+		"organization `{organization_id}` can not become a member of `{entity_id}`, as that would create a cycle",
+	)
+	errMembershipDepth = errors.DefineInvalidArgument(
+		"membership_depth",
+		"`{account_id}` can not become a member of `{entity_id}`, as that would nest organizations more than `{max_depth}` levels deep",
+	)
 )
 
+// memberDepth returns the number of levels of (indirect) members of the
+// organization or user with the given account ID.
+func (s *membershipStore) memberDepth(ctx context.Context, accountID string) (int, error) {
+	depth := 0
+	next := []string{accountID}
+	for depth <= maxMembershipDepth {
+		var memberIDs []string
+		err := s.query(ctx, &Membership{}).
+			Joins(`JOIN "accounts" ON "accounts"."account_type" = 'organization' AND "accounts"."account_id" = "memberships"."entity_id"`).
+			Where(`"memberships"."entity_type" = 'organization' AND "accounts"."id" IN (?)`, next).
+			Pluck(`"memberships"."account_id"`, &memberIDs).Error
+		if err != nil {
+			return 0, err
+		}
+		if len(memberIDs) == 0 {
+			break
+		}
+		depth++
+		next = memberIDs
+	}
+	return depth, nil
+}
+
 func (s *membershipStore) SetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers, rights *ttnpb.Rights) error {
 	defer trace.StartRegion(ctx, "update membership").End()
+	// SELECT ... FOR UPDATE on the accounts of the member and of the organizations
+	// in the membership chains of an organization serializes concurrent transactions
+	// that change memberships of the same organizations, so that the cycle detection
+	// below sees the memberships that are added by the other transactions.
+	lockingStore := &membershipStore{store: newStore(s.DB.Set("gorm:query_option", `FOR UPDATE OF "accounts"`))}
+	accountStore := s
+	if entityID.EntityType() == "organization" {
+		accountStore = lockingStore
+	}
 	var account Account
-	err := s.query(ctx, Account{}).Where(Account{
+	err := accountStore.query(ctx, Account{}).Where(Account{
 		UID:         id.IDString(),
 		AccountType: id.EntityType(),
 	}).Find(&account).Error
@@ -226,13 +421,57 @@ func (s *membershipStore) SetMember(ctx context.Context, id *ttnpb.OrganizationO
 	if err != nil {
 		return err
 	}
-	if _, ok := entity.(*Organization); ok && account.AccountType != "user" {
-		return errAccountType.WithAttributes("account_type", account.AccountType, "entity_type", "organization")
+	if _, ok := entity.(*Organization); ok && len(rights.GetRights()) > 0 {
+		// Make sure that the organization that becomes a member is not the
+		// organization itself, nor (indirectly) a member of it.
+		chains, err := lockingStore.findMembershipChains(ctx, ttnpb.OrganizationIdentifiers{
+			OrganizationID: entityID.IDString(),
+		}.OrganizationOrUserIdentifiers())
+		if err != nil {
+			return err
+		}
+		entityDepth := 0
+		for _, chain := range chains {
+			if chain.contains(account.PrimaryKey()) {
+				return errMembershipCycle.WithAttributes(
+					"organization_id", id.IDString(),
+					"entity_id", entityID.IDString(),
+				)
+			}
+			if depth := len(chain.accountIDs) - 1; depth > entityDepth {
+				entityDepth = depth
+			}
+		}
+		// Make sure that rights are not inherited through more than maxMembershipDepth
+		// memberships, since deeper memberships are not followed. This also makes
+		// sure that the cycle detection above follows all memberships.
+		memberDepth, err := s.memberDepth(ctx, account.PrimaryKey())
+		if err != nil {
+			return err
+		}
+		if memberDepth+1+entityDepth > maxMembershipDepth {
+			return errMembershipDepth.WithAttributes(
+				"account_id", id.IDString(),
+				"entity_id", entityID.IDString(),
+				"max_depth", maxMembershipDepth,
+			)
+		}
 	}
 	if err := ctx.Err(); err != nil { // Early exit if context canceled
 		return err
 	}
 
+	collaboratorIDs, err := auditLogValues(id)
+	if err != nil {
+		return err
+	}
+	change := auditLogChange{
+		entityID: entityID,
+		action:   "collaborator.update",
+		new:      rights,
+		subject:  map[string]interface{}{"ids": collaboratorIDs},
+	}
+
 	query := s.query(ctx, Membership{})
 	var membership Membership
 	err = query.Where(&Membership{
@@ -241,8 +480,14 @@ func (s *membershipStore) SetMember(ctx context.Context, id *ttnpb.OrganizationO
 		EntityType: entityTypeForID(entityID),
 	}).First(&membership).Error
 	if err == nil {
+		oldRights := ttnpb.Rights(membership.Rights)
+		change.old = &oldRights
 		if len(rights.Rights) == 0 {
-			return query.Delete(&membership).Error
+			if err = query.Delete(&membership).Error; err != nil {
+				return err
+			}
+			change.action, change.new = "collaborator.delete", nil
+			return s.writeAuditLog(ctx, change)
 		}
 		query = query.Select("rights", "updated_at")
 	} else if gorm.IsRecordNotFoundError(err) {
@@ -265,5 +510,8 @@ func (s *membershipStore) SetMember(ctx context.Context, id *ttnpb.OrganizationO
 		return err
 	}
 	membership.Rights = Rights(*rights)
-	return query.Save(&membership).Error
+	if err = query.Save(&membership).Error; err != nil {
+		return err
+	}
+	return s.writeAuditLog(ctx, change)
 }
@@ -15,6 +15,7 @@
 package store
 
 import (
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -84,6 +85,89 @@ func TestFindIndirectMemberships(t *testing.T) {
 	})
 }
 
+func TestGetMemberRights(t *testing.T) {
+	ctx := test.Context()
+	a := assertions.New(t)
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db,
+			&Membership{},
+			&Account{}, &User{}, &Organization{},
+			&Application{},
+		)
+
+		s := newStore(db)
+		store := GetMembershipStore(db)
+
+		if os.Getenv("TEST_REDIS") == "1" {
+			redis, flush := test.NewRedis(t, "is_membership_store")
+			defer flush()
+			store = GetMembershipCache(db, store, redis, time.Minute)
+		}
+
+		usr := &User{Account: Account{UID: "test-user"}}
+		s.createEntity(ctx, usr)
+		usrIDs := usr.Account.OrganizationOrUserIdentifiers()
+		parentOrg := &Organization{Account: Account{UID: "test-parent-org"}}
+		s.createEntity(ctx, parentOrg)
+		parentOrgIDs := parentOrg.Account.OrganizationOrUserIdentifiers()
+		childOrg := &Organization{Account: Account{UID: "test-child-org"}}
+		s.createEntity(ctx, childOrg)
+		childOrgIDs := childOrg.Account.OrganizationOrUserIdentifiers()
+		s.createEntity(ctx, &Application{ApplicationID: "test-app"})
+		appIDs := &ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+
+		// test-user is a member of test-parent-org, which is a member of
+		// test-child-org, which is a collaborator of test-app.
+		for _, membership := range []struct {
+			ids      *ttnpb.OrganizationOrUserIdentifiers
+			entityID ttnpb.Identifiers
+			rights   []ttnpb.Right
+		}{
+			{usrIDs, parentOrgIDs.GetOrganizationIDs(), []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL, ttnpb.RIGHT_ORGANIZATION_ALL}},
+			{parentOrgIDs, childOrgIDs.GetOrganizationIDs(), []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO, ttnpb.RIGHT_APPLICATION_LINK}},
+			{childOrgIDs, appIDs, []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO, ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC}},
+			{usrIDs, appIDs, []ttnpb.Right{ttnpb.RIGHT_APPLICATION_DEVICES_READ}},
+		} {
+			err := store.SetMember(ctx, membership.ids, membership.entityID, ttnpb.RightsFrom(membership.rights...))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+		}
+
+		rights, err := store.GetMemberRights(ctx, usrIDs, appIDs)
+
+		if a.So(err, should.BeNil) && a.So(rights, should.NotBeNil) {
+			a.So(rights.Sorted().GetRights(), should.Resemble, []ttnpb.Right{
+				ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+				ttnpb.RIGHT_APPLICATION_INFO,
+			})
+			a.So(rights.GrantedBy, should.Resemble, []*ttnpb.MembershipPath{
+				{
+					Path:   []ttnpb.OrganizationOrUserIdentifiers{*usrIDs},
+					Rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_DEVICES_READ},
+				},
+				{
+					Path:   []ttnpb.OrganizationOrUserIdentifiers{*usrIDs, *parentOrgIDs, *childOrgIDs},
+					Rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+				},
+			})
+		}
+
+		err = store.SetMember(ctx, childOrgIDs, parentOrgIDs.GetOrganizationIDs(), ttnpb.RightsFrom(ttnpb.RIGHT_ORGANIZATION_ALL))
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		ids, err := store.FindMemberships(ctx, usrIDs, "organization", true)
+
+		if a.So(err, should.BeNil) {
+			a.So(ids, should.HaveLength, 2)
+		}
+	})
+}
+
 func TestMembershipStore(t *testing.T) {
 	ctx := test.Context()
 
@@ -100,7 +184,7 @@ func TestMembershipStore(t *testing.T) {
 		if os.Getenv("TEST_REDIS") == "1" {
 			redis, flush := test.NewRedis(t, "is_membership_store")
 			defer flush()
-			store = GetMembershipCache(store, redis, time.Minute)
+			store = GetMembershipCache(db, store, redis, time.Minute)
 		}
 
 		usr := &User{Account: Account{UID: "test-user"}}
@@ -305,11 +389,142 @@ func TestMembershipStore(t *testing.T) {
 				ttnpb.RightsFrom([]ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_ALL}...),
 			)
 
+			a.So(err, should.BeNil)
+
+			memberships, err := store.FindMemberships(ctx, orgIDs, "organization", false)
+
+			if a.So(err, should.BeNil) && a.So(memberships, should.HaveLength, 1) {
+				a.So(memberships[0], should.Resemble, ttnpb.OrganizationIdentifiers{OrganizationID: "other-org"})
+			}
+
+			// Cycle through other-org.
+			err = store.SetMember(ctx,
+				ttnpb.OrganizationIdentifiers{OrganizationID: "other-org"}.OrganizationOrUserIdentifiers(),
+				ttnpb.OrganizationIdentifiers{OrganizationID: "test-org"},
+				ttnpb.RightsFrom([]ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_ALL}...),
+			)
+
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+
+			// Member of itself.
+			err = store.SetMember(ctx,
+				orgIDs,
+				ttnpb.OrganizationIdentifiers{OrganizationID: "test-org"},
+				ttnpb.RightsFrom([]ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_ALL}...),
+			)
+
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+
+			err = store.SetMember(ctx,
+				orgIDs,
+				ttnpb.OrganizationIdentifiers{OrganizationID: "other-org"},
+				ttnpb.RightsFrom([]ttnpb.Right{}...),
+			)
+
+			a.So(err, should.BeNil)
+		})
+
+		t.Run("Organization-Organization - depth exceeded", func(t *testing.T) {
+			a := assertions.New(t)
+
+			// Nest depth-org-0 in depth-org-1 in ... in depth-org-<maxMembershipDepth-1>.
+			var depthOrgIDs []*ttnpb.OrganizationOrUserIdentifiers
+			for i := 0; i < maxMembershipDepth; i++ {
+				org := &Organization{Account: Account{UID: fmt.Sprintf("depth-org-%d", i)}}
+				s.createEntity(ctx, org)
+				depthOrgIDs = append(depthOrgIDs, org.Account.OrganizationOrUserIdentifiers())
+			}
+			for i := 1; i < maxMembershipDepth; i++ {
+				err := store.SetMember(ctx,
+					depthOrgIDs[i-1],
+					depthOrgIDs[i].GetOrganizationIDs(),
+					ttnpb.RightsFrom([]ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_ALL}...),
+				)
+				a.So(err, should.BeNil)
+			}
+
+			// A user can be a member of the outermost organization through maxMembershipDepth memberships.
+			err := store.SetMember(ctx,
+				usrIDs,
+				depthOrgIDs[0].GetOrganizationIDs(),
+				ttnpb.RightsFrom([]ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_ALL}...),
+			)
+			a.So(err, should.BeNil)
+
+			// The outermost organization can not become a member of another organization.
+			err = store.SetMember(ctx,
+				depthOrgIDs[maxMembershipDepth-1],
+				ttnpb.OrganizationIdentifiers{OrganizationID: "other-org"},
+				ttnpb.RightsFrom([]ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_ALL}...),
+			)
 			if a.So(err, should.NotBeNil) {
 				a.So(errors.IsInvalidArgument(err), should.BeTrue)
 			}
 		})
 
+		t.Run("Organization-Organization - concurrent cycle", func(t *testing.T) {
+			a := assertions.New(t)
+
+			var concurrentOrgIDs []*ttnpb.OrganizationOrUserIdentifiers
+			for i := 0; i < 2; i++ {
+				org := &Organization{Account: Account{UID: fmt.Sprintf("concurrent-org-%d", i)}}
+				s.createEntity(ctx, org)
+				concurrentOrgIDs = append(concurrentOrgIDs, org.Account.OrganizationOrUserIdentifiers())
+			}
+			setMember := func(id, entityID *ttnpb.OrganizationOrUserIdentifiers, commit <-chan struct{}) error {
+				return Transact(ctx, db, func(db *gorm.DB) error {
+					err := GetMembershipStore(db).SetMember(ctx,
+						id,
+						entityID.GetOrganizationIDs(),
+						ttnpb.RightsFrom([]ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_ALL}...),
+					)
+					if err != nil {
+						return err
+					}
+					<-commit
+					return nil
+				})
+			}
+
+			// Make concurrent-org-0 a member of concurrent-org-1, and concurrent-org-1
+			// a member of concurrent-org-0 before the first transaction is committed.
+			commit := make(chan struct{})
+			committed := make(chan struct{})
+			close(committed)
+			errCh := make(chan error, 2)
+			go func() {
+				errCh <- setMember(concurrentOrgIDs[0], concurrentOrgIDs[1], commit)
+			}()
+			time.Sleep(test.Delay)
+			go func() {
+				errCh <- setMember(concurrentOrgIDs[1], concurrentOrgIDs[0], committed)
+			}()
+			time.Sleep(test.Delay)
+			close(commit)
+
+			var errs []error
+			for i := 0; i < 2; i++ {
+				if err := <-errCh; err != nil {
+					errs = append(errs, err)
+				}
+			}
+			a.So(errs, should.HaveLength, 1)
+
+			// Only one of the memberships is created.
+			var memberships int
+			for _, id := range concurrentOrgIDs {
+				ids, err := GetMembershipStore(db).FindMemberships(ctx, id, "organization", false)
+				if a.So(err, should.BeNil) {
+					memberships += len(ids)
+				}
+			}
+			a.So(memberships, should.Equal, 1)
+		})
+
 		userNotFoundIDs := ttnpb.UserIdentifiers{UserID: "test-usr-not-found"}.OrganizationOrUserIdentifiers()
 		organizationNotFoundIDs := ttnpb.UserIdentifiers{UserID: "test-usr-not-found"}.OrganizationOrUserIdentifiers()
 
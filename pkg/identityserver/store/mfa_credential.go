@@ -0,0 +1,66 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// MFACredential is a second factor of a user.
+type MFACredential struct {
+	Model
+
+	User   *User
+	UserID string `gorm:"type:UUID;index:mfa_credential_user_index;not null"`
+
+	Type      int    `gorm:"not null"`
+	Name      string `gorm:"type:VARCHAR"`
+	Confirmed bool   `gorm:"not null"`
+
+	Secret               []byte `gorm:"type:BYTEA"`
+	WebAuthnCredentialID []byte `gorm:"column:webauthn_credential_id;type:BYTEA"`
+	SignCount            uint32
+
+	LastUsedAt *time.Time
+}
+
+func init() {
+	registerModel(&MFACredential{})
+}
+
+func (cred MFACredential) toPB(pb *ttnpb.MFACredential) {
+	pb.ID = cred.ID
+	pb.Type = ttnpb.MFACredentialType(cred.Type)
+	pb.Name = cred.Name
+	pb.CreatedAt = cleanTime(cred.CreatedAt)
+	pb.UpdatedAt = cleanTime(cred.UpdatedAt)
+	pb.LastUsedAt = cleanTimePtr(cred.LastUsedAt)
+	pb.Confirmed = cred.Confirmed
+	pb.Secret = cred.Secret
+	pb.WebAuthnCredentialID = cred.WebAuthnCredentialID
+	pb.SignCount = cred.SignCount
+}
+
+func (cred *MFACredential) fromPB(pb *ttnpb.MFACredential) []string {
+	cred.Name = pb.Name
+	cred.Confirmed = pb.Confirmed
+	cred.Secret = pb.Secret
+	cred.WebAuthnCredentialID = pb.WebAuthnCredentialID
+	cred.SignCount = pb.SignCount
+	cred.LastUsedAt = cleanTimePtr(pb.LastUsedAt)
+	return []string{"name", "confirmed", "secret", "webauthn_credential_id", "sign_count", "last_used_at"}
+}
@@ -0,0 +1,127 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// GetMFAStore returns an MFAStore on the given db (or transaction).
+func GetMFAStore(db *gorm.DB) MFAStore {
+	return &mfaStore{store: newStore(db)}
+}
+
+type mfaStore struct {
+	*store
+}
+
+func (s *mfaStore) CreateMFACredential(ctx context.Context, cred *ttnpb.MFACredential) (*ttnpb.MFACredential, error) {
+	defer trace.StartRegion(ctx, "create mfa credential").End()
+	user, err := s.findEntity(ctx, cred.UserIdentifiers, "id")
+	if err != nil {
+		return nil, err
+	}
+	credModel := MFACredential{
+		UserID: user.PrimaryKey(),
+		Type:   int(cred.Type),
+	}
+	credModel.fromPB(cred)
+	if err = s.createEntity(ctx, &credModel); err != nil {
+		return nil, err
+	}
+	credProto := &ttnpb.MFACredential{UserIdentifiers: cred.UserIdentifiers}
+	credModel.toPB(credProto)
+	return credProto, nil
+}
+
+func (s *mfaStore) FindMFACredentials(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]*ttnpb.MFACredential, error) {
+	defer trace.StartRegion(ctx, "find mfa credentials").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	var credModels []MFACredential
+	err = s.query(ctx, MFACredential{}).
+		Where(MFACredential{UserID: user.PrimaryKey()}).
+		Order("created_at").
+		Find(&credModels).Error
+	if err != nil {
+		return nil, err
+	}
+	credProtos := make([]*ttnpb.MFACredential, len(credModels))
+	for i, credModel := range credModels {
+		credProto := &ttnpb.MFACredential{UserIdentifiers: *userIDs}
+		credModel.toPB(credProto)
+		credProtos[i] = credProto
+	}
+	return credProtos, nil
+}
+
+func (s *mfaStore) getMFACredentialModel(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) (*MFACredential, error) {
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	var credModel MFACredential
+	err = s.query(ctx, MFACredential{}).
+		Where(MFACredential{Model: Model{ID: id}, UserID: user.PrimaryKey()}).
+		First(&credModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errMFACredentialNotFound.WithAttributes("user_id", userIDs.UserID, "id", id)
+		}
+		return nil, err
+	}
+	return &credModel, nil
+}
+
+func (s *mfaStore) GetMFACredential(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) (*ttnpb.MFACredential, error) {
+	defer trace.StartRegion(ctx, "get mfa credential").End()
+	credModel, err := s.getMFACredentialModel(ctx, userIDs, id)
+	if err != nil {
+		return nil, err
+	}
+	credProto := &ttnpb.MFACredential{UserIdentifiers: *userIDs}
+	credModel.toPB(credProto)
+	return credProto, nil
+}
+
+func (s *mfaStore) UpdateMFACredential(ctx context.Context, cred *ttnpb.MFACredential) (*ttnpb.MFACredential, error) {
+	defer trace.StartRegion(ctx, "update mfa credential").End()
+	credModel, err := s.getMFACredentialModel(ctx, &cred.UserIdentifiers, cred.ID)
+	if err != nil {
+		return nil, err
+	}
+	columns := credModel.fromPB(cred)
+	if err = s.updateEntity(ctx, credModel, columns...); err != nil {
+		return nil, err
+	}
+	updated := &ttnpb.MFACredential{UserIdentifiers: cred.UserIdentifiers}
+	credModel.toPB(updated)
+	return updated, nil
+}
+
+func (s *mfaStore) DeleteMFACredential(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) error {
+	defer trace.StartRegion(ctx, "delete mfa credential").End()
+	credModel, err := s.getMFACredentialModel(ctx, userIDs, id)
+	if err != nil {
+		return err
+	}
+	return s.query(ctx, MFACredential{}).Delete(credModel).Error
+}
@@ -0,0 +1,137 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestMFAStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &Account{}, &User{}, &MFACredential{})
+
+		user := &User{
+			Account: Account{
+				UID: "test",
+			},
+			Name: "Test User",
+		}
+
+		userIDs := ttnpb.UserIdentifiers{UserID: "test"}
+		doesNotExistIDs := ttnpb.UserIdentifiers{UserID: "does_not_exist"}
+
+		if err := newStore(db).createEntity(ctx, user); err != nil {
+			panic(err)
+		}
+
+		store := GetMFAStore(db)
+
+		_, err := store.CreateMFACredential(ctx, &ttnpb.MFACredential{UserIdentifiers: doesNotExistIDs})
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		created, err := store.CreateMFACredential(ctx, &ttnpb.MFACredential{
+			UserIdentifiers: userIDs,
+			Type:            ttnpb.MFA_CREDENTIAL_TOTP,
+			Name:            "Phone",
+			Secret:          []byte{0x01, 0x02, 0x03},
+		})
+
+		a.So(err, should.BeNil)
+		if a.So(created, should.NotBeNil) {
+			a.So(created.ID, should.NotBeEmpty)
+			a.So(created.Type, should.Equal, ttnpb.MFA_CREDENTIAL_TOTP)
+			a.So(created.Confirmed, should.BeFalse)
+			a.So(created.CreatedAt, should.NotBeZeroValue)
+		}
+
+		got, err := store.GetMFACredential(ctx, &userIDs, created.ID)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.Name, should.Equal, "Phone")
+			a.So(got.Secret, should.Resemble, []byte{0x01, 0x02, 0x03})
+		}
+
+		_, err = store.GetMFACredential(ctx, &userIDs, "00000000-0000-0000-0000-000000000000")
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		now := time.Now()
+		got.Confirmed = true
+		got.LastUsedAt = &now
+		updated, err := store.UpdateMFACredential(ctx, got)
+
+		a.So(err, should.BeNil)
+		if a.So(updated, should.NotBeNil) {
+			a.So(updated.Confirmed, should.BeTrue)
+			a.So(updated.LastUsedAt, should.NotBeNil)
+			a.So(updated.UpdatedAt, should.NotEqual, created.UpdatedAt)
+		}
+
+		_, err = store.CreateMFACredential(ctx, &ttnpb.MFACredential{
+			UserIdentifiers:      userIDs,
+			Type:                 ttnpb.MFA_CREDENTIAL_WEBAUTHN,
+			Name:                 "Security Key",
+			WebAuthnCredentialID: []byte{0x04, 0x05},
+		})
+
+		a.So(err, should.BeNil)
+
+		_, err = store.FindMFACredentials(ctx, &doesNotExistIDs)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		list, err := store.FindMFACredentials(ctx, &userIDs)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.HaveLength, 2) {
+			a.So(list[0].ID, should.Equal, created.ID)
+			a.So(list[1].WebAuthnCredentialID, should.Resemble, []byte{0x04, 0x05})
+		}
+
+		err = store.DeleteMFACredential(ctx, &userIDs, created.ID)
+
+		a.So(err, should.BeNil)
+
+		err = store.DeleteMFACredential(ctx, &userIDs, created.ID)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		list, err = store.FindMFACredentials(ctx, &userIDs)
+
+		a.So(err, should.BeNil)
+		a.So(list, should.HaveLength, 1)
+	})
+}
@@ -0,0 +1,36 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import "time"
+
+// MFALogin is the pending login of a user that provided the password, but not yet the second factor.
+type MFALogin struct {
+	Model
+
+	User   *User
+	UserID string `gorm:"type:UUID;index:mfa_login_user_index;not null"`
+
+	ExpiresAt time.Time `gorm:"not null"`
+	Attempts  int       `gorm:"not null"`
+	// Challenge is the WebAuthn challenge of the login.
+	Challenge []byte `gorm:"type:BYTEA"`
+	// EnrollmentID is the ID of the second factor that the user is enrolling.
+	EnrollmentID string `gorm:"type:VARCHAR"`
+}
+
+func init() {
+	registerModel(&MFALogin{})
+}
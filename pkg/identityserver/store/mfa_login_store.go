@@ -0,0 +1,117 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// GetMFALoginStore returns an MFALoginStore on the given db (or transaction).
+func GetMFALoginStore(db *gorm.DB) MFALoginStore {
+	return &mfaLoginStore{store: newStore(db)}
+}
+
+type mfaLoginStore struct {
+	*store
+}
+
+func (s *mfaLoginStore) CreateMFALogin(ctx context.Context, userIDs *ttnpb.UserIdentifiers, challenge []byte, expiresAt time.Time) (string, error) {
+	defer trace.StartRegion(ctx, "create mfa login").End()
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return "", err
+	}
+	err = s.query(ctx, MFALogin{}).
+		Where("user_id = ? AND expires_at < ?", user.PrimaryKey(), time.Now()).
+		Delete(MFALogin{}).Error
+	if err != nil {
+		return "", err
+	}
+	loginModel := MFALogin{
+		UserID:    user.PrimaryKey(),
+		ExpiresAt: cleanTime(expiresAt),
+		Challenge: challenge,
+	}
+	if err = s.createEntity(ctx, &loginModel); err != nil {
+		return "", err
+	}
+	return loginModel.ID, nil
+}
+
+func (s *mfaLoginStore) getMFALoginModel(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) (*MFALogin, error) {
+	user, err := s.findEntity(ctx, userIDs, "id")
+	if err != nil {
+		return nil, err
+	}
+	var loginModel MFALogin
+	err = s.query(ctx, MFALogin{}).
+		Where(MFALogin{Model: Model{ID: id}, UserID: user.PrimaryKey()}).
+		Where("expires_at >= ?", time.Now()).
+		First(&loginModel).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errMFALoginNotFound.WithAttributes("user_id", userIDs.UserID)
+		}
+		return nil, err
+	}
+	return &loginModel, nil
+}
+
+func (s *mfaLoginStore) GetMFALogin(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) (*MFALogin, error) {
+	defer trace.StartRegion(ctx, "get mfa login").End()
+	return s.getMFALoginModel(ctx, userIDs, id)
+}
+
+func (s *mfaLoginStore) AddMFALoginAttempt(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) (int, error) {
+	defer trace.StartRegion(ctx, "add mfa login attempt").End()
+	loginModel, err := s.getMFALoginModel(ctx, userIDs, id)
+	if err != nil {
+		return 0, err
+	}
+	// The attempts are incremented in the database, so that concurrent attempts are all counted.
+	err = s.DB.Model(loginModel).UpdateColumn("attempts", gorm.Expr("attempts + 1")).Error
+	if err != nil {
+		return 0, err
+	}
+	loginModel, err = s.getMFALoginModel(ctx, userIDs, id)
+	if err != nil {
+		return 0, err
+	}
+	return loginModel.Attempts, nil
+}
+
+func (s *mfaLoginStore) SetMFALoginEnrollment(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id, enrollmentID string) error {
+	defer trace.StartRegion(ctx, "set mfa login enrollment").End()
+	loginModel, err := s.getMFALoginModel(ctx, userIDs, id)
+	if err != nil {
+		return err
+	}
+	loginModel.EnrollmentID = enrollmentID
+	return s.updateEntity(ctx, loginModel, "enrollment_id")
+}
+
+func (s *mfaLoginStore) DeleteMFALogin(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) error {
+	defer trace.StartRegion(ctx, "delete mfa login").End()
+	loginModel, err := s.getMFALoginModel(ctx, userIDs, id)
+	if err != nil {
+		return err
+	}
+	return s.query(ctx, MFALogin{}).Delete(loginModel).Error
+}
@@ -0,0 +1,111 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestMFALoginStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &Account{}, &User{}, &MFALogin{})
+
+		user := &User{
+			Account: Account{
+				UID: "test",
+			},
+			Name: "Test User",
+		}
+
+		userIDs := ttnpb.UserIdentifiers{UserID: "test"}
+		doesNotExistIDs := ttnpb.UserIdentifiers{UserID: "does_not_exist"}
+
+		if err := newStore(db).createEntity(ctx, user); err != nil {
+			panic(err)
+		}
+
+		store := GetMFALoginStore(db)
+
+		_, err := store.CreateMFALogin(ctx, &doesNotExistIDs, nil, time.Now().Add(time.Minute))
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		expiredID, err := store.CreateMFALogin(ctx, &userIDs, nil, time.Now().Add(-time.Minute))
+
+		a.So(err, should.BeNil)
+
+		_, err = store.GetMFALogin(ctx, &userIDs, expiredID)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		id, err := store.CreateMFALogin(ctx, &userIDs, []byte{0x01, 0x02}, time.Now().Add(time.Minute))
+
+		a.So(err, should.BeNil)
+		a.So(id, should.NotBeEmpty)
+
+		got, err := store.GetMFALogin(ctx, &userIDs, id)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.Challenge, should.Resemble, []byte{0x01, 0x02})
+			a.So(got.Attempts, should.Equal, 0)
+			a.So(got.EnrollmentID, should.BeEmpty)
+		}
+
+		for i := 1; i <= 3; i++ {
+			attempts, err := store.AddMFALoginAttempt(ctx, &userIDs, id)
+
+			a.So(err, should.BeNil)
+			a.So(attempts, should.Equal, i)
+		}
+
+		err = store.SetMFALoginEnrollment(ctx, &userIDs, id, "00000000-0000-0000-0000-000000000000")
+
+		a.So(err, should.BeNil)
+
+		got, err = store.GetMFALogin(ctx, &userIDs, id)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.Attempts, should.Equal, 3)
+			a.So(got.EnrollmentID, should.Equal, "00000000-0000-0000-0000-000000000000")
+		}
+
+		err = store.DeleteMFALogin(ctx, &userIDs, id)
+
+		a.So(err, should.BeNil)
+
+		err = store.DeleteMFALogin(ctx, &userIDs, id)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+	})
+}
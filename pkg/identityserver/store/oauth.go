@@ -66,6 +66,9 @@ type AuthorizationCode struct {
 	RedirectURI string `gorm:"type:VARCHAR;column:redirect_uri"`
 	State       string `gorm:"type:VARCHAR"`
 	ExpiresAt   time.Time
+
+	OpenID bool   `gorm:"column:openid;not null"`
+	Nonce  string `gorm:"type:VARCHAR"`
 }
 
 func (a AuthorizationCode) toPB() *ttnpb.OAuthAuthorizationCode {
@@ -76,6 +79,8 @@ func (a AuthorizationCode) toPB() *ttnpb.OAuthAuthorizationCode {
 		State:       a.State,
 		CreatedAt:   cleanTime(a.CreatedAt),
 		ExpiresAt:   cleanTime(a.ExpiresAt),
+		OpenID:      a.OpenID,
+		Nonce:       a.Nonce,
 	}
 	if a.Client != nil {
 		pb.ClientIDs.ClientID = a.Client.ClientID
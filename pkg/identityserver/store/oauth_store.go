@@ -158,6 +158,8 @@ func (s *oauthStore) CreateAuthorizationCode(ctx context.Context, code *ttnpb.OA
 		RedirectURI: code.RedirectURI,
 		State:       code.State,
 		ExpiresAt:   code.ExpiresAt,
+		OpenID:      code.OpenID,
+		Nonce:       code.Nonce,
 	}
 	if code.UserSessionID != "" {
 		codeModel.UserSessionID = &code.UserSessionID
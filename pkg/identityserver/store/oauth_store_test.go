@@ -143,6 +143,8 @@ func TestOAuthStore(t *testing.T) {
 				Code:        code,
 				RedirectURI: redirectURI,
 				State:       state,
+				OpenID:      true,
+				Nonce:       "nonce",
 			})
 
 			a.So(err, should.BeNil)
@@ -156,6 +158,8 @@ func TestOAuthStore(t *testing.T) {
 				a.So(got.Code, should.Equal, code)
 				a.So(got.RedirectURI, should.Equal, redirectURI)
 				a.So(got.State, should.Equal, state)
+				a.So(got.OpenID, should.BeTrue)
+				a.So(got.Nonce, should.Equal, "nonce")
 				a.So(got.CreatedAt, should.HappenAfter, start)
 				if a.So(got.Rights, should.HaveLength, len(rights)) {
 					for _, right := range rights {
@@ -78,6 +78,13 @@ func (s *organizationStore) CreateOrganization(ctx context.Context, org *ttnpb.O
 	}
 	var orgProto ttnpb.Organization
 	orgModel.toPB(&orgProto, nil)
+	if err := s.writeAuditLog(ctx, auditLogChange{
+		entityID: org.OrganizationIdentifiers,
+		action:   "create",
+		new:      &orgProto,
+	}); err != nil {
+		return nil, err
+	}
 	return &orgProto, nil
 }
 
@@ -139,6 +146,8 @@ func (s *organizationStore) UpdateOrganization(ctx context.Context, org *ttnpb.O
 	if err := ctx.Err(); err != nil { // Early exit if context canceled
 		return nil, err
 	}
+	old := &ttnpb.Organization{}
+	orgModel.toPB(old, fieldMask)
 	oldAttributes := orgModel.Attributes
 	columns := orgModel.fromPB(org, fieldMask)
 	if err = s.updateEntity(ctx, &orgModel, columns...); err != nil {
@@ -151,6 +160,15 @@ func (s *organizationStore) UpdateOrganization(ctx context.Context, org *ttnpb.O
 	}
 	updated = &ttnpb.Organization{}
 	orgModel.toPB(updated, fieldMask)
+	if err = s.writeAuditLog(ctx, auditLogChange{
+		entityID: org.OrganizationIdentifiers,
+		action:   "update",
+		old:      old,
+		new:      updated,
+		paths:    fieldMask.GetPaths(),
+	}); err != nil {
+		return nil, err
+	}
 	return updated, nil
 }
 
@@ -158,3 +176,13 @@ func (s *organizationStore) DeleteOrganization(ctx context.Context, id *ttnpb.Or
 	defer trace.StartRegion(ctx, "delete organization").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *organizationStore) RestoreOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) (err error) {
+	defer trace.StartRegion(ctx, "restore organization").End()
+	return s.restoreEntity(ctx, id)
+}
+
+func (s *organizationStore) PurgeOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) (err error) {
+	defer trace.StartRegion(ctx, "purge organization").End()
+	return s.purgeEntity(ctx, id)
+}
@@ -70,9 +70,13 @@ func limitAndOffsetFromContext(ctx context.Context) (limit, offset uint32) {
 
 // WithOrder instructs the store to sort the results by the given field.
 // If the field is prefixed with a minus, the order is reversed.
+// An empty spec resets the order to the default order.
 func WithOrder(ctx context.Context, spec string) context.Context {
 	if spec == "" {
-		return ctx
+		if _, ok := ctx.Value(orderOptionsKey).(orderOptions); !ok {
+			return ctx
+		}
+		return context.WithValue(ctx, orderOptionsKey, orderOptions{})
 	}
 	field := spec
 	order := "ASC"
@@ -0,0 +1,77 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import "go.thethings.network/lorawan-stack/pkg/ttnpb"
+
+// AccountQuota model. Quotas that are nil are not overridden.
+type AccountQuota struct {
+	Model
+
+	Account   *Account
+	AccountID string `gorm:"type:UUID;unique_index:account_quota_account_index;not null"`
+
+	Applications             *int
+	Gateways                 *int
+	EndDevicesPerApplication *int
+	APIKeys                  *int `gorm:"column:api_keys"`
+	Collaborators            *int
+	Organizations            *int
+}
+
+func init() {
+	registerModel(&AccountQuota{})
+}
+
+type quotaField struct {
+	path  string
+	model **int
+	pb    *uint32
+}
+
+func quotaFields(model *AccountQuota, pb *ttnpb.Quotas) []quotaField {
+	return []quotaField{
+		{path: "api_keys", model: &model.APIKeys, pb: &pb.APIKeys},
+		{path: "applications", model: &model.Applications, pb: &pb.Applications},
+		{path: "collaborators", model: &model.Collaborators, pb: &pb.Collaborators},
+		{path: "end_devices_per_application", model: &model.EndDevicesPerApplication, pb: &pb.EndDevicesPerApplication},
+		{path: "gateways", model: &model.Gateways, pb: &pb.Gateways},
+		{path: "organizations", model: &model.Organizations, pb: &pb.Organizations},
+	}
+}
+
+// toPB returns the overridden quotas and their paths.
+func (q AccountQuota) toPB() (*ttnpb.Quotas, []string) {
+	pb := &ttnpb.Quotas{}
+	var paths []string
+	for _, field := range quotaFields(&q, pb) {
+		if *field.model != nil {
+			*field.pb = uint32(**field.model)
+			paths = append(paths, field.path)
+		}
+	}
+	return pb, paths
+}
+
+// fromPB overrides the quotas in paths, and removes the other overrides.
+func (q *AccountQuota) fromPB(pb *ttnpb.Quotas, paths []string) {
+	for _, field := range quotaFields(q, pb) {
+		*field.model = nil
+		if ttnpb.HasAnyField(paths, field.path) {
+			v := int(*field.pb)
+			*field.model = &v
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"runtime/trace"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// GetQuotaStore returns a QuotaStore on the given db (or transaction).
+func GetQuotaStore(db *gorm.DB) QuotaStore {
+	return &quotaStore{store: newStore(db)}
+}
+
+type quotaStore struct {
+	*store
+}
+
+func (s *quotaStore) findAccount(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers) (*Account, error) {
+	var account Account
+	err := s.query(ctx, Account{}).Where(Account{
+		UID:         id.IDString(),
+		AccountType: id.EntityType(),
+	}).Find(&account).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errNotFoundForID(id)
+		}
+		return nil, err
+	}
+	return &account, nil
+}
+
+func (s *quotaStore) GetQuotas(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers) (*ttnpb.Quotas, []string, error) {
+	defer trace.StartRegion(ctx, "get quotas").End()
+	account, err := s.findAccount(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	var quota AccountQuota
+	err = s.query(ctx, AccountQuota{}).Where(AccountQuota{AccountID: account.PrimaryKey()}).First(&quota).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &ttnpb.Quotas{}, nil, nil
+		}
+		return nil, nil, err
+	}
+	quotas, paths := quota.toPB()
+	return quotas, paths, nil
+}
+
+func (s *quotaStore) SetQuotas(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, quotas *ttnpb.Quotas, paths []string) error {
+	defer trace.StartRegion(ctx, "set quotas").End()
+	account, err := s.findAccount(ctx, id)
+	if err != nil {
+		return err
+	}
+	query := s.query(ctx, AccountQuota{})
+	var quota AccountQuota
+	err = query.Where(AccountQuota{AccountID: account.PrimaryKey()}).First(&quota).Error
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+	oldQuotas, oldPaths := quota.toPB()
+	change := auditLogChange{
+		entityID: id,
+		action:   "quotas.update",
+		old:      oldQuotas,
+		new:      quotas,
+		paths:    append(oldPaths, paths...),
+	}
+	if len(paths) == 0 {
+		if quota.ID == "" {
+			return nil
+		}
+		if err = query.Delete(&quota).Error; err != nil {
+			return err
+		}
+		change.action, change.new = "quotas.delete", nil
+		return s.writeAuditLog(ctx, change)
+	}
+	if quota.ID == "" {
+		quota.AccountID = account.PrimaryKey()
+		quota.SetContext(ctx)
+	}
+	quota.fromPB(quotas, paths)
+	if err = query.Save(&quota).Error; err != nil {
+		return err
+	}
+	return s.writeAuditLog(ctx, change)
+}
+
+func (s *quotaStore) CountOwnedEntities(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityType string) (total uint64, err error) {
+	defer trace.StartRegion(ctx, fmt.Sprintf("count %ss owned by %s", entityType, id.IDString())).End()
+	account, err := s.findAccount(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	// The creator of an entity becomes a collaborator with all rights. Memberships
+	// with fewer rights are not counted.
+	err = s.query(ctx, Membership{}).
+		Where(&Membership{AccountID: account.PrimaryKey(), EntityType: entityType}).
+		Where(`? = ANY("memberships"."rights")`, int(ttnpb.RIGHT_ALL)).
+		Count(&total).Error
+	return
+}
+
+func (s *quotaStore) FindOwner(ctx context.Context, entityID ttnpb.Identifiers) (*ttnpb.OrganizationOrUserIdentifiers, error) {
+	defer trace.StartRegion(ctx, fmt.Sprintf("find owner of %s", entityID.EntityType())).End()
+	entityQuery := s.query(ctx, modelForID(entityID), withID(entityID)).
+		Select(fmt.Sprintf(`"%ss"."id"`, entityID.EntityType())).
+		QueryExpr()
+	var results []struct {
+		UID         string
+		AccountType string
+	}
+	err := s.query(ctx, Account{}).
+		Select(`"accounts"."uid" AS "uid", "accounts"."account_type" AS "account_type"`).
+		Joins(`JOIN "memberships" ON "memberships"."account_id" = "accounts"."id"`).
+		Where(fmt.Sprintf(`"memberships"."entity_type" = '%s' AND "memberships"."entity_id" = (?)`, entityID.EntityType()), entityQuery).
+		Where(`? = ANY("memberships"."rights")`, int(ttnpb.RIGHT_ALL)).
+		Order(`"memberships"."created_at" ASC`).
+		Limit(1).
+		Scan(&results).Error
+	if err != nil || len(results) == 0 {
+		return nil, err
+	}
+	return Account{AccountType: results[0].AccountType, UID: results[0].UID}.OrganizationOrUserIdentifiers(), nil
+}
+
+func (s *quotaStore) LockEntity(ctx context.Context, entityID ttnpb.Identifiers) error {
+	defer trace.StartRegion(ctx, fmt.Sprintf("lock %s", entityID.EntityType())).End()
+	// SELECT ... FOR UPDATE blocks concurrent transactions that lock the same entity
+	// until this transaction is committed or rolled back. Only the row of the entity
+	// itself is locked, as the accounts of organizations and users are outer joined.
+	lockOption := fmt.Sprintf(`FOR UPDATE OF "%ss"`, entityID.EntityType())
+	_, err := newStore(s.DB.Set("gorm:query_option", lockOption)).findEntity(ctx, entityID, "id")
+	return err
+}
+
+func (s *quotaStore) MaxEndDevicesPerApplication(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers) (uint64, error) {
+	defer trace.StartRegion(ctx, "find max end devices per application").End()
+	account, err := s.findAccount(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	membershipsExpr := s.query(ctx, Membership{}).
+		Select(`"memberships"."entity_id"`).
+		Where(&Membership{AccountID: account.PrimaryKey(), EntityType: "application"}).
+		QueryExpr()
+	applicationsExpr := s.query(ctx, Application{}).
+		Select(`"applications"."application_id"`).
+		Where(`"applications"."id" IN (?)`, membershipsExpr).
+		QueryExpr()
+	var res []struct {
+		Count uint64
+	}
+	err = s.query(ctx, EndDevice{}).
+		Select(`COUNT(*) AS "count"`).
+		Where(`"end_devices"."application_id" IN (?)`, applicationsExpr).
+		Group(`"end_devices"."application_id"`).
+		Order(`"count" DESC`).
+		Limit(1).
+		Scan(&res).Error
+	if err != nil || len(res) == 0 {
+		return 0, err
+	}
+	return res[0].Count, nil
+}
@@ -0,0 +1,156 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestQuotaStore(t *testing.T) {
+	ctx := test.Context()
+	a := assertions.New(t)
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db,
+			&AccountQuota{}, &Membership{}, &AuditLogEntry{},
+			&Account{}, &User{}, &Organization{},
+			&Application{}, &EndDevice{},
+		)
+
+		s := newStore(db)
+		store := GetQuotaStore(db)
+
+		usr := &User{Account: Account{UID: "test-user"}}
+		s.createEntity(ctx, usr)
+		usrIDs := ttnpb.UserIdentifiers{UserID: "test-user"}.OrganizationOrUserIdentifiers()
+
+		// The user owns test-app-1, and is a collaborator of test-app-2.
+		for appID, rights := range map[string][]ttnpb.Right{
+			"test-app-1": {ttnpb.RIGHT_ALL},
+			"test-app-2": {ttnpb.RIGHT_APPLICATION_ALL},
+		} {
+			app := &Application{ApplicationID: appID}
+			s.createEntity(ctx, app)
+			s.createEntity(ctx, &Membership{
+				AccountID:  usr.Account.ID,
+				EntityID:   app.ID,
+				EntityType: "application",
+				Rights:     Rights{Rights: rights},
+			})
+		}
+		for _, devID := range []string{"test-dev-1", "test-dev-2", "test-dev-3"} {
+			s.createEntity(ctx, &EndDevice{ApplicationID: "test-app-2", DeviceID: devID})
+		}
+		s.createEntity(ctx, &EndDevice{ApplicationID: "test-app-1", DeviceID: "test-dev-1"})
+
+		t.Run("Usage", func(t *testing.T) {
+			a := assertions.New(t)
+
+			applications, err := store.CountOwnedEntities(ctx, usrIDs, "application")
+			a.So(err, should.BeNil)
+			a.So(applications, should.Equal, 1)
+
+			gateways, err := store.CountOwnedEntities(ctx, usrIDs, "gateway")
+			a.So(err, should.BeNil)
+			a.So(gateways, should.Equal, 0)
+
+			devices, err := store.MaxEndDevicesPerApplication(ctx, usrIDs)
+			a.So(err, should.BeNil)
+			a.So(devices, should.Equal, 3)
+
+			_, err = store.CountOwnedEntities(ctx, ttnpb.UserIdentifiers{UserID: "other-user"}.OrganizationOrUserIdentifiers(), "application")
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		})
+
+		t.Run("Owner", func(t *testing.T) {
+			a := assertions.New(t)
+
+			// An account that is given all rights later does not become the owner.
+			other := &User{Account: Account{UID: "other-owner"}}
+			s.createEntity(ctx, other)
+			app := &Application{}
+			s.query(ctx, Application{}).Where(Application{ApplicationID: "test-app-1"}).First(app)
+			s.createEntity(ctx, &Membership{
+				AccountID:  other.Account.ID,
+				EntityID:   app.ID,
+				EntityType: "application",
+				Rights:     Rights{Rights: []ttnpb.Right{ttnpb.RIGHT_ALL}},
+			})
+
+			owner, err := store.FindOwner(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-1"})
+			a.So(err, should.BeNil)
+			a.So(owner, should.Resemble, usrIDs)
+
+			owner, err = store.FindOwner(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-2"})
+			a.So(err, should.BeNil)
+			a.So(owner, should.BeNil)
+		})
+
+		t.Run("Lock", func(t *testing.T) {
+			a := assertions.New(t)
+
+			err := store.LockEntity(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-1"})
+			a.So(err, should.BeNil)
+
+			err = store.LockEntity(ctx, usrIDs)
+			a.So(err, should.BeNil)
+
+			err = store.LockEntity(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: "other-app"})
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		})
+
+		quotas, paths, err := store.GetQuotas(ctx, usrIDs)
+		a.So(err, should.BeNil)
+		a.So(quotas, should.Resemble, &ttnpb.Quotas{})
+		a.So(paths, should.BeEmpty)
+
+		err = store.SetQuotas(ctx, usrIDs, &ttnpb.Quotas{
+			Applications: 10,
+			Gateways:     0,
+			APIKeys:      5,
+		}, []string{"applications", "gateways"})
+		a.So(err, should.BeNil)
+
+		quotas, paths, err = store.GetQuotas(ctx, usrIDs)
+		a.So(err, should.BeNil)
+		a.So(quotas, should.Resemble, &ttnpb.Quotas{Applications: 10})
+		a.So(paths, should.Resemble, []string{"applications", "gateways"})
+
+		err = store.SetQuotas(ctx, usrIDs, &ttnpb.Quotas{
+			Collaborators: 3,
+		}, []string{"collaborators"})
+		a.So(err, should.BeNil)
+
+		quotas, paths, err = store.GetQuotas(ctx, usrIDs)
+		a.So(err, should.BeNil)
+		a.So(quotas, should.Resemble, &ttnpb.Quotas{Collaborators: 3})
+		a.So(paths, should.Resemble, []string{"collaborators"})
+
+		err = store.SetQuotas(ctx, usrIDs, &ttnpb.Quotas{}, nil)
+		a.So(err, should.BeNil)
+
+		quotas, paths, err = store.GetQuotas(ctx, usrIDs)
+		a.So(err, should.BeNil)
+		a.So(quotas, should.Resemble, &ttnpb.Quotas{})
+		a.So(paths, should.BeEmpty)
+	})
+}
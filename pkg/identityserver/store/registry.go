@@ -26,6 +26,20 @@ func registerModel(m ...interface{}) {
 	models = append(models, m...)
 }
 
+type modelIndex struct {
+	model   interface{}
+	name    string
+	columns []string
+}
+
+var indexes []modelIndex
+
+// registerIndex registers an index that can not be defined in the struct tags
+// of the model, such as an index on the fields of an embedded struct.
+func registerIndex(model interface{}, name string, columns ...string) {
+	indexes = append(indexes, modelIndex{model: model, name: name, columns: columns})
+}
+
 var (
 	errMissingTable  = errors.DefineCorruption("database_table", "database table `{table}` does not exist")
 	errMissingColumn = errors.DefineCorruption("database_table_column", "column `{column}` does not exist in database table `{table}`")
@@ -109,7 +123,15 @@ func Check(db *gorm.DB) error {
 
 // AutoMigrate automatically migrates the database for the registered models.
 func AutoMigrate(db *gorm.DB) *gorm.DB {
-	return db.AutoMigrate(models...)
+	if db = db.AutoMigrate(models...); db.Error != nil {
+		return db
+	}
+	for _, index := range indexes {
+		if res := db.Model(index.model).AddIndex(index.name, index.columns...); res.Error != nil {
+			return res
+		}
+	}
+	return db
 }
 
 // clear database tables for the given models.
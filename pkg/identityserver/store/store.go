@@ -40,7 +40,7 @@ type store struct {
 }
 
 func (s *store) query(ctx context.Context, model interface{}, funcs ...func(*gorm.DB) *gorm.DB) *gorm.DB {
-	query := s.DB.Model(model).Scopes(withContext(ctx))
+	query := s.DB.Model(model).Scopes(withContext(ctx), withSoftDeleted(ctx))
 	if len(funcs) > 0 {
 		query = query.Scopes(funcs...)
 	}
@@ -83,7 +83,10 @@ func (s *store) deleteEntity(ctx context.Context, entityID ttnpb.Identifiers) er
 	if err != nil {
 		return err
 	}
-	return s.DB.Delete(model).Error
+	if err = s.DB.Delete(model).Error; err != nil {
+		return err
+	}
+	return s.writeAuditLog(ctx, auditLogChange{entityID: entityID, action: "delete"})
 }
 
 var (
@@ -169,6 +172,20 @@ func Initialize(db *gorm.DB) error {
 // ErrTransactionRecovered is returned when a panic is caught from a SQL transaction.
 var ErrTransactionRecovered = errors.DefineInternal("transaction_recovered", "Internal Server Error")
 
+const afterCommitKey = "ttn:after_commit"
+
+// AfterCommit calls f after the transaction of db is committed. The function is not
+// called if the transaction is rolled back. If db is not in a transaction that was
+// started with Transact, f is called immediately.
+func AfterCommit(db *gorm.DB, f func()) {
+	if afterCommit, ok := db.Get(afterCommitKey); ok {
+		funcs := afterCommit.(*[]func())
+		*funcs = append(*funcs, f)
+		return
+	}
+	f()
+}
+
 // Transact executes f in a db transaction.
 func Transact(ctx context.Context, db *gorm.DB, f func(db *gorm.DB) error) (err error) {
 	defer trace.StartRegion(ctx, "database transaction").End()
@@ -176,6 +193,8 @@ func Transact(ctx context.Context, db *gorm.DB, f func(db *gorm.DB) error) (err
 	if tx.Error != nil {
 		return convertError(tx.Error)
 	}
+	var afterCommit []func()
+	tx = tx.Set(afterCommitKey, &afterCommit)
 	defer func() {
 		if p := recover(); p != nil {
 			fmt.Fprintln(os.Stderr, p)
@@ -193,8 +212,10 @@ func Transact(ctx context.Context, db *gorm.DB, f func(db *gorm.DB) error) (err
 		}
 		if err != nil {
 			tx.Rollback()
-		} else {
-			err = tx.Commit().Error
+		} else if err = tx.Commit().Error; err == nil {
+			for _, f := range afterCommit {
+				f()
+			}
 		}
 		err = convertError(err)
 	}()
@@ -243,6 +264,11 @@ var (
 	errAccessTokenNotFound       = errors.DefineNotFound("access_token_not_found", "access token not found")
 
 	errAPIKeyNotFound = errors.DefineNotFound("api_key_not_found", "API key not found")
+
+	errMFACredentialNotFound = errors.DefineNotFound("mfa_credential_not_found", "MFA credential `{id}` for user `{user_id}` not found")
+	errMFALoginNotFound      = errors.DefineNotFound("mfa_login_not_found", "pending login of user `{user_id}` not found")
+
+	errExternalUserNotFound = errors.DefineNotFound("external_user_not_found", "user for subject `{subject}` of provider `{provider}` not found")
 )
 
 func errNotFoundForID(id ttnpb.Identifiers) error {
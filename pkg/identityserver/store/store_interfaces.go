@@ -16,6 +16,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -31,6 +32,8 @@ type ApplicationStore interface {
 	GetApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Application, error)
 	UpdateApplication(ctx context.Context, app *ttnpb.Application, fieldMask *types.FieldMask) (*ttnpb.Application, error)
 	DeleteApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error
+	RestoreApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error
+	PurgeApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error
 }
 
 // ClientStore interface for storing Clients.
@@ -43,6 +46,8 @@ type ClientStore interface {
 	GetClient(ctx context.Context, id *ttnpb.ClientIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Client, error)
 	UpdateClient(ctx context.Context, cli *ttnpb.Client, fieldMask *types.FieldMask) (*ttnpb.Client, error)
 	DeleteClient(ctx context.Context, id *ttnpb.ClientIdentifiers) error
+	RestoreClient(ctx context.Context, id *ttnpb.ClientIdentifiers) error
+	PurgeClient(ctx context.Context, id *ttnpb.ClientIdentifiers) error
 }
 
 // EndDeviceStore interface for storing EndDevices.
@@ -69,6 +74,8 @@ type GatewayStore interface {
 	GetGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Gateway, error)
 	UpdateGateway(ctx context.Context, gtw *ttnpb.Gateway, fieldMask *types.FieldMask) (*ttnpb.Gateway, error)
 	DeleteGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error
+	RestoreGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error
+	PurgeGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error
 }
 
 // OrganizationStore interface for storing Organizations.
@@ -81,6 +88,8 @@ type OrganizationStore interface {
 	GetOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Organization, error)
 	UpdateOrganization(ctx context.Context, org *ttnpb.Organization, fieldMask *types.FieldMask) (*ttnpb.Organization, error)
 	DeleteOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error
+	RestoreOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error
+	PurgeOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error
 }
 
 // UserStore interface for storing Users.
@@ -93,6 +102,8 @@ type UserStore interface {
 	GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fieldMask *types.FieldMask) (*ttnpb.User, error)
 	UpdateUser(ctx context.Context, usr *ttnpb.User, fieldMask *types.FieldMask) (*ttnpb.User, error)
 	DeleteUser(ctx context.Context, id *ttnpb.UserIdentifiers) error
+	RestoreUser(ctx context.Context, id *ttnpb.UserIdentifiers) error
+	PurgeUser(ctx context.Context, id *ttnpb.UserIdentifiers) error
 }
 
 // UserSessionStore interface for storing User sessions.
@@ -106,6 +117,47 @@ type UserSessionStore interface {
 	DeleteSession(ctx context.Context, userIDs *ttnpb.UserIdentifiers, sessionID string) error
 }
 
+// MFAStore interface for storing the second factors of users.
+//
+// For internal use (by the OAuth server and the user registry) only.
+type MFAStore interface {
+	CreateMFACredential(ctx context.Context, cred *ttnpb.MFACredential) (*ttnpb.MFACredential, error)
+	FindMFACredentials(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]*ttnpb.MFACredential, error)
+	GetMFACredential(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) (*ttnpb.MFACredential, error)
+	UpdateMFACredential(ctx context.Context, cred *ttnpb.MFACredential) (*ttnpb.MFACredential, error)
+	DeleteMFACredential(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) error
+}
+
+// MFALoginStore interface for storing the pending logins of users that provided
+// the password, but not yet the second factor. Expired pending logins are not found.
+//
+// For internal use (by the OAuth server) only.
+type MFALoginStore interface {
+	// Create a pending login of the user that expires at the given time, and return its ID.
+	CreateMFALogin(ctx context.Context, userIDs *ttnpb.UserIdentifiers, challenge []byte, expiresAt time.Time) (string, error)
+	// Get the pending login of the user.
+	GetMFALogin(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) (*MFALogin, error)
+	// Count an attempt to provide the second factor and return the number of attempts, including this one.
+	AddMFALoginAttempt(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) (int, error)
+	// Set the ID of the second factor that the user is enrolling during the login.
+	SetMFALoginEnrollment(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id, enrollmentID string) error
+	// Delete the pending login.
+	DeleteMFALogin(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) error
+}
+
+// ExternalUserStore interface for storing the links between users and their
+// accounts at external identity providers.
+//
+// For internal use (by the OAuth server) only.
+type ExternalUserStore interface {
+	// Link the user to the subject of an account at the provider.
+	CreateExternalUser(ctx context.Context, userIDs *ttnpb.UserIdentifiers, provider, subject string) error
+	// Get the user that is linked to the subject of an account at the provider.
+	GetExternalUser(ctx context.Context, provider, subject string) (*ttnpb.UserIdentifiers, error)
+	// Delete all links of the user.
+	DeleteExternalUsers(ctx context.Context, userIDs *ttnpb.UserIdentifiers) error
+}
+
 // MembershipStore interface for storing membership (collaboration) relations
 // between accounts (users or organizations) and entities (applications, clients,
 // gateways or organizations).
@@ -122,7 +174,12 @@ type MembershipStore interface {
 	FindMembers(ctx context.Context, entityID ttnpb.Identifiers) (map[*ttnpb.OrganizationOrUserIdentifiers]*ttnpb.Rights, error)
 	// Get direct member rights on an entity.
 	GetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers) (*ttnpb.Rights, error)
+	// Get direct and inherited member rights on an entity. Rights are inherited
+	// through (nested) organizations. The memberships through which the rights
+	// are granted are set in the GrantedBy field of the result.
+	GetMemberRights(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers) (*ttnpb.Rights, error)
 	// Set direct member rights on an entity. Rights can be deleted by not passing any rights.
+	// Organizations can be members of other organizations, as long as this does not create a cycle.
 	SetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers, rights *ttnpb.Rights) error
 }
 
@@ -137,6 +194,10 @@ type APIKeyStore interface {
 	GetAPIKey(ctx context.Context, id string) (ttnpb.Identifiers, *ttnpb.APIKey, error)
 	// Update key rights on an entity. Rights can be deleted by not passing any rights, in which case the returned API key will be nil.
 	UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifiers, key *ttnpb.APIKey) (*ttnpb.APIKey, error)
+	// Find API keys that expire in the given time range, and of which the expiry reminder was not sent yet.
+	FindExpiringAPIKeys(ctx context.Context, expiresAfter, expiresBefore time.Time) ([]*ttnpb.AuthInfoResponse_APIKeyAccess, error)
+	// Set the expiry reminder of the API key as sent. Returns false if it was already set.
+	SetAPIKeyExpiryNotified(ctx context.Context, id string) (bool, error)
 }
 
 // OAuthStore interface for the OAuth server.
@@ -170,6 +231,7 @@ type InvitationStore interface {
 // EntitySearch interface for searching entities.
 type EntitySearch interface {
 	FindEntities(ctx context.Context, member *ttnpb.OrganizationOrUserIdentifiers, req *ttnpb.SearchEntitiesRequest, entityType string) ([]ttnpb.Identifiers, error)
+	FindGateways(ctx context.Context, member *ttnpb.OrganizationOrUserIdentifiers, req *ttnpb.SearchGatewaysRequest) ([]*ttnpb.GatewayIdentifiers, error)
 	FindEndDevices(ctx context.Context, req *ttnpb.SearchEndDevicesRequest) ([]*ttnpb.EndDeviceIdentifiers, error)
 }
 
@@ -181,3 +243,28 @@ type ContactInfoStore interface {
 	// Confirm a validation. Only the ID and Token need to be set.
 	Validate(ctx context.Context, validation *ttnpb.ContactInfoValidation) error
 }
+
+// AuditLogStore interface for the audit log of administrative changes.
+type AuditLogStore interface {
+	// Find the audit log entries that match the filters of the request, newest first.
+	FindAuditLogEntries(ctx context.Context, req *ttnpb.ListAuditLogRequest) ([]*ttnpb.AuditLogEntry, error)
+}
+
+// QuotaStore interface for the quotas of organizations and users.
+type QuotaStore interface {
+	// Get the quotas that are overridden for the organization or user, and the paths of those quotas.
+	GetQuotas(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers) (*ttnpb.Quotas, []string, error)
+	// Override the quotas in paths for the organization or user. Overrides that are not in paths are removed.
+	SetQuotas(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, quotas *ttnpb.Quotas, paths []string) error
+	// Count the entities of the given type that the organization or user owns. These are the
+	// entities that the organization or user is a direct collaborator of with all rights.
+	CountOwnedEntities(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityType string) (uint64, error)
+	// Get the highest number of end devices in any of the applications of the organization or user.
+	MaxEndDevicesPerApplication(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers) (uint64, error)
+	// Find the owner of the entity. This is the first direct collaborator of the entity
+	// that was given all rights. Returns nil if the entity has no owner.
+	FindOwner(ctx context.Context, entityID ttnpb.Identifiers) (*ttnpb.OrganizationOrUserIdentifiers, error)
+	// Lock the entity until the end of the transaction, so that concurrent quota checks
+	// and the creates that follow them are serialized.
+	LockEntity(ctx context.Context, entityID ttnpb.Identifiers) error
+}
@@ -82,6 +82,13 @@ func (s *userStore) CreateUser(ctx context.Context, usr *ttnpb.User) (*ttnpb.Use
 	}
 	var userProto ttnpb.User
 	userModel.toPB(&userProto, nil)
+	if err := s.writeAuditLog(ctx, auditLogChange{
+		entityID: usr.UserIdentifiers,
+		action:   "create",
+		new:      &userProto,
+	}); err != nil {
+		return nil, err
+	}
 	return &userProto, nil
 }
 
@@ -143,6 +150,8 @@ func (s *userStore) UpdateUser(ctx context.Context, usr *ttnpb.User, fieldMask *
 	if err := ctx.Err(); err != nil { // Early exit if context canceled
 		return nil, err
 	}
+	old := &ttnpb.User{}
+	userModel.toPB(old, fieldMask)
 	oldAttributes, oldProfilePicture := userModel.Attributes, userModel.ProfilePicture
 	columns := userModel.fromPB(usr, fieldMask)
 	newProfilePicture := userModel.ProfilePicture
@@ -171,6 +180,15 @@ func (s *userStore) UpdateUser(ctx context.Context, usr *ttnpb.User, fieldMask *
 	userModel.ProfilePicture = newProfilePicture
 	updated = &ttnpb.User{}
 	userModel.toPB(updated, fieldMask)
+	if err = s.writeAuditLog(ctx, auditLogChange{
+		entityID: usr.UserIdentifiers,
+		action:   "update",
+		old:      old,
+		new:      updated,
+		paths:    fieldMask.GetPaths(),
+	}); err != nil {
+		return nil, err
+	}
 	return updated, nil
 }
 
@@ -178,3 +196,13 @@ func (s *userStore) DeleteUser(ctx context.Context, id *ttnpb.UserIdentifiers) (
 	defer trace.StartRegion(ctx, "delete user").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *userStore) RestoreUser(ctx context.Context, id *ttnpb.UserIdentifiers) (err error) {
+	defer trace.StartRegion(ctx, "restore user").End()
+	return s.restoreEntity(ctx, id)
+}
+
+func (s *userStore) PurgeUser(ctx context.Context, id *ttnpb.UserIdentifiers) (err error) {
+	defer trace.StartRegion(ctx, "purge user").End()
+	return s.purgeEntity(ctx, id)
+}
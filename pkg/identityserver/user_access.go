@@ -47,7 +47,7 @@ func (is *IdentityServer) listUserRights(ctx context.Context, ids *ttnpb.UserIde
 	if err != nil {
 		return nil, err
 	}
-	return usrRights.Intersect(ttnpb.AllEntityRights.Union(ttnpb.AllOrganizationRights, ttnpb.AllUserRights)), nil
+	return withGrantedBy(usrRights.Intersect(ttnpb.AllEntityRights.Union(ttnpb.AllOrganizationRights, ttnpb.AllUserRights)), usrRights), nil
 }
 
 func (is *IdentityServer) createUserAPIKey(ctx context.Context, req *ttnpb.CreateUserAPIKeyRequest) (key *ttnpb.APIKey, err error) {
@@ -59,11 +59,14 @@ func (is *IdentityServer) createUserAPIKey(ctx context.Context, req *ttnpb.Creat
 	if err = rights.RequireUser(ctx, req.UserIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
-	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
+	key, token, err := generateAPIKey(ctx, req.Name, req.ExpiresAt, req.AllowedIPRanges, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := is.checkAPIKeyQuota(ctx, db, req.UserIdentifiers); err != nil {
+			return err
+		}
 		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.UserIdentifiers, key)
 	})
 	if err != nil {
@@ -0,0 +1,239 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/pkg/auth/webauthn"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	evtEnrollUserMFA = events.Define(
+		"user.mfa.enroll", "enroll second factor",
+		ttnpb.RIGHT_USER_INFO,
+	)
+	evtDeleteUserMFA = events.Define(
+		"user.mfa.delete", "delete second factor",
+		ttnpb.RIGHT_USER_INFO,
+	)
+	evtCreateUserRecoveryCodes = events.Define(
+		"user.mfa.recovery_codes.create", "create recovery codes",
+		ttnpb.RIGHT_USER_INFO,
+	)
+)
+
+var (
+	errWebAuthnDisabled       = errors.DefineFailedPrecondition("webauthn_disabled", "WebAuthn second factors are not enabled")
+	errMFAEnrollmentConfirmed = errors.DefineFailedPrecondition("mfa_enrollment_confirmed", "enrollment of second factor `{id}` is already finished")
+	errMFAEnrollmentExpired   = errors.DefineFailedPrecondition("mfa_enrollment_expired", "enrollment of second factor `{id}` expired")
+	errMFAEnrollmentType      = errors.DefineInvalidArgument("mfa_enrollment_type", "second factor `{id}` can not be enrolled")
+	errMFAEnrollmentCode      = errors.DefineInvalidArgument("mfa_enrollment_code", "incorrect TOTP code")
+)
+
+// mfaEnrollmentTimeout is the time that the user has to finish the enrollment of a second factor.
+const mfaEnrollmentTimeout = 10 * time.Minute
+
+// cleanMFACredential removes the secrets from the credential before it is returned.
+func cleanMFACredential(cred *ttnpb.MFACredential) *ttnpb.MFACredential {
+	cred.Secret = nil
+	return cred
+}
+
+func (is *IdentityServer) beginMFAEnrollment(ctx context.Context, req *ttnpb.BeginMFAEnrollmentRequest) (*ttnpb.MFAEnrollment, error) {
+	if err := rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_ALL); err != nil {
+		return nil, err
+	}
+	config := is.configFromContext(ctx).OAuth.MFA
+	enrollment := &ttnpb.MFAEnrollment{}
+	var secret []byte
+	var err error
+	switch req.Type {
+	case ttnpb.MFA_CREDENTIAL_TOTP:
+		if secret, err = totp.GenerateSecret(); err != nil {
+			return nil, err
+		}
+		enrollment.TOTPSecret = totp.EncodeSecret(secret)
+		enrollment.TOTPURI = totp.URI(config.TOTPIssuer, req.UserID, secret)
+	case ttnpb.MFA_CREDENTIAL_WEBAUTHN:
+		if !config.WebAuthn.Enabled() {
+			return nil, errWebAuthnDisabled.New()
+		}
+		if secret, err = webauthn.NewChallenge(); err != nil {
+			return nil, err
+		}
+		userHandle := sha256.Sum256([]byte(req.UserID))
+		enrollment.WebAuthnChallenge = secret
+		enrollment.WebAuthnRPID = config.WebAuthn.RPID
+		enrollment.WebAuthnRPName = config.WebAuthn.RPName
+		enrollment.WebAuthnUserHandle = userHandle[:]
+	}
+	var cred *ttnpb.MFACredential
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		cred, err = store.GetMFAStore(db).CreateMFACredential(ctx, &ttnpb.MFACredential{
+			UserIdentifiers: req.UserIdentifiers,
+			Type:            req.Type,
+			Name:            req.Name,
+			Secret:          secret,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	enrollment.ID = cred.ID
+	return enrollment, nil
+}
+
+func (is *IdentityServer) finishMFAEnrollment(ctx context.Context, req *ttnpb.FinishMFAEnrollmentRequest) (cred *ttnpb.MFACredential, err error) {
+	if err := rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_ALL); err != nil {
+		return nil, err
+	}
+	config := is.configFromContext(ctx).OAuth.MFA
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		mfaStore := store.GetMFAStore(db)
+		cred, err = mfaStore.GetMFACredential(ctx, &req.UserIdentifiers, req.ID)
+		if err != nil {
+			return err
+		}
+		if cred.Confirmed {
+			return errMFAEnrollmentConfirmed.WithAttributes("id", req.ID)
+		}
+		if time.Since(cred.CreatedAt) > mfaEnrollmentTimeout {
+			return errMFAEnrollmentExpired.WithAttributes("id", req.ID)
+		}
+		now := time.Now()
+		switch cred.Type {
+		case ttnpb.MFA_CREDENTIAL_TOTP:
+			step, ok := totp.Match(cred.Secret, req.TOTPCode, now)
+			if !ok {
+				return errMFAEnrollmentCode.New()
+			}
+			cred.SignCount = uint32(step)
+		case ttnpb.MFA_CREDENTIAL_WEBAUTHN:
+			if !config.WebAuthn.Enabled() {
+				return errWebAuthnDisabled.New()
+			}
+			registered, err := config.WebAuthn.RelyingParty().ParseRegistration(
+				cred.Secret, req.WebAuthnClientDataJSON, req.WebAuthnAttestationObject,
+			)
+			if err != nil {
+				return err
+			}
+			cred.Secret = registered.PublicKey
+			cred.WebAuthnCredentialID = registered.ID
+			cred.SignCount = registered.SignCount
+		default:
+			return errMFAEnrollmentType.WithAttributes("id", req.ID)
+		}
+		cred.Confirmed = true
+		cred.LastUsedAt = &now
+		cred, err = mfaStore.UpdateMFACredential(ctx, cred)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtEnrollUserMFA(ctx, req.UserIdentifiers, nil))
+	return cleanMFACredential(cred), nil
+}
+
+func (is *IdentityServer) listMFACredentials(ctx context.Context, ids *ttnpb.UserIdentifiers) (creds *ttnpb.MFACredentials, err error) {
+	if err := rights.RequireUser(ctx, *ids, ttnpb.RIGHT_USER_ALL); err != nil {
+		return nil, err
+	}
+	creds = &ttnpb.MFACredentials{}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		found, err := store.GetMFAStore(db).FindMFACredentials(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, cred := range found {
+			creds.Credentials = append(creds.Credentials, cleanMFACredential(cred))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return creds, nil
+}
+
+func (is *IdentityServer) deleteMFACredential(ctx context.Context, req *ttnpb.MFACredentialIdentifiers) (*types.Empty, error) {
+	if err := rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_ALL); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetMFAStore(db).DeleteMFACredential(ctx, &req.UserIdentifiers, req.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtDeleteUserMFA(ctx, req.UserIdentifiers, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) createRecoveryCodes(ctx context.Context, ids *ttnpb.UserIdentifiers) (*ttnpb.RecoveryCodes, error) {
+	if err := rights.RequireUser(ctx, *ids, ttnpb.RIGHT_USER_ALL); err != nil {
+		return nil, err
+	}
+	codes, err := auth.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		mfaStore := store.GetMFAStore(db)
+		existing, err := mfaStore.FindMFACredentials(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, cred := range existing {
+			if cred.Type != ttnpb.MFA_CREDENTIAL_RECOVERY_CODE {
+				continue
+			}
+			if err := mfaStore.DeleteMFACredential(ctx, ids, cred.ID); err != nil {
+				return err
+			}
+		}
+		for _, code := range codes {
+			_, err := mfaStore.CreateMFACredential(ctx, &ttnpb.MFACredential{
+				UserIdentifiers: *ids,
+				Type:            ttnpb.MFA_CREDENTIAL_RECOVERY_CODE,
+				Confirmed:       true,
+				Secret:          auth.HashRecoveryCode(code),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtCreateUserRecoveryCodes(ctx, *ids, nil))
+	return &ttnpb.RecoveryCodes{Codes: codes}, nil
+}
@@ -0,0 +1,130 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestUserMFA(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := population.Users[defaultUserIdx].UserIdentifiers, userCreds(defaultUserIdx)
+
+		reg := ttnpb.NewUserRegistryClient(cc)
+
+		_, err := reg.BeginMFAEnrollment(ctx, &ttnpb.BeginMFAEnrollmentRequest{
+			UserIdentifiers: userID,
+			Type:            ttnpb.MFA_CREDENTIAL_TOTP,
+		})
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err) || errors.IsUnauthenticated(err), should.BeTrue)
+		}
+
+		_, err = reg.BeginMFAEnrollment(ctx, &ttnpb.BeginMFAEnrollmentRequest{
+			UserIdentifiers: userID,
+			Type:            ttnpb.MFA_CREDENTIAL_RECOVERY_CODE,
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		enrollment, err := reg.BeginMFAEnrollment(ctx, &ttnpb.BeginMFAEnrollmentRequest{
+			UserIdentifiers: userID,
+			Type:            ttnpb.MFA_CREDENTIAL_TOTP,
+			Name:            "Phone",
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if !a.So(enrollment, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(enrollment.TOTPURI, should.StartWith, "otpauth://totp/")
+
+		secret, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(enrollment.TOTPSecret)
+		a.So(err, should.BeNil)
+
+		_, err = reg.FinishMFAEnrollment(ctx, &ttnpb.FinishMFAEnrollmentRequest{
+			UserIdentifiers: userID,
+			ID:              enrollment.ID,
+			TOTPCode:        "000000",
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		cred, err := reg.FinishMFAEnrollment(ctx, &ttnpb.FinishMFAEnrollmentRequest{
+			UserIdentifiers: userID,
+			ID:              enrollment.ID,
+			TOTPCode:        totp.Generate(secret, time.Now()),
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(cred, should.NotBeNil) {
+			a.So(cred.Confirmed, should.BeTrue)
+			a.So(cred.Name, should.Equal, "Phone")
+			a.So(cred.Secret, should.BeEmpty)
+		}
+
+		codes, err := reg.CreateRecoveryCodes(ctx, &userID, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(codes, should.NotBeNil) {
+			a.So(codes.Codes, should.HaveLength, 10)
+		}
+
+		_, err = reg.CreateRecoveryCodes(ctx, &userID, creds)
+
+		a.So(err, should.BeNil)
+
+		list, err := reg.ListMFACredentials(ctx, &userID, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.NotBeNil) && a.So(list.Credentials, should.HaveLength, 11) {
+			for _, cred := range list.Credentials {
+				a.So(cred.Secret, should.BeEmpty)
+			}
+		}
+
+		_, err = reg.DeleteMFACredential(ctx, &ttnpb.MFACredentialIdentifiers{
+			UserIdentifiers: userID,
+			ID:              enrollment.ID,
+		}, creds)
+
+		a.So(err, should.BeNil)
+
+		list, err = reg.ListMFACredentials(ctx, &userID, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(list, should.NotBeNil) {
+			a.So(list.Credentials, should.HaveLength, 10)
+		}
+	})
+}
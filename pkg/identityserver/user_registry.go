@@ -50,6 +50,14 @@ var (
 		"user.delete", "delete user",
 		ttnpb.RIGHT_USER_INFO,
 	)
+	evtRestoreUser = events.Define(
+		"user.restore", "restore user",
+		ttnpb.RIGHT_USER_INFO,
+	)
+	evtPurgeUser = events.Define(
+		"user.purge", "purge user",
+		ttnpb.RIGHT_USER_INFO,
+	)
 	evtUpdateUserIncorrectPassword = events.Define(
 		"user.update.incorrect_password", "update user failure: incorrect password",
 		ttnpb.RIGHT_USER_INFO,
@@ -273,6 +281,9 @@ func (is *IdentityServer) listUsers(ctx context.Context, req *ttnpb.ListUsersReq
 	}()
 	users = &ttnpb.Users{}
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		if req.Deleted {
+			paginateCtx = store.WithSoftDeleted(paginateCtx, true)
+		}
 		users.Users, err = store.GetUserStore(db).FindUsers(paginateCtx, nil, &req.FieldMask)
 		if err != nil {
 			return err
@@ -589,6 +600,9 @@ func (is *IdentityServer) deleteUser(ctx context.Context, ids *ttnpb.UserIdentif
 		return nil, err
 	}
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := store.GetExternalUserStore(db).DeleteExternalUsers(ctx, ids); err != nil {
+			return err
+		}
 		return store.GetUserStore(db).DeleteUser(ctx, ids)
 	})
 	if err != nil {
@@ -598,6 +612,34 @@ func (is *IdentityServer) deleteUser(ctx context.Context, ids *ttnpb.UserIdentif
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) restoreUser(ctx context.Context, ids *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetUserStore(db).RestoreUser(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtRestoreUser(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeUser(ctx context.Context, ids *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetUserStore(db).PurgeUser(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtPurgeUser(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
 type userRegistry struct {
 	*IdentityServer
 }
@@ -629,3 +671,31 @@ func (ur *userRegistry) CreateTemporaryPassword(ctx context.Context, req *ttnpb.
 func (ur *userRegistry) Delete(ctx context.Context, req *ttnpb.UserIdentifiers) (*types.Empty, error) {
 	return ur.deleteUser(ctx, req)
 }
+
+func (ur *userRegistry) Restore(ctx context.Context, req *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	return ur.restoreUser(ctx, req)
+}
+
+func (ur *userRegistry) Purge(ctx context.Context, req *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	return ur.purgeUser(ctx, req)
+}
+
+func (ur *userRegistry) BeginMFAEnrollment(ctx context.Context, req *ttnpb.BeginMFAEnrollmentRequest) (*ttnpb.MFAEnrollment, error) {
+	return ur.beginMFAEnrollment(ctx, req)
+}
+
+func (ur *userRegistry) FinishMFAEnrollment(ctx context.Context, req *ttnpb.FinishMFAEnrollmentRequest) (*ttnpb.MFACredential, error) {
+	return ur.finishMFAEnrollment(ctx, req)
+}
+
+func (ur *userRegistry) ListMFACredentials(ctx context.Context, req *ttnpb.UserIdentifiers) (*ttnpb.MFACredentials, error) {
+	return ur.listMFACredentials(ctx, req)
+}
+
+func (ur *userRegistry) DeleteMFACredential(ctx context.Context, req *ttnpb.MFACredentialIdentifiers) (*types.Empty, error) {
+	return ur.deleteMFACredential(ctx, req)
+}
+
+func (ur *userRegistry) CreateRecoveryCodes(ctx context.Context, req *ttnpb.UserIdentifiers) (*ttnpb.RecoveryCodes, error) {
+	return ur.createRecoveryCodes(ctx, req)
+}
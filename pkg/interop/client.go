@@ -107,6 +107,14 @@ func (p jsRPCPaths) rejoin() string  { return p.Rejoin }
 func (p jsRPCPaths) appSKey() string { return p.AppSKey }
 func (p jsRPCPaths) homeNS() string  { return p.HomeNS }
 
+type nsRPCPaths struct {
+	SNS string `yaml:"sns"`
+	FNS string `yaml:"fns"`
+}
+
+func (p nsRPCPaths) sns() string { return p.SNS }
+func (p nsRPCPaths) fns() string { return p.FNS }
+
 func serverURL(scheme, fqdn, path string, port uint32) string {
 	if scheme == "" {
 		scheme = "https"
@@ -157,6 +165,16 @@ func JoinServerFQDN(eui types.EUI64, domain string) string {
 	)
 }
 
+// NetworkServerFQDN constructs Network Server FQDN using specified NetID under domain
+// according to LoRaWAN Backend Interfaces specification.
+// If domain is empty, LoRaAllianceNetIDDomain is used.
+func NetworkServerFQDN(netID types.NetID, domain string) string {
+	if domain == "" {
+		domain = LoRaAllianceNetIDDomain
+	}
+	return fmt.Sprintf("%s.%s", strings.ToLower(netID.String()), domain)
+}
+
 func httpExchange(ctx context.Context, httpReq *http.Request, res interface{}, do func(*http.Request) (*http.Response, error)) error {
 	logger := log.FromContext(ctx).WithField("url", httpReq.URL)
 
@@ -238,6 +256,29 @@ func (cl joinServerHTTPClient) GetAppSKey(ctx context.Context, asID string, req
 	}, nil
 }
 
+// HomeNSRequest performs HomeNS request according to LoRaWAN Backend Interfaces specification.
+func (cl joinServerHTTPClient) HomeNSRequest(ctx context.Context, netID types.NetID, joinEUI, devEUI types.EUI64) (types.NetID, error) {
+	interopAns := &HomeNSAns{}
+	if err := cl.exchange(ctx, joinEUI, jsRPCPaths.homeNS, &HomeNSReq{
+		NsJsMessageHeader: NsJsMessageHeader{
+			MessageHeader: MessageHeader{
+				ProtocolVersion: cl.Protocol.BackendInterfacesVersion(),
+				MessageType:     MessageTypeHomeNSReq,
+			},
+			SenderID:   NetID(netID),
+			ReceiverID: EUI64(joinEUI),
+			SenderNSID: NetID(netID),
+		},
+		DevEUI: EUI64(devEUI),
+	}, interopAns); err != nil {
+		return types.NetID{}, err
+	}
+	if err := parseResult(interopAns.Result); err != nil {
+		return types.NetID{}, err
+	}
+	return types.NetID(interopAns.HNetID), nil
+}
+
 var (
 	errGenerateSessionKeyID = errors.Define("generate_session_key_id", "failed to generate session key ID")
 
@@ -250,7 +291,28 @@ func (cl joinServerHTTPClient) HandleJoinRequest(ctx context.Context, netID type
 	if pld == nil {
 		return nil, ErrMalformedMessage.New()
 	}
+	return cl.handleJoinRequest(ctx, netID, req, MessageTypeJoinReq, jsRPCPaths.join, pld.JoinEUI, pld.DevEUI)
+}
+
+// HandleRejoinRequest performs Rejoin request according to LoRaWAN Backend Interfaces specification.
+func (cl joinServerHTTPClient) HandleRejoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+	pld := req.Payload.GetRejoinRequestPayload()
+	if pld == nil {
+		return nil, ErrMalformedMessage.New()
+	}
+	return cl.handleJoinRequest(ctx, netID, req, MessageTypeRejoinReq, jsRPCPaths.rejoin, rejoinRequestJoinEUI(req), pld.DevEUI)
+}
+
+// rejoinRequestJoinEUI returns the JoinEUI of the rejoin-request.
+// Rejoin-requests of type 0 and 2 do not contain the JoinEUI, so the JoinEUI set by the Network Server is used.
+func rejoinRequestJoinEUI(req *ttnpb.JoinRequest) types.EUI64 {
+	if pld := req.Payload.GetRejoinRequestPayload(); pld != nil && pld.RejoinType == ttnpb.RejoinType_SESSION {
+		return pld.JoinEUI
+	}
+	return req.JoinEUI
+}
 
+func (cl joinServerHTTPClient) handleJoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest, messageType MessageType, pathFunc func(jsRPCPaths) string, joinEUI, devEUI types.EUI64) (*ttnpb.JoinResponse, error) {
 	dlSettings, err := lorawan.MarshalDLSettings(req.DownlinkSettings)
 	if err != nil {
 		return nil, err
@@ -265,19 +327,19 @@ func (cl joinServerHTTPClient) HandleJoinRequest(ctx context.Context, netID type
 	}
 
 	interopAns := &JoinAns{}
-	if err := cl.exchange(ctx, pld.JoinEUI, jsRPCPaths.join, &JoinReq{
+	if err := cl.exchange(ctx, joinEUI, pathFunc, &JoinReq{
 		NsJsMessageHeader: NsJsMessageHeader{
 			MessageHeader: MessageHeader{
 				ProtocolVersion: cl.Protocol.BackendInterfacesVersion(),
-				MessageType:     MessageTypeJoinReq,
+				MessageType:     messageType,
 			},
 			SenderID:   NetID(netID),
-			ReceiverID: EUI64(pld.JoinEUI),
+			ReceiverID: EUI64(joinEUI),
 			SenderNSID: NetID(netID),
 		},
 		MACVersion: MACVersion(req.SelectedMACVersion),
 		PHYPayload: Buffer(req.RawPayload),
-		DevEUI:     EUI64(pld.DevEUI),
+		DevEUI:     EUI64(devEUI),
 		DevAddr:    DevAddr(req.DevAddr),
 		DLSettings: Buffer(dlSettings),
 		RxDelay:    req.RxDelay,
@@ -336,18 +398,106 @@ func makeJoinServerHTTPRequestFunc(scheme, dns, fqdn string, port uint32, rpcPat
 	}
 }
 
+type networkServerHTTPClient struct {
+	Client         http.Client
+	NewRequestFunc func(types.NetID, func(nsRPCPaths) string, interface{}) (*http.Request, error)
+	Protocol       JoinServerProtocol
+}
+
+func (cl networkServerHTTPClient) exchange(ctx context.Context, netID types.NetID, pathFunc func(nsRPCPaths) string, req, res interface{}) error {
+	httpReq, err := cl.NewRequestFunc(netID, pathFunc, req)
+	if err != nil {
+		return err
+	}
+	return httpExchange(ctx, httpReq.WithContext(ctx), res, cl.Client.Do)
+}
+
+// PRStartRequest performs passive roaming start request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) PRStartRequest(ctx context.Context, netID types.NetID, req *PRStartReq) (*PRStartAns, error) {
+	req.ProtocolVersion = cl.Protocol.BackendInterfacesVersion()
+	req.MessageType = MessageTypePRStartReq
+	req.SenderID = NetID(netID)
+	interopAns := &PRStartAns{}
+	if err := cl.exchange(ctx, types.NetID(req.ReceiverID), nsRPCPaths.sns, req, interopAns); err != nil {
+		return nil, err
+	}
+	if err := parseResult(interopAns.Result); err != nil {
+		return nil, err
+	}
+	return interopAns, nil
+}
+
+// XmitDataRequest performs transmit data request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) XmitDataRequest(ctx context.Context, netID types.NetID, req *XmitDataReq) (*XmitDataAns, error) {
+	req.ProtocolVersion = cl.Protocol.BackendInterfacesVersion()
+	req.MessageType = MessageTypeXmitDataReq
+	req.SenderID = NetID(netID)
+	interopAns := &XmitDataAns{}
+	if err := cl.exchange(ctx, types.NetID(req.ReceiverID), nsRPCPaths.fns, req, interopAns); err != nil {
+		return nil, err
+	}
+	if err := parseResult(interopAns.Result); err != nil {
+		return nil, err
+	}
+	return interopAns, nil
+}
+
+func makeNetworkServerHTTPRequestFunc(scheme, dns, fqdn string, port uint32, rpcPaths nsRPCPaths, headers map[string]string) func(types.NetID, func(nsRPCPaths) string, interface{}) (*http.Request, error) {
+	if port == 0 {
+		port = defaultHTTPSPort
+	}
+	if rpcPaths.SNS == "" {
+		rpcPaths.SNS = "sns"
+	}
+	if rpcPaths.FNS == "" {
+		rpcPaths.FNS = "fns"
+	}
+	return func(netID types.NetID, pathFunc func(nsRPCPaths) string, pld interface{}) (*http.Request, error) {
+		fqdn := fqdn // Create a new reference to fqdn to avoid mutating the variable in the outside scope.
+		if fqdn == "" {
+			fqdn = NetworkServerFQDN(netID, dns)
+		}
+		return newHTTPRequest(serverURL(scheme, fqdn, pathFunc(rpcPaths), port), pld, headers)
+	}
+}
+
 type joinServerClient interface {
 	HandleJoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HandleRejoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
 	GetAppSKey(ctx context.Context, asID string, req *ttnpb.SessionKeyRequest) (*ttnpb.AppSKeyResponse, error)
+	HomeNSRequest(ctx context.Context, netID types.NetID, joinEUI, devEUI types.EUI64) (types.NetID, error)
 }
 
 type prefixJoinServerClient struct {
 	joinServerClient
-	prefix types.EUI64Prefix
+	prefix  types.EUI64Prefix
+	address func(types.EUI64) string
+}
+
+type networkServerClient interface {
+	PRStartRequest(ctx context.Context, netID types.NetID, req *PRStartReq) (*PRStartAns, error)
+	XmitDataRequest(ctx context.Context, netID types.NetID, req *XmitDataReq) (*XmitDataAns, error)
+}
+
+// RoamingAgreement is a passive roaming agreement with a network.
+type RoamingAgreement struct {
+	// ForwardUplinks indicates whether uplink messages of devices of the network are forwarded to its Network Server.
+	// With this, the Network Server acts as Forwarding Network Server (fNS) for the network.
+	ForwardUplinks bool `yaml:"forward-uplinks"`
+	// AcceptUplinks indicates whether uplink messages forwarded by the Network Server of the network are accepted.
+	// With this, the Network Server acts as Serving Network Server (sNS) for the network.
+	AcceptUplinks bool `yaml:"accept-uplinks"`
+}
+
+type roamingNetworkServerClient struct {
+	networkServerClient
+	prefix    types.DevAddrPrefix
+	agreement RoamingAgreement
 }
 
 type Client struct {
-	joinServers []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	joinServers    []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	networkServers map[types.NetID]roamingNetworkServerClient
 }
 
 var errUnknownProtocol = errors.DefineInvalidArgument("unknown_protocol", "unknown protocol")
@@ -430,6 +580,11 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 			File     string              `yaml:"file"`
 			JoinEUIs []types.EUI64Prefix `yaml:"join-euis"`
 		} `yaml:"join-servers"`
+		NetworkServers []struct {
+			File           string           `yaml:"file"`
+			NetIDs         []types.NetID    `yaml:"net-ids"`
+			PassiveRoaming RoamingAgreement `yaml:"passive-roaming"`
+		} `yaml:"network-servers"`
 	}
 	if err := yaml.UnmarshalStrict(confFileBytes, &yamlConf); err != nil {
 		return nil, err
@@ -443,12 +598,40 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 		TLS     tlsConfig         `yaml:"tls"`
 	}
 
+	// componentFile returns the fetcher relative to the component configuration file and the contents of the file.
+	componentFile := func(file string) (fetch.Interface, []byte, error) {
+		els := strings.Split(filepath.ToSlash(file), "/")
+		fetcher := fetch.WithBasePath(fetcher, els[:len(els)-1]...)
+		b, err := fetcher.File(els[len(els)-1])
+		if err != nil {
+			return nil, nil, err
+		}
+		return fetcher, b, nil
+	}
+	newHTTPClient := func(fetcher fetch.Interface, conf ComponentConfig) (http.Client, error) {
+		tlsConf := fallbackTLS
+		if !conf.TLS.IsZero() {
+			var err error
+			tlsConf, err = conf.TLS.TLSConfig(fetcher)
+			if err != nil {
+				return http.Client{}, err
+			}
+		}
+
+		var tr *http.Transport
+		if tlsConf != nil {
+			tr = &http.Transport{
+				TLSClientConfig: tlsConf,
+			}
+		}
+		return http.Client{
+			Transport: tr,
+		}, nil
+	}
+
 	jss := make([]prefixJoinServerClient, 0, len(yamlConf.JoinServers))
 	for _, jsConf := range yamlConf.JoinServers {
-		jsConfEls := strings.Split(filepath.ToSlash(jsConf.File), "/")
-
-		fetcher := fetch.WithBasePath(fetcher, jsConfEls[:len(jsConfEls)-1]...)
-		jsFileBytes, err := fetcher.File(jsConfEls[len(jsConfEls)-1])
+		fetcher, jsFileBytes, err := componentFile(jsConf.File)
 		if err != nil {
 			return nil, err
 		}
@@ -465,34 +648,30 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 		var js joinServerClient
 		switch yamlJSConf.Protocol {
 		case LoRaWANJoinServerProtocol1_0, LoRaWANJoinServerProtocol1_1:
-			tlsConf := fallbackTLS
-			if !yamlJSConf.TLS.IsZero() {
-				tlsConf, err = yamlJSConf.TLS.TLSConfig(fetcher)
-				if err != nil {
-					return nil, err
-				}
-			}
-
-			var tr *http.Transport
-			if tlsConf != nil {
-				tr = &http.Transport{
-					TLSClientConfig: tlsConf,
-				}
+			httpClient, err := newHTTPClient(fetcher, yamlJSConf.ComponentConfig)
+			if err != nil {
+				return nil, err
 			}
 			js = &joinServerHTTPClient{
-				Client: http.Client{
-					Transport: tr,
-				},
+				Client:         httpClient,
 				NewRequestFunc: makeJoinServerHTTPRequestFunc("https", yamlJSConf.DNS, yamlJSConf.FQDN, yamlJSConf.Port, yamlJSConf.Paths, yamlJSConf.Headers),
 				Protocol:       yamlJSConf.Protocol,
 			}
 		default:
 			return nil, errUnknownProtocol.New()
 		}
+		dns, fqdn := yamlJSConf.DNS, yamlJSConf.FQDN
+		address := func(joinEUI types.EUI64) string {
+			if fqdn != "" {
+				return fqdn
+			}
+			return JoinServerFQDN(joinEUI, dns)
+		}
 		for _, pre := range jsConf.JoinEUIs {
 			jss = append(jss, prefixJoinServerClient{
 				joinServerClient: js,
 				prefix:           pre,
+				address:          address,
 			})
 		}
 	}
@@ -503,19 +682,84 @@ func NewClient(ctx context.Context, conf config.InteropClient) (*Client, error)
 		}
 		return pi.EUI64.MarshalNumber() > pj.EUI64.MarshalNumber()
 	})
+
+	nss := make(map[types.NetID]roamingNetworkServerClient, len(yamlConf.NetworkServers))
+	for _, nsConf := range yamlConf.NetworkServers {
+		fetcher, nsFileBytes, err := componentFile(nsConf.File)
+		if err != nil {
+			return nil, err
+		}
+
+		var yamlNSConf struct {
+			ComponentConfig `yaml:",inline"`
+			Paths           nsRPCPaths         `yaml:"paths"`
+			Protocol        JoinServerProtocol `yaml:"protocol"`
+		}
+		if err := yaml.UnmarshalStrict(nsFileBytes, &yamlNSConf); err != nil {
+			return nil, err
+		}
+
+		var ns networkServerClient
+		switch yamlNSConf.Protocol {
+		case LoRaWANJoinServerProtocol1_0, LoRaWANJoinServerProtocol1_1:
+			httpClient, err := newHTTPClient(fetcher, yamlNSConf.ComponentConfig)
+			if err != nil {
+				return nil, err
+			}
+			ns = &networkServerHTTPClient{
+				Client:         httpClient,
+				NewRequestFunc: makeNetworkServerHTTPRequestFunc("https", yamlNSConf.DNS, yamlNSConf.FQDN, yamlNSConf.Port, yamlNSConf.Paths, yamlNSConf.Headers),
+				Protocol:       yamlNSConf.Protocol,
+			}
+		default:
+			return nil, errUnknownProtocol.New()
+		}
+		for _, netID := range nsConf.NetIDs {
+			devAddr, err := types.NewDevAddr(netID, nil)
+			if err != nil {
+				return nil, err
+			}
+			nss[netID] = roamingNetworkServerClient{
+				networkServerClient: ns,
+				prefix: types.DevAddrPrefix{
+					DevAddr: devAddr,
+					Length:  uint8(32 - types.NwkAddrBits(netID)),
+				},
+				agreement: nsConf.PassiveRoaming,
+			}
+		}
+	}
 	return &Client{
-		joinServers: jss,
+		joinServers:    jss,
+		networkServers: nss,
 	}, nil
 }
 
-func (cl Client) joinServer(joinEUI types.EUI64) (joinServerClient, bool) {
+func (cl Client) prefixJoinServer(joinEUI types.EUI64) (prefixJoinServerClient, bool) {
 	// NOTE: joinServers slice is sorted by prefix length and the range start decreasing, hence the first match is the most specific one.
 	for _, js := range cl.joinServers {
 		if js.prefix.Matches(joinEUI) {
-			return js.joinServerClient, true
+			return js, true
 		}
 	}
-	return nil, false
+	return prefixJoinServerClient{}, false
+}
+
+func (cl Client) joinServer(joinEUI types.EUI64) (joinServerClient, bool) {
+	js, ok := cl.prefixJoinServer(joinEUI)
+	if !ok {
+		return nil, false
+	}
+	return js.joinServerClient, true
+}
+
+// JoinServerAddress returns the address of the Join Server associated with joinEUI.
+func (cl Client) JoinServerAddress(joinEUI types.EUI64) (string, bool) {
+	js, ok := cl.prefixJoinServer(joinEUI)
+	if !ok {
+		return "", false
+	}
+	return js.address(joinEUI), true
 }
 
 // GetAppSKey performs AppSKey request to Join Server associated with req.JoinEUI.
@@ -539,3 +783,69 @@ func (cl Client) HandleJoinRequest(ctx context.Context, netID types.NetID, req *
 	}
 	return js.HandleJoinRequest(ctx, netID, req)
 }
+
+// HandleRejoinRequest performs Rejoin request to Join Server associated with the JoinEUI of the rejoin-request.
+func (cl Client) HandleRejoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+	if req.Payload.GetRejoinRequestPayload() == nil {
+		return nil, ErrMalformedMessage.New()
+	}
+	js, ok := cl.joinServer(rejoinRequestJoinEUI(req))
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return js.HandleRejoinRequest(ctx, netID, req)
+}
+
+// HomeNSRequest performs HomeNS request to Join Server associated with joinEUI and returns the NetID of the
+// Home Network Server of the device.
+func (cl Client) HomeNSRequest(ctx context.Context, netID types.NetID, joinEUI, devEUI types.EUI64) (types.NetID, error) {
+	js, ok := cl.joinServer(joinEUI)
+	if !ok {
+		return types.NetID{}, errNotRegistered.New()
+	}
+	return js.HomeNSRequest(ctx, netID, joinEUI, devEUI)
+}
+
+// RoamingAgreement returns the passive roaming agreement with the network identified by netID.
+func (cl Client) RoamingAgreement(netID types.NetID) (RoamingAgreement, bool) {
+	ns, ok := cl.networkServers[netID]
+	if !ok {
+		return RoamingAgreement{}, false
+	}
+	return ns.agreement, true
+}
+
+// RoamingNetID returns the NetID and the passive roaming agreement of the network that devAddr belongs to.
+// If devAddr matches the DevAddr prefix of multiple networks, the network with the longest prefix is returned.
+func (cl Client) RoamingNetID(devAddr types.DevAddr) (types.NetID, RoamingAgreement, bool) {
+	var (
+		match   types.NetID
+		matchNS roamingNetworkServerClient
+		ok      bool
+	)
+	for netID, ns := range cl.networkServers {
+		if !devAddr.HasPrefix(ns.prefix) || ok && ns.prefix.Length <= matchNS.prefix.Length {
+			continue
+		}
+		match, matchNS, ok = netID, ns, true
+	}
+	return match, matchNS.agreement, ok
+}
+
+// PRStartRequest performs passive roaming start request to Network Server associated with req.ReceiverID.
+func (cl Client) PRStartRequest(ctx context.Context, netID types.NetID, req *PRStartReq) (*PRStartAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok || !ns.agreement.ForwardUplinks {
+		return nil, ErrNoRoamingAgreement.New()
+	}
+	return ns.PRStartRequest(ctx, netID, req)
+}
+
+// XmitDataRequest performs transmit data request to Network Server associated with req.ReceiverID.
+func (cl Client) XmitDataRequest(ctx context.Context, netID types.NetID, req *XmitDataReq) (*XmitDataAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok || !ns.agreement.AcceptUplinks {
+		return nil, ErrNoRoamingAgreement.New()
+	}
+	return ns.XmitDataRequest(ctx, netID, req)
+}
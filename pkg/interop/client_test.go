@@ -1354,3 +1354,44 @@ paths:
 		})
 	}
 }
+
+func TestJoinServerAddress(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	confDir := test.Must(ioutil.TempDir("", "lorawan-stack-js-interop-test")).(string)
+	defer os.RemoveAll(confDir)
+
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, InteropClientConfigurationName), []byte(`join-servers:
+   - file: test-js-1.yml
+     join-euis:
+        - 70b3d57ed0000000/40
+
+   - file: test-js-2.yml
+     join-euis:
+        - 70b3d57ed0001000/52`), 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, "test-js-1.yml"), []byte(`fqdn: js.example.com
+protocol: BI1.1`), 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, "test-js-2.yml"), []byte(`dns: joineuis.example.com
+protocol: BI1.1`), 0644))
+
+	cl, err := NewClient(ctx, config.InteropClient{
+		Directory:            confDir,
+		GetFallbackTLSConfig: func(context.Context) (*tls.Config, error) { return nil, nil },
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	addr, ok := cl.JoinServerAddress(types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01})
+	a.So(ok, should.BeTrue)
+	a.So(addr, should.Equal, "js.example.com")
+
+	joinEUI := types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x10, 0x01}
+	addr, ok = cl.JoinServerAddress(joinEUI)
+	a.So(ok, should.BeTrue)
+	a.So(addr, should.Equal, JoinServerFQDN(joinEUI, "joineuis.example.com"))
+
+	_, ok = cl.JoinServerAddress(types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42})
+	a.So(ok, should.BeFalse)
+}
@@ -15,6 +15,7 @@
 package interop_test
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"io/ioutil"
@@ -22,6 +23,7 @@ import (
 	"net/http/httptest"
 	"path/filepath"
 
+	. "go.thethings.network/lorawan-stack/pkg/interop"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 )
 
@@ -87,3 +89,25 @@ func newTLSServer(hdl http.Handler) *httptest.Server {
 	srv.StartTLS()
 	return srv
 }
+
+type mockServingNetworkServer struct {
+	PRStartRequestFunc func(context.Context, *PRStartReq) (*PRStartAns, error)
+}
+
+func (m mockServingNetworkServer) PRStartRequest(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+	if m.PRStartRequestFunc == nil {
+		panic("PRStartRequest called, but not set")
+	}
+	return m.PRStartRequestFunc(ctx, req)
+}
+
+type mockForwardingNetworkServer struct {
+	XmitDataRequestFunc func(context.Context, *XmitDataReq) (*XmitDataAns, error)
+}
+
+func (m mockForwardingNetworkServer) XmitDataRequest(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+	if m.XmitDataRequestFunc == nil {
+		panic("XmitDataRequest called, but not set")
+	}
+	return m.XmitDataRequestFunc(ctx, req)
+}
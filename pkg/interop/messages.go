@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	echo "github.com/labstack/echo/v4"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -163,6 +164,26 @@ type JsAsMessageHeader struct {
 	ReceiverID string
 }
 
+// NsMessageHeader contains the message header for NS to NS messages.
+type NsMessageHeader struct {
+	MessageHeader
+	SenderID   NetID
+	ReceiverID NetID
+}
+
+// AnswerHeader returns the header of the answer message.
+func (h NsMessageHeader) AnswerHeader() (NsMessageHeader, error) {
+	header, err := h.MessageHeader.AnswerHeader()
+	if err != nil {
+		return NsMessageHeader{}, err
+	}
+	return NsMessageHeader{
+		MessageHeader: header,
+		SenderID:      h.ReceiverID,
+		ReceiverID:    h.SenderID,
+	}, nil
+}
+
 // JoinReq is a join-request message.
 type JoinReq struct {
 	NsJsMessageHeader
@@ -189,6 +210,101 @@ type JoinAns struct {
 	SessionKeyID Buffer       `json:",omitempty"`
 }
 
+// RejoinReq is a rejoin-request message.
+// For rejoin-requests of type 0 and 2, which do not contain the JoinEUI, the JoinEUI is the ReceiverID.
+type RejoinReq JoinReq
+
+// RejoinAns is an answer to a RejoinReq message.
+type RejoinAns JoinAns
+
+// GWInfoElement contains the metadata of a gateway that received an uplink message.
+type GWInfoElement struct {
+	ID        Buffer   `json:",omitempty"`
+	RFRegion  string   `json:",omitempty"`
+	RSSI      *int     `json:",omitempty"`
+	SNR       *float64 `json:",omitempty"`
+	Lat       *float64 `json:",omitempty"`
+	Lon       *float64 `json:",omitempty"`
+	ULToken   Buffer   `json:",omitempty"`
+	DLAllowed bool     `json:",omitempty"`
+}
+
+// ULMetaData contains the metadata of an uplink message.
+type ULMetaData struct {
+	DevEUI    *EUI64   `json:",omitempty"`
+	DevAddr   *DevAddr `json:",omitempty"`
+	FPort     *uint8   `json:",omitempty"`
+	FCntUp    *uint32  `json:",omitempty"`
+	Confirmed bool     `json:",omitempty"`
+	// DataRate is the data rate index in RFRegion.
+	DataRate *int `json:",omitempty"`
+	// ULFreq is the uplink frequency in MHz.
+	ULFreq   *float64 `json:",omitempty"`
+	RecvTime time.Time
+	RFRegion string `json:",omitempty"`
+	GWCnt    *int   `json:",omitempty"`
+	GWInfo   []GWInfoElement
+}
+
+// DLMetaData contains the metadata of a downlink message.
+type DLMetaData struct {
+	DevEUI    *EUI64  `json:",omitempty"`
+	FPort     *uint8  `json:",omitempty"`
+	FCntDown  *uint32 `json:",omitempty"`
+	Confirmed bool    `json:",omitempty"`
+	// DLFreq1 is the Rx1 frequency in MHz.
+	DLFreq1 *float64 `json:",omitempty"`
+	// DLFreq2 is the Rx2 frequency in MHz.
+	DLFreq2 *float64 `json:",omitempty"`
+	// RXDelay1 is the Rx1 delay in seconds.
+	RXDelay1  *int   `json:",omitempty"`
+	ClassMode string `json:",omitempty"`
+	// DataRate1 is the Rx1 data rate index in RFRegion.
+	DataRate1 *int `json:",omitempty"`
+	// DataRate2 is the Rx2 data rate index in RFRegion.
+	DataRate2      *int   `json:",omitempty"`
+	RFRegion       string `json:",omitempty"`
+	FNSULToken     Buffer `json:",omitempty"`
+	GWInfo         []GWInfoElement
+	HiPriorityFlag bool `json:",omitempty"`
+}
+
+// PRStartReq is a passive roaming start request message.
+type PRStartReq struct {
+	NsMessageHeader
+	PHYPayload Buffer
+	ULMetaData ULMetaData
+}
+
+// PRStartAns is an answer to a PRStartReq message.
+// A Lifetime of 0 indicates stateless passive roaming, in which the fNS sends a PRStartReq for each uplink message.
+type PRStartAns struct {
+	NsMessageHeader
+	Result     Result
+	PHYPayload Buffer      `json:",omitempty"`
+	DevEUI     *EUI64      `json:",omitempty"`
+	Lifetime   *uint32     `json:",omitempty"`
+	DLMetaData *DLMetaData `json:",omitempty"`
+}
+
+// XmitDataReq is a message to transmit a payload.
+type XmitDataReq struct {
+	NsMessageHeader
+	PHYPayload Buffer
+	ULMetaData *ULMetaData `json:",omitempty"`
+	DLMetaData *DLMetaData `json:",omitempty"`
+}
+
+// XmitDataAns is an answer to a XmitDataReq message.
+type XmitDataAns struct {
+	NsMessageHeader
+	Result Result
+	// DLFreq1 is the frequency in MHz on which the downlink is transmitted in Rx1.
+	DLFreq1 *float64 `json:",omitempty"`
+	// DLFreq2 is the frequency in MHz on which the downlink is transmitted in Rx2.
+	DLFreq2 *float64 `json:",omitempty"`
+}
+
 // AppSKeyReq is a AppSKey request message.
 type AppSKeyReq struct {
 	AsJsMessageHeader
@@ -250,6 +366,10 @@ func parseMessage() echo.MiddlewareFunc {
 				msg = &JoinReq{}
 			case MessageTypeJoinAns:
 				msg = &JoinAns{}
+			case MessageTypeRejoinReq:
+				msg = &RejoinReq{}
+			case MessageTypeRejoinAns:
+				msg = &RejoinAns{}
 			case MessageTypeAppSKeyReq:
 				msg = &AppSKeyReq{}
 			case MessageTypeAppSKeyAns:
@@ -258,6 +378,14 @@ func parseMessage() echo.MiddlewareFunc {
 				msg = &HomeNSReq{}
 			case MessageTypeHomeNSAns:
 				msg = &HomeNSAns{}
+			case MessageTypePRStartReq:
+				msg = &PRStartReq{}
+			case MessageTypePRStartAns:
+				msg = &PRStartAns{}
+			case MessageTypeXmitDataReq:
+				msg = &XmitDataReq{}
+			case MessageTypeXmitDataAns:
+				msg = &XmitDataAns{}
 			default:
 				return ErrMalformedMessage.New()
 			}
@@ -0,0 +1,236 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	. "go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+// newRoamingClientConfig returns the interop client configuration of a Network Server with a passive roaming agreement
+// with the Network Server of netID, which serves interop requests at serverURL.
+func newRoamingClientConfig(serverURL string, netID types.NetID, agreement string) (config.InteropClient, func() error) {
+	host := strings.Split(test.Must(url.Parse(serverURL)).(*url.URL).Host, ":")
+	port := test.Must(strconv.ParseUint(host[1], 10, 32)).(uint64)
+
+	confDir := test.Must(ioutil.TempDir("", "lorawan-stack-ns-interop-test")).(string)
+	confPath := filepath.Join(confDir, InteropClientConfigurationName)
+	nsPath := filepath.Join(confDir, "test-ns.yml")
+
+	test.MustMultiple(os.Mkdir(filepath.Join(confDir, "testdata"), 0755))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, ClientCertPath), ClientCert, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, ClientKeyPath), ClientKey, 0644))
+	test.MustMultiple(ioutil.WriteFile(filepath.Join(confDir, RootCAPath), RootCA, 0644))
+
+	test.MustMultiple(ioutil.WriteFile(confPath, []byte(fmt.Sprintf(`network-servers:
+   - file: test-ns.yml
+     net-ids:
+        - "%s"
+     passive-roaming:
+        %s: true`,
+		netID,
+		agreement,
+	)), 0644))
+
+	test.MustMultiple(ioutil.WriteFile(nsPath, []byte(fmt.Sprintf(`fqdn: %s
+port: %d
+protocol: BI1.1
+tls:
+   root-ca: %s
+   certificate: %s
+   key: %s`,
+		host[0],
+		port,
+		RootCAPath,
+		ClientCertPath,
+		ClientKeyPath,
+	)), 0644))
+
+	return config.InteropClient{
+		Directory:            confDir,
+		GetFallbackTLSConfig: func(context.Context) (*tls.Config, error) { return nil, nil },
+	}, func() error {
+		return os.RemoveAll(confDir)
+	}
+}
+
+func TestPassiveRoaming(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	fNSNetID := types.NetID{0x00, 0x00, 0x01}
+	sNSNetID := types.NetID{0x00, 0x00, 0x02}
+
+	newServer := func(senderID types.NetID) *Server {
+		s, err := NewServer(ctx, nil, config.InteropServer{
+			SenderClientCA: config.SenderClientCA{
+				Static: map[string][]byte{
+					senderID.String(): RootCA,
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Failed to create interop server: %v", err)
+		}
+		return s
+	}
+	fNSServer := newServer(sNSNetID)
+	sNSServer := newServer(fNSNetID)
+
+	fNSSrv := newTLSServer(fNSServer)
+	defer fNSSrv.Close()
+	sNSSrv := newTLSServer(sNSServer)
+	defer sNSSrv.Close()
+
+	fNSConf, flushFNS := newRoamingClientConfig(sNSSrv.URL, sNSNetID, "forward-uplinks")
+	defer flushFNS()
+	fNSClient, err := NewClient(ctx, fNSConf)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	sNSConf, flushSNS := newRoamingClientConfig(fNSSrv.URL, fNSNetID, "accept-uplinks")
+	defer flushSNS()
+	sNSClient, err := NewClient(ctx, sNSConf)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	devAddr, err := types.NewDevAddr(sNSNetID, []byte{0x01, 0x02, 0x03})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	netID, agreement, ok := fNSClient.RoamingNetID(devAddr)
+	a.So(ok, should.BeTrue)
+	a.So(netID, should.Equal, sNSNetID)
+	a.So(agreement, should.Resemble, RoamingAgreement{ForwardUplinks: true})
+	_, ok = sNSClient.RoamingAgreement(sNSNetID)
+	a.So(ok, should.BeFalse)
+
+	ulToken := Buffer{0x01, 0x02, 0x03}
+	var xmitDataReq *XmitDataReq
+	fNSServer.RegisterFNS(mockForwardingNetworkServer{
+		XmitDataRequestFunc: func(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+			xmitDataReq = req
+			header, err := req.AnswerHeader()
+			if err != nil {
+				return nil, err
+			}
+			return &XmitDataAns{
+				NsMessageHeader: header,
+				Result: Result{
+					ResultCode: ResultSuccess,
+				},
+			}, nil
+		},
+	})
+	var prStartReq *PRStartReq
+	sNSServer.RegisterSNS(mockServingNetworkServer{
+		PRStartRequestFunc: func(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+			prStartReq = req
+			rxDelay := 1
+			if _, err := sNSClient.XmitDataRequest(ctx, sNSNetID, &XmitDataReq{
+				NsMessageHeader: NsMessageHeader{
+					ReceiverID: req.SenderID,
+				},
+				PHYPayload: Buffer{0x60, 0x01, 0x02, 0x03, 0x04},
+				DLMetaData: &DLMetaData{
+					RXDelay1:  &rxDelay,
+					ClassMode: "A",
+					GWInfo: []GWInfoElement{
+						{
+							ULToken:   req.ULMetaData.GWInfo[0].ULToken,
+							DLAllowed: true,
+						},
+					},
+				},
+			}); err != nil {
+				return nil, err
+			}
+			header, err := req.AnswerHeader()
+			if err != nil {
+				return nil, err
+			}
+			lifetime := uint32(0)
+			return &PRStartAns{
+				NsMessageHeader: header,
+				Result: Result{
+					ResultCode: ResultSuccess,
+				},
+				Lifetime: &lifetime,
+			}, nil
+		},
+	})
+
+	interopDevAddr := DevAddr(devAddr)
+	ans, err := fNSClient.PRStartRequest(ctx, fNSNetID, &PRStartReq{
+		NsMessageHeader: NsMessageHeader{
+			ReceiverID: NetID(sNSNetID),
+		},
+		PHYPayload: Buffer{0x40, 0x01, 0x02, 0x03, 0x04},
+		ULMetaData: ULMetaData{
+			DevAddr:  &interopDevAddr,
+			RFRegion: "EU868",
+			GWInfo: []GWInfoElement{
+				{
+					ID:        Buffer{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+					ULToken:   ulToken,
+					DLAllowed: true,
+				},
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(ans.Result, should.Resemble, Result{ResultCode: ResultSuccess})
+	a.So(ans.SenderID, should.Equal, NetID(sNSNetID))
+	a.So(ans.ReceiverID, should.Equal, NetID(fNSNetID))
+
+	if a.So(prStartReq, should.NotBeNil) {
+		a.So(prStartReq.MessageType, should.Equal, MessageTypePRStartReq)
+		a.So(prStartReq.SenderID, should.Equal, NetID(fNSNetID))
+		a.So(prStartReq.ReceiverID, should.Equal, NetID(sNSNetID))
+		a.So(*prStartReq.ULMetaData.DevAddr, should.Equal, interopDevAddr)
+	}
+	if a.So(xmitDataReq, should.NotBeNil) {
+		a.So(xmitDataReq.MessageType, should.Equal, MessageTypeXmitDataReq)
+		a.So(xmitDataReq.SenderID, should.Equal, NetID(sNSNetID))
+		a.So(xmitDataReq.ReceiverID, should.Equal, NetID(fNSNetID))
+		a.So(xmitDataReq.DLMetaData.GWInfo[0].ULToken, should.Resemble, ulToken)
+	}
+
+	// The sNS does not forward uplink messages to the fNS.
+	_, err = sNSClient.PRStartRequest(ctx, sNSNetID, &PRStartReq{
+		NsMessageHeader: NsMessageHeader{
+			ReceiverID: NetID(fNSNetID),
+		},
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, ErrNoRoamingAgreement)
+}
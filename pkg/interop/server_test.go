@@ -16,6 +16,7 @@ package interop_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -87,6 +88,88 @@ func TestServeHTTP(t *testing.T) {
 				return a.So(res.StatusCode, should.Equal, http.StatusNotFound)
 			},
 		},
+		{
+			Name: "PRStartReq",
+			sNS: mockServingNetworkServer{
+				PRStartRequestFunc: func(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+					header, err := req.AnswerHeader()
+					if err != nil {
+						return nil, err
+					}
+					return &PRStartAns{
+						NsMessageHeader: header,
+						Result: Result{
+							ResultCode: ResultSuccess,
+						},
+					}, nil
+				},
+			},
+			RequestBody: &PRStartReq{
+				NsMessageHeader: NsMessageHeader{
+					MessageHeader: MessageHeader{
+						MessageType:     MessageTypePRStartReq,
+						ProtocolVersion: "1.1",
+					},
+					SenderID:   NetID{0x0, 0x0, 0x01},
+					ReceiverID: NetID{0x0, 0x0, 0x02},
+				},
+				PHYPayload: Buffer{0x40, 0x01, 0x02, 0x03, 0x04},
+			},
+			ResponseAssertion: func(t *testing.T, res *http.Response) bool {
+				a := assertions.New(t)
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg PRStartAns
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.MessageType, should.Equal, MessageTypePRStartAns) &&
+					a.So(msg.Result, should.Resemble, Result{ResultCode: ResultSuccess})
+			},
+		},
+		{
+			Name: "XmitDataReq/NotRegistered",
+			RequestBody: &XmitDataReq{
+				NsMessageHeader: NsMessageHeader{
+					MessageHeader: MessageHeader{
+						MessageType:     MessageTypeXmitDataReq,
+						ProtocolVersion: "1.1",
+					},
+					SenderID:   NetID{0x0, 0x0, 0x01},
+					ReceiverID: NetID{0x0, 0x0, 0x02},
+				},
+				PHYPayload: Buffer{0x60, 0x01, 0x02, 0x03, 0x04},
+			},
+			ResponseAssertion: func(t *testing.T, res *http.Response) bool {
+				a := assertions.New(t)
+				return a.So(res.StatusCode, should.Equal, http.StatusNotFound)
+			},
+		},
+		{
+			Name: "XmitDataReq/TransmitFailed",
+			fNS: mockForwardingNetworkServer{
+				XmitDataRequestFunc: func(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+					return nil, ErrTransmitFailed.New()
+				},
+			},
+			RequestBody: &XmitDataReq{
+				NsMessageHeader: NsMessageHeader{
+					MessageHeader: MessageHeader{
+						MessageType:     MessageTypeXmitDataReq,
+						ProtocolVersion: "1.1",
+					},
+					SenderID:   NetID{0x0, 0x0, 0x01},
+					ReceiverID: NetID{0x0, 0x0, 0x02},
+				},
+				PHYPayload: Buffer{0x60, 0x01, 0x02, 0x03, 0x04},
+			},
+			ResponseAssertion: func(t *testing.T, res *http.Response) bool {
+				a := assertions.New(t)
+				var msg ErrorMessage
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) && a.So(msg.Result, should.Resemble, Result{ResultCode: ResultXmitFailed})
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
@@ -108,6 +191,9 @@ func TestServeHTTP(t *testing.T) {
 			if tc.sNS != nil {
 				s.RegisterSNS(tc.sNS)
 			}
+			if tc.fNS != nil {
+				s.RegisterFNS(tc.fNS)
+			}
 			if tc.AS != nil {
 				s.RegisterAS(tc.AS)
 			}
@@ -31,7 +31,9 @@ var (
 	errEncryptPayload                 = errors.Define("encrypt_payload", "failed to encrypt JoinAccept")
 	errEndDeviceRequest               = errors.DefineInvalidArgument("end_device_request", "GetEndDeviceRequest is invalid")
 	errGenerateSessionKeyID           = errors.Define("generate_session_key_id", "failed to generate session key ID")
+	errInvalidCursor                  = errors.DefineInvalidArgument("cursor", "invalid cursor `{cursor}`")
 	errInvalidIdentifiers             = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errJoinAttemptsNotSupported       = errors.DefineUnimplemented("join_attempts_not_supported", "registry does not support join attempts")
 	errJoinNonceTooHigh               = errors.Define("join_nonce_too_high", "JoinNonce is too high")
 	errMICMismatch                    = errors.DefineInvalidArgument("mic_mismatch", "MIC mismatch")
 	errNetIDMismatch                  = errors.DefineInvalidArgument("net_id_mismatch", "NetID `{net_id}` does not match")
@@ -43,9 +45,11 @@ var (
 	errNoFNwkSIntKey                  = errors.DefineCorruption("no_f_nwk_s_int_key", "no FNwkSIntKey specified")
 	errNoJoinEUI                      = errors.DefineInvalidArgument("no_join_eui", "no JoinEUI specified")
 	errNoJoinRequest                  = errors.DefineInvalidArgument("no_join_request", "no JoinRequest specified")
+	errNoExternalActivationRegistry   = errors.DefineFailedPrecondition("no_external_activation_registry", "no external activation registry configured")
 	errNoNetID                        = errors.DefineFailedPrecondition("no_net_id", "no NetID specified")
 	errNoNwkKey                       = errors.DefineCorruption("no_nwk_key", "no NwkKey specified")
 	errNoNwkSEncKey                   = errors.DefineCorruption("no_nwk_s_enc_key", "no NwkSEncKey specified")
+	errNoRejoinRequest                = errors.DefineInvalidArgument("no_rejoin_request", "no RejoinRequest specified")
 	errNoPayload                      = errors.DefineInvalidArgument("no_payload", "no message payload specified")
 	errNoRootKeys                     = errors.DefineCorruption("no_root_keys", "no root keys specified")
 	errNoSNwkSIntKey                  = errors.DefineCorruption("no_s_nwk_s_int_key", "no SNwkSIntKey specified")
@@ -55,7 +59,10 @@ var (
 	errProvisionerNotFound            = errors.DefineNotFound("provisioner_not_found", "provisioner `{id}` not found")
 	errProvisioning                   = errors.DefineAborted("provisioning", "provisioning failed")
 	errRegistryOperation              = errors.DefineInternal("registry_operation", "registry operation failed")
+	errRejoinCountTooSmall            = errors.DefineInvalidArgument("rejoin_count_too_small", "RJcount1 is too small")
+	errRejoinRequestMACVersion        = errors.DefineInvalidArgument("rejoin_request_mac_version", "rejoin-requests are not supported by LoRaWAN version `{version}`")
 	errReuseDevNonce                  = errors.DefineInvalidArgument("reuse_dev_nonce", "DevNonce has already been used")
+	errScanNotSupported               = errors.DefineUnimplemented("scan_not_supported", "registry does not support scanning")
 	errUnauthenticated                = errors.DefineUnauthenticated("unauthenticated", "unauthenticated")
 	errUnknownJoinEUI                 = errors.Define("unknown_join_eui", "JoinEUI specified is not known")
 	errUnsupportedLoRaWANMajorVersion = errors.DefineInvalidArgument("lorawan_major_version", "unsupported LoRaWAN major version: `{major}`")
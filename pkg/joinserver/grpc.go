@@ -18,7 +18,9 @@ import (
 	"context"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
 )
 
 type jsServer struct {
@@ -27,8 +29,14 @@ type jsServer struct {
 
 // GetJoinEUIPrefixes returns the JoinEUIPrefixes associated with the join server.
 func (srv jsServer) GetJoinEUIPrefixes(ctx context.Context, _ *pbtypes.Empty) (*ttnpb.JoinEUIPrefixes, error) {
-	prefixes := make([]ttnpb.JoinEUIPrefix, 0, len(srv.JS.euiPrefixes))
-	for _, p := range srv.JS.euiPrefixes {
+	euiPrefixes := make([]types.EUI64Prefix, 0, len(srv.JS.euiPrefixes)+len(srv.JS.forwardEUIPrefixes))
+	euiPrefixes = append(euiPrefixes, srv.JS.euiPrefixes...)
+	if srv.JS.interopClient != nil {
+		// Join-requests of devices in the forwarded JoinEUI prefixes are handled by this Join Server as well.
+		euiPrefixes = append(euiPrefixes, srv.JS.forwardEUIPrefixes...)
+	}
+	prefixes := make([]ttnpb.JoinEUIPrefix, 0, len(euiPrefixes))
+	for _, p := range euiPrefixes {
 		prefixes = append(prefixes, ttnpb.JoinEUIPrefix{
 			JoinEUI: p.EUI64,
 			Length:  uint32(p.Length),
@@ -38,3 +46,28 @@ func (srv jsServer) GetJoinEUIPrefixes(ctx context.Context, _ *pbtypes.Empty) (*
 		Prefixes: prefixes,
 	}, nil
 }
+
+// defaultExternalActivationsLimit is the number of external activations returned if no limit is given.
+const defaultExternalActivationsLimit = 100
+
+// ListExternalActivations returns the most recent activations of the device by external Join Servers.
+func (srv jsServer) ListExternalActivations(ctx context.Context, req *ttnpb.ListExternalActivationsRequest) (*ttnpb.ExternalActivations, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	if srv.JS.externalActivations == nil {
+		return nil, errNoExternalActivationRegistry.New()
+	}
+	limit := int64(req.Limit)
+	if limit == 0 {
+		limit = defaultExternalActivationsLimit
+	}
+	res := &ttnpb.ExternalActivations{}
+	if err := srv.JS.externalActivations.Range(ctx, req.JoinEUI, req.DevEUI, limit, func(act *ttnpb.ExternalActivation) bool {
+		res.Activations = append(res.Activations, *act)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -300,3 +300,63 @@ func (srv jsEndDeviceRegistryServer) Delete(ctx context.Context, ids *ttnpb.EndD
 	}
 	return ttnpb.Empty, err
 }
+
+// ResetNonces implements ttnpb.JsEndDeviceRegistryServer.
+func (srv jsEndDeviceRegistryServer) ResetNonces(ctx context.Context, req *ttnpb.ResetEndDeviceNoncesRequest) (*ttnpb.EndDevice, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	paths := []string{
+		"last_dev_nonce",
+		"last_rj_count_1",
+	}
+	if req.ResetUsedDevNonces {
+		paths = append(paths, "used_dev_nonces")
+	}
+	var evt events.Event
+	dev, err := srv.JS.devices.SetByID(ctx, req.ApplicationIdentifiers, req.DeviceID, paths, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		if dev == nil {
+			return nil, nil, errDeviceNotFound.New()
+		}
+		dev.LastDevNonce = 0
+		dev.LastRJCount1 = 0
+		if req.ResetUsedDevNonces {
+			dev.UsedDevNonces = nil
+		}
+		evt = evtUpdateEndDevice(ctx, req.EndDeviceIdentifiers, paths)
+		return dev, paths, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if evt != nil {
+		events.Publish(evt)
+	}
+	return ttnpb.FilterGetEndDevice(dev, paths...)
+}
+
+// defaultJoinAttemptLimit is the number of join attempts returned if no limit is specified.
+const defaultJoinAttemptLimit = 20
+
+// GetJoinAttempts implements ttnpb.JsEndDeviceRegistryServer.
+func (srv jsEndDeviceRegistryServer) GetJoinAttempts(ctx context.Context, req *ttnpb.GetJoinAttemptsRequest) (*ttnpb.JoinAttempts, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	r, ok := srv.JS.devices.(JoinAttemptRegistry)
+	if !ok {
+		return nil, errJoinAttemptsNotSupported.New()
+	}
+	limit := int64(req.Limit)
+	if limit == 0 {
+		limit = defaultJoinAttemptLimit
+	}
+	res := &ttnpb.JoinAttempts{}
+	if err := r.RangeJoinAttempts(ctx, req.EndDeviceIdentifiers, limit, func(attempt *ttnpb.JoinAttempt) bool {
+		res.Attempts = append(res.Attempts, *attempt)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
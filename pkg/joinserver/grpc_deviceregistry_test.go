@@ -1029,3 +1029,183 @@ func TestDeviceRegistryDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceRegistryResetNonces(t *testing.T) {
+	registeredApplicationID := "foo-application"
+	registeredDeviceID := "foo-device"
+	unregisteredDeviceID := "bar-device"
+	registeredJoinEUI := eui64Ptr(types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+	registeredDevEUI := eui64Ptr(types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+	registeredDevice := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: registeredApplicationID,
+			},
+			DeviceID: registeredDeviceID,
+			JoinEUI:  registeredJoinEUI,
+			DevEUI:   registeredDevEUI,
+		},
+		LastDevNonce:  0x42,
+		LastJoinNonce: 0x24,
+		LastRJCount1:  0x12,
+		UsedDevNonces: []uint32{0x1, 0x42},
+	}
+	writeRights := func(ctx context.Context) context.Context {
+		return rights.NewContext(ctx, rights.Rights{
+			ApplicationRights: map[string]*ttnpb.Rights{
+				unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID}): ttnpb.RightsFrom(
+					ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+				),
+			},
+		})
+	}
+	for _, tc := range []struct {
+		Name           string
+		ContextFunc    func(context.Context) context.Context
+		SetByIDFunc    func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
+		Request        *ttnpb.ResetEndDeviceNoncesRequest
+		Device         *ttnpb.EndDevice
+		ErrorAssertion func(*testing.T, error) bool
+		SetByIDCalls   uint64
+	}{
+		{
+			Name: "Permission denied",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+						),
+					},
+				})
+			},
+			Request: &ttnpb.ResetEndDeviceNoncesRequest{
+				EndDeviceIdentifiers: registeredDevice.EndDeviceIdentifiers,
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Errorf("SetByIDFunc must not be called")
+				return nil, errors.New("SetByIDFunc must not be called")
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			},
+		},
+
+		{
+			Name:        "Not found",
+			ContextFunc: writeRights,
+			Request: &ttnpb.ResetEndDeviceNoncesRequest{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+						ApplicationID: registeredApplicationID,
+					},
+					DeviceID: unregisteredDeviceID,
+				},
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(devID, should.Equal, unregisteredDeviceID)
+				dev, _, err := cb(nil)
+				return dev, err
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsNotFound(err), should.BeTrue)
+			},
+			SetByIDCalls: 1,
+		},
+
+		{
+			Name:        "Reset",
+			ContextFunc: writeRights,
+			Request: &ttnpb.ResetEndDeviceNoncesRequest{
+				EndDeviceIdentifiers: registeredDevice.EndDeviceIdentifiers,
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(appID, should.Resemble, registeredDevice.ApplicationIdentifiers)
+				a.So(devID, should.Equal, registeredDeviceID)
+				a.So(paths, should.Resemble, []string{
+					"last_dev_nonce",
+					"last_rj_count_1",
+				})
+				dev, sets, err := cb(CopyEndDevice(registeredDevice))
+				a.So(sets, should.Resemble, paths)
+				return dev, err
+			},
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: registeredDevice.EndDeviceIdentifiers,
+			},
+			SetByIDCalls: 1,
+		},
+
+		{
+			Name:        "Reset used DevNonces",
+			ContextFunc: writeRights,
+			Request: &ttnpb.ResetEndDeviceNoncesRequest{
+				EndDeviceIdentifiers: registeredDevice.EndDeviceIdentifiers,
+				ResetUsedDevNonces:   true,
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(paths, should.Resemble, []string{
+					"last_dev_nonce",
+					"last_rj_count_1",
+					"used_dev_nonces",
+				})
+				dev, sets, err := cb(CopyEndDevice(registeredDevice))
+				a.So(sets, should.Resemble, paths)
+				if a.So(dev, should.NotBeNil) {
+					a.So(dev.LastJoinNonce, should.Equal, registeredDevice.LastJoinNonce)
+				}
+				return dev, err
+			},
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: registeredDevice.EndDeviceIdentifiers,
+			},
+			SetByIDCalls: 1,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var setByIDCalls uint64
+
+			js := test.Must(New(
+				componenttest.NewComponent(t, &component.Config{}),
+				&Config{
+					Devices: &MockDeviceRegistry{
+						SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+							atomic.AddUint64(&setByIDCalls, 1)
+							return tc.SetByIDFunc(ctx, appID, devID, paths, cb)
+						},
+					},
+				},
+			)).(*JoinServer)
+
+			js.AddContextFiller(tc.ContextFunc)
+			js.AddContextFiller(func(ctx context.Context) context.Context {
+				ctx, cancel := context.WithDeadline(ctx, time.Now().Add(Timeout))
+				_ = cancel
+				return ctx
+			})
+			js.AddContextFiller(func(ctx context.Context) context.Context {
+				return test.ContextWithT(ctx, t)
+			})
+			componenttest.StartComponent(t, js.Component)
+			defer js.Close()
+
+			ctx := js.FillContext(test.Context())
+
+			dev, err := ttnpb.NewJsEndDeviceRegistryClient(js.LoopbackConn()).ResetNonces(ctx, tc.Request)
+			a.So(setByIDCalls, should.Equal, tc.SetByIDCalls)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(t, err), should.BeTrue)
+				a.So(dev, should.BeNil)
+			} else if a.So(err, should.BeNil) {
+				a.So(dev, should.Resemble, tc.Device)
+			}
+		})
+	}
+}
@@ -37,6 +37,20 @@ type interopServer struct {
 }
 
 func (srv interopServer) JoinRequest(ctx context.Context, in *interop.JoinReq) (*interop.JoinAns, error) {
+	return srv.handleJoinRequest(ctx, in, nil)
+}
+
+func (srv interopServer) RejoinRequest(ctx context.Context, in *interop.RejoinReq) (*interop.RejoinAns, error) {
+	// Rejoin-requests of type 0 and 2 do not contain the JoinEUI, so the receiver of the message is used.
+	joinEUI := types.EUI64(in.ReceiverID)
+	ans, err := srv.handleJoinRequest(ctx, (*interop.JoinReq)(in), &joinEUI)
+	if err != nil {
+		return nil, err
+	}
+	return (*interop.RejoinAns)(ans), nil
+}
+
+func (srv interopServer) handleJoinRequest(ctx context.Context, in *interop.JoinReq, joinEUI *types.EUI64) (*interop.JoinAns, error) {
 	ctx = log.NewContextWithField(ctx, "namespace", "joinserver/interop")
 
 	var cfList *ttnpb.CFList
@@ -60,6 +74,9 @@ func (srv interopServer) JoinRequest(ctx context.Context, in *interop.JoinReq) (
 		RxDelay:            in.RxDelay,
 		CFList:             cfList,
 	}
+	if joinEUI != nil {
+		req.JoinEUI = *joinEUI
+	}
 	if err := req.ValidateFields(
 		"raw_payload",
 		"dev_addr",
@@ -77,6 +94,7 @@ func (srv interopServer) JoinRequest(ctx context.Context, in *interop.JoinReq) (
 		switch {
 		case errors.Resemble(err, errDecodePayload),
 			errors.Resemble(err, errWrongPayloadType),
+			errors.Resemble(err, errRejoinRequestMACVersion),
 			errors.Resemble(err, errNoDevEUI),
 			errors.Resemble(err, errNoJoinEUI):
 			return nil, interop.ErrMalformedMessage.WithCause(err)
@@ -84,6 +102,8 @@ func (srv interopServer) JoinRequest(ctx context.Context, in *interop.JoinReq) (
 			return nil, interop.ErrActivation.WithCause(err)
 		case errors.Resemble(err, errMICMismatch):
 			return nil, interop.ErrMIC.WithCause(err)
+		case errors.Resemble(err, errRejoinCountTooSmall):
+			return nil, interop.ErrFrameReplayed.WithCause(err)
 		case errors.Resemble(err, errRegistryOperation):
 			if errors.IsNotFound(errors.Cause(err)) {
 				return nil, interop.ErrUnknownDevEUI.WithCause(err)
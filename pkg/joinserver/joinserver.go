@@ -18,6 +18,7 @@ package joinserver
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"crypto/x509/pkix"
 	"encoding/binary"
 	"io"
@@ -36,6 +37,7 @@ import (
 	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoservices"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
@@ -51,10 +53,20 @@ import (
 
 // Config represents the JoinServer configuration.
 type Config struct {
-	Devices         DeviceRegistry      `name:"-"`
-	Keys            KeyRegistry         `name:"-"`
-	JoinEUIPrefixes []types.EUI64Prefix `name:"join-eui-prefix" description:"JoinEUI prefixes handled by this JS"`
-	DeviceKEKLabel  string              `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	Devices                DeviceRegistry             `name:"-"`
+	Keys                   KeyRegistry                `name:"-"`
+	ExternalActivations    ExternalActivationRegistry `name:"-"`
+	JoinEUIPrefixes        []types.EUI64Prefix        `name:"join-eui-prefix" description:"JoinEUI prefixes handled by this JS"`
+	ForwardJoinEUIPrefixes []types.EUI64Prefix        `name:"forward-join-eui-prefix" description:"JoinEUI prefixes of which join-requests of devices that are not in the device registry are forwarded to external Join Servers"`
+	DeviceKEKLabel         string                     `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	Interop                config.InteropClient       `name:"interop" description:"Interop client configuration for forwarding join-requests to external Join Servers"`
+}
+
+// InteropClient is a client, which Join Server can use to forward join-requests to external Join Servers.
+type InteropClient interface {
+	HandleJoinRequest(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HandleRejoinRequest(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	JoinServerAddress(types.EUI64) (string, bool)
 }
 
 // JoinServer implements the Join Server component.
@@ -64,10 +76,14 @@ type JoinServer struct {
 	*component.Component
 	ctx context.Context
 
-	devices DeviceRegistry
-	keys    KeyRegistry
+	devices             DeviceRegistry
+	keys                KeyRegistry
+	externalActivations ExternalActivationRegistry
+
+	euiPrefixes        []types.EUI64Prefix
+	forwardEUIPrefixes []types.EUI64Prefix
 
-	euiPrefixes []types.EUI64Prefix
+	interopClient InteropClient
 
 	entropyMu *sync.Mutex
 	entropy   io.Reader
@@ -88,14 +104,35 @@ func (js *JoinServer) Context() context.Context {
 
 // New returns new *JoinServer.
 func New(c *component.Component, conf *Config) (*JoinServer, error) {
+	ctx := log.NewContextWithField(c.Context(), "namespace", "joinserver")
+
+	var interopCl InteropClient
+	if len(conf.ForwardJoinEUIPrefixes) > 0 && !conf.Interop.IsZero() {
+		interopConf := conf.Interop
+		interopConf.GetFallbackTLSConfig = func(ctx context.Context) (*tls.Config, error) {
+			return c.GetTLSClientConfig(ctx)
+		}
+		interopConf.BlobConfig = c.GetBaseConfig(ctx).Blob
+
+		var err error
+		interopCl, err = interop.NewClient(ctx, interopConf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	js := &JoinServer{
 		Component: c,
-		ctx:       log.NewContextWithField(c.Context(), "namespace", "joinserver"),
+		ctx:       ctx,
+
+		devices:             conf.Devices,
+		keys:                conf.Keys,
+		externalActivations: conf.ExternalActivations,
 
-		devices: conf.Devices,
-		keys:    conf.Keys,
+		euiPrefixes:        conf.JoinEUIPrefixes,
+		forwardEUIPrefixes: conf.ForwardJoinEUIPrefixes,
 
-		euiPrefixes: conf.JoinEUIPrefixes,
+		interopClient: interopCl,
 
 		entropyMu: &sync.Mutex{},
 		entropy:   ulid.Monotonic(rand.New(rand.NewSource(time.Now().UnixNano())), 0),
@@ -221,9 +258,29 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 	}
 
 	logger := log.FromContext(ctx)
+	var (
+		attempt = &ttnpb.JoinAttempt{
+			ReceivedAt: time.Now().UTC(),
+			NetID:      req.NetID,
+		}
+		devIDs *ttnpb.EndDeviceIdentifiers
+		found  bool
+	)
 	defer func() {
 		if err != nil {
-			registerRejectJoin(ctx, req, err)
+			attempt.RejectReason = joinRejectReason(err, found)
+			if ttnErr, ok := errors.From(err); ok {
+				attempt.Error = ttnpb.ErrorDetailsToProto(ttnErr)
+			}
+			registerRejectJoin(ctx, devIDs, attempt, err)
+		}
+		if devIDs == nil {
+			return
+		}
+		if r, ok := js.devices.(JoinAttemptRegistry); ok {
+			if err := r.AddJoinAttempt(ctx, *devIDs, attempt); err != nil {
+				logger.WithError(err).Warn("Failed to record join attempt")
+			}
 		}
 	}()
 
@@ -246,41 +303,82 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 	if req.Payload.Major != ttnpb.Major_LORAWAN_R1 {
 		return nil, errUnsupportedLoRaWANMajorVersion.WithAttributes("major", req.Payload.Major)
 	}
-	if req.Payload.MType != ttnpb.MType_JOIN_REQUEST {
-		return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MType)
-	}
 
-	pld := req.Payload.GetJoinRequestPayload()
-	if pld == nil {
-		return nil, errNoJoinRequest.New()
+	var (
+		joinEUI, devEUI types.EUI64
+		devNonce        types.DevNonce
+		joinReqType     = byte(0xff)
+		rejoinPld       *ttnpb.RejoinRequestPayload
+	)
+	switch req.Payload.MType {
+	case ttnpb.MType_JOIN_REQUEST:
+		pld := req.Payload.GetJoinRequestPayload()
+		if pld == nil {
+			return nil, errNoJoinRequest.New()
+		}
+		joinEUI, devEUI, devNonce = pld.JoinEUI, pld.DevEUI, pld.DevNonce
+
+	case ttnpb.MType_REJOIN_REQUEST:
+		if req.SelectedMACVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+			return nil, errRejoinRequestMACVersion.WithAttributes("version", req.SelectedMACVersion)
+		}
+		rejoinPld = req.Payload.GetRejoinRequestPayload()
+		if rejoinPld == nil {
+			return nil, errNoRejoinRequest.New()
+		}
+		switch rejoinPld.RejoinType {
+		case ttnpb.RejoinType_SESSION:
+			joinEUI = rejoinPld.JoinEUI
+		default:
+			// Rejoin-requests of type 0 and 2 do not contain the JoinEUI and are verified by the Network Server.
+			if !rejoinPld.NetID.Equal(req.NetID) {
+				return nil, errNetIDMismatch.WithAttributes("net_id", rejoinPld.NetID)
+			}
+			joinEUI = req.JoinEUI
+		}
+		devEUI = rejoinPld.DevEUI
+		// The RJcount is used instead of the DevNonce for rejoin-requests.
+		binary.BigEndian.PutUint16(devNonce[:], uint16(rejoinPld.RejoinCnt))
+		joinReqType = byte(rejoinPld.RejoinType)
+
+	default:
+		return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MType)
 	}
-	if pld.DevEUI.IsZero() {
+	if devEUI.IsZero() {
 		return nil, errNoDevEUI.New()
 	}
+	attempt.MType = req.Payload.MType
+	attempt.DevNonce = devNonce
 	logger = logger.WithFields(log.Fields(
-		"join_eui", pld.JoinEUI,
-		"dev_eui", pld.DevEUI,
+		"join_eui", joinEUI,
+		"dev_eui", devEUI,
 	))
 
-	var match bool
-	for _, p := range js.euiPrefixes {
-		if p.Matches(pld.JoinEUI) {
-			match = true
-			break
-		}
+	prefix, match := matchJoinEUIPrefix(js.euiPrefixes, joinEUI)
+	forwardPrefix, forward := matchJoinEUIPrefix(js.forwardEUIPrefixes, joinEUI)
+	forward = forward && js.interopClient != nil
+	if !match && !forward {
+		return nil, errUnknownJoinEUI.New()
+	}
+	if forward && (!match || forwardPrefix.Length > prefix.Length) {
+		prefix = forwardPrefix
 	}
+	defer func() {
+		registerJoinEUIPrefixJoin(ctx, prefix, err)
+	}()
 	if !match {
-		return nil, errUnknownJoinEUI.New()
+		return js.forwardJoin(ctx, joinEUI, devEUI, prefix, req)
 	}
 
 	var handled bool
-	dev, err := js.devices.SetByEUI(ctx, pld.JoinEUI, pld.DevEUI,
+	dev, err := js.devices.SetByEUI(ctx, joinEUI, devEUI,
 		[]string{
 			"application_server_address",
 			"application_server_id",
 			"application_server_kek_label",
 			"last_dev_nonce",
 			"last_join_nonce",
+			"last_rj_count_1",
 			"net_id",
 			"network_server_address",
 			"network_server_kek_label",
@@ -291,6 +389,9 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 			"used_dev_nonces",
 		},
 		func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			found = true
+			ids := dev.EndDeviceIdentifiers
+			devIDs = &ids
 			if dn, ok := auth.X509DNFromContext(ctx); ok {
 				if dev.NetID == nil {
 					return nil, nil, errNoNetID.New()
@@ -307,28 +408,39 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 
 			paths := make([]string, 0, 3)
 
-			dn := uint32(binary.BigEndian.Uint16(pld.DevNonce[:]))
-			if req.SelectedMACVersion.IncrementDevNonce() {
-				if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces {
-					if dn <= dev.LastDevNonce {
-						return nil, nil, errDevNonceTooSmall.New()
+			switch {
+			case rejoinPld == nil:
+				dn := uint32(binary.BigEndian.Uint16(devNonce[:]))
+				if req.SelectedMACVersion.IncrementDevNonce() {
+					if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces {
+						if dn <= dev.LastDevNonce {
+							return nil, nil, errDevNonceTooSmall.New()
+						}
+						if dn == math.MaxUint32 {
+							return nil, nil, errDevNonceTooHigh.New()
+						}
 					}
-					if dn == math.MaxUint32 {
-						return nil, nil, errDevNonceTooHigh.New()
+					dev.LastDevNonce = dn
+					paths = append(paths, "last_dev_nonce")
+				} else {
+					i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
+					if i >= len(dev.UsedDevNonces) || dev.UsedDevNonces[i] != dn {
+						dev.UsedDevNonces = append(dev.UsedDevNonces, 0)
+						copy(dev.UsedDevNonces[i+1:], dev.UsedDevNonces[i:])
+						dev.UsedDevNonces[i] = dn
+						paths = append(paths, "used_dev_nonces")
+					} else if !dev.ResetsJoinNonces {
+						return nil, nil, errReuseDevNonce.New()
 					}
 				}
-				dev.LastDevNonce = dn
-				paths = append(paths, "last_dev_nonce")
-			} else {
-				i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
-				if i >= len(dev.UsedDevNonces) || dev.UsedDevNonces[i] != dn {
-					dev.UsedDevNonces = append(dev.UsedDevNonces, 0)
-					copy(dev.UsedDevNonces[i+1:], dev.UsedDevNonces[i:])
-					dev.UsedDevNonces[i] = dn
-					paths = append(paths, "used_dev_nonces")
-				} else if !dev.ResetsJoinNonces {
-					return nil, nil, errReuseDevNonce.New()
+
+			case rejoinPld.RejoinType == ttnpb.RejoinType_SESSION:
+				rjc := rejoinPld.RejoinCnt
+				if (rjc != 0 || dev.LastRJCount1 != 0) && rjc <= dev.LastRJCount1 {
+					return nil, nil, errRejoinCountTooSmall.New()
 				}
+				dev.LastRJCount1 = rjc
+				paths = append(paths, "last_rj_count_1")
 			}
 
 			var b []byte
@@ -420,26 +532,43 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 			if err := cryptoDev.SetFields(dev, "ids", "provisioner_id", "provisioning_data"); err != nil {
 				return nil, nil, err
 			}
-			reqMIC, err := networkCryptoService.JoinRequestMIC(ctx, cryptoDev, req.SelectedMACVersion, req.RawPayload[:19])
-			if err != nil {
-				return nil, nil, errComputeMIC.WithCause(err)
-			}
-			if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
-				return nil, nil, errMICMismatch.New()
+			switch {
+			case rejoinPld == nil:
+				reqMIC, err := networkCryptoService.JoinRequestMIC(ctx, cryptoDev, req.SelectedMACVersion, req.RawPayload[:19])
+				if err != nil {
+					return nil, nil, errComputeMIC.WithCause(err)
+				}
+				if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
+					return nil, nil, errMICMismatch.New()
+				}
+
+			case rejoinPld.RejoinType == ttnpb.RejoinType_SESSION:
+				reqMIC, err := networkCryptoService.RejoinRequestMIC(ctx, cryptoDev, req.SelectedMACVersion, req.RawPayload[:20])
+				if err != nil {
+					return nil, nil, errComputeMIC.WithCause(err)
+				}
+				if !bytes.Equal(reqMIC[:], req.RawPayload[20:]) {
+					return nil, nil, errMICMismatch.New()
+				}
 			}
-			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMACVersion, 0xff, pld.DevNonce, b)
+			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMACVersion, joinReqType, devNonce, b)
 			if err != nil {
 				return nil, nil, errComputeMIC.WithCause(err)
 			}
-			enc, err := networkCryptoService.EncryptJoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			var enc []byte
+			if rejoinPld == nil {
+				enc, err = networkCryptoService.EncryptJoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			} else {
+				enc, err = networkCryptoService.EncryptRejoinAccept(ctx, cryptoDev, req.SelectedMACVersion, append(b[1:], resMIC[:]...))
+			}
 			if err != nil {
 				return nil, nil, errEncryptPayload.WithCause(err)
 			}
-			nwkSKeys, err := networkCryptoService.DeriveNwkSKeys(ctx, cryptoDev, req.SelectedMACVersion, jn, pld.DevNonce, req.NetID)
+			nwkSKeys, err := networkCryptoService.DeriveNwkSKeys(ctx, cryptoDev, req.SelectedMACVersion, jn, devNonce, req.NetID)
 			if err != nil {
 				return nil, nil, errDeriveNwkSKeys.WithCause(err)
 			}
-			appSKey, err := applicationCryptoService.DeriveAppSKey(ctx, cryptoDev, req.SelectedMACVersion, jn, pld.DevNonce, req.NetID)
+			appSKey, err := applicationCryptoService.DeriveAppSKey(ctx, cryptoDev, req.SelectedMACVersion, jn, devNonce, req.NetID)
 			if err != nil {
 				return nil, nil, errDeriveAppSKey.WithCause(err)
 			}
@@ -518,6 +647,10 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 	)
 	if err != nil {
 		logger := logger.WithError(err)
+		if !found && forward && errors.IsNotFound(err) {
+			logger.Debug("Device not found, forward join-request")
+			return js.forwardJoin(ctx, joinEUI, devEUI, prefix, req)
+		}
 		if !handled {
 			logger.Info("Join not accepted")
 			return nil, err
@@ -526,7 +659,73 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 		return nil, errRegistryOperation.WithCause(err)
 	}
 
-	registerAcceptJoin(dev.Context, dev.EndDevice, req)
+	attempt.Accepted = true
+	attempt.SessionKeyID = res.SessionKeys.SessionKeyID
+	registerAcceptJoin(dev.Context, dev.EndDevice, attempt)
+	return res, nil
+}
+
+// joinRejectReason returns the reason why the join-request is rejected with err.
+// found indicates whether the end device is registered.
+func joinRejectReason(err error, found bool) ttnpb.JoinRejectReason {
+	switch {
+	case !found && errors.IsNotFound(err):
+		return ttnpb.JoinRejectReason_JOIN_REJECT_UNKNOWN_DEVICE
+	case errors.Resemble(err, errReuseDevNonce),
+		errors.Resemble(err, errDevNonceTooSmall),
+		errors.Resemble(err, errRejoinCountTooSmall):
+		return ttnpb.JoinRejectReason_JOIN_REJECT_DEV_NONCE_REPLAYED
+	case errors.Resemble(err, errMICMismatch), errors.Resemble(err, interop.ErrMIC):
+		return ttnpb.JoinRejectReason_JOIN_REJECT_MIC_FAILED
+	default:
+		return ttnpb.JoinRejectReason_JOIN_REJECT_OTHER
+	}
+}
+
+// matchJoinEUIPrefix returns the most specific prefix in prefixes that matches joinEUI.
+func matchJoinEUIPrefix(prefixes []types.EUI64Prefix, joinEUI types.EUI64) (types.EUI64Prefix, bool) {
+	var (
+		match types.EUI64Prefix
+		ok    bool
+	)
+	for _, p := range prefixes {
+		if p.Matches(joinEUI) && (!ok || p.Length > match.Length) {
+			match, ok = p, true
+		}
+	}
+	return match, ok
+}
+
+// forwardJoin forwards the join-request to the external Join Server of joinEUI and records the external activation.
+func (js *JoinServer) forwardJoin(ctx context.Context, joinEUI, devEUI types.EUI64, prefix types.EUI64Prefix, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"join_eui", joinEUI,
+		"dev_eui", devEUI,
+	))
+	handle := js.interopClient.HandleJoinRequest
+	if req.Payload.MType == ttnpb.MType_REJOIN_REQUEST {
+		handle = js.interopClient.HandleRejoinRequest
+	}
+	res, err := handle(ctx, req.NetID, req)
+	if err != nil {
+		logger.WithError(err).Info("External Join Server did not accept join")
+		return nil, err
+	}
+	registerForwardJoin(ctx, prefix)
+
+	if js.externalActivations != nil {
+		addr, _ := js.interopClient.JoinServerAddress(joinEUI)
+		if err := js.externalActivations.Add(ctx, &ttnpb.ExternalActivation{
+			JoinEUI:           joinEUI,
+			DevEUI:            devEUI,
+			NetID:             req.NetID,
+			JoinServerAddress: addr,
+			SessionKeyID:      res.SessionKeys.SessionKeyID,
+			ActivatedAt:       time.Now().UTC(),
+		}); err != nil {
+			logger.WithError(err).Warn("Failed to record external activation")
+		}
+	}
 	return res, nil
 }
 
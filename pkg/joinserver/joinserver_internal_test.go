@@ -105,3 +105,37 @@ func (m MockKeyRegistry) SetByID(ctx context.Context, joinEUI, devEUI types.EUI6
 	}
 	return m.SetByIDFunc(ctx, joinEUI, devEUI, id, paths, f)
 }
+
+type MockInteropClient struct {
+	HandleJoinRequestFunc   func(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HandleRejoinRequestFunc func(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	JoinServerAddressFunc   func(types.EUI64) (string, bool)
+}
+
+// HandleJoinRequest calls HandleJoinRequestFunc if set and panics otherwise.
+func (m MockInteropClient) HandleJoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+	if m.HandleJoinRequestFunc == nil {
+		panic("HandleJoinRequest called, but not set")
+	}
+	return m.HandleJoinRequestFunc(ctx, netID, req)
+}
+
+// HandleRejoinRequest calls HandleRejoinRequestFunc if set and panics otherwise.
+func (m MockInteropClient) HandleRejoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+	if m.HandleRejoinRequestFunc == nil {
+		panic("HandleRejoinRequest called, but not set")
+	}
+	return m.HandleRejoinRequestFunc(ctx, netID, req)
+}
+
+// JoinServerAddress calls JoinServerAddressFunc if set and panics otherwise.
+func (m MockInteropClient) JoinServerAddress(joinEUI types.EUI64) (string, bool) {
+	if m.JoinServerAddressFunc == nil {
+		panic("JoinServerAddress called, but not set")
+	}
+	return m.JoinServerAddressFunc(joinEUI)
+}
+
+func SetInteropClient(js *JoinServer, cl InteropClient) {
+	js.interopClient = cl
+}
@@ -30,6 +30,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/interop"
 	. "go.thethings.network/lorawan-stack/pkg/joinserver"
 	"go.thethings.network/lorawan-stack/pkg/joinserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/log"
@@ -748,6 +749,174 @@ func TestHandleJoin(t *testing.T) {
 			},
 			ErrorAssertion: errors.IsInvalidArgument,
 		},
+		{
+			Name:        "1.1.0/rejoin-request type 1/MIC mismatch",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			Device: &ttnpb.EndDevice{
+				LastDevNonce:  0x2442,
+				LastJoinNonce: 0x42fffd,
+				LastRJCount1:  0x41,
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevEUI:                 &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					JoinEUI:                &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+					DeviceID:               "test-dev",
+				},
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{
+						Key: &appKey,
+					},
+					NwkKey: &ttnpb.KeyEnvelope{
+						Key: &nwkKey,
+					},
+				},
+				LoRaWANVersion:       ttnpb.MAC_V1_1,
+				NetworkServerAddress: nsAddr,
+			},
+			NextLastDevNonce:  0x2442,
+			NextLastJoinNonce: 0x42fffd,
+			JoinRequest: &ttnpb.JoinRequest{
+				SelectedMACVersion: ttnpb.MAC_V1_1,
+				RawPayload: []byte{
+					/* MHDR */
+					0xc0,
+
+					/* MACPayload */
+					/** RejoinType **/
+					0x01,
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** RJcount1 **/
+					0x42, 0x00,
+
+					/* MIC */
+					0x00, 0x00, 0x00, 0x00,
+				},
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				NetID:   types.NetID{0x42, 0xff, 0xff},
+				DownlinkSettings: ttnpb.DLSettings{
+					OptNeg:      true,
+					Rx1DROffset: 0x7,
+					Rx2DR:       0xf,
+				},
+				RxDelay: 0x42,
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:        "1.1.0/rejoin-request type 1/RJcount1 too small",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			Device: &ttnpb.EndDevice{
+				LastDevNonce:  0x2442,
+				LastJoinNonce: 0x42fffd,
+				LastRJCount1:  0x42,
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevEUI:                 &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					JoinEUI:                &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+					DeviceID:               "test-dev",
+				},
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{
+						Key: &appKey,
+					},
+					NwkKey: &ttnpb.KeyEnvelope{
+						Key: &nwkKey,
+					},
+				},
+				LoRaWANVersion:       ttnpb.MAC_V1_1,
+				NetworkServerAddress: nsAddr,
+			},
+			NextLastDevNonce:  0x2442,
+			NextLastJoinNonce: 0x42fffd,
+			JoinRequest: &ttnpb.JoinRequest{
+				SelectedMACVersion: ttnpb.MAC_V1_1,
+				RawPayload: []byte{
+					/* MHDR */
+					0xc0,
+
+					/* MACPayload */
+					/** RejoinType **/
+					0x01,
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** RJcount1 **/
+					0x42, 0x00,
+
+					/* MIC */
+					0x00, 0x00, 0x00, 0x00,
+				},
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				NetID:   types.NetID{0x42, 0xff, 0xff},
+				DownlinkSettings: ttnpb.DLSettings{
+					OptNeg:      true,
+					Rx1DROffset: 0x7,
+					Rx2DR:       0xf,
+				},
+				RxDelay: 0x42,
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:        "1.0.3/rejoin-request type 1",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			Device: &ttnpb.EndDevice{
+				LastDevNonce:  0x2442,
+				LastJoinNonce: 0x42fffd,
+				LastRJCount1:  0x41,
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DevEUI:                 &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					JoinEUI:                &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+					DeviceID:               "test-dev",
+				},
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{
+						Key: &appKey,
+					},
+					NwkKey: &ttnpb.KeyEnvelope{
+						Key: &nwkKey,
+					},
+				},
+				LoRaWANVersion:       ttnpb.MAC_V1_1,
+				NetworkServerAddress: nsAddr,
+			},
+			NextLastDevNonce:  0x2442,
+			NextLastJoinNonce: 0x42fffd,
+			JoinRequest: &ttnpb.JoinRequest{
+				SelectedMACVersion: ttnpb.MAC_V1_0_3,
+				RawPayload: []byte{
+					/* MHDR */
+					0xc0,
+
+					/* MACPayload */
+					/** RejoinType **/
+					0x01,
+					/** JoinEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42,
+					/** DevEUI **/
+					0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+					/** RJcount1 **/
+					0x42, 0x00,
+
+					/* MIC */
+					0x00, 0x00, 0x00, 0x00,
+				},
+				DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+				NetID:   types.NetID{0x42, 0xff, 0xff},
+				DownlinkSettings: ttnpb.DLSettings{
+					OptNeg:      true,
+					Rx1DROffset: 0x7,
+					Rx2DR:       0xf,
+				},
+				RxDelay: 0x42,
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
 		{
 			Name:        "1.0.3/cluster auth/new device",
 			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
@@ -1830,6 +1999,7 @@ func TestHandleJoin(t *testing.T) {
 					"created_at",
 					"last_dev_nonce",
 					"last_join_nonce",
+					"last_rj_count_1",
 					"lorawan_version",
 					"net_id",
 					"network_server_address",
@@ -1855,6 +2025,7 @@ func TestHandleJoin(t *testing.T) {
 						"ids.join_eui",
 						"last_dev_nonce",
 						"last_join_nonce",
+						"last_rj_count_1",
 						"lorawan_version",
 						"net_id",
 						"network_server_address",
@@ -2506,3 +2677,158 @@ func TestGetHomeNetID(t *testing.T) {
 		})
 	}
 }
+
+func TestForwardJoin(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	redisClient, flush := test.NewRedis(t, "joinserver_test")
+	defer flush()
+	defer redisClient.Close()
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	js := test.Must(New(c, &Config{
+		Devices:             &redis.DeviceRegistry{Redis: redisClient},
+		Keys:                &redis.KeyRegistry{Redis: redisClient},
+		ExternalActivations: &redis.ExternalActivationRegistry{Redis: redisClient},
+		JoinEUIPrefixes:     joinEUIPrefixes,
+		ForwardJoinEUIPrefixes: []types.EUI64Prefix{
+			{EUI64: types.EUI64{0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, Length: 8},
+			{EUI64: types.EUI64{0x70, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, Length: 8},
+		},
+	})).(*JoinServer)
+
+	var forwarded []*ttnpb.JoinRequest
+	SetInteropClient(js, &MockInteropClient{
+		HandleJoinRequestFunc: func(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+			a.So(netID, should.Resemble, types.NetID{0x42, 0xff, 0xff})
+			forwarded = append(forwarded, req)
+			if req.RawPayload[len(req.RawPayload)-1] == 0x00 {
+				return nil, interop.ErrMIC.New()
+			}
+			return &ttnpb.JoinResponse{
+				RawPayload: []byte{0x20, 0x01, 0x02, 0x03},
+				SessionKeys: ttnpb.SessionKeys{
+					SessionKeyID: []byte{0x01, 0x02, 0x03, 0x04},
+				},
+			}, nil
+		},
+		JoinServerAddressFunc: func(joinEUI types.EUI64) (string, bool) {
+			return fmt.Sprintf("%s.js.test.org", joinEUI), true
+		},
+	})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	client := ttnpb.NewJsClient(js.LoopbackConn())
+
+	prefixes, err := client.GetJoinEUIPrefixes(ctx, ttnpb.Empty, js.WithClusterAuth())
+	if a.So(err, should.BeNil) {
+		a.So(prefixes.Prefixes, should.HaveLength, len(joinEUIPrefixes)+2)
+	}
+
+	makeJoinRequest := func(joinEUI types.EUI64, mic byte) *ttnpb.JoinRequest {
+		return &ttnpb.JoinRequest{
+			SelectedMACVersion: ttnpb.MAC_V1_1,
+			RawPayload: []byte{
+				/* MHDR */
+				0x00,
+
+				/* MACPayload */
+				/** JoinEUI **/
+				joinEUI[7], joinEUI[6], joinEUI[5], joinEUI[4], joinEUI[3], joinEUI[2], joinEUI[1], joinEUI[0],
+				/** DevEUI **/
+				0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x42, 0x42,
+				/** DevNonce **/
+				0x00, 0x00,
+
+				/* MIC */
+				0x55, 0x17, 0x54, mic,
+			},
+			DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+			NetID:   types.NetID{0x42, 0xff, 0xff},
+		}
+	}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+	for _, tc := range []struct {
+		Name      string
+		JoinEUI   types.EUI64
+		MIC       byte
+		Forwarded bool
+		Accepted  bool
+	}{
+		{
+			Name:      "Local prefix/unknown device",
+			JoinEUI:   types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			MIC:       0x8e,
+			Forwarded: true,
+			Accepted:  true,
+		},
+		{
+			Name:      "Forwarded prefix",
+			JoinEUI:   types.EUI64{0x70, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+			MIC:       0x8e,
+			Forwarded: true,
+			Accepted:  true,
+		},
+		{
+			Name:      "Forwarded prefix/MIC failure",
+			JoinEUI:   types.EUI64{0x70, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02},
+			MIC:       0x00,
+			Forwarded: true,
+		},
+		{
+			Name:    "Unknown prefix",
+			JoinEUI: types.EUI64{0x71, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+			MIC:     0x8e,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			forwarded = nil
+
+			res, err := js.HandleJoin(clusterauth.NewContext(ctx, nil), makeJoinRequest(tc.JoinEUI, tc.MIC))
+			if tc.Forwarded {
+				a.So(forwarded, should.HaveLength, 1)
+			} else {
+				a.So(forwarded, should.BeEmpty)
+			}
+			if !tc.Accepted {
+				a.So(err, should.NotBeNil)
+				a.So(res, should.BeNil)
+				if tc.Forwarded {
+					a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrMIC)
+				}
+			} else if a.So(err, should.BeNil) {
+				a.So(res.RawPayload, should.Resemble, []byte{0x20, 0x01, 0x02, 0x03})
+			}
+
+			acts, err := client.ListExternalActivations(ctx, &ttnpb.ListExternalActivationsRequest{
+				JoinEUI: tc.JoinEUI,
+				DevEUI:  devEUI,
+			}, js.WithClusterAuth())
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			if !tc.Accepted {
+				a.So(acts.Activations, should.BeEmpty)
+				return
+			}
+			if a.So(acts.Activations, should.HaveLength, 1) {
+				act := acts.Activations[0]
+				a.So(act.JoinEUI, should.Resemble, tc.JoinEUI)
+				a.So(act.DevEUI, should.Resemble, devEUI)
+				a.So(act.NetID, should.Resemble, types.NetID{0x42, 0xff, 0xff})
+				a.So(act.JoinServerAddress, should.Equal, fmt.Sprintf("%s.js.test.org", tc.JoinEUI))
+				a.So(act.SessionKeyID, should.Resemble, []byte{0x01, 0x02, 0x03, 0x04})
+			}
+		})
+	}
+
+	_, err = client.ListExternalActivations(ctx, &ttnpb.ListExternalActivationsRequest{
+		JoinEUI: types.EUI64{0x70, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+		DevEUI:  devEUI,
+	})
+	a.So(err, should.NotBeNil)
+}
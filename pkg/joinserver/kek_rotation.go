@@ -0,0 +1,216 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+	"strings"
+
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// DeviceIDScanner is a DeviceRegistry that can scan the identifiers of the stored devices.
+type DeviceIDScanner interface {
+	// ScanIDs scans the identifiers of the stored devices, starting at cursor.
+	// It returns the cursor to continue scanning from, which is empty if all devices have been scanned.
+	ScanIDs(ctx context.Context, cursor string, count int64) ([]ttnpb.EndDeviceIdentifiers, string, error)
+}
+
+// KeyIDScanner is a KeyRegistry that can scan the identifiers of the stored session keys.
+type KeyIDScanner interface {
+	// ScanIDs scans the identifiers of the stored session keys, starting at cursor.
+	// It returns the cursor to continue scanning from, which is empty if all session keys have been scanned.
+	ScanIDs(ctx context.Context, cursor string, count int64) ([]ttnpb.SessionKeyRequest, string, error)
+}
+
+// defaultKEKRotationLimit is the number of devices or session keys processed per RotateKEK call if no limit is given.
+const defaultKEKRotationLimit = 100
+
+// The Join Server rotates the KEK of the root keys in the device registry first, and then of the session keys in the
+// key registry. The cursor is prefixed with the registry that is being scanned.
+const (
+	kekRotationDevicesCursorPrefix = "devices:"
+	kekRotationKeysCursorPrefix    = "keys:"
+)
+
+var kekRotationDevicePaths = [...]string{
+	"root_keys.app_key",
+	"root_keys.nwk_key",
+}
+
+// rewrapRootKeys re-wraps the root keys of dev that are wrapped with oldKEKLabel with newKEKLabel.
+// It returns the paths of the re-wrapped keys.
+func rewrapRootKeys(ctx context.Context, dev *ttnpb.EndDevice, oldKEKLabel, newKEKLabel string, keyVault crypto.KeyVault) ([]string, error) {
+	if dev.RootKeys == nil {
+		return nil, nil
+	}
+	var sets []string
+	for _, k := range []struct {
+		path     string
+		envelope **ttnpb.KeyEnvelope
+	}{
+		{path: "root_keys.app_key", envelope: &dev.RootKeys.AppKey},
+		{path: "root_keys.nwk_key", envelope: &dev.RootKeys.NwkKey},
+	} {
+		if *k.envelope == nil {
+			continue
+		}
+		rewrapped, err := cryptoutil.RewrapAES128Key(ctx, **k.envelope, oldKEKLabel, newKEKLabel, keyVault)
+		if err != nil {
+			return nil, err
+		}
+		if rewrapped == nil {
+			continue
+		}
+		*k.envelope = rewrapped
+		sets = append(sets, k.path)
+	}
+	return sets, nil
+}
+
+func rotateDeviceKEK(ctx context.Context, devices DeviceRegistry, keyVault crypto.KeyVault, req *ttnpb.RotateKEKRequest, cursor string, limit int64) (*ttnpb.RotateKEKResponse, error) {
+	scanner, ok := devices.(DeviceIDScanner)
+	if !ok {
+		return nil, errScanNotSupported.New()
+	}
+	ids, cursor, err := scanner.ScanIDs(ctx, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.RotateKEKResponse{}
+	if cursor != "" {
+		res.Cursor = kekRotationDevicesCursorPrefix + cursor
+	} else {
+		res.Cursor = kekRotationKeysCursorPrefix
+	}
+	for _, ids := range ids {
+		var rewrapped int
+		_, err := devices.SetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceID, kekRotationDevicePaths[:], func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, nil
+			}
+			sets, err := rewrapRootKeys(ctx, dev, req.OldKEKLabel, req.NewKEKLabel, keyVault)
+			if err != nil {
+				return nil, nil, err
+			}
+			rewrapped = len(sets)
+			if req.DryRun {
+				return dev, nil, nil
+			}
+			return dev, sets, nil
+		})
+		if err != nil {
+			log.FromContext(ctx).WithField("device_uid", unique.ID(ctx, ids)).WithError(err).Warn("Failed to rotate KEK of device")
+			return nil, err
+		}
+		res.Processed++
+		res.Rewrapped += uint32(rewrapped)
+	}
+	return res, nil
+}
+
+func rotateSessionKeysKEK(ctx context.Context, keys KeyRegistry, keyVault crypto.KeyVault, req *ttnpb.RotateKEKRequest, cursor string, limit int64) (*ttnpb.RotateKEKResponse, error) {
+	scanner, ok := keys.(KeyIDScanner)
+	if !ok {
+		return nil, errScanNotSupported.New()
+	}
+	ids, cursor, err := scanner.ScanIDs(ctx, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.RotateKEKResponse{}
+	if cursor != "" {
+		res.Cursor = kekRotationKeysCursorPrefix + cursor
+	}
+	for _, ids := range ids {
+		var rewrapped int
+		_, err := keys.SetByID(ctx, ids.JoinEUI, ids.DevEUI, ids.SessionKeyID, ttnpb.SessionKeysFieldPathsTopLevel, func(sk *ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error) {
+			if sk == nil {
+				return nil, nil, nil
+			}
+			sets, err := cryptoutil.RewrapSessionKeys(ctx, sk, "", req.OldKEKLabel, req.NewKEKLabel, keyVault)
+			if err != nil {
+				return nil, nil, err
+			}
+			rewrapped = len(sets)
+			if req.DryRun {
+				return sk, nil, nil
+			}
+			return sk, sets, nil
+		})
+		if err != nil {
+			log.FromContext(ctx).WithFields(log.Fields(
+				"join_eui", ids.JoinEUI,
+				"dev_eui", ids.DevEUI,
+			)).WithError(err).Warn("Failed to rotate KEK of session keys")
+			return nil, err
+		}
+		res.Processed++
+		res.Rewrapped += uint32(rewrapped)
+	}
+	return res, nil
+}
+
+// RotateKEK re-wraps the root keys of the devices in the given device registry and the session keys in the given key
+// registry that are wrapped with the old KEK label with the new KEK label. It processes a batch of devices or session
+// keys starting at the cursor of the request. The returned cursor is empty if all devices and session keys have been
+// processed.
+func RotateKEK(ctx context.Context, devices DeviceRegistry, keys KeyRegistry, keyVault crypto.KeyVault, req *ttnpb.RotateKEKRequest) (*ttnpb.RotateKEKResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultKEKRotationLimit
+	}
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"old_kek_label", req.OldKEKLabel,
+		"new_kek_label", req.NewKEKLabel,
+		"dry_run", req.DryRun,
+	))
+
+	var (
+		res *ttnpb.RotateKEKResponse
+		err error
+	)
+	switch {
+	case req.Cursor == "":
+		res, err = rotateDeviceKEK(ctx, devices, keyVault, req, "", int64(limit))
+	case strings.HasPrefix(req.Cursor, kekRotationDevicesCursorPrefix):
+		res, err = rotateDeviceKEK(ctx, devices, keyVault, req, strings.TrimPrefix(req.Cursor, kekRotationDevicesCursorPrefix), int64(limit))
+	case strings.HasPrefix(req.Cursor, kekRotationKeysCursorPrefix):
+		res, err = rotateSessionKeysKEK(ctx, keys, keyVault, req, strings.TrimPrefix(req.Cursor, kekRotationKeysCursorPrefix), int64(limit))
+	default:
+		return nil, errInvalidCursor.WithAttributes("cursor", req.Cursor)
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).WithFields(log.Fields(
+		"processed", res.Processed,
+		"rewrapped", res.Rewrapped,
+	)).Debug("Rotated KEK")
+	return res, nil
+}
+
+// RotateKEK implements ttnpb.JsServer.
+func (srv jsServer) RotateKEK(ctx context.Context, req *ttnpb.RotateKEKRequest) (*ttnpb.RotateKEKResponse, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	return RotateKEK(ctx, srv.JS.devices, srv.JS.keys, srv.JS.KeyVault, req)
+}
@@ -0,0 +1,186 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/pkg/joinserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestRotateKEK(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	keys := map[string][]byte{
+		"old": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+		"new": {0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x00},
+		"ns":  {0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f},
+	}
+	keyVault := cryptoutil.NewMemKeyVault(keys)
+	wrap := func(key types.AES128Key, kekLabel string) *ttnpb.KeyEnvelope {
+		env, err := cryptoutil.WrapAES128Key(ctx, key, kekLabel, keyVault)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return &env
+	}
+
+	redisClient, flush := test.NewRedis(t, "joinserver_test")
+	defer flush()
+	defer redisClient.Close()
+	devReg := &redis.DeviceRegistry{Redis: redisClient}
+	keyReg := &redis.KeyRegistry{Redis: redisClient}
+
+	appID := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	joinEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	devEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	sessionKeyID := []byte{0x01, 0x02, 0x03, 0x04}
+	appKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	nwkKey := types.AES128Key{0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43, 0x43}
+	appSKey := types.AES128Key{0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44}
+	fNwkSIntKey := types.AES128Key{0x45, 0x45, 0x45, 0x45, 0x45, 0x45, 0x45, 0x45, 0x45, 0x45, 0x45, 0x45, 0x45, 0x45, 0x45, 0x45}
+
+	_, err := devReg.SetByID(ctx, appID, "test-dev", nil, func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: appID,
+				DeviceID:               "test-dev",
+				JoinEUI:                &joinEUI,
+				DevEUI:                 &devEUI,
+			},
+			RootKeys: &ttnpb.RootKeys{
+				AppKey: wrap(appKey, "old"),
+				NwkKey: wrap(nwkKey, ""),
+			},
+		}, []string{
+			"ids.application_ids",
+			"ids.dev_eui",
+			"ids.device_id",
+			"ids.join_eui",
+			"root_keys.app_key",
+			"root_keys.nwk_key",
+		}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = keyReg.SetByID(ctx, joinEUI, devEUI, sessionKeyID, nil, func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error) {
+		return &ttnpb.SessionKeys{
+			SessionKeyID: sessionKeyID,
+			AppSKey:      wrap(appSKey, "old"),
+			FNwkSIntKey:  wrap(fNwkSIntKey, "ns"),
+		}, []string{
+			"app_s_key",
+			"f_nwk_s_int_key",
+			"session_key_id",
+		}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			KeyVault: config.KeyVault{
+				Provider: "static",
+				Static:   keys,
+			},
+		},
+	})
+	js := test.Must(New(c, &Config{
+		Devices: devReg,
+		Keys:    keyReg,
+	})).(*JoinServer)
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	client := ttnpb.NewJsClient(js.LoopbackConn())
+
+	assertKey := func(env *ttnpb.KeyEnvelope, expected types.AES128Key, kekLabel string) {
+		a.So(env.KEKLabel, should.Equal, kekLabel)
+		key, err := cryptoutil.UnwrapAES128Key(ctx, *env, keyVault)
+		a.So(err, should.BeNil)
+		a.So(key, should.Equal, expected)
+	}
+	assertKeys := func(kekLabel string) {
+		dev, err := devReg.GetByID(ctx, appID, "test-dev", []string{"root_keys"})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		assertKey(dev.RootKeys.AppKey, appKey, kekLabel)
+		assertKey(dev.RootKeys.NwkKey, nwkKey, "")
+
+		sk, err := keyReg.GetByID(ctx, joinEUI, devEUI, sessionKeyID, ttnpb.SessionKeysFieldPathsTopLevel)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		assertKey(sk.AppSKey, appSKey, kekLabel)
+		assertKey(sk.FNwkSIntKey, fNwkSIntKey, "ns")
+	}
+
+	_, err = client.RotateKEK(ctx, &ttnpb.RotateKEKRequest{
+		OldKEKLabel: "old",
+		NewKEKLabel: "new",
+	})
+	a.So(err, should.NotBeNil)
+
+	_, err = client.RotateKEK(ctx, &ttnpb.RotateKEKRequest{
+		OldKEKLabel: "old",
+		NewKEKLabel: "new",
+		Cursor:      "invalid",
+	}, js.WithClusterAuth())
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	for _, dryRun := range []bool{true, false} {
+		req := &ttnpb.RotateKEKRequest{
+			OldKEKLabel: "old",
+			NewKEKLabel: "new",
+			Limit:       1,
+			DryRun:      dryRun,
+		}
+		var processed, rewrapped uint32
+		for {
+			res, err := client.RotateKEK(ctx, req, js.WithClusterAuth())
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			processed += res.Processed
+			rewrapped += res.Rewrapped
+			if res.Cursor == "" {
+				break
+			}
+			req.Cursor = res.Cursor
+		}
+		a.So(processed, should.Equal, 2)
+		a.So(rewrapped, should.Equal, 2)
+		if dryRun {
+			assertKeys("old")
+		} else {
+			assertKeys("new")
+		}
+	}
+}
@@ -20,8 +20,10 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/interop"
 	"go.thethings.network/lorawan-stack/pkg/metrics"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
 )
 
 var (
@@ -57,6 +59,38 @@ var jsMetrics = &messageMetrics{
 		},
 		[]string{"error"},
 	),
+	joinEUIPrefixJoinReceived: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "join_eui_prefix_join_received_total",
+			Help:      "Total number of received joins per JoinEUI prefix",
+		},
+		[]string{"join_eui_prefix"},
+	),
+	joinEUIPrefixJoinAccepted: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "join_eui_prefix_join_accepted_total",
+			Help:      "Total number of accepted joins per JoinEUI prefix",
+		},
+		[]string{"join_eui_prefix"},
+	),
+	joinEUIPrefixJoinMICFailed: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "join_eui_prefix_join_mic_failed_total",
+			Help:      "Total number of joins with a MIC failure per JoinEUI prefix",
+		},
+		[]string{"join_eui_prefix"},
+	),
+	joinForwarded: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "join_forwarded_total",
+			Help:      "Total number of joins accepted by external Join Servers",
+		},
+		[]string{"join_eui_prefix"},
+	),
 }
 
 func init() {
@@ -64,30 +98,61 @@ func init() {
 }
 
 type messageMetrics struct {
-	joinAccepted *metrics.ContextualCounterVec
-	joinRejected *metrics.ContextualCounterVec
+	joinAccepted               *metrics.ContextualCounterVec
+	joinRejected               *metrics.ContextualCounterVec
+	joinEUIPrefixJoinReceived  *metrics.ContextualCounterVec
+	joinEUIPrefixJoinAccepted  *metrics.ContextualCounterVec
+	joinEUIPrefixJoinMICFailed *metrics.ContextualCounterVec
+	joinForwarded              *metrics.ContextualCounterVec
 }
 
 func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.joinAccepted.Describe(ch)
 	m.joinRejected.Describe(ch)
+	m.joinEUIPrefixJoinReceived.Describe(ch)
+	m.joinEUIPrefixJoinAccepted.Describe(ch)
+	m.joinEUIPrefixJoinMICFailed.Describe(ch)
+	m.joinForwarded.Describe(ch)
 }
 
 func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.joinAccepted.Collect(ch)
 	m.joinRejected.Collect(ch)
+	m.joinEUIPrefixJoinReceived.Collect(ch)
+	m.joinEUIPrefixJoinAccepted.Collect(ch)
+	m.joinEUIPrefixJoinMICFailed.Collect(ch)
+	m.joinForwarded.Collect(ch)
 }
 
-func registerAcceptJoin(ctx context.Context, dev *ttnpb.EndDevice, msg *ttnpb.JoinRequest) {
-	events.Publish(evtAcceptJoin(ctx, dev.EndDeviceIdentifiers, nil))
+func registerAcceptJoin(ctx context.Context, dev *ttnpb.EndDevice, attempt *ttnpb.JoinAttempt) {
+	events.Publish(evtAcceptJoin(ctx, dev.EndDeviceIdentifiers, attempt))
 	jsMetrics.joinAccepted.WithLabelValues(ctx, dev.ApplicationID).Inc()
 }
 
-func registerRejectJoin(ctx context.Context, req *ttnpb.JoinRequest, err error) {
-	events.Publish(evtRejectJoin(ctx, nil, err))
+func registerRejectJoin(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, attempt *ttnpb.JoinAttempt, err error) {
+	if ids != nil {
+		events.Publish(evtRejectJoin(ctx, *ids, attempt))
+	} else {
+		events.Publish(evtRejectJoin(ctx, nil, attempt))
+	}
 	if ttnErr, ok := errors.From(err); ok {
 		jsMetrics.joinRejected.WithLabelValues(ctx, ttnErr.FullName()).Inc()
 	} else {
 		jsMetrics.joinRejected.WithLabelValues(ctx, unknown).Inc()
 	}
 }
+
+func registerJoinEUIPrefixJoin(ctx context.Context, prefix types.EUI64Prefix, err error) {
+	label := prefix.String()
+	jsMetrics.joinEUIPrefixJoinReceived.WithLabelValues(ctx, label).Inc()
+	switch {
+	case err == nil:
+		jsMetrics.joinEUIPrefixJoinAccepted.WithLabelValues(ctx, label).Inc()
+	case errors.Resemble(err, errMICMismatch), errors.Resemble(err, interop.ErrMIC):
+		jsMetrics.joinEUIPrefixJoinMICFailed.WithLabelValues(ctx, label).Inc()
+	}
+}
+
+func registerForwardJoin(ctx context.Context, prefix types.EUI64Prefix) {
+	jsMetrics.joinForwarded.WithLabelValues(ctx, prefix.String()).Inc()
+}
@@ -0,0 +1,99 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+var errInvalidExternalActivation = errors.DefineCorruption("invalid_external_activation", "invalid external activation")
+
+// defaultExternalActivationLimit is the number of external activations stored per device if no limit is configured.
+const defaultExternalActivationLimit = 100
+
+// ExternalActivationRegistry is an implementation of joinserver.ExternalActivationRegistry.
+// The external activations are stored in a list per device, most recent first.
+type ExternalActivationRegistry struct {
+	Redis *ttnredis.Client
+	// Limit is the maximum number of external activations stored per device.
+	// If zero, the 100 most recent external activations are stored.
+	Limit int64
+}
+
+func (r *ExternalActivationRegistry) euiKey(joinEUI, devEUI types.EUI64) string {
+	return r.Redis.Key("eui", joinEUI.String(), devEUI.String())
+}
+
+// Add adds the external activation and removes the external activations of the device that exceed the limit.
+func (r *ExternalActivationRegistry) Add(ctx context.Context, act *ttnpb.ExternalActivation) error {
+	if act.DevEUI.IsZero() {
+		return errInvalidIdentifiers.New()
+	}
+	if err := act.ValidateFields(); err != nil {
+		return err
+	}
+
+	defer trace.StartRegion(ctx, "add external activation").End()
+
+	s, err := ttnredis.MarshalProto(act)
+	if err != nil {
+		return err
+	}
+	limit := r.Limit
+	if limit == 0 {
+		limit = defaultExternalActivationLimit
+	}
+	k := r.euiKey(act.JoinEUI, act.DevEUI)
+	_, err = r.Redis.Pipelined(func(p redis.Pipeliner) error {
+		p.LPush(k, s)
+		p.LTrim(k, 0, limit-1)
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Range ranges over the external activations of the device identified by joinEUI, devEUI, most recent first.
+func (r *ExternalActivationRegistry) Range(ctx context.Context, joinEUI, devEUI types.EUI64, limit int64, f func(*ttnpb.ExternalActivation) bool) error {
+	if devEUI.IsZero() {
+		return errInvalidIdentifiers.New()
+	}
+
+	defer trace.StartRegion(ctx, "range external activations").End()
+
+	res, err := r.Redis.LRange(r.euiKey(joinEUI, devEUI), 0, limit-1).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	for _, s := range res {
+		act := &ttnpb.ExternalActivation{}
+		if err := ttnredis.UnmarshalProto(s, act); err != nil {
+			return errInvalidExternalActivation.WithCause(err)
+		}
+		if !f(act) {
+			return nil
+		}
+	}
+	return nil
+}
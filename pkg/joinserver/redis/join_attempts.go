@@ -0,0 +1,90 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var errInvalidJoinAttempt = errors.DefineCorruption("invalid_join_attempt", "invalid join attempt")
+
+// defaultJoinAttemptLimit is the number of join attempts stored per device if no limit is configured.
+const defaultJoinAttemptLimit = 20
+
+func (r *DeviceRegistry) joinAttemptsKey(uid string) string {
+	return r.Redis.Key("join-attempts", uid)
+}
+
+// AddJoinAttempt adds the join attempt of the device identified by ids and removes the join attempts that exceed the limit.
+func (r *DeviceRegistry) AddJoinAttempt(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, attempt *ttnpb.JoinAttempt) error {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return err
+	}
+	if err := attempt.ValidateFields(); err != nil {
+		return err
+	}
+
+	defer trace.StartRegion(ctx, "add join attempt").End()
+
+	s, err := ttnredis.MarshalProto(attempt)
+	if err != nil {
+		return err
+	}
+	limit := r.JoinAttemptLimit
+	if limit == 0 {
+		limit = defaultJoinAttemptLimit
+	}
+	k := r.joinAttemptsKey(unique.ID(ctx, ids))
+	_, err = r.Redis.Pipelined(func(p redis.Pipeliner) error {
+		p.LPush(k, s)
+		p.LTrim(k, 0, limit-1)
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// RangeJoinAttempts ranges over the join attempts of the device identified by ids, most recent first.
+func (r *DeviceRegistry) RangeJoinAttempts(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, limit int64, f func(*ttnpb.JoinAttempt) bool) error {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return err
+	}
+
+	defer trace.StartRegion(ctx, "range join attempts").End()
+
+	res, err := r.Redis.LRange(r.joinAttemptsKey(unique.ID(ctx, ids)), 0, limit-1).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	for _, s := range res {
+		attempt := &ttnpb.JoinAttempt{}
+		if err := ttnredis.UnmarshalProto(s, attempt); err != nil {
+			return errInvalidJoinAttempt.WithCause(err)
+		}
+		if !f(attempt) {
+			return nil
+		}
+	}
+	return nil
+}
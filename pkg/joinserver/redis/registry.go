@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/base64"
 	"runtime/trace"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis"
@@ -32,16 +33,21 @@ import (
 
 var (
 	errAlreadyProvisioned   = errors.DefineAlreadyExists("already_provisioned", "device already provisioned")
+	errDeviceUID            = errors.DefineCorruption("device_uid", "invalid device UID `{device_uid}`")
 	errDuplicateIdentifiers = errors.DefineAlreadyExists("duplicate_identifiers", "duplicate identifiers")
 	errInvalidFieldmask     = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
 	errInvalidIdentifiers   = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
 	errReadOnlyField        = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errSessionKeyID         = errors.DefineCorruption("session_key_id", "invalid session key identifiers in key `{key}`")
 	errProvisionerNotFound  = errors.DefineNotFound("provisioner_not_found", "provisioner `{id}` not found")
 )
 
 // DeviceRegistry is an implementation of joinserver.DeviceRegistry.
 type DeviceRegistry struct {
 	Redis *ttnredis.Client
+	// JoinAttemptLimit is the maximum number of join attempts stored per device.
+	// If zero, the 20 most recent join attempts are stored.
+	JoinAttemptLimit int64
 }
 
 func provisionerUniqueID(dev *ttnpb.EndDevice) (string, error) {
@@ -110,6 +116,27 @@ func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI
 	}, nil
 }
 
+// ScanIDs scans the identifiers of the stored devices, starting at cursor.
+// It returns the cursor to continue scanning from, which is empty if all devices have been scanned.
+// Count is a hint for the number of devices to scan; the number of returned identifiers may differ.
+func (r *DeviceRegistry) ScanIDs(ctx context.Context, cursor string, count int64) ([]ttnpb.EndDeviceIdentifiers, string, error) {
+	defer trace.StartRegion(ctx, "scan end device identifiers").End()
+
+	uids, cursor, err := ttnredis.ScanKeys(r.Redis, r.uidKey(""), cursor, count)
+	if err != nil {
+		return nil, "", err
+	}
+	ids := make([]ttnpb.EndDeviceIdentifiers, 0, len(uids))
+	for _, uid := range uids {
+		devIDs, err := unique.ToDeviceID(uid)
+		if err != nil {
+			return nil, "", errDeviceUID.WithCause(err).WithAttributes("device_uid", uid)
+		}
+		ids = append(ids, devIDs)
+	}
+	return ids, cursor, nil
+}
+
 func equalEUI64(x, y *types.EUI64) bool {
 	if x == nil || y == nil {
 		return x == y
@@ -174,6 +201,7 @@ func (r *DeviceRegistry) set(ctx context.Context, tx *redis.Tx, uid string, gets
 	if pb == nil && len(sets) == 0 {
 		pipelined = func(p redis.Pipeliner) error {
 			p.Del(uk)
+			p.Del(r.joinAttemptsKey(uid))
 			if stored.JoinEUI != nil && stored.DevEUI != nil {
 				p.Del(r.euiKey(*stored.JoinEUI, *stored.DevEUI))
 			}
@@ -391,6 +419,37 @@ func (r *KeyRegistry) GetByID(ctx context.Context, joinEUI, devEUI types.EUI64,
 	return ttnpb.FilterGetSessionKeys(pb, paths...)
 }
 
+// ScanIDs scans the identifiers of the stored session keys, starting at cursor.
+// It returns the cursor to continue scanning from, which is empty if all session keys have been scanned.
+// Count is a hint for the number of session keys to scan; the number of returned identifiers may differ.
+func (r *KeyRegistry) ScanIDs(ctx context.Context, cursor string, count int64) ([]ttnpb.SessionKeyRequest, string, error) {
+	defer trace.StartRegion(ctx, "scan session key identifiers").End()
+
+	ks, cursor, err := ttnredis.ScanKeys(r.Redis, r.Redis.Key("id", ""), cursor, count)
+	if err != nil {
+		return nil, "", err
+	}
+	ids := make([]ttnpb.SessionKeyRequest, 0, len(ks))
+	for _, k := range ks {
+		parts := strings.Split(k, ":")
+		if len(parts) != 3 {
+			return nil, "", errSessionKeyID.WithAttributes("key", k)
+		}
+		var req ttnpb.SessionKeyRequest
+		if err := req.JoinEUI.UnmarshalText([]byte(parts[0])); err != nil {
+			return nil, "", errSessionKeyID.WithCause(err).WithAttributes("key", k)
+		}
+		if err := req.DevEUI.UnmarshalText([]byte(parts[1])); err != nil {
+			return nil, "", errSessionKeyID.WithCause(err).WithAttributes("key", k)
+		}
+		if req.SessionKeyID, err = base64.RawStdEncoding.DecodeString(parts[2]); err != nil {
+			return nil, "", errSessionKeyID.WithCause(err).WithAttributes("key", k)
+		}
+		ids = append(ids, req)
+	}
+	return ids, cursor, nil
+}
+
 // SetByID sets session keys by joinEUI, devEUI, id.
 func (r *KeyRegistry) SetByID(ctx context.Context, joinEUI, devEUI types.EUI64, id []byte, gets []string, f func(*ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error)) (*ttnpb.SessionKeys, error) {
 	if devEUI.IsZero() || len(id) == 0 {
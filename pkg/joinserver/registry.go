@@ -46,3 +46,22 @@ func DeleteKeys(ctx context.Context, r KeyRegistry, joinEUI, devEUI types.EUI64,
 	_, err := r.SetByID(ctx, joinEUI, devEUI, id, nil, func(keys *ttnpb.SessionKeys) (*ttnpb.SessionKeys, []string, error) { return nil, nil, nil })
 	return err
 }
+
+// ExternalActivationRegistry is a registry, containing the activations of devices by external Join Servers.
+type ExternalActivationRegistry interface {
+	// Add adds the external activation to the registry.
+	Add(ctx context.Context, act *ttnpb.ExternalActivation) error
+	// Range ranges over the external activations of the device identified by joinEUI, devEUI, most recent first.
+	// If limit is 0, all external activations are ranged over.
+	Range(ctx context.Context, joinEUI, devEUI types.EUI64, limit int64, f func(*ttnpb.ExternalActivation) bool) error
+}
+
+// JoinAttemptRegistry is a registry, containing the join attempts of devices.
+// DeviceRegistry implementations may implement JoinAttemptRegistry to store the join attempts alongside the devices.
+type JoinAttemptRegistry interface {
+	// AddJoinAttempt adds the join attempt of the device identified by ids to the registry.
+	AddJoinAttempt(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, attempt *ttnpb.JoinAttempt) error
+	// RangeJoinAttempts ranges over the join attempts of the device identified by ids, most recent first.
+	// If limit is 0, all join attempts are ranged over.
+	RangeJoinAttempts(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, limit int64, f func(*ttnpb.JoinAttempt) bool) error
+}
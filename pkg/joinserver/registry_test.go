@@ -398,3 +398,230 @@ func TestSessionKeyRegistries(t *testing.T) {
 		}
 	}
 }
+
+func handleExternalActivationRegistryTest(t *testing.T, reg ExternalActivationRegistry) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	joinEUI := types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	devEUI := types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	start := time.Now().UTC()
+
+	var acts []*ttnpb.ExternalActivation
+	for i := 0; i < 3; i++ {
+		act := &ttnpb.ExternalActivation{
+			JoinEUI:           joinEUI,
+			DevEUI:            devEUI,
+			NetID:             types.NetID{0x00, 0x00, 0x13},
+			JoinServerAddress: "js.example.com",
+			SessionKeyID:      []byte{0x11, 0x22, 0x33, byte(i)},
+			ActivatedAt:       start.Add(time.Duration(i) * time.Second),
+		}
+		if err := reg.Add(ctx, act); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		acts = append(acts, act)
+	}
+
+	rangeActivations := func(joinEUI, devEUI types.EUI64, limit int64) []*ttnpb.ExternalActivation {
+		var ret []*ttnpb.ExternalActivation
+		if err := reg.Range(ctx, joinEUI, devEUI, limit, func(act *ttnpb.ExternalActivation) bool {
+			ret = append(ret, act)
+			return true
+		}); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return ret
+	}
+
+	a.So(rangeActivations(joinEUI, devEUI, 0), should.Resemble, []*ttnpb.ExternalActivation{acts[2], acts[1]})
+	a.So(rangeActivations(joinEUI, devEUI, 1), should.Resemble, []*ttnpb.ExternalActivation{acts[2]})
+	a.So(rangeActivations(joinEUI, types.EUI64{0x43, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, 0), should.BeEmpty)
+
+	err := reg.Add(ctx, &ttnpb.ExternalActivation{JoinEUI: joinEUI})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestExternalActivationRegistries(t *testing.T) {
+	t.Parallel()
+
+	namespace := [...]string{
+		"joinserver_test",
+	}
+
+	for _, tc := range []struct {
+		Name string
+		New  func(t testing.TB) (reg ExternalActivationRegistry, closeFn func() error)
+		N    uint16
+	}{
+		{
+			Name: "Redis",
+			New: func(t testing.TB) (ExternalActivationRegistry, func() error) {
+				cl, flush := test.NewRedis(t, namespace[:]...)
+				reg := &redis.ExternalActivationRegistry{
+					Redis: cl,
+					Limit: 2,
+				}
+				return reg, func() error {
+					flush()
+					return cl.Close()
+				}
+			},
+			N: 8,
+		},
+	} {
+		for i := 0; i < int(tc.N); i++ {
+			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
+				t.Parallel()
+				reg, closeFn := tc.New(t)
+				if closeFn != nil {
+					defer func() {
+						if err := closeFn(); err != nil {
+							t.Errorf("Failed to close registry: %s", err)
+						}
+					}()
+				}
+				t.Run("1st run", func(t *testing.T) { handleExternalActivationRegistryTest(t, reg) })
+				if t.Failed() {
+					t.Skip("Skipping 2nd run")
+				}
+				t.Run("2nd run", func(t *testing.T) { handleExternalActivationRegistryTest(t, reg) })
+			})
+		}
+	}
+}
+
+type joinAttemptDeviceRegistry interface {
+	DeviceRegistry
+	JoinAttemptRegistry
+}
+
+// handleJoinAttemptRegistryTest runs a test suite on reg.
+func handleJoinAttemptRegistryTest(t *testing.T, reg joinAttemptDeviceRegistry) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "test-app",
+		},
+		DeviceID: "test-dev",
+		JoinEUI:  &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		DevEUI:   &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+	_, err := reg.SetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceID, nil, func(stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ids,
+		}, []string{
+			"ids.application_ids",
+			"ids.dev_eui",
+			"ids.device_id",
+			"ids.join_eui",
+		}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	start := time.Now().UTC()
+	attempts := []*ttnpb.JoinAttempt{
+		{
+			ReceivedAt:   start,
+			MType:        ttnpb.MType_JOIN_REQUEST,
+			DevNonce:     types.DevNonce{0x00, 0x01},
+			NetID:        types.NetID{0x00, 0x00, 0x13},
+			RejectReason: ttnpb.JoinRejectReason_JOIN_REJECT_MIC_FAILED,
+		},
+		{
+			ReceivedAt:   start.Add(time.Second),
+			MType:        ttnpb.MType_JOIN_REQUEST,
+			DevNonce:     types.DevNonce{0x00, 0x01},
+			NetID:        types.NetID{0x00, 0x00, 0x13},
+			RejectReason: ttnpb.JoinRejectReason_JOIN_REJECT_DEV_NONCE_REPLAYED,
+		},
+		{
+			ReceivedAt:   start.Add(2 * time.Second),
+			MType:        ttnpb.MType_JOIN_REQUEST,
+			DevNonce:     types.DevNonce{0x00, 0x02},
+			NetID:        types.NetID{0x00, 0x00, 0x13},
+			Accepted:     true,
+			SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+		},
+	}
+	for _, attempt := range attempts {
+		if err := reg.AddJoinAttempt(ctx, ids, attempt); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	rangeAttempts := func(limit int64) []*ttnpb.JoinAttempt {
+		var ret []*ttnpb.JoinAttempt
+		if err := reg.RangeJoinAttempts(ctx, ids, limit, func(attempt *ttnpb.JoinAttempt) bool {
+			ret = append(ret, attempt)
+			return true
+		}); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return ret
+	}
+
+	a.So(rangeAttempts(0), should.Resemble, []*ttnpb.JoinAttempt{attempts[2], attempts[1]})
+	a.So(rangeAttempts(1), should.Resemble, []*ttnpb.JoinAttempt{attempts[2]})
+
+	err = DeleteDevice(ctx, reg, ids.ApplicationIdentifiers, ids.DeviceID)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(rangeAttempts(0), should.BeEmpty)
+}
+
+func TestJoinAttemptRegistries(t *testing.T) {
+	t.Parallel()
+
+	namespace := [...]string{
+		"joinserver_test",
+	}
+
+	for _, tc := range []struct {
+		Name string
+		New  func(t testing.TB) (reg joinAttemptDeviceRegistry, closeFn func() error)
+		N    uint16
+	}{
+		{
+			Name: "Redis",
+			New: func(t testing.TB) (joinAttemptDeviceRegistry, func() error) {
+				cl, flush := test.NewRedis(t, namespace[:]...)
+				reg := &redis.DeviceRegistry{
+					Redis:            cl,
+					JoinAttemptLimit: 2,
+				}
+				return reg, func() error {
+					flush()
+					return cl.Close()
+				}
+			},
+			N: 8,
+		},
+	} {
+		for i := 0; i < int(tc.N); i++ {
+			t.Run(fmt.Sprintf("%s/%d", tc.Name, i), func(t *testing.T) {
+				t.Parallel()
+				reg, closeFn := tc.New(t)
+				if closeFn != nil {
+					defer func() {
+						if err := closeFn(); err != nil {
+							t.Errorf("Failed to close registry: %s", err)
+						}
+					}()
+				}
+				t.Run("1st run", func(t *testing.T) { handleJoinAttemptRegistryTest(t, reg) })
+				if t.Failed() {
+					t.Skip("Skipping 2nd run")
+				}
+				t.Run("2nd run", func(t *testing.T) { handleJoinAttemptRegistryTest(t, reg) })
+			})
+		}
+	}
+}
@@ -85,6 +85,27 @@ func deviceADRMargin(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) float32 {
 	return DefaultADRMargin
 }
 
+// deviceDesiredDataRateRange returns the range of data rate indexes, which the ADR algorithm may assign to dev.
+func deviceDesiredDataRateRange(dev *ttnpb.EndDevice, phy band.Band, defaults ttnpb.MACSettings) (min, max ttnpb.DataRateIndex) {
+	max = ttnpb.DataRateIndex(phy.MaxADRDataRateIndex)
+	if dev.GetMACSettings().GetDesiredMinDataRateIndex() != nil {
+		min = dev.MACSettings.DesiredMinDataRateIndex.Value
+	} else if defaults.DesiredMinDataRateIndex != nil {
+		min = defaults.DesiredMinDataRateIndex.Value
+	}
+	if dev.GetMACSettings().GetDesiredMaxDataRateIndex() != nil {
+		if dev.MACSettings.DesiredMaxDataRateIndex.Value < max {
+			max = dev.MACSettings.DesiredMaxDataRateIndex.Value
+		}
+	} else if defaults.DesiredMaxDataRateIndex != nil && defaults.DesiredMaxDataRateIndex.Value < max {
+		max = defaults.DesiredMaxDataRateIndex.Value
+	}
+	if min > max {
+		min = max
+	}
+	return min, max
+}
+
 func lossRate(nbTrans uint32, ups ...*ttnpb.UplinkMessage) float32 {
 	if len(ups) < 2 {
 		return 0
@@ -188,9 +209,23 @@ func adaptDataRate(dev *ttnpb.EndDevice, phy band.Band, defaults ttnpb.MACSettin
 		margin -= safetyMargin
 	}
 
+	// The data rate must stay within the range desired for the device, regardless of the margin.
+	// If we change the DR, we reset the Tx power.
+	minDataRateIndex, maxDataRateIndex := deviceDesiredDataRateRange(dev, phy, defaults)
+	for dev.MACState.DesiredParameters.ADRDataRateIndex < minDataRateIndex {
+		margin -= drStep
+		dev.MACState.DesiredParameters.ADRDataRateIndex++
+		dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+	}
+	for dev.MACState.DesiredParameters.ADRDataRateIndex > maxDataRateIndex {
+		margin += drStep
+		dev.MACState.DesiredParameters.ADRDataRateIndex--
+		dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+	}
+
 	// As long as we have enough margin to increase the data rate, we do that.
 	// If we change the DR, we reset the Tx power.
-	for int(dev.MACState.DesiredParameters.ADRDataRateIndex) < int(phy.MaxADRDataRateIndex) {
+	for dev.MACState.DesiredParameters.ADRDataRateIndex < maxDataRateIndex {
 		newMargin := margin - drStep
 		if newMargin < 0 {
 			break
@@ -229,7 +229,112 @@ func TestLossRate(t *testing.T) {
 	}
 }
 
+func TestDeviceDesiredDataRateRange(t *testing.T) {
+	phy := test.Must(band.All[band.EU_863_870].Version(ttnpb.PHY_V1_1_REV_B)).(band.Band)
+	for _, tc := range []struct {
+		Name     string
+		Settings *ttnpb.MACSettings
+		Defaults ttnpb.MACSettings
+		Min, Max ttnpb.DataRateIndex
+	}{
+		{
+			Name: "no settings",
+			Min:  ttnpb.DATA_RATE_0,
+			Max:  ttnpb.DATA_RATE_5,
+		},
+		{
+			Name: "defaults",
+			Defaults: ttnpb.MACSettings{
+				DesiredMinDataRateIndex: &ttnpb.DataRateIndexValue{Value: ttnpb.DATA_RATE_1},
+				DesiredMaxDataRateIndex: &ttnpb.DataRateIndexValue{Value: ttnpb.DATA_RATE_3},
+			},
+			Min: ttnpb.DATA_RATE_1,
+			Max: ttnpb.DATA_RATE_3,
+		},
+		{
+			Name: "device settings override defaults",
+			Settings: &ttnpb.MACSettings{
+				DesiredMinDataRateIndex: &ttnpb.DataRateIndexValue{Value: ttnpb.DATA_RATE_0},
+				DesiredMaxDataRateIndex: &ttnpb.DataRateIndexValue{Value: ttnpb.DATA_RATE_5},
+			},
+			Defaults: ttnpb.MACSettings{
+				DesiredMinDataRateIndex: &ttnpb.DataRateIndexValue{Value: ttnpb.DATA_RATE_1},
+				DesiredMaxDataRateIndex: &ttnpb.DataRateIndexValue{Value: ttnpb.DATA_RATE_3},
+			},
+			Min: ttnpb.DATA_RATE_0,
+			Max: ttnpb.DATA_RATE_5,
+		},
+		{
+			Name: "device maximum above band maximum",
+			Settings: &ttnpb.MACSettings{
+				DesiredMaxDataRateIndex: &ttnpb.DataRateIndexValue{Value: ttnpb.DATA_RATE_7},
+			},
+			Defaults: ttnpb.MACSettings{
+				DesiredMaxDataRateIndex: &ttnpb.DataRateIndexValue{Value: ttnpb.DATA_RATE_3},
+			},
+			Min: ttnpb.DATA_RATE_0,
+			Max: ttnpb.DATA_RATE_5,
+		},
+		{
+			Name: "minimum above maximum",
+			Settings: &ttnpb.MACSettings{
+				DesiredMinDataRateIndex: &ttnpb.DataRateIndexValue{Value: ttnpb.DATA_RATE_4},
+			},
+			Defaults: ttnpb.MACSettings{
+				DesiredMaxDataRateIndex: &ttnpb.DataRateIndexValue{Value: ttnpb.DATA_RATE_2},
+			},
+			Min: ttnpb.DATA_RATE_2,
+			Max: ttnpb.DATA_RATE_2,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			min, max := deviceDesiredDataRateRange(&ttnpb.EndDevice{
+				MACSettings: tc.Settings,
+			}, phy, tc.Defaults)
+			a.So(min, should.Equal, tc.Min)
+			a.So(max, should.Equal, tc.Max)
+		})
+	}
+}
+
 func TestAdaptDataRate(t *testing.T) {
+	semtechPaperUplinks := adrMatrixToUplinks([]adrMatrixRow{
+		{FCnt: 10, MaxSNR: -6, GtwDiversity: 2},
+		{FCnt: 11, MaxSNR: -7, GtwDiversity: 2},
+		{FCnt: 12, MaxSNR: -25, GtwDiversity: 1},
+		{FCnt: 13, MaxSNR: -25, GtwDiversity: 1},
+		{FCnt: 14, MaxSNR: -10, GtwDiversity: 2},
+		{FCnt: 16, MaxSNR: -25, GtwDiversity: 1},
+		{FCnt: 17, MaxSNR: -10, GtwDiversity: 2},
+		{FCnt: 19, MaxSNR: -10, GtwDiversity: 3},
+		{FCnt: 20, MaxSNR: -6, GtwDiversity: 2},
+		{FCnt: 21, MaxSNR: -7, GtwDiversity: 2},
+		{FCnt: 22, MaxSNR: -25, GtwDiversity: 0},
+		{FCnt: 23, MaxSNR: -25, GtwDiversity: 1},
+		{FCnt: 24, MaxSNR: -10, GtwDiversity: 2},
+		{FCnt: 25, MaxSNR: -10, GtwDiversity: 2},
+		{FCnt: 26, MaxSNR: -25, GtwDiversity: 1},
+		{FCnt: 27, MaxSNR: -8, GtwDiversity: 2},
+		{FCnt: 28, MaxSNR: -10, GtwDiversity: 2},
+		{FCnt: 29, MaxSNR: -10, GtwDiversity: 3},
+		{FCnt: 30, MaxSNR: -9, GtwDiversity: 3},
+		{
+			FCnt: 31, MaxSNR: -7, GtwDiversity: 2,
+			TxSettings: ttnpb.TxSettings{
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LoRa{
+						LoRa: &ttnpb.LoRaDataRate{
+							SpreadingFactor: 12,
+							Bandwidth:       125000,
+						},
+					},
+				},
+				DataRateIndex: 0,
+			},
+		},
+	})
+
 	for _, tc := range []struct {
 		Name       string
 		Device     *ttnpb.EndDevice
@@ -257,42 +362,8 @@ func TestAdaptDataRate(t *testing.T) {
 						Value: 2,
 					},
 				},
-				FrequencyPlanID: test.EUFrequencyPlanID,
-				RecentADRUplinks: adrMatrixToUplinks([]adrMatrixRow{
-					{FCnt: 10, MaxSNR: -6, GtwDiversity: 2},
-					{FCnt: 11, MaxSNR: -7, GtwDiversity: 2},
-					{FCnt: 12, MaxSNR: -25, GtwDiversity: 1},
-					{FCnt: 13, MaxSNR: -25, GtwDiversity: 1},
-					{FCnt: 14, MaxSNR: -10, GtwDiversity: 2},
-					{FCnt: 16, MaxSNR: -25, GtwDiversity: 1},
-					{FCnt: 17, MaxSNR: -10, GtwDiversity: 2},
-					{FCnt: 19, MaxSNR: -10, GtwDiversity: 3},
-					{FCnt: 20, MaxSNR: -6, GtwDiversity: 2},
-					{FCnt: 21, MaxSNR: -7, GtwDiversity: 2},
-					{FCnt: 22, MaxSNR: -25, GtwDiversity: 0},
-					{FCnt: 23, MaxSNR: -25, GtwDiversity: 1},
-					{FCnt: 24, MaxSNR: -10, GtwDiversity: 2},
-					{FCnt: 25, MaxSNR: -10, GtwDiversity: 2},
-					{FCnt: 26, MaxSNR: -25, GtwDiversity: 1},
-					{FCnt: 27, MaxSNR: -8, GtwDiversity: 2},
-					{FCnt: 28, MaxSNR: -10, GtwDiversity: 2},
-					{FCnt: 29, MaxSNR: -10, GtwDiversity: 3},
-					{FCnt: 30, MaxSNR: -9, GtwDiversity: 3},
-					{
-						FCnt: 31, MaxSNR: -7, GtwDiversity: 2,
-						TxSettings: ttnpb.TxSettings{
-							DataRate: ttnpb.DataRate{
-								Modulation: &ttnpb.DataRate_LoRa{
-									LoRa: &ttnpb.LoRaDataRate{
-										SpreadingFactor: 12,
-										Bandwidth:       125000,
-									},
-								},
-							},
-							DataRateIndex: 0,
-						},
-					},
-				}),
+				FrequencyPlanID:  test.EUFrequencyPlanID,
+				RecentADRUplinks: semtechPaperUplinks,
 			},
 			PHY: test.Must(band.All[band.EU_863_870].Version(ttnpb.PHY_V1_1_REV_B)).(band.Band),
 			DeviceDiff: func(dev *ttnpb.EndDevice) {
@@ -301,6 +372,72 @@ func TestAdaptDataRate(t *testing.T) {
 				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
 			},
 		},
+		{
+			Name: "adapted example from Semtech paper with desired maximum data rate",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRDataRateIndex: 0,
+						ADRNbTrans:       0,
+						ADRTxPowerIndex:  1,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						ADRDataRateIndex: 5,
+						ADRNbTrans:       3,
+						ADRTxPowerIndex:  2,
+					},
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 2,
+					},
+					DesiredMaxDataRateIndex: &ttnpb.DataRateIndexValue{
+						Value: ttnpb.DATA_RATE_3,
+					},
+				},
+				FrequencyPlanID:  test.EUFrequencyPlanID,
+				RecentADRUplinks: semtechPaperUplinks,
+			},
+			PHY: test.Must(band.All[band.EU_863_870].Version(ttnpb.PHY_V1_1_REV_B)).(band.Band),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_3
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 2
+			},
+		},
+		{
+			Name: "adapted example from Semtech paper with desired minimum data rate",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						ADRDataRateIndex: 0,
+						ADRNbTrans:       0,
+						ADRTxPowerIndex:  1,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						ADRDataRateIndex: 5,
+						ADRNbTrans:       3,
+						ADRTxPowerIndex:  2,
+					},
+				},
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 2,
+					},
+					DesiredMinDataRateIndex: &ttnpb.DataRateIndexValue{
+						Value: ttnpb.DATA_RATE_5,
+					},
+				},
+				FrequencyPlanID:  test.EUFrequencyPlanID,
+				RecentADRUplinks: semtechPaperUplinks,
+			},
+			PHY: test.Must(band.All[band.EU_863_870].Version(ttnpb.PHY_V1_1_REV_B)).(band.Band),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_5
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
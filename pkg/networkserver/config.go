@@ -17,6 +17,7 @@ package networkserver
 import (
 	"time"
 
+	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -25,18 +26,19 @@ import (
 
 // Config represents the NetworkServer configuration.
 type Config struct {
-	ApplicationUplinks  ApplicationUplinkQueue `name:"-"`
-	Devices             DeviceRegistry         `name:"-"`
-	DownlinkTasks       DownlinkTaskQueue      `name:"-"`
-	UplinkDeduplicator  UplinkDeduplicator     `name:"-"`
-	NetID               types.NetID            `name:"net-id" description:"NetID of this Network Server"`
-	DevAddrPrefixes     []types.DevAddrPrefix  `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
-	DeduplicationWindow time.Duration          `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
-	CooldownWindow      time.Duration          `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
-	DownlinkPriorities  DownlinkPriorityConfig `name:"downlink-priorities" description:"Downlink message priorities"`
-	DefaultMACSettings  MACSettingConfig       `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
-	Interop             config.InteropClient   `name:"interop" description:"Interop client configuration"`
-	DeviceKEKLabel      string                 `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	ApplicationUplinks     ApplicationUplinkQueue      `name:"-"`
+	Devices                DeviceRegistry              `name:"-"`
+	DownlinkTasks          DownlinkTaskQueue           `name:"-"`
+	UplinkDeduplicator     UplinkDeduplicator          `name:"-"`
+	NetID                  types.NetID                 `name:"net-id" description:"NetID of this Network Server"`
+	DevAddrPrefixes        []types.DevAddrPrefix       `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
+	DeduplicationWindow    time.Duration               `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
+	CooldownWindow         time.Duration               `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
+	DownlinkPriorities     DownlinkPriorityConfig      `name:"downlink-priorities" description:"Downlink message priorities"`
+	DefaultMACSettings     MACSettingConfig            `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
+	ApplicationMACSettings map[string]MACSettingConfig `name:"application-mac-settings" file-only:"true" description:"MAC settings by application ID to fallback to if not specified by device, which override the default MAC settings"`
+	Interop                config.InteropClient        `name:"interop" description:"Interop client configuration"`
+	DeviceKEKLabel         string                      `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 }
 
 // MACSettingConfig defines MAC-layer configuration.
@@ -46,12 +48,84 @@ type MACSettingConfig struct {
 	DesiredMaxDutyCycle        *ttnpb.AggregatedDutyCycle `name:"desired-max-duty-cycle" description:"Desired MaxDutyCycle value Network Server should use if not configured in device's MAC settings"`
 	DesiredADRAckLimitExponent *ttnpb.ADRAckLimitExponent `name:"desired-adr-ack-limit-exponent" description:"Desired ADR_ACK_LIMIT value Network Server should use if not configured in device's MAC settings"`
 	DesiredADRAckDelayExponent *ttnpb.ADRAckDelayExponent `name:"desired-adr-ack-delay-exponent" description:"Desired ADR_ACK_DELAY value Network Server should use if not configured in device's MAC settings"`
+	DesiredMinDataRateIndex    *ttnpb.DataRateIndex       `name:"desired-min-data-rate-index" description:"Minimum data rate index Network Server should assign using ADR if not configured in device's MAC settings"`
+	DesiredMaxDataRateIndex    *ttnpb.DataRateIndex       `name:"desired-max-data-rate-index" description:"Maximum data rate index Network Server should assign using ADR if not configured in device's MAC settings"`
+	DesiredRx2DataRateIndex    *ttnpb.DataRateIndex       `name:"desired-rx2-data-rate-index" description:"Desired Rx2 data rate index Network Server should use if not configured in device's MAC settings"`
+	DesiredRx2Frequency        *uint64                    `name:"desired-rx2-frequency" description:"Desired Rx2 frequency (Hz) Network Server should use if not configured in device's MAC settings"`
+	DesiredChannelMask         []bool                     `name:"desired-channel-mask" file-only:"true" description:"Desired uplink channel mask Network Server should use if not configured in device's MAC settings"`
+	DesiredExtraChannels       []MACChannelConfig         `name:"desired-extra-channels" file-only:"true" description:"Desired additional uplink channels Network Server should use if not configured in device's MAC settings"`
 	ClassBTimeout              *time.Duration             `name:"class-b-timeout" description:"Deadline for a device in class B mode to respond to requests from the Network Server if not configured in device's MAC settings"`
 	ClassCTimeout              *time.Duration             `name:"class-c-timeout" description:"Deadline for a device in class C mode to respond to requests from the Network Server if not configured in device's MAC settings"`
 	StatusTimePeriodicity      *time.Duration             `name:"status-time-periodicity" description:"The interval after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
 	StatusCountPeriodicity     *uint32                    `name:"status-count-periodicity" description:"Number of uplink messages after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
 }
 
+// MACChannelConfig defines an uplink channel.
+type MACChannelConfig struct {
+	UplinkFrequency   uint64              `name:"uplink-frequency" description:"Uplink frequency of the channel (Hz)"`
+	DownlinkFrequency uint64              `name:"downlink-frequency" description:"Downlink frequency of the channel (Hz); the uplink frequency is used if not set"`
+	MinDataRateIndex  ttnpb.DataRateIndex `name:"min-data-rate-index" description:"Minimum data rate index of the channel"`
+	MaxDataRateIndex  ttnpb.DataRateIndex `name:"max-data-rate-index" description:"Maximum data rate index of the channel"`
+}
+
+// apply sets the MAC settings that are configured in c on settings.
+func (c MACSettingConfig) apply(settings *ttnpb.MACSettings) {
+	if c.ADRMargin != nil {
+		settings.ADRMargin = &pbtypes.FloatValue{Value: *c.ADRMargin}
+	}
+	if c.DesiredRx1Delay != nil {
+		settings.DesiredRx1Delay = &ttnpb.RxDelayValue{Value: *c.DesiredRx1Delay}
+	}
+	if c.DesiredMaxDutyCycle != nil {
+		settings.DesiredMaxDutyCycle = &ttnpb.AggregatedDutyCycleValue{Value: *c.DesiredMaxDutyCycle}
+	}
+	if c.DesiredADRAckLimitExponent != nil {
+		settings.DesiredADRAckLimitExponent = &ttnpb.ADRAckLimitExponentValue{Value: *c.DesiredADRAckLimitExponent}
+	}
+	if c.DesiredADRAckDelayExponent != nil {
+		settings.DesiredADRAckDelayExponent = &ttnpb.ADRAckDelayExponentValue{Value: *c.DesiredADRAckDelayExponent}
+	}
+	if c.DesiredMinDataRateIndex != nil {
+		settings.DesiredMinDataRateIndex = &ttnpb.DataRateIndexValue{Value: *c.DesiredMinDataRateIndex}
+	}
+	if c.DesiredMaxDataRateIndex != nil {
+		settings.DesiredMaxDataRateIndex = &ttnpb.DataRateIndexValue{Value: *c.DesiredMaxDataRateIndex}
+	}
+	if c.DesiredRx2DataRateIndex != nil {
+		settings.DesiredRx2DataRateIndex = &ttnpb.DataRateIndexValue{Value: *c.DesiredRx2DataRateIndex}
+	}
+	if c.DesiredRx2Frequency != nil {
+		settings.DesiredRx2Frequency = &pbtypes.UInt64Value{Value: *c.DesiredRx2Frequency}
+	}
+	if len(c.DesiredChannelMask) > 0 {
+		settings.DesiredChannelMask = c.DesiredChannelMask
+	}
+	if len(c.DesiredExtraChannels) > 0 {
+		settings.DesiredExtraChannels = make([]*ttnpb.MACParameters_Channel, 0, len(c.DesiredExtraChannels))
+		for _, ch := range c.DesiredExtraChannels {
+			settings.DesiredExtraChannels = append(settings.DesiredExtraChannels, &ttnpb.MACParameters_Channel{
+				UplinkFrequency:   ch.UplinkFrequency,
+				DownlinkFrequency: ch.DownlinkFrequency,
+				MinDataRateIndex:  ch.MinDataRateIndex,
+				MaxDataRateIndex:  ch.MaxDataRateIndex,
+				EnableUplink:      true,
+			})
+		}
+	}
+	if c.ClassBTimeout != nil {
+		settings.ClassBTimeout = c.ClassBTimeout
+	}
+	if c.ClassCTimeout != nil {
+		settings.ClassCTimeout = c.ClassCTimeout
+	}
+	if c.StatusTimePeriodicity != nil {
+		settings.StatusTimePeriodicity = c.StatusTimePeriodicity
+	}
+	if c.StatusCountPeriodicity != nil {
+		settings.StatusCountPeriodicity = &pbtypes.UInt32Value{Value: *c.StatusCountPeriodicity}
+	}
+}
+
 // DownlinkPriorityConfig defines priorities for downlink messages.
 type DownlinkPriorityConfig struct {
 	// JoinAccept is the downlink priority for join-accept messages.
@@ -68,6 +68,12 @@ func loggerWithApplicationDownlinkFields(logger log.Interface, down *ttnpb.Appli
 	} else {
 		pairs = append(pairs, "class_b_c", false)
 	}
+	if down.ExpiresAt != nil {
+		pairs = append(pairs, "expires_at", *down.ExpiresAt)
+	}
+	if down.NotBefore != nil {
+		pairs = append(pairs, "not_before", *down.NotBefore)
+	}
 	return logger.WithFields(log.Fields(pairs...))
 }
 
@@ -115,7 +121,7 @@ func (ns *NetworkServer) updateDataDownlinkTask(ctx context.Context, dev *ttnpb.
 	} else {
 		delay := dev.MACState.CurrentParameters.Rx1Delay.Duration() / 2
 		var ok bool
-		t, _, ok = nextDataDownlinkAt(ctx, dev, phy, ns.defaultMACSettings, earliestAt.Add(delay))
+		t, _, ok = nextDataDownlinkAt(ctx, dev, phy, ns.defaultMACSettingsFor(dev.ApplicationIdentifiers), earliestAt.Add(delay))
 		if !ok {
 			return nil
 		}
@@ -127,6 +133,40 @@ func (ns *NetworkServer) updateDataDownlinkTask(ctx context.Context, dev *ttnpb.
 	return ns.downlinkTasks.Add(ctx, dev.EndDeviceIdentifiers, t, true)
 }
 
+// prioritizeApplicationDownlinks moves the application downlink of the session identified by sessionKeyID with the
+// highest priority, which can be transmitted at transmitAt, to the position of the first such downlink in downs.
+// Downlinks queued after a downlink, which must not be transmitted at transmitAt, has an absolute time or is longer
+// than maxLen, are not considered.
+// Of downlinks with equal priority, the one queued first is selected. downs is not mutated.
+func prioritizeApplicationDownlinks(sessionKeyID []byte, transmitAt time.Time, maxLen uint16, downs ...*ttnpb.ApplicationDownlink) []*ttnpb.ApplicationDownlink {
+	first, selected := -1, -1
+	for i, down := range downs {
+		if down.NotBefore != nil && down.NotBefore.After(transmitAt) ||
+			down.ClassBC.GetAbsoluteTime() != nil ||
+			len(down.FRMPayload) > int(maxLen) {
+			break
+		}
+		if !bytes.Equal(down.SessionKeyID, sessionKeyID) || down.ExpiresAt != nil && down.ExpiresAt.Before(transmitAt) {
+			continue
+		}
+		if first < 0 {
+			first, selected = i, i
+			continue
+		}
+		if down.Priority > downs[selected].Priority {
+			selected = i
+		}
+	}
+	if selected == first {
+		return downs
+	}
+	prioritized := make([]*ttnpb.ApplicationDownlink, 0, len(downs))
+	prioritized = append(prioritized, downs[:first]...)
+	prioritized = append(prioritized, downs[selected])
+	prioritized = append(prioritized, downs[first:selected]...)
+	return append(prioritized, downs[selected+1:]...)
+}
+
 // generateDataDownlink attempts to generate a downlink.
 // generateDataDownlink returns the generated downlink, application uplinks associated with the generation and error, if any.
 // generateDataDownlink may mutate the device in order to record the downlink generated.
@@ -198,12 +238,12 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 				enqueueDutyCycleReq,
 				enqueueRxParamSetupReq,
 				func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) macCommandEnqueueState {
-					return enqueueDevStatusReq(ctx, dev, maxDownLen, maxUpLen, ns.defaultMACSettings, transmitAt)
+					return enqueueDevStatusReq(ctx, dev, maxDownLen, maxUpLen, ns.defaultMACSettingsFor(dev.ApplicationIdentifiers), transmitAt)
 				},
 				enqueueNewChannelReq,
 				func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) macCommandEnqueueState {
 					// NOTE: LinkADRReq must be enqueued after NewChannelReq.
-					st, err := enqueueLinkADRReq(ctx, dev, maxDownLen, maxUpLen, ns.defaultMACSettings, phy)
+					st, err := enqueueLinkADRReq(ctx, dev, maxDownLen, maxUpLen, ns.defaultMACSettingsFor(dev.ApplicationIdentifiers), phy)
 					if err != nil {
 						logger.WithError(err).Error("Failed to enqueue LinkADRReq")
 						return macCommandEnqueueState{
@@ -296,7 +336,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 			DevAddr: dev.Session.DevAddr,
 			FCtrl: ttnpb.FCtrl{
 				Ack: up != nil && up.Payload.MHDR.MType == ttnpb.MType_CONFIRMED_UP,
-				ADR: deviceUseADR(dev, ns.defaultMACSettings),
+				ADR: deviceUseADR(dev, ns.defaultMACSettingsFor(dev.ApplicationIdentifiers)),
 			},
 		},
 	}
@@ -307,9 +347,13 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 	ctx = log.NewContext(ctx, logger)
 
 	if len(cmdBuf) <= fOptsCapacity {
-		appDowns := dev.Session.QueuedApplicationDownlinks[:0:0]
+		queue := dev.Session.QueuedApplicationDownlinks
+		if class == ttnpb.CLASS_C {
+			queue = prioritizeApplicationDownlinks(dev.Session.SessionKeyID, transmitAt, maxDownLen, queue...)
+		}
+		appDowns := queue[:0:0]
 	outer:
-		for i, down := range dev.Session.QueuedApplicationDownlinks {
+		for i, down := range queue {
 			logger := loggerWithApplicationDownlinkFields(logger, down)
 
 			switch {
@@ -333,7 +377,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 
 			case down.FCnt <= dev.Session.LastNFCntDown && dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0:
 				logger.WithField("last_f_cnt_down", dev.Session.LastNFCntDown).Debug("Drop application downlink with too low FCnt")
-				invalid, rest := partitionDownlinksBySessionKeyIDEquality(dev.Session.SessionKeyID, dev.Session.QueuedApplicationDownlinks[i:]...)
+				invalid, rest := partitionDownlinksBySessionKeyIDEquality(dev.Session.SessionKeyID, queue[i:]...)
 				genState.baseApplicationUps = append(genState.baseApplicationUps, &ttnpb.ApplicationUp{
 					EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
 					CorrelationIDs:       events.CorrelationIDsFromContext(ctx),
@@ -361,6 +405,24 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 				})
 				// TODO: Check if following downlinks must be dropped (https://github.com/TheThingsNetwork/lorawan-stack/issues/1653).
 
+			case down.ExpiresAt != nil && down.ExpiresAt.Before(transmitAt):
+				logger.Debug("Drop expired application downlink")
+				genState.baseApplicationUps = append(genState.baseApplicationUps, &ttnpb.ApplicationUp{
+					EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+					CorrelationIDs:       append(events.CorrelationIDsFromContext(ctx), down.CorrelationIDs...),
+					Up: &ttnpb.ApplicationUp_DownlinkFailed{
+						DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+							ApplicationDownlink: *down,
+							Error:               *ttnpb.ErrorDetailsToProto(errExpiredDownlink),
+						},
+					},
+				})
+
+			case down.NotBefore != nil && down.NotBefore.After(transmitAt):
+				appDowns = append(appDowns, queue[i:]...)
+				logger.Debug("Skip application downlink, which must not be transmitted yet")
+				break outer
+
 			case down.ClassBC.GetAbsoluteTime() != nil && down.ClassBC.AbsoluteTime.Before(transmitAt):
 				logger.Debug("Drop expired downlink")
 				genState.baseApplicationUps = append(genState.baseApplicationUps, &ttnpb.ApplicationUp{
@@ -376,14 +438,14 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 				// TODO: Check if following downlinks must be dropped (https://github.com/TheThingsNetwork/lorawan-stack/issues/1653).
 
 			case down.ClassBC != nil && class == ttnpb.CLASS_A:
-				appDowns = append(appDowns, dev.Session.QueuedApplicationDownlinks[i:]...)
+				appDowns = append(appDowns, queue[i:]...)
 				logger.Debug("Skip class B/C downlink for class A downlink slot")
 				break outer
 
 			case len(down.FRMPayload) > int(maxDownLen):
 				if len(down.FRMPayload) <= int(maxDownLen)+len(cmdBuf) {
 					logger.Debug("Skip application downlink with payload length exceeding band regulations due to FOpts field being non-empty")
-					appDowns = append(appDowns, dev.Session.QueuedApplicationDownlinks[i:]...)
+					appDowns = append(appDowns, queue[i:]...)
 				} else {
 					logger.Debug("Drop application downlink with payload length exceeding band regulations")
 					genState.baseApplicationUps = append(genState.baseApplicationUps, &ttnpb.ApplicationUp{
@@ -400,7 +462,25 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 				}
 
 			default:
-				appDowns = append(appDowns, dev.Session.QueuedApplicationDownlinks[i+1:]...)
+				invalid, rest := partitionDownlinksBySessionKeyIDEquality(dev.Session.SessionKeyID, queue[i+1:]...)
+				if len(invalid) > 0 && invalid[0].FCnt < down.FCnt {
+					// The downlink is prioritized over downlinks of the session that were queued before it, which have a lower
+					// FCnt. These downlinks can not be transmitted after it, so the Application Server recalculates them.
+					logger.WithField("count", len(invalid)).Debug("Invalidate application downlinks queued before prioritized application downlink")
+					genState.baseApplicationUps = append(genState.baseApplicationUps, &ttnpb.ApplicationUp{
+						EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+						CorrelationIDs:       events.CorrelationIDsFromContext(ctx),
+						Up: &ttnpb.ApplicationUp_DownlinkQueueInvalidated{
+							DownlinkQueueInvalidated: &ttnpb.ApplicationInvalidatedDownlinks{
+								Downlinks:    invalid,
+								LastFCntDown: down.FCnt,
+							},
+						},
+					})
+					appDowns = append(appDowns, rest...)
+				} else {
+					appDowns = append(appDowns, queue[i+1:]...)
+				}
 				genState.ApplicationDownlink = down
 				break outer
 			}
@@ -486,10 +566,10 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 		var confirmedAt time.Time
 		switch class {
 		case ttnpb.CLASS_B:
-			confirmedAt, _ = nextConfirmedClassBDownlinkAt(ctx, dev, ns.defaultMACSettings, transmitAt)
+			confirmedAt, _ = nextConfirmedClassBDownlinkAt(ctx, dev, ns.defaultMACSettingsFor(dev.ApplicationIdentifiers), transmitAt)
 
 		case ttnpb.CLASS_C:
-			confirmedAt, _ = nextConfirmedClassCDownlinkAt(ctx, dev, ns.defaultMACSettings, transmitAt)
+			confirmedAt, _ = nextConfirmedClassCDownlinkAt(ctx, dev, ns.defaultMACSettingsFor(dev.ApplicationIdentifiers), transmitAt)
 		}
 		if confirmedAt.After(transmitAt) {
 			logger.WithField("confirmed_at", confirmedAt).Debug("Confirmed class B/C downlink attempt performed too soon")
@@ -702,40 +782,49 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb
 
 	type attempt struct {
 		peer  cluster.Peer
-		paths []*ttnpb.DownlinkPath
-	}
-	attempts := make([]*attempt, 0, len(paths))
-	lastAttempt := func() *attempt {
-		return attempts[len(attempts)-1]
-	}
-
-	for _, path := range paths {
-		logger := logger.WithField(
-			"gateway_uid", unique.ID(ctx, path.GatewayIdentifiers),
-		)
+		paths []downlinkPath
+		retry bool
+	}
+	var attempts []*attempt
+	var roamingPaths []downlinkPath
+	addAttempts := func(retry bool, paths ...downlinkPath) {
+		var last *attempt
+		for _, path := range paths {
+			if _, _, ok := parseRoamingUplinkToken(path.GetUplinkToken()); ok {
+				// The uplink message was forwarded by a roaming partner, so downlink is sent through it.
+				roamingPaths = append(roamingPaths, path)
+				continue
+			}
+			logger := logger.WithField(
+				"gateway_uid", unique.ID(ctx, path.GatewayIdentifiers),
+			)
 
-		p, err := ns.GetPeer(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, path.GatewayIdentifiers)
-		if err != nil {
-			logger.WithError(err).Debug("Could not get Gateway Server")
-			continue
-		}
+			p, err := ns.GetPeer(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, path.GatewayIdentifiers)
+			if err != nil {
+				logger.WithError(err).Debug("Could not get Gateway Server")
+				continue
+			}
 
-		var a *attempt
-		if len(attempts) > 0 && lastAttempt().peer == p {
-			a = lastAttempt()
-		} else {
-			a = &attempt{
-				peer: p,
+			if last == nil || last.peer != p {
+				last = &attempt{
+					peer:  p,
+					retry: retry,
+				}
+				attempts = append(attempts, last)
 			}
-			attempts = append(attempts, a)
+			last.paths = append(last.paths, path)
 		}
-		a.paths = append(a.paths, path.DownlinkPath)
 	}
+	addAttempts(false, paths...)
 
 	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("ns:downlink:%s", events.NewCorrelationID()))
 	errs := make([]error, 0, len(attempts))
-	for _, a := range attempts {
-		req.DownlinkPaths = a.paths
+	for i := 0; i < len(attempts); i++ {
+		a := attempts[i]
+		req.DownlinkPaths = make([]*ttnpb.DownlinkPath, 0, len(a.paths))
+		for _, path := range a.paths {
+			req.DownlinkPaths = append(req.DownlinkPaths, path.DownlinkPath)
+		}
 		down := &ttnpb.DownlinkMessage{
 			RawPayload:     b,
 			CorrelationIDs: events.CorrelationIDsFromContext(ctx),
@@ -745,14 +834,30 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb
 		}
 
 		logger.WithField("path_count", len(req.DownlinkPaths)).Debug("Schedule downlink")
-		cc, err := a.peer.Conn()
-		if err != nil {
-			errs = append(errs, err)
-			continue
-		}
-		res, err := ttnpb.NewNsGsClient(cc).ScheduleDownlink(ctx, down, ns.WithClusterAuth())
+		res, err := func() (*ttnpb.ScheduleDownlinkResponse, error) {
+			cc, err := a.peer.Conn()
+			if err != nil {
+				return nil, err
+			}
+			return ttnpb.NewNsGsClient(cc).ScheduleDownlink(ctx, down, ns.WithClusterAuth())
+		}()
 		if err != nil {
 			errs = append(errs, err)
+			if !a.retry && (gatewaysNotConnected(err) || peerUnavailable(err)) {
+				// The gateways may have reconnected to another Gateway Server instance since the peer was looked up,
+				// or the Gateway Server instance may be unavailable.
+				// Look up the peers again and retry the paths that are now handled by another peer.
+				var retryPaths []downlinkPath
+				for _, path := range a.paths {
+					if p, err := ns.GetPeer(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, path.GatewayIdentifiers); err == nil && p != a.peer {
+						retryPaths = append(retryPaths, path)
+					}
+				}
+				if len(retryPaths) > 0 {
+					logger.WithError(err).WithField("path_count", len(retryPaths)).Debug("Failed to schedule downlink, retry on other Gateway Server")
+					addAttempts(true, retryPaths...)
+				}
+			}
 			continue
 		}
 		transmitAt := timeNow().Add(res.Delay)
@@ -765,9 +870,29 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb
 			TransmitAt: transmitAt,
 		}, nil
 	}
+	if len(roamingPaths) > 0 {
+		down, err := ns.scheduleRoamingDownlink(ctx, req, b, roamingPaths...)
+		if err == nil {
+			return down, nil
+		}
+		errs = append(errs, err)
+	}
 	return nil, downlinkSchedulingError(errs)
 }
 
+// gatewaysNotConnected returns whether err is a scheduling error of which all path errors indicate that the gateway
+// is not connected to the Gateway Server.
+func gatewaysNotConnected(err error) bool {
+	pathErrs, ok := downlinkSchedulingError{err}.pathErrors()
+	return ok && len(pathErrs) > 0 && allErrors(errors.IsNotFound, pathErrs...)
+}
+
+// peerUnavailable returns whether err indicates that the Gateway Server peer could not be reached or is not available,
+// for example because it is shutting down.
+func peerUnavailable(err error) bool {
+	return errors.IsUnavailable(err) || errors.IsDeadlineExceeded(err)
+}
+
 func loggerWithTxRequestFields(logger log.Interface, req *ttnpb.TxRequest, rx1, rx2 bool) log.Interface {
 	pairs := []interface{}{
 		"attempt_rx1", rx1,
@@ -1125,7 +1250,7 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 	if genState.ApplicationDownlink != nil {
 		sets = ttnpb.AddFields(sets, "session.queued_application_downlinks")
 	}
-	recordDataDownlink(dev, genDown, genState, down, ns.defaultMACSettings)
+	recordDataDownlink(dev, genDown, genState, down, ns.defaultMACSettingsFor(dev.ApplicationIdentifiers))
 	return downlinkAttemptResult{
 		SetPaths: ttnpb.AddFields(sets,
 			"mac_state.last_confirmed_downlink_at",
@@ -1318,7 +1443,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 				transmissionDelay := dev.MACState.CurrentParameters.Rx1Delay.Duration() / 2
 
 				// Class B/C data downlink
-				transmitAt, class, ok := nextDataDownlinkAt(ctx, dev, phy, ns.defaultMACSettings, timeNow().UTC().Add(transmissionDelay))
+				transmitAt, class, ok := nextDataDownlinkAt(ctx, dev, phy, ns.defaultMACSettingsFor(dev.ApplicationIdentifiers), timeNow().UTC().Add(transmissionDelay))
 				if !ok || class == ttnpb.CLASS_A {
 					logger.Debug("No class B/C downlink available, skip class B/C downlink slot")
 					return dev, sets, nil
@@ -1485,7 +1610,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 					return dev, sets, nil
 				}
 
-				recordDataDownlink(dev, genDown, genState, down, ns.defaultMACSettings)
+				recordDataDownlink(dev, genDown, genState, down, ns.defaultMACSettingsFor(dev.ApplicationIdentifiers))
 				queuedEvents = append(queuedEvents, genState.Events...)
 				queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, true)
 				if genState.ApplicationDownlink != nil {
@@ -1519,6 +1644,12 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 			logger.WithError(err).Error("Failed to update device in registry")
 			return err
 		}
+		// NOTE: Dropped downlinks are only removed from the queue if the device is updated in the registry.
+		for _, up := range queuedApplicationUplinks {
+			if pld := up.GetDownlinkFailed(); pld != nil {
+				events.Publish(evtDropDataDownlink(events.ContextWithCorrelationID(ctx, up.CorrelationIDs...), up.EndDeviceIdentifiers, &pld.Error))
+			}
+		}
 
 		if retryTask {
 			if err := ns.updateDataDownlinkTask(ctx, dev, timeNow().Add(downlinkRetryInterval)); err != nil {
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -103,6 +104,101 @@ func TestAppendRecentDownlink(t *testing.T) {
 	}
 }
 
+func TestPrioritizeApplicationDownlinks(t *testing.T) {
+	now := time.Now()
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+	sessionKeyID := []byte{0x01}
+
+	downs := [...]*ttnpb.ApplicationDownlink{
+		{
+			SessionKeyID: sessionKeyID,
+			FCnt:         42,
+			Priority:     ttnpb.TxSchedulePriority_NORMAL,
+		},
+		{
+			SessionKeyID: sessionKeyID,
+			FCnt:         43,
+			Priority:     ttnpb.TxSchedulePriority_HIGH,
+		},
+		{
+			SessionKeyID: sessionKeyID,
+			FCnt:         44,
+			Priority:     ttnpb.TxSchedulePriority_HIGH,
+		},
+		{
+			SessionKeyID: []byte{0x02},
+			FCnt:         1,
+			Priority:     ttnpb.TxSchedulePriority_HIGHEST,
+		},
+		{
+			SessionKeyID: sessionKeyID,
+			FCnt:         45,
+			Priority:     ttnpb.TxSchedulePriority_HIGHEST,
+			ExpiresAt:    &past,
+		},
+		{
+			SessionKeyID: sessionKeyID,
+			FCnt:         46,
+			Priority:     ttnpb.TxSchedulePriority_HIGHEST,
+			NotBefore:    &future,
+		},
+		{
+			SessionKeyID: sessionKeyID,
+			FCnt:         47,
+			Priority:     ttnpb.TxSchedulePriority_HIGHEST,
+			FRMPayload:   bytes.Repeat([]byte{0x42}, 20),
+		},
+	}
+	for _, tc := range []struct {
+		Name     string
+		Downs    []*ttnpb.ApplicationDownlink
+		Expected []*ttnpb.ApplicationDownlink
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name:     "single",
+			Downs:    downs[:1],
+			Expected: downs[:1],
+		},
+		{
+			Name:     "higher priority queued last",
+			Downs:    downs[:2],
+			Expected: []*ttnpb.ApplicationDownlink{downs[1], downs[0]},
+		},
+		{
+			Name:     "equal priority",
+			Downs:    downs[:3],
+			Expected: []*ttnpb.ApplicationDownlink{downs[1], downs[0], downs[2]},
+		},
+		{
+			Name:     "unknown session and expired",
+			Downs:    []*ttnpb.ApplicationDownlink{downs[3], downs[0], downs[4], downs[1]},
+			Expected: []*ttnpb.ApplicationDownlink{downs[3], downs[1], downs[0], downs[4]},
+		},
+		{
+			Name:     "not before",
+			Downs:    []*ttnpb.ApplicationDownlink{downs[0], downs[5], downs[1]},
+			Expected: []*ttnpb.ApplicationDownlink{downs[0], downs[5], downs[1]},
+		},
+		{
+			Name:     "too long",
+			Downs:    []*ttnpb.ApplicationDownlink{downs[0], downs[6], downs[1]},
+			Expected: []*ttnpb.ApplicationDownlink{downs[0], downs[6], downs[1]},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			queue := append(tc.Downs[:0:0], tc.Downs...)
+			ret := prioritizeApplicationDownlinks(sessionKeyID, now, 10, queue...)
+			a.So(queue, should.Resemble, tc.Downs)
+			a.So(ret, should.Resemble, tc.Expected)
+		})
+	}
+}
+
 func TestProcessDownlinkTask(t *testing.T) {
 	getPaths := []string{
 		"frequency_plan_id",
@@ -5530,6 +5626,7 @@ func TestGenerateDownlink(t *testing.T) {
 		Device                       *ttnpb.EndDevice
 		Bytes                        []byte
 		ApplicationDownlinkAssertion func(t *testing.T, down *ttnpb.ApplicationDownlink) bool
+		ApplicationUplinksAssertion  func(t *testing.T, ups []*ttnpb.ApplicationUp) bool
 		DeviceAssertion              func(*testing.T, *ttnpb.EndDevice) bool
 		Error                        error
 	}{
@@ -5826,6 +5923,283 @@ func TestGenerateDownlink(t *testing.T) {
 				})
 			},
 		},
+		{
+			Name: "1.1/expired app downlink/unconfirmed app downlink/no MAC/no ack",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: appID,
+					DeviceID:               devID,
+					DevAddr:                &devAddr,
+				},
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion: ttnpb.MAC_V1_1,
+					RecentUplinks: []*ttnpb.UplinkMessage{{
+						Payload: &ttnpb.Message{
+							MHDR: ttnpb.MHDR{
+								MType: ttnpb.MType_UNCONFIRMED_UP,
+							},
+							Payload: &ttnpb.Message_MACPayload{MACPayload: &ttnpb.MACPayload{}},
+						},
+					}},
+					RxWindowsAvailable: true,
+				},
+				Session: &ttnpb.Session{
+					DevAddr: devAddr,
+					SessionKeys: ttnpb.SessionKeys{
+						NwkSEncKey: &ttnpb.KeyEnvelope{
+							Key: &nwkSEncKey,
+						},
+						SNwkSIntKey: &ttnpb.KeyEnvelope{
+							Key: &sNwkSIntKey,
+						},
+					},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							Confirmed:  false,
+							FCnt:       41,
+							FPort:      1,
+							FRMPayload: []byte("expired"),
+							ExpiresAt:  timePtr(time.Now().Add(-time.Minute)),
+						},
+						{
+							Confirmed:  false,
+							FCnt:       42,
+							FPort:      1,
+							FRMPayload: []byte("test"),
+						},
+					},
+				},
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				FrequencyPlanID:   band.EU_863_870,
+			},
+			Bytes: encodeMessage(&ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_UNCONFIRMED_DOWN,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_MACPayload{
+					MACPayload: &ttnpb.MACPayload{
+						FHDR: ttnpb.FHDR{
+							DevAddr: devAddr,
+							FCtrl: ttnpb.FCtrl{
+								Ack: false,
+								ADR: true,
+							},
+							FCnt: 42,
+						},
+						FPort:      1,
+						FRMPayload: []byte("test"),
+					},
+				},
+			}, ttnpb.MAC_V1_1, 0),
+			ApplicationDownlinkAssertion: func(t *testing.T, down *ttnpb.ApplicationDownlink) bool {
+				return assertions.New(t).So(down, should.Resemble, &ttnpb.ApplicationDownlink{
+					Confirmed:  false,
+					FCnt:       42,
+					FPort:      1,
+					FRMPayload: []byte("test"),
+				})
+			},
+			DeviceAssertion: func(t *testing.T, dev *ttnpb.EndDevice) bool {
+				return assertions.New(t).So(dev, should.Resemble, &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: appID,
+						DeviceID:               devID,
+						DevAddr:                &devAddr,
+					},
+					MACState: &ttnpb.MACState{
+						LoRaWANVersion: ttnpb.MAC_V1_1,
+						RecentUplinks: []*ttnpb.UplinkMessage{{
+							Payload: &ttnpb.Message{
+								MHDR: ttnpb.MHDR{
+									MType: ttnpb.MType_UNCONFIRMED_UP,
+								},
+								Payload: &ttnpb.Message_MACPayload{MACPayload: &ttnpb.MACPayload{}},
+							},
+						}},
+						RxWindowsAvailable: true,
+					},
+					Session: &ttnpb.Session{
+						DevAddr: devAddr,
+						SessionKeys: ttnpb.SessionKeys{
+							NwkSEncKey: &ttnpb.KeyEnvelope{
+								Key: &nwkSEncKey,
+							},
+							SNwkSIntKey: &ttnpb.KeyEnvelope{
+								Key: &sNwkSIntKey,
+							},
+						},
+						QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{},
+					},
+					LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+					FrequencyPlanID:   band.EU_863_870,
+				})
+			},
+		},
+		{
+			Name: "1.1/class C/unconfirmed app downlink/prioritized unconfirmed app downlink/no MAC/no ack",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: appID,
+					DeviceID:               devID,
+					DevAddr:                &devAddr,
+				},
+				MACState: &ttnpb.MACState{
+					DeviceClass:    ttnpb.CLASS_C,
+					LoRaWANVersion: ttnpb.MAC_V1_1,
+				},
+				Session: &ttnpb.Session{
+					DevAddr: devAddr,
+					SessionKeys: ttnpb.SessionKeys{
+						NwkSEncKey: &ttnpb.KeyEnvelope{
+							Key: &nwkSEncKey,
+						},
+						SNwkSIntKey: &ttnpb.KeyEnvelope{
+							Key: &sNwkSIntKey,
+						},
+					},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							Confirmed:  false,
+							FCnt:       42,
+							FPort:      1,
+							FRMPayload: []byte("low"),
+							Priority:   ttnpb.TxSchedulePriority_NORMAL,
+						},
+						{
+							Confirmed:  false,
+							FCnt:       43,
+							FPort:      1,
+							FRMPayload: []byte("high"),
+							Priority:   ttnpb.TxSchedulePriority_HIGH,
+						},
+					},
+				},
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				FrequencyPlanID:   band.EU_863_870,
+			},
+			Bytes: encodeMessage(&ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_UNCONFIRMED_DOWN,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_MACPayload{
+					MACPayload: &ttnpb.MACPayload{
+						FHDR: ttnpb.FHDR{
+							DevAddr: devAddr,
+							FCtrl: ttnpb.FCtrl{
+								Ack: false,
+								ADR: true,
+							},
+							FCnt: 43,
+						},
+						FPort:      1,
+						FRMPayload: []byte("high"),
+					},
+				},
+			}, ttnpb.MAC_V1_1, 0),
+			ApplicationDownlinkAssertion: func(t *testing.T, down *ttnpb.ApplicationDownlink) bool {
+				return assertions.New(t).So(down, should.Resemble, &ttnpb.ApplicationDownlink{
+					Confirmed:  false,
+					FCnt:       43,
+					FPort:      1,
+					FRMPayload: []byte("high"),
+					Priority:   ttnpb.TxSchedulePriority_HIGH,
+				})
+			},
+			ApplicationUplinksAssertion: func(t *testing.T, ups []*ttnpb.ApplicationUp) bool {
+				a := assertions.New(t)
+				if !a.So(ups, should.HaveLength, 1) {
+					return false
+				}
+				return a.So(ups[0].Up, should.Resemble, &ttnpb.ApplicationUp_DownlinkQueueInvalidated{
+					DownlinkQueueInvalidated: &ttnpb.ApplicationInvalidatedDownlinks{
+						Downlinks: []*ttnpb.ApplicationDownlink{
+							{
+								Confirmed:  false,
+								FCnt:       42,
+								FPort:      1,
+								FRMPayload: []byte("low"),
+								Priority:   ttnpb.TxSchedulePriority_NORMAL,
+							},
+						},
+						LastFCntDown: 43,
+					},
+				})
+			},
+			DeviceAssertion: func(t *testing.T, dev *ttnpb.EndDevice) bool {
+				return assertions.New(t).So(dev, should.Resemble, &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: appID,
+						DeviceID:               devID,
+						DevAddr:                &devAddr,
+					},
+					MACState: &ttnpb.MACState{
+						DeviceClass:    ttnpb.CLASS_C,
+						LoRaWANVersion: ttnpb.MAC_V1_1,
+					},
+					Session: &ttnpb.Session{
+						DevAddr: devAddr,
+						SessionKeys: ttnpb.SessionKeys{
+							NwkSEncKey: &ttnpb.KeyEnvelope{
+								Key: &nwkSEncKey,
+							},
+							SNwkSIntKey: &ttnpb.KeyEnvelope{
+								Key: &sNwkSIntKey,
+							},
+						},
+						QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{},
+					},
+					LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+					FrequencyPlanID:   band.EU_863_870,
+				})
+			},
+		},
+		{
+			Name: "1.1/unconfirmed app downlink with future NotBefore/no MAC/no ack",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: appID,
+					DeviceID:               devID,
+					DevAddr:                &devAddr,
+				},
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion: ttnpb.MAC_V1_1,
+					RecentUplinks: []*ttnpb.UplinkMessage{{
+						Payload: &ttnpb.Message{
+							MHDR: ttnpb.MHDR{
+								MType: ttnpb.MType_UNCONFIRMED_UP,
+							},
+							Payload: &ttnpb.Message_MACPayload{MACPayload: &ttnpb.MACPayload{}},
+						},
+					}},
+					RxWindowsAvailable: true,
+				},
+				Session: &ttnpb.Session{
+					DevAddr: devAddr,
+					SessionKeys: ttnpb.SessionKeys{
+						NwkSEncKey: &ttnpb.KeyEnvelope{
+							Key: &nwkSEncKey,
+						},
+						SNwkSIntKey: &ttnpb.KeyEnvelope{
+							Key: &sNwkSIntKey,
+						},
+					},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							Confirmed:  false,
+							FCnt:       42,
+							FPort:      1,
+							FRMPayload: []byte("test"),
+							NotBefore:  timePtr(time.Now().Add(time.Hour)),
+						},
+					},
+				},
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				FrequencyPlanID:   band.EU_863_870,
+			},
+			Error: errNoDownlink,
+		},
 		{
 			Name: "1.1/unconfirmed app downlink/no MAC/ack",
 			Device: &ttnpb.EndDevice{
@@ -6448,6 +6822,10 @@ func TestGenerateDownlink(t *testing.T) {
 				a.So(genState.ApplicationDownlink, should.BeNil)
 			}
 
+			if tc.ApplicationUplinksAssertion != nil {
+				a.So(tc.ApplicationUplinksAssertion(t, genState.appendApplicationUplinks(nil, true)), should.BeTrue)
+			}
+
 			if tc.DeviceAssertion != nil {
 				a.So(tc.DeviceAssertion(t, dev), should.BeTrue)
 			} else {
@@ -6456,3 +6834,75 @@ func TestGenerateDownlink(t *testing.T) {
 		})
 	}
 }
+
+var errTestGatewayServerUnavailable = errors.DefineUnavailable("test_gateway_server_unavailable", "Gateway Server unavailable")
+
+func TestScheduleDownlinkByPathsFailover(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.ContextWithT(test.Context(), t)
+	ctx, cancel := context.WithTimeout(ctx, (1<<7)*test.Delay)
+	defer cancel()
+
+	var failingCalls, availableCalls int32
+	failingPeer := NewGSPeer(ctx, &MockNsGsServer{
+		ScheduleDownlinkFunc: func(context.Context, *ttnpb.DownlinkMessage) (*ttnpb.ScheduleDownlinkResponse, error) {
+			atomic.AddInt32(&failingCalls, 1)
+			return nil, errTestGatewayServerUnavailable.New()
+		},
+	})
+	availablePeer := NewGSPeer(ctx, &MockNsGsServer{
+		ScheduleDownlinkFunc: func(context.Context, *ttnpb.DownlinkMessage) (*ttnpb.ScheduleDownlinkResponse, error) {
+			atomic.AddInt32(&availableCalls, 1)
+			return &ttnpb.ScheduleDownlinkResponse{
+				Delay: time.Second,
+			}, nil
+		},
+	})
+
+	// The first lookup returns the Gateway Server instance that claimed the gateway. That instance fails, after which
+	// the gateway is routed to another instance.
+	var getPeerCalls int32
+	c := component.MustNew(
+		log.Noop,
+		&component.Config{},
+		component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+			return &test.MockCluster{
+				JoinFunc: test.ClusterJoinNilFunc,
+				AuthFunc: func() grpc.CallOption { return grpc.EmptyCallOption{} },
+				GetPeerFunc: func(_ context.Context, role ttnpb.ClusterRole, _ ttnpb.Identifiers) (cluster.Peer, error) {
+					a.So(role, should.Equal, ttnpb.ClusterRole_GATEWAY_SERVER)
+					if atomic.AddInt32(&getPeerCalls, 1) == 1 {
+						return failingPeer, nil
+					}
+					return availablePeer, nil
+				},
+			}, nil
+		}),
+	)
+	componenttest.StartComponent(t, c)
+
+	ns := &NetworkServer{
+		Component: c,
+		ctx:       ctx,
+	}
+
+	down, err := ns.scheduleDownlinkByPaths(ctx, &ttnpb.TxRequest{
+		Class:    ttnpb.CLASS_C,
+		Priority: ttnpb.TxSchedulePriority_NORMAL,
+	}, []byte{0x42}, downlinkPath{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "test-gateway"},
+		DownlinkPath: &ttnpb.DownlinkPath{
+			Path: &ttnpb.DownlinkPath_UplinkToken{
+				UplinkToken: []byte("token-gtw"),
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) || !a.So(down, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(down.Message.RawPayload, should.Resemble, []byte{0x42})
+	a.So(atomic.LoadInt32(&failingCalls), should.Equal, 1)
+	a.So(atomic.LoadInt32(&availableCalls), should.Equal, 1)
+	a.So(atomic.LoadInt32(&getPeerCalls), should.Equal, 2)
+}
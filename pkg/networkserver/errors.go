@@ -42,19 +42,27 @@ var (
 	errInvalidFieldMask           = errors.DefineInvalidArgument("field_mask", "invalid field mask")
 	errInvalidFieldValue          = errors.DefineInvalidArgument("field_value", "invalid value of field `{field}`")
 	errInvalidFixedPaths          = errors.DefineInvalidArgument("fixed_paths", "invalid fixed paths set in application downlink")
+	errInvalidNotBefore           = errors.DefineInvalidArgument("not_before", "invalid not before time set in application downlink")
 	errInvalidPayload             = errors.DefineInvalidArgument("payload", "invalid payload")
 	errJoinServerNotFound         = errors.DefineNotFound("join_server_not_found", "Join Server not found")
 	errMACRequestNotFound         = errors.DefineInvalidArgument("mac_request_not_found", "MAC response received, but corresponding request not found")
+	errNetIDMismatch              = errors.DefineInvalidArgument("net_id_mismatch", "NetID `{net_id}` does not match")
 	errNoDevEUI                   = errors.DefineInvalidArgument("no_dev_eui", "no DevEUI specified")
 	errNoJoinEUI                  = errors.DefineInvalidArgument("no_join_eui", "no JoinEUI specified")
 	errNoPath                     = errors.DefineNotFound("no_downlink_path", "no downlink path available")
 	errNoPayload                  = errors.DefineInvalidArgument("no_payload", "no message payload specified")
 	errOutdatedData               = errors.DefineNotFound("outdated_data", "data is outdated")
 	errRawPayloadTooShort         = errors.Define("raw_payload_too_short", "length of RawPayload must not be less than 4")
+	errRFRegionNotFound           = errors.DefineNotFound("rf_region_not_found", "RF region not found")
+	errRoamingClass               = errors.DefineUnimplemented("roaming_class", "class `{class}` downlink is not supported in passive roaming")
+	errRoamingMetadata            = errors.DefineInvalidArgument("roaming_metadata", "`{field}` missing in roaming metadata")
+	errRejoinCountTooSmall        = errors.DefineInvalidArgument("rejoin_count_too_small", "RJcount0 `{rejoin_cnt}` is not higher than the last RJcount0 `{last_rejoin_cnt}`")
+	errScanNotSupported           = errors.DefineUnimplemented("scan_not_supported", "device registry does not support scanning")
 	errSchedule                   = errors.Define("schedule", "all downlink scheduling attempts failed")
 	errUnknownChannel             = errors.Define("unknown_chanel", "channel is unknown")
 	errUnknownMACState            = errors.DefineFailedPrecondition("unknown_mac_state", "MAC state is unknown")
 	errUnknownNwkSEncKey          = errors.DefineNotFound("unknown_nwk_s_enc_key", "NwkSEncKey is unknown")
+	errUnknownRFRegion            = errors.DefineInvalidArgument("unknown_rf_region", "unknown RF region `{rf_region}`")
 	errUnknownSession             = errors.DefineNotFound("unknown_session", "unknown session")
 	errUnknownSNwkSIntKey         = errors.DefineNotFound("unknown_s_nwk_s_int_key", "SNwkSIntKey is unknown")
 	errUnsupportedLoRaWANVersion  = errors.DefineInvalidArgument("unsupported_lorawan_version", "unsupported LoRaWAN version: `{version}`", "version")
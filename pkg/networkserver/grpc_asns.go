@@ -171,6 +171,15 @@ func matchApplicationDownlinks(session *ttnpb.Session, macState *ttnpb.MACState,
 
 		case down.GetClassBC().GetAbsoluteTime() != nil && down.GetClassBC().GetAbsoluteTime().Before(timeNow().Add(macState.CurrentParameters.Rx1Delay.Duration()/2)):
 			return unmatched, errExpiredDownlink.New()
+
+		case down.ExpiresAt != nil && !down.ExpiresAt.After(timeNow()):
+			return unmatched, errExpiredDownlink.New()
+
+		case down.NotBefore != nil && down.ExpiresAt != nil && !down.NotBefore.Before(*down.ExpiresAt):
+			return unmatched, errInvalidNotBefore.New()
+
+		case down.NotBefore != nil && down.GetClassBC().GetAbsoluteTime() != nil && down.ClassBC.AbsoluteTime.Before(*down.NotBefore):
+			return unmatched, errInvalidAbsoluteTime.New()
 		}
 		minFCnt = down.FCnt + 1
 		session.QueuedApplicationDownlinks = append(session.QueuedApplicationDownlinks, down)
@@ -0,0 +1,138 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestMatchApplicationDownlinks(t *testing.T) {
+	now := time.Unix(42, 0)
+
+	defer SetMockClock(test.NewMockClock(now))()
+
+	for _, tc := range []struct {
+		Name     string
+		Downlink *ttnpb.ApplicationDownlink
+		Error    error
+	}{
+		{
+			Name:     "no timing",
+			Downlink: &ttnpb.ApplicationDownlink{},
+		},
+		{
+			Name: "ExpiresAt in future",
+			Downlink: &ttnpb.ApplicationDownlink{
+				ExpiresAt: timePtr(now.Add(time.Hour)),
+			},
+		},
+		{
+			Name: "ExpiresAt now",
+			Downlink: &ttnpb.ApplicationDownlink{
+				ExpiresAt: timePtr(now),
+			},
+			Error: errExpiredDownlink,
+		},
+		{
+			Name: "ExpiresAt in past",
+			Downlink: &ttnpb.ApplicationDownlink{
+				ExpiresAt: timePtr(now.Add(-time.Hour)),
+			},
+			Error: errExpiredDownlink,
+		},
+		{
+			Name: "NotBefore in past",
+			Downlink: &ttnpb.ApplicationDownlink{
+				NotBefore: timePtr(now.Add(-time.Hour)),
+			},
+		},
+		{
+			Name: "NotBefore before ExpiresAt",
+			Downlink: &ttnpb.ApplicationDownlink{
+				NotBefore: timePtr(now.Add(time.Hour)),
+				ExpiresAt: timePtr(now.Add(2 * time.Hour)),
+			},
+		},
+		{
+			Name: "NotBefore equal to ExpiresAt",
+			Downlink: &ttnpb.ApplicationDownlink{
+				NotBefore: timePtr(now.Add(time.Hour)),
+				ExpiresAt: timePtr(now.Add(time.Hour)),
+			},
+			Error: errInvalidNotBefore,
+		},
+		{
+			Name: "NotBefore after ExpiresAt",
+			Downlink: &ttnpb.ApplicationDownlink{
+				NotBefore: timePtr(now.Add(2 * time.Hour)),
+				ExpiresAt: timePtr(now.Add(time.Hour)),
+			},
+			Error: errInvalidNotBefore,
+		},
+		{
+			Name: "AbsoluteTime after NotBefore",
+			Downlink: &ttnpb.ApplicationDownlink{
+				NotBefore: timePtr(now.Add(time.Hour)),
+				ClassBC: &ttnpb.ApplicationDownlink_ClassBC{
+					AbsoluteTime: timePtr(now.Add(2 * time.Hour)),
+				},
+			},
+		},
+		{
+			Name: "AbsoluteTime before NotBefore",
+			Downlink: &ttnpb.ApplicationDownlink{
+				NotBefore: timePtr(now.Add(2 * time.Hour)),
+				ClassBC: &ttnpb.ApplicationDownlink_ClassBC{
+					AbsoluteTime: timePtr(now.Add(time.Hour)),
+				},
+			},
+			Error: errInvalidAbsoluteTime,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			session := &ttnpb.Session{
+				SessionKeys: ttnpb.SessionKeys{
+					SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+				},
+			}
+			down := *tc.Downlink
+			down.SessionKeyID = session.SessionKeyID
+			down.FCnt = 42
+			down.FPort = 1
+			down.FRMPayload = []byte("test")
+
+			unmatched, err := matchApplicationDownlinks(session, &ttnpb.MACState{
+				LoRaWANVersion: ttnpb.MAC_V1_0_3,
+			}, false, 51, &down)
+			a.So(unmatched, should.BeEmpty)
+			if tc.Error != nil {
+				a.So(err, should.EqualErrorOrDefinition, tc.Error)
+				a.So(session.QueuedApplicationDownlinks, should.BeEmpty)
+				return
+			}
+			if a.So(err, should.BeNil) {
+				a.So(session.QueuedApplicationDownlinks, should.Resemble, []*ttnpb.ApplicationDownlink{&down})
+			}
+		})
+	}
+}
@@ -20,9 +20,11 @@ import (
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/band"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -40,6 +42,10 @@ var (
 		"ns.end_device.delete", "delete end device",
 		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
 	)
+	evtUpdateDesiredMACParameters = events.Define(
+		"ns.mac.desired_parameters.update", "update desired MAC parameters",
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	)
 )
 
 // Get implements NsEndDeviceRegistryServer.
@@ -211,6 +217,138 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 	return ttnpb.FilterGetEndDevice(dev, req.FieldMask.Paths...)
 }
 
+func validateDesiredMACSettings(settings *ttnpb.MACSettings, fp *frequencyplans.FrequencyPlan, phy band.Band) error {
+	if settings == nil {
+		return nil
+	}
+	if len(settings.DesiredChannelMask) > int(phy.MaxUplinkChannels) {
+		return errInvalidFieldValue.WithAttributes("field", "mac_settings.desired_channel_mask")
+	}
+	if len(settings.DesiredExtraChannels) > 0 {
+		if phy.CFListType != ttnpb.CFListType_FREQUENCIES {
+			return errInvalidFieldValue.WithAttributes("field", "mac_settings.desired_extra_channels")
+		}
+		// Desired extra channels are added to the channels of the band and the frequency plan, unless the
+		// uplink frequency is already used. All channels together must not exceed the maximum number of uplink channels.
+		frequencies := make(map[uint64]struct{}, len(phy.UplinkChannels)+len(fp.UplinkChannels)+len(settings.DesiredExtraChannels))
+		for _, ch := range phy.UplinkChannels {
+			frequencies[ch.Frequency] = struct{}{}
+		}
+		for _, ch := range fp.UplinkChannels {
+			frequencies[ch.Frequency] = struct{}{}
+		}
+		for _, ch := range settings.DesiredExtraChannels {
+			if ch.MinDataRateIndex > ch.MaxDataRateIndex || ch.MaxDataRateIndex > ttnpb.DataRateIndex(phy.MaxADRDataRateIndex) {
+				return errInvalidFieldValue.WithAttributes("field", "mac_settings.desired_extra_channels")
+			}
+			frequencies[ch.UplinkFrequency] = struct{}{}
+		}
+		if len(frequencies) > int(phy.MaxUplinkChannels) {
+			return errInvalidFieldValue.WithAttributes("field", "mac_settings.desired_extra_channels")
+		}
+	}
+	if settings.DesiredMinDataRateIndex != nil && settings.DesiredMinDataRateIndex.Value > ttnpb.DataRateIndex(phy.MaxADRDataRateIndex) {
+		return errInvalidFieldValue.WithAttributes("field", "mac_settings.desired_min_data_rate_index")
+	}
+	if settings.DesiredMaxDataRateIndex != nil && settings.DesiredMaxDataRateIndex.Value > ttnpb.DataRateIndex(phy.MaxADRDataRateIndex) ||
+		settings.DesiredMinDataRateIndex != nil && settings.DesiredMaxDataRateIndex != nil && settings.DesiredMinDataRateIndex.Value > settings.DesiredMaxDataRateIndex.Value {
+		return errInvalidFieldValue.WithAttributes("field", "mac_settings.desired_max_data_rate_index")
+	}
+	return nil
+}
+
+// desiredMACParameterSetters maps MAC settings paths to functions, which copy the corresponding
+// desired MAC parameters from a MAC state derived from the device's MAC settings.
+var desiredMACParameterSetters = map[string]func(dst, src *ttnpb.MACParameters){
+	"mac_settings.desired_adr_ack_delay_exponent": func(dst, src *ttnpb.MACParameters) {
+		dst.ADRAckDelayExponent = src.ADRAckDelayExponent
+	},
+	"mac_settings.desired_adr_ack_limit_exponent": func(dst, src *ttnpb.MACParameters) {
+		dst.ADRAckLimitExponent = src.ADRAckLimitExponent
+	},
+	"mac_settings.desired_beacon_frequency": func(dst, src *ttnpb.MACParameters) {
+		dst.BeaconFrequency = src.BeaconFrequency
+	},
+	"mac_settings.desired_channel_mask": func(dst, src *ttnpb.MACParameters) {
+		dst.Channels = src.Channels
+	},
+	"mac_settings.desired_extra_channels": func(dst, src *ttnpb.MACParameters) {
+		dst.Channels = src.Channels
+	},
+	"mac_settings.desired_max_data_rate_index": func(dst, src *ttnpb.MACParameters) {
+		if dst.ADRDataRateIndex > src.ADRDataRateIndex {
+			dst.ADRDataRateIndex = src.ADRDataRateIndex
+			dst.ADRTxPowerIndex = 0
+		}
+	},
+	"mac_settings.desired_max_duty_cycle": func(dst, src *ttnpb.MACParameters) {
+		dst.MaxDutyCycle = src.MaxDutyCycle
+	},
+	"mac_settings.desired_min_data_rate_index": func(dst, src *ttnpb.MACParameters) {
+		if dst.ADRDataRateIndex < src.ADRDataRateIndex {
+			dst.ADRDataRateIndex = src.ADRDataRateIndex
+			dst.ADRTxPowerIndex = 0
+		}
+	},
+	"mac_settings.desired_ping_slot_data_rate_index": func(dst, src *ttnpb.MACParameters) {
+		dst.PingSlotDataRateIndexValue = src.PingSlotDataRateIndexValue
+	},
+	"mac_settings.desired_ping_slot_frequency": func(dst, src *ttnpb.MACParameters) {
+		dst.PingSlotFrequency = src.PingSlotFrequency
+	},
+	"mac_settings.desired_rx1_data_rate_offset": func(dst, src *ttnpb.MACParameters) {
+		dst.Rx1DataRateOffset = src.Rx1DataRateOffset
+	},
+	"mac_settings.desired_rx1_delay": func(dst, src *ttnpb.MACParameters) {
+		dst.Rx1Delay = src.Rx1Delay
+	},
+	"mac_settings.desired_rx2_data_rate_index": func(dst, src *ttnpb.MACParameters) {
+		dst.Rx2DataRateIndex = src.Rx2DataRateIndex
+	},
+	"mac_settings.desired_rx2_frequency": func(dst, src *ttnpb.MACParameters) {
+		dst.Rx2Frequency = src.Rx2Frequency
+	},
+}
+
+// applyDesiredMACSettings validates the desired MAC settings set by paths of update and returns the desired MAC
+// parameters of stored device dev updated according to them. The returned parameters are nil if none were changed,
+// if dev has no MAC state or if the MAC state is set by paths.
+func (ns *NetworkServer) applyDesiredMACSettings(dev, update *ttnpb.EndDevice, paths ...string) (*ttnpb.MACParameters, error) {
+	var setters []func(dst, src *ttnpb.MACParameters)
+	for p, f := range desiredMACParameterSetters {
+		if ttnpb.HasAnyField(paths, p, p+".value") {
+			setters = append(setters, f)
+		}
+	}
+	if len(setters) == 0 {
+		return nil, nil
+	}
+
+	merged := copyEndDevice(dev)
+	if err := merged.SetFields(update, paths...); err != nil {
+		return nil, err
+	}
+	fp, phy, err := getDeviceBandVersion(merged, ns.FrequencyPlans)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDesiredMACSettings(merged.MACSettings, fp, phy); err != nil {
+		return nil, err
+	}
+	if dev.MACState == nil || ttnpb.HasAnyField(paths, "mac_state") {
+		return nil, nil
+	}
+	template, err := newMACState(merged, ns.FrequencyPlans, ns.defaultMACSettingsFor(merged.ApplicationIdentifiers))
+	if err != nil {
+		return nil, err
+	}
+	params := merged.MACState.DesiredParameters
+	for _, f := range setters {
+		f(&params, &template.DesiredParameters)
+	}
+	return &params, nil
+}
+
 // Set implements NsEndDeviceRegistryServer.
 func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (dev *ttnpb.EndDevice, err error) {
 	if ttnpb.HasAnyField(req.FieldMask.Paths, "frequency_plan_id") && req.EndDevice.FrequencyPlanID == "" {
@@ -366,7 +504,7 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 		needsDownlinkCheck = true
 	}
 
-	var evt events.Event
+	var evt, desiredEvt events.Event
 	dev, ctx, err = ns.devices.SetByID(ctx, req.EndDevice.EndDeviceIdentifiers.ApplicationIdentifiers, req.EndDevice.EndDeviceIdentifiers.DeviceID, gets, func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 		if ttnpb.HasAnyField(sets, "version_ids") {
 			// TODO: Apply version IDs (https://github.com/TheThingsIndustries/lorawan-stack/issues/1544)
@@ -400,6 +538,18 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 					return nil, nil, err
 				}
 			}
+			params, err := ns.applyDesiredMACSettings(dev, &req.EndDevice, sets...)
+			if err != nil {
+				return nil, nil, err
+			}
+			if params != nil {
+				req.EndDevice.MACState = &ttnpb.MACState{
+					CurrentParameters: dev.MACState.CurrentParameters,
+					DesiredParameters: *params,
+				}
+				sets = ttnpb.AddFields(sets, "mac_state.desired_parameters")
+				desiredEvt = evtUpdateDesiredMACParameters(ctx, req.EndDevice.EndDeviceIdentifiers, pendingMACParameters(req.EndDevice.MACState))
+			}
 			return &req.EndDevice, sets, nil
 		}
 
@@ -413,20 +563,24 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 			return nil, nil, errInvalidFieldMask.WithCause(err)
 		}
 
-		_, phy, err := getDeviceBandVersion(&req.EndDevice, ns.FrequencyPlans)
+		fp, phy, err := getDeviceBandVersion(&req.EndDevice, ns.FrequencyPlans)
 		if err != nil {
 			return nil, nil, err
 		}
+		if err := validateDesiredMACSettings(req.EndDevice.MACSettings, fp, phy); err != nil {
+			return nil, nil, err
+		}
 
+		defaults := ns.defaultMACSettingsFor(req.EndDevice.ApplicationIdentifiers)
 		if ttnpb.HasAnyField(sets, "supports_class_b") && req.EndDevice.SupportsClassB {
-			if ns.defaultMACSettings.PingSlotFrequency == nil && phy.PingSlotFrequency == nil {
+			if defaults.PingSlotFrequency == nil && phy.PingSlotFrequency == nil {
 				if err := ttnpb.RequireFields(sets,
 					"mac_settings.ping_slot_frequency.value",
 				); err != nil {
 					return nil, nil, errInvalidFieldMask.WithCause(err)
 				}
 			}
-			if ns.defaultMACSettings.PingSlotPeriodicity == nil && ttnpb.HasAnyField(req.FieldMask.Paths, "multicast") && req.EndDevice.Multicast {
+			if defaults.PingSlotPeriodicity == nil && ttnpb.HasAnyField(req.FieldMask.Paths, "multicast") && req.EndDevice.Multicast {
 				if err := ttnpb.RequireFields(sets,
 					"mac_settings.ping_slot_periodicity.value",
 				); err != nil {
@@ -516,7 +670,7 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 			sets = append(sets, "session.started_at")
 		}
 
-		macState, err := newMACState(&req.EndDevice, ns.FrequencyPlans, ns.defaultMACSettings)
+		macState, err := newMACState(&req.EndDevice, ns.FrequencyPlans, ns.defaultMACSettingsFor(req.EndDevice.ApplicationIdentifiers))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -532,6 +686,9 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 	if evt != nil {
 		events.Publish(evt)
 	}
+	if desiredEvt != nil {
+		events.Publish(desiredEvt)
+	}
 
 	if !needsDownlinkCheck {
 		return ttnpb.FilterGetEndDevice(dev, req.FieldMask.Paths...)
@@ -1490,6 +1490,139 @@ func TestDeviceRegistrySet(t *testing.T) {
 			},
 			SetByIDCalls: 1,
 		},
+
+		{
+			Name: "Update device without MAC state with invalid desired MAC settings",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							},
+						},
+					},
+				})
+			},
+			AddFunc: func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, at time.Time, replace bool) error {
+				err := errors.New("AddFunc must not be called")
+				test.MustTFromContext(ctx).Error(err)
+				return err
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
+				a.So(devID, should.Equal, "test-dev-id")
+
+				dev, sets, err := f(ctx, &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						DeviceID:               "test-dev-id",
+						ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+					},
+					FrequencyPlanID:   test.EUFrequencyPlanID,
+					LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				})
+				a.So(dev, should.BeNil)
+				a.So(sets, should.BeNil)
+				if !a.So(err, should.NotBeNil) {
+					return nil, ctx, errors.New("test")
+				}
+				return nil, ctx, err
+			},
+			Request: &ttnpb.SetEndDeviceRequest{
+				EndDevice: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						DeviceID:               "test-dev-id",
+						ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+					},
+					MACSettings: &ttnpb.MACSettings{
+						DesiredMaxDataRateIndex: &ttnpb.DataRateIndexValue{
+							Value: ttnpb.DATA_RATE_7,
+						},
+					},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"mac_settings.desired_max_data_rate_index",
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+			SetByIDCalls: 1,
+		},
+		{
+			Name: "Update device with too many desired extra channels",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							},
+						},
+					},
+				})
+			},
+			AddFunc: func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, at time.Time, replace bool) error {
+				err := errors.New("AddFunc must not be called")
+				test.MustTFromContext(ctx).Error(err)
+				return err
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
+				a.So(devID, should.Equal, "test-dev-id")
+
+				dev, sets, err := f(ctx, &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						DeviceID:               "test-dev-id",
+						ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+					},
+					FrequencyPlanID:   test.EUFrequencyPlanID,
+					LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				})
+				a.So(dev, should.BeNil)
+				a.So(sets, should.BeNil)
+				if !a.So(err, should.NotBeNil) {
+					return nil, ctx, errors.New("test")
+				}
+				return nil, ctx, err
+			},
+			Request: &ttnpb.SetEndDeviceRequest{
+				EndDevice: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						DeviceID:               "test-dev-id",
+						ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+					},
+					MACSettings: &ttnpb.MACSettings{
+						DesiredExtraChannels: func() []*ttnpb.MACParameters_Channel {
+							// The frequency plan defines 8 channels, so 9 extra channels exceed the maximum of 16.
+							chs := make([]*ttnpb.MACParameters_Channel, 0, 9)
+							for i := 0; i < 9; i++ {
+								chs = append(chs, &ttnpb.MACParameters_Channel{
+									UplinkFrequency:  863100000 + uint64(i)*200000,
+									MinDataRateIndex: ttnpb.DATA_RATE_0,
+									MaxDataRateIndex: ttnpb.DATA_RATE_5,
+									EnableUplink:     true,
+								})
+							}
+							return chs
+						}(),
+					},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"mac_settings.desired_extra_channels",
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+			SetByIDCalls: 1,
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
@@ -31,6 +31,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/interop"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
@@ -46,6 +47,10 @@ const (
 
 	// maxConfNbTrans is the maximum number of confirmed uplink retransmissions for pre-1.0.3 devices.
 	maxConfNbTrans = 5
+
+	// uplinkHandoverInterval is the interval at which a Network Server instance, that is not elected to process an uplink,
+	// tries to take over the processing of the uplink until the deduplication window closes.
+	uplinkHandoverInterval = 20 * time.Millisecond
 )
 
 // UplinkDeduplicator represents an entity, that deduplicates uplinks and accumulates metadata.
@@ -57,6 +62,17 @@ type UplinkDeduplicator interface {
 	AccumulatedMetadata(context.Context, *ttnpb.UplinkMessage) ([]*ttnpb.RxMetadata, error)
 }
 
+// UplinkDeduplicationReleaser represents an UplinkDeduplicator, that supports handing over the processing of uplinks.
+type UplinkDeduplicationReleaser interface {
+	// ReleaseUplink releases the uplink message, which was deduplicated by DeduplicateUplink, such that
+	// the processing of it can be claimed by ClaimUplink, and returns error, if any.
+	ReleaseUplink(context.Context, *ttnpb.UplinkMessage) error
+	// ClaimUplink claims the processing of the uplink message, which was deduplicated by DeduplicateUplink, for specified time.Duration,
+	// if no processing of it is claimed.
+	// ClaimUplink returns true if the processing is claimed or false and error, if any, otherwise.
+	ClaimUplink(context.Context, *ttnpb.UplinkMessage, time.Duration) (bool, error)
+}
+
 func (ns *NetworkServer) deduplicateUplink(ctx context.Context, up *ttnpb.UplinkMessage) (bool, error) {
 	ok, err := ns.uplinkDeduplicator.DeduplicateUplink(ctx, up, ns.collectionWindow(ctx))
 	if err != nil {
@@ -64,13 +80,60 @@ func (ns *NetworkServer) deduplicateUplink(ctx context.Context, up *ttnpb.Uplink
 		return false, err
 	}
 	if !ok {
-		log.FromContext(ctx).Debug("Dropped duplicate uplink")
-		return false, nil
+		ok, err = ns.awaitUplinkHandover(ctx, up)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to await uplink handover")
+			return false, err
+		}
+		if !ok {
+			log.FromContext(ctx).Debug("Dropped duplicate uplink")
+			return false, nil
+		}
+		log.FromContext(ctx).Debug("Uplink handed over")
 	}
 	registerReceiveUniqueUplink(ctx, up)
 	return true, nil
 }
 
+// awaitUplinkHandover waits until the processing of up is handed over to this Network Server instance,
+// if supported by the uplink deduplicator.
+// awaitUplinkHandover returns false if the processing of up is not handed over before the deduplication window closes.
+func (ns *NetworkServer) awaitUplinkHandover(ctx context.Context, up *ttnpb.UplinkMessage) (bool, error) {
+	r, ok := ns.uplinkDeduplicator.(UplinkDeduplicationReleaser)
+	if !ok {
+		return false, nil
+	}
+	done := ns.deduplicationDone(ctx, up)
+	for {
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-done:
+			return false, nil
+		case <-timeAfter(uplinkHandoverInterval):
+		}
+		ok, err := r.ClaimUplink(ctx, up, timeUntil(up.ReceivedAt.Add(ns.collectionWindow(ctx))))
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+}
+
+// releaseUplink hands over the processing of up to another Network Server instance that received up,
+// if supported by the uplink deduplicator.
+func (ns *NetworkServer) releaseUplink(ctx context.Context, up *ttnpb.UplinkMessage) {
+	r, ok := ns.uplinkDeduplicator.(UplinkDeduplicationReleaser)
+	if !ok {
+		return
+	}
+	// NOTE: ctx may be done already.
+	if err := r.ReleaseUplink(ns.Context(), up); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to release uplink")
+		return
+	}
+	log.FromContext(ctx).Debug("Released uplink")
+}
+
 func resetsFCnt(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) bool {
 	if dev.MACSettings != nil && dev.MACSettings.ResetsFCnt != nil {
 		return dev.MACSettings.ResetsFCnt.Value
@@ -271,8 +334,8 @@ func (ns *NetworkServer) matchAndHandleDataUplink(up *ttnpb.UplinkMessage, dedup
 		supports32BitFCnt := true
 		if dev.GetMACSettings().GetSupports32BitFCnt() != nil {
 			supports32BitFCnt = dev.MACSettings.Supports32BitFCnt.Value
-		} else if ns.defaultMACSettings.GetSupports32BitFCnt() != nil {
-			supports32BitFCnt = ns.defaultMACSettings.Supports32BitFCnt.Value
+		} else if defaults := ns.defaultMACSettingsFor(dev.ApplicationIdentifiers); defaults.GetSupports32BitFCnt() != nil {
+			supports32BitFCnt = defaults.Supports32BitFCnt.Value
 		}
 
 		fCnt := pld.FCnt
@@ -345,12 +408,12 @@ func (ns *NetworkServer) matchAndHandleDataUplink(up *ttnpb.UplinkMessage, dedup
 		}
 
 		if fCnt < dev.Session.LastFCntUp {
-			if !resetsFCnt(dev.EndDevice, ns.defaultMACSettings) {
+			if !resetsFCnt(dev.EndDevice, ns.defaultMACSettingsFor(dev.ApplicationIdentifiers)) {
 				logger.Debug("FCnt too low, skip")
 				continue
 			}
 
-			macState, err := newMACState(dev.EndDevice, ns.FrequencyPlans, ns.defaultMACSettings)
+			macState, err := newMACState(dev.EndDevice, ns.FrequencyPlans, ns.defaultMACSettingsFor(dev.ApplicationIdentifiers))
 			if err != nil {
 				logger.WithError(err).Warn("Failed to generate new MAC state")
 				continue
@@ -385,8 +448,8 @@ func (ns *NetworkServer) matchAndHandleDataUplink(up *ttnpb.UplinkMessage, dedup
 		logger = logger.WithField("transmission", 1)
 		ctx = log.NewContext(ctx, logger)
 
-		if fCnt != pld.FCnt && resetsFCnt(dev.EndDevice, ns.defaultMACSettings) {
-			macState, err := newMACState(dev.EndDevice, ns.FrequencyPlans, ns.defaultMACSettings)
+		if fCnt != pld.FCnt && resetsFCnt(dev.EndDevice, ns.defaultMACSettingsFor(dev.ApplicationIdentifiers)) {
+			macState, err := newMACState(dev.EndDevice, ns.FrequencyPlans, ns.defaultMACSettingsFor(dev.ApplicationIdentifiers))
 			if err != nil {
 				logger.WithError(err).Warn("Failed to generate new MAC state")
 				continue
@@ -597,6 +660,7 @@ matchLoop:
 			}
 		}
 
+		pendingMACParams := pendingMACParameters(match.Device.MACState)
 		match.Device.MACState.QueuedResponses = match.Device.MACState.QueuedResponses[:0]
 	macLoop:
 		for len(cmds) > 0 {
@@ -611,7 +675,7 @@ matchLoop:
 			var err error
 			switch cmd.CID {
 			case ttnpb.CID_RESET:
-				evs, err = handleResetInd(ctx, match.Device, cmd.GetResetInd(), ns.FrequencyPlans, ns.defaultMACSettings)
+				evs, err = handleResetInd(ctx, match.Device, cmd.GetResetInd(), ns.FrequencyPlans, ns.defaultMACSettingsFor(match.Device.ApplicationIdentifiers))
 			case ttnpb.CID_LINK_CHECK:
 				if !deduplicated {
 					match.deferMACHandler(handleLinkCheckReq)
@@ -661,7 +725,11 @@ matchLoop:
 			case ttnpb.CID_DL_CHANNEL:
 				evs, err = handleDLChannelAns(ctx, match.Device, cmd.GetDLChannelAns())
 			case ttnpb.CID_REKEY:
+				pending := match.Device.PendingSession != nil
 				evs, err = handleRekeyInd(ctx, match.Device, cmd.GetRekeyInd())
+				if err == nil && pending && match.Device.PendingSession == nil {
+					match.SetPaths = append(match.SetPaths, "last_rj_count_0")
+				}
 			case ttnpb.CID_ADR_PARAM_SETUP:
 				evs, err = handleADRParamSetupAns(ctx, match.Device)
 			case ttnpb.CID_DEVICE_TIME:
@@ -692,6 +760,9 @@ matchLoop:
 			}
 			match.QueuedEvents = append(match.QueuedEvents, evs...)
 		}
+		if pending := pendingMACParameters(match.Device.MACState); len(pending) < len(pendingMACParams) {
+			match.QueuedEvents = append(match.QueuedEvents, evtConvergeMACParameters.BindData(pending))
+		}
 		if n := len(match.Device.MACState.PendingRequests); n > 0 {
 			logger.WithField("unanswered_request_count", n).Warn("MAC command buffer not fully answered")
 			match.Device.MACState.PendingRequests = match.Device.MACState.PendingRequests[:0]
@@ -858,6 +929,13 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	))
 	ctx = log.NewContext(ctx, logger)
 
+	if netID, ok := ns.forwardingNetID(ctx, pld.DevAddr); ok {
+		devAddr := interop.DevAddr(pld.DevAddr)
+		return ns.forwardRoamingUplink(ctx, up, netID, func(md *interop.ULMetaData) {
+			md.DevAddr = &devAddr
+		})
+	}
+
 	var addrMatches []contextualEndDevice
 	if err := ns.devices.RangeByAddr(ctx, pld.DevAddr, handleDataUplinkGetPaths[:],
 		func(ctx context.Context, dev *ttnpb.EndDevice) bool {
@@ -894,6 +972,8 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	}
 	select {
 	case <-ctx.Done():
+		// NOTE: The uplink is not processed yet, so another instance can process it.
+		ns.releaseUplink(ctx, up)
 		return ctx.Err()
 	case <-ns.deduplicationDone(ctx, up):
 	}
@@ -962,10 +1042,10 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			}
 			stored.RecentADRUplinks = appendRecentUplink(stored.RecentADRUplinks, up, optimalADRUplinkCount)
 
-			if !deviceUseADR(stored, ns.defaultMACSettings) {
+			if !deviceUseADR(stored, ns.defaultMACSettingsFor(stored.ApplicationIdentifiers)) {
 				return stored, paths, nil
 			}
-			if err := adaptDataRate(stored, matched.phy, ns.defaultMACSettings); err != nil {
+			if err := adaptDataRate(stored, matched.phy, ns.defaultMACSettingsFor(stored.ApplicationIdentifiers)); err != nil {
 				return nil, nil, err
 			}
 			return stored, paths, nil
@@ -1039,7 +1119,11 @@ func (ns *NetworkServer) sendJoinRequest(ctx context.Context, ids ttnpb.EndDevic
 		}
 	}
 	if ns.interopClient != nil {
-		resp, err := ns.interopClient.HandleJoinRequest(ctx, ns.netID, req)
+		handleJoinRequest := ns.interopClient.HandleJoinRequest
+		if req.Payload.MType == ttnpb.MType_REJOIN_REQUEST {
+			handleJoinRequest = ns.interopClient.HandleRejoinRequest
+		}
+		resp, err := handleJoinRequest(ctx, ns.netID, req)
 		if err == nil {
 			logger.Debug("Join-request accepted by interop Join Server")
 			return resp, nil
@@ -1083,6 +1167,14 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		},
 	)
 	if err != nil {
+		if errors.IsNotFound(err) {
+			if netID, ok := ns.homeNetID(ctx, pld.JoinEUI, pld.DevEUI); ok {
+				devEUI := interop.EUI64(pld.DevEUI)
+				return ns.forwardRoamingUplink(ctx, up, netID, func(md *interop.ULMetaData) {
+					md.DevEUI = &devEUI
+				})
+			}
+		}
 		logRegistryRPCError(ctx, err, "Failed to load device from registry by EUIs")
 		return err
 	}
@@ -1118,7 +1210,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	logger = logger.WithField("dev_addr", devAddr)
 	ctx = log.NewContext(ctx, logger)
 
-	macState, err := newMACState(matched, ns.FrequencyPlans, ns.defaultMACSettings)
+	macState, err := newMACState(matched, ns.FrequencyPlans, ns.defaultMACSettingsFor(matched.ApplicationIdentifiers))
 	if err != nil {
 		logger.WithError(err).Warn("Failed to reset device's MAC state")
 		return err
@@ -1272,7 +1364,78 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	return nil
 }
 
-var errRejoinRequest = errors.DefineUnimplemented("rejoin_request", "rejoin-request handling is not implemented")
+var handleRejoinRequestGetPaths = [...]string{
+	"frequency_plan_id",
+	"last_rj_count_0",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"mac_state",
+	"session",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+}
+
+// matchRejoinRequestBySession returns the device, which sent the rejoin-request of type 0 or 2.
+// These rejoin-requests do not contain the JoinEUI and are signed with the SNwkSIntKey of the current session.
+func (ns *NetworkServer) matchRejoinRequestBySession(ctx context.Context, up *ttnpb.UplinkMessage, pld *ttnpb.RejoinRequestPayload) (*ttnpb.EndDevice, context.Context, error) {
+	if !pld.NetID.Equal(ns.netID) {
+		return nil, ctx, errNetIDMismatch.WithAttributes("net_id", pld.NetID)
+	}
+	var (
+		matched    *ttnpb.EndDevice
+		matchedCtx context.Context
+	)
+	if err := ns.devices.RangeByDevEUI(ctx, pld.DevEUI, handleRejoinRequestGetPaths[:], func(ctx context.Context, dev *ttnpb.EndDevice) bool {
+		if !dev.SupportsJoin || dev.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 ||
+			dev.Session == nil || dev.Session.SNwkSIntKey == nil {
+			return true
+		}
+		logger := log.FromContext(ctx).WithField("device_uid", unique.ID(ctx, dev.EndDeviceIdentifiers))
+		sNwkSIntKey, err := cryptoutil.UnwrapAES128Key(ctx, *dev.Session.SNwkSIntKey, ns.KeyVault)
+		if err != nil {
+			logger.WithField("kek_label", dev.Session.SNwkSIntKey.KEKLabel).WithError(err).Warn("Failed to unwrap SNwkSIntKey, skip")
+			return true
+		}
+		mic, err := crypto.ComputeRejoinRequestMIC(sNwkSIntKey, up.RawPayload[:15])
+		if err != nil {
+			logger.WithError(err).Warn("Failed to compute rejoin-request MIC, skip")
+			return true
+		}
+		if !bytes.Equal(mic[:], up.RawPayload[15:]) {
+			logger.Debug("MIC mismatch, skip")
+			return true
+		}
+		matched, matchedCtx = dev, ctx
+		return false
+	}); err != nil {
+		logRegistryRPCError(ctx, err, "Failed to find devices in registry by DevEUI")
+		return nil, ctx, err
+	}
+	if matched == nil {
+		return nil, ctx, errDeviceNotFound.New()
+	}
+	return matched, matchedCtx, nil
+}
+
+// checkRejoinCount0 returns an error if RJcount0 of the rejoin-request has already been used in the current session.
+// The Network Server stores the last accepted RJcount0 plus one, so that 0 means that no rejoin-request was accepted
+// in the current session, and an RJcount0 of 0 is accepted only once.
+func checkRejoinCount0(dev *ttnpb.EndDevice, rjc uint32) error {
+	if rjc < dev.LastRJCount0 {
+		return errRejoinCountTooSmall.WithAttributes(
+			"rejoin_cnt", rjc,
+			"last_rejoin_cnt", dev.LastRJCount0-1,
+		)
+	}
+	return nil
+}
+
+// recordRejoinCount0 records that RJcount0 of the rejoin-request has been used in the current session.
+func recordRejoinCount0(dev *ttnpb.EndDevice, rjc uint32) {
+	dev.LastRJCount0 = rjc + 1
+}
 
 func (ns *NetworkServer) handleRejoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) (err error) {
 	defer func() {
@@ -1280,8 +1443,246 @@ func (ns *NetworkServer) handleRejoinRequest(ctx context.Context, up *ttnpb.Upli
 			registerDropRejoinRequest(ctx, up, err)
 		}
 	}()
-	// TODO: Implement https://github.com/TheThingsNetwork/lorawan-stack/issues/8
-	return errRejoinRequest.New()
+	pld := up.Payload.GetRejoinRequestPayload()
+
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"dev_eui", pld.DevEUI,
+		"rejoin_cnt", pld.RejoinCnt,
+		"rejoin_type", pld.RejoinType,
+	))
+	ctx = log.NewContext(ctx, logger)
+
+	var (
+		matched    *ttnpb.EndDevice
+		matchedCtx context.Context
+	)
+	switch pld.RejoinType {
+	case ttnpb.RejoinType_CONTEXT, ttnpb.RejoinType_KEYS:
+		matched, matchedCtx, err = ns.matchRejoinRequestBySession(ctx, up, pld)
+		if err != nil {
+			return err
+		}
+
+	case ttnpb.RejoinType_SESSION:
+		logger = logger.WithField("join_eui", pld.JoinEUI)
+		ctx = log.NewContext(ctx, logger)
+		matched, matchedCtx, err = ns.devices.GetByEUI(ctx, pld.JoinEUI, pld.DevEUI, handleRejoinRequestGetPaths[:])
+		if err != nil {
+			logRegistryRPCError(ctx, err, "Failed to load device from registry by EUIs")
+			return err
+		}
+
+	default:
+		return errInvalidPayload.New()
+	}
+	ctx = matchedCtx
+
+	defer func() {
+		if err != nil {
+			events.Publish(evtDropRejoinRequest(ctx, matched.EndDeviceIdentifiers, err))
+		}
+	}()
+
+	ok, err := ns.deduplicateUplink(ctx, up)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	logger = logger.WithField("device_uid", unique.ID(ctx, matched.EndDeviceIdentifiers))
+
+	if !matched.SupportsJoin {
+		logger.Warn("ABP device sent a rejoin-request, drop")
+		return errABPJoinRequest.New()
+	}
+	if matched.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+		logger.Warn("Pre-1.1 device sent a rejoin-request, drop")
+		return errUnsupportedLoRaWANVersion.WithAttributes("version", matched.LoRaWANVersion)
+	}
+	if pld.RejoinType != ttnpb.RejoinType_SESSION {
+		// RJcount1 is verified by the Join Server.
+		if err := checkRejoinCount0(matched, pld.RejoinCnt); err != nil {
+			return err
+		}
+	}
+
+	ctx = log.NewContext(ctx, logger)
+
+	devAddr := ns.newDevAddr(ctx, matched)
+	for matched.Session != nil && devAddr.Equal(matched.Session.DevAddr) {
+		devAddr = ns.newDevAddr(ctx, matched)
+	}
+	logger = logger.WithField("dev_addr", devAddr)
+	ctx = log.NewContext(ctx, logger)
+
+	macState, err := newMACState(matched, ns.FrequencyPlans, ns.defaultMACSettingsFor(matched.ApplicationIdentifiers))
+	if err != nil {
+		logger.WithError(err).Warn("Failed to reset device's MAC state")
+		return err
+	}
+
+	fp, phy, err := getDeviceBandVersion(matched, ns.FrequencyPlans)
+	if err != nil {
+		return err
+	}
+
+	var cfList *ttnpb.CFList
+	if pld.RejoinType == ttnpb.RejoinType_KEYS && matched.MACState != nil {
+		// Rejoin-requests of type 2 only rekey the session, the radio parameters are kept.
+		macState.CurrentParameters = matched.MACState.CurrentParameters
+		macState.DesiredParameters = matched.MACState.DesiredParameters
+	} else {
+		cfList = frequencyplans.CFList(*fp, matched.LoRaWANPHYVersion)
+	}
+
+	req := &ttnpb.JoinRequest{
+		Payload:            up.Payload,
+		CFList:             cfList,
+		CorrelationIDs:     events.CorrelationIDsFromContext(ctx),
+		DevAddr:            devAddr,
+		NetID:              ns.netID,
+		RawPayload:         up.RawPayload,
+		RxDelay:            macState.DesiredParameters.Rx1Delay,
+		SelectedMACVersion: matched.LoRaWANVersion,
+		DownlinkSettings: ttnpb.DLSettings{
+			Rx1DROffset: macState.DesiredParameters.Rx1DataRateOffset,
+			Rx2DR:       macState.DesiredParameters.Rx2DataRateIndex,
+			OptNeg:      true,
+		},
+	}
+	if pld.RejoinType != ttnpb.RejoinType_SESSION && matched.JoinEUI != nil {
+		req.JoinEUI = *matched.JoinEUI
+	}
+
+	resp, err := ns.sendJoinRequest(ctx, matched.EndDeviceIdentifiers, req)
+	if err != nil {
+		return err
+	}
+	respRecvAt := timeNow()
+
+	ctx = events.ContextWithCorrelationID(ctx, resp.CorrelationIDs...)
+
+	macState.QueuedJoinAccept = &ttnpb.MACState_JoinAccept{
+		Keys:    resp.SessionKeys,
+		Payload: resp.RawPayload,
+		Request: *req,
+	}
+	macState.RxWindowsAvailable = true
+
+	chIdx, err := searchUplinkChannel(up.Settings.Frequency, macState)
+	if err != nil {
+		return err
+	}
+	up.DeviceChannelIndex = uint32(chIdx)
+
+	drIdx, _, ok := phy.FindUplinkDataRate(up.Settings.DataRate)
+	if !ok {
+		return errDataRateNotFound.New()
+	}
+	up.Settings.DataRateIndex = drIdx
+
+	events.Publish(evtForwardRejoinRequest(ctx, matched.EndDeviceIdentifiers, nil))
+	registerForwardRejoinRequest(ctx, up)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ns.deduplicationDone(ctx, up):
+	}
+
+	var queuedEvents []events.DefinitionDataClosure
+
+	mds, err := ns.uplinkDeduplicator.AccumulatedMetadata(ctx, up)
+	if err != nil {
+		logger.WithError(err).Error("Failed to merge metadata")
+	} else {
+		up.RxMetadata = mds
+		logger = logger.WithField("metadata_count", len(up.RxMetadata))
+		logger.Debug("Merged metadata")
+		ctx = log.NewContext(ctx, logger)
+		queuedEvents = append(queuedEvents, evtMergeMetadata.BindData(len(up.RxMetadata)))
+		registerMergeMetadata(ctx, up)
+	}
+
+	var invalidatedQueue []*ttnpb.ApplicationDownlink
+	stored, storedCtx, err := ns.devices.SetByID(ctx, matched.EndDeviceIdentifiers.ApplicationIdentifiers, matched.EndDeviceIdentifiers.DeviceID,
+		[]string{
+			"frequency_plan_id",
+			"last_rj_count_0",
+			"lorawan_phy_version",
+			"pending_session.queued_application_downlinks",
+			"recent_uplinks",
+			"session.queued_application_downlinks",
+		},
+		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored == nil {
+				logger.Warn("Device deleted during rejoin-request handling, drop")
+				return nil, nil, errOutdatedData.New()
+			}
+			paths := []string{
+				"pending_mac_state",
+				"recent_uplinks",
+			}
+			if pld.RejoinType != ttnpb.RejoinType_SESSION {
+				if err := checkRejoinCount0(stored, pld.RejoinCnt); err != nil {
+					return nil, nil, err
+				}
+				recordRejoinCount0(stored, pld.RejoinCnt)
+				paths = append(paths, "last_rj_count_0")
+			}
+			if stored.Session != nil {
+				invalidatedQueue = stored.Session.QueuedApplicationDownlinks
+			} else {
+				invalidatedQueue = stored.GetPendingSession().GetQueuedApplicationDownlinks()
+			}
+			stored.PendingMACState = macState
+			stored.RecentUplinks = appendRecentUplink(stored.RecentUplinks, up, recentUplinkCount)
+			return stored, paths, nil
+		})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to update device in registry")
+		return err
+	}
+	matched = stored
+	ctx = storedCtx
+
+	downAt := up.ReceivedAt.Add(-infrastructureDelay/2 + phy.JoinAcceptDelay1 - req.RxDelay.Duration()/2 - nsScheduleWindow())
+	logger.WithField("start_at", downAt).Debug("Add downlink task")
+	if err := ns.downlinkTasks.Add(ctx, stored.EndDeviceIdentifiers, downAt, true); err != nil {
+		logger.WithError(err).Error("Failed to add downlink task after rejoin-request")
+	}
+	logger.Debug("Enqueue join-accept for sending to Application Server")
+	if err := ns.applicationUplinks.Add(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: stored.EndDeviceIdentifiers.ApplicationIdentifiers,
+			DeviceID:               stored.EndDeviceIdentifiers.DeviceID,
+			DevEUI:                 stored.EndDeviceIdentifiers.DevEUI,
+			JoinEUI:                stored.EndDeviceIdentifiers.JoinEUI,
+			DevAddr:                &devAddr,
+		},
+		CorrelationIDs: events.CorrelationIDsFromContext(ctx),
+		Up: &ttnpb.ApplicationUp_JoinAccept{
+			JoinAccept: &ttnpb.ApplicationJoinAccept{
+				AppSKey:              resp.SessionKeys.AppSKey,
+				InvalidatedDownlinks: invalidatedQueue,
+				SessionKeyID:         resp.SessionKeys.SessionKeyID,
+				ReceivedAt:           respRecvAt,
+			},
+		},
+	}); err != nil {
+		logger.WithError(err).Warn("Failed to enqueue join-accept for sending to Application Server")
+	}
+
+	if n := len(queuedEvents); n > 0 {
+		logger := logger.WithField("event_count", n)
+		logger.Debug("Publish events")
+		for _, ev := range queuedEvents {
+			events.Publish(ev(ctx, stored.EndDeviceIdentifiers))
+		}
+	}
+	return nil
 }
 
 // HandleUplink is called by the Gateway Server when an uplink message arrives.
@@ -1289,7 +1690,14 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	if err := clusterauth.Authorized(ctx); err != nil {
 		return nil, err
 	}
+	if err := ns.handleUplink(ctx, up); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
 
+// handleUplink handles the uplink message received by a Gateway Server or forwarded by a Forwarding Network Server.
+func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessage) error {
 	ctx = events.ContextWithCorrelationID(ctx, append(
 		up.CorrelationIDs,
 		fmt.Sprintf("ns:uplink:%s", events.NewCorrelationID()),
@@ -1298,11 +1706,11 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	up.ReceivedAt = timeNow().UTC()
 	up.Payload = &ttnpb.Message{}
 	if err := lorawan.UnmarshalMessage(up.RawPayload, up.Payload); err != nil {
-		return nil, errDecodePayload.WithCause(err)
+		return errDecodePayload.WithCause(err)
 	}
 
 	if up.Payload.Major != ttnpb.Major_LORAWAN_R1 {
-		return nil, errUnsupportedLoRaWANVersion.WithAttributes(
+		return errUnsupportedLoRaWANVersion.WithAttributes(
 			"version", up.Payload.Major,
 		)
 	}
@@ -1318,12 +1726,12 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	registerReceiveUplink(ctx, up)
 	switch up.Payload.MType {
 	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
-		return ttnpb.Empty, ns.handleDataUplink(ctx, up)
+		return ns.handleDataUplink(ctx, up)
 	case ttnpb.MType_JOIN_REQUEST:
-		return ttnpb.Empty, ns.handleJoinRequest(ctx, up)
+		return ns.handleJoinRequest(ctx, up)
 	case ttnpb.MType_REJOIN_REQUEST:
-		return ttnpb.Empty, ns.handleRejoinRequest(ctx, up)
+		return ns.handleRejoinRequest(ctx, up)
 	}
 	logger.Debug("Unmatched MType")
-	return ttnpb.Empty, nil
+	return nil
 }
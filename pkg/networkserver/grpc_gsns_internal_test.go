@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -1210,3 +1211,203 @@ func TestMatchAndHandleUplink(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckRejoinCount0(t *testing.T) {
+	a := assertions.New(t)
+
+	dev := &ttnpb.EndDevice{}
+	for _, step := range []struct {
+		RejoinCnt uint32
+		Accepted  bool
+	}{
+		{RejoinCnt: 0, Accepted: true},
+		{RejoinCnt: 0, Accepted: false},
+		{RejoinCnt: 1, Accepted: true},
+		{RejoinCnt: 1, Accepted: false},
+		{RejoinCnt: 0, Accepted: false},
+		{RejoinCnt: 5, Accepted: true},
+		{RejoinCnt: 3, Accepted: false},
+		{RejoinCnt: 0xffff, Accepted: true},
+		{RejoinCnt: 0xffff, Accepted: false},
+	} {
+		err := checkRejoinCount0(dev, step.RejoinCnt)
+		if !step.Accepted {
+			a.So(err, should.HaveSameErrorDefinitionAs, errRejoinCountTooSmall)
+			continue
+		}
+		if a.So(err, should.BeNil) {
+			recordRejoinCount0(dev, step.RejoinCnt)
+		}
+	}
+
+	// The device resets RJcount0 when a new session is activated.
+	dev.LastRJCount0 = 0
+	a.So(checkRejoinCount0(dev, 0), should.BeNil)
+}
+
+// memUplinkDeduplication is an in-memory uplink deduplication, that is shared by Network Server instances.
+type memUplinkDeduplication struct {
+	mu       sync.Mutex
+	elected  map[string]string
+	metadata map[string][]*ttnpb.RxMetadata
+}
+
+// memUplinkDeduplicator is the view of a Network Server instance on a memUplinkDeduplication.
+type memUplinkDeduplicator struct {
+	*memUplinkDeduplication
+	instance string
+}
+
+func (d memUplinkDeduplicator) DeduplicateUplink(ctx context.Context, up *ttnpb.UplinkMessage, window time.Duration) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	k := string(up.RawPayload)
+	d.metadata[k] = append(d.metadata[k], up.RxMetadata...)
+	if _, ok := d.elected[k]; ok {
+		return false, nil
+	}
+	d.elected[k] = d.instance
+	return true, nil
+}
+
+func (d memUplinkDeduplicator) AccumulatedMetadata(ctx context.Context, up *ttnpb.UplinkMessage) ([]*ttnpb.RxMetadata, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]*ttnpb.RxMetadata(nil), d.metadata[string(up.RawPayload)]...), nil
+}
+
+func (d memUplinkDeduplicator) ReleaseUplink(ctx context.Context, up *ttnpb.UplinkMessage) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	k := string(up.RawPayload)
+	if d.elected[k] == d.instance {
+		delete(d.elected, k)
+	}
+	return nil
+}
+
+func (d memUplinkDeduplicator) ClaimUplink(ctx context.Context, up *ttnpb.UplinkMessage, window time.Duration) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	k := string(up.RawPayload)
+	if _, ok := d.elected[k]; ok {
+		return false, nil
+	}
+	d.elected[k] = d.instance
+	return true, nil
+}
+
+func TestUplinkHandover(t *testing.T) {
+	window := (1 << 7) * test.Delay
+
+	for _, tc := range []struct {
+		Name    string
+		Release bool
+	}{
+		{
+			Name: "no release",
+		},
+		{
+			Name:    "release",
+			Release: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			deduplication := &memUplinkDeduplication{
+				elected:  make(map[string]string),
+				metadata: make(map[string][]*ttnpb.RxMetadata),
+			}
+			var (
+				nss  []*NetworkServer
+				ctxs []context.Context
+			)
+			for i := 0; i < 2; i++ {
+				ns, ctx, env, stop := StartTest(t, component.Config{}, Config{
+					NetID:               NetID,
+					DeduplicationWindow: window,
+					CooldownWindow:      window,
+					UplinkDeduplicator: memUplinkDeduplicator{
+						memUplinkDeduplication: deduplication,
+						instance:               fmt.Sprintf("instance-%d", i),
+					},
+				}, (1<<10)*test.Delay)
+				defer stop()
+
+				<-env.DownlinkTasks.Pop
+
+				nss = append(nss, ns)
+				ctxs = append(ctxs, ctx)
+			}
+
+			makeUplink := func(receivedAt time.Time, mds ...*ttnpb.RxMetadata) *ttnpb.UplinkMessage {
+				return &ttnpb.UplinkMessage{
+					RawPayload: []byte("test"),
+					Payload: &ttnpb.Message{
+						MHDR: ttnpb.MHDR{
+							MType: ttnpb.MType_UNCONFIRMED_UP,
+						},
+					},
+					RxMetadata: mds,
+					ReceivedAt: receivedAt,
+				}
+			}
+
+			type result struct {
+				Instance int
+				Ok       bool
+				Error    error
+			}
+			results := make(chan result, len(nss))
+			start := make(chan struct{})
+			now := time.Now()
+			for i, ns := range nss {
+				i, ns := i, ns
+				go func() {
+					<-start
+					up := makeUplink(now, &ttnpb.RxMetadata{
+						GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: fmt.Sprintf("gateway-%d", i)},
+					})
+					ok, err := ns.deduplicateUplink(ctxs[i], up)
+					results <- result{Instance: i, Ok: ok, Error: err}
+				}()
+			}
+			close(start)
+
+			var elected result
+			select {
+			case elected = <-results:
+			case <-time.After(window):
+				t.Fatal("Timed out while waiting for an instance to be elected")
+			}
+			a.So(elected.Error, should.BeNil)
+			a.So(elected.Ok, should.BeTrue)
+
+			if tc.Release {
+				// The elected instance cancels processing during the deduplication window.
+				nss[elected.Instance].releaseUplink(ctxs[elected.Instance], makeUplink(now))
+			}
+
+			var other result
+			select {
+			case other = <-results:
+			case <-time.After(2 * window):
+				t.Fatal("Timed out while waiting for the other instance")
+			}
+			a.So(other.Instance, should.NotEqual, elected.Instance)
+			a.So(other.Error, should.BeNil)
+			if !tc.Release {
+				// The other instance awaits the handover until the deduplication window closes.
+				a.So(other.Ok, should.BeFalse)
+				a.So(time.Since(now), should.BeGreaterThanOrEqualTo, window)
+				return
+			}
+			a.So(other.Ok, should.BeTrue)
+			mds, err := nss[other.Instance].uplinkDeduplicator.AccumulatedMetadata(ctxs[other.Instance], makeUplink(now))
+			if a.So(err, should.BeNil) {
+				a.So(mds, should.HaveLength, 2)
+			}
+		})
+	}
+}
@@ -1263,12 +1263,60 @@ func TestHandleUplink(t *testing.T) {
 					return msg
 				}
 
-				tcs = append(tcs, TestCase{
-					Name: makeMDName(makeChDRName(chIdx, drIdx, makeLoopName(fmt.Sprintf("Rejoin-request Type %d", typ)))),
-					Handler: func(ctx context.Context, env TestEnvironment, clock *test.MockClock, handle func(context.Context, *ttnpb.UplinkMessage) <-chan error) bool {
-						return assertions.New(test.MustTFromContext(ctx)).So(assertHandleUplinkResponse(ctx, handle(ctx, makeRejoinRequest(false)), ErrRejoinRequest), should.BeTrue)
-					},
-				})
+				makeRejoinName := func(parts ...string) string {
+					return makeMDName(makeChDRName(chIdx, drIdx, makeLoopName(append([]string{fmt.Sprintf("Rejoin-request Type %d", typ)}, parts...)...)))
+				}
+				switch typ {
+				case ttnpb.RejoinType_CONTEXT, ttnpb.RejoinType_KEYS:
+					assertRejoinRangeByDevEUI := func(ctx context.Context, env TestEnvironment, err error) bool {
+						a := assertions.New(test.MustTFromContext(ctx))
+						return AssertDeviceRegistryRangeByDevEUI(ctx, env.DeviceRegistry.RangeByDevEUI, func(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) bool {
+							return AllTrue(
+								a.So(devEUI, should.Resemble, DevEUI),
+								a.So(paths, should.NotBeEmpty),
+							)
+						}, err)
+					}
+					tcs = append(tcs,
+						TestCase{
+							Name: makeRejoinName("Range fail"),
+							Handler: func(ctx context.Context, env TestEnvironment, clock *test.MockClock, handle func(context.Context, *ttnpb.UplinkMessage) <-chan error) bool {
+								return assertions.New(test.MustTFromContext(ctx)).So(assertHandleUplink(ctx, handle, makeRejoinRequest(false), func() bool {
+									return assertRejoinRangeByDevEUI(ctx, env, ErrTestInternal)
+								}, ErrTestInternal), should.BeTrue)
+							},
+						},
+						TestCase{
+							Name: makeRejoinName("No device"),
+							Handler: func(ctx context.Context, env TestEnvironment, clock *test.MockClock, handle func(context.Context, *ttnpb.UplinkMessage) <-chan error) bool {
+								return assertions.New(test.MustTFromContext(ctx)).So(assertHandleUplink(ctx, handle, makeRejoinRequest(false), func() bool {
+									return assertRejoinRangeByDevEUI(ctx, env, nil)
+								}, ErrDeviceNotFound), should.BeTrue)
+							},
+						},
+					)
+
+				case ttnpb.RejoinType_SESSION:
+					tcs = append(tcs, TestCase{
+						Name: makeRejoinName("Get fail"),
+						Handler: func(ctx context.Context, env TestEnvironment, clock *test.MockClock, handle func(context.Context, *ttnpb.UplinkMessage) <-chan error) bool {
+							a := assertions.New(test.MustTFromContext(ctx))
+							return a.So(assertHandleUplink(ctx, handle, makeRejoinRequest(false), func() bool {
+								return AssertDeviceRegistryGetByEUI(ctx, env.DeviceRegistry.GetByEUI, func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) bool {
+									return AllTrue(
+										a.So(joinEUI, should.Resemble, JoinEUI),
+										a.So(devEUI, should.Resemble, DevEUI),
+									)
+								}, func(ctx context.Context) DeviceRegistryGetByEUIResponse {
+									return DeviceRegistryGetByEUIResponse{
+										Context: ctx,
+										Error:   ErrTestInternal,
+									}
+								})
+							}, ErrTestInternal), should.BeTrue)
+						},
+					})
+				}
 			}
 		})
 
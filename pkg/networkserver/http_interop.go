@@ -0,0 +1,110 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+type interopServer struct {
+	NS *NetworkServer
+}
+
+// PRStartRequest handles an uplink message forwarded by a Forwarding Network Server in passive roaming.
+// The Network Server acts as stateless Serving Network Server, so the uplink message is handled as if it
+// was received from a Gateway Server.
+func (srv interopServer) PRStartRequest(ctx context.Context, in *interop.PRStartReq) (*interop.PRStartAns, error) {
+	netID := types.NetID(in.SenderID)
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"namespace", "networkserver/interop",
+		"roaming_net_id", netID,
+	))
+
+	if agreement, ok := srv.NS.interopClient.RoamingAgreement(netID); !ok || !agreement.AcceptUplinks {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	up, err := roamingUplink(netID, in)
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	if err := srv.NS.handleUplink(newContextWithRoamingSender(ctx, netID), up); err != nil {
+		switch {
+		case errors.Resemble(err, errDecodePayload):
+			return nil, interop.ErrMalformedMessage.WithCause(err)
+		case errors.Resemble(err, errDeviceNotFound):
+			return nil, interop.ErrUnknownDevAddr.WithCause(err)
+		case errors.IsNotFound(err) && in.ULMetaData.DevEUI != nil:
+			return nil, interop.ErrUnknownDevEUI.WithCause(err)
+		}
+		return nil, err
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	lifetime := uint32(0)
+	return &interop.PRStartAns{
+		NsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		Lifetime: &lifetime,
+	}, nil
+}
+
+// XmitDataRequest handles a downlink message sent by a Serving Network Server in passive roaming.
+// The downlink message is scheduled on the gateways that received the uplink message forwarded by the
+// Network Server.
+func (srv interopServer) XmitDataRequest(ctx context.Context, in *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	netID := types.NetID(in.SenderID)
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"namespace", "networkserver/interop",
+		"roaming_net_id", netID,
+	))
+
+	if agreement, ok := srv.NS.interopClient.RoamingAgreement(netID); !ok || !agreement.ForwardUplinks {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if in.DLMetaData == nil {
+		return nil, interop.ErrMalformedMessage.WithCause(errRoamingMetadata.WithAttributes("field", "DLMetaData"))
+	}
+	req, paths, err := roamingTxRequest(in.DLMetaData)
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	if _, err := srv.NS.scheduleDownlinkByPaths(ctx, req, in.PHYPayload, paths...); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to schedule downlink of roaming partner")
+		return nil, interop.ErrTransmitFailed.WithCause(err)
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.XmitDataAns{
+		NsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		DLFreq1: in.DLMetaData.DLFreq1,
+		DLFreq2: in.DLMetaData.DLFreq2,
+	}, nil
+}
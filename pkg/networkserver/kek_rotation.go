@@ -0,0 +1,149 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// DeviceIDScanner is a DeviceRegistry that can scan the identifiers of the stored devices.
+type DeviceIDScanner interface {
+	// ScanIDs scans the identifiers of the stored devices, starting at cursor.
+	// It returns the cursor to continue scanning from, which is empty if all devices have been scanned.
+	ScanIDs(ctx context.Context, cursor string, count int64) ([]ttnpb.EndDeviceIdentifiers, string, error)
+}
+
+// ScanIDs implements DeviceIDScanner if the wrapped registry implements it.
+func (w deprecatedDeviceFieldRegistryWrapper) ScanIDs(ctx context.Context, cursor string, count int64) ([]ttnpb.EndDeviceIdentifiers, string, error) {
+	scanner, ok := w.registry.(DeviceIDScanner)
+	if !ok {
+		return nil, "", errScanNotSupported.New()
+	}
+	return scanner.ScanIDs(ctx, cursor, count)
+}
+
+// defaultKEKRotationLimit is the number of devices processed per RotateKEK call if no limit is given.
+const defaultKEKRotationLimit = 100
+
+var kekRotationPaths = [...]string{
+	"mac_state.queued_join_accept.keys",
+	"pending_mac_state.queued_join_accept.keys",
+	"pending_session.keys",
+	"session.keys",
+}
+
+// rewrapDeviceKeys re-wraps the keys of dev that are wrapped with oldKEKLabel with newKEKLabel.
+// It returns the paths of the re-wrapped keys.
+func rewrapDeviceKeys(ctx context.Context, dev *ttnpb.EndDevice, oldKEKLabel, newKEKLabel string, keyVault crypto.KeyVault) ([]string, error) {
+	var sets []string
+	if dev.MACState != nil && dev.MACState.QueuedJoinAccept != nil {
+		paths, err := cryptoutil.RewrapSessionKeys(ctx, &dev.MACState.QueuedJoinAccept.Keys, "mac_state.queued_join_accept.keys", oldKEKLabel, newKEKLabel, keyVault)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, paths...)
+	}
+	if dev.PendingMACState != nil && dev.PendingMACState.QueuedJoinAccept != nil {
+		paths, err := cryptoutil.RewrapSessionKeys(ctx, &dev.PendingMACState.QueuedJoinAccept.Keys, "pending_mac_state.queued_join_accept.keys", oldKEKLabel, newKEKLabel, keyVault)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, paths...)
+	}
+	if dev.PendingSession != nil {
+		paths, err := cryptoutil.RewrapSessionKeys(ctx, &dev.PendingSession.SessionKeys, "pending_session.keys", oldKEKLabel, newKEKLabel, keyVault)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, paths...)
+	}
+	if dev.Session != nil {
+		paths, err := cryptoutil.RewrapSessionKeys(ctx, &dev.Session.SessionKeys, "session.keys", oldKEKLabel, newKEKLabel, keyVault)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, paths...)
+	}
+	return sets, nil
+}
+
+// RotateKEK re-wraps the session keys of the devices in the given registry that are wrapped with the old KEK label with
+// the new KEK label. It processes a batch of devices starting at the cursor of the request.
+// The returned cursor is empty if all devices have been processed.
+func RotateKEK(ctx context.Context, devices DeviceRegistry, keyVault crypto.KeyVault, req *ttnpb.RotateKEKRequest) (*ttnpb.RotateKEKResponse, error) {
+	scanner, ok := devices.(DeviceIDScanner)
+	if !ok {
+		return nil, errScanNotSupported.New()
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultKEKRotationLimit
+	}
+	ids, cursor, err := scanner.ScanIDs(ctx, req.Cursor, int64(limit))
+	if err != nil {
+		return nil, err
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"old_kek_label", req.OldKEKLabel,
+		"new_kek_label", req.NewKEKLabel,
+		"dry_run", req.DryRun,
+	))
+	res := &ttnpb.RotateKEKResponse{
+		Cursor: cursor,
+	}
+	for _, ids := range ids {
+		var rewrapped int
+		_, _, err := devices.SetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceID, kekRotationPaths[:], func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, nil
+			}
+			sets, err := rewrapDeviceKeys(ctx, dev, req.OldKEKLabel, req.NewKEKLabel, keyVault)
+			if err != nil {
+				return nil, nil, err
+			}
+			rewrapped = len(sets)
+			if req.DryRun {
+				return dev, nil, nil
+			}
+			return dev, sets, nil
+		})
+		if err != nil {
+			logger.WithField("device_uid", unique.ID(ctx, ids)).WithError(err).Warn("Failed to rotate KEK of device")
+			return nil, err
+		}
+		res.Processed++
+		res.Rewrapped += uint32(rewrapped)
+	}
+	logger.WithFields(log.Fields(
+		"processed", res.Processed,
+		"rewrapped", res.Rewrapped,
+	)).Debug("Rotated KEK of devices")
+	return res, nil
+}
+
+// RotateKEK implements ttnpb.NsServer.
+func (ns *NetworkServer) RotateKEK(ctx context.Context, req *ttnpb.RotateKEKRequest) (*ttnpb.RotateKEKResponse, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	return RotateKEK(ctx, ns.devices, ns.KeyVault, req)
+}
@@ -0,0 +1,155 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
+	. "go.thethings.network/lorawan-stack/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestRotateKEK(t *testing.T) {
+	a := assertions.New(t)
+
+	keys := map[string][]byte{
+		"old": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+		"new": {0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x00},
+	}
+	keyVault := cryptoutil.NewMemKeyVault(keys)
+
+	reg, closeFn := NewRedisDeviceRegistry(t)
+	defer func() {
+		if err := closeFn(); err != nil {
+			t.Errorf("Failed to close registry: %s", err)
+		}
+	}()
+
+	ns, ctx, _, stop := StartTest(t, component.Config{
+		ServiceBase: config.ServiceBase{
+			KeyVault: config.KeyVault{
+				Provider: "static",
+				Static:   keys,
+			},
+		},
+	}, Config{
+		Devices: reg,
+		DownlinkTasks: &MockDownlinkTaskQueue{
+			PopFunc: DownlinkTaskPopBlockFunc,
+		},
+	}, (1<<10)*test.Delay)
+	defer stop()
+
+	fNwkSIntKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	setDevice := func(devID, kekLabel string) {
+		env, err := cryptoutil.WrapAES128Key(ctx, fNwkSIntKey, kekLabel, keyVault)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		_, _, err = reg.SetByID(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}, devID, nil, func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			return &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+					DeviceID:               devID,
+				},
+				Session: &ttnpb.Session{
+					DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+					SessionKeys: ttnpb.SessionKeys{
+						FNwkSIntKey: &env,
+					},
+				},
+			}, []string{
+				"ids.application_ids",
+				"ids.device_id",
+				"session.dev_addr",
+				"session.keys.f_nwk_s_int_key",
+			}, nil
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	setDevice("test-dev-1", "old")
+	setDevice("test-dev-2", "")
+
+	assertKEKLabel := func(devID, kekLabel string) {
+		dev, _, err := reg.GetByID(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}, devID, []string{"session.keys"})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(dev.Session.FNwkSIntKey.KEKLabel, should.Equal, kekLabel)
+		key, err := cryptoutil.UnwrapAES128Key(ctx, *dev.Session.FNwkSIntKey, keyVault)
+		a.So(err, should.BeNil)
+		a.So(key, should.Equal, fNwkSIntKey)
+	}
+
+	rotate := func(req ttnpb.RotateKEKRequest) (processed, rewrapped uint32) {
+		req.Limit = 1
+		for {
+			res, err := ttnpb.NewNsClient(ns.LoopbackConn()).RotateKEK(ctx, &req)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			processed += res.Processed
+			rewrapped += res.Rewrapped
+			if res.Cursor == "" {
+				return processed, rewrapped
+			}
+			req.Cursor = res.Cursor
+		}
+	}
+
+	processed, rewrapped := rotate(ttnpb.RotateKEKRequest{
+		OldKEKLabel: "old",
+		NewKEKLabel: "new",
+		DryRun:      true,
+	})
+	a.So(processed, should.Equal, 2)
+	a.So(rewrapped, should.Equal, 1)
+	assertKEKLabel("test-dev-1", "old")
+	assertKEKLabel("test-dev-2", "")
+
+	processed, rewrapped = rotate(ttnpb.RotateKEKRequest{
+		OldKEKLabel: "old",
+		NewKEKLabel: "new",
+	})
+	a.So(processed, should.Equal, 2)
+	a.So(rewrapped, should.Equal, 1)
+	assertKEKLabel("test-dev-1", "new")
+	assertKEKLabel("test-dev-2", "")
+
+	processed, rewrapped = rotate(ttnpb.RotateKEKRequest{
+		NewKEKLabel: "new",
+	})
+	a.So(processed, should.Equal, 2)
+	a.So(rewrapped, should.Equal, 1)
+	assertKEKLabel("test-dev-1", "new")
+	assertKEKLabel("test-dev-2", "new")
+
+	_, err := ttnpb.NewNsClient(ns.LoopbackConn()).RotateKEK(ctx, &ttnpb.RotateKEKRequest{
+		OldKEKLabel: "new",
+		NewKEKLabel: "unknown",
+	})
+	a.So(err, should.NotBeNil)
+	assertKEKLabel("test-dev-1", "new")
+}
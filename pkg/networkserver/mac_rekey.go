@@ -44,6 +44,8 @@ func handleRekeyInd(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCom
 	dev.Session = dev.PendingSession
 	dev.PendingMACState = nil
 	dev.PendingSession = nil
+	// The device resets RJcount0 when the new session is activated.
+	dev.LastRJCount0 = 0
 
 	conf := &ttnpb.MACCommand_RekeyConf{
 		MinorVersion: pld.MinorVersion,
@@ -21,7 +21,6 @@ import (
 	"sync"
 	"time"
 
-	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
@@ -86,6 +85,12 @@ type DownlinkPriorities struct {
 // InteropClient is a client, which Network Server can use for interoperability.
 type InteropClient interface {
 	HandleJoinRequest(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HandleRejoinRequest(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HomeNSRequest(ctx context.Context, netID types.NetID, joinEUI, devEUI types.EUI64) (types.NetID, error)
+	RoamingAgreement(types.NetID) (interop.RoamingAgreement, bool)
+	RoamingNetID(types.DevAddr) (types.NetID, interop.RoamingAgreement, bool)
+	PRStartRequest(context.Context, types.NetID, *interop.PRStartReq) (*interop.PRStartAns, error)
+	XmitDataRequest(context.Context, types.NetID, *interop.XmitDataReq) (*interop.XmitDataAns, error)
 }
 
 // NetworkServer implements the Network Server component.
@@ -97,8 +102,9 @@ type NetworkServer struct {
 
 	devices DeviceRegistry
 
-	netID      types.NetID
-	newDevAddr newDevAddrFunc
+	netID           types.NetID
+	devAddrPrefixes []types.DevAddrPrefix
+	newDevAddr      newDevAddrFunc
 
 	applicationServers *sync.Map // string -> *applicationUpStream
 	applicationUplinks ApplicationUplinkQueue
@@ -109,9 +115,12 @@ type NetworkServer struct {
 	deduplicationWindow windowDurationFunc
 	collectionWindow    windowDurationFunc
 
-	defaultMACSettings ttnpb.MACSettings
+	defaultMACSettings     ttnpb.MACSettings
+	applicationMACSettings map[string]ttnpb.MACSettings
 
 	interopClient InteropClient
+	interop       interopServer
+	homeNetIDs    homeNetIDCache
 
 	uplinkDeduplicator UplinkDeduplicator
 
@@ -174,6 +183,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		Component:           c,
 		ctx:                 ctx,
 		netID:               conf.NetID,
+		devAddrPrefixes:     devAddrPrefixes,
 		newDevAddr:          makeNewDevAddrFunc(devAddrPrefixes...),
 		applicationServers:  &sync.Map{},
 		applicationUplinks:  conf.ApplicationUplinks,
@@ -182,32 +192,21 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		devices:             wrapDeviceRegistryWithDeprecatedFields(conf.Devices, deprecatedDeviceFields...),
 		downlinkTasks:       conf.DownlinkTasks,
 		downlinkPriorities:  downlinkPriorities,
-		defaultMACSettings: ttnpb.MACSettings{
-			ClassBTimeout:         conf.DefaultMACSettings.ClassBTimeout,
-			ClassCTimeout:         conf.DefaultMACSettings.ClassCTimeout,
-			StatusTimePeriodicity: conf.DefaultMACSettings.StatusTimePeriodicity,
-		},
-		interopClient:      interopCl,
-		uplinkDeduplicator: conf.UplinkDeduplicator,
-		deviceKEKLabel:     conf.DeviceKEKLabel,
+		interopClient:       interopCl,
+		uplinkDeduplicator:  conf.UplinkDeduplicator,
+		deviceKEKLabel:      conf.DeviceKEKLabel,
 	}
-	if conf.DefaultMACSettings.ADRMargin != nil {
-		ns.defaultMACSettings.ADRMargin = &pbtypes.FloatValue{Value: *conf.DefaultMACSettings.ADRMargin}
-	}
-	if conf.DefaultMACSettings.DesiredRx1Delay != nil {
-		ns.defaultMACSettings.DesiredRx1Delay = &ttnpb.RxDelayValue{Value: *conf.DefaultMACSettings.DesiredRx1Delay}
-	}
-	if conf.DefaultMACSettings.DesiredMaxDutyCycle != nil {
-		ns.defaultMACSettings.DesiredMaxDutyCycle = &ttnpb.AggregatedDutyCycleValue{Value: *conf.DefaultMACSettings.DesiredMaxDutyCycle}
-	}
-	if conf.DefaultMACSettings.DesiredADRAckLimitExponent != nil {
-		ns.defaultMACSettings.DesiredADRAckLimitExponent = &ttnpb.ADRAckLimitExponentValue{Value: *conf.DefaultMACSettings.DesiredADRAckLimitExponent}
-	}
-	if conf.DefaultMACSettings.DesiredADRAckDelayExponent != nil {
-		ns.defaultMACSettings.DesiredADRAckDelayExponent = &ttnpb.ADRAckDelayExponentValue{Value: *conf.DefaultMACSettings.DesiredADRAckDelayExponent}
-	}
-	if conf.DefaultMACSettings.StatusCountPeriodicity != nil {
-		ns.defaultMACSettings.StatusCountPeriodicity = &pbtypes.UInt32Value{Value: *conf.DefaultMACSettings.StatusCountPeriodicity}
+	conf.DefaultMACSettings.apply(&ns.defaultMACSettings)
+	if len(conf.ApplicationMACSettings) > 0 {
+		ns.applicationMACSettings = make(map[string]ttnpb.MACSettings, len(conf.ApplicationMACSettings))
+		for appID, appConf := range conf.ApplicationMACSettings {
+			if err := (&ttnpb.ApplicationIdentifiers{ApplicationID: appID}).ValidateFields("application_id"); err != nil {
+				return nil, errInvalidConfiguration.WithCause(err)
+			}
+			settings := ns.defaultMACSettings
+			appConf.apply(&settings)
+			ns.applicationMACSettings[appID] = settings
+		}
 	}
 
 	if len(opts) == 0 {
@@ -241,6 +240,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	}, component.TaskRestartOnFailure)
 
 	c.RegisterGRPC(ns)
+	if ns.interopClient != nil {
+		ns.interop = interopServer{NS: ns}
+		c.RegisterInterop(ns)
+	}
 	return ns, nil
 }
 
@@ -263,6 +266,21 @@ func (ns *NetworkServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Client
 	ttnpb.RegisterNsHandler(ns.Context(), s, conn)
 }
 
+// RegisterInterop registers the sNS and fNS interop services for passive roaming.
+func (ns *NetworkServer) RegisterInterop(srv *interop.Server) {
+	srv.RegisterSNS(ns.interop)
+	srv.RegisterFNS(ns.interop)
+}
+
+// defaultMACSettingsFor returns the MAC settings to fallback to if not specified by end devices of the application
+// identified by ids.
+func (ns *NetworkServer) defaultMACSettingsFor(ids ttnpb.ApplicationIdentifiers) ttnpb.MACSettings {
+	if settings, ok := ns.applicationMACSettings[ids.ApplicationID]; ok {
+		return settings
+	}
+	return ns.defaultMACSettings
+}
+
 // Roles returns the roles that the Network Server fulfills.
 func (ns *NetworkServer) Roles() []ttnpb.ClusterRole {
 	return []ttnpb.ClusterRole{ttnpb.ClusterRole_NETWORK_SERVER}
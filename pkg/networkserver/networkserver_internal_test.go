@@ -17,8 +17,10 @@ package networkserver
 import (
 	"testing"
 
+	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
@@ -91,3 +93,43 @@ func TestNewDevAddr(t *testing.T) {
 		a.So(seen[ps[2]], should.BeGreaterThan, 0)
 	})
 }
+
+func TestApplicationMACSettings(t *testing.T) {
+	a := assertions.New(t)
+
+	defaultMaxDataRateIndex := ttnpb.DATA_RATE_5
+	appMaxDataRateIndex := ttnpb.DATA_RATE_3
+	appRx2Frequency := uint64(869525000)
+	ns, _, _, stop := StartTest(
+		t,
+		component.Config{},
+		Config{
+			NetID: types.NetID{0x00, 0x00, 0x13},
+			DownlinkTasks: MockDownlinkTaskQueue{
+				PopFunc: DownlinkTaskPopBlockFunc,
+			},
+			DefaultMACSettings: MACSettingConfig{
+				DesiredMaxDataRateIndex: &defaultMaxDataRateIndex,
+			},
+			ApplicationMACSettings: map[string]MACSettingConfig{
+				"test-app-id": {
+					DesiredMaxDataRateIndex: &appMaxDataRateIndex,
+					DesiredRx2Frequency:     &appRx2Frequency,
+					DesiredChannelMask:      []bool{true, true, false},
+				},
+			},
+		},
+		(1<<3)*test.Delay,
+	)
+	defer stop()
+
+	settings := ns.defaultMACSettingsFor(ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
+	a.So(settings.DesiredMaxDataRateIndex, should.Resemble, &ttnpb.DataRateIndexValue{Value: ttnpb.DATA_RATE_3})
+	a.So(settings.DesiredRx2Frequency, should.Resemble, &pbtypes.UInt64Value{Value: 869525000})
+	a.So(settings.DesiredChannelMask, should.Resemble, []bool{true, true, false})
+
+	settings = ns.defaultMACSettingsFor(ttnpb.ApplicationIdentifiers{ApplicationID: "other-app-id"})
+	a.So(settings.DesiredMaxDataRateIndex, should.Resemble, &ttnpb.DataRateIndexValue{Value: ttnpb.DATA_RATE_5})
+	a.So(settings.DesiredRx2Frequency, should.BeNil)
+	a.So(settings.DesiredChannelMask, should.BeNil)
+}
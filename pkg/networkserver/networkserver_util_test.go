@@ -34,6 +34,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/interop"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/networkserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
@@ -64,7 +65,6 @@ var (
 	ErrDecodePayload             = errDecodePayload
 	ErrDeviceNotFound            = errDeviceNotFound
 	ErrOutdatedData              = errOutdatedData
-	ErrRejoinRequest             = errRejoinRequest
 	ErrUnsupportedLoRaWANVersion = errUnsupportedLoRaWANVersion
 
 	EvtBeginApplicationLink    = evtBeginApplicationLink
@@ -767,10 +767,11 @@ var _ DeviceRegistry = MockDeviceRegistry{}
 
 // MockDeviceRegistry is a mock DeviceRegistry used for testing.
 type MockDeviceRegistry struct {
-	GetByEUIFunc    func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error)
-	GetByIDFunc     func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
-	RangeByAddrFunc func(ctx context.Context, devAddr types.DevAddr, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error
-	SetByIDFunc     func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
+	GetByEUIFunc      func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error)
+	GetByIDFunc       func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
+	RangeByAddrFunc   func(ctx context.Context, devAddr types.DevAddr, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error
+	RangeByDevEUIFunc func(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error
+	SetByIDFunc       func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
 }
 
 // GetByEUI calls GetByEUIFunc if set and panics otherwise.
@@ -797,6 +798,14 @@ func (m MockDeviceRegistry) RangeByAddr(ctx context.Context, devAddr types.DevAd
 	return m.RangeByAddrFunc(ctx, devAddr, paths, f)
 }
 
+// RangeByDevEUI calls RangeByDevEUIFunc if set and panics otherwise.
+func (m MockDeviceRegistry) RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+	if m.RangeByDevEUIFunc == nil {
+		panic("RangeByDevEUI called, but not set")
+	}
+	return m.RangeByDevEUIFunc(ctx, devEUI, paths, f)
+}
+
 // SetByID calls SetByIDFunc if set and panics otherwise.
 func (m MockDeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
 	if m.SetByIDFunc == nil {
@@ -883,6 +892,28 @@ func MakeDeviceRegistryRangeByAddrChFunc(reqCh chan<- DeviceRegistryRangeByAddrR
 	}
 }
 
+type DeviceRegistryRangeByDevEUIRequest struct {
+	Context  context.Context
+	DevEUI   types.EUI64
+	Paths    []string
+	Func     func(context.Context, *ttnpb.EndDevice) bool
+	Response chan<- error
+}
+
+func MakeDeviceRegistryRangeByDevEUIChFunc(reqCh chan<- DeviceRegistryRangeByDevEUIRequest) func(context.Context, types.EUI64, []string, func(context.Context, *ttnpb.EndDevice) bool) error {
+	return func(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+		respCh := make(chan error)
+		reqCh <- DeviceRegistryRangeByDevEUIRequest{
+			Context:  ctx,
+			DevEUI:   devEUI,
+			Paths:    paths,
+			Func:     f,
+			Response: respCh,
+		}
+		return <-respCh
+	}
+}
+
 type DeviceRegistrySetByIDResponse contextualDeviceAndError
 
 type DeviceRegistrySetByIDRequest struct {
@@ -920,7 +951,13 @@ var _ InteropClient = MockInteropClient{}
 
 // MockInteropClient is a mock InteropClient used for testing.
 type MockInteropClient struct {
-	HandleJoinRequestFunc func(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HandleJoinRequestFunc   func(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HandleRejoinRequestFunc func(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HomeNSRequestFunc       func(context.Context, types.NetID, types.EUI64, types.EUI64) (types.NetID, error)
+	RoamingAgreementFunc    func(types.NetID) (interop.RoamingAgreement, bool)
+	RoamingNetIDFunc        func(types.DevAddr) (types.NetID, interop.RoamingAgreement, bool)
+	PRStartRequestFunc      func(context.Context, types.NetID, *interop.PRStartReq) (*interop.PRStartAns, error)
+	XmitDataRequestFunc     func(context.Context, types.NetID, *interop.XmitDataReq) (*interop.XmitDataAns, error)
 }
 
 // HandleJoinRequest calls HandleJoinRequestFunc if set and panics otherwise.
@@ -931,6 +968,54 @@ func (m MockInteropClient) HandleJoinRequest(ctx context.Context, netID types.Ne
 	return m.HandleJoinRequestFunc(ctx, netID, req)
 }
 
+// HandleRejoinRequest calls HandleRejoinRequestFunc if set and panics otherwise.
+func (m MockInteropClient) HandleRejoinRequest(ctx context.Context, netID types.NetID, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+	if m.HandleRejoinRequestFunc == nil {
+		panic("HandleRejoinRequest called, but not set")
+	}
+	return m.HandleRejoinRequestFunc(ctx, netID, req)
+}
+
+// HomeNSRequest calls HomeNSRequestFunc if set and panics otherwise.
+func (m MockInteropClient) HomeNSRequest(ctx context.Context, netID types.NetID, joinEUI, devEUI types.EUI64) (types.NetID, error) {
+	if m.HomeNSRequestFunc == nil {
+		panic("HomeNSRequest called, but not set")
+	}
+	return m.HomeNSRequestFunc(ctx, netID, joinEUI, devEUI)
+}
+
+// RoamingAgreement calls RoamingAgreementFunc if set and panics otherwise.
+func (m MockInteropClient) RoamingAgreement(netID types.NetID) (interop.RoamingAgreement, bool) {
+	if m.RoamingAgreementFunc == nil {
+		panic("RoamingAgreement called, but not set")
+	}
+	return m.RoamingAgreementFunc(netID)
+}
+
+// RoamingNetID calls RoamingNetIDFunc if set and panics otherwise.
+func (m MockInteropClient) RoamingNetID(devAddr types.DevAddr) (types.NetID, interop.RoamingAgreement, bool) {
+	if m.RoamingNetIDFunc == nil {
+		panic("RoamingNetID called, but not set")
+	}
+	return m.RoamingNetIDFunc(devAddr)
+}
+
+// PRStartRequest calls PRStartRequestFunc if set and panics otherwise.
+func (m MockInteropClient) PRStartRequest(ctx context.Context, netID types.NetID, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+	if m.PRStartRequestFunc == nil {
+		panic("PRStartRequest called, but not set")
+	}
+	return m.PRStartRequestFunc(ctx, netID, req)
+}
+
+// XmitDataRequest calls XmitDataRequestFunc if set and panics otherwise.
+func (m MockInteropClient) XmitDataRequest(ctx context.Context, netID types.NetID, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	if m.XmitDataRequestFunc == nil {
+		panic("XmitDataRequest called, but not set")
+	}
+	return m.XmitDataRequestFunc(ctx, netID, req)
+}
+
 type InteropClientHandleJoinRequestResponse struct {
 	Response *ttnpb.JoinResponse
 	Error    error
@@ -1277,6 +1362,29 @@ func AssertDeviceRegistryRangeByAddr(ctx context.Context, reqCh <-chan DeviceReg
 	}
 }
 
+func AssertDeviceRegistryRangeByDevEUI(ctx context.Context, reqCh <-chan DeviceRegistryRangeByDevEUIRequest, assert func(context.Context, types.EUI64, []string, func(context.Context, *ttnpb.EndDevice) bool) bool, resp error) bool {
+	t := test.MustTFromContext(ctx)
+	t.Helper()
+	select {
+	case <-ctx.Done():
+		t.Error("Timed out while waiting for DeviceRegistry.RangeByDevEUI to be called")
+		return false
+
+	case req := <-reqCh:
+		if !assert(req.Context, req.DevEUI, req.Paths, req.Func) {
+			return false
+		}
+		select {
+		case <-ctx.Done():
+			t.Error("Timed out while waiting for DeviceRegistry.RangeByDevEUI response to be processed")
+			return false
+
+		case req.Response <- resp:
+			return true
+		}
+	}
+}
+
 func AssertApplicationUplinkQueueAddRequest(ctx context.Context, reqCh <-chan ApplicationUplinkQueueAddRequest, assert func(context.Context, ...*ttnpb.ApplicationUp) bool, resp error) bool {
 	t := test.MustTFromContext(ctx)
 	t.Helper()
@@ -1526,10 +1634,11 @@ func newMockApplicationUplinkQueue(t *testing.T) (ApplicationUplinkQueue, Applic
 }
 
 type DeviceRegistryEnvironment struct {
-	GetByID     <-chan DeviceRegistryGetByIDRequest
-	GetByEUI    <-chan DeviceRegistryGetByEUIRequest
-	RangeByAddr <-chan DeviceRegistryRangeByAddrRequest
-	SetByID     <-chan DeviceRegistrySetByIDRequest
+	GetByID       <-chan DeviceRegistryGetByIDRequest
+	GetByEUI      <-chan DeviceRegistryGetByEUIRequest
+	RangeByAddr   <-chan DeviceRegistryRangeByAddrRequest
+	RangeByDevEUI <-chan DeviceRegistryRangeByDevEUIRequest
+	SetByID       <-chan DeviceRegistrySetByIDRequest
 }
 
 func newMockDeviceRegistry(t *testing.T) (DeviceRegistry, DeviceRegistryEnvironment, func()) {
@@ -1538,16 +1647,19 @@ func newMockDeviceRegistry(t *testing.T) (DeviceRegistry, DeviceRegistryEnvironm
 	getByEUICh := make(chan DeviceRegistryGetByEUIRequest)
 	getByIDCh := make(chan DeviceRegistryGetByIDRequest)
 	rangeByAddrCh := make(chan DeviceRegistryRangeByAddrRequest)
+	rangeByDevEUICh := make(chan DeviceRegistryRangeByDevEUIRequest)
 	setByIDCh := make(chan DeviceRegistrySetByIDRequest)
 	return &MockDeviceRegistry{
-			GetByEUIFunc:    MakeDeviceRegistryGetByEUIChFunc(getByEUICh),
-			GetByIDFunc:     MakeDeviceRegistryGetByIDChFunc(getByIDCh),
-			RangeByAddrFunc: MakeDeviceRegistryRangeByAddrChFunc(rangeByAddrCh),
-			SetByIDFunc:     MakeDeviceRegistrySetByIDChFunc(setByIDCh),
+			GetByEUIFunc:      MakeDeviceRegistryGetByEUIChFunc(getByEUICh),
+			GetByIDFunc:       MakeDeviceRegistryGetByIDChFunc(getByIDCh),
+			RangeByAddrFunc:   MakeDeviceRegistryRangeByAddrChFunc(rangeByAddrCh),
+			RangeByDevEUIFunc: MakeDeviceRegistryRangeByDevEUIChFunc(rangeByDevEUICh),
+			SetByIDFunc:       MakeDeviceRegistrySetByIDChFunc(setByIDCh),
 		}, DeviceRegistryEnvironment{
-			GetByEUI:    getByEUICh,
-			RangeByAddr: rangeByAddrCh,
-			SetByID:     setByIDCh,
+			GetByEUI:      getByEUICh,
+			RangeByAddr:   rangeByAddrCh,
+			RangeByDevEUI: rangeByDevEUICh,
+			SetByID:       setByIDCh,
 		},
 		func() {
 			select {
@@ -1569,6 +1681,12 @@ func newMockDeviceRegistry(t *testing.T) (DeviceRegistry, DeviceRegistryEnvironm
 				close(rangeByAddrCh)
 			}
 			select {
+			case <-rangeByDevEUICh:
+				t.Error("DeviceRegistry.RangeByDevEUI call missed")
+			default:
+				close(rangeByDevEUICh)
+			}
+			select {
 			case <-setByIDCh:
 				t.Error("DeviceRegistry.SetByID call missed")
 			default:
@@ -1611,17 +1729,30 @@ func newMockDownlinkTaskQueue(t *testing.T) (DownlinkTaskQueue, DownlinkTaskQueu
 }
 
 type InteropClientEnvironment struct {
-	HandleJoinRequest <-chan InteropClientHandleJoinRequestRequest
+	HandleJoinRequest   <-chan InteropClientHandleJoinRequestRequest
+	HandleRejoinRequest <-chan InteropClientHandleJoinRequestRequest
 }
 
 func newMockInteropClient(t *testing.T) (InteropClient, InteropClientEnvironment, func()) {
 	t.Helper()
 
 	handleJoinCh := make(chan InteropClientHandleJoinRequestRequest)
+	handleRejoinCh := make(chan InteropClientHandleJoinRequestRequest)
 	return &MockInteropClient{
-			HandleJoinRequestFunc: MakeInteropClientHandleJoinRequestChFunc(handleJoinCh),
+			HandleJoinRequestFunc:   MakeInteropClientHandleJoinRequestChFunc(handleJoinCh),
+			HandleRejoinRequestFunc: MakeInteropClientHandleJoinRequestChFunc(handleRejoinCh),
+			HomeNSRequestFunc: func(context.Context, types.NetID, types.EUI64, types.EUI64) (types.NetID, error) {
+				return types.NetID{}, errors.New("no Home Network Server")
+			},
+			RoamingAgreementFunc: func(types.NetID) (interop.RoamingAgreement, bool) {
+				return interop.RoamingAgreement{}, false
+			},
+			RoamingNetIDFunc: func(types.DevAddr) (types.NetID, interop.RoamingAgreement, bool) {
+				return types.NetID{}, interop.RoamingAgreement{}, false
+			},
 		}, InteropClientEnvironment{
-			HandleJoinRequest: handleJoinCh,
+			HandleJoinRequest:   handleJoinCh,
+			HandleRejoinRequest: handleRejoinCh,
 		},
 		func() {
 			select {
@@ -1630,6 +1761,12 @@ func newMockInteropClient(t *testing.T) (InteropClient, InteropClientEnvironment
 			default:
 				close(handleJoinCh)
 			}
+			select {
+			case <-handleRejoinCh:
+				t.Error("InteropClient.HandleRejoinRequest call missed")
+			default:
+				close(handleRejoinCh)
+			}
 		}
 }
 
@@ -120,6 +120,10 @@ var (
 		"ns.up.data.forward", "forward data message",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtDropDataDownlink = events.Define(
+		"ns.down.data.drop", "drop data downlink",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtDropJoinRequest = events.Define(
 		"ns.up.join.drop", "drop join-request",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
@@ -136,6 +140,10 @@ var (
 		"ns.up.rejoin.forward", "forward rejoin-request",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtConvergeMACParameters = events.Define(
+		"ns.mac.parameters.converge", "converge MAC parameters to desired MAC parameters",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtEnqueueProprietaryMACAnswer  = defineEnqueueMACAnswerEvent("proprietary", "proprietary MAC command")
 	evtEnqueueProprietaryMACRequest = defineEnqueueMACRequestEvent("proprietary", "proprietary MAC command")
 	evtReceiveProprietaryMAC        = events.Define(
@@ -179,6 +187,14 @@ var nsMetrics = &messageMetrics{
 		},
 		[]string{messageType},
 	),
+	uplinkRoamingForwarded: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "uplink_roaming_forwarded_total",
+			Help:      "Total number of uplinks forwarded to roaming partners",
+		},
+		[]string{messageType},
+	),
 	uplinkDropped: metrics.NewContextualCounterVec(
 		prometheus.CounterOpts{
 			Subsystem: subsystem,
@@ -203,17 +219,19 @@ func init() {
 }
 
 type messageMetrics struct {
-	uplinkReceived       *metrics.ContextualCounterVec
-	uplinkUniqueReceived *metrics.ContextualCounterVec
-	uplinkForwarded      *metrics.ContextualCounterVec
-	uplinkDropped        *metrics.ContextualCounterVec
-	uplinkGateways       *metrics.ContextualHistogramVec
+	uplinkReceived         *metrics.ContextualCounterVec
+	uplinkUniqueReceived   *metrics.ContextualCounterVec
+	uplinkForwarded        *metrics.ContextualCounterVec
+	uplinkRoamingForwarded *metrics.ContextualCounterVec
+	uplinkDropped          *metrics.ContextualCounterVec
+	uplinkGateways         *metrics.ContextualHistogramVec
 }
 
 func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.uplinkReceived.Describe(ch)
 	m.uplinkUniqueReceived.Describe(ch)
 	m.uplinkForwarded.Describe(ch)
+	m.uplinkRoamingForwarded.Describe(ch)
 	m.uplinkDropped.Describe(ch)
 	m.uplinkGateways.Describe(ch)
 }
@@ -222,6 +240,7 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.uplinkReceived.Collect(ch)
 	m.uplinkUniqueReceived.Collect(ch)
 	m.uplinkForwarded.Collect(ch)
+	m.uplinkRoamingForwarded.Collect(ch)
 	m.uplinkDropped.Collect(ch)
 	m.uplinkGateways.Collect(ch)
 }
@@ -258,6 +277,10 @@ func registerForwardRejoinRequest(ctx context.Context, msg *ttnpb.UplinkMessage)
 	nsMetrics.uplinkForwarded.WithLabelValues(ctx, uplinkMTypeLabel(msg)).Inc()
 }
 
+func registerForwardRoamingUplink(ctx context.Context, msg *ttnpb.UplinkMessage) {
+	nsMetrics.uplinkRoamingForwarded.WithLabelValues(ctx, uplinkMTypeLabel(msg)).Inc()
+}
+
 func registerDropDataUplink(ctx context.Context, msg *ttnpb.UplinkMessage, err error) {
 	if ttnErr, ok := errors.From(err); ok {
 		nsMetrics.uplinkDropped.WithLabelValues(ctx, uplinkMTypeLabel(msg), ttnErr.FullName()).Inc()
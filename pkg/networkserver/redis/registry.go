@@ -33,6 +33,7 @@ var (
 	errInvalidIdentifiers   = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
 	errDuplicateIdentifiers = errors.DefineAlreadyExists("duplicate_identifiers", "duplicate identifiers")
 	errReadOnlyField        = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errDeviceUID            = errors.DefineCorruption("device_uid", "invalid device UID `{device_uid}`")
 )
 
 // DeviceRegistry is an implementation of networkserver.DeviceRegistry.
@@ -48,6 +49,10 @@ func (r *DeviceRegistry) addrKey(addr types.DevAddr) string {
 	return r.Redis.Key("addr", addr.String())
 }
 
+func (r *DeviceRegistry) devEUIKey(devEUI types.EUI64) string {
+	return r.Redis.Key("dev_eui", devEUI.String())
+}
+
 func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) string {
 	return r.Redis.Key("eui", joinEUI.String(), devEUI.String())
 }
@@ -108,6 +113,43 @@ func (r *DeviceRegistry) RangeByAddr(ctx context.Context, addr types.DevAddr, pa
 	})
 }
 
+// RangeByDevEUI ranges over devices by DevEUI.
+func (r *DeviceRegistry) RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+	defer trace.StartRegion(ctx, "range end devices by dev_eui").End()
+
+	return ttnredis.FindProtos(r.Redis, r.devEUIKey(devEUI), r.uidKey).Range(func() (proto.Message, func() (bool, error)) {
+		pb := &ttnpb.EndDevice{}
+		return pb, func() (bool, error) {
+			pb, err := ttnpb.FilterGetEndDevice(pb, paths...)
+			if err != nil {
+				return false, err
+			}
+			return f(ctx, pb), nil
+		}
+	})
+}
+
+// ScanIDs scans the identifiers of the stored devices, starting at cursor.
+// It returns the cursor to continue scanning from, which is empty if all devices have been scanned.
+// Count is a hint for the number of devices to scan; the number of returned identifiers may differ.
+func (r *DeviceRegistry) ScanIDs(ctx context.Context, cursor string, count int64) ([]ttnpb.EndDeviceIdentifiers, string, error) {
+	defer trace.StartRegion(ctx, "scan end device identifiers").End()
+
+	uids, cursor, err := ttnredis.ScanKeys(r.Redis, r.uidKey(""), cursor, count)
+	if err != nil {
+		return nil, "", err
+	}
+	ids := make([]ttnpb.EndDeviceIdentifiers, 0, len(uids))
+	for _, uid := range uids {
+		devIDs, err := unique.ToDeviceID(uid)
+		if err != nil {
+			return nil, "", errDeviceUID.WithCause(err).WithAttributes("device_uid", uid)
+		}
+		ids = append(ids, devIDs)
+	}
+	return ids, cursor, nil
+}
+
 func getDevAddrs(pb *ttnpb.EndDevice) (addrs struct{ current, pending *types.DevAddr }) {
 	if pb == nil {
 		return
@@ -203,6 +245,9 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 				if stored.JoinEUI != nil && stored.DevEUI != nil {
 					p.Del(r.euiKey(*stored.JoinEUI, *stored.DevEUI))
 				}
+				if stored.DevEUI != nil {
+					p.SRem(r.devEUIKey(*stored.DevEUI), uid)
+				}
 				if stored.PendingSession != nil {
 					p.SRem(r.addrKey(stored.PendingSession.DevAddr), uid)
 				}
@@ -284,6 +329,10 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 				if err != nil {
 					return err
 				}
+				if updated.DevEUI != nil {
+					// Devices stored before the DevEUI index existed are indexed on their next update.
+					p.SAdd(r.devEUIKey(*updated.DevEUI), uid)
+				}
 
 				storedAddrs := getDevAddrs(stored)
 				updatedAddrs := getDevAddrs(updated)
@@ -19,16 +19,26 @@ import (
 	"encoding/base64"
 	"hash/fnv"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/proto"
+	"go.thethings.network/lorawan-stack/pkg/random"
 	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
 // UplinkDeduplicator is an implementation of networkserver.UplinkDeduplicator.
+// Network Server instances that share the Redis database elect the instance that first receives an uplink
+// to process it. Other instances add the metadata of the uplink to the accumulated metadata of the elected instance.
 type UplinkDeduplicator struct {
 	Redis *ttnredis.Client
+	// Instance identifies the Network Server instance in the election. A random identifier is used if empty.
+	Instance string
+
+	instanceOnce sync.Once
+	instance     string
 }
 
 // NewUplinkDeduplicator returns a new uplink deduplicator.
@@ -38,6 +48,16 @@ func NewUplinkDeduplicator(cl *ttnredis.Client) *UplinkDeduplicator {
 	}
 }
 
+func (d *UplinkDeduplicator) instanceID() string {
+	d.instanceOnce.Do(func() {
+		d.instance = d.Instance
+		if d.instance == "" {
+			d.instance = random.String(16)
+		}
+	})
+	return d.instance
+}
+
 var keyEncoding = base64.RawStdEncoding
 
 func uplinkHash(ctx context.Context, up *ttnpb.UplinkMessage) (string, error) {
@@ -56,31 +76,116 @@ func uplinkHash(ctx context.Context, up *ttnpb.UplinkMessage) (string, error) {
 	), nil
 }
 
-// DeduplicateUplink deduplicates up for window. Since highest precision allowed by Redis is millisecondsm, window is truncated to milliseconds.
+// deduplicationScript elects ARGV[2] to process the uplink at KEYS[1] for ARGV[1] milliseconds and appends ARGV[3:] to the list at KEYS[2].
+// The list expires with the election.
+var deduplicationScript = redis.NewScript(`local ok = redis.call('set', KEYS[1], ARGV[2], 'px', ARGV[1], 'nx')
+if #ARGV > 2 then
+	redis.call('rpush', KEYS[2], unpack(ARGV, 3))
+end
+if ok then
+	redis.call('pexpire', KEYS[2], ARGV[1])
+	return 1
+end
+if redis.call('pttl', KEYS[2]) == -1 then
+	local ttl = redis.call('pttl', KEYS[1])
+	if ttl < 0 then
+		ttl = ARGV[1]
+	end
+	redis.call('pexpire', KEYS[2], ttl)
+end
+return 0`)
+
+// releaseScript deletes the election at KEYS[1] if ARGV[1] is elected.
+var releaseScript = redis.NewScript(`if redis.call('get', KEYS[1]) == ARGV[1] then
+	return redis.call('del', KEYS[1])
+end
+return 0`)
+
+// DeduplicateUplink deduplicates up for window. Since highest precision allowed by Redis is milliseconds, window is truncated to milliseconds.
+// DeduplicateUplink returns true if the instance is elected to process up.
 func (d *UplinkDeduplicator) DeduplicateUplink(ctx context.Context, up *ttnpb.UplinkMessage, window time.Duration) (bool, error) {
 	h, err := uplinkHash(ctx, up)
 	if err != nil {
 		return false, err
 	}
-	msgs := make([]proto.Message, 0, len(up.RxMetadata))
+	args := make([]interface{}, 0, 2+len(up.RxMetadata))
+	args = append(args, window.Milliseconds(), d.instanceID())
 	for _, md := range up.RxMetadata {
-		msgs = append(msgs, md)
+		s, err := ttnredis.MarshalProto(md)
+		if err != nil {
+			return false, err
+		}
+		args = append(args, s)
+	}
+	k := d.Redis.Key(h)
+	v, err := deduplicationScript.Run(d.Redis, []string{ttnredis.DeduplicationLockKey(k), ttnredis.DeduplicationListKey(k)}, args...).Int64()
+	if err != nil {
+		return false, ttnredis.ConvertError(err)
+	}
+	return v == 1, nil
+}
+
+// ReleaseUplink releases the election of the instance to process up, if it is elected.
+// The accumulated metadata is retained, so that the instance that claims up by ClaimUplink processes up with all metadata.
+func (d *UplinkDeduplicator) ReleaseUplink(ctx context.Context, up *ttnpb.UplinkMessage) error {
+	h, err := uplinkHash(ctx, up)
+	if err != nil {
+		return err
+	}
+	if err := releaseScript.Run(d.Redis, []string{ttnredis.DeduplicationLockKey(d.Redis.Key(h))}, d.instanceID()).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// ClaimUplink elects the instance to process up for window, if no instance is elected. This is used to take over the processing
+// of up, which was deduplicated by DeduplicateUplink before, after the elected instance released it by ReleaseUplink.
+// Since highest precision allowed by Redis is milliseconds, window is truncated to milliseconds.
+// ClaimUplink returns true if the instance is elected to process up.
+func (d *UplinkDeduplicator) ClaimUplink(ctx context.Context, up *ttnpb.UplinkMessage, window time.Duration) (bool, error) {
+	if window <= 0 {
+		return false, nil
+	}
+	h, err := uplinkHash(ctx, up)
+	if err != nil {
+		return false, err
+	}
+	ok, err := d.Redis.SetNX(ttnredis.DeduplicationLockKey(d.Redis.Key(h)), d.instanceID(), window).Result()
+	if err != nil {
+		return false, ttnredis.ConvertError(err)
 	}
-	return ttnredis.DeduplicateProtos(ctx, d.Redis, d.Redis.Key(h), window, msgs...)
+	return ok, nil
 }
 
-// DeduplicateUplink returns accumulated metadata for up.
+// AccumulatedMetadata returns accumulated metadata for up.
+// Metadata of the same gateway antenna, that is received by multiple instances, is merged into the metadata with the best signal quality.
 func (d *UplinkDeduplicator) AccumulatedMetadata(ctx context.Context, up *ttnpb.UplinkMessage) ([]*ttnpb.RxMetadata, error) {
 	h, err := uplinkHash(ctx, up)
 	if err != nil {
 		return nil, err
 	}
 	var mds []*ttnpb.RxMetadata
-	return mds, ttnredis.ListProtos(ctx, d.Redis, d.Redis.Key(ttnredis.DeduplicationListKey(h))).Range(func() (proto.Message, func() (bool, error)) {
+	idxs := make(map[string]int)
+	if err := ttnredis.ListProtos(ctx, d.Redis, d.Redis.Key(ttnredis.DeduplicationListKey(h))).Range(func() (proto.Message, func() (bool, error)) {
 		md := &ttnpb.RxMetadata{}
 		return md, func() (bool, error) {
-			mds = append(mds, md)
+			if md.GatewayID == "" {
+				mds = append(mds, md)
+				return true, nil
+			}
+			k := ttnredis.Key(md.GatewayID, strconv.FormatUint(uint64(md.AntennaIndex), 10))
+			i, ok := idxs[k]
+			switch {
+			case !ok:
+				idxs[k] = len(mds)
+				mds = append(mds, md)
+			case md.SNR > mds[i].SNR || md.SNR == mds[i].SNR && md.RSSI > mds[i].RSSI:
+				mds[i] = md
+			}
 			return true, nil
 		}
-	})
+	}); err != nil {
+		return nil, err
+	}
+	return mds, nil
 }
@@ -0,0 +1,187 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var (
+	_ networkserver.UplinkDeduplicator          = &UplinkDeduplicator{}
+	_ networkserver.UplinkDeduplicationReleaser = &UplinkDeduplicator{}
+)
+
+func TestUplinkDeduplicator(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.ContextWithT(test.Context(), t)
+
+	cl, flush := test.NewRedis(t, "networkserver_test", "uplink_deduplication")
+	defer flush()
+	defer cl.Close()
+
+	const n = 8
+	window := test.Delay << 12
+
+	ds := make([]*UplinkDeduplicator, 0, n)
+	for i := 0; i < n; i++ {
+		ds = append(ds, &UplinkDeduplicator{
+			Redis:    cl,
+			Instance: fmt.Sprintf("instance-%d", i),
+		})
+	}
+
+	makeUplink := func(mds ...*ttnpb.RxMetadata) *ttnpb.UplinkMessage {
+		return &ttnpb.UplinkMessage{
+			RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04, 0x00, 0x01, 0x00, 0x01, 0x42, 0x01, 0x02, 0x03, 0x04},
+			Settings: ttnpb.TxSettings{
+				DataRate: ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_LoRa{
+						LoRa: &ttnpb.LoRaDataRate{
+							Bandwidth:       125000,
+							SpreadingFactor: 7,
+						},
+					},
+				},
+				Frequency: 868100000,
+			},
+			RxMetadata: mds,
+		}
+	}
+	makeMetadata := func(gtwID string, snr float32) *ttnpb.RxMetadata {
+		return &ttnpb.RxMetadata{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: gtwID},
+			SNR:                snr,
+		}
+	}
+
+	// deduplicate deduplicates an uplink on all instances concurrently and returns the elected instances.
+	deduplicate := func(round int) []int {
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			elected []int
+		)
+		start := make(chan struct{})
+		for i, d := range ds {
+			i, d := i, d
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				up := makeUplink(
+					makeMetadata(fmt.Sprintf("gateway-%d-%d", round, i), 1),
+					// All instances receive the uplink from the shared gateway with a different SNR.
+					makeMetadata("gateway-shared", float32(i)),
+				)
+				ok, err := d.DeduplicateUplink(ctx, up, window)
+				if !a.So(err, should.BeNil) {
+					return
+				}
+				if ok {
+					mu.Lock()
+					elected = append(elected, i)
+					mu.Unlock()
+				}
+			}()
+		}
+		close(start)
+		wg.Wait()
+		return elected
+	}
+
+	elected := deduplicate(0)
+	if !a.So(elected, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	first := elected[0]
+	other := (first + 1) % n
+
+	mds, err := ds[first].AccumulatedMetadata(ctx, makeUplink())
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(mds, should.HaveLength, n+1)
+	var shared []*ttnpb.RxMetadata
+	for _, md := range mds {
+		if md.GatewayID == "gateway-shared" {
+			shared = append(shared, md)
+		}
+	}
+	if a.So(shared, should.HaveLength, 1) {
+		a.So(shared[0].SNR, should.Equal, float32(n-1))
+	}
+
+	otherMDs, err := ds[other].AccumulatedMetadata(ctx, makeUplink())
+	if a.So(err, should.BeNil) {
+		a.So(otherMDs, should.Resemble, mds)
+	}
+
+	// Instances that are not elected cannot release the uplink.
+	if !a.So(ds[other].ReleaseUplink(ctx, makeUplink()), should.BeNil) {
+		t.FailNow()
+	}
+	ok, err := ds[other].DeduplicateUplink(ctx, makeUplink(), window)
+	if a.So(err, should.BeNil) {
+		a.So(ok, should.BeFalse)
+	}
+
+	// The elected instance hands over the processing of the uplink.
+	if !a.So(ds[first].ReleaseUplink(ctx, makeUplink()), should.BeNil) {
+		t.FailNow()
+	}
+	elected = deduplicate(1)
+	if !a.So(elected, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	mds, err = ds[elected[0]].AccumulatedMetadata(ctx, makeUplink())
+	if a.So(err, should.BeNil) {
+		// The metadata accumulated before the handover is retained.
+		a.So(mds, should.HaveLength, 2*n+1)
+	}
+
+	// Instances that are not elected cannot claim the uplink while another instance is elected.
+	first = elected[0]
+	other = (first + 1) % n
+	ok, err = ds[other].ClaimUplink(ctx, makeUplink(), window)
+	if a.So(err, should.BeNil) {
+		a.So(ok, should.BeFalse)
+	}
+
+	// The instance that claims the uplink first after the release takes over its processing.
+	if !a.So(ds[first].ReleaseUplink(ctx, makeUplink()), should.BeNil) {
+		t.FailNow()
+	}
+	ok, err = ds[other].ClaimUplink(ctx, makeUplink(), window)
+	if a.So(err, should.BeNil) {
+		a.So(ok, should.BeTrue)
+	}
+	ok, err = ds[first].ClaimUplink(ctx, makeUplink(), window)
+	if a.So(err, should.BeNil) {
+		a.So(ok, should.BeFalse)
+	}
+	mds, err = ds[other].AccumulatedMetadata(ctx, makeUplink())
+	if a.So(err, should.BeNil) {
+		a.So(mds, should.HaveLength, 2*n+1)
+	}
+}
@@ -28,6 +28,7 @@ type DeviceRegistry interface {
 	GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error)
 	GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
 	RangeByAddr(ctx context.Context, devAddr types.DevAddr, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error
+	RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error
 	SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
 }
 
@@ -118,6 +119,18 @@ func (w deprecatedDeviceFieldRegistryWrapper) RangeByAddr(ctx context.Context, d
 	})
 }
 
+func (w deprecatedDeviceFieldRegistryWrapper) RangeByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string, f func(context.Context, *ttnpb.EndDevice) bool) error {
+	paths, deprecated := matchDeprecatedDeviceFields(paths, w.fields)
+	return w.registry.RangeByDevEUI(ctx, devEUI, paths, func(ctx context.Context, dev *ttnpb.EndDevice) bool {
+		if dev != nil {
+			for _, d := range deprecated {
+				d.GetTransform(dev)
+			}
+		}
+		return f(ctx, dev)
+	})
+}
+
 func (w deprecatedDeviceFieldRegistryWrapper) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
 	paths, deprecated := matchDeprecatedDeviceFields(paths, w.fields)
 	dev, ctx, err := w.registry.SetByID(ctx, appID, devID, paths, func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
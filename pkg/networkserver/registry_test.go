@@ -193,6 +193,15 @@ func handleRegistryTest(t *testing.T, reg DeviceRegistry) {
 	a.So(err, should.BeNil)
 	a.So(rets, should.HaveSameElementsDiff, []*ttnpb.EndDevice{pb})
 
+	rets = nil
+	err = reg.RangeByDevEUI(ctx, *pb.EndDeviceIdentifiers.DevEUI, ttnpb.EndDeviceFieldPathsTopLevel, func(devCtx context.Context, dev *ttnpb.EndDevice) bool {
+		a.So(devCtx, should.HaveParentContextOrEqual, ctx)
+		rets = append(rets, dev)
+		return true
+	})
+	a.So(err, should.BeNil)
+	a.So(rets, should.HaveSameElementsDiff, []*ttnpb.EndDevice{pb})
+
 	pbOther := CopyEndDevice(pb)
 	pbOther.EndDeviceIdentifiers.DeviceID = "test-dev-other"
 	pbOther.EndDeviceIdentifiers.DevEUI = &types.EUI64{0x43, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
@@ -311,6 +320,15 @@ func handleRegistryTest(t *testing.T, reg DeviceRegistry) {
 	a.So(err, should.BeNil)
 	a.So(rets, should.HaveSameElementsDiff, []*ttnpb.EndDevice{pbOther})
 
+	rets = nil
+	err = reg.RangeByDevEUI(ctx, *pb.EndDeviceIdentifiers.DevEUI, ttnpb.EndDeviceFieldPathsTopLevel, func(devCtx context.Context, dev *ttnpb.EndDevice) bool {
+		a.So(devCtx, should.HaveParentContextOrEqual, ctx)
+		rets = append(rets, dev)
+		return true
+	})
+	a.So(err, should.BeNil)
+	a.So(rets, should.BeNil)
+
 	err = DeleteDevice(ctx, reg, pbOther.EndDeviceIdentifiers.ApplicationIdentifiers, pbOther.EndDeviceIdentifiers.DeviceID)
 	if !a.So(err, should.BeNil) {
 		t.FailNow()
@@ -0,0 +1,549 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// rfRegions maps band IDs to RF regions as defined in LoRaWAN Backend Interfaces specification.
+var rfRegions = map[string]string{
+	band.AS_923:     "AS923",
+	band.AU_915_928: "AU915",
+	band.CN_470_510: "CN470",
+	band.CN_779_787: "CN779",
+	band.EU_433:     "EU433",
+	band.EU_863_870: "EU868",
+	band.IN_865_867: "IN865",
+	band.KR_920_923: "KR920",
+	band.RU_864_870: "RU864",
+	band.US_902_928: "US915",
+}
+
+// rfRegionBand returns the band of the RF region.
+func rfRegionBand(rfRegion string) (band.Band, error) {
+	for id, region := range rfRegions {
+		if region == rfRegion {
+			return band.GetByID(id)
+		}
+	}
+	return band.Band{}, errUnknownRFRegion.WithAttributes("rf_region", rfRegion)
+}
+
+// uplinkRFRegion returns the RF region of the first band, ordered by ID, in which the data rate index of the uplink
+// corresponds to the data rate of the uplink.
+// The band of the gateway is not known to the Network Server, but this allows the receiver to derive the data rate.
+func uplinkRFRegion(settings ttnpb.TxSettings) (string, error) {
+	ids := make([]string, 0, len(rfRegions))
+	for id := range rfRegions {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		phy, err := band.GetByID(id)
+		if err != nil {
+			continue
+		}
+		if dr, ok := phy.DataRates[settings.DataRateIndex]; ok && dr.Rate.Equal(settings.DataRate) {
+			return rfRegions[id], nil
+		}
+	}
+	return "", errRFRegionNotFound.New()
+}
+
+// roamingUplinkTokenPrefix is the prefix of uplink tokens of uplink messages forwarded by a Forwarding Network Server.
+// These uplink tokens contain the NetID of the Forwarding Network Server, followed by its uplink token.
+var roamingUplinkTokenPrefix = []byte("ttn-lw-roaming:")
+
+func roamingUplinkToken(netID types.NetID, token []byte) []byte {
+	b := make([]byte, 0, len(roamingUplinkTokenPrefix)+len(netID)+len(token))
+	b = append(b, roamingUplinkTokenPrefix...)
+	b = append(b, netID[:]...)
+	return append(b, token...)
+}
+
+// parseRoamingUplinkToken returns the NetID of the Forwarding Network Server and its uplink token.
+// parseRoamingUplinkToken returns false if b is not a roaming uplink token.
+func parseRoamingUplinkToken(b []byte) (types.NetID, []byte, bool) {
+	var netID types.NetID
+	if !bytes.HasPrefix(b, roamingUplinkTokenPrefix) || len(b) < len(roamingUplinkTokenPrefix)+len(netID) {
+		return types.NetID{}, nil, false
+	}
+	b = b[len(roamingUplinkTokenPrefix):]
+	copy(netID[:], b)
+	return netID, b[len(netID):], true
+}
+
+// uplinkTokenGatewayIdentifiers returns the identifiers of the gateway of the uplink token issued by the Gateway Server.
+func uplinkTokenGatewayIdentifiers(token []byte) (ttnpb.GatewayIdentifiers, error) {
+	var t ttnpb.UplinkToken
+	if err := t.Unmarshal(token); err != nil {
+		return ttnpb.GatewayIdentifiers{}, err
+	}
+	return t.GatewayIdentifiers, nil
+}
+
+// roamingULMetaData returns the uplink metadata of up to forward to a Serving Network Server.
+// The gateway EUI is used as gateway ID if known, otherwise the gateway ID is used.
+func roamingULMetaData(up *ttnpb.UplinkMessage) (interop.ULMetaData, error) {
+	rfRegion, err := uplinkRFRegion(up.Settings)
+	if err != nil {
+		return interop.ULMetaData{}, err
+	}
+	drIdx := int(up.Settings.DataRateIndex)
+	freq := float64(up.Settings.Frequency) / 1e6
+	gwCnt := len(up.RxMetadata)
+	md := interop.ULMetaData{
+		DataRate: &drIdx,
+		ULFreq:   &freq,
+		RecvTime: up.ReceivedAt,
+		RFRegion: rfRegion,
+		GWCnt:    &gwCnt,
+		GWInfo:   make([]interop.GWInfoElement, 0, len(up.RxMetadata)),
+	}
+	for _, rx := range up.RxMetadata {
+		rssi := int(rx.RSSI)
+		snr := float64(rx.SNR)
+		gw := interop.GWInfoElement{
+			ID:        interop.Buffer(rx.GatewayID),
+			RFRegion:  rfRegion,
+			RSSI:      &rssi,
+			SNR:       &snr,
+			ULToken:   interop.Buffer(rx.UplinkToken),
+			DLAllowed: len(rx.UplinkToken) > 0 && rx.DownlinkPathConstraint != ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+		}
+		if rx.EUI != nil {
+			gw.ID = interop.Buffer(rx.EUI[:])
+		}
+		if loc := rx.Location; loc != nil {
+			lat, lon := loc.Latitude, loc.Longitude
+			gw.Lat, gw.Lon = &lat, &lon
+		}
+		md.GWInfo = append(md.GWInfo, gw)
+	}
+	return md, nil
+}
+
+// roamingUplink returns the uplink message forwarded by the Forwarding Network Server identified by netID.
+// The uplink tokens of the gateways that allow downlink are wrapped, so that downlink is sent through the
+// Forwarding Network Server.
+func roamingUplink(netID types.NetID, req *interop.PRStartReq) (*ttnpb.UplinkMessage, error) {
+	md := req.ULMetaData
+	switch {
+	case md.DataRate == nil:
+		return nil, errRoamingMetadata.WithAttributes("field", "DataRate")
+	case md.ULFreq == nil:
+		return nil, errRoamingMetadata.WithAttributes("field", "ULFreq")
+	}
+	phy, err := rfRegionBand(md.RFRegion)
+	if err != nil {
+		return nil, err
+	}
+	drIdx := ttnpb.DataRateIndex(*md.DataRate)
+	dr, ok := phy.DataRates[drIdx]
+	if !ok {
+		return nil, errDataRateNotFound.New()
+	}
+	up := &ttnpb.UplinkMessage{
+		RawPayload: req.PHYPayload,
+		Settings: ttnpb.TxSettings{
+			DataRate:      dr.Rate,
+			DataRateIndex: drIdx,
+			Frequency:     uint64(math.Round(*md.ULFreq * 1e6)),
+			EnableCRC:     true,
+		},
+		RxMetadata: make([]*ttnpb.RxMetadata, 0, len(md.GWInfo)),
+	}
+	for _, gw := range md.GWInfo {
+		rx := &ttnpb.RxMetadata{}
+		if len(gw.ID) == 8 {
+			eui := types.EUI64{}
+			copy(eui[:], gw.ID)
+			rx.GatewayIdentifiers = ttnpb.GatewayIdentifiers{
+				GatewayID: fmt.Sprintf("eui-%s", strings.ToLower(eui.String())),
+				EUI:       &eui,
+			}
+		} else {
+			rx.GatewayIdentifiers = ttnpb.GatewayIdentifiers{
+				GatewayID: string(gw.ID),
+			}
+		}
+		if gw.RSSI != nil {
+			rx.RSSI = float32(*gw.RSSI)
+			rx.ChannelRSSI = float32(*gw.RSSI)
+		}
+		if gw.SNR != nil {
+			rx.SNR = float32(*gw.SNR)
+		}
+		if gw.Lat != nil && gw.Lon != nil {
+			rx.Location = &ttnpb.Location{
+				Latitude:  *gw.Lat,
+				Longitude: *gw.Lon,
+				Source:    ttnpb.SOURCE_REGISTRY,
+			}
+		}
+		if gw.DLAllowed && len(gw.ULToken) > 0 {
+			rx.UplinkToken = roamingUplinkToken(netID, gw.ULToken)
+		} else {
+			rx.DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER
+		}
+		up.RxMetadata = append(up.RxMetadata, rx)
+	}
+	return up, nil
+}
+
+// roamingDLMetaData returns the downlink metadata of the class A downlink described by req to send through the
+// Forwarding Network Server over paths.
+func roamingDLMetaData(req *ttnpb.TxRequest, paths ...downlinkPath) (*interop.DLMetaData, error) {
+	if req.Class != ttnpb.CLASS_A {
+		return nil, errRoamingClass.WithAttributes("class", req.Class)
+	}
+	rxDelay := int(req.Rx1Delay)
+	md := &interop.DLMetaData{
+		RXDelay1:       &rxDelay,
+		ClassMode:      "A",
+		GWInfo:         make([]interop.GWInfoElement, 0, len(paths)),
+		HiPriorityFlag: req.Priority >= ttnpb.TxSchedulePriority_HIGH,
+	}
+	if req.Rx1Frequency != 0 {
+		freq, drIdx := float64(req.Rx1Frequency)/1e6, int(req.Rx1DataRateIndex)
+		md.DLFreq1, md.DataRate1 = &freq, &drIdx
+	}
+	if req.Rx2Frequency != 0 {
+		freq, drIdx := float64(req.Rx2Frequency)/1e6, int(req.Rx2DataRateIndex)
+		md.DLFreq2, md.DataRate2 = &freq, &drIdx
+	}
+	for _, path := range paths {
+		_, token, ok := parseRoamingUplinkToken(path.GetUplinkToken())
+		if !ok {
+			continue
+		}
+		md.GWInfo = append(md.GWInfo, interop.GWInfoElement{
+			ULToken:   interop.Buffer(token),
+			DLAllowed: true,
+		})
+	}
+	return md, nil
+}
+
+// roamingTxRequest returns the transmission request and the downlink paths of the downlink described by md,
+// which is sent by a Serving Network Server.
+func roamingTxRequest(md *interop.DLMetaData) (*ttnpb.TxRequest, []downlinkPath, error) {
+	if md.ClassMode != "" && md.ClassMode != "A" {
+		return nil, nil, errRoamingClass.WithAttributes("class", md.ClassMode)
+	}
+	req := &ttnpb.TxRequest{
+		Class:    ttnpb.CLASS_A,
+		Priority: ttnpb.TxSchedulePriority_NORMAL,
+	}
+	if md.HiPriorityFlag {
+		req.Priority = ttnpb.TxSchedulePriority_HIGH
+	}
+	if md.RXDelay1 != nil {
+		req.Rx1Delay = ttnpb.RxDelay(*md.RXDelay1)
+	}
+	if md.DLFreq1 != nil && md.DataRate1 != nil {
+		req.Rx1Frequency = uint64(math.Round(*md.DLFreq1 * 1e6))
+		req.Rx1DataRateIndex = ttnpb.DataRateIndex(*md.DataRate1)
+	}
+	if md.DLFreq2 != nil && md.DataRate2 != nil {
+		req.Rx2Frequency = uint64(math.Round(*md.DLFreq2 * 1e6))
+		req.Rx2DataRateIndex = ttnpb.DataRateIndex(*md.DataRate2)
+	}
+	if req.Rx1Frequency == 0 && req.Rx2Frequency == 0 {
+		return nil, nil, errRoamingMetadata.WithAttributes("field", "DLFreq1")
+	}
+	paths := make([]downlinkPath, 0, len(md.GWInfo))
+	for _, gw := range md.GWInfo {
+		if !gw.DLAllowed || len(gw.ULToken) == 0 {
+			continue
+		}
+		ids, err := uplinkTokenGatewayIdentifiers(gw.ULToken)
+		if err != nil {
+			continue
+		}
+		paths = append(paths, downlinkPath{
+			GatewayIdentifiers: ids,
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: gw.ULToken,
+				},
+			},
+		})
+	}
+	if len(paths) == 0 {
+		return nil, nil, errNoPath.New()
+	}
+	return req, paths, nil
+}
+
+type roamingSenderKey struct{}
+
+// newContextWithRoamingSender returns a derived context, which indicates that the uplink message being handled was
+// forwarded by the Forwarding Network Server identified by netID.
+func newContextWithRoamingSender(ctx context.Context, netID types.NetID) context.Context {
+	return context.WithValue(ctx, roamingSenderKey{}, netID)
+}
+
+// roamingSenderFromContext returns the NetID of the Forwarding Network Server that forwarded the uplink message
+// being handled, if any.
+func roamingSenderFromContext(ctx context.Context) (types.NetID, bool) {
+	netID, ok := ctx.Value(roamingSenderKey{}).(types.NetID)
+	return netID, ok
+}
+
+// forwardingNetID returns the NetID of the network that devAddr belongs to, if devAddr is not assigned by this
+// Network Server and the uplink messages of the network are forwarded to its Network Server.
+// Uplink messages that are forwarded by a roaming partner are never forwarded again.
+func (ns *NetworkServer) forwardingNetID(ctx context.Context, devAddr types.DevAddr) (types.NetID, bool) {
+	if ns.interopClient == nil {
+		return types.NetID{}, false
+	}
+	if _, ok := roamingSenderFromContext(ctx); ok {
+		return types.NetID{}, false
+	}
+	for _, prefix := range ns.devAddrPrefixes {
+		if devAddr.HasPrefix(prefix) {
+			return types.NetID{}, false
+		}
+	}
+	netID, agreement, ok := ns.interopClient.RoamingNetID(devAddr)
+	if !ok || !agreement.ForwardUplinks || netID.Equal(ns.netID) {
+		return types.NetID{}, false
+	}
+	return netID, true
+}
+
+const (
+	// homeNetIDTTL is the duration for which the NetID of the Home Network Server of a device is cached.
+	homeNetIDTTL = time.Hour
+	// homeNetIDErrorTTL is the duration for which a failure to determine the Home Network Server of a device is
+	// cached. This limits the number of HomeNS requests for join-requests of devices that are unknown to the
+	// Join Server.
+	homeNetIDErrorTTL = 10 * time.Minute
+)
+
+type homeNetIDCacheKey struct {
+	JoinEUI, DevEUI types.EUI64
+}
+
+type homeNetIDCacheEntry struct {
+	netID     types.NetID
+	err       error
+	expiresAt time.Time
+}
+
+// homeNetIDCache caches the results of HomeNS requests.
+// The zero value is ready to use.
+type homeNetIDCache struct {
+	mu          sync.Mutex
+	entries     map[homeNetIDCacheKey]homeNetIDCacheEntry
+	lastCleanup time.Time
+}
+
+func (c *homeNetIDCache) get(key homeNetIDCacheKey, now time.Time) (homeNetIDCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || !now.Before(entry.expiresAt) {
+		return homeNetIDCacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (c *homeNetIDCache) set(key homeNetIDCacheKey, entry homeNetIDCacheEntry, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[homeNetIDCacheKey]homeNetIDCacheEntry)
+	}
+	if now.Sub(c.lastCleanup) > homeNetIDTTL {
+		for key, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, key)
+			}
+		}
+		c.lastCleanup = now
+	}
+	c.entries[key] = entry
+}
+
+// lookupHomeNetID returns the NetID of the Home Network Server of the device identified by joinEUI and devEUI.
+// HomeNS requests are only sent to the Join Servers that are configured for the JoinEUI, and their results are
+// cached, including failures.
+func (ns *NetworkServer) lookupHomeNetID(ctx context.Context, joinEUI, devEUI types.EUI64) (types.NetID, error) {
+	key := homeNetIDCacheKey{JoinEUI: joinEUI, DevEUI: devEUI}
+	if entry, ok := ns.homeNetIDs.get(key, timeNow()); ok {
+		return entry.netID, entry.err
+	}
+	netID, err := ns.interopClient.HomeNSRequest(ctx, ns.netID, joinEUI, devEUI)
+	if err != nil && ctx.Err() != nil {
+		// Do not cache the result if the request was canceled.
+		return types.NetID{}, err
+	}
+	now := timeNow()
+	entry := homeNetIDCacheEntry{
+		netID:     netID,
+		err:       err,
+		expiresAt: now.Add(homeNetIDTTL),
+	}
+	if err != nil {
+		entry.expiresAt = now.Add(homeNetIDErrorTTL)
+	}
+	ns.homeNetIDs.set(key, entry, now)
+	return netID, err
+}
+
+// homeNetID returns the NetID of the Home Network Server of the device identified by joinEUI and devEUI, if the
+// device is not registered in this Network Server and join-requests of the network are forwarded to its Network Server.
+func (ns *NetworkServer) homeNetID(ctx context.Context, joinEUI, devEUI types.EUI64) (types.NetID, bool) {
+	if ns.interopClient == nil {
+		return types.NetID{}, false
+	}
+	if _, ok := roamingSenderFromContext(ctx); ok {
+		return types.NetID{}, false
+	}
+	netID, err := ns.lookupHomeNetID(ctx, joinEUI, devEUI)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("Failed to determine Home Network Server")
+		return types.NetID{}, false
+	}
+	if netID.Equal(ns.netID) {
+		return types.NetID{}, false
+	}
+	agreement, ok := ns.interopClient.RoamingAgreement(netID)
+	if !ok || !agreement.ForwardUplinks {
+		return types.NetID{}, false
+	}
+	return netID, true
+}
+
+// forwardRoamingUplink forwards up to the Serving Network Server identified by netID in passive roaming.
+// The uplink message is deduplicated first, so that it is forwarded once with the metadata of all gateways.
+func (ns *NetworkServer) forwardRoamingUplink(ctx context.Context, up *ttnpb.UplinkMessage, netID types.NetID, f func(*interop.ULMetaData)) error {
+	logger := log.FromContext(ctx).WithField("roaming_net_id", netID)
+	ctx = log.NewContext(ctx, logger)
+
+	ok, err := ns.deduplicateUplink(ctx, up)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		// NOTE: The uplink is not forwarded yet, so another instance can forward it.
+		ns.releaseUplink(ctx, up)
+		return ctx.Err()
+	case <-ns.deduplicationDone(ctx, up):
+	}
+
+	mds, err := ns.uplinkDeduplicator.AccumulatedMetadata(ctx, up)
+	if err != nil {
+		logger.WithError(err).Error("Failed to merge metadata")
+	} else {
+		up.RxMetadata = mds
+		registerMergeMetadata(ctx, up)
+	}
+
+	md, err := roamingULMetaData(up)
+	if err != nil {
+		return err
+	}
+	if f != nil {
+		f(&md)
+	}
+	logger.Debug("Forward uplink to roaming partner")
+	if _, err := ns.interopClient.PRStartRequest(ctx, ns.netID, &interop.PRStartReq{
+		NsMessageHeader: interop.NsMessageHeader{
+			ReceiverID: interop.NetID(netID),
+		},
+		PHYPayload: interop.Buffer(up.RawPayload),
+		ULMetaData: md,
+	}); err != nil {
+		logger.WithError(err).Warn("Roaming partner did not accept uplink")
+		return err
+	}
+	registerForwardRoamingUplink(ctx, up)
+	return nil
+}
+
+// scheduleRoamingDownlink attempts to schedule payload b using parameters in req through the Forwarding Network
+// Server that forwarded the uplink message of the first of paths.
+func (ns *NetworkServer) scheduleRoamingDownlink(ctx context.Context, req *ttnpb.TxRequest, b []byte, paths ...downlinkPath) (*scheduledDownlink, error) {
+	if ns.interopClient == nil || len(paths) == 0 {
+		return nil, errNoPath.New()
+	}
+	netID, _, _ := parseRoamingUplinkToken(paths[0].GetUplinkToken())
+	netIDPaths := make([]downlinkPath, 0, len(paths))
+	for _, path := range paths {
+		if pathNetID, _, ok := parseRoamingUplinkToken(path.GetUplinkToken()); ok && pathNetID.Equal(netID) {
+			netIDPaths = append(netIDPaths, path)
+		}
+	}
+	md, err := roamingDLMetaData(req, netIDPaths...)
+	if err != nil {
+		return nil, err
+	}
+	req.DownlinkPaths = make([]*ttnpb.DownlinkPath, 0, len(netIDPaths))
+	for _, path := range netIDPaths {
+		req.DownlinkPaths = append(req.DownlinkPaths, path.DownlinkPath)
+	}
+	down := &ttnpb.DownlinkMessage{
+		RawPayload:     b,
+		CorrelationIDs: events.CorrelationIDsFromContext(ctx),
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: req,
+		},
+	}
+
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"path_count", len(netIDPaths),
+		"roaming_net_id", netID,
+	))
+	logger.Debug("Schedule downlink through roaming partner")
+	if _, err := ns.interopClient.XmitDataRequest(ctx, ns.netID, &interop.XmitDataReq{
+		NsMessageHeader: interop.NsMessageHeader{
+			ReceiverID: interop.NetID(netID),
+		},
+		PHYPayload: interop.Buffer(b),
+		DLMetaData: md,
+	}); err != nil {
+		return nil, err
+	}
+	// The Forwarding Network Server does not report the transmission time, so the downlink is assumed to be
+	// transmitted immediately.
+	transmitAt := timeNow()
+	logger.WithField("transmit_at", transmitAt).Debug("Scheduled downlink through roaming partner")
+	return &scheduledDownlink{
+		Message:    down,
+		TransmitAt: transmitAt,
+	}, nil
+}
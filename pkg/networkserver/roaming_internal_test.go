@@ -0,0 +1,469 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/cluster"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestRoamingUplinkToken(t *testing.T) {
+	a := assertions.New(t)
+
+	netID := types.NetID{0x00, 0x00, 0x13}
+	token := roamingUplinkToken(netID, []byte{0x01, 0x02, 0x03})
+
+	parsedNetID, parsedToken, ok := parseRoamingUplinkToken(token)
+	a.So(ok, should.BeTrue)
+	a.So(parsedNetID, should.Equal, netID)
+	a.So(parsedToken, should.Resemble, []byte{0x01, 0x02, 0x03})
+
+	_, _, ok = parseRoamingUplinkToken([]byte{0x01, 0x02, 0x03})
+	a.So(ok, should.BeFalse)
+	_, _, ok = parseRoamingUplinkToken(roamingUplinkTokenPrefix)
+	a.So(ok, should.BeFalse)
+}
+
+func TestRoamingUplink(t *testing.T) {
+	a := assertions.New(t)
+
+	gsToken, err := (&ttnpb.UplinkToken{
+		GatewayAntennaIdentifiers: ttnpb.GatewayAntennaIdentifiers{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+				GatewayID: "test-gtw",
+			},
+		},
+		Timestamp: 42,
+	}).Marshal()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	eui := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	receivedAt := time.Unix(0, 42).UTC()
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+		Settings: ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LoRa{
+					LoRa: &ttnpb.LoRaDataRate{
+						SpreadingFactor: 9,
+						Bandwidth:       125000,
+					},
+				},
+			},
+			DataRateIndex: ttnpb.DATA_RATE_3,
+			Frequency:     868300000,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+					GatewayID: "test-gtw",
+					EUI:       &eui,
+				},
+				RSSI:        -42,
+				SNR:         5.5,
+				UplinkToken: gsToken,
+			},
+			{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+					GatewayID: "test-gtw-no-downlink",
+				},
+				RSSI:                   -100,
+				SNR:                    -2,
+				UplinkToken:            gsToken,
+				DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+			},
+		},
+		ReceivedAt: receivedAt,
+	}
+
+	md, err := roamingULMetaData(up)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(*md.GWCnt, should.Equal, 2)
+	a.So(md.RecvTime.Equal(receivedAt), should.BeTrue)
+	a.So(md.GWInfo, should.HaveLength, 2)
+	a.So(md.GWInfo[0].ID, should.Resemble, interop.Buffer(eui[:]))
+	a.So(md.GWInfo[0].DLAllowed, should.BeTrue)
+	a.So(md.GWInfo[1].ID, should.Resemble, interop.Buffer("test-gtw-no-downlink"))
+	a.So(md.GWInfo[1].DLAllowed, should.BeFalse)
+
+	netID := types.NetID{0x00, 0x00, 0x13}
+	roamed, err := roamingUplink(netID, &interop.PRStartReq{
+		PHYPayload: interop.Buffer(up.RawPayload),
+		ULMetaData: md,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(roamed.RawPayload, should.Resemble, up.RawPayload)
+	a.So(roamed.Settings.DataRate, should.Resemble, up.Settings.DataRate)
+	a.So(roamed.Settings.DataRateIndex, should.Equal, up.Settings.DataRateIndex)
+	a.So(roamed.Settings.Frequency, should.Equal, up.Settings.Frequency)
+	if a.So(roamed.RxMetadata, should.HaveLength, 2) {
+		a.So(roamed.RxMetadata[0].GatewayIdentifiers, should.Resemble, ttnpb.GatewayIdentifiers{
+			GatewayID: "eui-4242424242424242",
+			EUI:       &eui,
+		})
+		a.So(roamed.RxMetadata[0].RSSI, should.Equal, float32(-42))
+		a.So(roamed.RxMetadata[0].SNR, should.Equal, float32(5.5))
+		a.So(roamed.RxMetadata[0].UplinkToken, should.Resemble, roamingUplinkToken(netID, gsToken))
+		a.So(roamed.RxMetadata[1].GatewayID, should.Equal, "test-gtw-no-downlink")
+		a.So(roamed.RxMetadata[1].UplinkToken, should.BeEmpty)
+		a.So(roamed.RxMetadata[1].DownlinkPathConstraint, should.Equal, ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER)
+	}
+
+	paths := downlinkPathsFromMetadata(roamed.RxMetadata...)
+	if !a.So(paths, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	dlMD, err := roamingDLMetaData(&ttnpb.TxRequest{
+		Class:            ttnpb.CLASS_A,
+		Rx1Delay:         ttnpb.RX_DELAY_1,
+		Rx1DataRateIndex: ttnpb.DATA_RATE_3,
+		Rx1Frequency:     868300000,
+		Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+		Rx2Frequency:     869525000,
+		Priority:         ttnpb.TxSchedulePriority_HIGHEST,
+	}, paths...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(dlMD.HiPriorityFlag, should.BeTrue)
+	a.So(dlMD.GWInfo, should.Resemble, []interop.GWInfoElement{
+		{
+			ULToken:   interop.Buffer(gsToken),
+			DLAllowed: true,
+		},
+	})
+
+	req, fwdPaths, err := roamingTxRequest(dlMD)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(req, should.Resemble, &ttnpb.TxRequest{
+		Class:            ttnpb.CLASS_A,
+		Rx1Delay:         ttnpb.RX_DELAY_1,
+		Rx1DataRateIndex: ttnpb.DATA_RATE_3,
+		Rx1Frequency:     868300000,
+		Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+		Rx2Frequency:     869525000,
+		Priority:         ttnpb.TxSchedulePriority_HIGH,
+	})
+	if a.So(fwdPaths, should.HaveLength, 1) {
+		a.So(fwdPaths[0].GatewayIdentifiers, should.Resemble, ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"})
+		a.So(fwdPaths[0].GetUplinkToken(), should.Resemble, gsToken)
+	}
+
+	_, err = roamingDLMetaData(&ttnpb.TxRequest{Class: ttnpb.CLASS_C}, paths...)
+	a.So(err, should.HaveSameErrorDefinitionAs, errRoamingClass)
+}
+
+func TestHomeNetIDCache(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	clock := test.NewMockClock(time.Unix(0, 42).UTC())
+	defer SetMockClock(clock)()
+
+	homeNetID := types.NetID{0x00, 0x00, 0x13}
+	knownDevEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	joinEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x00}
+
+	var requests int
+	ns := &NetworkServer{
+		netID: types.NetID{0x00, 0x00, 0x42},
+		interopClient: MockInteropClient{
+			HomeNSRequestFunc: func(_ context.Context, _ types.NetID, _, devEUI types.EUI64) (types.NetID, error) {
+				requests++
+				if devEUI.Equal(knownDevEUI) {
+					return homeNetID, nil
+				}
+				return types.NetID{}, interop.ErrUnknownDevEUI.New()
+			},
+			RoamingAgreementFunc: func(netID types.NetID) (interop.RoamingAgreement, bool) {
+				return interop.RoamingAgreement{ForwardUplinks: true}, netID.Equal(homeNetID)
+			},
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		netID, ok := ns.homeNetID(ctx, joinEUI, knownDevEUI)
+		a.So(ok, should.BeTrue)
+		a.So(netID, should.Equal, homeNetID)
+
+		_, ok = ns.homeNetID(ctx, joinEUI, types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x43})
+		a.So(ok, should.BeFalse)
+	}
+	a.So(requests, should.Equal, 2)
+
+	// Failures expire before successes.
+	clock.Add(homeNetIDErrorTTL)
+	ns.homeNetID(ctx, joinEUI, knownDevEUI)
+	ns.homeNetID(ctx, joinEUI, types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x43})
+	a.So(requests, should.Equal, 3)
+
+	clock.Add(homeNetIDTTL)
+	ns.homeNetID(ctx, joinEUI, knownDevEUI)
+	a.So(requests, should.Equal, 4)
+
+	// Uplink messages forwarded by a roaming partner are not forwarded again.
+	_, ok := ns.homeNetID(newContextWithRoamingSender(ctx, homeNetID), joinEUI, types.EUI64{0x01})
+	a.So(ok, should.BeFalse)
+	a.So(requests, should.Equal, 4)
+}
+
+func TestPassiveRoaming(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.ContextWithT(test.Context(), t)
+	ctx, cancel := context.WithTimeout(ctx, (1<<7)*test.Delay)
+	defer cancel()
+
+	fNetID := types.NetID{0x00, 0x00, 0x13}
+	sNetID := types.NetID{0x00, 0x00, 0x42}
+
+	newComponent := func(gs cluster.Peer) *component.Component {
+		c := component.MustNew(
+			log.Noop,
+			&component.Config{},
+			component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+				return &test.MockCluster{
+					JoinFunc: test.ClusterJoinNilFunc,
+					AuthFunc: func() grpc.CallOption { return grpc.EmptyCallOption{} },
+					GetPeerFunc: func(_ context.Context, role ttnpb.ClusterRole, _ ttnpb.Identifiers) (cluster.Peer, error) {
+						if !a.So(role, should.Equal, ttnpb.ClusterRole_GATEWAY_SERVER) || gs == nil {
+							return nil, errTestGatewayServerUnavailable.New()
+						}
+						return gs, nil
+					},
+				}, nil
+			}),
+		)
+		componenttest.StartComponent(t, c)
+		return c
+	}
+
+	scheduleDownlinkCh := make(chan *ttnpb.DownlinkMessage, 1)
+	gsPeer := NewGSPeer(ctx, &MockNsGsServer{
+		ScheduleDownlinkFunc: func(_ context.Context, msg *ttnpb.DownlinkMessage) (*ttnpb.ScheduleDownlinkResponse, error) {
+			scheduleDownlinkCh <- msg
+			return &ttnpb.ScheduleDownlinkResponse{
+				Delay: time.Second,
+			}, nil
+		},
+	})
+
+	sDevAddr, err := types.NewDevAddr(sNetID, []byte{0x01, 0x02, 0x03})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	sDevAddrPrefix := types.DevAddrPrefix{
+		DevAddr: sDevAddr,
+		Length:  uint8(32 - types.NwkAddrBits(sNetID)),
+	}
+	fDevAddr, err := types.NewDevAddr(fNetID, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	var fNS, sNS *NetworkServer
+	var forwarded *interop.PRStartReq
+	fNS = &NetworkServer{
+		Component: newComponent(gsPeer),
+		ctx:       ctx,
+		netID:     fNetID,
+		devAddrPrefixes: []types.DevAddrPrefix{
+			{
+				DevAddr: fDevAddr,
+				Length:  uint8(32 - types.NwkAddrBits(fNetID)),
+			},
+		},
+		deduplicationWindow: makeWindowDurationFunc(test.Delay),
+		collectionWindow:    makeWindowDurationFunc(2 * test.Delay),
+		uplinkDeduplicator: &MockUplinkDeduplicator{
+			DeduplicateUplinkFunc: func(context.Context, *ttnpb.UplinkMessage, time.Duration) (bool, error) {
+				return true, nil
+			},
+			AccumulatedMetadataFunc: func(_ context.Context, up *ttnpb.UplinkMessage) ([]*ttnpb.RxMetadata, error) {
+				return up.RxMetadata, nil
+			},
+		},
+		interopClient: MockInteropClient{
+			RoamingAgreementFunc: func(netID types.NetID) (interop.RoamingAgreement, bool) {
+				return interop.RoamingAgreement{ForwardUplinks: true}, netID.Equal(sNetID)
+			},
+			RoamingNetIDFunc: func(devAddr types.DevAddr) (types.NetID, interop.RoamingAgreement, bool) {
+				if !devAddr.HasPrefix(sDevAddrPrefix) {
+					return types.NetID{}, interop.RoamingAgreement{}, false
+				}
+				return sNetID, interop.RoamingAgreement{ForwardUplinks: true}, true
+			},
+			PRStartRequestFunc: func(ctx context.Context, netID types.NetID, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+				a.So(netID, should.Equal, fNetID)
+				a.So(types.NetID(req.ReceiverID), should.Equal, sNetID)
+				req.MessageType = interop.MessageTypePRStartReq
+				req.SenderID = interop.NetID(netID)
+				forwarded = req
+				return sNS.interop.PRStartRequest(ctx, req)
+			},
+		},
+	}
+	fNS.interop = interopServer{NS: fNS}
+
+	var rangeByAddrCalls int
+	sNS = &NetworkServer{
+		Component:       newComponent(nil),
+		ctx:             ctx,
+		netID:           sNetID,
+		devAddrPrefixes: []types.DevAddrPrefix{sDevAddrPrefix},
+		devices: MockDeviceRegistry{
+			RangeByAddrFunc: func(ctx context.Context, devAddr types.DevAddr, _ []string, _ func(context.Context, *ttnpb.EndDevice) bool) error {
+				rangeByAddrCalls++
+				a.So(devAddr, should.Equal, sDevAddr)
+				netID, ok := roamingSenderFromContext(ctx)
+				a.So(ok, should.BeTrue)
+				a.So(netID, should.Equal, fNetID)
+				return nil
+			},
+		},
+		interopClient: MockInteropClient{
+			RoamingAgreementFunc: func(netID types.NetID) (interop.RoamingAgreement, bool) {
+				return interop.RoamingAgreement{AcceptUplinks: true}, netID.Equal(fNetID)
+			},
+			XmitDataRequestFunc: func(ctx context.Context, netID types.NetID, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+				a.So(netID, should.Equal, sNetID)
+				a.So(types.NetID(req.ReceiverID), should.Equal, fNetID)
+				req.MessageType = interop.MessageTypeXmitDataReq
+				req.SenderID = interop.NetID(netID)
+				return fNS.interop.XmitDataRequest(ctx, req)
+			},
+		},
+	}
+	sNS.interop = interopServer{NS: sNS}
+
+	phyPayload, err := lorawan.MarshalMessage(ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_UNCONFIRMED_UP,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_MACPayload{
+			MACPayload: &ttnpb.MACPayload{
+				FHDR: ttnpb.FHDR{
+					DevAddr: sDevAddr,
+					FCnt:    42,
+				},
+				FPort:      1,
+				FRMPayload: []byte{0x01, 0x02, 0x03},
+			},
+		},
+		MIC: []byte{0x01, 0x02, 0x03, 0x04},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	gsToken, err := (&ttnpb.UplinkToken{
+		GatewayAntennaIdentifiers: ttnpb.GatewayAntennaIdentifiers{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+				GatewayID: "test-gtw",
+			},
+		},
+		Timestamp: 42,
+	}).Marshal()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The Forwarding Network Server forwards the uplink message to the Serving Network Server, which does not know the
+	// device and rejects the uplink message.
+	err = fNS.handleUplink(ctx, &ttnpb.UplinkMessage{
+		RawPayload: phyPayload,
+		Settings: ttnpb.TxSettings{
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LoRa{
+					LoRa: &ttnpb.LoRaDataRate{
+						SpreadingFactor: 9,
+						Bandwidth:       125000,
+					},
+				},
+			},
+			DataRateIndex: ttnpb.DATA_RATE_3,
+			Frequency:     868300000,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+					GatewayID: "test-gtw",
+				},
+				RSSI:        -42,
+				SNR:         5.5,
+				UplinkToken: gsToken,
+			},
+		},
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrUnknownDevAddr)
+	a.So(rangeByAddrCalls, should.Equal, 1)
+	if !a.So(forwarded, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(forwarded.PHYPayload, should.Resemble, interop.Buffer(phyPayload))
+
+	// The Serving Network Server schedules a downlink message through the Forwarding Network Server, which schedules it
+	// on the gateway that received the uplink message.
+	up, err := roamingUplink(fNetID, forwarded)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	down, err := sNS.scheduleDownlinkByPaths(ctx, &ttnpb.TxRequest{
+		Class:            ttnpb.CLASS_A,
+		Priority:         ttnpb.TxSchedulePriority_NORMAL,
+		Rx1Delay:         ttnpb.RX_DELAY_1,
+		Rx1DataRateIndex: ttnpb.DATA_RATE_3,
+		Rx1Frequency:     868300000,
+	}, []byte{0x60, 0x42}, downlinkPathsFromMetadata(up.RxMetadata...)...)
+	if !a.So(err, should.BeNil) || !a.So(down, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(down.Message.RawPayload, should.Resemble, []byte{0x60, 0x42})
+
+	select {
+	case <-ctx.Done():
+		t.Fatal("Timed out while waiting for downlink to be scheduled")
+	case msg := <-scheduleDownlinkCh:
+		a.So(msg.RawPayload, should.Resemble, []byte{0x60, 0x42})
+		a.So(msg.GetRequest().GetClass(), should.Equal, ttnpb.CLASS_A)
+		a.So(msg.GetRequest().GetRx1Frequency(), should.Equal, uint64(868300000))
+		a.So(msg.GetRequest().GetRx1DataRateIndex(), should.Equal, ttnpb.DATA_RATE_3)
+		if a.So(msg.GetRequest().GetDownlinkPaths(), should.HaveLength, 1) {
+			a.So(msg.GetRequest().GetDownlinkPaths()[0].GetUplinkToken(), should.Resemble, gsToken)
+		}
+	}
+}
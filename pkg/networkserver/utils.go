@@ -179,7 +179,7 @@ func needsClassADataDownlinkAt(ctx context.Context, dev *ttnpb.EndDevice, t time
 		return true
 	}
 	for _, down := range dev.Session.QueuedApplicationDownlinks {
-		if down.GetClassBC() == nil {
+		if down.GetClassBC() == nil && (down.NotBefore == nil || !down.NotBefore.After(t)) {
 			return true
 		}
 	}
@@ -384,7 +384,18 @@ func nextDataDownlinkAt(ctx context.Context, dev *ttnpb.EndDevice, phy band.Band
 
 	var absTime time.Time
 	for _, down := range dev.Session.QueuedApplicationDownlinks {
+		if down.ExpiresAt != nil && down.ExpiresAt.Before(earliestAt) {
+			// This downlink will be dropped on next downlink attempt, hence continue to next one.
+			continue
+		}
 		if down.ClassBC == nil || down.ClassBC.AbsoluteTime == nil || down.ClassBC.AbsoluteTime.IsZero() {
+			if down.NotBefore != nil && down.NotBefore.After(earliestAt) {
+				// NOTE: MAC commands may be sent before the downlink can be transmitted.
+				if deviceNeedsMACRequestsAt(ctx, dev, earliestConfirmedAt, phy, defaults) {
+					return earliestConfirmedAt, class, true
+				}
+				return nextDataDownlinkAt(ctx, dev, phy, defaults, *down.NotBefore)
+			}
 			if down.Confirmed || deviceNeedsMACRequestsAt(ctx, dev, earliestAt, phy, defaults) {
 				return earliestConfirmedAt, class, true
 			}
@@ -727,5 +738,117 @@ outerUp:
 		}
 	}
 
+	desiredExtraChannels := dev.GetMACSettings().GetDesiredExtraChannels()
+	if len(desiredExtraChannels) == 0 {
+		desiredExtraChannels = defaults.DesiredExtraChannels
+	}
+	if phy.CFListType == ttnpb.CFListType_FREQUENCIES {
+	outerExtra:
+		for _, extraCh := range desiredExtraChannels {
+			downlinkFrequency := extraCh.DownlinkFrequency
+			if downlinkFrequency == 0 {
+				downlinkFrequency = extraCh.UplinkFrequency
+			}
+			for _, ch := range macState.DesiredParameters.Channels {
+				if ch.UplinkFrequency == extraCh.UplinkFrequency {
+					ch.MinDataRateIndex = extraCh.MinDataRateIndex
+					ch.MaxDataRateIndex = extraCh.MaxDataRateIndex
+					ch.DownlinkFrequency = downlinkFrequency
+					ch.EnableUplink = extraCh.EnableUplink
+					continue outerExtra
+				}
+			}
+			if len(macState.DesiredParameters.Channels) >= int(phy.MaxUplinkChannels) {
+				return nil, errInvalidFieldValue.WithAttributes("field", "mac_settings.desired_extra_channels")
+			}
+			macState.DesiredParameters.Channels = append(macState.DesiredParameters.Channels, &ttnpb.MACParameters_Channel{
+				MinDataRateIndex:  extraCh.MinDataRateIndex,
+				MaxDataRateIndex:  extraCh.MaxDataRateIndex,
+				UplinkFrequency:   extraCh.UplinkFrequency,
+				DownlinkFrequency: downlinkFrequency,
+				EnableUplink:      extraCh.EnableUplink,
+			})
+		}
+	}
+
+	desiredChannelMask := dev.GetMACSettings().GetDesiredChannelMask()
+	if len(desiredChannelMask) == 0 {
+		desiredChannelMask = defaults.DesiredChannelMask
+	}
+	for i, enabled := range desiredChannelMask {
+		if i >= len(macState.DesiredParameters.Channels) {
+			break
+		}
+		macState.DesiredParameters.Channels[i].EnableUplink = enabled
+	}
+
+	minDataRateIndex, maxDataRateIndex := deviceDesiredDataRateRange(dev, phy, defaults)
+	if macState.DesiredParameters.ADRDataRateIndex < minDataRateIndex {
+		macState.DesiredParameters.ADRDataRateIndex = minDataRateIndex
+	} else if macState.DesiredParameters.ADRDataRateIndex > maxDataRateIndex {
+		macState.DesiredParameters.ADRDataRateIndex = maxDataRateIndex
+	}
+
 	return macState, nil
 }
+
+// pendingMACParameters returns the paths of the MAC parameters, which differ between current and desired parameters of macState.
+func pendingMACParameters(macState *ttnpb.MACState) []string {
+	if macState == nil {
+		return nil
+	}
+	cur, des := macState.CurrentParameters, macState.DesiredParameters
+
+	var paths []string
+	if !channelsEqual(cur.Channels, des.Channels) {
+		paths = append(paths, "channels")
+	}
+	for _, p := range []struct {
+		path  string
+		equal bool
+	}{
+		{"adr_ack_delay_exponent", cur.ADRAckDelayExponent.GetValue() == des.ADRAckDelayExponent.GetValue()},
+		{"adr_ack_limit_exponent", cur.ADRAckLimitExponent.GetValue() == des.ADRAckLimitExponent.GetValue()},
+		{"adr_data_rate_index", cur.ADRDataRateIndex == des.ADRDataRateIndex},
+		{"adr_nb_trans", cur.ADRNbTrans == des.ADRNbTrans},
+		{"adr_tx_power_index", cur.ADRTxPowerIndex == des.ADRTxPowerIndex},
+		{"beacon_frequency", cur.BeaconFrequency == des.BeaconFrequency},
+		{"downlink_dwell_time", cur.DownlinkDwellTime.GetValue() == des.DownlinkDwellTime.GetValue()},
+		{"max_duty_cycle", cur.MaxDutyCycle == des.MaxDutyCycle},
+		{"max_eirp", cur.MaxEIRP == des.MaxEIRP},
+		{"ping_slot_data_rate_index_value", cur.PingSlotDataRateIndexValue.GetValue() == des.PingSlotDataRateIndexValue.GetValue()},
+		{"ping_slot_frequency", cur.PingSlotFrequency == des.PingSlotFrequency},
+		{"rx1_data_rate_offset", cur.Rx1DataRateOffset == des.Rx1DataRateOffset},
+		{"rx1_delay", cur.Rx1Delay == des.Rx1Delay},
+		{"rx2_data_rate_index", cur.Rx2DataRateIndex == des.Rx2DataRateIndex},
+		{"rx2_frequency", cur.Rx2Frequency == des.Rx2Frequency},
+		{"uplink_dwell_time", cur.UplinkDwellTime.GetValue() == des.UplinkDwellTime.GetValue()},
+	} {
+		if !p.equal {
+			paths = append(paths, p.path)
+		}
+	}
+	return paths
+}
+
+func channelsEqual(a, b []*ttnpb.MACParameters_Channel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] == nil || b[i] == nil {
+			if a[i] != b[i] {
+				return false
+			}
+			continue
+		}
+		if a[i].UplinkFrequency != b[i].UplinkFrequency ||
+			a[i].DownlinkFrequency != b[i].DownlinkFrequency ||
+			a[i].MinDataRateIndex != b[i].MinDataRateIndex ||
+			a[i].MaxDataRateIndex != b[i].MaxDataRateIndex ||
+			a[i].EnableUplink != b[i].EnableUplink {
+			return false
+		}
+	}
+	return true
+}
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/band"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
@@ -59,6 +60,69 @@ func TestNewMACState(t *testing.T) {
 			}(),
 			FrequencyPlanStore: frequencyplans.NewStore(test.FrequencyPlansFetcher),
 		},
+		{
+			Name: "1.0.2/EU868/desired channels and data rate range",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANVersion:    ttnpb.MAC_V1_0_2,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACSettings: &ttnpb.MACSettings{
+					DesiredChannelMask: []bool{true, false},
+					DesiredExtraChannels: []*ttnpb.MACParameters_Channel{
+						{
+							UplinkFrequency:  868800000,
+							MinDataRateIndex: ttnpb.DATA_RATE_0,
+							MaxDataRateIndex: ttnpb.DATA_RATE_5,
+							EnableUplink:     true,
+						},
+					},
+					DesiredMinDataRateIndex: &ttnpb.DataRateIndexValue{
+						Value: ttnpb.DATA_RATE_2,
+					},
+				},
+			},
+			MACState: func() *ttnpb.MACState {
+				macState := MakeDefaultEU868MACState(ttnpb.CLASS_A, ttnpb.MAC_V1_0_2, ttnpb.PHY_V1_0_2_REV_B)
+				macState.DesiredParameters.Channels[1].EnableUplink = false
+				macState.DesiredParameters.Channels = append(macState.DesiredParameters.Channels, &ttnpb.MACParameters_Channel{
+					UplinkFrequency:   868800000,
+					DownlinkFrequency: 868800000,
+					MinDataRateIndex:  ttnpb.DATA_RATE_0,
+					MaxDataRateIndex:  ttnpb.DATA_RATE_5,
+					EnableUplink:      true,
+				})
+				macState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_2
+				return macState
+			}(),
+			FrequencyPlanStore: frequencyplans.NewStore(test.FrequencyPlansFetcher),
+		},
+		{
+			Name: "1.0.2/EU868/too many desired extra channels",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANVersion:    ttnpb.MAC_V1_0_2,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACSettings: &ttnpb.MACSettings{
+					DesiredExtraChannels: func() []*ttnpb.MACParameters_Channel {
+						// The frequency plan defines 8 channels, so 9 extra channels exceed the maximum of 16.
+						chs := make([]*ttnpb.MACParameters_Channel, 0, 9)
+						for i := 0; i < 9; i++ {
+							chs = append(chs, &ttnpb.MACParameters_Channel{
+								UplinkFrequency:  863100000 + uint64(i)*200000,
+								MinDataRateIndex: ttnpb.DATA_RATE_0,
+								MaxDataRateIndex: ttnpb.DATA_RATE_5,
+								EnableUplink:     true,
+							})
+						}
+						return chs
+					}(),
+				},
+			},
+			FrequencyPlanStore: frequencyplans.NewStore(test.FrequencyPlansFetcher),
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.HaveSameErrorDefinitionAs, errInvalidFieldValue)
+			},
+		},
 		{
 			Name: "1.0.2/EU868/multicast/class A",
 			Device: &ttnpb.EndDevice{
@@ -488,6 +552,209 @@ func TestNextPingSlotAt(t *testing.T) {
 	}
 }
 
+func TestNeedsClassADataDownlinkAt(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Device   *ttnpb.EndDevice
+		At       time.Time
+		Expected bool
+	}{
+		{
+			Name:     "no session",
+			Device:   &ttnpb.EndDevice{},
+			At:       time.Unix(45, 0),
+			Expected: false,
+		},
+		{
+			Name: "downlink without NotBefore",
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{
+					StatusTimePeriodicity:  DurationPtr(0),
+					StatusCountPeriodicity: &pbtypes.UInt32Value{Value: 0},
+				},
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					LoRaWANVersion:     ttnpb.MAC_V1_0_3,
+					DeviceClass:        ttnpb.CLASS_A,
+					RxWindowsAvailable: true,
+					RecentUplinks: []*ttnpb.UplinkMessage{
+						{
+							Payload: &ttnpb.Message{
+								MHDR: ttnpb.MHDR{
+									MType: ttnpb.MType_UNCONFIRMED_UP,
+								},
+								Payload: &ttnpb.Message_MACPayload{
+									MACPayload: &ttnpb.MACPayload{},
+								},
+							},
+							ReceivedAt: time.Unix(41, 0),
+						},
+					},
+				},
+				Session: &ttnpb.Session{
+					DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							FPort:      1,
+							FRMPayload: []byte("test"),
+							Confirmed:  false,
+						},
+					},
+				},
+			},
+			At:       time.Unix(45, 0),
+			Expected: true,
+		},
+		{
+			Name: "downlink with NotBefore before transmission",
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{
+					StatusTimePeriodicity:  DurationPtr(0),
+					StatusCountPeriodicity: &pbtypes.UInt32Value{Value: 0},
+				},
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					LoRaWANVersion:     ttnpb.MAC_V1_0_3,
+					DeviceClass:        ttnpb.CLASS_A,
+					RxWindowsAvailable: true,
+					RecentUplinks: []*ttnpb.UplinkMessage{
+						{
+							Payload: &ttnpb.Message{
+								MHDR: ttnpb.MHDR{
+									MType: ttnpb.MType_UNCONFIRMED_UP,
+								},
+								Payload: &ttnpb.Message_MACPayload{
+									MACPayload: &ttnpb.MACPayload{},
+								},
+							},
+							ReceivedAt: time.Unix(41, 0),
+						},
+					},
+				},
+				Session: &ttnpb.Session{
+					DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							FPort:      1,
+							FRMPayload: []byte("test"),
+							NotBefore:  timePtr(time.Unix(43, 0)),
+						},
+					},
+				},
+			},
+			At:       time.Unix(45, 0),
+			Expected: true,
+		},
+		{
+			Name: "downlink with NotBefore at transmission",
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{
+					StatusTimePeriodicity:  DurationPtr(0),
+					StatusCountPeriodicity: &pbtypes.UInt32Value{Value: 0},
+				},
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					LoRaWANVersion:     ttnpb.MAC_V1_0_3,
+					DeviceClass:        ttnpb.CLASS_A,
+					RxWindowsAvailable: true,
+					RecentUplinks: []*ttnpb.UplinkMessage{
+						{
+							Payload: &ttnpb.Message{
+								MHDR: ttnpb.MHDR{
+									MType: ttnpb.MType_UNCONFIRMED_UP,
+								},
+								Payload: &ttnpb.Message_MACPayload{
+									MACPayload: &ttnpb.MACPayload{},
+								},
+							},
+							ReceivedAt: time.Unix(41, 0),
+						},
+					},
+				},
+				Session: &ttnpb.Session{
+					DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							FPort:      1,
+							FRMPayload: []byte("test"),
+							NotBefore:  timePtr(time.Unix(45, 0)),
+						},
+					},
+				},
+			},
+			At:       time.Unix(45, 0),
+			Expected: true,
+		},
+		{
+			Name: "downlink with NotBefore after transmission",
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{
+					StatusTimePeriodicity:  DurationPtr(0),
+					StatusCountPeriodicity: &pbtypes.UInt32Value{Value: 0},
+				},
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					LoRaWANVersion:     ttnpb.MAC_V1_0_3,
+					DeviceClass:        ttnpb.CLASS_A,
+					RxWindowsAvailable: true,
+					RecentUplinks: []*ttnpb.UplinkMessage{
+						{
+							Payload: &ttnpb.Message{
+								MHDR: ttnpb.MHDR{
+									MType: ttnpb.MType_UNCONFIRMED_UP,
+								},
+								Payload: &ttnpb.Message_MACPayload{
+									MACPayload: &ttnpb.MACPayload{},
+								},
+							},
+							ReceivedAt: time.Unix(41, 0),
+						},
+					},
+				},
+				Session: &ttnpb.Session{
+					DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							FPort:      1,
+							FRMPayload: []byte("test"),
+							NotBefore:  timePtr(time.Unix(100, 0)),
+						},
+					},
+				},
+			},
+			At:       time.Unix(45, 0),
+			Expected: false,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ctx := log.NewContext(test.Context(), test.GetLogger(t))
+			a.So(needsClassADataDownlinkAt(ctx, tc.Device, tc.At, test.Must(band.GetByID(band.EU_863_870)).(band.Band), ttnpb.MACSettings{}), should.Equal, tc.Expected)
+		})
+	}
+}
+
 func TestNextDataDownlinkAt(t *testing.T) {
 	nextPingSlotAt := func(ctx context.Context, dev *ttnpb.EndDevice, earliestAt time.Time) time.Time {
 		pingSlotAt, ok := nextPingSlotAt(ctx, dev, earliestAt)
@@ -696,6 +963,186 @@ func TestNextDataDownlinkAt(t *testing.T) {
 			ExpectedClass: ttnpb.CLASS_C,
 			ExpectedOk:    true,
 		},
+		{
+			Name:       "unicast/class A/Rx1,Rx2 available/downlink with NotBefore before Rx1",
+			EarliestAt: time.Unix(42, 0),
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{
+					StatusTimePeriodicity:  DurationPtr(0),
+					StatusCountPeriodicity: &pbtypes.UInt32Value{Value: 0},
+				},
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					LoRaWANVersion:     ttnpb.MAC_V1_0_3,
+					DeviceClass:        ttnpb.CLASS_A,
+					RxWindowsAvailable: true,
+					RecentUplinks: []*ttnpb.UplinkMessage{
+						{
+							Payload: &ttnpb.Message{
+								MHDR: ttnpb.MHDR{
+									MType: ttnpb.MType_UNCONFIRMED_UP,
+								},
+								Payload: &ttnpb.Message_MACPayload{
+									MACPayload: &ttnpb.MACPayload{},
+								},
+							},
+							ReceivedAt: time.Unix(41, 0),
+						},
+					},
+				},
+				Session: &ttnpb.Session{
+					DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							FPort:      1,
+							FRMPayload: []byte("test"),
+							NotBefore:  timePtr(time.Unix(43, 0)),
+						},
+					},
+				},
+			},
+			ExpectedTime:  time.Unix(41+4, 0).Add(-infrastructureDelay / 2),
+			ExpectedClass: ttnpb.CLASS_A,
+			ExpectedOk:    true,
+		},
+		{
+			Name:       "unicast/class A/Rx1,Rx2 available/downlink with NotBefore after Rx2",
+			EarliestAt: time.Unix(42, 0),
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{
+					StatusTimePeriodicity:  DurationPtr(0),
+					StatusCountPeriodicity: &pbtypes.UInt32Value{Value: 0},
+				},
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					LoRaWANVersion:     ttnpb.MAC_V1_0_3,
+					DeviceClass:        ttnpb.CLASS_A,
+					RxWindowsAvailable: true,
+					RecentUplinks: []*ttnpb.UplinkMessage{
+						{
+							Payload: &ttnpb.Message{
+								MHDR: ttnpb.MHDR{
+									MType: ttnpb.MType_UNCONFIRMED_UP,
+								},
+								Payload: &ttnpb.Message_MACPayload{
+									MACPayload: &ttnpb.MACPayload{},
+								},
+							},
+							ReceivedAt: time.Unix(41, 0),
+						},
+					},
+				},
+				Session: &ttnpb.Session{
+					DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							FPort:      1,
+							FRMPayload: []byte("test"),
+							NotBefore:  timePtr(time.Unix(100, 0)),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:       "unicast/class C/Rx windows closed/expired downlink",
+			EarliestAt: time.Unix(42, 0),
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{
+					StatusTimePeriodicity:  DurationPtr(0),
+					StatusCountPeriodicity: &pbtypes.UInt32Value{Value: 0},
+				},
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					LoRaWANVersion: ttnpb.MAC_V1_0_3,
+					DeviceClass:    ttnpb.CLASS_C,
+					RecentUplinks: []*ttnpb.UplinkMessage{
+						{
+							Payload: &ttnpb.Message{
+								MHDR: ttnpb.MHDR{
+									MType: ttnpb.MType_UNCONFIRMED_UP,
+								},
+								Payload: &ttnpb.Message_MACPayload{
+									MACPayload: &ttnpb.MACPayload{},
+								},
+							},
+							ReceivedAt: time.Unix(41, 0),
+						},
+					},
+				},
+				Session: &ttnpb.Session{
+					DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							FPort:      1,
+							FRMPayload: []byte("test"),
+							ExpiresAt:  timePtr(time.Unix(10, 0)),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:       "unicast/class C/Rx windows closed/downlink with NotBefore",
+			EarliestAt: time.Unix(42, 0),
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{
+					StatusTimePeriodicity:  DurationPtr(0),
+					StatusCountPeriodicity: &pbtypes.UInt32Value{Value: 0},
+				},
+				MACState: &ttnpb.MACState{
+					CurrentParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Rx1Delay: ttnpb.RX_DELAY_4,
+					},
+					LoRaWANVersion: ttnpb.MAC_V1_0_3,
+					DeviceClass:    ttnpb.CLASS_C,
+					RecentUplinks: []*ttnpb.UplinkMessage{
+						{
+							Payload: &ttnpb.Message{
+								MHDR: ttnpb.MHDR{
+									MType: ttnpb.MType_UNCONFIRMED_UP,
+								},
+								Payload: &ttnpb.Message_MACPayload{
+									MACPayload: &ttnpb.MACPayload{},
+								},
+							},
+							ReceivedAt: time.Unix(41, 0),
+						},
+					},
+				},
+				Session: &ttnpb.Session{
+					DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							FPort:      1,
+							FRMPayload: []byte("test"),
+							NotBefore:  timePtr(time.Unix(100, 0)),
+						},
+					},
+				},
+			},
+			ExpectedTime:  time.Unix(100, 0),
+			ExpectedClass: ttnpb.CLASS_C,
+			ExpectedOk:    true,
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
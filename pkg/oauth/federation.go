@@ -0,0 +1,510 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	echo "github.com/labstack/echo/v4"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"golang.org/x/oauth2"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// FederatedProviderConfig is the configuration of an external OpenID Connect provider.
+type FederatedProviderConfig struct {
+	Name         string   `name:"name" description:"Name of the provider that is shown to users"`
+	Issuer       string   `name:"issuer" description:"Issuer URL of the provider, used for discovery of its endpoints and keys"`
+	ClientID     string   `name:"client-id" description:"OAuth client ID at the provider"`
+	ClientSecret string   `name:"client-secret" description:"OAuth client secret at the provider"`
+	Scopes       []string `name:"scopes" description:"Scopes to request in addition to openid, profile and email"`
+	CreateUsers  bool     `name:"create-users" description:"Create users at their first login with this provider"`
+}
+
+// FederationConfig is the configuration for login via external OpenID Connect providers.
+type FederationConfig struct {
+	Providers        map[string]FederatedProviderConfig `name:"providers" file-only:"true" description:"External OpenID Connect providers by ID"`
+	DefaultUserState string                             `name:"default-user-state" description:"State of users that are created at their first login (requested, approved)"`
+}
+
+// userState returns the state of users that are created at their first login.
+func (c FederationConfig) userState() (ttnpb.State, error) {
+	switch c.DefaultUserState {
+	case "", "approved":
+		return ttnpb.STATE_APPROVED, nil
+	case "requested":
+		return ttnpb.STATE_REQUESTED, nil
+	default:
+		return 0, errFederatedUserState.WithAttributes("state", c.DefaultUserState)
+	}
+}
+
+// federatedProviderInfo is the information about a provider that is passed to the frontend.
+type federatedProviderInfo struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (c FederationConfig) providerInfo() []federatedProviderInfo {
+	if len(c.Providers) == 0 {
+		return nil
+	}
+	res := make([]federatedProviderInfo, 0, len(c.Providers))
+	for id, provider := range c.Providers {
+		name := provider.Name
+		if name == "" {
+			name = id
+		}
+		res = append(res, federatedProviderInfo{ID: id, Name: name})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+const (
+	// federatedLoginTimeout is the time that a user has to log in at the provider.
+	federatedLoginTimeout = 10 * time.Minute
+	// idTokenLeeway is the clock skew that is accepted when validating ID tokens.
+	idTokenLeeway = time.Minute
+)
+
+var (
+	errFederatedProviderNotFound = errors.DefineNotFound("federated_provider_not_found", "provider `{provider}` not found")
+	errFederatedDiscovery        = errors.DefineUnavailable("federated_discovery", "discovery of provider `{provider}` failed")
+	errFederatedLoginNotStarted  = errors.DefineInvalidArgument("federated_login_not_started", "login with provider not started")
+	errFederatedLoginExpired     = errors.DefineInvalidArgument("federated_login_expired", "login with provider expired")
+	errFederatedState            = errors.DefinePermissionDenied("federated_state", "invalid state")
+	errFederatedProviderError    = errors.DefinePermissionDenied("federated_provider_error", "provider returned error `{error}`", "description")
+	errFederatedExchange         = errors.DefinePermissionDenied("federated_exchange", "could not exchange authorization code with provider")
+	errFederatedNoIDToken        = errors.DefinePermissionDenied("federated_no_id_token", "provider did not return an ID token")
+	errFederatedIDToken          = errors.DefinePermissionDenied("federated_id_token", "invalid ID token")
+	errFederatedIDTokenKey       = errors.DefinePermissionDenied("federated_id_token_key", "unknown ID token key `{kid}`")
+	errFederatedNonce            = errors.DefinePermissionDenied("federated_nonce", "invalid ID token nonce")
+	errFederatedUserNotFound     = errors.DefinePermissionDenied("federated_user_not_found", "no user found for account at provider `{provider}`")
+	errFederatedEmail            = errors.DefinePermissionDenied("federated_email", "provider did not return an email address")
+	errFederatedUserID           = errors.DefineAborted("federated_user_id", "could not find an available user ID for `{user_id}`")
+	errFederatedUserState        = errors.DefineInvalidArgument("federated_user_state", "invalid default user state `{state}`")
+	errFederatedUserRequested    = errors.DefinePermissionDenied("federated_user_requested", "user account approval is pending")
+	errFederatedUserRejected     = errors.DefinePermissionDenied("federated_user_rejected", "user account was rejected")
+	errFederatedUserSuspended    = errors.DefinePermissionDenied("federated_user_suspended", "user account was suspended")
+)
+
+// checkFederatedUserState returns an error if the user can not login via an external provider in its current state.
+func checkFederatedUserState(user *ttnpb.User) error {
+	switch user.State {
+	case ttnpb.STATE_REQUESTED:
+		return errFederatedUserRequested.New()
+	case ttnpb.STATE_REJECTED:
+		return errFederatedUserRejected.New()
+	case ttnpb.STATE_SUSPENDED:
+		return errFederatedUserSuspended.New()
+	default:
+		return nil
+	}
+}
+
+var federationHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// federatedProvider is an external OpenID Connect provider with its discovered endpoints and keys.
+type federatedProvider struct {
+	id     string
+	config FederatedProviderConfig
+
+	mu            sync.Mutex
+	authURL       string
+	tokenURL      string
+	jwksURL       string
+	keys          *jose.JSONWebKeySet
+	keysFetchedAt time.Time
+}
+
+type openIDConfiguration struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+func getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	res, err := federationHTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return errors.FromHTTPStatusCode(res.StatusCode)
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+// discover discovers the endpoints of the provider, if not already done.
+func (p *federatedProvider) discover(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.authURL != "" {
+		return nil
+	}
+	var conf openIDConfiguration
+	wellKnown := strings.TrimSuffix(p.config.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := getJSON(ctx, wellKnown, &conf); err != nil {
+		return errFederatedDiscovery.WithCause(err).WithAttributes("provider", p.id)
+	}
+	if conf.Issuer != p.config.Issuer || conf.AuthorizationEndpoint == "" || conf.TokenEndpoint == "" || conf.JWKSURI == "" {
+		return errFederatedDiscovery.WithAttributes("provider", p.id)
+	}
+	p.authURL, p.tokenURL, p.jwksURL = conf.AuthorizationEndpoint, conf.TokenEndpoint, conf.JWKSURI
+	return nil
+}
+
+// key returns the key with the given ID. The keys are fetched again if the key is unknown,
+// as the provider may have rotated its keys.
+func (p *federatedProvider) key(ctx context.Context, kid string) (*jose.JSONWebKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.keys != nil {
+		if keys := p.keys.Key(kid); len(keys) > 0 {
+			return &keys[0], nil
+		}
+		if time.Since(p.keysFetchedAt) < time.Minute {
+			return nil, errFederatedIDTokenKey.WithAttributes("kid", kid)
+		}
+	}
+	var keys jose.JSONWebKeySet
+	if err := getJSON(ctx, p.jwksURL, &keys); err != nil {
+		return nil, errFederatedDiscovery.WithCause(err).WithAttributes("provider", p.id)
+	}
+	p.keys, p.keysFetchedAt = &keys, time.Now()
+	if keys := p.keys.Key(kid); len(keys) > 0 {
+		return &keys[0], nil
+	}
+	return nil, errFederatedIDTokenKey.WithAttributes("kid", kid)
+}
+
+func (p *federatedProvider) oauth2Config(redirectURL string) *oauth2.Config {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  p.authURL,
+			TokenURL: p.tokenURL,
+		},
+		RedirectURL: redirectURL,
+		Scopes:      append([]string{"openid", "profile", "email"}, p.config.Scopes...),
+	}
+}
+
+// verifyIDToken verifies the signature and the claims of the ID token.
+func (p *federatedProvider) verifyIDToken(ctx context.Context, rawIDToken, nonce string) (*idTokenClaims, error) {
+	token, err := jwt.ParseSigned(rawIDToken)
+	if err != nil {
+		return nil, errFederatedIDToken.WithCause(err)
+	}
+	if len(token.Headers) != 1 {
+		return nil, errFederatedIDToken.New()
+	}
+	key, err := p.key(ctx, token.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+	var claims idTokenClaims
+	if err := token.Claims(key, &claims); err != nil {
+		return nil, errFederatedIDToken.WithCause(err)
+	}
+	if err := claims.ValidateWithLeeway(jwt.Expected{
+		Issuer:   p.config.Issuer,
+		Audience: jwt.Audience{p.config.ClientID},
+		Time:     time.Now(),
+	}, idTokenLeeway); err != nil {
+		return nil, errFederatedIDToken.WithCause(err)
+	}
+	if claims.Subject == "" {
+		return nil, errFederatedIDToken.New()
+	}
+	if claims.Nonce != nonce {
+		return nil, errFederatedNonce.New()
+	}
+	return &claims, nil
+}
+
+func (s *server) federatedProvider(id string) (*federatedProvider, error) {
+	s.federatedProvidersMu.Lock()
+	defer s.federatedProvidersMu.Unlock()
+	if provider, ok := s.federatedProviders[id]; ok {
+		return provider, nil
+	}
+	config, ok := s.config.Federation.Providers[id]
+	if !ok {
+		return nil, errFederatedProviderNotFound.WithAttributes("provider", id)
+	}
+	provider := &federatedProvider{id: id, config: config}
+	if s.federatedProviders == nil {
+		s.federatedProviders = make(map[string]*federatedProvider)
+	}
+	s.federatedProviders[id] = provider
+	return provider, nil
+}
+
+func (s *server) federatedRedirectURL(provider string) string {
+	return fmt.Sprintf("%s/login/federated/%s/callback", strings.TrimSuffix(s.config.UI.CanonicalURL, "/"), url.PathEscape(provider))
+}
+
+// federatedLoginCookie is the login of a user that was redirected to an external provider.
+type federatedLoginCookie struct {
+	Provider  string    `json:"provider"`
+	State     string    `json:"state"`
+	Nonce     string    `json:"nonce"`
+	Next      string    `json:"next,omitempty"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// FederatedLogin redirects the user to the external provider.
+func (s *server) FederatedLogin(c echo.Context) error {
+	ctx := c.Request().Context()
+	provider, err := s.federatedProvider(c.Param("provider"))
+	if err != nil {
+		return err
+	}
+	if err := provider.discover(ctx); err != nil {
+		return err
+	}
+	state, err := randomString()
+	if err != nil {
+		return err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return err
+	}
+	err = s.updateAuthCookie(c, func(cookie *authCookie) error {
+		cookie.Federated = &federatedLoginCookie{
+			Provider:  provider.id,
+			State:     state,
+			Nonce:     nonce,
+			Next:      c.QueryParam(nextKey),
+			StartedAt: time.Now(),
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	authCodeURL := provider.oauth2Config(s.federatedRedirectURL(provider.id)).
+		AuthCodeURL(state, oauth2.SetAuthURLParam("nonce", nonce))
+	return c.Redirect(http.StatusFound, authCodeURL)
+}
+
+var userIDInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// federatedUserID returns a user ID that is based on the preferred username or email address of the user.
+func federatedUserID(claims *idTokenClaims) string {
+	base := claims.PreferredUsername
+	if base == "" {
+		base = strings.SplitN(claims.Email, "@", 2)[0]
+	}
+	base = strings.Trim(userIDInvalidChars.ReplaceAllString(strings.ToLower(base), "-"), "-")
+	if len(base) > 32 {
+		base = strings.TrimRight(base[:32], "-")
+	}
+	for len(base) < 3 {
+		base += "0"
+	}
+	return base
+}
+
+// createFederatedUser creates a user for the account at the provider, and links it to that account.
+func (s *server) createFederatedUser(ctx context.Context, provider *federatedProvider, claims *idTokenClaims) (*ttnpb.User, error) {
+	if claims.Email == "" {
+		return nil, errFederatedEmail.New()
+	}
+	state, err := s.config.Federation.userState()
+	if err != nil {
+		return nil, err
+	}
+	base := federatedUserID(claims)
+	var userIDs *ttnpb.UserIdentifiers
+	for i := 1; i <= 10; i++ {
+		candidate := ttnpb.UserIdentifiers{UserID: base}
+		if i > 1 {
+			candidate.UserID = fmt.Sprintf("%s-%d", base, i)
+		}
+		if err := candidate.ValidateContext(ctx); err != nil {
+			return nil, err
+		}
+		_, err := s.store.GetUser(ctx, &candidate, nil)
+		if errors.IsNotFound(err) {
+			userIDs = &candidate
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if userIDs == nil {
+		return nil, errFederatedUserID.WithAttributes("user_id", base)
+	}
+	now := time.Now()
+	user := &ttnpb.User{
+		UserIdentifiers:     *userIDs,
+		Name:                claims.Name,
+		PrimaryEmailAddress: claims.Email,
+		PasswordUpdatedAt:   &now,
+		State:               state,
+	}
+	if claims.EmailVerified {
+		user.PrimaryEmailAddressValidatedAt = &now
+	}
+	if _, err := s.store.CreateUser(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := s.store.CreateExternalUser(ctx, userIDs, provider.id, claims.Subject); err != nil {
+		return nil, err
+	}
+	events.Publish(evtUserCreateFederated(ctx, userIDs, nil))
+	log.FromContext(ctx).WithFields(log.Fields(
+		"provider", provider.id,
+		"user_id", userIDs.UserID,
+	)).Info("Created user for account at provider")
+	return user, nil
+}
+
+// FederatedLoginCallback handles the redirect of the external provider, validates the ID token and
+// logs in the user that is linked to the account at the provider.
+func (s *server) FederatedLoginCallback(c echo.Context) error {
+	ctx := c.Request().Context()
+	cookie, err := s.getAuthCookie(c)
+	if err != nil || cookie.Federated == nil {
+		return errFederatedLoginNotStarted.New()
+	}
+	pending := cookie.Federated
+	if err := s.updateAuthCookie(c, func(cookie *authCookie) error {
+		cookie.Federated = nil
+		return nil
+	}); err != nil {
+		return err
+	}
+	if time.Since(pending.StartedAt) > federatedLoginTimeout {
+		return errFederatedLoginExpired.New()
+	}
+	if pending.Provider != c.Param("provider") || pending.State == "" || c.QueryParam("state") != pending.State {
+		return errFederatedState.New()
+	}
+	if errCode := c.QueryParam("error"); errCode != "" {
+		return errFederatedProviderError.WithAttributes("error", errCode, "description", c.QueryParam("error_description"))
+	}
+	provider, err := s.federatedProvider(pending.Provider)
+	if err != nil {
+		return err
+	}
+	if err := provider.discover(ctx); err != nil {
+		return err
+	}
+	token, err := provider.oauth2Config(s.federatedRedirectURL(provider.id)).Exchange(
+		context.WithValue(ctx, oauth2.HTTPClient, federationHTTPClient),
+		c.QueryParam("code"),
+	)
+	if err != nil {
+		return errFederatedExchange.WithCause(err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return errFederatedNoIDToken.New()
+	}
+	claims, err := provider.verifyIDToken(ctx, rawIDToken, pending.Nonce)
+	if err != nil {
+		return err
+	}
+	var user *ttnpb.User
+	userIDs, err := s.store.GetExternalUser(ctx, provider.id, claims.Subject)
+	switch {
+	case err == nil:
+		user, err = s.store.GetUser(ctx, userIDs, &types.FieldMask{Paths: []string{"admin", "state"}})
+		if err != nil {
+			return err
+		}
+	case !errors.IsNotFound(err):
+		return err
+	case !provider.config.CreateUsers:
+		return errFederatedUserNotFound.WithAttributes("provider", provider.id)
+	default:
+		if user, err = s.createFederatedUser(ctx, provider, claims); err != nil {
+			return err
+		}
+		userIDs = &user.UserIdentifiers
+	}
+	if err := checkFederatedUserState(user); err != nil {
+		return err
+	}
+	next := pending.Next
+	if next == "" {
+		next = s.config.UI.MountPath()
+	}
+	creds, err := s.usableMFACredentials(ctx, *userIDs)
+	if err != nil {
+		return err
+	}
+	if len(creds) > 0 || s.config.MFA.Required(user) {
+		// The login page asks for the second factor of the pending login.
+		if _, err := s.beginMFALogin(c, user, creds); err != nil {
+			return err
+		}
+		values := make(url.Values)
+		values.Set("mfa", "true")
+		values.Set(nextKey, next)
+		return c.Redirect(http.StatusFound, fmt.Sprintf("%s?%s", path.Join(s.config.UI.MountPath(), "login"), values.Encode()))
+	}
+	if err := s.finishLogin(c, *userIDs); err != nil {
+		return err
+	}
+	nextURL, err := url.Parse(next)
+	if err != nil {
+		return err
+	}
+	if nextURL.RawQuery == "" {
+		return c.Redirect(http.StatusFound, nextURL.Path)
+	}
+	return c.Redirect(http.StatusFound, fmt.Sprintf("%s?%s", nextURL.Path, nextURL.RawQuery))
+}
@@ -0,0 +1,311 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/oauth"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/webui"
+	"golang.org/x/net/publicsuffix"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// mockProvider is a stand-in for an external OpenID Connect provider.
+type mockProvider struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	nonce  string
+	claims map[string]interface{}
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &mockProvider{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.URL,
+			"authorization_endpoint": p.URL + "/authorize",
+			"token_endpoint":         p.URL + "/token",
+			"jwks_uri":               p.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{
+			Key:       &p.key.PublicKey,
+			KeyID:     "key",
+			Algorithm: string(jose.RS256),
+			Use:       "sig",
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok {
+			clientID, clientSecret = r.FormValue("client_id"), r.FormValue("client_secret")
+		}
+		if clientID != "client" || clientSecret != "secret" || r.FormValue("code") != "code" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		signer, err := jose.NewSigner(
+			jose.SigningKey{Algorithm: jose.RS256, Key: p.key},
+			(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "key"),
+		)
+		if err != nil {
+			panic(err)
+		}
+		now := time.Now()
+		claims := map[string]interface{}{
+			"iss":   p.URL,
+			"aud":   "client",
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+			"nonce": p.nonce,
+		}
+		for k, v := range p.claims {
+			claims[k] = v
+		}
+		idToken, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		if err != nil {
+			panic(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "bearer",
+			"expires_in":   3600,
+			"id_token":     idToken,
+		})
+	})
+	p.Server = httptest.NewServer(mux)
+	return p
+}
+
+func TestFederatedLogin(t *testing.T) {
+	ctx := test.Context()
+	provider := newMockProvider(t)
+	defer provider.Close()
+
+	store := &mockStore{}
+	jar, err := cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	if err != nil {
+		panic(err)
+	}
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Cookie: config.Cookie{
+					HashKey:  []byte("12345678123456781234567812345678"),
+					BlockKey: []byte("12345678123456781234567812345678"),
+				},
+			},
+		},
+	})
+	s := oauth.NewServer(ctx, store, oauth.Config{
+		Mount: "/oauth",
+		UI: oauth.UIConfig{
+			TemplateData: webui.TemplateData{
+				SiteName:     "The Things Network",
+				Title:        "OAuth",
+				CanonicalURL: "https://example.com/oauth",
+			},
+		},
+		Federation: oauth.FederationConfig{
+			Providers: map[string]oauth.FederatedProviderConfig{
+				"corp": {
+					Name:         "Corp",
+					Issuer:       provider.URL,
+					ClientID:     "client",
+					ClientSecret: "secret",
+					CreateUsers:  true,
+				},
+			},
+		},
+	})
+	c.RegisterWeb(s)
+	componenttest.StartComponent(t, c)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.URL.Scheme, req.URL.Host = "http", req.Host
+		for _, c := range jar.Cookies(req.URL) {
+			req.AddCookie(c)
+		}
+		res := httptest.NewRecorder()
+		c.ServeHTTP(res, req)
+		if cookies := res.Result().Cookies(); len(cookies) > 0 {
+			jar.SetCookies(req.URL, cookies)
+		}
+		return res
+	}
+
+	// startLogin starts the login and returns the state that the provider should return.
+	startLogin := func(t *testing.T) string {
+		a := assertions.New(t)
+		res := get("/oauth/login/federated/corp?n=" + url.QueryEscape("/oauth/authorize?client_id=client"))
+		if !a.So(res.Code, should.Equal, http.StatusFound) {
+			t.FailNow()
+		}
+		location, err := url.Parse(res.Header().Get("location"))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(location.Scheme+"://"+location.Host+location.Path, should.Equal, provider.URL+"/authorize")
+		query := location.Query()
+		a.So(query.Get("client_id"), should.Equal, "client")
+		a.So(query.Get("redirect_uri"), should.Equal, "https://example.com/oauth/login/federated/corp/callback")
+		a.So(query.Get("scope"), should.Equal, "openid profile email")
+		a.So(query.Get("nonce"), should.NotBeEmpty)
+		a.So(query.Get("state"), should.NotBeEmpty)
+		provider.nonce = query.Get("nonce")
+		return query.Get("state")
+	}
+
+	t.Run("Unknown provider", func(t *testing.T) {
+		res := get("/oauth/login/federated/unknown")
+		assertions.New(t).So(res.Code, should.Equal, http.StatusNotFound)
+	})
+
+	t.Run("Callback without login", func(t *testing.T) {
+		res := get("/oauth/login/federated/corp/callback?code=code&state=state")
+		assertions.New(t).So(res.Code, should.Equal, http.StatusBadRequest)
+	})
+
+	t.Run("Invalid state", func(t *testing.T) {
+		store.reset()
+		startLogin(t)
+		res := get("/oauth/login/federated/corp/callback?code=code&state=invalid")
+		a := assertions.New(t)
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+		a.So(store.calls, should.BeEmpty)
+	})
+
+	t.Run("Invalid nonce", func(t *testing.T) {
+		store.reset()
+		state := startLogin(t)
+		provider.nonce = "invalid"
+		provider.claims = map[string]interface{}{"sub": "subject"}
+		res := get("/oauth/login/federated/corp/callback?code=code&state=" + url.QueryEscape(state))
+		a := assertions.New(t)
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+		a.So(store.calls, should.BeEmpty)
+	})
+
+	approvedUser := &ttnpb.User{
+		UserIdentifiers: ttnpb.UserIdentifiers{UserID: "user"},
+		State:           ttnpb.STATE_APPROVED,
+	}
+
+	t.Run("Existing user", func(t *testing.T) {
+		store.reset()
+		store.res.externalUser = &ttnpb.UserIdentifiers{UserID: "user"}
+		store.res.user = approvedUser
+		store.res.session = mockSession
+		state := startLogin(t)
+		provider.claims = map[string]interface{}{"sub": "subject"}
+		res := get("/oauth/login/federated/corp/callback?code=code&state=" + url.QueryEscape(state))
+		a := assertions.New(t)
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(res.Header().Get("location"), should.Equal, "/oauth/authorize?client_id=client")
+		a.So(store.calls, should.Resemble, []string{"GetExternalUser", "GetUser", "FindMFACredentials", "CreateSession"})
+		a.So(store.req.provider, should.Equal, "corp")
+		a.So(store.req.subject, should.Equal, "subject")
+		a.So(store.req.session.UserID, should.Equal, "user")
+	})
+
+	t.Run("Existing user with second factor", func(t *testing.T) {
+		store.reset()
+		store.res.externalUser = &ttnpb.UserIdentifiers{UserID: "user"}
+		store.res.user = approvedUser
+		store.res.mfaCredentials = mockMFACredentials
+		state := startLogin(t)
+		provider.claims = map[string]interface{}{"sub": "subject"}
+		res := get("/oauth/login/federated/corp/callback?code=code&state=" + url.QueryEscape(state))
+		a := assertions.New(t)
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(res.Header().Get("location"), should.Equal, "/oauth/login?"+url.Values{
+			"mfa": []string{"true"},
+			"n":   []string{"/oauth/authorize?client_id=client"},
+		}.Encode())
+		a.So(store.calls, should.Resemble, []string{"GetExternalUser", "GetUser", "FindMFACredentials", "CreateMFALogin"})
+	})
+
+	t.Run("Suspended user", func(t *testing.T) {
+		store.reset()
+		store.res.externalUser = &ttnpb.UserIdentifiers{UserID: "user"}
+		store.res.user = &ttnpb.User{
+			UserIdentifiers: ttnpb.UserIdentifiers{UserID: "user"},
+			State:           ttnpb.STATE_SUSPENDED,
+		}
+		state := startLogin(t)
+		provider.claims = map[string]interface{}{"sub": "subject"}
+		res := get("/oauth/login/federated/corp/callback?code=code&state=" + url.QueryEscape(state))
+		a := assertions.New(t)
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+		a.So(store.calls, should.Resemble, []string{"GetExternalUser", "GetUser"})
+	})
+
+	t.Run("New user", func(t *testing.T) {
+		store.reset()
+		store.err.getExternalUser = mockErrNotFound
+		store.err.getUser = mockErrNotFound
+		store.res.session = &ttnpb.UserSession{
+			UserIdentifiers: ttnpb.UserIdentifiers{UserID: "john-doe"},
+			SessionID:       "session_id",
+		}
+		state := startLogin(t)
+		provider.claims = map[string]interface{}{
+			"sub":                "other-subject",
+			"email":              "john@example.com",
+			"email_verified":     true,
+			"name":               "John Doe",
+			"preferred_username": "John.Doe",
+		}
+		res := get("/oauth/login/federated/corp/callback?code=code&state=" + url.QueryEscape(state))
+		a := assertions.New(t)
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(store.calls, should.Resemble, []string{"GetExternalUser", "GetUser", "CreateUser", "CreateExternalUser", "FindMFACredentials", "CreateSession"})
+		if a.So(store.req.user, should.NotBeNil) {
+			a.So(store.req.user.UserID, should.Equal, "john-doe")
+			a.So(store.req.user.Name, should.Equal, "John Doe")
+			a.So(store.req.user.PrimaryEmailAddress, should.Equal, "john@example.com")
+			a.So(store.req.user.PrimaryEmailAddressValidatedAt, should.NotBeNil)
+			a.So(store.req.user.State, should.Equal, ttnpb.STATE_APPROVED)
+		}
+		a.So(store.req.userIDs.GetUserID(), should.Equal, "john-doe")
+		a.So(store.req.subject, should.Equal, "other-subject")
+	})
+}
@@ -0,0 +1,481 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"time"
+
+	echo "github.com/labstack/echo/v4"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/auth/totp"
+	"go.thethings.network/lorawan-stack/pkg/auth/webauthn"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// MFAConfig is the configuration for two-factor authentication of user logins.
+type MFAConfig struct {
+	Require    string         `name:"require" description:"Require two-factor authentication for admins or for all users (admins, all)"`
+	TOTPIssuer string         `name:"totp-issuer" description:"Issuer name that authenticator apps show for TOTP second factors"`
+	WebAuthn   WebAuthnConfig `name:"webauthn"`
+}
+
+// WebAuthnConfig is the configuration for WebAuthn second factors.
+type WebAuthnConfig struct {
+	RPID   string `name:"rp-id" description:"WebAuthn relying party ID, which is the domain name of the OAuth server"`
+	RPName string `name:"rp-name" description:"WebAuthn relying party name that is shown to users"`
+	Origin string `name:"origin" description:"Origin of the OAuth server (for example https://example.com)"`
+}
+
+// Enabled returns whether WebAuthn second factors can be used.
+func (c WebAuthnConfig) Enabled() bool {
+	return c.RPID != "" && c.Origin != ""
+}
+
+// RelyingParty returns the WebAuthn relying party.
+func (c WebAuthnConfig) RelyingParty() webauthn.RelyingParty {
+	return webauthn.RelyingParty{
+		ID:     c.RPID,
+		Origin: c.Origin,
+	}
+}
+
+// Required returns whether the user is required to use two-factor authentication.
+func (c MFAConfig) Required(user *ttnpb.User) bool {
+	switch c.Require {
+	case "all":
+		return true
+	case "admins":
+		return user.Admin
+	default:
+		return false
+	}
+}
+
+// mfaLoginTimeout is the time that the user has to provide the second factor after providing the password.
+const mfaLoginTimeout = 5 * time.Minute
+
+// mfaLoginAttempts is the number of times that the user can provide a second factor for one login.
+const mfaLoginAttempts = 5
+
+var (
+	errMFALoginNotStarted  = errors.DefineUnauthenticated("mfa_login_not_started", "login with password first")
+	errMFALoginExpired     = errors.DefineUnauthenticated("mfa_login_expired", "login expired, login with password again")
+	errMFACode             = errors.DefineInvalidArgument("mfa_code", "incorrect two-factor authentication code")
+	errMFARequired         = errors.DefinePermissionDenied("mfa_required", "two-factor authentication required")
+	errMFAEnrollmentDenied = errors.DefinePermissionDenied("mfa_enrollment_denied", "two-factor authentication enrollment is only possible when it is required and not enrolled")
+	errMFAUnavailable      = errors.DefineFailedPrecondition("mfa_unavailable", "two-factor authentication with `{type}` is not available")
+)
+
+// usableMFACredentials returns the confirmed second factors of the user. If the user has a confirmed second factor
+// that can not be used, such as a WebAuthn credential while WebAuthn is disabled, the login is rejected instead of
+// skipping two-factor authentication.
+func (s *server) usableMFACredentials(ctx context.Context, userIDs ttnpb.UserIdentifiers) ([]*ttnpb.MFACredential, error) {
+	creds, err := s.store.FindMFACredentials(ctx, &userIDs)
+	if err != nil {
+		return nil, err
+	}
+	usable := creds[:0]
+	for _, cred := range creds {
+		if !cred.Confirmed {
+			continue
+		}
+		if cred.Type == ttnpb.MFA_CREDENTIAL_WEBAUTHN && !s.config.MFA.WebAuthn.Enabled() {
+			return nil, errMFAUnavailable.WithAttributes("type", cred.Type.String())
+		}
+		usable = append(usable, cred)
+	}
+	return usable, nil
+}
+
+type webAuthnLoginChallenge struct {
+	Challenge        []byte   `json:"challenge"`
+	RPID             string   `json:"rp_id"`
+	AllowCredentials [][]byte `json:"allow_credentials"`
+}
+
+type mfaLoginChallenge struct {
+	Required           bool                    `json:"required"`
+	EnrollmentRequired bool                    `json:"enrollment_required,omitempty"`
+	Types              []string                `json:"types,omitempty"`
+	WebAuthn           *webAuthnLoginChallenge `json:"webauthn,omitempty"`
+}
+
+type loginResponse struct {
+	MFA *mfaLoginChallenge `json:"mfa"`
+}
+
+// newMFALoginChallenge returns the challenge for the second factor of the user. The WebAuthn challenge is only
+// included if the user has WebAuthn credentials.
+func (s *server) newMFALoginChallenge(creds []*ttnpb.MFACredential, challenge []byte) *mfaLoginChallenge {
+	res := &mfaLoginChallenge{
+		Required:           true,
+		EnrollmentRequired: len(creds) == 0,
+	}
+	seen := make(map[ttnpb.MFACredentialType]bool)
+	for _, cred := range creds {
+		if !seen[cred.Type] {
+			seen[cred.Type] = true
+			res.Types = append(res.Types, cred.Type.String())
+		}
+		if cred.Type != ttnpb.MFA_CREDENTIAL_WEBAUTHN || challenge == nil {
+			continue
+		}
+		if res.WebAuthn == nil {
+			res.WebAuthn = &webAuthnLoginChallenge{
+				Challenge: challenge,
+				RPID:      s.config.MFA.WebAuthn.RPID,
+			}
+		}
+		res.WebAuthn.AllowCredentials = append(res.WebAuthn.AllowCredentials, cred.WebAuthnCredentialID)
+	}
+	return res
+}
+
+// beginMFALogin stores the pending login, refers to it in the auth cookie and returns the challenge for the second
+// factor.
+func (s *server) beginMFALogin(c echo.Context, user *ttnpb.User, creds []*ttnpb.MFACredential) (*mfaLoginChallenge, error) {
+	ctx := c.Request().Context()
+	var challenge []byte
+	for _, cred := range creds {
+		if cred.Type == ttnpb.MFA_CREDENTIAL_WEBAUTHN {
+			var err error
+			if challenge, err = webauthn.NewChallenge(); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	userIDs := ttnpb.UserIdentifiers{UserID: user.UserID}
+	loginID, err := s.store.CreateMFALogin(ctx, &userIDs, challenge, time.Now().Add(mfaLoginTimeout))
+	if err != nil {
+		return nil, err
+	}
+	err = s.updateAuthCookie(c, func(cookie *authCookie) error {
+		cookie.MFA = &mfaLoginCookie{
+			UserID:  user.UserID,
+			LoginID: loginID,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.newMFALoginChallenge(creds, challenge), nil
+}
+
+// startMFALogin begins the pending login and responds with the challenge for the second factor.
+func (s *server) startMFALogin(c echo.Context, user *ttnpb.User, creds []*ttnpb.MFACredential) error {
+	res, err := s.beginMFALogin(c, user, creds)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, loginResponse{MFA: res})
+}
+
+// LoginMFAChallenge returns the challenge for the second factor of the pending login. This is used when the login
+// did not start with a password, such as with federated logins.
+func (s *server) LoginMFAChallenge(c echo.Context) error {
+	ctx := c.Request().Context()
+	userIDs, pending, err := s.getMFALogin(c)
+	if err != nil {
+		return err
+	}
+	creds, err := s.usableMFACredentials(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, loginResponse{MFA: s.newMFALoginChallenge(creds, pending.Challenge)})
+}
+
+// getMFALogin returns the user and the pending login that the auth cookie refers to.
+func (s *server) getMFALogin(c echo.Context) (ttnpb.UserIdentifiers, *store.MFALogin, error) {
+	cookie := &authCookie{}
+	if _, err := s.authCookie().Get(c, cookie); err != nil {
+		return ttnpb.UserIdentifiers{}, nil, err
+	}
+	if cookie.MFA == nil {
+		return ttnpb.UserIdentifiers{}, nil, errMFALoginNotStarted.New()
+	}
+	userIDs := ttnpb.UserIdentifiers{UserID: cookie.MFA.UserID}
+	pending, err := s.store.GetMFALogin(c.Request().Context(), &userIDs, cookie.MFA.LoginID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return ttnpb.UserIdentifiers{}, nil, errMFALoginExpired.WithCause(err)
+		}
+		return ttnpb.UserIdentifiers{}, nil, err
+	}
+	if pending.Attempts >= mfaLoginAttempts {
+		return ttnpb.UserIdentifiers{}, nil, errMFALoginExpired.New()
+	}
+	return userIDs, pending, nil
+}
+
+// addMFALoginAttempt counts an attempt to provide the second factor for the pending login. Attempts are counted
+// before the second factor is verified, so that concurrent attempts cannot exceed the maximum number of attempts.
+func (s *server) addMFALoginAttempt(ctx context.Context, userIDs ttnpb.UserIdentifiers, pending *store.MFALogin) error {
+	attempts, err := s.store.AddMFALoginAttempt(ctx, &userIDs, pending.ID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errMFALoginExpired.WithCause(err)
+		}
+		return err
+	}
+	if attempts > mfaLoginAttempts {
+		return errMFALoginExpired.New()
+	}
+	return nil
+}
+
+// claimMFALogin deletes the pending login after the second factor is verified, so that it cannot be used again.
+func (s *server) claimMFALogin(ctx context.Context, userIDs ttnpb.UserIdentifiers, pending *store.MFALogin) error {
+	if err := s.store.DeleteMFALogin(ctx, &userIDs, pending.ID); err != nil {
+		if errors.IsNotFound(err) {
+			return errMFALoginExpired.WithCause(err)
+		}
+		return err
+	}
+	return nil
+}
+
+type webAuthnAssertion struct {
+	CredentialID      []byte `json:"credential_id"`
+	ClientDataJSON    []byte `json:"client_data_json"`
+	AuthenticatorData []byte `json:"authenticator_data"`
+	Signature         []byte `json:"signature"`
+}
+
+type mfaLoginRequest struct {
+	TOTPCode     string             `json:"totp_code" form:"totp_code"`
+	RecoveryCode string             `json:"recovery_code" form:"recovery_code"`
+	WebAuthn     *webAuthnAssertion `json:"webauthn"`
+}
+
+// verifyMFA verifies the second factor of the request against the credentials of the user. It returns the
+// credential that was used, updated with its new usage, or nil if the second factor is incorrect.
+func (s *server) verifyMFA(req *mfaLoginRequest, challenge []byte, creds []*ttnpb.MFACredential) *ttnpb.MFACredential {
+	now := time.Now()
+	for _, cred := range creds {
+		switch cred.Type {
+		case ttnpb.MFA_CREDENTIAL_TOTP:
+			if req.TOTPCode == "" {
+				continue
+			}
+			// The time step of the last accepted code is stored as sign count, so that codes cannot be used again.
+			step, ok := totp.Match(cred.Secret, req.TOTPCode, now)
+			if !ok || step <= uint64(cred.SignCount) {
+				continue
+			}
+			cred.SignCount = uint32(step)
+		case ttnpb.MFA_CREDENTIAL_RECOVERY_CODE:
+			if req.RecoveryCode == "" || !bytes.Equal(cred.Secret, auth.HashRecoveryCode(req.RecoveryCode)) {
+				continue
+			}
+		case ttnpb.MFA_CREDENTIAL_WEBAUTHN:
+			if req.WebAuthn == nil || challenge == nil || !bytes.Equal(cred.WebAuthnCredentialID, req.WebAuthn.CredentialID) {
+				continue
+			}
+			signCount, err := s.config.MFA.WebAuthn.RelyingParty().VerifyAssertion(
+				challenge,
+				webauthn.Credential{
+					ID:        cred.WebAuthnCredentialID,
+					PublicKey: cred.Secret,
+					SignCount: cred.SignCount,
+				},
+				req.WebAuthn.ClientDataJSON,
+				req.WebAuthn.AuthenticatorData,
+				req.WebAuthn.Signature,
+			)
+			if err != nil {
+				continue
+			}
+			cred.SignCount = signCount
+		default:
+			continue
+		}
+		cred.LastUsedAt = &now
+		return cred
+	}
+	return nil
+}
+
+func (s *server) LoginMFA(c echo.Context) error {
+	ctx := c.Request().Context()
+	userIDs, pending, err := s.getMFALogin(c)
+	if err != nil {
+		return err
+	}
+	req := new(mfaLoginRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	if err := s.addMFALoginAttempt(ctx, userIDs, pending); err != nil {
+		return err
+	}
+	creds, err := s.usableMFACredentials(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	cred := s.verifyMFA(req, pending.Challenge, creds)
+	if cred == nil {
+		events.Publish(evtUserLoginFailed(ctx, userIDs, nil))
+		return errMFACode.New()
+	}
+	if err := s.claimMFALogin(ctx, userIDs, pending); err != nil {
+		return err
+	}
+	if cred.Type == ttnpb.MFA_CREDENTIAL_RECOVERY_CODE {
+		err = s.store.DeleteMFACredential(ctx, &userIDs, cred.ID)
+	} else {
+		_, err = s.store.UpdateMFACredential(ctx, cred)
+	}
+	if err != nil {
+		return err
+	}
+	if err := s.finishLogin(c, userIDs); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+type mfaEnrollmentResponse struct {
+	TOTPSecret string `json:"totp_secret"`
+	TOTPURI    string `json:"totp_uri"`
+}
+
+// getMFAEnrollment returns the user and the pending login of a user that is required to enroll a second factor.
+func (s *server) getMFAEnrollment(c echo.Context) (ttnpb.UserIdentifiers, *store.MFALogin, error) {
+	ctx := c.Request().Context()
+	userIDs, pending, err := s.getMFALogin(c)
+	if err != nil {
+		return ttnpb.UserIdentifiers{}, nil, err
+	}
+	creds, err := s.usableMFACredentials(ctx, userIDs)
+	if err != nil {
+		return ttnpb.UserIdentifiers{}, nil, err
+	}
+	if len(creds) > 0 {
+		return ttnpb.UserIdentifiers{}, nil, errMFAEnrollmentDenied.New()
+	}
+	return userIDs, pending, nil
+}
+
+// LoginMFAEnroll starts the enrollment of a TOTP second factor for a user that is required to use
+// two-factor authentication, but did not enroll a second factor yet. An enrollment that was started
+// before during the same login is replaced.
+func (s *server) LoginMFAEnroll(c echo.Context) error {
+	ctx := c.Request().Context()
+	userIDs, pending, err := s.getMFAEnrollment(c)
+	if err != nil {
+		return err
+	}
+	if pending.EnrollmentID != "" {
+		err := s.store.DeleteMFACredential(ctx, &userIDs, pending.EnrollmentID)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return err
+	}
+	cred, err := s.store.CreateMFACredential(ctx, &ttnpb.MFACredential{
+		UserIdentifiers: userIDs,
+		Type:            ttnpb.MFA_CREDENTIAL_TOTP,
+		Name:            "Authenticator app",
+		Secret:          secret,
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.store.SetMFALoginEnrollment(ctx, &userIDs, pending.ID, cred.ID); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, mfaEnrollmentResponse{
+		TOTPSecret: totp.EncodeSecret(secret),
+		TOTPURI:    totp.URI(s.config.MFA.TOTPIssuer, userIDs.UserID, secret),
+	})
+}
+
+type mfaEnrollmentConfirmResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// LoginMFAEnrollConfirm finishes the enrollment of the TOTP second factor, creates recovery codes
+// and logs in the user.
+func (s *server) LoginMFAEnrollConfirm(c echo.Context) error {
+	ctx := c.Request().Context()
+	userIDs, pending, err := s.getMFAEnrollment(c)
+	if err != nil {
+		return err
+	}
+	if pending.EnrollmentID == "" {
+		return errMFALoginNotStarted.New()
+	}
+	req := new(mfaLoginRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	if err := s.addMFALoginAttempt(ctx, userIDs, pending); err != nil {
+		return err
+	}
+	cred, err := s.store.GetMFACredential(ctx, &userIDs, pending.EnrollmentID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if cred.Type != ttnpb.MFA_CREDENTIAL_TOTP {
+		return errMFACode.New()
+	}
+	step, ok := totp.Match(cred.Secret, req.TOTPCode, now)
+	if !ok {
+		return errMFACode.New()
+	}
+	if err := s.claimMFALogin(ctx, userIDs, pending); err != nil {
+		return err
+	}
+	cred.Confirmed = true
+	cred.LastUsedAt = &now
+	cred.SignCount = uint32(step)
+	if _, err = s.store.UpdateMFACredential(ctx, cred); err != nil {
+		return err
+	}
+	codes, err := auth.GenerateRecoveryCodes()
+	if err != nil {
+		return err
+	}
+	for _, code := range codes {
+		_, err := s.store.CreateMFACredential(ctx, &ttnpb.MFACredential{
+			UserIdentifiers: userIDs,
+			Type:            ttnpb.MFA_CREDENTIAL_RECOVERY_CODE,
+			Confirmed:       true,
+			Secret:          auth.HashRecoveryCode(code),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := s.finishLogin(c, userIDs); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, mfaEnrollmentConfirmResponse{
+		RecoveryCodes: codes,
+	})
+}
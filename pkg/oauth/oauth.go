@@ -77,10 +77,14 @@ func (s *server) Authorize(authorizePage echo.HandlerFunc) echo.HandlerFunc {
 		if ar == nil {
 			return s.output(c, resp)
 		}
-		ar.UserData = userData{UserSessionIdentifiers: ttnpb.UserSessionIdentifiers{
+		ud := userData{UserSessionIdentifiers: ttnpb.UserSessionIdentifiers{
 			UserIdentifiers: session.UserIdentifiers,
 			SessionID:       session.SessionID,
 		}}
+		if s.config.OpenID.Enabled() && hasOpenIDScope(ar.Scope) {
+			ud.OpenID, ud.Nonce = true, req.FormValue("nonce")
+		}
+		ar.UserData = ud
 		client := ttnpb.Client(ar.Client.(osinClient))
 		if !clientHasGrant(&client, ttnpb.GRANT_AUTHORIZATION_CODE) {
 			resp.InternalError = errClientMissingGrant.WithAttributes("grant", "authorization_code")
@@ -209,16 +213,37 @@ func (s *server) Token(c echo.Context) error {
 		ar.Authorized = clientHasGrant(&client, ttnpb.GRANT_REFRESH_TOKEN)
 	case osin.PASSWORD:
 		if clientHasGrant(&client, ttnpb.GRANT_PASSWORD) {
-			if err := s.doLogin(req.Context(), ar.Username, ar.Password); err != nil {
+			user, err := s.doLogin(req.Context(), ar.Username, ar.Password)
+			if err != nil {
+				return err
+			}
+			// The password grant has no way to provide a second factor.
+			creds, err := s.usableMFACredentials(req.Context(), user.UserIdentifiers)
+			if err != nil {
 				return err
 			}
+			if len(creds) > 0 || s.config.MFA.Required(user) {
+				return errMFARequired.New()
+			}
 			ar.Authorized = true
 		}
 	}
 	if ar.Authorized {
 		events.Publish(evtTokenExchange(req.Context(), ttnpb.CombineIdentifiers(userIDs, client.ClientIdentifiers), nil))
 	}
+	var idToken string
+	if ud, ok := ar.UserData.(userData); ok && ud.OpenID && ar.Type == osin.AUTHORIZATION_CODE && ar.Authorized {
+		// The ID token is signed before the tokens are stored, so that no tokens are stored when signing fails.
+		var err error
+		idToken, err = s.idToken(req.Context(), &client, userIDs, ud.Nonce, rightsFromScope(ar.Scope))
+		if err != nil {
+			return err
+		}
+	}
 	oauth2.FinishAccessRequest(resp, req, ar)
+	if idToken != "" && !resp.IsError {
+		resp.Output["id_token"] = idToken
+	}
 	delete(resp.Output, "scope")
 	return s.output(c, resp)
 }
@@ -28,6 +28,10 @@ var (
 		"oauth.user.login_failed", "login user failure",
 		ttnpb.RIGHT_USER_ALL,
 	)
+	evtUserCreateFederated = events.Define(
+		"oauth.user.create_federated", "create user for account at external provider",
+		ttnpb.RIGHT_USER_ALL,
+	)
 	evtUserLogout = events.Define(
 		"oauth.user.logout", "logout user",
 		ttnpb.RIGHT_USER_ALL,
@@ -0,0 +1,322 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	echo "github.com/labstack/echo/v4"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// OpenIDConfig is the configuration for OpenID Connect.
+type OpenIDConfig struct {
+	SigningKeys []string      `name:"signing-keys" description:"Paths to PEM encoded private keys (RSA or ECDSA) for signing ID tokens. The first key is used for signing, all keys are published"`
+	IDTokenTTL  time.Duration `name:"id-token-ttl" description:"Validity of issued ID tokens"`
+}
+
+// Enabled returns whether OpenID Connect is enabled.
+func (c OpenIDConfig) Enabled() bool {
+	return len(c.SigningKeys) > 0
+}
+
+// openIDScope is the scope that clients request to get an ID token.
+const openIDScope = "openid"
+
+// hasOpenIDScope returns whether the scope requested by the client contains the openid scope.
+func hasOpenIDScope(scope string) bool {
+	for _, s := range strings.Fields(scope) {
+		if s == openIDScope {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	errOpenIDNotEnabled  = errors.DefineNotFound("openid_not_enabled", "OpenID Connect is not enabled")
+	errOpenIDSigningKey  = errors.DefineInvalidArgument("openid_signing_key", "invalid OpenID Connect signing key `{file}`")
+	errOpenIDSigningAlgo = errors.DefineInvalidArgument("openid_signing_algorithm", "unsupported OpenID Connect signing key type `{type}`")
+	errUserInfoToken     = errors.DefineUnauthenticated("userinfo_token", "invalid or expired access token")
+)
+
+// signingAlgorithm returns the JWS algorithm for the given private key.
+func signingAlgorithm(key crypto.PrivateKey) (jose.SignatureAlgorithm, error) {
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return jose.RS256, nil
+	case *ecdsa.PrivateKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return jose.ES256, nil
+		case elliptic.P384():
+			return jose.ES384, nil
+		case elliptic.P521():
+			return jose.ES512, nil
+		}
+		return "", errOpenIDSigningAlgo.WithAttributes("type", key.Curve.Params().Name)
+	default:
+		return "", errOpenIDSigningAlgo.WithAttributes("type", fmt.Sprintf("%T", key))
+	}
+}
+
+// parsePrivateKey parses a PEM encoded PKCS #1, PKCS #8 or SEC 1 private key.
+func parsePrivateKey(data []byte) (crypto.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return x509.ParseECPrivateKey(block.Bytes)
+}
+
+// loadSigningKey loads the private key from the given file. The key ID is the
+// base64 encoded SHA-256 thumbprint of the key.
+func loadSigningKey(file string) (*jose.JSONWebKey, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errOpenIDSigningKey.WithCause(err).WithAttributes("file", file)
+	}
+	key, err := parsePrivateKey(data)
+	if err != nil {
+		return nil, errOpenIDSigningKey.WithCause(err).WithAttributes("file", file)
+	}
+	alg, err := signingAlgorithm(key)
+	if err != nil {
+		return nil, err
+	}
+	jwk := &jose.JSONWebKey{
+		Key:       key,
+		Algorithm: string(alg),
+		Use:       "sig",
+	}
+	thumbprint, err := jwk.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, errOpenIDSigningKey.WithCause(err).WithAttributes("file", file)
+	}
+	jwk.KeyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+	return jwk, nil
+}
+
+// openIDSigningKeys returns the signing keys. The keys are loaded on first use.
+func (s *server) openIDSigningKeys() ([]*jose.JSONWebKey, error) {
+	if !s.config.OpenID.Enabled() {
+		return nil, errOpenIDNotEnabled.New()
+	}
+	s.openIDSigningKeysMu.Lock()
+	defer s.openIDSigningKeysMu.Unlock()
+	if s.openIDSigningKeysLoaded != nil {
+		return s.openIDSigningKeysLoaded, nil
+	}
+	keys := make([]*jose.JSONWebKey, 0, len(s.config.OpenID.SigningKeys))
+	for _, file := range s.config.OpenID.SigningKeys {
+		key, err := loadSigningKey(file)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	s.openIDSigningKeysLoaded = keys
+	return keys, nil
+}
+
+// openIDIssuer returns the issuer identifier, which is the canonical URL of the OAuth server.
+func (s *server) openIDIssuer() string {
+	return strings.TrimSuffix(s.config.UI.CanonicalURL, "/")
+}
+
+// idTokenClaims are the claims of ID tokens that are issued by the OAuth server and by external providers.
+type idTokenClaims struct {
+	jwt.Claims
+	Nonce             string `json:"nonce,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     bool   `json:"email_verified,omitempty"`
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+}
+
+// userClaims returns the claims about the user that the client is allowed to see.
+// Clients without the right to view user info only get the subject.
+func userClaims(user *ttnpb.User, rights []ttnpb.Right) idTokenClaims {
+	claims := idTokenClaims{
+		Claims: jwt.Claims{
+			Subject: user.UserID,
+		},
+	}
+	if !ttnpb.RightsFrom(rights...).IncludesAll(ttnpb.RIGHT_USER_INFO) {
+		return claims
+	}
+	claims.Name = user.Name
+	claims.PreferredUsername = user.UserID
+	claims.Email = user.PrimaryEmailAddress
+	claims.EmailVerified = user.PrimaryEmailAddressValidatedAt != nil
+	return claims
+}
+
+// idToken returns a signed ID token for the user that authorized the client.
+func (s *server) idToken(ctx context.Context, client *ttnpb.Client, userIDs ttnpb.UserIdentifiers, nonce string, rights []ttnpb.Right) (string, error) {
+	keys, err := s.openIDSigningKeys()
+	if err != nil {
+		return "", err
+	}
+	user, err := s.store.GetUser(ctx, &userIDs, nil)
+	if err != nil {
+		return "", err
+	}
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.SignatureAlgorithm(keys[0].Algorithm), Key: keys[0]},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return "", err
+	}
+	now := s.now()
+	claims := userClaims(user, rights)
+	claims.Issuer = s.openIDIssuer()
+	claims.Audience = jwt.Audience{client.ClientID}
+	claims.IssuedAt = jwt.NewNumericDate(now)
+	claims.Expiry = jwt.NewNumericDate(now.Add(s.config.OpenID.IDTokenTTL))
+	claims.Nonce = nonce
+	return jwt.Signed(signer).Claims(claims).CompactSerialize()
+}
+
+type openIDProviderMetadata struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+// OpenIDConfiguration returns the OpenID Connect discovery document.
+func (s *server) OpenIDConfiguration(c echo.Context) error {
+	keys, err := s.openIDSigningKeys()
+	if err != nil {
+		return err
+	}
+	algs := make([]string, 0, len(keys))
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if !seen[key.Algorithm] {
+			algs = append(algs, key.Algorithm)
+			seen[key.Algorithm] = true
+		}
+	}
+	issuer := s.openIDIssuer()
+	return c.JSON(http.StatusOK, openIDProviderMetadata{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + "/authorize",
+		TokenEndpoint:                     issuer + "/token",
+		UserInfoEndpoint:                  issuer + "/userinfo",
+		JWKSURI:                           issuer + "/jwks",
+		ScopesSupported:                   []string{openIDScope},
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{"authorization_code", "refresh_token"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  algs,
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "nonce",
+			"name", "preferred_username", "email", "email_verified",
+		},
+	})
+}
+
+// JWKS returns the public keys that clients use to verify ID tokens.
+func (s *server) JWKS(c echo.Context) error {
+	keys, err := s.openIDSigningKeys()
+	if err != nil {
+		return err
+	}
+	var set jose.JSONWebKeySet
+	for _, key := range keys {
+		set.Keys = append(set.Keys, key.Public())
+	}
+	return c.JSON(http.StatusOK, set)
+}
+
+// UserInfo returns the claims about the user that authorized the access token.
+func (s *server) UserInfo(c echo.Context) error {
+	if !s.config.OpenID.Enabled() {
+		return errOpenIDNotEnabled.New()
+	}
+	ctx := c.Request().Context()
+	header := c.Request().Header.Get(echo.HeaderAuthorization)
+	if !strings.HasPrefix(strings.ToLower(header), "bearer ") {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+		return errUserInfoToken.New()
+	}
+	token := strings.TrimSpace(header[len("bearer "):])
+	accessToken, err := s.validateAccessToken(ctx, token)
+	if err != nil {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+		return err
+	}
+	user, err := s.store.GetUser(ctx, &accessToken.UserIDs, nil)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, userClaims(user, accessToken.Rights))
+}
+
+// validateAccessToken returns the access token if it is valid and not expired.
+func (s *server) validateAccessToken(ctx context.Context, token string) (*ttnpb.OAuthAccessToken, error) {
+	tokenType, tokenID, tokenKey, err := auth.SplitToken(token)
+	if err != nil || tokenType != auth.AccessToken {
+		return nil, errUserInfoToken.New()
+	}
+	accessToken, err := s.store.GetAccessToken(ctx, tokenID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errUserInfoToken.WithCause(err)
+		}
+		return nil, err
+	}
+	if valid, err := auth.Validate(accessToken.AccessToken, tokenKey); err != nil || !valid {
+		return nil, errUserInfoToken.New()
+	}
+	if accessToken.ExpiresAt.Before(s.now()) {
+		return nil, errUserInfoToken.New()
+	}
+	return accessToken, nil
+}
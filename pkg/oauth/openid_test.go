@@ -0,0 +1,329 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/auth/pbkdf2"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/oauth"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/webui"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+func writeSigningKey(t *testing.T) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := ioutil.TempFile("", "signing-key-*.pem")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := pem.Encode(f, &pem.Block{Type: "PRIVATE KEY", Bytes: der}); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func TestOpenID(t *testing.T) {
+	ctx := test.Context()
+	hashValidator := pbkdf2.Default()
+	hashValidator.Iterations = 10
+	ctx = auth.NewContextWithHashValidator(ctx, hashValidator)
+
+	signingKey := writeSigningKey(t)
+	defer os.Remove(signingKey)
+
+	store := &mockStore{}
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Cookie: config.Cookie{
+					HashKey:  []byte("12345678123456781234567812345678"),
+					BlockKey: []byte("12345678123456781234567812345678"),
+				},
+			},
+		},
+	})
+	s := oauth.NewServer(ctx, store, oauth.Config{
+		Mount: "/oauth",
+		UI: oauth.UIConfig{
+			TemplateData: webui.TemplateData{
+				SiteName:     "The Things Network",
+				Title:        "OAuth",
+				CanonicalURL: "https://example.com/oauth",
+			},
+		},
+		OpenID: oauth.OpenIDConfig{
+			SigningKeys: []string{signingKey},
+			IDTokenTTL:  time.Hour,
+		},
+	})
+	c.RegisterWeb(s)
+	componenttest.StartComponent(t, c)
+
+	do := func(method, path string, body interface{}, header http.Header) *httptest.ResponseRecorder {
+		var buf bytes.Buffer
+		if body != nil {
+			json.NewEncoder(&buf).Encode(body)
+		}
+		req := httptest.NewRequest(method, path, &buf)
+		req.URL.Scheme, req.URL.Host = "http", req.Host
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		res := httptest.NewRecorder()
+		c.ServeHTTP(res, req)
+		return res
+	}
+
+	user := &ttnpb.User{
+		UserIdentifiers:                ttnpb.UserIdentifiers{UserID: "user"},
+		Name:                           "User",
+		PrimaryEmailAddress:            "user@example.com",
+		PrimaryEmailAddressValidatedAt: &time.Time{},
+	}
+
+	var keys jose.JSONWebKeySet
+
+	t.Run("Discovery", func(t *testing.T) {
+		a := assertions.New(t)
+		res := do(http.MethodGet, "/oauth/.well-known/openid-configuration", nil, nil)
+		if !a.So(res.Code, should.Equal, http.StatusOK) {
+			t.FailNow()
+		}
+		var configuration map[string]interface{}
+		if !a.So(json.NewDecoder(res.Body).Decode(&configuration), should.BeNil) {
+			t.FailNow()
+		}
+		a.So(configuration["issuer"], should.Equal, "https://example.com/oauth")
+		a.So(configuration["authorization_endpoint"], should.Equal, "https://example.com/oauth/authorize")
+		a.So(configuration["token_endpoint"], should.Equal, "https://example.com/oauth/token")
+		a.So(configuration["userinfo_endpoint"], should.Equal, "https://example.com/oauth/userinfo")
+		a.So(configuration["jwks_uri"], should.Equal, "https://example.com/oauth/jwks")
+		a.So(configuration["id_token_signing_alg_values_supported"], should.Resemble, []interface{}{"ES256"})
+	})
+
+	t.Run("JWKS", func(t *testing.T) {
+		a := assertions.New(t)
+		res := do(http.MethodGet, "/oauth/jwks", nil, nil)
+		if !a.So(res.Code, should.Equal, http.StatusOK) {
+			t.FailNow()
+		}
+		if !a.So(json.NewDecoder(res.Body).Decode(&keys), should.BeNil) {
+			t.FailNow()
+		}
+		if a.So(keys.Keys, should.HaveLength, 1) {
+			a.So(keys.Keys[0].IsPublic(), should.BeTrue)
+			a.So(keys.Keys[0].KeyID, should.NotBeEmpty)
+			a.So(keys.Keys[0].Algorithm, should.Equal, "ES256")
+		}
+	})
+
+	t.Run("ID Token", func(t *testing.T) {
+		a := assertions.New(t)
+		store.reset()
+		store.res.client = mockClient
+		store.res.user = user
+		store.res.authorizationCode = &ttnpb.OAuthAuthorizationCode{
+			UserIDs:       user.UserIdentifiers,
+			ClientIDs:     mockClient.ClientIdentifiers,
+			UserSessionID: mockSession.SessionID,
+			Rights:        mockClient.Rights,
+			Code:          "the code",
+			RedirectURI:   "http://uri/callback",
+			CreatedAt:     time.Now().Truncate(time.Second),
+			ExpiresAt:     time.Now().Truncate(time.Second).Add(time.Hour),
+			OpenID:        true,
+			Nonce:         "nonce",
+		}
+		res := do(http.MethodPost, "/oauth/token", map[string]string{
+			"grant_type":    "authorization_code",
+			"code":          "the code",
+			"redirect_uri":  "http://uri/callback",
+			"client_id":     "client",
+			"client_secret": "secret",
+		}, nil)
+		if !a.So(res.Code, should.Equal, http.StatusOK) {
+			t.FailNow()
+		}
+		var tokenResponse struct {
+			AccessToken string `json:"access_token"`
+			IDToken     string `json:"id_token"`
+		}
+		if !a.So(json.NewDecoder(res.Body).Decode(&tokenResponse), should.BeNil) {
+			t.FailNow()
+		}
+		a.So(tokenResponse.AccessToken, should.NotBeEmpty)
+		idToken, err := jwt.ParseSigned(tokenResponse.IDToken)
+		if !a.So(err, should.BeNil) || !a.So(keys.Keys, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		a.So(idToken.Headers[0].KeyID, should.Equal, keys.Keys[0].KeyID)
+		var claims struct {
+			jwt.Claims
+			Nonce         string `json:"nonce"`
+			Email         string `json:"email"`
+			EmailVerified bool   `json:"email_verified"`
+			Name          string `json:"name"`
+		}
+		if !a.So(idToken.Claims(keys.Keys[0], &claims), should.BeNil) {
+			t.FailNow()
+		}
+		a.So(claims.Validate(jwt.Expected{
+			Issuer:   "https://example.com/oauth",
+			Subject:  "user",
+			Audience: jwt.Audience{"client"},
+			Time:     time.Now(),
+		}), should.BeNil)
+		a.So(claims.Nonce, should.Equal, "nonce")
+		a.So(claims.Name, should.Equal, "User")
+		a.So(claims.Email, should.Equal, "user@example.com")
+		a.So(claims.EmailVerified, should.BeTrue)
+	})
+
+	t.Run("ID Token Failure", func(t *testing.T) {
+		a := assertions.New(t)
+		store.reset()
+		store.res.client = mockClient
+		store.err.getUser = mockErrNotFound
+		store.res.authorizationCode = &ttnpb.OAuthAuthorizationCode{
+			UserIDs:       user.UserIdentifiers,
+			ClientIDs:     mockClient.ClientIdentifiers,
+			UserSessionID: mockSession.SessionID,
+			Rights:        mockClient.Rights,
+			Code:          "the code",
+			RedirectURI:   "http://uri/callback",
+			CreatedAt:     time.Now().Truncate(time.Second),
+			ExpiresAt:     time.Now().Truncate(time.Second).Add(time.Hour),
+			OpenID:        true,
+			Nonce:         "nonce",
+		}
+		res := do(http.MethodPost, "/oauth/token", map[string]string{
+			"grant_type":    "authorization_code",
+			"code":          "the code",
+			"redirect_uri":  "http://uri/callback",
+			"client_id":     "client",
+			"client_secret": "secret",
+		}, nil)
+		a.So(res.Code, should.NotEqual, http.StatusOK)
+		a.So(store.calls, should.Contain, "GetUser")
+		a.So(store.calls, should.NotContain, "CreateAccessToken")
+		a.So(store.calls, should.NotContain, "DeleteAuthorizationCode")
+	})
+
+	t.Run("UserInfo", func(t *testing.T) {
+		a := assertions.New(t)
+		hash, err := auth.Hash(ctx, "key")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		store.reset()
+		store.res.user = user
+		store.res.accessToken = &ttnpb.OAuthAccessToken{
+			UserIDs:     user.UserIdentifiers,
+			ClientIDs:   mockClient.ClientIdentifiers,
+			ID:          "ID",
+			AccessToken: hash,
+			Rights:      mockClient.Rights,
+			CreatedAt:   time.Now(),
+			ExpiresAt:   time.Now().Add(time.Hour),
+		}
+
+		res := do(http.MethodGet, "/oauth/userinfo", nil, nil)
+		a.So(res.Code, should.Equal, http.StatusUnauthorized)
+		a.So(res.Header().Get("WWW-Authenticate"), should.StartWith, "Bearer")
+
+		res = do(http.MethodGet, "/oauth/userinfo", nil, http.Header{
+			"Authorization": {"Bearer " + auth.JoinToken(auth.AccessToken, "ID", "other")},
+		})
+		a.So(res.Code, should.Equal, http.StatusUnauthorized)
+
+		res = do(http.MethodGet, "/oauth/userinfo", nil, http.Header{
+			"Authorization": {"Bearer " + auth.JoinToken(auth.AccessToken, "ID", "key")},
+		})
+		if !a.So(res.Code, should.Equal, http.StatusOK) {
+			t.FailNow()
+		}
+		a.So(store.req.tokenID, should.Equal, "ID")
+		var claims map[string]interface{}
+		if a.So(json.NewDecoder(res.Body).Decode(&claims), should.BeNil) {
+			a.So(claims, should.Resemble, map[string]interface{}{
+				"sub":                "user",
+				"name":               "User",
+				"preferred_username": "user",
+				"email":              "user@example.com",
+				"email_verified":     true,
+			})
+		}
+	})
+}
+
+func TestOpenIDNotEnabled(t *testing.T) {
+	ctx := test.Context()
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Cookie: config.Cookie{
+					HashKey:  []byte("12345678123456781234567812345678"),
+					BlockKey: []byte("12345678123456781234567812345678"),
+				},
+			},
+		},
+	})
+	s := oauth.NewServer(ctx, &mockStore{}, oauth.Config{
+		Mount: "/oauth",
+	})
+	c.RegisterWeb(s)
+	componenttest.StartComponent(t, c)
+
+	for _, path := range []string{"/oauth/.well-known/openid-configuration", "/oauth/jwks", "/oauth/userinfo"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		res := httptest.NewRecorder()
+		c.ServeHTTP(res, req)
+		assertions.New(t).So(res.Code, should.Equal, http.StatusNotFound)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	echo "github.com/labstack/echo/v4"
@@ -29,6 +30,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/web"
 	"go.thethings.network/lorawan-stack/pkg/webui"
+	jose "gopkg.in/square/go-jose.v2"
 )
 
 // Server is the interface for the OAuth server.
@@ -36,10 +38,19 @@ type Server interface {
 	web.Registerer
 
 	Login(c echo.Context) error
+	LoginMFAChallenge(c echo.Context) error
+	LoginMFA(c echo.Context) error
+	LoginMFAEnroll(c echo.Context) error
+	LoginMFAEnrollConfirm(c echo.Context) error
+	FederatedLogin(c echo.Context) error
+	FederatedLoginCallback(c echo.Context) error
 	CurrentUser(c echo.Context) error
 	Logout(c echo.Context) error
 	Authorize(authorizePage echo.HandlerFunc) echo.HandlerFunc
 	Token(c echo.Context) error
+	OpenIDConfiguration(c echo.Context) error
+	JWKS(c echo.Context) error
+	UserInfo(c echo.Context) error
 }
 
 type server struct {
@@ -47,6 +58,12 @@ type server struct {
 	config     Config
 	osinConfig *osin.ServerConfig
 	store      Store
+
+	federatedProvidersMu sync.Mutex
+	federatedProviders   map[string]*federatedProvider
+
+	openIDSigningKeysMu     sync.Mutex
+	openIDSigningKeysLoaded []*jose.JSONWebKey
 }
 
 // Store used by the OAuth server.
@@ -54,6 +71,12 @@ type Store interface {
 	// UserStore and UserSessionStore are needed for user login/logout.
 	store.UserStore
 	store.UserSessionStore
+	// MFAStore is needed for two-factor authentication of user logins.
+	store.MFAStore
+	// MFALoginStore is needed for the pending logins of users that did not provide the second factor yet.
+	store.MFALoginStore
+	// ExternalUserStore is needed for login via external OpenID Connect providers.
+	store.ExternalUserStore
 	// ClientStore is needed for getting the OAuth client.
 	store.ClientStore
 	// OAuth is needed for OAuth authorizations.
@@ -73,14 +96,18 @@ type StackConfig struct {
 
 // FrontendConfig is the configuration for the OAuth frontend.
 type FrontendConfig struct {
-	Language    string `json:"language" name:"-"`
-	StackConfig `json:"stack_config" name:",squash"`
+	Language           string                  `json:"language" name:"-"`
+	FederatedProviders []federatedProviderInfo `json:"federated_providers,omitempty" name:"-"`
+	StackConfig        `json:"stack_config" name:",squash"`
 }
 
 // Config is the configuration for the OAuth server.
 type Config struct {
-	Mount string   `name:"mount" description:"Path on the server where the OAuth server will be served"`
-	UI    UIConfig `name:"ui"`
+	Mount      string           `name:"mount" description:"Path on the server where the OAuth server will be served"`
+	UI         UIConfig         `name:"ui"`
+	MFA        MFAConfig        `name:"mfa"`
+	Federation FederationConfig `name:"federation"`
+	OpenID     OpenIDConfig     `name:"openid"`
 }
 
 // NewServer returns a new OAuth server on top of the given store.
@@ -230,6 +257,7 @@ func (s *server) RegisterRoutes(server *web.Server) {
 				c.Set("template_data", config.UI.TemplateData)
 				frontendConfig := config.UI.FrontendConfig
 				frontendConfig.Language = config.UI.TemplateData.Language
+				frontendConfig.FederatedProviders = config.Federation.providerInfo()
 				c.Set("app_config", struct {
 					FrontendConfig
 				}{
@@ -245,6 +273,10 @@ func (s *server) RegisterRoutes(server *web.Server) {
 
 	api := group.Group("/api", middleware.CSRF())
 	api.POST("/auth/login", s.Login)
+	api.GET("/auth/login/mfa", s.LoginMFAChallenge)
+	api.POST("/auth/login/mfa", s.LoginMFA)
+	api.POST("/auth/login/mfa/enroll", s.LoginMFAEnroll)
+	api.POST("/auth/login/mfa/enroll/confirm", s.LoginMFAEnrollConfirm)
 	api.POST("/auth/logout", s.Logout, s.requireLogin)
 	api.GET("/me", s.CurrentUser, s.requireLogin)
 
@@ -252,6 +284,8 @@ func (s *server) RegisterRoutes(server *web.Server) {
 		TokenLookup: "form:csrf",
 	}))
 	page.GET("/login", webui.Template.Handler, s.redirectToNext)
+	page.GET("/login/federated/:provider", s.FederatedLogin)
+	page.GET("/login/federated/:provider/callback", s.FederatedLoginCallback)
 	page.GET("/authorize", s.Authorize(webui.Template.Handler), s.redirectToLogin)
 	page.POST("/authorize", s.Authorize(webui.Template.Handler), s.redirectToLogin)
 
@@ -264,4 +298,8 @@ func (s *server) RegisterRoutes(server *web.Server) {
 	group.GET("/code", webui.Template.Handler)
 	group.GET("/local-callback", s.redirectToLocal)
 	group.POST("/token", s.Token)
+	group.GET("/.well-known/openid-configuration", s.OpenIDConfiguration)
+	group.GET("/jwks", s.JWKS)
+	group.GET("/userinfo", s.UserInfo)
+	group.POST("/userinfo", s.UserInfo)
 }
@@ -31,9 +31,11 @@ import (
 	"github.com/smartystreets/assertions/should"
 	"go.thethings.network/lorawan-stack/pkg/auth"
 	"go.thethings.network/lorawan-stack/pkg/auth/pbkdf2"
+	"go.thethings.network/lorawan-stack/pkg/auth/totp"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/pkg/oauth"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -47,6 +49,11 @@ type loginFormData struct {
 	Password string `json:"password"`
 }
 
+type mfaFormData struct {
+	TOTPCode     string `json:"totp_code"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
 type authorizeFormData struct {
 	encoding  string
 	Authorize bool `json:"authorize"`
@@ -68,6 +75,26 @@ var (
 		RedirectURIs:      []string{"https://uri/callback", "http://uri/callback"},
 		Rights:            []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
 	}
+	mockTOTPSecret     = []byte("12345678901234567890")
+	mockMFACredentials = []*ttnpb.MFACredential{
+		{
+			UserIdentifiers: ttnpb.UserIdentifiers{UserID: "user"},
+			ID:              "totp",
+			Type:            ttnpb.MFA_CREDENTIAL_TOTP,
+			Confirmed:       true,
+			Secret:          mockTOTPSecret,
+		},
+		{
+			UserIdentifiers: ttnpb.UserIdentifiers{UserID: "user"},
+			ID:              "recovery",
+			Type:            ttnpb.MFA_CREDENTIAL_RECOVERY_CODE,
+			Confirmed:       true,
+			Secret:          auth.HashRecoveryCode("abcde-fghij"),
+		},
+	}
+	mockMFALogin = func() *store.MFALogin {
+		return &store.MFALogin{Model: store.Model{ID: "login"}}
+	}
 	mockAccessToken = &ttnpb.OAuthAccessToken{
 		UserIDs:       ttnpb.UserIdentifiers{UserID: "user"},
 		UserSessionID: "session_id",
@@ -124,6 +151,8 @@ func TestOAuthFlow(t *testing.T) {
 	c.RegisterWeb(s)
 	componenttest.StartComponent(t, c)
 
+	mockTOTPCode := totp.Generate(mockTOTPSecret, time.Now())
+
 	for _, tt := range []struct {
 		Name             string
 		StoreSetup       func(*mockStore)
@@ -219,6 +248,184 @@ func TestOAuthFlow(t *testing.T) {
 			Body:         loginFormData{"json", "user", "pass"},
 			ExpectedCode: http.StatusNoContent,
 		},
+		{
+			Name: "login second factor not started",
+			StoreSetup: func(s *mockStore) {
+				s.res.mfaCredentials = mockMFACredentials
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login/mfa",
+			Body:         mfaFormData{TOTPCode: mockTOTPCode},
+			ExpectedCode: http.StatusUnauthorized,
+		},
+		{
+			Name: "login with second factor",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.mfaCredentials = mockMFACredentials
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "user", "pass"},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `"types":["MFA_CREDENTIAL_TOTP","MFA_CREDENTIAL_RECOVERY_CODE"]`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "FindMFACredentials")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "login with unavailable second factor",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.mfaCredentials = []*ttnpb.MFACredential{
+					{
+						UserIdentifiers:      ttnpb.UserIdentifiers{UserID: "user"},
+						ID:                   "webauthn",
+						Type:                 ttnpb.MFA_CREDENTIAL_WEBAUTHN,
+						Confirmed:            true,
+						WebAuthnCredentialID: []byte{0x01, 0x02, 0x03},
+					},
+				}
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "user", "pass"},
+			ExpectedCode: http.StatusBadRequest,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "FindMFACredentials")
+				a.So(s.calls, should.NotContain, "CreateMFALogin")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "login second factor challenge",
+			StoreSetup: func(s *mockStore) {
+				s.res.mfaLogin = mockMFALogin()
+				s.res.mfaCredentials = mockMFACredentials
+			},
+			Method:       "GET",
+			Path:         "/oauth/api/auth/login/mfa",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `"types":["MFA_CREDENTIAL_TOTP","MFA_CREDENTIAL_RECOVERY_CODE"]`,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.req.mfaLoginID, should.Equal, "login")
+			},
+		},
+		{
+			Name: "login second factor wrong code",
+			StoreSetup: func(s *mockStore) {
+				s.res.mfaLogin = mockMFALogin()
+				s.res.mfaCredentials = mockMFACredentials
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login/mfa",
+			Body:         mfaFormData{TOTPCode: "000000", RecoveryCode: "xxxxx-xxxxx"},
+			ExpectedCode: http.StatusBadRequest,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "AddMFALoginAttempt")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "login second factor attempts exceeded",
+			StoreSetup: func(s *mockStore) {
+				s.res.mfaLogin = mockMFALogin()
+				s.res.mfaLogin.Attempts = 5
+				s.res.mfaCredentials = mockMFACredentials
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login/mfa",
+			Body:         mfaFormData{RecoveryCode: "ABCDE FGHIJ"},
+			ExpectedCode: http.StatusUnauthorized,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "DeleteMFACredential")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "login second factor recovery code",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.mfaLogin = mockMFALogin()
+				s.res.mfaCredentials = mockMFACredentials
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login/mfa",
+			Body:         mfaFormData{RecoveryCode: "ABCDE FGHIJ"},
+			ExpectedCode: http.StatusNoContent,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "DeleteMFACredential")
+				a.So(s.req.mfaCredentialID, should.Equal, "recovery")
+				a.So(s.calls, should.Contain, "DeleteMFALogin")
+				a.So(s.calls, should.Contain, "CreateSession")
+			},
+		},
+		{
+			Name: "login with second factor again",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.mfaCredentials = mockMFACredentials
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "user", "pass"},
+			ExpectedCode: http.StatusOK,
+		},
+		{
+			Name: "login second factor TOTP",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.mfaLogin = mockMFALogin()
+				s.res.mfaCredentials = mockMFACredentials
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login/mfa",
+			Body:         mfaFormData{TOTPCode: mockTOTPCode},
+			ExpectedCode: http.StatusNoContent,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "UpdateMFACredential")
+				if a.So(s.req.mfaCredential, should.NotBeNil) {
+					a.So(s.req.mfaCredential.ID, should.Equal, "totp")
+					a.So(s.req.mfaCredential.LastUsedAt, should.NotBeNil)
+				}
+				a.So(s.calls, should.Contain, "CreateSession")
+			},
+		},
+		{
+			Name: "login with second factor to replay TOTP",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.mfaCredentials = mockMFACredentials
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "user", "pass"},
+			ExpectedCode: http.StatusOK,
+		},
+		{
+			Name: "login second factor TOTP replay",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.mfaLogin = mockMFALogin()
+				s.res.mfaCredentials = mockMFACredentials
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login/mfa",
+			Body:         mfaFormData{TOTPCode: mockTOTPCode},
+			ExpectedCode: http.StatusBadRequest,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
 		{
 			Name: "GET me with auth",
 			StoreSetup: func(s *mockStore) {
@@ -454,6 +661,10 @@ func TestOAuthFlow(t *testing.T) {
 					}.Encode()))
 					contentType = "application/x-www-form-urlencoded"
 				}
+			case mfaFormData:
+				json, _ := json.Marshal(b)
+				body = bytes.NewBuffer(json)
+				contentType = "application/json"
 			case authorizeFormData:
 				if b.encoding == "json" {
 					json, _ := json.Marshal(b)
@@ -57,6 +57,9 @@ func (cli osinClient) GetUserData() interface{} { return nil }
 type userData struct {
 	ttnpb.UserSessionIdentifiers
 	ID string
+	// OpenID and Nonce are set if the client requested an ID token.
+	OpenID bool
+	Nonce  string
 }
 
 // storage wraps IS stores, while implementing the osin.Storage interface.
@@ -83,7 +86,8 @@ func (s *storage) GetClient(id string) (osin.Client, error) {
 }
 
 func (s *storage) SaveAuthorize(data *osin.AuthorizeData) error {
-	userSessionIDs := data.UserData.(userData).UserSessionIdentifiers
+	ud := data.UserData.(userData)
+	userSessionIDs := ud.UserSessionIdentifiers
 	client := ttnpb.Client(data.Client.(osinClient))
 	rights := rightsFromScope(data.Scope)
 	_, err := s.oauth.Authorize(s.ctx, &ttnpb.OAuthClientAuthorization{
@@ -107,6 +111,8 @@ func (s *storage) SaveAuthorize(data *osin.AuthorizeData) error {
 		State:         data.State,
 		CreatedAt:     data.CreatedAt,
 		ExpiresAt:     data.CreatedAt.Add(time.Duration(data.ExpiresIn) * time.Second),
+		OpenID:        ud.OpenID,
+		Nonce:         ud.Nonce,
 	})
 	if err != nil {
 		return err
@@ -136,6 +142,8 @@ func (s *storage) LoadAuthorize(code string) (data *osin.AuthorizeData, err erro
 				UserIdentifiers: authorizationCode.UserIDs,
 				SessionID:       authorizationCode.UserSessionID,
 			},
+			OpenID: authorizationCode.OpenID,
+			Nonce:  authorizationCode.Nonce,
 		},
 	}, nil
 }
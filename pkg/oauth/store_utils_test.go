@@ -16,6 +16,7 @@ package oauth_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
@@ -39,6 +40,12 @@ type mockStoreContents struct {
 		token             *ttnpb.OAuthAccessToken
 		previousID        string
 		tokenID           string
+		mfaCredential     *ttnpb.MFACredential
+		mfaCredentialID   string
+		mfaLoginID        string
+		user              *ttnpb.User
+		provider          string
+		subject           string
 	}
 	res struct {
 		session           *ttnpb.UserSession
@@ -47,6 +54,9 @@ type mockStoreContents struct {
 		authorization     *ttnpb.OAuthClientAuthorization
 		authorizationCode *ttnpb.OAuthAuthorizationCode
 		accessToken       *ttnpb.OAuthAccessToken
+		mfaCredentials    []*ttnpb.MFACredential
+		mfaLogin          *store.MFALogin
+		externalUser      *ttnpb.UserIdentifiers
 	}
 	err struct {
 		getUser                 error
@@ -62,12 +72,23 @@ type mockStoreContents struct {
 		createAccessToken       error
 		getAccessToken          error
 		deleteAccessToken       error
+		findMFACredentials      error
+		updateMFACredential     error
+		deleteMFACredential     error
+		createMFALogin          error
+		deleteMFALogin          error
+		createUser              error
+		getExternalUser         error
+		createExternalUser      error
 	}
 }
 
 type mockStore struct {
 	store.UserStore
 	store.UserSessionStore
+	store.MFAStore
+	store.MFALoginStore
+	store.ExternalUserStore
 	store.ClientStore
 	store.OAuthStore
 
@@ -89,6 +110,12 @@ func (s *mockStore) GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fiel
 	return s.res.user, s.err.getUser
 }
 
+func (s *mockStore) CreateUser(ctx context.Context, usr *ttnpb.User) (*ttnpb.User, error) {
+	s.req.ctx, s.req.user = ctx, usr
+	s.calls = append(s.calls, "CreateUser")
+	return usr, s.err.createUser
+}
+
 func (s *mockStore) CreateSession(ctx context.Context, sess *ttnpb.UserSession) (*ttnpb.UserSession, error) {
 	s.req.ctx, s.req.session = ctx, sess
 	s.calls = append(s.calls, "CreateSession")
@@ -163,3 +190,70 @@ func (s *mockStore) DeleteAccessToken(ctx context.Context, tokenID string) error
 	s.calls = append(s.calls, "DeleteAccessToken")
 	return s.err.deleteAccessToken
 }
+
+func (s *mockStore) FindMFACredentials(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]*ttnpb.MFACredential, error) {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "FindMFACredentials")
+	return s.res.mfaCredentials, s.err.findMFACredentials
+}
+
+func (s *mockStore) UpdateMFACredential(ctx context.Context, cred *ttnpb.MFACredential) (*ttnpb.MFACredential, error) {
+	s.req.ctx, s.req.mfaCredential = ctx, cred
+	s.calls = append(s.calls, "UpdateMFACredential")
+	return cred, s.err.updateMFACredential
+}
+
+func (s *mockStore) DeleteMFACredential(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) error {
+	s.req.ctx, s.req.userIDs, s.req.mfaCredentialID = ctx, userIDs, id
+	s.calls = append(s.calls, "DeleteMFACredential")
+	return s.err.deleteMFACredential
+}
+
+func (s *mockStore) CreateMFALogin(ctx context.Context, userIDs *ttnpb.UserIdentifiers, challenge []byte, expiresAt time.Time) (string, error) {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "CreateMFALogin")
+	return "login", s.err.createMFALogin
+}
+
+func (s *mockStore) GetMFALogin(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) (*store.MFALogin, error) {
+	s.req.ctx, s.req.userIDs, s.req.mfaLoginID = ctx, userIDs, id
+	s.calls = append(s.calls, "GetMFALogin")
+	if s.res.mfaLogin == nil {
+		return nil, mockErrNotFound
+	}
+	return s.res.mfaLogin, nil
+}
+
+func (s *mockStore) AddMFALoginAttempt(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) (int, error) {
+	s.req.ctx, s.req.userIDs, s.req.mfaLoginID = ctx, userIDs, id
+	s.calls = append(s.calls, "AddMFALoginAttempt")
+	if s.res.mfaLogin == nil {
+		return 0, mockErrNotFound
+	}
+	s.res.mfaLogin.Attempts++
+	return s.res.mfaLogin.Attempts, nil
+}
+
+func (s *mockStore) SetMFALoginEnrollment(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id, enrollmentID string) error {
+	s.req.ctx, s.req.userIDs, s.req.mfaLoginID, s.req.mfaCredentialID = ctx, userIDs, id, enrollmentID
+	s.calls = append(s.calls, "SetMFALoginEnrollment")
+	return nil
+}
+
+func (s *mockStore) DeleteMFALogin(ctx context.Context, userIDs *ttnpb.UserIdentifiers, id string) error {
+	s.req.ctx, s.req.userIDs, s.req.mfaLoginID = ctx, userIDs, id
+	s.calls = append(s.calls, "DeleteMFALogin")
+	return s.err.deleteMFALogin
+}
+
+func (s *mockStore) GetExternalUser(ctx context.Context, provider, subject string) (*ttnpb.UserIdentifiers, error) {
+	s.req.ctx, s.req.provider, s.req.subject = ctx, provider, subject
+	s.calls = append(s.calls, "GetExternalUser")
+	return s.res.externalUser, s.err.getExternalUser
+}
+
+func (s *mockStore) CreateExternalUser(ctx context.Context, userIDs *ttnpb.UserIdentifiers, provider, subject string) error {
+	s.req.ctx, s.req.userIDs, s.req.provider, s.req.subject = ctx, userIDs, provider, subject
+	s.calls = append(s.calls, "CreateExternalUser")
+	return s.err.createExternalUser
+}
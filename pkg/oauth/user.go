@@ -42,8 +42,17 @@ func (s *server) authCookie() *cookie.Cookie {
 }
 
 type authCookie struct {
-	UserID    string `json:"user_id"`
-	SessionID string `json:"session_id"`
+	UserID    string                `json:"user_id"`
+	SessionID string                `json:"session_id"`
+	MFA       *mfaLoginCookie       `json:"mfa,omitempty"`
+	Federated *federatedLoginCookie `json:"federated,omitempty"`
+}
+
+// mfaLoginCookie refers to the pending login of a user that provided the password, but not yet the second factor.
+// The state of the pending login is stored server-side, so that replaying the cookie does not reset it.
+type mfaLoginCookie struct {
+	UserID  string `json:"user_id"`
+	LoginID string `json:"login_id"`
 }
 
 var errAuthCookie = errors.DefineUnauthenticated("auth_cookie", "could not get auth cookie")
@@ -153,42 +162,35 @@ type loginRequest struct {
 
 var errIncorrectPasswordOrUserID = errors.DefineInvalidArgument("no_user_id_password_match", "incorrect password or user ID")
 
-func (s *server) doLogin(ctx context.Context, userID, password string) error {
+func (s *server) doLogin(ctx context.Context, userID, password string) (*ttnpb.User, error) {
 	ids := &ttnpb.UserIdentifiers{UserID: userID}
 	if err := ids.ValidateContext(ctx); err != nil {
-		return err
+		return nil, err
 	}
 	user, err := s.store.GetUser(
 		ctx,
 		ids,
-		&types.FieldMask{Paths: []string{"password"}},
+		&types.FieldMask{Paths: []string{"password", "admin"}},
 	)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			return errIncorrectPasswordOrUserID.New()
+			return nil, errIncorrectPasswordOrUserID.New()
 		}
-		return err
+		return nil, err
 	}
 	region := trace.StartRegion(ctx, "validate password")
 	ok, err := auth.Validate(user.Password, password)
 	region.End()
 	if err != nil || !ok {
 		events.Publish(evtUserLoginFailed(ctx, user.UserIdentifiers, nil))
-		return errIncorrectPasswordOrUserID.New()
+		return nil, errIncorrectPasswordOrUserID.New()
 	}
-	return nil
+	return user, nil
 }
 
-func (s *server) Login(c echo.Context) error {
+// finishLogin creates the session of the user and stores it in the auth cookie.
+func (s *server) finishLogin(c echo.Context, userIDs ttnpb.UserIdentifiers) error {
 	ctx := c.Request().Context()
-	req := new(loginRequest)
-	if err := c.Bind(req); err != nil {
-		return err
-	}
-	if err := s.doLogin(ctx, req.UserID, req.Password); err != nil {
-		return err
-	}
-	userIDs := ttnpb.UserIdentifiers{UserID: req.UserID}
 	session, err := s.store.CreateSession(ctx, &ttnpb.UserSession{
 		UserIdentifiers: userIDs,
 	})
@@ -196,14 +198,35 @@ func (s *server) Login(c echo.Context) error {
 		return err
 	}
 	events.Publish(evtUserLogin(ctx, userIDs, nil))
-	err = s.updateAuthCookie(c, func(cookie *authCookie) error {
+	return s.updateAuthCookie(c, func(cookie *authCookie) error {
 		cookie.UserID = session.UserID
 		cookie.SessionID = session.SessionID
+		cookie.MFA = nil
 		return nil
 	})
+}
+
+func (s *server) Login(c echo.Context) error {
+	ctx := c.Request().Context()
+	req := new(loginRequest)
+	if err := c.Bind(req); err != nil {
+		return err
+	}
+	user, err := s.doLogin(ctx, req.UserID, req.Password)
 	if err != nil {
 		return err
 	}
+	userIDs := ttnpb.UserIdentifiers{UserID: req.UserID}
+	creds, err := s.usableMFACredentials(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	if len(creds) > 0 || s.config.MFA.Required(user) {
+		return s.startMFALogin(c, user, creds)
+	}
+	if err := s.finishLogin(c, userIDs); err != nil {
+		return err
+	}
 	return c.NoContent(http.StatusNoContent)
 }
 
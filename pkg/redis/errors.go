@@ -22,6 +22,7 @@ import (
 var (
 	errDecode              = errors.Define("decode", "failed to decode value")
 	errEncode              = errors.Define("encode", "failed to encode value")
+	errInvalidCursor       = errors.DefineInvalidArgument("cursor", "invalid cursor `{cursor}`")
 	errInvalidKeyValueType = errors.DefineInvalidArgument("value_type", "invalid value type for key `{key}`")
 	errNoArguments         = errors.DefineInvalidArgument("no_arguments", "no arguments")
 	errNotFound            = errors.DefineNotFound("not_found", "entity not found")
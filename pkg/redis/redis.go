@@ -313,6 +313,34 @@ func ListProtos(ctx context.Context, r redis.Cmdable, k string) ProtosCmd {
 	}
 }
 
+var globReplacer = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`)
+
+// ScanKeys scans the keys with the given prefix, starting at cursor.
+// It returns the scanned keys with the prefix trimmed, and the cursor to continue scanning from.
+// The empty cursor starts a new scan, and the returned cursor is empty if all keys have been scanned.
+// Count is a hint for the number of keys to scan; the number of returned keys may differ.
+func ScanKeys(r redis.Cmdable, prefix string, cursor string, count int64) ([]string, string, error) {
+	var c uint64
+	if cursor != "" {
+		var err error
+		c, err = strconv.ParseUint(cursor, 10, 64)
+		if err != nil {
+			return nil, "", errInvalidCursor.WithAttributes("cursor", cursor).WithCause(err)
+		}
+	}
+	ks, c, err := r.Scan(c, globReplacer.Replace(prefix)+"*", count).Result()
+	if err != nil {
+		return nil, "", ConvertError(err)
+	}
+	for i, k := range ks {
+		ks[i] = strings.TrimPrefix(k, prefix)
+	}
+	if c == 0 {
+		return ks, "", nil
+	}
+	return ks, strconv.FormatUint(c, 10), nil
+}
+
 const (
 	payloadKey = "payload"
 	replaceKey = "replace"
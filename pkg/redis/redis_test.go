@@ -777,3 +777,50 @@ func TestProtoDeduplicator(t *testing.T) {
 	a.So(lockTTL, should.BeLessThanOrEqualTo, ttl)
 	a.So(listTTL, should.BeLessThanOrEqualTo, ttl)
 }
+
+func TestScanKeys(t *testing.T) {
+	a := assertions.New(t)
+
+	cl, flush := test.NewRedis(t, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	for _, k := range []string{
+		cl.Key("uid", "app1.dev1"),
+		cl.Key("uid", "app1.dev2"),
+		cl.Key("uid", "app2.dev1"),
+		cl.Key("eui", "0102030405060708", "0102030405060708"),
+		cl.Key("uid*", "app3.dev1"),
+	} {
+		if err := cl.Set(k, "test", 0).Err(); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	scanAll := func(prefix string) []string {
+		var ks []string
+		var cursor string
+		for {
+			scanned, next, err := ScanKeys(cl, prefix, cursor, 1)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			ks = append(ks, scanned...)
+			if next == "" {
+				return ks
+			}
+			cursor = next
+		}
+	}
+
+	ks := scanAll(cl.Key("uid", ""))
+	a.So(ks, should.HaveLength, 3)
+	a.So(ks, should.Contain, "app1.dev1")
+	a.So(ks, should.Contain, "app1.dev2")
+	a.So(ks, should.Contain, "app2.dev1")
+
+	a.So(scanAll(cl.Key("uid*", "")), should.Resemble, []string{"app3.dev1"})
+
+	_, _, err := ScanKeys(cl, cl.Key("uid", ""), "invalid", 1)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
@@ -221,7 +221,9 @@ type ListApplicationsRequest struct {
 	// Limit the number of results per page.
 	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Page uint32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	// Only return recently deleted applications. Only admins can list deleted applications.
+	Deleted              bool     `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -293,6 +295,13 @@ func (m *ListApplicationsRequest) GetPage() uint32 {
 	return 0
 }
 
+func (m *ListApplicationsRequest) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type CreateApplicationRequest struct {
 	Application `protobuf:"bytes,1,opt,name=application,proto3,embedded=application" json:"application"`
 	// Collaborator to grant all rights on the newly created application.
@@ -491,10 +500,14 @@ func (m *GetApplicationAPIKeyRequest) GetKeyID() string {
 
 type CreateApplicationAPIKeyRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
-	Name                   string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights                 []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	Name                   string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights                 []Right `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Time after which the API key can no longer be used.
+	ExpiresAt *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// IP address ranges (in CIDR notation) from which the API key can be used.
+	AllowedIPRanges      []string `protobuf:"bytes,5,rep,name=allowed_ip_ranges,json=allowedIpRanges,proto3" json:"allowed_ip_ranges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateApplicationAPIKeyRequest) Reset()      { *m = CreateApplicationAPIKeyRequest{} }
@@ -543,6 +556,20 @@ func (m *CreateApplicationAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateApplicationAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateApplicationAPIKeyRequest) GetAllowedIPRanges() []string {
+	if m != nil {
+		return m.AllowedIPRanges
+	}
+	return nil
+}
+
 type UpdateApplicationAPIKeyRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	APIKey                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
@@ -762,77 +789,82 @@ func init() {
 }
 
 var fileDescriptor_57d90136b1f4f7b1 = []byte{
-	// 1109 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4b, 0x6c, 0x1b, 0x45,
-	0x18, 0xde, 0xf1, 0x23, 0xa9, 0xc7, 0x79, 0x69, 0x45, 0x61, 0x95, 0xc0, 0xc4, 0xdd, 0x46, 0x55,
-	0x5a, 0xb2, 0x6b, 0xe4, 0x5e, 0xa0, 0x02, 0x22, 0x6f, 0x78, 0xc8, 0x04, 0x1a, 0x58, 0xe8, 0x85,
-	0xaa, 0x58, 0x63, 0xef, 0x78, 0x33, 0xb2, 0xbd, 0xbb, 0xec, 0x8e, 0x53, 0x5c, 0x84, 0x54, 0x71,
-	0xaa, 0x38, 0x55, 0x9c, 0x10, 0x27, 0xd4, 0x03, 0xea, 0x81, 0x43, 0x4f, 0xa8, 0x12, 0x1c, 0x7a,
-	0x82, 0x1c, 0x38, 0xe4, 0x84, 0x7a, 0x0a, 0xf5, 0xfa, 0x12, 0x89, 0x4b, 0x8f, 0x95, 0x4f, 0x68,
-	0x67, 0xd7, 0xf5, 0xfa, 0x41, 0x24, 0x68, 0x65, 0xf5, 0x94, 0x99, 0xd9, 0xef, 0xff, 0xfe, 0xef,
-	0x9f, 0xf9, 0xbf, 0x19, 0x07, 0x9e, 0x6e, 0xd8, 0x2e, 0xbe, 0x8a, 0x2d, 0xc5, 0x63, 0xb8, 0x5a,
-	0xcf, 0x63, 0x87, 0xe6, 0xb1, 0xe3, 0x34, 0x68, 0x15, 0x33, 0x6a, 0x5b, 0xaa, 0xe3, 0xda, 0xcc,
-	0x16, 0x17, 0x18, 0xb3, 0xd4, 0x08, 0xa8, 0xee, 0x9d, 0x5f, 0x2e, 0x9a, 0x94, 0xed, 0xb6, 0x2a,
-	0x6a, 0xd5, 0x6e, 0xe6, 0x89, 0xb5, 0x67, 0xb7, 0x1d, 0xd7, 0xfe, 0xa2, 0x9d, 0xe7, 0xe0, 0xaa,
-	0x62, 0x12, 0x4b, 0xd9, 0xc3, 0x0d, 0x6a, 0x60, 0x46, 0xf2, 0x63, 0x83, 0x90, 0x72, 0x59, 0x89,
-	0x51, 0x98, 0xb6, 0x69, 0x87, 0xc1, 0x95, 0x56, 0x8d, 0xcf, 0xf8, 0x84, 0x8f, 0x22, 0x78, 0xce,
-	0xb4, 0x6d, 0xb3, 0x41, 0x06, 0xa8, 0x1a, 0x25, 0x0d, 0xa3, 0xdc, 0xc4, 0x5e, 0x3d, 0x42, 0xac,
-	0x8e, 0x22, 0x18, 0x6d, 0x12, 0x8f, 0xe1, 0xa6, 0x13, 0x01, 0xd6, 0xc6, 0x2b, 0xad, 0xda, 0x16,
-	0xc3, 0x55, 0x56, 0xa6, 0x56, 0xad, 0x9f, 0x68, 0xc2, 0x7e, 0x50, 0x83, 0x58, 0x8c, 0xd6, 0x28,
-	0x71, 0xbd, 0x08, 0x84, 0xc6, 0x41, 0x2e, 0x35, 0x77, 0x59, 0xf4, 0x5d, 0xfe, 0x31, 0x05, 0xb3,
-	0xc5, 0xc1, 0x2e, 0x8a, 0xef, 0xc1, 0x24, 0x35, 0x3c, 0x09, 0xe4, 0xc0, 0x7a, 0xb6, 0x70, 0x46,
-	0x1d, 0xde, 0x4d, 0x35, 0x86, 0x2c, 0x0d, 0x52, 0x69, 0x4b, 0x3d, 0x2d, 0xfd, 0x0d, 0x48, 0x2c,
-	0x81, 0xfd, 0xc3, 0x55, 0xe1, 0xe0, 0x70, 0x15, 0xe8, 0x01, 0x89, 0xb8, 0x05, 0x61, 0xd5, 0x25,
-	0x98, 0x11, 0xa3, 0x8c, 0x99, 0x94, 0xe0, 0x94, 0xcb, 0x6a, 0x58, 0xbc, 0xda, 0x2f, 0x5e, 0xfd,
-	0xa4, 0x5f, 0xbc, 0x76, 0x22, 0x08, 0xbf, 0xf9, 0xd7, 0x2a, 0xd0, 0x33, 0x51, 0x5c, 0x91, 0x05,
-	0x24, 0x2d, 0xc7, 0xe8, 0x93, 0x24, 0xff, 0x0b, 0x49, 0x14, 0x57, 0x64, 0xe2, 0x0a, 0x4c, 0x59,
-	0xb8, 0x49, 0xa4, 0x54, 0x0e, 0xac, 0x67, 0xb4, 0xd9, 0x9e, 0x96, 0x72, 0x13, 0x52, 0x41, 0xe7,
-	0x8b, 0xe2, 0x39, 0x98, 0x35, 0x88, 0x57, 0x75, 0xa9, 0x13, 0xd4, 0x25, 0xa5, 0x39, 0xe6, 0x44,
-	0x4f, 0x4b, 0xbb, 0x49, 0xe9, 0x60, 0x51, 0x8f, 0x7f, 0x14, 0xdb, 0x10, 0x62, 0xc6, 0x5c, 0x5a,
-	0x69, 0x31, 0xe2, 0x49, 0x33, 0xb9, 0xe4, 0x7a, 0xb6, 0xf0, 0xf2, 0x31, 0xbb, 0xa4, 0x16, 0x1f,
-	0xa3, 0xdf, 0xb6, 0x98, 0xdb, 0xd6, 0x36, 0x7a, 0xda, 0xd9, 0xef, 0xc1, 0x19, 0x79, 0xcd, 0x95,
-	0xa5, 0xb5, 0x02, 0xfa, 0xec, 0x32, 0x56, 0xae, 0xbd, 0xa2, 0xbc, 0x76, 0x65, 0x7d, 0xf3, 0xc2,
-	0x65, 0xe5, 0xca, 0x66, 0x7f, 0x7a, 0xf6, 0xcb, 0xc2, 0xc6, 0x57, 0x6b, 0x7a, 0x2c, 0x99, 0xf8,
-	0x26, 0x9c, 0x8b, 0x37, 0x81, 0x34, 0xcb, 0x93, 0xaf, 0x8c, 0x26, 0xdf, 0x0a, 0x31, 0x25, 0xab,
-	0x66, 0xeb, 0xd9, 0xea, 0x60, 0xb2, 0xfc, 0x06, 0x5c, 0x1c, 0x11, 0x23, 0x2e, 0xc1, 0x64, 0x9d,
-	0xb4, 0xf9, 0x61, 0x67, 0xf4, 0x60, 0x28, 0x3e, 0x07, 0xd3, 0x7b, 0xb8, 0xd1, 0x22, 0xfc, 0xb4,
-	0x32, 0x7a, 0x38, 0xb9, 0x90, 0x78, 0x15, 0xc8, 0x3b, 0x70, 0x2e, 0x56, 0x97, 0x27, 0x6e, 0xc2,
-	0xb9, 0x98, 0xfb, 0x82, 0x8e, 0x99, 0x28, 0x27, 0x16, 0xa3, 0x0f, 0x05, 0xc8, 0xbf, 0x00, 0x78,
-	0xf2, 0x5d, 0xc2, 0xe2, 0x00, 0xf2, 0x79, 0x8b, 0x78, 0x4c, 0xc4, 0x70, 0x31, 0x86, 0x2c, 0x3f,
-	0x8d, 0x7e, 0x5c, 0xc0, 0x71, 0x64, 0xa0, 0x1e, 0x0e, 0x6c, 0xf9, 0xaf, 0xad, 0xf9, 0x4e, 0x00,
-	0xf9, 0x00, 0x7b, 0x75, 0x2d, 0x15, 0x30, 0xe9, 0x99, 0x5a, 0x7f, 0x41, 0xfe, 0x3d, 0x01, 0x5f,
-	0x78, 0x9f, 0x7a, 0x71, 0xf9, 0x5e, 0x5f, 0xff, 0x47, 0xc1, 0x49, 0x35, 0x1a, 0xb8, 0x62, 0xbb,
-	0x98, 0xd9, 0x6e, 0x24, 0x5e, 0x19, 0x15, 0xbf, 0xe3, 0x9a, 0xd8, 0xa2, 0xd7, 0x78, 0xec, 0x8e,
-	0x7b, 0xc9, 0x23, 0x6e, 0xac, 0x06, 0x7d, 0x88, 0xe2, 0x89, 0xf5, 0x8a, 0x06, 0x4c, 0xdb, 0xae,
-	0x41, 0x5c, 0xee, 0xa0, 0x8c, 0x76, 0xb1, 0xa7, 0x6d, 0xbb, 0x25, 0x5d, 0x18, 0xda, 0x98, 0x32,
-	0x35, 0xf4, 0x45, 0x65, 0x64, 0x81, 0x7b, 0x44, 0x4f, 0x2b, 0xfc, 0x4f, 0xcc, 0xcf, 0x7a, 0x56,
-	0x89, 0x4d, 0x42, 0x72, 0x11, 0xc1, 0x74, 0x83, 0x36, 0x29, 0xe3, 0x46, 0x9b, 0xe7, 0x26, 0x3a,
-	0x97, 0x94, 0x8e, 0x66, 0xf5, 0x70, 0x59, 0x14, 0x61, 0xca, 0xc1, 0x26, 0xe1, 0x1e, 0x9b, 0xd7,
-	0xf9, 0x58, 0xfe, 0x03, 0x40, 0x69, 0x8b, 0x33, 0x4d, 0x68, 0x85, 0x1d, 0x98, 0x8d, 0xe9, 0x89,
-	0x76, 0xf2, 0xb8, 0x26, 0x9b, 0x70, 0xf6, 0x71, 0x06, 0xb1, 0x3c, 0x72, 0x36, 0x89, 0xff, 0x71,
-	0x36, 0xda, 0x5c, 0x3c, 0xc7, 0xf0, 0x49, 0xc9, 0x3f, 0x01, 0x28, 0x5d, 0xe2, 0x17, 0xcf, 0x34,
-	0xca, 0x79, 0xe2, 0x3e, 0xfe, 0x19, 0xc0, 0x97, 0x46, 0xfa, 0xb8, 0xf8, 0x61, 0x69, 0x9b, 0xb4,
-	0xbd, 0x29, 0xba, 0xf1, 0x71, 0xdb, 0x24, 0x8e, 0x6f, 0x9b, 0x64, 0xac, 0x6d, 0x6e, 0x01, 0xb8,
-	0x32, 0x7c, 0x7d, 0x84, 0xba, 0xa7, 0x28, 0x3b, 0x07, 0x67, 0xea, 0xa4, 0x5d, 0xa6, 0x46, 0x78,
-	0x5b, 0x6a, 0x19, 0xff, 0x70, 0x35, 0xbd, 0x4d, 0xda, 0xa5, 0xb7, 0xf4, 0x74, 0x9d, 0xb4, 0x4b,
-	0x86, 0x7c, 0x08, 0x20, 0x1a, 0xeb, 0xed, 0xa9, 0xeb, 0xec, 0xbf, 0x7e, 0x89, 0x49, 0xaf, 0xdf,
-	0xeb, 0x70, 0x26, 0xfc, 0x41, 0x20, 0x25, 0x73, 0xc9, 0xf5, 0x85, 0xc2, 0xc9, 0xd1, 0xb4, 0x7a,
-	0xf0, 0x55, 0x9b, 0xef, 0x69, 0xf0, 0x5b, 0x30, 0x2b, 0xa7, 0xbf, 0x0e, 0x52, 0xe9, 0x51, 0x8c,
-	0xfc, 0x1b, 0x80, 0x68, 0xac, 0xdb, 0xa7, 0x5e, 0x60, 0x11, 0xce, 0x62, 0x87, 0x96, 0x83, 0xb7,
-	0x2c, 0xb4, 0xc0, 0xf3, 0x63, 0xd4, 0x5c, 0xd2, 0x04, 0xaa, 0x19, 0xec, 0xd0, 0x6d, 0xd2, 0x96,
-	0x7f, 0x05, 0xf0, 0xf4, 0x88, 0x0f, 0xb6, 0x62, 0xb6, 0x7e, 0xd6, 0xdd, 0xf0, 0x37, 0x80, 0xa7,
-	0x86, 0xdd, 0x10, 0x57, 0x3f, 0x45, 0xf1, 0xd5, 0xa7, 0x71, 0xbf, 0x8e, 0xa7, 0x19, 0xbe, 0x63,
-	0xff, 0x04, 0xf0, 0xd4, 0xc7, 0xcf, 0x42, 0xb5, 0x17, 0x27, 0x56, 0xfb, 0xe2, 0xf8, 0x6f, 0xb2,
-	0x01, 0xe6, 0xb8, 0xc7, 0x43, 0xbb, 0x05, 0xf6, 0x3b, 0x08, 0x1c, 0x74, 0x10, 0xb8, 0xdf, 0x41,
-	0xc2, 0x83, 0x0e, 0x12, 0x8e, 0x3a, 0x48, 0x78, 0xd8, 0x41, 0xc2, 0xa3, 0x0e, 0x02, 0xd7, 0x7d,
-	0x04, 0x6e, 0xf8, 0x48, 0xb8, 0xed, 0x23, 0x70, 0xc7, 0x47, 0xc2, 0x5d, 0x1f, 0x09, 0xf7, 0x7c,
-	0x24, 0xec, 0xfb, 0x08, 0x1c, 0xf8, 0x08, 0xdc, 0xf7, 0x91, 0xf0, 0xc0, 0x47, 0xe0, 0xc8, 0x47,
-	0xc2, 0x43, 0x1f, 0x81, 0x47, 0x3e, 0x12, 0xae, 0x77, 0x91, 0x70, 0xa3, 0x8b, 0xc0, 0xcd, 0x2e,
-	0x12, 0xbe, 0xeb, 0x22, 0xf0, 0x43, 0x17, 0x09, 0xb7, 0xbb, 0x48, 0xb8, 0xd3, 0x45, 0xe0, 0x6e,
-	0x17, 0x81, 0x7b, 0x5d, 0x04, 0x3e, 0xdd, 0x30, 0x6d, 0x95, 0xed, 0x12, 0xb6, 0x4b, 0x2d, 0xd3,
-	0x53, 0x2d, 0xc2, 0xae, 0xda, 0x6e, 0x3d, 0x3f, 0xfc, 0x9f, 0x83, 0x53, 0x37, 0xf3, 0x8c, 0x59,
-	0x4e, 0xa5, 0x32, 0xc3, 0xdf, 0x95, 0xf3, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xbf, 0xf2, 0xf8,
-	0x57, 0x90, 0x0d, 0x00, 0x00,
+	// 1195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x3d, 0x8c, 0x1b, 0x45,
+	0x14, 0xde, 0xb1, 0xbd, 0x77, 0xf1, 0xf8, 0x92, 0x3b, 0x56, 0x09, 0xac, 0xee, 0x60, 0xec, 0x6c,
+	0x4e, 0x91, 0x13, 0xe2, 0x35, 0x72, 0x1a, 0x88, 0x80, 0xc8, 0x7b, 0xc0, 0xc9, 0x1c, 0xe4, 0xc2,
+	0x42, 0x1a, 0xa2, 0x60, 0x8d, 0xbd, 0xe3, 0xbd, 0x91, 0xd7, 0xbb, 0xcb, 0xee, 0xf8, 0x12, 0x07,
+	0x21, 0x45, 0x54, 0x11, 0x55, 0x94, 0x0a, 0x51, 0xa1, 0x14, 0x28, 0x05, 0x45, 0x2a, 0x14, 0x09,
+	0x8a, 0x54, 0xe8, 0x0a, 0x8a, 0xab, 0x50, 0xaa, 0x23, 0x5e, 0x37, 0x27, 0xd1, 0xa4, 0x8c, 0x5c,
+	0xa1, 0xfd, 0x71, 0xbc, 0xfe, 0xc9, 0x49, 0x90, 0xc8, 0x4a, 0x75, 0x33, 0xb3, 0xdf, 0x7b, 0xef,
+	0x7b, 0x6f, 0xbe, 0xf7, 0xc6, 0x07, 0x4f, 0x18, 0x96, 0x83, 0xaf, 0x62, 0xb3, 0xe0, 0x32, 0x5c,
+	0x6f, 0x16, 0xb1, 0x4d, 0x8b, 0xd8, 0xb6, 0x0d, 0x5a, 0xc7, 0x8c, 0x5a, 0xa6, 0x6c, 0x3b, 0x16,
+	0xb3, 0x84, 0x23, 0x8c, 0x99, 0x72, 0x04, 0x94, 0xb7, 0xcf, 0x2e, 0x97, 0x75, 0xca, 0xb6, 0xda,
+	0x35, 0xb9, 0x6e, 0xb5, 0x8a, 0xc4, 0xdc, 0xb6, 0x3a, 0xb6, 0x63, 0x5d, 0xeb, 0x14, 0x03, 0x70,
+	0xbd, 0xa0, 0x13, 0xb3, 0xb0, 0x8d, 0x0d, 0xaa, 0x61, 0x46, 0x8a, 0x13, 0x8b, 0xd0, 0xe5, 0x72,
+	0x21, 0xe6, 0x42, 0xb7, 0x74, 0x2b, 0x34, 0xae, 0xb5, 0x1b, 0xc1, 0x2e, 0xd8, 0x04, 0xab, 0x08,
+	0x9e, 0xd3, 0x2d, 0x4b, 0x37, 0xc8, 0x10, 0xd5, 0xa0, 0xc4, 0xd0, 0xaa, 0x2d, 0xec, 0x36, 0x23,
+	0x44, 0x76, 0x1c, 0xc1, 0x68, 0x8b, 0xb8, 0x0c, 0xb7, 0xec, 0x08, 0xb0, 0x3a, 0x99, 0x69, 0xdd,
+	0x32, 0x19, 0xae, 0xb3, 0x2a, 0x35, 0x1b, 0x83, 0x40, 0x53, 0xea, 0x41, 0x35, 0x62, 0x32, 0xda,
+	0xa0, 0xc4, 0x71, 0x23, 0x10, 0x9a, 0x04, 0x39, 0x54, 0xdf, 0x62, 0xd1, 0x77, 0xe9, 0xe7, 0x14,
+	0xcc, 0x94, 0x87, 0x55, 0x14, 0x3e, 0x86, 0x49, 0xaa, 0xb9, 0x22, 0xc8, 0x81, 0x7c, 0xa6, 0x74,
+	0x52, 0x1e, 0xad, 0xa6, 0x1c, 0x43, 0x56, 0x86, 0xa1, 0x94, 0xa5, 0xbe, 0xc2, 0x7f, 0x0f, 0x12,
+	0x4b, 0x60, 0x67, 0x2f, 0xcb, 0xed, 0xee, 0x65, 0x81, 0xea, 0x3b, 0x11, 0xd6, 0x20, 0xac, 0x3b,
+	0x04, 0x33, 0xa2, 0x55, 0x31, 0x13, 0x13, 0x81, 0xcb, 0x65, 0x39, 0x4c, 0x5e, 0x1e, 0x24, 0x2f,
+	0x7f, 0x31, 0x48, 0x5e, 0x39, 0xe4, 0x9b, 0xdf, 0xfa, 0x3b, 0x0b, 0xd4, 0x74, 0x64, 0x57, 0x66,
+	0xbe, 0x93, 0xb6, 0xad, 0x0d, 0x9c, 0x24, 0xff, 0x8b, 0x93, 0xc8, 0xae, 0xcc, 0x84, 0x15, 0x98,
+	0x32, 0x71, 0x8b, 0x88, 0xa9, 0x1c, 0xc8, 0xa7, 0x95, 0xf9, 0xbe, 0x92, 0x72, 0x12, 0x62, 0x49,
+	0x0d, 0x0e, 0x85, 0xd3, 0x30, 0xa3, 0x11, 0xb7, 0xee, 0x50, 0xdb, 0xcf, 0x4b, 0xe4, 0x03, 0xcc,
+	0xa1, 0xbe, 0xc2, 0x3b, 0x49, 0x71, 0x77, 0x51, 0x8d, 0x7f, 0x14, 0x3a, 0x10, 0x62, 0xc6, 0x1c,
+	0x5a, 0x6b, 0x33, 0xe2, 0x8a, 0x73, 0xb9, 0x64, 0x3e, 0x53, 0x7a, 0xf3, 0x80, 0x2a, 0xc9, 0xe5,
+	0xa7, 0xe8, 0x0f, 0x4d, 0xe6, 0x74, 0x94, 0x33, 0x7d, 0xe5, 0xd4, 0x8f, 0xe0, 0xa4, 0xb4, 0xea,
+	0x48, 0xe2, 0x6a, 0x09, 0x7d, 0x75, 0x19, 0x17, 0xae, 0xbf, 0x55, 0x78, 0xe7, 0x4a, 0xfe, 0xfc,
+	0xb9, 0xcb, 0x85, 0x2b, 0xe7, 0x07, 0xdb, 0x53, 0xdf, 0x94, 0xce, 0x7c, 0xbb, 0xaa, 0xc6, 0x82,
+	0x09, 0xef, 0xc3, 0x85, 0xb8, 0x08, 0xc4, 0xf9, 0x20, 0xf8, 0xca, 0x78, 0xf0, 0xb5, 0x10, 0x53,
+	0x31, 0x1b, 0x96, 0x9a, 0xa9, 0x0f, 0x37, 0xcb, 0xef, 0xc1, 0xc5, 0x31, 0x32, 0xc2, 0x12, 0x4c,
+	0x36, 0x49, 0x27, 0xb8, 0xec, 0xb4, 0xea, 0x2f, 0x85, 0xa3, 0x90, 0xdf, 0xc6, 0x46, 0x9b, 0x04,
+	0xb7, 0x95, 0x56, 0xc3, 0xcd, 0xb9, 0xc4, 0xdb, 0x40, 0xda, 0x84, 0x0b, 0xb1, 0xbc, 0x5c, 0xe1,
+	0x3c, 0x5c, 0x88, 0x75, 0x9f, 0xaf, 0x98, 0xa9, 0x74, 0x62, 0x36, 0xea, 0x88, 0x81, 0xf4, 0x1b,
+	0x80, 0xc7, 0xd6, 0x09, 0x8b, 0x03, 0xc8, 0xd7, 0x6d, 0xe2, 0x32, 0x01, 0xc3, 0xc5, 0x18, 0xb2,
+	0xfa, 0x22, 0xf4, 0x78, 0x04, 0xc7, 0x91, 0x3e, 0x7b, 0x38, 0x6c, 0xcb, 0x67, 0x4a, 0xf3, 0x23,
+	0x1f, 0xf2, 0x29, 0x76, 0x9b, 0x4a, 0xca, 0xf7, 0xa4, 0xa6, 0x1b, 0x83, 0x03, 0xa9, 0x9b, 0x80,
+	0xaf, 0x7d, 0x42, 0xdd, 0x38, 0x7d, 0x77, 0xc0, 0xff, 0x33, 0xff, 0xa6, 0x0c, 0x03, 0xd7, 0x2c,
+	0x07, 0x33, 0xcb, 0x89, 0xc8, 0x17, 0xc6, 0xc9, 0x6f, 0x3a, 0x3a, 0x36, 0xe9, 0xf5, 0xc0, 0x76,
+	0xd3, 0xb9, 0xe4, 0x12, 0x27, 0x96, 0x83, 0x3a, 0xe2, 0xe2, 0xb9, 0xf9, 0x0a, 0x1a, 0xe4, 0x2d,
+	0x47, 0x23, 0x4e, 0xd0, 0x41, 0x69, 0xe5, 0x42, 0x5f, 0xd9, 0x70, 0x2a, 0x2a, 0x37, 0x52, 0x98,
+	0x2a, 0xd5, 0xd4, 0xc5, 0xc2, 0xd8, 0x41, 0xd0, 0x23, 0x2a, 0x5f, 0x08, 0xfe, 0xc4, 0xfa, 0x59,
+	0xcd, 0x14, 0x62, 0x9b, 0xd0, 0xb9, 0x80, 0x20, 0x6f, 0xd0, 0x16, 0x65, 0x41, 0xa3, 0x1d, 0x0e,
+	0x9a, 0xe8, 0x74, 0x52, 0xdc, 0x9f, 0x57, 0xc3, 0x63, 0x41, 0x80, 0x29, 0x1b, 0xeb, 0x24, 0xe8,
+	0xb1, 0xc3, 0x6a, 0xb0, 0x16, 0x44, 0x38, 0xaf, 0x11, 0x83, 0x30, 0xa2, 0x89, 0x73, 0x39, 0x90,
+	0x3f, 0xa4, 0x0e, 0xb6, 0xd2, 0x9f, 0x00, 0x8a, 0x6b, 0x41, 0x8c, 0x29, 0x22, 0xd9, 0x84, 0x99,
+	0x18, 0xd3, 0xa8, 0xc6, 0x07, 0xc9, 0x6f, 0x8a, 0x2a, 0xe2, 0x1e, 0x84, 0xea, 0xd8, 0xad, 0x25,
+	0xfe, 0xc7, 0xad, 0x29, 0x0b, 0xf1, 0x18, 0xa3, 0x77, 0x28, 0xfd, 0x02, 0xa0, 0x78, 0x29, 0x18,
+	0x49, 0xb3, 0x48, 0xe7, 0xb9, 0x15, 0xfe, 0x2b, 0x80, 0x6f, 0x8c, 0x29, 0xbc, 0x7c, 0xb1, 0xb2,
+	0x41, 0x3a, 0xee, 0x0c, 0xfb, 0xf4, 0xa9, 0xa0, 0x12, 0x07, 0x0b, 0x2a, 0x39, 0x14, 0x94, 0x74,
+	0x07, 0xc0, 0x95, 0x75, 0x32, 0xc9, 0x7b, 0x86, 0xb4, 0x73, 0x70, 0xae, 0x49, 0x3a, 0x55, 0xaa,
+	0x85, 0x73, 0x54, 0x49, 0x7b, 0x7b, 0x59, 0x7e, 0x83, 0x74, 0x2a, 0x1f, 0xa8, 0x7c, 0x93, 0x74,
+	0x2a, 0x9a, 0xb4, 0x9f, 0x80, 0x68, 0x42, 0xdb, 0x33, 0xe7, 0x39, 0x78, 0x17, 0x13, 0xd3, 0xde,
+	0xc5, 0x77, 0xe1, 0x5c, 0xf8, 0x53, 0x41, 0x4c, 0xe6, 0x92, 0xf9, 0x23, 0xa5, 0x63, 0xe3, 0x61,
+	0x55, 0xff, 0xab, 0x72, 0xb8, 0xaf, 0xc0, 0xdb, 0x60, 0x5e, 0xe2, 0xbf, 0xf3, 0x43, 0xa9, 0x91,
+	0x8d, 0xaf, 0x3f, 0x72, 0xcd, 0xa6, 0x0e, 0x71, 0xab, 0x38, 0x9c, 0x07, 0x07, 0xbf, 0xdb, 0xa9,
+	0xf0, 0xcd, 0x8e, 0x6c, 0xca, 0x4c, 0x58, 0x87, 0xaf, 0x60, 0xc3, 0xb0, 0xae, 0x12, 0xad, 0x4a,
+	0xed, 0xaa, 0x83, 0x4d, 0x9d, 0xb8, 0x22, 0x9f, 0x4b, 0xe6, 0xd3, 0xca, 0x4a, 0x5f, 0xe1, 0x6f,
+	0x83, 0xc4, 0xd2, 0x51, 0x6f, 0x2f, 0xbb, 0x58, 0x0e, 0x41, 0x95, 0x8b, 0x6a, 0x00, 0x51, 0x17,
+	0x23, 0xab, 0x8a, 0x1d, 0x1e, 0x48, 0x7f, 0x00, 0x88, 0x26, 0xfa, 0x6e, 0xe6, 0xa5, 0x2e, 0xc3,
+	0x79, 0x6c, 0xd3, 0xaa, 0xff, 0xde, 0x86, 0xcd, 0xf8, 0xea, 0x84, 0xeb, 0x80, 0xd2, 0x14, 0x57,
+	0x73, 0xd8, 0xa6, 0x1b, 0xa4, 0x23, 0xfd, 0x0e, 0xe0, 0x89, 0xb1, 0x8e, 0x5c, 0x8b, 0x0d, 0x98,
+	0x97, 0xbd, 0x2f, 0xff, 0x01, 0xf0, 0xf8, 0x3a, 0x79, 0x16, 0xfb, 0x19, 0x92, 0xaf, 0xbf, 0x88,
+	0x49, 0x3f, 0x19, 0x66, 0x74, 0xda, 0xff, 0x05, 0xe0, 0xf1, 0xcf, 0x5f, 0x86, 0x6c, 0x2f, 0x4c,
+	0xcd, 0xf6, 0xf5, 0xc9, 0xdf, 0x8d, 0x43, 0xcc, 0x41, 0xcf, 0x98, 0x72, 0x07, 0xec, 0x74, 0x11,
+	0xd8, 0xed, 0x22, 0xf0, 0xb0, 0x8b, 0xb8, 0x47, 0x5d, 0xc4, 0xed, 0x77, 0x11, 0xf7, 0xb8, 0x8b,
+	0xb8, 0x27, 0x5d, 0x04, 0x6e, 0x78, 0x08, 0xdc, 0xf4, 0x10, 0x77, 0xd7, 0x43, 0xe0, 0x9e, 0x87,
+	0xb8, 0xfb, 0x1e, 0xe2, 0x1e, 0x78, 0x88, 0xdb, 0xf1, 0x10, 0xd8, 0xf5, 0x10, 0x78, 0xe8, 0x21,
+	0xee, 0x91, 0x87, 0xc0, 0xbe, 0x87, 0xb8, 0xc7, 0x1e, 0x02, 0x4f, 0x3c, 0xc4, 0xdd, 0xe8, 0x21,
+	0xee, 0x66, 0x0f, 0x81, 0x5b, 0x3d, 0xc4, 0xfd, 0xd0, 0x43, 0xe0, 0xa7, 0x1e, 0xe2, 0xee, 0xf6,
+	0x10, 0x77, 0xaf, 0x87, 0xc0, 0xfd, 0x1e, 0x02, 0x0f, 0x7a, 0x08, 0x7c, 0x79, 0x46, 0xb7, 0x64,
+	0xb6, 0x45, 0xd8, 0x16, 0x35, 0x75, 0x57, 0x36, 0x09, 0xbb, 0x6a, 0x39, 0xcd, 0xe2, 0xe8, 0x7f,
+	0x37, 0x76, 0x53, 0x2f, 0x32, 0x66, 0xda, 0xb5, 0xda, 0x5c, 0x30, 0x61, 0xce, 0xfe, 0x1b, 0x00,
+	0x00, 0xff, 0xff, 0x31, 0x8c, 0x5b, 0xd9, 0x34, 0x0e, 0x00, 0x00,
 }
 
 func (this *Application) Equal(that interface{}) bool {
@@ -977,6 +1009,9 @@ func (this *ListApplicationsRequest) Equal(that interface{}) bool {
 	if this.Page != that1.Page {
 		return false
 	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
 	return true
 }
 func (this *CreateApplicationRequest) Equal(that interface{}) bool {
@@ -1123,6 +1158,21 @@ func (this *CreateApplicationAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedIPRanges) != len(that1.AllowedIPRanges) {
+		return false
+	}
+	for i := range this.AllowedIPRanges {
+		if this.AllowedIPRanges[i] != that1.AllowedIPRanges[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateApplicationAPIKeyRequest) Equal(that interface{}) bool {
@@ -1432,6 +1482,16 @@ func (m *ListApplicationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Page != 0 {
 		i = encodeVarintApplication(dAtA, i, uint64(m.Page))
 		i--
@@ -1663,21 +1723,40 @@ func (m *CreateApplicationAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedIPRanges) > 0 {
+		for iNdEx := len(m.AllowedIPRanges) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedIPRanges[iNdEx])
+			copy(dAtA[i:], m.AllowedIPRanges[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.AllowedIPRanges[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExpiresAt != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintApplication(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Rights) > 0 {
-		dAtA15 := make([]byte, len(m.Rights)*10)
-		var j14 int
+		dAtA16 := make([]byte, len(m.Rights)*10)
+		var j15 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j14++
+				j15++
 			}
-			dAtA15[j14] = uint8(num)
-			j14++
+			dAtA16[j15] = uint8(num)
+			j15++
 		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintApplication(dAtA, i, uint64(j14))
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintApplication(dAtA, i, uint64(j15))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1948,6 +2027,7 @@ func NewPopulatedListApplicationsRequest(r randyApplication, easy bool) *ListApp
 	this.Order = randStringApplication(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
+	this.Deleted = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2006,6 +2086,14 @@ func NewPopulatedCreateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 	for i := 0; i < v17; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v18 := r.Intn(10)
+	this.AllowedIPRanges = make([]string, v18)
+	for i := 0; i < v18; i++ {
+		this.AllowedIPRanges[i] = randStringApplication(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2013,10 +2101,10 @@ func NewPopulatedCreateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 
 func NewPopulatedUpdateApplicationAPIKeyRequest(r randyApplication, easy bool) *UpdateApplicationAPIKeyRequest {
 	this := &UpdateApplicationAPIKeyRequest{}
-	v18 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v18
-	v19 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v19
+	v19 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v19
+	v20 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v20
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2024,8 +2112,8 @@ func NewPopulatedUpdateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 
 func NewPopulatedListApplicationCollaboratorsRequest(r randyApplication, easy bool) *ListApplicationCollaboratorsRequest {
 	this := &ListApplicationCollaboratorsRequest{}
-	v20 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v20
+	v21 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v21
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -2035,10 +2123,10 @@ func NewPopulatedListApplicationCollaboratorsRequest(r randyApplication, easy bo
 
 func NewPopulatedGetApplicationCollaboratorRequest(r randyApplication, easy bool) *GetApplicationCollaboratorRequest {
 	this := &GetApplicationCollaboratorRequest{}
-	v21 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v21
-	v22 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v22
+	v22 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v22
+	v23 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v23
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2046,10 +2134,10 @@ func NewPopulatedGetApplicationCollaboratorRequest(r randyApplication, easy bool
 
 func NewPopulatedSetApplicationCollaboratorRequest(r randyApplication, easy bool) *SetApplicationCollaboratorRequest {
 	this := &SetApplicationCollaboratorRequest{}
-	v23 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v23
-	v24 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v24
+	v24 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v24
+	v25 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v25
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2074,9 +2162,9 @@ func randUTF8RuneApplication(r randyApplication) rune {
 	return rune(ru + 61)
 }
 func randStringApplication(r randyApplication) string {
-	v25 := r.Intn(100)
-	tmps := make([]rune, v25)
-	for i := 0; i < v25; i++ {
+	v26 := r.Intn(100)
+	tmps := make([]rune, v26)
+	for i := 0; i < v26; i++ {
 		tmps[i] = randUTF8RuneApplication(r)
 	}
 	return string(tmps)
@@ -2098,11 +2186,11 @@ func randFieldApplication(dAtA []byte, r randyApplication, fieldNumber int, wire
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
-		v26 := r.Int63()
+		v27 := r.Int63()
 		if r.Intn(2) == 0 {
-			v26 *= -1
+			v27 *= -1
 		}
-		dAtA = encodeVarintPopulateApplication(dAtA, uint64(v26))
+		dAtA = encodeVarintPopulateApplication(dAtA, uint64(v27))
 	case 1:
 		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2214,6 +2302,9 @@ func (m *ListApplicationsRequest) Size() (n int) {
 	if m.Page != 0 {
 		n += 1 + sovApplication(uint64(m.Page))
 	}
+	if m.Deleted {
+		n += 2
+	}
 	return n
 }
 
@@ -2294,6 +2385,16 @@ func (m *CreateApplicationAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovApplication(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.AllowedIPRanges) > 0 {
+		for _, s := range m.AllowedIPRanges {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2426,6 +2527,7 @@ func (this *ListApplicationsRequest) String() string {
 		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2483,6 +2585,8 @@ func (this *CreateApplicationAPIKeyRequest) String() string {
 		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedIPRanges:` + fmt.Sprintf("%v", this.AllowedIPRanges) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3291,6 +3395,26 @@ func (m *ListApplicationsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -3958,6 +4082,74 @@ func (m *CreateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedIPRanges", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedIPRanges = append(m.AllowedIPRanges, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
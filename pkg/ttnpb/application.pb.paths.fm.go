@@ -47,6 +47,7 @@ var ListApplicationsRequestFieldPathsNested = []string{
 	"collaborator.ids.user_ids",
 	"collaborator.ids.user_ids.email",
 	"collaborator.ids.user_ids.user_id",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -55,6 +56,7 @@ var ListApplicationsRequestFieldPathsNested = []string{
 
 var ListApplicationsRequestFieldPathsTopLevel = []string{
 	"collaborator",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -123,19 +125,25 @@ var GetApplicationAPIKeyRequestFieldPathsTopLevel = []string{
 	"key_id",
 }
 var CreateApplicationAPIKeyRequestFieldPathsNested = []string{
+	"allowed_ip_ranges",
 	"application_ids",
 	"application_ids.application_id",
+	"expires_at",
 	"name",
 	"rights",
 }
 
 var CreateApplicationAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_ip_ranges",
 	"application_ids",
+	"expires_at",
 	"name",
 	"rights",
 }
 var UpdateApplicationAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_ip_ranges",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
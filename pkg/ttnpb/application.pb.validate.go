@@ -469,6 +469,8 @@ func (m *ListApplicationsRequest) ValidateFields(paths ...string) error {
 
 		case "page":
 			// no validation rules for Page
+		case "deleted":
+			// no validation rules for Deleted
 		default:
 			return ListApplicationsRequestValidationError{
 				field:  name,
@@ -1008,6 +1010,27 @@ func (m *CreateApplicationAPIKeyRequest) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateApplicationAPIKeyRequestValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_ip_ranges":
+
+			if len(m.GetAllowedIPRanges()) > 20 {
+				return CreateApplicationAPIKeyRequestValidationError{
+					field:  "allowed_ip_ranges",
+					reason: "value must contain no more than 20 item(s)",
+				}
+			}
+
 		default:
 			return CreateApplicationAPIKeyRequestValidationError{
 				field:  name,
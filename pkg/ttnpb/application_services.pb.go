@@ -37,61 +37,64 @@ func init() {
 }
 
 var fileDescriptor_f6c42f4fe8e3c902 = []byte{
-	// 861 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x48, 0x1c, 0x57,
-	0x18, 0x9f, 0xa7, 0x65, 0xa1, 0x4f, 0x5b, 0xf1, 0x15, 0x5a, 0x58, 0xed, 0xa3, 0x4c, 0x51, 0x8b,
-	0x75, 0x67, 0x5a, 0x97, 0xb6, 0xd8, 0x4a, 0x5b, 0xff, 0x94, 0xad, 0xd8, 0x52, 0x51, 0x7a, 0xd9,
-	0x8b, 0x9d, 0x5d, 0x9f, 0xe3, 0xb0, 0xdb, 0x99, 0xc9, 0xbc, 0xa7, 0x66, 0x5d, 0x04, 0x93, 0x93,
-	0x78, 0x4a, 0xc8, 0x1f, 0x42, 0x48, 0x20, 0x84, 0x84, 0x78, 0x09, 0x78, 0xf4, 0xe8, 0xd1, 0xa3,
-	0x90, 0x8b, 0x47, 0x77, 0x26, 0x07, 0x0f, 0x09, 0x78, 0xf4, 0x90, 0x43, 0x98, 0x37, 0x33, 0x38,
-	0xb3, 0xbb, 0xce, 0xba, 0xbb, 0xb9, 0xb9, 0xdf, 0xfb, 0x7d, 0xdf, 0xf7, 0xfb, 0xbe, 0x6f, 0x7e,
-	0x3f, 0x84, 0x23, 0x45, 0xc3, 0x52, 0xd6, 0x15, 0x3d, 0x45, 0x99, 0x92, 0x2f, 0xc8, 0x8a, 0xa9,
-	0xc9, 0x8a, 0x69, 0x16, 0xb5, 0xbc, 0xc2, 0x34, 0x43, 0x5f, 0xa4, 0xc4, 0x5a, 0xd3, 0xf2, 0x84,
-	0x4a, 0xa6, 0x65, 0x30, 0x03, 0x7d, 0xca, 0x98, 0x2e, 0xf9, 0x19, 0xd2, 0x5a, 0x3a, 0xd9, 0xaf,
-	0x1a, 0x86, 0x5a, 0x24, 0x5e, 0x9a, 0xae, 0x1b, 0x8c, 0x67, 0xf9, 0xe8, 0x64, 0x9f, 0xff, 0xca,
-	0x7f, 0xe5, 0x56, 0x97, 0x65, 0xf2, 0xbf, 0xc9, 0x4a, 0xfe, 0xe3, 0xd7, 0xb1, 0x8d, 0x2f, 0x07,
-	0x69, 0x4b, 0x44, 0x67, 0xda, 0xb2, 0x46, 0xac, 0xa0, 0x0d, 0xae, 0x05, 0x59, 0x9a, 0xba, 0xc2,
-	0xfc, 0xf7, 0xd1, 0xb7, 0x09, 0xf8, 0xd9, 0xc4, 0x45, 0xe9, 0x79, 0xa2, 0x6a, 0x94, 0x59, 0x25,
-	0xe4, 0x00, 0x98, 0x98, 0xb2, 0x88, 0xc2, 0x08, 0xfa, 0x46, 0x8a, 0x0e, 0x26, 0x79, 0xf1, 0x48,
-	0xd6, 0xb5, 0x55, 0x42, 0x59, 0xb2, 0xaf, 0x1a, 0x19, 0xc2, 0x88, 0xb7, 0xc1, 0xcd, 0x57, 0xaf,
-	0xef, 0x74, 0xec, 0x00, 0x31, 0x2d, 0xaf, 0x52, 0x62, 0x51, 0xb9, 0x9c, 0x37, 0x8a, 0x45, 0x25,
-	0x67, 0x58, 0x0a, 0x33, 0x2c, 0xc9, 0x8d, 0x2d, 0x6a, 0x4b, 0x34, 0xf8, 0x63, 0x33, 0x3c, 0x32,
-	0xfd, 0x19, 0x0c, 0x67, 0xe7, 0xc4, 0x59, 0xd9, 0xb0, 0x54, 0x45, 0xd7, 0x36, 0xbc, 0x60, 0x55,
-	0x85, 0xf0, 0x1b, 0xaf, 0x54, 0x15, 0xa8, 0xa9, 0x88, 0x6e, 0x00, 0xd8, 0x99, 0x21, 0x0c, 0x0d,
-	0x54, 0x13, 0xcf, 0x10, 0xd6, 0xec, 0x7c, 0x3f, 0xf2, 0xf1, 0xbe, 0x43, 0x52, 0xa4, 0x8b, 0x5c,
-	0x0e, 0x7f, 0x31, 0x2e, 0xa9, 0xe8, 0xef, 0x4d, 0xf4, 0x06, 0xc0, 0x8f, 0xfe, 0xd2, 0x28, 0x43,
-	0x43, 0xd5, 0xd5, 0xdd, 0x68, 0xa8, 0x03, 0x0d, 0x68, 0xf4, 0xc7, 0xd0, 0xa0, 0xe2, 0x23, 0x6f,
-	0xcf, 0xf7, 0x00, 0xfa, 0x24, 0xc2, 0x24, 0xfb, 0x03, 0x6a, 0x65, 0xf1, 0xd9, 0xbf, 0xd1, 0x87,
-	0xdc, 0x3a, 0xda, 0x01, 0x30, 0xf1, 0xaf, 0xb9, 0x54, 0xf7, 0xc3, 0xf2, 0xe2, 0xcd, 0x2e, 0x7e,
-	0x8c, 0xcf, 0x9b, 0x4e, 0xc6, 0x2c, 0x5e, 0xaa, 0xb3, 0x78, 0xf7, 0xfe, 0x26, 0x4c, 0x4c, 0x93,
-	0x22, 0x61, 0x04, 0x0d, 0xc6, 0x74, 0x98, 0xb9, 0x50, 0x55, 0xf2, 0x73, 0xc9, 0xd3, 0xad, 0x14,
-	0xe8, 0x56, 0xfa, 0xc3, 0xd5, 0xad, 0x38, 0xc8, 0x49, 0x7c, 0x35, 0x8c, 0x63, 0xaf, 0xbf, 0x39,
-	0xfa, 0xae, 0x0b, 0xf6, 0x86, 0x4a, 0x4f, 0xe4, 0xf3, 0x84, 0x52, 0x54, 0x86, 0xd0, 0x3d, 0xf6,
-	0x3c, 0x57, 0x66, 0x13, 0x5c, 0xaa, 0x70, 0x5e, 0xbe, 0x98, 0xe2, 0x5c, 0x86, 0xd0, 0x40, 0x3c,
-	0x17, 0xdf, 0x08, 0xd0, 0x43, 0x00, 0xbb, 0x7d, 0x49, 0xcf, 0xcd, 0xcc, 0x92, 0x12, 0x92, 0x1a,
-	0x0a, 0xde, 0x03, 0x06, 0xd7, 0xa9, 0xe1, 0xe1, 0x3d, 0x8b, 0x93, 0x9c, 0xc7, 0xb8, 0xf8, 0x53,
-	0x73, 0x8a, 0x70, 0x4d, 0x2a, 0x55, 0x20, 0x25, 0xae, 0xd0, 0xfb, 0x00, 0x76, 0x71, 0x1d, 0xf0,
-	0x92, 0x14, 0xa5, 0x1a, 0x88, 0xc4, 0xc7, 0x05, 0xd4, 0xbe, 0xa8, 0x4f, 0x8d, 0x8a, 0xbf, 0x71,
-	0x6e, 0x63, 0xa8, 0x55, 0x6e, 0xee, 0xd6, 0x3e, 0x76, 0x5d, 0xc2, 0x5b, 0xd9, 0xb7, 0xf1, 0x06,
-	0x72, 0xb5, 0x7d, 0xfd, 0xc9, 0x39, 0x4d, 0xa2, 0xdf, 0x5b, 0xe4, 0x24, 0x97, 0x0b, 0xa4, 0xc4,
-	0x3d, 0xe5, 0x05, 0x80, 0xdd, 0xbe, 0x98, 0x2e, 0x39, 0x69, 0x8d, 0xd4, 0xae, 0x46, 0xf1, 0x1f,
-	0x4e, 0x71, 0x26, 0x39, 0xdd, 0x32, 0x45, 0xc5, 0xd4, 0x16, 0x0b, 0xa4, 0x24, 0xf9, 0x0a, 0xbc,
-	0xdb, 0x09, 0x7b, 0x32, 0x84, 0x4d, 0x85, 0x1c, 0x05, 0x7d, 0x1f, 0xbf, 0xcc, 0x30, 0x36, 0xe0,
-	0x3b, 0x54, 0x27, 0x25, 0x8a, 0xa3, 0xa6, 0xa1, 0x53, 0x22, 0x3e, 0xeb, 0xe0, 0x13, 0x3c, 0xee,
-	0x40, 0xbf, 0x34, 0x39, 0x42, 0xd8, 0xf4, 0xb2, 0x39, 0xf4, 0x5f, 0x1b, 0xe9, 0xdc, 0x86, 0x1b,
-	0xb9, 0x70, 0x76, 0x03, 0x5d, 0x6f, 0xa7, 0x47, 0xd8, 0x86, 0x9b, 0xb5, 0x6c, 0xf4, 0x1c, 0xc0,
-	0x9e, 0x85, 0x46, 0x67, 0x59, 0x68, 0x78, 0x96, 0xcb, 0xdc, 0x32, 0xc3, 0x8f, 0x30, 0x91, 0x1c,
-	0x6f, 0x63, 0x40, 0x6e, 0x0f, 0x2f, 0x01, 0xec, 0x75, 0x1d, 0x20, 0xdc, 0x9c, 0xa2, 0x74, 0x03,
-	0x93, 0x88, 0xa0, 0x03, 0xae, 0x5f, 0xd6, 0xb8, 0x5e, 0x18, 0x25, 0x4e, 0x73, 0xca, 0xbf, 0xa2,
-	0xb6, 0x28, 0x4f, 0x3e, 0x05, 0x87, 0x15, 0x0c, 0x8e, 0x2a, 0x18, 0x1c, 0x57, 0xb0, 0x70, 0x52,
-	0xc1, 0xc2, 0x69, 0x05, 0x0b, 0x67, 0x15, 0x2c, 0x9c, 0x57, 0x30, 0xd8, 0xb2, 0x31, 0xd8, 0xb6,
-	0xb1, 0xb0, 0x6b, 0x63, 0xb0, 0x67, 0x63, 0x61, 0xdf, 0xc6, 0xc2, 0x81, 0x8d, 0x85, 0x43, 0x1b,
-	0x83, 0x23, 0x1b, 0x83, 0x63, 0x1b, 0x0b, 0x27, 0x36, 0x06, 0xa7, 0x36, 0x16, 0xce, 0x6c, 0x0c,
-	0xce, 0x6d, 0x2c, 0x6c, 0x39, 0x58, 0xd8, 0x76, 0x30, 0xb8, 0xe5, 0x60, 0xe1, 0x81, 0x83, 0xc1,
-	0x13, 0x07, 0x0b, 0xbb, 0x0e, 0x16, 0xf6, 0x1c, 0x0c, 0xf6, 0x1d, 0x0c, 0x0e, 0x1c, 0x0c, 0xb2,
-	0x23, 0xaa, 0x21, 0xb1, 0x15, 0xc2, 0x56, 0x34, 0x5d, 0xa5, 0x92, 0x4e, 0xd8, 0xba, 0x61, 0x15,
-	0xe4, 0xe8, 0xbf, 0x86, 0x66, 0x41, 0x95, 0x19, 0xd3, 0xcd, 0x5c, 0x2e, 0xc1, 0xaf, 0x95, 0x7e,
-	0x1f, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x6c, 0x5d, 0x6c, 0xff, 0x0a, 0x00, 0x00,
+	// 900 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x4c, 0x2b, 0x45,
+	0x18, 0xdf, 0xe1, 0x69, 0xd5, 0x79, 0x4f, 0x5f, 0xde, 0x98, 0x68, 0xd2, 0x87, 0x13, 0xb3, 0x4a,
+	0x21, 0x48, 0x67, 0x95, 0x46, 0x0d, 0x4a, 0x54, 0xfe, 0x98, 0x4a, 0xd0, 0x48, 0x20, 0x5e, 0x7a,
+	0xc1, 0x6d, 0x19, 0x96, 0x4d, 0xeb, 0xee, 0xba, 0x33, 0x05, 0x4b, 0x43, 0x82, 0x9e, 0x08, 0x27,
+	0x8d, 0x7f, 0x62, 0x8c, 0x26, 0xc6, 0x68, 0xe4, 0x62, 0xc2, 0x91, 0x23, 0x47, 0x8e, 0x24, 0x5e,
+	0xb8, 0x49, 0x77, 0x3d, 0x70, 0xf0, 0xc0, 0x91, 0x83, 0x07, 0xb3, 0xb3, 0xbb, 0x61, 0xb7, 0x2d,
+	0xbb, 0xb4, 0xf5, 0xd6, 0x9d, 0xf9, 0x7d, 0xf3, 0xfb, 0x7d, 0xdf, 0x37, 0xdf, 0x6f, 0x0a, 0x27,
+	0x6a, 0xa6, 0xad, 0x6e, 0xa9, 0x46, 0x9e, 0x71, 0xb5, 0x52, 0x55, 0x54, 0x4b, 0x57, 0x54, 0xcb,
+	0xaa, 0xe9, 0x15, 0x95, 0xeb, 0xa6, 0xb1, 0xca, 0xa8, 0xbd, 0xa9, 0x57, 0x28, 0x23, 0x96, 0x6d,
+	0x72, 0x13, 0x3d, 0xc5, 0xb9, 0x41, 0x82, 0x08, 0xb2, 0x59, 0xc8, 0x0e, 0x6b, 0xa6, 0xa9, 0xd5,
+	0xa8, 0x1f, 0x66, 0x18, 0x26, 0x17, 0x51, 0x01, 0x3a, 0xfb, 0x30, 0xd8, 0x15, 0x5f, 0xe5, 0xfa,
+	0xba, 0x42, 0x3f, 0xb1, 0x78, 0x23, 0xd8, 0x7c, 0x21, 0x91, 0xf8, 0x66, 0x90, 0xbe, 0x46, 0x0d,
+	0xae, 0xaf, 0xeb, 0xd4, 0x0e, 0x69, 0x70, 0x27, 0xc8, 0xd6, 0xb5, 0x0d, 0x1e, 0xec, 0x4f, 0xfe,
+	0xf5, 0x38, 0x7c, 0x7a, 0xe6, 0xfa, 0xe8, 0x65, 0xaa, 0xe9, 0x8c, 0xdb, 0x0d, 0xe4, 0x02, 0x98,
+	0x99, 0xb3, 0xa9, 0xca, 0x29, 0x1a, 0x23, 0xf1, 0xc4, 0x88, 0xbf, 0x1e, 0x8b, 0xfa, 0xb4, 0x4e,
+	0x19, 0xcf, 0x3e, 0x6c, 0x47, 0x46, 0x30, 0xf2, 0x57, 0xe0, 0x8b, 0x3f, 0xff, 0xfe, 0x7a, 0x68,
+	0x1f, 0xc8, 0x05, 0xa5, 0xce, 0xa8, 0xcd, 0x94, 0x66, 0xc5, 0xac, 0xd5, 0xd4, 0xb2, 0x69, 0xab,
+	0xdc, 0xb4, 0x89, 0xb7, 0xb6, 0xaa, 0xaf, 0xb1, 0xf0, 0xc7, 0x4e, 0x34, 0x65, 0xf6, 0x06, 0x18,
+	0x2f, 0x2d, 0xc9, 0x8b, 0x8a, 0x69, 0x6b, 0xaa, 0xa1, 0x6f, 0xfb, 0x8b, 0x6d, 0x27, 0x44, 0xf7,
+	0xc4, 0x49, 0x6d, 0x0b, 0x1d, 0x27, 0xa2, 0xcf, 0x01, 0xbc, 0x53, 0xa4, 0x1c, 0x8d, 0xb4, 0x0b,
+	0x2f, 0x52, 0xde, 0x6b, 0x7e, 0xaf, 0x89, 0xf4, 0x5e, 0x46, 0x24, 0xc6, 0xa2, 0x34, 0x23, 0x5f,
+	0x42, 0x54, 0xfc, 0x7b, 0x07, 0xfd, 0x03, 0xe0, 0x23, 0xef, 0xeb, 0x8c, 0xa3, 0xd1, 0xf6, 0xd3,
+	0xbd, 0xd5, 0x08, 0x03, 0x0b, 0x65, 0x0c, 0x27, 0xc8, 0x60, 0xf2, 0x8f, 0x7e, 0x9d, 0xbf, 0x05,
+	0xe8, 0xc9, 0x98, 0x92, 0xd2, 0xab, 0xa8, 0x9f, 0xc2, 0x97, 0x3e, 0x40, 0xff, 0x67, 0xd5, 0xd1,
+	0x3e, 0x80, 0x99, 0x8f, 0xac, 0xb5, 0xae, 0x17, 0xcb, 0x5f, 0xef, 0xb5, 0xf0, 0x53, 0x22, 0xdf,
+	0x42, 0x36, 0xa1, 0xf0, 0xa4, 0x4b, 0xe1, 0xbd, 0xfe, 0x5b, 0x30, 0x33, 0x4f, 0x6b, 0x94, 0x53,
+	0x94, 0x4b, 0x60, 0x58, 0xb8, 0x9e, 0xaa, 0xec, 0x33, 0xc4, 0x9f, 0x5b, 0x12, 0xce, 0x2d, 0x79,
+	0xd7, 0x9b, 0x5b, 0x39, 0x27, 0x44, 0x3c, 0x3f, 0x8e, 0x13, 0xbb, 0xbf, 0x83, 0x1a, 0xf0, 0xb1,
+	0x65, 0xca, 0xb8, 0x69, 0x0f, 0x4e, 0x49, 0x04, 0xe5, 0x98, 0x9c, 0x4b, 0xa6, 0x54, 0xec, 0x80,
+	0xaf, 0x0e, 0x1f, 0x5d, 0xaa, 0xdb, 0xda, 0xe0, 0xc4, 0x13, 0x82, 0x38, 0x37, 0xfe, 0x62, 0x0a,
+	0xb1, 0xe5, 0xb1, 0x4d, 0xfe, 0x7b, 0x17, 0x3e, 0x88, 0x10, 0xcc, 0x54, 0x2a, 0x94, 0x31, 0xd4,
+	0x84, 0xd0, 0xbb, 0xde, 0xcb, 0xc2, 0x8b, 0x7a, 0x50, 0xd4, 0x86, 0xf3, 0xe3, 0xe5, 0xbc, 0x50,
+	0x34, 0x8a, 0x46, 0xd2, 0x4a, 0xe1, 0xd3, 0xfd, 0x00, 0xe0, 0xbd, 0xc0, 0xc4, 0x96, 0x16, 0x16,
+	0x69, 0x03, 0x91, 0x54, 0x8b, 0xf3, 0x81, 0xe1, 0x7d, 0xec, 0xd0, 0xe1, 0x6f, 0xcb, 0xb3, 0x42,
+	0xc7, 0xb4, 0xfc, 0x7a, 0x6f, 0x1e, 0xe0, 0xd9, 0x72, 0xbe, 0x4a, 0x1b, 0xc2, 0x93, 0xbe, 0x03,
+	0xf0, 0xae, 0x98, 0x7c, 0x71, 0x24, 0x43, 0xf9, 0x14, 0x5b, 0x08, 0x70, 0xa1, 0xb4, 0x67, 0xbb,
+	0x4b, 0x63, 0xf2, 0xdb, 0x42, 0xdb, 0x14, 0xea, 0x57, 0x9b, 0x57, 0xb5, 0x27, 0x3c, 0x5f, 0xf4,
+	0x4b, 0xf6, 0x52, 0xb2, 0x65, 0xde, 0xae, 0x5e, 0xef, 0x09, 0x4d, 0xb3, 0xe8, 0x9d, 0x3e, 0x35,
+	0x29, 0xcd, 0x2a, 0x6d, 0x88, 0xb9, 0xfa, 0x1d, 0xc0, 0x7b, 0x81, 0x7d, 0xdc, 0xd0, 0xd2, 0x0e,
+	0x73, 0xb9, 0x9d, 0xc4, 0x0f, 0x85, 0xc4, 0x85, 0xec, 0x7c, 0xdf, 0x12, 0x55, 0x4b, 0x5f, 0xad,
+	0xd2, 0x06, 0x09, 0x3c, 0xe7, 0x9b, 0x3b, 0xf0, 0x7e, 0x91, 0xf2, 0xb9, 0x88, 0x87, 0xa2, 0x57,
+	0x92, 0x8b, 0x19, 0xc5, 0x86, 0x7a, 0x47, 0xbb, 0x84, 0xc4, 0x71, 0xcc, 0x32, 0x0d, 0x46, 0xe5,
+	0x5f, 0x87, 0x44, 0x06, 0x3f, 0x0d, 0xa1, 0x37, 0x7b, 0x4c, 0x21, 0x6a, 0xf3, 0xa5, 0x32, 0xfa,
+	0x78, 0x80, 0x70, 0xf1, 0xf0, 0xa4, 0xbd, 0x3b, 0xa5, 0x6d, 0xf4, 0xd9, 0x20, 0x1c, 0xd1, 0x87,
+	0xa7, 0xd7, 0x47, 0x0a, 0xfd, 0x06, 0xe0, 0xfd, 0x95, 0xb4, 0xb6, 0xac, 0xa4, 0xb6, 0xe5, 0x26,
+	0xcf, 0x2c, 0x8a, 0x26, 0xcc, 0x64, 0xa7, 0x07, 0x48, 0x50, 0xd8, 0xc3, 0x1f, 0x00, 0x3e, 0xf0,
+	0x1c, 0x20, 0x4a, 0xce, 0x50, 0x21, 0xc5, 0x24, 0x62, 0xe8, 0x50, 0xeb, 0x73, 0x1d, 0xae, 0x17,
+	0x45, 0xc9, 0xf3, 0x42, 0xf2, 0x5b, 0x68, 0x20, 0xc9, 0xb3, 0xbf, 0x80, 0x93, 0x16, 0x06, 0xa7,
+	0x2d, 0x0c, 0xce, 0x5a, 0x58, 0x3a, 0x6f, 0x61, 0xe9, 0xa2, 0x85, 0xa5, 0xcb, 0x16, 0x96, 0xae,
+	0x5a, 0x18, 0xec, 0x3a, 0x18, 0xec, 0x39, 0x58, 0x3a, 0x70, 0x30, 0x38, 0x74, 0xb0, 0x74, 0xe4,
+	0x60, 0xe9, 0xd8, 0xc1, 0xd2, 0x89, 0x83, 0xc1, 0xa9, 0x83, 0xc1, 0x99, 0x83, 0xa5, 0x73, 0x07,
+	0x83, 0x0b, 0x07, 0x4b, 0x97, 0x0e, 0x06, 0x57, 0x0e, 0x96, 0x76, 0x5d, 0x2c, 0xed, 0xb9, 0x18,
+	0x7c, 0xe9, 0x62, 0xe9, 0x7b, 0x17, 0x83, 0x9f, 0x5d, 0x2c, 0x1d, 0xb8, 0x58, 0x3a, 0x74, 0x31,
+	0x38, 0x72, 0x31, 0x38, 0x76, 0x31, 0x28, 0x4d, 0x68, 0x26, 0xe1, 0x1b, 0x94, 0x6f, 0xe8, 0x86,
+	0xc6, 0x88, 0x41, 0xf9, 0x96, 0x69, 0x57, 0x95, 0xf8, 0x9f, 0x61, 0xab, 0xaa, 0x29, 0x9c, 0x1b,
+	0x56, 0xb9, 0x9c, 0x11, 0xdd, 0x2a, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x3d, 0xa5, 0xe4, 0xcb,
+	0xf1, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -117,6 +120,11 @@ type ApplicationRegistryClient interface {
 	List(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*Applications, error)
 	Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*Application, error)
 	Delete(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Restore a recently deleted application. Only admins can restore applications.
+	Restore(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the application. This permanently deletes the application and the data that is
+	// associated with it, and releases its ID. Only admins can purge applications.
+	Purge(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type applicationRegistryClient struct {
@@ -172,6 +180,24 @@ func (c *applicationRegistryClient) Delete(ctx context.Context, in *ApplicationI
 	return out, nil
 }
 
+func (c *applicationRegistryClient) Restore(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationRegistry/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationRegistryClient) Purge(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationRegistry/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationRegistryServer is the server API for ApplicationRegistry service.
 type ApplicationRegistryServer interface {
 	// Create a new application. This also sets the given organization or user as
@@ -185,6 +211,11 @@ type ApplicationRegistryServer interface {
 	List(context.Context, *ListApplicationsRequest) (*Applications, error)
 	Update(context.Context, *UpdateApplicationRequest) (*Application, error)
 	Delete(context.Context, *ApplicationIdentifiers) (*types.Empty, error)
+	// Restore a recently deleted application. Only admins can restore applications.
+	Restore(context.Context, *ApplicationIdentifiers) (*types.Empty, error)
+	// Purge the application. This permanently deletes the application and the data that is
+	// associated with it, and releases its ID. Only admins can purge applications.
+	Purge(context.Context, *ApplicationIdentifiers) (*types.Empty, error)
 }
 
 // UnimplementedApplicationRegistryServer can be embedded to have forward compatible implementations.
@@ -206,6 +237,12 @@ func (*UnimplementedApplicationRegistryServer) Update(ctx context.Context, req *
 func (*UnimplementedApplicationRegistryServer) Delete(ctx context.Context, req *ApplicationIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedApplicationRegistryServer) Restore(ctx context.Context, req *ApplicationIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (*UnimplementedApplicationRegistryServer) Purge(ctx context.Context, req *ApplicationIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
+}
 
 func RegisterApplicationRegistryServer(s *grpc.Server, srv ApplicationRegistryServer) {
 	s.RegisterService(&_ApplicationRegistry_serviceDesc, srv)
@@ -301,6 +338,42 @@ func _ApplicationRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationRegistry_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationRegistryServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationRegistry/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationRegistryServer).Restore(ctx, req.(*ApplicationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationRegistry_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationRegistryServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationRegistry/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationRegistryServer).Purge(ctx, req.(*ApplicationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.ApplicationRegistry",
 	HandlerType: (*ApplicationRegistryServer)(nil),
@@ -325,6 +398,14 @@ var _ApplicationRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _ApplicationRegistry_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _ApplicationRegistry_Purge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/application_services.proto",
@@ -316,92 +316,94 @@ func init() {
 }
 
 var fileDescriptor_df9d75a19dc066e1 = []byte{
-	// 1347 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x6c, 0x13, 0xc7,
-	0x1a, 0xdf, 0xb1, 0x4d, 0x42, 0x86, 0xf7, 0x20, 0x2c, 0x3c, 0x5e, 0xe2, 0xc7, 0x1b, 0x47, 0x86,
-	0x22, 0xc7, 0xc2, 0xbb, 0xd4, 0xb4, 0x55, 0x9b, 0xaa, 0x8d, 0xd6, 0x10, 0x52, 0x4a, 0xa2, 0x82,
-	0x1d, 0x54, 0x29, 0xfc, 0xb1, 0x26, 0xde, 0xb1, 0xb3, 0xb2, 0xbd, 0xbb, 0xec, 0xcc, 0x26, 0x18,
-	0x88, 0x84, 0xaa, 0x8a, 0x22, 0x0e, 0x2d, 0x6a, 0x85, 0xc4, 0xb1, 0x6a, 0x2f, 0x1c, 0x51, 0x7b,
-	0x28, 0xa7, 0x96, 0x4b, 0x25, 0xd4, 0x5e, 0xa8, 0x7a, 0x41, 0xaa, 0x94, 0xe2, 0x75, 0x0f, 0x48,
-	0xbd, 0x70, 0xa4, 0x9c, 0xaa, 0x9d, 0x5d, 0xff, 0x89, 0x37, 0x09, 0x86, 0x22, 0xaa, 0xde, 0x66,
-	0x77, 0x7e, 0xdf, 0xef, 0xfb, 0x7d, 0xdf, 0xfc, 0xbe, 0xf1, 0x1a, 0x8e, 0x56, 0x0c, 0x0b, 0x2f,
-	0x62, 0x3d, 0x45, 0x19, 0x2e, 0x94, 0x65, 0x6c, 0x6a, 0x32, 0x36, 0xcd, 0x8a, 0x56, 0xc0, 0x4c,
-	0x33, 0x74, 0x4a, 0xac, 0x05, 0x62, 0x49, 0xa6, 0x65, 0x30, 0x43, 0xdc, 0xcc, 0x98, 0x2e, 0xf9,
-	0x70, 0x69, 0x61, 0x7f, 0x54, 0x29, 0x69, 0x6c, 0xde, 0x9e, 0x93, 0x0a, 0x46, 0x55, 0x26, 0xfa,
-	0x82, 0x51, 0x33, 0x2d, 0xe3, 0x6c, 0x4d, 0xe6, 0xe0, 0x42, 0xaa, 0x44, 0xf4, 0xd4, 0x02, 0xae,
-	0x68, 0x2a, 0x66, 0x44, 0x0e, 0x2c, 0x3c, 0xca, 0x68, 0xaa, 0x83, 0xa2, 0x64, 0x94, 0x0c, 0x2f,
-	0x78, 0xce, 0x2e, 0xf2, 0x27, 0xfe, 0xc0, 0x57, 0x3e, 0x7c, 0x67, 0xc9, 0x30, 0x4a, 0x15, 0xe2,
-	0xa9, 0xd4, 0x75, 0x83, 0x79, 0x22, 0xfd, 0xdd, 0xff, 0xf9, 0xbb, 0x2d, 0x0e, 0x52, 0x35, 0x59,
-	0xcd, 0xdf, 0x1c, 0xe9, 0xde, 0x2c, 0x6a, 0xa4, 0xa2, 0xe6, 0xab, 0x98, 0x96, 0x7d, 0x44, 0xac,
-	0x1b, 0xc1, 0xb4, 0x2a, 0xa1, 0x0c, 0x57, 0x4d, 0x1f, 0x10, 0x0f, 0xb6, 0x8a, 0xe8, 0x6a, 0x5e,
-	0x25, 0x0b, 0x5a, 0xa1, 0x59, 0xd0, 0xae, 0x20, 0x46, 0x53, 0x89, 0xce, 0xb4, 0xa2, 0x46, 0xac,
-	0xa6, 0xd0, 0x91, 0x20, 0xa8, 0x4a, 0x28, 0xc5, 0x25, 0xd2, 0x44, 0xec, 0x5c, 0x05, 0x71, 0x86,
-	0x31, 0x6f, 0x37, 0xfe, 0x47, 0x08, 0x6e, 0x51, 0xda, 0x87, 0x34, 0xa5, 0xe9, 0x65, 0xf1, 0x7b,
-	0x00, 0x77, 0xe8, 0x84, 0x2d, 0x1a, 0x56, 0x39, 0xef, 0x9d, 0x5a, 0x1e, 0xab, 0xaa, 0x45, 0x28,
-	0x1d, 0x02, 0x23, 0x20, 0x31, 0x90, 0xf9, 0x18, 0x3c, 0xce, 0x5c, 0x01, 0xd6, 0x47, 0x20, 0xfd,
-	0x21, 0x38, 0x9d, 0x18, 0x1f, 0x4b, 0x8c, 0x8f, 0x9d, 0xc0, 0xa9, 0x73, 0x4a, 0x6a, 0x76, 0x5f,
-	0xea, 0x8d, 0x53, 0x17, 0x3a, 0xd6, 0xed, 0xe5, 0xc9, 0xd4, 0xa9, 0x64, 0xc7, 0xc6, 0xe8, 0x49,
-	0x69, 0x34, 0xe9, 0xc6, 0x29, 0xa9, 0x59, 0x9c, 0x3a, 0xe7, 0xc5, 0xb5, 0xd7, 0xed, 0x25, 0x8f,
-	0x6b, 0x6f, 0x8c, 0x26, 0xc6, 0xc7, 0xc6, 0x4e, 0xb8, 0xab, 0xf3, 0x2f, 0xef, 0x7d, 0x75, 0x69,
-	0x74, 0x7c, 0xf7, 0x85, 0xd3, 0xbb, 0xb3, 0xdb, 0x7d, 0xb9, 0x39, 0xae, 0x56, 0xf1, 0xc4, 0x8a,
-	0x49, 0xd8, 0x8f, 0x4d, 0x2d, 0x5f, 0x26, 0xb5, 0xa1, 0x10, 0xd7, 0xbd, 0xf5, 0x71, 0x26, 0x62,
-	0x85, 0x06, 0x81, 0xb3, 0x1c, 0xeb, 0x53, 0x8e, 0x1e, 0x3e, 0x42, 0x6a, 0xd9, 0x3e, 0x6c, 0x6a,
-	0x47, 0x48, 0x4d, 0x7c, 0x1f, 0x8a, 0x2a, 0x29, 0x62, 0xbb, 0xc2, 0xf2, 0x45, 0xc3, 0xaa, 0x62,
-	0xc6, 0x88, 0x45, 0x87, 0xc2, 0x23, 0x20, 0xb1, 0x29, 0x9d, 0x90, 0x56, 0xba, 0x55, 0x9a, 0xf6,
-	0x3a, 0x7c, 0x14, 0xd7, 0x2a, 0x06, 0x56, 0x0f, 0xb5, 0xf0, 0xd9, 0xad, 0x3e, 0x47, 0xfb, 0x95,
-	0x38, 0x0c, 0xc3, 0xac, 0x42, 0x87, 0x22, 0x23, 0x20, 0xb1, 0x31, 0xd3, 0xef, 0x2c, 0xc7, 0xc2,
-	0x33, 0x53, 0xb9, 0xac, 0xfb, 0x2e, 0xfe, 0x1d, 0x80, 0xc3, 0x93, 0x84, 0x75, 0xb5, 0x3f, 0x4b,
-	0xce, 0xd8, 0x84, 0x32, 0x11, 0xc3, 0x2d, 0x1d, 0xd3, 0x93, 0xd7, 0x54, 0xaf, 0xfb, 0x9b, 0xd2,
-	0x7b, 0xba, 0xe5, 0x74, 0x10, 0x1c, 0x6e, 0x1b, 0x24, 0x33, 0xf8, 0x38, 0xb3, 0xe1, 0x0a, 0x08,
-	0x0d, 0x82, 0x3b, 0xcb, 0x31, 0xe1, 0xee, 0x72, 0x0c, 0x64, 0x37, 0xe3, 0x4e, 0x24, 0x15, 0xc7,
-	0x21, 0x6c, 0x5b, 0x97, 0xf7, 0x68, 0x53, 0x3a, 0x2a, 0x79, 0xde, 0x95, 0x9a, 0xde, 0x95, 0x0e,
-	0xb9, 0x90, 0x69, 0x4c, 0xcb, 0x99, 0x88, 0xcb, 0x94, 0x1d, 0x28, 0x36, 0x5f, 0xc4, 0x2f, 0x85,
-	0xe0, 0x70, 0xee, 0xef, 0xac, 0x60, 0x02, 0x46, 0x2a, 0x9a, 0xde, 0xd4, 0x1e, 0x5b, 0x87, 0xd7,
-	0x15, 0xb6, 0x0a, 0x21, 0x0f, 0xef, 0x6a, 0x44, 0xf8, 0xe9, 0x1b, 0xf1, 0x49, 0x04, 0x6e, 0xef,
-	0x4a, 0x96, 0x63, 0x98, 0x51, 0xf1, 0x2d, 0x38, 0xe0, 0x66, 0x20, 0x6a, 0x1e, 0x33, 0xbf, 0xfa,
-	0x20, 0xf1, 0x4c, 0xf3, 0x76, 0xc8, 0x44, 0xae, 0xfe, 0x1a, 0x03, 0xd9, 0x8d, 0x5e, 0x88, 0xc2,
-	0xd6, 0x1b, 0xc5, 0xd0, 0x3f, 0x69, 0x14, 0xdf, 0x83, 0xdb, 0x2a, 0x98, 0xb2, 0xbc, 0x6d, 0xe6,
-	0x2d, 0x52, 0x20, 0xda, 0x82, 0xd7, 0x90, 0x70, 0x8f, 0x0d, 0x19, 0x74, 0x83, 0x8f, 0x9b, 0x59,
-	0x3f, 0x54, 0x61, 0xe2, 0x30, 0xdc, 0x68, 0x9b, 0xf9, 0x82, 0x61, 0xeb, 0x8c, 0xcf, 0x56, 0x24,
-	0xdb, 0x6f, 0x9b, 0x07, 0xdc, 0x47, 0xf1, 0x14, 0x8c, 0xf2, 0x5c, 0xaa, 0xb1, 0xa8, 0xbb, 0x8d,
-	0x74, 0x07, 0x7a, 0x11, 0x5b, 0xaa, 0x97, 0x72, 0x43, 0x8f, 0x29, 0xff, 0xeb, 0x72, 0x1c, 0xf4,
-	0x29, 0x0e, 0x35, 0x19, 0x14, 0x26, 0xbe, 0x04, 0x37, 0xb7, 0x98, 0xbd, 0xfc, 0x7d, 0x3c, 0xff,
-	0xbf, 0x9b, 0x6f, 0xb9, 0x8a, 0xf4, 0x0f, 0x11, 0x18, 0x52, 0xa8, 0x78, 0x0d, 0xc0, 0xfe, 0x49,
-	0xc2, 0xf8, 0xbd, 0x3a, 0xda, 0x6d, 0xcf, 0x35, 0x87, 0x3f, 0xfa, 0x24, 0x27, 0xc7, 0xdf, 0xfe,
-	0xe0, 0xe7, 0xdf, 0x3e, 0x0b, 0xbd, 0x2e, 0xbe, 0x26, 0x63, 0xba, 0xe2, 0x57, 0x56, 0x3e, 0xdf,
-	0x35, 0x73, 0xd2, 0xca, 0xe7, 0x25, 0x99, 0x3b, 0xfe, 0x3a, 0x80, 0xfd, 0xb9, 0xb5, 0x74, 0xe5,
-	0x9e, 0x5d, 0x97, 0xc2, 0x75, 0xbd, 0x19, 0x7d, 0x46, 0x5d, 0x63, 0x20, 0x29, 0x5e, 0x80, 0xf0,
-	0x20, 0xa9, 0x10, 0x46, 0xb8, 0xb8, 0x1e, 0xef, 0x8a, 0xe8, 0x8e, 0xc0, 0x89, 0x4e, 0xb8, 0x3f,
-	0xd9, 0x71, 0x89, 0x0b, 0x4a, 0x24, 0xf7, 0x3c, 0x49, 0x90, 0xdf, 0x98, 0x4f, 0x01, 0xfc, 0x97,
-	0x7f, 0x60, 0xde, 0x04, 0xf7, 0x2a, 0x60, 0xf7, 0x13, 0x5a, 0xc3, 0xd9, 0xe2, 0xaf, 0x70, 0x39,
-	0x92, 0xb8, 0xb7, 0x37, 0x39, 0x32, 0x75, 0xa3, 0xd2, 0xbf, 0xf4, 0xc1, 0x0d, 0x8a, 0x69, 0x2a,
-	0x54, 0x9c, 0x81, 0x03, 0x39, 0x7b, 0x8e, 0x16, 0x2c, 0x6d, 0x8e, 0xf4, 0x2c, 0xed, 0xff, 0xeb,
-	0xe0, 0x8e, 0x9b, 0xfb, 0x80, 0xf8, 0x23, 0x80, 0x5b, 0x9b, 0x5e, 0x3f, 0x66, 0x13, 0x9b, 0x1c,
-	0xb5, 0xe9, 0xbc, 0x18, 0xa8, 0x68, 0x05, 0xa4, 0x69, 0x89, 0xb5, 0x1a, 0x7f, 0x96, 0x57, 0x6a,
-	0xc5, 0xab, 0xc1, 0x4a, 0xdb, 0x9f, 0x3a, 0xab, 0x18, 0x21, 0x68, 0x0c, 0x0f, 0x1a, 0x8c, 0x6b,
-	0x2d, 0x97, 0x64, 0x77, 0xf6, 0x64, 0xd3, 0xa6, 0xf3, 0xae, 0x81, 0x7e, 0x02, 0x70, 0x7b, 0x97,
-	0x54, 0xb3, 0x82, 0x0b, 0xe4, 0x2f, 0x16, 0x74, 0x9e, 0x17, 0x64, 0xc7, 0xcd, 0x17, 0x56, 0x90,
-	0xe5, 0xe9, 0x76, 0x6b, 0xfa, 0xba, 0xfb, 0x84, 0xa6, 0x34, 0xca, 0x82, 0x05, 0x4d, 0xe8, 0xea,
-	0x41, 0x4e, 0xd2, 0xab, 0x33, 0x9b, 0x9c, 0x34, 0x9e, 0xe5, 0xe5, 0x4d, 0x89, 0xef, 0x3e, 0xfd,
-	0xe4, 0xb6, 0xea, 0xe9, 0x2a, 0x40, 0xfc, 0x12, 0xc0, 0xff, 0x4c, 0x12, 0x36, 0x7d, 0x6c, 0x66,
-	0xe6, 0x80, 0xa1, 0xeb, 0xa4, 0xc0, 0x9d, 0xa9, 0x17, 0x8d, 0x9e, 0xad, 0x1b, 0x0f, 0x7c, 0x7b,
-	0x05, 0xb8, 0x7a, 0xbf, 0x0b, 0x97, 0xf8, 0x97, 0x6f, 0xaa, 0xd0, 0x0a, 0x4f, 0x69, 0x7a, 0xd1,
-	0x48, 0xff, 0x1e, 0x81, 0xdb, 0x14, 0xda, 0x6a, 0x5d, 0x96, 0x94, 0x34, 0xca, 0xac, 0x9a, 0xf8,
-	0x15, 0x80, 0xe1, 0x49, 0xc2, 0xc4, 0x5d, 0xab, 0xdc, 0xdb, 0x1d, 0x68, 0xcf, 0x35, 0xc3, 0x6b,
-	0x1e, 0x45, 0xbc, 0xcc, 0xf5, 0x11, 0xb1, 0xf0, 0x02, 0x8c, 0x23, 0x5e, 0x0a, 0xc1, 0x70, 0x6e,
-	0x35, 0xd1, 0xb9, 0xa7, 0x13, 0xfd, 0x2d, 0xe0, 0xaa, 0xbf, 0x01, 0xd1, 0x75, 0x65, 0x4b, 0xcf,
-	0x28, 0x5b, 0x5a, 0x29, 0x7b, 0x0c, 0x24, 0x67, 0xa7, 0xe3, 0xef, 0x3c, 0xaf, 0x4c, 0xee, 0xc4,
-	0x5c, 0x03, 0xb0, 0xcf, 0xfb, 0x1d, 0xe9, 0x71, 0x4c, 0xd6, 0x9a, 0xfb, 0x69, 0xde, 0x88, 0xc9,
-	0xe4, 0xc4, 0x73, 0x19, 0x8c, 0xcc, 0x17, 0xe0, 0x4e, 0x1d, 0x81, 0xbb, 0x75, 0x04, 0xee, 0xd5,
-	0x91, 0x70, 0xbf, 0x8e, 0x84, 0x07, 0x75, 0x24, 0x3c, 0xac, 0x23, 0xe1, 0x51, 0x1d, 0x81, 0x8b,
-	0x0e, 0x02, 0x97, 0x1d, 0x24, 0xdc, 0x70, 0x10, 0xb8, 0xe9, 0x20, 0xe1, 0x96, 0x83, 0x84, 0xdb,
-	0x0e, 0x12, 0xee, 0x38, 0x08, 0xdc, 0x75, 0x10, 0xb8, 0xe7, 0x20, 0xe1, 0xbe, 0x83, 0xc0, 0x03,
-	0x07, 0x09, 0x0f, 0x1d, 0x04, 0x1e, 0x39, 0x48, 0xb8, 0xd8, 0x40, 0xc2, 0xe5, 0x06, 0x02, 0x57,
-	0x1b, 0x48, 0xb8, 0xde, 0x40, 0xe0, 0xf3, 0x06, 0x12, 0x6e, 0x34, 0x90, 0x70, 0xb3, 0x81, 0xc0,
-	0xad, 0x06, 0x02, 0xb7, 0x1b, 0x08, 0xcc, 0xee, 0x2d, 0x19, 0x12, 0x9b, 0x27, 0x6c, 0x5e, 0xd3,
-	0x4b, 0x54, 0xf2, 0x3f, 0xd2, 0xe4, 0x95, 0xff, 0x0d, 0xcd, 0x72, 0x49, 0x66, 0x4c, 0x37, 0xe7,
-	0xe6, 0xfa, 0x78, 0x0f, 0xf6, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x64, 0x22, 0xcb, 0xfb, 0xd3,
-	0x0f, 0x00, 0x00,
+	// 1380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x6c, 0x13, 0x47,
+	0x17, 0xdf, 0xb1, 0x9d, 0x84, 0x0c, 0xdf, 0x07, 0x61, 0xe1, 0xe3, 0x4b, 0x5c, 0x3a, 0x4e, 0x0d,
+	0x45, 0x8e, 0x85, 0xd7, 0xd4, 0xb4, 0x55, 0x9b, 0xaa, 0x8d, 0xd6, 0x10, 0x52, 0x9a, 0x44, 0x0d,
+	0x76, 0x50, 0xa5, 0xf0, 0xc7, 0x9a, 0x78, 0xc7, 0xce, 0xca, 0xf6, 0xee, 0xb2, 0x33, 0x9b, 0x60,
+	0x20, 0x12, 0xaa, 0x2a, 0x8a, 0x38, 0xb4, 0xa8, 0x15, 0x12, 0x87, 0x1e, 0xaa, 0xf6, 0xc2, 0x11,
+	0xb5, 0x87, 0x72, 0x6a, 0xb9, 0x54, 0x42, 0xea, 0x85, 0xaa, 0x17, 0xa4, 0x4a, 0x29, 0x5e, 0xf7,
+	0x80, 0xd4, 0x0b, 0x47, 0xca, 0xa9, 0xda, 0xd9, 0xf5, 0x9f, 0x78, 0x93, 0x60, 0x28, 0xa2, 0xea,
+	0x6d, 0x76, 0xdf, 0x7b, 0xbf, 0xf7, 0x7b, 0xbf, 0x79, 0x6f, 0x76, 0x16, 0x8e, 0x94, 0x75, 0x13,
+	0x2f, 0x61, 0x2d, 0x41, 0x19, 0xce, 0x97, 0x92, 0xd8, 0x50, 0x93, 0xd8, 0x30, 0xca, 0x6a, 0x1e,
+	0x33, 0x55, 0xd7, 0x28, 0x31, 0x17, 0x89, 0x29, 0x19, 0xa6, 0xce, 0x74, 0x71, 0x0b, 0x63, 0x9a,
+	0xe4, 0xb9, 0x4b, 0x8b, 0x07, 0xc2, 0x72, 0x51, 0x65, 0x0b, 0xd6, 0xbc, 0x94, 0xd7, 0x2b, 0x49,
+	0xa2, 0x2d, 0xea, 0x55, 0xc3, 0xd4, 0xcf, 0x54, 0x93, 0xdc, 0x39, 0x9f, 0x28, 0x12, 0x2d, 0xb1,
+	0x88, 0xcb, 0xaa, 0x82, 0x19, 0x49, 0xfa, 0x16, 0x2e, 0x64, 0x38, 0xd1, 0x06, 0x51, 0xd4, 0x8b,
+	0xba, 0x1b, 0x3c, 0x6f, 0x15, 0xf8, 0x13, 0x7f, 0xe0, 0x2b, 0xcf, 0x7d, 0x57, 0x51, 0xd7, 0x8b,
+	0x65, 0xe2, 0xb2, 0xd4, 0x34, 0x9d, 0xb9, 0x24, 0x3d, 0xeb, 0x0b, 0x9e, 0xb5, 0x89, 0x41, 0x2a,
+	0x06, 0xab, 0x7a, 0xc6, 0xe1, 0x4e, 0x63, 0x41, 0x25, 0x65, 0x25, 0x57, 0xc1, 0xb4, 0xe4, 0x79,
+	0x44, 0x3a, 0x3d, 0x98, 0x5a, 0x21, 0x94, 0xe1, 0x8a, 0xe1, 0x39, 0x44, 0xfd, 0x52, 0x11, 0x4d,
+	0xc9, 0x29, 0x64, 0x51, 0xcd, 0x37, 0x0a, 0xda, 0xed, 0xf7, 0x51, 0x15, 0xa2, 0x31, 0xb5, 0xa0,
+	0x12, 0xb3, 0x41, 0x74, 0x97, 0xdf, 0xa9, 0x44, 0xaa, 0x0d, 0xeb, 0xb0, 0xdf, 0x5a, 0x21, 0x94,
+	0xe2, 0x22, 0xd9, 0x20, 0xbe, 0x72, 0x9a, 0x31, 0xd7, 0x1a, 0xfd, 0x33, 0x00, 0xb7, 0xca, 0xad,
+	0x2d, 0x9c, 0x52, 0xb5, 0x92, 0xf8, 0x23, 0x80, 0x3b, 0x35, 0xc2, 0x96, 0x74, 0xb3, 0x94, 0x73,
+	0xf7, 0x34, 0x87, 0x15, 0xc5, 0x24, 0x94, 0x0e, 0x82, 0x61, 0x10, 0xeb, 0x4f, 0x7f, 0x02, 0x1e,
+	0xa5, 0x2f, 0x03, 0xf3, 0x63, 0x90, 0xfa, 0x08, 0x9c, 0x8a, 0x8d, 0x8d, 0xc6, 0xc6, 0x46, 0x8f,
+	0xe3, 0xc4, 0x59, 0x39, 0x31, 0xb7, 0x3f, 0xf1, 0xe6, 0xc9, 0xf3, 0x6d, 0xeb, 0xd6, 0xf2, 0x44,
+	0xe2, 0x64, 0xbc, 0xcd, 0x30, 0x72, 0x42, 0x1a, 0x89, 0x3b, 0x71, 0x72, 0x62, 0x0e, 0x27, 0xce,
+	0xba, 0x71, 0xad, 0x75, 0x6b, 0xc9, 0xe3, 0x5a, 0x86, 0x91, 0xd8, 0xd8, 0xe8, 0xe8, 0x71, 0x67,
+	0x75, 0xee, 0x95, 0x7d, 0xaf, 0x2d, 0x8f, 0x8c, 0xed, 0x39, 0x7f, 0x6a, 0x4f, 0x66, 0x87, 0x47,
+	0x37, 0xcb, 0xd9, 0xca, 0x2e, 0x59, 0x31, 0x0e, 0xfb, 0xb0, 0xa1, 0xe6, 0x4a, 0xa4, 0x3a, 0x18,
+	0xe0, 0xbc, 0xb7, 0x3d, 0x4a, 0x87, 0xcc, 0xc0, 0x00, 0xb0, 0x57, 0x22, 0xbd, 0xf2, 0xcc, 0x91,
+	0x49, 0x52, 0xcd, 0xf4, 0x62, 0x43, 0x9d, 0x24, 0x55, 0xf1, 0x03, 0x28, 0x2a, 0xa4, 0x80, 0xad,
+	0x32, 0xcb, 0x15, 0x74, 0xb3, 0x82, 0x19, 0x23, 0x26, 0x1d, 0x0c, 0x0e, 0x83, 0xd8, 0xe6, 0x54,
+	0x4c, 0x5a, 0xdd, 0xcb, 0xd2, 0xb4, 0xab, 0xf0, 0x0c, 0xae, 0x96, 0x75, 0xac, 0x1c, 0x6e, 0xfa,
+	0x67, 0xb6, 0x79, 0x18, 0xad, 0x57, 0xe2, 0x10, 0x0c, 0xb2, 0x32, 0x1d, 0x0c, 0x0d, 0x83, 0xd8,
+	0xa6, 0x74, 0x9f, 0xbd, 0x12, 0x09, 0xce, 0x4e, 0x65, 0x33, 0xce, 0xbb, 0xe8, 0x0f, 0x00, 0x0e,
+	0x4d, 0x10, 0xd6, 0x21, 0x7f, 0x86, 0x9c, 0xb6, 0x08, 0x65, 0x22, 0x86, 0x5b, 0xdb, 0x66, 0x2b,
+	0xa7, 0x2a, 0xae, 0xfa, 0x9b, 0x53, 0x7b, 0x3b, 0xe9, 0xb4, 0x01, 0x1c, 0x69, 0xb5, 0x4f, 0x7a,
+	0xe0, 0x51, 0xba, 0xe7, 0x32, 0x08, 0x0c, 0x80, 0xdb, 0x2b, 0x11, 0xe1, 0xce, 0x4a, 0x04, 0x64,
+	0xb6, 0xe0, 0x76, 0x4f, 0x2a, 0x8e, 0x41, 0xd8, 0x6a, 0x6c, 0xae, 0xd1, 0xe6, 0x54, 0x58, 0x72,
+	0x3b, 0x5b, 0x6a, 0x74, 0xb6, 0x74, 0xd8, 0x71, 0x99, 0xc6, 0xb4, 0x94, 0x0e, 0x39, 0x48, 0x99,
+	0xfe, 0x42, 0xe3, 0x45, 0xf4, 0x62, 0x00, 0x0e, 0x65, 0xff, 0xc9, 0x0a, 0xc6, 0x61, 0xa8, 0xac,
+	0x6a, 0x0d, 0xee, 0x91, 0x0d, 0x70, 0x1d, 0x62, 0x6b, 0x00, 0xf2, 0xf0, 0x0e, 0x21, 0x82, 0x4f,
+	0x2e, 0xc4, 0xa7, 0x21, 0xb8, 0xa3, 0x23, 0x59, 0x96, 0x61, 0x46, 0xc5, 0xb7, 0x61, 0xbf, 0x93,
+	0x81, 0x28, 0x39, 0xcc, 0x06, 0xc1, 0x3a, 0xc0, 0xb3, 0x8d, 0xb3, 0x23, 0x1d, 0xba, 0xf2, 0x5b,
+	0x04, 0x64, 0x36, 0xb9, 0x21, 0x32, 0xdb, 0x68, 0x14, 0x03, 0xff, 0xa6, 0x51, 0x7c, 0x1f, 0x6e,
+	0x2f, 0x63, 0xca, 0x72, 0x96, 0x91, 0x33, 0x49, 0x9e, 0xa8, 0x8b, 0xae, 0x20, 0xc1, 0x2e, 0x05,
+	0x19, 0x70, 0x82, 0x8f, 0x19, 0x19, 0x2f, 0x54, 0x66, 0xe2, 0x10, 0xdc, 0x64, 0x19, 0xb9, 0xbc,
+	0x6e, 0x69, 0x8c, 0xcf, 0x56, 0x28, 0xd3, 0x67, 0x19, 0x07, 0x9d, 0x47, 0xf1, 0x24, 0x0c, 0xf3,
+	0x5c, 0x8a, 0xbe, 0xa4, 0x39, 0x42, 0x3a, 0x03, 0xbd, 0x84, 0x4d, 0xc5, 0x4d, 0xd9, 0xd3, 0x65,
+	0xca, 0xff, 0x3b, 0x18, 0x87, 0x3c, 0x88, 0xc3, 0x0d, 0x04, 0x99, 0x89, 0x2f, 0xc3, 0x2d, 0x4d,
+	0x64, 0x37, 0x7f, 0x2f, 0xcf, 0xff, 0xdf, 0xc6, 0x5b, 0xce, 0x22, 0xf5, 0x45, 0x0f, 0x0c, 0xc8,
+	0x54, 0xbc, 0x0a, 0x60, 0xdf, 0x04, 0x61, 0xfc, 0x5c, 0x1d, 0xe9, 0x6c, 0xcf, 0x75, 0x87, 0x3f,
+	0xfc, 0xb8, 0x4e, 0x8e, 0xbe, 0xf3, 0xe1, 0x2f, 0xbf, 0x7f, 0x1e, 0x78, 0x43, 0x7c, 0x3d, 0x89,
+	0xe9, 0xaa, 0x6f, 0x70, 0xf2, 0x5c, 0xc7, 0xcc, 0x49, 0xab, 0x9f, 0x97, 0x93, 0xbc, 0xe3, 0xaf,
+	0x01, 0xd8, 0x97, 0x5d, 0x8f, 0x57, 0xf6, 0xe9, 0x79, 0xc9, 0x9c, 0xd7, 0x5b, 0xe1, 0xa7, 0xe4,
+	0x35, 0x0a, 0xe2, 0xe2, 0x79, 0x08, 0x0f, 0x91, 0x32, 0x61, 0x84, 0x93, 0xeb, 0xf2, 0xac, 0x08,
+	0xef, 0xf4, 0xed, 0xe8, 0xb8, 0xf3, 0x41, 0x8f, 0x4a, 0x9c, 0x50, 0x2c, 0xbe, 0xf7, 0x71, 0x84,
+	0x3c, 0x61, 0x3e, 0x03, 0xf0, 0x3f, 0xde, 0x86, 0xb9, 0x13, 0xdc, 0x2d, 0x81, 0x3d, 0x8f, 0x91,
+	0x86, 0xa3, 0x45, 0x5f, 0xe5, 0x74, 0x24, 0x71, 0x5f, 0x77, 0x74, 0x92, 0x94, 0x73, 0x98, 0x81,
+	0xfd, 0x19, 0x9d, 0x61, 0x46, 0x26, 0xc7, 0x27, 0xc5, 0xe1, 0xce, 0x44, 0x4d, 0x53, 0x63, 0x97,
+	0x5e, 0xda, 0xc0, 0x83, 0x1a, 0xba, 0x46, 0x49, 0xea, 0xd7, 0x5e, 0xd8, 0x23, 0x1b, 0x86, 0x4c,
+	0xc5, 0x59, 0xd8, 0x9f, 0xb5, 0xe6, 0x69, 0xde, 0x54, 0xe7, 0x49, 0xd7, 0xc5, 0xbe, 0xb8, 0x81,
+	0xdf, 0x31, 0x63, 0x3f, 0x10, 0x7f, 0x02, 0x70, 0x5b, 0x63, 0x7a, 0x8e, 0x5a, 0xc4, 0x22, 0x33,
+	0x16, 0x5d, 0x10, 0x7d, 0x1a, 0xad, 0x72, 0x69, 0xd0, 0x5f, 0x6f, 0x2b, 0xcf, 0x70, 0xed, 0xcc,
+	0x68, 0xc5, 0xaf, 0x5d, 0xeb, 0x6a, 0xb5, 0x46, 0x6b, 0xf9, 0x5b, 0xcd, 0x75, 0xf5, 0xc7, 0x35,
+	0x97, 0xcb, 0x49, 0x67, 0x9a, 0x93, 0x86, 0x45, 0x17, 0x9c, 0x96, 0xfc, 0x19, 0xc0, 0x1d, 0x1d,
+	0x54, 0x8d, 0x32, 0xce, 0x93, 0xbf, 0x59, 0xd0, 0x39, 0x5e, 0x90, 0x15, 0x35, 0x9e, 0x5b, 0x41,
+	0xa6, 0xcb, 0xdb, 0xa9, 0xe9, 0xdb, 0xce, 0x1d, 0x9a, 0x52, 0x29, 0xf3, 0x17, 0x34, 0xae, 0x29,
+	0x87, 0x38, 0x48, 0xb7, 0xbd, 0xde, 0xc0, 0xa4, 0xd1, 0x0c, 0x2f, 0x6f, 0x4a, 0x7c, 0xef, 0xc9,
+	0xcf, 0x82, 0x66, 0x3d, 0x1d, 0x05, 0x88, 0x5f, 0x03, 0xf8, 0xbf, 0x09, 0xc2, 0xa6, 0x8f, 0xce,
+	0xce, 0x1e, 0xd4, 0x35, 0x8d, 0xe4, 0x79, 0x67, 0x6a, 0x05, 0xbd, 0xeb, 0xd6, 0x8d, 0xfa, 0x6e,
+	0x73, 0x3e, 0xac, 0xee, 0x4f, 0xd7, 0x65, 0x7e, 0x97, 0x4e, 0xe4, 0x9b, 0xe1, 0x09, 0x55, 0x2b,
+	0xe8, 0xa9, 0x3f, 0x42, 0x70, 0xbb, 0x4c, 0x9b, 0xd2, 0x65, 0x48, 0x51, 0xa5, 0xcc, 0xac, 0x8a,
+	0xdf, 0x00, 0x18, 0x9c, 0x20, 0x4c, 0xdc, 0xbd, 0xc6, 0x97, 0xa0, 0xcd, 0xdb, 0xed, 0x9a, 0xa1,
+	0x75, 0xb7, 0x22, 0x5a, 0xe2, 0xfc, 0x88, 0x98, 0x7f, 0x0e, 0x8d, 0x23, 0x5e, 0x0c, 0xc0, 0x60,
+	0x76, 0x2d, 0xd2, 0xd9, 0x27, 0x23, 0xfd, 0x3d, 0xe0, 0xac, 0xbf, 0x03, 0xe1, 0x0d, 0x69, 0x4b,
+	0x4f, 0x49, 0x5b, 0x5a, 0x4d, 0x7b, 0x14, 0xc4, 0xe7, 0xa6, 0xa3, 0xef, 0x3e, 0xab, 0x4c, 0xce,
+	0xc4, 0x5c, 0x05, 0xb0, 0xd7, 0xfd, 0x32, 0x75, 0x39, 0x26, 0xeb, 0xcd, 0xfd, 0x34, 0x17, 0x62,
+	0x22, 0x3e, 0xfe, 0x4c, 0x06, 0x23, 0xfd, 0x15, 0xb8, 0x5d, 0x43, 0xe0, 0x4e, 0x0d, 0x81, 0xbb,
+	0x35, 0x24, 0xdc, 0xab, 0x21, 0xe1, 0x7e, 0x0d, 0x09, 0x0f, 0x6a, 0x48, 0x78, 0x58, 0x43, 0xe0,
+	0x82, 0x8d, 0xc0, 0x25, 0x1b, 0x09, 0xd7, 0x6d, 0x04, 0x6e, 0xd8, 0x48, 0xb8, 0x69, 0x23, 0xe1,
+	0x96, 0x8d, 0x84, 0xdb, 0x36, 0x02, 0x77, 0x6c, 0x04, 0xee, 0xda, 0x48, 0xb8, 0x67, 0x23, 0x70,
+	0xdf, 0x46, 0xc2, 0x03, 0x1b, 0x81, 0x87, 0x36, 0x12, 0x2e, 0xd4, 0x91, 0x70, 0xa9, 0x8e, 0xc0,
+	0x95, 0x3a, 0x12, 0xae, 0xd5, 0x11, 0xf8, 0xb2, 0x8e, 0x84, 0xeb, 0x75, 0x24, 0xdc, 0xa8, 0x23,
+	0x70, 0xb3, 0x8e, 0xc0, 0xad, 0x3a, 0x02, 0x73, 0xfb, 0x8a, 0xba, 0xc4, 0x16, 0x08, 0x5b, 0x50,
+	0xb5, 0x22, 0x95, 0xbc, 0x6b, 0x5f, 0x72, 0xf5, 0xdf, 0xa6, 0x51, 0x2a, 0x26, 0x19, 0xd3, 0x8c,
+	0xf9, 0xf9, 0x5e, 0xae, 0xc1, 0x81, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x1f, 0xa6, 0xfb,
+	0x43, 0x10, 0x00, 0x00,
 }
 
 func (this *ApplicationLink) Equal(that interface{}) bool {
@@ -568,6 +570,10 @@ type AsClient interface {
 	// This call returns a NotFound error code if there is no link for the given application identifiers.
 	// This call returns the error code of the link error if linking to a Network Server failed.
 	GetLinkStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationLinkStats, error)
+	// RotateKEK re-wraps the keys that are stored by the Application Server with the new KEK.
+	// Keys are processed in batches; call this RPC with the returned cursor until the returned cursor is empty.
+	// This RPC requires cluster authentication.
+	RotateKEK(ctx context.Context, in *RotateKEKRequest, opts ...grpc.CallOption) (*RotateKEKResponse, error)
 }
 
 type asClient struct {
@@ -614,6 +620,15 @@ func (c *asClient) GetLinkStats(ctx context.Context, in *ApplicationIdentifiers,
 	return out, nil
 }
 
+func (c *asClient) RotateKEK(ctx context.Context, in *RotateKEKRequest, opts ...grpc.CallOption) (*RotateKEKResponse, error) {
+	out := new(RotateKEKResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/RotateKEK", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AsServer is the server API for As service.
 type AsServer interface {
 	GetLink(context.Context, *GetApplicationLinkRequest) (*ApplicationLink, error)
@@ -626,6 +641,10 @@ type AsServer interface {
 	// This call returns a NotFound error code if there is no link for the given application identifiers.
 	// This call returns the error code of the link error if linking to a Network Server failed.
 	GetLinkStats(context.Context, *ApplicationIdentifiers) (*ApplicationLinkStats, error)
+	// RotateKEK re-wraps the keys that are stored by the Application Server with the new KEK.
+	// Keys are processed in batches; call this RPC with the returned cursor until the returned cursor is empty.
+	// This RPC requires cluster authentication.
+	RotateKEK(context.Context, *RotateKEKRequest) (*RotateKEKResponse, error)
 }
 
 // UnimplementedAsServer can be embedded to have forward compatible implementations.
@@ -644,6 +663,9 @@ func (*UnimplementedAsServer) DeleteLink(ctx context.Context, req *ApplicationId
 func (*UnimplementedAsServer) GetLinkStats(ctx context.Context, req *ApplicationIdentifiers) (*ApplicationLinkStats, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLinkStats not implemented")
 }
+func (*UnimplementedAsServer) RotateKEK(ctx context.Context, req *RotateKEKRequest) (*RotateKEKResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateKEK not implemented")
+}
 
 func RegisterAsServer(s *grpc.Server, srv AsServer) {
 	s.RegisterService(&_As_serviceDesc, srv)
@@ -721,6 +743,24 @@ func _As_GetLinkStats_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _As_RotateKEK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateKEKRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsServer).RotateKEK(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.As/RotateKEK",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsServer).RotateKEK(ctx, req.(*RotateKEKRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _As_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.As",
 	HandlerType: (*AsServer)(nil),
@@ -741,6 +781,10 @@ var _As_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetLinkStats",
 			Handler:    _As_GetLinkStats_Handler,
 		},
+		{
+			MethodName: "RotateKEK",
+			Handler:    _As_RotateKEK_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/applicationserver.proto",
@@ -37,52 +37,54 @@ func init() {
 }
 
 var fileDescriptor_80815ba053239a77 = []byte{
-	// 708 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3f, 0x4c, 0x14, 0x4d,
-	0x14, 0x9f, 0xe1, 0xfb, 0xbe, 0xcb, 0x97, 0x8d, 0x81, 0x38, 0x31, 0x98, 0x1c, 0xf0, 0x94, 0xc5,
-	0x84, 0x84, 0xc0, 0xac, 0x81, 0x98, 0x18, 0x3b, 0x45, 0x45, 0xa3, 0x8d, 0x10, 0x9b, 0x6b, 0xc8,
-	0xde, 0x31, 0xec, 0x4d, 0xee, 0xdc, 0x39, 0x76, 0x06, 0x08, 0x12, 0x12, 0x62, 0xa1, 0x34, 0x26,
-	0x12, 0x1b, 0xed, 0x8c, 0x15, 0x25, 0x25, 0x25, 0x25, 0x9d, 0x24, 0x34, 0x94, 0xdc, 0xac, 0x05,
-	0x25, 0x9d, 0x94, 0x66, 0x67, 0x77, 0x75, 0xef, 0x9f, 0x70, 0x89, 0xdd, 0xde, 0x7b, 0xbf, 0xf9,
-	0xbd, 0x79, 0xbf, 0xf7, 0x7b, 0x73, 0xd6, 0x68, 0x55, 0x04, 0xee, 0xaa, 0xeb, 0x4f, 0x48, 0xe5,
-	0x96, 0x2a, 0x8e, 0x5b, 0xe3, 0x4e, 0xa9, 0xca, 0x99, 0xaf, 0xe6, 0x25, 0x0b, 0x56, 0x78, 0x89,
-	0x49, 0x5a, 0x0b, 0x84, 0x12, 0xa4, 0x57, 0x29, 0x9f, 0x26, 0x60, 0xba, 0x32, 0x95, 0x1f, 0xf4,
-	0x84, 0xf0, 0xaa, 0xcc, 0x9c, 0x70, 0x7d, 0x5f, 0x28, 0x57, 0x71, 0xe1, 0x27, 0xe8, 0xfc, 0x40,
-	0x92, 0x35, 0xbf, 0x8a, 0xcb, 0x8b, 0x0e, 0x7b, 0x55, 0x53, 0x6b, 0x49, 0x12, 0x3a, 0xd5, 0x4c,
-	0xf2, 0x23, 0xad, 0x79, 0xbe, 0xc0, 0x7c, 0xc5, 0x17, 0x39, 0x0b, 0x64, 0x67, 0x92, 0x80, 0x7b,
-	0x65, 0x95, 0xe4, 0x27, 0xcf, 0xff, 0xb3, 0x7a, 0xa7, 0x0d, 0xeb, 0x2c, 0xf3, 0xb8, 0x54, 0xc1,
-	0x1a, 0xf9, 0x86, 0xad, 0xdc, 0x74, 0xc0, 0x5c, 0xc5, 0xc8, 0x08, 0x6d, 0x6c, 0x87, 0xc6, 0xf1,
-	0xf4, 0xc0, 0xd2, 0x32, 0x93, 0x2a, 0xdf, 0xdf, 0x02, 0x32, 0x69, 0xfb, 0x1d, 0x7e, 0x73, 0xf4,
-	0xfd, 0x63, 0xcf, 0x26, 0xb6, 0xa9, 0xb3, 0x2c, 0x59, 0x20, 0x9d, 0xf5, 0x92, 0xa8, 0x56, 0xdd,
-	0xa2, 0x08, 0x5c, 0x25, 0x02, 0x1a, 0xc5, 0xe6, 0xf9, 0x82, 0x4c, 0x3f, 0x36, 0x92, 0xf6, 0xe4,
-	0x3d, 0x3c, 0x56, 0x78, 0x66, 0x3f, 0x76, 0x44, 0xe0, 0xb9, 0x3e, 0x7f, 0x1d, 0x2b, 0xd6, 0x74,
-	0x38, 0x9b, 0x33, 0x24, 0x4d, 0x81, 0x2c, 0x19, 0x29, 0x5b, 0xff, 0xcc, 0x30, 0x45, 0x6e, 0x36,
-	0x5f, 0x74, 0x86, 0xa9, 0xcb, 0xb5, 0x32, 0x6a, 0x3a, 0x19, 0x26, 0x37, 0x52, 0x56, 0x67, 0x3d,
-	0x19, 0x7f, 0x54, 0xfa, 0xd7, 0xe7, 0x06, 0x39, 0xc2, 0xd6, 0xbf, 0xcf, 0xb9, 0x54, 0xc4, 0x6e,
-	0x66, 0x8a, 0xa2, 0x31, 0x9b, 0x4c, 0xab, 0x5d, 0x6f, 0x5f, 0x4d, 0xda, 0xef, 0x63, 0xe5, 0xde,
-	0x62, 0xf2, 0x7f, 0x5a, 0xb0, 0x70, 0x9b, 0x74, 0xa9, 0x62, 0xe1, 0x09, 0xf9, 0x4b, 0x12, 0x92,
-	0x25, 0x2b, 0xf7, 0xb2, 0xb6, 0xd0, 0xd6, 0x10, 0x71, 0xfc, 0x72, 0x2a, 0x8e, 0x99, 0xae, 0x6e,
-	0xe5, 0x5b, 0x54, 0xa4, 0x8d, 0x2a, 0x46, 0x23, 0x73, 0xad, 0xdc, 0x43, 0x56, 0x65, 0x8a, 0x91,
-	0xe1, 0xf6, 0x6c, 0x4f, 0x7f, 0x5b, 0x3d, 0xdf, 0x4f, 0xe3, 0x3d, 0xa2, 0xe9, 0x1e, 0xd1, 0x47,
-	0xd1, 0x1e, 0xd9, 0x83, 0xa6, 0x60, 0xff, 0xd8, 0xb5, 0x36, 0x63, 0xdb, 0x98, 0xdc, 0xce, 0x59,
-	0x57, 0x62, 0xae, 0xfb, 0xa5, 0x12, 0x93, 0x92, 0x54, 0x2d, 0x2b, 0x9a, 0xd2, 0xac, 0xd9, 0x8f,
-	0xcb, 0xd5, 0x6d, 0x82, 0xc4, 0x47, 0xed, 0x11, 0x53, 0x77, 0x88, 0x0c, 0xb4, 0xab, 0x9b, 0xec,
-	0x1f, 0xd1, 0x3d, 0x56, 0x5f, 0x64, 0xc0, 0xcc, 0x48, 0xc8, 0x78, 0x47, 0x87, 0x66, 0x61, 0xa9,
-	0xce, 0xa3, 0xed, 0xd0, 0x0d, 0x38, 0x59, 0x13, 0xbe, 0x64, 0xf6, 0x8f, 0xd8, 0x4f, 0x67, 0x98,
-	0x8c, 0x5f, 0x60, 0x60, 0x27, 0xeb, 0x90, 0xc2, 0x1c, 0x79, 0xd1, 0x0d, 0xde, 0xf8, 0xf3, 0x22,
-	0x7b, 0x16, 0x2a, 0x84, 0x77, 0x45, 0x9a, 0x75, 0x65, 0xb7, 0x0e, 0x26, 0xdb, 0xd8, 0xea, 0x9b,
-	0xbb, 0x48, 0xe4, 0xb9, 0x3f, 0x89, 0xdc, 0xc9, 0x5b, 0x77, 0x8d, 0xa4, 0x93, 0xf9, 0x89, 0x6e,
-	0x9a, 0x31, 0xaf, 0xd1, 0x67, 0x6c, 0x5d, 0x35, 0xaf, 0x41, 0x36, 0x41, 0x68, 0xe7, 0x07, 0xa3,
-	0x01, 0x98, 0xde, 0x6b, 0xa8, 0xc5, 0x9e, 0x59, 0x94, 0x7d, 0xc7, 0x5c, 0xcf, 0x21, 0xdd, 0x5d,
-	0xef, 0xc1, 0x57, 0x7c, 0x50, 0x07, 0x7c, 0x58, 0x07, 0x7c, 0x5c, 0x07, 0x74, 0x52, 0x07, 0x74,
-	0x5a, 0x07, 0x74, 0x56, 0x07, 0x74, 0x5e, 0x07, 0xbc, 0xa9, 0x01, 0x6f, 0x69, 0x40, 0x3b, 0x1a,
-	0xf0, 0xae, 0x06, 0xb4, 0xa7, 0x01, 0xed, 0x6b, 0x40, 0x07, 0x1a, 0xf0, 0xa1, 0x06, 0x7c, 0xac,
-	0x01, 0x9d, 0x68, 0xc0, 0xa7, 0x1a, 0xd0, 0x99, 0x06, 0x7c, 0xae, 0x01, 0x6d, 0x86, 0x80, 0xb6,
-	0x42, 0xc0, 0x1f, 0x42, 0x40, 0x9f, 0x42, 0xc0, 0x5f, 0x42, 0x40, 0x3b, 0x21, 0xa0, 0xdd, 0x10,
-	0xf0, 0x5e, 0x08, 0x78, 0x3f, 0x04, 0x5c, 0x18, 0xf7, 0x04, 0x55, 0x65, 0xa6, 0xca, 0xdc, 0xf7,
-	0x24, 0xf5, 0x99, 0x5a, 0x15, 0x41, 0xc5, 0x69, 0xfc, 0xeb, 0xaa, 0x55, 0x3c, 0x47, 0x29, 0xbf,
-	0x56, 0x2c, 0xe6, 0xcc, 0x28, 0xa6, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff, 0x6e, 0xfe, 0xaf, 0x34,
-	0x95, 0x07, 0x00, 0x00,
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x31, 0x4c, 0x14, 0x41,
+	0x14, 0xdd, 0x41, 0x3d, 0xcc, 0xc6, 0x40, 0x9c, 0x18, 0x4c, 0x8e, 0xe3, 0x2b, 0x0b, 0x09, 0xc9,
+	0x05, 0x76, 0x0d, 0xc4, 0xc4, 0xd8, 0x29, 0x2a, 0x1a, 0x2d, 0x14, 0x62, 0x73, 0x0d, 0xd9, 0x3b,
+	0x86, 0xbd, 0xf5, 0xce, 0x9d, 0x65, 0x66, 0x0e, 0x82, 0x84, 0x84, 0x58, 0x28, 0x8d, 0x89, 0xc4,
+	0x46, 0x3b, 0x63, 0x45, 0x49, 0x49, 0x49, 0x49, 0x61, 0x22, 0x09, 0x0d, 0x25, 0xb7, 0x6b, 0x41,
+	0x49, 0x27, 0xa5, 0xd9, 0xd9, 0x5d, 0xdd, 0xbb, 0xdb, 0x13, 0x2e, 0xd8, 0xed, 0xcd, 0x7f, 0xf3,
+	0xde, 0xfc, 0x37, 0xff, 0xcd, 0xa9, 0x23, 0x55, 0xca, 0xcc, 0x25, 0xd3, 0x19, 0xe3, 0xc2, 0x2c,
+	0x55, 0x0c, 0xd3, 0xb5, 0x8d, 0x52, 0xd5, 0x26, 0x8e, 0x98, 0xe5, 0x84, 0x2d, 0xda, 0x25, 0xc2,
+	0x75, 0x97, 0x51, 0x41, 0x71, 0x8f, 0x10, 0x8e, 0x1e, 0x81, 0xf5, 0xc5, 0x89, 0x6c, 0xce, 0xa2,
+	0xd4, 0xaa, 0x12, 0xb9, 0xc3, 0x74, 0x1c, 0x2a, 0x4c, 0x61, 0x53, 0x27, 0x42, 0x67, 0xfb, 0xa3,
+	0xaa, 0xfc, 0x55, 0xac, 0xcd, 0x1b, 0xe4, 0xb5, 0x2b, 0x96, 0xa3, 0x22, 0xb4, 0xd3, 0x8c, 0xea,
+	0x43, 0xad, 0x75, 0x7b, 0x8e, 0x38, 0xc2, 0x9e, 0xb7, 0x09, 0xe3, 0xed, 0x49, 0x98, 0x6d, 0x95,
+	0x45, 0x54, 0x1f, 0xff, 0xde, 0xad, 0xf6, 0x4c, 0x4a, 0xd6, 0x69, 0x62, 0xd9, 0x5c, 0xb0, 0x65,
+	0xfc, 0x03, 0xa9, 0x99, 0x49, 0x46, 0x4c, 0x41, 0xf0, 0x90, 0xde, 0xd8, 0x8e, 0x1e, 0xae, 0xc7,
+	0x1b, 0x16, 0x6a, 0x84, 0x8b, 0x6c, 0x5f, 0x0b, 0x48, 0x96, 0xb5, 0xf7, 0xe8, 0xed, 0xfe, 0xcf,
+	0x4f, 0x5d, 0x6b, 0x48, 0xd3, 0x8d, 0x1a, 0x27, 0x8c, 0x1b, 0x2b, 0x25, 0x5a, 0xad, 0x9a, 0x45,
+	0xca, 0x4c, 0x41, 0x99, 0x1e, 0xac, 0xcd, 0xda, 0x73, 0x3c, 0xfe, 0x58, 0x8d, 0xda, 0xe3, 0x77,
+	0x51, 0xbe, 0xf0, 0x54, 0x7b, 0x64, 0x50, 0x66, 0x99, 0x8e, 0xfd, 0x26, 0x74, 0xac, 0x69, 0x73,
+	0xb2, 0x26, 0x49, 0x9a, 0x16, 0x92, 0x64, 0xb8, 0xac, 0x5e, 0x98, 0x22, 0x02, 0xdf, 0x6c, 0x3e,
+	0xe8, 0x14, 0x11, 0x67, 0x6b, 0x65, 0x44, 0x76, 0x32, 0x88, 0x6f, 0xc4, 0xac, 0xc6, 0x4a, 0x74,
+	0xfd, 0x81, 0xf4, 0x9f, 0xcf, 0x55, 0xbc, 0x8f, 0xd4, 0x8b, 0xcf, 0x6c, 0x2e, 0xb0, 0xd6, 0xcc,
+	0x14, 0xac, 0x86, 0x6c, 0x3c, 0x56, 0xbb, 0x9e, 0xae, 0xc6, 0xb5, 0x0f, 0xa1, 0x73, 0xef, 0x10,
+	0xbe, 0x1c, 0x0b, 0x16, 0x6e, 0xe1, 0x0e, 0x5d, 0x2c, 0x3c, 0xc6, 0xff, 0xc9, 0x42, 0xbc, 0xa0,
+	0x66, 0x5e, 0xba, 0x73, 0xa9, 0x03, 0x11, 0xae, 0x9f, 0xcd, 0xc5, 0xbc, 0xec, 0x6a, 0x38, 0xdb,
+	0xe2, 0xa2, 0xde, 0xe8, 0x62, 0x70, 0x65, 0xa6, 0x9a, 0x79, 0x40, 0xaa, 0x44, 0x10, 0x3c, 0x98,
+	0xce, 0xf6, 0xe4, 0xef, 0xa8, 0x67, 0xfb, 0xf4, 0x30, 0x47, 0x7a, 0x9c, 0x23, 0xfd, 0x61, 0x90,
+	0x23, 0x2d, 0x27, 0x05, 0xfb, 0xf2, 0xd7, 0x52, 0xae, 0x6d, 0x15, 0xbf, 0x52, 0xbb, 0xa7, 0x09,
+	0x17, 0x94, 0x9d, 0x4b, 0x63, 0x58, 0x6a, 0x80, 0x96, 0x4b, 0xd3, 0x30, 0x58, 0x24, 0x30, 0xaf,
+	0x5e, 0x7a, 0x5e, 0x63, 0xd6, 0xb9, 0x94, 0x34, 0xa9, 0x94, 0xcb, 0x67, 0x53, 0x95, 0xdc, 0x80,
+	0x7e, 0x7c, 0x23, 0xa3, 0x5e, 0x09, 0x19, 0xef, 0x95, 0x4a, 0x84, 0x73, 0x5c, 0x55, 0xd5, 0x60,
+	0xf2, 0xa6, 0x65, 0xe6, 0xcf, 0xa6, 0xde, 0x04, 0x09, 0xb7, 0x6a, 0x43, 0x52, 0x7d, 0x00, 0xf7,
+	0xa7, 0xf7, 0x19, 0xf2, 0x7b, 0x5d, 0x6a, 0x6f, 0x10, 0xaa, 0xc4, 0x98, 0xe1, 0xd1, 0xb6, 0xa9,
+	0x4b, 0xc2, 0xe2, 0xd9, 0x19, 0x49, 0x43, 0x37, 0xe0, 0xb8, 0x4b, 0x1d, 0x4e, 0xb4, 0x5f, 0x61,
+	0x46, 0x8e, 0x11, 0x1e, 0x3d, 0x25, 0x94, 0x46, 0x72, 0xea, 0x0b, 0x33, 0xf8, 0x45, 0x27, 0x78,
+	0x99, 0xb9, 0xd3, 0x22, 0x57, 0xa8, 0x60, 0xbb, 0x23, 0xd2, 0x64, 0xd2, 0x3a, 0x4d, 0x25, 0xde,
+	0x40, 0x6a, 0xef, 0xcc, 0x69, 0x26, 0xcf, 0xfc, 0xcb, 0xe4, 0x76, 0x13, 0x76, 0x47, 0x5a, 0x3a,
+	0x9e, 0x1d, 0xeb, 0xa4, 0x19, 0xf9, 0xc2, 0x7e, 0x41, 0xea, 0x55, 0xf9, 0xc2, 0x25, 0x0b, 0x58,
+	0x6f, 0xff, 0x08, 0x36, 0x00, 0xe3, 0x73, 0x0d, 0xb4, 0x8c, 0x67, 0x12, 0xa5, 0xdd, 0x96, 0xc7,
+	0x33, 0x70, 0x67, 0xc7, 0xbb, 0xff, 0x0d, 0xed, 0xd6, 0x01, 0xed, 0xd5, 0x01, 0x1d, 0xd4, 0x41,
+	0x39, 0xac, 0x83, 0x72, 0x54, 0x07, 0xe5, 0xb8, 0x0e, 0xca, 0x49, 0x1d, 0xd0, 0x9a, 0x07, 0x68,
+	0xdd, 0x03, 0x65, 0xd3, 0x03, 0xb4, 0xe5, 0x81, 0xb2, 0xed, 0x81, 0xb2, 0xe3, 0x81, 0xb2, 0xeb,
+	0x01, 0xda, 0xf3, 0x00, 0x1d, 0x78, 0xa0, 0x1c, 0x7a, 0x80, 0x8e, 0x3c, 0x50, 0x8e, 0x3d, 0x40,
+	0x27, 0x1e, 0x28, 0x6b, 0x3e, 0x28, 0xeb, 0x3e, 0xa0, 0x8f, 0x3e, 0x28, 0x9f, 0x7d, 0x40, 0x5f,
+	0x7d, 0x50, 0x36, 0x7d, 0x50, 0xb6, 0x7c, 0x40, 0xdb, 0x3e, 0xa0, 0x1d, 0x1f, 0x50, 0x61, 0xd4,
+	0xa2, 0xba, 0x28, 0x13, 0x51, 0xb6, 0x1d, 0x8b, 0xeb, 0x0e, 0x11, 0x4b, 0x94, 0x55, 0x8c, 0xc6,
+	0xbf, 0x63, 0xb7, 0x62, 0x19, 0x42, 0x38, 0x6e, 0xb1, 0x98, 0x91, 0x57, 0x31, 0xf1, 0x3b, 0x00,
+	0x00, 0xff, 0xff, 0x86, 0xa7, 0x5d, 0x2b, 0x69, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -108,6 +110,11 @@ type ClientRegistryClient interface {
 	List(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*Clients, error)
 	Update(ctx context.Context, in *UpdateClientRequest, opts ...grpc.CallOption) (*Client, error)
 	Delete(ctx context.Context, in *ClientIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Restore a recently deleted client. Only admins can restore clients.
+	Restore(ctx context.Context, in *ClientIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the client. This permanently deletes the client and the data that is
+	// associated with it, and releases its ID. Only admins can purge clients.
+	Purge(ctx context.Context, in *ClientIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type clientRegistryClient struct {
@@ -163,6 +170,24 @@ func (c *clientRegistryClient) Delete(ctx context.Context, in *ClientIdentifiers
 	return out, nil
 }
 
+func (c *clientRegistryClient) Restore(ctx context.Context, in *ClientIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ClientRegistry/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientRegistryClient) Purge(ctx context.Context, in *ClientIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ClientRegistry/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClientRegistryServer is the server API for ClientRegistry service.
 type ClientRegistryServer interface {
 	// Create a new OAuth client. This also sets the given organization or user as
@@ -176,6 +201,11 @@ type ClientRegistryServer interface {
 	List(context.Context, *ListClientsRequest) (*Clients, error)
 	Update(context.Context, *UpdateClientRequest) (*Client, error)
 	Delete(context.Context, *ClientIdentifiers) (*types.Empty, error)
+	// Restore a recently deleted client. Only admins can restore clients.
+	Restore(context.Context, *ClientIdentifiers) (*types.Empty, error)
+	// Purge the client. This permanently deletes the client and the data that is
+	// associated with it, and releases its ID. Only admins can purge clients.
+	Purge(context.Context, *ClientIdentifiers) (*types.Empty, error)
 }
 
 // UnimplementedClientRegistryServer can be embedded to have forward compatible implementations.
@@ -197,6 +227,12 @@ func (*UnimplementedClientRegistryServer) Update(ctx context.Context, req *Updat
 func (*UnimplementedClientRegistryServer) Delete(ctx context.Context, req *ClientIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedClientRegistryServer) Restore(ctx context.Context, req *ClientIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (*UnimplementedClientRegistryServer) Purge(ctx context.Context, req *ClientIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
+}
 
 func RegisterClientRegistryServer(s *grpc.Server, srv ClientRegistryServer) {
 	s.RegisterService(&_ClientRegistry_serviceDesc, srv)
@@ -292,6 +328,42 @@ func _ClientRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientRegistry_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientRegistryServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ClientRegistry/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientRegistryServer).Restore(ctx, req.(*ClientIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientRegistry_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientRegistryServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ClientRegistry/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientRegistryServer).Purge(ctx, req.(*ClientIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ClientRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.ClientRegistry",
 	HandlerType: (*ClientRegistryServer)(nil),
@@ -316,6 +388,14 @@ var _ClientRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ClientRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _ClientRegistry_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _ClientRegistry_Purge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/client_services.proto",
@@ -919,8 +919,21 @@ type MACSettings struct {
 	// The frequency of the class B beacon (Hz) Network Server should configure device to use via MAC commands.
 	// If unset, the default value from Network Server configuration will be used.
 	DesiredBeaconFrequency *types.UInt64Value `protobuf:"bytes,29,opt,name=desired_beacon_frequency,json=desiredBeaconFrequency,proto3" json:"desired_beacon_frequency,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}           `json:"-"`
-	XXX_sizecache          int32              `json:"-"`
+	// The uplink channel mask Network Server should configure device to use via MAC commands.
+	// The mask applies to the channels of the band and frequency plan, in that order. Channels beyond the length of the mask are not affected.
+	// If unset, the channels of the frequency plan are enabled.
+	DesiredChannelMask []bool `protobuf:"varint,30,rep,packed,name=desired_channel_mask,json=desiredChannelMask,proto3" json:"desired_channel_mask,omitempty"`
+	// The additional uplink channels Network Server should configure device to use via MAC commands.
+	// This is only supported by bands with dynamic channel plans.
+	DesiredExtraChannels []*MACParameters_Channel `protobuf:"bytes,31,rep,name=desired_extra_channels,json=desiredExtraChannels,proto3" json:"desired_extra_channels,omitempty"`
+	// The minimum data rate index Network Server should configure device to use via MAC commands.
+	// If unset, the default value from Network Server configuration will be used.
+	DesiredMinDataRateIndex *DataRateIndexValue `protobuf:"bytes,32,opt,name=desired_min_data_rate_index,json=desiredMinDataRateIndex,proto3" json:"desired_min_data_rate_index,omitempty"`
+	// The maximum data rate index Network Server should configure device to use via MAC commands.
+	// If unset, the default value from Network Server configuration will be used.
+	DesiredMaxDataRateIndex *DataRateIndexValue `protobuf:"bytes,33,opt,name=desired_max_data_rate_index,json=desiredMaxDataRateIndex,proto3" json:"desired_max_data_rate_index,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}            `json:"-"`
+	XXX_sizecache           int32               `json:"-"`
 }
 
 func (m *MACSettings) Reset()      { *m = MACSettings{} }
@@ -1151,6 +1164,34 @@ func (m *MACSettings) GetDesiredBeaconFrequency() *types.UInt64Value {
 	return nil
 }
 
+func (m *MACSettings) GetDesiredChannelMask() []bool {
+	if m != nil {
+		return m.DesiredChannelMask
+	}
+	return nil
+}
+
+func (m *MACSettings) GetDesiredExtraChannels() []*MACParameters_Channel {
+	if m != nil {
+		return m.DesiredExtraChannels
+	}
+	return nil
+}
+
+func (m *MACSettings) GetDesiredMinDataRateIndex() *DataRateIndexValue {
+	if m != nil {
+		return m.DesiredMinDataRateIndex
+	}
+	return nil
+}
+
+func (m *MACSettings) GetDesiredMaxDataRateIndex() *DataRateIndexValue {
+	if m != nil {
+		return m.DesiredMaxDataRateIndex
+	}
+	return nil
+}
+
 // MACState represents the state of MAC layer of the device.
 // MACState is reset on each join for OTAA or ResetInd for ABP devices.
 // This is used internally by the Network Server and is read only.
@@ -1575,7 +1616,7 @@ type EndDevice struct {
 	// Last JoinNonce/AppNonce(for devices using LoRaWAN versions preceding 1.1) used.
 	// Stored in Join Server.
 	LastJoinNonce uint32 `protobuf:"varint,30,opt,name=last_join_nonce,json=lastJoinNonce,proto3" json:"last_join_nonce,omitempty"`
-	// Last Rejoin counter value used (type 0/2).
+	// Last Rejoin counter value used (type 0/2) plus one, or 0 if none was used in the current session.
 	// Stored in Join Server.
 	LastRJCount0 uint32 `protobuf:"varint,31,opt,name=last_rj_count_0,json=lastRjCount0,proto3" json:"last_rj_count_0,omitempty"`
 	// Last Rejoin counter value used (type 1).
@@ -2630,311 +2671,315 @@ func init() {
 }
 
 var fileDescriptor_a656ee0551c94a80 = []byte{
-	// 4864 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x5b, 0x6c, 0x1c, 0xd7,
-	0x79, 0xde, 0xe1, 0x6d, 0x77, 0x7f, 0x5e, 0x76, 0x79, 0x48, 0x8a, 0x23, 0x4a, 0xda, 0xa5, 0x56,
-	0x94, 0x4d, 0x29, 0x22, 0x25, 0xae, 0x6c, 0x27, 0x51, 0xec, 0x2a, 0x3b, 0x5c, 0xd2, 0x5e, 0x49,
-	0x94, 0x99, 0xa3, 0x5b, 0xad, 0xdb, 0xe4, 0x70, 0xe7, 0x90, 0x1a, 0x73, 0x77, 0x66, 0x33, 0x33,
-	0x4b, 0x91, 0xb6, 0x05, 0x18, 0x41, 0x8b, 0xa4, 0x41, 0x5b, 0xa4, 0x7e, 0x32, 0xfa, 0x50, 0x18,
-	0x05, 0x0a, 0xe4, 0xa9, 0x08, 0x8a, 0x16, 0xf0, 0x5b, 0xd3, 0x87, 0x16, 0x7e, 0x29, 0xe0, 0x87,
-	0x3c, 0x04, 0x01, 0xca, 0x46, 0xab, 0x17, 0x3f, 0xe6, 0x31, 0xe0, 0x43, 0x50, 0x9c, 0xcb, 0x5c,
-	0xf6, 0x42, 0x72, 0x69, 0xbb, 0x81, 0x5f, 0xc8, 0x99, 0x73, 0xfe, 0xff, 0xfb, 0xff, 0xf3, 0x9f,
-	0xdb, 0x7f, 0x99, 0x85, 0x5c, 0xc5, 0x76, 0xc8, 0x53, 0x62, 0xcd, 0xb9, 0x1e, 0x29, 0x6f, 0x5e,
-	0x24, 0x35, 0xf3, 0x22, 0xb5, 0x0c, 0xdd, 0xa0, 0x5b, 0x66, 0x99, 0xce, 0xd7, 0x1c, 0xdb, 0xb3,
-	0xd1, 0x88, 0xe7, 0x59, 0xf3, 0x92, 0x6e, 0x7e, 0xeb, 0xf2, 0x54, 0x61, 0xc3, 0xf4, 0x9e, 0xd4,
-	0xd7, 0xe6, 0xcb, 0x76, 0xf5, 0x22, 0xb5, 0xb6, 0xec, 0x9d, 0x9a, 0x63, 0x6f, 0xef, 0x5c, 0xe4,
-	0xc4, 0xe5, 0xb9, 0x0d, 0x6a, 0xcd, 0x6d, 0x91, 0x8a, 0x69, 0x10, 0x8f, 0x5e, 0x6c, 0x7b, 0x10,
-	0x90, 0x53, 0x73, 0x11, 0x88, 0x0d, 0x7b, 0xc3, 0x16, 0xcc, 0x6b, 0xf5, 0x75, 0xfe, 0xc6, 0x5f,
-	0xf8, 0x93, 0x24, 0xcf, 0x6c, 0xd8, 0xf6, 0x46, 0x85, 0x86, 0x54, 0x46, 0xdd, 0x21, 0x9e, 0x69,
-	0x5b, 0xb2, 0x7f, 0xba, 0xb5, 0x7f, 0xdd, 0xa4, 0x15, 0x43, 0xaf, 0x12, 0x77, 0x53, 0x52, 0x9c,
-	0x6c, 0xa5, 0x70, 0x3d, 0xa7, 0x5e, 0xf6, 0x64, 0x6f, 0xb6, 0xb5, 0xd7, 0x33, 0xab, 0xd4, 0xf5,
-	0x48, 0xb5, 0xb6, 0x9f, 0x02, 0x4f, 0x1d, 0x52, 0xab, 0x51, 0xc7, 0x95, 0xfd, 0x67, 0xda, 0xcd,
-	0x68, 0x1a, 0xd4, 0xf2, 0xcc, 0x75, 0x33, 0x24, 0x3a, 0xd9, 0x4e, 0xf4, 0xae, 0x6d, 0x5a, 0xfb,
-	0xf7, 0x6e, 0xd2, 0x1d, 0x9f, 0x37, 0xdb, 0xde, 0xeb, 0xcf, 0x88, 0x34, 0x41, 0x3b, 0x41, 0x95,
-	0xba, 0x2e, 0xd9, 0xa0, 0x07, 0x40, 0xd4, 0xcc, 0xb2, 0x57, 0x77, 0xe8, 0x41, 0x10, 0x1e, 0x31,
-	0x88, 0x47, 0x04, 0x45, 0xee, 0x8f, 0xbd, 0x10, 0xbf, 0x45, 0x5d, 0xd7, 0xb4, 0x2d, 0x74, 0x0f,
-	0x12, 0x06, 0xdd, 0xd2, 0x89, 0x61, 0x38, 0x6a, 0xcf, 0xb4, 0x32, 0x3b, 0xa4, 0xbd, 0xfe, 0xd9,
-	0x6e, 0x36, 0xf6, 0xdb, 0xdd, 0xec, 0x2b, 0x1b, 0xf6, 0xbc, 0xf7, 0x84, 0x7a, 0x4f, 0x4c, 0x6b,
-	0xc3, 0x9d, 0xb7, 0xa8, 0xf7, 0xd4, 0x76, 0x36, 0x2f, 0x36, 0x83, 0xd7, 0x36, 0x37, 0x2e, 0x7a,
-	0x3b, 0x35, 0xea, 0xce, 0x17, 0xe9, 0x56, 0xc1, 0x30, 0x1c, 0x1c, 0x37, 0xc4, 0x03, 0x2a, 0x40,
-	0x1f, 0x1b, 0xb8, 0xda, 0x3b, 0xad, 0xcc, 0x0e, 0xe6, 0x4f, 0xcc, 0x37, 0xaf, 0xbe, 0x79, 0x29,
-	0xff, 0x3a, 0xdd, 0x71, 0xb5, 0xf4, 0x9e, 0xd6, 0xff, 0x33, 0xa5, 0x27, 0xad, 0x30, 0xc9, 0x9f,
-	0xef, 0x66, 0x15, 0xcc, 0x59, 0xd1, 0x69, 0x18, 0xae, 0x10, 0xd7, 0xd3, 0xd7, 0xf5, 0xb2, 0xe5,
-	0xe9, 0xf5, 0x9a, 0xda, 0x37, 0xad, 0xcc, 0x0e, 0x63, 0x60, 0x8d, 0xcb, 0x8b, 0x96, 0x77, 0xa7,
-	0x86, 0x66, 0x61, 0x94, 0x93, 0x58, 0x92, 0xc8, 0xb0, 0x9f, 0x5a, 0x6a, 0x3f, 0x27, 0xe3, 0xbc,
-	0x37, 0x19, 0x5d, 0xd1, 0x7e, 0x6a, 0x05, 0x94, 0x24, 0x4a, 0x39, 0x10, 0x52, 0x16, 0x02, 0xca,
-	0x79, 0x18, 0xe7, 0x94, 0x65, 0xdb, 0x5a, 0x8f, 0x12, 0xc7, 0x39, 0x71, 0x9a, 0xf5, 0x2d, 0xda,
-	0xd6, 0x7a, 0x40, 0xbf, 0x08, 0xe0, 0x7a, 0xc4, 0xf1, 0xa8, 0xa1, 0x13, 0x4f, 0x4d, 0xf0, 0xf1,
-	0x4e, 0xcd, 0x8b, 0xa5, 0x36, 0xef, 0x2f, 0xb5, 0xf9, 0xdb, 0xfe, 0x5a, 0xd4, 0x12, 0x6c, 0x98,
-	0x3f, 0xff, 0xdf, 0xac, 0x82, 0x93, 0x92, 0xaf, 0xe0, 0x21, 0x0a, 0x27, 0x7f, 0x54, 0xa7, 0x75,
-	0x86, 0x51, 0xab, 0x55, 0xcc, 0x32, 0xdf, 0x17, 0x5c, 0x6e, 0xc5, 0xb4, 0x36, 0x5d, 0x35, 0x39,
-	0xdd, 0x3b, 0x3b, 0x98, 0x3f, 0xd3, 0x6a, 0xc6, 0x42, 0x48, 0x5c, 0x94, 0xb4, 0x78, 0x4a, 0x00,
-	0x75, 0xe8, 0x72, 0xaf, 0xf5, 0x25, 0x94, 0x74, 0x4f, 0xee, 0x9f, 0xd3, 0x30, 0xbc, 0x52, 0x58,
-	0x5c, 0x25, 0x0e, 0xa9, 0x52, 0x8f, 0x3a, 0x2e, 0x7a, 0x09, 0x12, 0x55, 0xb2, 0xad, 0x53, 0xd3,
-	0xa9, 0xa9, 0xca, 0xb4, 0x32, 0xdb, 0xa3, 0x0d, 0x36, 0x76, 0xb3, 0xf1, 0x15, 0xb2, 0xbd, 0x54,
-	0xc2, 0xab, 0x38, 0x5e, 0x25, 0xdb, 0x4b, 0xa6, 0x53, 0x43, 0xef, 0xc2, 0x18, 0x31, 0x1c, 0x9d,
-	0x2d, 0x26, 0xdd, 0x21, 0x1e, 0xd5, 0x4d, 0xcb, 0xa0, 0xdb, 0x7c, 0x62, 0x46, 0xf2, 0xa7, 0x5a,
-	0xb5, 0x2b, 0x12, 0x8f, 0x60, 0xe2, 0xd1, 0x12, 0x23, 0xd2, 0x4e, 0xee, 0x69, 0xfd, 0x3f, 0x66,
-	0xd3, 0xdc, 0xd8, 0xcd, 0xa6, 0x0b, 0x45, 0xdc, 0xd4, 0x8b, 0xd3, 0xc4, 0x70, 0x9a, 0x5a, 0xd0,
-	0x9b, 0x80, 0x98, 0x2c, 0x6f, 0x5b, 0xaf, 0xd9, 0x4f, 0xa9, 0x23, 0x45, 0xf1, 0xc9, 0xd5, 0xa6,
-	0xf6, 0xb4, 0xbe, 0xf3, 0x3d, 0x6a, 0xaa, 0xb1, 0x9b, 0x4d, 0x15, 0x8a, 0xf8, 0xf6, 0xf6, 0x2a,
-	0x23, 0x11, 0x48, 0x29, 0x62, 0x38, 0xd1, 0x06, 0xf4, 0x6d, 0x18, 0x62, 0x40, 0xd6, 0x9a, 0xee,
-	0x39, 0xc4, 0x72, 0xc5, 0xac, 0x6b, 0x13, 0x21, 0x04, 0x14, 0x8a, 0xf8, 0xe6, 0xda, 0x6d, 0xd6,
-	0x89, 0x81, 0x18, 0x8e, 0x7c, 0x46, 0xaf, 0xc2, 0x30, 0x63, 0x24, 0xe5, 0x4d, 0xbd, 0x62, 0x56,
-	0x4d, 0x4f, 0x2c, 0x01, 0x6d, 0xb4, 0xb1, 0x9b, 0x1d, 0x2c, 0x14, 0x71, 0xa1, 0xbc, 0x79, 0x83,
-	0x37, 0x2b, 0x78, 0x90, 0x18, 0x8e, 0xff, 0x1a, 0x65, 0x33, 0x68, 0x85, 0xec, 0xf0, 0x35, 0xd1,
-	0xc4, 0x56, 0xe4, 0xcd, 0x01, 0x1b, 0x7f, 0x45, 0x7f, 0x06, 0x49, 0x67, 0x7b, 0x41, 0xb2, 0x24,
-	0xb9, 0x45, 0x27, 0x5b, 0x2d, 0x8a, 0xb7, 0x39, 0xad, 0x96, 0xf0, 0x6d, 0x89, 0x13, 0xce, 0xf6,
-	0x82, 0xe0, 0xff, 0x0e, 0x8c, 0x73, 0xfe, 0x60, 0x6e, 0xec, 0xf5, 0x75, 0x97, 0x7a, 0x2a, 0x70,
-	0xe9, 0x71, 0x31, 0xdc, 0x38, 0x1e, 0x65, 0x0c, 0xd2, 0xd0, 0x6f, 0x73, 0x0a, 0x74, 0x17, 0xc6,
-	0x9c, 0xed, 0x7c, 0xdb, 0xac, 0x0e, 0x76, 0x33, 0xab, 0xa1, 0x26, 0x69, 0x67, 0x3b, 0xdf, 0x3c,
-	0x83, 0xf3, 0x30, 0xcc, 0x70, 0xd7, 0x1d, 0xfa, 0xa3, 0x3a, 0xb5, 0xca, 0x3b, 0xea, 0xd0, 0xb4,
-	0x32, 0xdb, 0xa7, 0x25, 0xf7, 0xb4, 0x81, 0x7c, 0xdf, 0xec, 0x27, 0x7f, 0x33, 0x80, 0x87, 0x9c,
-	0xed, 0xfc, 0xb2, 0xdf, 0x8d, 0x6e, 0xc1, 0x08, 0x5b, 0x85, 0x46, 0xdd, 0xdb, 0xd1, 0xcb, 0x3b,
-	0xe5, 0x0a, 0x55, 0x87, 0xb9, 0x0a, 0xed, 0xcb, 0x7e, 0x63, 0xc3, 0xa1, 0x1b, 0xc4, 0xa3, 0x46,
-	0xb1, 0xee, 0xed, 0x2c, 0x32, 0xd2, 0x88, 0x22, 0x43, 0x55, 0xb2, 0x1d, 0xb4, 0x23, 0x03, 0x26,
-	0x1d, 0xca, 0x4e, 0x68, 0x9d, 0x5d, 0x07, 0x7a, 0x8d, 0x3a, 0xa6, 0x6d, 0x98, 0x65, 0xd3, 0xdb,
-	0x51, 0x47, 0x38, 0x7a, 0xae, 0xcd, 0xc8, 0x9c, 0x9c, 0x6d, 0xd8, 0xa5, 0xed, 0x9a, 0x6d, 0x51,
-	0xcb, 0x8b, 0x80, 0x4f, 0x38, 0x41, 0xef, 0x6a, 0x08, 0x85, 0x36, 0x40, 0x95, 0x52, 0xca, 0x76,
-	0xdd, 0xf2, 0x9a, 0xc4, 0xa4, 0x3a, 0x0f, 0x42, 0x88, 0x59, 0x64, 0xe4, 0x1d, 0xe4, 0x1c, 0x73,
-	0xc2, 0xee, 0xa8, 0xa0, 0xef, 0xc1, 0x58, 0xcd, 0xb4, 0x36, 0x74, 0xb7, 0x62, 0x7b, 0x11, 0xcb,
-	0xa6, 0xb9, 0x65, 0x07, 0xf7, 0xb4, 0x44, 0x7e, 0x40, 0x8d, 0x71, 0xdb, 0x8e, 0x32, 0xba, 0x5b,
-	0x15, 0xdb, 0x0b, 0x0d, 0xfc, 0x00, 0x8e, 0x87, 0xcc, 0xad, 0xd3, 0x3d, 0xda, 0xcd, 0x74, 0xf7,
-	0xa8, 0x0a, 0x9e, 0xf0, 0x81, 0x9b, 0x67, 0xfb, 0x35, 0x48, 0xaf, 0x51, 0x52, 0xb6, 0xad, 0x88,
-	0x5a, 0xa8, 0x5d, 0xad, 0x94, 0x20, 0x0a, 0x95, 0xba, 0x0e, 0x89, 0xf2, 0x13, 0x62, 0x59, 0xb4,
-	0xe2, 0xaa, 0x63, 0xfc, 0x98, 0x3b, 0xdb, 0xaa, 0x43, 0xd3, 0x61, 0x35, 0xbf, 0x28, 0xa8, 0xb9,
-	0xb1, 0x3e, 0x52, 0x7a, 0x12, 0x0a, 0x0e, 0x00, 0xd0, 0x32, 0x8c, 0xd6, 0x6b, 0xec, 0xac, 0xd3,
-	0x8d, 0xa7, 0xb4, 0x52, 0xe1, 0x73, 0xae, 0x8e, 0xef, 0x73, 0x26, 0x6b, 0xb6, 0x5d, 0xb9, 0x4b,
-	0x2a, 0x75, 0x8a, 0x53, 0x82, 0xa9, 0xc8, 0x78, 0xd8, 0xd4, 0xa2, 0x6b, 0x30, 0xe6, 0x1f, 0xbe,
-	0x51, 0xa4, 0x89, 0x43, 0x91, 0x46, 0x7d, 0xb6, 0x10, 0x6b, 0x0b, 0x8e, 0x35, 0x1d, 0x23, 0x3a,
-	0x95, 0xd3, 0xad, 0x1e, 0xe3, 0x70, 0xb3, 0x6d, 0xcb, 0x3b, 0x3c, 0x5b, 0xfc, 0x95, 0xc1, 0xc1,
-	0xb5, 0xc9, 0xc6, 0x6e, 0x76, 0xac, 0x43, 0x2f, 0x1e, 0x8b, 0x9c, 0x3f, 0x7e, 0x63, 0x54, 0x2e,
-	0x3f, 0x54, 0x42, 0xb9, 0x93, 0x07, 0xc9, 0xe5, 0xa7, 0xc9, 0xbe, 0x72, 0x9b, 0x7a, 0x7d, 0xb9,
-	0x4d, 0x8d, 0x68, 0x03, 0xb2, 0xfb, 0xae, 0x32, 0x7d, 0x8b, 0x01, 0xaa, 0x2a, 0x57, 0x20, 0x77,
-	0xe0, 0x5a, 0x13, 0xf6, 0x9c, 0xea, 0xb8, 0xd8, 0x78, 0xdf, 0xd4, 0xaf, 0x7b, 0x20, 0x2e, 0x17,
-	0x03, 0x7a, 0x05, 0xd2, 0x72, 0xe2, 0xc3, 0xd5, 0xa7, 0xb4, 0x1e, 0x37, 0x72, 0x9a, 0xc3, 0xb5,
-	0xf7, 0x1d, 0x40, 0xc1, 0x34, 0x87, 0x7c, 0x3d, 0xad, 0x7c, 0xc1, 0xa4, 0x86, 0x9c, 0x77, 0x61,
-	0xac, 0x6a, 0x5a, 0x6d, 0x9b, 0xa8, 0xf7, 0x88, 0x67, 0x66, 0xd5, 0xb4, 0x9a, 0x77, 0x11, 0xc3,
-	0x65, 0x67, 0xe0, 0x97, 0xb9, 0x61, 0xa3, 0xb8, 0x64, 0xbb, 0x19, 0xf7, 0x0c, 0x0c, 0x53, 0x8b,
-	0xac, 0x55, 0xa8, 0x2e, 0x6c, 0xc0, 0x2f, 0xd2, 0x04, 0x1e, 0x12, 0x8d, 0x77, 0x78, 0xdb, 0x95,
-	0xbe, 0x4f, 0x3f, 0xc9, 0xc6, 0xc4, 0xdf, 0x6b, 0x7d, 0x89, 0x9e, 0x74, 0xef, 0xb5, 0xbe, 0x44,
-	0x6f, 0xba, 0x2f, 0x57, 0x85, 0x91, 0x25, 0xcb, 0x28, 0xf2, 0x70, 0x42, 0x73, 0x88, 0x65, 0xa0,
-	0x63, 0xd0, 0x63, 0x1a, 0xdc, 0xc0, 0x49, 0x6d, 0xa0, 0xb1, 0x9b, 0xed, 0x29, 0x15, 0x71, 0x8f,
-	0x69, 0x20, 0x04, 0x7d, 0x16, 0xa9, 0x52, 0x6e, 0xc2, 0x24, 0xe6, 0xcf, 0xe8, 0x38, 0xf4, 0xd6,
-	0x9d, 0x0a, 0x37, 0x4d, 0x52, 0x8b, 0x37, 0x76, 0xb3, 0xbd, 0x77, 0xf0, 0x0d, 0xcc, 0xda, 0xd0,
-	0x38, 0xf4, 0x57, 0xec, 0x0d, 0xdb, 0x55, 0xfb, 0xa6, 0x7b, 0x67, 0x93, 0x58, 0xbc, 0xe4, 0xfe,
-	0x45, 0x89, 0xc8, 0x5b, 0xb1, 0x0d, 0x5a, 0x41, 0x2b, 0x90, 0x58, 0x63, 0x82, 0xf5, 0x40, 0x6a,
-	0x7e, 0x4f, 0x9b, 0x71, 0x72, 0xea, 0x4c, 0x3e, 0xf3, 0xf8, 0x01, 0x99, 0x7b, 0xef, 0xd2, 0xdc,
-	0x77, 0x1f, 0xcd, 0x5e, 0xbd, 0xf2, 0x60, 0xee, 0xd1, 0x55, 0xff, 0xf5, 0xdc, 0xfb, 0xf9, 0x0b,
-	0xcf, 0x66, 0x98, 0x1f, 0xc3, 0x75, 0x2e, 0x15, 0x71, 0x9c, 0x63, 0x94, 0x0c, 0xf4, 0x06, 0x57,
-	0x9f, 0x2b, 0xa9, 0xcd, 0x75, 0x0f, 0xd4, 0x3a, 0xca, 0xde, 0x70, 0x94, 0xb9, 0xbf, 0xeb, 0x81,
-	0x13, 0x81, 0xd2, 0x77, 0xa9, 0xc3, 0xdc, 0xdb, 0x52, 0x18, 0x3d, 0x7c, 0xdd, 0x23, 0x58, 0x81,
-	0x44, 0x95, 0x59, 0x46, 0x0f, 0xc6, 0x71, 0x14, 0x38, 0x6e, 0x54, 0x06, 0xc7, 0x31, 0x4a, 0x06,
-	0x3a, 0x07, 0xe9, 0x27, 0xc4, 0x31, 0x9e, 0x12, 0x87, 0xea, 0x5b, 0x42, 0x79, 0x39, 0xba, 0x94,
-	0xdf, 0x2e, 0xc7, 0xc4, 0x48, 0xd7, 0x4d, 0xa7, 0xda, 0x44, 0xda, 0x27, 0x48, 0xfd, 0x76, 0x49,
-	0x9a, 0xfb, 0xf5, 0x00, 0xa4, 0x5b, 0x6d, 0x82, 0xde, 0x86, 0x5e, 0xd3, 0x70, 0xb9, 0x0d, 0x06,
-	0xf3, 0xdf, 0x6a, 0x5d, 0xd1, 0x07, 0x98, 0xb0, 0x43, 0xa0, 0xc0, 0x90, 0x90, 0x0e, 0x29, 0x09,
-	0x10, 0xe8, 0xd3, 0xc3, 0xb7, 0xcb, 0x54, 0x87, 0x7b, 0x44, 0xc2, 0x32, 0x0f, 0x32, 0xf0, 0x46,
-	0x47, 0x6e, 0xd8, 0x98, 0xdc, 0x2b, 0xdc, 0x94, 0x7d, 0x78, 0x44, 0xb2, 0xf8, 0x1a, 0x9b, 0x30,
-	0xe6, 0x0b, 0xa8, 0x3d, 0xd9, 0x69, 0xb2, 0x4f, 0x07, 0x21, 0xab, 0x6f, 0xbd, 0xe3, 0x0b, 0x39,
-	0x15, 0x11, 0x32, 0x2a, 0x85, 0x84, 0xdd, 0x78, 0x54, 0x72, 0xad, 0x3e, 0xd9, 0xf1, 0x45, 0x2d,
-	0xc3, 0x68, 0x70, 0x0e, 0xe9, 0xb5, 0x0a, 0xb1, 0xd8, 0xfc, 0x72, 0xeb, 0x72, 0x9f, 0xd7, 0xe9,
-	0x51, 0xbf, 0xcf, 0x7c, 0xde, 0xe0, 0x1c, 0x5a, 0xad, 0x10, 0xab, 0x54, 0xc4, 0xa9, 0xf5, 0xa6,
-	0x06, 0xb6, 0x3f, 0x07, 0x6a, 0x4f, 0x6c, 0xcf, 0x76, 0xd5, 0x7e, 0xbe, 0xb3, 0xe4, 0x1b, 0x9a,
-	0x85, 0xb4, 0x5b, 0xaf, 0xd5, 0x6c, 0xc7, 0x73, 0xf5, 0x72, 0x85, 0xb8, 0xae, 0xbe, 0xc6, 0xfd,
-	0xe1, 0x04, 0x1e, 0xf1, 0xdb, 0x17, 0x59, 0xb3, 0xd6, 0x81, 0xb2, 0xcc, 0xfd, 0xdf, 0x56, 0xca,
-	0x45, 0x44, 0x61, 0xdc, 0xa0, 0xeb, 0xa4, 0x5e, 0xf1, 0xf4, 0x2a, 0x29, 0xeb, 0x2e, 0xf5, 0x3c,
-	0x16, 0x33, 0xca, 0x50, 0xe8, 0x44, 0x87, 0x49, 0xb8, 0x25, 0x49, 0xb4, 0x63, 0x8d, 0xdd, 0x2c,
-	0x2a, 0x0a, 0xe6, 0x48, 0x3b, 0x46, 0x12, 0x70, 0x85, 0x94, 0xfd, 0x36, 0x76, 0x82, 0xb1, 0x13,
-	0x37, 0x3c, 0xa6, 0x99, 0x8f, 0xdc, 0x87, 0x87, 0xaa, 0x66, 0xc4, 0x99, 0x60, 0x44, 0x64, 0x3b,
-	0x42, 0x04, 0x92, 0x88, 0x6c, 0x37, 0x11, 0x05, 0x43, 0x63, 0x4e, 0x16, 0xf7, 0x74, 0x13, 0x78,
-	0xc8, 0x6f, 0xbc, 0x66, 0x9b, 0x16, 0xba, 0x00, 0xc8, 0xa1, 0x2e, 0x95, 0x24, 0xba, 0x65, 0x5b,
-	0x65, 0xea, 0x72, 0x0f, 0x36, 0x81, 0xd3, 0xa2, 0x87, 0xd1, 0xdd, 0xe4, 0xed, 0x88, 0x82, 0xaf,
-	0xb2, 0xbe, 0x6e, 0x3b, 0x55, 0xe2, 0x31, 0x4f, 0x85, 0xbb, 0xaf, 0x1d, 0xee, 0xd9, 0x15, 0x11,
-	0xd2, 0xaf, 0x92, 0x9d, 0x8a, 0x4d, 0x8c, 0xe5, 0x80, 0x5e, 0x1b, 0x8a, 0x2e, 0x70, 0x3c, 0x2a,
-	0x11, 0x43, 0x02, 0x71, 0x34, 0xe7, 0xfe, 0x63, 0x0c, 0x06, 0x23, 0xd6, 0x42, 0x6f, 0x42, 0x4a,
-	0xce, 0x25, 0xf7, 0x52, 0xec, 0xba, 0x27, 0x77, 0xd7, 0xf1, 0x36, 0x47, 0xa5, 0x28, 0x53, 0x2e,
-	0x5a, 0xdf, 0xc7, 0x2c, 0x02, 0x1d, 0xe6, 0x7c, 0xda, 0x6d, 0xc1, 0x85, 0xee, 0xc1, 0x44, 0x78,
-	0x73, 0x47, 0x5d, 0xd8, 0x1e, 0x0e, 0xd7, 0xe6, 0xc2, 0xae, 0xca, 0xbb, 0x59, 0x38, 0xa8, 0xe2,
-	0xc2, 0x1e, 0xab, 0x35, 0x35, 0x0a, 0xaf, 0xf5, 0xe1, 0x41, 0x8e, 0x67, 0x6f, 0xd7, 0xce, 0xc0,
-	0x3e, 0x9e, 0xe7, 0xbd, 0xce, 0x3e, 0x71, 0x1f, 0xc7, 0x3d, 0xd9, 0x66, 0x83, 0x3b, 0x25, 0xcb,
-	0x7b, 0xed, 0x15, 0xe1, 0xd9, 0x44, 0x2f, 0xf9, 0x76, 0x7f, 0x19, 0x77, 0x70, 0x69, 0x8f, 0x1f,
-	0x0d, 0xb5, 0xcd, 0xdd, 0x0d, 0x26, 0xab, 0x1c, 0x4c, 0x56, 0xff, 0x51, 0x26, 0x6b, 0xd1, 0x9f,
-	0xac, 0xef, 0x46, 0xe3, 0xc5, 0x01, 0xa9, 0x55, 0xe7, 0x78, 0x51, 0x58, 0x2f, 0x0c, 0x15, 0xef,
-	0xee, 0x13, 0x2a, 0xc6, 0x0f, 0x18, 0xdb, 0xe5, 0xbc, 0x18, 0xdb, 0x41, 0x81, 0xe4, 0x0f, 0x3a,
-	0x07, 0x92, 0x89, 0xae, 0x27, 0xb8, 0x3d, 0x86, 0xbc, 0xd1, 0x1a, 0x43, 0x26, 0x8f, 0x66, 0xff,
-	0xe6, 0x08, 0xf3, 0x75, 0x98, 0x5a, 0x27, 0x65, 0xcf, 0x76, 0x76, 0xf4, 0x1a, 0xdf, 0xc3, 0x01,
-	0xb0, 0x49, 0x5d, 0x15, 0xa6, 0x7b, 0x67, 0xfb, 0xb0, 0x2a, 0x29, 0x56, 0x39, 0xc1, 0x72, 0xd8,
-	0x8f, 0x6e, 0xb6, 0xc5, 0xa7, 0x83, 0xfb, 0x38, 0xd2, 0xed, 0xf1, 0xa9, 0x18, 0x5f, 0x73, 0x68,
-	0x5a, 0x86, 0x89, 0xe0, 0x1c, 0xba, 0x9c, 0xd7, 0xd7, 0x4c, 0x99, 0xeb, 0xe2, 0xa7, 0xcc, 0x81,
-	0x61, 0x86, 0x36, 0xc1, 0x6e, 0x94, 0x5b, 0x92, 0xf9, 0x72, 0x5e, 0x33, 0x79, 0x46, 0x0c, 0x8f,
-	0xba, 0xad, 0x4d, 0xe8, 0x2a, 0xc4, 0xeb, 0x2e, 0xd5, 0x89, 0xe1, 0xc8, 0xe3, 0xe8, 0x20, 0x58,
-	0x68, 0xec, 0x66, 0x07, 0xee, 0xb8, 0xb4, 0x50, 0xc4, 0x78, 0xa0, 0xee, 0xd2, 0x82, 0xe1, 0xa0,
-	0x12, 0x00, 0x0b, 0x23, 0xaa, 0xc4, 0xd9, 0x30, 0x2d, 0x1e, 0x33, 0xb3, 0x43, 0xbd, 0x15, 0x63,
-	0xb9, 0x62, 0x13, 0x19, 0x2d, 0x0c, 0x37, 0x76, 0xb3, 0xc9, 0x42, 0x11, 0xaf, 0x70, 0x0e, 0x9c,
-	0x24, 0x86, 0x23, 0x1e, 0xd1, 0xeb, 0x30, 0x24, 0xcf, 0x54, 0x31, 0xce, 0xd4, 0xa1, 0xe1, 0x14,
-	0x08, 0x7a, 0x3e, 0x92, 0x7b, 0x30, 0xe9, 0x7a, 0xc4, 0xab, 0xbb, 0xed, 0x91, 0x7c, 0xba, 0xbb,
-	0x1d, 0x34, 0x21, 0xf8, 0x5b, 0x83, 0xf7, 0xbb, 0xa0, 0x4a, 0xe0, 0xf6, 0xe0, 0x7d, 0xf4, 0xf0,
-	0x2d, 0x81, 0x8f, 0x09, 0xee, 0xb6, 0x58, 0xfd, 0x2d, 0x18, 0x35, 0xa8, 0x6b, 0x3a, 0xd4, 0xd0,
-	0xc3, 0x9d, 0x8a, 0xba, 0xd8, 0xa9, 0x29, 0xc9, 0x86, 0xfd, 0x0d, 0xfb, 0x10, 0x4e, 0x36, 0x21,
-	0xb5, 0x6e, 0xdc, 0xb1, 0x2e, 0xb4, 0x54, 0x23, 0xa0, 0xcd, 0xdb, 0xf6, 0x87, 0x70, 0x22, 0x44,
-	0x6f, 0xdf, 0xbe, 0xe3, 0x5d, 0x6f, 0xdf, 0xc9, 0x40, 0x44, 0xcb, 0x2e, 0x7e, 0x00, 0x13, 0x51,
-	0x09, 0xe1, 0x6e, 0x9e, 0x38, 0xda, 0x6e, 0x1e, 0x0b, 0x05, 0x84, 0x9b, 0xfa, 0x11, 0x1c, 0xf3,
-	0xc1, 0x5b, 0xb6, 0xe7, 0xb1, 0x23, 0x6e, 0x4f, 0x1f, 0x7e, 0x25, 0xba, 0x4b, 0xff, 0x5a, 0x81,
-	0x8c, 0x8f, 0xbf, 0x4f, 0x1c, 0x3f, 0x79, 0xc4, 0x38, 0x3e, 0xd3, 0xd8, 0xcd, 0x4e, 0x15, 0x05,
-	0x66, 0xa7, 0x70, 0x7e, 0x4a, 0xca, 0x2b, 0x74, 0x88, 0xea, 0x3b, 0xa9, 0xd3, 0x12, 0xde, 0xab,
-	0x47, 0x0c, 0xef, 0xdb, 0xd5, 0x69, 0x8e, 0xf2, 0x9b, 0xd5, 0x69, 0x0e, 0xf6, 0x37, 0xe1, 0xb4,
-	0xaf, 0xcd, 0xfe, 0x37, 0xfc, 0x89, 0xae, 0x57, 0x90, 0xbf, 0xcc, 0x57, 0x3b, 0x5e, 0xf4, 0xeb,
-	0xe1, 0x42, 0xed, 0x74, 0xe1, 0x9f, 0x3c, 0xda, 0x62, 0x52, 0x5b, 0x64, 0x85, 0x2b, 0x8a, 0x80,
-	0xdf, 0xa7, 0xb7, 0xdd, 0xff, 0xa7, 0x8e, 0x26, 0xc4, 0x5f, 0x9a, 0x5a, 0xb3, 0x1b, 0x90, 0xfb,
-	0x9f, 0x41, 0x48, 0x30, 0x1f, 0xce, 0x23, 0x1e, 0x45, 0xf7, 0x01, 0x95, 0xeb, 0x8e, 0x43, 0xd9,
-	0xd9, 0x13, 0xe4, 0xb9, 0xa4, 0x0f, 0x77, 0xea, 0xc0, 0x64, 0x58, 0xab, 0xcb, 0x28, 0x61, 0x22,
-	0xa9, 0xfd, 0xfb, 0xcc, 0x33, 0x95, 0x36, 0x0b, 0xb1, 0x7b, 0xbe, 0x04, 0xb6, 0x6f, 0xae, 0x10,
-	0x5b, 0x83, 0x21, 0x51, 0x63, 0x14, 0x11, 0x82, 0x8c, 0x88, 0x26, 0x5a, 0x51, 0x45, 0x44, 0x11,
-	0x66, 0x27, 0x06, 0x05, 0x13, 0x6f, 0xee, 0x14, 0xbd, 0xf5, 0x7d, 0xad, 0xd1, 0xdb, 0x23, 0x98,
-	0x0a, 0xea, 0x39, 0xa6, 0x53, 0xa5, 0x46, 0x50, 0x56, 0xd1, 0x89, 0xef, 0x7b, 0x1d, 0x54, 0xaf,
-	0xe9, 0xe3, 0xb5, 0x9a, 0x49, 0xbf, 0xee, 0xc3, 0x21, 0xfc, 0x8a, 0x4a, 0xc1, 0x43, 0xaf, 0x82,
-	0xca, 0xe1, 0x0d, 0xba, 0xa5, 0xcb, 0x5b, 0x24, 0x28, 0x58, 0x89, 0xfa, 0xd2, 0x18, 0xeb, 0x2f,
-	0xd2, 0xad, 0x5b, 0xbc, 0x57, 0x56, 0xae, 0xf6, 0x75, 0xb5, 0xe3, 0x5f, 0xd1, 0xd5, 0xa6, 0x70,
-	0xb2, 0x46, 0x2d, 0x83, 0x61, 0x77, 0x2a, 0x25, 0x49, 0x67, 0xac, 0xbb, 0x4a, 0x92, 0x04, 0xea,
-	0xd0, 0x87, 0x96, 0x20, 0x2d, 0x0b, 0x56, 0x0e, 0x75, 0x6b, 0xb6, 0xe5, 0x52, 0xbf, 0x48, 0xd5,
-	0x69, 0xde, 0x16, 0xed, 0x6a, 0x95, 0x58, 0x06, 0x4e, 0x09, 0x1e, 0xec, 0xb3, 0x30, 0x18, 0x5f,
-	0x5b, 0xbe, 0x35, 0x5c, 0x4f, 0xb8, 0x61, 0x87, 0xc0, 0x48, 0x1e, 0x2c, 0x59, 0xd0, 0x0f, 0x00,
-	0x49, 0x6d, 0x78, 0xb0, 0x46, 0xca, 0x65, 0x5a, 0xf3, 0xa4, 0x77, 0x76, 0xa6, 0x53, 0x00, 0xca,
-	0xb6, 0xdd, 0x3c, 0x8b, 0xdf, 0x0a, 0x9c, 0x14, 0xcb, 0xc1, 0x84, 0x2d, 0x68, 0x05, 0xc6, 0x7d,
-	0xcd, 0x38, 0xa6, 0x54, 0x4f, 0xfa, 0x66, 0x6d, 0x51, 0x2d, 0xe3, 0x94, 0xea, 0x60, 0x24, 0x19,
-	0x23, 0x6d, 0xe8, 0x12, 0x73, 0xb9, 0xf5, 0xa7, 0xa6, 0x65, 0xd8, 0x4f, 0x5d, 0x9d, 0x6c, 0x11,
-	0xb3, 0x42, 0xd6, 0x64, 0x85, 0x23, 0x81, 0x91, 0xb3, 0x7d, 0x4f, 0x74, 0x15, 0xfc, 0x1e, 0x54,
-	0x84, 0x11, 0x87, 0x96, 0x29, 0x5f, 0x49, 0xa2, 0x08, 0x38, 0xc2, 0x0d, 0xd3, 0xb6, 0x69, 0x45,
-	0xf2, 0x4e, 0x06, 0x95, 0x78, 0x58, 0x30, 0x89, 0x46, 0x17, 0x5d, 0x83, 0xb4, 0x44, 0x09, 0x8b,
-	0x89, 0x29, 0x8e, 0x93, 0x6d, 0x3b, 0x8e, 0x25, 0x81, 0x8f, 0x94, 0x12, 0x8c, 0x41, 0xf5, 0x10,
-	0x55, 0x20, 0x27, 0xaa, 0xad, 0xa2, 0x18, 0xac, 0x9b, 0x96, 0xe9, 0x99, 0xec, 0x1a, 0x6d, 0xda,
-	0x51, 0xe9, 0x2e, 0x77, 0x54, 0x86, 0x17, 0x68, 0x05, 0x54, 0xc9, 0x47, 0x0a, 0x37, 0xd6, 0xd4,
-	0xbf, 0x29, 0x00, 0x91, 0xf9, 0x38, 0x03, 0xf1, 0x9a, 0x08, 0x98, 0xf9, 0xc1, 0x38, 0xc4, 0x0f,
-	0xd9, 0xf7, 0xfa, 0xd2, 0xa3, 0xea, 0x69, 0xec, 0xf7, 0xa0, 0x45, 0x88, 0xfb, 0xf3, 0xd4, 0x73,
-	0xe8, 0x3c, 0xb5, 0x9c, 0x6f, 0x3e, 0x27, 0x7a, 0xa3, 0xfb, 0xd2, 0x75, 0x33, 0x02, 0x67, 0x93,
-	0x31, 0xfa, 0xe7, 0x4a, 0x24, 0x1d, 0x58, 0xa8, 0x7b, 0x4f, 0xa8, 0xe5, 0xc9, 0x3d, 0xb4, 0x68,
-	0x1b, 0x14, 0xcd, 0x41, 0xbf, 0x48, 0x85, 0x8b, 0x5c, 0xe0, 0xe4, 0x9e, 0x36, 0xee, 0xa0, 0x7c,
-	0xfa, 0xf1, 0x83, 0xc2, 0xdc, 0xfd, 0x4b, 0x73, 0xdf, 0x7d, 0xf4, 0xfe, 0xc2, 0x85, 0xcb, 0xf9,
-	0x67, 0x33, 0x58, 0x50, 0xa1, 0xab, 0x00, 0xfc, 0xe3, 0x0b, 0x7d, 0xdd, 0xb1, 0xab, 0x72, 0x6c,
-	0x87, 0x9b, 0x38, 0xc9, 0x79, 0x96, 0x1d, 0xbb, 0x8a, 0xbe, 0x07, 0x09, 0x01, 0xe0, 0xd9, 0x72,
-	0x60, 0x87, 0xb3, 0xc7, 0x39, 0xc7, 0x6d, 0x5b, 0x0e, 0xe9, 0xe3, 0x69, 0x48, 0x06, 0x43, 0x42,
-	0x6f, 0x45, 0xd3, 0x78, 0x33, 0xfb, 0xa6, 0xf1, 0xba, 0xc8, 0xdf, 0x2d, 0x02, 0x94, 0x1d, 0x4a,
-	0x64, 0x01, 0xbd, 0xe7, 0x28, 0x05, 0x74, 0xc9, 0x57, 0xf0, 0x18, 0x48, 0xbd, 0x66, 0xf8, 0x20,
-	0xbd, 0x47, 0x01, 0x91, 0x7c, 0x05, 0x0f, 0x9d, 0x90, 0x79, 0x5d, 0x91, 0x70, 0x8b, 0x8b, 0x84,
-	0x5b, 0x5e, 0xa6, 0xb1, 0xcf, 0xc3, 0xa0, 0x41, 0xdd, 0xb2, 0x63, 0xd6, 0xd8, 0x24, 0xf2, 0x8b,
-	0x23, 0xc9, 0x2f, 0x35, 0xa7, 0x57, 0xfd, 0x3c, 0x85, 0xa3, 0x9d, 0xe8, 0x29, 0x00, 0xf1, 0x3c,
-	0xc7, 0x5c, 0xab, 0x7b, 0xd4, 0x55, 0x07, 0xf8, 0x7e, 0x3b, 0xb7, 0xaf, 0x8d, 0xe6, 0x0b, 0x01,
-	0xed, 0x92, 0xe5, 0x39, 0x3b, 0xda, 0x85, 0x3d, 0xed, 0xdc, 0xdf, 0x2b, 0x2f, 0xe5, 0xba, 0xca,
-	0xe7, 0xe2, 0x88, 0x28, 0xf4, 0x10, 0x06, 0xe5, 0x2d, 0xaa, 0xb3, 0xd9, 0x89, 0x1f, 0x3d, 0xc9,
-	0x3a, 0xd2, 0xd8, 0xcd, 0x82, 0xdf, 0x5e, 0x74, 0x31, 0x6c, 0xf9, 0x34, 0x2e, 0x2a, 0x01, 0x72,
-	0xa9, 0xc3, 0x2f, 0xfc, 0x9a, 0x63, 0xaf, 0x9b, 0x15, 0xaa, 0x9b, 0x06, 0xbf, 0x51, 0x92, 0xda,
-	0x89, 0x30, 0x3d, 0x99, 0xbe, 0x25, 0x88, 0x56, 0x05, 0x4d, 0xa9, 0x88, 0xd3, 0x6e, 0x73, 0x8b,
-	0x81, 0xfe, 0x53, 0x81, 0x63, 0xfe, 0x39, 0xc2, 0x3a, 0xa9, 0xc3, 0x3f, 0x42, 0xa1, 0xae, 0xcb,
-	0x23, 0xfc, 0xa4, 0xf6, 0xb7, 0xca, 0x9e, 0xf6, 0x33, 0xc5, 0xf9, 0x89, 0x92, 0xff, 0x0b, 0xe5,
-	0xf1, 0xec, 0xd5, 0x2b, 0x6c, 0xec, 0x64, 0xee, 0x3d, 0xb9, 0x3d, 0x3e, 0x88, 0x3c, 0x87, 0x8f,
-	0x0f, 0xe7, 0x1e, 0x9d, 0x8f, 0x74, 0x9c, 0x7b, 0x38, 0x7f, 0xee, 0x3c, 0xe3, 0x2b, 0xcc, 0xdd,
-	0x97, 0x26, 0xfb, 0x20, 0xf2, 0x1c, 0x3e, 0x72, 0xbe, 0xb0, 0xe3, 0xdc, 0xec, 0xd5, 0x2b, 0x57,
-	0x1e, 0xc8, 0x5d, 0xf8, 0xea, 0xb3, 0x73, 0x57, 0x67, 0x3e, 0x78, 0x3c, 0x83, 0xc7, 0xa5, 0xba,
-	0xb7, 0xb8, 0xb6, 0x05, 0xa1, 0x2c, 0xba, 0x0f, 0x6a, 0xcb, 0x30, 0x36, 0xe9, 0xa6, 0x5e, 0x21,
-	0x6b, 0xb4, 0xa2, 0x5e, 0xe4, 0x03, 0x39, 0x2d, 0x96, 0xc8, 0x87, 0xe9, 0xc6, 0x6e, 0x76, 0xe2,
-	0x66, 0x14, 0xe3, 0xfa, 0xd2, 0xf5, 0x1b, 0x8c, 0x10, 0x4f, 0x34, 0x41, 0x5f, 0xa7, 0x9b, 0xbc,
-	0x19, 0xfd, 0xb7, 0x02, 0x53, 0xd1, 0x3b, 0xbc, 0xc5, 0x4e, 0xf0, 0xcd, 0xb4, 0x93, 0x1a, 0x51,
-	0xb9, 0xd9, 0x56, 0xeb, 0x70, 0xb2, 0xc3, 0x70, 0x42, 0x7b, 0x5d, 0xe2, 0x03, 0x3a, 0x1b, 0xb1,
-	0xd7, 0xf1, 0x42, 0x2b, 0x56, 0x60, 0xb3, 0xe3, 0x6d, 0x62, 0x02, 0xbb, 0x61, 0x98, 0xe8, 0x20,
-	0xc7, 0x34, 0xd4, 0x05, 0x2e, 0x20, 0x23, 0x56, 0xaa, 0xc1, 0x8b, 0x9a, 0xad, 0x20, 0xa5, 0x22,
-	0x1e, 0x6b, 0x43, 0x2e, 0x19, 0xe8, 0xdf, 0x15, 0x18, 0xe3, 0x7e, 0x40, 0xcb, 0x24, 0x0c, 0x7e,
-	0x33, 0x27, 0x61, 0x94, 0xe9, 0xda, 0x6c, 0x7d, 0x0f, 0x92, 0x15, 0x5b, 0x8c, 0xca, 0x55, 0x87,
-	0xf8, 0x91, 0x34, 0xbb, 0xff, 0x91, 0x74, 0xc3, 0x27, 0xfd, 0x32, 0x27, 0x52, 0x28, 0x08, 0x2d,
-	0x40, 0x5c, 0x7e, 0x9f, 0xa6, 0xe6, 0xf9, 0x61, 0x34, 0xd9, 0xee, 0xd9, 0xf2, 0x6e, 0xec, 0xd3,
-	0x75, 0xac, 0x51, 0x0c, 0x77, 0x5d, 0xa3, 0x18, 0xe9, 0x58, 0xa3, 0xe8, 0x10, 0x65, 0xa4, 0xfe,
-	0x14, 0x35, 0xa2, 0xf4, 0x9f, 0xaa, 0x46, 0x34, 0x7a, 0xf4, 0x1a, 0x51, 0x5b, 0x41, 0x05, 0x75,
-	0x53, 0x50, 0x19, 0xeb, 0xa6, 0xa0, 0x32, 0xde, 0x75, 0x41, 0x65, 0x62, 0x9f, 0x82, 0xca, 0xab,
-	0x90, 0x74, 0x6c, 0xdb, 0xd3, 0xb9, 0x27, 0x26, 0xf2, 0x38, 0x6a, 0x5b, 0xce, 0xcc, 0xb6, 0x3d,
-	0xe6, 0x86, 0xe1, 0x84, 0x23, 0x9f, 0xd0, 0x5d, 0x18, 0xb0, 0xa8, 0xc7, 0x0c, 0x32, 0xc9, 0x9d,
-	0xc4, 0xab, 0xbf, 0xdd, 0xcd, 0xe6, 0x8f, 0xf4, 0x25, 0xe3, 0x4d, 0xea, 0x95, 0x8a, 0x8d, 0xdd,
-	0x6c, 0x3f, 0x7f, 0xc0, 0xfd, 0x16, 0xf5, 0x4a, 0x06, 0x7a, 0x1b, 0x86, 0x9a, 0x6a, 0x5b, 0xea,
-	0xe1, 0xb5, 0xad, 0x54, 0x63, 0x37, 0x1b, 0x2d, 0xd3, 0xe0, 0xc1, 0x6a, 0xa4, 0x9a, 0xb5, 0x08,
-	0x49, 0x0e, 0xc8, 0x02, 0x11, 0x59, 0x53, 0x50, 0xf7, 0x0b, 0x54, 0xb4, 0xa1, 0xc6, 0x6e, 0x36,
-	0xc8, 0x16, 0xe0, 0x04, 0xc3, 0xe1, 0x79, 0x83, 0x77, 0x60, 0xd4, 0x8f, 0x51, 0x42, 0xb0, 0x0b,
-	0x87, 0x80, 0x8d, 0xb1, 0xc5, 0xb1, 0x2a, 0xd8, 0x02, 0x4c, 0x3f, 0xa2, 0x5a, 0xf1, 0xa1, 0x17,
-	0x20, 0xee, 0x0a, 0x47, 0x57, 0x9d, 0xea, 0xbc, 0x6f, 0xa5, 0x1f, 0x8c, 0x7d, 0x3a, 0xf4, 0x7d,
-	0xf0, 0x51, 0x74, 0x9f, 0xf5, 0xc4, 0xc1, 0xac, 0x23, 0x92, 0xde, 0xff, 0x1a, 0x75, 0x06, 0x46,
-	0x82, 0x58, 0x9a, 0xaf, 0x0f, 0x9e, 0xd2, 0x19, 0xc6, 0x43, 0x32, 0x82, 0xe6, 0x6b, 0x03, 0xbd,
-	0x04, 0xa9, 0xba, 0x4b, 0x8d, 0x90, 0xca, 0x55, 0x4f, 0x4d, 0xf7, 0xce, 0x0e, 0xe3, 0x61, 0xd6,
-	0xec, 0x93, 0xb9, 0x8c, 0x8e, 0xa3, 0x85, 0xcb, 0x4d, 0xcd, 0x84, 0x1f, 0x7c, 0x06, 0x6b, 0x0d,
-	0x7d, 0x5b, 0xd2, 0x39, 0xef, 0xca, 0xfc, 0xef, 0x25, 0x35, 0xcb, 0xbf, 0x99, 0x63, 0x37, 0xd0,
-	0xd0, 0x0d, 0xe2, 0x7a, 0xf8, 0x1a, 0xcf, 0xed, 0x5e, 0x12, 0x8a, 0xe0, 0x77, 0xc5, 0x5b, 0x3b,
-	0xe3, 0x82, 0x3a, 0xdd, 0x91, 0x71, 0xa1, 0x89, 0x71, 0x01, 0x3d, 0x86, 0x13, 0xad, 0x39, 0x03,
-	0x16, 0x6b, 0x99, 0x5b, 0xc2, 0x7b, 0x3d, 0x7d, 0x94, 0x9c, 0x44, 0x90, 0x58, 0xc0, 0x12, 0xa1,
-	0xe0, 0xa1, 0x25, 0x18, 0x14, 0xdf, 0x4c, 0x8a, 0x15, 0x91, 0xdb, 0xe7, 0x10, 0x62, 0x24, 0x62,
-	0x4d, 0x84, 0xb9, 0x19, 0xa8, 0x05, 0xad, 0xe8, 0x01, 0xa0, 0x35, 0x5e, 0x78, 0xdc, 0xd1, 0x6b,
-	0xd4, 0x61, 0xb1, 0x20, 0xd9, 0xa0, 0xea, 0x99, 0xc3, 0x2b, 0x00, 0xa9, 0x3d, 0x6d, 0x08, 0xe0,
-	0x54, 0x2c, 0xf6, 0xe1, 0xd5, 0xb9, 0x58, 0x2c, 0x16, 0xc3, 0xa3, 0x12, 0x67, 0x35, 0x80, 0x41,
-	0x2f, 0x43, 0x2a, 0x88, 0x1a, 0x65, 0x6d, 0x61, 0x66, 0x5a, 0x99, 0xed, 0xc7, 0x23, 0x7e, 0xb3,
-	0x2c, 0x1a, 0x10, 0x76, 0x6e, 0xf0, 0x08, 0x96, 0x18, 0x4e, 0x10, 0x0b, 0x9f, 0xed, 0x22, 0x16,
-	0xd6, 0xc6, 0x99, 0x33, 0x8a, 0x39, 0x73, 0xa1, 0x88, 0x65, 0x48, 0x8c, 0x65, 0x40, 0x5c, 0x30,
-	0x1c, 0x3f, 0x48, 0x6e, 0x0f, 0xb5, 0x5f, 0xfa, 0x9a, 0x42, 0xed, 0x97, 0xbf, 0x64, 0xa8, 0x7d,
-	0xd8, 0xf7, 0xc0, 0xb3, 0x5f, 0xcb, 0xf7, 0xc0, 0xe8, 0x2d, 0x80, 0x48, 0xb9, 0xfa, 0xdc, 0xd1,
-	0xca, 0xd5, 0x38, 0xc2, 0x8b, 0xd6, 0x60, 0xa4, 0xe6, 0xd8, 0x5b, 0x26, 0xdb, 0xc7, 0xc2, 0xd9,
-	0x3a, 0xcf, 0x6f, 0xa4, 0xef, 0xed, 0x69, 0x2f, 0x3b, 0x67, 0xd5, 0x99, 0xfc, 0xe9, 0x83, 0x7d,
-	0x86, 0x0f, 0x1e, 0xcf, 0x34, 0x76, 0xb3, 0xc3, 0xab, 0x21, 0x46, 0xa9, 0x88, 0x87, 0x23, 0x90,
-	0x25, 0x03, 0x15, 0x61, 0x34, 0x68, 0x60, 0xa7, 0x8c, 0x41, 0x3c, 0xa2, 0x7e, 0x4b, 0x1e, 0x31,
-	0xad, 0xcb, 0xf1, 0x16, 0xff, 0x69, 0x00, 0x4e, 0x47, 0x39, 0x8a, 0xc4, 0x23, 0xe8, 0x24, 0x24,
-	0xab, 0xf5, 0x0a, 0x0b, 0xc6, 0x5d, 0x4f, 0x9d, 0xe3, 0xd7, 0x4f, 0xd8, 0x80, 0x36, 0xe0, 0x78,
-	0xb9, 0x42, 0xcc, 0xaa, 0x4e, 0x9a, 0x62, 0x76, 0xbd, 0x6c, 0x1b, 0x54, 0x9d, 0x3f, 0x24, 0x9c,
-	0x6a, 0x8f, 0xf3, 0xf1, 0x24, 0x47, 0xeb, 0x90, 0x00, 0x98, 0x87, 0x31, 0x77, 0xd3, 0xac, 0xe9,
-	0x32, 0x75, 0xa1, 0x97, 0x9d, 0x9d, 0x9a, 0x67, 0xab, 0x97, 0xb9, 0x42, 0xa3, 0xac, 0x4b, 0x1a,
-	0x7c, 0x91, 0x77, 0x4c, 0xbd, 0x01, 0xa9, 0x96, 0x30, 0x11, 0xa5, 0xa1, 0x77, 0x93, 0x8a, 0xcf,
-	0xdc, 0x92, 0x98, 0x3d, 0xa2, 0x71, 0x3f, 0xab, 0x20, 0xbe, 0xbf, 0x12, 0x2f, 0x57, 0x7a, 0xbe,
-	0xa3, 0x4c, 0xdd, 0x85, 0x91, 0x66, 0x97, 0xae, 0x03, 0xf7, 0x7c, 0x94, 0xbb, 0xc3, 0x15, 0xe2,
-	0x03, 0x44, 0x70, 0x65, 0x6a, 0xe0, 0x2d, 0x80, 0xc0, 0x08, 0x2e, 0xba, 0x02, 0x83, 0xe1, 0xcf,
-	0x4f, 0x5c, 0x55, 0xe1, 0x6b, 0xf5, 0xf8, 0xbe, 0x56, 0xc3, 0x40, 0x03, 0xde, 0x9c, 0x01, 0xc7,
-	0x16, 0x79, 0x50, 0x1f, 0x76, 0xcb, 0xb4, 0xcc, 0x35, 0x80, 0x10, 0x35, 0xf8, 0xc0, 0x61, 0x3f,
-	0xd0, 0x0e, 0xc9, 0x86, 0x64, 0x20, 0x26, 0xf7, 0x4f, 0x0a, 0x1c, 0xbb, 0xc3, 0xc3, 0xfe, 0xff,
-	0x4f, 0x31, 0xe8, 0x2a, 0x40, 0xf8, 0x1b, 0x96, 0x7d, 0x33, 0x1b, 0xcb, 0x8c, 0x64, 0x85, 0xb8,
-	0x9b, 0x5a, 0x1f, 0x4f, 0x23, 0x25, 0xd7, 0xfd, 0x86, 0xdc, 0xbf, 0x2a, 0x30, 0xf6, 0x26, 0xf5,
-	0xda, 0x94, 0x7c, 0x08, 0x23, 0xa1, 0x92, 0xfa, 0x57, 0xcf, 0xc3, 0x0c, 0xd1, 0x90, 0xce, 0xfd,
-	0xea, 0x6a, 0x7f, 0xa1, 0xc0, 0xd9, 0xa8, 0xda, 0x11, 0xe1, 0xcb, 0xb6, 0xb3, 0x74, 0xa7, 0xe4,
-	0xfa, 0x03, 0xf9, 0x21, 0x24, 0xf8, 0xf5, 0x4c, 0xeb, 0xa6, 0x4c, 0xeb, 0x2d, 0xc9, 0xdf, 0x9f,
-	0x1c, 0xcd, 0x6b, 0x5b, 0xba, 0x53, 0x7a, 0xed, 0x95, 0xc6, 0x6e, 0x36, 0xce, 0xae, 0xf5, 0xa5,
-	0x3b, 0x25, 0x1c, 0x67, 0xb0, 0x4b, 0x75, 0x13, 0x3d, 0x82, 0x38, 0xbb, 0x66, 0x99, 0x00, 0xf1,
-	0x03, 0x97, 0xe2, 0x57, 0x12, 0x30, 0x50, 0xa4, 0x5b, 0x0c, 0x7f, 0xc0, 0xa0, 0x5b, 0x4b, 0x75,
-	0x33, 0xf7, 0x51, 0x2f, 0x4c, 0xdc, 0x30, 0xdd, 0x70, 0xac, 0xc1, 0xd0, 0x08, 0xa4, 0xa2, 0x67,
-	0x77, 0x38, 0x49, 0x2f, 0x1d, 0x70, 0x6a, 0x1f, 0x3c, 0x4d, 0x23, 0x24, 0x4a, 0xf9, 0xd5, 0x27,
-	0x0a, 0x7d, 0xa2, 0x40, 0xbf, 0xed, 0x18, 0xd4, 0x91, 0x5f, 0x67, 0xfe, 0x95, 0xb2, 0xa7, 0xfd,
-	0xa5, 0xe2, 0xfc, 0x58, 0xc1, 0x31, 0x9c, 0x0c, 0x56, 0x17, 0x86, 0xb9, 0xf0, 0x39, 0x98, 0x2f,
-	0x9c, 0x9c, 0x0b, 0x1e, 0x7d, 0x13, 0xe3, 0xc4, 0x9c, 0xff, 0xc4, 0x93, 0x66, 0xb8, 0x7f, 0x8e,
-	0xff, 0x8b, 0x26, 0xc7, 0xf0, 0xd0, 0x5c, 0xf4, 0x2d, 0x92, 0xfb, 0xc3, 0x83, 0x73, 0x91, 0x17,
-	0xa1, 0x18, 0xca, 0x40, 0xbf, 0xf8, 0xf1, 0x05, 0xff, 0xf5, 0x0f, 0xf7, 0x54, 0xce, 0xf7, 0xaa,
-	0x5f, 0xc4, 0xb1, 0x68, 0x46, 0x08, 0xfa, 0x6a, 0xcc, 0x2d, 0x11, 0xbf, 0xfa, 0xe1, 0xcf, 0xb9,
-	0x7f, 0x50, 0x60, 0xec, 0x56, 0x87, 0x6d, 0xb3, 0x7c, 0xb4, 0xbd, 0xdd, 0x9c, 0xdd, 0xfd, 0x3a,
-	0xf7, 0xf5, 0x7f, 0x29, 0x30, 0x1a, 0xc8, 0xb9, 0x4d, 0xab, 0xb5, 0x0a, 0xf3, 0xb7, 0xbe, 0x29,
-	0xea, 0xa1, 0x59, 0x18, 0xac, 0x92, 0x1a, 0xaf, 0x4f, 0xb1, 0x2b, 0xa2, 0x37, 0x9a, 0x0e, 0x35,
-	0x30, 0xc8, 0xbe, 0xeb, 0x74, 0x27, 0xf7, 0xa9, 0x02, 0x93, 0x6d, 0x03, 0x11, 0x2e, 0x42, 0x90,
-	0x4d, 0x55, 0x9a, 0xd9, 0x3b, 0x66, 0x53, 0x7b, 0xa2, 0xd9, 0xd4, 0xcf, 0x94, 0xe6, 0x6c, 0xea,
-	0x6d, 0x48, 0xf1, 0x5c, 0x23, 0xdd, 0xf6, 0xa8, 0xe5, 0xf2, 0xfc, 0x45, 0xef, 0x74, 0xef, 0x6c,
-	0x52, 0xfb, 0xd6, 0x9e, 0x36, 0xfb, 0x91, 0x72, 0x36, 0x6d, 0xa8, 0x4a, 0x2e, 0xeb, 0x9c, 0xca,
-	0x9f, 0x78, 0x3c, 0x7b, 0xf5, 0xca, 0xc3, 0x79, 0xdf, 0xb3, 0x78, 0x7f, 0xe1, 0xc2, 0xc2, 0x6b,
-	0xcf, 0xce, 0xbd, 0xbf, 0x70, 0x21, 0xff, 0x6c, 0x06, 0x8f, 0x30, 0x8c, 0xa5, 0x00, 0x22, 0xf7,
-	0x47, 0x05, 0xd4, 0x7d, 0x54, 0x77, 0xd1, 0x33, 0x88, 0x0b, 0xe7, 0xc6, 0xbf, 0xbe, 0x5e, 0xdd,
-	0x77, 0x1e, 0x5a, 0x58, 0xe7, 0xe5, 0xff, 0x2f, 0x93, 0x37, 0xf1, 0x65, 0x4e, 0x95, 0x61, 0x28,
-	0x0a, 0xd3, 0xe1, 0xae, 0x7e, 0xa3, 0xf9, 0xae, 0x7e, 0xb9, 0x4b, 0xf5, 0x22, 0x57, 0x77, 0xee,
-	0x27, 0x0a, 0x64, 0x17, 0x6d, 0x6b, 0x8b, 0x3a, 0x5e, 0x1b, 0xb5, 0xbf, 0x63, 0x56, 0x21, 0x29,
-	0x74, 0x0a, 0x3f, 0x5b, 0xbe, 0xdc, 0xfd, 0x77, 0xc6, 0x09, 0x21, 0xb4, 0x54, 0xc4, 0x09, 0x81,
-	0x52, 0xe2, 0xdf, 0x4e, 0x73, 0xbf, 0x8d, 0x1f, 0xc6, 0x98, 0x3f, 0x9f, 0x5f, 0x06, 0x08, 0x83,
-	0x11, 0x34, 0x0a, 0xc3, 0xab, 0x6f, 0xdf, 0x5b, 0xc2, 0xfa, 0x9d, 0x9b, 0xd7, 0x6f, 0xbe, 0x7d,
-	0xef, 0x66, 0x3a, 0x16, 0x36, 0x69, 0x85, 0xdb, 0xb7, 0x97, 0xf0, 0x3b, 0x69, 0x05, 0x21, 0x18,
-	0x11, 0x4d, 0x4b, 0x7f, 0x7e, 0x7b, 0x09, 0xdf, 0x2c, 0xdc, 0x48, 0xf7, 0x68, 0xff, 0xa8, 0x7c,
-	0xf6, 0x3c, 0xa3, 0x7c, 0xfe, 0x3c, 0xa3, 0xfc, 0xe6, 0x79, 0x26, 0xf6, 0xbb, 0xe7, 0x99, 0xd8,
-	0x17, 0xcf, 0x33, 0xb1, 0xdf, 0x3f, 0xcf, 0xc4, 0xfe, 0xf0, 0x3c, 0xa3, 0x7c, 0xd8, 0xc8, 0x28,
-	0x3f, 0x6d, 0x64, 0x62, 0xbf, 0x68, 0x64, 0x94, 0x5f, 0x36, 0x32, 0xb1, 0x4f, 0x1b, 0x99, 0xd8,
-	0xaf, 0x1a, 0x99, 0xd8, 0x67, 0x8d, 0x8c, 0xf2, 0x79, 0x23, 0xa3, 0xfc, 0xa6, 0x91, 0x89, 0xfd,
-	0xae, 0x91, 0x51, 0xbe, 0x68, 0x64, 0x62, 0xbf, 0x6f, 0x64, 0x94, 0x3f, 0x34, 0x32, 0xb1, 0x0f,
-	0x5f, 0x64, 0x62, 0x3f, 0x7d, 0x91, 0x51, 0x7e, 0xfe, 0x22, 0x13, 0xfb, 0xf8, 0x45, 0x46, 0xf9,
-	0xe4, 0x45, 0x26, 0xf6, 0x8b, 0x17, 0x99, 0xd8, 0x2f, 0x5f, 0x64, 0x94, 0x4f, 0x5f, 0x64, 0x94,
-	0x5f, 0xbd, 0xc8, 0x28, 0xf7, 0x2f, 0x74, 0x7b, 0x93, 0x78, 0x56, 0x6d, 0x6d, 0x6d, 0x80, 0xef,
-	0xc0, 0xcb, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x43, 0xf0, 0x93, 0xb5, 0x3b, 0x00, 0x00,
+	// 4918 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x5b, 0x6c, 0x1b, 0x57,
+	0x7a, 0xe6, 0x90, 0x92, 0x48, 0x1e, 0x49, 0xbc, 0x1c, 0xdd, 0xc6, 0xb2, 0x4d, 0xca, 0x8c, 0x9d,
+	0xc8, 0x5e, 0x8b, 0x8e, 0xe8, 0x24, 0xbb, 0xeb, 0x4d, 0xea, 0xe5, 0x88, 0x52, 0x42, 0xdb, 0x72,
+	0xb4, 0xc7, 0xb7, 0x26, 0xbe, 0xcc, 0x1e, 0x71, 0x8e, 0xe4, 0x89, 0xc8, 0x19, 0xee, 0xcc, 0x50,
+	0xa6, 0x72, 0x01, 0x82, 0x45, 0x8b, 0xdd, 0x2e, 0xda, 0x62, 0x1b, 0xf4, 0x21, 0xe8, 0x43, 0x11,
+	0x14, 0x28, 0xb0, 0x4f, 0xc5, 0xa2, 0x68, 0x81, 0xbc, 0x75, 0x5f, 0x5a, 0xe4, 0xa5, 0x40, 0x1e,
+	0xf6, 0x61, 0xb1, 0x40, 0xd5, 0x35, 0xfd, 0x92, 0xc7, 0x7d, 0x5c, 0xe8, 0x61, 0x51, 0x9c, 0xcb,
+	0x5c, 0x48, 0x8e, 0x24, 0x32, 0x49, 0x17, 0x79, 0xb1, 0x87, 0xe7, 0xfc, 0xff, 0xf7, 0xff, 0xe7,
+	0x3f, 0xb7, 0xff, 0x72, 0x04, 0x0a, 0x75, 0xd3, 0xc2, 0x4f, 0xb0, 0xb1, 0x64, 0x3b, 0xb8, 0xb6,
+	0x73, 0x09, 0x37, 0xf5, 0x4b, 0xc4, 0xd0, 0x54, 0x8d, 0xec, 0xea, 0x35, 0x52, 0x6c, 0x5a, 0xa6,
+	0x63, 0xc2, 0x94, 0xe3, 0x18, 0x45, 0x41, 0x57, 0xdc, 0xbd, 0x3c, 0x5f, 0xde, 0xd6, 0x9d, 0xc7,
+	0xad, 0xcd, 0x62, 0xcd, 0x6c, 0x5c, 0x22, 0xc6, 0xae, 0xb9, 0xd7, 0xb4, 0xcc, 0xf6, 0xde, 0x25,
+	0x46, 0x5c, 0x5b, 0xda, 0x26, 0xc6, 0xd2, 0x2e, 0xae, 0xeb, 0x1a, 0x76, 0xc8, 0xa5, 0xbe, 0x0f,
+	0x0e, 0x39, 0xbf, 0x14, 0x80, 0xd8, 0x36, 0xb7, 0x4d, 0xce, 0xbc, 0xd9, 0xda, 0x62, 0xbf, 0xd8,
+	0x0f, 0xf6, 0x25, 0xc8, 0x73, 0xdb, 0xa6, 0xb9, 0x5d, 0x27, 0x3e, 0x95, 0xd6, 0xb2, 0xb0, 0xa3,
+	0x9b, 0x86, 0xe8, 0x5f, 0xe8, 0xed, 0xdf, 0xd2, 0x49, 0x5d, 0x53, 0x1b, 0xd8, 0xde, 0x11, 0x14,
+	0xa7, 0x7a, 0x29, 0x6c, 0xc7, 0x6a, 0xd5, 0x1c, 0xd1, 0x9b, 0xef, 0xed, 0x75, 0xf4, 0x06, 0xb1,
+	0x1d, 0xdc, 0x68, 0x1e, 0xa6, 0xc0, 0x13, 0x0b, 0x37, 0x9b, 0xc4, 0xb2, 0x45, 0xff, 0x73, 0xfd,
+	0x66, 0xd4, 0x35, 0x62, 0x38, 0xfa, 0x96, 0xee, 0x13, 0x9d, 0xea, 0x27, 0x7a, 0xc7, 0xd4, 0x8d,
+	0xc3, 0x7b, 0x77, 0xc8, 0x9e, 0xcb, 0x9b, 0xef, 0xef, 0x75, 0x67, 0x44, 0x98, 0xa0, 0x9f, 0xa0,
+	0x41, 0x6c, 0x1b, 0x6f, 0x93, 0x23, 0x20, 0x9a, 0x7a, 0xcd, 0x69, 0x59, 0xe4, 0x28, 0x08, 0x07,
+	0x6b, 0xd8, 0xc1, 0x9c, 0xa2, 0xf0, 0xc7, 0x18, 0x88, 0xdf, 0x22, 0xb6, 0xad, 0x9b, 0x06, 0xbc,
+	0x07, 0x12, 0x1a, 0xd9, 0x55, 0xb1, 0xa6, 0x59, 0x72, 0x74, 0x41, 0x5a, 0x9c, 0x50, 0x5e, 0xfd,
+	0x6c, 0x3f, 0x1f, 0xf9, 0xed, 0x7e, 0xfe, 0xa5, 0x6d, 0xb3, 0xe8, 0x3c, 0x26, 0xce, 0x63, 0xdd,
+	0xd8, 0xb6, 0x8b, 0x06, 0x71, 0x9e, 0x98, 0xd6, 0xce, 0xa5, 0x6e, 0xf0, 0xe6, 0xce, 0xf6, 0x25,
+	0x67, 0xaf, 0x49, 0xec, 0x62, 0x85, 0xec, 0x96, 0x35, 0xcd, 0x42, 0x71, 0x8d, 0x7f, 0xc0, 0x32,
+	0x18, 0xa1, 0x03, 0x97, 0x63, 0x0b, 0xd2, 0xe2, 0x78, 0xe9, 0x64, 0xb1, 0x7b, 0xf5, 0x15, 0x85,
+	0xfc, 0xeb, 0x64, 0xcf, 0x56, 0x32, 0x07, 0xca, 0xe8, 0xcf, 0xa4, 0x68, 0x46, 0xa2, 0x92, 0x3f,
+	0xdf, 0xcf, 0x4b, 0x88, 0xb1, 0xc2, 0x33, 0x60, 0xb2, 0x8e, 0x6d, 0x47, 0xdd, 0x52, 0x6b, 0x86,
+	0xa3, 0xb6, 0x9a, 0xf2, 0xc8, 0x82, 0xb4, 0x38, 0x89, 0x00, 0x6d, 0x5c, 0x5b, 0x31, 0x9c, 0x3b,
+	0x4d, 0xb8, 0x08, 0xb2, 0x8c, 0xc4, 0x10, 0x44, 0x9a, 0xf9, 0xc4, 0x90, 0x47, 0x19, 0x19, 0xe3,
+	0xbd, 0x49, 0xe9, 0x2a, 0xe6, 0x13, 0xc3, 0xa3, 0xc4, 0x41, 0xca, 0x31, 0x9f, 0xb2, 0xec, 0x51,
+	0x16, 0xc1, 0x34, 0xa3, 0xac, 0x99, 0xc6, 0x56, 0x90, 0x38, 0xce, 0x88, 0x33, 0xb4, 0x6f, 0xc5,
+	0x34, 0xb6, 0x3c, 0xfa, 0x15, 0x00, 0x6c, 0x07, 0x5b, 0x0e, 0xd1, 0x54, 0xec, 0xc8, 0x09, 0x36,
+	0xde, 0xf9, 0x22, 0x5f, 0x6a, 0x45, 0x77, 0xa9, 0x15, 0x6f, 0xbb, 0x6b, 0x51, 0x49, 0xd0, 0x61,
+	0xfe, 0xfc, 0x7f, 0xf3, 0x12, 0x4a, 0x0a, 0xbe, 0xb2, 0x03, 0x09, 0x38, 0xf5, 0xa3, 0x16, 0x69,
+	0x51, 0x8c, 0x66, 0xb3, 0xae, 0xd7, 0xd8, 0xbe, 0x60, 0x72, 0xeb, 0xba, 0xb1, 0x63, 0xcb, 0xc9,
+	0x85, 0xd8, 0xe2, 0x78, 0xe9, 0xb9, 0x5e, 0x33, 0x96, 0x7d, 0xe2, 0x8a, 0xa0, 0x45, 0xf3, 0x1c,
+	0x28, 0xa4, 0xcb, 0xbe, 0x36, 0x92, 0x90, 0x32, 0xd1, 0xc2, 0xbf, 0x64, 0xc0, 0xe4, 0x7a, 0x79,
+	0x65, 0x03, 0x5b, 0xb8, 0x41, 0x1c, 0x62, 0xd9, 0xf0, 0x79, 0x90, 0x68, 0xe0, 0xb6, 0x4a, 0x74,
+	0xab, 0x29, 0x4b, 0x0b, 0xd2, 0x62, 0x54, 0x19, 0xef, 0xec, 0xe7, 0xe3, 0xeb, 0xb8, 0xbd, 0x5a,
+	0x45, 0x1b, 0x28, 0xde, 0xc0, 0xed, 0x55, 0xdd, 0x6a, 0xc2, 0x77, 0xc0, 0x14, 0xd6, 0x2c, 0x95,
+	0x2e, 0x26, 0xd5, 0xc2, 0x0e, 0x51, 0x75, 0x43, 0x23, 0x6d, 0x36, 0x31, 0xa9, 0xd2, 0xe9, 0x5e,
+	0xed, 0x2a, 0xd8, 0xc1, 0x08, 0x3b, 0xa4, 0x4a, 0x89, 0x94, 0x53, 0x07, 0xca, 0xe8, 0x8f, 0xe9,
+	0x34, 0x77, 0xf6, 0xf3, 0x99, 0x72, 0x05, 0x75, 0xf5, 0xa2, 0x0c, 0xd6, 0xac, 0xae, 0x16, 0xf8,
+	0x3a, 0x80, 0x54, 0x96, 0xd3, 0x56, 0x9b, 0xe6, 0x13, 0x62, 0x09, 0x51, 0x6c, 0x72, 0x95, 0xf9,
+	0x03, 0x65, 0xe4, 0x42, 0x54, 0x4e, 0x77, 0xf6, 0xf3, 0xe9, 0x72, 0x05, 0xdd, 0x6e, 0x6f, 0x50,
+	0x12, 0x8e, 0x94, 0xc6, 0x9a, 0x15, 0x6c, 0x80, 0xdf, 0x06, 0x13, 0x14, 0xc8, 0xd8, 0x54, 0x1d,
+	0x0b, 0x1b, 0x36, 0x9f, 0x75, 0x65, 0xc6, 0x87, 0x00, 0xe5, 0x0a, 0xba, 0xb9, 0x79, 0x9b, 0x76,
+	0x22, 0x80, 0x35, 0x4b, 0x7c, 0xc3, 0x97, 0xc1, 0x24, 0x65, 0xc4, 0xb5, 0x1d, 0xb5, 0xae, 0x37,
+	0x74, 0x87, 0x2f, 0x01, 0x25, 0xdb, 0xd9, 0xcf, 0x8f, 0x97, 0x2b, 0xa8, 0x5c, 0xdb, 0xb9, 0xc1,
+	0x9a, 0x25, 0x34, 0x8e, 0x35, 0xcb, 0xfd, 0x19, 0x64, 0xd3, 0x48, 0x1d, 0xef, 0xc9, 0x89, 0x5e,
+	0xb6, 0x0a, 0x6b, 0xf6, 0xd8, 0xd8, 0x4f, 0xf8, 0x67, 0x20, 0x69, 0xb5, 0x97, 0x05, 0x4b, 0x92,
+	0x59, 0x74, 0xae, 0xd7, 0xa2, 0xa8, 0xcd, 0x68, 0x95, 0x84, 0x6b, 0x4b, 0x94, 0xb0, 0xda, 0xcb,
+	0x9c, 0xff, 0x3b, 0x60, 0x9a, 0xf1, 0x7b, 0x73, 0x63, 0x6e, 0x6d, 0xd9, 0xc4, 0x91, 0x01, 0x93,
+	0x1e, 0xe7, 0xc3, 0x8d, 0xa3, 0x2c, 0x65, 0x10, 0x86, 0x7e, 0x93, 0x51, 0xc0, 0xbb, 0x60, 0xca,
+	0x6a, 0x97, 0xfa, 0x66, 0x75, 0x7c, 0x90, 0x59, 0xf5, 0x35, 0xc9, 0x58, 0xed, 0x52, 0xf7, 0x0c,
+	0x16, 0xc1, 0x24, 0xc5, 0xdd, 0xb2, 0xc8, 0x8f, 0x5a, 0xc4, 0xa8, 0xed, 0xc9, 0x13, 0x0b, 0xd2,
+	0xe2, 0x88, 0x92, 0x3c, 0x50, 0xc6, 0x4a, 0x23, 0x8b, 0x9f, 0xfc, 0xcd, 0x18, 0x9a, 0xb0, 0xda,
+	0xa5, 0x35, 0xb7, 0x1b, 0xde, 0x02, 0x29, 0xba, 0x0a, 0xb5, 0x96, 0xb3, 0xa7, 0xd6, 0xf6, 0x6a,
+	0x75, 0x22, 0x4f, 0x32, 0x15, 0xfa, 0x97, 0xfd, 0xf6, 0xb6, 0x45, 0xb6, 0xb1, 0x43, 0xb4, 0x4a,
+	0xcb, 0xd9, 0x5b, 0xa1, 0xa4, 0x01, 0x45, 0x26, 0x1a, 0xb8, 0xed, 0xb5, 0x43, 0x0d, 0xcc, 0x59,
+	0x84, 0x9e, 0xd0, 0x2a, 0xbd, 0x0e, 0xd4, 0x26, 0xb1, 0x74, 0x53, 0xd3, 0x6b, 0xba, 0xb3, 0x27,
+	0xa7, 0x18, 0x7a, 0xa1, 0xcf, 0xc8, 0x8c, 0x9c, 0x6e, 0xd8, 0xd5, 0x76, 0xd3, 0x34, 0x88, 0xe1,
+	0x04, 0xc0, 0x67, 0x2c, 0xaf, 0x77, 0xc3, 0x87, 0x82, 0xdb, 0x40, 0x16, 0x52, 0x6a, 0x66, 0xcb,
+	0x70, 0xba, 0xc4, 0xa4, 0xc3, 0x07, 0xc1, 0xc5, 0xac, 0x50, 0xf2, 0x10, 0x39, 0xb3, 0x96, 0xdf,
+	0x1d, 0x14, 0xf4, 0x3d, 0x30, 0xd5, 0xd4, 0x8d, 0x6d, 0xd5, 0xae, 0x9b, 0x4e, 0xc0, 0xb2, 0x19,
+	0x66, 0xd9, 0xf1, 0x03, 0x25, 0x51, 0x1a, 0x93, 0x23, 0xcc, 0xb6, 0x59, 0x4a, 0x77, 0xab, 0x6e,
+	0x3a, 0xbe, 0x81, 0xef, 0x83, 0x13, 0x3e, 0x73, 0xef, 0x74, 0x67, 0x07, 0x99, 0xee, 0xa8, 0x2c,
+	0xa1, 0x19, 0x17, 0xb8, 0x7b, 0xb6, 0x5f, 0x01, 0x99, 0x4d, 0x82, 0x6b, 0xa6, 0x11, 0x50, 0x0b,
+	0xf6, 0xab, 0x95, 0xe6, 0x44, 0xbe, 0x52, 0xd7, 0x41, 0xa2, 0xf6, 0x18, 0x1b, 0x06, 0xa9, 0xdb,
+	0xf2, 0x14, 0x3b, 0xe6, 0xce, 0xf5, 0xea, 0xd0, 0x75, 0x58, 0x15, 0x57, 0x38, 0x35, 0x33, 0xd6,
+	0x47, 0x52, 0x34, 0x21, 0x21, 0x0f, 0x00, 0xae, 0x81, 0x6c, 0xab, 0x49, 0xcf, 0x3a, 0x55, 0x7b,
+	0x42, 0xea, 0x75, 0x36, 0xe7, 0xf2, 0xf4, 0x21, 0x67, 0xb2, 0x62, 0x9a, 0xf5, 0xbb, 0xb8, 0xde,
+	0x22, 0x28, 0xcd, 0x99, 0x2a, 0x94, 0x87, 0x4e, 0x2d, 0xbc, 0x06, 0xa6, 0xdc, 0xc3, 0x37, 0x88,
+	0x34, 0x73, 0x2c, 0x52, 0xd6, 0x65, 0xf3, 0xb1, 0x76, 0xc1, 0x6c, 0xd7, 0x31, 0xa2, 0x12, 0x31,
+	0xdd, 0xf2, 0x2c, 0x83, 0x5b, 0xec, 0x5b, 0xde, 0xfe, 0xd9, 0xe2, 0xae, 0x0c, 0x06, 0xae, 0xcc,
+	0x75, 0xf6, 0xf3, 0x53, 0x21, 0xbd, 0x68, 0x2a, 0x70, 0xfe, 0xb8, 0x8d, 0x41, 0xb9, 0xec, 0x50,
+	0xf1, 0xe5, 0xce, 0x1d, 0x25, 0x97, 0x9d, 0x26, 0x87, 0xca, 0xed, 0xea, 0x75, 0xe5, 0x76, 0x35,
+	0xc2, 0x6d, 0x90, 0x3f, 0x74, 0x95, 0xa9, 0xbb, 0x14, 0x50, 0x96, 0x99, 0x02, 0x85, 0x23, 0xd7,
+	0x1a, 0xb7, 0xe7, 0x7c, 0xe8, 0x62, 0x63, 0x7d, 0xf3, 0xbf, 0x8e, 0x82, 0xb8, 0x58, 0x0c, 0xf0,
+	0x25, 0x90, 0x11, 0x13, 0xef, 0xaf, 0x3e, 0xa9, 0xf7, 0xb8, 0x11, 0xd3, 0xec, 0xaf, 0xbd, 0xef,
+	0x00, 0xe8, 0x4d, 0xb3, 0xcf, 0x17, 0xed, 0xe5, 0xf3, 0x26, 0xd5, 0xe7, 0xbc, 0x0b, 0xa6, 0x1a,
+	0xba, 0xd1, 0xb7, 0x89, 0x62, 0x43, 0x9e, 0x99, 0x0d, 0xdd, 0xe8, 0xde, 0x45, 0x14, 0x17, 0xb7,
+	0xfb, 0x70, 0x47, 0x86, 0xc5, 0xc5, 0xed, 0x6e, 0xdc, 0xe7, 0xc0, 0x24, 0x31, 0xf0, 0x66, 0x9d,
+	0xa8, 0xdc, 0x06, 0xec, 0x22, 0x4d, 0xa0, 0x09, 0xde, 0x78, 0x87, 0xb5, 0x5d, 0x19, 0xf9, 0xf4,
+	0x93, 0x7c, 0x84, 0xff, 0x7b, 0x6d, 0x24, 0x11, 0xcd, 0xc4, 0xae, 0x8d, 0x24, 0x62, 0x99, 0x91,
+	0x42, 0x03, 0xa4, 0x56, 0x0d, 0xad, 0xc2, 0xc2, 0x09, 0xc5, 0xc2, 0x86, 0x06, 0x67, 0x41, 0x54,
+	0xd7, 0x98, 0x81, 0x93, 0xca, 0x58, 0x67, 0x3f, 0x1f, 0xad, 0x56, 0x50, 0x54, 0xd7, 0x20, 0x04,
+	0x23, 0x06, 0x6e, 0x10, 0x66, 0xc2, 0x24, 0x62, 0xdf, 0xf0, 0x04, 0x88, 0xb5, 0xac, 0x3a, 0x33,
+	0x4d, 0x52, 0x89, 0x77, 0xf6, 0xf3, 0xb1, 0x3b, 0xe8, 0x06, 0xa2, 0x6d, 0x70, 0x1a, 0x8c, 0xd6,
+	0xcd, 0x6d, 0xd3, 0x96, 0x47, 0x16, 0x62, 0x8b, 0x49, 0xc4, 0x7f, 0x14, 0xfe, 0x55, 0x0a, 0xc8,
+	0x5b, 0x37, 0x35, 0x52, 0x87, 0xeb, 0x20, 0xb1, 0x49, 0x05, 0xab, 0x9e, 0xd4, 0xd2, 0x81, 0x72,
+	0xd6, 0x2a, 0xc8, 0x67, 0x4b, 0xb9, 0x47, 0xf7, 0xf1, 0xd2, 0xbb, 0x2f, 0x2e, 0x7d, 0xf7, 0xe1,
+	0xe2, 0xd5, 0x2b, 0xf7, 0x97, 0x1e, 0x5e, 0x75, 0x7f, 0x9e, 0x7f, 0xaf, 0x74, 0xf1, 0x83, 0xb3,
+	0xd4, 0x8f, 0x61, 0x3a, 0x57, 0x2b, 0x28, 0xce, 0x30, 0xaa, 0x1a, 0x7c, 0x8d, 0xa9, 0xcf, 0x94,
+	0x54, 0x96, 0x06, 0x07, 0xea, 0x1d, 0x65, 0xcc, 0x1f, 0x65, 0xe1, 0xef, 0xa2, 0xe0, 0xa4, 0xa7,
+	0xf4, 0x5d, 0x62, 0x51, 0xf7, 0xb6, 0xea, 0x47, 0x0f, 0x5f, 0xf7, 0x08, 0xd6, 0x41, 0xa2, 0x41,
+	0x2d, 0xa3, 0x7a, 0xe3, 0x18, 0x06, 0x8e, 0x19, 0x95, 0xc2, 0x31, 0x8c, 0xaa, 0x06, 0xcf, 0x83,
+	0xcc, 0x63, 0x6c, 0x69, 0x4f, 0xb0, 0x45, 0xd4, 0x5d, 0xae, 0xbc, 0x18, 0x5d, 0xda, 0x6d, 0x17,
+	0x63, 0xa2, 0xa4, 0x5b, 0xba, 0xd5, 0xe8, 0x22, 0x1d, 0xe1, 0xa4, 0x6e, 0xbb, 0x20, 0x2d, 0xfc,
+	0x7a, 0x0c, 0x64, 0x7a, 0x6d, 0x02, 0xdf, 0x04, 0x31, 0x5d, 0xb3, 0x99, 0x0d, 0xc6, 0x4b, 0xdf,
+	0xea, 0x5d, 0xd1, 0x47, 0x98, 0x30, 0x24, 0x50, 0xa0, 0x48, 0x50, 0x05, 0x69, 0x01, 0xe0, 0xe9,
+	0x13, 0x65, 0xdb, 0x65, 0x3e, 0xe4, 0x1e, 0x11, 0xb0, 0xca, 0xbc, 0xbb, 0x57, 0x3a, 0xfb, 0xf9,
+	0xd4, 0x0d, 0x13, 0xe1, 0x7b, 0xe5, 0x9b, 0xa2, 0x0f, 0xa5, 0x04, 0x8b, 0xab, 0xb1, 0x0e, 0xa6,
+	0x5c, 0x01, 0xcd, 0xc7, 0x7b, 0x5d, 0xf6, 0x09, 0x11, 0xb2, 0xf1, 0xc6, 0x5b, 0xae, 0x90, 0xd3,
+	0x01, 0x21, 0x59, 0x21, 0xc4, 0xef, 0x46, 0x59, 0xc1, 0xb5, 0xf1, 0x78, 0xcf, 0x15, 0xb5, 0x06,
+	0xb2, 0xde, 0x39, 0xa4, 0x36, 0xeb, 0xd8, 0xa0, 0xf3, 0xcb, 0xac, 0xcb, 0x7c, 0x5e, 0x2b, 0x2a,
+	0x7f, 0x9f, 0xfa, 0xbc, 0xde, 0x39, 0xb4, 0x51, 0xc7, 0x46, 0xb5, 0x82, 0xd2, 0x5b, 0x5d, 0x0d,
+	0x74, 0x7f, 0x8e, 0x35, 0x1f, 0x9b, 0x8e, 0x69, 0xcb, 0xa3, 0x6c, 0x67, 0x89, 0x5f, 0x70, 0x11,
+	0x64, 0xec, 0x56, 0xb3, 0x69, 0x5a, 0x8e, 0xad, 0xd6, 0xea, 0xd8, 0xb6, 0xd5, 0x4d, 0xe6, 0x0f,
+	0x27, 0x50, 0xca, 0x6d, 0x5f, 0xa1, 0xcd, 0x4a, 0x08, 0x65, 0x4d, 0x8e, 0x87, 0x50, 0xae, 0x40,
+	0x02, 0xa6, 0x35, 0xb2, 0x85, 0x5b, 0x75, 0x47, 0x6d, 0xe0, 0x9a, 0x6a, 0x13, 0xc7, 0xa1, 0x31,
+	0xa3, 0x9c, 0x08, 0x0f, 0xfd, 0xd6, 0xcb, 0x2b, 0xb7, 0x04, 0x89, 0x32, 0xdb, 0xd9, 0xcf, 0xc3,
+	0x0a, 0x67, 0x0e, 0xb4, 0x23, 0x28, 0x00, 0xd7, 0x71, 0xcd, 0x6d, 0xa3, 0x27, 0x18, 0x3d, 0x71,
+	0xfd, 0x63, 0x9a, 0xfa, 0xc8, 0x23, 0x68, 0xa2, 0xa1, 0x07, 0x9c, 0x09, 0x4a, 0x84, 0xdb, 0x01,
+	0x22, 0x20, 0x88, 0x70, 0xbb, 0x8b, 0xc8, 0x1b, 0x1a, 0x75, 0xb2, 0x98, 0xa7, 0x9b, 0x40, 0x13,
+	0x6e, 0xe3, 0x35, 0x53, 0x37, 0xe0, 0x45, 0x00, 0x2d, 0x62, 0x13, 0x41, 0xa2, 0x1a, 0xa6, 0x51,
+	0x23, 0x36, 0xf3, 0x60, 0x13, 0x28, 0xc3, 0x7b, 0x28, 0xdd, 0x4d, 0xd6, 0x0e, 0x09, 0x70, 0x55,
+	0x56, 0xb7, 0x4c, 0xab, 0x81, 0x1d, 0xea, 0xa9, 0xc8, 0x93, 0xe1, 0xf7, 0xec, 0x3a, 0x0f, 0xe9,
+	0x37, 0xf0, 0x5e, 0xdd, 0xc4, 0xda, 0x9a, 0x47, 0xaf, 0x4c, 0x04, 0x17, 0x38, 0xca, 0x0a, 0x44,
+	0x9f, 0x80, 0x1f, 0xcd, 0x85, 0xbf, 0x9f, 0x05, 0xe3, 0x01, 0x6b, 0xc1, 0xd7, 0x41, 0x5a, 0xcc,
+	0x25, 0xf3, 0x52, 0xcc, 0x96, 0x23, 0x76, 0xd7, 0x89, 0x3e, 0x47, 0xa5, 0x22, 0x52, 0x2e, 0xca,
+	0xc8, 0xc7, 0x34, 0x02, 0x9d, 0x64, 0x7c, 0xca, 0x6d, 0xce, 0x05, 0xef, 0x81, 0x19, 0xff, 0xe6,
+	0x0e, 0xba, 0xb0, 0x51, 0x06, 0xd7, 0xe7, 0xc2, 0x6e, 0x88, 0xbb, 0x99, 0x3b, 0xa8, 0xfc, 0xc2,
+	0x9e, 0x6a, 0x76, 0x35, 0x72, 0xaf, 0xf5, 0xc1, 0x51, 0x8e, 0x67, 0x6c, 0x60, 0x67, 0xe0, 0x10,
+	0xcf, 0xf3, 0x5e, 0xb8, 0x4f, 0x3c, 0xc2, 0x70, 0x4f, 0xf5, 0xd9, 0xe0, 0x4e, 0xd5, 0x70, 0x5e,
+	0x79, 0x89, 0x7b, 0x36, 0xc1, 0x4b, 0xbe, 0xdf, 0x5f, 0x46, 0x21, 0x2e, 0xed, 0x89, 0xe1, 0x50,
+	0xfb, 0xdc, 0x5d, 0x6f, 0xb2, 0x6a, 0xde, 0x64, 0x8d, 0x0e, 0x33, 0x59, 0x2b, 0xee, 0x64, 0x7d,
+	0x37, 0x18, 0x2f, 0x8e, 0x09, 0xad, 0xc2, 0xe3, 0x45, 0x6e, 0x3d, 0x3f, 0x54, 0xbc, 0x7b, 0x48,
+	0xa8, 0x18, 0x3f, 0x62, 0x6c, 0x97, 0x4b, 0x7c, 0x6c, 0x47, 0x05, 0x92, 0x3f, 0x08, 0x0f, 0x24,
+	0x13, 0x03, 0x4f, 0x70, 0x7f, 0x0c, 0x79, 0xa3, 0x37, 0x86, 0x4c, 0x0e, 0x67, 0xff, 0xee, 0x08,
+	0xf3, 0x55, 0x30, 0xbf, 0x85, 0x6b, 0x8e, 0x69, 0xed, 0xa9, 0x4d, 0xb6, 0x87, 0x3d, 0x60, 0x9d,
+	0xd8, 0x32, 0x58, 0x88, 0x2d, 0x8e, 0x20, 0x59, 0x50, 0x6c, 0x30, 0x82, 0x35, 0xbf, 0x1f, 0xde,
+	0xec, 0x8b, 0x4f, 0xc7, 0x0f, 0x71, 0xa4, 0xfb, 0xe3, 0x53, 0x3e, 0xbe, 0xee, 0xd0, 0xb4, 0x06,
+	0x66, 0xbc, 0x73, 0xe8, 0x72, 0x49, 0xdd, 0xd4, 0x45, 0xae, 0x4b, 0x9e, 0x38, 0x2e, 0xcc, 0x50,
+	0x66, 0xe8, 0x8d, 0x72, 0x4b, 0x30, 0x5f, 0x2e, 0x29, 0x3a, 0xcb, 0x88, 0xa1, 0xac, 0xdd, 0xdb,
+	0x04, 0xaf, 0x82, 0x78, 0xcb, 0x26, 0x2a, 0xd6, 0x2c, 0x79, 0xf2, 0x58, 0x58, 0xd0, 0xd9, 0xcf,
+	0x8f, 0xdd, 0xb1, 0x49, 0xb9, 0x82, 0xd0, 0x58, 0xcb, 0x26, 0x65, 0xcd, 0x82, 0x55, 0x40, 0x73,
+	0x22, 0x6a, 0x03, 0x5b, 0xdb, 0xba, 0x21, 0xa7, 0xc4, 0xa1, 0xde, 0x8b, 0xb1, 0x56, 0x37, 0xb1,
+	0x88, 0x16, 0x26, 0x3b, 0xfb, 0xf9, 0x64, 0xb9, 0x82, 0xd6, 0x19, 0x07, 0x4a, 0x62, 0xcd, 0xe2,
+	0x9f, 0xf0, 0x55, 0x30, 0x21, 0xce, 0x54, 0x3e, 0xce, 0xf4, 0xb1, 0xe1, 0x14, 0xe0, 0xf4, 0x6c,
+	0x24, 0xf7, 0xc0, 0x9c, 0xed, 0x60, 0xa7, 0x65, 0xf7, 0x47, 0xf2, 0x99, 0xc1, 0x76, 0xd0, 0x0c,
+	0xe7, 0xef, 0x0d, 0xde, 0xef, 0x02, 0x59, 0x00, 0xf7, 0x07, 0xef, 0xd9, 0xe3, 0xb7, 0x04, 0x9a,
+	0xe5, 0xdc, 0x7d, 0xb1, 0xfa, 0x1b, 0x20, 0xab, 0x11, 0x5b, 0xb7, 0x88, 0xa6, 0xfa, 0x3b, 0x15,
+	0x0e, 0xb0, 0x53, 0xd3, 0x82, 0x0d, 0xb9, 0x1b, 0xf6, 0x01, 0x38, 0xd5, 0x85, 0xd4, 0xbb, 0x71,
+	0xa7, 0x06, 0xd0, 0x52, 0x0e, 0x80, 0x76, 0x6f, 0xdb, 0x1f, 0x82, 0x93, 0x3e, 0x7a, 0xff, 0xf6,
+	0x9d, 0x1e, 0x78, 0xfb, 0xce, 0x79, 0x22, 0x7a, 0x76, 0xf1, 0x7d, 0x30, 0x13, 0x94, 0xe0, 0xef,
+	0xe6, 0x99, 0xe1, 0x76, 0xf3, 0x94, 0x2f, 0xc0, 0xdf, 0xd4, 0x0f, 0xc1, 0xac, 0x0b, 0xde, 0xb3,
+	0x3d, 0x67, 0x87, 0xdc, 0x9e, 0x2e, 0xfc, 0x7a, 0x70, 0x97, 0xfe, 0xb5, 0x04, 0x72, 0x2e, 0xfe,
+	0x21, 0x71, 0xfc, 0xdc, 0x90, 0x71, 0x7c, 0xae, 0xb3, 0x9f, 0x9f, 0xaf, 0x70, 0xcc, 0x10, 0x22,
+	0x34, 0x2f, 0xe4, 0x95, 0x43, 0xa2, 0xfa, 0x30, 0x75, 0x7a, 0xc2, 0x7b, 0x79, 0xc8, 0xf0, 0xbe,
+	0x5f, 0x9d, 0x2e, 0xa2, 0x1e, 0x75, 0xba, 0xfa, 0xe0, 0x0e, 0x38, 0xe3, 0x6a, 0x73, 0xf8, 0x0d,
+	0x7f, 0x72, 0xe0, 0x15, 0xe4, 0x2e, 0xf3, 0x8d, 0xd0, 0x8b, 0x7e, 0x0b, 0x9c, 0xec, 0x17, 0xe6,
+	0x2f, 0xa6, 0x53, 0xc3, 0x2d, 0x26, 0xb9, 0x47, 0x96, 0xbf, 0xa2, 0x30, 0x70, 0xfb, 0xd4, 0xbe,
+	0xfb, 0xff, 0xf4, 0x70, 0x42, 0xdc, 0xa5, 0xa9, 0xf4, 0xb8, 0x01, 0x2f, 0x82, 0x69, 0xd1, 0xa3,
+	0x8a, 0xe4, 0x15, 0x2b, 0x74, 0xc9, 0xb9, 0x85, 0xd8, 0x62, 0x02, 0x41, 0xd1, 0x27, 0xb2, 0x1b,
+	0xeb, 0xd8, 0xde, 0x81, 0xf7, 0xfd, 0x65, 0x4e, 0xda, 0x8e, 0x85, 0x55, 0x2f, 0x6b, 0x96, 0x1f,
+	0x22, 0x6b, 0x86, 0x5c, 0xb1, 0xab, 0x14, 0x43, 0x34, 0xda, 0xc1, 0x23, 0x20, 0x2c, 0xad, 0xb1,
+	0x30, 0xf4, 0x11, 0xb0, 0xde, 0x9b, 0xd8, 0x08, 0x4a, 0x08, 0x49, 0x70, 0x9c, 0x19, 0x5e, 0x42,
+	0x4f, 0x8a, 0xa3, 0xf0, 0x3f, 0xe3, 0x20, 0x41, 0xdd, 0x62, 0x07, 0x3b, 0x04, 0xbe, 0x0d, 0x60,
+	0xad, 0x65, 0x59, 0x84, 0x1e, 0xe7, 0x9e, 0x11, 0x84, 0x5b, 0x7c, 0xfa, 0x48, 0x4b, 0xf5, 0x7a,
+	0xe1, 0x02, 0xc6, 0x27, 0xa0, 0xd8, 0xde, 0x32, 0xf4, 0xb1, 0xa3, 0x5f, 0x02, 0xdb, 0x5d, 0x81,
+	0x3e, 0xb6, 0x02, 0x26, 0x78, 0xd9, 0x96, 0x07, 0x5d, 0x22, 0xc8, 0x9c, 0xe9, 0x45, 0xe5, 0x41,
+	0x9a, 0x9f, 0xf0, 0x19, 0xe7, 0x4c, 0xac, 0x39, 0x2c, 0x20, 0x1e, 0xf9, 0x5a, 0x03, 0xe2, 0x87,
+	0x60, 0xde, 0x2b, 0x91, 0xe9, 0x56, 0x83, 0x68, 0x5e, 0xa5, 0x4a, 0xc5, 0xae, 0x3b, 0x7b, 0x54,
+	0x09, 0x6c, 0x84, 0x95, 0xbf, 0xe6, 0xdc, 0x52, 0x1a, 0x83, 0x70, 0x8b, 0x54, 0x65, 0x5a, 0x40,
+	0x91, 0x19, 0x3c, 0xad, 0x4c, 0x8a, 0x8b, 0xd9, 0xab, 0x01, 0xf2, 0x92, 0xdd, 0x14, 0xed, 0xaf,
+	0x90, 0xdd, 0x5b, 0xac, 0x57, 0x14, 0x03, 0x0f, 0x8d, 0x5e, 0xe2, 0x5f, 0x31, 0x7a, 0x21, 0xe0,
+	0x54, 0x93, 0x18, 0x1a, 0xc5, 0x0e, 0xab, 0xce, 0xc9, 0x89, 0x70, 0xfc, 0xd0, 0xe2, 0x9c, 0x00,
+	0x0a, 0xe9, 0x83, 0xab, 0x20, 0x23, 0x6a, 0x80, 0x16, 0xb1, 0x9b, 0xa6, 0x61, 0x13, 0xb7, 0xee,
+	0x17, 0x36, 0x6f, 0x2b, 0x66, 0xa3, 0x81, 0x0d, 0x0d, 0xa5, 0x39, 0x0f, 0x72, 0x59, 0x28, 0x8c,
+	0xab, 0x2d, 0x3b, 0x6d, 0x6c, 0x87, 0x7b, 0xb6, 0xc7, 0xc0, 0x08, 0x1e, 0x24, 0x58, 0xe0, 0x0f,
+	0x00, 0x14, 0xda, 0xb0, 0xf8, 0x17, 0xd7, 0x6a, 0xa4, 0xe9, 0xc8, 0xe3, 0xe1, 0x43, 0x75, 0xb7,
+	0x5d, 0x91, 0x86, 0xc4, 0x65, 0x46, 0x8a, 0xc4, 0x60, 0xfc, 0x16, 0xb8, 0x0e, 0xa6, 0x5d, 0xcd,
+	0x18, 0xa6, 0x50, 0x4f, 0x9e, 0x08, 0x4f, 0x14, 0x50, 0x4e, 0xa1, 0x0e, 0x82, 0x82, 0x31, 0xd0,
+	0x46, 0x8f, 0x50, 0xab, 0xad, 0x3e, 0xd1, 0x0d, 0xcd, 0x7c, 0x62, 0xab, 0x78, 0x17, 0xeb, 0x75,
+	0x9a, 0xcb, 0x64, 0x6e, 0x6e, 0x02, 0x41, 0xab, 0x7d, 0x8f, 0x77, 0x95, 0xdd, 0x1e, 0x58, 0x01,
+	0x29, 0x8b, 0xd4, 0x08, 0x5b, 0x49, 0xbc, 0xae, 0x9a, 0x5a, 0x88, 0x85, 0x6d, 0x5a, 0x9e, 0x0f,
+	0x15, 0x71, 0x3a, 0x9a, 0xe4, 0x4c, 0xbc, 0xd1, 0x86, 0xd7, 0x40, 0x46, 0xa0, 0xf8, 0xf5, 0xd9,
+	0x34, 0xc3, 0xc9, 0xf7, 0x1d, 0x5f, 0x82, 0xc0, 0x45, 0x4a, 0x73, 0x46, 0xb7, 0xd9, 0x86, 0x75,
+	0x50, 0xe0, 0x05, 0x6c, 0x5e, 0x5f, 0x57, 0x75, 0x43, 0x77, 0x74, 0xec, 0xf4, 0xec, 0xa8, 0xcc,
+	0x80, 0x3b, 0x2a, 0xc7, 0x6a, 0xde, 0x1c, 0xaa, 0xea, 0x22, 0xf9, 0x1b, 0x6b, 0xfe, 0xdf, 0x25,
+	0x00, 0x02, 0xf3, 0xf1, 0x1c, 0x88, 0x37, 0x79, 0x0e, 0x82, 0x1d, 0x8c, 0x13, 0xec, 0xde, 0x7a,
+	0x77, 0x24, 0x93, 0x95, 0xcf, 0x20, 0xb7, 0x07, 0xae, 0x80, 0xb8, 0x3b, 0x4f, 0xd1, 0x63, 0xe7,
+	0xa9, 0xe7, 0x7c, 0x73, 0x39, 0xe1, 0x6b, 0x83, 0xbf, 0x06, 0xe8, 0x46, 0x60, 0x6c, 0x22, 0xed,
+	0xf1, 0xb9, 0x14, 0xc8, 0xb0, 0x96, 0x5b, 0xce, 0x63, 0x62, 0x38, 0x62, 0x0f, 0xad, 0x98, 0x1a,
+	0x81, 0x4b, 0x60, 0x94, 0x57, 0x17, 0x78, 0x7a, 0x75, 0xee, 0x40, 0x99, 0xb6, 0x60, 0x29, 0xf3,
+	0xe8, 0x7e, 0x79, 0xe9, 0x6d, 0x9a, 0xfe, 0x7c, 0x6f, 0xf9, 0xe2, 0xe5, 0xd2, 0x07, 0x67, 0x11,
+	0xa7, 0x82, 0x57, 0x01, 0x60, 0xef, 0x59, 0xd4, 0x2d, 0xcb, 0x6c, 0xc8, 0xd1, 0x01, 0x4d, 0x9c,
+	0x64, 0x3c, 0x6b, 0x96, 0xd9, 0x80, 0xdf, 0x03, 0x09, 0x0e, 0xe0, 0x98, 0x72, 0x6c, 0x40, 0xf6,
+	0x38, 0xe3, 0xb8, 0x6d, 0x8a, 0x21, 0x7d, 0xbc, 0x00, 0x92, 0xde, 0x90, 0xe0, 0x1b, 0xc1, 0xcc,
+	0xe8, 0xd9, 0x43, 0x33, 0xa3, 0x03, 0xa4, 0x44, 0x57, 0x00, 0xa8, 0x59, 0x04, 0x8b, 0x37, 0x09,
+	0xd1, 0x61, 0xde, 0x24, 0x08, 0xbe, 0xb2, 0x43, 0x41, 0x5a, 0x4d, 0xcd, 0x05, 0x89, 0x0d, 0x03,
+	0x22, 0xf8, 0xca, 0x0e, 0x3c, 0x29, 0x52, 0xe5, 0x3c, 0x87, 0x19, 0xe7, 0x39, 0xcc, 0x92, 0xa8,
+	0x0c, 0x5c, 0x00, 0xe3, 0x1a, 0xb1, 0x6b, 0x96, 0xde, 0xa4, 0x93, 0xc8, 0x2e, 0x8e, 0x24, 0xbb,
+	0xd4, 0xac, 0x98, 0xfc, 0x79, 0x1a, 0x05, 0x3b, 0xe1, 0x13, 0x00, 0xb0, 0xe3, 0x58, 0xfa, 0x66,
+	0xcb, 0x21, 0xb4, 0x86, 0x4f, 0xf7, 0xdb, 0xf9, 0x43, 0x6d, 0x54, 0x2c, 0x7b, 0xb4, 0xab, 0x86,
+	0x63, 0xed, 0x29, 0x17, 0x0f, 0x94, 0xf3, 0xff, 0x20, 0x3d, 0x5f, 0x18, 0x28, 0x45, 0x8e, 0x02,
+	0xa2, 0xe0, 0x03, 0x30, 0x2e, 0x6e, 0x51, 0x95, 0xce, 0x4e, 0x7c, 0xf8, 0xbc, 0x75, 0x8a, 0xbe,
+	0x31, 0x70, 0xdb, 0x2b, 0x36, 0x02, 0xbb, 0x2e, 0x8d, 0x0d, 0xab, 0x00, 0xda, 0xc4, 0x62, 0x17,
+	0x7e, 0xd3, 0x32, 0xb7, 0xf4, 0x3a, 0xa1, 0x19, 0xdf, 0x04, 0xb3, 0xc4, 0x49, 0x3f, 0xe3, 0x9b,
+	0xb9, 0xc5, 0x89, 0x36, 0x38, 0x4d, 0xb5, 0x82, 0x32, 0x76, 0x77, 0x8b, 0x06, 0xff, 0x53, 0x02,
+	0xb3, 0xee, 0x39, 0x42, 0x3b, 0x89, 0xc5, 0xde, 0xf5, 0x10, 0xdb, 0x66, 0x49, 0x93, 0xa4, 0xf2,
+	0xb7, 0xd2, 0x81, 0xf2, 0x33, 0xc9, 0xfa, 0x89, 0x54, 0xfa, 0x0b, 0xe9, 0xd1, 0xe2, 0xd5, 0x2b,
+	0x74, 0xec, 0x78, 0xe9, 0x5d, 0xb1, 0x3d, 0xde, 0x0f, 0x7c, 0xfb, 0x9f, 0x0f, 0x96, 0x1e, 0x5e,
+	0x08, 0x74, 0x9c, 0x7f, 0x50, 0x3c, 0x7f, 0x81, 0xf2, 0x95, 0x97, 0xde, 0x16, 0x26, 0x7b, 0x3f,
+	0xf0, 0xed, 0x7f, 0x32, 0x3e, 0xbf, 0xe3, 0xfc, 0xe2, 0xd5, 0x2b, 0x57, 0xee, 0x8b, 0x5d, 0xf8,
+	0xf2, 0x07, 0xe7, 0xaf, 0x9e, 0x7d, 0xff, 0xd1, 0x59, 0x34, 0x2d, 0xd4, 0xbd, 0xc5, 0xb4, 0x2d,
+	0x73, 0x65, 0xe1, 0xdb, 0x40, 0xee, 0x19, 0xc6, 0x0e, 0xd9, 0x51, 0xeb, 0x78, 0x93, 0xd4, 0xe5,
+	0x4b, 0x6c, 0x20, 0x67, 0xf8, 0x12, 0xf9, 0x30, 0xd3, 0xd9, 0xcf, 0xcf, 0xdc, 0x0c, 0x62, 0x5c,
+	0x5f, 0xbd, 0x7e, 0x83, 0x12, 0xa2, 0x99, 0x2e, 0xe8, 0xeb, 0x64, 0x87, 0x35, 0xc3, 0xff, 0x96,
+	0xc0, 0x7c, 0xf0, 0x0e, 0xef, 0xb1, 0x13, 0xf8, 0x66, 0xda, 0x49, 0x0e, 0xa8, 0xdc, 0x6d, 0xab,
+	0x2d, 0x70, 0x2a, 0x64, 0x38, 0xbe, 0xbd, 0x5e, 0x64, 0x03, 0x3a, 0x17, 0xb0, 0xd7, 0x89, 0x72,
+	0x2f, 0x96, 0x67, 0xb3, 0x13, 0x7d, 0x62, 0x3c, 0xbb, 0x21, 0x30, 0x13, 0x22, 0x47, 0xd7, 0xe4,
+	0x65, 0x26, 0x20, 0xc7, 0x57, 0xaa, 0xc6, 0xea, 0xc4, 0xbd, 0x20, 0xd5, 0x0a, 0x9a, 0xea, 0x43,
+	0xae, 0x6a, 0xf0, 0x3f, 0x24, 0x30, 0xc5, 0xfc, 0x80, 0x9e, 0x49, 0x18, 0xff, 0x66, 0x4e, 0x42,
+	0x96, 0xea, 0xda, 0x6d, 0x7d, 0x07, 0x24, 0xeb, 0x26, 0x1f, 0x15, 0x2d, 0x0d, 0xc4, 0xc2, 0xa2,
+	0x6e, 0xff, 0x48, 0xba, 0xe1, 0x92, 0x7e, 0x99, 0x13, 0xc9, 0x17, 0x04, 0x97, 0x41, 0x5c, 0x3c,
+	0xf9, 0x93, 0x4b, 0xec, 0x30, 0x9a, 0xeb, 0xf7, 0x6c, 0x59, 0x37, 0x72, 0xe9, 0x42, 0xcb, 0x3e,
+	0x93, 0x03, 0x97, 0x7d, 0x52, 0xa1, 0x65, 0x9f, 0x90, 0x28, 0x23, 0xfd, 0xa7, 0x28, 0xbb, 0x65,
+	0xfe, 0x54, 0x65, 0xb7, 0xec, 0xf0, 0x65, 0xb7, 0xbe, 0x1a, 0x15, 0x1c, 0xa4, 0x46, 0x35, 0x35,
+	0x48, 0x8d, 0x6a, 0x7a, 0xe0, 0x1a, 0xd5, 0xcc, 0x21, 0x35, 0xaa, 0x97, 0x41, 0xd2, 0x32, 0x4d,
+	0x47, 0x65, 0x9e, 0x18, 0x4f, 0x8d, 0xc9, 0x7d, 0x69, 0x48, 0xd3, 0x74, 0xa8, 0x1b, 0x86, 0x12,
+	0x96, 0xf8, 0x82, 0x77, 0xc1, 0x98, 0x41, 0x1c, 0x6a, 0x90, 0x39, 0xe6, 0x24, 0x5e, 0xfd, 0xed,
+	0x7e, 0xbe, 0x34, 0xd4, 0xe3, 0xd0, 0x9b, 0xc4, 0xa9, 0x56, 0x3a, 0xfb, 0xf9, 0x51, 0xf6, 0x81,
+	0x46, 0x0d, 0xe2, 0x54, 0x35, 0xf8, 0x26, 0x98, 0xe8, 0x2a, 0x17, 0xca, 0xc7, 0x97, 0x0b, 0xe9,
+	0x63, 0xbd, 0x60, 0xe5, 0x0b, 0x8d, 0x37, 0x02, 0x05, 0xc2, 0x15, 0x90, 0x64, 0x80, 0x0e, 0x76,
+	0x88, 0x7c, 0x22, 0x7c, 0x7c, 0x6e, 0xa0, 0xa2, 0x4c, 0x74, 0xf6, 0xf3, 0x5e, 0xb6, 0x00, 0x25,
+	0x28, 0x0e, 0xfd, 0x82, 0x6f, 0x81, 0xac, 0x1b, 0xa3, 0xf8, 0x60, 0x17, 0x8f, 0x01, 0x9b, 0xa2,
+	0x8b, 0x63, 0x83, 0xb3, 0x79, 0x98, 0x6e, 0x44, 0xb5, 0xee, 0x42, 0x2f, 0x83, 0xb8, 0xcd, 0x1d,
+	0x5d, 0x79, 0x3e, 0x7c, 0xdf, 0x0a, 0x3f, 0x18, 0xb9, 0x74, 0xf0, 0xfb, 0xc0, 0x45, 0x51, 0x5d,
+	0xd6, 0x93, 0x47, 0xb3, 0xa6, 0x04, 0xbd, 0xf8, 0x0d, 0xcf, 0x82, 0x94, 0x17, 0x4b, 0xb3, 0xf5,
+	0xc1, 0xb2, 0x64, 0x93, 0x68, 0x42, 0x44, 0xd0, 0x6c, 0x6d, 0xc0, 0xe7, 0x41, 0xba, 0x65, 0x13,
+	0xcd, 0xa7, 0xb2, 0xe5, 0xd3, 0x0b, 0x31, 0xfa, 0x36, 0x96, 0x36, 0xbb, 0x64, 0xf4, 0x9d, 0x68,
+	0x9a, 0xa1, 0xf9, 0xcb, 0x4d, 0xce, 0xf9, 0x6f, 0x68, 0xbd, 0xb5, 0x06, 0xbf, 0x2d, 0xe8, 0xac,
+	0x77, 0x44, 0x4a, 0xfd, 0x45, 0x39, 0x4f, 0xe9, 0x14, 0x7a, 0x03, 0x4d, 0xdc, 0xc0, 0xb6, 0x83,
+	0xae, 0xb1, 0x74, 0xf9, 0x8b, 0x5c, 0x11, 0xf4, 0x0e, 0xff, 0xd5, 0xcf, 0xb8, 0x2c, 0x2f, 0x84,
+	0x32, 0x2e, 0x77, 0x31, 0x2e, 0xc3, 0x47, 0xe0, 0x64, 0x6f, 0xce, 0x80, 0xc6, 0x5a, 0xfa, 0x2e,
+	0xf7, 0x5e, 0xcf, 0x0c, 0x93, 0x93, 0xf0, 0x12, 0x0b, 0x48, 0x20, 0x94, 0x1d, 0xb8, 0x0a, 0xc6,
+	0xf9, 0x33, 0x54, 0xbe, 0x22, 0x0a, 0x87, 0x1c, 0x42, 0x94, 0x84, 0xaf, 0x09, 0x3f, 0x37, 0x03,
+	0x9a, 0x5e, 0x2b, 0xbc, 0x0f, 0xe0, 0x26, 0xab, 0xe5, 0xee, 0xd1, 0x0c, 0x05, 0x8d, 0x05, 0xf1,
+	0x36, 0x91, 0x9f, 0x3b, 0xbe, 0xa8, 0x92, 0x3e, 0x50, 0x26, 0x00, 0x38, 0x1d, 0x89, 0x7c, 0x78,
+	0x75, 0x29, 0x12, 0x89, 0x44, 0x50, 0x56, 0xe0, 0x6c, 0x78, 0x30, 0xf0, 0x05, 0x90, 0xf6, 0xa2,
+	0x46, 0x51, 0xae, 0x39, 0xbb, 0x20, 0x2d, 0x8e, 0xa2, 0x94, 0xdb, 0x2c, 0xea, 0x30, 0x98, 0x9e,
+	0x1b, 0x94, 0x8b, 0x65, 0x90, 0xdd, 0x58, 0xf8, 0xdc, 0x00, 0xb1, 0xb0, 0x32, 0x4d, 0x9d, 0x51,
+	0xc4, 0x98, 0xcb, 0x15, 0xc4, 0xfb, 0x6c, 0x24, 0x02, 0xe2, 0xb2, 0x66, 0x89, 0x96, 0x90, 0x50,
+	0xfb, 0xf9, 0xaf, 0x29, 0xd4, 0x7e, 0xe1, 0x4b, 0x86, 0xda, 0xc7, 0x3d, 0xb1, 0x5e, 0xfc, 0x5a,
+	0x9e, 0x58, 0xc3, 0x37, 0x00, 0x08, 0xbc, 0x00, 0x38, 0x3f, 0xdc, 0x0b, 0x00, 0x14, 0xe0, 0x85,
+	0x9b, 0x20, 0xd5, 0xb4, 0xcc, 0x5d, 0x9d, 0xee, 0x63, 0xee, 0x6c, 0x5d, 0x60, 0x37, 0xd2, 0xf7,
+	0x0e, 0x94, 0x17, 0xac, 0x73, 0xf2, 0xd9, 0xd2, 0x99, 0xa3, 0x7d, 0x86, 0xf7, 0x1f, 0xd1, 0xb7,
+	0x3e, 0x93, 0x1b, 0x3e, 0x46, 0xb5, 0x82, 0x26, 0x03, 0x90, 0x55, 0x0d, 0x56, 0x40, 0xd6, 0x6b,
+	0xa0, 0xa7, 0x8c, 0x86, 0x1d, 0x2c, 0x7f, 0x4b, 0x1c, 0x31, 0xbd, 0xcb, 0xf1, 0x16, 0xfb, 0x6b,
+	0x0b, 0x94, 0x09, 0x72, 0xd0, 0x14, 0x2c, 0x3c, 0x05, 0x92, 0x8d, 0x56, 0x9d, 0x06, 0xe3, 0xb6,
+	0x23, 0x2f, 0xb1, 0xeb, 0xc7, 0x6f, 0x80, 0xdb, 0xe0, 0x44, 0xad, 0x8e, 0xf5, 0x86, 0x8a, 0xbb,
+	0x62, 0x76, 0xb5, 0x66, 0x6a, 0x44, 0x2e, 0x1e, 0x13, 0x4e, 0xf5, 0xc7, 0xf9, 0x68, 0x8e, 0xa1,
+	0xf5, 0x77, 0xc0, 0x22, 0x98, 0xb2, 0x77, 0xf4, 0xa6, 0x2a, 0x52, 0x17, 0x6a, 0xcd, 0xda, 0x6b,
+	0x3a, 0xa6, 0x7c, 0x99, 0x29, 0x94, 0xa5, 0x5d, 0xc2, 0xe0, 0x2b, 0xac, 0x63, 0xfe, 0x35, 0x90,
+	0xee, 0x09, 0x13, 0x61, 0x06, 0xc4, 0x76, 0x08, 0x7f, 0x39, 0x98, 0x44, 0xf4, 0x93, 0x3e, 0x51,
+	0xe3, 0x59, 0x05, 0xfe, 0xa4, 0x8d, 0xff, 0xb8, 0x12, 0xfd, 0x8e, 0x34, 0x7f, 0x17, 0xa4, 0xba,
+	0x5d, 0xba, 0x10, 0xee, 0x62, 0x90, 0x3b, 0xe4, 0x0a, 0x71, 0x01, 0x02, 0xb8, 0x22, 0x35, 0xf0,
+	0x06, 0x00, 0x9e, 0x11, 0x6c, 0x78, 0x05, 0x8c, 0xfb, 0x7f, 0xd1, 0x43, 0x53, 0x04, 0x31, 0x56,
+	0xef, 0x3c, 0xcc, 0x6a, 0x08, 0x10, 0x8f, 0xb7, 0xa0, 0x81, 0xd9, 0x15, 0x16, 0xd4, 0xfb, 0xdd,
+	0x22, 0x2d, 0x73, 0x0d, 0x00, 0x1f, 0xd5, 0x7b, 0x33, 0x72, 0x18, 0x68, 0x48, 0xb2, 0x21, 0xe9,
+	0x89, 0x29, 0xfc, 0xb3, 0x04, 0x66, 0xef, 0xb0, 0xb0, 0xff, 0xff, 0x53, 0x0c, 0xcd, 0xda, 0xf8,
+	0x7f, 0x16, 0x74, 0x68, 0x66, 0x63, 0x8d, 0x92, 0xd0, 0xaa, 0x89, 0x32, 0x42, 0x41, 0x50, 0x72,
+	0xcb, 0x6d, 0x28, 0xfc, 0x9b, 0x04, 0xa6, 0x5e, 0x27, 0x4e, 0x9f, 0x92, 0x0f, 0x40, 0xca, 0x57,
+	0x52, 0xfd, 0xea, 0x79, 0x98, 0x09, 0xe2, 0xd3, 0xd9, 0x5f, 0x5d, 0xed, 0x2f, 0x24, 0x70, 0x2e,
+	0xa8, 0x76, 0x40, 0xf8, 0x9a, 0x69, 0xad, 0xde, 0xa9, 0xda, 0xee, 0x40, 0x7e, 0x08, 0x12, 0xec,
+	0x7a, 0x26, 0x2d, 0x5d, 0xa4, 0xf5, 0x56, 0xc5, 0x9f, 0xf4, 0x0c, 0xe7, 0xb5, 0xad, 0xde, 0xa9,
+	0xbe, 0xf2, 0x12, 0x7d, 0x2c, 0x48, 0xaf, 0xf5, 0xd5, 0x3b, 0x55, 0x14, 0xa7, 0xb0, 0xab, 0x2d,
+	0x1d, 0x3e, 0x04, 0xf4, 0xcf, 0x7c, 0x98, 0x00, 0xfe, 0x37, 0x43, 0x95, 0xaf, 0x24, 0x60, 0xac,
+	0x42, 0x76, 0x29, 0xfe, 0x98, 0x46, 0x76, 0x57, 0x5b, 0x7a, 0xe1, 0xa3, 0x18, 0x98, 0xb9, 0xa1,
+	0xdb, 0xfe, 0x58, 0xbd, 0xa1, 0x61, 0x90, 0x0e, 0x9e, 0xdd, 0xfe, 0x24, 0x3d, 0x7f, 0xc4, 0xa9,
+	0x7d, 0xf4, 0x34, 0xa5, 0x70, 0x90, 0xf2, 0xab, 0x4f, 0x14, 0xfc, 0x44, 0x02, 0xa3, 0xa6, 0xa5,
+	0x11, 0x4b, 0x3c, 0x78, 0xfd, 0x2b, 0xe9, 0x40, 0xf9, 0x4b, 0xc9, 0xfa, 0xb1, 0x84, 0x22, 0x28,
+	0xe9, 0xad, 0x2e, 0x04, 0x96, 0xfc, 0x6f, 0x6f, 0xbe, 0x50, 0x72, 0xc9, 0xfb, 0x74, 0x4d, 0x8c,
+	0x12, 0x4b, 0xee, 0x17, 0x4b, 0x9a, 0xa1, 0xd1, 0x25, 0xf6, 0x5f, 0x30, 0x39, 0x86, 0x26, 0x96,
+	0x82, 0xbf, 0x02, 0xb9, 0x3f, 0x34, 0xbe, 0x14, 0xf8, 0xc1, 0x15, 0x83, 0x39, 0x30, 0xca, 0xff,
+	0x9e, 0x85, 0xfd, 0x41, 0x15, 0xf3, 0x54, 0x2e, 0xc4, 0xe4, 0x2f, 0xe2, 0x88, 0x37, 0xd3, 0xe7,
+	0xad, 0x4d, 0xea, 0x96, 0xf0, 0x3f, 0xa4, 0x62, 0xdf, 0x85, 0x7f, 0x94, 0xc0, 0xd4, 0xad, 0x90,
+	0x6d, 0xb3, 0x36, 0xdc, 0xde, 0xee, 0xce, 0xee, 0x7e, 0x9d, 0xfb, 0xfa, 0xbf, 0x24, 0x90, 0xf5,
+	0xe4, 0xdc, 0x26, 0x8d, 0x66, 0x9d, 0xfa, 0x5b, 0xdf, 0x14, 0xf5, 0xe0, 0x22, 0x18, 0x6f, 0xe0,
+	0x26, 0xab, 0x4f, 0xd1, 0x2b, 0x22, 0x16, 0x4c, 0x87, 0x6a, 0x08, 0x88, 0xbe, 0xeb, 0x64, 0xaf,
+	0xf0, 0xa9, 0x04, 0xe6, 0xfa, 0x06, 0xc2, 0x5d, 0x04, 0x2f, 0x9b, 0x2a, 0x75, 0xb3, 0x87, 0x66,
+	0x53, 0xa3, 0xc1, 0x6c, 0xea, 0x67, 0x52, 0x77, 0x36, 0xf5, 0x36, 0x48, 0xb3, 0x5c, 0x23, 0x69,
+	0x3b, 0xc4, 0xb0, 0x59, 0xfe, 0x22, 0x46, 0x1f, 0x8a, 0x2a, 0xdf, 0x3a, 0x50, 0x16, 0x3f, 0x92,
+	0xce, 0x65, 0x34, 0x59, 0x2a, 0xe4, 0xad, 0xd3, 0xa5, 0x93, 0x34, 0xf7, 0xf2, 0xa0, 0xe8, 0x7a,
+	0x16, 0xef, 0x2d, 0x5f, 0x5c, 0x7e, 0xe5, 0x83, 0xf3, 0xef, 0x2d, 0x5f, 0xa4, 0x99, 0xf4, 0x14,
+	0xc5, 0x58, 0xf5, 0x20, 0x0a, 0x7f, 0x94, 0x80, 0x7c, 0x88, 0xea, 0x36, 0xfc, 0x00, 0xc4, 0xb9,
+	0x73, 0xe3, 0x5e, 0x5f, 0x2f, 0x1f, 0x3a, 0x0f, 0x3d, 0xac, 0x45, 0xf1, 0xff, 0x97, 0xc9, 0x9b,
+	0xb8, 0x32, 0xe7, 0x6b, 0x60, 0x22, 0x08, 0x13, 0x72, 0x57, 0xbf, 0xd6, 0x7d, 0x57, 0xbf, 0x30,
+	0xa0, 0x7a, 0x81, 0xab, 0xbb, 0xf0, 0x13, 0x09, 0xe4, 0x57, 0x4c, 0x63, 0x97, 0x58, 0x4e, 0x1f,
+	0xb5, 0xbb, 0x63, 0x36, 0x40, 0x92, 0xeb, 0xe4, 0xbf, 0x04, 0xbf, 0x3c, 0xf8, 0xd3, 0xed, 0x04,
+	0x17, 0x5a, 0xad, 0xa0, 0x04, 0x47, 0xa9, 0xb2, 0xe7, 0xe8, 0xcc, 0x6f, 0x63, 0x87, 0x31, 0x62,
+	0xdf, 0x17, 0xd6, 0x00, 0xf0, 0x83, 0x11, 0x98, 0x05, 0x93, 0x1b, 0x6f, 0xde, 0x5b, 0x45, 0xea,
+	0x9d, 0x9b, 0xd7, 0x6f, 0xbe, 0x79, 0xef, 0x66, 0x26, 0xe2, 0x37, 0x29, 0xe5, 0xdb, 0xb7, 0x57,
+	0xd1, 0x5b, 0x19, 0x09, 0x42, 0x90, 0xe2, 0x4d, 0xab, 0x7f, 0x7e, 0x7b, 0x15, 0xdd, 0x2c, 0xdf,
+	0xc8, 0x44, 0x95, 0x7f, 0x92, 0x3e, 0x7b, 0x9a, 0x93, 0x3e, 0x7f, 0x9a, 0x93, 0x7e, 0xf3, 0x34,
+	0x17, 0xf9, 0xdd, 0xd3, 0x5c, 0xe4, 0x8b, 0xa7, 0xb9, 0xc8, 0xef, 0x9f, 0xe6, 0x22, 0x7f, 0x78,
+	0x9a, 0x93, 0x3e, 0xec, 0xe4, 0xa4, 0x9f, 0x76, 0x72, 0x91, 0x5f, 0x74, 0x72, 0xd2, 0x2f, 0x3b,
+	0xb9, 0xc8, 0xa7, 0x9d, 0x5c, 0xe4, 0x57, 0x9d, 0x5c, 0xe4, 0xb3, 0x4e, 0x4e, 0xfa, 0xbc, 0x93,
+	0x93, 0x7e, 0xd3, 0xc9, 0x45, 0x7e, 0xd7, 0xc9, 0x49, 0x5f, 0x74, 0x72, 0x91, 0xdf, 0x77, 0x72,
+	0xd2, 0x1f, 0x3a, 0xb9, 0xc8, 0x87, 0xcf, 0x72, 0x91, 0x9f, 0x3e, 0xcb, 0x49, 0x3f, 0x7f, 0x96,
+	0x8b, 0x7c, 0xfc, 0x2c, 0x27, 0x7d, 0xf2, 0x2c, 0x17, 0xf9, 0xc5, 0xb3, 0x5c, 0xe4, 0x97, 0xcf,
+	0x72, 0xd2, 0xa7, 0xcf, 0x72, 0xd2, 0xaf, 0x9e, 0xe5, 0xa4, 0xb7, 0x2f, 0x0e, 0x7a, 0x93, 0x38,
+	0x46, 0x73, 0x73, 0x73, 0x8c, 0xed, 0xc0, 0xcb, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x2f,
+	0x15, 0xdb, 0x08, 0x3d, 0x00, 0x00,
 }
 
 func (x PowerState) String() string {
@@ -3414,6 +3459,28 @@ func (this *MACSettings) Equal(that interface{}) bool {
 	if !this.DesiredBeaconFrequency.Equal(that1.DesiredBeaconFrequency) {
 		return false
 	}
+	if len(this.DesiredChannelMask) != len(that1.DesiredChannelMask) {
+		return false
+	}
+	for i := range this.DesiredChannelMask {
+		if this.DesiredChannelMask[i] != that1.DesiredChannelMask[i] {
+			return false
+		}
+	}
+	if len(this.DesiredExtraChannels) != len(that1.DesiredExtraChannels) {
+		return false
+	}
+	for i := range this.DesiredExtraChannels {
+		if !this.DesiredExtraChannels[i].Equal(that1.DesiredExtraChannels[i]) {
+			return false
+		}
+	}
+	if !this.DesiredMinDataRateIndex.Equal(that1.DesiredMinDataRateIndex) {
+		return false
+	}
+	if !this.DesiredMaxDataRateIndex.Equal(that1.DesiredMaxDataRateIndex) {
+		return false
+	}
 	return true
 }
 func (this *MACState) Equal(that interface{}) bool {
@@ -4749,6 +4816,65 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DesiredMaxDataRateIndex != nil {
+		{
+			size, err := m.DesiredMaxDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.DesiredMinDataRateIndex != nil {
+		{
+			size, err := m.DesiredMinDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.DesiredExtraChannels) > 0 {
+		for iNdEx := len(m.DesiredExtraChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DesiredExtraChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.DesiredChannelMask) > 0 {
+		for iNdEx := len(m.DesiredChannelMask) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.DesiredChannelMask[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.DesiredChannelMask)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
 	if m.DesiredBeaconFrequency != nil {
 		{
 			size, err := m.DesiredBeaconFrequency.MarshalToSizedBuffer(dAtA[:i])
@@ -4918,12 +5044,12 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x8a
 	}
 	if m.StatusTimePeriodicity != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StatusTimePeriodicity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity):])
-		if err23 != nil {
-			return 0, err23
+		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StatusTimePeriodicity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity):])
+		if err25 != nil {
+			return 0, err25
 		}
-		i -= n23
-		i = encodeVarintEndDevice(dAtA, i, uint64(n23))
+		i -= n25
+		i = encodeVarintEndDevice(dAtA, i, uint64(n25))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4990,20 +5116,20 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if len(m.FactoryPresetFrequencies) > 0 {
-		dAtA30 := make([]byte, len(m.FactoryPresetFrequencies)*10)
-		var j29 int
+		dAtA32 := make([]byte, len(m.FactoryPresetFrequencies)*10)
+		var j31 int
 		for _, num := range m.FactoryPresetFrequencies {
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(num&0x7f | 0x80)
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j31++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA32[j31] = uint8(num)
+			j31++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j29))
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j31))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -5056,12 +5182,12 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 	}
 	if m.ClassCTimeout != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassCTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout):])
-		if err35 != nil {
-			return 0, err35
+		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassCTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n35
-		i = encodeVarintEndDevice(dAtA, i, uint64(n35))
+		i -= n37
+		i = encodeVarintEndDevice(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -5102,12 +5228,12 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.ClassBTimeout != nil {
-		n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassBTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout):])
-		if err39 != nil {
-			return 0, err39
+		n41, err41 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassBTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout):])
+		if err41 != nil {
+			return 0, err41
 		}
-		i -= n39
-		i = encodeVarintEndDevice(dAtA, i, uint64(n39))
+		i -= n41
+		i = encodeVarintEndDevice(dAtA, i, uint64(n41))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -5135,12 +5261,12 @@ func (m *MACState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.LastNetworkInitiatedDownlinkAt != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastNetworkInitiatedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastNetworkInitiatedDownlinkAt):])
-		if err40 != nil {
-			return 0, err40
+		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastNetworkInitiatedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastNetworkInitiatedDownlinkAt):])
+		if err42 != nil {
+			return 0, err42
 		}
-		i -= n40
-		i = encodeVarintEndDevice(dAtA, i, uint64(n40))
+		i -= n42
+		i = encodeVarintEndDevice(dAtA, i, uint64(n42))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5266,12 +5392,12 @@ func (m *MACState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x30
 	}
 	if m.LastConfirmedDownlinkAt != nil {
-		n45, err45 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt):])
-		if err45 != nil {
-			return 0, err45
+		n47, err47 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt):])
+		if err47 != nil {
+			return 0, err47
 		}
-		i -= n45
-		i = encodeVarintEndDevice(dAtA, i, uint64(n45))
+		i -= n47
+		i = encodeVarintEndDevice(dAtA, i, uint64(n47))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -5379,22 +5505,22 @@ func (m *EndDeviceAuthenticationCode) MarshalToSizedBuffer(dAtA []byte) (int, er
 	var l int
 	_ = l
 	if m.ValidTo != nil {
-		n50, err50 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidTo, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo):])
-		if err50 != nil {
-			return 0, err50
+		n52, err52 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidTo, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo):])
+		if err52 != nil {
+			return 0, err52
 		}
-		i -= n50
-		i = encodeVarintEndDevice(dAtA, i, uint64(n50))
+		i -= n52
+		i = encodeVarintEndDevice(dAtA, i, uint64(n52))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.ValidFrom != nil {
-		n51, err51 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom):])
-		if err51 != nil {
-			return 0, err51
+		n53, err53 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom):])
+		if err53 != nil {
+			return 0, err53
 		}
-		i -= n51
-		i = encodeVarintEndDevice(dAtA, i, uint64(n51))
+		i -= n53
+		i = encodeVarintEndDevice(dAtA, i, uint64(n53))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5651,12 +5777,12 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x90
 	}
 	if m.LastDevStatusReceivedAt != nil {
-		n58, err58 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt):])
-		if err58 != nil {
-			return 0, err58
+		n60, err60 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt):])
+		if err60 != nil {
+			return 0, err60
 		}
-		i -= n58
-		i = encodeVarintEndDevice(dAtA, i, uint64(n58))
+		i -= n60
+		i = encodeVarintEndDevice(dAtA, i, uint64(n60))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -5684,20 +5810,20 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xf0
 	}
 	if len(m.UsedDevNonces) > 0 {
-		dAtA60 := make([]byte, len(m.UsedDevNonces)*10)
-		var j59 int
+		dAtA62 := make([]byte, len(m.UsedDevNonces)*10)
+		var j61 int
 		for _, num := range m.UsedDevNonces {
 			for num >= 1<<7 {
-				dAtA60[j59] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j59++
+				j61++
 			}
-			dAtA60[j59] = uint8(num)
-			j59++
+			dAtA62[j61] = uint8(num)
+			j61++
 		}
-		i -= j59
-		copy(dAtA[i:], dAtA60[:j59])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j59))
+		i -= j61
+		copy(dAtA[i:], dAtA62[:j61])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j61))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5972,20 +6098,20 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n68, err68 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err68 != nil {
-		return 0, err68
+	n70, err70 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err70 != nil {
+		return 0, err70
 	}
-	i -= n68
-	i = encodeVarintEndDevice(dAtA, i, uint64(n68))
+	i -= n70
+	i = encodeVarintEndDevice(dAtA, i, uint64(n70))
 	i--
 	dAtA[i] = 0x1a
-	n69, err69 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err69 != nil {
-		return 0, err69
+	n71, err71 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err71 != nil {
+		return 0, err71
 	}
-	i -= n69
-	i = encodeVarintEndDevice(dAtA, i, uint64(n69))
+	i -= n71
+	i = encodeVarintEndDevice(dAtA, i, uint64(n71))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -6644,6 +6770,24 @@ func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
 	if r.Intn(5) != 0 {
 		this.DesiredBeaconFrequency = types.NewPopulatedUInt64Value(r, easy)
 	}
+	v7 := r.Intn(10)
+	this.DesiredChannelMask = make([]bool, v7)
+	for i := 0; i < v7; i++ {
+		this.DesiredChannelMask[i] = bool(r.Intn(2) == 0)
+	}
+	if r.Intn(5) != 0 {
+		v8 := r.Intn(5)
+		this.DesiredExtraChannels = make([]*MACParameters_Channel, v8)
+		for i := 0; i < v8; i++ {
+			this.DesiredExtraChannels[i] = NewPopulatedMACParameters_Channel(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.DesiredMinDataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DesiredMaxDataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6651,15 +6795,15 @@ func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
 
 func NewPopulatedMACState_JoinAccept(r randyEndDevice, easy bool) *MACState_JoinAccept {
 	this := &MACState_JoinAccept{}
-	v7 := r.Intn(100)
-	this.Payload = make([]byte, v7)
-	for i := 0; i < v7; i++ {
+	v9 := r.Intn(100)
+	this.Payload = make([]byte, v9)
+	for i := 0; i < v9; i++ {
 		this.Payload[i] = byte(r.Intn(256))
 	}
-	v8 := NewPopulatedJoinRequest(r, easy)
-	this.Request = *v8
-	v9 := NewPopulatedSessionKeys(r, easy)
-	this.Keys = *v9
+	v10 := NewPopulatedJoinRequest(r, easy)
+	this.Request = *v10
+	v11 := NewPopulatedSessionKeys(r, easy)
+	this.Keys = *v11
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6668,9 +6812,9 @@ func NewPopulatedMACState_JoinAccept(r randyEndDevice, easy bool) *MACState_Join
 func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
 	this := &EndDevices{}
 	if r.Intn(5) != 0 {
-		v10 := r.Intn(5)
-		this.EndDevices = make([]*EndDevice, v10)
-		for i := 0; i < v10; i++ {
+		v12 := r.Intn(5)
+		this.EndDevices = make([]*EndDevice, v12)
+		for i := 0; i < v12; i++ {
 			this.EndDevices[i] = NewPopulatedEndDevice(r, easy)
 		}
 	}
@@ -6681,8 +6825,8 @@ func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
 
 func NewPopulatedCreateEndDeviceRequest(r randyEndDevice, easy bool) *CreateEndDeviceRequest {
 	this := &CreateEndDeviceRequest{}
-	v11 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v11
+	v13 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v13
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6690,10 +6834,10 @@ func NewPopulatedCreateEndDeviceRequest(r randyEndDevice, easy bool) *CreateEndD
 
 func NewPopulatedUpdateEndDeviceRequest(r randyEndDevice, easy bool) *UpdateEndDeviceRequest {
 	this := &UpdateEndDeviceRequest{}
-	v12 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v12
-	v13 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v13
+	v14 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v14
+	v15 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v15
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6701,10 +6845,10 @@ func NewPopulatedUpdateEndDeviceRequest(r randyEndDevice, easy bool) *UpdateEndD
 
 func NewPopulatedGetEndDeviceRequest(r randyEndDevice, easy bool) *GetEndDeviceRequest {
 	this := &GetEndDeviceRequest{}
-	v14 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v14
-	v15 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v15
+	v16 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v16
+	v17 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v17
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6712,10 +6856,10 @@ func NewPopulatedGetEndDeviceRequest(r randyEndDevice, easy bool) *GetEndDeviceR
 
 func NewPopulatedGetEndDeviceIdentifiersForEUIsRequest(r randyEndDevice, easy bool) *GetEndDeviceIdentifiersForEUIsRequest {
 	this := &GetEndDeviceIdentifiersForEUIsRequest{}
-	v16 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.JoinEUI = *v16
-	v17 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.DevEUI = *v17
+	v18 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEUI = *v18
+	v19 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v19
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6723,10 +6867,10 @@ func NewPopulatedGetEndDeviceIdentifiersForEUIsRequest(r randyEndDevice, easy bo
 
 func NewPopulatedListEndDevicesRequest(r randyEndDevice, easy bool) *ListEndDevicesRequest {
 	this := &ListEndDevicesRequest{}
-	v18 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v18
-	v19 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v19
+	v20 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v20
+	v21 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v21
 	this.Order = randStringEndDevice(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -6737,10 +6881,10 @@ func NewPopulatedListEndDevicesRequest(r randyEndDevice, easy bool) *ListEndDevi
 
 func NewPopulatedSetEndDeviceRequest(r randyEndDevice, easy bool) *SetEndDeviceRequest {
 	this := &SetEndDeviceRequest{}
-	v20 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v20
-	v21 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v21
+	v22 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v22
+	v23 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v23
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6748,10 +6892,10 @@ func NewPopulatedSetEndDeviceRequest(r randyEndDevice, easy bool) *SetEndDeviceR
 
 func NewPopulatedEndDeviceTemplate(r randyEndDevice, easy bool) *EndDeviceTemplate {
 	this := &EndDeviceTemplate{}
-	v22 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v22
-	v23 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v23
+	v24 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v24
+	v25 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v25
 	this.MappingKey = randStringEndDevice(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -6762,9 +6906,9 @@ func NewPopulatedEndDeviceTemplateFormat(r randyEndDevice, easy bool) *EndDevice
 	this := &EndDeviceTemplateFormat{}
 	this.Name = randStringEndDevice(r)
 	this.Description = randStringEndDevice(r)
-	v24 := r.Intn(10)
-	this.FileExtensions = make([]string, v24)
-	for i := 0; i < v24; i++ {
+	v26 := r.Intn(10)
+	this.FileExtensions = make([]string, v26)
+	for i := 0; i < v26; i++ {
 		this.FileExtensions[i] = randStringEndDevice(r)
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -6775,9 +6919,9 @@ func NewPopulatedEndDeviceTemplateFormat(r randyEndDevice, easy bool) *EndDevice
 func NewPopulatedEndDeviceTemplateFormats(r randyEndDevice, easy bool) *EndDeviceTemplateFormats {
 	this := &EndDeviceTemplateFormats{}
 	if r.Intn(5) != 0 {
-		v25 := r.Intn(10)
+		v27 := r.Intn(10)
 		this.Formats = make(map[string]*EndDeviceTemplateFormat)
-		for i := 0; i < v25; i++ {
+		for i := 0; i < v27; i++ {
 			this.Formats[randStringEndDevice(r)] = NewPopulatedEndDeviceTemplateFormat(r, easy)
 		}
 	}
@@ -6789,9 +6933,9 @@ func NewPopulatedEndDeviceTemplateFormats(r randyEndDevice, easy bool) *EndDevic
 func NewPopulatedConvertEndDeviceTemplateRequest(r randyEndDevice, easy bool) *ConvertEndDeviceTemplateRequest {
 	this := &ConvertEndDeviceTemplateRequest{}
 	this.FormatID = randStringEndDevice(r)
-	v26 := r.Intn(100)
-	this.Data = make([]byte, v26)
-	for i := 0; i < v26; i++ {
+	v28 := r.Intn(100)
+	this.Data = make([]byte, v28)
+	for i := 0; i < v28; i++ {
 		this.Data[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -6818,9 +6962,9 @@ func randUTF8RuneEndDevice(r randyEndDevice) rune {
 	return rune(ru + 61)
 }
 func randStringEndDevice(r randyEndDevice) string {
-	v27 := r.Intn(100)
-	tmps := make([]rune, v27)
-	for i := 0; i < v27; i++ {
+	v29 := r.Intn(100)
+	tmps := make([]rune, v29)
+	for i := 0; i < v29; i++ {
 		tmps[i] = randUTF8RuneEndDevice(r)
 	}
 	return string(tmps)
@@ -6842,11 +6986,11 @@ func randFieldEndDevice(dAtA []byte, r randyEndDevice, fieldNumber int, wire int
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
-		v28 := r.Int63()
+		v30 := r.Int63()
 		if r.Intn(2) == 0 {
-			v28 *= -1
+			v30 *= -1
 		}
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v28))
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v30))
 	case 1:
 		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -7256,6 +7400,23 @@ func (m *MACSettings) Size() (n int) {
 		l = m.DesiredBeaconFrequency.Size()
 		n += 2 + l + sovEndDevice(uint64(l))
 	}
+	if len(m.DesiredChannelMask) > 0 {
+		n += 2 + sovEndDevice(uint64(len(m.DesiredChannelMask))) + len(m.DesiredChannelMask)*1
+	}
+	if len(m.DesiredExtraChannels) > 0 {
+		for _, e := range m.DesiredExtraChannels {
+			l = e.Size()
+			n += 2 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.DesiredMinDataRateIndex != nil {
+		l = m.DesiredMinDataRateIndex.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.DesiredMaxDataRateIndex != nil {
+		l = m.DesiredMaxDataRateIndex.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -7907,6 +8068,11 @@ func (this *MACSettings) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForDesiredExtraChannels := "[]*MACParameters_Channel{"
+	for _, f := range this.DesiredExtraChannels {
+		repeatedStringForDesiredExtraChannels += strings.Replace(fmt.Sprintf("%v", f), "MACParameters_Channel", "MACParameters_Channel", 1) + ","
+	}
+	repeatedStringForDesiredExtraChannels += "}"
 	s := strings.Join([]string{`&MACSettings{`,
 		`ClassBTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ClassBTimeout), "Duration", "types.Duration", 1) + `,`,
 		`PingSlotPeriodicity:` + strings.Replace(fmt.Sprintf("%v", this.PingSlotPeriodicity), "PingSlotPeriodValue", "PingSlotPeriodValue", 1) + `,`,
@@ -7936,6 +8102,10 @@ func (this *MACSettings) String() string {
 		`DesiredPingSlotDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
 		`DesiredPingSlotFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredPingSlotFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
 		`DesiredBeaconFrequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredBeaconFrequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
+		`DesiredChannelMask:` + fmt.Sprintf("%v", this.DesiredChannelMask) + `,`,
+		`DesiredExtraChannels:` + repeatedStringForDesiredExtraChannels + `,`,
+		`DesiredMinDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredMinDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`DesiredMaxDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredMaxDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11192,6 +11362,182 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 30:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DesiredChannelMask = append(m.DesiredChannelMask, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEndDevice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEndDevice
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.DesiredChannelMask) == 0 {
+					m.DesiredChannelMask = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEndDevice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DesiredChannelMask = append(m.DesiredChannelMask, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredChannelMask", wireType)
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredExtraChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DesiredExtraChannels = append(m.DesiredExtraChannels, &MACParameters_Channel{})
+			if err := m.DesiredExtraChannels[len(m.DesiredExtraChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredMinDataRateIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredMinDataRateIndex == nil {
+				m.DesiredMinDataRateIndex = &DataRateIndexValue{}
+			}
+			if err := m.DesiredMinDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredMaxDataRateIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DesiredMaxDataRateIndex == nil {
+				m.DesiredMaxDataRateIndex = &DataRateIndexValue{}
+			}
+			if err := m.DesiredMaxDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -145,8 +145,14 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_mac_settings.desired_adr_ack_limit_exponent",
 	"default_mac_settings.desired_adr_ack_limit_exponent.value",
 	"default_mac_settings.desired_beacon_frequency",
+	"default_mac_settings.desired_channel_mask",
+	"default_mac_settings.desired_extra_channels",
+	"default_mac_settings.desired_max_data_rate_index",
+	"default_mac_settings.desired_max_data_rate_index.value",
 	"default_mac_settings.desired_max_duty_cycle",
 	"default_mac_settings.desired_max_duty_cycle.value",
+	"default_mac_settings.desired_min_data_rate_index",
+	"default_mac_settings.desired_min_data_rate_index.value",
 	"default_mac_settings.desired_ping_slot_data_rate_index",
 	"default_mac_settings.desired_ping_slot_data_rate_index.value",
 	"default_mac_settings.desired_ping_slot_frequency",
@@ -217,8 +223,14 @@ var MACSettingsFieldPathsNested = []string{
 	"desired_adr_ack_limit_exponent",
 	"desired_adr_ack_limit_exponent.value",
 	"desired_beacon_frequency",
+	"desired_channel_mask",
+	"desired_extra_channels",
+	"desired_max_data_rate_index",
+	"desired_max_data_rate_index.value",
 	"desired_max_duty_cycle",
 	"desired_max_duty_cycle.value",
+	"desired_min_data_rate_index",
+	"desired_min_data_rate_index.value",
 	"desired_ping_slot_data_rate_index",
 	"desired_ping_slot_data_rate_index.value",
 	"desired_ping_slot_frequency",
@@ -257,7 +269,11 @@ var MACSettingsFieldPathsTopLevel = []string{
 	"desired_adr_ack_delay_exponent",
 	"desired_adr_ack_limit_exponent",
 	"desired_beacon_frequency",
+	"desired_channel_mask",
+	"desired_extra_channels",
+	"desired_max_data_rate_index",
 	"desired_max_duty_cycle",
+	"desired_min_data_rate_index",
 	"desired_ping_slot_data_rate_index",
 	"desired_ping_slot_frequency",
 	"desired_rx1_data_rate_offset",
@@ -344,9 +360,11 @@ var MACStateFieldPathsNested = []string{
 	"pending_application_downlink.confirmed",
 	"pending_application_downlink.correlation_ids",
 	"pending_application_downlink.decoded_payload",
+	"pending_application_downlink.expires_at",
 	"pending_application_downlink.f_cnt",
 	"pending_application_downlink.f_port",
 	"pending_application_downlink.frm_payload",
+	"pending_application_downlink.not_before",
 	"pending_application_downlink.priority",
 	"pending_application_downlink.session_key_id",
 	"pending_join_request",
@@ -570,8 +588,14 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_settings.desired_adr_ack_limit_exponent",
 	"mac_settings.desired_adr_ack_limit_exponent.value",
 	"mac_settings.desired_beacon_frequency",
+	"mac_settings.desired_channel_mask",
+	"mac_settings.desired_extra_channels",
+	"mac_settings.desired_max_data_rate_index",
+	"mac_settings.desired_max_data_rate_index.value",
 	"mac_settings.desired_max_duty_cycle",
 	"mac_settings.desired_max_duty_cycle.value",
+	"mac_settings.desired_min_data_rate_index",
+	"mac_settings.desired_min_data_rate_index.value",
 	"mac_settings.desired_ping_slot_data_rate_index",
 	"mac_settings.desired_ping_slot_data_rate_index.value",
 	"mac_settings.desired_ping_slot_frequency",
@@ -665,9 +689,11 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.pending_application_downlink.confirmed",
 	"mac_state.pending_application_downlink.correlation_ids",
 	"mac_state.pending_application_downlink.decoded_payload",
+	"mac_state.pending_application_downlink.expires_at",
 	"mac_state.pending_application_downlink.f_cnt",
 	"mac_state.pending_application_downlink.f_port",
 	"mac_state.pending_application_downlink.frm_payload",
+	"mac_state.pending_application_downlink.not_before",
 	"mac_state.pending_application_downlink.priority",
 	"mac_state.pending_application_downlink.session_key_id",
 	"mac_state.pending_join_request",
@@ -887,9 +913,11 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.pending_application_downlink.confirmed",
 	"pending_mac_state.pending_application_downlink.correlation_ids",
 	"pending_mac_state.pending_application_downlink.decoded_payload",
+	"pending_mac_state.pending_application_downlink.expires_at",
 	"pending_mac_state.pending_application_downlink.f_cnt",
 	"pending_mac_state.pending_application_downlink.f_port",
 	"pending_mac_state.pending_application_downlink.frm_payload",
+	"pending_mac_state.pending_application_downlink.not_before",
 	"pending_mac_state.pending_application_downlink.priority",
 	"pending_mac_state.pending_application_downlink.session_key_id",
 	"pending_mac_state.pending_join_request",
@@ -1232,8 +1260,14 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_adr_ack_limit_exponent",
 	"end_device.mac_settings.desired_adr_ack_limit_exponent.value",
 	"end_device.mac_settings.desired_beacon_frequency",
+	"end_device.mac_settings.desired_channel_mask",
+	"end_device.mac_settings.desired_extra_channels",
+	"end_device.mac_settings.desired_max_data_rate_index",
+	"end_device.mac_settings.desired_max_data_rate_index.value",
 	"end_device.mac_settings.desired_max_duty_cycle",
 	"end_device.mac_settings.desired_max_duty_cycle.value",
+	"end_device.mac_settings.desired_min_data_rate_index",
+	"end_device.mac_settings.desired_min_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
@@ -1327,9 +1361,11 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
+	"end_device.mac_state.pending_application_downlink.not_before",
 	"end_device.mac_state.pending_application_downlink.priority",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
@@ -1549,9 +1585,11 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
+	"end_device.pending_mac_state.pending_application_downlink.not_before",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
@@ -1837,8 +1875,14 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_adr_ack_limit_exponent",
 	"end_device.mac_settings.desired_adr_ack_limit_exponent.value",
 	"end_device.mac_settings.desired_beacon_frequency",
+	"end_device.mac_settings.desired_channel_mask",
+	"end_device.mac_settings.desired_extra_channels",
+	"end_device.mac_settings.desired_max_data_rate_index",
+	"end_device.mac_settings.desired_max_data_rate_index.value",
 	"end_device.mac_settings.desired_max_duty_cycle",
 	"end_device.mac_settings.desired_max_duty_cycle.value",
+	"end_device.mac_settings.desired_min_data_rate_index",
+	"end_device.mac_settings.desired_min_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
@@ -1932,9 +1976,11 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
+	"end_device.mac_state.pending_application_downlink.not_before",
 	"end_device.mac_state.pending_application_downlink.priority",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
@@ -2154,9 +2200,11 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
+	"end_device.pending_mac_state.pending_application_downlink.not_before",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
@@ -2484,8 +2532,14 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_adr_ack_limit_exponent",
 	"end_device.mac_settings.desired_adr_ack_limit_exponent.value",
 	"end_device.mac_settings.desired_beacon_frequency",
+	"end_device.mac_settings.desired_channel_mask",
+	"end_device.mac_settings.desired_extra_channels",
+	"end_device.mac_settings.desired_max_data_rate_index",
+	"end_device.mac_settings.desired_max_data_rate_index.value",
 	"end_device.mac_settings.desired_max_duty_cycle",
 	"end_device.mac_settings.desired_max_duty_cycle.value",
+	"end_device.mac_settings.desired_min_data_rate_index",
+	"end_device.mac_settings.desired_min_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
@@ -2579,9 +2633,11 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
+	"end_device.mac_state.pending_application_downlink.not_before",
 	"end_device.mac_state.pending_application_downlink.priority",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
@@ -2801,9 +2857,11 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
+	"end_device.pending_mac_state.pending_application_downlink.not_before",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
@@ -3091,8 +3149,14 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_settings.desired_adr_ack_limit_exponent",
 	"end_device.mac_settings.desired_adr_ack_limit_exponent.value",
 	"end_device.mac_settings.desired_beacon_frequency",
+	"end_device.mac_settings.desired_channel_mask",
+	"end_device.mac_settings.desired_extra_channels",
+	"end_device.mac_settings.desired_max_data_rate_index",
+	"end_device.mac_settings.desired_max_data_rate_index.value",
 	"end_device.mac_settings.desired_max_duty_cycle",
 	"end_device.mac_settings.desired_max_duty_cycle.value",
+	"end_device.mac_settings.desired_min_data_rate_index",
+	"end_device.mac_settings.desired_min_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
@@ -3186,9 +3250,11 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
+	"end_device.mac_state.pending_application_downlink.not_before",
 	"end_device.mac_state.pending_application_downlink.priority",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
@@ -3408,9 +3474,11 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
+	"end_device.pending_mac_state.pending_application_downlink.not_before",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
@@ -1125,6 +1125,74 @@ func (dst *MACSettings) SetFields(src *MACSettings, paths ...string) error {
 			} else {
 				dst.DesiredBeaconFrequency = nil
 			}
+		case "desired_channel_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'desired_channel_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DesiredChannelMask = src.DesiredChannelMask
+			} else {
+				dst.DesiredChannelMask = nil
+			}
+		case "desired_extra_channels":
+			if len(subs) > 0 {
+				return fmt.Errorf("'desired_extra_channels' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DesiredExtraChannels = src.DesiredExtraChannels
+			} else {
+				dst.DesiredExtraChannels = nil
+			}
+		case "desired_min_data_rate_index":
+			if len(subs) > 0 {
+				var newDst, newSrc *DataRateIndexValue
+				if (src == nil || src.DesiredMinDataRateIndex == nil) && dst.DesiredMinDataRateIndex == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DesiredMinDataRateIndex
+				}
+				if dst.DesiredMinDataRateIndex != nil {
+					newDst = dst.DesiredMinDataRateIndex
+				} else {
+					newDst = &DataRateIndexValue{}
+					dst.DesiredMinDataRateIndex = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DesiredMinDataRateIndex = src.DesiredMinDataRateIndex
+				} else {
+					dst.DesiredMinDataRateIndex = nil
+				}
+			}
+		case "desired_max_data_rate_index":
+			if len(subs) > 0 {
+				var newDst, newSrc *DataRateIndexValue
+				if (src == nil || src.DesiredMaxDataRateIndex == nil) && dst.DesiredMaxDataRateIndex == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DesiredMaxDataRateIndex
+				}
+				if dst.DesiredMaxDataRateIndex != nil {
+					newDst = dst.DesiredMaxDataRateIndex
+				} else {
+					newDst = &DataRateIndexValue{}
+					dst.DesiredMaxDataRateIndex = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DesiredMaxDataRateIndex = src.DesiredMaxDataRateIndex
+				} else {
+					dst.DesiredMaxDataRateIndex = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1274,6 +1274,49 @@ func (m *MACSettings) ValidateFields(paths ...string) error {
 
 			}
 
+		case "desired_channel_mask":
+
+		case "desired_extra_channels":
+
+			for idx, item := range m.GetDesiredExtraChannels() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MACSettingsValidationError{
+							field:  fmt.Sprintf("desired_extra_channels[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "desired_min_data_rate_index":
+
+			if v, ok := interface{}(m.GetDesiredMinDataRateIndex()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "desired_min_data_rate_index",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "desired_max_data_rate_index":
+
+			if v, ok := interface{}(m.GetDesiredMaxDataRateIndex()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "desired_max_data_rate_index",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return MACSettingsValidationError{
 				field:  name,
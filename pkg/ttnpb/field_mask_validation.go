@@ -248,8 +248,14 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"mac_settings.desired_adr_ack_limit_exponent",
 		"mac_settings.desired_adr_ack_limit_exponent.value",
 		"mac_settings.desired_beacon_frequency",
+		"mac_settings.desired_channel_mask",
+		"mac_settings.desired_extra_channels",
+		"mac_settings.desired_max_data_rate_index",
+		"mac_settings.desired_max_data_rate_index.value",
 		"mac_settings.desired_max_duty_cycle",
 		"mac_settings.desired_max_duty_cycle.value",
+		"mac_settings.desired_min_data_rate_index",
+		"mac_settings.desired_min_data_rate_index.value",
 		"mac_settings.desired_ping_slot_data_rate_index",
 		"mac_settings.desired_ping_slot_data_rate_index.value",
 		"mac_settings.desired_ping_slot_frequency",
@@ -338,9 +344,11 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"mac_state.pending_application_downlink.confirmed",
 		"mac_state.pending_application_downlink.correlation_ids",
 		"mac_state.pending_application_downlink.decoded_payload",
+		"mac_state.pending_application_downlink.expires_at",
 		"mac_state.pending_application_downlink.f_cnt",
 		"mac_state.pending_application_downlink.f_port",
 		"mac_state.pending_application_downlink.frm_payload",
+		"mac_state.pending_application_downlink.not_before",
 		"mac_state.pending_application_downlink.priority",
 		"mac_state.pending_application_downlink.session_key_id",
 		"mac_state.pending_join_request",
@@ -544,8 +552,14 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"mac_settings.desired_adr_ack_limit_exponent",
 		"mac_settings.desired_adr_ack_limit_exponent.value",
 		"mac_settings.desired_beacon_frequency",
+		"mac_settings.desired_channel_mask",
+		"mac_settings.desired_extra_channels",
+		"mac_settings.desired_max_data_rate_index",
+		"mac_settings.desired_max_data_rate_index.value",
 		"mac_settings.desired_max_duty_cycle",
 		"mac_settings.desired_max_duty_cycle.value",
+		"mac_settings.desired_min_data_rate_index",
+		"mac_settings.desired_min_data_rate_index.value",
 		"mac_settings.desired_ping_slot_data_rate_index",
 		"mac_settings.desired_ping_slot_data_rate_index.value",
 		"mac_settings.desired_ping_slot_frequency",
@@ -88,6 +88,7 @@ func HasAnyField(requested []string, search ...string) bool {
 func FlattenPaths(paths, flatten []string) []string {
 	res := make([]string, 0, len(paths))
 	flattened := make(map[string]bool)
+nextPath:
 	for _, path := range paths {
 		for _, flatten := range flatten {
 			if flatten == path || strings.HasPrefix(path, flatten+".") {
@@ -95,10 +96,10 @@ func FlattenPaths(paths, flatten []string) []string {
 					res = append(res, flatten)
 					flattened[flatten] = true
 				}
-			} else {
-				res = append(res, path)
+				continue nextPath
 			}
 		}
+		res = append(res, path)
 	}
 	return res
 }
@@ -113,6 +113,7 @@ func TestFlattenPaths(t *testing.T) {
 		"e.f",
 	}
 	a.So(FlattenPaths(paths, []string{"a.b"}), should.Resemble, []string{"a", "a.b", "e.f"})
+	a.So(FlattenPaths(paths, []string{"a.b", "e"}), should.Resemble, []string{"a", "a.b", "e"})
 }
 
 func TestContainsField(t *testing.T) {
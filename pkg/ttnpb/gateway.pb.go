@@ -789,7 +789,9 @@ type ListGatewaysRequest struct {
 	// Limit the number of results per page.
 	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Page uint32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	// Only return recently deleted gateways. Only admins can list deleted gateways.
+	Deleted              bool     `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -861,6 +863,13 @@ func (m *ListGatewaysRequest) GetPage() uint32 {
 	return 0
 }
 
+func (m *ListGatewaysRequest) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type CreateGatewayRequest struct {
 	Gateway `protobuf:"bytes,1,opt,name=gateway,proto3,embedded=gateway" json:"gateway"`
 	// Collaborator to grant all rights on the newly created gateway.
@@ -1058,9 +1067,13 @@ func (m *GetGatewayAPIKeyRequest) GetKeyID() string {
 }
 
 type CreateGatewayAPIKeyRequest struct {
-	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights               []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	Name               string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights             []Right `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Time after which the API key can no longer be used.
+	ExpiresAt *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// IP address ranges (in CIDR notation) from which the API key can be used.
+	AllowedIPRanges      []string `protobuf:"bytes,5,rep,name=allowed_ip_ranges,json=allowedIpRanges,proto3" json:"allowed_ip_ranges,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -1111,6 +1124,20 @@ func (m *CreateGatewayAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateGatewayAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateGatewayAPIKeyRequest) GetAllowedIPRanges() []string {
+	if m != nil {
+		return m.AllowedIPRanges
+	}
+	return nil
+}
+
 type UpdateGatewayAPIKeyRequest struct {
 	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
 	APIKey               `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
@@ -1714,169 +1741,173 @@ func init() {
 }
 
 var fileDescriptor_1df6bae1ac946b39 = []byte{
-	// 2587 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x90, 0xa2, 0x44, 0x0d, 0xf5, 0xe7, 0x89, 0xa2, 0xac, 0x65, 0x7b, 0xa9, 0x30, 0x4e,
-	0x22, 0xb9, 0x26, 0xd5, 0x32, 0x49, 0xd1, 0xaa, 0x75, 0x14, 0xae, 0x64, 0x07, 0x44, 0xec, 0xc6,
-	0x5d, 0x59, 0x09, 0x1a, 0x3b, 0x5e, 0x8c, 0x76, 0x87, 0xe4, 0x56, 0xcb, 0x5d, 0x76, 0x76, 0x28,
-	0x8b, 0x89, 0x03, 0x04, 0x45, 0x80, 0x06, 0x41, 0xd1, 0x06, 0x3e, 0x05, 0x45, 0x0f, 0x41, 0x80,
-	0x16, 0x41, 0xdb, 0x43, 0xd0, 0x43, 0x91, 0x43, 0x0f, 0xb9, 0xb4, 0xc8, 0xa9, 0xf0, 0x29, 0x08,
-	0x5a, 0x40, 0x89, 0xa8, 0x4b, 0x7a, 0x0b, 0x7a, 0x0a, 0x74, 0x2a, 0x66, 0x76, 0x76, 0xb9, 0xa4,
-	0x2c, 0x45, 0x8a, 0xe3, 0xb4, 0x27, 0xce, 0xbc, 0xf9, 0xde, 0xcf, 0xbc, 0xf7, 0xf6, 0xcd, 0xbc,
-	0x21, 0xcc, 0x39, 0x1e, 0xc5, 0x37, 0xb0, 0x5b, 0xf0, 0x19, 0x36, 0xd7, 0xe7, 0x71, 0xd3, 0x9e,
-	0xaf, 0x61, 0x46, 0x6e, 0xe0, 0x76, 0xb1, 0x49, 0x3d, 0xe6, 0xa1, 0x31, 0xc6, 0xdc, 0xa2, 0x04,
-	0x15, 0x37, 0x1e, 0x9b, 0x2e, 0xd7, 0x6c, 0x56, 0x6f, 0xad, 0x15, 0x4d, 0xaf, 0x31, 0x4f, 0xdc,
-	0x0d, 0xaf, 0xdd, 0xa4, 0xde, 0x66, 0x7b, 0x5e, 0x80, 0xcd, 0x42, 0x8d, 0xb8, 0x85, 0x0d, 0xec,
-	0xd8, 0x16, 0x66, 0x64, 0x7e, 0xcf, 0x20, 0x10, 0x39, 0x5d, 0x88, 0x89, 0xa8, 0x79, 0x35, 0x2f,
-	0x60, 0x5e, 0x6b, 0x55, 0xc5, 0x4c, 0x4c, 0xc4, 0x48, 0xc2, 0xd5, 0x9a, 0xe7, 0xd5, 0x1c, 0xd2,
-	0x45, 0x59, 0x2d, 0x8a, 0x99, 0xed, 0xb9, 0x72, 0x7d, 0xa6, 0x7f, 0xbd, 0x6a, 0x13, 0xc7, 0x32,
-	0x1a, 0xd8, 0x5f, 0x97, 0x88, 0x93, 0xfd, 0x08, 0x9f, 0xd1, 0x96, 0xc9, 0xe4, 0x6a, 0xae, 0x7f,
-	0x95, 0xd9, 0x0d, 0xe2, 0x33, 0xdc, 0x68, 0x4a, 0xc0, 0xe9, 0xbd, 0x3e, 0x32, 0x3d, 0x97, 0x61,
-	0x93, 0x19, 0xb6, 0x5b, 0x0d, 0xcd, 0x3c, 0xb5, 0x17, 0x45, 0xdc, 0x56, 0xc3, 0x97, 0xcb, 0x0f,
-	0xed, 0x5d, 0xb6, 0x2d, 0xe2, 0x32, 0xbb, 0x6a, 0x13, 0x1a, 0x82, 0x66, 0xf6, 0x82, 0x1a, 0x84,
-	0x61, 0x0b, 0x33, 0x1c, 0x3a, 0x63, 0x2f, 0x82, 0xda, 0xb5, 0x3a, 0x93, 0x12, 0xf2, 0xeb, 0x70,
-	0xe4, 0xe9, 0x20, 0x7e, 0x1a, 0xc5, 0xae, 0x85, 0xa6, 0x60, 0xd2, 0xb6, 0x14, 0x30, 0x03, 0x66,
-	0x87, 0xb5, 0xc1, 0xce, 0x56, 0x2e, 0x59, 0x59, 0xd6, 0x93, 0xb6, 0x85, 0x10, 0x1c, 0x70, 0x71,
-	0x83, 0x28, 0x49, 0xbe, 0xa2, 0x8b, 0x31, 0x3a, 0x0e, 0x53, 0x2d, 0xea, 0x28, 0x29, 0x01, 0x1e,
-	0xea, 0x6c, 0xe5, 0x52, 0xab, 0xfa, 0x45, 0x9d, 0xd3, 0xd0, 0x24, 0x4c, 0x3b, 0x5e, 0xcd, 0xf3,
-	0x95, 0x81, 0x99, 0xd4, 0xec, 0xb0, 0x1e, 0x4c, 0xf2, 0xef, 0x81, 0x48, 0xdb, 0x25, 0xcf, 0x22,
-	0x0e, 0xba, 0x04, 0x33, 0x6b, 0x5c, 0xad, 0x11, 0xe9, 0x2c, 0xed, 0x6a, 0xa7, 0x69, 0x5e, 0x39,
-	0x5d, 0x52, 0xaf, 0x5f, 0xc5, 0x85, 0x97, 0xbe, 0x5d, 0xf8, 0xfe, 0x8b, 0xb3, 0x8b, 0x0b, 0x57,
-	0x0b, 0x2f, 0x2e, 0x86, 0xd3, 0xb9, 0x97, 0x4b, 0x67, 0x5f, 0x39, 0xdd, 0xd9, 0xca, 0x0d, 0x09,
-	0x8b, 0x2b, 0xcb, 0xfa, 0x90, 0x90, 0x51, 0xb1, 0xd0, 0x39, 0x61, 0xbc, 0x30, 0x51, 0x2b, 0x1c,
-	0x5e, 0x50, 0xff, 0x1e, 0x53, 0xdd, 0x3d, 0xe6, 0x7f, 0x9d, 0x84, 0xc7, 0xa5, 0xc9, 0xcf, 0x11,
-	0xea, 0xdb, 0x9e, 0x5b, 0xe9, 0x46, 0xe1, 0xeb, 0xb6, 0xff, 0x12, 0xcc, 0x34, 0xb8, 0x5f, 0x8c,
-	0x68, 0x17, 0x47, 0x11, 0x27, 0x5c, 0xca, 0xc5, 0x09, 0x19, 0x15, 0x0b, 0xcd, 0xc1, 0x89, 0x3a,
-	0xa6, 0xd6, 0x0d, 0x4c, 0x89, 0xb1, 0x11, 0x18, 0x2f, 0xf7, 0x36, 0x1e, 0xd2, 0xe5, 0x9e, 0x38,
-	0xb4, 0x6a, 0xd3, 0x46, 0x0f, 0x74, 0x20, 0x80, 0x86, 0x74, 0x09, 0xcd, 0xff, 0x27, 0x19, 0x05,
-	0x51, 0xc7, 0x96, 0xed, 0xa1, 0x29, 0x38, 0x48, 0x5c, 0xbc, 0xe6, 0x10, 0xe1, 0x82, 0x8c, 0x2e,
-	0x67, 0xe8, 0x04, 0x1c, 0x36, 0xeb, 0x76, 0xd3, 0x60, 0xed, 0x66, 0x98, 0x37, 0x19, 0x4e, 0xb8,
-	0xd2, 0x6e, 0x12, 0x74, 0x12, 0x0e, 0x57, 0x29, 0xf9, 0x59, 0x8b, 0xb8, 0x66, 0x5b, 0x18, 0x35,
-	0xa0, 0x77, 0x09, 0x68, 0x1e, 0x66, 0xa9, 0xef, 0xdb, 0x86, 0x57, 0xad, 0xfa, 0x84, 0x09, 0x4b,
-	0x92, 0xda, 0x58, 0x67, 0x2b, 0x07, 0xf5, 0x95, 0x95, 0xca, 0xb3, 0x82, 0xaa, 0x43, 0x0e, 0x09,
-	0xc6, 0xe8, 0x79, 0x38, 0xc1, 0x36, 0x0d, 0xd3, 0x73, 0xab, 0x76, 0x4d, 0x7e, 0xed, 0x4a, 0x7a,
-	0x06, 0xcc, 0x66, 0x4b, 0x67, 0x8b, 0xbd, 0x05, 0xa9, 0x18, 0xb7, 0xbd, 0x78, 0x65, 0x73, 0x29,
-	0xce, 0xa3, 0x8f, 0xb3, 0x5e, 0xc2, 0xf4, 0x6b, 0x00, 0x8e, 0xf7, 0x81, 0xd0, 0x43, 0x70, 0xb4,
-	0x61, 0xbb, 0x46, 0xd7, 0x7e, 0x20, 0xec, 0x1f, 0x69, 0xd8, 0xee, 0x85, 0x68, 0x0b, 0x1c, 0x84,
-	0x37, 0x63, 0xa0, 0xa4, 0x04, 0xe1, 0xcd, 0x2e, 0xe8, 0x51, 0x38, 0xee, 0x7a, 0xcc, 0xac, 0x1b,
-	0xfd, 0xbe, 0x18, 0x13, 0xe4, 0x08, 0x98, 0xff, 0x08, 0xc0, 0xb1, 0xde, 0x34, 0x44, 0x97, 0x60,
-	0xca, 0xb6, 0x7c, 0xa1, 0x3b, 0x5b, 0x9a, 0xdb, 0x67, 0x97, 0x7b, 0x73, 0x56, 0x9b, 0xd8, 0xd5,
-	0xd2, 0x6f, 0x80, 0xe4, 0x04, 0xf8, 0x70, 0x2b, 0x97, 0xb8, 0xbd, 0x95, 0x03, 0x3a, 0x97, 0xc3,
-	0xa3, 0xd8, 0xac, 0x7b, 0xcc, 0xf3, 0x95, 0xa4, 0xf8, 0x64, 0xe5, 0x0c, 0x3d, 0x0e, 0x07, 0x29,
-	0x77, 0x95, 0xaf, 0xa4, 0x66, 0x52, 0xb3, 0xd9, 0xd2, 0xc9, 0x83, 0xfc, 0xa9, 0x4b, 0x2c, 0x7a,
-	0x10, 0x8e, 0x98, 0x8e, 0x67, 0xae, 0x1b, 0xbe, 0xd7, 0xa2, 0x26, 0x51, 0x86, 0x66, 0xc0, 0xec,
-	0xa8, 0x9e, 0x15, 0xb4, 0x15, 0x41, 0x5a, 0x18, 0x78, 0xff, 0xed, 0x5c, 0x22, 0xff, 0xce, 0x08,
-	0x1c, 0x92, 0x12, 0xd0, 0x85, 0xf8, 0x8e, 0xf2, 0xfb, 0xe8, 0x39, 0xc4, 0x56, 0x96, 0x20, 0x34,
-	0x29, 0xc1, 0x8c, 0x58, 0x06, 0x66, 0xc2, 0xef, 0xd9, 0xd2, 0x74, 0x31, 0xa8, 0xda, 0xc5, 0xb0,
-	0x6a, 0x17, 0xaf, 0x84, 0x55, 0x5b, 0xcb, 0x70, 0xf6, 0x37, 0x3f, 0xc9, 0x01, 0x7d, 0x58, 0xf2,
-	0x95, 0x19, 0x17, 0xd2, 0x6a, 0x5a, 0xa1, 0x90, 0xd4, 0x51, 0x84, 0x48, 0xbe, 0x32, 0x43, 0x27,
-	0x64, 0x45, 0x19, 0x08, 0x4a, 0xe4, 0xae, 0x36, 0x40, 0x93, 0x4a, 0x49, 0x96, 0xcf, 0x33, 0x30,
-	0x6b, 0x11, 0xdf, 0xa4, 0x76, 0x33, 0x4a, 0xd7, 0x61, 0x2d, 0xb3, 0xab, 0xa5, 0x69, 0x4a, 0xb9,
-	0x3d, 0xae, 0xc7, 0x17, 0x51, 0x0b, 0x42, 0xcc, 0x18, 0xb5, 0xd7, 0x5a, 0x8c, 0xf8, 0xca, 0xa0,
-	0x88, 0xc4, 0xa3, 0xfb, 0x78, 0xa8, 0x58, 0x8e, 0x90, 0xe7, 0x5d, 0x46, 0xdb, 0xda, 0xd9, 0x5d,
-	0x6d, 0xee, 0x37, 0xe0, 0x91, 0xfc, 0xa1, 0x2a, 0x89, 0x1e, 0x53, 0x84, 0x9e, 0x84, 0x23, 0xf1,
-	0x93, 0x4b, 0x19, 0x12, 0x8a, 0x4f, 0xf4, 0x2b, 0x5e, 0x0a, 0x30, 0x15, 0xb7, 0xea, 0xe9, 0x59,
-	0xb3, 0x3b, 0x41, 0xd7, 0x60, 0x56, 0x56, 0x13, 0x83, 0x47, 0x36, 0x73, 0xf7, 0xb9, 0x0a, 0x37,
-	0x42, 0x94, 0x8f, 0xfe, 0x06, 0xe0, 0x94, 0xbc, 0x7c, 0x18, 0x3e, 0xa1, 0x1b, 0x84, 0x1a, 0xd8,
-	0xb2, 0x28, 0xf1, 0x7d, 0x65, 0x58, 0x38, 0xf3, 0x57, 0x60, 0x57, 0x7b, 0x03, 0xd0, 0x5f, 0x80,
-	0xd2, 0x6b, 0xe0, 0xfa, 0xec, 0xe2, 0x02, 0xdf, 0x30, 0x2e, 0xbc, 0x54, 0x2e, 0xbc, 0xc0, 0xf7,
-	0x7b, 0x33, 0x36, 0xee, 0x0e, 0xaf, 0x15, 0x5e, 0x3c, 0x13, 0x5b, 0x98, 0xbb, 0x56, 0x9c, 0x3b,
-	0xc3, 0xf9, 0xca, 0x85, 0x17, 0xa4, 0x9f, 0x6e, 0xc6, 0xc6, 0xdd, 0xa1, 0xe0, 0xeb, 0x2e, 0xcc,
-	0xcd, 0x2e, 0x2e, 0x2c, 0x5c, 0xe5, 0xa3, 0x97, 0xbf, 0x73, 0xf6, 0x89, 0x57, 0xe6, 0x16, 0x4f,
-	0xdf, 0xbc, 0x7e, 0x5a, 0x9f, 0x94, 0xe6, 0xae, 0x08, 0x6b, 0xcb, 0x81, 0xb1, 0x28, 0x07, 0xb3,
-	0xb8, 0xc5, 0x3c, 0x23, 0xc8, 0x1b, 0x05, 0x8a, 0x2a, 0x0a, 0x39, 0x69, 0x55, 0x50, 0xd0, 0xc3,
-	0x70, 0x2c, 0x58, 0x33, 0xcc, 0x3a, 0x76, 0x5d, 0xe2, 0x28, 0x59, 0x51, 0x4e, 0x47, 0x03, 0xea,
-	0x52, 0x40, 0x44, 0x17, 0xe0, 0xb1, 0xa8, 0x8e, 0x18, 0x4d, 0x07, 0x73, 0xa7, 0x2b, 0x23, 0xc2,
-	0x13, 0xd3, 0x41, 0xea, 0x3d, 0xd5, 0xd9, 0xca, 0x8d, 0x47, 0x55, 0xe5, 0xb2, 0x83, 0xdd, 0xca,
-	0xb2, 0x3e, 0x5e, 0xed, 0x21, 0x58, 0xe8, 0x39, 0x88, 0xf6, 0xc8, 0xf1, 0x95, 0x49, 0x5e, 0x16,
-	0xb4, 0xd9, 0x5d, 0x2d, 0x7d, 0x0b, 0x24, 0x27, 0x32, 0xbb, 0xda, 0xf0, 0x2d, 0x30, 0x98, 0x0f,
-	0xa5, 0x4e, 0xf4, 0x49, 0xf5, 0xf5, 0x89, 0x3e, 0xb1, 0x3e, 0x7a, 0x0a, 0x66, 0xb0, 0xcb, 0x88,
-	0xeb, 0x62, 0x5f, 0x19, 0x15, 0x99, 0xa4, 0xee, 0x93, 0x0a, 0xe5, 0x00, 0xa6, 0x0d, 0xf0, 0xb8,
-	0xeb, 0x11, 0x17, 0x2f, 0xaa, 0x3e, 0xc3, 0xac, 0xe5, 0x1b, 0xcd, 0xd6, 0x9a, 0x63, 0x9b, 0xca,
-	0x98, 0xf0, 0xd5, 0x48, 0x40, 0xbc, 0x2c, 0x68, 0xbc, 0xa8, 0x3a, 0x9e, 0x29, 0x4a, 0x75, 0x08,
-	0x1b, 0x17, 0xb0, 0xb1, 0x90, 0x2c, 0x81, 0x8f, 0xc3, 0x29, 0xdf, 0xac, 0x13, 0xab, 0xe5, 0x10,
-	0xc3, 0xf2, 0x6e, 0xb8, 0x8e, 0xed, 0xae, 0x1b, 0x0e, 0x0f, 0xc1, 0x84, 0xc0, 0x4f, 0x86, 0xab,
-	0xcb, 0x72, 0xf1, 0x22, 0x0f, 0xc6, 0x59, 0x88, 0x88, 0x5b, 0xf5, 0xa8, 0x49, 0x0c, 0xab, 0xc5,
-	0xda, 0x86, 0xd9, 0x36, 0x1d, 0xa2, 0x1c, 0x13, 0x1c, 0x13, 0x72, 0x65, 0xb9, 0xc5, 0xda, 0x4b,
-	0x9c, 0x8e, 0x7e, 0x0a, 0x95, 0x48, 0x74, 0x13, 0xb3, 0x3a, 0x3f, 0xa3, 0x7c, 0x46, 0xb1, 0xed,
-	0x32, 0x05, 0xcd, 0x80, 0xd9, 0xb1, 0xd2, 0x23, 0xfd, 0x3e, 0x08, 0xb5, 0x5d, 0xc6, 0xac, 0xbe,
-	0x14, 0xa1, 0x45, 0x65, 0xf8, 0x39, 0xff, 0x16, 0xf4, 0x29, 0xeb, 0x8e, 0x08, 0xf4, 0x93, 0xd8,
-	0x7e, 0xb0, 0xdb, 0xe6, 0xd7, 0x52, 0xc3, 0x22, 0x0e, 0x6e, 0x2b, 0xf7, 0x89, 0x0f, 0xef, 0xf8,
-	0x9e, 0xf2, 0xb5, 0x2c, 0x8f, 0x34, 0x51, 0xbd, 0xc0, 0x5b, 0xbc, 0x7a, 0x45, 0x9b, 0x2e, 0x07,
-	0x12, 0x96, 0xb9, 0x00, 0x74, 0x0e, 0x9e, 0x90, 0x19, 0x18, 0xb9, 0xb6, 0x4a, 0xbd, 0x86, 0x11,
-	0x38, 0x5e, 0xb9, 0x5f, 0xec, 0x5e, 0x09, 0x20, 0x17, 0x25, 0xe2, 0x02, 0xf5, 0x1a, 0x2b, 0x62,
-	0x7d, 0xfa, 0x1c, 0x1c, 0xef, 0x2b, 0x4a, 0x68, 0x02, 0xa6, 0xd6, 0x49, 0x70, 0x74, 0x0e, 0xeb,
-	0x7c, 0xc8, 0xef, 0x8c, 0x1b, 0xd8, 0x69, 0x85, 0x77, 0x85, 0x60, 0xb2, 0x90, 0xfc, 0x1e, 0xc8,
-	0x2f, 0xc2, 0x8c, 0x4c, 0x0c, 0x1f, 0x3d, 0x06, 0x33, 0xf2, 0x23, 0xe2, 0x27, 0x05, 0x4f, 0xa2,
-	0x07, 0xf6, 0x3b, 0x91, 0x22, 0x60, 0xfe, 0x8f, 0x00, 0x1e, 0x7b, 0x9a, 0xb0, 0x70, 0x81, 0xe7,
-	0xa5, 0xcf, 0xd0, 0x2a, 0xcc, 0x86, 0xe5, 0xe3, 0x6e, 0xcf, 0x1d, 0x58, 0x0b, 0x51, 0x3e, 0x5a,
-	0x84, 0xb0, 0xdb, 0x51, 0xec, 0x7b, 0xfc, 0x5c, 0xe0, 0x90, 0x4b, 0xd8, 0x5f, 0x97, 0x49, 0x3e,
-	0x5c, 0x0d, 0x09, 0xf9, 0x36, 0xcc, 0x77, 0x8d, 0x8d, 0xe9, 0xbd, 0xe0, 0xd1, 0xf3, 0xab, 0x95,
-	0xd0, 0xfa, 0x15, 0x98, 0x22, 0x2d, 0x5b, 0x58, 0x3d, 0xa2, 0x95, 0xb9, 0x8c, 0x7f, 0x6e, 0xe5,
-	0x4a, 0x35, 0xaf, 0xc8, 0xea, 0x84, 0xd5, 0x6d, 0xb7, 0xe6, 0x17, 0x5d, 0xc2, 0x6e, 0x78, 0x74,
-	0x7d, 0xbe, 0xb7, 0x07, 0x68, 0xae, 0xd7, 0xe6, 0xf9, 0x9d, 0xcc, 0x2f, 0x9e, 0x5f, 0xad, 0x7c,
-	0xf7, 0x71, 0x7e, 0x6f, 0xe7, 0x62, 0xb9, 0xb4, 0xfc, 0x47, 0x49, 0x78, 0xdf, 0x45, 0xdb, 0x0f,
-	0x95, 0xfb, 0xa1, 0xb2, 0x1f, 0xf3, 0x83, 0xc0, 0x71, 0xf0, 0x9a, 0x47, 0x31, 0xf3, 0xa8, 0xf4,
-	0x55, 0xa1, 0xdf, 0x57, 0xcf, 0xd2, 0x1a, 0x76, 0xed, 0x97, 0x44, 0xf8, 0x9f, 0xa5, 0xab, 0x3e,
-	0xa1, 0x31, 0xf3, 0xf5, 0x1e, 0x11, 0x77, 0xed, 0x26, 0x74, 0x03, 0xa6, 0x3d, 0x6a, 0x11, 0x2a,
-	0x1b, 0x10, 0xbc, 0xab, 0x5d, 0xa7, 0xd7, 0xf4, 0x44, 0x14, 0x0b, 0xc3, 0xb6, 0xf4, 0x6c, 0x21,
-	0x3e, 0x09, 0xc7, 0xa4, 0x65, 0xeb, 0x23, 0x85, 0xf8, 0x4c, 0x9c, 0xc8, 0x7a, 0xba, 0x20, 0x7e,
-	0x62, 0xb7, 0x07, 0x3d, 0x5b, 0x88, 0x4d, 0x02, 0x7d, 0x48, 0x85, 0x69, 0xc7, 0x6e, 0xd8, 0xc1,
-	0xbd, 0x74, 0x54, 0x7c, 0x98, 0x67, 0x52, 0xca, 0x67, 0x43, 0x7a, 0x40, 0xe6, 0x7d, 0x44, 0x13,
-	0xd7, 0x88, 0x38, 0xd1, 0x47, 0x75, 0x31, 0xce, 0xff, 0x15, 0xc0, 0xc9, 0x25, 0x21, 0xa9, 0x2f,
-	0x09, 0x97, 0xe0, 0x90, 0x34, 0x44, 0x3a, 0x75, 0xbf, 0x74, 0xbe, 0x43, 0xd6, 0x85, 0x9c, 0xc8,
-	0xe8, 0x0b, 0x4f, 0xf2, 0x2b, 0x84, 0x47, 0x1b, 0x89, 0xcb, 0xef, 0x0d, 0x56, 0xfe, 0xb7, 0x00,
-	0x4e, 0x06, 0x87, 0xd1, 0xbd, 0x30, 0xff, 0xae, 0xbf, 0x98, 0xdf, 0x03, 0x78, 0x3c, 0x96, 0xb6,
-	0xe5, 0xcb, 0x95, 0x67, 0x48, 0x37, 0x79, 0xef, 0xd1, 0x77, 0x1e, 0xa5, 0x41, 0xf2, 0xe0, 0x34,
-	0x48, 0xc5, 0xd2, 0xe0, 0x16, 0x80, 0x0f, 0x74, 0xbf, 0xed, 0xc0, 0xce, 0x7b, 0x6c, 0xe6, 0x0c,
-	0x1c, 0x5c, 0x27, 0xed, 0x6e, 0x4b, 0x39, 0xdc, 0xd9, 0xca, 0xa5, 0x9f, 0x21, 0xed, 0xca, 0xb2,
-	0x9e, 0x5e, 0x27, 0xed, 0x8a, 0x95, 0xff, 0x07, 0x80, 0xd3, 0x3d, 0xb9, 0xf9, 0x8d, 0xd8, 0x75,
-	0x22, 0xfe, 0xa2, 0xd0, 0x7f, 0x37, 0xfe, 0x21, 0x1c, 0x0c, 0x9e, 0x29, 0x44, 0xd7, 0x31, 0x56,
-	0xba, 0xbf, 0x5f, 0x9d, 0xce, 0x57, 0xb5, 0xd1, 0x5d, 0x0d, 0xde, 0x02, 0x43, 0x79, 0x79, 0x30,
-	0x4a, 0x9e, 0xfc, 0x5f, 0x00, 0x9c, 0xee, 0xc9, 0xd6, 0x6f, 0x64, 0x43, 0x65, 0x38, 0x84, 0x9b,
-	0xb6, 0xc1, 0x4f, 0xb5, 0x20, 0x85, 0xa7, 0xfa, 0x45, 0x06, 0x66, 0xdc, 0x41, 0xcc, 0x20, 0x6e,
-	0xda, 0xcf, 0x90, 0x76, 0xfe, 0x4f, 0x00, 0xe6, 0x62, 0x79, 0xbc, 0x14, 0xfb, 0x04, 0xff, 0x1f,
-	0xb3, 0xf9, 0x5f, 0x00, 0x9e, 0xea, 0x66, 0x73, 0xdc, 0xda, 0x7b, 0x6c, 0xac, 0xf9, 0x75, 0xd4,
-	0xbb, 0xbd, 0x2a, 0x7a, 0x6b, 0xde, 0xdf, 0x01, 0x3c, 0xb5, 0xf2, 0xbf, 0xd8, 0xdd, 0x8f, 0xee,
-	0xb8, 0xbb, 0x93, 0x7b, 0xbb, 0xae, 0x2e, 0xe6, 0xc0, 0xe2, 0xfd, 0xbb, 0x64, 0xf4, 0x78, 0x20,
-	0x2f, 0xd6, 0x3c, 0x9a, 0x35, 0x6c, 0xbb, 0xc2, 0xe4, 0xa4, 0x2e, 0xc6, 0x48, 0x83, 0x99, 0xf0,
-	0x72, 0x27, 0x55, 0x2a, 0xfd, 0x2a, 0xc3, 0xab, 0x5d, 0x9f, 0xba, 0x88, 0x0f, 0xdd, 0xec, 0xe9,
-	0x53, 0x83, 0x17, 0x83, 0xe2, 0xc1, 0x97, 0xfc, 0xaf, 0xaf, 0x5d, 0xbd, 0xdb, 0x6b, 0xe6, 0x2f,
-	0xd3, 0x70, 0x54, 0xda, 0x16, 0xdc, 0x5b, 0xd1, 0x53, 0x70, 0x80, 0xdf, 0x81, 0x65, 0x64, 0x0f,
-	0x6a, 0xff, 0x79, 0x44, 0xff, 0x0c, 0x92, 0x19, 0x10, 0x3d, 0x03, 0x08, 0x4e, 0x54, 0x86, 0xc3,
-	0x6b, 0x9e, 0xc7, 0x0c, 0x21, 0xe6, 0x28, 0x4f, 0x11, 0x19, 0xce, 0xc6, 0x17, 0x50, 0x0b, 0x66,
-	0x64, 0xd3, 0x1b, 0x7a, 0xf4, 0x5b, 0xfb, 0x78, 0x34, 0xb0, 0xba, 0x28, 0x1b, 0xe9, 0xaf, 0xe4,
-	0xce, 0x48, 0x15, 0x3a, 0x0f, 0x8f, 0xc9, 0xbe, 0x2b, 0xba, 0xf3, 0x07, 0xcf, 0xb9, 0x07, 0xe4,
-	0x85, 0x3e, 0x21, 0x59, 0x42, 0x82, 0x2f, 0x1e, 0x94, 0x9b, 0x4a, 0x5a, 0x34, 0x8f, 0xc1, 0x83,
-	0xf2, 0x65, 0x3d, 0x69, 0x37, 0x11, 0x85, 0x43, 0x0d, 0xc2, 0xa8, 0x6d, 0x86, 0xcf, 0x19, 0x67,
-	0x0e, 0xde, 0xd4, 0xa5, 0x00, 0xfc, 0x55, 0xf6, 0x14, 0x2a, 0xe2, 0xbd, 0x03, 0xb6, 0x36, 0xb0,
-	0x6b, 0x12, 0x4b, 0x31, 0xe5, 0x6d, 0xa5, 0x3f, 0x16, 0x2b, 0xe2, 0xa9, 0x5f, 0x8f, 0x80, 0xd3,
-	0x3f, 0x80, 0xa3, 0x3d, 0x0e, 0x3d, 0x4a, 0x4a, 0x4d, 0x2f, 0xc0, 0x91, 0xb8, 0xe1, 0x5f, 0xc6,
-	0x9b, 0x8c, 0xa7, 0xe3, 0x27, 0x83, 0x70, 0x2a, 0x2a, 0x3e, 0xae, 0x4b, 0x4c, 0xee, 0x50, 0xee,
-	0x0d, 0x1f, 0x2d, 0x89, 0x77, 0x19, 0x4e, 0x0a, 0x9e, 0xa7, 0xbe, 0x3c, 0x3f, 0x07, 0x44, 0x52,
-	0x65, 0x23, 0xae, 0x32, 0x43, 0xd3, 0x30, 0x13, 0xfc, 0x0b, 0xe3, 0x39, 0xe1, 0xf3, 0x6c, 0x38,
-	0x47, 0xcf, 0xc3, 0x07, 0x1c, 0xec, 0x33, 0xd9, 0xdf, 0x19, 0x94, 0x98, 0xc4, 0xde, 0x38, 0xec,
-	0x53, 0x58, 0xa0, 0x6b, 0x92, 0x0b, 0x08, 0x82, 0xa7, 0x4b, 0xf6, 0x32, 0x43, 0x4f, 0xc2, 0x6c,
-	0x4c, 0xb0, 0xb8, 0x41, 0x67, 0x4b, 0xa7, 0x0e, 0x0c, 0xbd, 0x0e, 0xbb, 0x92, 0x22, 0xc3, 0x5a,
-	0x4d, 0xd1, 0x52, 0xc7, 0x0d, 0x4b, 0x1f, 0xc5, 0xb0, 0x55, 0xc1, 0x1f, 0x33, 0xec, 0x41, 0x38,
-	0x22, 0x65, 0x9a, 0x5e, 0xcb, 0x65, 0xca, 0xa0, 0x78, 0x87, 0xcd, 0x06, 0xb4, 0x25, 0x4e, 0x42,
-	0x57, 0xe1, 0x71, 0xa1, 0x3b, 0x6a, 0xe8, 0xe3, 0xda, 0x87, 0x0e, 0xa9, 0x7d, 0x8a, 0x8b, 0x08,
-	0x5b, 0xfc, 0x98, 0xfe, 0x87, 0xe1, 0x58, 0x24, 0x37, 0xb0, 0x20, 0x23, 0x2c, 0x18, 0x0d, 0xa9,
-	0x81, 0x0d, 0x06, 0x9c, 0xa0, 0x5e, 0xcb, 0xb5, 0x0c, 0x46, 0xed, 0xa6, 0xa8, 0x2a, 0xc1, 0x63,
-	0x57, 0xb6, 0xf4, 0xc4, 0x3e, 0x4e, 0xec, 0xcb, 0x9d, 0xa2, 0xce, 0xd9, 0xaf, 0x50, 0xbb, 0x29,
-	0x2c, 0xd3, 0xc7, 0x68, 0xcf, 0x7c, 0xfa, 0xdf, 0x00, 0x8e, 0xf5, 0x42, 0xd0, 0x39, 0x98, 0x6a,
-	0xc8, 0xb3, 0xe2, 0xc0, 0x47, 0x04, 0x5e, 0x03, 0xff, 0x10, 0xd6, 0x40, 0xf1, 0x98, 0xc0, 0xf9,
-	0x04, 0x3b, 0xde, 0x94, 0xc5, 0xef, 0x88, 0xec, 0x78, 0x13, 0x2d, 0xc1, 0xc1, 0x06, 0xb1, 0x6c,
-	0xec, 0xca, 0xcc, 0x3b, 0x92, 0x04, 0xc9, 0xca, 0xbf, 0xb2, 0xc0, 0xa9, 0xa2, 0x65, 0xd3, 0x83,
-	0x89, 0xf6, 0x0e, 0xf8, 0x70, 0x5b, 0x05, 0xb7, 0xb7, 0x55, 0xf0, 0xf1, 0xb6, 0x9a, 0xf8, 0x74,
-	0x5b, 0x4d, 0x7c, 0xb6, 0xad, 0x26, 0x3e, 0xdf, 0x56, 0x13, 0x5f, 0x6c, 0xab, 0xe0, 0xd5, 0x8e,
-	0x0a, 0x5e, 0xef, 0xa8, 0x89, 0x77, 0x3b, 0x2a, 0x78, 0xaf, 0xa3, 0x26, 0xde, 0xef, 0xa8, 0x89,
-	0x0f, 0x3a, 0x6a, 0xe2, 0xc3, 0x8e, 0x0a, 0x6e, 0x77, 0x54, 0xf0, 0x71, 0x47, 0x4d, 0x7c, 0xda,
-	0x51, 0xc1, 0x67, 0x1d, 0x35, 0xf1, 0x79, 0x47, 0x05, 0x5f, 0x74, 0xd4, 0xc4, 0xab, 0x3b, 0x6a,
-	0xe2, 0xf5, 0x1d, 0x15, 0xbc, 0xb9, 0xa3, 0x26, 0xde, 0xda, 0x51, 0xc1, 0xdb, 0x3b, 0x6a, 0xe2,
-	0xdd, 0x1d, 0x35, 0xf1, 0xde, 0x8e, 0x0a, 0xde, 0xdf, 0x51, 0xc1, 0x07, 0x3b, 0x2a, 0x78, 0xe1,
-	0xec, 0x61, 0x9b, 0x74, 0xe6, 0x36, 0xd7, 0xd6, 0x06, 0xc5, 0x3e, 0x1f, 0xfb, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x34, 0x25, 0xe6, 0x3c, 0x7a, 0x1d, 0x00, 0x00,
+	// 2651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6c, 0x1b, 0xc7,
+	0xd5, 0xe7, 0x90, 0x92, 0x48, 0x0d, 0x29, 0x8a, 0x9e, 0x28, 0xca, 0x5a, 0xb6, 0x97, 0x0a, 0xe3,
+	0x24, 0x92, 0x3f, 0x93, 0xfa, 0xca, 0x24, 0x45, 0xeb, 0xd6, 0x51, 0x48, 0xc9, 0x36, 0x88, 0xd8,
+	0x8d, 0xba, 0xb2, 0x1a, 0x34, 0x76, 0xbc, 0x18, 0xed, 0x0e, 0xa9, 0xad, 0x96, 0xbb, 0xec, 0xec,
+	0x50, 0x12, 0x13, 0x07, 0x08, 0x8a, 0x00, 0x0d, 0x82, 0xa2, 0x0d, 0x7c, 0x0a, 0x8a, 0x1c, 0x82,
+	0x02, 0x2d, 0x82, 0xb6, 0x87, 0xa0, 0x87, 0x22, 0x87, 0x1e, 0x72, 0x69, 0x91, 0xa3, 0x4f, 0x45,
+	0xd0, 0x02, 0x4a, 0x44, 0x5d, 0xd2, 0x5b, 0xd0, 0x53, 0xa0, 0x53, 0x31, 0xb3, 0xb3, 0xcb, 0x25,
+	0x65, 0x29, 0xb2, 0x1d, 0xa7, 0x3d, 0x71, 0xe7, 0xcd, 0xef, 0xfd, 0x99, 0x37, 0x6f, 0xde, 0xbc,
+	0x79, 0x84, 0x79, 0xdb, 0xa5, 0x78, 0x13, 0x3b, 0x45, 0x8f, 0x61, 0x63, 0x7d, 0x0e, 0xb7, 0xac,
+	0xb9, 0x06, 0x66, 0x64, 0x13, 0x77, 0x4a, 0x2d, 0xea, 0x32, 0x17, 0x65, 0x19, 0x73, 0x4a, 0x12,
+	0x54, 0xda, 0x78, 0x6a, 0xaa, 0xd2, 0xb0, 0xd8, 0x5a, 0x7b, 0xb5, 0x64, 0xb8, 0xcd, 0x39, 0xe2,
+	0x6c, 0xb8, 0x9d, 0x16, 0x75, 0xb7, 0x3a, 0x73, 0x02, 0x6c, 0x14, 0x1b, 0xc4, 0x29, 0x6e, 0x60,
+	0xdb, 0x32, 0x31, 0x23, 0x73, 0xfb, 0x3e, 0x7c, 0x91, 0x53, 0xc5, 0x88, 0x88, 0x86, 0xdb, 0x70,
+	0x7d, 0xe6, 0xd5, 0x76, 0x5d, 0x8c, 0xc4, 0x40, 0x7c, 0x49, 0xb8, 0xda, 0x70, 0xdd, 0x86, 0x4d,
+	0x7a, 0x28, 0xb3, 0x4d, 0x31, 0xb3, 0x5c, 0x47, 0xce, 0x4f, 0x0f, 0xce, 0xd7, 0x2d, 0x62, 0x9b,
+	0x7a, 0x13, 0x7b, 0xeb, 0x12, 0x71, 0x72, 0x10, 0xe1, 0x31, 0xda, 0x36, 0x98, 0x9c, 0xcd, 0x0f,
+	0xce, 0x32, 0xab, 0x49, 0x3c, 0x86, 0x9b, 0x2d, 0x09, 0x38, 0xbd, 0xdf, 0x47, 0x86, 0xeb, 0x30,
+	0x6c, 0x30, 0xdd, 0x72, 0xea, 0x81, 0x99, 0xa7, 0xf6, 0xa3, 0x88, 0xd3, 0x6e, 0x7a, 0x72, 0xfa,
+	0xb1, 0xfd, 0xd3, 0x96, 0x49, 0x1c, 0x66, 0xd5, 0x2d, 0x42, 0x03, 0xd0, 0xf4, 0x7e, 0x50, 0x93,
+	0x30, 0x6c, 0x62, 0x86, 0x03, 0x67, 0xec, 0x47, 0x50, 0xab, 0xb1, 0xc6, 0xa4, 0x84, 0xc2, 0x3a,
+	0xcc, 0x5c, 0xf2, 0xf7, 0xaf, 0x4a, 0xb1, 0x63, 0xa2, 0x49, 0x18, 0xb7, 0x4c, 0x05, 0x4c, 0x83,
+	0x99, 0xd1, 0xea, 0x48, 0x77, 0x3b, 0x1f, 0xaf, 0x2d, 0x6a, 0x71, 0xcb, 0x44, 0x08, 0x0e, 0x39,
+	0xb8, 0x49, 0x94, 0x38, 0x9f, 0xd1, 0xc4, 0x37, 0x3a, 0x0e, 0x13, 0x6d, 0x6a, 0x2b, 0x09, 0x01,
+	0x4e, 0x76, 0xb7, 0xf3, 0x89, 0x15, 0xed, 0xb2, 0xc6, 0x69, 0x68, 0x02, 0x0e, 0xdb, 0x6e, 0xc3,
+	0xf5, 0x94, 0xa1, 0xe9, 0xc4, 0xcc, 0xa8, 0xe6, 0x0f, 0x0a, 0x1f, 0x80, 0x50, 0xdb, 0x15, 0xd7,
+	0x24, 0x36, 0xba, 0x02, 0x53, 0xab, 0x5c, 0xad, 0x1e, 0xea, 0x2c, 0xef, 0x55, 0x4f, 0xd3, 0x82,
+	0x72, 0xba, 0xac, 0xde, 0xb8, 0x86, 0x8b, 0xaf, 0xfc, 0x7f, 0xf1, 0xbb, 0x2f, 0xcf, 0xcc, 0x9f,
+	0xbb, 0x56, 0x7c, 0x79, 0x3e, 0x18, 0xce, 0xbe, 0x5a, 0x3e, 0xfb, 0xda, 0xe9, 0xee, 0x76, 0x3e,
+	0x29, 0x2c, 0xae, 0x2d, 0x6a, 0x49, 0x21, 0xa3, 0x66, 0xa2, 0xf3, 0xc2, 0x78, 0x61, 0x62, 0xb5,
+	0x78, 0x74, 0x41, 0x83, 0x6b, 0x4c, 0xf4, 0xd6, 0x58, 0xf8, 0x55, 0x1c, 0x1e, 0x97, 0x26, 0xff,
+	0x88, 0x50, 0xcf, 0x72, 0x9d, 0x5a, 0x6f, 0x17, 0xbe, 0x6e, 0xfb, 0xaf, 0xc0, 0x54, 0x93, 0xfb,
+	0x45, 0x0f, 0x57, 0x71, 0x37, 0xe2, 0x84, 0x4b, 0xb9, 0x38, 0x21, 0xa3, 0x66, 0xa2, 0x59, 0x98,
+	0x5b, 0xc3, 0xd4, 0xdc, 0xc4, 0x94, 0xe8, 0x1b, 0xbe, 0xf1, 0x72, 0x6d, 0xe3, 0x01, 0x5d, 0xae,
+	0x89, 0x43, 0xeb, 0x16, 0x6d, 0xf6, 0x41, 0x87, 0x7c, 0x68, 0x40, 0x97, 0xd0, 0xc2, 0xbf, 0xe3,
+	0xe1, 0x26, 0x6a, 0xd8, 0xb4, 0x5c, 0x34, 0x09, 0x47, 0x88, 0x83, 0x57, 0x6d, 0x22, 0x5c, 0x90,
+	0xd2, 0xe4, 0x08, 0x9d, 0x80, 0xa3, 0xc6, 0x9a, 0xd5, 0xd2, 0x59, 0xa7, 0x15, 0xc4, 0x4d, 0x8a,
+	0x13, 0xae, 0x76, 0x5a, 0x04, 0x9d, 0x84, 0xa3, 0x75, 0x4a, 0x7e, 0xda, 0x26, 0x8e, 0xd1, 0x11,
+	0x46, 0x0d, 0x69, 0x3d, 0x02, 0x9a, 0x83, 0x69, 0xea, 0x79, 0x96, 0xee, 0xd6, 0xeb, 0x1e, 0x61,
+	0xc2, 0x92, 0x78, 0x35, 0xdb, 0xdd, 0xce, 0x43, 0x6d, 0x79, 0xb9, 0xf6, 0x82, 0xa0, 0x6a, 0x90,
+	0x43, 0xfc, 0x6f, 0xf4, 0x22, 0xcc, 0xb1, 0x2d, 0xdd, 0x70, 0x9d, 0xba, 0xd5, 0x90, 0xa7, 0x5d,
+	0x19, 0x9e, 0x06, 0x33, 0xe9, 0xf2, 0xd9, 0x52, 0x7f, 0x42, 0x2a, 0x45, 0x6d, 0x2f, 0x5d, 0xdd,
+	0x5a, 0x88, 0xf2, 0x68, 0xe3, 0xac, 0x9f, 0x30, 0xf5, 0x06, 0x80, 0xe3, 0x03, 0x20, 0xf4, 0x18,
+	0x1c, 0x6b, 0x5a, 0x8e, 0xde, 0xb3, 0x1f, 0x08, 0xfb, 0x33, 0x4d, 0xcb, 0xb9, 0x18, 0x2e, 0x81,
+	0x83, 0xf0, 0x56, 0x04, 0x14, 0x97, 0x20, 0xbc, 0xd5, 0x03, 0x3d, 0x09, 0xc7, 0x1d, 0x97, 0x19,
+	0x6b, 0xfa, 0xa0, 0x2f, 0xb2, 0x82, 0x1c, 0x02, 0x0b, 0x7f, 0x07, 0x30, 0xdb, 0x1f, 0x86, 0xe8,
+	0x0a, 0x4c, 0x58, 0xa6, 0x27, 0x74, 0xa7, 0xcb, 0xb3, 0x07, 0xac, 0x72, 0x7f, 0xcc, 0x56, 0x73,
+	0x7b, 0xd5, 0xe1, 0xb7, 0x40, 0x3c, 0x07, 0x3e, 0xde, 0xce, 0xc7, 0x6e, 0x6f, 0xe7, 0x81, 0xc6,
+	0xe5, 0xf0, 0x5d, 0x6c, 0xad, 0xb9, 0xcc, 0xf5, 0x94, 0xb8, 0x38, 0xb2, 0x72, 0x84, 0x9e, 0x86,
+	0x23, 0x94, 0xbb, 0xca, 0x53, 0x12, 0xd3, 0x89, 0x99, 0x74, 0xf9, 0xe4, 0x61, 0xfe, 0xd4, 0x24,
+	0x16, 0x3d, 0x0a, 0x33, 0x86, 0xed, 0x1a, 0xeb, 0xba, 0xe7, 0xb6, 0xa9, 0x41, 0x94, 0xe4, 0x34,
+	0x98, 0x19, 0xd3, 0xd2, 0x82, 0xb6, 0x2c, 0x48, 0xe7, 0x86, 0x3e, 0x7c, 0x2f, 0x1f, 0x2b, 0xbc,
+	0x9b, 0x81, 0x49, 0x29, 0x01, 0x5d, 0x8c, 0xae, 0xa8, 0x70, 0x80, 0x9e, 0x23, 0x2c, 0x65, 0x01,
+	0x42, 0x83, 0x12, 0xcc, 0x88, 0xa9, 0x63, 0x26, 0xfc, 0x9e, 0x2e, 0x4f, 0x95, 0xfc, 0xac, 0x5d,
+	0x0a, 0xb2, 0x76, 0xe9, 0x6a, 0x90, 0xb5, 0xab, 0x29, 0xce, 0xfe, 0xf6, 0xa7, 0x79, 0xa0, 0x8d,
+	0x4a, 0xbe, 0x0a, 0xe3, 0x42, 0xda, 0x2d, 0x33, 0x10, 0x92, 0xb8, 0x1b, 0x21, 0x92, 0xaf, 0xc2,
+	0xd0, 0x09, 0x99, 0x51, 0x86, 0xfc, 0x14, 0xb9, 0x57, 0x1d, 0xa2, 0x71, 0xa5, 0x2c, 0xd3, 0xe7,
+	0x19, 0x98, 0x36, 0x89, 0x67, 0x50, 0xab, 0x15, 0x86, 0xeb, 0x68, 0x35, 0xb5, 0x57, 0x1d, 0xa6,
+	0x09, 0xe5, 0xf6, 0xb8, 0x16, 0x9d, 0x44, 0x6d, 0x08, 0x31, 0x63, 0xd4, 0x5a, 0x6d, 0x33, 0xe2,
+	0x29, 0x23, 0x62, 0x27, 0x9e, 0x3c, 0xc0, 0x43, 0xa5, 0x4a, 0x88, 0xbc, 0xe0, 0x30, 0xda, 0xa9,
+	0x9e, 0xdd, 0xab, 0xce, 0xfe, 0x1a, 0x3c, 0x51, 0x38, 0x52, 0x26, 0xd1, 0x22, 0x8a, 0xd0, 0xb3,
+	0x30, 0x13, 0xbd, 0xb9, 0x94, 0xa4, 0x50, 0x7c, 0x62, 0x50, 0xf1, 0x82, 0x8f, 0xa9, 0x39, 0x75,
+	0x57, 0x4b, 0x1b, 0xbd, 0x01, 0xba, 0x0e, 0xd3, 0x32, 0x9b, 0xe8, 0x7c, 0x67, 0x53, 0xf7, 0x1f,
+	0xab, 0x70, 0x23, 0x40, 0x79, 0xe8, 0xaf, 0x00, 0x4e, 0xca, 0xe2, 0x43, 0xf7, 0x08, 0xdd, 0x20,
+	0x54, 0xc7, 0xa6, 0x49, 0x89, 0xe7, 0x29, 0xa3, 0xc2, 0x99, 0xbf, 0x04, 0x7b, 0xd5, 0xb7, 0x00,
+	0xfd, 0x39, 0x28, 0xbf, 0x01, 0x6e, 0xcc, 0xcc, 0x9f, 0xe3, 0x0b, 0xc6, 0xc5, 0x57, 0x2a, 0xc5,
+	0x97, 0xf8, 0x7a, 0x6f, 0x46, 0xbe, 0x7b, 0x9f, 0xd7, 0x8b, 0x2f, 0x9f, 0x89, 0x4c, 0xcc, 0x5e,
+	0x2f, 0xcd, 0x9e, 0xe1, 0x7c, 0x95, 0xe2, 0x4b, 0xd2, 0x4f, 0x37, 0x23, 0xdf, 0xbd, 0x4f, 0xc1,
+	0xd7, 0x9b, 0x98, 0x9d, 0x99, 0x3f, 0x77, 0xee, 0x1a, 0xff, 0x7a, 0xf5, 0x5b, 0x67, 0x9f, 0x79,
+	0x6d, 0x76, 0xfe, 0xf4, 0xcd, 0x1b, 0xa7, 0xb5, 0x09, 0x69, 0xee, 0xb2, 0xb0, 0xb6, 0xe2, 0x1b,
+	0x8b, 0xf2, 0x30, 0x8d, 0xdb, 0xcc, 0xd5, 0xfd, 0xb8, 0x51, 0xa0, 0xc8, 0xa2, 0x90, 0x93, 0x56,
+	0x04, 0x05, 0x3d, 0x0e, 0xb3, 0xfe, 0x9c, 0x6e, 0xac, 0x61, 0xc7, 0x21, 0xb6, 0x92, 0x16, 0xe9,
+	0x74, 0xcc, 0xa7, 0x2e, 0xf8, 0x44, 0x74, 0x11, 0x1e, 0x0b, 0xf3, 0x88, 0xde, 0xb2, 0x31, 0x77,
+	0xba, 0x92, 0x11, 0x9e, 0x98, 0xf2, 0x43, 0xef, 0xb9, 0xee, 0x76, 0x7e, 0x3c, 0xcc, 0x2a, 0x4b,
+	0x36, 0x76, 0x6a, 0x8b, 0xda, 0x78, 0xbd, 0x8f, 0x60, 0xa2, 0x25, 0x88, 0xf6, 0xc9, 0xf1, 0x94,
+	0x09, 0x9e, 0x16, 0xaa, 0x85, 0xbd, 0x6a, 0xfa, 0x16, 0x48, 0xe5, 0x52, 0x85, 0x40, 0x5e, 0x6e,
+	0x40, 0x9e, 0xa7, 0xe5, 0x06, 0x04, 0x7a, 0xe8, 0x39, 0x98, 0xc2, 0x0e, 0x23, 0x8e, 0x83, 0x3d,
+	0x65, 0x4c, 0xc4, 0x90, 0x7a, 0x40, 0x10, 0x54, 0x7c, 0x58, 0x75, 0x88, 0xef, 0xb8, 0x16, 0x72,
+	0xf1, 0x74, 0xea, 0x31, 0xcc, 0xda, 0x9e, 0xde, 0x6a, 0xaf, 0xda, 0x96, 0xa1, 0x64, 0x85, 0x97,
+	0x32, 0x3e, 0x71, 0x49, 0xd0, 0x78, 0x3a, 0xb5, 0x5d, 0x43, 0x24, 0xe9, 0x00, 0x36, 0x2e, 0x60,
+	0xd9, 0x80, 0x2c, 0x81, 0x4f, 0xc3, 0x49, 0xcf, 0x58, 0x23, 0x66, 0xdb, 0x26, 0xba, 0xe9, 0x6e,
+	0x3a, 0xb6, 0xe5, 0xac, 0xeb, 0x36, 0x77, 0x7e, 0x4e, 0xe0, 0x27, 0x82, 0xd9, 0x45, 0x39, 0x79,
+	0x99, 0x6f, 0xc3, 0x59, 0x88, 0x88, 0x53, 0x77, 0xa9, 0x41, 0x74, 0xb3, 0xcd, 0x3a, 0xba, 0xd1,
+	0x31, 0x6c, 0xa2, 0x1c, 0x13, 0x1c, 0x39, 0x39, 0xb3, 0xd8, 0x66, 0x9d, 0x05, 0x4e, 0x47, 0x3f,
+	0x81, 0x4a, 0x28, 0xba, 0x85, 0xd9, 0x1a, 0xbf, 0x9d, 0x3c, 0x46, 0xb1, 0xe5, 0x30, 0x05, 0x4d,
+	0x83, 0x99, 0x6c, 0xf9, 0x89, 0x41, 0x1f, 0x04, 0xda, 0x96, 0x30, 0x5b, 0x5b, 0x08, 0xd1, 0x22,
+	0x27, 0xfc, 0x8c, 0x9f, 0x02, 0x6d, 0xd2, 0xbc, 0x23, 0x02, 0xfd, 0x38, 0xb2, 0x1e, 0xec, 0x74,
+	0x78, 0x41, 0xaa, 0x9b, 0xc4, 0xc6, 0x1d, 0xe5, 0x21, 0x71, 0xe4, 0x8e, 0xef, 0x4b, 0x5c, 0x8b,
+	0xf2, 0x32, 0x13, 0x79, 0x0b, 0xbc, 0xc3, 0xf3, 0x56, 0xb8, 0xe8, 0x8a, 0x2f, 0x61, 0x91, 0x0b,
+	0x40, 0xe7, 0xe1, 0x09, 0x19, 0x7b, 0xa1, 0x6b, 0xeb, 0xd4, 0x6d, 0xea, 0xbe, 0xe3, 0x95, 0x87,
+	0xc5, 0xea, 0x15, 0x1f, 0x72, 0x59, 0x22, 0x2e, 0x52, 0xb7, 0xb9, 0x2c, 0xe6, 0xa7, 0xce, 0xc3,
+	0xf1, 0x81, 0x74, 0x84, 0x72, 0x30, 0xb1, 0x4e, 0xfc, 0x4b, 0x73, 0x54, 0xe3, 0x9f, 0xbc, 0x5a,
+	0xdc, 0xc0, 0x76, 0x3b, 0xa8, 0x12, 0xfc, 0xc1, 0xb9, 0xf8, 0x77, 0x40, 0x61, 0x1e, 0xa6, 0x64,
+	0x60, 0x78, 0xe8, 0x29, 0x98, 0x92, 0xc7, 0x87, 0xdf, 0x11, 0x3c, 0x88, 0x1e, 0x39, 0xe8, 0x2e,
+	0x0a, 0x81, 0x85, 0x3f, 0x00, 0x78, 0xec, 0x12, 0x61, 0xc1, 0x04, 0x8f, 0x4b, 0x8f, 0xa1, 0x15,
+	0x98, 0x0e, 0x12, 0xc7, 0xfd, 0xde, 0x38, 0xb0, 0x11, 0xa0, 0x3c, 0x34, 0x0f, 0x61, 0xef, 0x2d,
+	0x71, 0xe0, 0xc5, 0x73, 0x91, 0x43, 0xae, 0x60, 0x6f, 0x5d, 0x06, 0xf9, 0x68, 0x3d, 0x20, 0x14,
+	0x3a, 0xb0, 0xd0, 0x33, 0x36, 0xa2, 0xf7, 0xa2, 0x4b, 0x2f, 0xac, 0xd4, 0x02, 0xeb, 0x97, 0x61,
+	0x82, 0xb4, 0x2d, 0x61, 0x75, 0xa6, 0x5a, 0xe1, 0x32, 0xfe, 0xb1, 0x9d, 0x2f, 0x37, 0xdc, 0x12,
+	0x5b, 0x23, 0x6c, 0xcd, 0x72, 0x1a, 0x5e, 0xc9, 0x21, 0x6c, 0xd3, 0xa5, 0xeb, 0x73, 0xfd, 0xd5,
+	0x7f, 0x6b, 0xbd, 0x31, 0xc7, 0xab, 0x31, 0xaf, 0x74, 0x61, 0xa5, 0xf6, 0xed, 0xa7, 0x79, 0xc5,
+	0xce, 0xc5, 0x72, 0x69, 0x85, 0x2f, 0xe2, 0xf0, 0xa1, 0xcb, 0x96, 0x17, 0x28, 0xf7, 0x02, 0x65,
+	0x3f, 0xe4, 0x57, 0x80, 0x6d, 0xe3, 0x55, 0x97, 0x62, 0xe6, 0x52, 0xe9, 0xab, 0xe2, 0xa0, 0xaf,
+	0x5e, 0xa0, 0x0d, 0xec, 0x58, 0xaf, 0x88, 0xed, 0x7f, 0x81, 0xae, 0x78, 0x84, 0x46, 0xcc, 0xd7,
+	0xfa, 0x44, 0xdc, 0xb7, 0x9b, 0xd0, 0x26, 0x1c, 0x76, 0xa9, 0x49, 0xa8, 0x7c, 0x7a, 0xe0, 0xbd,
+	0xea, 0x0d, 0x7a, 0x5d, 0x8b, 0x85, 0x7b, 0xa1, 0x5b, 0xa6, 0x96, 0x2e, 0x46, 0x07, 0xc1, 0x37,
+	0x69, 0x5b, 0x5a, 0xa6, 0x18, 0x1d, 0x89, 0xbb, 0x58, 0x1b, 0x2e, 0x8a, 0x9f, 0x48, 0xdd, 0xa0,
+	0xa5, 0x8b, 0x91, 0x81, 0xaf, 0x0f, 0xa9, 0x70, 0xd8, 0xb6, 0x9a, 0x96, 0x5f, 0x91, 0x8e, 0x89,
+	0x83, 0x79, 0x26, 0xa1, 0x7c, 0x9e, 0xd4, 0x7c, 0x32, 0x7f, 0x41, 0xb4, 0x70, 0x83, 0x88, 0xbb,
+	0x7c, 0x4c, 0x13, 0xdf, 0x48, 0x81, 0x49, 0x93, 0xd8, 0x84, 0x11, 0x53, 0x19, 0x11, 0x87, 0x25,
+	0x18, 0x16, 0xfe, 0x02, 0xe0, 0xc4, 0x82, 0xd0, 0x31, 0x10, 0x9e, 0x0b, 0x30, 0x29, 0x4d, 0x94,
+	0xee, 0x3e, 0x28, 0xd0, 0xef, 0x10, 0x8f, 0x01, 0x27, 0xd2, 0x07, 0x36, 0x2e, 0x7e, 0x0f, 0x1b,
+	0x57, 0xcd, 0x44, 0xe5, 0xf7, 0x6f, 0x63, 0xe1, 0x5d, 0x00, 0x27, 0xfc, 0x0b, 0xea, 0x41, 0x98,
+	0x7f, 0xdf, 0x67, 0xe9, 0x77, 0x00, 0x1e, 0x8f, 0x04, 0x74, 0x65, 0xa9, 0xf6, 0x3c, 0xe9, 0x78,
+	0x0f, 0x38, 0x03, 0x84, 0x01, 0x12, 0x3f, 0x3c, 0x40, 0x12, 0xbd, 0x00, 0x29, 0xdc, 0x02, 0xf0,
+	0x91, 0x4b, 0xa4, 0xdf, 0xce, 0x07, 0x6c, 0xe6, 0x34, 0x1c, 0x59, 0x27, 0x9d, 0xde, 0x33, 0x73,
+	0xb4, 0xbb, 0x9d, 0x1f, 0x7e, 0x9e, 0x74, 0x6a, 0x8b, 0xda, 0xf0, 0x3a, 0xe9, 0xd4, 0xcc, 0xc2,
+	0x27, 0x71, 0x38, 0xd5, 0x17, 0x9b, 0xdf, 0x88, 0x5d, 0x27, 0xa2, 0x5d, 0x86, 0xc1, 0x7a, 0xf9,
+	0xfb, 0x70, 0xc4, 0x6f, 0x5d, 0x88, 0x97, 0x48, 0xb6, 0xfc, 0xf0, 0xa0, 0x3a, 0x8d, 0xcf, 0x56,
+	0xc7, 0xf6, 0xaa, 0xf0, 0x16, 0x48, 0x16, 0xe4, 0x95, 0x29, 0x79, 0x78, 0x3c, 0x91, 0xad, 0x96,
+	0x45, 0x89, 0xa7, 0x63, 0xff, 0xfc, 0x1e, 0x5e, 0xcf, 0x0f, 0xf9, 0xb5, 0xbc, 0xe4, 0xa9, 0x30,
+	0x74, 0x09, 0x1e, 0xc3, 0xb6, 0xed, 0x6e, 0x12, 0x53, 0xb7, 0x5a, 0x3a, 0xc5, 0x4e, 0x83, 0x78,
+	0xca, 0xb0, 0x28, 0x8a, 0x4e, 0xec, 0x55, 0x87, 0x6f, 0x81, 0x78, 0x6e, 0x82, 0x97, 0x57, 0x15,
+	0x1f, 0x54, 0x5b, 0xd2, 0x04, 0x44, 0x1b, 0x97, 0x5c, 0xb5, 0x96, 0x4f, 0x28, 0xfc, 0x19, 0xc0,
+	0xa9, 0xbe, 0x73, 0xf3, 0x8d, 0xb8, 0xb6, 0x02, 0x93, 0xb8, 0x65, 0xe9, 0xfc, 0xe6, 0xf5, 0x0f,
+	0xd3, 0xe4, 0xa0, 0x48, 0xdf, 0x8c, 0x3b, 0x88, 0x19, 0xc1, 0x2d, 0xeb, 0x79, 0xd2, 0x29, 0xfc,
+	0x11, 0xc0, 0x7c, 0xe4, 0x44, 0x2d, 0x44, 0x92, 0xc1, 0xff, 0xe2, 0xb9, 0xfa, 0x27, 0x80, 0xa7,
+	0x2e, 0x91, 0x3b, 0x59, 0xfb, 0x80, 0x8d, 0x35, 0xbe, 0x8e, 0xcc, 0xbb, 0x5f, 0x45, 0x7f, 0xf6,
+	0xfd, 0x1b, 0x80, 0xa7, 0x96, 0xff, 0x1b, 0xab, 0xfb, 0xc1, 0x1d, 0x57, 0x77, 0x72, 0xff, 0x9b,
+	0xb0, 0x87, 0x39, 0xf4, 0x1a, 0xf9, 0x6d, 0x1c, 0x66, 0xfb, 0x8b, 0x7f, 0xbe, 0x9b, 0x0d, 0x6c,
+	0x39, 0xc2, 0xe4, 0xb8, 0x26, 0xbe, 0x51, 0x15, 0xa6, 0x82, 0x02, 0x54, 0xaa, 0x54, 0x06, 0x55,
+	0x06, 0xe5, 0xe7, 0x80, 0xba, 0x90, 0x0f, 0xdd, 0xec, 0x7b, 0x45, 0xfb, 0xfd, 0x8c, 0xd2, 0xe1,
+	0x0f, 0x91, 0xaf, 0xef, 0x31, 0x7d, 0xbf, 0xa5, 0xf0, 0x2f, 0x86, 0xe1, 0x98, 0xb4, 0xcd, 0xaf,
+	0xad, 0xd1, 0x73, 0x70, 0x88, 0xd7, 0xe9, 0x0a, 0xf8, 0xca, 0x64, 0xc6, 0x77, 0xf4, 0x4f, 0x20,
+	0x9e, 0x02, 0x61, 0x93, 0x42, 0x70, 0xa2, 0x0a, 0x1c, 0x5d, 0x75, 0x5d, 0xa6, 0x0b, 0x31, 0x77,
+	0xd3, 0x28, 0x49, 0x71, 0x36, 0x3e, 0x81, 0xda, 0x30, 0x25, 0x9f, 0xe4, 0x81, 0x47, 0xff, 0xef,
+	0x00, 0x8f, 0xfa, 0x56, 0x97, 0xe4, 0x33, 0xff, 0x9e, 0xdc, 0x19, 0xaa, 0x42, 0x17, 0xe0, 0x31,
+	0xf9, 0x36, 0x0c, 0xdf, 0x25, 0x7e, 0xb3, 0xf9, 0x90, 0xb8, 0xd0, 0x72, 0x92, 0x25, 0x20, 0x78,
+	0xa2, 0xdd, 0xdd, 0x92, 0x59, 0xdc, 0x6f, 0x77, 0x2f, 0x69, 0x71, 0xab, 0x85, 0x28, 0x4c, 0x36,
+	0x09, 0xa3, 0x96, 0x11, 0x34, 0x5b, 0xce, 0x1c, 0xbe, 0xa8, 0x2b, 0x3e, 0xf8, 0x5e, 0xd6, 0x14,
+	0x28, 0xe2, 0xef, 0x1b, 0x6c, 0x6e, 0x60, 0xc7, 0x20, 0xa6, 0x62, 0xc8, 0xba, 0x69, 0x70, 0x2f,
+	0x96, 0xc5, 0x1f, 0x11, 0x5a, 0x08, 0x9c, 0xfa, 0x1e, 0x1c, 0xeb, 0x73, 0xe8, 0xdd, 0x84, 0xd4,
+	0xd4, 0x39, 0x98, 0x89, 0x1a, 0xfe, 0x55, 0xbc, 0xf1, 0x68, 0x38, 0x7e, 0x3a, 0x02, 0x27, 0xc3,
+	0xe4, 0xe3, 0x38, 0xc4, 0xe0, 0x0e, 0xe5, 0xde, 0xe0, 0xfd, 0xb7, 0x8c, 0xe1, 0x93, 0xfc, 0xe6,
+	0x19, 0x38, 0xe2, 0x65, 0x9b, 0x0e, 0xb9, 0x2a, 0x0c, 0x4d, 0xc1, 0x94, 0x00, 0x1a, 0xae, 0x1d,
+	0x34, 0x8f, 0x83, 0x31, 0x7a, 0x11, 0x3e, 0x62, 0x63, 0x8f, 0xc9, 0x37, 0xa8, 0x4e, 0x89, 0x41,
+	0xac, 0x8d, 0xa3, 0x36, 0xea, 0x7c, 0x5d, 0x13, 0x5c, 0x80, 0xbf, 0x79, 0x9a, 0x64, 0xaf, 0x30,
+	0xf4, 0x2c, 0x4c, 0x47, 0x04, 0xcb, 0x2a, 0xe1, 0xd4, 0xa1, 0x5b, 0xaf, 0xc1, 0x9e, 0xa4, 0xd0,
+	0xb0, 0x76, 0x4b, 0x3c, 0xfb, 0xa3, 0x86, 0x0d, 0xdf, 0x8d, 0x61, 0x2b, 0x82, 0x3f, 0x62, 0xd8,
+	0xa3, 0x30, 0x23, 0x65, 0x1a, 0x6e, 0xdb, 0x61, 0xe2, 0x25, 0x31, 0xa4, 0xa5, 0x7d, 0xda, 0x02,
+	0x27, 0xa1, 0x6b, 0xf0, 0xb8, 0xd0, 0x1d, 0x36, 0x1d, 0xa2, 0xda, 0x93, 0x47, 0xd4, 0x3e, 0xc9,
+	0x45, 0x04, 0x6d, 0x88, 0x88, 0xfe, 0xc7, 0x61, 0x36, 0x94, 0xeb, 0x5b, 0x90, 0x12, 0x16, 0x8c,
+	0x05, 0x54, 0xdf, 0x06, 0x1d, 0xe6, 0xa8, 0xdb, 0x76, 0x4c, 0x9d, 0x51, 0xde, 0xf8, 0xe7, 0xc2,
+	0x45, 0x2b, 0x2e, 0x5d, 0x7e, 0xe6, 0x00, 0x27, 0x0e, 0xc4, 0x4e, 0x49, 0xe3, 0xec, 0x57, 0xa9,
+	0xd5, 0x12, 0x96, 0x69, 0x59, 0xda, 0x37, 0x9e, 0xfa, 0x17, 0x80, 0xd9, 0x7e, 0x08, 0x3a, 0x0f,
+	0x13, 0x4d, 0x79, 0x57, 0x1c, 0xda, 0xe8, 0xe0, 0x39, 0xf0, 0xf7, 0x41, 0x0e, 0x14, 0x0d, 0x0f,
+	0xce, 0x27, 0xd8, 0xf1, 0x96, 0x12, 0xbf, 0x17, 0x76, 0xbc, 0x85, 0x16, 0xe0, 0x48, 0x93, 0x98,
+	0x16, 0x76, 0x94, 0xc4, 0xdd, 0x4b, 0x90, 0xac, 0xfc, 0x94, 0xf9, 0x4e, 0x15, 0xcf, 0x4a, 0xcd,
+	0x1f, 0x54, 0x7f, 0x03, 0x3e, 0xde, 0x51, 0xc1, 0xed, 0x1d, 0x15, 0x7c, 0xb2, 0xa3, 0xc6, 0x3e,
+	0xdb, 0x51, 0x63, 0x9f, 0xef, 0xa8, 0xb1, 0x2f, 0x76, 0xd4, 0xd8, 0x97, 0x3b, 0x2a, 0x78, 0xbd,
+	0xab, 0x82, 0x37, 0xbb, 0x6a, 0xec, 0xfd, 0xae, 0x0a, 0x3e, 0xe8, 0xaa, 0xb1, 0x0f, 0xbb, 0x6a,
+	0xec, 0xa3, 0xae, 0x1a, 0xfb, 0xb8, 0xab, 0x82, 0xdb, 0x5d, 0x15, 0x7c, 0xd2, 0x55, 0x63, 0x9f,
+	0x75, 0x55, 0xf0, 0x79, 0x57, 0x8d, 0x7d, 0xd1, 0x55, 0xc1, 0x97, 0x5d, 0x35, 0xf6, 0xfa, 0xae,
+	0x1a, 0x7b, 0x73, 0x57, 0x05, 0x6f, 0xef, 0xaa, 0xb1, 0x77, 0x76, 0x55, 0xf0, 0xde, 0xae, 0x1a,
+	0x7b, 0x7f, 0x57, 0x8d, 0x7d, 0xb0, 0xab, 0x82, 0x0f, 0x77, 0x55, 0xf0, 0xd1, 0xae, 0x0a, 0x5e,
+	0x3a, 0x7b, 0xd4, 0x46, 0x02, 0x73, 0x5a, 0xab, 0xab, 0x23, 0x62, 0x9d, 0x4f, 0xfd, 0x27, 0x00,
+	0x00, 0xff, 0xff, 0x36, 0x6d, 0x12, 0x0c, 0x18, 0x1e, 0x00, 0x00,
 }
 
 func (this *GatewayBrand) Equal(that interface{}) bool {
@@ -2313,6 +2344,9 @@ func (this *ListGatewaysRequest) Equal(that interface{}) bool {
 	if this.Page != that1.Page {
 		return false
 	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
 	return true
 }
 func (this *CreateGatewayRequest) Equal(that interface{}) bool {
@@ -2459,6 +2493,21 @@ func (this *CreateGatewayAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedIPRanges) != len(that1.AllowedIPRanges) {
+		return false
+	}
+	for i := range this.AllowedIPRanges {
+		if this.AllowedIPRanges[i] != that1.AllowedIPRanges[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateGatewayAPIKeyRequest) Equal(that interface{}) bool {
@@ -3446,6 +3495,16 @@ func (m *ListGatewaysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Page != 0 {
 		i = encodeVarintGateway(dAtA, i, uint64(m.Page))
 		i--
@@ -3677,21 +3736,40 @@ func (m *CreateGatewayAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedIPRanges) > 0 {
+		for iNdEx := len(m.AllowedIPRanges) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedIPRanges[iNdEx])
+			copy(dAtA[i:], m.AllowedIPRanges[iNdEx])
+			i = encodeVarintGateway(dAtA, i, uint64(len(m.AllowedIPRanges[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExpiresAt != nil {
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintGateway(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Rights) > 0 {
-		dAtA19 := make([]byte, len(m.Rights)*10)
-		var j18 int
+		dAtA20 := make([]byte, len(m.Rights)*10)
+		var j19 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j18++
+				j19++
 			}
-			dAtA19[j18] = uint8(num)
-			j18++
+			dAtA20[j19] = uint8(num)
+			j19++
 		}
-		i -= j18
-		copy(dAtA[i:], dAtA19[:j18])
-		i = encodeVarintGateway(dAtA, i, uint64(j18))
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintGateway(dAtA, i, uint64(j19))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4039,21 +4117,21 @@ func (m *GatewayStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BootTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BootTime):])
-	if err30 != nil {
-		return 0, err30
-	}
-	i -= n30
-	i = encodeVarintGateway(dAtA, i, uint64(n30))
-	i--
-	dAtA[i] = 0x12
-	n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BootTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.BootTime):])
 	if err31 != nil {
 		return 0, err31
 	}
 	i -= n31
 	i = encodeVarintGateway(dAtA, i, uint64(n31))
 	i--
+	dAtA[i] = 0x12
+	n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err32 != nil {
+		return 0, err32
+	}
+	i -= n32
+	i = encodeVarintGateway(dAtA, i, uint64(n32))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -4096,12 +4174,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x40
 	}
 	if m.LastDownlinkReceivedAt != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkReceivedAt):])
-		if err33 != nil {
-			return 0, err33
+		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkReceivedAt):])
+		if err34 != nil {
+			return 0, err34
 		}
-		i -= n33
-		i = encodeVarintGateway(dAtA, i, uint64(n33))
+		i -= n34
+		i = encodeVarintGateway(dAtA, i, uint64(n34))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -4111,12 +4189,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x30
 	}
 	if m.LastUplinkReceivedAt != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUplinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUplinkReceivedAt):])
-		if err34 != nil {
-			return 0, err34
+		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUplinkReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUplinkReceivedAt):])
+		if err35 != nil {
+			return 0, err35
 		}
-		i -= n34
-		i = encodeVarintGateway(dAtA, i, uint64(n34))
+		i -= n35
+		i = encodeVarintGateway(dAtA, i, uint64(n35))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -4133,12 +4211,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x22
 	}
 	if m.LastStatusReceivedAt != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStatusReceivedAt):])
-		if err36 != nil {
-			return 0, err36
+		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStatusReceivedAt):])
+		if err37 != nil {
+			return 0, err37
 		}
-		i -= n36
-		i = encodeVarintGateway(dAtA, i, uint64(n36))
+		i -= n37
+		i = encodeVarintGateway(dAtA, i, uint64(n37))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4150,12 +4228,12 @@ func (m *GatewayConnectionStats) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x12
 	}
 	if m.ConnectedAt != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ConnectedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ConnectedAt):])
-		if err37 != nil {
-			return 0, err37
+		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ConnectedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ConnectedAt):])
+		if err38 != nil {
+			return 0, err38
 		}
-		i -= n37
-		i = encodeVarintGateway(dAtA, i, uint64(n37))
+		i -= n38
+		i = encodeVarintGateway(dAtA, i, uint64(n38))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4187,29 +4265,29 @@ func (m *GatewayConnectionStats_RoundTripTimes) MarshalToSizedBuffer(dAtA []byte
 		i--
 		dAtA[i] = 0x20
 	}
-	n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median):])
-	if err38 != nil {
-		return 0, err38
-	}
-	i -= n38
-	i = encodeVarintGateway(dAtA, i, uint64(n38))
-	i--
-	dAtA[i] = 0x1a
-	n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
+	n39, err39 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median):])
 	if err39 != nil {
 		return 0, err39
 	}
 	i -= n39
 	i = encodeVarintGateway(dAtA, i, uint64(n39))
 	i--
-	dAtA[i] = 0x12
-	n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min):])
+	dAtA[i] = 0x1a
+	n40, err40 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
 	if err40 != nil {
 		return 0, err40
 	}
 	i -= n40
 	i = encodeVarintGateway(dAtA, i, uint64(n40))
 	i--
+	dAtA[i] = 0x12
+	n41, err41 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min):])
+	if err41 != nil {
+		return 0, err41
+	}
+	i -= n41
+	i = encodeVarintGateway(dAtA, i, uint64(n41))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -4389,6 +4467,7 @@ func NewPopulatedListGatewaysRequest(r randyGateway, easy bool) *ListGatewaysReq
 	this.Order = randStringGateway(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
+	this.Deleted = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4447,6 +4526,14 @@ func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGa
 	for i := 0; i < v23; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v24 := r.Intn(10)
+	this.AllowedIPRanges = make([]string, v24)
+	for i := 0; i < v24; i++ {
+		this.AllowedIPRanges[i] = randStringGateway(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4454,10 +4541,10 @@ func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGa
 
 func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGatewayAPIKeyRequest {
 	this := &UpdateGatewayAPIKeyRequest{}
-	v24 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v24
-	v25 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v25
+	v25 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v25
+	v26 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v26
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4465,8 +4552,8 @@ func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGa
 
 func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *ListGatewayCollaboratorsRequest {
 	this := &ListGatewayCollaboratorsRequest{}
-	v26 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v26
+	v27 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v27
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -4476,10 +4563,10 @@ func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *Lis
 
 func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGatewayCollaboratorRequest {
 	this := &GetGatewayCollaboratorRequest{}
-	v27 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v27
-	v28 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v28
+	v28 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v28
+	v29 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v29
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4487,10 +4574,10 @@ func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGa
 
 func NewPopulatedSetGatewayCollaboratorRequest(r randyGateway, easy bool) *SetGatewayCollaboratorRequest {
 	this := &SetGatewayCollaboratorRequest{}
-	v29 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v29
-	v30 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v30
+	v30 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v30
+	v31 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v31
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4502,12 +4589,12 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 	if r.Intn(2) == 0 {
 		this.Gain *= -1
 	}
-	v31 := NewPopulatedLocation(r, easy)
-	this.Location = *v31
+	v32 := NewPopulatedLocation(r, easy)
+	this.Location = *v32
 	if r.Intn(5) != 0 {
-		v32 := r.Intn(10)
+		v33 := r.Intn(10)
 		this.Attributes = make(map[string]string)
-		for i := 0; i < v32; i++ {
+		for i := 0; i < v33; i++ {
 			this.Attributes[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
@@ -4518,37 +4605,37 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 
 func NewPopulatedGatewayStatus(r randyGateway, easy bool) *GatewayStatus {
 	this := &GatewayStatus{}
-	v33 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v33
 	v34 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.BootTime = *v34
+	this.Time = *v34
+	v35 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.BootTime = *v35
 	if r.Intn(5) != 0 {
-		v35 := r.Intn(10)
+		v36 := r.Intn(10)
 		this.Versions = make(map[string]string)
-		for i := 0; i < v35; i++ {
+		for i := 0; i < v36; i++ {
 			this.Versions[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
 	if r.Intn(5) != 0 {
-		v36 := r.Intn(5)
-		this.AntennaLocations = make([]*Location, v36)
-		for i := 0; i < v36; i++ {
+		v37 := r.Intn(5)
+		this.AntennaLocations = make([]*Location, v37)
+		for i := 0; i < v37; i++ {
 			this.AntennaLocations[i] = NewPopulatedLocation(r, easy)
 		}
 	}
-	v37 := r.Intn(10)
-	this.IP = make([]string, v37)
-	for i := 0; i < v37; i++ {
+	v38 := r.Intn(10)
+	this.IP = make([]string, v38)
+	for i := 0; i < v38; i++ {
 		this.IP[i] = randStringGateway(r)
 	}
 	if r.Intn(5) != 0 {
-		v38 := r.Intn(10)
+		v39 := r.Intn(10)
 		this.Metrics = make(map[string]float32)
-		for i := 0; i < v38; i++ {
-			v39 := randStringGateway(r)
-			this.Metrics[v39] = float32(r.Float32())
+		for i := 0; i < v39; i++ {
+			v40 := randStringGateway(r)
+			this.Metrics[v40] = float32(r.Float32())
 			if r.Intn(2) == 0 {
-				this.Metrics[v39] *= -1
+				this.Metrics[v40] *= -1
 			}
 		}
 	}
@@ -4590,12 +4677,12 @@ func NewPopulatedGatewayConnectionStats(r randyGateway, easy bool) *GatewayConne
 
 func NewPopulatedGatewayConnectionStats_RoundTripTimes(r randyGateway, easy bool) *GatewayConnectionStats_RoundTripTimes {
 	this := &GatewayConnectionStats_RoundTripTimes{}
-	v40 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Min = *v40
 	v41 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Max = *v41
+	this.Min = *v41
 	v42 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Median = *v42
+	this.Max = *v42
+	v43 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Median = *v43
 	this.Count = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -4621,9 +4708,9 @@ func randUTF8RuneGateway(r randyGateway) rune {
 	return rune(ru + 61)
 }
 func randStringGateway(r randyGateway) string {
-	v43 := r.Intn(100)
-	tmps := make([]rune, v43)
-	for i := 0; i < v43; i++ {
+	v44 := r.Intn(100)
+	tmps := make([]rune, v44)
+	for i := 0; i < v44; i++ {
 		tmps[i] = randUTF8RuneGateway(r)
 	}
 	return string(tmps)
@@ -4645,11 +4732,11 @@ func randFieldGateway(dAtA []byte, r randyGateway, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
-		v44 := r.Int63()
+		v45 := r.Int63()
 		if r.Intn(2) == 0 {
-			v44 *= -1
+			v45 *= -1
 		}
-		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v44))
+		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v45))
 	case 1:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -4966,6 +5053,9 @@ func (m *ListGatewaysRequest) Size() (n int) {
 	if m.Page != 0 {
 		n += 1 + sovGateway(uint64(m.Page))
 	}
+	if m.Deleted {
+		n += 2
+	}
 	return n
 }
 
@@ -5046,6 +5136,16 @@ func (m *CreateGatewayAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovGateway(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if len(m.AllowedIPRanges) > 0 {
+		for _, s := range m.AllowedIPRanges {
+			l = len(s)
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5417,6 +5517,7 @@ func (this *ListGatewaysRequest) String() string {
 		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5474,6 +5575,8 @@ func (this *CreateGatewayAPIKeyRequest) String() string {
 		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedIPRanges:` + fmt.Sprintf("%v", this.AllowedIPRanges) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7795,6 +7898,26 @@ func (m *ListGatewaysRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -8462,6 +8585,74 @@ func (m *CreateGatewayAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedIPRanges", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedIPRanges = append(m.AllowedIPRanges, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -160,6 +160,7 @@ var ListGatewaysRequestFieldPathsNested = []string{
 	"collaborator.ids.user_ids",
 	"collaborator.ids.user_ids.email",
 	"collaborator.ids.user_ids.user_id",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -168,6 +169,7 @@ var ListGatewaysRequestFieldPathsNested = []string{
 
 var ListGatewaysRequestFieldPathsTopLevel = []string{
 	"collaborator",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -276,6 +278,8 @@ var GetGatewayAPIKeyRequestFieldPathsTopLevel = []string{
 	"key_id",
 }
 var CreateGatewayAPIKeyRequestFieldPathsNested = []string{
+	"allowed_ip_ranges",
+	"expires_at",
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
@@ -284,12 +288,16 @@ var CreateGatewayAPIKeyRequestFieldPathsNested = []string{
 }
 
 var CreateGatewayAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_ip_ranges",
+	"expires_at",
 	"gateway_ids",
 	"name",
 	"rights",
 }
 var UpdateGatewayAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_ip_ranges",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
@@ -665,6 +665,16 @@ func (dst *ListGatewaysRequest) SetFields(src *ListGatewaysRequest, paths ...str
 				var zero uint32
 				dst.Page = zero
 			}
+		case "deleted":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deleted' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Deleted = src.Deleted
+			} else {
+				var zero bool
+				dst.Deleted = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -887,6 +897,24 @@ func (dst *CreateGatewayAPIKeyRequest) SetFields(src *CreateGatewayAPIKeyRequest
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "allowed_ip_ranges":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allowed_ip_ranges' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowedIPRanges = src.AllowedIPRanges
+			} else {
+				dst.AllowedIPRanges = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
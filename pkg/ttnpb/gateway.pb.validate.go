@@ -1188,6 +1188,8 @@ func (m *ListGatewaysRequest) ValidateFields(paths ...string) error {
 
 		case "page":
 			// no validation rules for Page
+		case "deleted":
+			// no validation rules for Deleted
 		default:
 			return ListGatewaysRequestValidationError{
 				field:  name,
@@ -1726,6 +1728,27 @@ func (m *CreateGatewayAPIKeyRequest) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateGatewayAPIKeyRequestValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_ip_ranges":
+
+			if len(m.GetAllowedIPRanges()) > 20 {
+				return CreateGatewayAPIKeyRequestValidationError{
+					field:  "allowed_ip_ranges",
+					reason: "value must contain no more than 20 item(s)",
+				}
+			}
+
 		default:
 			return CreateGatewayAPIKeyRequestValidationError{
 				field:  name,
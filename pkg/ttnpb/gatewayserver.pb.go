@@ -4,7 +4,9 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -238,1007 +240,4529 @@ func (m *ScheduleDownlinkErrorDetails) GetPathErrors() []*ErrorDetails {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
-	golang_proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
-	proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
-	golang_proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
-	proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
-	golang_proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
-	proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
-	golang_proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+type CaptureGatewayTrafficRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// Duration of the capture.
+	Duration             time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
-}
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
+func (m *CaptureGatewayTrafficRequest) Reset()      { *m = CaptureGatewayTrafficRequest{} }
+func (*CaptureGatewayTrafficRequest) ProtoMessage() {}
+func (*CaptureGatewayTrafficRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{4}
 }
-
-var fileDescriptor_62b07a36420f2d6d = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x31, 0x6c, 0x1c, 0x45,
-	0x14, 0x9d, 0xb1, 0x31, 0x82, 0xb1, 0x70, 0xcc, 0x48, 0xc0, 0xf9, 0xe2, 0x8c, 0xad, 0x43, 0x20,
-	0x0b, 0xe5, 0x76, 0xad, 0x8b, 0x84, 0x68, 0x28, 0x6c, 0x5f, 0x38, 0x19, 0x61, 0x24, 0xce, 0x36,
-	0x12, 0x48, 0x96, 0x35, 0xbe, 0x1d, 0xcf, 0xad, 0x6e, 0x3d, 0xb3, 0xec, 0xcc, 0xdd, 0xf9, 0x84,
-	0x90, 0x22, 0xaa, 0x94, 0x48, 0x14, 0x44, 0xa2, 0x41, 0xa9, 0x22, 0xaa, 0x94, 0x29, 0x53, 0xba,
-	0x8c, 0x44, 0x93, 0x8a, 0xe4, 0x76, 0x29, 0x5c, 0xa6, 0x8c, 0xa8, 0xd0, 0xce, 0xcd, 0xda, 0x77,
-	0x7b, 0x5e, 0xa0, 0x49, 0xb7, 0xb3, 0xff, 0xfd, 0xf7, 0xdf, 0x7f, 0xf3, 0xff, 0xa0, 0x0f, 0x02,
-	0x19, 0xd1, 0x3e, 0x15, 0x55, 0xa5, 0x69, 0xab, 0xe3, 0xd2, 0xd0, 0x77, 0x39, 0xd5, 0xac, 0x4f,
-	0x07, 0x8a, 0x45, 0x3d, 0x16, 0x39, 0x61, 0x24, 0xb5, 0xc4, 0x0b, 0x5a, 0x0b, 0xc7, 0x42, 0x9d,
-	0xde, 0xad, 0xf2, 0x06, 0xf7, 0x75, 0xbb, 0x7b, 0xe4, 0xb4, 0xe4, 0x89, 0xcb, 0x44, 0x4f, 0x0e,
-	0xc2, 0x48, 0x9e, 0x0e, 0x5c, 0x03, 0x6e, 0x55, 0x39, 0x13, 0xd5, 0x1e, 0x0d, 0x7c, 0x8f, 0x6a,
-	0xe6, 0x4e, 0x7d, 0x8c, 0x28, 0xcb, 0xd5, 0x31, 0x0a, 0x2e, 0xb9, 0x1c, 0x25, 0x1f, 0x75, 0x8f,
-	0xcd, 0xc9, 0x1c, 0xcc, 0x97, 0x85, 0x2f, 0x73, 0x29, 0x79, 0xc0, 0x8c, 0x42, 0x2a, 0x84, 0xd4,
-	0x54, 0xfb, 0x52, 0x28, 0x1b, 0x25, 0x36, 0x7a, 0xc1, 0xe1, 0x75, 0x23, 0x03, 0xb0, 0xf1, 0xeb,
-	0xf9, 0x38, 0x3b, 0x09, 0xf5, 0xc0, 0x06, 0x6f, 0x4c, 0x7b, 0xc0, 0xa2, 0x48, 0xda, 0xde, 0xcb,
-	0x2b, 0x85, 0x16, 0x59, 0xc0, 0xfb, 0xd3, 0x00, 0xdf, 0x63, 0x42, 0xfb, 0xc7, 0x3e, 0x8b, 0x32,
-	0x85, 0xab, 0xd3, 0xa0, 0x13, 0xa6, 0x14, 0xe5, 0x2c, 0x43, 0x2c, 0x5f, 0x81, 0xf8, 0x4e, 0xeb,
-	0xe2, 0xfc, 0x88, 0x71, 0x5f, 0x0a, 0x1a, 0x8c, 0x10, 0x95, 0x73, 0x88, 0xde, 0x6c, 0x8c, 0x84,
-	0xed, 0x87, 0xf8, 0x33, 0x74, 0xad, 0x1b, 0x06, 0xbe, 0xe8, 0x1c, 0x66, 0x65, 0x4a, 0x70, 0x75,
-	0x76, 0x6d, 0xbe, 0x76, 0xc3, 0x99, 0xbc, 0x4b, 0x67, 0xdf, 0xc0, 0x76, 0x46, 0xa8, 0xe6, 0x42,
-	0x77, 0xfc, 0xa8, 0x70, 0x1d, 0x2d, 0xd8, 0x6e, 0x0f, 0x95, 0xa6, 0xba, 0xab, 0x4a, 0x33, 0xab,
-	0xf0, 0x2a, 0x1a, 0x5b, 0x7a, 0xd7, 0x80, 0x9a, 0x6f, 0xf1, 0xf1, 0x23, 0xde, 0x41, 0x6f, 0xeb,
-	0xd3, 0x43, 0xda, 0xea, 0x08, 0xd9, 0x0f, 0x98, 0xc7, 0x4f, 0x98, 0xd0, 0xa5, 0x59, 0x43, 0xb4,
-	0x9a, 0x27, 0xda, 0x3b, 0xdd, 0x98, 0xc0, 0x35, 0x17, 0x75, 0xee, 0x4f, 0xe5, 0x1b, 0x34, 0x6f,
-	0xcb, 0xd5, 0x65, 0x5f, 0xe0, 0xcf, 0xd1, 0xa2, 0x27, 0xfb, 0x62, 0xbc, 0xdb, 0x12, 0x34, 0xe4,
-	0x2b, 0x79, 0xf2, 0xba, 0xc5, 0x65, 0xed, 0x5e, 0xf3, 0x26, 0x7f, 0x54, 0x0e, 0x50, 0x69, 0xb7,
-	0xd5, 0x66, 0x5e, 0x37, 0x60, 0x19, 0xb6, 0xc9, 0x54, 0x28, 0x85, 0x62, 0x78, 0x03, 0xcd, 0x79,
-	0x2c, 0xa0, 0x03, 0x4b, 0xbe, 0xe4, 0x8c, 0xa6, 0xca, 0xc9, 0xa6, 0xca, 0xa9, 0xdb, 0xa9, 0xdb,
-	0x5c, 0xfc, 0x7b, 0x73, 0xee, 0x77, 0x38, 0xf3, 0x06, 0x3c, 0xfb, 0x73, 0x05, 0xdc, 0x7b, 0xb6,
-	0x02, 0x9b, 0xa3, 0xcc, 0xca, 0x01, 0x5a, 0xce, 0xd3, 0xdf, 0x4e, 0x67, 0xad, 0xce, 0x34, 0xf5,
-	0x03, 0x85, 0x3f, 0x45, 0xf3, 0x21, 0xd5, 0xed, 0x43, 0x33, 0x80, 0xd9, 0x95, 0x2d, 0xe7, 0xbb,
-	0x18, 0x4f, 0x69, 0xa2, 0x34, 0xc1, 0xfc, 0x51, 0xb5, 0x67, 0xb3, 0x68, 0xae, 0xa1, 0xfb, 0x0d,
-	0x85, 0xb7, 0xd1, 0xfc, 0x17, 0xbe, 0xe8, 0x58, 0x9b, 0xf0, 0x52, 0xc1, 0x75, 0xed, 0x87, 0xe5,
-	0xeb, 0x05, 0xa1, 0x54, 0xdf, 0x1a, 0x5c, 0x87, 0x78, 0x17, 0xbd, 0xd3, 0x60, 0x7a, 0x4b, 0x8a,
-	0x16, 0x13, 0x3a, 0xa2, 0x5a, 0x46, 0x5b, 0x52, 0x1c, 0xfb, 0x1c, 0xbf, 0x3b, 0x65, 0xc0, 0xed,
-	0x74, 0xad, 0xca, 0x95, 0x3c, 0xe3, 0x15, 0xb9, 0xbf, 0x40, 0xc3, 0xba, 0xf3, 0xd5, 0xde, 0xde,
-	0x96, 0x14, 0x82, 0xb5, 0x52, 0xdf, 0xb6, 0xc5, 0xb1, 0xc4, 0x95, 0x02, 0x3d, 0xdb, 0x97, 0x3b,
-	0x35, 0x5d, 0x61, 0x9a, 0xa7, 0xf2, 0xf1, 0x8f, 0x7f, 0xfc, 0xf5, 0xf3, 0xcc, 0x3a, 0x76, 0x5c,
-	0xae, 0x2e, 0x1e, 0x35, 0xf7, 0xfb, 0x6c, 0x9a, 0x7d, 0xef, 0x07, 0xb3, 0x5f, 0xd5, 0xd6, 0x45,
-	0x5a, 0xd5, 0x4f, 0xeb, 0xff, 0x0a, 0xd1, 0x7b, 0x56, 0xd9, 0xd7, 0xb5, 0x57, 0xa4, 0xed, 0x13,
-	0xa3, 0xad, 0x86, 0xd7, 0xff, 0x5d, 0x5b, 0xaf, 0x96, 0x57, 0x57, 0x63, 0xe8, 0xb5, 0x2f, 0x55,
-	0x43, 0xe1, 0x03, 0xb4, 0x98, 0x1f, 0x24, 0xfc, 0x5f, 0xd3, 0x5e, 0x5e, 0xcb, 0x03, 0x8a, 0x46,
-	0xbd, 0x76, 0x1f, 0xa2, 0x99, 0x86, 0x4a, 0xbd, 0x58, 0x6a, 0x30, 0x6d, 0xbb, 0xbc, 0x6c, 0x22,
-	0x5d, 0x6b, 0xf5, 0xbf, 0xdc, 0xf8, 0xb0, 0x00, 0x93, 0xe3, 0xaa, 0xd4, 0x8c, 0x23, 0x37, 0xf1,
-	0x47, 0xc5, 0x8e, 0x5c, 0x5a, 0xe1, 0xa6, 0x4f, 0x91, 0xda, 0xbc, 0x0f, 0xcf, 0x86, 0x04, 0x3e,
-	0x19, 0x12, 0xf8, 0x74, 0x48, 0xc0, 0xf3, 0x21, 0x01, 0xe7, 0x43, 0x02, 0x5e, 0x0c, 0x09, 0x78,
-	0x39, 0x24, 0xf0, 0x4e, 0x4c, 0xe0, 0xdd, 0x98, 0x80, 0x07, 0x31, 0x81, 0x0f, 0x63, 0x02, 0x1e,
-	0xc5, 0x04, 0x3c, 0x8e, 0x09, 0x38, 0x8b, 0x09, 0x7c, 0x12, 0x13, 0xf8, 0x34, 0x26, 0xe0, 0x79,
-	0x4c, 0xe0, 0x79, 0x4c, 0xc0, 0x8b, 0x98, 0xc0, 0x97, 0x31, 0x01, 0x77, 0x12, 0x02, 0xee, 0x26,
-	0x04, 0xfe, 0x94, 0x10, 0x70, 0x2f, 0x21, 0xf0, 0xb7, 0x84, 0x80, 0x07, 0x09, 0x01, 0x0f, 0x13,
-	0x02, 0x1f, 0x25, 0x04, 0x3e, 0x4e, 0x08, 0xfc, 0xf6, 0x26, 0x97, 0x8e, 0x6e, 0x33, 0xdd, 0xf6,
-	0x05, 0x57, 0x8e, 0x60, 0xba, 0x2f, 0xa3, 0x8e, 0x3b, 0xf9, 0x3e, 0x87, 0x1d, 0xee, 0x6a, 0x2d,
-	0xc2, 0xa3, 0xa3, 0xd7, 0xcd, 0x72, 0xdc, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x29, 0x78, 0x18,
-	0x24, 0x6a, 0x07, 0x00, 0x00,
+func (m *CaptureGatewayTrafficRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *GatewayUp) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*GatewayUp)
-	if !ok {
-		that2, ok := that.(GatewayUp)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.UplinkMessages) != len(that1.UplinkMessages) {
-		return false
-	}
-	for i := range this.UplinkMessages {
-		if !this.UplinkMessages[i].Equal(that1.UplinkMessages[i]) {
-			return false
+func (m *CaptureGatewayTrafficRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CaptureGatewayTrafficRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !this.GatewayStatus.Equal(that1.GatewayStatus) {
-		return false
-	}
-	if !this.TxAcknowledgment.Equal(that1.TxAcknowledgment) {
-		return false
-	}
-	return true
 }
-func (this *GatewayDown) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CaptureGatewayTrafficRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaptureGatewayTrafficRequest.Merge(m, src)
+}
+func (m *CaptureGatewayTrafficRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CaptureGatewayTrafficRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaptureGatewayTrafficRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GatewayDown)
-	if !ok {
-		that2, ok := that.(GatewayDown)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
-		return false
+var xxx_messageInfo_CaptureGatewayTrafficRequest proto.InternalMessageInfo
+
+func (m *CaptureGatewayTrafficRequest) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return true
+	return 0
 }
-func (this *ScheduleDownlinkResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ScheduleDownlinkResponse)
-	if !ok {
-		that2, ok := that.(ScheduleDownlinkResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Delay != that1.Delay {
-		return false
-	}
-	return true
+type CaptureGatewayTrafficResponse struct {
+	// Name of the capture file on the Gateway Server.
+	FileName string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Time when the capture stops.
+	StopsAt              time.Time `protobuf:"bytes,2,opt,name=stops_at,json=stopsAt,proto3,stdtime" json:"stops_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
-func (this *ScheduleDownlinkErrorDetails) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ScheduleDownlinkErrorDetails)
-	if !ok {
-		that2, ok := that.(ScheduleDownlinkErrorDetails)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.PathErrors) != len(that1.PathErrors) {
-		return false
-	}
-	for i := range this.PathErrors {
-		if !this.PathErrors[i].Equal(that1.PathErrors[i]) {
-			return false
+func (m *CaptureGatewayTrafficResponse) Reset()      { *m = CaptureGatewayTrafficResponse{} }
+func (*CaptureGatewayTrafficResponse) ProtoMessage() {}
+func (*CaptureGatewayTrafficResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{5}
+}
+func (m *CaptureGatewayTrafficResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CaptureGatewayTrafficResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CaptureGatewayTrafficResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
+}
+func (m *CaptureGatewayTrafficResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaptureGatewayTrafficResponse.Merge(m, src)
+}
+func (m *CaptureGatewayTrafficResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CaptureGatewayTrafficResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaptureGatewayTrafficResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_CaptureGatewayTrafficResponse proto.InternalMessageInfo
 
-// GtwGsClient is the client API for GtwGs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GtwGsClient interface {
-	// Link the gateway to the Gateway Server.
-	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
-	// GetConcentratorConfig associated to the gateway.
-	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
-	// Get the MQTT server address and the username for the gateway.
-	GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
-	// Get the MQTTV2 server address and the username for the gateway.
-	GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+func (m *CaptureGatewayTrafficResponse) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
 }
 
-type gtwGsClient struct {
-	cc *grpc.ClientConn
+func (m *CaptureGatewayTrafficResponse) GetStopsAt() time.Time {
+	if m != nil {
+		return m.StopsAt
+	}
+	return time.Time{}
 }
 
-func NewGtwGsClient(cc *grpc.ClientConn) GtwGsClient {
-	return &gtwGsClient{cc}
+type GetGatewayTrafficCaptureRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// Name of the capture file, as returned when the capture is started.
+	FileName             string   `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *gtwGsClient) LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GtwGs_serviceDesc.Streams[0], "/ttn.lorawan.v3.GtwGs/LinkGateway", opts...)
-	if err != nil {
-		return nil, err
+func (m *GetGatewayTrafficCaptureRequest) Reset()      { *m = GetGatewayTrafficCaptureRequest{} }
+func (*GetGatewayTrafficCaptureRequest) ProtoMessage() {}
+func (*GetGatewayTrafficCaptureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{6}
+}
+func (m *GetGatewayTrafficCaptureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetGatewayTrafficCaptureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetGatewayTrafficCaptureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &gtwGsLinkGatewayClient{stream}
-	return x, nil
 }
-
-type GtwGs_LinkGatewayClient interface {
-	Send(*GatewayUp) error
-	Recv() (*GatewayDown, error)
-	grpc.ClientStream
+func (m *GetGatewayTrafficCaptureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGatewayTrafficCaptureRequest.Merge(m, src)
+}
+func (m *GetGatewayTrafficCaptureRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetGatewayTrafficCaptureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGatewayTrafficCaptureRequest.DiscardUnknown(m)
 }
 
-type gtwGsLinkGatewayClient struct {
-	grpc.ClientStream
+var xxx_messageInfo_GetGatewayTrafficCaptureRequest proto.InternalMessageInfo
+
+func (m *GetGatewayTrafficCaptureRequest) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
 }
 
-func (x *gtwGsLinkGatewayClient) Send(m *GatewayUp) error {
-	return x.ClientStream.SendMsg(m)
+type GatewayTrafficCapture struct {
+	// Name of the capture file.
+	FileName string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Contents of the capture file.
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *gtwGsLinkGatewayClient) Recv() (*GatewayDown, error) {
-	m := new(GatewayDown)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GatewayTrafficCapture) Reset()      { *m = GatewayTrafficCapture{} }
+func (*GatewayTrafficCapture) ProtoMessage() {}
+func (*GatewayTrafficCapture) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{7}
+}
+func (m *GatewayTrafficCapture) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayTrafficCapture) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayTrafficCapture.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *GatewayTrafficCapture) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTrafficCapture.Merge(m, src)
+}
+func (m *GatewayTrafficCapture) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayTrafficCapture) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTrafficCapture.DiscardUnknown(m)
 }
 
-func (c *gtwGsClient) GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error) {
-	out := new(ConcentratorConfig)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GatewayTrafficCapture proto.InternalMessageInfo
+
+func (m *GatewayTrafficCapture) GetFileName() string {
+	if m != nil {
+		return m.FileName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *gtwGsClient) GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
-	out := new(MQTTConnectionInfo)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GatewayTrafficCapture) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return out, nil
+	return nil
 }
 
-func (c *gtwGsClient) GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
-	out := new(MQTTConnectionInfo)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// GatewayTrafficStats contains the traffic statistics of a gateway aggregated over a period.
+type GatewayTrafficStats struct {
+	// Start of the aggregation period.
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// End of the aggregation period.
+	End           time.Time `protobuf:"bytes,2,opt,name=end,proto3,stdtime" json:"end"`
+	UplinkCount   uint64    `protobuf:"varint,3,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	DownlinkCount uint64    `protobuf:"varint,4,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
+	// Number of packets received by the gateway with a CRC error, as reported in the gateway status.
+	CRCErrorCount uint64 `protobuf:"varint,5,opt,name=crc_error_count,json=crcErrorCount,proto3" json:"crc_error_count,omitempty"`
+	// Uplink and downlink counters per frequency and data rate.
+	Channels             []*GatewayTrafficStats_ChannelStats `protobuf:"bytes,6,rep,name=channels,proto3" json:"channels,omitempty"`
+	SubBands             []*GatewayTrafficStats_SubBandStats `protobuf:"bytes,7,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
+	RoundTripTimes       *GatewayTrafficStats_RoundTripTimes `protobuf:"bytes,8,opt,name=round_trip_times,json=roundTripTimes,proto3" json:"round_trip_times,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
 }
 
-// GtwGsServer is the server API for GtwGs service.
-type GtwGsServer interface {
-	// Link the gateway to the Gateway Server.
-	LinkGateway(GtwGs_LinkGatewayServer) error
-	// GetConcentratorConfig associated to the gateway.
-	GetConcentratorConfig(context.Context, *types.Empty) (*ConcentratorConfig, error)
-	// Get the MQTT server address and the username for the gateway.
-	GetMQTTConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
-	// Get the MQTTV2 server address and the username for the gateway.
-	GetMQTTV2ConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
+func (m *GatewayTrafficStats) Reset()      { *m = GatewayTrafficStats{} }
+func (*GatewayTrafficStats) ProtoMessage() {}
+func (*GatewayTrafficStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{8}
 }
-
-// UnimplementedGtwGsServer can be embedded to have forward compatible implementations.
-type UnimplementedGtwGsServer struct {
+func (m *GatewayTrafficStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedGtwGsServer) LinkGateway(srv GtwGs_LinkGatewayServer) error {
-	return status.Errorf(codes.Unimplemented, "method LinkGateway not implemented")
+func (m *GatewayTrafficStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayTrafficStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedGtwGsServer) GetConcentratorConfig(ctx context.Context, req *types.Empty) (*ConcentratorConfig, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConcentratorConfig not implemented")
+func (m *GatewayTrafficStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTrafficStats.Merge(m, src)
 }
-func (*UnimplementedGtwGsServer) GetMQTTConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTConnectionInfo not implemented")
+func (m *GatewayTrafficStats) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedGtwGsServer) GetMQTTV2ConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTV2ConnectionInfo not implemented")
+func (m *GatewayTrafficStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTrafficStats.DiscardUnknown(m)
 }
 
-func RegisterGtwGsServer(s *grpc.Server, srv GtwGsServer) {
-	s.RegisterService(&_GtwGs_serviceDesc, srv)
-}
+var xxx_messageInfo_GatewayTrafficStats proto.InternalMessageInfo
 
-func _GtwGs_LinkGateway_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(GtwGsServer).LinkGateway(&gtwGsLinkGatewayServer{stream})
+func (m *GatewayTrafficStats) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
 }
 
-type GtwGs_LinkGatewayServer interface {
-	Send(*GatewayDown) error
-	Recv() (*GatewayUp, error)
-	grpc.ServerStream
+func (m *GatewayTrafficStats) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
 }
 
-type gtwGsLinkGatewayServer struct {
-	grpc.ServerStream
+func (m *GatewayTrafficStats) GetUplinkCount() uint64 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
 }
 
-func (x *gtwGsLinkGatewayServer) Send(m *GatewayDown) error {
-	return x.ServerStream.SendMsg(m)
+func (m *GatewayTrafficStats) GetDownlinkCount() uint64 {
+	if m != nil {
+		return m.DownlinkCount
+	}
+	return 0
 }
 
-func (x *gtwGsLinkGatewayServer) Recv() (*GatewayUp, error) {
-	m := new(GatewayUp)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GatewayTrafficStats) GetCRCErrorCount() uint64 {
+	if m != nil {
+		return m.CRCErrorCount
 	}
-	return m, nil
+	return 0
 }
 
-func _GtwGs_GetConcentratorConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GtwGsServer).GetConcentratorConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GtwGsServer).GetConcentratorConfig(ctx, req.(*types.Empty))
+func (m *GatewayTrafficStats) GetChannels() []*GatewayTrafficStats_ChannelStats {
+	if m != nil {
+		return m.Channels
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _GtwGs_GetMQTTConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, req.(*GatewayIdentifiers))
+func (m *GatewayTrafficStats) GetSubBands() []*GatewayTrafficStats_SubBandStats {
+	if m != nil {
+		return m.SubBands
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _GtwGs_GetMQTTV2ConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, req.(*GatewayIdentifiers))
+func (m *GatewayTrafficStats) GetRoundTripTimes() *GatewayTrafficStats_RoundTripTimes {
+	if m != nil {
+		return m.RoundTripTimes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _GtwGs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.GtwGs",
-	HandlerType: (*GtwGsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetConcentratorConfig",
-			Handler:    _GtwGs_GetConcentratorConfig_Handler,
-		},
-		{
-			MethodName: "GetMQTTConnectionInfo",
-			Handler:    _GtwGs_GetMQTTConnectionInfo_Handler,
-		},
-		{
-			MethodName: "GetMQTTV2ConnectionInfo",
-			Handler:    _GtwGs_GetMQTTV2ConnectionInfo_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "LinkGateway",
-			Handler:       _GtwGs_LinkGateway_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "lorawan-stack/api/gatewayserver.proto",
+type GatewayTrafficStats_ChannelStats struct {
+	Frequency            uint64        `protobuf:"varint,1,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	DataRateIndex        DataRateIndex `protobuf:"varint,2,opt,name=data_rate_index,json=dataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"data_rate_index,omitempty"`
+	UplinkCount          uint64        `protobuf:"varint,3,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	DownlinkCount        uint64        `protobuf:"varint,4,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-// NsGsClient is the client API for NsGs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NsGsClient interface {
-	// ScheduleDownlink instructs the Gateway Server to schedule a downlink message.
-	// The Gateway Server may refuse if there are any conflicts in the schedule or
-	// if a duty cycle prevents the gateway from transmitting.
-	ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error)
+func (m *GatewayTrafficStats_ChannelStats) Reset()      { *m = GatewayTrafficStats_ChannelStats{} }
+func (*GatewayTrafficStats_ChannelStats) ProtoMessage() {}
+func (*GatewayTrafficStats_ChannelStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{8, 0}
 }
-
-type nsGsClient struct {
-	cc *grpc.ClientConn
+func (m *GatewayTrafficStats_ChannelStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewNsGsClient(cc *grpc.ClientConn) NsGsClient {
-	return &nsGsClient{cc}
+func (m *GatewayTrafficStats_ChannelStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayTrafficStats_ChannelStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GatewayTrafficStats_ChannelStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTrafficStats_ChannelStats.Merge(m, src)
+}
+func (m *GatewayTrafficStats_ChannelStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayTrafficStats_ChannelStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTrafficStats_ChannelStats.DiscardUnknown(m)
 }
 
-func (c *nsGsClient) ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error) {
-	out := new(ScheduleDownlinkResponse)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsGs/ScheduleDownlink", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GatewayTrafficStats_ChannelStats proto.InternalMessageInfo
+
+func (m *GatewayTrafficStats_ChannelStats) GetFrequency() uint64 {
+	if m != nil {
+		return m.Frequency
 	}
-	return out, nil
+	return 0
 }
 
-// NsGsServer is the server API for NsGs service.
-type NsGsServer interface {
-	// ScheduleDownlink instructs the Gateway Server to schedule a downlink message.
-	// The Gateway Server may refuse if there are any conflicts in the schedule or
-	// if a duty cycle prevents the gateway from transmitting.
-	ScheduleDownlink(context.Context, *DownlinkMessage) (*ScheduleDownlinkResponse, error)
+func (m *GatewayTrafficStats_ChannelStats) GetDataRateIndex() DataRateIndex {
+	if m != nil {
+		return m.DataRateIndex
+	}
+	return DATA_RATE_0
 }
 
-// UnimplementedNsGsServer can be embedded to have forward compatible implementations.
-type UnimplementedNsGsServer struct {
+func (m *GatewayTrafficStats_ChannelStats) GetUplinkCount() uint64 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
 }
 
-func (*UnimplementedNsGsServer) ScheduleDownlink(ctx context.Context, req *DownlinkMessage) (*ScheduleDownlinkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDownlink not implemented")
+func (m *GatewayTrafficStats_ChannelStats) GetDownlinkCount() uint64 {
+	if m != nil {
+		return m.DownlinkCount
+	}
+	return 0
 }
 
-func RegisterNsGsServer(s *grpc.Server, srv NsGsServer) {
-	s.RegisterService(&_NsGs_serviceDesc, srv)
+type GatewayTrafficStats_SubBandStats struct {
+	MinFrequency uint64 `protobuf:"varint,1,opt,name=min_frequency,json=minFrequency,proto3" json:"min_frequency,omitempty"`
+	MaxFrequency uint64 `protobuf:"varint,2,opt,name=max_frequency,json=maxFrequency,proto3" json:"max_frequency,omitempty"`
+	// Downlink duty-cycle utilization as a fraction of the available duty-cycle at the end of the period.
+	DownlinkUtilization  float32  `protobuf:"fixed32,3,opt,name=downlink_utilization,json=downlinkUtilization,proto3" json:"downlink_utilization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _NsGs_ScheduleDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownlinkMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NsGsServer).ScheduleDownlink(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.NsGs/ScheduleDownlink",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsGsServer).ScheduleDownlink(ctx, req.(*DownlinkMessage))
+func (m *GatewayTrafficStats_SubBandStats) Reset()      { *m = GatewayTrafficStats_SubBandStats{} }
+func (*GatewayTrafficStats_SubBandStats) ProtoMessage() {}
+func (*GatewayTrafficStats_SubBandStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{8, 1}
+}
+func (m *GatewayTrafficStats_SubBandStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayTrafficStats_SubBandStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayTrafficStats_SubBandStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _NsGs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.NsGs",
-	HandlerType: (*NsGsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ScheduleDownlink",
-			Handler:    _NsGs_ScheduleDownlink_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/gatewayserver.proto",
+func (m *GatewayTrafficStats_SubBandStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTrafficStats_SubBandStats.Merge(m, src)
 }
-
-// GsClient is the client API for Gs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GsClient interface {
-	// Get statistics about the current gateway connection to the Gateway Server.
-	// This is not persisted between reconnects.
-	GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error)
+func (m *GatewayTrafficStats_SubBandStats) XXX_Size() int {
+	return m.Size()
 }
-
-type gsClient struct {
-	cc *grpc.ClientConn
+func (m *GatewayTrafficStats_SubBandStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTrafficStats_SubBandStats.DiscardUnknown(m)
 }
 
-func NewGsClient(cc *grpc.ClientConn) GsClient {
-	return &gsClient{cc}
-}
+var xxx_messageInfo_GatewayTrafficStats_SubBandStats proto.InternalMessageInfo
 
-func (c *gsClient) GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error) {
-	out := new(GatewayConnectionStats)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GatewayTrafficStats_SubBandStats) GetMinFrequency() uint64 {
+	if m != nil {
+		return m.MinFrequency
 	}
-	return out, nil
+	return 0
 }
 
-// GsServer is the server API for Gs service.
-type GsServer interface {
-	// Get statistics about the current gateway connection to the Gateway Server.
-	// This is not persisted between reconnects.
-	GetGatewayConnectionStats(context.Context, *GatewayIdentifiers) (*GatewayConnectionStats, error)
+func (m *GatewayTrafficStats_SubBandStats) GetMaxFrequency() uint64 {
+	if m != nil {
+		return m.MaxFrequency
+	}
+	return 0
 }
 
-// UnimplementedGsServer can be embedded to have forward compatible implementations.
-type UnimplementedGsServer struct {
+func (m *GatewayTrafficStats_SubBandStats) GetDownlinkUtilization() float32 {
+	if m != nil {
+		return m.DownlinkUtilization
+	}
+	return 0
 }
 
-func (*UnimplementedGsServer) GetGatewayConnectionStats(ctx context.Context, req *GatewayIdentifiers) (*GatewayConnectionStats, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStats not implemented")
+type GatewayTrafficStats_RoundTripTimes struct {
+	Min                  time.Duration `protobuf:"bytes,1,opt,name=min,proto3,stdduration" json:"min"`
+	Median               time.Duration `protobuf:"bytes,2,opt,name=median,proto3,stdduration" json:"median"`
+	P90                  time.Duration `protobuf:"bytes,3,opt,name=p90,proto3,stdduration" json:"p90"`
+	P99                  time.Duration `protobuf:"bytes,4,opt,name=p99,proto3,stdduration" json:"p99"`
+	Max                  time.Duration `protobuf:"bytes,5,opt,name=max,proto3,stdduration" json:"max"`
+	Count                uint32        `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func RegisterGsServer(s *grpc.Server, srv GsServer) {
-	s.RegisterService(&_Gs_serviceDesc, srv)
+func (m *GatewayTrafficStats_RoundTripTimes) Reset()      { *m = GatewayTrafficStats_RoundTripTimes{} }
+func (*GatewayTrafficStats_RoundTripTimes) ProtoMessage() {}
+func (*GatewayTrafficStats_RoundTripTimes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{8, 2}
 }
-
-func _Gs_GetGatewayConnectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GatewayIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GsServer).GetGatewayConnectionStats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GsServer).GetGatewayConnectionStats(ctx, req.(*GatewayIdentifiers))
+func (m *GatewayTrafficStats_RoundTripTimes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayTrafficStats_RoundTripTimes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayTrafficStats_RoundTripTimes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Gs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.Gs",
-	HandlerType: (*GsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetGatewayConnectionStats",
-			Handler:    _Gs_GetGatewayConnectionStats_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/gatewayserver.proto",
+func (m *GatewayTrafficStats_RoundTripTimes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTrafficStats_RoundTripTimes.Merge(m, src)
+}
+func (m *GatewayTrafficStats_RoundTripTimes) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayTrafficStats_RoundTripTimes) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTrafficStats_RoundTripTimes.DiscardUnknown(m)
 }
 
-func (m *GatewayUp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GatewayTrafficStats_RoundTripTimes proto.InternalMessageInfo
+
+func (m *GatewayTrafficStats_RoundTripTimes) GetMin() time.Duration {
+	if m != nil {
+		return m.Min
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GatewayUp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GatewayTrafficStats_RoundTripTimes) GetMedian() time.Duration {
+	if m != nil {
+		return m.Median
+	}
+	return 0
 }
 
-func (m *GatewayUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxAcknowledgment != nil {
-		{
-			size, err := m.TxAcknowledgment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (m *GatewayTrafficStats_RoundTripTimes) GetP90() time.Duration {
+	if m != nil {
+		return m.P90
 	}
-	if m.GatewayStatus != nil {
-		{
-			size, err := m.GatewayStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *GatewayTrafficStats_RoundTripTimes) GetP99() time.Duration {
+	if m != nil {
+		return m.P99
 	}
-	if len(m.UplinkMessages) > 0 {
-		for iNdEx := len(m.UplinkMessages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UplinkMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *GatewayTrafficStats_RoundTripTimes) GetMax() time.Duration {
+	if m != nil {
+		return m.Max
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GatewayDown) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GatewayTrafficStats_RoundTripTimes) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GatewayDown) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetGatewayTrafficStatsRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// Return the statistics of periods that end after this time.
+	From *time.Time `protobuf:"bytes,2,opt,name=from,proto3,stdtime" json:"from,omitempty"`
+	// Return the statistics of periods that start before this time.
+	To                   *time.Time `protobuf:"bytes,3,opt,name=to,proto3,stdtime" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *GatewayDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DownlinkMessage != nil {
-		{
-			size, err := m.DownlinkMessage.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+func (m *GetGatewayTrafficStatsRequest) Reset()      { *m = GetGatewayTrafficStatsRequest{} }
+func (*GetGatewayTrafficStatsRequest) ProtoMessage() {}
+func (*GetGatewayTrafficStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{9}
+}
+func (m *GetGatewayTrafficStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetGatewayTrafficStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetGatewayTrafficStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ScheduleDownlinkResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *GetGatewayTrafficStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGatewayTrafficStatsRequest.Merge(m, src)
 }
-
-func (m *ScheduleDownlinkResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetGatewayTrafficStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetGatewayTrafficStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGatewayTrafficStatsRequest.DiscardUnknown(m)
 }
 
-func (m *ScheduleDownlinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
-	if err4 != nil {
-		return 0, err4
+var xxx_messageInfo_GetGatewayTrafficStatsRequest proto.InternalMessageInfo
+
+func (m *GetGatewayTrafficStatsRequest) GetFrom() *time.Time {
+	if m != nil {
+		return m.From
 	}
-	i -= n4
-	i = encodeVarintGatewayserver(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ScheduleDownlinkErrorDetails) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetGatewayTrafficStatsRequest) GetTo() *time.Time {
+	if m != nil {
+		return m.To
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ScheduleDownlinkErrorDetails) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GatewayTrafficStatsHistory struct {
+	Stats                []*GatewayTrafficStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *ScheduleDownlinkErrorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PathErrors) > 0 {
-		for iNdEx := len(m.PathErrors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PathErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *GatewayTrafficStatsHistory) Reset()      { *m = GatewayTrafficStatsHistory{} }
+func (*GatewayTrafficStatsHistory) ProtoMessage() {}
+func (*GatewayTrafficStatsHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{10}
+}
+func (m *GatewayTrafficStatsHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayTrafficStatsHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayTrafficStatsHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GatewayTrafficStatsHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTrafficStatsHistory.Merge(m, src)
+}
+func (m *GatewayTrafficStatsHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayTrafficStatsHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTrafficStatsHistory.DiscardUnknown(m)
 }
 
-func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGatewayserver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_GatewayTrafficStatsHistory proto.InternalMessageInfo
+
+func (m *GatewayTrafficStatsHistory) GetStats() []*GatewayTrafficStats {
+	if m != nil {
+		return m.Stats
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func NewPopulatedGatewayUp(r randyGatewayserver, easy bool) *GatewayUp {
-	this := &GatewayUp{}
-	if r.Intn(5) != 0 {
-		v1 := r.Intn(5)
-		this.UplinkMessages = make([]*UplinkMessage, v1)
-		for i := 0; i < v1; i++ {
-			this.UplinkMessages[i] = NewPopulatedUplinkMessage(r, easy)
+
+func init() {
+	proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
+	golang_proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
+	proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
+	golang_proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
+	proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
+	golang_proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
+	proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+	golang_proto.RegisterType((*ScheduleDownlinkErrorDetails)(nil), "ttn.lorawan.v3.ScheduleDownlinkErrorDetails")
+	proto.RegisterType((*CaptureGatewayTrafficRequest)(nil), "ttn.lorawan.v3.CaptureGatewayTrafficRequest")
+	golang_proto.RegisterType((*CaptureGatewayTrafficRequest)(nil), "ttn.lorawan.v3.CaptureGatewayTrafficRequest")
+	proto.RegisterType((*CaptureGatewayTrafficResponse)(nil), "ttn.lorawan.v3.CaptureGatewayTrafficResponse")
+	golang_proto.RegisterType((*CaptureGatewayTrafficResponse)(nil), "ttn.lorawan.v3.CaptureGatewayTrafficResponse")
+	proto.RegisterType((*GetGatewayTrafficCaptureRequest)(nil), "ttn.lorawan.v3.GetGatewayTrafficCaptureRequest")
+	golang_proto.RegisterType((*GetGatewayTrafficCaptureRequest)(nil), "ttn.lorawan.v3.GetGatewayTrafficCaptureRequest")
+	proto.RegisterType((*GatewayTrafficCapture)(nil), "ttn.lorawan.v3.GatewayTrafficCapture")
+	golang_proto.RegisterType((*GatewayTrafficCapture)(nil), "ttn.lorawan.v3.GatewayTrafficCapture")
+	proto.RegisterType((*GatewayTrafficStats)(nil), "ttn.lorawan.v3.GatewayTrafficStats")
+	golang_proto.RegisterType((*GatewayTrafficStats)(nil), "ttn.lorawan.v3.GatewayTrafficStats")
+	proto.RegisterType((*GatewayTrafficStats_ChannelStats)(nil), "ttn.lorawan.v3.GatewayTrafficStats.ChannelStats")
+	golang_proto.RegisterType((*GatewayTrafficStats_ChannelStats)(nil), "ttn.lorawan.v3.GatewayTrafficStats.ChannelStats")
+	proto.RegisterType((*GatewayTrafficStats_SubBandStats)(nil), "ttn.lorawan.v3.GatewayTrafficStats.SubBandStats")
+	golang_proto.RegisterType((*GatewayTrafficStats_SubBandStats)(nil), "ttn.lorawan.v3.GatewayTrafficStats.SubBandStats")
+	proto.RegisterType((*GatewayTrafficStats_RoundTripTimes)(nil), "ttn.lorawan.v3.GatewayTrafficStats.RoundTripTimes")
+	golang_proto.RegisterType((*GatewayTrafficStats_RoundTripTimes)(nil), "ttn.lorawan.v3.GatewayTrafficStats.RoundTripTimes")
+	proto.RegisterType((*GetGatewayTrafficStatsRequest)(nil), "ttn.lorawan.v3.GetGatewayTrafficStatsRequest")
+	golang_proto.RegisterType((*GetGatewayTrafficStatsRequest)(nil), "ttn.lorawan.v3.GetGatewayTrafficStatsRequest")
+	proto.RegisterType((*GatewayTrafficStatsHistory)(nil), "ttn.lorawan.v3.GatewayTrafficStatsHistory")
+	golang_proto.RegisterType((*GatewayTrafficStatsHistory)(nil), "ttn.lorawan.v3.GatewayTrafficStatsHistory")
+}
+
+func init() {
+	proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/gatewayserver.proto", fileDescriptor_62b07a36420f2d6d)
+}
+
+var fileDescriptor_62b07a36420f2d6d = []byte{
+	// 1596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x6c, 0x13, 0x49,
+	0x1a, 0xee, 0x72, 0xec, 0xe0, 0x54, 0x9e, 0x14, 0x8f, 0x6d, 0x4c, 0xd2, 0xc9, 0x36, 0x62, 0x37,
+	0x42, 0xd8, 0xce, 0x9a, 0x05, 0x6d, 0x60, 0x57, 0xab, 0x38, 0x01, 0x93, 0x15, 0x61, 0xb5, 0x9d,
+	0x64, 0x47, 0x33, 0x1a, 0x64, 0x55, 0xba, 0xcb, 0x9d, 0x56, 0xec, 0xea, 0xa6, 0xab, 0x9c, 0x38,
+	0x83, 0x90, 0x10, 0x27, 0x34, 0x73, 0x41, 0x9a, 0xc3, 0x20, 0xcd, 0x65, 0x1e, 0x17, 0x34, 0x87,
+	0x11, 0xa7, 0x11, 0xa7, 0x81, 0xdb, 0x70, 0x44, 0x9a, 0x0b, 0xa7, 0x40, 0xec, 0x39, 0x70, 0xe4,
+	0x88, 0x38, 0x8d, 0xba, 0xba, 0xdb, 0xcf, 0x38, 0x38, 0xd2, 0x70, 0x73, 0x55, 0x7d, 0xff, 0x57,
+	0xdf, 0xff, 0xa8, 0xbf, 0x7f, 0xc3, 0xd3, 0x45, 0xdb, 0xc5, 0x5b, 0x98, 0x26, 0x19, 0xc7, 0xfa,
+	0x46, 0x1a, 0x3b, 0x56, 0xda, 0xc4, 0x9c, 0x6c, 0xe1, 0x6d, 0x46, 0xdc, 0x4d, 0xe2, 0xa6, 0x1c,
+	0xd7, 0xe6, 0x36, 0x1a, 0xe1, 0x9c, 0xa6, 0x02, 0x68, 0x6a, 0xf3, 0x5c, 0x62, 0xce, 0xb4, 0xf8,
+	0x7a, 0x79, 0x2d, 0xa5, 0xdb, 0xa5, 0x34, 0xa1, 0x9b, 0xf6, 0xb6, 0xe3, 0xda, 0x95, 0xed, 0xb4,
+	0x00, 0xeb, 0x49, 0x93, 0xd0, 0xe4, 0x26, 0x2e, 0x5a, 0x06, 0xe6, 0x24, 0xdd, 0xf1, 0xc3, 0xa7,
+	0x4c, 0x24, 0x9b, 0x28, 0x4c, 0xdb, 0xb4, 0x7d, 0xe3, 0xb5, 0x72, 0x41, 0xac, 0xc4, 0x42, 0xfc,
+	0x0a, 0xe0, 0xe3, 0xa6, 0x6d, 0x9b, 0x45, 0x22, 0x14, 0x62, 0x4a, 0x6d, 0x8e, 0xb9, 0x65, 0x53,
+	0x16, 0x9c, 0x2a, 0xc1, 0x69, 0x9d, 0xc3, 0x28, 0xbb, 0x02, 0x10, 0x9c, 0x9f, 0x6c, 0x3f, 0x27,
+	0x25, 0x87, 0x6f, 0x07, 0x87, 0x93, 0xed, 0x87, 0xdc, 0x2a, 0x11, 0xc6, 0x71, 0xc9, 0x09, 0x00,
+	0x13, 0x9d, 0x41, 0x22, 0xae, 0x6b, 0xbb, 0xa1, 0x7d, 0xd7, 0x18, 0x06, 0x80, 0x53, 0x9d, 0x00,
+	0xcb, 0x20, 0x94, 0x5b, 0x05, 0x8b, 0xb8, 0xac, 0x3b, 0x4b, 0x18, 0x70, 0x1f, 0x30, 0xd5, 0x09,
+	0x28, 0x11, 0xc6, 0xb0, 0x49, 0x42, 0x8a, 0xf1, 0x3d, 0x10, 0x37, 0x39, 0xef, 0x6e, 0xef, 0x12,
+	0xd3, 0xb2, 0x29, 0x2e, 0xfa, 0x08, 0xf5, 0x35, 0x80, 0x03, 0x39, 0x5f, 0xf9, 0xaa, 0x83, 0xae,
+	0xc0, 0xd1, 0xb2, 0x53, 0xb4, 0xe8, 0x46, 0x3e, 0xbc, 0x46, 0x06, 0x53, 0x7d, 0xd3, 0x83, 0x99,
+	0x89, 0x54, 0x6b, 0x35, 0xa4, 0x56, 0x05, 0x6c, 0xc9, 0x47, 0x69, 0x23, 0xe5, 0xe6, 0x25, 0x43,
+	0x0b, 0x70, 0x24, 0x08, 0x47, 0x9e, 0x71, 0xcc, 0xcb, 0x4c, 0x8e, 0x4c, 0x81, 0xbd, 0x68, 0x82,
+	0xab, 0x97, 0x05, 0x48, 0x1b, 0x36, 0x9b, 0x97, 0x68, 0x09, 0x1e, 0xe6, 0x95, 0x3c, 0xd6, 0x37,
+	0xa8, 0xbd, 0x55, 0x24, 0x86, 0x59, 0x22, 0x94, 0xcb, 0x7d, 0x82, 0x68, 0xaa, 0x9d, 0x68, 0xa5,
+	0x32, 0xd7, 0x82, 0xd3, 0xc6, 0x78, 0xdb, 0x8e, 0xfa, 0x31, 0x1c, 0x0c, 0xae, 0x5b, 0xb0, 0xb7,
+	0x28, 0xfa, 0x0f, 0x1c, 0x33, 0xec, 0x2d, 0xda, 0xec, 0xad, 0x0c, 0x04, 0xf9, 0x64, 0x3b, 0xf9,
+	0x42, 0x80, 0x0b, 0xdd, 0x1d, 0x35, 0x5a, 0x37, 0xd4, 0x1b, 0x50, 0x5e, 0xd6, 0xd7, 0x89, 0x51,
+	0x2e, 0x92, 0x10, 0xab, 0x11, 0xe6, 0xd8, 0x94, 0x11, 0x34, 0x07, 0x63, 0x06, 0x29, 0xe2, 0xed,
+	0x80, 0xfc, 0x44, 0xca, 0x2f, 0xbd, 0x54, 0x58, 0x7a, 0xa9, 0x85, 0xa0, 0x6e, 0xb3, 0x63, 0xef,
+	0xb2, 0xb1, 0x1f, 0x40, 0x24, 0x0e, 0x9e, 0xed, 0x4c, 0x4a, 0x0f, 0x5e, 0x4e, 0x02, 0xcd, 0xb7,
+	0x54, 0x6f, 0xc0, 0xf1, 0x76, 0xfa, 0xcb, 0x5e, 0x31, 0x2e, 0x10, 0x8e, 0xad, 0x22, 0x43, 0xff,
+	0x82, 0x83, 0x0e, 0xe6, 0xeb, 0x79, 0x51, 0xa1, 0x61, 0xca, 0xc6, 0xdb, 0xbd, 0x68, 0x36, 0xd1,
+	0xa0, 0x67, 0x20, 0x76, 0x98, 0xfa, 0x33, 0x80, 0xe3, 0xf3, 0xd8, 0xe1, 0x65, 0x97, 0x04, 0x01,
+	0x5a, 0x71, 0x71, 0xa1, 0x60, 0xe9, 0x1a, 0xb9, 0x59, 0x26, 0x8c, 0xa3, 0x55, 0x38, 0x18, 0xa6,
+	0xd3, 0x32, 0x58, 0xe0, 0x88, 0xda, 0x25, 0x97, 0x8b, 0x8d, 0x32, 0x17, 0x1e, 0x7d, 0x0e, 0x22,
+	0x63, 0xc2, 0xa3, 0xe7, 0x3b, 0x93, 0x40, 0x83, 0x66, 0x88, 0x62, 0x28, 0x07, 0xe3, 0xe1, 0x9b,
+	0x95, 0x23, 0x07, 0x0f, 0x4e, 0xdd, 0x58, 0xbd, 0x0d, 0x27, 0xba, 0xe8, 0x0f, 0x72, 0x70, 0x12,
+	0x0e, 0x14, 0xac, 0x22, 0xc9, 0x53, 0x5c, 0xf2, 0x93, 0x3c, 0xa0, 0xc5, 0xbd, 0x8d, 0xeb, 0xb8,
+	0x44, 0xd0, 0xbf, 0x61, 0x9c, 0x71, 0xdb, 0x61, 0x79, 0xcc, 0x03, 0x19, 0x89, 0x0e, 0x19, 0x2b,
+	0x61, 0x7b, 0xc8, 0xc6, 0xbd, 0xfb, 0xef, 0x7b, 0xf7, 0x1f, 0x12, 0x56, 0x73, 0x5c, 0xfd, 0x16,
+	0xc0, 0xc9, 0x1c, 0xe1, 0xad, 0x77, 0x07, 0x82, 0x3e, 0x70, 0x08, 0xff, 0xda, 0xec, 0x98, 0x27,
+	0x7e, 0x20, 0x0b, 0xdf, 0x65, 0x0f, 0xb9, 0xb1, 0x31, 0x20, 0xdf, 0x89, 0x34, 0x9c, 0x54, 0xaf,
+	0xc2, 0x63, 0x7b, 0xea, 0xdb, 0x3f, 0x34, 0x08, 0x46, 0x0d, 0xcc, 0xb1, 0x60, 0x1e, 0xd2, 0xc4,
+	0x6f, 0xf5, 0xfb, 0x01, 0x78, 0xa4, 0x95, 0xca, 0x7b, 0xae, 0x0c, 0x5d, 0x84, 0x31, 0xc6, 0xb1,
+	0xcb, 0x65, 0x70, 0x80, 0x18, 0xfa, 0x26, 0xe8, 0x02, 0xec, 0x23, 0xd4, 0x38, 0x50, 0xf4, 0x3d,
+	0x03, 0xf4, 0x67, 0x38, 0x14, 0xf4, 0x2b, 0xdd, 0x2e, 0x07, 0xcd, 0x21, 0xaa, 0x0d, 0xfa, 0x7b,
+	0xf3, 0xde, 0x16, 0x3a, 0x0d, 0x47, 0xea, 0xcf, 0xdc, 0x07, 0x45, 0x05, 0x68, 0x38, 0xdc, 0xf5,
+	0x61, 0xb3, 0x70, 0x54, 0x77, 0x75, 0xff, 0x05, 0x05, 0xb8, 0x98, 0x87, 0xcb, 0x1e, 0xae, 0xee,
+	0x4c, 0x0e, 0xcf, 0x6b, 0xf3, 0xe2, 0xad, 0x08, 0xac, 0x36, 0xac, 0xbb, 0x7a, 0x63, 0x89, 0xae,
+	0xc1, 0xb8, 0xbe, 0x8e, 0x29, 0x25, 0x45, 0x26, 0xf7, 0x8b, 0xa7, 0x37, 0xd3, 0x25, 0xaf, 0xcd,
+	0xf1, 0x4a, 0xcd, 0xfb, 0x36, 0x62, 0xa1, 0xd5, 0x19, 0xd0, 0x12, 0x1c, 0x60, 0xe5, 0xb5, 0xfc,
+	0x1a, 0xa6, 0x06, 0x93, 0x0f, 0xf5, 0x4e, 0xb7, 0x5c, 0x5e, 0xcb, 0x62, 0x6a, 0x04, 0x74, 0xcc,
+	0x5f, 0x31, 0xf4, 0x29, 0x1c, 0x73, 0xed, 0x32, 0x35, 0xf2, 0xdc, 0xb5, 0x9c, 0xbc, 0xf8, 0xca,
+	0xc9, 0x71, 0x11, 0xe6, 0x4c, 0x2f, 0xac, 0x9a, 0x67, 0xbb, 0xe2, 0x5a, 0x8e, 0x48, 0x81, 0x36,
+	0xe2, 0xb6, 0xac, 0x13, 0xbf, 0x00, 0x38, 0xd4, 0xec, 0x07, 0x1a, 0x87, 0x03, 0x05, 0xd7, 0x2b,
+	0x79, 0xaa, 0xfb, 0x0d, 0x2f, 0xaa, 0x35, 0x36, 0xd0, 0x7f, 0xe1, 0xa8, 0x57, 0x42, 0x79, 0x17,
+	0x73, 0x92, 0xb7, 0xa8, 0x41, 0x2a, 0x22, 0xe5, 0x23, 0x9d, 0xdf, 0x85, 0x05, 0xcc, 0xb1, 0x86,
+	0x39, 0x59, 0xf4, 0x40, 0xd9, 0xf8, 0xbb, 0x6c, 0xec, 0xae, 0xf7, 0x06, 0xb4, 0x61, 0xa3, 0xf9,
+	0xe0, 0x8f, 0xcb, 0x7f, 0xe2, 0x0b, 0x00, 0x87, 0x9a, 0x43, 0x88, 0x4e, 0xc1, 0xe1, 0x92, 0x45,
+	0xf3, 0xed, 0xde, 0x0c, 0x95, 0x2c, 0x7a, 0xa5, 0xee, 0x90, 0x07, 0xc2, 0x95, 0x26, 0x50, 0x24,
+	0x00, 0xe1, 0x4a, 0x03, 0xf4, 0x37, 0x78, 0xb4, 0xae, 0xa0, 0xcc, 0xad, 0xa2, 0xf5, 0x99, 0xdf,
+	0xf2, 0x3c, 0xb1, 0x11, 0xed, 0x48, 0x78, 0xb6, 0xda, 0x38, 0x4a, 0x3c, 0x89, 0xc0, 0x91, 0xd6,
+	0xd0, 0xa3, 0xf3, 0xb0, 0xaf, 0x64, 0xd1, 0xf7, 0x7f, 0x44, 0xe2, 0xf5, 0xfe, 0xe8, 0xe1, 0xd1,
+	0x25, 0xd8, 0x5f, 0x22, 0x86, 0x85, 0x7b, 0xe8, 0xb0, 0x0d, 0xcb, 0xc0, 0xc4, 0xbb, 0xd3, 0x99,
+	0x9d, 0x91, 0xfb, 0x7a, 0xb7, 0xf4, 0xf0, 0xbe, 0xd9, 0xac, 0x1c, 0x3d, 0x90, 0xd9, 0xac, 0xf0,
+	0x10, 0x57, 0xe4, 0xd8, 0x01, 0xcc, 0x4a, 0xb8, 0x82, 0x8e, 0xc2, 0x98, 0x9f, 0xd7, 0xfe, 0x29,
+	0x30, 0x3d, 0xac, 0xf9, 0x0b, 0x75, 0x07, 0xc0, 0x89, 0x8e, 0x9e, 0xec, 0x3f, 0x8e, 0x0f, 0xdb,
+	0x91, 0xff, 0x0e, 0xa3, 0x05, 0xd7, 0x2e, 0xf5, 0xd0, 0xcb, 0xa2, 0xa2, 0x8f, 0x09, 0x34, 0x9a,
+	0x81, 0x11, 0x6e, 0xcb, 0x7d, 0x3d, 0xda, 0x44, 0xb8, 0xad, 0x7e, 0x04, 0x13, 0x7b, 0x38, 0x77,
+	0xd5, 0x62, 0xdc, 0x76, 0xb7, 0xd1, 0xac, 0x68, 0xc6, 0x3c, 0x9c, 0x05, 0x4e, 0xf5, 0xf0, 0xd6,
+	0x35, 0xdf, 0x22, 0xf3, 0xb2, 0x0f, 0xc6, 0x72, 0x7c, 0x2b, 0xc7, 0xd0, 0x22, 0x1c, 0xbc, 0x66,
+	0xd1, 0x8d, 0x00, 0x8b, 0x4e, 0x74, 0x21, 0x59, 0x75, 0x12, 0x27, 0xbb, 0x1c, 0x79, 0xd3, 0xca,
+	0x34, 0x98, 0x01, 0x68, 0x19, 0x1e, 0xcb, 0x11, 0x3e, 0x6f, 0x53, 0x9d, 0x50, 0xee, 0x62, 0xee,
+	0xf5, 0x4e, 0x5a, 0xb0, 0x4c, 0x74, 0xbc, 0xc3, 0xd9, 0xcb, 0xde, 0x98, 0x9e, 0xe8, 0x48, 0xc4,
+	0x1e, 0xb6, 0x5f, 0x01, 0xc1, 0xba, 0xf4, 0xbf, 0x95, 0x95, 0x79, 0x9b, 0x52, 0xa2, 0x7b, 0xe5,
+	0xb1, 0x48, 0x0b, 0x36, 0xea, 0x21, 0x8d, 0x9d, 0x37, 0x74, 0xf2, 0xa8, 0x17, 0xee, 0xfe, 0xfa,
+	0xdb, 0x97, 0x91, 0x19, 0x94, 0x4a, 0x9b, 0xac, 0xfe, 0x27, 0x29, 0x7d, 0xab, 0x51, 0x37, 0xb7,
+	0xc5, 0xb4, 0x9d, 0xd4, 0xeb, 0x66, 0x49, 0xcb, 0xbb, 0xff, 0x6b, 0x00, 0xff, 0x14, 0x28, 0xfb,
+	0x7f, 0xe6, 0x03, 0x69, 0xfb, 0x87, 0xd0, 0x96, 0x41, 0x33, 0xfb, 0x6b, 0xdb, 0xcc, 0xb4, 0xab,
+	0xcb, 0x10, 0x18, 0xbd, 0xce, 0x72, 0x0c, 0xdd, 0x80, 0x63, 0xed, 0x63, 0x25, 0x7a, 0xdf, 0xec,
+	0x9b, 0x98, 0x6e, 0x07, 0x74, 0x1b, 0x7c, 0x33, 0x4f, 0x62, 0x30, 0x92, 0x63, 0x5e, 0x2c, 0x4e,
+	0x34, 0x5e, 0x62, 0xc3, 0x09, 0xbf, 0xcd, 0xf6, 0x12, 0x8d, 0xbf, 0x74, 0xc1, 0xb4, 0x71, 0xa9,
+	0x19, 0x11, 0x91, 0xb3, 0xe8, 0x4c, 0xf7, 0x88, 0x34, 0x42, 0x91, 0x16, 0xd5, 0x8e, 0x7e, 0x04,
+	0xf0, 0xd8, 0x9e, 0xb3, 0x23, 0x3a, 0xdb, 0x51, 0x81, 0xfb, 0x8c, 0xc8, 0x89, 0x64, 0x8f, 0x68,
+	0x3f, 0x36, 0x61, 0xf2, 0xd4, 0x64, 0x37, 0xa9, 0x2c, 0xd5, 0x22, 0xdb, 0x27, 0xbb, 0x08, 0xce,
+	0xa0, 0x47, 0x00, 0x1e, 0xdf, 0xbb, 0xb1, 0xa1, 0x0e, 0x0d, 0xfb, 0x36, 0xc0, 0xc4, 0x99, 0x1e,
+	0x9a, 0x42, 0xd0, 0x4f, 0xd4, 0x4b, 0x42, 0xef, 0x79, 0x74, 0xae, 0x37, 0xbd, 0xdc, 0xa7, 0x08,
+	0x62, 0xfc, 0x13, 0x80, 0x72, 0xb7, 0xf9, 0x18, 0xa5, 0xdf, 0x2b, 0xba, 0x75, 0x92, 0x4e, 0x9c,
+	0xde, 0x5f, 0x76, 0x80, 0x56, 0xb3, 0x42, 0xf1, 0x3f, 0xd1, 0xc5, 0x03, 0x45, 0x98, 0xa5, 0x6f,
+	0xd5, 0xa7, 0xe1, 0xdb, 0xd9, 0xef, 0xc0, 0xb3, 0x5d, 0x05, 0x3c, 0xdf, 0x55, 0xc0, 0x8b, 0x5d,
+	0x45, 0x7a, 0xb5, 0xab, 0x48, 0xaf, 0x77, 0x15, 0xe9, 0xcd, 0xae, 0x22, 0xbd, 0xdd, 0x55, 0xc0,
+	0x9d, 0xaa, 0x02, 0xee, 0x55, 0x15, 0xe9, 0x61, 0x55, 0x01, 0x8f, 0xaa, 0x8a, 0xf4, 0xb8, 0xaa,
+	0x48, 0x4f, 0xab, 0x8a, 0xf4, 0xac, 0xaa, 0x80, 0xe7, 0x55, 0x05, 0xbc, 0xa8, 0x2a, 0xd2, 0xab,
+	0xaa, 0x02, 0x5e, 0x57, 0x15, 0xe9, 0x4d, 0x55, 0x01, 0x6f, 0xab, 0x8a, 0x74, 0xa7, 0xa6, 0x48,
+	0xf7, 0x6a, 0x0a, 0xb8, 0x5f, 0x53, 0xa4, 0x07, 0x35, 0x05, 0x7c, 0x53, 0x53, 0xa4, 0x87, 0x35,
+	0x45, 0x7a, 0x54, 0x53, 0xc0, 0xe3, 0x9a, 0x02, 0x9e, 0xd6, 0x14, 0xf0, 0xc9, 0x59, 0xd3, 0x4e,
+	0xf1, 0x75, 0xc2, 0xd7, 0x2d, 0x6a, 0xb2, 0x14, 0x25, 0x7c, 0xcb, 0x76, 0x37, 0xd2, 0xad, 0x7f,
+	0xe5, 0x9d, 0x0d, 0x33, 0xcd, 0x39, 0x75, 0xd6, 0xd6, 0xfa, 0x45, 0xe7, 0x3c, 0xf7, 0x7b, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x24, 0xd4, 0x06, 0x06, 0xd7, 0x11, 0x00, 0x00,
+}
+
+func (this *GatewayUp) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayUp)
+	if !ok {
+		that2, ok := that.(GatewayUp)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if r.Intn(5) != 0 {
-		this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if r.Intn(5) != 0 {
-		this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
+	if len(this.UplinkMessages) != len(that1.UplinkMessages) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	for i := range this.UplinkMessages {
+		if !this.UplinkMessages[i].Equal(that1.UplinkMessages[i]) {
+			return false
+		}
 	}
-	return this
-}
-
-func NewPopulatedGatewayDown(r randyGatewayserver, easy bool) *GatewayDown {
-	this := &GatewayDown{}
-	if r.Intn(5) != 0 {
-		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+	if !this.GatewayStatus.Equal(that1.GatewayStatus) {
+		return false
 	}
-	if !easy && r.Intn(10) != 0 {
+	if !this.TxAcknowledgment.Equal(that1.TxAcknowledgment) {
+		return false
 	}
-	return this
+	return true
 }
-
-func NewPopulatedScheduleDownlinkResponse(r randyGatewayserver, easy bool) *ScheduleDownlinkResponse {
-	this := &ScheduleDownlinkResponse{}
-	v2 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Delay = *v2
-	if !easy && r.Intn(10) != 0 {
+func (this *GatewayDown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return this
-}
 
-func NewPopulatedScheduleDownlinkErrorDetails(r randyGatewayserver, easy bool) *ScheduleDownlinkErrorDetails {
-	this := &ScheduleDownlinkErrorDetails{}
-	if r.Intn(5) == 0 {
-		v3 := r.Intn(5)
-		this.PathErrors = make([]*ErrorDetails, v3)
-		for i := 0; i < v3; i++ {
-			this.PathErrors[i] = NewPopulatedErrorDetails(r, easy)
+	that1, ok := that.(*GatewayDown)
+	if !ok {
+		that2, ok := that.(GatewayDown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
-}
-
-type randyGatewayserver interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
-}
-
-func randUTF8RuneGatewayserver(r randyGatewayserver) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
+		return false
 	}
-	return rune(ru + 61)
+	return true
 }
-func randStringGatewayserver(r randyGatewayserver) string {
-	v4 := r.Intn(100)
-	tmps := make([]rune, v4)
-	for i := 0; i < v4; i++ {
-		tmps[i] = randUTF8RuneGatewayserver(r)
+func (this *ScheduleDownlinkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return string(tmps)
-}
-func randUnrecognizedGatewayserver(r randyGatewayserver, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+
+	that1, ok := that.(*ScheduleDownlinkResponse)
+	if !ok {
+		that2, ok := that.(ScheduleDownlinkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldGatewayserver(dAtA, r, fieldNumber, wire)
 	}
-	return dAtA
-}
-func randFieldGatewayserver(dAtA []byte, r randyGatewayserver, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		v5 := r.Int63()
-		if r.Intn(2) == 0 {
-			v5 *= -1
-		}
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(v5))
-	case 1:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA
-}
-func encodeVarintPopulateGatewayserver(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
+	if this.Delay != that1.Delay {
+		return false
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+	return true
 }
-func (m *GatewayUp) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ScheduleDownlinkErrorDetails) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.UplinkMessages) > 0 {
-		for _, e := range m.UplinkMessages {
-			l = e.Size()
-			n += 1 + l + sovGatewayserver(uint64(l))
+
+	that1, ok := that.(*ScheduleDownlinkErrorDetails)
+	if !ok {
+		that2, ok := that.(ScheduleDownlinkErrorDetails)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.GatewayStatus != nil {
-		l = m.GatewayStatus.Size()
-		n += 1 + l + sovGatewayserver(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.TxAcknowledgment != nil {
-		l = m.TxAcknowledgment.Size()
-		n += 1 + l + sovGatewayserver(uint64(l))
+	if len(this.PathErrors) != len(that1.PathErrors) {
+		return false
 	}
-	return n
+	for i := range this.PathErrors {
+		if !this.PathErrors[i].Equal(that1.PathErrors[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *CaptureGatewayTrafficRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GatewayDown) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*CaptureGatewayTrafficRequest)
+	if !ok {
+		that2, ok := that.(CaptureGatewayTrafficRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.DownlinkMessage != nil {
-		l = m.DownlinkMessage.Size()
-		n += 1 + l + sovGatewayserver(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *ScheduleDownlinkResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
-	n += 1 + l + sovGatewayserver(uint64(l))
-	return n
+	if this.Duration != that1.Duration {
+		return false
+	}
+	return true
 }
+func (this *CaptureGatewayTrafficResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ScheduleDownlinkErrorDetails) Size() (n int) {
-	if m == nil {
+	that1, ok := that.(*CaptureGatewayTrafficResponse)
+	if !ok {
+		that2, ok := that.(CaptureGatewayTrafficResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FileName != that1.FileName {
+		return false
+	}
+	if !this.StopsAt.Equal(that1.StopsAt) {
+		return false
+	}
+	return true
+}
+func (this *GetGatewayTrafficCaptureRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetGatewayTrafficCaptureRequest)
+	if !ok {
+		that2, ok := that.(GetGatewayTrafficCaptureRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if this.FileName != that1.FileName {
+		return false
+	}
+	return true
+}
+func (this *GatewayTrafficCapture) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTrafficCapture)
+	if !ok {
+		that2, ok := that.(GatewayTrafficCapture)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FileName != that1.FileName {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *GatewayTrafficStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTrafficStats)
+	if !ok {
+		that2, ok := that.(GatewayTrafficStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if !this.End.Equal(that1.End) {
+		return false
+	}
+	if this.UplinkCount != that1.UplinkCount {
+		return false
+	}
+	if this.DownlinkCount != that1.DownlinkCount {
+		return false
+	}
+	if this.CRCErrorCount != that1.CRCErrorCount {
+		return false
+	}
+	if len(this.Channels) != len(that1.Channels) {
+		return false
+	}
+	for i := range this.Channels {
+		if !this.Channels[i].Equal(that1.Channels[i]) {
+			return false
+		}
+	}
+	if len(this.SubBands) != len(that1.SubBands) {
+		return false
+	}
+	for i := range this.SubBands {
+		if !this.SubBands[i].Equal(that1.SubBands[i]) {
+			return false
+		}
+	}
+	if !this.RoundTripTimes.Equal(that1.RoundTripTimes) {
+		return false
+	}
+	return true
+}
+func (this *GatewayTrafficStats_ChannelStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTrafficStats_ChannelStats)
+	if !ok {
+		that2, ok := that.(GatewayTrafficStats_ChannelStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Frequency != that1.Frequency {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.UplinkCount != that1.UplinkCount {
+		return false
+	}
+	if this.DownlinkCount != that1.DownlinkCount {
+		return false
+	}
+	return true
+}
+func (this *GatewayTrafficStats_SubBandStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTrafficStats_SubBandStats)
+	if !ok {
+		that2, ok := that.(GatewayTrafficStats_SubBandStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinFrequency != that1.MinFrequency {
+		return false
+	}
+	if this.MaxFrequency != that1.MaxFrequency {
+		return false
+	}
+	if this.DownlinkUtilization != that1.DownlinkUtilization {
+		return false
+	}
+	return true
+}
+func (this *GatewayTrafficStats_RoundTripTimes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTrafficStats_RoundTripTimes)
+	if !ok {
+		that2, ok := that.(GatewayTrafficStats_RoundTripTimes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Min != that1.Min {
+		return false
+	}
+	if this.Median != that1.Median {
+		return false
+	}
+	if this.P90 != that1.P90 {
+		return false
+	}
+	if this.P99 != that1.P99 {
+		return false
+	}
+	if this.Max != that1.Max {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *GetGatewayTrafficStatsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetGatewayTrafficStatsRequest)
+	if !ok {
+		that2, ok := that.(GetGatewayTrafficStatsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if that1.From == nil {
+		if this.From != nil {
+			return false
+		}
+	} else if !this.From.Equal(*that1.From) {
+		return false
+	}
+	if that1.To == nil {
+		if this.To != nil {
+			return false
+		}
+	} else if !this.To.Equal(*that1.To) {
+		return false
+	}
+	return true
+}
+func (this *GatewayTrafficStatsHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTrafficStatsHistory)
+	if !ok {
+		that2, ok := that.(GatewayTrafficStatsHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Stats) != len(that1.Stats) {
+		return false
+	}
+	for i := range this.Stats {
+		if !this.Stats[i].Equal(that1.Stats[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GtwGsClient is the client API for GtwGs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GtwGsClient interface {
+	// Link the gateway to the Gateway Server.
+	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
+	// GetConcentratorConfig associated to the gateway.
+	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
+	// Get the MQTT server address and the username for the gateway.
+	GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+	// Get the MQTTV2 server address and the username for the gateway.
+	GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+}
+
+type gtwGsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGtwGsClient(cc *grpc.ClientConn) GtwGsClient {
+	return &gtwGsClient{cc}
+}
+
+func (c *gtwGsClient) LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GtwGs_serviceDesc.Streams[0], "/ttn.lorawan.v3.GtwGs/LinkGateway", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gtwGsLinkGatewayClient{stream}
+	return x, nil
+}
+
+type GtwGs_LinkGatewayClient interface {
+	Send(*GatewayUp) error
+	Recv() (*GatewayDown, error)
+	grpc.ClientStream
+}
+
+type gtwGsLinkGatewayClient struct {
+	grpc.ClientStream
+}
+
+func (x *gtwGsLinkGatewayClient) Send(m *GatewayUp) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gtwGsLinkGatewayClient) Recv() (*GatewayDown, error) {
+	m := new(GatewayDown)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gtwGsClient) GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error) {
+	out := new(ConcentratorConfig)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gtwGsClient) GetMQTTConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
+	out := new(MQTTConnectionInfo)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gtwGsClient) GetMQTTV2ConnectionInfo(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
+	out := new(MQTTConnectionInfo)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GtwGsServer is the server API for GtwGs service.
+type GtwGsServer interface {
+	// Link the gateway to the Gateway Server.
+	LinkGateway(GtwGs_LinkGatewayServer) error
+	// GetConcentratorConfig associated to the gateway.
+	GetConcentratorConfig(context.Context, *types.Empty) (*ConcentratorConfig, error)
+	// Get the MQTT server address and the username for the gateway.
+	GetMQTTConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
+	// Get the MQTTV2 server address and the username for the gateway.
+	GetMQTTV2ConnectionInfo(context.Context, *GatewayIdentifiers) (*MQTTConnectionInfo, error)
+}
+
+// UnimplementedGtwGsServer can be embedded to have forward compatible implementations.
+type UnimplementedGtwGsServer struct {
+}
+
+func (*UnimplementedGtwGsServer) LinkGateway(srv GtwGs_LinkGatewayServer) error {
+	return status.Errorf(codes.Unimplemented, "method LinkGateway not implemented")
+}
+func (*UnimplementedGtwGsServer) GetConcentratorConfig(ctx context.Context, req *types.Empty) (*ConcentratorConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConcentratorConfig not implemented")
+}
+func (*UnimplementedGtwGsServer) GetMQTTConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTConnectionInfo not implemented")
+}
+func (*UnimplementedGtwGsServer) GetMQTTV2ConnectionInfo(ctx context.Context, req *GatewayIdentifiers) (*MQTTConnectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTV2ConnectionInfo not implemented")
+}
+
+func RegisterGtwGsServer(s *grpc.Server, srv GtwGsServer) {
+	s.RegisterService(&_GtwGs_serviceDesc, srv)
+}
+
+func _GtwGs_LinkGateway_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GtwGsServer).LinkGateway(&gtwGsLinkGatewayServer{stream})
+}
+
+type GtwGs_LinkGatewayServer interface {
+	Send(*GatewayDown) error
+	Recv() (*GatewayUp, error)
+	grpc.ServerStream
+}
+
+type gtwGsLinkGatewayServer struct {
+	grpc.ServerStream
+}
+
+func (x *gtwGsLinkGatewayServer) Send(m *GatewayDown) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gtwGsLinkGatewayServer) Recv() (*GatewayUp, error) {
+	m := new(GatewayUp)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GtwGs_GetConcentratorConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GtwGsServer).GetConcentratorConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GtwGs/GetConcentratorConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GtwGsServer).GetConcentratorConfig(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GtwGs_GetMQTTConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTConnectionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GtwGsServer).GetMQTTConnectionInfo(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GtwGs_GetMQTTV2ConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GtwGs/GetMQTTV2ConnectionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GtwGsServer).GetMQTTV2ConnectionInfo(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GtwGs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.GtwGs",
+	HandlerType: (*GtwGsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetConcentratorConfig",
+			Handler:    _GtwGs_GetConcentratorConfig_Handler,
+		},
+		{
+			MethodName: "GetMQTTConnectionInfo",
+			Handler:    _GtwGs_GetMQTTConnectionInfo_Handler,
+		},
+		{
+			MethodName: "GetMQTTV2ConnectionInfo",
+			Handler:    _GtwGs_GetMQTTV2ConnectionInfo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "LinkGateway",
+			Handler:       _GtwGs_LinkGateway_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "lorawan-stack/api/gatewayserver.proto",
+}
+
+// NsGsClient is the client API for NsGs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsGsClient interface {
+	// ScheduleDownlink instructs the Gateway Server to schedule a downlink message.
+	// The Gateway Server may refuse if there are any conflicts in the schedule or
+	// if a duty cycle prevents the gateway from transmitting.
+	ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error)
+}
+
+type nsGsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsGsClient(cc *grpc.ClientConn) NsGsClient {
+	return &nsGsClient{cc}
+}
+
+func (c *nsGsClient) ScheduleDownlink(ctx context.Context, in *DownlinkMessage, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error) {
+	out := new(ScheduleDownlinkResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsGs/ScheduleDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsGsServer is the server API for NsGs service.
+type NsGsServer interface {
+	// ScheduleDownlink instructs the Gateway Server to schedule a downlink message.
+	// The Gateway Server may refuse if there are any conflicts in the schedule or
+	// if a duty cycle prevents the gateway from transmitting.
+	ScheduleDownlink(context.Context, *DownlinkMessage) (*ScheduleDownlinkResponse, error)
+}
+
+// UnimplementedNsGsServer can be embedded to have forward compatible implementations.
+type UnimplementedNsGsServer struct {
+}
+
+func (*UnimplementedNsGsServer) ScheduleDownlink(ctx context.Context, req *DownlinkMessage) (*ScheduleDownlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleDownlink not implemented")
+}
+
+func RegisterNsGsServer(s *grpc.Server, srv NsGsServer) {
+	s.RegisterService(&_NsGs_serviceDesc, srv)
+}
+
+func _NsGs_ScheduleDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownlinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsGsServer).ScheduleDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsGs/ScheduleDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsGsServer).ScheduleDownlink(ctx, req.(*DownlinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NsGs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsGs",
+	HandlerType: (*NsGsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleDownlink",
+			Handler:    _NsGs_ScheduleDownlink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/gatewayserver.proto",
+}
+
+// GsClient is the client API for Gs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GsClient interface {
+	// Get statistics about the current gateway connection to the Gateway Server.
+	// This is not persisted between reconnects.
+	GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error)
+	// Capture the raw traffic that the Gateway Server receives from the gateway for the given duration.
+	// The traffic is written to a capture file on the Gateway Server, which can be replayed to a Gateway Server.
+	CaptureGatewayTraffic(ctx context.Context, in *CaptureGatewayTrafficRequest, opts ...grpc.CallOption) (*CaptureGatewayTrafficResponse, error)
+	// Get the traffic statistics of the gateway over time.
+	// The statistics are aggregated per period, as configured in the Gateway Server.
+	GetGatewayTrafficStats(ctx context.Context, in *GetGatewayTrafficStatsRequest, opts ...grpc.CallOption) (*GatewayTrafficStatsHistory, error)
+	// Get a capture file of the raw traffic of the gateway.
+	// Captures are available when they are stopped. If a capture bucket is configured, captures can be retrieved from
+	// any Gateway Server instance. Otherwise, captures are only available on the Gateway Server instance that captured
+	// the traffic.
+	GetGatewayTrafficCapture(ctx context.Context, in *GetGatewayTrafficCaptureRequest, opts ...grpc.CallOption) (*GatewayTrafficCapture, error)
+}
+
+type gsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGsClient(cc *grpc.ClientConn) GsClient {
+	return &gsClient{cc}
+}
+
+func (c *gsClient) GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error) {
+	out := new(GatewayConnectionStats)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) CaptureGatewayTraffic(ctx context.Context, in *CaptureGatewayTrafficRequest, opts ...grpc.CallOption) (*CaptureGatewayTrafficResponse, error) {
+	out := new(CaptureGatewayTrafficResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/CaptureGatewayTraffic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) GetGatewayTrafficStats(ctx context.Context, in *GetGatewayTrafficStatsRequest, opts ...grpc.CallOption) (*GatewayTrafficStatsHistory, error) {
+	out := new(GatewayTrafficStatsHistory)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayTrafficStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) GetGatewayTrafficCapture(ctx context.Context, in *GetGatewayTrafficCaptureRequest, opts ...grpc.CallOption) (*GatewayTrafficCapture, error) {
+	out := new(GatewayTrafficCapture)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayTrafficCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GsServer is the server API for Gs service.
+type GsServer interface {
+	// Get statistics about the current gateway connection to the Gateway Server.
+	// This is not persisted between reconnects.
+	GetGatewayConnectionStats(context.Context, *GatewayIdentifiers) (*GatewayConnectionStats, error)
+	// Capture the raw traffic that the Gateway Server receives from the gateway for the given duration.
+	// The traffic is written to a capture file on the Gateway Server, which can be replayed to a Gateway Server.
+	CaptureGatewayTraffic(context.Context, *CaptureGatewayTrafficRequest) (*CaptureGatewayTrafficResponse, error)
+	// Get the traffic statistics of the gateway over time.
+	// The statistics are aggregated per period, as configured in the Gateway Server.
+	GetGatewayTrafficStats(context.Context, *GetGatewayTrafficStatsRequest) (*GatewayTrafficStatsHistory, error)
+	// Get a capture file of the raw traffic of the gateway.
+	// Captures are available when they are stopped. If a capture bucket is configured, captures can be retrieved from
+	// any Gateway Server instance. Otherwise, captures are only available on the Gateway Server instance that captured
+	// the traffic.
+	GetGatewayTrafficCapture(context.Context, *GetGatewayTrafficCaptureRequest) (*GatewayTrafficCapture, error)
+}
+
+// UnimplementedGsServer can be embedded to have forward compatible implementations.
+type UnimplementedGsServer struct {
+}
+
+func (*UnimplementedGsServer) GetGatewayConnectionStats(ctx context.Context, req *GatewayIdentifiers) (*GatewayConnectionStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStats not implemented")
+}
+func (*UnimplementedGsServer) CaptureGatewayTraffic(ctx context.Context, req *CaptureGatewayTrafficRequest) (*CaptureGatewayTrafficResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureGatewayTraffic not implemented")
+}
+func (*UnimplementedGsServer) GetGatewayTrafficStats(ctx context.Context, req *GetGatewayTrafficStatsRequest) (*GatewayTrafficStatsHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayTrafficStats not implemented")
+}
+func (*UnimplementedGsServer) GetGatewayTrafficCapture(ctx context.Context, req *GetGatewayTrafficCaptureRequest) (*GatewayTrafficCapture, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayTrafficCapture not implemented")
+}
+
+func RegisterGsServer(s *grpc.Server, srv GsServer) {
+	s.RegisterService(&_Gs_serviceDesc, srv)
+}
+
+func _Gs_GetGatewayConnectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewayConnectionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewayConnectionStats(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_CaptureGatewayTraffic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureGatewayTrafficRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).CaptureGatewayTraffic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/CaptureGatewayTraffic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).CaptureGatewayTraffic(ctx, req.(*CaptureGatewayTrafficRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_GetGatewayTrafficStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayTrafficStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewayTrafficStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayTrafficStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewayTrafficStats(ctx, req.(*GetGatewayTrafficStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_GetGatewayTrafficCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayTrafficCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewayTrafficCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayTrafficCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewayTrafficCapture(ctx, req.(*GetGatewayTrafficCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Gs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.Gs",
+	HandlerType: (*GsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGatewayConnectionStats",
+			Handler:    _Gs_GetGatewayConnectionStats_Handler,
+		},
+		{
+			MethodName: "CaptureGatewayTraffic",
+			Handler:    _Gs_CaptureGatewayTraffic_Handler,
+		},
+		{
+			MethodName: "GetGatewayTrafficStats",
+			Handler:    _Gs_GetGatewayTrafficStats_Handler,
+		},
+		{
+			MethodName: "GetGatewayTrafficCapture",
+			Handler:    _Gs_GetGatewayTrafficCapture_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/gatewayserver.proto",
+}
+
+func (m *GatewayUp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayUp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxAcknowledgment != nil {
+		{
+			size, err := m.TxAcknowledgment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GatewayStatus != nil {
+		{
+			size, err := m.GatewayStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UplinkMessages) > 0 {
+		for iNdEx := len(m.UplinkMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UplinkMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayDown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayDown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DownlinkMessage != nil {
+		{
+			size, err := m.DownlinkMessage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleDownlinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleDownlinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleDownlinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleDownlinkErrorDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleDownlinkErrorDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleDownlinkErrorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PathErrors) > 0 {
+		for iNdEx := len(m.PathErrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PathErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CaptureGatewayTrafficRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CaptureGatewayTrafficRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CaptureGatewayTrafficRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CaptureGatewayTrafficResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CaptureGatewayTrafficResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CaptureGatewayTrafficResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StopsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StopsAt):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetGatewayTrafficCaptureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGatewayTrafficCaptureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGatewayTrafficCaptureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayTrafficCapture) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayTrafficCapture) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTrafficCapture) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayTrafficStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayTrafficStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RoundTripTimes != nil {
+		{
+			size, err := m.RoundTripTimes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SubBands) > 0 {
+		for iNdEx := len(m.SubBands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubBands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.CRCErrorCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.CRCErrorCount)
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DownlinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.DownlinkCount)
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UplinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.UplinkCount)
+		i--
+		dAtA[i] = 0x18
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.End):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayTrafficStats_ChannelStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayTrafficStats_ChannelStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTrafficStats_ChannelStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DownlinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.DownlinkCount)
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UplinkCount != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.UplinkCount)
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DataRateIndex != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Frequency != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.Frequency)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayTrafficStats_SubBandStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayTrafficStats_SubBandStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTrafficStats_SubBandStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DownlinkUtilization != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], math.Float32bits(float32(m.DownlinkUtilization)))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if m.MaxFrequency != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.MaxFrequency)
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinFrequency != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, m.MinFrequency)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayTrafficStats_RoundTripTimes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayTrafficStats_RoundTripTimes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTrafficStats_RoundTripTimes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x30
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x2a
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.P99, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.P99):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x22
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.P90, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.P90):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x12
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintGatewayserver(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetGatewayTrafficStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGatewayTrafficStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetGatewayTrafficStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.To != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.To, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.To):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.From, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.From):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintGatewayserver(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GatewayIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayTrafficStatsHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayTrafficStatsHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayTrafficStatsHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGatewayserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGatewayserver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedGatewayUp(r randyGatewayserver, easy bool) *GatewayUp {
+	this := &GatewayUp{}
+	if r.Intn(5) != 0 {
+		v1 := r.Intn(5)
+		this.UplinkMessages = make([]*UplinkMessage, v1)
+		for i := 0; i < v1; i++ {
+			this.UplinkMessages[i] = NewPopulatedUplinkMessage(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayDown(r randyGatewayserver, easy bool) *GatewayDown {
+	this := &GatewayDown{}
+	if r.Intn(5) != 0 {
+		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScheduleDownlinkResponse(r randyGatewayserver, easy bool) *ScheduleDownlinkResponse {
+	this := &ScheduleDownlinkResponse{}
+	v2 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Delay = *v2
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScheduleDownlinkErrorDetails(r randyGatewayserver, easy bool) *ScheduleDownlinkErrorDetails {
+	this := &ScheduleDownlinkErrorDetails{}
+	if r.Intn(5) == 0 {
+		v3 := r.Intn(5)
+		this.PathErrors = make([]*ErrorDetails, v3)
+		for i := 0; i < v3; i++ {
+			this.PathErrors[i] = NewPopulatedErrorDetails(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCaptureGatewayTrafficRequest(r randyGatewayserver, easy bool) *CaptureGatewayTrafficRequest {
+	this := &CaptureGatewayTrafficRequest{}
+	v4 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v4
+	v5 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Duration = *v5
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCaptureGatewayTrafficResponse(r randyGatewayserver, easy bool) *CaptureGatewayTrafficResponse {
+	this := &CaptureGatewayTrafficResponse{}
+	this.FileName = randStringGatewayserver(r)
+	v6 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.StopsAt = *v6
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetGatewayTrafficCaptureRequest(r randyGatewayserver, easy bool) *GetGatewayTrafficCaptureRequest {
+	this := &GetGatewayTrafficCaptureRequest{}
+	v7 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v7
+	this.FileName = randStringGatewayserver(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayTrafficCapture(r randyGatewayserver, easy bool) *GatewayTrafficCapture {
+	this := &GatewayTrafficCapture{}
+	this.FileName = randStringGatewayserver(r)
+	v8 := r.Intn(100)
+	this.Data = make([]byte, v8)
+	for i := 0; i < v8; i++ {
+		this.Data[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayTrafficStats(r randyGatewayserver, easy bool) *GatewayTrafficStats {
+	this := &GatewayTrafficStats{}
+	v9 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Start = *v9
+	v10 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.End = *v10
+	this.UplinkCount = uint64(r.Uint32())
+	this.DownlinkCount = uint64(r.Uint32())
+	this.CRCErrorCount = uint64(r.Uint32())
+	if r.Intn(5) != 0 {
+		v11 := r.Intn(5)
+		this.Channels = make([]*GatewayTrafficStats_ChannelStats, v11)
+		for i := 0; i < v11; i++ {
+			this.Channels[i] = NewPopulatedGatewayTrafficStats_ChannelStats(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v12 := r.Intn(5)
+		this.SubBands = make([]*GatewayTrafficStats_SubBandStats, v12)
+		for i := 0; i < v12; i++ {
+			this.SubBands[i] = NewPopulatedGatewayTrafficStats_SubBandStats(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.RoundTripTimes = NewPopulatedGatewayTrafficStats_RoundTripTimes(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayTrafficStats_ChannelStats(r randyGatewayserver, easy bool) *GatewayTrafficStats_ChannelStats {
+	this := &GatewayTrafficStats_ChannelStats{}
+	this.Frequency = uint64(r.Uint32())
+	this.DataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.UplinkCount = uint64(r.Uint32())
+	this.DownlinkCount = uint64(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayTrafficStats_SubBandStats(r randyGatewayserver, easy bool) *GatewayTrafficStats_SubBandStats {
+	this := &GatewayTrafficStats_SubBandStats{}
+	this.MinFrequency = uint64(r.Uint32())
+	this.MaxFrequency = uint64(r.Uint32())
+	this.DownlinkUtilization = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.DownlinkUtilization *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayTrafficStats_RoundTripTimes(r randyGatewayserver, easy bool) *GatewayTrafficStats_RoundTripTimes {
+	this := &GatewayTrafficStats_RoundTripTimes{}
+	v13 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Min = *v13
+	v14 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Median = *v14
+	v15 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.P90 = *v15
+	v16 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.P99 = *v16
+	v17 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Max = *v17
+	this.Count = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetGatewayTrafficStatsRequest(r randyGatewayserver, easy bool) *GetGatewayTrafficStatsRequest {
+	this := &GetGatewayTrafficStatsRequest{}
+	v18 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v18
+	if r.Intn(5) != 0 {
+		this.From = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.To = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayTrafficStatsHistory(r randyGatewayserver, easy bool) *GatewayTrafficStatsHistory {
+	this := &GatewayTrafficStatsHistory{}
+	if r.Intn(5) != 0 {
+		v19 := r.Intn(5)
+		this.Stats = make([]*GatewayTrafficStats, v19)
+		for i := 0; i < v19; i++ {
+			this.Stats[i] = NewPopulatedGatewayTrafficStats(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyGatewayserver interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneGatewayserver(r randyGatewayserver) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringGatewayserver(r randyGatewayserver) string {
+	v20 := r.Intn(100)
+	tmps := make([]rune, v20)
+	for i := 0; i < v20; i++ {
+		tmps[i] = randUTF8RuneGatewayserver(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedGatewayserver(r randyGatewayserver, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldGatewayserver(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldGatewayserver(dAtA []byte, r randyGatewayserver, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		v21 := r.Int63()
+		if r.Intn(2) == 0 {
+			v21 *= -1
+		}
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(v21))
+	case 1:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateGatewayserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateGatewayserver(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *GatewayUp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UplinkMessages) > 0 {
+		for _, e := range m.UplinkMessages {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if m.GatewayStatus != nil {
+		l = m.GatewayStatus.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.TxAcknowledgment != nil {
+		l = m.TxAcknowledgment.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayDown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DownlinkMessage != nil {
+		l = m.DownlinkMessage.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleDownlinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+
+func (m *ScheduleDownlinkErrorDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PathErrors) > 0 {
+		for _, e := range m.PathErrors {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CaptureGatewayTrafficRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+
+func (m *CaptureGatewayTrafficResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StopsAt)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+
+func (m *GetGatewayTrafficCaptureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayTrafficCapture) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayTrafficStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.UplinkCount != 0 {
+		n += 1 + sovGatewayserver(m.UplinkCount)
+	}
+	if m.DownlinkCount != 0 {
+		n += 1 + sovGatewayserver(m.DownlinkCount)
+	}
+	if m.CRCErrorCount != 0 {
+		n += 1 + sovGatewayserver(m.CRCErrorCount)
+	}
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if len(m.SubBands) > 0 {
+		for _, e := range m.SubBands {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	if m.RoundTripTimes != nil {
+		l = m.RoundTripTimes.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayTrafficStats_ChannelStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frequency != 0 {
+		n += 1 + sovGatewayserver(m.Frequency)
+	}
+	if m.DataRateIndex != 0 {
+		n += 1 + sovGatewayserver(uint64(m.DataRateIndex))
+	}
+	if m.UplinkCount != 0 {
+		n += 1 + sovGatewayserver(m.UplinkCount)
+	}
+	if m.DownlinkCount != 0 {
+		n += 1 + sovGatewayserver(m.DownlinkCount)
+	}
+	return n
+}
+
+func (m *GatewayTrafficStats_SubBandStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinFrequency != 0 {
+		n += 1 + sovGatewayserver(m.MinFrequency)
+	}
+	if m.MaxFrequency != 0 {
+		n += 1 + sovGatewayserver(m.MaxFrequency)
+	}
+	if m.DownlinkUtilization != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *GatewayTrafficStats_RoundTripTimes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.P90)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.P99)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *GetGatewayTrafficStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.From != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.From)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.To != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.To)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GatewayTrafficStatsHistory) Size() (n int) {
+	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if len(m.PathErrors) > 0 {
-		for _, e := range m.PathErrors {
-			l = e.Size()
-			n += 1 + l + sovGatewayserver(uint64(l))
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGatewayserver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGatewayserver(x uint64) (n int) {
+	return sovGatewayserver((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *GatewayUp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUplinkMessages := "[]*UplinkMessage{"
+	for _, f := range this.UplinkMessages {
+		repeatedStringForUplinkMessages += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
+	}
+	repeatedStringForUplinkMessages += "}"
+	s := strings.Join([]string{`&GatewayUp{`,
+		`UplinkMessages:` + repeatedStringForUplinkMessages + `,`,
+		`GatewayStatus:` + strings.Replace(fmt.Sprintf("%v", this.GatewayStatus), "GatewayStatus", "GatewayStatus", 1) + `,`,
+		`TxAcknowledgment:` + strings.Replace(fmt.Sprintf("%v", this.TxAcknowledgment), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayDown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayDown{`,
+		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleDownlinkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleDownlinkResponse{`,
+		`Delay:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Delay), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleDownlinkErrorDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPathErrors := "[]*ErrorDetails{"
+	for _, f := range this.PathErrors {
+		repeatedStringForPathErrors += strings.Replace(fmt.Sprintf("%v", f), "ErrorDetails", "ErrorDetails", 1) + ","
+	}
+	repeatedStringForPathErrors += "}"
+	s := strings.Join([]string{`&ScheduleDownlinkErrorDetails{`,
+		`PathErrors:` + repeatedStringForPathErrors + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CaptureGatewayTrafficRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CaptureGatewayTrafficRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Duration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Duration), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CaptureGatewayTrafficResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CaptureGatewayTrafficResponse{`,
+		`FileName:` + fmt.Sprintf("%v", this.FileName) + `,`,
+		`StopsAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StopsAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetGatewayTrafficCaptureRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetGatewayTrafficCaptureRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FileName:` + fmt.Sprintf("%v", this.FileName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTrafficCapture) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayTrafficCapture{`,
+		`FileName:` + fmt.Sprintf("%v", this.FileName) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTrafficStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChannels := "[]*GatewayTrafficStats_ChannelStats{"
+	for _, f := range this.Channels {
+		repeatedStringForChannels += strings.Replace(fmt.Sprintf("%v", f), "GatewayTrafficStats_ChannelStats", "GatewayTrafficStats_ChannelStats", 1) + ","
+	}
+	repeatedStringForChannels += "}"
+	repeatedStringForSubBands := "[]*GatewayTrafficStats_SubBandStats{"
+	for _, f := range this.SubBands {
+		repeatedStringForSubBands += strings.Replace(fmt.Sprintf("%v", f), "GatewayTrafficStats_SubBandStats", "GatewayTrafficStats_SubBandStats", 1) + ","
+	}
+	repeatedStringForSubBands += "}"
+	s := strings.Join([]string{`&GatewayTrafficStats{`,
+		`Start:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Start), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`End:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.End), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UplinkCount:` + fmt.Sprintf("%v", this.UplinkCount) + `,`,
+		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
+		`CRCErrorCount:` + fmt.Sprintf("%v", this.CRCErrorCount) + `,`,
+		`Channels:` + repeatedStringForChannels + `,`,
+		`SubBands:` + repeatedStringForSubBands + `,`,
+		`RoundTripTimes:` + strings.Replace(fmt.Sprintf("%v", this.RoundTripTimes), "GatewayTrafficStats_RoundTripTimes", "GatewayTrafficStats_RoundTripTimes", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTrafficStats_ChannelStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayTrafficStats_ChannelStats{`,
+		`Frequency:` + fmt.Sprintf("%v", this.Frequency) + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`UplinkCount:` + fmt.Sprintf("%v", this.UplinkCount) + `,`,
+		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTrafficStats_SubBandStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayTrafficStats_SubBandStats{`,
+		`MinFrequency:` + fmt.Sprintf("%v", this.MinFrequency) + `,`,
+		`MaxFrequency:` + fmt.Sprintf("%v", this.MaxFrequency) + `,`,
+		`DownlinkUtilization:` + fmt.Sprintf("%v", this.DownlinkUtilization) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTrafficStats_RoundTripTimes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayTrafficStats_RoundTripTimes{`,
+		`Min:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Min), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Median:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Median), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`P90:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.P90), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`P99:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.P99), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Max:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Max), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetGatewayTrafficStatsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetGatewayTrafficStatsRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GatewayIdentifiers), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`From:` + strings.Replace(fmt.Sprintf("%v", this.From), "Timestamp", "types.Timestamp", 1) + `,`,
+		`To:` + strings.Replace(fmt.Sprintf("%v", this.To), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayTrafficStatsHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForStats := "[]*GatewayTrafficStats{"
+	for _, f := range this.Stats {
+		repeatedStringForStats += strings.Replace(f.String(), "GatewayTrafficStats", "GatewayTrafficStats", 1) + ","
+	}
+	repeatedStringForStats += "}"
+	s := strings.Join([]string{`&GatewayTrafficStatsHistory{`,
+		`Stats:` + repeatedStringForStats + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGatewayserver(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GatewayUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
+			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GatewayStatus == nil {
+				m.GatewayStatus = &GatewayStatus{}
+			}
+			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxAcknowledgment == nil {
+				m.TxAcknowledgment = &TxAcknowledgment{}
+			}
+			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayDown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkMessage == nil {
+				m.DownlinkMessage = &DownlinkMessage{}
+			}
+			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathErrors = append(m.PathErrors, &ErrorDetails{})
+			if err := m.PathErrors[len(m.PathErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CaptureGatewayTrafficRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CaptureGatewayTrafficRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CaptureGatewayTrafficRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CaptureGatewayTrafficResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CaptureGatewayTrafficResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CaptureGatewayTrafficResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopsAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StopsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetGatewayTrafficCaptureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetGatewayTrafficCaptureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetGatewayTrafficCaptureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayTrafficCapture) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayTrafficCapture: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayTrafficCapture: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayTrafficStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayTrafficStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayTrafficStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkCount", wireType)
+			}
+			m.UplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
+			}
+			m.DownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CRCErrorCount", wireType)
+			}
+			m.CRCErrorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CRCErrorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, &GatewayTrafficStats_ChannelStats{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubBands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubBands = append(m.SubBands, &GatewayTrafficStats_SubBandStats{})
+			if err := m.SubBands[len(m.SubBands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundTripTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RoundTripTimes == nil {
+				m.RoundTripTimes = &GatewayTrafficStats_RoundTripTimes{}
+			}
+			if err := m.RoundTripTimes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayTrafficStats_ChannelStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			m.Frequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Frequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+			}
+			m.DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkCount", wireType)
+			}
+			m.UplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
+			}
+			m.DownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayTrafficStats_SubBandStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubBandStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubBandStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
+			}
+			m.MinFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
+			}
+			m.MaxFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkUtilization", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:])
+			iNdEx += 4
+			m.DownlinkUtilization = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovGatewayserver(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGatewayserver(x uint64) (n int) {
-	return sovGatewayserver((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *GatewayUp) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForUplinkMessages := "[]*UplinkMessage{"
-	for _, f := range this.UplinkMessages {
-		repeatedStringForUplinkMessages += strings.Replace(fmt.Sprintf("%v", f), "UplinkMessage", "UplinkMessage", 1) + ","
-	}
-	repeatedStringForUplinkMessages += "}"
-	s := strings.Join([]string{`&GatewayUp{`,
-		`UplinkMessages:` + repeatedStringForUplinkMessages + `,`,
-		`GatewayStatus:` + strings.Replace(fmt.Sprintf("%v", this.GatewayStatus), "GatewayStatus", "GatewayStatus", 1) + `,`,
-		`TxAcknowledgment:` + strings.Replace(fmt.Sprintf("%v", this.TxAcknowledgment), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GatewayDown) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GatewayDown{`,
-		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ScheduleDownlinkResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ScheduleDownlinkResponse{`,
-		`Delay:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Delay), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ScheduleDownlinkErrorDetails) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForPathErrors := "[]*ErrorDetails{"
-	for _, f := range this.PathErrors {
-		repeatedStringForPathErrors += strings.Replace(fmt.Sprintf("%v", f), "ErrorDetails", "ErrorDetails", 1) + ","
-	}
-	repeatedStringForPathErrors += "}"
-	s := strings.Join([]string{`&ScheduleDownlinkErrorDetails{`,
-		`PathErrors:` + repeatedStringForPathErrors + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGatewayserver(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *GatewayUp) Unmarshal(dAtA []byte) error {
+func (m *GatewayTrafficStats_RoundTripTimes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,15 +4785,15 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoundTripTimes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoundTripTimes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1296,14 +4820,13 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
-			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Min, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1330,16 +4853,13 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GatewayStatus == nil {
-				m.GatewayStatus = &GatewayStatus{}
-			}
-			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Median, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field P90", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1366,13 +4886,95 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxAcknowledgment == nil {
-				m.TxAcknowledgment = &TxAcknowledgment{}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.P90, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P99", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.P99, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Max, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGatewayserver(dAtA[iNdEx:])
@@ -1397,7 +4999,7 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GatewayDown) Unmarshal(dAtA []byte) error {
+func (m *GetGatewayTrafficStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1420,15 +5022,15 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetGatewayTrafficStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetGatewayTrafficStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1455,69 +5057,49 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkMessage == nil {
-				m.DownlinkMessage = &DownlinkMessage{}
-			}
-			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGatewayserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGatewayserver
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGatewayserver
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.From == nil {
+				m.From = new(time.Time)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.From, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1544,7 +5126,10 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+			if m.To == nil {
+				m.To = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.To, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1572,7 +5157,7 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
+func (m *GatewayTrafficStatsHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1595,15 +5180,15 @@ func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayTrafficStatsHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDownlinkErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayTrafficStatsHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathErrors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1630,8 +5215,8 @@ func (m *ScheduleDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathErrors = append(m.PathErrors, &ErrorDetails{})
-			if err := m.PathErrors[len(m.PathErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stats = append(m.Stats, &GatewayTrafficStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
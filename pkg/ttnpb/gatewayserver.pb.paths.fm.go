@@ -22,6 +22,7 @@ var GatewayUpFieldPathsTopLevel = []string{
 	"tx_acknowledgment",
 	"uplink_messages",
 }
+
 var GatewayDownFieldPathsNested = []string{
 	"downlink_message",
 	"downlink_message.correlation_ids",
@@ -113,6 +114,7 @@ var GatewayDownFieldPathsNested = []string{
 var GatewayDownFieldPathsTopLevel = []string{
 	"downlink_message",
 }
+
 var ScheduleDownlinkResponseFieldPathsNested = []string{
 	"delay",
 }
@@ -120,6 +122,7 @@ var ScheduleDownlinkResponseFieldPathsNested = []string{
 var ScheduleDownlinkResponseFieldPathsTopLevel = []string{
 	"delay",
 }
+
 var ScheduleDownlinkErrorDetailsFieldPathsNested = []string{
 	"path_errors",
 }
@@ -127,3 +130,141 @@ var ScheduleDownlinkErrorDetailsFieldPathsNested = []string{
 var ScheduleDownlinkErrorDetailsFieldPathsTopLevel = []string{
 	"path_errors",
 }
+
+var CaptureGatewayTrafficRequestFieldPathsNested = []string{
+	"duration",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var CaptureGatewayTrafficRequestFieldPathsTopLevel = []string{
+	"duration",
+	"gateway_ids",
+}
+
+var CaptureGatewayTrafficResponseFieldPathsNested = []string{
+	"file_name",
+	"stops_at",
+}
+
+var CaptureGatewayTrafficResponseFieldPathsTopLevel = []string{
+	"file_name",
+	"stops_at",
+}
+
+var GetGatewayTrafficCaptureRequestFieldPathsNested = []string{
+	"file_name",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var GetGatewayTrafficCaptureRequestFieldPathsTopLevel = []string{
+	"file_name",
+	"gateway_ids",
+}
+
+var GatewayTrafficCaptureFieldPathsNested = []string{
+	"data",
+	"file_name",
+}
+
+var GatewayTrafficCaptureFieldPathsTopLevel = []string{
+	"data",
+	"file_name",
+}
+
+var GatewayTrafficStatsFieldPathsNested = []string{
+	"channels",
+	"crc_error_count",
+	"downlink_count",
+	"end",
+	"round_trip_times",
+	"round_trip_times.count",
+	"round_trip_times.max",
+	"round_trip_times.median",
+	"round_trip_times.min",
+	"round_trip_times.p90",
+	"round_trip_times.p99",
+	"start",
+	"sub_bands",
+	"uplink_count",
+}
+
+var GatewayTrafficStatsFieldPathsTopLevel = []string{
+	"channels",
+	"crc_error_count",
+	"downlink_count",
+	"end",
+	"round_trip_times",
+	"start",
+	"sub_bands",
+	"uplink_count",
+}
+
+var GetGatewayTrafficStatsRequestFieldPathsNested = []string{
+	"from",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"to",
+}
+
+var GetGatewayTrafficStatsRequestFieldPathsTopLevel = []string{
+	"from",
+	"gateway_ids",
+	"to",
+}
+
+var GatewayTrafficStatsHistoryFieldPathsNested = []string{
+	"stats",
+}
+
+var GatewayTrafficStatsHistoryFieldPathsTopLevel = []string{
+	"stats",
+}
+
+var GatewayTrafficStats_ChannelStatsFieldPathsNested = []string{
+	"data_rate_index",
+	"downlink_count",
+	"frequency",
+	"uplink_count",
+}
+
+var GatewayTrafficStats_ChannelStatsFieldPathsTopLevel = []string{
+	"data_rate_index",
+	"downlink_count",
+	"frequency",
+	"uplink_count",
+}
+
+var GatewayTrafficStats_SubBandStatsFieldPathsNested = []string{
+	"downlink_utilization",
+	"max_frequency",
+	"min_frequency",
+}
+
+var GatewayTrafficStats_SubBandStatsFieldPathsTopLevel = []string{
+	"downlink_utilization",
+	"max_frequency",
+	"min_frequency",
+}
+
+var GatewayTrafficStats_RoundTripTimesFieldPathsNested = []string{
+	"count",
+	"max",
+	"median",
+	"min",
+	"p90",
+	"p99",
+}
+
+var GatewayTrafficStats_RoundTripTimesFieldPathsTopLevel = []string{
+	"count",
+	"max",
+	"median",
+	"min",
+	"p90",
+	"p99",
+}
@@ -153,3 +153,476 @@ func (dst *ScheduleDownlinkErrorDetails) SetFields(src *ScheduleDownlinkErrorDet
 	}
 	return nil
 }
+
+func (dst *CaptureGatewayTrafficRequest) SetFields(src *CaptureGatewayTrafficRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				var zero time.Duration
+				dst.Duration = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *CaptureGatewayTrafficResponse) SetFields(src *CaptureGatewayTrafficResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "file_name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'file_name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FileName = src.FileName
+			} else {
+				var zero string
+				dst.FileName = zero
+			}
+		case "stops_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'stops_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StopsAt = src.StopsAt
+			} else {
+				var zero time.Time
+				dst.StopsAt = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetGatewayTrafficCaptureRequest) SetFields(src *GetGatewayTrafficCaptureRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "file_name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'file_name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FileName = src.FileName
+			} else {
+				var zero string
+				dst.FileName = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayTrafficCapture) SetFields(src *GatewayTrafficCapture, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "file_name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'file_name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FileName = src.FileName
+			} else {
+				var zero string
+				dst.FileName = zero
+			}
+		case "data":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Data = src.Data
+			} else {
+				dst.Data = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayTrafficStats) SetFields(src *GatewayTrafficStats, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "start":
+			if len(subs) > 0 {
+				return fmt.Errorf("'start' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Start = src.Start
+			} else {
+				var zero time.Time
+				dst.Start = zero
+			}
+		case "end":
+			if len(subs) > 0 {
+				return fmt.Errorf("'end' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.End = src.End
+			} else {
+				var zero time.Time
+				dst.End = zero
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint64
+				dst.UplinkCount = zero
+			}
+		case "downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkCount = src.DownlinkCount
+			} else {
+				var zero uint64
+				dst.DownlinkCount = zero
+			}
+		case "crc_error_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'crc_error_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CRCErrorCount = src.CRCErrorCount
+			} else {
+				var zero uint64
+				dst.CRCErrorCount = zero
+			}
+		case "channels":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channels' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Channels = src.Channels
+			} else {
+				dst.Channels = nil
+			}
+		case "sub_bands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sub_bands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SubBands = src.SubBands
+			} else {
+				dst.SubBands = nil
+			}
+		case "round_trip_times":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayTrafficStats_RoundTripTimes
+				if (src == nil || src.RoundTripTimes == nil) && dst.RoundTripTimes == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RoundTripTimes
+				}
+				if dst.RoundTripTimes != nil {
+					newDst = dst.RoundTripTimes
+				} else {
+					newDst = &GatewayTrafficStats_RoundTripTimes{}
+					dst.RoundTripTimes = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RoundTripTimes = src.RoundTripTimes
+				} else {
+					dst.RoundTripTimes = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetGatewayTrafficStatsRequest) SetFields(src *GetGatewayTrafficStatsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				newDst = &dst.GatewayIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "from":
+			if len(subs) > 0 {
+				return fmt.Errorf("'from' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.From = src.From
+			} else {
+				dst.From = nil
+			}
+		case "to":
+			if len(subs) > 0 {
+				return fmt.Errorf("'to' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.To = src.To
+			} else {
+				dst.To = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayTrafficStatsHistory) SetFields(src *GatewayTrafficStatsHistory, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "stats":
+			if len(subs) > 0 {
+				return fmt.Errorf("'stats' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Stats = src.Stats
+			} else {
+				dst.Stats = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayTrafficStats_ChannelStats) SetFields(src *GatewayTrafficStats_ChannelStats, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frequency = src.Frequency
+			} else {
+				var zero uint64
+				dst.Frequency = zero
+			}
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint64
+				dst.UplinkCount = zero
+			}
+		case "downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkCount = src.DownlinkCount
+			} else {
+				var zero uint64
+				dst.DownlinkCount = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayTrafficStats_SubBandStats) SetFields(src *GatewayTrafficStats_SubBandStats, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "min_frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinFrequency = src.MinFrequency
+			} else {
+				var zero uint64
+				dst.MinFrequency = zero
+			}
+		case "max_frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxFrequency = src.MaxFrequency
+			} else {
+				var zero uint64
+				dst.MaxFrequency = zero
+			}
+		case "downlink_utilization":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_utilization' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkUtilization = src.DownlinkUtilization
+			} else {
+				var zero float32
+				dst.DownlinkUtilization = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayTrafficStats_RoundTripTimes) SetFields(src *GatewayTrafficStats_RoundTripTimes, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "min":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Min = src.Min
+			} else {
+				var zero time.Duration
+				dst.Min = zero
+			}
+		case "median":
+			if len(subs) > 0 {
+				return fmt.Errorf("'median' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Median = src.Median
+			} else {
+				var zero time.Duration
+				dst.Median = zero
+			}
+		case "p90":
+			if len(subs) > 0 {
+				return fmt.Errorf("'p90' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.P90 = src.P90
+			} else {
+				var zero time.Duration
+				dst.P90 = zero
+			}
+		case "p99":
+			if len(subs) > 0 {
+				return fmt.Errorf("'p99' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.P99 = src.P99
+			} else {
+				var zero time.Duration
+				dst.P99 = zero
+			}
+		case "max":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Max = src.Max
+			} else {
+				var zero time.Duration
+				dst.Max = zero
+			}
+		case "count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Count = src.Count
+			} else {
+				var zero uint32
+				dst.Count = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -428,3 +428,1089 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ScheduleDownlinkErrorDetailsValidationError{}
+
+// ValidateFields checks the field values on CaptureGatewayTrafficRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *CaptureGatewayTrafficRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CaptureGatewayTrafficRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CaptureGatewayTrafficRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "duration":
+
+		default:
+			return CaptureGatewayTrafficRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CaptureGatewayTrafficRequestValidationError is the validation error
+// returned by CaptureGatewayTrafficRequest.ValidateFields if the designated
+// constraints aren't met.
+type CaptureGatewayTrafficRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CaptureGatewayTrafficRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CaptureGatewayTrafficRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CaptureGatewayTrafficRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CaptureGatewayTrafficRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CaptureGatewayTrafficRequestValidationError) ErrorName() string {
+	return "CaptureGatewayTrafficRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CaptureGatewayTrafficRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCaptureGatewayTrafficRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CaptureGatewayTrafficRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CaptureGatewayTrafficRequestValidationError{}
+
+// ValidateFields checks the field values on CaptureGatewayTrafficResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *CaptureGatewayTrafficResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CaptureGatewayTrafficResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "file_name":
+			// no validation rules for FileName
+		case "stops_at":
+
+			if v, ok := interface{}(&m.StopsAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CaptureGatewayTrafficResponseValidationError{
+						field:  "stops_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return CaptureGatewayTrafficResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CaptureGatewayTrafficResponseValidationError is the validation error
+// returned by CaptureGatewayTrafficResponse.ValidateFields if the designated
+// constraints aren't met.
+type CaptureGatewayTrafficResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CaptureGatewayTrafficResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CaptureGatewayTrafficResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CaptureGatewayTrafficResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CaptureGatewayTrafficResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CaptureGatewayTrafficResponseValidationError) ErrorName() string {
+	return "CaptureGatewayTrafficResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CaptureGatewayTrafficResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCaptureGatewayTrafficResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CaptureGatewayTrafficResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CaptureGatewayTrafficResponseValidationError{}
+
+// ValidateFields checks the field values on GetGatewayTrafficCaptureRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GetGatewayTrafficCaptureRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetGatewayTrafficCaptureRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayTrafficCaptureRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "file_name":
+
+			if l := utf8.RuneCountInString(m.GetFileName()); l < 1 || l > 256 {
+				return GetGatewayTrafficCaptureRequestValidationError{
+					field:  "file_name",
+					reason: "value length must be between 1 and 256 runes, inclusive",
+				}
+			}
+
+		default:
+			return GetGatewayTrafficCaptureRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetGatewayTrafficCaptureRequestValidationError is the validation error
+// returned by GetGatewayTrafficCaptureRequest.ValidateFields if the
+// designated constraints aren't met.
+type GetGatewayTrafficCaptureRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGatewayTrafficCaptureRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGatewayTrafficCaptureRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGatewayTrafficCaptureRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGatewayTrafficCaptureRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGatewayTrafficCaptureRequestValidationError) ErrorName() string {
+	return "GetGatewayTrafficCaptureRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGatewayTrafficCaptureRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGatewayTrafficCaptureRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGatewayTrafficCaptureRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGatewayTrafficCaptureRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayTrafficCapture with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayTrafficCapture) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTrafficCaptureFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "file_name":
+			// no validation rules for FileName
+		case "data":
+			// no validation rules for Data
+		default:
+			return GatewayTrafficCaptureValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTrafficCaptureValidationError is the validation error returned by
+// GatewayTrafficCapture.ValidateFields if the designated constraints aren't
+// met.
+type GatewayTrafficCaptureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTrafficCaptureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTrafficCaptureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayTrafficCaptureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTrafficCaptureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTrafficCaptureValidationError) ErrorName() string {
+	return "GatewayTrafficCaptureValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayTrafficCaptureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTrafficCapture.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTrafficCaptureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTrafficCaptureValidationError{}
+
+// ValidateFields checks the field values on GatewayTrafficStats with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayTrafficStats) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTrafficStatsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "start":
+
+			if v, ok := interface{}(&m.Start).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficStatsValidationError{
+						field:  "start",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "end":
+
+			if v, ok := interface{}(&m.End).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficStatsValidationError{
+						field:  "end",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "downlink_count":
+			// no validation rules for DownlinkCount
+		case "crc_error_count":
+			// no validation rules for CRCErrorCount
+		case "channels":
+
+			for idx, item := range m.GetChannels() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayTrafficStatsValidationError{
+							field:  fmt.Sprintf("channels[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "sub_bands":
+
+			for idx, item := range m.GetSubBands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayTrafficStatsValidationError{
+							field:  fmt.Sprintf("sub_bands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "round_trip_times":
+
+			if v, ok := interface{}(m.GetRoundTripTimes()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficStatsValidationError{
+						field:  "round_trip_times",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayTrafficStatsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTrafficStatsValidationError is the validation error returned by
+// GatewayTrafficStats.ValidateFields if the designated constraints aren't
+// met.
+type GatewayTrafficStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTrafficStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTrafficStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayTrafficStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTrafficStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTrafficStatsValidationError) ErrorName() string {
+	return "GatewayTrafficStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayTrafficStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTrafficStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTrafficStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTrafficStatsValidationError{}
+
+// ValidateFields checks the field values on GetGatewayTrafficStatsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GetGatewayTrafficStatsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetGatewayTrafficStatsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(&m.GatewayIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayTrafficStatsRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "from":
+
+			if v, ok := interface{}(m.GetFrom()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayTrafficStatsRequestValidationError{
+						field:  "from",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "to":
+
+			if v, ok := interface{}(m.GetTo()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayTrafficStatsRequestValidationError{
+						field:  "to",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetGatewayTrafficStatsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetGatewayTrafficStatsRequestValidationError is the validation error
+// returned by GetGatewayTrafficStatsRequest.ValidateFields if the designated
+// constraints aren't met.
+type GetGatewayTrafficStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGatewayTrafficStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGatewayTrafficStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGatewayTrafficStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGatewayTrafficStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGatewayTrafficStatsRequestValidationError) ErrorName() string {
+	return "GetGatewayTrafficStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGatewayTrafficStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGatewayTrafficStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGatewayTrafficStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGatewayTrafficStatsRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayTrafficStatsHistory with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayTrafficStatsHistory) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTrafficStatsHistoryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "stats":
+
+			for idx, item := range m.GetStats() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayTrafficStatsHistoryValidationError{
+							field:  fmt.Sprintf("stats[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayTrafficStatsHistoryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTrafficStatsHistoryValidationError is the validation error returned
+// by GatewayTrafficStatsHistory.ValidateFields if the designated constraints
+// aren't met.
+type GatewayTrafficStatsHistoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTrafficStatsHistoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTrafficStatsHistoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayTrafficStatsHistoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTrafficStatsHistoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTrafficStatsHistoryValidationError) ErrorName() string {
+	return "GatewayTrafficStatsHistoryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayTrafficStatsHistoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTrafficStatsHistory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTrafficStatsHistoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTrafficStatsHistoryValidationError{}
+
+// ValidateFields checks the field values on GatewayTrafficStats_ChannelStats
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GatewayTrafficStats_ChannelStats) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTrafficStats_ChannelStatsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "frequency":
+			// no validation rules for Frequency
+		case "data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return GatewayTrafficStats_ChannelStatsValidationError{
+					field:  "data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "downlink_count":
+			// no validation rules for DownlinkCount
+		default:
+			return GatewayTrafficStats_ChannelStatsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTrafficStats_ChannelStatsValidationError is the validation error
+// returned by GatewayTrafficStats_ChannelStats.ValidateFields if the
+// designated constraints aren't met.
+type GatewayTrafficStats_ChannelStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTrafficStats_ChannelStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTrafficStats_ChannelStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayTrafficStats_ChannelStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTrafficStats_ChannelStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTrafficStats_ChannelStatsValidationError) ErrorName() string {
+	return "GatewayTrafficStats_ChannelStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayTrafficStats_ChannelStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTrafficStats_ChannelStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTrafficStats_ChannelStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTrafficStats_ChannelStatsValidationError{}
+
+// ValidateFields checks the field values on GatewayTrafficStats_SubBandStats
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GatewayTrafficStats_SubBandStats) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTrafficStats_SubBandStatsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "min_frequency":
+			// no validation rules for MinFrequency
+		case "max_frequency":
+			// no validation rules for MaxFrequency
+		case "downlink_utilization":
+			// no validation rules for DownlinkUtilization
+		default:
+			return GatewayTrafficStats_SubBandStatsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTrafficStats_SubBandStatsValidationError is the validation error
+// returned by GatewayTrafficStats_SubBandStats.ValidateFields if the
+// designated constraints aren't met.
+type GatewayTrafficStats_SubBandStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTrafficStats_SubBandStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTrafficStats_SubBandStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayTrafficStats_SubBandStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTrafficStats_SubBandStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTrafficStats_SubBandStatsValidationError) ErrorName() string {
+	return "GatewayTrafficStats_SubBandStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayTrafficStats_SubBandStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTrafficStats_SubBandStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTrafficStats_SubBandStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTrafficStats_SubBandStatsValidationError{}
+
+// ValidateFields checks the field values on
+// GatewayTrafficStats_RoundTripTimes with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is
+// returned.
+func (m *GatewayTrafficStats_RoundTripTimes) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTrafficStats_RoundTripTimesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "min":
+
+			if v, ok := interface{}(&m.Min).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficStats_RoundTripTimesValidationError{
+						field:  "min",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "median":
+
+			if v, ok := interface{}(&m.Median).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficStats_RoundTripTimesValidationError{
+						field:  "median",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "p90":
+
+			if v, ok := interface{}(&m.P90).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficStats_RoundTripTimesValidationError{
+						field:  "p90",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "p99":
+
+			if v, ok := interface{}(&m.P99).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficStats_RoundTripTimesValidationError{
+						field:  "p99",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "max":
+
+			if v, ok := interface{}(&m.Max).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficStats_RoundTripTimesValidationError{
+						field:  "max",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "count":
+			// no validation rules for Count
+		default:
+			return GatewayTrafficStats_RoundTripTimesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTrafficStats_RoundTripTimesValidationError is the validation error
+// returned by GatewayTrafficStats_RoundTripTimes.ValidateFields if the
+// designated constraints aren't met.
+type GatewayTrafficStats_RoundTripTimesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTrafficStats_RoundTripTimesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTrafficStats_RoundTripTimesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayTrafficStats_RoundTripTimesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTrafficStats_RoundTripTimesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTrafficStats_RoundTripTimesValidationError) ErrorName() string {
+	return "GatewayTrafficStats_RoundTripTimesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayTrafficStats_RoundTripTimesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTrafficStats_RoundTripTimes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTrafficStats_RoundTripTimesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTrafficStats_RoundTripTimesValidationError{}
@@ -44,6 +44,10 @@ func init() {
 	defineEnum(CONTACT_METHOD_EMAIL, "email")
 	defineEnum(CONTACT_METHOD_PHONE, "phone")
 
+	defineEnum(MFA_CREDENTIAL_TOTP, "authenticator app")
+	defineEnum(MFA_CREDENTIAL_WEBAUTHN, "security key")
+	defineEnum(MFA_CREDENTIAL_RECOVERY_CODE, "recovery code")
+
 	defineEnum(MType_JOIN_REQUEST, "join request")
 	defineEnum(MType_JOIN_ACCEPT, "join accept")
 	defineEnum(MType_UNCONFIRMED_UP, "unconfirmed uplink")
@@ -57,6 +61,11 @@ func init() {
 	defineEnum(RejoinType_SESSION, "renew session")
 	defineEnum(RejoinType_KEYS, "renew keys")
 
+	defineEnum(JoinRejectReason_JOIN_REJECT_OTHER, "other")
+	defineEnum(JoinRejectReason_JOIN_REJECT_UNKNOWN_DEVICE, "unknown device")
+	defineEnum(JoinRejectReason_JOIN_REJECT_DEV_NONCE_REPLAYED, "replayed DevNonce")
+	defineEnum(JoinRejectReason_JOIN_REJECT_MIC_FAILED, "MIC failure")
+
 	defineEnum(CFListType_FREQUENCIES, "frequencies")
 	defineEnum(CFListType_CHANNEL_MASKS, "channel masks")
 
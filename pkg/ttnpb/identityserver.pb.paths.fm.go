@@ -6,6 +6,8 @@ var AuthInfoResponseFieldPathsNested = []string{
 	"access_method",
 	"access_method.api_key",
 	"access_method.api_key.api_key",
+	"access_method.api_key.api_key.allowed_ip_ranges",
+	"access_method.api_key.api_key.expires_at",
 	"access_method.api_key.api_key.id",
 	"access_method.api_key.api_key.key",
 	"access_method.api_key.api_key.name",
@@ -56,6 +58,8 @@ var AuthInfoResponseFieldPathsTopLevel = []string{
 }
 var AuthInfoResponse_APIKeyAccessFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_ip_ranges",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
@@ -11,11 +11,14 @@ import (
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
+	time "time"
 
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	go_thethings_network_lorawan_stack_pkg_types "go.thethings.network/lorawan-stack/pkg/types"
@@ -30,6 +33,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,6 +41,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type JoinRejectReason int32
+
+const (
+	// The join-request was rejected for another reason. See the error of the join attempt.
+	JoinRejectReason_JOIN_REJECT_OTHER JoinRejectReason = 0
+	// The end device is not registered in the Join Server.
+	JoinRejectReason_JOIN_REJECT_UNKNOWN_DEVICE JoinRejectReason = 1
+	// The DevNonce or RJcount1 has been used before, or is lower than the last DevNonce or RJcount1.
+	JoinRejectReason_JOIN_REJECT_DEV_NONCE_REPLAYED JoinRejectReason = 2
+	// The MIC of the join-request does not match.
+	JoinRejectReason_JOIN_REJECT_MIC_FAILED JoinRejectReason = 3
+)
+
+var JoinRejectReason_name = map[int32]string{
+	0: "JOIN_REJECT_OTHER",
+	1: "JOIN_REJECT_UNKNOWN_DEVICE",
+	2: "JOIN_REJECT_DEV_NONCE_REPLAYED",
+	3: "JOIN_REJECT_MIC_FAILED",
+}
+
+var JoinRejectReason_value = map[string]int32{
+	"JOIN_REJECT_OTHER":              0,
+	"JOIN_REJECT_UNKNOWN_DEVICE":     1,
+	"JOIN_REJECT_DEV_NONCE_REPLAYED": 2,
+	"JOIN_REJECT_MIC_FAILED":         3,
+}
+
+func (JoinRejectReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{0}
+}
+
 type SessionKeyRequest struct {
 	// Join Server issued identifier for the session keys.
 	SessionKeyID []byte `protobuf:"bytes,1,opt,name=session_key_id,json=sessionKeyId,proto3" json:"session_key_id,omitempty"`
@@ -728,6 +763,241 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProvisionEndDevicesRequest_IdentifiersFromData proto.InternalMessageInfo
 
+// JoinAttempt is a join-request or rejoin-request of an end device that is handled by the Join Server.
+type JoinAttempt struct {
+	ReceivedAt time.Time `protobuf:"bytes,1,opt,name=received_at,json=receivedAt,proto3,stdtime" json:"received_at"`
+	// Message type: JOIN_REQUEST or REJOIN_REQUEST.
+	MType MType `protobuf:"varint,2,opt,name=m_type,json=mType,proto3,enum=ttn.lorawan.v3.MType" json:"m_type,omitempty"`
+	// DevNonce of the join-request, or RJcount of the rejoin-request.
+	DevNonce go_thethings_network_lorawan_stack_pkg_types.DevNonce `protobuf:"bytes,3,opt,name=dev_nonce,json=devNonce,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.DevNonce" json:"dev_nonce"`
+	// NetID of the Network Server that sent the join-request.
+	NetID    go_thethings_network_lorawan_stack_pkg_types.NetID `protobuf:"bytes,4,opt,name=net_id,json=netId,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.NetID" json:"net_id"`
+	Accepted bool                                               `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// Reason why the join-request was rejected. Only set if the join-request was not accepted.
+	RejectReason JoinRejectReason `protobuf:"varint,6,opt,name=reject_reason,json=rejectReason,proto3,enum=ttn.lorawan.v3.JoinRejectReason" json:"reject_reason,omitempty"`
+	// Error of the rejected join-request. Only set if the join-request was not accepted.
+	Error *ErrorDetails `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// Join Server issued identifier for the session keys. Only set if the join-request was accepted.
+	SessionKeyID         []byte   `protobuf:"bytes,8,opt,name=session_key_id,json=sessionKeyId,proto3" json:"session_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JoinAttempt) Reset()      { *m = JoinAttempt{} }
+func (*JoinAttempt) ProtoMessage() {}
+func (*JoinAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{9}
+}
+func (m *JoinAttempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinAttempt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JoinAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinAttempt.Merge(m, src)
+}
+func (m *JoinAttempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinAttempt proto.InternalMessageInfo
+
+func (m *JoinAttempt) GetReceivedAt() time.Time {
+	if m != nil {
+		return m.ReceivedAt
+	}
+	return time.Time{}
+}
+
+func (m *JoinAttempt) GetMType() MType {
+	if m != nil {
+		return m.MType
+	}
+	return MType_JOIN_REQUEST
+}
+
+func (m *JoinAttempt) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *JoinAttempt) GetRejectReason() JoinRejectReason {
+	if m != nil {
+		return m.RejectReason
+	}
+	return JoinRejectReason_JOIN_REJECT_OTHER
+}
+
+func (m *JoinAttempt) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *JoinAttempt) GetSessionKeyID() []byte {
+	if m != nil {
+		return m.SessionKeyID
+	}
+	return nil
+}
+
+type JoinAttempts struct {
+	// Join attempts, most recent first.
+	Attempts             []JoinAttempt `protobuf:"bytes,1,rep,name=attempts,proto3" json:"attempts"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *JoinAttempts) Reset()      { *m = JoinAttempts{} }
+func (*JoinAttempts) ProtoMessage() {}
+func (*JoinAttempts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{10}
+}
+func (m *JoinAttempts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JoinAttempts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JoinAttempts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JoinAttempts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JoinAttempts.Merge(m, src)
+}
+func (m *JoinAttempts) XXX_Size() int {
+	return m.Size()
+}
+func (m *JoinAttempts) XXX_DiscardUnknown() {
+	xxx_messageInfo_JoinAttempts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JoinAttempts proto.InternalMessageInfo
+
+func (m *JoinAttempts) GetAttempts() []JoinAttempt {
+	if m != nil {
+		return m.Attempts
+	}
+	return nil
+}
+
+type GetJoinAttemptsRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// Limit the number of results.
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJoinAttemptsRequest) Reset()      { *m = GetJoinAttemptsRequest{} }
+func (*GetJoinAttemptsRequest) ProtoMessage() {}
+func (*GetJoinAttemptsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{11}
+}
+func (m *GetJoinAttemptsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetJoinAttemptsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetJoinAttemptsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetJoinAttemptsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJoinAttemptsRequest.Merge(m, src)
+}
+func (m *GetJoinAttemptsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetJoinAttemptsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJoinAttemptsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJoinAttemptsRequest proto.InternalMessageInfo
+
+func (m *GetJoinAttemptsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ResetEndDeviceNoncesRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// Also forget the DevNonces that have been used by the end device, so that the end device starts with a fresh
+	// nonce window. This allows end devices that use random DevNonces (LoRaWAN 1.0.3 and older) to reuse DevNonces.
+	ResetUsedDevNonces   bool     `protobuf:"varint,2,opt,name=reset_used_dev_nonces,json=resetUsedDevNonces,proto3" json:"reset_used_dev_nonces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetEndDeviceNoncesRequest) Reset()      { *m = ResetEndDeviceNoncesRequest{} }
+func (*ResetEndDeviceNoncesRequest) ProtoMessage() {}
+func (*ResetEndDeviceNoncesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{12}
+}
+func (m *ResetEndDeviceNoncesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetEndDeviceNoncesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetEndDeviceNoncesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetEndDeviceNoncesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetEndDeviceNoncesRequest.Merge(m, src)
+}
+func (m *ResetEndDeviceNoncesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetEndDeviceNoncesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetEndDeviceNoncesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetEndDeviceNoncesRequest proto.InternalMessageInfo
+
+func (m *ResetEndDeviceNoncesRequest) GetResetUsedDevNonces() bool {
+	if m != nil {
+		return m.ResetUsedDevNonces
+	}
+	return false
+}
+
 type JoinEUIPrefix struct {
 	JoinEUI              go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,1,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"join_eui"`
 	Length               uint32                                             `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
@@ -738,7 +1008,7 @@ type JoinEUIPrefix struct {
 func (m *JoinEUIPrefix) Reset()      { *m = JoinEUIPrefix{} }
 func (*JoinEUIPrefix) ProtoMessage() {}
 func (*JoinEUIPrefix) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b695d5f526759a7, []int{9}
+	return fileDescriptor_1b695d5f526759a7, []int{13}
 }
 func (m *JoinEUIPrefix) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -783,7 +1053,7 @@ type JoinEUIPrefixes struct {
 func (m *JoinEUIPrefixes) Reset()      { *m = JoinEUIPrefixes{} }
 func (*JoinEUIPrefixes) ProtoMessage() {}
 func (*JoinEUIPrefixes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b695d5f526759a7, []int{10}
+	return fileDescriptor_1b695d5f526759a7, []int{14}
 }
 func (m *JoinEUIPrefixes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -819,7 +1089,176 @@ func (m *JoinEUIPrefixes) GetPrefixes() []JoinEUIPrefix {
 	return nil
 }
 
+// ExternalActivation is a record of an end device activation by an external Join Server.
+// The Join Server records external activations of the join-requests that it forwards to external Join Servers.
+type ExternalActivation struct {
+	// The LoRaWAN JoinEUI (AppEUI until LoRaWAN 1.0.3 end devices).
+	JoinEUI go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,1,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"join_eui"`
+	// LoRaWAN DevEUI.
+	DevEUI go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"dev_eui"`
+	// NetID of the Network Server that sent the join-request.
+	NetID go_thethings_network_lorawan_stack_pkg_types.NetID `protobuf:"bytes,3,opt,name=net_id,json=netId,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.NetID" json:"net_id"`
+	// Address of the external Join Server that activated the end device.
+	JoinServerAddress string `protobuf:"bytes,4,opt,name=join_server_address,json=joinServerAddress,proto3" json:"join_server_address,omitempty"`
+	// External Join Server issued identifier for the session keys.
+	SessionKeyID         []byte    `protobuf:"bytes,5,opt,name=session_key_id,json=sessionKeyId,proto3" json:"session_key_id,omitempty"`
+	ActivatedAt          time.Time `protobuf:"bytes,6,opt,name=activated_at,json=activatedAt,proto3,stdtime" json:"activated_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ExternalActivation) Reset()      { *m = ExternalActivation{} }
+func (*ExternalActivation) ProtoMessage() {}
+func (*ExternalActivation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{15}
+}
+func (m *ExternalActivation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalActivation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalActivation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalActivation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalActivation.Merge(m, src)
+}
+func (m *ExternalActivation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalActivation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalActivation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalActivation proto.InternalMessageInfo
+
+func (m *ExternalActivation) GetJoinServerAddress() string {
+	if m != nil {
+		return m.JoinServerAddress
+	}
+	return ""
+}
+
+func (m *ExternalActivation) GetSessionKeyID() []byte {
+	if m != nil {
+		return m.SessionKeyID
+	}
+	return nil
+}
+
+func (m *ExternalActivation) GetActivatedAt() time.Time {
+	if m != nil {
+		return m.ActivatedAt
+	}
+	return time.Time{}
+}
+
+type ExternalActivations struct {
+	// External activations, most recent first.
+	Activations          []ExternalActivation `protobuf:"bytes,1,rep,name=activations,proto3" json:"activations"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ExternalActivations) Reset()      { *m = ExternalActivations{} }
+func (*ExternalActivations) ProtoMessage() {}
+func (*ExternalActivations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{16}
+}
+func (m *ExternalActivations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalActivations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalActivations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalActivations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalActivations.Merge(m, src)
+}
+func (m *ExternalActivations) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalActivations) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalActivations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalActivations proto.InternalMessageInfo
+
+func (m *ExternalActivations) GetActivations() []ExternalActivation {
+	if m != nil {
+		return m.Activations
+	}
+	return nil
+}
+
+type ListExternalActivationsRequest struct {
+	// The LoRaWAN JoinEUI (AppEUI until LoRaWAN 1.0.3 end devices).
+	JoinEUI go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,1,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"join_eui"`
+	// LoRaWAN DevEUI.
+	DevEUI go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"dev_eui"`
+	// Limit the number of results.
+	Limit                uint32   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListExternalActivationsRequest) Reset()      { *m = ListExternalActivationsRequest{} }
+func (*ListExternalActivationsRequest) ProtoMessage() {}
+func (*ListExternalActivationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{17}
+}
+func (m *ListExternalActivationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListExternalActivationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListExternalActivationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListExternalActivationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExternalActivationsRequest.Merge(m, src)
+}
+func (m *ListExternalActivationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListExternalActivationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExternalActivationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExternalActivationsRequest proto.InternalMessageInfo
+
+func (m *ListExternalActivationsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.JoinRejectReason", JoinRejectReason_name, JoinRejectReason_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.JoinRejectReason", JoinRejectReason_name, JoinRejectReason_value)
 	proto.RegisterType((*SessionKeyRequest)(nil), "ttn.lorawan.v3.SessionKeyRequest")
 	golang_proto.RegisterType((*SessionKeyRequest)(nil), "ttn.lorawan.v3.SessionKeyRequest")
 	proto.RegisterType((*NwkSKeysResponse)(nil), "ttn.lorawan.v3.NwkSKeysResponse")
@@ -844,10 +1283,24 @@ func init() {
 	golang_proto.RegisterType((*ProvisionEndDevicesRequest_IdentifiersRange)(nil), "ttn.lorawan.v3.ProvisionEndDevicesRequest.IdentifiersRange")
 	proto.RegisterType((*ProvisionEndDevicesRequest_IdentifiersFromData)(nil), "ttn.lorawan.v3.ProvisionEndDevicesRequest.IdentifiersFromData")
 	golang_proto.RegisterType((*ProvisionEndDevicesRequest_IdentifiersFromData)(nil), "ttn.lorawan.v3.ProvisionEndDevicesRequest.IdentifiersFromData")
+	proto.RegisterType((*JoinAttempt)(nil), "ttn.lorawan.v3.JoinAttempt")
+	golang_proto.RegisterType((*JoinAttempt)(nil), "ttn.lorawan.v3.JoinAttempt")
+	proto.RegisterType((*JoinAttempts)(nil), "ttn.lorawan.v3.JoinAttempts")
+	golang_proto.RegisterType((*JoinAttempts)(nil), "ttn.lorawan.v3.JoinAttempts")
+	proto.RegisterType((*GetJoinAttemptsRequest)(nil), "ttn.lorawan.v3.GetJoinAttemptsRequest")
+	golang_proto.RegisterType((*GetJoinAttemptsRequest)(nil), "ttn.lorawan.v3.GetJoinAttemptsRequest")
+	proto.RegisterType((*ResetEndDeviceNoncesRequest)(nil), "ttn.lorawan.v3.ResetEndDeviceNoncesRequest")
+	golang_proto.RegisterType((*ResetEndDeviceNoncesRequest)(nil), "ttn.lorawan.v3.ResetEndDeviceNoncesRequest")
 	proto.RegisterType((*JoinEUIPrefix)(nil), "ttn.lorawan.v3.JoinEUIPrefix")
 	golang_proto.RegisterType((*JoinEUIPrefix)(nil), "ttn.lorawan.v3.JoinEUIPrefix")
 	proto.RegisterType((*JoinEUIPrefixes)(nil), "ttn.lorawan.v3.JoinEUIPrefixes")
 	golang_proto.RegisterType((*JoinEUIPrefixes)(nil), "ttn.lorawan.v3.JoinEUIPrefixes")
+	proto.RegisterType((*ExternalActivation)(nil), "ttn.lorawan.v3.ExternalActivation")
+	golang_proto.RegisterType((*ExternalActivation)(nil), "ttn.lorawan.v3.ExternalActivation")
+	proto.RegisterType((*ExternalActivations)(nil), "ttn.lorawan.v3.ExternalActivations")
+	golang_proto.RegisterType((*ExternalActivations)(nil), "ttn.lorawan.v3.ExternalActivations")
+	proto.RegisterType((*ListExternalActivationsRequest)(nil), "ttn.lorawan.v3.ListExternalActivationsRequest")
+	golang_proto.RegisterType((*ListExternalActivationsRequest)(nil), "ttn.lorawan.v3.ListExternalActivationsRequest")
 }
 
 func init() {
@@ -858,124 +1311,168 @@ func init() {
 }
 
 var fileDescriptor_1b695d5f526759a7 = []byte{
-	// 1829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x41, 0x6c, 0x1b, 0xc7,
-	0x15, 0xdd, 0x21, 0x45, 0x4a, 0x1a, 0x49, 0x94, 0x3c, 0x76, 0x13, 0x96, 0x76, 0x96, 0x0e, 0xa3,
-	0xb6, 0xae, 0x63, 0x91, 0x01, 0xd3, 0x06, 0xa9, 0x82, 0xda, 0x20, 0x45, 0x56, 0xa2, 0x65, 0xa9,
-	0xea, 0xb2, 0x69, 0x53, 0x25, 0x0a, 0xb3, 0x22, 0x47, 0xf4, 0x9a, 0xd4, 0xec, 0x76, 0x67, 0x44,
-	0x85, 0x49, 0x0d, 0x18, 0x3e, 0x04, 0x6e, 0xd1, 0x43, 0x81, 0x36, 0x40, 0x8f, 0x45, 0x7b, 0x68,
-	0x0e, 0x3d, 0x04, 0xbd, 0x34, 0xa7, 0x22, 0x87, 0x1e, 0xdc, 0x9b, 0x8b, 0x5e, 0x82, 0x1e, 0xd4,
-	0x68, 0xd9, 0x43, 0x8e, 0x39, 0x15, 0x81, 0x4e, 0xc5, 0xcc, 0x0e, 0xc9, 0xe5, 0x92, 0x92, 0x45,
-	0x45, 0x32, 0xd0, 0xdb, 0xcc, 0xce, 0xff, 0x6f, 0xfe, 0x7f, 0xff, 0xff, 0xd9, 0xff, 0x61, 0xa2,
-	0x6e, 0xda, 0xfa, 0xae, 0x4e, 0xe6, 0x28, 0xd3, 0xcb, 0xb5, 0x94, 0x6e, 0x19, 0xa9, 0x3b, 0xa6,
-	0x41, 0x28, 0xb6, 0x1b, 0xd8, 0x4e, 0x5a, 0xb6, 0xc9, 0x4c, 0x14, 0x61, 0x8c, 0x24, 0xa5, 0x5c,
-	0xb2, 0xf1, 0x62, 0x2c, 0x53, 0x35, 0xd8, 0xed, 0x9d, 0xcd, 0x64, 0xd9, 0xdc, 0x4e, 0x61, 0xd2,
-	0x30, 0x9b, 0x96, 0x6d, 0xbe, 0xdd, 0x4c, 0x09, 0xe1, 0xf2, 0x5c, 0x15, 0x93, 0xb9, 0x86, 0x5e,
-	0x37, 0x2a, 0x3a, 0xc3, 0xa9, 0xbe, 0x85, 0x0b, 0x19, 0x9b, 0xf3, 0x40, 0x54, 0xcd, 0xaa, 0xe9,
-	0x2a, 0x6f, 0xee, 0x6c, 0x89, 0x9d, 0xd8, 0x88, 0x95, 0x14, 0xbf, 0x54, 0x35, 0xcd, 0x6a, 0x1d,
-	0x0b, 0xf3, 0x74, 0x42, 0x4c, 0xa6, 0x33, 0xc3, 0x24, 0x54, 0x9e, 0x5e, 0x94, 0xa7, 0x1d, 0x0c,
-	0xbc, 0x6d, 0xb1, 0xa6, 0x4f, 0xb5, 0x73, 0x48, 0x99, 0xbd, 0x53, 0x66, 0xf2, 0x74, 0x80, 0xfb,
-	0x98, 0x54, 0x4a, 0x15, 0xdc, 0x30, 0xca, 0x6d, 0x5b, 0x9f, 0xeb, 0x97, 0x31, 0x2a, 0x98, 0x30,
-	0x63, 0xcb, 0xc0, 0x76, 0xdb, 0x86, 0x4b, 0x83, 0x79, 0x3c, 0xfc, 0xb4, 0x86, 0x9b, 0x6d, 0xdd,
-	0x78, 0xff, 0x69, 0x9b, 0x6d, 0x21, 0x90, 0xf8, 0x4d, 0x00, 0x9e, 0x2b, 0x62, 0x4a, 0x0d, 0x93,
-	0x2c, 0xe3, 0xa6, 0x86, 0x7f, 0xba, 0x83, 0x29, 0x43, 0xd7, 0x61, 0x84, 0xba, 0x1f, 0x4b, 0x35,
-	0xdc, 0x2c, 0x19, 0x95, 0x28, 0xb8, 0x0c, 0xae, 0x4c, 0x66, 0xa3, 0x07, 0xd9, 0xd0, 0x3b, 0xc1,
-	0xe8, 0xbd, 0x19, 0x67, 0x2f, 0x3e, 0xd9, 0x55, 0x2b, 0xe4, 0xb4, 0x49, 0xda, 0xdd, 0x55, 0xd0,
-	0x06, 0x1c, 0xad, 0xe0, 0x46, 0x09, 0xef, 0x18, 0xd1, 0x80, 0x50, 0xcc, 0x3d, 0xdc, 0x8b, 0x2b,
-	0xff, 0xda, 0x8b, 0xa7, 0xab, 0x66, 0x92, 0xdd, 0xc6, 0xec, 0xb6, 0x41, 0xaa, 0x34, 0x49, 0x30,
-	0xdb, 0x35, 0xed, 0x5a, 0xaa, 0xd7, 0x48, 0xab, 0x56, 0x4d, 0xb1, 0xa6, 0x85, 0x69, 0x32, 0xff,
-	0x6a, 0xe1, 0xa5, 0x6f, 0x39, 0x7b, 0xf1, 0x70, 0x0e, 0x37, 0xf2, 0xaf, 0x16, 0xb4, 0x70, 0x05,
-	0x37, 0xf2, 0x3b, 0x06, 0x7a, 0x0b, 0x8e, 0x71, 0x06, 0x04, 0x7e, 0x50, 0xe0, 0xe7, 0xbf, 0x14,
-	0xfe, 0xe8, 0x4d, 0xd3, 0x20, 0xfc, 0x82, 0x51, 0x0e, 0x9b, 0xdf, 0x31, 0x12, 0xf7, 0x03, 0x70,
-	0x66, 0x75, 0xb7, 0x56, 0x5c, 0xc6, 0x4d, 0xaa, 0x61, 0x6a, 0x99, 0x84, 0x62, 0xf4, 0x7d, 0x38,
-	0xbd, 0x55, 0x22, 0xbb, 0xb5, 0x12, 0x2d, 0x19, 0x84, 0x71, 0x66, 0x04, 0x2d, 0x13, 0xe9, 0x8b,
-	0xc9, 0xde, 0x34, 0x4e, 0x2e, 0xe3, 0x66, 0x9e, 0x34, 0x70, 0xdd, 0xb4, 0x70, 0x76, 0xf2, 0x20,
-	0x1b, 0xfa, 0x05, 0x08, 0xcc, 0x00, 0x6e, 0xa2, 0x36, 0xb1, 0xc5, 0x61, 0x0b, 0x84, 0x2d, 0xe3,
-	0x26, 0x07, 0xa4, 0x3e, 0xc0, 0xc0, 0xd0, 0x80, 0xd4, 0x03, 0x78, 0x0b, 0x4e, 0xb9, 0x70, 0x98,
-	0x94, 0x05, 0x5c, 0x70, 0x58, 0x38, 0x48, 0x76, 0x6b, 0xc5, 0x3c, 0x29, 0x2f, 0xe3, 0x66, 0xe2,
-	0x35, 0x38, 0x9d, 0xb1, 0xac, 0xa2, 0xc8, 0x0b, 0x49, 0x41, 0x1e, 0x8e, 0xeb, 0x96, 0x55, 0xa2,
-	0x27, 0x73, 0x7e, 0x54, 0x77, 0xe1, 0x12, 0xbf, 0x0c, 0xc2, 0x8b, 0x0b, 0x76, 0xd3, 0x62, 0x66,
-	0x11, 0xdb, 0xbc, 0x1e, 0xd6, 0xf4, 0x66, 0xdd, 0xd4, 0x2b, 0xed, 0xfc, 0x5b, 0x82, 0x41, 0xa3,
-	0x42, 0xe5, 0x05, 0xb3, 0xfe, 0x0b, 0xf2, 0xa4, 0x92, 0x13, 0x55, 0x54, 0xe8, 0xd6, 0x4a, 0x76,
-	0xc6, 0x7b, 0xd3, 0xa3, 0xbd, 0x38, 0xd0, 0x38, 0x04, 0x2a, 0xc1, 0x69, 0xa9, 0x59, 0x6a, 0x60,
-	0x9b, 0x67, 0xa8, 0xa0, 0x38, 0x92, 0x8e, 0xf9, 0x51, 0x57, 0x32, 0x0b, 0x3f, 0x72, 0x25, 0xb2,
-	0xb1, 0x83, 0x6c, 0xe8, 0x3e, 0xc7, 0x72, 0xf6, 0xe2, 0x91, 0x5b, 0xa6, 0xa6, 0xff, 0x38, 0xb3,
-	0x2a, 0xcf, 0xb4, 0x88, 0x54, 0x91, 0x7b, 0x14, 0x85, 0xa3, 0x96, 0x6b, 0xbc, 0x9b, 0x8a, 0x5a,
-	0x7b, 0x8b, 0x36, 0x61, 0xc4, 0xb2, 0xcd, 0x86, 0xc1, 0xc5, 0xb0, 0xcd, 0x8b, 0x68, 0xe4, 0x32,
-	0xb8, 0x32, 0x9e, 0x7d, 0xe5, 0x20, 0xfb, 0x0d, 0xfb, 0x6b, 0xd1, 0xd9, 0xf4, 0xb3, 0x6f, 0xbe,
-	0xae, 0xcf, 0xbd, 0xf3, 0xc2, 0xdc, 0x77, 0x36, 0xae, 0xdc, 0x98, 0x7f, 0x7d, 0x6e, 0xe3, 0x46,
-	0x7b, 0xfb, 0xcd, 0x77, 0xd3, 0xd7, 0xee, 0xce, 0xfe, 0xec, 0xcd, 0x59, 0x67, 0x2f, 0x3e, 0xb5,
-	0xd6, 0xc5, 0x28, 0xe4, 0xb4, 0x29, 0x0f, 0x64, 0xa1, 0x82, 0x72, 0xf0, 0x5c, 0xe7, 0x83, 0x41,
-	0xaa, 0xa5, 0x8a, 0xce, 0xf4, 0x68, 0x48, 0xd0, 0xf6, 0x74, 0xd2, 0x7d, 0x9e, 0x92, 0xed, 0xe7,
-	0x29, 0x59, 0x14, 0xcf, 0x93, 0x36, 0xe3, 0xd5, 0xc8, 0xe9, 0x4c, 0x4f, 0xbc, 0x0c, 0x2f, 0x0d,
-	0x8e, 0x86, 0x8c, 0xba, 0xc7, 0x47, 0xd0, 0xe3, 0x63, 0xe2, 0x4f, 0x01, 0x78, 0x81, 0x17, 0x4f,
-	0xa6, 0x5c, 0xc6, 0x16, 0x5b, 0x29, 0x2c, 0xb4, 0x23, 0xb8, 0x05, 0xa7, 0xa5, 0x4c, 0xc9, 0x76,
-	0x3f, 0xc9, 0x68, 0x3e, 0xef, 0xe7, 0xfd, 0x88, 0x3c, 0x18, 0x10, 0xd4, 0x88, 0xd5, 0x9b, 0x29,
-	0x6b, 0xf0, 0x9c, 0x78, 0x0a, 0xe4, 0x25, 0x25, 0x5e, 0xd8, 0x87, 0x45, 0x58, 0xc3, 0x5c, 0xf4,
-	0x87, 0x4d, 0x0b, 0x67, 0xc7, 0xda, 0x11, 0xd6, 0xa6, 0xf9, 0x37, 0x89, 0xc6, 0x8f, 0xd0, 0x3a,
-	0x1c, 0xe7, 0x6f, 0x17, 0x31, 0x49, 0x19, 0xcb, 0xd7, 0xe5, 0xbb, 0xf2, 0x75, 0xf9, 0xf6, 0x50,
-	0xaf, 0x4b, 0x0e, 0x37, 0x56, 0x39, 0x88, 0x36, 0x56, 0x91, 0xab, 0xc4, 0x7b, 0x21, 0x18, 0xcd,
-	0x61, 0xdb, 0x68, 0xe0, 0xee, 0xe3, 0x49, 0xff, 0x0f, 0x93, 0x7e, 0x03, 0x42, 0xc1, 0xba, 0x97,
-	0xa4, 0xeb, 0x92, 0xa4, 0x97, 0x86, 0x22, 0x89, 0x27, 0x8f, 0xcb, 0xd2, 0xf8, 0x9d, 0xf6, 0xb2,
-	0x37, 0x04, 0x23, 0xa7, 0x1a, 0x02, 0xb4, 0x0e, 0xc3, 0x04, 0x33, 0x5e, 0x8d, 0x21, 0x01, 0xbc,
-	0x70, 0xa2, 0x3f, 0xc7, 0x2a, 0x66, 0x85, 0x9c, 0xb3, 0x17, 0x0f, 0x89, 0x85, 0x16, 0x22, 0x98,
-	0x15, 0x06, 0x55, 0x7c, 0xf8, 0xc9, 0x54, 0xfc, 0xe8, 0xb0, 0x15, 0xff, 0x20, 0x00, 0xd1, 0x22,
-	0x66, 0x9a, 0x69, 0xb2, 0xb3, 0x49, 0xc1, 0x7e, 0x2a, 0x02, 0x4f, 0x86, 0x8a, 0xe0, 0xb0, 0x54,
-	0xfc, 0x7d, 0x0c, 0xc6, 0x3a, 0xd7, 0x74, 0x5c, 0xec, 0x50, 0xf2, 0x13, 0x38, 0xad, 0x5b, 0x56,
-	0xdd, 0x28, 0x8b, 0xbe, 0xb0, 0xd4, 0xa5, 0xe7, 0xeb, 0x7e, 0x7a, 0x32, 0x5d, 0x31, 0x2f, 0x41,
-	0x63, 0xdd, 0xb7, 0x4b, 0xf7, 0x4a, 0xf0, 0x32, 0x1d, 0xcc, 0xd1, 0xcb, 0x07, 0xd9, 0x59, 0x3b,
-	0x11, 0x9d, 0x4d, 0xab, 0x47, 0x73, 0xf4, 0x58, 0x82, 0x9e, 0x3f, 0x8c, 0xa0, 0xc9, 0x7e, 0x1e,
-	0xd0, 0x1a, 0x1c, 0xa9, 0x1b, 0x94, 0x89, 0x7a, 0x9b, 0x48, 0xcf, 0xfb, 0xbd, 0x3b, 0x9c, 0xa2,
-	0xa4, 0xc7, 0xdb, 0x5b, 0x06, 0x65, 0x4b, 0x8a, 0x26, 0x90, 0x50, 0x11, 0x86, 0x6c, 0x9d, 0x54,
-	0xb1, 0xfc, 0x21, 0xbd, 0x72, 0x32, 0x48, 0x8d, 0x43, 0x2c, 0x29, 0x9a, 0x8b, 0x85, 0x36, 0xe0,
-	0xf8, 0x96, 0x6d, 0x6e, 0xbb, 0xbe, 0x84, 0x05, 0xf0, 0xf5, 0x93, 0x01, 0x7f, 0xcf, 0x36, 0xb7,
-	0xb9, 0xe7, 0x4b, 0x8a, 0x36, 0xb6, 0x25, 0xd7, 0xb1, 0x7f, 0x00, 0x38, 0xed, 0xf3, 0x07, 0xbd,
-	0xe1, 0x69, 0x37, 0xdd, 0x3e, 0x38, 0x73, 0x7a, 0xad, 0x26, 0x7a, 0x0b, 0x46, 0xba, 0x73, 0x81,
-	0xc8, 0xaf, 0xc0, 0xe5, 0xe0, 0xb1, 0xcb, 0xef, 0x02, 0xcf, 0x2e, 0xde, 0x8d, 0x77, 0x4f, 0x73,
-	0x54, 0x9b, 0xc4, 0x5d, 0x59, 0x1a, 0xfb, 0x37, 0x80, 0x33, 0x7e, 0x42, 0xcf, 0xd8, 0xa9, 0x6d,
-	0x38, 0x45, 0x99, 0x6e, 0xb3, 0x52, 0xef, 0x18, 0x50, 0xf8, 0x52, 0x6d, 0xfa, 0x44, 0x91, 0x43,
-	0xca, 0x59, 0x60, 0x82, 0xb6, 0x37, 0x3b, 0x46, 0x8c, 0xc2, 0xf3, 0x03, 0x02, 0x7b, 0xb6, 0x3e,
-	0xce, 0x07, 0xa2, 0x20, 0x3b, 0x05, 0x27, 0xba, 0xc1, 0xa3, 0x89, 0x9f, 0x03, 0x38, 0x25, 0xe5,
-	0xd6, 0x6c, 0xbc, 0x65, 0xbc, 0xdd, 0x33, 0xaa, 0x80, 0xb3, 0x18, 0x55, 0xd0, 0x53, 0x30, 0x5c,
-	0xc7, 0xa4, 0xca, 0x6e, 0x0b, 0x8e, 0xa7, 0x34, 0xb9, 0x4b, 0x68, 0x70, 0xba, 0xc7, 0x14, 0x4c,
-	0xd1, 0x0d, 0x38, 0x66, 0xc9, 0x75, 0x14, 0x88, 0x24, 0x7b, 0xc6, 0x9f, 0x64, 0x3d, 0x2a, 0xd9,
-	0x11, 0xd1, 0xb6, 0x77, 0x94, 0xd2, 0x7f, 0x00, 0x70, 0x64, 0x95, 0xde, 0xa4, 0x68, 0x11, 0xc2,
-	0x25, 0x9d, 0x54, 0xea, 0x98, 0xcb, 0xa3, 0x8b, 0x83, 0x50, 0x64, 0xc5, 0xc5, 0x2e, 0x0d, 0x3e,
-	0x94, 0xad, 0xa5, 0x06, 0x27, 0x16, 0x31, 0x6b, 0x8f, 0x5a, 0xe8, 0x59, 0xbf, 0x70, 0xdf, 0x6c,
-	0x1a, 0xbb, 0xec, 0x17, 0xf1, 0xcf, 0x69, 0xe9, 0xd7, 0xe0, 0x48, 0x86, 0x1b, 0xb9, 0x06, 0xe1,
-	0x22, 0x66, 0x72, 0x84, 0x39, 0x0e, 0x74, 0x7c, 0xc0, 0x93, 0xee, 0x1d, 0x7f, 0xd2, 0xff, 0x1d,
-	0x81, 0x17, 0x56, 0xdd, 0x48, 0xf5, 0xb4, 0xad, 0xa8, 0x06, 0x23, 0x1e, 0x9f, 0x57, 0x0a, 0x0b,
-	0x68, 0x98, 0x3e, 0x37, 0x76, 0xed, 0x78, 0xc2, 0x92, 0xb3, 0xb2, 0x9b, 0x64, 0x9d, 0x9e, 0x1b,
-	0xcd, 0x0e, 0xa2, 0xd8, 0xdf, 0x92, 0x0f, 0x79, 0x09, 0x81, 0xe7, 0xf2, 0xa4, 0xcc, 0x25, 0xba,
-	0x60, 0x67, 0xe9, 0x94, 0x05, 0xcf, 0xcb, 0xfb, 0xdc, 0x36, 0xfd, 0xec, 0x6f, 0x7c, 0x03, 0x46,
-	0xdc, 0x5e, 0xbc, 0x93, 0x7d, 0x57, 0xfc, 0xfa, 0x87, 0xf5, 0xea, 0x8f, 0x4f, 0x42, 0x74, 0x0b,
-	0x8e, 0xbb, 0x89, 0xcd, 0x73, 0x2f, 0xe1, 0x17, 0xef, 0xef, 0xbd, 0x62, 0x47, 0xcd, 0xd1, 0xe9,
-	0xbf, 0x01, 0x18, 0xf5, 0xf4, 0x17, 0xbd, 0xc9, 0xb7, 0x0e, 0xa7, 0x5c, 0x43, 0xdb, 0xa9, 0x7e,
-	0x7c, 0x3f, 0x1e, 0x97, 0xf1, 0xd2, 0x8d, 0x8c, 0x65, 0x9d, 0x8a, 0x1b, 0xef, 0x87, 0xe1, 0xf9,
-	0x9b, 0xb4, 0xf3, 0xab, 0xd2, 0x70, 0xd5, 0xa0, 0xcc, 0x6e, 0xa2, 0x3f, 0x03, 0x18, 0x5c, 0xc4,
-	0x0c, 0x3d, 0x37, 0xe0, 0x02, 0x8f, 0xb4, 0x7b, 0xc3, 0x57, 0x0f, 0xfd, 0x31, 0x26, 0x6a, 0xf7,
-	0xff, 0xf9, 0x9f, 0x5f, 0x07, 0x30, 0x2a, 0xa7, 0xee, 0xd0, 0x94, 0xa7, 0xdb, 0xa2, 0xa9, 0x77,
-	0x7b, 0xff, 0xb1, 0x49, 0x5f, 0x4f, 0xe7, 0xdb, 0xdf, 0x4d, 0xc9, 0x17, 0xbd, 0x4f, 0xaf, 0xb3,
-	0xbc, 0x8b, 0xde, 0x0b, 0xc0, 0x60, 0x71, 0x90, 0xd1, 0xc5, 0xe1, 0x8c, 0xfe, 0x2b, 0x10, 0x56,
-	0xff, 0x05, 0xc4, 0x8e, 0x34, 0x3b, 0x79, 0x42, 0xb3, 0x93, 0xbd, 0x66, 0xcf, 0x83, 0xab, 0xeb,
-	0x2b, 0x89, 0xa5, 0xd3, 0xba, 0x69, 0x1e, 0x5c, 0x45, 0x7f, 0x04, 0x70, 0xbc, 0xd3, 0x72, 0xa1,
-	0xab, 0xc7, 0xef, 0xc6, 0x8e, 0x62, 0xe5, 0x07, 0x82, 0x94, 0xa5, 0xd8, 0x42, 0xbf, 0xa5, 0x8f,
-	0x33, 0xad, 0xd3, 0xda, 0xce, 0x75, 0x8d, 0x7c, 0x10, 0x00, 0x2f, 0x00, 0xf4, 0x3e, 0x80, 0xe1,
-	0x1c, 0xae, 0x63, 0x86, 0xd1, 0xb1, 0xda, 0xab, 0xd8, 0x53, 0x7d, 0x73, 0x44, 0x7e, 0xdb, 0x62,
-	0xcd, 0xc4, 0x8a, 0xb0, 0x6e, 0xf1, 0x6a, 0x7e, 0x78, 0xeb, 0x3a, 0x21, 0xea, 0xc6, 0x24, 0x6d,
-	0xc3, 0xc0, 0x4d, 0x8a, 0xea, 0x62, 0x26, 0xf3, 0xff, 0xb4, 0x0f, 0x31, 0xa1, 0xbf, 0x74, 0x7d,
-	0x8a, 0x89, 0x67, 0x84, 0x8d, 0x4f, 0xa3, 0xaf, 0x70, 0x1b, 0xdb, 0x4d, 0x48, 0xa9, 0xfd, 0x2f,
-	0xcf, 0xfe, 0x1e, 0x3c, 0xdc, 0x57, 0xc1, 0xa3, 0x7d, 0x15, 0x7c, 0xb2, 0xaf, 0x2a, 0x9f, 0xee,
-	0xab, 0xca, 0x67, 0xfb, 0xaa, 0xf2, 0xf9, 0xbe, 0xaa, 0x7c, 0xb1, 0xaf, 0x82, 0x7b, 0x8e, 0x0a,
-	0x1e, 0x38, 0xaa, 0xf2, 0x81, 0xa3, 0x82, 0x0f, 0x1d, 0x55, 0xf9, 0xc8, 0x51, 0x95, 0x8f, 0x1d,
-	0x55, 0x79, 0xe8, 0xa8, 0xe0, 0x91, 0xa3, 0x82, 0x4f, 0x1c, 0x55, 0xf9, 0xd4, 0x51, 0xc1, 0x67,
-	0x8e, 0xaa, 0x7c, 0xee, 0xa8, 0xe0, 0x0b, 0x47, 0x55, 0xee, 0xb5, 0x54, 0xe5, 0x41, 0x4b, 0x05,
-	0xbf, 0x6a, 0xa9, 0xca, 0x6f, 0x5b, 0x2a, 0xf8, 0x5d, 0x4b, 0x55, 0x3e, 0x68, 0xa9, 0xca, 0x87,
-	0x2d, 0x15, 0x7c, 0xd4, 0x52, 0xc1, 0xc7, 0x2d, 0x15, 0xac, 0x5f, 0x3b, 0x6e, 0xdb, 0xc3, 0x88,
-	0xb5, 0xb9, 0x19, 0x16, 0x4e, 0xbf, 0xf8, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x0e, 0xb9,
-	0xea, 0x4c, 0x18, 0x00, 0x00,
+	// 2431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xe7, 0x92, 0xa2, 0x44, 0x3d, 0xea, 0x83, 0x1e, 0xc7, 0x0e, 0xff, 0xb4, 0xb3, 0xb4, 0xd7,
+	0xfa, 0xa7, 0xae, 0x62, 0x91, 0x29, 0xd3, 0x1a, 0xa9, 0x83, 0xd8, 0x20, 0xc5, 0x8d, 0x44, 0x7d,
+	0x50, 0xea, 0xca, 0x76, 0x12, 0xc7, 0xce, 0x66, 0x4d, 0x8e, 0xe8, 0xb5, 0xa8, 0xdd, 0xcd, 0xce,
+	0x88, 0x36, 0xe3, 0x1a, 0x70, 0x7d, 0x08, 0x9c, 0xa2, 0x87, 0x00, 0xfd, 0x40, 0x8f, 0x45, 0x8b,
+	0xa2, 0x39, 0x14, 0x45, 0x50, 0x14, 0x68, 0x4e, 0x6d, 0x0e, 0x3d, 0xb8, 0x37, 0x37, 0xbd, 0x04,
+	0x3d, 0x28, 0x11, 0xd5, 0x02, 0x46, 0x4f, 0x39, 0x06, 0x3e, 0x15, 0x3b, 0xbb, 0x4b, 0x2e, 0xc9,
+	0x95, 0x2c, 0xda, 0x92, 0x80, 0xdc, 0x76, 0x38, 0x6f, 0xde, 0xbc, 0xf7, 0x7b, 0x1f, 0xf3, 0xde,
+	0x23, 0x08, 0x55, 0xdd, 0x54, 0x6e, 0x28, 0xda, 0x04, 0xa1, 0x4a, 0x69, 0x25, 0xad, 0x18, 0x6a,
+	0xfa, 0xba, 0xae, 0x6a, 0x04, 0x9b, 0x35, 0x6c, 0xa6, 0x0c, 0x53, 0xa7, 0x3a, 0x1a, 0xa1, 0x54,
+	0x4b, 0x39, 0x74, 0xa9, 0xda, 0x4b, 0x89, 0x6c, 0x45, 0xa5, 0xd7, 0xd6, 0xae, 0xa6, 0x4a, 0xfa,
+	0x6a, 0x1a, 0x6b, 0x35, 0xbd, 0x6e, 0x98, 0xfa, 0xcd, 0x7a, 0x9a, 0x11, 0x97, 0x26, 0x2a, 0x58,
+	0x9b, 0xa8, 0x29, 0x55, 0xb5, 0xac, 0x50, 0x9c, 0xee, 0xfa, 0xb0, 0x59, 0x26, 0x26, 0x3c, 0x2c,
+	0x2a, 0x7a, 0x45, 0xb7, 0x0f, 0x5f, 0x5d, 0x5b, 0x66, 0x2b, 0xb6, 0x60, 0x5f, 0x0e, 0xf9, 0xd1,
+	0x8a, 0xae, 0x57, 0xaa, 0x98, 0x89, 0xa7, 0x68, 0x9a, 0x4e, 0x15, 0xaa, 0xea, 0x1a, 0x71, 0x76,
+	0x8f, 0x38, 0xbb, 0x4d, 0x1e, 0x78, 0xd5, 0xa0, 0xf5, 0x8e, 0xa3, 0xcd, 0x4d, 0x42, 0xcd, 0xb5,
+	0x12, 0x75, 0x76, 0x93, 0x9d, 0xbb, 0x54, 0x5d, 0xc5, 0x84, 0x2a, 0xab, 0x86, 0x43, 0xe0, 0x83,
+	0x0f, 0xd6, 0xca, 0x72, 0x19, 0xd7, 0xd4, 0x92, 0xab, 0xcc, 0x73, 0x3e, 0x34, 0xa6, 0xa9, 0x3b,
+	0xf0, 0x25, 0x4e, 0x74, 0x6f, 0xab, 0x65, 0xac, 0x51, 0x75, 0x59, 0xc5, 0xa6, 0xab, 0xc3, 0x51,
+	0x7f, 0x3b, 0x6c, 0xbd, 0xbb, 0x82, 0xeb, 0xee, 0xd9, 0x64, 0xf7, 0xae, 0x6b, 0x2d, 0x46, 0x20,
+	0xfc, 0x2c, 0x08, 0x07, 0x96, 0x30, 0x21, 0xaa, 0xae, 0xcd, 0xe2, 0xba, 0x84, 0xdf, 0x5d, 0xc3,
+	0x84, 0xa2, 0xb3, 0x30, 0x42, 0xec, 0x1f, 0xe5, 0x15, 0x5c, 0x97, 0xd5, 0x72, 0x9c, 0x3b, 0xc6,
+	0x9d, 0x1c, 0xca, 0xc5, 0x1f, 0xe5, 0xc2, 0xef, 0x85, 0xe2, 0x77, 0x62, 0x8d, 0xf5, 0xe4, 0x50,
+	0xeb, 0x58, 0x21, 0x2f, 0x0d, 0x91, 0xd6, 0xaa, 0x8c, 0xae, 0xc0, 0x40, 0x19, 0xd7, 0x64, 0xbc,
+	0xa6, 0xc6, 0x83, 0xec, 0x60, 0xfe, 0xfe, 0x7a, 0x32, 0xf0, 0xaf, 0xf5, 0x64, 0xa6, 0xa2, 0xa7,
+	0xe8, 0x35, 0x4c, 0xaf, 0xa9, 0x5a, 0x85, 0xa4, 0x34, 0x4c, 0x6f, 0xe8, 0xe6, 0x4a, 0xba, 0x5d,
+	0x48, 0x63, 0xa5, 0x92, 0xa6, 0x75, 0x03, 0x93, 0x94, 0x78, 0xa1, 0x70, 0xfa, 0xbb, 0x8d, 0xf5,
+	0x64, 0x7f, 0x1e, 0xd7, 0xc4, 0x0b, 0x05, 0xa9, 0xbf, 0x8c, 0x6b, 0xe2, 0x9a, 0x8a, 0xde, 0x81,
+	0x88, 0x85, 0x00, 0xe3, 0x1f, 0x62, 0xfc, 0xc5, 0xa7, 0xe2, 0x3f, 0x30, 0xa3, 0xab, 0x9a, 0x75,
+	0xc1, 0x80, 0xc5, 0x56, 0x5c, 0x53, 0x85, 0xbb, 0x41, 0x88, 0x15, 0x6f, 0xac, 0x2c, 0xcd, 0xe2,
+	0x3a, 0x91, 0x30, 0x31, 0x74, 0x8d, 0x60, 0xb4, 0x00, 0xa3, 0xcb, 0xb2, 0x76, 0x63, 0x45, 0x26,
+	0xb2, 0xaa, 0x51, 0x0b, 0x19, 0x06, 0x4b, 0x34, 0x73, 0x24, 0xd5, 0x1e, 0x06, 0xa9, 0x59, 0x5c,
+	0x17, 0xb5, 0x1a, 0xae, 0xea, 0x06, 0xce, 0x0d, 0x3d, 0xca, 0x85, 0x7f, 0xcc, 0x05, 0x63, 0x9c,
+	0x25, 0xa2, 0x14, 0x5d, 0xb6, 0xd8, 0x16, 0x34, 0x3a, 0x8b, 0xeb, 0x16, 0x43, 0xd2, 0xc1, 0x30,
+	0xd8, 0x33, 0x43, 0xe2, 0x61, 0x38, 0x07, 0xc3, 0x36, 0x3b, 0xac, 0x95, 0x18, 0xbb, 0x50, 0xaf,
+	0xec, 0x40, 0xbb, 0xb1, 0xb2, 0x24, 0x6a, 0xa5, 0x59, 0x5c, 0x17, 0xde, 0x80, 0xd1, 0xac, 0x61,
+	0x2c, 0x31, 0xbf, 0x70, 0x20, 0x10, 0x61, 0x50, 0x31, 0x0c, 0x99, 0x3c, 0x99, 0xf2, 0x03, 0x8a,
+	0xcd, 0x4e, 0xf8, 0x49, 0x08, 0x8e, 0x4c, 0x9a, 0x75, 0x83, 0xea, 0x4b, 0xd8, 0xb4, 0xc2, 0x65,
+	0x51, 0xa9, 0x57, 0x75, 0xa5, 0xec, 0xfa, 0xdf, 0x34, 0x84, 0xd4, 0x32, 0x71, 0x2e, 0x18, 0xeb,
+	0xbc, 0x40, 0xd4, 0xca, 0x79, 0x16, 0x64, 0x85, 0x56, 0xac, 0xe4, 0x62, 0xde, 0x9b, 0x1e, 0xac,
+	0x27, 0x39, 0xc9, 0x62, 0x81, 0x64, 0x18, 0x75, 0x4e, 0xca, 0x35, 0x6c, 0x5a, 0x1e, 0xca, 0x20,
+	0x1e, 0xc9, 0x24, 0x3a, 0xb9, 0xce, 0x67, 0x27, 0x2f, 0xda, 0x14, 0xb9, 0xc4, 0xa3, 0x5c, 0xf8,
+	0xae, 0xc5, 0xab, 0xb1, 0x9e, 0x1c, 0x99, 0xd3, 0x25, 0xe5, 0xf5, 0x6c, 0xd1, 0xd9, 0x93, 0x46,
+	0x9c, 0x23, 0xce, 0x1a, 0xc5, 0x61, 0xc0, 0xb0, 0x85, 0xb7, 0x5d, 0x51, 0x72, 0x97, 0xe8, 0x2a,
+	0x8c, 0x18, 0xa6, 0x5e, 0x53, 0x2d, 0x32, 0x6c, 0x5a, 0x41, 0xd4, 0x77, 0x8c, 0x3b, 0x39, 0x98,
+	0x7b, 0xe5, 0x51, 0xee, 0x5b, 0xe6, 0xff, 0xc7, 0xc7, 0x32, 0xc7, 0xdf, 0x7e, 0x4b, 0x99, 0x78,
+	0xef, 0xc5, 0x89, 0xef, 0x5f, 0x39, 0x79, 0xee, 0xcc, 0x5b, 0x13, 0x57, 0xce, 0xb9, 0xcb, 0x6f,
+	0xdf, 0xca, 0x9c, 0xba, 0x3d, 0xf6, 0xc3, 0xb7, 0xc7, 0x1a, 0xeb, 0xc9, 0xe1, 0xc5, 0x16, 0x8f,
+	0x42, 0x5e, 0x1a, 0xf6, 0xb0, 0x2c, 0x94, 0x51, 0x1e, 0x0e, 0x34, 0x7f, 0x50, 0xb5, 0x8a, 0x5c,
+	0x56, 0xa8, 0x12, 0x0f, 0x33, 0xd8, 0x9e, 0x4d, 0xd9, 0x09, 0x2c, 0xe5, 0x26, 0xb0, 0xd4, 0x12,
+	0x4b, 0x6f, 0x52, 0xcc, 0x7b, 0x22, 0xaf, 0x50, 0x45, 0x78, 0x19, 0x8e, 0xfa, 0x5b, 0xc3, 0xb1,
+	0xba, 0x47, 0x47, 0xae, 0x4d, 0x47, 0xe1, 0xf7, 0x41, 0x78, 0xc6, 0x0a, 0x9e, 0x6c, 0xa9, 0x84,
+	0x0d, 0x3a, 0x5f, 0x98, 0x74, 0x2d, 0xb8, 0x0c, 0xa3, 0x0e, 0x8d, 0x6c, 0xda, 0x3f, 0x39, 0xd6,
+	0x7c, 0xa1, 0x13, 0xf7, 0x6d, 0xfc, 0xc0, 0xc7, 0xa8, 0x23, 0x46, 0xbb, 0xa7, 0x2c, 0xc2, 0x01,
+	0x96, 0x0a, 0x9c, 0x4b, 0x64, 0x2b, 0xb0, 0xb7, 0xb2, 0xb0, 0x84, 0x2d, 0xd2, 0xf3, 0x75, 0x03,
+	0xe7, 0x22, 0xae, 0x85, 0xa5, 0x51, 0xeb, 0x37, 0x87, 0x9b, 0xb5, 0x85, 0x2e, 0xc1, 0xa0, 0x95,
+	0xbb, 0x34, 0x5d, 0x2b, 0x61, 0x27, 0xbb, 0xbc, 0xea, 0x64, 0x97, 0xef, 0xf5, 0x94, 0x5d, 0xf2,
+	0xb8, 0x56, 0xb4, 0x98, 0x48, 0x91, 0xb2, 0xf3, 0x25, 0xbc, 0x1f, 0x86, 0x78, 0x1e, 0x9b, 0x6a,
+	0x0d, 0xb7, 0x92, 0x27, 0xf9, 0x06, 0x3a, 0xfd, 0x15, 0x00, 0x86, 0xba, 0x17, 0xa4, 0xb3, 0x0e,
+	0x48, 0xa7, 0x7b, 0x02, 0xc9, 0x72, 0x1e, 0x1b, 0xa5, 0xc1, 0xeb, 0xee, 0x67, 0xbb, 0x09, 0xfa,
+	0x76, 0xd5, 0x04, 0xe8, 0x12, 0xf4, 0x6b, 0x98, 0x5a, 0xd1, 0x18, 0x66, 0x8c, 0x27, 0x9f, 0xe8,
+	0xe5, 0x28, 0x62, 0x5a, 0xc8, 0x37, 0xd6, 0x93, 0x61, 0xf6, 0x21, 0x85, 0x35, 0x4c, 0x0b, 0x7e,
+	0x11, 0xdf, 0xbf, 0x3f, 0x11, 0x3f, 0xd0, 0x6b, 0xc4, 0xdf, 0x0b, 0x02, 0x9a, 0xc2, 0x54, 0xd2,
+	0x75, 0xba, 0x37, 0x2e, 0xd8, 0x0d, 0x45, 0x70, 0x7f, 0xa0, 0x08, 0xf5, 0x0a, 0xc5, 0xdf, 0x23,
+	0x90, 0x68, 0x5e, 0xd3, 0x54, 0xb1, 0x09, 0xc9, 0x9b, 0x30, 0xaa, 0x18, 0x46, 0x55, 0x2d, 0xb1,
+	0xba, 0x52, 0x6e, 0xc1, 0xf3, 0x7c, 0x27, 0x3c, 0xd9, 0x16, 0x99, 0x17, 0xa0, 0x48, 0x2b, 0x77,
+	0x29, 0x5e, 0x0a, 0x2b, 0x4c, 0xfd, 0x31, 0x7a, 0xf9, 0x51, 0x6e, 0xcc, 0x14, 0xe2, 0x63, 0x19,
+	0x7e, 0x7b, 0x8c, 0x1e, 0x0b, 0xd0, 0x0b, 0x5b, 0x01, 0x34, 0xd4, 0x8d, 0x03, 0x5a, 0x84, 0xbe,
+	0xaa, 0x4a, 0x28, 0x8b, 0xb7, 0x68, 0xe6, 0x4c, 0xa7, 0x76, 0x5b, 0x43, 0x94, 0xf2, 0x68, 0x3b,
+	0xa7, 0x12, 0x3a, 0x1d, 0x90, 0x18, 0x27, 0xb4, 0x04, 0x61, 0x53, 0xd1, 0x2a, 0xd8, 0x79, 0x90,
+	0x5e, 0x79, 0x32, 0x96, 0x92, 0xc5, 0x62, 0x3a, 0x20, 0xd9, 0xbc, 0xd0, 0x15, 0x18, 0x5c, 0x36,
+	0xf5, 0x55, 0x5b, 0x97, 0x7e, 0xc6, 0xf8, 0xec, 0x93, 0x31, 0x7e, 0xcd, 0xd4, 0x57, 0x2d, 0xcd,
+	0xa7, 0x03, 0x52, 0x64, 0xd9, 0xf9, 0x4e, 0xfc, 0x83, 0x83, 0xd1, 0x0e, 0x7d, 0xd0, 0x65, 0x4f,
+	0xb9, 0x69, 0xd7, 0xc1, 0xd9, 0xdd, 0x2b, 0x35, 0xd1, 0x3b, 0x30, 0xd2, 0x6a, 0x1b, 0x98, 0x7f,
+	0x05, 0x8f, 0x85, 0x76, 0x1c, 0x7e, 0xcf, 0x58, 0xde, 0x65, 0x55, 0xe3, 0xad, 0xdd, 0x3c, 0x91,
+	0x86, 0x70, 0x8b, 0x96, 0x24, 0xbe, 0xe0, 0x20, 0xd6, 0x09, 0xe8, 0x1e, 0x2b, 0xb5, 0x0a, 0xc3,
+	0x84, 0x2a, 0x26, 0x95, 0xdb, 0xdb, 0x80, 0xc2, 0x53, 0x95, 0xe9, 0xd1, 0x25, 0x8b, 0xa5, 0xd3,
+	0x0b, 0x44, 0x89, 0xbb, 0x58, 0x53, 0x13, 0x04, 0x0e, 0xfa, 0x18, 0x76, 0x6f, 0x75, 0x3c, 0x13,
+	0x8c, 0x73, 0xb9, 0x61, 0x88, 0xb6, 0x8c, 0x47, 0x84, 0xdf, 0xf6, 0x41, 0x94, 0x95, 0x43, 0x94,
+	0x5a, 0x8d, 0x26, 0x12, 0x21, 0x6a, 0xe2, 0x12, 0x56, 0x6b, 0xb8, 0x2c, 0x2b, 0x6e, 0x05, 0x94,
+	0xe8, 0xca, 0x4d, 0xe7, 0xdd, 0xce, 0xd2, 0x4e, 0x16, 0x1f, 0x7e, 0x91, 0xe4, 0x24, 0x70, 0x0f,
+	0x66, 0x29, 0x3a, 0x0d, 0xfd, 0xab, 0xde, 0xca, 0xe6, 0x50, 0xd7, 0x33, 0xde, 0x51, 0xd4, 0x84,
+	0x57, 0xf7, 0xba, 0x94, 0xf1, 0xbc, 0xa3, 0x7d, 0xbb, 0xfe, 0x8e, 0x26, 0x20, 0xa2, 0xb0, 0x82,
+	0x12, 0xdb, 0xaf, 0x74, 0x44, 0x6a, 0xae, 0xd1, 0x02, 0x0c, 0x9b, 0xf8, 0x3a, 0x2e, 0x51, 0xd9,
+	0xc4, 0x0a, 0xd1, 0x35, 0x96, 0x03, 0x46, 0x32, 0xc7, 0x3a, 0x21, 0x99, 0x61, 0x65, 0x9d, 0x45,
+	0x28, 0x31, 0x3a, 0x0f, 0x3a, 0x43, 0xa6, 0xe7, 0x77, 0x94, 0x81, 0x30, 0x6b, 0xc9, 0x9d, 0x47,
+	0xf4, 0x68, 0x57, 0xd8, 0x59, 0x9b, 0x79, 0x4c, 0x15, 0xb5, 0x4a, 0x24, 0x9b, 0xd4, 0xa7, 0x3f,
+	0x8e, 0xf4, 0xd2, 0x1f, 0x0b, 0xf3, 0x30, 0xe4, 0x71, 0x13, 0x82, 0x5e, 0x85, 0x88, 0xe2, 0x7c,
+	0xc7, 0xb9, 0x63, 0x21, 0xbf, 0xae, 0xca, 0x43, 0x9f, 0xeb, 0x63, 0xdd, 0x54, 0xf3, 0x88, 0xf0,
+	0x0b, 0x0e, 0x0e, 0x4f, 0x61, 0xea, 0x65, 0xe9, 0x3e, 0x5f, 0x97, 0xbb, 0xb2, 0xcb, 0xd3, 0x3d,
+	0xee, 0x6d, 0x99, 0x05, 0xf1, 0x10, 0xae, 0xaa, 0xab, 0x2a, 0x65, 0x7e, 0x39, 0xcc, 0x20, 0x1e,
+	0x0f, 0xc5, 0x1f, 0x0e, 0x48, 0xf6, 0xcf, 0xc2, 0x1f, 0x38, 0x38, 0x22, 0x61, 0x82, 0x69, 0x93,
+	0x3b, 0x73, 0x9e, 0x7d, 0x92, 0xee, 0x3b, 0x70, 0xc8, 0xb4, 0x2e, 0x97, 0xd7, 0x08, 0x2e, 0xcb,
+	0xcd, 0x48, 0x20, 0x4c, 0xda, 0x88, 0x84, 0xd8, 0xe6, 0x05, 0x82, 0xcb, 0xae, 0x7b, 0x13, 0xe1,
+	0x03, 0x0e, 0x86, 0x9d, 0x40, 0x5f, 0x34, 0xf1, 0xb2, 0x7a, 0xb3, 0x6d, 0xd6, 0xc0, 0xed, 0xc5,
+	0xac, 0x01, 0x1d, 0x86, 0xfe, 0x2a, 0xd6, 0x2a, 0xf4, 0x9a, 0x8d, 0xa2, 0xe4, 0xac, 0x04, 0x09,
+	0x46, 0xdb, 0x44, 0xc1, 0x04, 0x9d, 0x83, 0x88, 0xe1, 0x7c, 0x3b, 0x7e, 0xf2, 0x9c, 0x9f, 0x9f,
+	0x34, 0x8f, 0xb8, 0x9e, 0xe2, 0x1e, 0x12, 0xfe, 0x1b, 0x02, 0x24, 0xde, 0xa4, 0xd8, 0xd4, 0x94,
+	0x6a, 0xb6, 0x44, 0xd5, 0x1a, 0x2b, 0x46, 0xf6, 0x41, 0xc9, 0x3d, 0x9e, 0x08, 0xb5, 0xb2, 0x51,
+	0x68, 0xd7, 0xb3, 0x51, 0x0a, 0x0e, 0x32, 0x70, 0xec, 0xc1, 0xa7, 0xac, 0x94, 0xcb, 0x26, 0x26,
+	0xc4, 0x6e, 0xe6, 0x25, 0xd6, 0x7d, 0x2e, 0xb1, 0x9d, 0xac, 0xbd, 0xe1, 0x93, 0x1c, 0xc2, 0x3d,
+	0x0d, 0xcf, 0xa6, 0x60, 0x48, 0xb1, 0x4d, 0x63, 0xbf, 0x1a, 0xfd, 0x3d, 0xbc, 0x1a, 0xd1, 0xe6,
+	0xc9, 0x2c, 0x15, 0x14, 0x38, 0xd8, 0x6d, 0x6b, 0x82, 0x66, 0x20, 0xaa, 0xb4, 0x96, 0x8e, 0x1f,
+	0x09, 0x5d, 0x11, 0xd7, 0x75, 0xd2, 0x71, 0x26, 0xef, 0x61, 0xe1, 0x47, 0x41, 0xe0, 0xad, 0x1a,
+	0xc9, 0xe7, 0x1e, 0x37, 0xc6, 0xbf, 0xf1, 0xbe, 0xd5, 0x4c, 0x72, 0x21, 0xdf, 0x24, 0x37, 0xfe,
+	0x01, 0x07, 0xb1, 0xce, 0xd7, 0x06, 0x1d, 0x82, 0x03, 0x33, 0x0b, 0x85, 0xa2, 0x2c, 0x89, 0x33,
+	0xe2, 0xe4, 0x79, 0x79, 0xe1, 0xfc, 0xb4, 0x28, 0xc5, 0x02, 0x88, 0x87, 0x84, 0xf7, 0xe7, 0x0b,
+	0xc5, 0xd9, 0xe2, 0xc2, 0xeb, 0x45, 0x39, 0x2f, 0x5e, 0x2c, 0x4c, 0x8a, 0x31, 0x0e, 0x09, 0xc0,
+	0x7b, 0xf7, 0xf3, 0xe2, 0x45, 0xb9, 0xb8, 0x50, 0x9c, 0x14, 0x65, 0x49, 0x5c, 0x9c, 0xcb, 0xbe,
+	0x29, 0xe6, 0x63, 0x41, 0x94, 0x80, 0xc3, 0x5e, 0x9a, 0xf9, 0xc2, 0xa4, 0xfc, 0x5a, 0xb6, 0x30,
+	0x27, 0xe6, 0x63, 0xa1, 0xcc, 0x6f, 0x38, 0xe8, 0x2b, 0x92, 0x19, 0x82, 0xa6, 0x00, 0xa6, 0x15,
+	0xad, 0x5c, 0xc5, 0x96, 0x64, 0xe8, 0x88, 0xff, 0xeb, 0xc8, 0x0c, 0x94, 0x38, 0xea, 0xbf, 0xe9,
+	0xcc, 0x7e, 0x24, 0x88, 0x4e, 0x61, 0xea, 0xce, 0x42, 0xd1, 0xf1, 0x4e, 0xe2, 0xae, 0xe1, 0x71,
+	0xa2, 0xeb, 0x29, 0xee, 0x1c, 0xa4, 0x66, 0xde, 0x80, 0xbe, 0xac, 0x25, 0xe4, 0x22, 0xc0, 0x14,
+	0xa6, 0xce, 0x8c, 0x71, 0x27, 0xac, 0x93, 0x3e, 0x3d, 0x97, 0x77, 0x3e, 0x99, 0xf9, 0x4f, 0x18,
+	0x9e, 0x29, 0xda, 0x76, 0x6e, 0x9b, 0x2b, 0xa1, 0x15, 0x18, 0xf1, 0xe8, 0x3c, 0x5f, 0x98, 0x44,
+	0xbd, 0x0c, 0xa2, 0x12, 0xa7, 0x76, 0x46, 0xec, 0x60, 0xb6, 0x0a, 0x31, 0x7b, 0xd6, 0xb4, 0x3f,
+	0xd7, 0x95, 0x60, 0xb8, 0x6d, 0x06, 0x87, 0xc6, 0x7c, 0x8b, 0x87, 0x8e, 0x11, 0x5d, 0x8f, 0x97,
+	0x68, 0x70, 0x40, 0xd4, 0x4a, 0x16, 0x45, 0x8b, 0xd9, 0x5e, 0x2a, 0x65, 0xc0, 0x41, 0xe7, 0x3e,
+	0x1b, 0xca, 0xbd, 0xbf, 0xf1, 0x32, 0x8c, 0xd8, 0xb3, 0xb9, 0xa6, 0xb3, 0x9f, 0xec, 0x3c, 0xbf,
+	0xd5, 0xec, 0xee, 0xf1, 0x3e, 0x8f, 0xe6, 0x60, 0xd0, 0x8e, 0x23, 0xcb, 0xd5, 0xbb, 0xb2, 0x6d,
+	0xf7, 0x2c, 0x26, 0xb1, 0xdd, 0x5c, 0x3d, 0xf3, 0x37, 0x0e, 0xe2, 0x9e, 0x79, 0x43, 0xbb, 0xaf,
+	0x5f, 0x82, 0x61, 0x5b, 0x50, 0x37, 0xb2, 0x76, 0xae, 0xc7, 0xe3, 0x02, 0xcc, 0x51, 0x23, 0x6b,
+	0x18, 0xbb, 0xa2, 0xc6, 0x5f, 0x07, 0xe1, 0xe0, 0x0c, 0x69, 0x16, 0x77, 0x12, 0xae, 0xa8, 0x84,
+	0x9a, 0x75, 0xf4, 0x47, 0x0e, 0x42, 0x53, 0x98, 0xa2, 0x13, 0x3e, 0x17, 0x78, 0xa8, 0xed, 0x1b,
+	0xfe, 0x6f, 0xcb, 0x62, 0x51, 0x58, 0xb9, 0xfb, 0xcf, 0x7f, 0xff, 0x34, 0x88, 0x51, 0x29, 0x7d,
+	0x9d, 0xa4, 0x3d, 0xd3, 0x17, 0x92, 0xbe, 0xd5, 0x5e, 0x77, 0xa6, 0x3a, 0x66, 0x3c, 0x1d, 0xeb,
+	0xdb, 0x69, 0x9b, 0xb4, 0xfb, 0x5c, 0xf3, 0xf3, 0x36, 0x7a, 0x3f, 0x08, 0xa1, 0x25, 0x3f, 0xa1,
+	0x97, 0x7a, 0x13, 0xfa, 0x2f, 0x1c, 0x93, 0xfa, 0xcf, 0x5c, 0x62, 0x5b, 0xb1, 0x53, 0x4f, 0x28,
+	0x76, 0xaa, 0x5d, 0xec, 0x33, 0xdc, 0xf8, 0xa5, 0x79, 0x61, 0x7a, 0xb7, 0x6e, 0x3a, 0xc3, 0x8d,
+	0xa3, 0xdf, 0x71, 0x30, 0xd8, 0x1c, 0xc1, 0xa0, 0xf1, 0x9d, 0x4f, 0x67, 0xb6, 0x43, 0xe5, 0x07,
+	0x0c, 0x94, 0xe9, 0xc4, 0x64, 0xb7, 0xa4, 0x8f, 0x13, 0xad, 0x39, 0xea, 0x9a, 0x68, 0x09, 0x79,
+	0x2f, 0xc8, 0xbd, 0xc8, 0xa1, 0x9f, 0x73, 0xd0, 0x9f, 0xc7, 0x55, 0x4c, 0x31, 0xda, 0x51, 0xcb,
+	0x91, 0x38, 0xdc, 0x55, 0x85, 0x89, 0xd6, 0x1f, 0xca, 0xc2, 0x3c, 0x93, 0x6e, 0x6a, 0x5c, 0xec,
+	0x5d, 0xba, 0xa6, 0x89, 0x3c, 0xae, 0xf4, 0x19, 0x07, 0x51, 0xd6, 0x37, 0xd9, 0x6d, 0x49, 0x77,
+	0xd6, 0xdb, 0xa6, 0xa9, 0xda, 0x0e, 0xc4, 0x5b, 0x4c, 0xcc, 0x35, 0xc1, 0xd8, 0x87, 0x78, 0x48,
+	0xb3, 0xf6, 0x6a, 0xc2, 0x6e, 0xb9, 0x2c, 0xb7, 0xf8, 0x8c, 0x83, 0xd1, 0x8e, 0x2e, 0x15, 0x3d,
+	0xef, 0x13, 0xe0, 0x3e, 0x6d, 0xac, 0x7f, 0x8d, 0xe2, 0x12, 0x09, 0x75, 0xa6, 0x16, 0x41, 0xef,
+	0xee, 0x87, 0x5a, 0xd6, 0x63, 0x34, 0xe1, 0xb6, 0xde, 0x99, 0x3f, 0x05, 0x21, 0x38, 0x43, 0x50,
+	0x95, 0x8d, 0xd3, 0x3b, 0xdb, 0xb5, 0x2d, 0xbc, 0xa5, 0x3b, 0xcb, 0x76, 0x1c, 0x14, 0x9e, 0x63,
+	0x0a, 0x3d, 0x8b, 0x0e, 0x59, 0x0a, 0xb9, 0xd5, 0xb3, 0xec, 0x76, 0x71, 0x68, 0x11, 0x06, 0x25,
+	0x9d, 0x2a, 0x14, 0xcf, 0x8a, 0xb3, 0xa8, 0xeb, 0xe9, 0x69, 0x6e, 0xb9, 0xe0, 0x1d, 0xdf, 0x86,
+	0xa2, 0xf9, 0xba, 0x3f, 0xbb, 0x45, 0x19, 0x8f, 0x52, 0x9d, 0xa7, 0xb7, 0xaf, 0xf7, 0x13, 0x27,
+	0x1e, 0xdf, 0x49, 0x90, 0xdc, 0xaf, 0xb9, 0xfb, 0x1b, 0x3c, 0xf7, 0x60, 0x83, 0xe7, 0x3e, 0xdf,
+	0xe0, 0x03, 0x5f, 0x6e, 0xf0, 0x81, 0x87, 0x1b, 0x7c, 0xe0, 0xab, 0x0d, 0x3e, 0xf0, 0xf5, 0x06,
+	0xcf, 0xdd, 0x69, 0xf0, 0xdc, 0xbd, 0x06, 0x1f, 0xf8, 0xa8, 0xc1, 0x73, 0x1f, 0x37, 0xf8, 0xc0,
+	0x27, 0x0d, 0x3e, 0xf0, 0x69, 0x83, 0x0f, 0xdc, 0x6f, 0xf0, 0xdc, 0x83, 0x06, 0xcf, 0x7d, 0xde,
+	0xe0, 0x03, 0x5f, 0x36, 0x78, 0xee, 0x61, 0x83, 0x0f, 0x7c, 0xd5, 0xe0, 0xb9, 0xaf, 0x1b, 0x7c,
+	0xe0, 0xce, 0x26, 0x1f, 0xb8, 0xb7, 0xc9, 0x73, 0x1f, 0x6e, 0xf2, 0x81, 0x5f, 0x6e, 0xf2, 0xdc,
+	0xaf, 0x36, 0xf9, 0xc0, 0x47, 0x9b, 0x7c, 0xe0, 0xe3, 0x4d, 0x9e, 0xfb, 0x64, 0x93, 0xe7, 0x3e,
+	0xdd, 0xe4, 0xb9, 0x4b, 0xa7, 0x76, 0xda, 0x10, 0x50, 0xcd, 0xb8, 0x7a, 0xb5, 0x9f, 0x99, 0xed,
+	0xa5, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x63, 0x87, 0x4f, 0xcb, 0x09, 0x23, 0x00, 0x00,
+}
+
+func (x JoinRejectReason) String() string {
+	s, ok := JoinRejectReason_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
 }
-
 func (this *SessionKeyRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1428,14 +1925,14 @@ func (this *ProvisionEndDevicesRequest_IdentifiersFromData) Equal(that interface
 	}
 	return true
 }
-func (this *JoinEUIPrefix) Equal(that interface{}) bool {
+func (this *JoinAttempt) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*JoinEUIPrefix)
+	that1, ok := that.(*JoinAttempt)
 	if !ok {
-		that2, ok := that.(JoinEUIPrefix)
+		that2, ok := that.(JoinAttempt)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1447,22 +1944,150 @@ func (this *JoinEUIPrefix) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.JoinEUI.Equal(that1.JoinEUI) {
+	if !this.ReceivedAt.Equal(that1.ReceivedAt) {
 		return false
 	}
-	if this.Length != that1.Length {
+	if this.MType != that1.MType {
 		return false
 	}
-	return true
-}
-func (this *JoinEUIPrefixes) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if !this.DevNonce.Equal(that1.DevNonce) {
+		return false
 	}
-
-	that1, ok := that.(*JoinEUIPrefixes)
-	if !ok {
-		that2, ok := that.(JoinEUIPrefixes)
+	if !this.NetID.Equal(that1.NetID) {
+		return false
+	}
+	if this.Accepted != that1.Accepted {
+		return false
+	}
+	if this.RejectReason != that1.RejectReason {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !bytes.Equal(this.SessionKeyID, that1.SessionKeyID) {
+		return false
+	}
+	return true
+}
+func (this *JoinAttempts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JoinAttempts)
+	if !ok {
+		that2, ok := that.(JoinAttempts)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Attempts) != len(that1.Attempts) {
+		return false
+	}
+	for i := range this.Attempts {
+		if !this.Attempts[i].Equal(&that1.Attempts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GetJoinAttemptsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetJoinAttemptsRequest)
+	if !ok {
+		that2, ok := that.(GetJoinAttemptsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *ResetEndDeviceNoncesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResetEndDeviceNoncesRequest)
+	if !ok {
+		that2, ok := that.(ResetEndDeviceNoncesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if this.ResetUsedDevNonces != that1.ResetUsedDevNonces {
+		return false
+	}
+	return true
+}
+func (this *JoinEUIPrefix) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JoinEUIPrefix)
+	if !ok {
+		that2, ok := that.(JoinEUIPrefix)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.JoinEUI.Equal(that1.JoinEUI) {
+		return false
+	}
+	if this.Length != that1.Length {
+		return false
+	}
+	return true
+}
+func (this *JoinEUIPrefixes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JoinEUIPrefixes)
+	if !ok {
+		that2, ok := that.(JoinEUIPrefixes)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1484,6 +2109,104 @@ func (this *JoinEUIPrefixes) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ExternalActivation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExternalActivation)
+	if !ok {
+		that2, ok := that.(ExternalActivation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.JoinEUI.Equal(that1.JoinEUI) {
+		return false
+	}
+	if !this.DevEUI.Equal(that1.DevEUI) {
+		return false
+	}
+	if !this.NetID.Equal(that1.NetID) {
+		return false
+	}
+	if this.JoinServerAddress != that1.JoinServerAddress {
+		return false
+	}
+	if !bytes.Equal(this.SessionKeyID, that1.SessionKeyID) {
+		return false
+	}
+	if !this.ActivatedAt.Equal(that1.ActivatedAt) {
+		return false
+	}
+	return true
+}
+func (this *ExternalActivations) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExternalActivations)
+	if !ok {
+		that2, ok := that.(ExternalActivations)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Activations) != len(that1.Activations) {
+		return false
+	}
+	for i := range this.Activations {
+		if !this.Activations[i].Equal(&that1.Activations[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ListExternalActivationsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListExternalActivationsRequest)
+	if !ok {
+		that2, ok := that.(ListExternalActivationsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.JoinEUI.Equal(that1.JoinEUI) {
+		return false
+	}
+	if !this.DevEUI.Equal(that1.DevEUI) {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1678,6 +2401,7 @@ var _AsJs_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type NetworkCryptoServiceClient interface {
 	JoinRequestMIC(ctx context.Context, in *CryptoServicePayloadRequest, opts ...grpc.CallOption) (*CryptoServicePayloadResponse, error)
+	RejoinRequestMIC(ctx context.Context, in *CryptoServicePayloadRequest, opts ...grpc.CallOption) (*CryptoServicePayloadResponse, error)
 	JoinAcceptMIC(ctx context.Context, in *JoinAcceptMICRequest, opts ...grpc.CallOption) (*CryptoServicePayloadResponse, error)
 	EncryptJoinAccept(ctx context.Context, in *CryptoServicePayloadRequest, opts ...grpc.CallOption) (*CryptoServicePayloadResponse, error)
 	EncryptRejoinAccept(ctx context.Context, in *CryptoServicePayloadRequest, opts ...grpc.CallOption) (*CryptoServicePayloadResponse, error)
@@ -1703,6 +2427,15 @@ func (c *networkCryptoServiceClient) JoinRequestMIC(ctx context.Context, in *Cry
 	return out, nil
 }
 
+func (c *networkCryptoServiceClient) RejoinRequestMIC(ctx context.Context, in *CryptoServicePayloadRequest, opts ...grpc.CallOption) (*CryptoServicePayloadResponse, error) {
+	out := new(CryptoServicePayloadResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NetworkCryptoService/RejoinRequestMIC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *networkCryptoServiceClient) JoinAcceptMIC(ctx context.Context, in *JoinAcceptMICRequest, opts ...grpc.CallOption) (*CryptoServicePayloadResponse, error) {
 	out := new(CryptoServicePayloadResponse)
 	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NetworkCryptoService/JoinAcceptMIC", in, out, opts...)
@@ -1751,6 +2484,7 @@ func (c *networkCryptoServiceClient) GetNwkKey(ctx context.Context, in *GetRootK
 // NetworkCryptoServiceServer is the server API for NetworkCryptoService service.
 type NetworkCryptoServiceServer interface {
 	JoinRequestMIC(context.Context, *CryptoServicePayloadRequest) (*CryptoServicePayloadResponse, error)
+	RejoinRequestMIC(context.Context, *CryptoServicePayloadRequest) (*CryptoServicePayloadResponse, error)
 	JoinAcceptMIC(context.Context, *JoinAcceptMICRequest) (*CryptoServicePayloadResponse, error)
 	EncryptJoinAccept(context.Context, *CryptoServicePayloadRequest) (*CryptoServicePayloadResponse, error)
 	EncryptRejoinAccept(context.Context, *CryptoServicePayloadRequest) (*CryptoServicePayloadResponse, error)
@@ -1766,6 +2500,9 @@ type UnimplementedNetworkCryptoServiceServer struct {
 func (*UnimplementedNetworkCryptoServiceServer) JoinRequestMIC(ctx context.Context, req *CryptoServicePayloadRequest) (*CryptoServicePayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinRequestMIC not implemented")
 }
+func (*UnimplementedNetworkCryptoServiceServer) RejoinRequestMIC(ctx context.Context, req *CryptoServicePayloadRequest) (*CryptoServicePayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejoinRequestMIC not implemented")
+}
 func (*UnimplementedNetworkCryptoServiceServer) JoinAcceptMIC(ctx context.Context, req *JoinAcceptMICRequest) (*CryptoServicePayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method JoinAcceptMIC not implemented")
 }
@@ -1804,6 +2541,24 @@ func _NetworkCryptoService_JoinRequestMIC_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NetworkCryptoService_RejoinRequestMIC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CryptoServicePayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NetworkCryptoServiceServer).RejoinRequestMIC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NetworkCryptoService/RejoinRequestMIC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NetworkCryptoServiceServer).RejoinRequestMIC(ctx, req.(*CryptoServicePayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NetworkCryptoService_JoinAcceptMIC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(JoinAcceptMICRequest)
 	if err := dec(in); err != nil {
@@ -1902,6 +2657,10 @@ var _NetworkCryptoService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "JoinRequestMIC",
 			Handler:    _NetworkCryptoService_JoinRequestMIC_Handler,
 		},
+		{
+			MethodName: "RejoinRequestMIC",
+			Handler:    _NetworkCryptoService_RejoinRequestMIC_Handler,
+		},
 		{
 			MethodName: "JoinAcceptMIC",
 			Handler:    _NetworkCryptoService_JoinAcceptMIC_Handler,
@@ -2052,6 +2811,12 @@ type JsEndDeviceRegistryClient interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// ResetNonces resets the last DevNonce and RJcount1 of the end device, so that the Join Server accepts
+	// join-requests of end devices that reset their DevNonce counter.
+	// The JoinNonce of the Join Server is not reset.
+	ResetNonces(ctx context.Context, in *ResetEndDeviceNoncesRequest, opts ...grpc.CallOption) (*EndDevice, error)
+	// GetJoinAttempts returns the most recent join attempts of the end device.
+	GetJoinAttempts(ctx context.Context, in *GetJoinAttemptsRequest, opts ...grpc.CallOption) (*JoinAttempts, error)
 }
 
 type jsEndDeviceRegistryClient struct {
@@ -2122,6 +2887,24 @@ func (c *jsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIde
 	return out, nil
 }
 
+func (c *jsEndDeviceRegistryClient) ResetNonces(ctx context.Context, in *ResetEndDeviceNoncesRequest, opts ...grpc.CallOption) (*EndDevice, error) {
+	out := new(EndDevice)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsEndDeviceRegistry/ResetNonces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsEndDeviceRegistryClient) GetJoinAttempts(ctx context.Context, in *GetJoinAttemptsRequest, opts ...grpc.CallOption) (*JoinAttempts, error) {
+	out := new(JoinAttempts)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsEndDeviceRegistry/GetJoinAttempts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JsEndDeviceRegistryServer is the server API for JsEndDeviceRegistry service.
 type JsEndDeviceRegistryServer interface {
 	// Get returns the device that matches the given identifiers.
@@ -2135,6 +2918,12 @@ type JsEndDeviceRegistryServer interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// ResetNonces resets the last DevNonce and RJcount1 of the end device, so that the Join Server accepts
+	// join-requests of end devices that reset their DevNonce counter.
+	// The JoinNonce of the Join Server is not reset.
+	ResetNonces(context.Context, *ResetEndDeviceNoncesRequest) (*EndDevice, error)
+	// GetJoinAttempts returns the most recent join attempts of the end device.
+	GetJoinAttempts(context.Context, *GetJoinAttemptsRequest) (*JoinAttempts, error)
 }
 
 // UnimplementedJsEndDeviceRegistryServer can be embedded to have forward compatible implementations.
@@ -2153,6 +2942,12 @@ func (*UnimplementedJsEndDeviceRegistryServer) Provision(req *ProvisionEndDevice
 func (*UnimplementedJsEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedJsEndDeviceRegistryServer) ResetNonces(ctx context.Context, req *ResetEndDeviceNoncesRequest) (*EndDevice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetNonces not implemented")
+}
+func (*UnimplementedJsEndDeviceRegistryServer) GetJoinAttempts(ctx context.Context, req *GetJoinAttemptsRequest) (*JoinAttempts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJoinAttempts not implemented")
+}
 
 func RegisterJsEndDeviceRegistryServer(s *grpc.Server, srv JsEndDeviceRegistryServer) {
 	s.RegisterService(&_JsEndDeviceRegistry_serviceDesc, srv)
@@ -2233,22 +3028,66 @@ func _JsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-var _JsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.JsEndDeviceRegistry",
-	HandlerType: (*JsEndDeviceRegistryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Get",
-			Handler:    _JsEndDeviceRegistry_Get_Handler,
-		},
-		{
-			MethodName: "Set",
-			Handler:    _JsEndDeviceRegistry_Set_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _JsEndDeviceRegistry_Delete_Handler,
-		},
+func _JsEndDeviceRegistry_ResetNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetEndDeviceNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsEndDeviceRegistryServer).ResetNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsEndDeviceRegistry/ResetNonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsEndDeviceRegistryServer).ResetNonces(ctx, req.(*ResetEndDeviceNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JsEndDeviceRegistry_GetJoinAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJoinAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsEndDeviceRegistryServer).GetJoinAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsEndDeviceRegistry/GetJoinAttempts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsEndDeviceRegistryServer).GetJoinAttempts(ctx, req.(*GetJoinAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _JsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.JsEndDeviceRegistry",
+	HandlerType: (*JsEndDeviceRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _JsEndDeviceRegistry_Get_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _JsEndDeviceRegistry_Set_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _JsEndDeviceRegistry_Delete_Handler,
+		},
+		{
+			MethodName: "ResetNonces",
+			Handler:    _JsEndDeviceRegistry_ResetNonces_Handler,
+		},
+		{
+			MethodName: "GetJoinAttempts",
+			Handler:    _JsEndDeviceRegistry_GetJoinAttempts_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2265,6 +3104,14 @@ var _JsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type JsClient interface {
 	GetJoinEUIPrefixes(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*JoinEUIPrefixes, error)
+	// RotateKEK re-wraps the keys that are stored by the Join Server with the new KEK.
+	// Keys are processed in batches; call this RPC with the returned cursor until the returned cursor is empty.
+	// This RPC requires cluster authentication.
+	RotateKEK(ctx context.Context, in *RotateKEKRequest, opts ...grpc.CallOption) (*RotateKEKResponse, error)
+	// ListExternalActivations returns the activations of the end device by external Join Servers,
+	// to which the Join Server forwarded the join-requests of the end device.
+	// This RPC requires cluster authentication.
+	ListExternalActivations(ctx context.Context, in *ListExternalActivationsRequest, opts ...grpc.CallOption) (*ExternalActivations, error)
 }
 
 type jsClient struct {
@@ -2284,9 +3131,35 @@ func (c *jsClient) GetJoinEUIPrefixes(ctx context.Context, in *types.Empty, opts
 	return out, nil
 }
 
+func (c *jsClient) RotateKEK(ctx context.Context, in *RotateKEKRequest, opts ...grpc.CallOption) (*RotateKEKResponse, error) {
+	out := new(RotateKEKResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Js/RotateKEK", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jsClient) ListExternalActivations(ctx context.Context, in *ListExternalActivationsRequest, opts ...grpc.CallOption) (*ExternalActivations, error) {
+	out := new(ExternalActivations)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Js/ListExternalActivations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JsServer is the server API for Js service.
 type JsServer interface {
 	GetJoinEUIPrefixes(context.Context, *types.Empty) (*JoinEUIPrefixes, error)
+	// RotateKEK re-wraps the keys that are stored by the Join Server with the new KEK.
+	// Keys are processed in batches; call this RPC with the returned cursor until the returned cursor is empty.
+	// This RPC requires cluster authentication.
+	RotateKEK(context.Context, *RotateKEKRequest) (*RotateKEKResponse, error)
+	// ListExternalActivations returns the activations of the end device by external Join Servers,
+	// to which the Join Server forwarded the join-requests of the end device.
+	// This RPC requires cluster authentication.
+	ListExternalActivations(context.Context, *ListExternalActivationsRequest) (*ExternalActivations, error)
 }
 
 // UnimplementedJsServer can be embedded to have forward compatible implementations.
@@ -2296,6 +3169,12 @@ type UnimplementedJsServer struct {
 func (*UnimplementedJsServer) GetJoinEUIPrefixes(ctx context.Context, req *types.Empty) (*JoinEUIPrefixes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJoinEUIPrefixes not implemented")
 }
+func (*UnimplementedJsServer) RotateKEK(ctx context.Context, req *RotateKEKRequest) (*RotateKEKResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateKEK not implemented")
+}
+func (*UnimplementedJsServer) ListExternalActivations(ctx context.Context, req *ListExternalActivationsRequest) (*ExternalActivations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExternalActivations not implemented")
+}
 
 func RegisterJsServer(s *grpc.Server, srv JsServer) {
 	s.RegisterService(&_Js_serviceDesc, srv)
@@ -2319,6 +3198,42 @@ func _Js_GetJoinEUIPrefixes_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Js_RotateKEK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateKEKRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsServer).RotateKEK(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Js/RotateKEK",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsServer).RotateKEK(ctx, req.(*RotateKEKRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Js_ListExternalActivations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExternalActivationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsServer).ListExternalActivations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Js/ListExternalActivations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsServer).ListExternalActivations(ctx, req.(*ListExternalActivationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Js_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.Js",
 	HandlerType: (*JsServer)(nil),
@@ -2327,6 +3242,14 @@ var _Js_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetJoinEUIPrefixes",
 			Handler:    _Js_GetJoinEUIPrefixes_Handler,
 		},
+		{
+			MethodName: "RotateKEK",
+			Handler:    _Js_RotateKEK_Handler,
+		},
+		{
+			MethodName: "ListExternalActivations",
+			Handler:    _Js_ListExternalActivations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/joinserver.proto",
@@ -2997,7 +3920,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) MarshalToSizedBuffer(dA
 	return len(dAtA) - i, nil
 }
 
-func (m *JoinEUIPrefix) Marshal() (dAtA []byte, err error) {
+func (m *JoinAttempt) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3007,35 +3930,87 @@ func (m *JoinEUIPrefix) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JoinEUIPrefix) MarshalTo(dAtA []byte) (int, error) {
+func (m *JoinAttempt) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JoinEUIPrefix) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JoinAttempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Length != 0 {
-		i = encodeVarintJoinserver(dAtA, i, uint64(m.Length))
+	if len(m.SessionKeyID) > 0 {
+		i -= len(m.SessionKeyID)
+		copy(dAtA[i:], m.SessionKeyID)
+		i = encodeVarintJoinserver(dAtA, i, uint64(len(m.SessionKeyID)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x42
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintJoinserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RejectReason != 0 {
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.RejectReason))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
 	{
-		size := m.JoinEUI.Size()
+		size := m.NetID.Size()
 		i -= size
-		if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJoinserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DevNonce.Size()
+		i -= size
+		if _, err := m.DevNonce.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintJoinserver(dAtA, i, uint64(size))
 	}
 	i--
+	dAtA[i] = 0x1a
+	if m.MType != 0 {
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.MType))
+		i--
+		dAtA[i] = 0x10
+	}
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintJoinserver(dAtA, i, uint64(n17))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *JoinEUIPrefixes) Marshal() (dAtA []byte, err error) {
+func (m *JoinAttempts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3045,20 +4020,20 @@ func (m *JoinEUIPrefixes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JoinEUIPrefixes) MarshalTo(dAtA []byte) (int, error) {
+func (m *JoinAttempts) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JoinEUIPrefixes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JoinAttempts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Prefixes) > 0 {
-		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Attempts) > 0 {
+		for iNdEx := len(m.Attempts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Prefixes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Attempts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3072,799 +4047,2272 @@ func (m *JoinEUIPrefixes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintJoinserver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovJoinserver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetJoinAttemptsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func NewPopulatedSessionKeyRequest(r randyJoinserver, easy bool) *SessionKeyRequest {
-	this := &SessionKeyRequest{}
-	v1 := r.Intn(100)
-	this.SessionKeyID = make([]byte, v1)
-	for i := 0; i < v1; i++ {
-		this.SessionKeyID[i] = byte(r.Intn(256))
+
+func (m *GetJoinAttemptsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetJoinAttemptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
 	}
-	v2 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.DevEUI = *v2
-	v3 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.JoinEUI = *v3
-	if !easy && r.Intn(10) != 0 {
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintJoinserver(dAtA, i, uint64(size))
 	}
-	return this
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedNwkSKeysResponse(r randyJoinserver, easy bool) *NwkSKeysResponse {
-	this := &NwkSKeysResponse{}
-	v4 := NewPopulatedKeyEnvelope(r, easy)
-	this.FNwkSIntKey = *v4
-	v5 := NewPopulatedKeyEnvelope(r, easy)
-	this.SNwkSIntKey = *v5
-	v6 := NewPopulatedKeyEnvelope(r, easy)
-	this.NwkSEncKey = *v6
-	if !easy && r.Intn(10) != 0 {
+func (m *ResetEndDeviceNoncesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedAppSKeyResponse(r randyJoinserver, easy bool) *AppSKeyResponse {
-	this := &AppSKeyResponse{}
-	v7 := NewPopulatedKeyEnvelope(r, easy)
-	this.AppSKey = *v7
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *ResetEndDeviceNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedCryptoServicePayloadRequest(r randyJoinserver, easy bool) *CryptoServicePayloadRequest {
-	this := &CryptoServicePayloadRequest{}
-	v8 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v8
-	this.LoRaWANVersion = MACVersion([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
-	v9 := r.Intn(100)
-	this.Payload = make([]byte, v9)
-	for i := 0; i < v9; i++ {
-		this.Payload[i] = byte(r.Intn(256))
-	}
-	this.ProvisionerID = randStringJoinserver(r)
-	if r.Intn(5) != 0 {
-		this.ProvisioningData = types.NewPopulatedStruct(r, easy)
+func (m *ResetEndDeviceNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetUsedDevNonces {
+		i--
+		if m.ResetUsedDevNonces {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if !easy && r.Intn(10) != 0 {
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintJoinserver(dAtA, i, uint64(size))
 	}
-	return this
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedCryptoServicePayloadResponse(r randyJoinserver, easy bool) *CryptoServicePayloadResponse {
-	this := &CryptoServicePayloadResponse{}
-	v10 := r.Intn(100)
-	this.Payload = make([]byte, v10)
-	for i := 0; i < v10; i++ {
-		this.Payload[i] = byte(r.Intn(256))
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *JoinEUIPrefix) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedJoinAcceptMICRequest(r randyJoinserver, easy bool) *JoinAcceptMICRequest {
-	this := &JoinAcceptMICRequest{}
-	v11 := NewPopulatedCryptoServicePayloadRequest(r, easy)
-	this.CryptoServicePayloadRequest = *v11
-	this.JoinRequestType = RejoinType([]int32{0, 1, 2}[r.Intn(3)])
-	v12 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevNonce(r)
-	this.DevNonce = *v12
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *JoinEUIPrefix) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedDeriveSessionKeysRequest(r randyJoinserver, easy bool) *DeriveSessionKeysRequest {
-	this := &DeriveSessionKeysRequest{}
-	v13 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v13
-	this.LoRaWANVersion = MACVersion([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
-	v14 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedJoinNonce(r)
-	this.JoinNonce = *v14
-	v15 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevNonce(r)
-	this.DevNonce = *v15
-	v16 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedNetID(r)
-	this.NetID = *v16
-	this.ProvisionerID = randStringJoinserver(r)
-	if r.Intn(5) != 0 {
-		this.ProvisioningData = types.NewPopulatedStruct(r, easy)
+func (m *JoinEUIPrefix) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x10
 	}
-	if !easy && r.Intn(10) != 0 {
+	{
+		size := m.JoinEUI.Size()
+		i -= size
+		if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJoinserver(dAtA, i, uint64(size))
 	}
-	return this
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedGetRootKeysRequest(r randyJoinserver, easy bool) *GetRootKeysRequest {
-	this := &GetRootKeysRequest{}
-	v17 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v17
-	this.ProvisionerID = randStringJoinserver(r)
-	if r.Intn(5) != 0 {
-		this.ProvisioningData = types.NewPopulatedStruct(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *JoinEUIPrefixes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedProvisionEndDevicesRequest(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest {
-	this := &ProvisionEndDevicesRequest{}
-	v18 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v18
-	this.ProvisionerID = randStringJoinserver(r)
-	v19 := r.Intn(100)
-	this.ProvisioningData = make([]byte, v19)
-	for i := 0; i < v19; i++ {
-		this.ProvisioningData[i] = byte(r.Intn(256))
-	}
-	oneofNumber_EndDevices := []int32{4, 5, 6}[r.Intn(3)]
-	switch oneofNumber_EndDevices {
-	case 4:
-		this.EndDevices = NewPopulatedProvisionEndDevicesRequest_List(r, easy)
-	case 5:
-		this.EndDevices = NewPopulatedProvisionEndDevicesRequest_Range(r, easy)
-	case 6:
-		this.EndDevices = NewPopulatedProvisionEndDevicesRequest_FromData(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *JoinEUIPrefixes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedProvisionEndDevicesRequest_List(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest_List {
-	this := &ProvisionEndDevicesRequest_List{}
-	this.List = NewPopulatedProvisionEndDevicesRequest_IdentifiersList(r, easy)
-	return this
+func (m *JoinEUIPrefixes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefixes) > 0 {
+		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prefixes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJoinserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
-func NewPopulatedProvisionEndDevicesRequest_Range(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest_Range {
-	this := &ProvisionEndDevicesRequest_Range{}
-	this.Range = NewPopulatedProvisionEndDevicesRequest_IdentifiersRange(r, easy)
-	return this
+
+func (m *ExternalActivation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func NewPopulatedProvisionEndDevicesRequest_FromData(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest_FromData {
-	this := &ProvisionEndDevicesRequest_FromData{}
-	this.FromData = NewPopulatedProvisionEndDevicesRequest_IdentifiersFromData(r, easy)
-	return this
+
+func (m *ExternalActivation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func NewPopulatedProvisionEndDevicesRequest_IdentifiersList(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest_IdentifiersList {
-	this := &ProvisionEndDevicesRequest_IdentifiersList{}
-	this.JoinEUI = go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	if r.Intn(5) != 0 {
-		v20 := r.Intn(5)
-		this.EndDeviceIDs = make([]EndDeviceIdentifiers, v20)
-		for i := 0; i < v20; i++ {
-			v21 := NewPopulatedEndDeviceIdentifiers(r, easy)
-			this.EndDeviceIDs[i] = *v21
+
+func (m *ExternalActivation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ActivatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivatedAt):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintJoinserver(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x32
+	if len(m.SessionKeyID) > 0 {
+		i -= len(m.SessionKeyID)
+		copy(dAtA[i:], m.SessionKeyID)
+		i = encodeVarintJoinserver(dAtA, i, uint64(len(m.SessionKeyID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.JoinServerAddress) > 0 {
+		i -= len(m.JoinServerAddress)
+		copy(dAtA[i:], m.JoinServerAddress)
+		i = encodeVarintJoinserver(dAtA, i, uint64(len(m.JoinServerAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.NetID.Size()
+		i -= size
+		if _, err := m.NetID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintJoinserver(dAtA, i, uint64(size))
 	}
-	if !easy && r.Intn(10) != 0 {
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DevEUI.Size()
+		i -= size
+		if _, err := m.DevEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJoinserver(dAtA, i, uint64(size))
 	}
-	return this
-}
-
-func NewPopulatedProvisionEndDevicesRequest_IdentifiersRange(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest_IdentifiersRange {
-	this := &ProvisionEndDevicesRequest_IdentifiersRange{}
-	this.JoinEUI = go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	v22 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.StartDevEUI = *v22
-	if !easy && r.Intn(10) != 0 {
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.JoinEUI.Size()
+		i -= size
+		if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintJoinserver(dAtA, i, uint64(size))
 	}
-	return this
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedProvisionEndDevicesRequest_IdentifiersFromData(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest_IdentifiersFromData {
-	this := &ProvisionEndDevicesRequest_IdentifiersFromData{}
-	this.JoinEUI = go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	if !easy && r.Intn(10) != 0 {
+func (m *ExternalActivations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedJoinEUIPrefix(r randyJoinserver, easy bool) *JoinEUIPrefix {
-	this := &JoinEUIPrefix{}
-	v23 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.JoinEUI = *v23
-	this.Length = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *ExternalActivations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedJoinEUIPrefixes(r randyJoinserver, easy bool) *JoinEUIPrefixes {
-	this := &JoinEUIPrefixes{}
-	if r.Intn(5) != 0 {
-		v24 := r.Intn(5)
-		this.Prefixes = make([]JoinEUIPrefix, v24)
-		for i := 0; i < v24; i++ {
-			v25 := NewPopulatedJoinEUIPrefix(r, easy)
-			this.Prefixes[i] = *v25
+func (m *ExternalActivations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Activations) > 0 {
+		for iNdEx := len(m.Activations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Activations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJoinserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if !easy && r.Intn(10) != 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *ListExternalActivationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-type randyJoinserver interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func (m *ListExternalActivationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func randUTF8RuneJoinserver(r randyJoinserver) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
-	}
-	return rune(ru + 61)
-}
-func randStringJoinserver(r randyJoinserver) string {
-	v26 := r.Intn(100)
-	tmps := make([]rune, v26)
-	for i := 0; i < v26; i++ {
-		tmps[i] = randUTF8RuneJoinserver(r)
+func (m *ListExternalActivationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintJoinserver(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
 	}
-	return string(tmps)
-}
-func randUnrecognizedJoinserver(r randyJoinserver, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+	{
+		size := m.DevEUI.Size()
+		i -= size
+		if _, err := m.DevEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldJoinserver(dAtA, r, fieldNumber, wire)
+		i = encodeVarintJoinserver(dAtA, i, uint64(size))
 	}
-	return dAtA
-}
-func randFieldJoinserver(dAtA []byte, r randyJoinserver, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(key))
-		v27 := r.Int63()
-		if r.Intn(2) == 0 {
-			v27 *= -1
-		}
-		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(v27))
-	case 1:
-		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.JoinEUI.Size()
+		i -= size
+		if _, err := m.JoinEUI.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-	default:
-		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+		i = encodeVarintJoinserver(dAtA, i, uint64(size))
 	}
-	return dAtA
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func encodeVarintPopulateJoinserver(dAtA []byte, v uint64) []byte {
+
+func encodeVarintJoinserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovJoinserver(v)
+	base := offset
 	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
+		offset++
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+	dAtA[offset] = uint8(v)
+	return base
 }
-func (m *SessionKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedSessionKeyRequest(r randyJoinserver, easy bool) *SessionKeyRequest {
+	this := &SessionKeyRequest{}
+	v1 := r.Intn(100)
+	this.SessionKeyID = make([]byte, v1)
+	for i := 0; i < v1; i++ {
+		this.SessionKeyID[i] = byte(r.Intn(256))
 	}
-	var l int
-	_ = l
-	l = len(m.SessionKeyID)
-	if l > 0 {
-		n += 1 + l + sovJoinserver(uint64(l))
+	v2 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v2
+	v3 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEUI = *v3
+	if !easy && r.Intn(10) != 0 {
 	}
-	l = m.DevEUI.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	l = m.JoinEUI.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	return n
+	return this
 }
 
-func (m *NwkSKeysResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedNwkSKeysResponse(r randyJoinserver, easy bool) *NwkSKeysResponse {
+	this := &NwkSKeysResponse{}
+	v4 := NewPopulatedKeyEnvelope(r, easy)
+	this.FNwkSIntKey = *v4
+	v5 := NewPopulatedKeyEnvelope(r, easy)
+	this.SNwkSIntKey = *v5
+	v6 := NewPopulatedKeyEnvelope(r, easy)
+	this.NwkSEncKey = *v6
+	if !easy && r.Intn(10) != 0 {
 	}
-	var l int
-	_ = l
-	l = m.FNwkSIntKey.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	l = m.SNwkSIntKey.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	l = m.NwkSEncKey.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	return n
+	return this
 }
 
-func (m *AppSKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedAppSKeyResponse(r randyJoinserver, easy bool) *AppSKeyResponse {
+	this := &AppSKeyResponse{}
+	v7 := NewPopulatedKeyEnvelope(r, easy)
+	this.AppSKey = *v7
+	if !easy && r.Intn(10) != 0 {
 	}
-	var l int
-	_ = l
-	l = m.AppSKey.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	return n
+	return this
 }
 
-func (m *CryptoServicePayloadRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedCryptoServicePayloadRequest(r randyJoinserver, easy bool) *CryptoServicePayloadRequest {
+	this := &CryptoServicePayloadRequest{}
+	v8 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v8
+	this.LoRaWANVersion = MACVersion([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
+	v9 := r.Intn(100)
+	this.Payload = make([]byte, v9)
+	for i := 0; i < v9; i++ {
+		this.Payload[i] = byte(r.Intn(256))
 	}
-	var l int
-	_ = l
-	l = m.EndDeviceIdentifiers.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	if m.LoRaWANVersion != 0 {
-		n += 1 + sovJoinserver(uint64(m.LoRaWANVersion))
+	this.ProvisionerID = randStringJoinserver(r)
+	if r.Intn(5) != 0 {
+		this.ProvisioningData = types.NewPopulatedStruct(r, easy)
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovJoinserver(uint64(l))
+	if !easy && r.Intn(10) != 0 {
 	}
-	l = len(m.ProvisionerID)
-	if l > 0 {
-		n += 1 + l + sovJoinserver(uint64(l))
+	return this
+}
+
+func NewPopulatedCryptoServicePayloadResponse(r randyJoinserver, easy bool) *CryptoServicePayloadResponse {
+	this := &CryptoServicePayloadResponse{}
+	v10 := r.Intn(100)
+	this.Payload = make([]byte, v10)
+	for i := 0; i < v10; i++ {
+		this.Payload[i] = byte(r.Intn(256))
 	}
-	if m.ProvisioningData != nil {
-		l = m.ProvisioningData.Size()
-		n += 1 + l + sovJoinserver(uint64(l))
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func (m *CryptoServicePayloadResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovJoinserver(uint64(l))
+func NewPopulatedJoinAcceptMICRequest(r randyJoinserver, easy bool) *JoinAcceptMICRequest {
+	this := &JoinAcceptMICRequest{}
+	v11 := NewPopulatedCryptoServicePayloadRequest(r, easy)
+	this.CryptoServicePayloadRequest = *v11
+	this.JoinRequestType = RejoinType([]int32{0, 1, 2}[r.Intn(3)])
+	v12 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevNonce(r)
+	this.DevNonce = *v12
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func (m *JoinAcceptMICRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedDeriveSessionKeysRequest(r randyJoinserver, easy bool) *DeriveSessionKeysRequest {
+	this := &DeriveSessionKeysRequest{}
+	v13 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v13
+	this.LoRaWANVersion = MACVersion([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
+	v14 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedJoinNonce(r)
+	this.JoinNonce = *v14
+	v15 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevNonce(r)
+	this.DevNonce = *v15
+	v16 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedNetID(r)
+	this.NetID = *v16
+	this.ProvisionerID = randStringJoinserver(r)
+	if r.Intn(5) != 0 {
+		this.ProvisioningData = types.NewPopulatedStruct(r, easy)
 	}
-	var l int
-	_ = l
-	l = m.CryptoServicePayloadRequest.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	if m.JoinRequestType != 0 {
-		n += 1 + sovJoinserver(uint64(m.JoinRequestType))
+	if !easy && r.Intn(10) != 0 {
 	}
-	l = m.DevNonce.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	return n
+	return this
 }
 
-func (m *DeriveSessionKeysRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedGetRootKeysRequest(r randyJoinserver, easy bool) *GetRootKeysRequest {
+	this := &GetRootKeysRequest{}
+	v17 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v17
+	this.ProvisionerID = randStringJoinserver(r)
+	if r.Intn(5) != 0 {
+		this.ProvisioningData = types.NewPopulatedStruct(r, easy)
 	}
-	var l int
-	_ = l
-	l = m.EndDeviceIdentifiers.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	if m.LoRaWANVersion != 0 {
-		n += 1 + sovJoinserver(uint64(m.LoRaWANVersion))
+	if !easy && r.Intn(10) != 0 {
 	}
-	l = m.JoinNonce.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	l = m.DevNonce.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	l = m.NetID.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	l = len(m.ProvisionerID)
-	if l > 0 {
-		n += 1 + l + sovJoinserver(uint64(l))
-	}
-	if m.ProvisioningData != nil {
-		l = m.ProvisioningData.Size()
-		n += 1 + l + sovJoinserver(uint64(l))
-	}
-	return n
+	return this
 }
 
-func (m *GetRootKeysRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedProvisionEndDevicesRequest(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest {
+	this := &ProvisionEndDevicesRequest{}
+	v18 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v18
+	this.ProvisionerID = randStringJoinserver(r)
+	v19 := r.Intn(100)
+	this.ProvisioningData = make([]byte, v19)
+	for i := 0; i < v19; i++ {
+		this.ProvisioningData[i] = byte(r.Intn(256))
 	}
-	var l int
-	_ = l
-	l = m.EndDeviceIdentifiers.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	l = len(m.ProvisionerID)
-	if l > 0 {
-		n += 1 + l + sovJoinserver(uint64(l))
+	oneofNumber_EndDevices := []int32{4, 5, 6}[r.Intn(3)]
+	switch oneofNumber_EndDevices {
+	case 4:
+		this.EndDevices = NewPopulatedProvisionEndDevicesRequest_List(r, easy)
+	case 5:
+		this.EndDevices = NewPopulatedProvisionEndDevicesRequest_Range(r, easy)
+	case 6:
+		this.EndDevices = NewPopulatedProvisionEndDevicesRequest_FromData(r, easy)
 	}
-	if m.ProvisioningData != nil {
-		l = m.ProvisioningData.Size()
-		n += 1 + l + sovJoinserver(uint64(l))
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func (m *ProvisionEndDevicesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ApplicationIdentifiers.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	l = len(m.ProvisionerID)
-	if l > 0 {
-		n += 1 + l + sovJoinserver(uint64(l))
-	}
-	l = len(m.ProvisioningData)
-	if l > 0 {
-		n += 1 + l + sovJoinserver(uint64(l))
+func NewPopulatedProvisionEndDevicesRequest_List(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest_List {
+	this := &ProvisionEndDevicesRequest_List{}
+	this.List = NewPopulatedProvisionEndDevicesRequest_IdentifiersList(r, easy)
+	return this
+}
+func NewPopulatedProvisionEndDevicesRequest_Range(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest_Range {
+	this := &ProvisionEndDevicesRequest_Range{}
+	this.Range = NewPopulatedProvisionEndDevicesRequest_IdentifiersRange(r, easy)
+	return this
+}
+func NewPopulatedProvisionEndDevicesRequest_FromData(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest_FromData {
+	this := &ProvisionEndDevicesRequest_FromData{}
+	this.FromData = NewPopulatedProvisionEndDevicesRequest_IdentifiersFromData(r, easy)
+	return this
+}
+func NewPopulatedProvisionEndDevicesRequest_IdentifiersList(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest_IdentifiersList {
+	this := &ProvisionEndDevicesRequest_IdentifiersList{}
+	this.JoinEUI = go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	if r.Intn(5) != 0 {
+		v20 := r.Intn(5)
+		this.EndDeviceIDs = make([]EndDeviceIdentifiers, v20)
+		for i := 0; i < v20; i++ {
+			v21 := NewPopulatedEndDeviceIdentifiers(r, easy)
+			this.EndDeviceIDs[i] = *v21
+		}
 	}
-	if m.EndDevices != nil {
-		n += m.EndDevices.Size()
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func (m *ProvisionEndDevicesRequest_List) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedProvisionEndDevicesRequest_IdentifiersRange(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest_IdentifiersRange {
+	this := &ProvisionEndDevicesRequest_IdentifiersRange{}
+	this.JoinEUI = go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	v22 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.StartDevEUI = *v22
+	if !easy && r.Intn(10) != 0 {
 	}
-	var l int
-	_ = l
-	if m.List != nil {
-		l = m.List.Size()
-		n += 1 + l + sovJoinserver(uint64(l))
+	return this
+}
+
+func NewPopulatedProvisionEndDevicesRequest_IdentifiersFromData(r randyJoinserver, easy bool) *ProvisionEndDevicesRequest_IdentifiersFromData {
+	this := &ProvisionEndDevicesRequest_IdentifiersFromData{}
+	this.JoinEUI = go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
-func (m *ProvisionEndDevicesRequest_Range) Size() (n int) {
-	if m == nil {
-		return 0
+
+func NewPopulatedJoinAttempt(r randyJoinserver, easy bool) *JoinAttempt {
+	this := &JoinAttempt{}
+	v23 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ReceivedAt = *v23
+	this.MType = MType([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
+	v24 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevNonce(r)
+	this.DevNonce = *v24
+	v25 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedNetID(r)
+	this.NetID = *v25
+	this.Accepted = bool(r.Intn(2) == 0)
+	this.RejectReason = JoinRejectReason([]int32{0, 1, 2, 3}[r.Intn(4)])
+	if r.Intn(5) == 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
 	}
-	var l int
-	_ = l
-	if m.Range != nil {
-		l = m.Range.Size()
-		n += 1 + l + sovJoinserver(uint64(l))
+	v26 := r.Intn(100)
+	this.SessionKeyID = make([]byte, v26)
+	for i := 0; i < v26; i++ {
+		this.SessionKeyID[i] = byte(r.Intn(256))
 	}
-	return n
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func (m *ProvisionEndDevicesRequest_FromData) Size() (n int) {
-	if m == nil {
-		return 0
+
+func NewPopulatedJoinAttempts(r randyJoinserver, easy bool) *JoinAttempts {
+	this := &JoinAttempts{}
+	if r.Intn(5) == 0 {
+		v27 := r.Intn(5)
+		this.Attempts = make([]JoinAttempt, v27)
+		for i := 0; i < v27; i++ {
+			v28 := NewPopulatedJoinAttempt(r, easy)
+			this.Attempts[i] = *v28
+		}
 	}
-	var l int
-	_ = l
-	if m.FromData != nil {
-		l = m.FromData.Size()
-		n += 1 + l + sovJoinserver(uint64(l))
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
-func (m *ProvisionEndDevicesRequest_IdentifiersList) Size() (n int) {
-	if m == nil {
-		return 0
+
+func NewPopulatedGetJoinAttemptsRequest(r randyJoinserver, easy bool) *GetJoinAttemptsRequest {
+	this := &GetJoinAttemptsRequest{}
+	v29 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v29
+	this.Limit = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	var l int
-	_ = l
-	if m.JoinEUI != nil {
-		l = m.JoinEUI.Size()
-		n += 1 + l + sovJoinserver(uint64(l))
+	return this
+}
+
+func NewPopulatedResetEndDeviceNoncesRequest(r randyJoinserver, easy bool) *ResetEndDeviceNoncesRequest {
+	this := &ResetEndDeviceNoncesRequest{}
+	v30 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v30
+	this.ResetUsedDevNonces = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
 	}
-	if len(m.EndDeviceIDs) > 0 {
-		for _, e := range m.EndDeviceIDs {
-			l = e.Size()
-			n += 1 + l + sovJoinserver(uint64(l))
-		}
+	return this
+}
+
+func NewPopulatedJoinEUIPrefix(r randyJoinserver, easy bool) *JoinEUIPrefix {
+	this := &JoinEUIPrefix{}
+	v31 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEUI = *v31
+	this.Length = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func (m *ProvisionEndDevicesRequest_IdentifiersRange) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedJoinEUIPrefixes(r randyJoinserver, easy bool) *JoinEUIPrefixes {
+	this := &JoinEUIPrefixes{}
+	if r.Intn(5) != 0 {
+		v32 := r.Intn(5)
+		this.Prefixes = make([]JoinEUIPrefix, v32)
+		for i := 0; i < v32; i++ {
+			v33 := NewPopulatedJoinEUIPrefix(r, easy)
+			this.Prefixes[i] = *v33
+		}
 	}
-	var l int
-	_ = l
-	if m.JoinEUI != nil {
-		l = m.JoinEUI.Size()
-		n += 1 + l + sovJoinserver(uint64(l))
+	if !easy && r.Intn(10) != 0 {
 	}
-	l = m.StartDevEUI.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	return n
+	return this
 }
 
-func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedExternalActivation(r randyJoinserver, easy bool) *ExternalActivation {
+	this := &ExternalActivation{}
+	v34 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEUI = *v34
+	v35 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v35
+	v36 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedNetID(r)
+	this.NetID = *v36
+	this.JoinServerAddress = randStringJoinserver(r)
+	v37 := r.Intn(100)
+	this.SessionKeyID = make([]byte, v37)
+	for i := 0; i < v37; i++ {
+		this.SessionKeyID[i] = byte(r.Intn(256))
 	}
-	var l int
-	_ = l
-	if m.JoinEUI != nil {
-		l = m.JoinEUI.Size()
-		n += 1 + l + sovJoinserver(uint64(l))
+	v38 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ActivatedAt = *v38
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func (m *JoinEUIPrefix) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedExternalActivations(r randyJoinserver, easy bool) *ExternalActivations {
+	this := &ExternalActivations{}
+	if r.Intn(5) != 0 {
+		v39 := r.Intn(5)
+		this.Activations = make([]ExternalActivation, v39)
+		for i := 0; i < v39; i++ {
+			v40 := NewPopulatedExternalActivation(r, easy)
+			this.Activations[i] = *v40
+		}
 	}
-	var l int
-	_ = l
-	l = m.JoinEUI.Size()
-	n += 1 + l + sovJoinserver(uint64(l))
-	if m.Length != 0 {
-		n += 1 + sovJoinserver(uint64(m.Length))
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func (m *JoinEUIPrefixes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Prefixes) > 0 {
-		for _, e := range m.Prefixes {
-			l = e.Size()
-			n += 1 + l + sovJoinserver(uint64(l))
-		}
+func NewPopulatedListExternalActivationsRequest(r randyJoinserver, easy bool) *ListExternalActivationsRequest {
+	this := &ListExternalActivationsRequest{}
+	v41 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEUI = *v41
+	v42 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v42
+	this.Limit = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func sovJoinserver(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+type randyJoinserver interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
 }
-func sozJoinserver(x uint64) (n int) {
-	return sovJoinserver((x << 1) ^ uint64((int64(x) >> 63)))
+
+func randUTF8RuneJoinserver(r randyJoinserver) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
 }
-func (this *SessionKeyRequest) String() string {
-	if this == nil {
-		return "nil"
+func randStringJoinserver(r randyJoinserver) string {
+	v43 := r.Intn(100)
+	tmps := make([]rune, v43)
+	for i := 0; i < v43; i++ {
+		tmps[i] = randUTF8RuneJoinserver(r)
 	}
-	s := strings.Join([]string{`&SessionKeyRequest{`,
-		`SessionKeyID:` + fmt.Sprintf("%v", this.SessionKeyID) + `,`,
-		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
-		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
-		`}`,
-	}, "")
-	return s
+	return string(tmps)
 }
-func (this *NwkSKeysResponse) String() string {
-	if this == nil {
-		return "nil"
+func randUnrecognizedJoinserver(r randyJoinserver, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldJoinserver(dAtA, r, fieldNumber, wire)
 	}
-	s := strings.Join([]string{`&NwkSKeysResponse{`,
-		`FNwkSIntKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FNwkSIntKey), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
-		`SNwkSIntKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SNwkSIntKey), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
-		`NwkSEncKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NwkSEncKey), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA
 }
-func (this *AppSKeyResponse) String() string {
-	if this == nil {
-		return "nil"
+func randFieldJoinserver(dAtA []byte, r randyJoinserver, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(key))
+		v44 := r.Int63()
+		if r.Intn(2) == 0 {
+			v44 *= -1
+		}
+		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(v44))
+	case 1:
+		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
 	}
-	s := strings.Join([]string{`&AppSKeyResponse{`,
-		`AppSKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AppSKey), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA
 }
-func (this *CryptoServicePayloadRequest) String() string {
-	if this == nil {
-		return "nil"
+func encodeVarintPopulateJoinserver(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
 	}
-	s := strings.Join([]string{`&CryptoServicePayloadRequest{`,
-		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
-		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
-		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
-		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
-		`ProvisioningData:` + strings.Replace(fmt.Sprintf("%v", this.ProvisioningData), "Struct", "types.Struct", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
 }
-func (this *CryptoServicePayloadResponse) String() string {
-	if this == nil {
-		return "nil"
+func (m *SessionKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&CryptoServicePayloadResponse{`,
-		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JoinAcceptMICRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.SessionKeyID)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
 	}
-	s := strings.Join([]string{`&JoinAcceptMICRequest{`,
-		`CryptoServicePayloadRequest:` + strings.Replace(strings.Replace(this.CryptoServicePayloadRequest.String(), "CryptoServicePayloadRequest", "CryptoServicePayloadRequest", 1), `&`, ``, 1) + `,`,
-		`JoinRequestType:` + fmt.Sprintf("%v", this.JoinRequestType) + `,`,
-		`DevNonce:` + fmt.Sprintf("%v", this.DevNonce) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = m.DevEUI.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = m.JoinEUI.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	return n
 }
-func (this *DeriveSessionKeysRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *NwkSKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&DeriveSessionKeysRequest{`,
-		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
-		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
-		`JoinNonce:` + fmt.Sprintf("%v", this.JoinNonce) + `,`,
-		`DevNonce:` + fmt.Sprintf("%v", this.DevNonce) + `,`,
-		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
-		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
-		`ProvisioningData:` + strings.Replace(fmt.Sprintf("%v", this.ProvisioningData), "Struct", "types.Struct", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.FNwkSIntKey.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = m.SNwkSIntKey.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = m.NwkSEncKey.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	return n
 }
-func (this *GetRootKeysRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AppSKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetRootKeysRequest{`,
-		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
-		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
-		`ProvisioningData:` + strings.Replace(fmt.Sprintf("%v", this.ProvisioningData), "Struct", "types.Struct", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.AppSKey.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	return n
 }
-func (this *ProvisionEndDevicesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CryptoServicePayloadRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ProvisionEndDevicesRequest{`,
-		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
-		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
-		`ProvisioningData:` + fmt.Sprintf("%v", this.ProvisioningData) + `,`,
-		`EndDevices:` + fmt.Sprintf("%v", this.EndDevices) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ProvisionEndDevicesRequest_List) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	if m.LoRaWANVersion != 0 {
+		n += 1 + sovJoinserver(uint64(m.LoRaWANVersion))
 	}
-	s := strings.Join([]string{`&ProvisionEndDevicesRequest_List{`,
-		`List:` + strings.Replace(fmt.Sprintf("%v", this.List), "ProvisionEndDevicesRequest_IdentifiersList", "ProvisionEndDevicesRequest_IdentifiersList", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ProvisionEndDevicesRequest_Range) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
 	}
-	s := strings.Join([]string{`&ProvisionEndDevicesRequest_Range{`,
-		`Range:` + strings.Replace(fmt.Sprintf("%v", this.Range), "ProvisionEndDevicesRequest_IdentifiersRange", "ProvisionEndDevicesRequest_IdentifiersRange", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ProvisionEndDevicesRequest_FromData) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.ProvisionerID)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
 	}
-	s := strings.Join([]string{`&ProvisionEndDevicesRequest_FromData{`,
-		`FromData:` + strings.Replace(fmt.Sprintf("%v", this.FromData), "ProvisionEndDevicesRequest_IdentifiersFromData", "ProvisionEndDevicesRequest_IdentifiersFromData", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.ProvisioningData != nil {
+		l = m.ProvisioningData.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	return n
 }
-func (this *ProvisionEndDevicesRequest_IdentifiersList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CryptoServicePayloadResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForEndDeviceIDs := "[]EndDeviceIdentifiers{"
-	for _, f := range this.EndDeviceIDs {
-		repeatedStringForEndDeviceIDs += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
 	}
-	repeatedStringForEndDeviceIDs += "}"
-	s := strings.Join([]string{`&ProvisionEndDevicesRequest_IdentifiersList{`,
-		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
-		`EndDeviceIDs:` + repeatedStringForEndDeviceIDs + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ProvisionEndDevicesRequest_IdentifiersRange) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *JoinAcceptMICRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ProvisionEndDevicesRequest_IdentifiersRange{`,
-		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
-		`StartDevEUI:` + fmt.Sprintf("%v", this.StartDevEUI) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ProvisionEndDevicesRequest_IdentifiersFromData) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.CryptoServicePayloadRequest.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	if m.JoinRequestType != 0 {
+		n += 1 + sovJoinserver(uint64(m.JoinRequestType))
 	}
-	s := strings.Join([]string{`&ProvisionEndDevicesRequest_IdentifiersFromData{`,
-		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = m.DevNonce.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	return n
 }
-func (this *JoinEUIPrefix) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DeriveSessionKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&JoinEUIPrefix{`,
-		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
-		`Length:` + fmt.Sprintf("%v", this.Length) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	if m.LoRaWANVersion != 0 {
+		n += 1 + sovJoinserver(uint64(m.LoRaWANVersion))
+	}
+	l = m.JoinNonce.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = m.DevNonce.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = m.NetID.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = len(m.ProvisionerID)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	if m.ProvisioningData != nil {
+		l = m.ProvisioningData.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	return n
 }
-func (this *JoinEUIPrefixes) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetRootKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPrefixes := "[]JoinEUIPrefix{"
-	for _, f := range this.Prefixes {
-		repeatedStringForPrefixes += strings.Replace(strings.Replace(f.String(), "JoinEUIPrefix", "JoinEUIPrefix", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = len(m.ProvisionerID)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	if m.ProvisioningData != nil {
+		l = m.ProvisioningData.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	return n
+}
+
+func (m *ProvisionEndDevicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = len(m.ProvisionerID)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	l = len(m.ProvisioningData)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	if m.EndDevices != nil {
+		n += m.EndDevices.Size()
+	}
+	return n
+}
+
+func (m *ProvisionEndDevicesRequest_List) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.List != nil {
+		l = m.List.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	return n
+}
+func (m *ProvisionEndDevicesRequest_Range) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	return n
+}
+func (m *ProvisionEndDevicesRequest_FromData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromData != nil {
+		l = m.FromData.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	return n
+}
+func (m *ProvisionEndDevicesRequest_IdentifiersList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JoinEUI != nil {
+		l = m.JoinEUI.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	if len(m.EndDeviceIDs) > 0 {
+		for _, e := range m.EndDeviceIDs {
+			l = e.Size()
+			n += 1 + l + sovJoinserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProvisionEndDevicesRequest_IdentifiersRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JoinEUI != nil {
+		l = m.JoinEUI.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	l = m.StartDevEUI.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	return n
+}
+
+func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JoinEUI != nil {
+		l = m.JoinEUI.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	return n
+}
+
+func (m *JoinAttempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt)
+	n += 1 + l + sovJoinserver(uint64(l))
+	if m.MType != 0 {
+		n += 1 + sovJoinserver(uint64(m.MType))
+	}
+	l = m.DevNonce.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = m.NetID.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	if m.Accepted {
+		n += 2
+	}
+	if m.RejectReason != 0 {
+		n += 1 + sovJoinserver(uint64(m.RejectReason))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	l = len(m.SessionKeyID)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	return n
+}
+
+func (m *JoinAttempts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attempts) > 0 {
+		for _, e := range m.Attempts {
+			l = e.Size()
+			n += 1 + l + sovJoinserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetJoinAttemptsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovJoinserver(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *ResetEndDeviceNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	if m.ResetUsedDevNonces {
+		n += 2
+	}
+	return n
+}
+
+func (m *JoinEUIPrefix) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.JoinEUI.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	if m.Length != 0 {
+		n += 1 + sovJoinserver(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *JoinEUIPrefixes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prefixes) > 0 {
+		for _, e := range m.Prefixes {
+			l = e.Size()
+			n += 1 + l + sovJoinserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExternalActivation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.JoinEUI.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = m.DevEUI.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = m.NetID.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = len(m.JoinServerAddress)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	l = len(m.SessionKeyID)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivatedAt)
+	n += 1 + l + sovJoinserver(uint64(l))
+	return n
+}
+
+func (m *ExternalActivations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Activations) > 0 {
+		for _, e := range m.Activations {
+			l = e.Size()
+			n += 1 + l + sovJoinserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListExternalActivationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.JoinEUI.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = m.DevEUI.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovJoinserver(uint64(m.Limit))
+	}
+	return n
+}
+
+func sovJoinserver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozJoinserver(x uint64) (n int) {
+	return sovJoinserver((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *SessionKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SessionKeyRequest{`,
+		`SessionKeyID:` + fmt.Sprintf("%v", this.SessionKeyID) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NwkSKeysResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NwkSKeysResponse{`,
+		`FNwkSIntKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FNwkSIntKey), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
+		`SNwkSIntKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SNwkSIntKey), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
+		`NwkSEncKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NwkSEncKey), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AppSKeyResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AppSKeyResponse{`,
+		`AppSKey:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AppSKey), "KeyEnvelope", "KeyEnvelope", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CryptoServicePayloadRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CryptoServicePayloadRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
+		`ProvisioningData:` + strings.Replace(fmt.Sprintf("%v", this.ProvisioningData), "Struct", "types.Struct", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CryptoServicePayloadResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CryptoServicePayloadResponse{`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JoinAcceptMICRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JoinAcceptMICRequest{`,
+		`CryptoServicePayloadRequest:` + strings.Replace(strings.Replace(this.CryptoServicePayloadRequest.String(), "CryptoServicePayloadRequest", "CryptoServicePayloadRequest", 1), `&`, ``, 1) + `,`,
+		`JoinRequestType:` + fmt.Sprintf("%v", this.JoinRequestType) + `,`,
+		`DevNonce:` + fmt.Sprintf("%v", this.DevNonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeriveSessionKeysRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeriveSessionKeysRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`LoRaWANVersion:` + fmt.Sprintf("%v", this.LoRaWANVersion) + `,`,
+		`JoinNonce:` + fmt.Sprintf("%v", this.JoinNonce) + `,`,
+		`DevNonce:` + fmt.Sprintf("%v", this.DevNonce) + `,`,
+		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
+		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
+		`ProvisioningData:` + strings.Replace(fmt.Sprintf("%v", this.ProvisioningData), "Struct", "types.Struct", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetRootKeysRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetRootKeysRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
+		`ProvisioningData:` + strings.Replace(fmt.Sprintf("%v", this.ProvisioningData), "Struct", "types.Struct", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProvisionEndDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProvisionEndDevicesRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
+		`ProvisioningData:` + fmt.Sprintf("%v", this.ProvisioningData) + `,`,
+		`EndDevices:` + fmt.Sprintf("%v", this.EndDevices) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProvisionEndDevicesRequest_List) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProvisionEndDevicesRequest_List{`,
+		`List:` + strings.Replace(fmt.Sprintf("%v", this.List), "ProvisionEndDevicesRequest_IdentifiersList", "ProvisionEndDevicesRequest_IdentifiersList", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProvisionEndDevicesRequest_Range) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProvisionEndDevicesRequest_Range{`,
+		`Range:` + strings.Replace(fmt.Sprintf("%v", this.Range), "ProvisionEndDevicesRequest_IdentifiersRange", "ProvisionEndDevicesRequest_IdentifiersRange", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProvisionEndDevicesRequest_FromData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProvisionEndDevicesRequest_FromData{`,
+		`FromData:` + strings.Replace(fmt.Sprintf("%v", this.FromData), "ProvisionEndDevicesRequest_IdentifiersFromData", "ProvisionEndDevicesRequest_IdentifiersFromData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProvisionEndDevicesRequest_IdentifiersList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEndDeviceIDs := "[]EndDeviceIdentifiers{"
+	for _, f := range this.EndDeviceIDs {
+		repeatedStringForEndDeviceIDs += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForEndDeviceIDs += "}"
+	s := strings.Join([]string{`&ProvisionEndDevicesRequest_IdentifiersList{`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`EndDeviceIDs:` + repeatedStringForEndDeviceIDs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProvisionEndDevicesRequest_IdentifiersRange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProvisionEndDevicesRequest_IdentifiersRange{`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`StartDevEUI:` + fmt.Sprintf("%v", this.StartDevEUI) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProvisionEndDevicesRequest_IdentifiersFromData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProvisionEndDevicesRequest_IdentifiersFromData{`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JoinAttempt) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JoinAttempt{`,
+		`ReceivedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ReceivedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`MType:` + fmt.Sprintf("%v", this.MType) + `,`,
+		`DevNonce:` + fmt.Sprintf("%v", this.DevNonce) + `,`,
+		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
+		`Accepted:` + fmt.Sprintf("%v", this.Accepted) + `,`,
+		`RejectReason:` + fmt.Sprintf("%v", this.RejectReason) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`SessionKeyID:` + fmt.Sprintf("%v", this.SessionKeyID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JoinAttempts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAttempts := "[]JoinAttempt{"
+	for _, f := range this.Attempts {
+		repeatedStringForAttempts += strings.Replace(strings.Replace(f.String(), "JoinAttempt", "JoinAttempt", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAttempts += "}"
+	s := strings.Join([]string{`&JoinAttempts{`,
+		`Attempts:` + repeatedStringForAttempts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJoinAttemptsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetJoinAttemptsRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetEndDeviceNoncesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetEndDeviceNoncesRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`ResetUsedDevNonces:` + fmt.Sprintf("%v", this.ResetUsedDevNonces) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JoinEUIPrefix) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JoinEUIPrefix{`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`Length:` + fmt.Sprintf("%v", this.Length) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JoinEUIPrefixes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPrefixes := "[]JoinEUIPrefix{"
+	for _, f := range this.Prefixes {
+		repeatedStringForPrefixes += strings.Replace(strings.Replace(f.String(), "JoinEUIPrefix", "JoinEUIPrefix", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPrefixes += "}"
+	s := strings.Join([]string{`&JoinEUIPrefixes{`,
+		`Prefixes:` + repeatedStringForPrefixes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExternalActivation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExternalActivation{`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`NetID:` + fmt.Sprintf("%v", this.NetID) + `,`,
+		`JoinServerAddress:` + fmt.Sprintf("%v", this.JoinServerAddress) + `,`,
+		`SessionKeyID:` + fmt.Sprintf("%v", this.SessionKeyID) + `,`,
+		`ActivatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ActivatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExternalActivations) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActivations := "[]ExternalActivation{"
+	for _, f := range this.Activations {
+		repeatedStringForActivations += strings.Replace(strings.Replace(f.String(), "ExternalActivation", "ExternalActivation", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForActivations += "}"
+	s := strings.Join([]string{`&ExternalActivations{`,
+		`Activations:` + repeatedStringForActivations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListExternalActivationsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListExternalActivationsRequest{`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringJoinserver(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SessionKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeyID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeyID = append(m.SessionKeyID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionKeyID == nil {
+				m.SessionKeyID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NwkSKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NwkSKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NwkSKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FNwkSIntKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FNwkSIntKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SNwkSIntKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SNwkSIntKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NwkSEncKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NwkSEncKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppSKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppSKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppSKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AppSKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CryptoServicePayloadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CryptoServicePayloadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CryptoServicePayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
+			}
+			m.LoRaWANVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProvisioningData == nil {
+				m.ProvisioningData = &types.Struct{}
+			}
+			if err := m.ProvisioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CryptoServicePayloadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CryptoServicePayloadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CryptoServicePayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinAcceptMICRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinAcceptMICRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinAcceptMICRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CryptoServicePayloadRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CryptoServicePayloadRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinRequestType", wireType)
+			}
+			m.JoinRequestType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinRequestType |= RejoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	repeatedStringForPrefixes += "}"
-	s := strings.Join([]string{`&JoinEUIPrefixes{`,
-		`Prefixes:` + repeatedStringForPrefixes + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringJoinserver(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *SessionKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *DeriveSessionKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3887,15 +6335,67 @@ func (m *SessionKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeriveSessionKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeriveSessionKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
+			}
+			m.LoRaWANVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinNonce", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3922,14 +6422,46 @@ func (m *SessionKeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionKeyID = append(m.SessionKeyID[:0], dAtA[iNdEx:postIndex]...)
-			if m.SessionKeyID == nil {
-				m.SessionKeyID = []byte{}
+			if err := m.JoinNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3946,25 +6478,57 @@ func (m *SessionKeyRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthJoinserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -3974,22 +6538,25 @@ func (m *SessionKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthJoinserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ProvisioningData == nil {
+				m.ProvisioningData = &types.Struct{}
+			}
+			if err := m.ProvisioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4017,7 +6584,7 @@ func (m *SessionKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NwkSKeysResponse) Unmarshal(dAtA []byte) error {
+func (m *GetRootKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4040,15 +6607,15 @@ func (m *NwkSKeysResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NwkSKeysResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRootKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NwkSKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRootKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FNwkSIntKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4075,15 +6642,15 @@ func (m *NwkSKeysResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FNwkSIntKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SNwkSIntKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -4093,28 +6660,27 @@ func (m *NwkSKeysResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthJoinserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SNwkSIntKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NwkSEncKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4141,7 +6707,10 @@ func (m *NwkSKeysResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NwkSEncKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ProvisioningData == nil {
+				m.ProvisioningData = &types.Struct{}
+			}
+			if err := m.ProvisioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4169,7 +6738,7 @@ func (m *NwkSKeysResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppSKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4192,15 +6761,15 @@ func (m *AppSKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppSKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProvisionEndDevicesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppSKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProvisionEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppSKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4227,66 +6796,79 @@ func (m *AppSKeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AppSKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipJoinserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CryptoServicePayloadRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowJoinserver
+			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
 			}
-			if iNdEx >= l {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ProvisioningData = append(m.ProvisioningData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProvisioningData == nil {
+				m.ProvisioningData = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CryptoServicePayloadRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CryptoServicePayloadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4313,15 +6895,17 @@ func (m *CryptoServicePayloadRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ProvisionEndDevicesRequest_IdentifiersList{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.EndDevices = &ProvisionEndDevicesRequest_List{v}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
 			}
-			m.LoRaWANVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -4331,16 +6915,32 @@ func (m *CryptoServicePayloadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ProvisionEndDevicesRequest_IdentifiersRange{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.EndDevices = &ProvisionEndDevicesRequest_Range{v}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromData", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -4350,31 +6950,85 @@ func (m *CryptoServicePayloadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthJoinserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
+			v := &ProvisionEndDevicesRequest_IdentifiersFromData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.EndDevices = &ProvisionEndDevicesRequest_FromData{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProvisionEndDevicesRequest_IdentifiersList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiersList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiersList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -4384,27 +7038,30 @@ func (m *CryptoServicePayloadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthJoinserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
+			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
+			m.JoinEUI = &v
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4431,10 +7088,8 @@ func (m *CryptoServicePayloadRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProvisioningData == nil {
-				m.ProvisioningData = &types.Struct{}
-			}
-			if err := m.ProvisioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EndDeviceIDs = append(m.EndDeviceIDs, EndDeviceIdentifiers{})
+			if err := m.EndDeviceIDs[len(m.EndDeviceIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4462,7 +7117,7 @@ func (m *CryptoServicePayloadRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CryptoServicePayloadResponse) Unmarshal(dAtA []byte) error {
+func (m *ProvisionEndDevicesRequest_IdentifiersRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4485,15 +7140,15 @@ func (m *CryptoServicePayloadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CryptoServicePayloadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifiersRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CryptoServicePayloadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifiersRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4520,9 +7175,43 @@ func (m *CryptoServicePayloadResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
+			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
+			m.JoinEUI = &v
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDevEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartDevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4549,7 +7238,7 @@ func (m *CryptoServicePayloadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JoinAcceptMICRequest) Unmarshal(dAtA []byte) error {
+func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4572,67 +7261,15 @@ func (m *JoinAcceptMICRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JoinAcceptMICRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifiersFromData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinAcceptMICRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifiersFromData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CryptoServicePayloadRequest", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowJoinserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthJoinserver
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthJoinserver
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CryptoServicePayloadRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinRequestType", wireType)
-			}
-			m.JoinRequestType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowJoinserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JoinRequestType |= RejoinType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4659,7 +7296,9 @@ func (m *JoinAcceptMICRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
+			m.JoinEUI = &v
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4687,7 +7326,7 @@ func (m *JoinAcceptMICRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeriveSessionKeysRequest) Unmarshal(dAtA []byte) error {
+func (m *JoinAttempt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4710,15 +7349,15 @@ func (m *DeriveSessionKeysRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeriveSessionKeysRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JoinAttempt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeriveSessionKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JoinAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4745,15 +7384,15 @@ func (m *DeriveSessionKeysRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MType", wireType)
 			}
-			m.LoRaWANVersion = 0
+			m.MType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -4763,14 +7402,14 @@ func (m *DeriveSessionKeysRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LoRaWANVersion |= MACVersion(b&0x7F) << shift
+				m.MType |= MType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevNonce", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4797,13 +7436,13 @@ func (m *DeriveSessionKeysRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JoinNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DevNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4830,15 +7469,54 @@ func (m *DeriveSessionKeysRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DevNonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+			}
+			m.RejectReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectReason |= JoinRejectReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -4848,30 +7526,33 @@ func (m *DeriveSessionKeysRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthJoinserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeyID", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -4881,27 +7562,82 @@ func (m *DeriveSessionKeysRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeyID = append(m.SessionKeyID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionKeyID == nil {
+				m.SessionKeyID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinAttempts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinAttempts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinAttempts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4928,10 +7664,8 @@ func (m *DeriveSessionKeysRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProvisioningData == nil {
-				m.ProvisioningData = &types.Struct{}
-			}
-			if err := m.ProvisioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attempts = append(m.Attempts, JoinAttempt{})
+			if err := m.Attempts[len(m.Attempts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4959,7 +7693,7 @@ func (m *DeriveSessionKeysRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRootKeysRequest) Unmarshal(dAtA []byte) error {
+func (m *GetJoinAttemptsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4982,10 +7716,10 @@ func (m *GetRootKeysRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRootKeysRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetJoinAttemptsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRootKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetJoinAttemptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5022,42 +7756,10 @@ func (m *GetRootKeysRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowJoinserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthJoinserver
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthJoinserver
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -5067,28 +7769,11 @@ func (m *GetRootKeysRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthJoinserver
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthJoinserver
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ProvisioningData == nil {
-				m.ProvisioningData = &types.Struct{}
-			}
-			if err := m.ProvisioningData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipJoinserver(dAtA[iNdEx:])
@@ -5113,7 +7798,7 @@ func (m *GetRootKeysRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetEndDeviceNoncesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5136,15 +7821,15 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProvisionEndDevicesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetEndDeviceNoncesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProvisionEndDevicesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetEndDeviceNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5171,15 +7856,15 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionerID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetUsedDevNonces", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -5189,27 +7874,68 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.ResetUsedDevNonces = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProvisionerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinEUIPrefix) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinEUIPrefix: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinEUIPrefix: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProvisioningData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5236,16 +7962,15 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProvisioningData = append(m.ProvisioningData[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProvisioningData == nil {
-				m.ProvisioningData = []byte{}
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			var msglen int
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -5255,65 +7980,67 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Length |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ProvisionEndDevicesRequest_IdentifiersList{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.EndDevices = &ProvisionEndDevicesRequest_List{v}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowJoinserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthJoinserver
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthJoinserver
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinEUIPrefixes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ProvisionEndDevicesRequest_IdentifiersRange{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.EndDevices = &ProvisionEndDevicesRequest_Range{v}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinEUIPrefixes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinEUIPrefixes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5340,11 +8067,10 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ProvisionEndDevicesRequest_IdentifiersFromData{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Prefixes = append(m.Prefixes, JoinEUIPrefix{})
+			if err := m.Prefixes[len(m.Prefixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.EndDevices = &ProvisionEndDevicesRequest_FromData{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5370,7 +8096,7 @@ func (m *ProvisionEndDevicesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProvisionEndDevicesRequest_IdentifiersList) Unmarshal(dAtA []byte) error {
+func (m *ExternalActivation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5393,10 +8119,10 @@ func (m *ProvisionEndDevicesRequest_IdentifiersList) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiersList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExternalActivation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiersList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExternalActivation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5428,17 +8154,15 @@ func (m *ProvisionEndDevicesRequest_IdentifiersList) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
-			m.JoinEUI = &v
 			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -5448,82 +8172,93 @@ func (m *ProvisionEndDevicesRequest_IdentifiersList) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthJoinserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndDeviceIDs = append(m.EndDeviceIDs, EndDeviceIdentifiers{})
-			if err := m.EndDeviceIDs[len(m.EndDeviceIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipJoinserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetID", wireType)
 			}
-			if skippy < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProvisionEndDevicesRequest_IdentifiersRange) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowJoinserver
+			if err := m.NetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinServerAddress", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiersRange: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiersRange: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinServerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeyID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5550,17 +8285,16 @@ func (m *ProvisionEndDevicesRequest_IdentifiersRange) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
-			m.JoinEUI = &v
-			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SessionKeyID = append(m.SessionKeyID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionKeyID == nil {
+				m.SessionKeyID = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartDevEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedAt", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -5570,22 +8304,22 @@ func (m *ProvisionEndDevicesRequest_IdentifiersRange) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthJoinserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartDevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ActivatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5613,7 +8347,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersRange) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Unmarshal(dAtA []byte) error {
+func (m *ExternalActivations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5636,17 +8370,17 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiersFromData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExternalActivations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiersFromData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExternalActivations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Activations", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -5656,24 +8390,23 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthJoinserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v go_thethings_network_lorawan_stack_pkg_types.EUI64
-			m.JoinEUI = &v
-			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Activations = append(m.Activations, ExternalActivation{})
+			if err := m.Activations[len(m.Activations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5701,7 +8434,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *JoinEUIPrefix) Unmarshal(dAtA []byte) error {
+func (m *ListExternalActivationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5724,10 +8457,10 @@ func (m *JoinEUIPrefix) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JoinEUIPrefix: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListExternalActivationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinEUIPrefix: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListExternalActivationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5764,10 +8497,10 @@ func (m *JoinEUIPrefix) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
 			}
-			m.Length = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -5777,69 +8510,30 @@ func (m *JoinEUIPrefix) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Length |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipJoinserver(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthJoinserver
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JoinEUIPrefixes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowJoinserver
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JoinEUIPrefixes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinEUIPrefixes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowJoinserver
@@ -5849,26 +8543,11 @@ func (m *JoinEUIPrefixes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthJoinserver
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthJoinserver
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Prefixes = append(m.Prefixes, JoinEUIPrefix{})
-			if err := m.Prefixes[len(m.Prefixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipJoinserver(dAtA[iNdEx:])
@@ -198,3 +198,116 @@ var ProvisionEndDevicesRequest_IdentifiersFromDataFieldPathsNested = []string{
 var ProvisionEndDevicesRequest_IdentifiersFromDataFieldPathsTopLevel = []string{
 	"join_eui",
 }
+
+var ExternalActivationFieldPathsNested = []string{
+	"activated_at",
+	"dev_eui",
+	"join_eui",
+	"join_server_address",
+	"net_id",
+	"session_key_id",
+}
+
+var ExternalActivationFieldPathsTopLevel = []string{
+	"activated_at",
+	"dev_eui",
+	"join_eui",
+	"join_server_address",
+	"net_id",
+	"session_key_id",
+}
+
+var ExternalActivationsFieldPathsNested = []string{
+	"activations",
+}
+
+var ExternalActivationsFieldPathsTopLevel = []string{
+	"activations",
+}
+
+var ListExternalActivationsRequestFieldPathsNested = []string{
+	"dev_eui",
+	"join_eui",
+	"limit",
+}
+
+var ListExternalActivationsRequestFieldPathsTopLevel = []string{
+	"dev_eui",
+	"join_eui",
+	"limit",
+}
+
+var JoinAttemptFieldPathsNested = []string{
+	"accepted",
+	"dev_nonce",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"m_type",
+	"net_id",
+	"received_at",
+	"reject_reason",
+	"session_key_id",
+}
+
+var JoinAttemptFieldPathsTopLevel = []string{
+	"accepted",
+	"dev_nonce",
+	"error",
+	"m_type",
+	"net_id",
+	"received_at",
+	"reject_reason",
+	"session_key_id",
+}
+
+var JoinAttemptsFieldPathsNested = []string{
+	"attempts",
+}
+
+var JoinAttemptsFieldPathsTopLevel = []string{
+	"attempts",
+}
+
+var GetJoinAttemptsRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"limit",
+}
+
+var GetJoinAttemptsRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"limit",
+}
+
+var ResetEndDeviceNoncesRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"reset_used_dev_nonces",
+}
+
+var ResetEndDeviceNoncesRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"reset_used_dev_nonces",
+}
@@ -4,6 +4,7 @@ package ttnpb
 
 import (
 	fmt "fmt"
+	time "time"
 
 	go_thethings_network_lorawan_stack_pkg_types "go.thethings.network/lorawan-stack/pkg/types"
 )
@@ -711,3 +712,337 @@ func (dst *ProvisionEndDevicesRequest_IdentifiersFromData) SetFields(src *Provis
 	}
 	return nil
 }
+
+func (dst *ExternalActivation) SetFields(src *ExternalActivation, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "join_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEUI = src.JoinEUI
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.EUI64
+				dst.JoinEUI = zero
+			}
+		case "dev_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevEUI = src.DevEUI
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.EUI64
+				dst.DevEUI = zero
+			}
+		case "net_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'net_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NetID = src.NetID
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.NetID
+				dst.NetID = zero
+			}
+		case "join_server_address":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_server_address' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinServerAddress = src.JoinServerAddress
+			} else {
+				var zero string
+				dst.JoinServerAddress = zero
+			}
+		case "session_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'session_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SessionKeyID = src.SessionKeyID
+			} else {
+				dst.SessionKeyID = nil
+			}
+		case "activated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'activated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ActivatedAt = src.ActivatedAt
+			} else {
+				var zero time.Time
+				dst.ActivatedAt = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ExternalActivations) SetFields(src *ExternalActivations, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "activations":
+			if len(subs) > 0 {
+				return fmt.Errorf("'activations' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Activations = src.Activations
+			} else {
+				dst.Activations = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListExternalActivationsRequest) SetFields(src *ListExternalActivationsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "join_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEUI = src.JoinEUI
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.EUI64
+				dst.JoinEUI = zero
+			}
+		case "dev_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevEUI = src.DevEUI
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.EUI64
+				dst.DevEUI = zero
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *JoinAttempt) SetFields(src *JoinAttempt, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "received_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'received_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReceivedAt = src.ReceivedAt
+			} else {
+				var zero time.Time
+				dst.ReceivedAt = zero
+			}
+		case "m_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'m_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MType = src.MType
+			} else {
+				var zero MType
+				dst.MType = zero
+			}
+		case "dev_nonce":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_nonce' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevNonce = src.DevNonce
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.DevNonce
+				dst.DevNonce = zero
+			}
+		case "net_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'net_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NetID = src.NetID
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.NetID
+				dst.NetID = zero
+			}
+		case "accepted":
+			if len(subs) > 0 {
+				return fmt.Errorf("'accepted' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Accepted = src.Accepted
+			} else {
+				var zero bool
+				dst.Accepted = zero
+			}
+		case "reject_reason":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reject_reason' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RejectReason = src.RejectReason
+			} else {
+				var zero JoinRejectReason
+				dst.RejectReason = zero
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+		case "session_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'session_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SessionKeyID = src.SessionKeyID
+			} else {
+				dst.SessionKeyID = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *JoinAttempts) SetFields(src *JoinAttempts, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Attempts = src.Attempts
+			} else {
+				dst.Attempts = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetJoinAttemptsRequest) SetFields(src *GetJoinAttemptsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ResetEndDeviceNoncesRequest) SetFields(src *ResetEndDeviceNoncesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				newDst = &dst.EndDeviceIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "reset_used_dev_nonces":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reset_used_dev_nonces' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ResetUsedDevNonces = src.ResetUsedDevNonces
+			} else {
+				var zero bool
+				dst.ResetUsedDevNonces = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -1547,3 +1547,742 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ProvisionEndDevicesRequest_IdentifiersFromDataValidationError{}
+
+// ValidateFields checks the field values on ExternalActivation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExternalActivation) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ExternalActivationFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "join_eui":
+			// no validation rules for JoinEUI
+		case "dev_eui":
+			// no validation rules for DevEUI
+		case "net_id":
+			// no validation rules for NetID
+		case "join_server_address":
+			// no validation rules for JoinServerAddress
+		case "session_key_id":
+
+			if len(m.GetSessionKeyID()) > 2048 {
+				return ExternalActivationValidationError{
+					field:  "session_key_id",
+					reason: "value length must be at most 2048 bytes",
+				}
+			}
+
+		case "activated_at":
+
+			if v, ok := interface{}(&m.ActivatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ExternalActivationValidationError{
+						field:  "activated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ExternalActivationValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ExternalActivationValidationError is the validation error returned by
+// ExternalActivation.ValidateFields if the designated constraints aren't met.
+type ExternalActivationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExternalActivationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExternalActivationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExternalActivationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExternalActivationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExternalActivationValidationError) ErrorName() string {
+	return "ExternalActivationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExternalActivationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExternalActivation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExternalActivationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExternalActivationValidationError{}
+
+// ValidateFields checks the field values on ExternalActivations with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExternalActivations) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ExternalActivationsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "activations":
+
+			for idx, item := range m.GetActivations() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ExternalActivationsValidationError{
+							field:  fmt.Sprintf("activations[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ExternalActivationsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ExternalActivationsValidationError is the validation error returned by
+// ExternalActivations.ValidateFields if the designated constraints aren't
+// met.
+type ExternalActivationsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExternalActivationsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExternalActivationsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExternalActivationsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExternalActivationsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExternalActivationsValidationError) ErrorName() string {
+	return "ExternalActivationsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExternalActivationsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExternalActivations.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExternalActivationsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExternalActivationsValidationError{}
+
+// ValidateFields checks the field values on ListExternalActivationsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ListExternalActivationsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListExternalActivationsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "join_eui":
+			// no validation rules for JoinEUI
+		case "dev_eui":
+			// no validation rules for DevEUI
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListExternalActivationsRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		default:
+			return ListExternalActivationsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListExternalActivationsRequestValidationError is the validation error
+// returned by ListExternalActivationsRequest.ValidateFields if the designated
+// constraints aren't met.
+type ListExternalActivationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListExternalActivationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListExternalActivationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListExternalActivationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListExternalActivationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListExternalActivationsRequestValidationError) ErrorName() string {
+	return "ListExternalActivationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListExternalActivationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListExternalActivationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListExternalActivationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListExternalActivationsRequestValidationError{}
+
+// ValidateFields checks the field values on JoinAttempt with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *JoinAttempt) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = JoinAttemptFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "received_at":
+
+			if v, ok := interface{}(&m.ReceivedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return JoinAttemptValidationError{
+						field:  "received_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "m_type":
+
+			if _, ok := MType_name[int32(m.GetMType())]; !ok {
+				return JoinAttemptValidationError{
+					field:  "m_type",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "dev_nonce":
+			// no validation rules for DevNonce
+		case "net_id":
+			// no validation rules for NetID
+		case "accepted":
+			// no validation rules for Accepted
+		case "reject_reason":
+
+			if _, ok := JoinRejectReason_name[int32(m.GetRejectReason())]; !ok {
+				return JoinAttemptValidationError{
+					field:  "reject_reason",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return JoinAttemptValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "session_key_id":
+
+			if len(m.GetSessionKeyID()) > 2048 {
+				return JoinAttemptValidationError{
+					field:  "session_key_id",
+					reason: "value length must be at most 2048 bytes",
+				}
+			}
+
+		default:
+			return JoinAttemptValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// JoinAttemptValidationError is the validation error returned by
+// JoinAttempt.ValidateFields if the designated constraints aren't met.
+type JoinAttemptValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JoinAttemptValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JoinAttemptValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JoinAttemptValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JoinAttemptValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JoinAttemptValidationError) ErrorName() string {
+	return "JoinAttemptValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JoinAttemptValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJoinAttempt.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JoinAttemptValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JoinAttemptValidationError{}
+
+// ValidateFields checks the field values on JoinAttempts with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *JoinAttempts) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = JoinAttemptsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "attempts":
+
+			for idx, item := range m.GetAttempts() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return JoinAttemptsValidationError{
+							field:  fmt.Sprintf("attempts[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return JoinAttemptsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// JoinAttemptsValidationError is the validation error returned by
+// JoinAttempts.ValidateFields if the designated constraints aren't met.
+type JoinAttemptsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JoinAttemptsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JoinAttemptsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JoinAttemptsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JoinAttemptsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JoinAttemptsValidationError) ErrorName() string {
+	return "JoinAttemptsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JoinAttemptsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJoinAttempts.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JoinAttemptsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JoinAttemptsValidationError{}
+
+// ValidateFields checks the field values on GetJoinAttemptsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetJoinAttemptsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetJoinAttemptsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetJoinAttemptsRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return GetJoinAttemptsRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		default:
+			return GetJoinAttemptsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetJoinAttemptsRequestValidationError is the validation error returned by
+// GetJoinAttemptsRequest.ValidateFields if the designated constraints aren't
+// met.
+type GetJoinAttemptsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJoinAttemptsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJoinAttemptsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJoinAttemptsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJoinAttemptsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJoinAttemptsRequestValidationError) ErrorName() string {
+	return "GetJoinAttemptsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJoinAttemptsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJoinAttemptsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJoinAttemptsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJoinAttemptsRequestValidationError{}
+
+// ValidateFields checks the field values on ResetEndDeviceNoncesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ResetEndDeviceNoncesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ResetEndDeviceNoncesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ResetEndDeviceNoncesRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "reset_used_dev_nonces":
+			// no validation rules for ResetUsedDevNonces
+		default:
+			return ResetEndDeviceNoncesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ResetEndDeviceNoncesRequestValidationError is the validation error returned
+// by ResetEndDeviceNoncesRequest.ValidateFields if the designated constraints
+// aren't met.
+type ResetEndDeviceNoncesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetEndDeviceNoncesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetEndDeviceNoncesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetEndDeviceNoncesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetEndDeviceNoncesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetEndDeviceNoncesRequestValidationError) ErrorName() string {
+	return "ResetEndDeviceNoncesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetEndDeviceNoncesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetEndDeviceNoncesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetEndDeviceNoncesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetEndDeviceNoncesRequestValidationError{}
@@ -242,6 +242,157 @@ func (m *SessionKeys) GetAppSKey() *KeyEnvelope {
 	return nil
 }
 
+type RotateKEKRequest struct {
+	// The label of the KEK that the keys are currently wrapped with.
+	// If empty, keys that are stored in the clear are wrapped with the new KEK.
+	OldKEKLabel string `protobuf:"bytes,1,opt,name=old_kek_label,json=oldKekLabel,proto3" json:"old_kek_label,omitempty"`
+	// The label of the KEK to wrap the keys with.
+	// If empty, the keys are stored in the clear.
+	NewKEKLabel string `protobuf:"bytes,2,opt,name=new_kek_label,json=newKekLabel,proto3" json:"new_kek_label,omitempty"`
+	// The cursor to resume the rotation from, as returned in a previous response.
+	// If empty, the rotation starts from the beginning.
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// The number of registry entries to process in this request (approximately).
+	// The rotation is complete when the returned cursor is empty.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// If true, the keys are unwrapped and wrapped, but the registries are not updated.
+	DryRun               bool     `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateKEKRequest) Reset()      { *m = RotateKEKRequest{} }
+func (*RotateKEKRequest) ProtoMessage() {}
+func (*RotateKEKRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee170ee4ccd55993, []int{3}
+}
+func (m *RotateKEKRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateKEKRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateKEKRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotateKEKRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateKEKRequest.Merge(m, src)
+}
+func (m *RotateKEKRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateKEKRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateKEKRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateKEKRequest proto.InternalMessageInfo
+
+func (m *RotateKEKRequest) GetOldKEKLabel() string {
+	if m != nil {
+		return m.OldKEKLabel
+	}
+	return ""
+}
+
+func (m *RotateKEKRequest) GetNewKEKLabel() string {
+	if m != nil {
+		return m.NewKEKLabel
+	}
+	return ""
+}
+
+func (m *RotateKEKRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *RotateKEKRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RotateKEKRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type RotateKEKResponse struct {
+	// The number of registry entries that were processed.
+	Processed uint32 `protobuf:"varint,1,opt,name=processed,proto3" json:"processed,omitempty"`
+	// The number of keys that were re-wrapped with the new KEK (or would be, in a dry run).
+	Rewrapped uint32 `protobuf:"varint,2,opt,name=rewrapped,proto3" json:"rewrapped,omitempty"`
+	// The cursor to resume the rotation from.
+	// If empty, the rotation is complete.
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateKEKResponse) Reset()      { *m = RotateKEKResponse{} }
+func (*RotateKEKResponse) ProtoMessage() {}
+func (*RotateKEKResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee170ee4ccd55993, []int{4}
+}
+func (m *RotateKEKResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateKEKResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateKEKResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotateKEKResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateKEKResponse.Merge(m, src)
+}
+func (m *RotateKEKResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateKEKResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateKEKResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateKEKResponse proto.InternalMessageInfo
+
+func (m *RotateKEKResponse) GetProcessed() uint32 {
+	if m != nil {
+		return m.Processed
+	}
+	return 0
+}
+
+func (m *RotateKEKResponse) GetRewrapped() uint32 {
+	if m != nil {
+		return m.Rewrapped
+	}
+	return 0
+}
+
+func (m *RotateKEKResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*KeyEnvelope)(nil), "ttn.lorawan.v3.KeyEnvelope")
 	golang_proto.RegisterType((*KeyEnvelope)(nil), "ttn.lorawan.v3.KeyEnvelope")
@@ -249,6 +400,10 @@ func init() {
 	golang_proto.RegisterType((*RootKeys)(nil), "ttn.lorawan.v3.RootKeys")
 	proto.RegisterType((*SessionKeys)(nil), "ttn.lorawan.v3.SessionKeys")
 	golang_proto.RegisterType((*SessionKeys)(nil), "ttn.lorawan.v3.SessionKeys")
+	proto.RegisterType((*RotateKEKRequest)(nil), "ttn.lorawan.v3.RotateKEKRequest")
+	golang_proto.RegisterType((*RotateKEKRequest)(nil), "ttn.lorawan.v3.RotateKEKRequest")
+	proto.RegisterType((*RotateKEKResponse)(nil), "ttn.lorawan.v3.RotateKEKResponse")
+	golang_proto.RegisterType((*RotateKEKResponse)(nil), "ttn.lorawan.v3.RotateKEKResponse")
 }
 
 func init() { proto.RegisterFile("lorawan-stack/api/keys.proto", fileDescriptor_ee170ee4ccd55993) }
@@ -257,45 +412,56 @@ func init() {
 }
 
 var fileDescriptor_ee170ee4ccd55993 = []byte{
-	// 599 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3f, 0x4c, 0xdb, 0x4e,
-	0x14, 0xc7, 0xef, 0xf8, 0xf7, 0x23, 0x97, 0xc0, 0xaf, 0x8a, 0x5a, 0x29, 0xa2, 0xd5, 0x03, 0xd1,
-	0x85, 0xa1, 0xb1, 0x05, 0xf4, 0x9f, 0x18, 0x90, 0xb0, 0xc8, 0x80, 0x8c, 0x3a, 0x38, 0x5b, 0x97,
-	0xc8, 0x49, 0x0e, 0x63, 0x5d, 0x7a, 0x67, 0xf9, 0x8e, 0xa4, 0xee, 0xc4, 0xc8, 0xd8, 0xb1, 0x23,
-	0xea, 0xc4, 0x52, 0x09, 0xa9, 0x0b, 0x23, 0x23, 0x23, 0xea, 0x84, 0x3a, 0x20, 0x7c, 0x5e, 0x18,
-	0x19, 0x11, 0x53, 0x75, 0x4e, 0x04, 0xa1, 0x1d, 0x60, 0x7b, 0xcf, 0xfe, 0x7e, 0xbf, 0xf7, 0x79,
-	0xf7, 0x74, 0xe4, 0x45, 0x47, 0xc4, 0x7e, 0xcf, 0xe7, 0x55, 0xa9, 0xfc, 0x16, 0xb3, 0xfd, 0x28,
-	0xb4, 0x19, 0x4d, 0xa4, 0x15, 0xc5, 0x42, 0x89, 0xf2, 0xb4, 0x52, 0xdc, 0x1a, 0x28, 0xac, 0xee,
-	0xf2, 0xcc, 0x5a, 0x10, 0xaa, 0xed, 0x9d, 0xa6, 0xd5, 0x12, 0x9f, 0x6c, 0xca, 0xbb, 0x22, 0x89,
-	0x62, 0xf1, 0x39, 0xb1, 0x73, 0x71, 0xab, 0x1a, 0x50, 0x5e, 0xed, 0xfa, 0x9d, 0xb0, 0xed, 0x2b,
-	0x6a, 0xff, 0x53, 0xf4, 0x23, 0x67, 0xaa, 0x43, 0x11, 0x81, 0x08, 0x44, 0xdf, 0xdc, 0xdc, 0xd9,
-	0xca, 0xbb, 0xbc, 0xc9, 0xab, 0xbe, 0x7c, 0xfe, 0x27, 0x26, 0x45, 0x97, 0x26, 0x35, 0xde, 0xa5,
-	0x1d, 0x11, 0xd1, 0xf2, 0x26, 0x19, 0x65, 0x34, 0xa9, 0xe0, 0x39, 0xbc, 0x50, 0x72, 0x56, 0x7e,
-	0x9f, 0xcf, 0xbe, 0x0d, 0x84, 0xa5, 0xb6, 0xa9, 0xda, 0x0e, 0x79, 0x20, 0x2d, 0x4e, 0x55, 0x4f,
-	0xc4, 0xcc, 0xbe, 0x3f, 0x55, 0xc4, 0x02, 0x5b, 0x25, 0x11, 0x95, 0xd6, 0x5a, 0xad, 0xbe, 0xb8,
-	0xf4, 0xde, 0xa5, 0x89, 0x67, 0x62, 0xca, 0x8b, 0xa4, 0xc0, 0x28, 0x6b, 0x74, 0xfc, 0x26, 0xed,
-	0x54, 0x46, 0xe6, 0xf0, 0x42, 0xc1, 0x79, 0x7a, 0xe3, 0x8c, 0xc7, 0xa3, 0x95, 0xdd, 0x27, 0xfa,
-	0x7c, 0x76, 0xd2, 0xad, 0xb9, 0x9b, 0xe6, 0x9f, 0x37, 0xc9, 0x28, 0xcb, 0xab, 0xf2, 0x4b, 0x32,
-	0x45, 0x79, 0x2b, 0x4e, 0x22, 0x45, 0xdb, 0x0d, 0x83, 0x32, 0x6a, 0x50, 0xbc, 0xd2, 0xed, 0x47,
-	0x97, 0x26, 0xf3, 0x3f, 0x30, 0x99, 0xf4, 0x84, 0x50, 0x2e, 0x4d, 0x64, 0xf9, 0x0d, 0x29, 0xc6,
-	0x42, 0x28, 0x23, 0x6e, 0x84, 0xed, 0x1c, 0xbd, 0xe0, 0x3c, 0x1b, 0x3a, 0xa6, 0x30, 0x90, 0x6e,
-	0xac, 0x7b, 0x85, 0x78, 0x50, 0xb6, 0xcb, 0xaf, 0xc9, 0x7f, 0x7e, 0x14, 0xe5, 0x47, 0x18, 0xb2,
-	0xe2, 0xd2, 0x73, 0xeb, 0xfe, 0x36, 0xac, 0xa1, 0x7b, 0xf1, 0x26, 0xfc, 0x28, 0x72, 0x69, 0x62,
-	0x5c, 0xbc, 0xc7, 0x6e, 0xc1, 0x1e, 0x72, 0xf1, 0x1e, 0x33, 0xbc, 0xbf, 0x46, 0x48, 0xb1, 0x4e,
-	0xa5, 0x0c, 0x05, 0xcf, 0x91, 0x57, 0xc9, 0xb4, 0xec, 0xb7, 0xc3, 0xd4, 0x25, 0xa7, 0x72, 0xe3,
-	0x8c, 0x7f, 0x19, 0x50, 0x97, 0xee, 0x0c, 0x1b, 0xeb, 0x5e, 0x49, 0xde, 0x75, 0xed, 0xf2, 0x1a,
-	0xf9, 0x7f, 0xab, 0x61, 0x38, 0x64, 0x23, 0xe4, 0xea, 0xb1, 0x33, 0x14, 0xb7, 0x3e, 0xf4, 0x58,
-	0x7d, 0x83, 0x9b, 0x0b, 0x30, 0x11, 0xf2, 0xaf, 0x88, 0x47, 0x0c, 0x54, 0x94, 0x43, 0x11, 0xab,
-	0x64, 0xaa, 0x1f, 0x40, 0x79, 0x2b, 0x0f, 0x18, 0x7b, 0x38, 0x80, 0xf0, 0x1e, 0xab, 0xd7, 0x78,
-	0xcb, 0xf8, 0xdf, 0x91, 0x82, 0xd9, 0x80, 0xcc, 0xbd, 0xe3, 0x0f, 0x7b, 0xcd, 0xbe, 0xea, 0x2e,
-	0x4d, 0x56, 0xc6, 0x8e, 0xf6, 0x67, 0x91, 0xf3, 0x1d, 0x9f, 0xa4, 0x80, 0x4f, 0x53, 0xc0, 0x67,
-	0x29, 0xa0, 0x8b, 0x14, 0xd0, 0x65, 0x0a, 0xe8, 0x2a, 0x05, 0x74, 0x9d, 0x02, 0xde, 0xd5, 0x80,
-	0xf7, 0x34, 0xa0, 0x03, 0x0d, 0xf8, 0x50, 0x03, 0x3a, 0xd2, 0x80, 0x8e, 0x35, 0xa0, 0x13, 0x0d,
-	0xf8, 0x54, 0x03, 0x3e, 0xd3, 0x80, 0x2e, 0x34, 0xe0, 0x4b, 0x0d, 0xe8, 0x4a, 0x03, 0xbe, 0xd6,
-	0x80, 0x76, 0x33, 0x40, 0x7b, 0x19, 0xe0, 0xaf, 0x19, 0xa0, 0x6f, 0x19, 0xe0, 0xfd, 0x0c, 0xd0,
-	0x41, 0x06, 0xe8, 0x30, 0x03, 0x7c, 0x94, 0x01, 0x3e, 0xce, 0x00, 0x7f, 0x7c, 0xf5, 0xd8, 0x07,
-	0xa1, 0x78, 0xd4, 0x6c, 0x4e, 0xe4, 0xcf, 0x6c, 0xf9, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3f,
-	0x3e, 0xb8, 0xae, 0x08, 0x04, 0x00, 0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x3d, 0x6c, 0xdb, 0x46,
+	0x14, 0xbe, 0xb3, 0x2d, 0x5b, 0x3c, 0x4a, 0x69, 0x4a, 0xb4, 0x8d, 0x90, 0x06, 0xe7, 0xc0, 0x5d,
+	0x82, 0xa2, 0xa6, 0x90, 0xa4, 0x7f, 0x48, 0x80, 0x00, 0x26, 0xa2, 0xc1, 0x60, 0x90, 0x02, 0xd4,
+	0xd6, 0x85, 0xa0, 0xc8, 0x67, 0x9a, 0x20, 0x73, 0x77, 0xbd, 0x3b, 0x99, 0x65, 0x27, 0x8f, 0x19,
+	0x3b, 0x76, 0x0c, 0x3a, 0x65, 0x29, 0x10, 0xa0, 0x8b, 0xc7, 0x8c, 0x19, 0x83, 0x4e, 0x41, 0x87,
+	0x20, 0x22, 0x81, 0xc2, 0x63, 0xc6, 0xc0, 0x53, 0x41, 0x52, 0xb1, 0xe4, 0x64, 0xb0, 0xb7, 0xf7,
+	0xde, 0xbd, 0xef, 0xbb, 0xef, 0xbd, 0xfb, 0x70, 0xe4, 0x5a, 0xc6, 0x65, 0x90, 0x07, 0x6c, 0x5b,
+	0xe9, 0x20, 0x4c, 0x87, 0x81, 0x48, 0x86, 0x29, 0x14, 0xca, 0x16, 0x92, 0x6b, 0x6e, 0x5d, 0xd2,
+	0x9a, 0xd9, 0xf3, 0x0e, 0xfb, 0xe0, 0xf6, 0xd5, 0x9d, 0x38, 0xd1, 0xfb, 0xd3, 0x89, 0x1d, 0xf2,
+	0x47, 0x43, 0x60, 0x07, 0xbc, 0x10, 0x92, 0xff, 0x5a, 0x0c, 0x9b, 0xe6, 0x70, 0x3b, 0x06, 0xb6,
+	0x7d, 0x10, 0x64, 0x49, 0x14, 0x68, 0x18, 0x7e, 0x14, 0xb4, 0x94, 0x57, 0xb7, 0x97, 0x28, 0x62,
+	0x1e, 0xf3, 0x16, 0x3c, 0x99, 0xee, 0x35, 0x59, 0x93, 0x34, 0x51, 0xdb, 0xbe, 0xf5, 0x37, 0x26,
+	0xa6, 0x0b, 0xc5, 0x88, 0x1d, 0x40, 0xc6, 0x05, 0x58, 0x0f, 0xc8, 0x6a, 0x0a, 0xc5, 0x00, 0x5f,
+	0xc7, 0x37, 0x7a, 0xce, 0x9d, 0x7f, 0x5f, 0x6f, 0x7e, 0x1f, 0x73, 0x5b, 0xef, 0x83, 0xde, 0x4f,
+	0x58, 0xac, 0x6c, 0x06, 0x3a, 0xe7, 0x32, 0x1d, 0x9e, 0x9d, 0x4a, 0xa4, 0xf1, 0x50, 0x17, 0x02,
+	0x94, 0xbd, 0x33, 0x1a, 0xdf, 0xbc, 0xf5, 0xa3, 0x0b, 0x85, 0x57, 0xd3, 0x58, 0x37, 0x89, 0x91,
+	0x42, 0xea, 0x67, 0xc1, 0x04, 0xb2, 0xc1, 0xca, 0x75, 0x7c, 0xc3, 0x70, 0x3e, 0x3b, 0x71, 0x3a,
+	0x72, 0x75, 0x70, 0x78, 0xb9, 0x7c, 0xbd, 0xd9, 0x75, 0x47, 0xee, 0x83, 0xfa, 0xcc, 0xeb, 0xa6,
+	0x90, 0x36, 0x91, 0xf5, 0x15, 0xe9, 0x03, 0x0b, 0x65, 0x21, 0x34, 0x44, 0x7e, 0x2d, 0x65, 0xb5,
+	0x96, 0xe2, 0xf5, 0x4e, 0x8b, 0x2e, 0x14, 0x5b, 0x7f, 0x61, 0xd2, 0xf5, 0x38, 0xd7, 0x2e, 0x14,
+	0xca, 0xfa, 0x8e, 0x98, 0x92, 0x73, 0x5d, 0x37, 0xfb, 0x49, 0xd4, 0x48, 0x37, 0x9c, 0xcf, 0x97,
+	0xae, 0x31, 0xe6, 0xad, 0xbb, 0xf7, 0x3d, 0x43, 0xce, 0xc3, 0xc8, 0xfa, 0x96, 0x6c, 0x04, 0x42,
+	0x34, 0x57, 0xd4, 0xca, 0xcc, 0x5b, 0x5f, 0xda, 0x67, 0x5f, 0xc3, 0x5e, 0xda, 0x8b, 0xb7, 0x1e,
+	0x08, 0xe1, 0x42, 0x51, 0xa3, 0x58, 0x9e, 0x9e, 0x0a, 0x3b, 0x0f, 0xc5, 0xf2, 0xb4, 0xd6, 0xfb,
+	0xcf, 0x0a, 0x31, 0xc7, 0xa0, 0x54, 0xc2, 0x59, 0x23, 0xf9, 0x1e, 0xb9, 0xa4, 0xda, 0x74, 0x59,
+	0x75, 0xcf, 0x19, 0x9c, 0x38, 0x9d, 0xdf, 0xe6, 0xaa, 0x7b, 0x0b, 0xc0, 0xee, 0x7d, 0xaf, 0xa7,
+	0x16, 0x59, 0x64, 0xed, 0x90, 0x4f, 0xf6, 0xfc, 0x5a, 0x87, 0xf2, 0x13, 0xa6, 0x2f, 0x3a, 0x83,
+	0xb9, 0xf7, 0x30, 0x4f, 0xc7, 0xbb, 0xac, 0x5e, 0x40, 0x4d, 0xa1, 0x3e, 0xa0, 0xb8, 0xc0, 0x40,
+	0xa6, 0x5a, 0xa2, 0xb8, 0x47, 0xfa, 0x2d, 0x01, 0xb0, 0xb0, 0x21, 0x58, 0x3b, 0x9f, 0x80, 0xb0,
+	0x3c, 0x1d, 0x8f, 0x58, 0x58, 0xe3, 0x7f, 0x20, 0x46, 0xfd, 0x02, 0xaa, 0xc1, 0x76, 0xce, 0xc7,
+	0xd6, 0xef, 0x35, 0x76, 0xa1, 0xb8, 0xb3, 0x76, 0xf4, 0x64, 0x13, 0x6d, 0xfd, 0x87, 0xc9, 0x65,
+	0x8f, 0xeb, 0x40, 0x83, 0x3b, 0x72, 0x3d, 0xf8, 0x65, 0x0a, 0x4a, 0x5b, 0x77, 0x49, 0x9f, 0x67,
+	0x91, 0xbf, 0x70, 0x5d, 0x6b, 0x87, 0x2b, 0x4b, 0x76, 0x30, 0x7f, 0xca, 0xa2, 0x53, 0xe3, 0x99,
+	0x3c, 0x8b, 0xdc, 0xf7, 0xde, 0xbb, 0x4b, 0xfa, 0x0c, 0x72, 0xff, 0x43, 0xcb, 0x9e, 0x01, 0x3f,
+	0x84, 0x7c, 0x01, 0x66, 0x90, 0x9f, 0x82, 0x37, 0xc9, 0x7a, 0x38, 0x95, 0x8a, 0xcb, 0x66, 0x8f,
+	0x86, 0xb3, 0x71, 0xe2, 0xac, 0xc9, 0x95, 0x41, 0xe4, 0xcd, 0xcb, 0x16, 0x25, 0x9d, 0x2c, 0x79,
+	0x94, 0xe8, 0x66, 0x4d, 0x7d, 0xa7, 0x7b, 0xe2, 0x74, 0xbe, 0x5e, 0x1d, 0x1c, 0x6f, 0x78, 0x6d,
+	0xd9, 0xba, 0x42, 0x36, 0x22, 0x59, 0xf8, 0x72, 0xca, 0x9a, 0x65, 0x74, 0xbd, 0xf5, 0x48, 0x16,
+	0xde, 0x94, 0x6d, 0xc5, 0xe4, 0xd3, 0xa5, 0x39, 0x95, 0xe0, 0x4c, 0x81, 0x75, 0x8d, 0x18, 0x42,
+	0xf2, 0x10, 0x94, 0x82, 0xd6, 0x3d, 0x7d, 0x6f, 0x51, 0xa8, 0x4f, 0x25, 0xe4, 0x32, 0x10, 0x02,
+	0xa2, 0x66, 0x8a, 0xbe, 0xb7, 0x28, 0x58, 0x5f, 0x9c, 0x95, 0xfa, 0x5e, 0xa1, 0xf3, 0x27, 0x7e,
+	0x31, 0xa3, 0xf8, 0xe5, 0x8c, 0xe2, 0x57, 0x33, 0x8a, 0xde, 0xcc, 0x28, 0x3a, 0x9e, 0x51, 0xf4,
+	0x76, 0x46, 0xd1, 0xbb, 0x19, 0xc5, 0x87, 0x25, 0xc5, 0x8f, 0x4b, 0x8a, 0x9e, 0x96, 0x14, 0x3f,
+	0x2b, 0x29, 0x3a, 0x2a, 0x29, 0x7a, 0x5e, 0x52, 0xf4, 0xa2, 0xa4, 0xf8, 0x65, 0x49, 0xf1, 0xab,
+	0x92, 0xa2, 0x37, 0x25, 0xc5, 0xc7, 0x25, 0x45, 0x6f, 0x4b, 0x8a, 0xdf, 0x95, 0x14, 0x1d, 0x56,
+	0x14, 0x3d, 0xae, 0x28, 0xfe, 0xbd, 0xa2, 0xe8, 0x8f, 0x8a, 0xe2, 0x27, 0x15, 0x45, 0x4f, 0x2b,
+	0x8a, 0x9e, 0x55, 0x14, 0x1f, 0x55, 0x14, 0x3f, 0xaf, 0x28, 0xfe, 0xf9, 0x9b, 0x8b, 0x7e, 0x31,
+	0x9a, 0x89, 0xc9, 0x64, 0xbd, 0xf9, 0xb8, 0x6e, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xdf,
+	0x65, 0x36, 0x5a, 0x05, 0x00, 0x00,
 }
 
 func (this *KeyEnvelope) Equal(that interface{}) bool {
@@ -398,6 +564,72 @@ func (this *SessionKeys) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RotateKEKRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RotateKEKRequest)
+	if !ok {
+		that2, ok := that.(RotateKEKRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OldKEKLabel != that1.OldKEKLabel {
+		return false
+	}
+	if this.NewKEKLabel != that1.NewKEKLabel {
+		return false
+	}
+	if this.Cursor != that1.Cursor {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.DryRun != that1.DryRun {
+		return false
+	}
+	return true
+}
+func (this *RotateKEKResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RotateKEKResponse)
+	if !ok {
+		that2, ok := that.(RotateKEKResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Processed != that1.Processed {
+		return false
+	}
+	if this.Rewrapped != that1.Rewrapped {
+		return false
+	}
+	if this.Cursor != that1.Cursor {
+		return false
+	}
+	return true
+}
 func (m *KeyEnvelope) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -579,6 +811,105 @@ func (m *SessionKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RotateKEKRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateKEKRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateKEKRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Limit != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewKEKLabel) > 0 {
+		i -= len(m.NewKEKLabel)
+		copy(dAtA[i:], m.NewKEKLabel)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.NewKEKLabel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldKEKLabel) > 0 {
+		i -= len(m.OldKEKLabel)
+		copy(dAtA[i:], m.OldKEKLabel)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.OldKEKLabel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RotateKEKResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateKEKResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RotateKEKResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Rewrapped != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Rewrapped))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Processed != 0 {
+		i = encodeVarintKeys(dAtA, i, uint64(m.Processed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintKeys(dAtA []byte, offset int, v uint64) int {
 	offset -= sovKeys(v)
 	base := offset
@@ -618,6 +949,28 @@ func NewPopulatedRootKeys(r randyKeys, easy bool) *RootKeys {
 	return this
 }
 
+func NewPopulatedRotateKEKRequest(r randyKeys, easy bool) *RotateKEKRequest {
+	this := &RotateKEKRequest{}
+	this.OldKEKLabel = randStringKeys(r)
+	this.NewKEKLabel = randStringKeys(r)
+	this.Cursor = randStringKeys(r)
+	this.Limit = r.Uint32()
+	this.DryRun = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRotateKEKResponse(r randyKeys, easy bool) *RotateKEKResponse {
+	this := &RotateKEKResponse{}
+	this.Processed = r.Uint32()
+	this.Rewrapped = r.Uint32()
+	this.Cursor = randStringKeys(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyKeys interface {
 	Float32() float32
 	Float64() float64
@@ -761,6 +1114,52 @@ func (m *SessionKeys) Size() (n int) {
 	return n
 }
 
+func (m *RotateKEKRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldKEKLabel)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.NewKEKLabel)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovKeys(uint64(m.Limit))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *RotateKEKResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Processed != 0 {
+		n += 1 + sovKeys(uint64(m.Processed))
+	}
+	if m.Rewrapped != 0 {
+		n += 1 + sovKeys(uint64(m.Rewrapped))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	return n
+}
+
 func sovKeys(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -805,6 +1204,32 @@ func (this *SessionKeys) String() string {
 	}, "")
 	return s
 }
+func (this *RotateKEKRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RotateKEKRequest{`,
+		`OldKEKLabel:` + fmt.Sprintf("%v", this.OldKEKLabel) + `,`,
+		`NewKEKLabel:` + fmt.Sprintf("%v", this.NewKEKLabel) + `,`,
+		`Cursor:` + fmt.Sprintf("%v", this.Cursor) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RotateKEKResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RotateKEKResponse{`,
+		`Processed:` + fmt.Sprintf("%v", this.Processed) + `,`,
+		`Rewrapped:` + fmt.Sprintf("%v", this.Rewrapped) + `,`,
+		`Cursor:` + fmt.Sprintf("%v", this.Cursor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringKeys(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1355,6 +1780,317 @@ func (m *SessionKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RotateKEKRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateKEKRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateKEKRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldKEKLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldKEKLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKEKLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewKEKLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotateKEKResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateKEKResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateKEKResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processed", wireType)
+			}
+			m.Processed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Processed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewrapped", wireType)
+			}
+			m.Rewrapped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rewrapped |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipKeys(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
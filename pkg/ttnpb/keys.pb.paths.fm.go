@@ -57,3 +57,31 @@ var SessionKeysFieldPathsTopLevel = []string{
 	"s_nwk_s_int_key",
 	"session_key_id",
 }
+
+var RotateKEKRequestFieldPathsNested = []string{
+	"cursor",
+	"dry_run",
+	"limit",
+	"new_kek_label",
+	"old_kek_label",
+}
+
+var RotateKEKRequestFieldPathsTopLevel = []string{
+	"cursor",
+	"dry_run",
+	"limit",
+	"new_kek_label",
+	"old_kek_label",
+}
+
+var RotateKEKResponseFieldPathsNested = []string{
+	"cursor",
+	"processed",
+	"rewrapped",
+}
+
+var RotateKEKResponseFieldPathsTopLevel = []string{
+	"cursor",
+	"processed",
+	"rewrapped",
+}
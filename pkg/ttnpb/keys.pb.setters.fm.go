@@ -233,3 +233,105 @@ func (dst *SessionKeys) SetFields(src *SessionKeys, paths ...string) error {
 	}
 	return nil
 }
+
+func (dst *RotateKEKRequest) SetFields(src *RotateKEKRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "old_kek_label":
+			if len(subs) > 0 {
+				return fmt.Errorf("'old_kek_label' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.OldKEKLabel = src.OldKEKLabel
+			} else {
+				var zero string
+				dst.OldKEKLabel = zero
+			}
+		case "new_kek_label":
+			if len(subs) > 0 {
+				return fmt.Errorf("'new_kek_label' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NewKEKLabel = src.NewKEKLabel
+			} else {
+				var zero string
+				dst.NewKEKLabel = zero
+			}
+		case "cursor":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cursor' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Cursor = src.Cursor
+			} else {
+				var zero string
+				dst.Cursor = zero
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "dry_run":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dry_run' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DryRun = src.DryRun
+			} else {
+				var zero bool
+				dst.DryRun = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RotateKEKResponse) SetFields(src *RotateKEKResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "processed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'processed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Processed = src.Processed
+			} else {
+				var zero uint32
+				dst.Processed = zero
+			}
+		case "rewrapped":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rewrapped' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rewrapped = src.Rewrapped
+			} else {
+				var zero uint32
+				dst.Rewrapped = zero
+			}
+		case "cursor":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cursor' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Cursor = src.Cursor
+			} else {
+				var zero string
+				dst.Cursor = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
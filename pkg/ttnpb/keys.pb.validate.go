@@ -374,3 +374,209 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SessionKeysValidationError{}
+
+// ValidateFields checks the field values on RotateKEKRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RotateKEKRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RotateKEKRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "old_kek_label":
+
+			if utf8.RuneCountInString(m.GetOldKEKLabel()) > 2048 {
+				return RotateKEKRequestValidationError{
+					field:  "old_kek_label",
+					reason: "value length must be at most 2048 runes",
+				}
+			}
+
+		case "new_kek_label":
+
+			if utf8.RuneCountInString(m.GetNewKEKLabel()) > 2048 {
+				return RotateKEKRequestValidationError{
+					field:  "new_kek_label",
+					reason: "value length must be at most 2048 runes",
+				}
+			}
+
+		case "cursor":
+
+			if utf8.RuneCountInString(m.GetCursor()) > 100 {
+				return RotateKEKRequestValidationError{
+					field:  "cursor",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return RotateKEKRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "dry_run":
+			// no validation rules for DryRun
+		default:
+			return RotateKEKRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RotateKEKRequestValidationError is the validation error returned by
+// RotateKEKRequest.ValidateFields if the designated constraints aren't met.
+type RotateKEKRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateKEKRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateKEKRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateKEKRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateKEKRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateKEKRequestValidationError) ErrorName() string {
+	return "RotateKEKRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateKEKRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateKEKRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateKEKRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateKEKRequestValidationError{}
+
+// ValidateFields checks the field values on RotateKEKResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RotateKEKResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RotateKEKResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "processed":
+			// no validation rules for Processed
+		case "rewrapped":
+			// no validation rules for Rewrapped
+		case "cursor":
+			// no validation rules for Cursor
+		default:
+			return RotateKEKResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RotateKEKResponseValidationError is the validation error returned by
+// RotateKEKResponse.ValidateFields if the designated constraints aren't met.
+type RotateKEKResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateKEKResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateKEKResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateKEKResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateKEKResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateKEKResponseValidationError) ErrorName() string {
+	return "RotateKEKResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateKEKResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateKEKResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateKEKResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateKEKResponseValidationError{}
@@ -75,9 +75,11 @@ var ProcessDownlinkMessageRequestFieldPathsNested = []string{
 	"message.confirmed",
 	"message.correlation_ids",
 	"message.decoded_payload",
+	"message.expires_at",
 	"message.f_cnt",
 	"message.f_port",
 	"message.frm_payload",
+	"message.not_before",
 	"message.priority",
 	"message.session_key_id",
 	"parameter",
@@ -716,10 +716,18 @@ type ApplicationDownlink struct {
 	// If not set, this downlink message may be transmitted in class A, B and C.
 	ClassBC *ApplicationDownlink_ClassBC `protobuf:"bytes,7,opt,name=class_b_c,json=classBC,proto3" json:"class_b_c,omitempty"`
 	// Priority for scheduling the downlink message.
-	Priority             TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
-	CorrelationIDs       []string           `protobuf:"bytes,9,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Priority       TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
+	CorrelationIDs []string           `protobuf:"bytes,9,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	// Time after which the downlink message expires.
+	// If the downlink message is not transmitted before this time, it is dropped from the queue and the downlink message fails.
+	// If null, the downlink message does not expire.
+	ExpiresAt *time.Time `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// Time before which the downlink message must not be transmitted.
+	// Downlink messages queued after this downlink message are not transmitted before this downlink message.
+	// If null, the downlink message may be transmitted in the first available slot.
+	NotBefore            *time.Time `protobuf:"bytes,11,opt,name=not_before,json=notBefore,proto3,stdtime" json:"not_before,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *ApplicationDownlink) Reset()      { *m = ApplicationDownlink{} }
@@ -817,6 +825,20 @@ func (m *ApplicationDownlink) GetCorrelationIDs() []string {
 	return nil
 }
 
+func (m *ApplicationDownlink) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *ApplicationDownlink) GetNotBefore() *time.Time {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
 type ApplicationDownlink_ClassBC struct {
 	// Possible gateway identifiers and antenna index to use for this downlink message.
 	// The Network Server selects one of these gateways for downlink, based on connectivity, signal quality, channel utilization and an available slot.
@@ -1382,142 +1404,144 @@ func init() {
 }
 
 var fileDescriptor_bbc6bff5780bdc9d = []byte{
-	// 2152 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4b, 0x6c, 0x1b, 0xc7,
-	0xf9, 0xdf, 0xe1, 0x9b, 0xc3, 0x87, 0x36, 0x13, 0x25, 0x7f, 0x46, 0x7f, 0x67, 0xa9, 0xd2, 0x4e,
-	0x23, 0xbb, 0x16, 0xd5, 0xca, 0x2d, 0xea, 0xba, 0x68, 0x13, 0x2e, 0xb5, 0xb2, 0x68, 0xc9, 0x24,
-	0x3d, 0xa4, 0x13, 0xbb, 0x69, 0xba, 0x58, 0x71, 0x47, 0xf4, 0x46, 0xd4, 0xee, 0x66, 0x77, 0xa8,
-	0x47, 0x8a, 0x02, 0x6e, 0x4e, 0x41, 0x4f, 0x46, 0x80, 0x16, 0x45, 0x81, 0x16, 0x41, 0x7b, 0xc9,
-	0xa1, 0x40, 0x7d, 0x34, 0x7a, 0x28, 0x72, 0xab, 0x8f, 0x3e, 0x06, 0x3d, 0xa8, 0x16, 0x75, 0xc9,
-	0x31, 0x47, 0x43, 0x97, 0x14, 0x3b, 0xbb, 0x4b, 0xee, 0x52, 0xac, 0x2d, 0x2b, 0xed, 0xa9, 0x27,
-	0x71, 0xe7, 0xfb, 0x7d, 0xbf, 0xf9, 0x66, 0xbe, 0xe7, 0x08, 0xce, 0xf6, 0x0c, 0x4b, 0xd9, 0x51,
-	0xf4, 0x79, 0x9b, 0x2a, 0x9d, 0xcd, 0x05, 0xc5, 0xd4, 0x16, 0xb6, 0x88, 0x6d, 0x2b, 0x5d, 0x62,
-	0x97, 0x4d, 0xcb, 0xa0, 0x06, 0xca, 0x53, 0xaa, 0x97, 0x3d, 0x54, 0x79, 0xfb, 0xd2, 0x4c, 0xa5,
-	0xab, 0xd1, 0x3b, 0xfd, 0xf5, 0x72, 0xc7, 0xd8, 0x5a, 0x20, 0xfa, 0xb6, 0xb1, 0x67, 0x5a, 0xc6,
-	0xee, 0xde, 0x02, 0x03, 0x77, 0xe6, 0xbb, 0x44, 0x9f, 0xdf, 0x56, 0x7a, 0x9a, 0xaa, 0x50, 0xb2,
-	0x70, 0xec, 0x87, 0x4b, 0x39, 0x33, 0x1f, 0xa0, 0xe8, 0x1a, 0x5d, 0xc3, 0x55, 0x5e, 0xef, 0x6f,
-	0xb0, 0x2f, 0xf6, 0xc1, 0x7e, 0x79, 0xf0, 0x33, 0x5d, 0xc3, 0xe8, 0xf6, 0xc8, 0x08, 0x65, 0x53,
-	0xab, 0xdf, 0xa1, 0x9e, 0xb4, 0x38, 0x2e, 0xa5, 0xda, 0x16, 0xb1, 0xa9, 0xb2, 0x65, 0x7a, 0x80,
-	0x57, 0x8f, 0x1f, 0x91, 0x58, 0x96, 0x61, 0x79, 0xe2, 0xb3, 0xc7, 0xc5, 0x9a, 0x4a, 0x74, 0xaa,
-	0x6d, 0x68, 0xc4, 0xb2, 0x7d, 0x13, 0x8e, 0x83, 0x36, 0xc9, 0x9e, 0x2f, 0x2d, 0x1e, 0x97, 0xfa,
-	0x17, 0xe6, 0x02, 0x26, 0xde, 0x32, 0x55, 0x54, 0x85, 0x2a, 0x2e, 0xa2, 0xf4, 0xb7, 0x28, 0xcc,
-	0xdd, 0x34, 0x7b, 0x9a, 0xbe, 0x79, 0xdd, 0xbd, 0x7e, 0x54, 0x84, 0x19, 0x4b, 0xd9, 0x91, 0x4d,
-	0x65, 0xaf, 0x67, 0x28, 0x6a, 0x01, 0xcc, 0x82, 0xb9, 0x2c, 0x86, 0x96, 0xb2, 0xd3, 0x74, 0x57,
-	0xd0, 0x77, 0x60, 0xd2, 0x17, 0x46, 0x66, 0xc1, 0x5c, 0x66, 0xf1, 0xff, 0xca, 0x61, 0x57, 0x95,
-	0x3d, 0x2a, 0xec, 0xe3, 0xd0, 0x12, 0x4c, 0xd9, 0x84, 0x52, 0x4d, 0xef, 0xda, 0x85, 0x18, 0xd3,
-	0x99, 0x19, 0xd7, 0x69, 0xef, 0xb6, 0x3c, 0x84, 0x98, 0x3d, 0x12, 0xe3, 0xbf, 0x02, 0x11, 0x1e,
-	0x3c, 0xdc, 0x2f, 0x72, 0x78, 0xa8, 0x89, 0x7e, 0x08, 0x33, 0xd6, 0xae, 0xec, 0x1f, 0xa0, 0x10,
-	0x9f, 0x8d, 0x4e, 0x22, 0xc2, 0xbb, 0xd7, 0x3d, 0x04, 0x86, 0xd6, 0xf0, 0x37, 0x92, 0x60, 0xc6,
-	0x22, 0x1d, 0xa2, 0x6d, 0x13, 0x55, 0x56, 0x68, 0x21, 0xe1, 0x59, 0xe1, 0x3a, 0xb1, 0xec, 0x3b,
-	0xb1, 0xdc, 0xf6, 0x9d, 0x28, 0xa6, 0x9c, 0xdd, 0xef, 0xfd, 0xb3, 0x08, 0x30, 0xf4, 0x15, 0x2b,
-	0x14, 0x5d, 0x85, 0x53, 0x1d, 0xc3, 0xb2, 0x48, 0x4f, 0xa1, 0x9a, 0xa1, 0xcb, 0x9a, 0x6a, 0x17,
-	0x92, 0xb3, 0xd1, 0xb9, 0xb4, 0x28, 0x1c, 0x89, 0xe9, 0x8f, 0x41, 0xa2, 0x14, 0xb3, 0x22, 0x05,
-	0x75, 0xb0, 0x5f, 0xcc, 0x57, 0x47, 0xb0, 0xda, 0x92, 0x8d, 0xf3, 0x01, 0xb5, 0x9a, 0x6a, 0xa3,
-	0x2b, 0x70, 0x5a, 0x25, 0xdb, 0x5a, 0x87, 0xc8, 0x9d, 0x3b, 0x8a, 0xae, 0x93, 0x9e, 0xac, 0xe9,
-	0x2a, 0xd9, 0x2d, 0xa4, 0x67, 0xc1, 0x5c, 0x4e, 0x4c, 0x1d, 0x89, 0xf1, 0x0b, 0xd1, 0xc2, 0x57,
-	0x00, 0x23, 0x17, 0x55, 0x75, 0x41, 0x35, 0x07, 0x73, 0x25, 0xf6, 0xe0, 0x93, 0x22, 0x77, 0x2d,
-	0x96, 0x4a, 0xf1, 0xe9, 0xd2, 0x6f, 0xa2, 0x70, 0x6a, 0xc9, 0xd8, 0xd1, 0xff, 0xdb, 0x2e, 0xfc,
-	0x29, 0xcc, 0x13, 0x5d, 0x95, 0x3d, 0x9b, 0x9d, 0x73, 0x47, 0x99, 0xe6, 0xb9, 0x71, 0x4d, 0x49,
-	0x57, 0x97, 0x18, 0xa8, 0x36, 0x8a, 0x66, 0x91, 0x1f, 0xec, 0x17, 0xb3, 0x23, 0xc9, 0x92, 0x8d,
-	0xb3, 0x64, 0x84, 0xb3, 0xd1, 0xf7, 0x60, 0xd2, 0x22, 0xef, 0xf7, 0x89, 0x4d, 0xbd, 0xf8, 0x78,
-	0xe5, 0x78, 0x7c, 0x60, 0x17, 0xb0, 0xc2, 0x61, 0x1f, 0x8b, 0xae, 0xc0, 0xb4, 0xdd, 0xb9, 0x43,
-	0xd4, 0x7e, 0x8f, 0xa8, 0x85, 0xf8, 0xb3, 0x02, 0x6b, 0x85, 0xc3, 0x23, 0xf8, 0x24, 0x4f, 0x26,
-	0x4e, 0xe3, 0x49, 0xd7, 0x1b, 0xe2, 0xd4, 0x28, 0xc4, 0x51, 0xf4, 0x89, 0x08, 0x4a, 0x7f, 0x8f,
-	0x40, 0xbe, 0xbd, 0x5b, 0xe9, 0x6c, 0xea, 0xc6, 0x4e, 0x8f, 0xa8, 0xdd, 0x2d, 0xa2, 0x4f, 0x0c,
-	0x1f, 0x70, 0xaa, 0xf0, 0xa9, 0xc1, 0x84, 0x45, 0xec, 0x7e, 0x8f, 0x32, 0x07, 0xe6, 0x17, 0x5f,
-	0x3f, 0x7e, 0xec, 0xf0, 0xd6, 0x65, 0xcc, 0xe0, 0x2c, 0xb2, 0x3e, 0x74, 0x92, 0x0b, 0x7b, 0x04,
-	0xa5, 0x3f, 0x00, 0x98, 0x70, 0x85, 0x28, 0x03, 0x93, 0xad, 0x9b, 0xd5, 0xaa, 0xd4, 0x6a, 0xf1,
-	0x1c, 0x7a, 0x01, 0xe6, 0x6e, 0xd6, 0x57, 0xeb, 0x8d, 0xb7, 0xeb, 0xb2, 0x84, 0x71, 0x03, 0xf3,
-	0x00, 0x65, 0x61, 0xaa, 0xdd, 0x68, 0xc8, 0x6b, 0x95, 0xb6, 0xc4, 0x47, 0x50, 0x0e, 0xa6, 0x9d,
-	0x2f, 0xa9, 0x82, 0xd7, 0x6e, 0xf3, 0x51, 0x34, 0x0d, 0xf9, 0x6a, 0x63, 0x6d, 0xad, 0xd6, 0xaa,
-	0x35, 0xea, 0x72, 0xb3, 0x52, 0x5d, 0x95, 0xda, 0x7c, 0x2c, 0xbc, 0x2a, 0x4a, 0x95, 0x6a, 0xa3,
-	0xce, 0xc7, 0x9d, 0x8d, 0xda, 0xb7, 0xe4, 0x65, 0x2c, 0xdd, 0xe0, 0x13, 0x8c, 0xf5, 0x96, 0xdc,
-	0x6c, 0xbc, 0x2d, 0x61, 0x3e, 0x89, 0x78, 0x98, 0xbd, 0xda, 0x6c, 0xc9, 0x37, 0xeb, 0x6b, 0x8d,
-	0xea, 0xaa, 0xb4, 0xc4, 0xa7, 0x4a, 0x1f, 0x02, 0x38, 0x7d, 0x55, 0xa1, 0x64, 0x47, 0xd9, 0x0b,
-	0x97, 0x2a, 0x09, 0x26, 0xbd, 0xa6, 0xc1, 0x62, 0x3c, 0xb3, 0xf8, 0xea, 0xf8, 0x2d, 0x84, 0xf0,
-	0xa3, 0xc2, 0xf2, 0x68, 0xbf, 0x08, 0xb0, 0xaf, 0x8b, 0xce, 0xc2, 0xe4, 0xba, 0xa2, 0xab, 0xb2,
-	0xe6, 0x66, 0x43, 0x5a, 0x84, 0x83, 0xfd, 0x62, 0x42, 0x54, 0x74, 0xb5, 0xb6, 0x84, 0x13, 0x8e,
-	0xa8, 0xa6, 0x96, 0x1e, 0xc4, 0xe0, 0x0b, 0x15, 0xd3, 0xec, 0x69, 0x1d, 0xe6, 0x03, 0x97, 0x18,
-	0xfd, 0x18, 0xe6, 0x6d, 0x62, 0xdb, 0x8e, 0x2f, 0x37, 0xc9, 0x9e, 0xc3, 0xc0, 0x92, 0x4d, 0x2c,
-	0x1c, 0x89, 0xf1, 0x0f, 0xa2, 0x85, 0xbb, 0x2c, 0xee, 0x5b, 0x2e, 0x62, 0x95, 0xec, 0xd5, 0x96,
-	0x70, 0xd6, 0x1e, 0x7d, 0xa9, 0xe8, 0x1c, 0x4c, 0x6c, 0xc8, 0xa6, 0x61, 0xb9, 0x6e, 0xcc, 0x89,
-	0xb9, 0x23, 0x11, 0x5e, 0x48, 0x15, 0xbe, 0x02, 0x73, 0xe0, 0xf2, 0x63, 0x80, 0xe3, 0x1b, 0x4d,
-	0xc3, 0xa2, 0xe8, 0x45, 0x18, 0xdf, 0x90, 0x3b, 0x3a, 0x65, 0x29, 0x97, 0xc3, 0xb1, 0x8d, 0xaa,
-	0x4e, 0xd1, 0x02, 0xcc, 0x6c, 0x58, 0x5b, 0xc3, 0x24, 0x8f, 0xb1, 0x7d, 0xf3, 0x83, 0xfd, 0x22,
-	0x5c, 0xc6, 0xd7, 0xbd, 0x44, 0xc7, 0x70, 0xc3, 0xda, 0xf2, 0x93, 0xfe, 0x4d, 0x38, 0xa5, 0x92,
-	0x8e, 0xa1, 0x12, 0x75, 0xa8, 0x14, 0xf7, 0x92, 0x7f, 0xbc, 0x0a, 0xb6, 0x58, 0xa3, 0xc3, 0x79,
-	0x0f, 0xef, 0x33, 0x48, 0xe1, 0x02, 0x9c, 0x78, 0x56, 0x01, 0x66, 0xc1, 0xf6, 0x31, 0x88, 0xa4,
-	0x40, 0xa8, 0x14, 0x07, 0xbb, 0x41, 0xf2, 0xd4, 0xdd, 0x60, 0xac, 0xa0, 0xa7, 0x4e, 0x59, 0xd0,
-	0xbf, 0x0f, 0xd3, 0x8a, 0x69, 0xca, 0xb6, 0xe3, 0x3f, 0x56, 0x7c, 0x33, 0x8b, 0xff, 0x3f, 0x6e,
-	0xcd, 0x2a, 0xd9, 0x93, 0xf4, 0x6d, 0xd2, 0x33, 0x4c, 0x82, 0x93, 0x8a, 0x69, 0xb6, 0x56, 0xc9,
-	0x1e, 0x9a, 0x83, 0x2f, 0xf4, 0x14, 0x9b, 0xca, 0x8a, 0xcc, 0x7c, 0x23, 0xab, 0xc6, 0x8e, 0x5e,
-	0x80, 0xcc, 0x41, 0x39, 0x47, 0x50, 0x59, 0xae, 0xea, 0xd4, 0xa9, 0xcc, 0xa5, 0xbf, 0x46, 0xe0,
-	0x8b, 0x81, 0xd0, 0x59, 0x33, 0xdc, 0xbf, 0xa8, 0x00, 0x93, 0x36, 0xb1, 0x9c, 0x12, 0xc8, 0xa2,
-	0x26, 0x8d, 0xfd, 0x4f, 0xb4, 0x0c, 0x53, 0x3d, 0x0f, 0xe5, 0x15, 0xe8, 0xc2, 0xb8, 0x4d, 0x3e,
-	0x8b, 0xc8, 0x07, 0xef, 0x87, 0x05, 0xf6, 0x50, 0x17, 0xfd, 0x12, 0x40, 0xa8, 0x50, 0x6a, 0x69,
-	0xeb, 0x7d, 0x4a, 0x9c, 0x8a, 0xed, 0x38, 0xec, 0xd2, 0x38, 0xd5, 0x04, 0xdb, 0xca, 0x95, 0xa1,
-	0x96, 0xa4, 0x53, 0x6b, 0x4f, 0xbc, 0x78, 0x24, 0x9e, 0xff, 0x1d, 0xf8, 0x66, 0xe9, 0x9c, 0x55,
-	0x2a, 0x9c, 0x5b, 0x14, 0x7e, 0xf6, 0x8e, 0x32, 0xff, 0xc1, 0xb7, 0xe7, 0x7f, 0xf0, 0xee, 0xdc,
-	0x1b, 0x57, 0xde, 0x99, 0x7f, 0xf7, 0x0d, 0xff, 0xf3, 0xfc, 0xcf, 0x17, 0x2f, 0xfe, 0xe2, 0x1c,
-	0x0e, 0x6c, 0x3a, 0xf3, 0x23, 0x38, 0x35, 0x46, 0x86, 0x78, 0x18, 0x75, 0x6e, 0xdb, 0x3d, 0xb4,
-	0xf3, 0x13, 0x4d, 0xc3, 0xf8, 0xb6, 0xd2, 0xeb, 0x13, 0x37, 0x01, 0xb1, 0xfb, 0x71, 0x25, 0x72,
-	0x19, 0x94, 0xfe, 0x11, 0x81, 0x2f, 0x05, 0x0c, 0xbc, 0x66, 0x68, 0x7a, 0xa5, 0xd3, 0x21, 0x26,
-	0xfd, 0xda, 0xb9, 0x17, 0xf2, 0x7c, 0xe4, 0x39, 0x3c, 0x7f, 0x0b, 0xbe, 0xa4, 0xe9, 0xfe, 0x68,
-	0xa9, 0x32, 0xc7, 0x3b, 0xc5, 0xc0, 0xbf, 0xdf, 0xb3, 0x4f, 0xb9, 0x5f, 0xbf, 0x53, 0xe3, 0xe9,
-	0x00, 0x83, 0xbf, 0x68, 0xa3, 0xd7, 0xe1, 0x94, 0x49, 0x74, 0x55, 0xd3, 0xbb, 0xb2, 0x67, 0x2a,
-	0xcb, 0xeb, 0x14, 0xce, 0x7b, 0xcb, 0xde, 0x71, 0xfe, 0x43, 0xc1, 0x5f, 0xfa, 0x53, 0x3c, 0x14,
-	0x99, 0xbe, 0x21, 0xff, 0x63, 0x65, 0xed, 0x0c, 0x4c, 0x77, 0x0c, 0x7d, 0x43, 0xb3, 0xb6, 0x88,
-	0xca, 0x06, 0xc3, 0x14, 0x1e, 0x2d, 0xa0, 0xab, 0x30, 0xdd, 0xe9, 0x29, 0xb6, 0x2d, 0xaf, 0xcb,
-	0x1d, 0xaf, 0x5c, 0x7d, 0xeb, 0x04, 0x1e, 0x2e, 0x57, 0x1d, 0x25, 0xb1, 0x8a, 0x93, 0x1d, 0xf7,
-	0x07, 0x5a, 0x81, 0x29, 0xd3, 0xd2, 0x0c, 0x4b, 0xa3, 0x7b, 0xcc, 0x61, 0xf9, 0xc5, 0xd2, 0x84,
-	0xb2, 0xe7, 0xcd, 0x27, 0x4d, 0x0f, 0x19, 0xe8, 0xd7, 0x43, 0xed, 0x49, 0x53, 0x44, 0xfa, 0x34,
-	0x53, 0xc4, 0xcc, 0xef, 0x01, 0x4c, 0x7a, 0x76, 0xa2, 0x55, 0x98, 0xea, 0xba, 0x4d, 0xd6, 0x1d,
-	0x69, 0x33, 0x8b, 0xe7, 0xc7, 0xcd, 0xf3, 0x9a, 0x70, 0x45, 0xa7, 0x44, 0xd7, 0x95, 0xe0, 0x7c,
-	0x17, 0x73, 0x8b, 0xb3, 0x4f, 0x80, 0x24, 0x98, 0x53, 0xd6, 0x6d, 0xa3, 0xd7, 0xa7, 0x44, 0x76,
-	0xde, 0x45, 0x27, 0x88, 0xd0, 0x18, 0x8b, 0xce, 0xac, 0xaf, 0xe6, 0x08, 0xdc, 0xd1, 0xaa, 0x74,
-	0x1b, 0x4e, 0x4f, 0xb8, 0x60, 0x1b, 0x55, 0x60, 0x7a, 0x94, 0x7b, 0xe0, 0xe4, 0xb9, 0x37, 0xd2,
-	0x2a, 0xdd, 0x07, 0xf0, 0x95, 0x09, 0x90, 0x65, 0x45, 0x73, 0x46, 0xc4, 0x1b, 0x30, 0xe5, 0x43,
-	0xbd, 0x01, 0xe3, 0x24, 0xfc, 0x93, 0x2a, 0xb2, 0x4f, 0x83, 0xde, 0x84, 0x71, 0xf6, 0x08, 0xf4,
-	0x0a, 0xce, 0x99, 0x63, 0xd3, 0xb3, 0x23, 0x5c, 0x22, 0x54, 0xd1, 0x7a, 0xe3, 0xad, 0xcf, 0x55,
-	0x2c, 0xfd, 0x1a, 0xc0, 0x62, 0x60, 0xd7, 0xda, 0xa4, 0x3a, 0xb2, 0x7a, 0xba, 0x9b, 0x09, 0xf4,
-	0xeb, 0x91, 0x3e, 0x7a, 0x0d, 0x4e, 0xb1, 0x46, 0x17, 0x68, 0x73, 0x2c, 0xab, 0x71, 0xd6, 0x59,
-	0x1e, 0x76, 0xb9, 0xc3, 0x24, 0xcc, 0x85, 0x06, 0xa4, 0x09, 0x4f, 0x06, 0xf0, 0x3c, 0x4f, 0x86,
-	0x63, 0xb7, 0x18, 0x7e, 0x32, 0x4c, 0x48, 0x82, 0xc8, 0xa9, 0x46, 0xe9, 0x4a, 0xb8, 0x96, 0x66,
-	0x4f, 0x18, 0xa9, 0xc1, 0x21, 0xe2, 0x1a, 0xcc, 0xf7, 0xd9, 0x40, 0x28, 0xfb, 0xf3, 0xa8, 0xfb,
-	0x38, 0xfa, 0xc6, 0x53, 0x2e, 0xdd, 0x9d, 0x20, 0x57, 0x38, 0x9c, 0xeb, 0x87, 0x86, 0xda, 0x15,
-	0x98, 0x79, 0xcf, 0xd0, 0x74, 0x59, 0x61, 0x5d, 0xce, 0x7b, 0x0e, 0xbd, 0xf6, 0x14, 0xa2, 0x51,
-	0x4b, 0x5c, 0xe1, 0x30, 0x7c, 0x6f, 0xd4, 0x20, 0x57, 0x60, 0xd6, 0xf7, 0xa2, 0xac, 0x74, 0x36,
-	0xbd, 0xb2, 0x78, 0x92, 0x40, 0x58, 0xe1, 0x70, 0xc6, 0x57, 0xad, 0x74, 0x36, 0xd1, 0x35, 0x98,
-	0x1b, 0x32, 0xe9, 0x0e, 0x55, 0xe2, 0x79, 0xa8, 0x86, 0x56, 0xd4, 0x95, 0x31, 0x2e, 0x9b, 0xe8,
-	0xd4, 0xab, 0xa9, 0xcf, 0xcb, 0xd5, 0x72, 0x9e, 0x53, 0x6d, 0x38, 0x35, 0xe4, 0xda, 0x60, 0x39,
-	0xeb, 0x15, 0x9a, 0xf3, 0x27, 0x60, 0x73, 0x93, 0x7c, 0x85, 0xc3, 0x79, 0x35, 0x9c, 0xf6, 0xf5,
-	0x00, 0xeb, 0xfb, 0x7d, 0xd2, 0x27, 0xaa, 0x37, 0x18, 0x9e, 0xd0, 0xc6, 0x21, 0xdf, 0x0d, 0xa6,
-	0x8c, 0x0c, 0x38, 0x13, 0xe6, 0x93, 0x03, 0xcd, 0x9f, 0x8d, 0x8c, 0x99, 0xc5, 0x85, 0xa7, 0x50,
-	0x4f, 0x4a, 0xf1, 0x15, 0x0e, 0x17, 0x42, 0xdb, 0x04, 0x40, 0xce, 0x01, 0xfc, 0x11, 0x50, 0xb6,
-	0x8d, 0xde, 0x36, 0x51, 0x0b, 0x99, 0x67, 0x1e, 0xc0, 0x1f, 0xfd, 0x9c, 0x03, 0xf8, 0xda, 0x2d,
-	0xa6, 0x2c, 0xa6, 0x61, 0xa4, 0x6f, 0xba, 0xaf, 0xda, 0x3f, 0x47, 0x60, 0xc1, 0x8b, 0x54, 0xaf,
-	0x7d, 0x2e, 0x1b, 0xd6, 0x96, 0x42, 0x29, 0xb1, 0x6c, 0x74, 0x1d, 0x66, 0xfb, 0xa6, 0xbc, 0xe1,
-	0x2f, 0xb0, 0x74, 0xcf, 0x2f, 0xce, 0x8e, 0x6f, 0x3a, 0xae, 0x18, 0xe8, 0x71, 0x99, 0xbe, 0x39,
-	0x5c, 0x46, 0xdf, 0x85, 0x2f, 0x07, 0xe9, 0x64, 0x53, 0xb1, 0x94, 0x2d, 0xe2, 0x10, 0xbb, 0x53,
-	0xe2, 0x74, 0x00, 0xdc, 0xf4, 0x65, 0xe8, 0x06, 0x64, 0xf7, 0x1f, 0x30, 0x23, 0xfa, 0xdc, 0x66,
-	0xb0, 0x08, 0x1d, 0x19, 0x72, 0x19, 0x16, 0xc2, 0x94, 0x01, 0x53, 0x62, 0xcc, 0x94, 0x97, 0x43,
-	0x0a, 0x43, 0x63, 0x4a, 0x7f, 0x01, 0x70, 0x7a, 0x29, 0xe8, 0x26, 0xef, 0x9f, 0x18, 0xa8, 0xfd,
-	0xb5, 0x6a, 0x63, 0xea, 0xdf, 0xd4, 0xc4, 0x50, 0x47, 0x8c, 0x9c, 0xa6, 0x23, 0x5e, 0xb8, 0x07,
-	0x20, 0x3f, 0x7e, 0x33, 0x08, 0xc1, 0xfc, 0x72, 0x03, 0x5f, 0xaf, 0xb4, 0xdb, 0x12, 0x96, 0xeb,
-	0x8d, 0xba, 0xc4, 0x73, 0xa8, 0x00, 0xa7, 0x47, 0x6b, 0x58, 0x6a, 0x36, 0x5a, 0xb5, 0x76, 0x03,
-	0xdf, 0xe6, 0x01, 0x9a, 0x81, 0x2f, 0x8f, 0x24, 0x57, 0x71, 0xb3, 0x2a, 0xb7, 0x24, 0xfc, 0x56,
-	0xad, 0x2a, 0xf1, 0x91, 0xb0, 0xd6, 0xb5, 0xca, 0x5b, 0x95, 0x56, 0x15, 0xd7, 0x9a, 0x6d, 0x3e,
-	0x1a, 0x96, 0x54, 0x2b, 0xb7, 0xa5, 0x7a, 0x5d, 0x5a, 0x6b, 0x36, 0xf9, 0x98, 0xf8, 0x47, 0xf0,
-	0xf0, 0x40, 0x00, 0x8f, 0x0e, 0x04, 0xf0, 0xf9, 0x81, 0xc0, 0x3d, 0x3e, 0x10, 0xb8, 0x2f, 0x0e,
-	0x04, 0xee, 0xcb, 0x03, 0x81, 0x7b, 0x72, 0x20, 0x80, 0xbb, 0x03, 0x01, 0x7c, 0x34, 0x10, 0xb8,
-	0x4f, 0x07, 0x02, 0xb8, 0x3f, 0x10, 0xb8, 0x07, 0x03, 0x81, 0xfb, 0x6c, 0x20, 0x70, 0x0f, 0x07,
-	0x02, 0x78, 0x34, 0x10, 0xc0, 0xe7, 0x03, 0x81, 0x7b, 0x3c, 0x10, 0xc0, 0x17, 0x03, 0x81, 0xfb,
-	0x72, 0x20, 0x80, 0x27, 0x03, 0x81, 0xbb, 0x7b, 0x28, 0x70, 0x1f, 0x1d, 0x0a, 0xe0, 0xde, 0xa1,
-	0xc0, 0xfd, 0xf6, 0x50, 0x00, 0x9f, 0x1c, 0x0a, 0xdc, 0xa7, 0x87, 0x02, 0x77, 0xff, 0x50, 0x00,
-	0x0f, 0x0e, 0x05, 0xf0, 0xd9, 0xa1, 0x00, 0x7e, 0x72, 0xb1, 0x6b, 0x94, 0xe9, 0x1d, 0x42, 0xef,
-	0x38, 0xcf, 0xcd, 0xb2, 0x4e, 0xe8, 0x8e, 0x61, 0x6d, 0x2e, 0x84, 0xff, 0xa3, 0x6a, 0x6e, 0x76,
-	0x17, 0x28, 0xd5, 0xcd, 0xf5, 0xf5, 0x04, 0x6b, 0x14, 0x97, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff,
-	0x0d, 0xbd, 0x72, 0x40, 0xd9, 0x16, 0x00, 0x00,
+	// 2186 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xde, 0x21, 0x29, 0xfe, 0x0c, 0x7f, 0xb4, 0x9e, 0x28, 0xee, 0x46, 0x75, 0x96, 0x2a, 0xed,
+	0x34, 0xb2, 0x6b, 0x51, 0xad, 0xdc, 0xa2, 0xae, 0x8b, 0xd6, 0xe1, 0x52, 0x2b, 0x8b, 0x96, 0x4c,
+	0xd2, 0x43, 0x3a, 0xb1, 0x9b, 0xa6, 0x8b, 0x15, 0x77, 0x48, 0x6f, 0x44, 0xed, 0x6e, 0x76, 0x87,
+	0x92, 0x98, 0xa2, 0x80, 0x9b, 0x53, 0xd0, 0x93, 0x11, 0xa0, 0x45, 0x51, 0xa0, 0x45, 0x50, 0xa0,
+	0x40, 0x0e, 0x05, 0xea, 0xa3, 0xd1, 0x43, 0x91, 0x5b, 0x7d, 0xf4, 0x31, 0xe8, 0x41, 0xb5, 0xa8,
+	0x4b, 0x8e, 0x39, 0x1a, 0xba, 0xa4, 0xd8, 0x3f, 0x72, 0x97, 0x62, 0x6d, 0x59, 0x69, 0x4f, 0x39,
+	0x71, 0x67, 0xde, 0x7b, 0xdf, 0xbc, 0x79, 0xff, 0x43, 0x38, 0xd7, 0xd5, 0x4d, 0x79, 0x47, 0xd6,
+	0x16, 0x2c, 0x2a, 0xb7, 0x36, 0x17, 0x65, 0x43, 0x5d, 0xdc, 0x22, 0x96, 0x25, 0x77, 0x88, 0x55,
+	0x34, 0x4c, 0x9d, 0xea, 0x28, 0x47, 0xa9, 0x56, 0xf4, 0xb8, 0x8a, 0xdb, 0x97, 0x66, 0x4b, 0x1d,
+	0x95, 0xde, 0xed, 0x6d, 0x14, 0x5b, 0xfa, 0xd6, 0x22, 0xd1, 0xb6, 0xf5, 0xbe, 0x61, 0xea, 0xbb,
+	0xfd, 0x45, 0x87, 0xb9, 0xb5, 0xd0, 0x21, 0xda, 0xc2, 0xb6, 0xdc, 0x55, 0x15, 0x99, 0x92, 0xc5,
+	0x23, 0x1f, 0x2e, 0xe4, 0xec, 0x42, 0x00, 0xa2, 0xa3, 0x77, 0x74, 0x57, 0x78, 0xa3, 0xd7, 0x76,
+	0x56, 0xce, 0xc2, 0xf9, 0xf2, 0xd8, 0xcf, 0x74, 0x74, 0xbd, 0xd3, 0x25, 0x23, 0x2e, 0x8b, 0x9a,
+	0xbd, 0x16, 0xf5, 0xa8, 0xf9, 0x71, 0x2a, 0x55, 0xb7, 0x88, 0x45, 0xe5, 0x2d, 0xc3, 0x63, 0x78,
+	0xf5, 0xe8, 0x15, 0x89, 0x69, 0xea, 0xa6, 0x47, 0x3e, 0x7b, 0x94, 0xac, 0x2a, 0x44, 0xa3, 0x6a,
+	0x5b, 0x25, 0xa6, 0xe5, 0xab, 0x70, 0x94, 0x69, 0x93, 0xf4, 0x7d, 0x6a, 0xfe, 0x28, 0xd5, 0x37,
+	0x98, 0xcb, 0x30, 0xd1, 0xca, 0x54, 0x56, 0x64, 0x2a, 0xbb, 0x1c, 0x85, 0x7f, 0x44, 0x61, 0xf6,
+	0x96, 0xd1, 0x55, 0xb5, 0xcd, 0x1b, 0xae, 0xf9, 0x51, 0x1e, 0xa6, 0x4d, 0x79, 0x47, 0x32, 0xe4,
+	0x7e, 0x57, 0x97, 0x15, 0x0e, 0xcc, 0x81, 0xf9, 0x0c, 0x86, 0xa6, 0xbc, 0x53, 0x77, 0x77, 0xd0,
+	0xf7, 0x60, 0xc2, 0x27, 0x46, 0xe6, 0xc0, 0x7c, 0x7a, 0xe9, 0x1b, 0xc5, 0xb0, 0xab, 0x8a, 0x1e,
+	0x14, 0xf6, 0xf9, 0xd0, 0x32, 0x4c, 0x5a, 0x84, 0x52, 0x55, 0xeb, 0x58, 0x5c, 0xcc, 0x91, 0x99,
+	0x1d, 0x97, 0x69, 0xee, 0x36, 0x3c, 0x0e, 0x21, 0x73, 0x28, 0x4c, 0xfd, 0x06, 0x44, 0x58, 0xf0,
+	0x68, 0x2f, 0xcf, 0xe0, 0xa1, 0x24, 0xfa, 0x31, 0x4c, 0x9b, 0xbb, 0x92, 0x7f, 0x01, 0x6e, 0x6a,
+	0x2e, 0x3a, 0x09, 0x08, 0xef, 0xde, 0xf0, 0x38, 0x30, 0x34, 0x87, 0xdf, 0x48, 0x84, 0x69, 0x93,
+	0xb4, 0x88, 0xba, 0x4d, 0x14, 0x49, 0xa6, 0x5c, 0xdc, 0xd3, 0xc2, 0x75, 0x62, 0xd1, 0x77, 0x62,
+	0xb1, 0xe9, 0x3b, 0x51, 0x48, 0xda, 0xa7, 0xdf, 0xff, 0x77, 0x1e, 0x60, 0xe8, 0x0b, 0x96, 0x28,
+	0xba, 0x06, 0xa7, 0x5b, 0xba, 0x69, 0x92, 0xae, 0x4c, 0x55, 0x5d, 0x93, 0x54, 0xc5, 0xe2, 0x12,
+	0x73, 0xd1, 0xf9, 0x94, 0xc0, 0x1f, 0x0a, 0xa9, 0x8f, 0x40, 0xbc, 0x10, 0x33, 0x23, 0x9c, 0x32,
+	0xd8, 0xcb, 0xe7, 0xca, 0x23, 0xb6, 0xca, 0xb2, 0x85, 0x73, 0x01, 0xb1, 0x8a, 0x62, 0xa1, 0x2b,
+	0x70, 0x46, 0x21, 0xdb, 0x6a, 0x8b, 0x48, 0xad, 0xbb, 0xb2, 0xa6, 0x91, 0xae, 0xa4, 0x6a, 0x0a,
+	0xd9, 0xe5, 0x52, 0x73, 0x60, 0x3e, 0x2b, 0x24, 0x0f, 0x85, 0xa9, 0x0b, 0x51, 0xee, 0x4b, 0x80,
+	0x91, 0xcb, 0x55, 0x76, 0x99, 0x2a, 0x36, 0xcf, 0x95, 0xd8, 0xc3, 0x8f, 0xf3, 0xcc, 0xf5, 0x58,
+	0x32, 0xc9, 0xa6, 0x0a, 0xbf, 0x8b, 0xc2, 0xe9, 0x65, 0x7d, 0x47, 0xfb, 0x7f, 0xbb, 0xf0, 0xe7,
+	0x30, 0x47, 0x34, 0x45, 0xf2, 0x74, 0xb6, 0xef, 0x1d, 0x75, 0x24, 0xcf, 0x8d, 0x4b, 0x8a, 0x9a,
+	0xb2, 0xec, 0x30, 0x55, 0x46, 0xd1, 0x2c, 0xb0, 0x83, 0xbd, 0x7c, 0x66, 0x44, 0x59, 0xb6, 0x70,
+	0x86, 0x8c, 0xf8, 0x2c, 0xf4, 0x03, 0x98, 0x30, 0xc9, 0x7b, 0x3d, 0x62, 0x51, 0x2f, 0x3e, 0x5e,
+	0x39, 0x1a, 0x1f, 0xd8, 0x65, 0x58, 0x65, 0xb0, 0xcf, 0x8b, 0xae, 0xc0, 0x94, 0xd5, 0xba, 0x4b,
+	0x94, 0x5e, 0x97, 0x28, 0xdc, 0xd4, 0xf3, 0x02, 0x6b, 0x95, 0xc1, 0x23, 0xf6, 0x49, 0x9e, 0x8c,
+	0x9f, 0xc4, 0x93, 0xae, 0x37, 0x84, 0xe9, 0x51, 0x88, 0xa3, 0xe8, 0x53, 0x01, 0x14, 0xfe, 0x19,
+	0x81, 0x6c, 0x73, 0xb7, 0xd4, 0xda, 0xd4, 0xf4, 0x9d, 0x2e, 0x51, 0x3a, 0x5b, 0x44, 0x9b, 0x18,
+	0x3e, 0xe0, 0x44, 0xe1, 0x53, 0x81, 0x71, 0x93, 0x58, 0xbd, 0x2e, 0x75, 0x1c, 0x98, 0x5b, 0x7a,
+	0xfd, 0xe8, 0xb5, 0xc3, 0x47, 0x17, 0xb1, 0xc3, 0xee, 0x44, 0xd6, 0x07, 0x76, 0x72, 0x61, 0x0f,
+	0xa0, 0xf0, 0x27, 0x00, 0xe3, 0x2e, 0x11, 0xa5, 0x61, 0xa2, 0x71, 0xab, 0x5c, 0x16, 0x1b, 0x0d,
+	0x96, 0x41, 0xa7, 0x60, 0xf6, 0x56, 0x75, 0xad, 0x5a, 0x7b, 0xab, 0x2a, 0x89, 0x18, 0xd7, 0x30,
+	0x0b, 0x50, 0x06, 0x26, 0x9b, 0xb5, 0x9a, 0xb4, 0x5e, 0x6a, 0x8a, 0x6c, 0x04, 0x65, 0x61, 0xca,
+	0x5e, 0x89, 0x25, 0xbc, 0x7e, 0x87, 0x8d, 0xa2, 0x19, 0xc8, 0x96, 0x6b, 0xeb, 0xeb, 0x95, 0x46,
+	0xa5, 0x56, 0x95, 0xea, 0xa5, 0xf2, 0x9a, 0xd8, 0x64, 0x63, 0xe1, 0x5d, 0x41, 0x2c, 0x95, 0x6b,
+	0x55, 0x76, 0xca, 0x3e, 0xa8, 0x79, 0x5b, 0x5a, 0xc1, 0xe2, 0x4d, 0x36, 0xee, 0xa0, 0xde, 0x96,
+	0xea, 0xb5, 0xb7, 0x44, 0xcc, 0x26, 0x10, 0x0b, 0x33, 0xd7, 0xea, 0x0d, 0xe9, 0x56, 0x75, 0xbd,
+	0x56, 0x5e, 0x13, 0x97, 0xd9, 0x64, 0xe1, 0x03, 0x00, 0x67, 0xae, 0xc9, 0x94, 0xec, 0xc8, 0xfd,
+	0x70, 0xa9, 0x12, 0x61, 0xc2, 0x6b, 0x1a, 0x4e, 0x8c, 0xa7, 0x97, 0x5e, 0x1d, 0xb7, 0x42, 0x88,
+	0x7f, 0x54, 0x58, 0x1e, 0xef, 0xe5, 0x01, 0xf6, 0x65, 0xd1, 0x59, 0x98, 0xd8, 0x90, 0x35, 0x45,
+	0x52, 0xdd, 0x6c, 0x48, 0x09, 0x70, 0xb0, 0x97, 0x8f, 0x0b, 0xb2, 0xa6, 0x54, 0x96, 0x71, 0xdc,
+	0x26, 0x55, 0x94, 0xc2, 0xc3, 0x18, 0x3c, 0x55, 0x32, 0x8c, 0xae, 0xda, 0x72, 0x7c, 0xe0, 0x02,
+	0xa3, 0x9f, 0xc2, 0x9c, 0x45, 0x2c, 0xcb, 0xf6, 0xe5, 0x26, 0xe9, 0xdb, 0x08, 0x4e, 0xb2, 0x09,
+	0xdc, 0xa1, 0x30, 0xf5, 0x7e, 0x94, 0xbb, 0xe7, 0xc4, 0x7d, 0xc3, 0xe5, 0x58, 0x23, 0xfd, 0xca,
+	0x32, 0xce, 0x58, 0xa3, 0x95, 0x82, 0xce, 0xc1, 0x78, 0x5b, 0x32, 0x74, 0xd3, 0x75, 0x63, 0x56,
+	0xc8, 0x1e, 0x0a, 0xf0, 0x42, 0x92, 0xfb, 0x12, 0xcc, 0x83, 0xcb, 0x4f, 0x00, 0x9e, 0x6a, 0xd7,
+	0x75, 0x93, 0xa2, 0x97, 0xe0, 0x54, 0x5b, 0x6a, 0x69, 0xd4, 0x49, 0xb9, 0x2c, 0x8e, 0xb5, 0xcb,
+	0x1a, 0x45, 0x8b, 0x30, 0xdd, 0x36, 0xb7, 0x86, 0x49, 0x1e, 0x73, 0xce, 0xcd, 0x0d, 0xf6, 0xf2,
+	0x70, 0x05, 0xdf, 0xf0, 0x12, 0x1d, 0xc3, 0xb6, 0xb9, 0xe5, 0x7d, 0xa3, 0x37, 0xe0, 0xb4, 0x42,
+	0x5a, 0xba, 0x42, 0x94, 0xa1, 0xd0, 0x94, 0x97, 0xfc, 0xe3, 0x55, 0xb0, 0xe1, 0x34, 0x3a, 0x9c,
+	0xf3, 0xf8, 0x7d, 0x04, 0x31, 0x5c, 0x80, 0xe3, 0xcf, 0x2b, 0xc0, 0x4e, 0xb0, 0x7d, 0x04, 0x22,
+	0x49, 0x10, 0x2a, 0xc5, 0xc1, 0x6e, 0x90, 0x38, 0x71, 0x37, 0x18, 0x2b, 0xe8, 0xc9, 0x13, 0x16,
+	0xf4, 0x1f, 0xc2, 0x94, 0x6c, 0x18, 0x92, 0x65, 0xfb, 0xcf, 0x29, 0xbe, 0xe9, 0xa5, 0x6f, 0x8e,
+	0x6b, 0xb3, 0x46, 0xfa, 0xa2, 0xb6, 0x4d, 0xba, 0xba, 0x41, 0x70, 0x42, 0x36, 0x8c, 0xc6, 0x1a,
+	0xe9, 0xa3, 0x79, 0x78, 0xaa, 0x2b, 0x5b, 0x54, 0x92, 0x25, 0xc7, 0x37, 0x92, 0xa2, 0xef, 0x68,
+	0x1c, 0x74, 0x1c, 0x94, 0xb5, 0x09, 0xa5, 0x95, 0xb2, 0x46, 0xed, 0xca, 0x5c, 0xf8, 0x7b, 0x04,
+	0xbe, 0x14, 0x08, 0x9d, 0x75, 0xdd, 0xfd, 0x45, 0x1c, 0x4c, 0x58, 0xc4, 0xb4, 0x4b, 0xa0, 0x13,
+	0x35, 0x29, 0xec, 0x2f, 0xd1, 0x0a, 0x4c, 0x76, 0x3d, 0x2e, 0xaf, 0x40, 0x73, 0xe3, 0x3a, 0xf9,
+	0x28, 0x02, 0x1b, 0xb4, 0x8f, 0x13, 0xd8, 0x43, 0x59, 0xf4, 0x6b, 0x00, 0xa1, 0x4c, 0xa9, 0xa9,
+	0x6e, 0xf4, 0x28, 0xb1, 0x2b, 0xb6, 0xed, 0xb0, 0x4b, 0xe3, 0x50, 0x13, 0x74, 0x2b, 0x96, 0x86,
+	0x52, 0xa2, 0x46, 0xcd, 0xbe, 0x70, 0xf1, 0x50, 0x38, 0xff, 0x07, 0xf0, 0xed, 0xc2, 0x39, 0xb3,
+	0xc0, 0x9d, 0x5b, 0xe2, 0x7f, 0xf1, 0xb6, 0xbc, 0xf0, 0xfe, 0x77, 0x17, 0x7e, 0xf4, 0xce, 0xfc,
+	0xd5, 0x2b, 0x6f, 0x2f, 0xbc, 0x73, 0xd5, 0x5f, 0x9e, 0xff, 0xe5, 0xd2, 0xc5, 0x5f, 0x9d, 0xc3,
+	0x81, 0x43, 0x67, 0x7f, 0x02, 0xa7, 0xc7, 0xc0, 0x10, 0x0b, 0xa3, 0xb6, 0xb5, 0xdd, 0x4b, 0xdb,
+	0x9f, 0x68, 0x06, 0x4e, 0x6d, 0xcb, 0xdd, 0x1e, 0x71, 0x13, 0x10, 0xbb, 0x8b, 0x2b, 0x91, 0xcb,
+	0xa0, 0xf0, 0xaf, 0x08, 0x7c, 0x39, 0xa0, 0xe0, 0x75, 0x5d, 0xd5, 0x4a, 0xad, 0x16, 0x31, 0xe8,
+	0x57, 0xce, 0xbd, 0x90, 0xe7, 0x23, 0x2f, 0xe0, 0xf9, 0xdb, 0xf0, 0x65, 0x55, 0xf3, 0x47, 0x4b,
+	0xc5, 0x71, 0xbc, 0x5d, 0x0c, 0x7c, 0xfb, 0x9e, 0x7d, 0x86, 0x7d, 0xfd, 0x4e, 0x8d, 0x67, 0x02,
+	0x08, 0xfe, 0xa6, 0x85, 0x5e, 0x87, 0xd3, 0x06, 0xd1, 0x14, 0x55, 0xeb, 0x48, 0x9e, 0xaa, 0x4e,
+	0x5e, 0x27, 0x71, 0xce, 0xdb, 0xf6, 0xae, 0xf3, 0x3f, 0x0a, 0xfe, 0xc2, 0x5f, 0xe2, 0xa1, 0xc8,
+	0xf4, 0x15, 0xf9, 0x9a, 0x95, 0xb5, 0x33, 0x30, 0xd5, 0xd2, 0xb5, 0xb6, 0x6a, 0x6e, 0x11, 0xc5,
+	0x19, 0x0c, 0x93, 0x78, 0xb4, 0x81, 0xae, 0xc1, 0x54, 0xab, 0x2b, 0x5b, 0x96, 0xb4, 0x21, 0xb5,
+	0xbc, 0x72, 0xf5, 0x9d, 0x63, 0x78, 0xb8, 0x58, 0xb6, 0x85, 0x84, 0x32, 0x4e, 0xb4, 0xdc, 0x0f,
+	0xb4, 0x0a, 0x93, 0x86, 0xa9, 0xea, 0xa6, 0x4a, 0xfb, 0x8e, 0xc3, 0x72, 0x4b, 0x85, 0x09, 0x65,
+	0xcf, 0x9b, 0x4f, 0xea, 0x1e, 0x67, 0xa0, 0x5f, 0x0f, 0xa5, 0x27, 0x4d, 0x11, 0xa9, 0x13, 0x4d,
+	0x11, 0x57, 0x21, 0x24, 0xbb, 0x86, 0x6a, 0x12, 0xcb, 0x8e, 0x22, 0xf8, 0xdc, 0x28, 0x8a, 0x39,
+	0x11, 0x94, 0xf2, 0x64, 0x4a, 0xd4, 0x06, 0xd0, 0x74, 0x2a, 0x6d, 0x90, 0xb6, 0x6e, 0x12, 0x2e,
+	0x7d, 0x5c, 0x00, 0x4d, 0xa7, 0x82, 0x23, 0x32, 0xfb, 0x47, 0x00, 0x13, 0x9e, 0xa5, 0xd0, 0x1a,
+	0x4c, 0x76, 0xdc, 0x36, 0xef, 0x0e, 0xd5, 0xe9, 0xa5, 0xf3, 0xe3, 0x06, 0xf2, 0xc6, 0x80, 0x92,
+	0x46, 0x89, 0xa6, 0xc9, 0xc1, 0x09, 0x33, 0xe6, 0xb6, 0x07, 0x1f, 0x00, 0x89, 0x30, 0x2b, 0x6f,
+	0x58, 0x7a, 0xb7, 0x47, 0x89, 0x64, 0xbf, 0xcc, 0xb8, 0xe4, 0x31, 0x95, 0xcb, 0xf8, 0x62, 0x36,
+	0xc1, 0x1d, 0xee, 0x0a, 0x77, 0xe0, 0xcc, 0x04, 0x17, 0x5b, 0xa8, 0x04, 0x53, 0xa3, 0xec, 0x07,
+	0xc7, 0xcf, 0xfe, 0x91, 0x54, 0xe1, 0x01, 0x80, 0xaf, 0x4c, 0x60, 0x59, 0x91, 0x55, 0x7b, 0x48,
+	0xbd, 0x09, 0x93, 0x3e, 0xab, 0x37, 0xe2, 0x1c, 0x07, 0x7f, 0x52, 0x4f, 0xf0, 0x61, 0xd0, 0x1b,
+	0x70, 0xca, 0x79, 0x86, 0x7a, 0x25, 0xef, 0xcc, 0x91, 0xf9, 0xdd, 0x26, 0x2e, 0x13, 0x2a, 0xab,
+	0xdd, 0xf1, 0xe6, 0xeb, 0x0a, 0x16, 0x7e, 0x0b, 0x60, 0x3e, 0x70, 0x6a, 0x65, 0x52, 0x25, 0x5b,
+	0x3b, 0x99, 0x65, 0x02, 0x13, 0xc3, 0x48, 0x1e, 0xbd, 0x06, 0xa7, 0x9d, 0x56, 0x1b, 0x68, 0xb4,
+	0x4e, 0x5d, 0xc1, 0x19, 0x7b, 0x7b, 0xd8, 0x67, 0x0f, 0x12, 0x30, 0x1b, 0x1a, 0xd1, 0x26, 0x3c,
+	0x5a, 0xc0, 0x8b, 0x3c, 0x5a, 0x8e, 0x58, 0x31, 0xfc, 0x68, 0x99, 0x90, 0x86, 0x91, 0x13, 0xa5,
+	0x61, 0x29, 0x5c, 0xcd, 0x33, 0xc7, 0x8c, 0xd4, 0xe0, 0x18, 0x73, 0x1d, 0xe6, 0x7a, 0xce, 0x48,
+	0x2a, 0xf9, 0x13, 0xb1, 0xfb, 0x3c, 0xfb, 0xd6, 0x33, 0x8c, 0xee, 0xce, 0xb0, 0xab, 0x0c, 0xce,
+	0xf6, 0x42, 0x63, 0xf5, 0x2a, 0x4c, 0xbf, 0xab, 0xab, 0x9a, 0x24, 0x3b, 0x7d, 0xd6, 0x7b, 0x90,
+	0xbd, 0xf6, 0x0c, 0xa0, 0x51, 0x53, 0x5e, 0x65, 0x30, 0x7c, 0x77, 0xb8, 0x42, 0xab, 0x30, 0xe3,
+	0x7b, 0x51, 0x92, 0x5b, 0x9b, 0x5e, 0x61, 0x3e, 0x4e, 0x20, 0xac, 0x32, 0x38, 0xed, 0x8b, 0x96,
+	0x5a, 0x9b, 0xe8, 0x3a, 0xcc, 0x0e, 0x91, 0x34, 0x1b, 0x2a, 0xfe, 0x22, 0x50, 0x43, 0x2d, 0xaa,
+	0xf2, 0x18, 0x96, 0x45, 0x34, 0xca, 0x25, 0x4e, 0x84, 0xd5, 0xb0, 0x1f, 0x74, 0x4d, 0x38, 0x3d,
+	0xc4, 0x6a, 0x3b, 0x39, 0xeb, 0x15, 0x9a, 0xf3, 0xc7, 0x40, 0x73, 0x93, 0x7c, 0x95, 0xc1, 0x39,
+	0x25, 0x9c, 0xf6, 0xd5, 0x00, 0xea, 0x7b, 0x3d, 0xd2, 0x23, 0x0a, 0x97, 0x7a, 0x11, 0x1d, 0x87,
+	0x78, 0x37, 0x1d, 0x61, 0xa4, 0xc3, 0xd9, 0x30, 0x9e, 0x14, 0x18, 0x3f, 0xbc, 0xba, 0xbf, 0xf8,
+	0x0c, 0xe8, 0x49, 0x29, 0xbe, 0xca, 0x60, 0x2e, 0x74, 0x4c, 0x80, 0xc9, 0xbe, 0x80, 0x3f, 0x84,
+	0x4a, 0x96, 0xde, 0xdd, 0x26, 0x0a, 0x97, 0x7e, 0xee, 0x05, 0xfc, 0xe1, 0xd3, 0xbe, 0x80, 0x2f,
+	0xdd, 0x70, 0x84, 0x85, 0x14, 0x8c, 0xf4, 0x0c, 0xf7, 0x5d, 0xfd, 0xd7, 0x08, 0xe4, 0xbc, 0x48,
+	0xf5, 0x1a, 0xf8, 0x8a, 0x6e, 0x6e, 0xc9, 0x94, 0x12, 0xd3, 0x42, 0x37, 0x60, 0xa6, 0x67, 0x48,
+	0x6d, 0x7f, 0xc3, 0x49, 0xf7, 0xdc, 0xd2, 0xdc, 0xf8, 0xa1, 0xe3, 0x82, 0x81, 0x2e, 0x9b, 0xee,
+	0x19, 0xc3, 0x6d, 0xf4, 0x7d, 0x78, 0x3a, 0x08, 0x27, 0x19, 0xb2, 0x29, 0x6f, 0x11, 0x1b, 0xd8,
+	0x9d, 0x53, 0x67, 0x02, 0xcc, 0x75, 0x9f, 0x86, 0x6e, 0x42, 0xc7, 0xfe, 0x01, 0x35, 0xa2, 0x2f,
+	0xac, 0x86, 0x13, 0xa1, 0x23, 0x45, 0x2e, 0x43, 0x2e, 0x0c, 0x19, 0x50, 0x25, 0xe6, 0xa8, 0x72,
+	0x3a, 0x24, 0x30, 0x54, 0xa6, 0xf0, 0x37, 0x00, 0x67, 0x96, 0x83, 0x6e, 0xf2, 0xfe, 0x46, 0x41,
+	0xcd, 0xaf, 0x54, 0x1b, 0x93, 0xff, 0xa5, 0x26, 0x86, 0x3a, 0x62, 0xe4, 0x24, 0x1d, 0xf1, 0xc2,
+	0x7d, 0x00, 0xd9, 0x71, 0xcb, 0x20, 0x04, 0x73, 0x2b, 0x35, 0x7c, 0xa3, 0xd4, 0x6c, 0x8a, 0x58,
+	0xaa, 0xd6, 0xaa, 0x22, 0xcb, 0x20, 0x0e, 0xce, 0x8c, 0xf6, 0xb0, 0x58, 0xaf, 0x35, 0x2a, 0xcd,
+	0x1a, 0xbe, 0xc3, 0x02, 0x34, 0x0b, 0x4f, 0x8f, 0x28, 0xd7, 0x70, 0xbd, 0x2c, 0x35, 0x44, 0xfc,
+	0x66, 0xa5, 0x6c, 0xff, 0x6b, 0x11, 0x92, 0xba, 0x5e, 0x7a, 0xb3, 0xd4, 0x28, 0xe3, 0x4a, 0xbd,
+	0xc9, 0x46, 0xc3, 0x94, 0x72, 0xe9, 0x8e, 0x58, 0xad, 0x8a, 0xeb, 0xf5, 0x3a, 0x1b, 0x13, 0xfe,
+	0x0c, 0x1e, 0xed, 0xf3, 0xe0, 0xf1, 0x3e, 0x0f, 0x3e, 0xdb, 0xe7, 0x99, 0x27, 0xfb, 0x3c, 0xf3,
+	0xf9, 0x3e, 0xcf, 0x7c, 0xb1, 0xcf, 0x33, 0x4f, 0xf7, 0x79, 0x70, 0x6f, 0xc0, 0x83, 0x0f, 0x07,
+	0x3c, 0xf3, 0xc9, 0x80, 0x07, 0x0f, 0x06, 0x3c, 0xf3, 0x70, 0xc0, 0x33, 0x9f, 0x0e, 0x78, 0xe6,
+	0xd1, 0x80, 0x07, 0x8f, 0x07, 0x3c, 0xf8, 0x6c, 0xc0, 0x33, 0x4f, 0x06, 0x3c, 0xf8, 0x7c, 0xc0,
+	0x33, 0x5f, 0x0c, 0x78, 0xf0, 0x74, 0xc0, 0x33, 0xf7, 0x0e, 0x78, 0xe6, 0xc3, 0x03, 0x1e, 0xdc,
+	0x3f, 0xe0, 0x99, 0xdf, 0x1f, 0xf0, 0xe0, 0xe3, 0x03, 0x9e, 0xf9, 0xe4, 0x80, 0x67, 0x1e, 0x1c,
+	0xf0, 0xe0, 0xe1, 0x01, 0x0f, 0x3e, 0x3d, 0xe0, 0xc1, 0xcf, 0x2e, 0x76, 0xf4, 0x22, 0xbd, 0x4b,
+	0xe8, 0x5d, 0xfb, 0xc1, 0x5b, 0xd4, 0x08, 0xdd, 0xd1, 0xcd, 0xcd, 0xc5, 0xf0, 0x7f, 0xba, 0xc6,
+	0x66, 0x67, 0x91, 0x52, 0xcd, 0xd8, 0xd8, 0x88, 0x3b, 0x8d, 0xe2, 0xd2, 0x7f, 0x02, 0x00, 0x00,
+	0xff, 0xff, 0x09, 0xee, 0x3b, 0x6f, 0x5b, 0x17, 0x00, 0x00,
 }
 
 func (x PayloadFormatter) String() string {
@@ -1923,6 +1947,20 @@ func (this *ApplicationDownlink) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if that1.NotBefore == nil {
+		if this.NotBefore != nil {
+			return false
+		}
+	} else if !this.NotBefore.Equal(*that1.NotBefore) {
+		return false
+	}
 	return true
 }
 func (this *ApplicationDownlink_ClassBC) Equal(that interface{}) bool {
@@ -2920,6 +2958,26 @@ func (m *ApplicationDownlink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NotBefore != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NotBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintMessages(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ExpiresAt != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintMessages(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.CorrelationIDs) > 0 {
 		for iNdEx := len(m.CorrelationIDs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.CorrelationIDs[iNdEx])
@@ -3016,12 +3074,12 @@ func (m *ApplicationDownlink_ClassBC) MarshalToSizedBuffer(dAtA []byte) (int, er
 	var l int
 	_ = l
 	if m.AbsoluteTime != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime):])
-		if err18 != nil {
-			return 0, err18
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n18
-		i = encodeVarintMessages(dAtA, i, uint64(n18))
+		i -= n20
+		i = encodeVarintMessages(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -3185,12 +3243,12 @@ func (m *ApplicationUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.ReceivedAt != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReceivedAt):])
-		if err21 != nil {
-			return 0, err21
+		n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReceivedAt):])
+		if err23 != nil {
+			return 0, err23
 		}
-		i -= n21
-		i = encodeVarintMessages(dAtA, i, uint64(n21))
+		i -= n23
+		i = encodeVarintMessages(dAtA, i, uint64(n23))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -4122,6 +4180,14 @@ func (m *ApplicationDownlink) Size() (n int) {
 			n += 1 + l + sovMessages(uint64(l))
 		}
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.NotBefore != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NotBefore)
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -4524,6 +4590,8 @@ func (this *ApplicationDownlink) String() string {
 		`ClassBC:` + strings.Replace(fmt.Sprintf("%v", this.ClassBC), "ApplicationDownlink_ClassBC", "ApplicationDownlink_ClassBC", 1) + `,`,
 		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`NotBefore:` + strings.Replace(fmt.Sprintf("%v", this.NotBefore), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6569,6 +6637,78 @@ func (m *ApplicationDownlink) Unmarshal(dAtA []byte) error {
 			}
 			m.CorrelationIDs = append(m.CorrelationIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NotBefore == nil {
+				m.NotBefore = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NotBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -346,9 +346,11 @@ var ApplicationDownlinkFieldPathsNested = []string{
 	"confirmed",
 	"correlation_ids",
 	"decoded_payload",
+	"expires_at",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
+	"not_before",
 	"priority",
 	"session_key_id",
 }
@@ -358,9 +360,11 @@ var ApplicationDownlinkFieldPathsTopLevel = []string{
 	"confirmed",
 	"correlation_ids",
 	"decoded_payload",
+	"expires_at",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
+	"not_before",
 	"priority",
 	"session_key_id",
 }
@@ -379,9 +383,11 @@ var ApplicationDownlinkFailedFieldPathsNested = []string{
 	"downlink.confirmed",
 	"downlink.correlation_ids",
 	"downlink.decoded_payload",
+	"downlink.expires_at",
 	"downlink.f_cnt",
 	"downlink.f_port",
 	"downlink.frm_payload",
+	"downlink.not_before",
 	"downlink.priority",
 	"downlink.session_key_id",
 	"error",
@@ -431,9 +437,11 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_ack.confirmed",
 	"up.downlink_ack.correlation_ids",
 	"up.downlink_ack.decoded_payload",
+	"up.downlink_ack.expires_at",
 	"up.downlink_ack.f_cnt",
 	"up.downlink_ack.f_port",
 	"up.downlink_ack.frm_payload",
+	"up.downlink_ack.not_before",
 	"up.downlink_ack.priority",
 	"up.downlink_ack.session_key_id",
 	"up.downlink_failed",
@@ -444,9 +452,11 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_failed.downlink.confirmed",
 	"up.downlink_failed.downlink.correlation_ids",
 	"up.downlink_failed.downlink.decoded_payload",
+	"up.downlink_failed.downlink.expires_at",
 	"up.downlink_failed.downlink.f_cnt",
 	"up.downlink_failed.downlink.f_port",
 	"up.downlink_failed.downlink.frm_payload",
+	"up.downlink_failed.downlink.not_before",
 	"up.downlink_failed.downlink.priority",
 	"up.downlink_failed.downlink.session_key_id",
 	"up.downlink_failed.error",
@@ -470,9 +480,11 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_nack.confirmed",
 	"up.downlink_nack.correlation_ids",
 	"up.downlink_nack.decoded_payload",
+	"up.downlink_nack.expires_at",
 	"up.downlink_nack.f_cnt",
 	"up.downlink_nack.f_port",
 	"up.downlink_nack.frm_payload",
+	"up.downlink_nack.not_before",
 	"up.downlink_nack.priority",
 	"up.downlink_nack.session_key_id",
 	"up.downlink_queue_invalidated",
@@ -485,9 +497,11 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_queued.confirmed",
 	"up.downlink_queued.correlation_ids",
 	"up.downlink_queued.decoded_payload",
+	"up.downlink_queued.expires_at",
 	"up.downlink_queued.f_cnt",
 	"up.downlink_queued.f_port",
 	"up.downlink_queued.frm_payload",
+	"up.downlink_queued.not_before",
 	"up.downlink_queued.priority",
 	"up.downlink_queued.session_key_id",
 	"up.downlink_sent",
@@ -497,9 +511,11 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_sent.confirmed",
 	"up.downlink_sent.correlation_ids",
 	"up.downlink_sent.decoded_payload",
+	"up.downlink_sent.expires_at",
 	"up.downlink_sent.f_cnt",
 	"up.downlink_sent.f_port",
 	"up.downlink_sent.frm_payload",
+	"up.downlink_sent.not_before",
 	"up.downlink_sent.priority",
 	"up.downlink_sent.session_key_id",
 	"up.join_accept",
@@ -706,6 +706,24 @@ func (dst *ApplicationDownlink) SetFields(src *ApplicationDownlink, paths ...str
 			} else {
 				dst.CorrelationIDs = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "not_before":
+			if len(subs) > 0 {
+				return fmt.Errorf("'not_before' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NotBefore = src.NotBefore
+			} else {
+				dst.NotBefore = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
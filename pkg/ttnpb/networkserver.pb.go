@@ -86,52 +86,54 @@ func init() {
 }
 
 var fileDescriptor_c77e7504ad1081b8 = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
+	// 746 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x48, 0x1b, 0x4f,
-	0x14, 0x9e, 0x89, 0xe2, 0xef, 0xc7, 0x20, 0x4a, 0xa7, 0xd2, 0x3f, 0x69, 0x3b, 0x95, 0x68, 0xa9,
-	0x48, 0x9d, 0x2d, 0xb1, 0x87, 0xe2, 0x4d, 0x49, 0x88, 0x05, 0x23, 0x55, 0xeb, 0xc5, 0x4b, 0xd8,
-	0x64, 0x9f, 0x9b, 0x25, 0x71, 0x76, 0xbb, 0x33, 0x89, 0x48, 0x11, 0xa4, 0x87, 0xe2, 0xb1, 0x50,
-	0x0a, 0x3d, 0x96, 0x9e, 0x3c, 0x4a, 0x2f, 0xf5, 0x54, 0x3c, 0x7a, 0x14, 0x7a, 0x91, 0x1e, 0xc4,
-	0xec, 0xf6, 0x20, 0xf4, 0xe2, 0xd1, 0x63, 0xc9, 0x26, 0x31, 0x26, 0x6b, 0xc4, 0xfe, 0xb9, 0xcd,
-	0xe4, 0x7d, 0xef, 0x7d, 0xdf, 0xfb, 0xe6, 0xcb, 0x92, 0x07, 0x45, 0xdb, 0xd5, 0x57, 0x75, 0x31,
-	0x26, 0x95, 0x9e, 0x2b, 0x68, 0xba, 0x63, 0x69, 0x02, 0xd4, 0xaa, 0xed, 0x16, 0x24, 0xb8, 0x65,
-	0x70, 0xb9, 0xe3, 0xda, 0xca, 0xa6, 0x7d, 0x4a, 0x09, 0x5e, 0x87, 0xf2, 0xf2, 0x78, 0x74, 0xcc,
-	0xb4, 0x54, 0xbe, 0x94, 0xe5, 0x39, 0x7b, 0x45, 0x33, 0x6d, 0xd3, 0xd6, 0x02, 0x58, 0xb6, 0xb4,
-	0x1c, 0xdc, 0x82, 0x4b, 0x70, 0xaa, 0xb5, 0x47, 0xef, 0x9a, 0xb6, 0x6d, 0x16, 0x21, 0x18, 0xaf,
-	0x0b, 0x61, 0x2b, 0x5d, 0x59, 0xb6, 0x90, 0xf5, 0xea, 0x9d, 0x7a, 0xf5, 0x6c, 0x06, 0xac, 0x38,
-	0x6a, 0xad, 0x5e, 0x8c, 0x85, 0x05, 0x82, 0x30, 0x32, 0x06, 0x94, 0xad, 0x1c, 0xd4, 0x31, 0x43,
-	0x61, 0x8c, 0x65, 0x80, 0x50, 0xd6, 0xb2, 0x05, 0x6e, 0x83, 0x65, 0x30, 0x0c, 0x5a, 0x01, 0x29,
-	0x75, 0x13, 0xea, 0x88, 0x98, 0x20, 0x37, 0x53, 0x20, 0xc0, 0xd5, 0x15, 0x24, 0xa0, 0x3c, 0x69,
-	0x18, 0xee, 0x3c, 0x48, 0xc7, 0x16, 0x12, 0xe8, 0x02, 0xf9, 0xdf, 0x80, 0x72, 0x46, 0x37, 0x0c,
-	0xf7, 0x16, 0x1e, 0xc4, 0x23, 0xbd, 0x53, 0x4f, 0xbf, 0x1f, 0xde, 0x7f, 0x62, 0xda, 0x5c, 0xe5,
-	0x41, 0xe5, 0x2d, 0x61, 0x4a, 0x5e, 0xf7, 0x4d, 0x6b, 0xe5, 0x71, 0x0a, 0xa6, 0xa6, 0xd6, 0x1c,
-	0x90, 0xbc, 0x31, 0xf3, 0x3f, 0xa3, 0x76, 0x88, 0x0b, 0x12, 0x99, 0x95, 0x34, 0x4f, 0xfa, 0xdb,
-	0x58, 0xe9, 0x0d, 0x5e, 0x73, 0x84, 0x37, 0x1c, 0xe1, 0xc9, 0xaa, 0x23, 0xd1, 0x87, 0xbc, 0xf5,
-	0x19, 0x78, 0x07, 0xb9, 0xb1, 0x81, 0xd7, 0xdf, 0x7e, 0xbc, 0x8b, 0xf4, 0xd1, 0x5e, 0x4d, 0x48,
-	0xad, 0x21, 0x3c, 0x7e, 0x18, 0x21, 0xdd, 0x93, 0x72, 0x56, 0xd2, 0x19, 0xd2, 0x3f, 0x63, 0x89,
-	0xc2, 0xa4, 0xe3, 0x14, 0xad, 0x5c, 0xf0, 0x14, 0x1d, 0x29, 0xef, 0xb5, 0x53, 0x9e, 0x6b, 0x5a,
-	0x74, 0x46, 0xf0, 0x63, 0x4c, 0x5f, 0x90, 0x81, 0x84, 0xbd, 0x2a, 0x8a, 0x96, 0x28, 0xcc, 0x95,
-	0xa0, 0x04, 0xf3, 0xe0, 0x14, 0xf5, 0x1c, 0xd0, 0xe1, 0xf6, 0xd6, 0x36, 0xd4, 0xcb, 0x12, 0x48,
-	0x15, 0xed, 0x40, 0x4c, 0xe7, 0xc8, 0xb5, 0x16, 0xfc, 0xf3, 0x92, 0xcc, 0xff, 0xe5, 0xc8, 0x4c,
-	0xdb, 0xc8, 0x19, 0x4b, 0xaa, 0xf0, 0xc8, 0xa4, 0x30, 0x12, 0x41, 0xb8, 0x9e, 0x35, 0x23, 0x14,
-	0x1d, 0xbe, 0xc4, 0x86, 0xc6, 0x4c, 0x19, 0x4f, 0x93, 0xee, 0x54, 0xd5, 0xdf, 0x24, 0xe9, 0x9d,
-	0xd6, 0x85, 0x51, 0x84, 0x45, 0xa7, 0x5a, 0xa0, 0x21, 0x13, 0x6b, 0xbf, 0xa7, 0x6b, 0xf1, 0xeb,
-	0xa4, 0x37, 0xfe, 0xb3, 0x9b, 0x5c, 0x9f, 0x95, 0x67, 0x7a, 0xe6, 0xc1, 0xb4, 0xa4, 0x72, 0xd7,
-	0xe8, 0x67, 0x4c, 0xba, 0x52, 0xa0, 0xe8, 0x50, 0x38, 0x0e, 0xea, 0x1c, 0xba, 0x66, 0xc6, 0xed,
-	0x8e, 0xfb, 0xc5, 0x0a, 0x41, 0x4a, 0x80, 0xe6, 0xaa, 0x29, 0xd1, 0x9b, 0x0b, 0x49, 0xed, 0x55,
-	0xf3, 0x2f, 0x96, 0xb1, 0x0c, 0xc9, 0xcf, 0x15, 0x2f, 0xb8, 0xaf, 0x6b, 0x35, 0x68, 0xb8, 0xef,
-	0xec, 0xb8, 0x4e, 0xdf, 0x44, 0x48, 0xd7, 0xc2, 0x45, 0xa2, 0x17, 0x7e, 0x4f, 0xf4, 0x57, 0x1c,
-	0xa8, 0xfe, 0x82, 0xa3, 0x97, 0xca, 0xe6, 0x7f, 0x28, 0x9b, 0xb7, 0xca, 0x9e, 0xc0, 0xa3, 0x4b,
-	0xe9, 0xd8, 0xf4, 0xbf, 0x62, 0x9a, 0xc0, 0xa3, 0xf4, 0x3d, 0x26, 0x3d, 0x09, 0x28, 0x82, 0x82,
-	0x2b, 0x66, 0xaf, 0x43, 0x3c, 0x62, 0xe9, 0xc0, 0x88, 0xd4, 0x68, 0x32, 0xac, 0xee, 0xca, 0x8b,
-	0x37, 0x37, 0x9d, 0xfa, 0x84, 0xf7, 0x2a, 0x0c, 0xef, 0x57, 0x18, 0x3e, 0xa8, 0x30, 0x74, 0x54,
-	0x61, 0xe8, 0xb8, 0xc2, 0xd0, 0x49, 0x85, 0xa1, 0xd3, 0x0a, 0xc3, 0x1b, 0x1e, 0xc3, 0x9b, 0x1e,
-	0x43, 0x5b, 0x1e, 0xc3, 0xdb, 0x1e, 0x43, 0x3b, 0x1e, 0x43, 0xbb, 0x1e, 0x43, 0x7b, 0x1e, 0xc3,
-	0xfb, 0x1e, 0xc3, 0x07, 0x1e, 0x43, 0x47, 0x1e, 0xc3, 0xc7, 0x1e, 0x43, 0x27, 0x1e, 0xc3, 0xa7,
-	0x1e, 0x43, 0x1b, 0x3e, 0x43, 0x9b, 0x3e, 0xc3, 0x6f, 0x7d, 0x86, 0x3e, 0xf8, 0x0c, 0x7f, 0xf4,
-	0x19, 0xda, 0xf2, 0x19, 0xda, 0xf6, 0x19, 0xde, 0xf1, 0x19, 0xde, 0xf5, 0x19, 0x5e, 0x7a, 0x74,
-	0xd5, 0x6f, 0xa8, 0x12, 0x4e, 0x36, 0xdb, 0x13, 0x78, 0x30, 0xfe, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0xd0, 0x32, 0x2f, 0xe5, 0xb7, 0x06, 0x00, 0x00,
+	0x14, 0xde, 0x89, 0xe2, 0xef, 0xd7, 0x41, 0x94, 0x4e, 0xa5, 0x7f, 0x52, 0x3b, 0xb5, 0xd1, 0x52,
+	0x91, 0xba, 0x5b, 0x62, 0x0f, 0xc5, 0x9b, 0x92, 0x10, 0x8b, 0x46, 0x34, 0xd6, 0x8b, 0x97, 0xb0,
+	0xc9, 0x3e, 0x37, 0x4b, 0xe2, 0xec, 0x76, 0x67, 0x12, 0x09, 0x45, 0x90, 0x1e, 0x8a, 0xc7, 0x42,
+	0x29, 0xf4, 0x58, 0x7a, 0xf2, 0x28, 0xbd, 0xd4, 0x53, 0xf1, 0xe8, 0x51, 0xe8, 0x45, 0x7a, 0x10,
+	0xb3, 0xdb, 0x83, 0xd0, 0x8b, 0x47, 0x8f, 0x25, 0xbb, 0xf9, 0x63, 0xb2, 0x46, 0xec, 0x9f, 0xdb,
+	0x4c, 0xde, 0xf7, 0xbe, 0xf7, 0xbd, 0x6f, 0xbe, 0x2c, 0x7e, 0x58, 0x30, 0x6d, 0x75, 0x5d, 0x65,
+	0xe3, 0x5c, 0xa8, 0xd9, 0xbc, 0xa2, 0x5a, 0x86, 0xc2, 0x40, 0xac, 0x9b, 0x76, 0x9e, 0x83, 0x5d,
+	0x02, 0x5b, 0xb6, 0x6c, 0x53, 0x98, 0xa4, 0x4f, 0x08, 0x26, 0xd7, 0xa0, 0x72, 0x69, 0x22, 0x3c,
+	0xae, 0x1b, 0x22, 0x57, 0xcc, 0xc8, 0x59, 0x73, 0x4d, 0xd1, 0x4d, 0xdd, 0x54, 0x3c, 0x58, 0xa6,
+	0xb8, 0xea, 0xdd, 0xbc, 0x8b, 0x77, 0xf2, 0xdb, 0xc3, 0x83, 0xba, 0x69, 0xea, 0x05, 0xf0, 0xe8,
+	0x55, 0xc6, 0x4c, 0xa1, 0x0a, 0xc3, 0x64, 0xbc, 0x56, 0xbd, 0x5b, 0xab, 0x36, 0x38, 0x60, 0xcd,
+	0x12, 0xe5, 0x5a, 0x31, 0x12, 0x14, 0x08, 0x4c, 0x4b, 0x6b, 0x50, 0x32, 0xb2, 0x50, 0xc3, 0x0c,
+	0x07, 0x31, 0x86, 0x06, 0x4c, 0x18, 0xab, 0x06, 0xd8, 0xf5, 0x29, 0x83, 0x41, 0x50, 0x1e, 0xca,
+	0xf5, 0xea, 0x50, 0xb0, 0xba, 0x06, 0x9c, 0xab, 0x3a, 0xd4, 0x10, 0x11, 0x86, 0x6f, 0x25, 0x80,
+	0x81, 0xad, 0x0a, 0x88, 0x41, 0x69, 0x4a, 0xd3, 0xec, 0x14, 0x70, 0xcb, 0x64, 0x1c, 0xc8, 0x12,
+	0xfe, 0x5f, 0x83, 0x52, 0x5a, 0xd5, 0x34, 0xfb, 0x36, 0x1a, 0x42, 0xa3, 0xbd, 0xd3, 0xcf, 0xbe,
+	0x1f, 0xdd, 0x7f, 0xaa, 0x9b, 0xb2, 0xc8, 0x81, 0xc8, 0x19, 0x4c, 0xe7, 0x72, 0xcd, 0x55, 0xa5,
+	0x75, 0x8e, 0x95, 0xd7, 0x15, 0x51, 0xb6, 0x80, 0xcb, 0x75, 0xce, 0xff, 0x34, 0xff, 0x10, 0xdd,
+	0x43, 0x38, 0x34, 0xcf, 0x49, 0x0e, 0xf7, 0xb7, 0x8d, 0x25, 0x37, 0x65, 0xdf, 0x30, 0xb9, 0x6e,
+	0x98, 0x1c, 0xaf, 0x1a, 0x16, 0x7e, 0x24, 0xb7, 0xbe, 0x92, 0xdc, 0x41, 0x6f, 0x64, 0xe0, 0xf5,
+	0xb7, 0x1f, 0xef, 0x42, 0x7d, 0xa4, 0x57, 0x61, 0x5c, 0xa9, 0x2b, 0x27, 0x0b, 0xf8, 0x5a, 0xaa,
+	0xfa, 0x32, 0x30, 0x1b, 0x9f, 0x25, 0x43, 0xed, 0x5c, 0x8d, 0x52, 0x0a, 0x5e, 0x16, 0x81, 0x8b,
+	0xf0, 0x83, 0x4b, 0x10, 0xfe, 0x9c, 0xe8, 0x51, 0x08, 0x77, 0x4f, 0xf1, 0x79, 0x4e, 0xe6, 0x70,
+	0xff, 0x9c, 0xc1, 0xf2, 0x53, 0x96, 0x55, 0x30, 0xb2, 0xde, 0xdb, 0x77, 0x5c, 0xe2, 0x5e, 0x3b,
+	0xed, 0xb9, 0xa6, 0x65, 0x6b, 0x14, 0x3d, 0x41, 0xe4, 0x05, 0x1e, 0x88, 0x99, 0xeb, 0xac, 0x60,
+	0xb0, 0xfc, 0x62, 0x11, 0x8a, 0x90, 0x02, 0xab, 0xa0, 0x66, 0x81, 0x8c, 0xb4, 0xb7, 0xb6, 0xa1,
+	0x7c, 0xdd, 0x1d, 0x06, 0x93, 0x45, 0x7c, 0xbd, 0x05, 0xbf, 0x50, 0xe4, 0xb9, 0xbf, 0xa4, 0x4c,
+	0xb7, 0x51, 0xce, 0x19, 0x5c, 0x04, 0x29, 0xe3, 0x4c, 0x8b, 0x79, 0x69, 0x7e, 0xde, 0xcc, 0x6c,
+	0x78, 0xe4, 0x12, 0x1b, 0xea, 0x9c, 0x3c, 0x9a, 0xc4, 0xdd, 0x89, 0xaa, 0xbf, 0x71, 0xdc, 0x3b,
+	0xa3, 0x32, 0xad, 0x00, 0xcb, 0x56, 0xb5, 0x40, 0x02, 0x26, 0xfa, 0xbf, 0x27, 0xfd, 0x44, 0x77,
+	0xd2, 0x1b, 0xfd, 0xd9, 0x8d, 0x6f, 0xcc, 0xf3, 0x86, 0x9e, 0x14, 0xe8, 0x06, 0x17, 0x76, 0x99,
+	0x7c, 0x46, 0xb8, 0x2b, 0x01, 0x82, 0x0c, 0x07, 0x03, 0x26, 0xce, 0xa1, 0x7d, 0x33, 0xee, 0x74,
+	0xdc, 0x2f, 0x92, 0xf7, 0x72, 0x07, 0x24, 0x5b, 0xcd, 0x9d, 0xda, 0x5c, 0x88, 0x2b, 0xaf, 0x9a,
+	0xff, 0xe9, 0xb4, 0xa1, 0x71, 0xf9, 0x5c, 0xf1, 0x82, 0xfb, 0x86, 0xe2, 0x43, 0x83, 0x7d, 0x8d,
+	0xe3, 0x06, 0x79, 0x13, 0xc2, 0x5d, 0x4b, 0x17, 0x89, 0x5e, 0xfa, 0x3d, 0xd1, 0x5f, 0x91, 0xa7,
+	0xfa, 0x0b, 0x0a, 0x5f, 0x2a, 0x5b, 0xfe, 0x43, 0xd9, 0x72, 0xab, 0xec, 0x49, 0x34, 0xb6, 0x92,
+	0x8c, 0xcc, 0xfc, 0xab, 0x49, 0x93, 0x68, 0x8c, 0xbc, 0x47, 0xb8, 0x27, 0x06, 0x05, 0x10, 0x70,
+	0xc5, 0xec, 0x75, 0x88, 0x47, 0x24, 0xe9, 0x19, 0x91, 0x18, 0x8b, 0x07, 0xd5, 0x5d, 0x79, 0xf1,
+	0xe6, 0xa6, 0xd3, 0x9f, 0xd0, 0x7e, 0x85, 0xa2, 0x83, 0x0a, 0x45, 0x87, 0x15, 0x2a, 0x1d, 0x57,
+	0xa8, 0x74, 0x52, 0xa1, 0xd2, 0x69, 0x85, 0x4a, 0x67, 0x15, 0x8a, 0x36, 0x1d, 0x8a, 0xb6, 0x1c,
+	0x2a, 0x6d, 0x3b, 0x14, 0xed, 0x38, 0x54, 0xda, 0x75, 0xa8, 0xb4, 0xe7, 0x50, 0x69, 0xdf, 0xa1,
+	0xe8, 0xc0, 0xa1, 0xe8, 0xd0, 0xa1, 0xd2, 0xb1, 0x43, 0xd1, 0x89, 0x43, 0xa5, 0x53, 0x87, 0xa2,
+	0x33, 0x87, 0x4a, 0x9b, 0x2e, 0x95, 0xb6, 0x5c, 0x8a, 0xde, 0xba, 0x54, 0xfa, 0xe0, 0x52, 0xf4,
+	0xd1, 0xa5, 0xd2, 0xb6, 0x4b, 0xa5, 0x1d, 0x97, 0xa2, 0x5d, 0x97, 0xa2, 0x3d, 0x97, 0xa2, 0x95,
+	0xc7, 0x57, 0xfd, 0x2c, 0x0b, 0x66, 0x65, 0x32, 0x3d, 0x9e, 0x07, 0x13, 0xbf, 0x02, 0x00, 0x00,
+	0xff, 0xff, 0xd9, 0x07, 0x4a, 0xf0, 0x28, 0x07, 0x00, 0x00,
 }
 
 func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
@@ -177,6 +179,10 @@ const _ = grpc.SupportPackageIsVersion4
 type NsClient interface {
 	// GenerateDevAddr requests a device address assignment from the Network Server.
 	GenerateDevAddr(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenerateDevAddrResponse, error)
+	// RotateKEK re-wraps the keys that are stored by the Network Server with the new KEK.
+	// Keys are processed in batches; call this RPC with the returned cursor until the returned cursor is empty.
+	// This RPC requires cluster authentication.
+	RotateKEK(ctx context.Context, in *RotateKEKRequest, opts ...grpc.CallOption) (*RotateKEKResponse, error)
 }
 
 type nsClient struct {
@@ -196,10 +202,23 @@ func (c *nsClient) GenerateDevAddr(ctx context.Context, in *types.Empty, opts ..
 	return out, nil
 }
 
+func (c *nsClient) RotateKEK(ctx context.Context, in *RotateKEKRequest, opts ...grpc.CallOption) (*RotateKEKResponse, error) {
+	out := new(RotateKEKResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Ns/RotateKEK", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NsServer is the server API for Ns service.
 type NsServer interface {
 	// GenerateDevAddr requests a device address assignment from the Network Server.
 	GenerateDevAddr(context.Context, *types.Empty) (*GenerateDevAddrResponse, error)
+	// RotateKEK re-wraps the keys that are stored by the Network Server with the new KEK.
+	// Keys are processed in batches; call this RPC with the returned cursor until the returned cursor is empty.
+	// This RPC requires cluster authentication.
+	RotateKEK(context.Context, *RotateKEKRequest) (*RotateKEKResponse, error)
 }
 
 // UnimplementedNsServer can be embedded to have forward compatible implementations.
@@ -209,6 +228,9 @@ type UnimplementedNsServer struct {
 func (*UnimplementedNsServer) GenerateDevAddr(ctx context.Context, req *types.Empty) (*GenerateDevAddrResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateDevAddr not implemented")
 }
+func (*UnimplementedNsServer) RotateKEK(ctx context.Context, req *RotateKEKRequest) (*RotateKEKResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateKEK not implemented")
+}
 
 func RegisterNsServer(s *grpc.Server, srv NsServer) {
 	s.RegisterService(&_Ns_serviceDesc, srv)
@@ -232,6 +254,24 @@ func _Ns_GenerateDevAddr_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Ns_RotateKEK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateKEKRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).RotateKEK(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Ns/RotateKEK",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).RotateKEK(ctx, req.(*RotateKEKRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Ns_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.Ns",
 	HandlerType: (*NsServer)(nil),
@@ -240,6 +280,10 @@ var _Ns_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateDevAddr",
 			Handler:    _Ns_GenerateDevAddr_Handler,
 		},
+		{
+			MethodName: "RotateKEK",
+			Handler:    _Ns_RotateKEK_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/networkserver.proto",
@@ -275,17 +275,21 @@ func (m *ListOAuthClientAuthorizationsRequest) GetPage() uint32 {
 }
 
 type OAuthAuthorizationCode struct {
-	UserIDs              UserIdentifiers   `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids"`
-	UserSessionID        string            `protobuf:"bytes,9,opt,name=user_session_id,json=userSessionId,proto3" json:"user_session_id,omitempty"`
-	ClientIDs            ClientIdentifiers `protobuf:"bytes,2,opt,name=client_ids,json=clientIds,proto3" json:"client_ids"`
-	Rights               []Right           `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	Code                 string            `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
-	RedirectURI          string            `protobuf:"bytes,5,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
-	State                string            `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
-	CreatedAt            time.Time         `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
-	ExpiresAt            time.Time         `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	UserIDs       UserIdentifiers   `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids"`
+	UserSessionID string            `protobuf:"bytes,9,opt,name=user_session_id,json=userSessionId,proto3" json:"user_session_id,omitempty"`
+	ClientIDs     ClientIdentifiers `protobuf:"bytes,2,opt,name=client_ids,json=clientIds,proto3" json:"client_ids"`
+	Rights        []Right           `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	Code          string            `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	RedirectURI   string            `protobuf:"bytes,5,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	State         string            `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	CreatedAt     time.Time         `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	ExpiresAt     time.Time         `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// Whether the client requested an OpenID Connect ID token with the openid scope.
+	OpenID bool `protobuf:"varint,10,opt,name=openid,proto3" json:"openid,omitempty"`
+	// Nonce of the OpenID Connect authentication request.
+	Nonce                string   `protobuf:"bytes,11,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *OAuthAuthorizationCode) Reset()      { *m = OAuthAuthorizationCode{} }
@@ -383,6 +387,20 @@ func (m *OAuthAuthorizationCode) GetExpiresAt() time.Time {
 	return time.Time{}
 }
 
+func (m *OAuthAuthorizationCode) GetOpenID() bool {
+	if m != nil {
+		return m.OpenID
+	}
+	return false
+}
+
+func (m *OAuthAuthorizationCode) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
 type OAuthAccessTokenIdentifiers struct {
 	UserIDs              UserIdentifiers   `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids"`
 	ClientIDs            ClientIdentifiers `protobuf:"bytes,2,opt,name=client_ids,json=clientIds,proto3" json:"client_ids"`
@@ -706,66 +724,68 @@ func init() {
 }
 
 var fileDescriptor_1454904971eaa7d7 = []byte{
-	// 936 bytes of a gzipped FileDescriptorProto
+	// 975 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x3d, 0x6c, 0xdb, 0x46,
-	0x14, 0xe6, 0xe9, 0xcf, 0xd6, 0xc9, 0x72, 0x5d, 0xa2, 0x4d, 0x19, 0xa7, 0x39, 0x29, 0x72, 0x07,
-	0xa1, 0xa8, 0x28, 0xc0, 0x01, 0x8a, 0xa2, 0x53, 0x44, 0x7b, 0x31, 0x90, 0xa2, 0xc5, 0x25, 0x5e,
-	0xda, 0x41, 0xa0, 0xc9, 0x33, 0x75, 0xb0, 0xc4, 0x63, 0xef, 0x8e, 0x4e, 0xd2, 0x29, 0x4b, 0x81,
-	0xa0, 0x93, 0xc7, 0x6e, 0x2d, 0x3a, 0x05, 0xe8, 0x92, 0x31, 0x43, 0x87, 0x8c, 0x1e, 0xbd, 0x35,
-	0x93, 0x6a, 0x91, 0x43, 0x3d, 0x66, 0x0c, 0x3c, 0x15, 0x3a, 0x52, 0x11, 0x2d, 0x57, 0x05, 0x6c,
-	0xa0, 0x68, 0x8d, 0x6c, 0xef, 0xdd, 0x7d, 0xef, 0x3b, 0xbe, 0x9f, 0x4f, 0x4f, 0xf0, 0x66, 0x9f,
-	0x71, 0xfb, 0x81, 0xed, 0xb7, 0x84, 0xb4, 0x9d, 0xbd, 0xb6, 0x1d, 0xd0, 0x36, 0xb3, 0x43, 0xd9,
-	0x33, 0x03, 0xce, 0x24, 0xd3, 0x97, 0xa5, 0xf4, 0xcd, 0x14, 0x62, 0xee, 0xdf, 0x5e, 0xed, 0x78,
-	0x54, 0xf6, 0xc2, 0x1d, 0xd3, 0x61, 0x83, 0x36, 0xf1, 0xf7, 0xd9, 0xa3, 0x80, 0xb3, 0x87, 0x8f,
-	0xda, 0x0a, 0xec, 0xb4, 0x3c, 0xe2, 0xb7, 0xf6, 0xed, 0x3e, 0x75, 0x6d, 0x49, 0xda, 0xe7, 0x8c,
-	0x84, 0x72, 0xb5, 0x95, 0xa1, 0xf0, 0x98, 0xc7, 0x92, 0xe0, 0x9d, 0x70, 0x57, 0x79, 0xca, 0x51,
-	0x56, 0x0a, 0xaf, 0x79, 0x8c, 0x79, 0x7d, 0x32, 0x45, 0x49, 0x3a, 0x20, 0x42, 0xda, 0x83, 0x20,
-	0x05, 0xac, 0x9d, 0xcf, 0x80, 0xba, 0xc4, 0x97, 0x74, 0x97, 0x12, 0x2e, 0x52, 0x10, 0x3a, 0x0f,
-	0xe2, 0xd4, 0xeb, 0xc9, 0xf4, 0xbe, 0xf1, 0x3b, 0x80, 0x6b, 0x5f, 0x76, 0x42, 0xd9, 0xdb, 0xe8,
-	0x53, 0xe2, 0xcb, 0xb1, 0xc5, 0x38, 0xfd, 0xce, 0x96, 0x94, 0xf9, 0x5b, 0x53, 0x36, 0xfd, 0x1e,
-	0x5c, 0x0c, 0x05, 0xe1, 0x5d, 0xea, 0x0a, 0x03, 0xd4, 0x41, 0xb3, 0xb2, 0x5e, 0x33, 0xcf, 0x96,
-	0xc8, 0xdc, 0x16, 0x84, 0x67, 0x42, 0xac, 0x0f, 0x4e, 0xad, 0xe2, 0x0f, 0x20, 0xb7, 0x02, 0x0e,
-	0x87, 0x35, 0x2d, 0x1a, 0xd6, 0x16, 0x14, 0x60, 0x53, 0xe0, 0x85, 0x50, 0x21, 0x85, 0xfe, 0x0d,
-	0x84, 0x8e, 0x7a, 0x56, 0xd1, 0xe6, 0x14, 0xed, 0xad, 0x59, 0xda, 0xe4, 0xc3, 0xb2, 0xc4, 0xd7,
-	0x67, 0x88, 0xcb, 0x29, 0x64, 0x53, 0xe0, 0xb2, 0x93, 0xa2, 0x45, 0xe3, 0xfb, 0x3c, 0x34, 0xe6,
-	0x65, 0x76, 0xf5, 0xd2, 0xd1, 0x5b, 0xb0, 0x94, 0x34, 0xce, 0xc8, 0xd7, 0xf3, 0xcd, 0xe5, 0xf5,
-	0xf7, 0x67, 0x89, 0xf1, 0xf8, 0x16, 0xa7, 0x20, 0x7d, 0x03, 0x42, 0x87, 0x13, 0x5b, 0x12, 0xb7,
-	0x6b, 0x4b, 0xa3, 0xa0, 0xbe, 0x65, 0xd5, 0x4c, 0x46, 0xca, 0x9c, 0x8c, 0x94, 0x79, 0x7f, 0x32,
-	0x52, 0xd6, 0xe2, 0xf8, 0xf1, 0x83, 0x3f, 0x6a, 0x00, 0x97, 0xd3, 0xb8, 0x8e, 0x1c, 0x93, 0x84,
-	0x81, 0x3b, 0x21, 0x29, 0x5e, 0x84, 0x24, 0x8d, 0xeb, 0xc8, 0xc6, 0x00, 0x5e, 0x9f, 0xd7, 0x06,
-	0xa1, 0x7f, 0x05, 0x97, 0xed, 0x33, 0x27, 0x06, 0xa8, 0xe7, 0x9b, 0x95, 0xf5, 0xe6, 0x6c, 0x76,
-	0xf3, 0x28, 0xf0, 0x4c, 0x7c, 0xe3, 0x18, 0xc0, 0x8f, 0xee, 0x52, 0x21, 0xe7, 0xbe, 0x89, 0xc9,
-	0xb7, 0x21, 0x11, 0x52, 0xbf, 0x7b, 0xf1, 0x11, 0x58, 0xc9, 0x76, 0xea, 0x68, 0x58, 0x03, 0xd3,
-	0xde, 0x7f, 0x0a, 0x8b, 0x8c, 0xbb, 0x84, 0xab, 0xb6, 0x97, 0xad, 0xfa, 0xa9, 0x75, 0x93, 0xdf,
-	0xc0, 0x1a, 0xce, 0x74, 0x01, 0x57, 0x5a, 0x19, 0x27, 0x81, 0xeb, 0x08, 0x16, 0xfb, 0x74, 0x40,
-	0xa5, 0x91, 0xaf, 0x83, 0x66, 0xd5, 0x5a, 0x3c, 0xb5, 0x8a, 0x1f, 0xe7, 0x8d, 0x93, 0x05, 0x9c,
-	0x1c, 0xeb, 0x3a, 0x2c, 0x04, 0xb6, 0x47, 0x54, 0x07, 0xab, 0x58, 0xd9, 0x8d, 0x5f, 0x0b, 0xf0,
-	0x9a, 0x4a, 0xef, 0x4c, 0x62, 0x1b, 0xcc, 0x25, 0xff, 0xce, 0x5c, 0xdf, 0x81, 0xef, 0x28, 0x52,
-	0x41, 0x84, 0xa0, 0xcc, 0xef, 0x52, 0xd7, 0x28, 0xab, 0x2c, 0x8d, 0x53, 0xab, 0xc0, 0x73, 0xc6,
-	0x9d, 0x68, 0x58, 0xab, 0x8e, 0xa3, 0xee, 0x25, 0x80, 0xad, 0x4d, 0x5c, 0x0d, 0x33, 0xae, 0xfb,
-	0xbf, 0x52, 0x86, 0x0e, 0x0b, 0x0e, 0x73, 0x93, 0x8a, 0x96, 0xb1, 0xb2, 0xf5, 0xcf, 0xe1, 0x12,
-	0x27, 0x2e, 0xe5, 0xc4, 0x91, 0xdd, 0x90, 0x53, 0x35, 0xea, 0x65, 0x55, 0x19, 0x9e, 0x3f, 0x00,
-	0x20, 0x1a, 0xd6, 0x2a, 0x38, 0xbd, 0xdf, 0xc6, 0x5b, 0xb8, 0x32, 0x01, 0x6f, 0x73, 0xaa, 0xbf,
-	0x07, 0x8b, 0x42, 0xda, 0x92, 0x18, 0x25, 0x45, 0x98, 0x38, 0x33, 0xfa, 0x5b, 0xb8, 0xb4, 0xfe,
-	0xc8, 0xc3, 0x80, 0x72, 0x22, 0xc6, 0x24, 0x8b, 0x17, 0x21, 0x49, 0xe3, 0x3a, 0xb2, 0xf1, 0x27,
-	0x80, 0x37, 0x92, 0x69, 0x71, 0x1c, 0x22, 0xc4, 0x7d, 0xb6, 0x47, 0xae, 0xf6, 0x2f, 0xbb, 0x7e,
-	0x0d, 0xe6, 0xa8, 0xab, 0x04, 0x53, 0xb6, 0x4a, 0xd1, 0xb0, 0x96, 0xdb, 0xda, 0xc4, 0x39, 0xea,
-	0x36, 0x7e, 0x2a, 0xc0, 0x95, 0xd9, 0x4c, 0xdf, 0x4a, 0x45, 0xcc, 0x29, 0x90, 0x7e, 0x0b, 0x2e,
-	0xd9, 0xaa, 0x34, 0x5d, 0x39, 0xae, 0x4d, 0x2a, 0x81, 0x8a, 0x9d, 0x29, 0xd7, 0x1a, 0xac, 0x72,
-	0xb2, 0xcb, 0x89, 0xe8, 0xa5, 0x18, 0x25, 0x05, 0xbc, 0x94, 0x1e, 0x26, 0xa0, 0xa9, 0xe2, 0x4a,
-	0x17, 0xdf, 0x45, 0xff, 0xa5, 0x16, 0xbe, 0x80, 0xef, 0xce, 0x0e, 0x88, 0xd0, 0x3f, 0x83, 0x25,
-	0x95, 0xea, 0x64, 0xf7, 0xd4, 0xff, 0x76, 0xf7, 0x64, 0x42, 0x70, 0x8a, 0x6f, 0xfc, 0x96, 0x83,
-	0x1f, 0xbe, 0xd9, 0x35, 0x59, 0xce, 0xc9, 0x8e, 0xb9, 0x7a, 0xda, 0x7a, 0xb3, 0xc7, 0xf2, 0x97,
-	0xdc, 0x63, 0x85, 0x7f, 0xde, 0x63, 0xc5, 0xe9, 0x1e, 0xb3, 0x7e, 0x01, 0x87, 0x23, 0x04, 0x8e,
-	0x46, 0x08, 0xbc, 0x1c, 0x21, 0xed, 0x78, 0x84, 0xb4, 0x93, 0x11, 0xd2, 0x5e, 0x8d, 0x90, 0xf6,
-	0x7a, 0x84, 0xc0, 0xe3, 0x08, 0x81, 0x27, 0x11, 0xd2, 0x9e, 0x46, 0x08, 0x3c, 0x8b, 0x90, 0xf6,
-	0x3c, 0x42, 0xda, 0x8b, 0x08, 0x69, 0x87, 0x11, 0x02, 0x47, 0x11, 0x02, 0x2f, 0x23, 0xa4, 0x1d,
-	0x47, 0x08, 0x9c, 0x44, 0x48, 0x7b, 0x15, 0x21, 0xf0, 0x3a, 0x42, 0xda, 0xe3, 0x18, 0x69, 0x4f,
-	0x62, 0x04, 0x0e, 0x62, 0xa4, 0xfd, 0x18, 0x23, 0xf0, 0x73, 0x8c, 0xb4, 0xa7, 0x31, 0xd2, 0x9e,
-	0xc5, 0x08, 0x3c, 0x8f, 0x11, 0x78, 0x11, 0x23, 0xf0, 0xf5, 0x27, 0x1e, 0x33, 0x65, 0x8f, 0xc8,
-	0x1e, 0xf5, 0x3d, 0x61, 0xfa, 0x44, 0x3e, 0x60, 0x7c, 0xaf, 0x7d, 0xf6, 0x7f, 0x72, 0xb0, 0xe7,
-	0xb5, 0xa5, 0xf4, 0x83, 0x9d, 0x9d, 0x92, 0x9a, 0xad, 0xdb, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0xed, 0x6f, 0x34, 0x84, 0x30, 0x0c, 0x00, 0x00,
+	0x18, 0xe5, 0xe9, 0xcf, 0xd2, 0xc9, 0x76, 0x5d, 0xa2, 0x4d, 0x19, 0xa7, 0x39, 0x2a, 0x74, 0x07,
+	0xa1, 0xa8, 0x28, 0xc0, 0x01, 0x8a, 0xa2, 0x53, 0x4c, 0x7b, 0x31, 0x90, 0x22, 0xc5, 0x25, 0x5e,
+	0xda, 0xc1, 0xa0, 0xc9, 0x33, 0x75, 0xb0, 0xc4, 0x63, 0xef, 0x8e, 0x4e, 0xd2, 0xc9, 0x4b, 0x81,
+	0xa0, 0x93, 0xc7, 0x6e, 0x2d, 0x3a, 0x65, 0xcc, 0x98, 0xa1, 0x43, 0x46, 0x8f, 0xde, 0x9a, 0x49,
+	0xb5, 0xa8, 0xa1, 0xde, 0x9a, 0x31, 0xf0, 0x54, 0xe8, 0x48, 0x45, 0xb4, 0x5c, 0x15, 0x70, 0x80,
+	0xa2, 0x35, 0xba, 0xdd, 0xcf, 0xfb, 0xde, 0xf1, 0x7d, 0x3f, 0x78, 0x84, 0x37, 0xbb, 0x8c, 0xbb,
+	0x0f, 0xdd, 0xb0, 0x25, 0xa4, 0xeb, 0xed, 0xb5, 0xdd, 0x88, 0xb6, 0x99, 0x1b, 0xcb, 0x8e, 0x1d,
+	0x71, 0x26, 0x99, 0xbe, 0x28, 0x65, 0x68, 0x67, 0x10, 0x7b, 0xff, 0xf6, 0xf2, 0x5a, 0x40, 0x65,
+	0x27, 0xde, 0xb1, 0x3d, 0xd6, 0x6b, 0x93, 0x70, 0x9f, 0x3d, 0x8e, 0x38, 0x7b, 0xf4, 0xb8, 0xad,
+	0xc0, 0x5e, 0x2b, 0x20, 0x61, 0x6b, 0xdf, 0xed, 0x52, 0xdf, 0x95, 0xa4, 0x7d, 0x61, 0x91, 0x52,
+	0x2e, 0xb7, 0x72, 0x14, 0x01, 0x0b, 0x58, 0x1a, 0xbc, 0x13, 0xef, 0xaa, 0x9d, 0xda, 0xa8, 0x55,
+	0x06, 0x37, 0x03, 0xc6, 0x82, 0x2e, 0x99, 0xa0, 0x24, 0xed, 0x11, 0x21, 0xdd, 0x5e, 0x94, 0x01,
+	0x56, 0x2e, 0x2a, 0xa0, 0x3e, 0x09, 0x25, 0xdd, 0xa5, 0x84, 0x8b, 0x0c, 0x84, 0x2e, 0x82, 0x38,
+	0x0d, 0x3a, 0x32, 0xbb, 0xb7, 0x7e, 0x05, 0x70, 0xe5, 0xde, 0x5a, 0x2c, 0x3b, 0xeb, 0x5d, 0x4a,
+	0x42, 0x39, 0x5a, 0x31, 0x4e, 0xbf, 0x75, 0x25, 0x65, 0xe1, 0xe6, 0x84, 0x4d, 0xbf, 0x0f, 0xab,
+	0xb1, 0x20, 0x7c, 0x9b, 0xfa, 0xc2, 0x00, 0x0d, 0xd0, 0xac, 0xaf, 0x9a, 0xf6, 0xf9, 0x14, 0xd9,
+	0x5b, 0x82, 0xf0, 0x5c, 0x88, 0xf3, 0xc1, 0x99, 0x53, 0xfe, 0x1e, 0x14, 0x96, 0xc0, 0x51, 0xdf,
+	0xd4, 0x92, 0xbe, 0x39, 0xa7, 0x00, 0x1b, 0x02, 0xcf, 0xc5, 0x0a, 0x29, 0xf4, 0xaf, 0x21, 0xf4,
+	0xd4, 0xb3, 0x8a, 0xb6, 0xa0, 0x68, 0x6f, 0x4d, 0xd3, 0xa6, 0x1f, 0x96, 0x27, 0xbe, 0x3e, 0x45,
+	0x5c, 0xcb, 0x20, 0x1b, 0x02, 0xd7, 0xbc, 0x0c, 0x2d, 0xac, 0xef, 0x8a, 0xd0, 0x98, 0xa5, 0xec,
+	0xea, 0xc9, 0xd1, 0x5b, 0xb0, 0x92, 0x16, 0xce, 0x28, 0x36, 0x8a, 0xcd, 0xc5, 0xd5, 0xf7, 0xa7,
+	0x89, 0xf1, 0xe8, 0x16, 0x67, 0x20, 0x7d, 0x1d, 0x42, 0x8f, 0x13, 0x57, 0x12, 0x7f, 0xdb, 0x95,
+	0x46, 0x49, 0x7d, 0xcb, 0xb2, 0x9d, 0xb6, 0x94, 0x3d, 0x6e, 0x29, 0xfb, 0xc1, 0xb8, 0xa5, 0x9c,
+	0xea, 0xe8, 0xf1, 0xc3, 0xdf, 0x4c, 0x80, 0x6b, 0x59, 0xdc, 0x9a, 0x1c, 0x91, 0xc4, 0x91, 0x3f,
+	0x26, 0x29, 0x5f, 0x86, 0x24, 0x8b, 0x5b, 0x93, 0x56, 0x0f, 0x5e, 0x9f, 0x55, 0x06, 0xa1, 0x7f,
+	0x09, 0x17, 0xdd, 0x73, 0x27, 0x06, 0x68, 0x14, 0x9b, 0xf5, 0xd5, 0xe6, 0xb4, 0xba, 0x59, 0x14,
+	0x78, 0x2a, 0xde, 0x3a, 0x01, 0xf0, 0xa3, 0xbb, 0x54, 0xc8, 0x99, 0x6f, 0x62, 0xf2, 0x4d, 0x4c,
+	0x84, 0xd4, 0xef, 0x5e, 0xbe, 0x05, 0x96, 0xf2, 0x95, 0x3a, 0xee, 0x9b, 0x60, 0x52, 0xfb, 0x4f,
+	0x61, 0x99, 0x71, 0x9f, 0x70, 0x55, 0xf6, 0x9a, 0xd3, 0x38, 0x73, 0x6e, 0xf2, 0x1b, 0x58, 0xc3,
+	0xb9, 0x2a, 0xe0, 0x7a, 0x2b, 0xb7, 0x49, 0xe1, 0x3a, 0x82, 0xe5, 0x2e, 0xed, 0x51, 0x69, 0x14,
+	0x1b, 0xa0, 0xb9, 0xe0, 0x54, 0xcf, 0x9c, 0xf2, 0xc7, 0x45, 0xe3, 0x74, 0x0e, 0xa7, 0xc7, 0xba,
+	0x0e, 0x4b, 0x91, 0x1b, 0x10, 0x55, 0xc1, 0x05, 0xac, 0xd6, 0xd6, 0x1f, 0x25, 0x78, 0x4d, 0xc9,
+	0x3b, 0x27, 0x6c, 0x9d, 0xf9, 0xe4, 0x9f, 0xe9, 0xeb, 0x3b, 0xf0, 0x1d, 0x45, 0x2a, 0x88, 0x10,
+	0x94, 0x85, 0xdb, 0xd4, 0x37, 0x6a, 0x4a, 0xa5, 0x71, 0xe6, 0x94, 0x78, 0xc1, 0xb8, 0x93, 0xf4,
+	0xcd, 0x85, 0x51, 0xd4, 0xfd, 0x14, 0xb0, 0xb9, 0x81, 0x17, 0xe2, 0xdc, 0xd6, 0xff, 0x4f, 0x4d,
+	0x86, 0x0e, 0x4b, 0x1e, 0xf3, 0xd3, 0x8c, 0xd6, 0xb0, 0x5a, 0xeb, 0x9f, 0xc3, 0x79, 0x4e, 0x7c,
+	0xca, 0x89, 0x27, 0xb7, 0x63, 0x4e, 0x55, 0xab, 0xd7, 0x54, 0x66, 0x78, 0xf1, 0x10, 0x80, 0xa4,
+	0x6f, 0xd6, 0x71, 0x76, 0xbf, 0x85, 0x37, 0x71, 0x7d, 0x0c, 0xde, 0xe2, 0x54, 0x7f, 0x0f, 0x96,
+	0x85, 0x74, 0x25, 0x31, 0x2a, 0x8a, 0x30, 0xdd, 0x4c, 0xcd, 0xdf, 0xdc, 0x5b, 0xcf, 0x1f, 0x79,
+	0x14, 0x51, 0x4e, 0xc4, 0x88, 0xa4, 0x7a, 0x19, 0x92, 0x2c, 0x6e, 0x4d, 0xea, 0x16, 0xac, 0xb0,
+	0x88, 0x84, 0xd4, 0x37, 0x60, 0x03, 0x34, 0xab, 0x0e, 0x4c, 0xfa, 0x66, 0xe5, 0x5e, 0x44, 0x46,
+	0x65, 0xca, 0x6e, 0x46, 0x5d, 0x18, 0xb2, 0xd0, 0x23, 0x46, 0x5d, 0x09, 0xaf, 0x2a, 0xe1, 0xc6,
+	0x41, 0x01, 0xa7, 0xc7, 0xd6, 0xef, 0x00, 0xde, 0x48, 0x3b, 0xce, 0xf3, 0x88, 0x10, 0x0f, 0xd8,
+	0x1e, 0xb9, 0xda, 0xee, 0xa0, 0x5f, 0x83, 0x05, 0xea, 0xab, 0xa1, 0xab, 0x39, 0x95, 0xa4, 0x6f,
+	0x16, 0x36, 0x37, 0x70, 0x81, 0xfa, 0xd6, 0x8f, 0x25, 0xb8, 0x34, 0xad, 0xf4, 0x7f, 0x39, 0x55,
+	0x33, 0x12, 0xa4, 0xdf, 0x82, 0xf3, 0xae, 0x4a, 0xcd, 0xb6, 0x1c, 0xe5, 0x26, 0x1b, 0xa3, 0xba,
+	0x9b, 0x4b, 0xd7, 0x0a, 0x5c, 0xe0, 0x64, 0x97, 0x13, 0xd1, 0xc9, 0x30, 0x6a, 0x9c, 0xf0, 0x7c,
+	0x76, 0x98, 0x82, 0x26, 0x53, 0x5b, 0xb9, 0xbc, 0x9f, 0xfd, 0x8b, 0xf3, 0x64, 0x7d, 0x01, 0xdf,
+	0x9d, 0x6e, 0x10, 0xa1, 0x7f, 0x06, 0x2b, 0x4a, 0xea, 0xd8, 0xbf, 0x1a, 0x7f, 0xe9, 0x5f, 0xb9,
+	0x10, 0x9c, 0xe1, 0xad, 0x5f, 0x0a, 0xf0, 0xc3, 0x37, 0x7e, 0x95, 0xe7, 0x1c, 0xfb, 0xd4, 0xd5,
+	0x9b, 0xad, 0x37, 0x5e, 0x58, 0x7c, 0x4b, 0x2f, 0x2c, 0xfd, 0xbd, 0x17, 0x96, 0x27, 0x5e, 0xe8,
+	0xfc, 0x0c, 0x8e, 0x06, 0x08, 0x1c, 0x0f, 0x10, 0x78, 0x39, 0x40, 0xda, 0xc9, 0x00, 0x69, 0xa7,
+	0x03, 0xa4, 0xbd, 0x1a, 0x20, 0xed, 0xf5, 0x00, 0x81, 0x83, 0x04, 0x81, 0x27, 0x09, 0xd2, 0x9e,
+	0x26, 0x08, 0x3c, 0x4b, 0x90, 0xf6, 0x3c, 0x41, 0xda, 0x8b, 0x04, 0x69, 0x47, 0x09, 0x02, 0xc7,
+	0x09, 0x02, 0x2f, 0x13, 0xa4, 0x9d, 0x24, 0x08, 0x9c, 0x26, 0x48, 0x7b, 0x95, 0x20, 0xf0, 0x3a,
+	0x41, 0xda, 0xc1, 0x10, 0x69, 0x4f, 0x86, 0x08, 0x1c, 0x0e, 0x91, 0xf6, 0xc3, 0x10, 0x81, 0x9f,
+	0x86, 0x48, 0x7b, 0x3a, 0x44, 0xda, 0xb3, 0x21, 0x02, 0xcf, 0x87, 0x08, 0xbc, 0x18, 0x22, 0xf0,
+	0xd5, 0x27, 0x01, 0xb3, 0x65, 0x87, 0xc8, 0x0e, 0x0d, 0x03, 0x61, 0x87, 0x44, 0x3e, 0x64, 0x7c,
+	0xaf, 0x7d, 0xfe, 0x5f, 0x3b, 0xda, 0x0b, 0xda, 0x52, 0x86, 0xd1, 0xce, 0x4e, 0x45, 0xf5, 0xd6,
+	0xed, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x91, 0x6f, 0x73, 0xf5, 0x74, 0x0c, 0x00, 0x00,
 }
 
 func (this *OAuthClientAuthorizationIdentifiers) Equal(that interface{}) bool {
@@ -949,6 +969,12 @@ func (this *OAuthAuthorizationCode) Equal(that interface{}) bool {
 	if !this.ExpiresAt.Equal(that1.ExpiresAt) {
 		return false
 	}
+	if this.OpenID != that1.OpenID {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
 	return true
 }
 func (this *OAuthAccessTokenIdentifiers) Equal(that interface{}) bool {
@@ -1326,6 +1352,23 @@ func (m *OAuthAuthorizationCode) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintOauth(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.OpenID {
+		i--
+		if m.OpenID {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.UserSessionID) > 0 {
 		i -= len(m.UserSessionID)
 		copy(dAtA[i:], m.UserSessionID)
@@ -1750,6 +1793,8 @@ func NewPopulatedOAuthAuthorizationCode(r randyOauth, easy bool) *OAuthAuthoriza
 	v14 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	this.ExpiresAt = *v14
 	this.UserSessionID = randStringOauth(r)
+	this.OpenID = bool(r.Intn(2) == 0)
+	this.Nonce = randStringOauth(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2001,6 +2046,13 @@ func (m *OAuthAuthorizationCode) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOauth(uint64(l))
 	}
+	if m.OpenID {
+		n += 2
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovOauth(uint64(l))
+	}
 	return n
 }
 
@@ -2172,6 +2224,8 @@ func (this *OAuthAuthorizationCode) String() string {
 		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
 		`ExpiresAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
 		`UserSessionID:` + fmt.Sprintf("%v", this.UserSessionID) + `,`,
+		`OpenID:` + fmt.Sprintf("%v", this.OpenID) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3217,6 +3271,58 @@ func (m *OAuthAuthorizationCode) Unmarshal(dAtA []byte) error {
 			}
 			m.UserSessionID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenID", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OpenID = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOauth(dAtA[iNdEx:])
@@ -60,6 +60,8 @@ var OAuthAuthorizationCodeFieldPathsNested = []string{
 	"code",
 	"created_at",
 	"expires_at",
+	"nonce",
+	"openid",
 	"redirect_uri",
 	"rights",
 	"state",
@@ -74,6 +76,8 @@ var OAuthAuthorizationCodeFieldPathsTopLevel = []string{
 	"code",
 	"created_at",
 	"expires_at",
+	"nonce",
+	"openid",
 	"redirect_uri",
 	"rights",
 	"state",
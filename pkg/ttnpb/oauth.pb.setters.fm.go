@@ -318,6 +318,26 @@ func (dst *OAuthAuthorizationCode) SetFields(src *OAuthAuthorizationCode, paths
 				dst.ExpiresAt = zero
 			}
 
+		case "openid":
+			if len(subs) > 0 {
+				return fmt.Errorf("'openid' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.OpenID = src.OpenID
+			} else {
+				var zero bool
+				dst.OpenID = zero
+			}
+		case "nonce":
+			if len(subs) > 0 {
+				return fmt.Errorf("'nonce' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Nonce = src.Nonce
+			} else {
+				var zero string
+				dst.Nonce = zero
+			}
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
 		}
@@ -580,6 +580,17 @@ func (m *OAuthAuthorizationCode) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "openid":
+			// no validation rules for OpenID
+		case "nonce":
+
+			if utf8.RuneCountInString(m.GetNonce()) > 256 {
+				return OAuthAuthorizationCodeValidationError{
+					field:  "nonce",
+					reason: "value length must be at most 256 runes",
+				}
+			}
+
 		default:
 			return OAuthAuthorizationCodeValidationError{
 				field:  name,
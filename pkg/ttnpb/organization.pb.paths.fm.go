@@ -47,6 +47,7 @@ var ListOrganizationsRequestFieldPathsNested = []string{
 	"collaborator.ids.user_ids",
 	"collaborator.ids.user_ids.email",
 	"collaborator.ids.user_ids.user_id",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -55,6 +56,7 @@ var ListOrganizationsRequestFieldPathsNested = []string{
 
 var ListOrganizationsRequestFieldPathsTopLevel = []string{
 	"collaborator",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -123,6 +125,8 @@ var GetOrganizationAPIKeyRequestFieldPathsTopLevel = []string{
 	"organization_ids",
 }
 var CreateOrganizationAPIKeyRequestFieldPathsNested = []string{
+	"allowed_ip_ranges",
+	"expires_at",
 	"name",
 	"organization_ids",
 	"organization_ids.organization_id",
@@ -130,12 +134,16 @@ var CreateOrganizationAPIKeyRequestFieldPathsNested = []string{
 }
 
 var CreateOrganizationAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_ip_ranges",
+	"expires_at",
 	"name",
 	"organization_ids",
 	"rights",
 }
 var UpdateOrganizationAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_ip_ranges",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
@@ -469,6 +469,8 @@ func (m *ListOrganizationsRequest) ValidateFields(paths ...string) error {
 
 		case "page":
 			// no validation rules for Page
+		case "deleted":
+			// no validation rules for Deleted
 		default:
 			return ListOrganizationsRequestValidationError{
 				field:  name,
@@ -1009,6 +1011,27 @@ func (m *CreateOrganizationAPIKeyRequest) ValidateFields(paths ...string) error
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateOrganizationAPIKeyRequestValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_ip_ranges":
+
+			if len(m.GetAllowedIPRanges()) > 20 {
+				return CreateOrganizationAPIKeyRequestValidationError{
+					field:  "allowed_ip_ranges",
+					reason: "value must contain no more than 20 item(s)",
+				}
+			}
+
 		default:
 			return CreateOrganizationAPIKeyRequestValidationError{
 				field:  name,
@@ -37,58 +37,61 @@ func init() {
 }
 
 var fileDescriptor_1a990e3af7846fd3 = []byte{
-	// 813 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x4f, 0x4c, 0x13, 0x4f,
-	0x14, 0xc7, 0x67, 0xf8, 0xfd, 0x7e, 0x4d, 0x7e, 0x03, 0x81, 0x38, 0x31, 0x9a, 0x54, 0x9c, 0x98,
-	0xd5, 0xc8, 0x9f, 0xc0, 0x6e, 0x04, 0x42, 0x02, 0x21, 0x12, 0xfe, 0xa5, 0x22, 0x44, 0x0d, 0xc4,
-	0x0b, 0x17, 0xb2, 0x2d, 0xc3, 0xb2, 0x69, 0xdd, 0x5d, 0x77, 0x06, 0x48, 0x25, 0x18, 0xe2, 0xc1,
-	0x70, 0xd3, 0xe8, 0xc5, 0x78, 0x10, 0xa3, 0x07, 0x31, 0x26, 0x06, 0x6f, 0x1c, 0x39, 0x72, 0x24,
-	0xf1, 0xc2, 0xc5, 0x84, 0xee, 0x7a, 0xe0, 0xc8, 0x91, 0xa3, 0xd9, 0xd9, 0x6d, 0xdc, 0x6d, 0x4b,
-	0x97, 0xd2, 0x5b, 0x3b, 0xfb, 0x7d, 0xef, 0x7d, 0xe6, 0xbd, 0xfd, 0xbe, 0x16, 0x75, 0xe7, 0x4c,
-	0x5b, 0x5d, 0x55, 0x8d, 0x6e, 0xc6, 0xd5, 0x4c, 0x56, 0x51, 0x2d, 0x5d, 0x31, 0x6d, 0x4d, 0x35,
-	0xf4, 0x67, 0x2a, 0xd7, 0x4d, 0x63, 0x9e, 0x51, 0x7b, 0x45, 0xcf, 0x50, 0x26, 0x5b, 0xb6, 0xc9,
-	0x4d, 0xdc, 0xcc, 0xb9, 0x21, 0x07, 0x21, 0xf2, 0x4a, 0x6f, 0xb2, 0x55, 0x33, 0x4d, 0x2d, 0x47,
-	0x45, 0x9c, 0x6a, 0x18, 0x26, 0x17, 0x51, 0x81, 0x3a, 0x79, 0x2d, 0x78, 0x2a, 0xbe, 0xa5, 0x97,
-	0x17, 0x15, 0xfa, 0xc4, 0xe2, 0xf9, 0xe0, 0xe1, 0xcd, 0xf2, 0xca, 0xfa, 0x02, 0x35, 0xb8, 0xbe,
-	0xa8, 0x53, 0xbb, 0x98, 0xe1, 0x56, 0x75, 0xbc, 0x40, 0x45, 0xca, 0x55, 0xb6, 0xae, 0x2d, 0xf1,
-	0x20, 0x4b, 0xcf, 0xaf, 0xff, 0xd0, 0xe5, 0x87, 0xa1, 0xb0, 0x19, 0xaa, 0xe9, 0x8c, 0xdb, 0x79,
-	0xfc, 0x06, 0xa2, 0xc4, 0x98, 0x4d, 0x55, 0x4e, 0x71, 0x87, 0x1c, 0xbd, 0x9a, 0xec, 0x9f, 0x47,
-	0xc3, 0x9e, 0x2e, 0x53, 0xc6, 0x93, 0xad, 0xa5, 0xd2, 0xb0, 0x48, 0x1a, 0x7e, 0xf1, 0xf3, 0xf7,
-	0xdb, 0x86, 0x01, 0xa9, 0x4f, 0x59, 0x66, 0xd4, 0x66, 0xca, 0x5a, 0xc6, 0xcc, 0xe5, 0xd4, 0xb4,
-	0x69, 0xab, 0xdc, 0xb4, 0x65, 0xef, 0x6c, 0x5e, 0x5f, 0x60, 0xc5, 0x0f, 0xeb, 0x91, 0xfb, 0xb0,
-	0x41, 0xd8, 0x89, 0x5f, 0x42, 0xf4, 0x4f, 0x8a, 0x72, 0x7c, 0xbb, 0xb4, 0x4c, 0x8a, 0xf2, 0xda,
-	0x71, 0x06, 0x04, 0x4e, 0x2f, 0xbe, 0x13, 0x2d, 0xa4, 0xac, 0x45, 0xc6, 0xec, 0x11, 0x95, 0x1c,
-	0xac, 0xe3, 0x2d, 0x88, 0xfe, 0x9d, 0xd6, 0x19, 0xc7, 0xed, 0xa5, 0x15, 0xbc, 0xd3, 0x70, 0x15,
-	0x56, 0x64, 0xb9, 0x5e, 0x8d, 0x85, 0x49, 0x0f, 0x04, 0xcc, 0x3d, 0xdc, 0x1c, 0x85, 0x99, 0xeb,
-	0xc7, 0x17, 0xea, 0x16, 0x7e, 0x05, 0x51, 0xe2, 0xb1, 0xb5, 0x50, 0x71, 0x7e, 0xfe, 0x79, 0xed,
-	0x0d, 0x1b, 0x12, 0x8c, 0xfd, 0xc9, 0xaa, 0x0d, 0x93, 0x2b, 0x35, 0xcc, 0x1b, 0x1e, 0x43, 0x89,
-	0x71, 0x9a, 0xa3, 0x9c, 0xe2, 0xb6, 0x6a, 0x55, 0x26, 0xff, 0xbe, 0xe9, 0xc9, 0x2b, 0xb2, 0x6f,
-	0x13, 0xb9, 0x68, 0x13, 0x79, 0xc2, 0xb3, 0x89, 0xd4, 0x2e, 0x40, 0xa4, 0xce, 0x1b, 0x31, 0x93,
-	0x5b, 0xef, 0xf9, 0xdc, 0x88, 0x70, 0x38, 0xfb, 0x48, 0x26, 0x43, 0x19, 0xc3, 0xcf, 0x11, 0xf2,
-	0x06, 0x35, 0x23, 0xac, 0x50, 0x0b, 0x4f, 0x89, 0xd0, 0x4f, 0x20, 0x29, 0x82, 0xa7, 0x03, 0xb7,
-	0xc5, 0xf1, 0x04, 0xe6, 0xc3, 0x1f, 0x20, 0x6a, 0xf2, 0x5d, 0x34, 0xf2, 0x68, 0x72, 0x8a, 0xe6,
-	0xb1, 0x12, 0xef, 0x31, 0x5f, 0x59, 0x9c, 0x54, 0x19, 0x8a, 0xff, 0x58, 0x9a, 0x10, 0x28, 0xc3,
-	0xd2, 0x60, 0xcd, 0x2f, 0xb5, 0xb7, 0x1c, 0xba, 0xb3, 0x34, 0x2f, 0x9c, 0xf6, 0x1e, 0xa2, 0x46,
-	0xaf, 0x43, 0x7e, 0x56, 0x86, 0xe5, 0xb8, 0xf7, 0x3c, 0x10, 0x16, 0xf1, 0xae, 0x56, 0xc6, 0x63,
-	0xd2, 0xa8, 0xe0, 0x1b, 0xc2, 0x75, 0xf0, 0x79, 0xdd, 0xfb, 0x3f, 0x45, 0x03, 0x36, 0xdc, 0x15,
-	0xb3, 0x0c, 0xce, 0xd7, 0xb7, 0x29, 0xc1, 0x35, 0x81, 0xc7, 0x2e, 0xce, 0xa5, 0xac, 0x65, 0x69,
-	0x5e, 0xac, 0x87, 0x6f, 0x10, 0x35, 0xf9, 0x26, 0x3b, 0x6b, 0xbc, 0xe5, 0x16, 0x3c, 0x1f, 0xe6,
-	0x8c, 0xc0, 0x9c, 0x4e, 0xa6, 0xea, 0xc1, 0x54, 0x2d, 0x7d, 0x3e, 0x4b, 0xf3, 0x72, 0x60, 0xcc,
-	0xad, 0x06, 0xd4, 0x92, 0xa2, 0x7c, 0x2c, 0xb4, 0x5e, 0x70, 0x4f, 0x4c, 0x53, 0xc3, 0xe2, 0x22,
-	0x73, 0x5b, 0x85, 0x98, 0xa8, 0x8e, 0x59, 0xa6, 0xc1, 0xa8, 0xf4, 0x1d, 0x8a, 0x5b, 0x7c, 0x81,
-	0xf8, 0x6e, 0xed, 0xd7, 0x08, 0x6f, 0xc1, 0x39, 0x8a, 0x33, 0xf5, 0x65, 0x10, 0xbb, 0x35, 0x6e,
-	0xb5, 0xe2, 0xaf, 0x10, 0xb5, 0xcc, 0xc6, 0x75, 0x68, 0x36, 0xbe, 0x43, 0x67, 0xed, 0xb3, 0xfb,
-	0xa2, 0x1f, 0xe3, 0xc9, 0xe1, 0xfa, 0x2e, 0x23, 0x9c, 0xfb, 0x03, 0xa2, 0x4b, 0x9e, 0x39, 0xc3,
-	0xf5, 0x19, 0xee, 0x8b, 0xf3, 0x6f, 0x44, 0x7e, 0xe6, 0x6f, 0x56, 0x44, 0x25, 0xa5, 0x04, 0xf6,
-	0x08, 0xae, 0x17, 0x7b, 0xf4, 0x13, 0xdc, 0x2f, 0x10, 0x78, 0x50, 0x20, 0xf0, 0xb0, 0x40, 0xc0,
-	0x51, 0x81, 0x80, 0xe3, 0x02, 0x01, 0x27, 0x05, 0x02, 0x4e, 0x0b, 0x04, 0x6e, 0x38, 0x04, 0x6e,
-	0x3a, 0x04, 0x6c, 0x3b, 0x04, 0xee, 0x38, 0x04, 0xec, 0x3a, 0x04, 0xec, 0x39, 0x04, 0xec, 0x3b,
-	0x04, 0x1e, 0x38, 0x04, 0x1e, 0x3a, 0x04, 0x1c, 0x39, 0x04, 0x1e, 0x3b, 0x04, 0x9c, 0x38, 0x04,
-	0x9e, 0x3a, 0x04, 0x6c, 0xb8, 0x04, 0x6c, 0xba, 0x04, 0xbe, 0x76, 0x09, 0x78, 0xe7, 0x12, 0xf8,
-	0xd1, 0x25, 0x60, 0xdb, 0x25, 0x60, 0xc7, 0x25, 0x70, 0xd7, 0x25, 0x70, 0xcf, 0x25, 0x70, 0xae,
-	0x4b, 0x33, 0x65, 0xbe, 0x44, 0xf9, 0x92, 0x6e, 0x68, 0x4c, 0x36, 0x28, 0x5f, 0x35, 0xed, 0xac,
-	0x12, 0xfd, 0xc7, 0x64, 0x65, 0x35, 0x85, 0x73, 0xc3, 0x4a, 0xa7, 0x13, 0x62, 0x68, 0xbd, 0x7f,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x94, 0x50, 0x95, 0x84, 0x18, 0x0a, 0x00, 0x00,
+	// 858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x4c, 0xdb, 0x56,
+	0x1c, 0xc7, 0xfd, 0xd8, 0x16, 0xb4, 0x07, 0x02, 0xed, 0x69, 0xda, 0xa4, 0x8c, 0x3d, 0x4d, 0xde,
+	0x04, 0x21, 0x02, 0x7b, 0x03, 0x84, 0x04, 0x42, 0x43, 0xfc, 0x53, 0xc6, 0x40, 0x1b, 0x0a, 0xda,
+	0x85, 0x0b, 0x72, 0xc2, 0xc3, 0x58, 0xc9, 0x6c, 0xd7, 0xef, 0x05, 0x9a, 0x52, 0x2a, 0xd4, 0x43,
+	0xc5, 0xad, 0x55, 0x7b, 0xa9, 0x7a, 0x28, 0x55, 0x7b, 0x28, 0x55, 0xa5, 0x8a, 0xde, 0x38, 0x72,
+	0xe4, 0x88, 0x54, 0x55, 0xe2, 0x48, 0xec, 0x1e, 0x38, 0x72, 0xe4, 0x58, 0xf9, 0xd9, 0x51, 0xed,
+	0x24, 0xc4, 0x84, 0x70, 0x83, 0xe7, 0xdf, 0x7b, 0xdf, 0xcf, 0xfb, 0xfe, 0xfc, 0xfb, 0x3a, 0xb0,
+	0x3f, 0x6f, 0x58, 0xca, 0x86, 0xa2, 0xf7, 0x53, 0xa6, 0x64, 0x73, 0xb2, 0x62, 0x6a, 0xb2, 0x61,
+	0xa9, 0x8a, 0xae, 0xdd, 0x51, 0x98, 0x66, 0xe8, 0xcb, 0x94, 0x58, 0xeb, 0x5a, 0x96, 0x50, 0xc9,
+	0xb4, 0x0c, 0x66, 0xa0, 0x0e, 0xc6, 0x74, 0xc9, 0xdf, 0x22, 0xad, 0x0f, 0xc6, 0xbb, 0x54, 0xc3,
+	0x50, 0xf3, 0x84, 0xef, 0x53, 0x74, 0xdd, 0x60, 0x7c, 0x97, 0x5f, 0x1d, 0xff, 0xc9, 0x7f, 0xca,
+	0xff, 0xcb, 0x14, 0x56, 0x65, 0xf2, 0xbf, 0xc9, 0x8a, 0xfe, 0xc3, 0x5f, 0xab, 0x95, 0xb5, 0x15,
+	0xa2, 0x33, 0x6d, 0x55, 0x23, 0x56, 0xf9, 0x84, 0xdf, 0xea, 0xe3, 0xf9, 0x55, 0xb8, 0xba, 0xca,
+	0xd2, 0xd4, 0x35, 0xe6, 0x9f, 0x32, 0xf0, 0xb1, 0x15, 0x7e, 0xff, 0x6f, 0x60, 0x5b, 0x9a, 0xa8,
+	0x1a, 0x65, 0x56, 0x11, 0x3d, 0x06, 0x30, 0x36, 0x65, 0x11, 0x85, 0x11, 0xd4, 0x2b, 0x85, 0xaf,
+	0x26, 0x79, 0xeb, 0xe1, 0x6d, 0xb7, 0x0a, 0x84, 0xb2, 0x78, 0x57, 0x65, 0x69, 0xb0, 0x48, 0x1c,
+	0xbf, 0xff, 0xe1, 0xd3, 0x93, 0x96, 0x11, 0x71, 0x48, 0x2e, 0x50, 0x62, 0x51, 0x79, 0x33, 0x6b,
+	0xe4, 0xf3, 0x4a, 0xc6, 0xb0, 0x14, 0x66, 0x58, 0x92, 0xbb, 0xb6, 0xac, 0xad, 0xd0, 0xf2, 0x1f,
+	0x5b, 0xa1, 0xfb, 0xd0, 0x51, 0x90, 0x44, 0x0f, 0x00, 0xfc, 0x2a, 0x45, 0x18, 0xea, 0xae, 0x94,
+	0x49, 0x11, 0xd6, 0x38, 0xce, 0x08, 0xc7, 0x19, 0x44, 0x7f, 0x84, 0x85, 0xe4, 0xcd, 0x50, 0x9b,
+	0x5d, 0xa2, 0x8a, 0x85, 0x2d, 0xb4, 0x0b, 0xe0, 0xd7, 0xf3, 0x1a, 0x65, 0x28, 0x51, 0xa9, 0xe0,
+	0xae, 0x06, 0x55, 0x68, 0x99, 0xe5, 0xe7, 0x7a, 0x2c, 0x54, 0xfc, 0x87, 0xc3, 0xfc, 0x85, 0x3a,
+	0xc2, 0x30, 0x4b, 0xc3, 0xe8, 0x5a, 0x6e, 0xa1, 0x87, 0x00, 0xc6, 0xfe, 0x33, 0x57, 0x6a, 0xf6,
+	0xcf, 0x5b, 0x6f, 0xdc, 0xb0, 0x31, 0xce, 0x38, 0x1c, 0xaf, 0x6b, 0x98, 0x54, 0xcb, 0x30, 0xb7,
+	0x79, 0x14, 0xc6, 0xa6, 0x49, 0x9e, 0x30, 0x82, 0x7a, 0xea, 0xa9, 0xcc, 0x7e, 0x79, 0xd3, 0xe3,
+	0x3f, 0x48, 0xde, 0x98, 0x48, 0xe5, 0x31, 0x91, 0x66, 0xdc, 0x31, 0x11, 0x13, 0x1c, 0x44, 0x4c,
+	0xfe, 0x12, 0xd1, 0xb9, 0x2d, 0x74, 0x17, 0xb6, 0xa6, 0x09, 0x65, 0x86, 0x75, 0x03, 0xaa, 0xbf,
+	0x73, 0xd5, 0xa4, 0x98, 0x88, 0x52, 0x95, 0x2d, 0x5f, 0xf2, 0x36, 0xfc, 0x66, 0xa1, 0x60, 0xa9,
+	0x37, 0xa0, 0x2d, 0x71, 0xed, 0x44, 0xb2, 0x3b, 0x52, 0xdb, 0x74, 0x05, 0x07, 0x5e, 0xb5, 0x41,
+	0x14, 0xd4, 0x98, 0xc8, 0x66, 0x09, 0xa5, 0xe8, 0x1e, 0x84, 0xee, 0x0b, 0x9a, 0xe6, 0x11, 0xd0,
+	0x08, 0x55, 0x45, 0xa1, 0x77, 0x80, 0x28, 0x73, 0xaa, 0x5e, 0xd4, 0x13, 0xed, 0x88, 0xa7, 0xf8,
+	0x1c, 0xc0, 0x76, 0x2f, 0x3d, 0x26, 0x16, 0x66, 0xe7, 0x48, 0x11, 0xc9, 0xd1, 0xd9, 0xe2, 0x55,
+	0x96, 0xdf, 0xd0, 0x2a, 0x14, 0xef, 0xb1, 0x38, 0xc3, 0x51, 0xc6, 0xc5, 0xd1, 0x86, 0x87, 0xd9,
+	0x0d, 0xc5, 0xfe, 0x1c, 0x29, 0xf2, 0x84, 0x79, 0x06, 0x60, 0x9b, 0xeb, 0x90, 0x77, 0x2a, 0x45,
+	0x52, 0xd4, 0x7c, 0xfb, 0x85, 0x65, 0xbc, 0x1f, 0x6b, 0xe3, 0x51, 0x71, 0x92, 0xf3, 0x8d, 0xa1,
+	0x26, 0xf8, 0x5c, 0xf7, 0xbe, 0x4d, 0x11, 0x9f, 0x0d, 0xf5, 0x45, 0x84, 0xe0, 0xd5, 0x7c, 0x9b,
+	0xe3, 0x5c, 0x33, 0x68, 0xea, 0xfa, 0x5c, 0xf2, 0x66, 0x8e, 0x14, 0xf9, 0xb4, 0xbd, 0x05, 0xb0,
+	0xdd, 0x0b, 0x97, 0xcb, 0xda, 0x5b, 0x1d, 0x3d, 0x57, 0xc3, 0x4c, 0x73, 0xcc, 0xf9, 0x78, 0xaa,
+	0x19, 0x4c, 0xc5, 0xd4, 0x96, 0x73, 0xa4, 0x28, 0xf9, 0x81, 0xb4, 0xdb, 0x02, 0x3b, 0x53, 0x84,
+	0x4d, 0x05, 0x62, 0x15, 0x0d, 0x44, 0x98, 0x1a, 0x2c, 0x2e, 0x33, 0xf7, 0xd4, 0xd8, 0x13, 0xae,
+	0xa3, 0xa6, 0xa1, 0x53, 0x22, 0xbe, 0x03, 0xfc, 0x16, 0xaf, 0x01, 0xfa, 0xb3, 0xf1, 0x6b, 0x04,
+	0xd3, 0x7f, 0x89, 0xa0, 0x6c, 0x73, 0x27, 0xf0, 0x6f, 0x4a, 0xd4, 0x27, 0x05, 0xbd, 0x01, 0xb0,
+	0x73, 0x31, 0xca, 0xa1, 0xc5, 0x68, 0x87, 0x2e, 0x4b, 0xb5, 0xbf, 0xb9, 0x1f, 0xd3, 0xf1, 0xf1,
+	0xe6, 0x2e, 0xc3, 0x27, 0xf7, 0x3d, 0x80, 0xdf, 0xb9, 0xc3, 0x19, 0xd4, 0xa7, 0x68, 0x28, 0x6a,
+	0x7e, 0x43, 0xe5, 0x97, 0x7e, 0xab, 0x43, 0x55, 0x62, 0x8a, 0x63, 0x4f, 0xa0, 0x66, 0xb1, 0x27,
+	0x5f, 0x82, 0xa3, 0x12, 0x06, 0xc7, 0x25, 0x0c, 0x4e, 0x4a, 0x58, 0x38, 0x2d, 0x61, 0xe1, 0xac,
+	0x84, 0x85, 0xf3, 0x12, 0x16, 0x2e, 0x4a, 0x18, 0x6c, 0xdb, 0x18, 0xec, 0xd8, 0x58, 0xd8, 0xb3,
+	0x31, 0xd8, 0xb7, 0xb1, 0x70, 0x60, 0x63, 0xe1, 0xd0, 0xc6, 0xc2, 0x91, 0x8d, 0xc1, 0xb1, 0x8d,
+	0xc1, 0x89, 0x8d, 0x85, 0x53, 0x1b, 0x83, 0x33, 0x1b, 0x0b, 0xe7, 0x36, 0x06, 0x17, 0x36, 0x16,
+	0xb6, 0x1d, 0x2c, 0xec, 0x38, 0x18, 0x3c, 0x72, 0xb0, 0xf0, 0xd4, 0xc1, 0xe0, 0x85, 0x83, 0x85,
+	0x3d, 0x07, 0x0b, 0xfb, 0x0e, 0x06, 0x07, 0x0e, 0x06, 0x87, 0x0e, 0x06, 0x4b, 0x7d, 0xaa, 0x21,
+	0xb1, 0x35, 0xc2, 0xd6, 0x34, 0x5d, 0xa5, 0x92, 0x4e, 0xd8, 0x86, 0x61, 0xe5, 0xe4, 0xf0, 0x2f,
+	0x45, 0x33, 0xa7, 0xca, 0x8c, 0xe9, 0x66, 0x26, 0x13, 0xe3, 0x4d, 0x1b, 0xfc, 0x1c, 0x00, 0x00,
+	0xff, 0xff, 0xba, 0xf1, 0x7c, 0xcb, 0x10, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -114,6 +117,11 @@ type OrganizationRegistryClient interface {
 	List(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*Organizations, error)
 	Update(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
 	Delete(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Restore a recently deleted organization. Only admins can restore organizations.
+	Restore(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the organization. This permanently deletes the organization and the data that is
+	// associated with it, and releases its ID. Only admins can purge organizations.
+	Purge(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type organizationRegistryClient struct {
@@ -169,6 +177,24 @@ func (c *organizationRegistryClient) Delete(ctx context.Context, in *Organizatio
 	return out, nil
 }
 
+func (c *organizationRegistryClient) Restore(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.OrganizationRegistry/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationRegistryClient) Purge(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.OrganizationRegistry/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrganizationRegistryServer is the server API for OrganizationRegistry service.
 type OrganizationRegistryServer interface {
 	// Create a new organization. This also sets the given user as
@@ -182,6 +208,11 @@ type OrganizationRegistryServer interface {
 	List(context.Context, *ListOrganizationsRequest) (*Organizations, error)
 	Update(context.Context, *UpdateOrganizationRequest) (*Organization, error)
 	Delete(context.Context, *OrganizationIdentifiers) (*types.Empty, error)
+	// Restore a recently deleted organization. Only admins can restore organizations.
+	Restore(context.Context, *OrganizationIdentifiers) (*types.Empty, error)
+	// Purge the organization. This permanently deletes the organization and the data that is
+	// associated with it, and releases its ID. Only admins can purge organizations.
+	Purge(context.Context, *OrganizationIdentifiers) (*types.Empty, error)
 }
 
 // UnimplementedOrganizationRegistryServer can be embedded to have forward compatible implementations.
@@ -203,6 +234,12 @@ func (*UnimplementedOrganizationRegistryServer) Update(ctx context.Context, req
 func (*UnimplementedOrganizationRegistryServer) Delete(ctx context.Context, req *OrganizationIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedOrganizationRegistryServer) Restore(ctx context.Context, req *OrganizationIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (*UnimplementedOrganizationRegistryServer) Purge(ctx context.Context, req *OrganizationIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
+}
 
 func RegisterOrganizationRegistryServer(s *grpc.Server, srv OrganizationRegistryServer) {
 	s.RegisterService(&_OrganizationRegistry_serviceDesc, srv)
@@ -298,6 +335,42 @@ func _OrganizationRegistry_Delete_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrganizationRegistry_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationRegistryServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.OrganizationRegistry/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationRegistryServer).Restore(ctx, req.(*OrganizationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationRegistry_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationRegistryServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.OrganizationRegistry/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationRegistryServer).Purge(ctx, req.(*OrganizationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _OrganizationRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.OrganizationRegistry",
 	HandlerType: (*OrganizationRegistryServer)(nil),
@@ -322,6 +395,14 @@ var _OrganizationRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _OrganizationRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _OrganizationRegistry_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _OrganizationRegistry_Purge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/organization_services.proto",
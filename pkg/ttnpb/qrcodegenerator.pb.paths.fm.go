@@ -73,8 +73,14 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_adr_ack_limit_exponent",
 	"end_device.mac_settings.desired_adr_ack_limit_exponent.value",
 	"end_device.mac_settings.desired_beacon_frequency",
+	"end_device.mac_settings.desired_channel_mask",
+	"end_device.mac_settings.desired_extra_channels",
+	"end_device.mac_settings.desired_max_data_rate_index",
+	"end_device.mac_settings.desired_max_data_rate_index.value",
 	"end_device.mac_settings.desired_max_duty_cycle",
 	"end_device.mac_settings.desired_max_duty_cycle.value",
+	"end_device.mac_settings.desired_min_data_rate_index",
+	"end_device.mac_settings.desired_min_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index",
 	"end_device.mac_settings.desired_ping_slot_data_rate_index.value",
 	"end_device.mac_settings.desired_ping_slot_frequency",
@@ -168,9 +174,11 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
+	"end_device.mac_state.pending_application_downlink.not_before",
 	"end_device.mac_state.pending_application_downlink.priority",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
@@ -390,9 +398,11 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
+	"end_device.pending_mac_state.pending_application_downlink.not_before",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
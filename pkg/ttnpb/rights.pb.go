@@ -11,10 +11,13 @@ import (
 	reflect "reflect"
 	strconv "strconv"
 	strings "strings"
+	time "time"
 
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 )
 
@@ -23,6 +26,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -282,9 +286,12 @@ func (Right) EnumDescriptor() ([]byte, []int) {
 }
 
 type Rights struct {
-	Rights               []Right  `protobuf:"varint,1,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Rights []Right `protobuf:"varint,1,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// The memberships through which the rights are granted.
+	// This is only set in responses of the ListRights RPCs of the Identity Server.
+	GrantedBy            []*MembershipPath `protobuf:"bytes,2,rep,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Rights) Reset()      { *m = Rights{} }
@@ -326,6 +333,71 @@ func (m *Rights) GetRights() []Right {
 	return nil
 }
 
+func (m *Rights) GetGrantedBy() []*MembershipPath {
+	if m != nil {
+		return m.GrantedBy
+	}
+	return nil
+}
+
+// MembershipPath is a chain of memberships through which rights on an entity are granted.
+type MembershipPath struct {
+	// The accounts in the chain, starting with the caller and ending with the
+	// direct collaborator of the entity. Every account in the chain is a member
+	// of the organization that follows it.
+	Path []OrganizationOrUserIdentifiers `protobuf:"bytes,1,rep,name=path,proto3" json:"path"`
+	// The rights granted through this chain of memberships.
+	Rights               []Right  `protobuf:"varint,2,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MembershipPath) Reset()      { *m = MembershipPath{} }
+func (*MembershipPath) ProtoMessage() {}
+func (*MembershipPath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9bb69af2cf8904c5, []int{1}
+}
+func (m *MembershipPath) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MembershipPath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MembershipPath.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MembershipPath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MembershipPath.Merge(m, src)
+}
+func (m *MembershipPath) XXX_Size() int {
+	return m.Size()
+}
+func (m *MembershipPath) XXX_DiscardUnknown() {
+	xxx_messageInfo_MembershipPath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MembershipPath proto.InternalMessageInfo
+
+func (m *MembershipPath) GetPath() []OrganizationOrUserIdentifiers {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *MembershipPath) GetRights() []Right {
+	if m != nil {
+		return m.Rights
+	}
+	return nil
+}
+
 type APIKey struct {
 	// Immutable and unique public identifier for the API key.
 	// Generated by the Access Server.
@@ -336,7 +408,13 @@ type APIKey struct {
 	// User-defined (friendly) name for the API key.
 	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	// Rights that are granted to this API key.
-	Rights               []Right  `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	Rights []Right `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Time after which the API key can no longer be used.
+	// If not set, the API key does not expire.
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// IP address ranges (in CIDR notation) from which the API key can be used.
+	// If empty, the API key can be used from any IP address.
+	AllowedIPRanges      []string `protobuf:"bytes,6,rep,name=allowed_ip_ranges,json=allowedIpRanges,proto3" json:"allowed_ip_ranges,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -344,7 +422,7 @@ type APIKey struct {
 func (m *APIKey) Reset()      { *m = APIKey{} }
 func (*APIKey) ProtoMessage() {}
 func (*APIKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9bb69af2cf8904c5, []int{1}
+	return fileDescriptor_9bb69af2cf8904c5, []int{2}
 }
 func (m *APIKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,6 +479,20 @@ func (m *APIKey) GetRights() []Right {
 	return nil
 }
 
+func (m *APIKey) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetAllowedIPRanges() []string {
+	if m != nil {
+		return m.AllowedIPRanges
+	}
+	return nil
+}
+
 type APIKeys struct {
 	APIKeys              []*APIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -410,7 +502,7 @@ type APIKeys struct {
 func (m *APIKeys) Reset()      { *m = APIKeys{} }
 func (*APIKeys) ProtoMessage() {}
 func (*APIKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9bb69af2cf8904c5, []int{2}
+	return fileDescriptor_9bb69af2cf8904c5, []int{3}
 }
 func (m *APIKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +548,7 @@ type Collaborator struct {
 func (m *Collaborator) Reset()      { *m = Collaborator{} }
 func (*Collaborator) ProtoMessage() {}
 func (*Collaborator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9bb69af2cf8904c5, []int{3}
+	return fileDescriptor_9bb69af2cf8904c5, []int{4}
 }
 func (m *Collaborator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +594,7 @@ type GetCollaboratorResponse struct {
 func (m *GetCollaboratorResponse) Reset()      { *m = GetCollaboratorResponse{} }
 func (*GetCollaboratorResponse) ProtoMessage() {}
 func (*GetCollaboratorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9bb69af2cf8904c5, []int{4}
+	return fileDescriptor_9bb69af2cf8904c5, []int{5}
 }
 func (m *GetCollaboratorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -547,7 +639,7 @@ type Collaborators struct {
 func (m *Collaborators) Reset()      { *m = Collaborators{} }
 func (*Collaborators) ProtoMessage() {}
 func (*Collaborators) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9bb69af2cf8904c5, []int{5}
+	return fileDescriptor_9bb69af2cf8904c5, []int{6}
 }
 func (m *Collaborators) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -588,6 +680,8 @@ func init() {
 	golang_proto.RegisterEnum("ttn.lorawan.v3.Right", Right_name, Right_value)
 	proto.RegisterType((*Rights)(nil), "ttn.lorawan.v3.Rights")
 	golang_proto.RegisterType((*Rights)(nil), "ttn.lorawan.v3.Rights")
+	proto.RegisterType((*MembershipPath)(nil), "ttn.lorawan.v3.MembershipPath")
+	golang_proto.RegisterType((*MembershipPath)(nil), "ttn.lorawan.v3.MembershipPath")
 	proto.RegisterType((*APIKey)(nil), "ttn.lorawan.v3.APIKey")
 	golang_proto.RegisterType((*APIKey)(nil), "ttn.lorawan.v3.APIKey")
 	proto.RegisterType((*APIKeys)(nil), "ttn.lorawan.v3.APIKeys")
@@ -606,83 +700,94 @@ func init() {
 }
 
 var fileDescriptor_9bb69af2cf8904c5 = []byte{
-	// 1210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x3b, 0x6c, 0xdb, 0x46,
-	0x18, 0xc7, 0x79, 0x7a, 0xe7, 0x1c, 0x39, 0x97, 0x4b, 0xec, 0x28, 0xb2, 0x73, 0x72, 0xe4, 0x3c,
-	0xdc, 0x34, 0x92, 0x5a, 0xa7, 0xaf, 0xa1, 0x28, 0x40, 0x4a, 0xb4, 0x42, 0x9b, 0x91, 0x54, 0x92,
-	0x4e, 0x90, 0x2c, 0x02, 0x6d, 0x33, 0x32, 0x61, 0x87, 0x14, 0x28, 0xc6, 0xa9, 0x3b, 0x05, 0x9d,
-	0x8c, 0x02, 0x05, 0x8a, 0x4e, 0x1d, 0x8b, 0x76, 0x09, 0xd0, 0x25, 0x5b, 0x33, 0x66, 0xf4, 0xe8,
-	0x31, 0x93, 0x11, 0x51, 0x4b, 0xc6, 0x8c, 0x86, 0xa7, 0x42, 0x24, 0x65, 0x3e, 0x24, 0xc5, 0x7d,
-	0x6c, 0xa7, 0xfb, 0x7e, 0xf7, 0xf1, 0xff, 0xff, 0xee, 0xfb, 0x0e, 0x82, 0x64, 0x5b, 0x37, 0xe4,
-	0x67, 0xb2, 0x56, 0xe8, 0x98, 0xf2, 0xfa, 0x56, 0x49, 0x6e, 0xab, 0x25, 0x43, 0x6d, 0x6d, 0x9a,
-	0x9d, 0x62, 0xdb, 0xd0, 0x4d, 0x1d, 0x4f, 0x9a, 0xa6, 0x56, 0x74, 0x99, 0xe2, 0xce, 0x9d, 0x2c,
-	0xdd, 0x52, 0xcd, 0xcd, 0xa7, 0x6b, 0xc5, 0x75, 0xfd, 0x49, 0x49, 0xd1, 0x76, 0xf4, 0xdd, 0xb6,
-	0xa1, 0x7f, 0xb7, 0x5b, 0xb2, 0xe1, 0xf5, 0x42, 0x4b, 0xd1, 0x0a, 0x3b, 0xf2, 0xb6, 0xba, 0x21,
-	0x9b, 0x4a, 0x69, 0x68, 0xe1, 0xa4, 0xcc, 0x16, 0x7c, 0x29, 0x5a, 0x7a, 0x4b, 0x77, 0x0e, 0xaf,
-	0x3d, 0x7d, 0x6c, 0xff, 0xb2, 0x7f, 0xd8, 0x2b, 0x17, 0x9f, 0x1f, 0x56, 0xa8, 0x6e, 0x28, 0x9a,
-	0xa9, 0x3e, 0x56, 0x15, 0xc3, 0x95, 0x99, 0x5f, 0x82, 0x09, 0xc1, 0x96, 0x8d, 0xbf, 0x86, 0x09,
-	0xc7, 0x40, 0x06, 0xcc, 0x45, 0x17, 0x26, 0x17, 0xa7, 0x8a, 0x41, 0x07, 0x45, 0x9b, 0x63, 0xd2,
-	0xc7, 0x0c, 0xfc, 0x05, 0x24, 0xf3, 0xf1, 0x1f, 0x40, 0x04, 0x01, 0xc1, 0x3d, 0x93, 0xff, 0x09,
-	0xc0, 0x04, 0xdd, 0xe0, 0x56, 0x94, 0x5d, 0x3c, 0x0d, 0x23, 0xea, 0x46, 0x06, 0xcc, 0x81, 0x85,
-	0x33, 0x4c, 0xc2, 0x3a, 0xcc, 0x45, 0xb8, 0x8a, 0x10, 0x51, 0x37, 0x30, 0x82, 0xd1, 0x2d, 0x65,
-	0x37, 0x13, 0xe9, 0x07, 0x84, 0xfe, 0x12, 0xcf, 0xc0, 0x98, 0x26, 0x3f, 0x51, 0x32, 0x51, 0x9b,
-	0x4d, 0x1e, 0x33, 0x31, 0x23, 0x92, 0x59, 0x14, 0xec, 0x4d, 0x9f, 0x9e, 0xd8, 0x7f, 0xd0, 0xc3,
-	0xc1, 0xa4, 0x23, 0xa7, 0x83, 0xbf, 0x81, 0x29, 0xb9, 0xad, 0x36, 0xb7, 0x94, 0x5d, 0xc7, 0xda,
-	0xc4, 0xe2, 0x74, 0x38, 0x95, 0x83, 0x32, 0x13, 0xd6, 0x61, 0x6e, 0x70, 0x4c, 0x48, 0xca, 0x6d,
-	0xb5, 0xbf, 0xc8, 0xff, 0x05, 0xe0, 0xd9, 0xb2, 0xbe, 0xbd, 0x2d, 0xaf, 0xe9, 0x86, 0x6c, 0xea,
-	0x06, 0xfe, 0x16, 0x46, 0xd5, 0x8d, 0x8e, 0xed, 0x70, 0x62, 0xb1, 0x10, 0xce, 0x55, 0x37, 0x5a,
-	0xb2, 0xa6, 0x7e, 0x2f, 0x9b, 0xaa, 0xae, 0xd5, 0x8d, 0xd5, 0x8e, 0x62, 0x70, 0x5e, 0xd5, 0x19,
-	0x74, 0xcc, 0xc4, 0x7f, 0xec, 0x2b, 0xdd, 0x3f, 0xcc, 0x51, 0x07, 0x87, 0x39, 0x20, 0xf4, 0x73,
-	0xf9, 0xcc, 0x46, 0xfe, 0xbd, 0xd9, 0xe5, 0x58, 0x2a, 0x8a, 0x62, 0xcb, 0xb1, 0x54, 0x0c, 0xc5,
-	0x97, 0x63, 0xa9, 0x38, 0x4a, 0x2c, 0xc7, 0x52, 0x09, 0x94, 0xcc, 0xff, 0x09, 0xe0, 0xa5, 0xaa,
-	0x62, 0xfa, 0xc5, 0x0b, 0x4a, 0xa7, 0xad, 0x6b, 0x1d, 0x05, 0x73, 0xff, 0xc3, 0x44, 0x2a, 0x28,
-	0xbe, 0xf0, 0x8f, 0xc4, 0x9f, 0xaa, 0x56, 0x84, 0x69, 0xbf, 0xd2, 0x0e, 0x66, 0x60, 0x7a, 0xdd,
-	0xbf, 0xe1, 0xde, 0xde, 0x6c, 0x38, 0x7d, 0xc0, 0x5f, 0xf0, 0xc8, 0xad, 0xa3, 0x49, 0x18, 0xb7,
-	0x3f, 0x8f, 0xcf, 0xc3, 0xb4, 0x2d, 0xa0, 0xa9, 0x6a, 0xf6, 0x5c, 0x21, 0x0a, 0x5f, 0x80, 0xe7,
-	0x04, 0xae, 0x7a, 0x57, 0x6a, 0xae, 0x8a, 0xac, 0xd0, 0xe4, 0x6a, 0x4b, 0x75, 0x04, 0xf0, 0x15,
-	0x78, 0xd9, 0xb7, 0x29, 0xb2, 0x92, 0xc4, 0xd5, 0xaa, 0x62, 0x93, 0xa1, 0x45, 0xae, 0x8c, 0x22,
-	0x78, 0x0e, 0xce, 0x8e, 0x0a, 0xd3, 0x0d, 0xae, 0xb9, 0xc2, 0x3e, 0x14, 0x51, 0x14, 0x4f, 0xc1,
-	0xf3, 0x3e, 0xa2, 0xc2, 0xf2, 0xac, 0xc4, 0xa2, 0x18, 0xbe, 0x0a, 0xaf, 0xf8, 0xb6, 0xe9, 0x55,
-	0xe9, 0x6e, 0x5d, 0xe0, 0x1e, 0xb1, 0x95, 0x66, 0x99, 0xe7, 0xd8, 0x9a, 0x24, 0xa2, 0x78, 0x28,
-	0x37, 0xdd, 0x68, 0xf0, 0x5c, 0x99, 0x96, 0xb8, 0x7a, 0x4d, 0x6c, 0xf2, 0x9c, 0x28, 0xa1, 0x04,
-	0xce, 0x43, 0x32, 0x8e, 0x28, 0x0b, 0x2c, 0x2d, 0xb1, 0x28, 0x89, 0x67, 0x61, 0xc6, 0xc7, 0x54,
-	0x69, 0x89, 0x7d, 0x40, 0x3f, 0x74, 0x33, 0xa4, 0x30, 0x81, 0xd9, 0x51, 0x51, 0xf7, 0xf4, 0x19,
-	0x3c, 0x03, 0x2f, 0xf9, 0xe2, 0xae, 0x36, 0xe7, 0x30, 0x0c, 0xd5, 0x66, 0x10, 0x74, 0xcf, 0x4e,
-	0x84, 0x2c, 0xd6, 0x85, 0x2a, 0x5d, 0xe3, 0x1e, 0xf9, 0x0d, 0x9c, 0xc5, 0xf3, 0x30, 0x37, 0x16,
-	0x71, 0xf3, 0xa4, 0x31, 0x86, 0x93, 0x7e, 0x97, 0x3c, 0x8f, 0x26, 0x71, 0x16, 0x4e, 0x3b, 0x7b,
-	0x3e, 0xd3, 0xce, 0x95, 0x9d, 0xc3, 0xd7, 0xe0, 0xdc, 0x70, 0x2c, 0x74, 0x73, 0x08, 0xdf, 0x84,
-	0xf3, 0x1f, 0xa0, 0x4e, 0x2e, 0xf0, 0x3c, 0xbe, 0x0d, 0x17, 0x3e, 0x00, 0x96, 0xeb, 0x3c, 0x4f,
-	0x33, 0x75, 0x81, 0x96, 0xea, 0x82, 0x88, 0xf0, 0x29, 0x69, 0x1b, 0x74, 0x79, 0x85, 0xae, 0xb2,
-	0x22, 0xfa, 0xca, 0xbb, 0x17, 0x3f, 0xe8, 0xb6, 0xc7, 0x05, 0xef, 0x66, 0x83, 0xd1, 0xfb, 0x5c,
-	0x99, 0x15, 0x9b, 0x02, 0x4b, 0x57, 0xd0, 0x45, 0xaf, 0x78, 0xa3, 0x98, 0x07, 0x02, 0x27, 0xb1,
-	0x68, 0x6a, 0xb4, 0x1e, 0x7f, 0x22, 0xc7, 0xe6, 0x34, 0x5e, 0x80, 0xd7, 0x4e, 0xc9, 0xe6, 0x90,
-	0x97, 0x46, 0x6b, 0x93, 0x04, 0x7a, 0x69, 0x89, 0x2b, 0x3b, 0xda, 0x32, 0xf8, 0x06, 0xcc, 0x8f,
-	0x67, 0x56, 0x1b, 0xae, 0xbc, 0xcb, 0xa3, 0xbf, 0x3a, 0xe0, 0x2a, 0xf5, 0x07, 0x35, 0x97, 0xcc,
-	0x8e, 0xbe, 0x71, 0x9e, 0xab, 0xad, 0xa0, 0x19, 0x7c, 0x19, 0x4e, 0x0d, 0xc7, 0xfa, 0x8d, 0x32,
-	0x8b, 0x2f, 0x42, 0xe4, 0x84, 0x9c, 0xf6, 0xb4, 0x77, 0xaf, 0xe0, 0x69, 0x88, 0x9d, 0x5d, 0xb7,
-	0xe3, 0x9d, 0xd6, 0x21, 0xde, 0xc8, 0x0d, 0xf6, 0x43, 0x6d, 0x93, 0xf3, 0x8a, 0x3e, 0x44, 0x9c,
-	0xb4, 0xcc, 0x9c, 0xe7, 0x6a, 0x08, 0x0a, 0xb6, 0xcb, 0x55, 0x9c, 0x81, 0x17, 0x83, 0xa4, 0xdb,
-	0x01, 0x79, 0x6f, 0x32, 0x07, 0x91, 0x40, 0x85, 0xe7, 0xbd, 0x2e, 0x0f, 0xc7, 0x7d, 0x55, 0xbb,
-	0x36, 0x6c, 0xd4, 0xae, 0xd8, 0x75, 0x6f, 0x74, 0x4f, 0x14, 0x4a, 0xb4, 0xb4, 0xea, 0xb6, 0xd6,
-	0x0d, 0x9c, 0x83, 0x33, 0xa1, 0x63, 0x75, 0xb7, 0xaa, 0x36, 0x70, 0xd3, 0x7b, 0xd5, 0x06, 0x40,
-	0xbf, 0xae, 0x0b, 0xde, 0x73, 0xe1, 0x1f, 0x65, 0xa7, 0xb8, 0x1f, 0xe1, 0xeb, 0xf0, 0xea, 0x88,
-	0x60, 0xa8, 0xc2, 0xb7, 0xbc, 0xe2, 0x8d, 0xc6, 0x4e, 0xca, 0xfc, 0xb1, 0xd7, 0xdb, 0xa3, 0xc9,
-	0x7b, 0xec, 0x3d, 0x86, 0x15, 0x44, 0x74, 0xdb, 0x73, 0x1b, 0x00, 0xdd, 0x52, 0x17, 0xc6, 0x7c,
-	0x71, 0xf8, 0xc1, 0x2d, 0xe2, 0x5b, 0xf0, 0xc6, 0x69, 0xa4, 0xfb, 0x6c, 0x95, 0xbc, 0x0b, 0x0a,
-	0xb0, 0xc1, 0x07, 0xf8, 0x13, 0x6f, 0x50, 0x46, 0x53, 0x6e, 0xb6, 0x4f, 0xbd, 0xbe, 0x0b, 0x70,
-	0x81, 0x07, 0x79, 0x71, 0x4c, 0x85, 0x43, 0x0f, 0xf3, 0x9d, 0x71, 0x2e, 0x2a, 0x95, 0x26, 0x1d,
-	0xec, 0x50, 0xf4, 0x99, 0x37, 0x76, 0x41, 0x96, 0xe7, 0xd1, 0xe7, 0x5e, 0x73, 0x89, 0x6c, 0xad,
-	0xd2, 0xe4, 0x6a, 0xf7, 0x39, 0x89, 0x15, 0xd1, 0x17, 0x38, 0x0d, 0xcf, 0xb8, 0xe3, 0xc8, 0xf3,
-	0xe8, 0xcb, 0x6c, 0x6c, 0xef, 0x0f, 0x42, 0x31, 0xbf, 0x83, 0xfd, 0x2e, 0x01, 0x07, 0x5d, 0x02,
-	0xde, 0x74, 0x09, 0xf5, 0xb6, 0x4b, 0xa8, 0x77, 0x5d, 0x42, 0xbd, 0xef, 0x12, 0xea, 0xa8, 0x4b,
-	0xc0, 0x73, 0x8b, 0x80, 0x3d, 0x8b, 0x50, 0x2f, 0x2c, 0x02, 0x5e, 0x5a, 0x84, 0x7a, 0x65, 0x11,
-	0xea, 0xb5, 0x45, 0xa8, 0x7d, 0x8b, 0x80, 0x03, 0x8b, 0x80, 0x37, 0x16, 0xa1, 0xde, 0x5a, 0x04,
-	0xbc, 0xb3, 0x08, 0xf5, 0xde, 0x22, 0xe0, 0xc8, 0x22, 0xd4, 0xf3, 0x1e, 0xa1, 0xf6, 0x7a, 0x04,
-	0xfc, 0xdc, 0x23, 0xd4, 0xaf, 0x3d, 0x02, 0x7e, 0xeb, 0x11, 0xea, 0x45, 0x8f, 0x50, 0x2f, 0x7b,
-	0x04, 0xbc, 0xea, 0x11, 0xf0, 0xba, 0x47, 0xc0, 0xa3, 0xdb, 0x2d, 0xbd, 0x68, 0x6e, 0x2a, 0xe6,
-	0xa6, 0xaa, 0xb5, 0x3a, 0x45, 0x4d, 0x31, 0x9f, 0xe9, 0xc6, 0x56, 0x29, 0xf8, 0x5f, 0xb8, 0xbd,
-	0xd5, 0x2a, 0x99, 0xa6, 0xd6, 0x5e, 0x5b, 0x4b, 0xd8, 0x7f, 0x83, 0xef, 0xfc, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0xe6, 0x94, 0x91, 0x73, 0xcf, 0x0b, 0x00, 0x00,
+	// 1380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4b, 0x70, 0xd3, 0x56,
+	0x14, 0xd5, 0xf3, 0x2f, 0xc9, 0x0b, 0x49, 0x5e, 0x1e, 0x49, 0x30, 0x49, 0x78, 0x36, 0x0e, 0x1f,
+	0x97, 0x12, 0xbb, 0x0d, 0xfd, 0x2d, 0xfa, 0x19, 0xc9, 0x16, 0x46, 0x89, 0xb1, 0x5d, 0x49, 0x81,
+	0x81, 0x8d, 0x46, 0x49, 0x84, 0xa3, 0x49, 0x22, 0x69, 0x24, 0xf1, 0x49, 0x57, 0x4c, 0x67, 0x3a,
+	0xc3, 0x74, 0xc5, 0xb0, 0xa1, 0xcb, 0x4e, 0xbb, 0x61, 0xa6, 0x1b, 0x76, 0x65, 0xc9, 0x92, 0x25,
+	0x4b, 0x56, 0x29, 0x96, 0x37, 0x2c, 0x59, 0x32, 0x59, 0x75, 0x2c, 0xc9, 0x91, 0x64, 0x3b, 0xa4,
+	0x94, 0xdd, 0xf3, 0xbd, 0xe7, 0x5e, 0xdd, 0x73, 0xee, 0x79, 0x6f, 0x12, 0x48, 0xb6, 0x75, 0x53,
+	0xbe, 0x2b, 0x6b, 0x8b, 0x96, 0x2d, 0xaf, 0x6f, 0x15, 0x65, 0x43, 0x2d, 0x9a, 0x6a, 0x73, 0xd3,
+	0xb6, 0x0a, 0x86, 0xa9, 0xdb, 0x3a, 0x1e, 0xb7, 0x6d, 0xad, 0xe0, 0x63, 0x0a, 0x77, 0x2e, 0xcd,
+	0xd2, 0x4d, 0xd5, 0xde, 0xbc, 0xbd, 0x56, 0x58, 0xd7, 0x77, 0x8a, 0x8a, 0x76, 0x47, 0xdf, 0x35,
+	0x4c, 0xfd, 0xde, 0x6e, 0xd1, 0x05, 0xaf, 0x2f, 0x36, 0x15, 0x6d, 0xf1, 0x8e, 0xbc, 0xad, 0x6e,
+	0xc8, 0xb6, 0x52, 0xec, 0x3b, 0x78, 0x2d, 0x67, 0x17, 0x43, 0x2d, 0x9a, 0x7a, 0x53, 0xf7, 0x8a,
+	0xd7, 0x6e, 0xdf, 0x72, 0x7f, 0xb9, 0x3f, 0xdc, 0x93, 0x0f, 0xcf, 0x34, 0x75, 0xbd, 0xb9, 0xad,
+	0x04, 0x28, 0x5b, 0xdd, 0x51, 0x2c, 0x5b, 0xde, 0x31, 0x7c, 0xc0, 0x42, 0x3f, 0x05, 0x75, 0x43,
+	0xd1, 0x6c, 0xf5, 0x96, 0xaa, 0x98, 0x3e, 0x8f, 0xdc, 0x2f, 0x00, 0xa6, 0x78, 0x97, 0x18, 0xfe,
+	0x16, 0xa6, 0x3c, 0x8a, 0x69, 0x90, 0x8d, 0xe7, 0xc7, 0x97, 0xa6, 0x0b, 0x51, 0x8e, 0x05, 0x17,
+	0xc7, 0x8c, 0xed, 0x33, 0xf0, 0x11, 0x18, 0xca, 0x25, 0x7f, 0x06, 0x31, 0x04, 0x78, 0xbf, 0x06,
+	0x7f, 0x07, 0x61, 0xd3, 0x94, 0x35, 0x5b, 0xd9, 0x90, 0xd6, 0x76, 0xd3, 0xb1, 0x6c, 0x3c, 0x3f,
+	0xba, 0x44, 0x7a, 0x3b, 0x5c, 0x55, 0x76, 0xd6, 0x14, 0xd3, 0xda, 0x54, 0x8d, 0x86, 0x6c, 0x6f,
+	0xf2, 0x23, 0x7e, 0x05, 0xb3, 0x9b, 0x7b, 0x0c, 0xe0, 0x78, 0x34, 0x8b, 0x2b, 0x30, 0x61, 0xc8,
+	0xf6, 0xa6, 0x3b, 0xcd, 0xe8, 0xd2, 0x62, 0x6f, 0xaf, 0xba, 0xd9, 0x94, 0x35, 0xf5, 0x27, 0xd9,
+	0x56, 0x75, 0xad, 0x6e, 0xae, 0x5a, 0x8a, 0xc9, 0x05, 0xec, 0x98, 0xc4, 0x8b, 0xbd, 0x0c, 0xc5,
+	0xbb, 0x0d, 0x42, 0xc4, 0x62, 0x1f, 0x4e, 0x2c, 0xf7, 0x38, 0x06, 0x53, 0x74, 0x83, 0x5b, 0x51,
+	0x76, 0xf1, 0x0c, 0x8c, 0xa9, 0x1b, 0x69, 0x90, 0x05, 0xf9, 0x11, 0x26, 0xe5, 0xec, 0x65, 0x62,
+	0x5c, 0x99, 0x8f, 0xa9, 0x1b, 0x18, 0xc1, 0xf8, 0x96, 0xd2, 0x21, 0x0d, 0xf2, 0x23, 0x7c, 0xe7,
+	0x88, 0xe7, 0x60, 0x42, 0x93, 0x77, 0x94, 0x74, 0xdc, 0xc5, 0x0e, 0xed, 0x33, 0x09, 0x33, 0x96,
+	0x5e, 0xe2, 0xdd, 0x60, 0x68, 0x9e, 0xc4, 0xff, 0x10, 0xfa, 0x07, 0x08, 0x95, 0x7b, 0x86, 0x6a,
+	0x2a, 0x96, 0x24, 0xdb, 0xe9, 0x64, 0x16, 0xe4, 0x47, 0x97, 0x66, 0x0b, 0x9e, 0x19, 0x0a, 0x5d,
+	0x33, 0x14, 0xc4, 0xae, 0x19, 0x98, 0xc4, 0xc3, 0x7f, 0x32, 0x80, 0x1f, 0xf1, 0x6b, 0x68, 0x1b,
+	0x57, 0xe0, 0xa4, 0xbc, 0xbd, 0xad, 0xdf, 0x55, 0x36, 0x24, 0xd5, 0x90, 0x4c, 0x59, 0x6b, 0x2a,
+	0x56, 0x3a, 0x95, 0x8d, 0xe7, 0x47, 0x98, 0xb9, 0x7d, 0x26, 0xf9, 0x08, 0xc4, 0xd0, 0x94, 0xb3,
+	0x97, 0x99, 0xa0, 0x3d, 0x10, 0xd7, 0xe0, 0x5d, 0x08, 0x3f, 0xe1, 0x57, 0x71, 0x86, 0x17, 0xc8,
+	0x71, 0x70, 0xc8, 0x13, 0xc6, 0xc2, 0xdf, 0xc3, 0x61, 0xd9, 0x50, 0xa5, 0x2d, 0x65, 0xd7, 0xf2,
+	0xf7, 0x35, 0xd3, 0x4b, 0xca, 0x83, 0x32, 0xa3, 0xce, 0x5e, 0xa6, 0x5b, 0xc6, 0x0f, 0xc9, 0x86,
+	0xda, 0x39, 0xe4, 0xfe, 0x06, 0xf0, 0x58, 0x49, 0xdf, 0xde, 0x96, 0xd7, 0x74, 0x53, 0xb6, 0x75,
+	0x13, 0xff, 0x08, 0xe3, 0xea, 0x86, 0xe5, 0x6a, 0xfd, 0xc1, 0xbb, 0x47, 0xfb, 0x4c, 0xf2, 0xd7,
+	0x8e, 0x66, 0x1d, 0x0f, 0xbc, 0xdc, 0xcb, 0x00, 0xbe, 0xd3, 0xeb, 0xe3, 0x6c, 0xb0, 0x9c, 0x18,
+	0x8e, 0xa3, 0xc4, 0x72, 0x62, 0x38, 0x81, 0x92, 0xcb, 0x89, 0xe1, 0x24, 0x4a, 0x2d, 0x27, 0x86,
+	0x53, 0x68, 0x28, 0xf7, 0x17, 0x80, 0x27, 0x2a, 0x8a, 0x1d, 0x1e, 0x9e, 0x57, 0x2c, 0x43, 0xd7,
+	0x2c, 0x05, 0x73, 0x1f, 0x41, 0x62, 0x38, 0x3a, 0xfc, 0xe2, 0x7f, 0x1a, 0xfe, 0xc8, 0x69, 0x05,
+	0x38, 0x16, 0x9e, 0xd4, 0xc2, 0x0c, 0x1c, 0x5b, 0x0f, 0x07, 0xfc, 0xed, 0xcd, 0xf7, 0xb6, 0x8f,
+	0xf0, 0x8b, 0x96, 0x5c, 0x78, 0x37, 0x0e, 0x93, 0xee, 0xe7, 0xf1, 0x24, 0x1c, 0x73, 0x07, 0x90,
+	0x54, 0xcd, 0x7d, 0xdc, 0x10, 0x85, 0x8f, 0xc3, 0x09, 0x9e, 0xab, 0x5c, 0x11, 0xa5, 0x55, 0x81,
+	0xe5, 0x25, 0xae, 0x76, 0xb9, 0x8e, 0x00, 0x3e, 0x05, 0x4f, 0x86, 0x82, 0x02, 0x2b, 0x8a, 0x5c,
+	0xad, 0x22, 0x48, 0x0c, 0x2d, 0x70, 0x25, 0x14, 0xc3, 0x59, 0x38, 0x3f, 0x28, 0x4d, 0x37, 0x38,
+	0x69, 0x85, 0xbd, 0x21, 0xa0, 0x38, 0x9e, 0x86, 0x93, 0x21, 0x44, 0x99, 0xad, 0xb2, 0x22, 0x8b,
+	0x12, 0xf8, 0x34, 0x3c, 0x15, 0x0a, 0xd3, 0xab, 0xe2, 0x95, 0x3a, 0xcf, 0xdd, 0x64, 0xcb, 0x52,
+	0xa9, 0xca, 0xb1, 0x35, 0x51, 0x40, 0xc9, 0x9e, 0xde, 0x74, 0xa3, 0x51, 0xe5, 0x4a, 0xb4, 0xc8,
+	0xd5, 0x6b, 0x82, 0x54, 0xe5, 0x04, 0x11, 0xa5, 0x70, 0x0e, 0x92, 0xc3, 0x10, 0x25, 0x9e, 0xa5,
+	0x45, 0x16, 0x0d, 0xe1, 0x79, 0x98, 0x0e, 0x61, 0x2a, 0xb4, 0xc8, 0x5e, 0xa7, 0x6f, 0xf8, 0x1d,
+	0x86, 0x31, 0x81, 0xb3, 0x83, 0xb2, 0x7e, 0xf5, 0x08, 0x9e, 0x83, 0x27, 0x42, 0x79, 0x7f, 0x36,
+	0xaf, 0x18, 0xf6, 0x68, 0xd3, 0x4d, 0xfa, 0xb5, 0xa3, 0x3d, 0x14, 0xeb, 0x7c, 0x85, 0xae, 0x71,
+	0x37, 0xc3, 0x04, 0x8e, 0xe1, 0x05, 0x98, 0x39, 0x14, 0xe2, 0xf7, 0x19, 0xc3, 0x18, 0x8e, 0x87,
+	0x59, 0x56, 0xab, 0x68, 0x1c, 0xcf, 0xc2, 0x19, 0x2f, 0x16, 0x22, 0xed, 0xad, 0x6c, 0x02, 0x9f,
+	0x81, 0xd9, 0xfe, 0x5c, 0xcf, 0xe6, 0x10, 0x3e, 0x0f, 0x17, 0xde, 0x83, 0x3a, 0x58, 0xe0, 0x24,
+	0xbe, 0x08, 0xf3, 0xef, 0x01, 0x96, 0xea, 0xd5, 0x2a, 0xcd, 0xd4, 0x79, 0x5a, 0xac, 0xf3, 0x02,
+	0xc2, 0x47, 0xb4, 0x6d, 0xd0, 0xa5, 0x15, 0xba, 0xc2, 0x0a, 0xe8, 0x9b, 0x60, 0x2f, 0x61, 0xa0,
+	0x6f, 0x8f, 0xe3, 0xc1, 0x66, 0xa3, 0xd9, 0x6b, 0x5c, 0x89, 0x15, 0x24, 0x9e, 0xa5, 0xcb, 0x68,
+	0x2a, 0x10, 0x6f, 0x10, 0xe6, 0x3a, 0xcf, 0x89, 0x2c, 0x9a, 0x1e, 0x3c, 0x4f, 0xb8, 0x91, 0x47,
+	0x73, 0x06, 0xe7, 0xe1, 0x99, 0x23, 0xba, 0x79, 0xc8, 0x13, 0x83, 0x67, 0x13, 0x79, 0xfa, 0xf2,
+	0x65, 0xae, 0xe4, 0xcd, 0x96, 0xc6, 0xe7, 0x60, 0xee, 0x70, 0xcc, 0x6a, 0xc3, 0x1f, 0xef, 0xe4,
+	0xe0, 0xaf, 0x76, 0x71, 0xe5, 0xfa, 0xf5, 0x9a, 0x8f, 0x9c, 0x1d, 0xbc, 0xf1, 0x2a, 0x57, 0x5b,
+	0x41, 0x73, 0xf8, 0x24, 0x9c, 0xee, 0xcf, 0x75, 0x8c, 0x32, 0x8f, 0xa7, 0x20, 0xf2, 0x52, 0x9e,
+	0x3d, 0xdd, 0xe8, 0x29, 0x3c, 0x03, 0xb1, 0x17, 0xf5, 0x1d, 0xef, 0x59, 0x87, 0x04, 0x57, 0xae,
+	0x1b, 0xef, 0xb1, 0x4d, 0x26, 0x10, 0xbd, 0x0f, 0x71, 0x60, 0x99, 0x6c, 0xc0, 0xaa, 0x0f, 0x14,
+	0xb5, 0xcb, 0x69, 0x9c, 0x86, 0x53, 0x51, 0xa4, 0xef, 0x80, 0x5c, 0x70, 0x33, 0xbb, 0x99, 0x88,
+	0xc2, 0x0b, 0x81, 0xcb, 0x7b, 0xf3, 0x21, 0xd5, 0xce, 0xf4, 0x13, 0x75, 0x15, 0x3b, 0x1b, 0x5c,
+	0xdd, 0x83, 0x09, 0x45, 0x5a, 0x5c, 0xf5, 0xad, 0x75, 0x0e, 0x67, 0xe0, 0x5c, 0x4f, 0x59, 0xdd,
+	0x57, 0xd5, 0x05, 0x9c, 0x0f, 0x5e, 0xb5, 0x2e, 0xa0, 0xa3, 0x6b, 0x3e, 0x78, 0x2e, 0xc2, 0x57,
+	0xd9, 0x13, 0xf7, 0x13, 0x7c, 0x16, 0x9e, 0x1e, 0x90, 0xec, 0x51, 0xf8, 0x42, 0x20, 0xde, 0x60,
+	0xd8, 0x81, 0xcc, 0x9f, 0x06, 0xde, 0x1e, 0x8c, 0xbc, 0xca, 0x5e, 0x65, 0x58, 0x5e, 0x40, 0x17,
+	0x03, 0xb6, 0x11, 0xa0, 0x2f, 0xf5, 0xe2, 0x21, 0x5f, 0xec, 0x7f, 0x70, 0x0b, 0xf8, 0x02, 0x3c,
+	0x77, 0x14, 0xd2, 0x7f, 0xb6, 0x8a, 0xc1, 0x82, 0x22, 0xd8, 0xe8, 0x03, 0xfc, 0x59, 0x70, 0x51,
+	0x06, 0xa3, 0xfc, 0x6e, 0x9f, 0x07, 0xbe, 0x8b, 0xe0, 0x22, 0x0f, 0xf2, 0xd2, 0x21, 0x0a, 0xf7,
+	0x3c, 0xcc, 0x97, 0x0e, 0x63, 0x51, 0x2e, 0x4b, 0x74, 0xd4, 0xa1, 0xe8, 0x8b, 0xe0, 0xda, 0x45,
+	0xb1, 0xd5, 0x2a, 0xfa, 0x32, 0x30, 0x97, 0xc0, 0xd6, 0xca, 0x12, 0x57, 0xbb, 0xc6, 0x89, 0xac,
+	0x80, 0xbe, 0xc2, 0x63, 0x70, 0xc4, 0x8b, 0x77, 0x60, 0x5f, 0xcf, 0x26, 0x1e, 0xfc, 0x49, 0x28,
+	0xe6, 0x0f, 0xf0, 0xa2, 0x45, 0xc0, 0xcb, 0x16, 0x01, 0xaf, 0x5a, 0x84, 0x7a, 0xdd, 0x22, 0xd4,
+	0x9b, 0x16, 0xa1, 0xde, 0xb6, 0x08, 0xf5, 0xae, 0x45, 0xc0, 0x7d, 0x87, 0x80, 0x07, 0x0e, 0xa1,
+	0x9e, 0x38, 0x04, 0x3c, 0x75, 0x08, 0xf5, 0xcc, 0x21, 0xd4, 0x73, 0x87, 0x50, 0x2f, 0x1c, 0x02,
+	0x5e, 0x3a, 0x04, 0xbc, 0x72, 0x08, 0xf5, 0xda, 0x21, 0xe0, 0x8d, 0x43, 0xa8, 0xb7, 0x0e, 0x01,
+	0xef, 0x1c, 0x42, 0xdd, 0x6f, 0x13, 0xea, 0x41, 0x9b, 0x80, 0x87, 0x6d, 0x42, 0xfd, 0xd6, 0x26,
+	0xe0, 0xf7, 0x36, 0xa1, 0x9e, 0xb4, 0x09, 0xf5, 0xb4, 0x4d, 0xc0, 0xb3, 0x36, 0x01, 0xcf, 0xdb,
+	0x04, 0xdc, 0xbc, 0xd8, 0xd4, 0x0b, 0xf6, 0xa6, 0x62, 0x6f, 0xaa, 0x5a, 0xd3, 0x2a, 0x68, 0x8a,
+	0x7d, 0x57, 0x37, 0xb7, 0x8a, 0xd1, 0xff, 0x37, 0x8c, 0xad, 0x66, 0xd1, 0xb6, 0x35, 0x63, 0x6d,
+	0x2d, 0xe5, 0xfe, 0x55, 0x7a, 0xe9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0xaf, 0xd3, 0xc4,
+	0x54, 0x0d, 0x00, 0x00,
 }
 
 func (x Right) String() string {
@@ -719,6 +824,51 @@ func (this *Rights) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.GrantedBy) != len(that1.GrantedBy) {
+		return false
+	}
+	for i := range this.GrantedBy {
+		if !this.GrantedBy[i].Equal(that1.GrantedBy[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MembershipPath) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MembershipPath)
+	if !ok {
+		that2, ok := that.(MembershipPath)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Path) != len(that1.Path) {
+		return false
+	}
+	for i := range this.Path {
+		if !this.Path[i].Equal(&that1.Path[i]) {
+			return false
+		}
+	}
+	if len(this.Rights) != len(that1.Rights) {
+		return false
+	}
+	for i := range this.Rights {
+		if this.Rights[i] != that1.Rights[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *APIKey) Equal(that interface{}) bool {
@@ -757,6 +907,21 @@ func (this *APIKey) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedIPRanges) != len(that1.AllowedIPRanges) {
+		return false
+	}
+	for i := range this.AllowedIPRanges {
+		if this.AllowedIPRanges[i] != that1.AllowedIPRanges[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *APIKeys) Equal(that interface{}) bool {
@@ -901,6 +1066,20 @@ func (m *Rights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GrantedBy) > 0 {
+		for iNdEx := len(m.GrantedBy) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GrantedBy[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRights(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Rights) > 0 {
 		dAtA2 := make([]byte, len(m.Rights)*10)
 		var j1 int
@@ -922,7 +1101,7 @@ func (m *Rights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *APIKey) Marshal() (dAtA []byte, err error) {
+func (m *MembershipPath) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -932,12 +1111,12 @@ func (m *APIKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *APIKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *MembershipPath) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *APIKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MembershipPath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -958,6 +1137,80 @@ func (m *APIKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA4[:j3])
 		i = encodeVarintRights(dAtA, i, uint64(j3))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		for iNdEx := len(m.Path) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Path[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRights(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *APIKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *APIKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *APIKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedIPRanges) > 0 {
+		for iNdEx := len(m.AllowedIPRanges) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedIPRanges[iNdEx])
+			copy(dAtA[i:], m.AllowedIPRanges[iNdEx])
+			i = encodeVarintRights(dAtA, i, uint64(len(m.AllowedIPRanges[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ExpiresAt != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintRights(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Rights) > 0 {
+		dAtA7 := make([]byte, len(m.Rights)*10)
+		var j6 int
+		for _, num := range m.Rights {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintRights(dAtA, i, uint64(j6))
+		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.Name) > 0 {
@@ -1042,20 +1295,20 @@ func (m *Collaborator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Rights) > 0 {
-		dAtA6 := make([]byte, len(m.Rights)*10)
-		var j5 int
+		dAtA9 := make([]byte, len(m.Rights)*10)
+		var j8 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j5++
+				j8++
 			}
-			dAtA6[j5] = uint8(num)
-			j5++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintRights(dAtA, i, uint64(j5))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintRights(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1093,20 +1346,20 @@ func (m *GetCollaboratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if len(m.Rights) > 0 {
-		dAtA9 := make([]byte, len(m.Rights)*10)
-		var j8 int
+		dAtA12 := make([]byte, len(m.Rights)*10)
+		var j11 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j11++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintRights(dAtA, i, uint64(j8))
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintRights(dAtA, i, uint64(j11))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1178,6 +1431,33 @@ func NewPopulatedRights(r randyRights, easy bool) *Rights {
 	for i := 0; i < v1; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
+	if r.Intn(5) != 0 {
+		v2 := r.Intn(5)
+		this.GrantedBy = make([]*MembershipPath, v2)
+		for i := 0; i < v2; i++ {
+			this.GrantedBy[i] = NewPopulatedMembershipPath(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMembershipPath(r randyRights, easy bool) *MembershipPath {
+	this := &MembershipPath{}
+	if r.Intn(5) != 0 {
+		v3 := r.Intn(5)
+		this.Path = make([]OrganizationOrUserIdentifiers, v3)
+		for i := 0; i < v3; i++ {
+			v4 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+			this.Path[i] = *v4
+		}
+	}
+	v5 := r.Intn(10)
+	this.Rights = make([]Right, v5)
+	for i := 0; i < v5; i++ {
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1188,11 +1468,19 @@ func NewPopulatedAPIKey(r randyRights, easy bool) *APIKey {
 	this.ID = randStringRights(r)
 	this.Key = randStringRights(r)
 	this.Name = randStringRights(r)
-	v2 := r.Intn(10)
-	this.Rights = make([]Right, v2)
-	for i := 0; i < v2; i++ {
+	v6 := r.Intn(10)
+	this.Rights = make([]Right, v6)
+	for i := 0; i < v6; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v7 := r.Intn(10)
+	this.AllowedIPRanges = make([]string, v7)
+	for i := 0; i < v7; i++ {
+		this.AllowedIPRanges[i] = randStringRights(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1201,9 +1489,9 @@ func NewPopulatedAPIKey(r randyRights, easy bool) *APIKey {
 func NewPopulatedAPIKeys(r randyRights, easy bool) *APIKeys {
 	this := &APIKeys{}
 	if r.Intn(5) != 0 {
-		v3 := r.Intn(5)
-		this.APIKeys = make([]*APIKey, v3)
-		for i := 0; i < v3; i++ {
+		v8 := r.Intn(5)
+		this.APIKeys = make([]*APIKey, v8)
+		for i := 0; i < v8; i++ {
 			this.APIKeys[i] = NewPopulatedAPIKey(r, easy)
 		}
 	}
@@ -1214,11 +1502,11 @@ func NewPopulatedAPIKeys(r randyRights, easy bool) *APIKeys {
 
 func NewPopulatedCollaborator(r randyRights, easy bool) *Collaborator {
 	this := &Collaborator{}
-	v4 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v4
-	v5 := r.Intn(10)
-	this.Rights = make([]Right, v5)
-	for i := 0; i < v5; i++ {
+	v9 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v9
+	v10 := r.Intn(10)
+	this.Rights = make([]Right, v10)
+	for i := 0; i < v10; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -1228,11 +1516,11 @@ func NewPopulatedCollaborator(r randyRights, easy bool) *Collaborator {
 
 func NewPopulatedGetCollaboratorResponse(r randyRights, easy bool) *GetCollaboratorResponse {
 	this := &GetCollaboratorResponse{}
-	v6 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v6
-	v7 := r.Intn(10)
-	this.Rights = make([]Right, v7)
-	for i := 0; i < v7; i++ {
+	v11 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v11
+	v12 := r.Intn(10)
+	this.Rights = make([]Right, v12)
+	for i := 0; i < v12; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -1243,9 +1531,9 @@ func NewPopulatedGetCollaboratorResponse(r randyRights, easy bool) *GetCollabora
 func NewPopulatedCollaborators(r randyRights, easy bool) *Collaborators {
 	this := &Collaborators{}
 	if r.Intn(5) != 0 {
-		v8 := r.Intn(5)
-		this.Collaborators = make([]*Collaborator, v8)
-		for i := 0; i < v8; i++ {
+		v13 := r.Intn(5)
+		this.Collaborators = make([]*Collaborator, v13)
+		for i := 0; i < v13; i++ {
 			this.Collaborators[i] = NewPopulatedCollaborator(r, easy)
 		}
 	}
@@ -1273,9 +1561,9 @@ func randUTF8RuneRights(r randyRights) rune {
 	return rune(ru + 61)
 }
 func randStringRights(r randyRights) string {
-	v9 := r.Intn(100)
-	tmps := make([]rune, v9)
-	for i := 0; i < v9; i++ {
+	v14 := r.Intn(100)
+	tmps := make([]rune, v14)
+	for i := 0; i < v14; i++ {
 		tmps[i] = randUTF8RuneRights(r)
 	}
 	return string(tmps)
@@ -1297,11 +1585,11 @@ func randFieldRights(dAtA []byte, r randyRights, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateRights(dAtA, uint64(key))
-		v10 := r.Int63()
+		v15 := r.Int63()
 		if r.Intn(2) == 0 {
-			v10 *= -1
+			v15 *= -1
 		}
-		dAtA = encodeVarintPopulateRights(dAtA, uint64(v10))
+		dAtA = encodeVarintPopulateRights(dAtA, uint64(v15))
 	case 1:
 		dAtA = encodeVarintPopulateRights(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1339,6 +1627,34 @@ func (m *Rights) Size() (n int) {
 		}
 		n += 1 + sovRights(uint64(l)) + l
 	}
+	if len(m.GrantedBy) > 0 {
+		for _, e := range m.GrantedBy {
+			l = e.Size()
+			n += 1 + l + sovRights(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MembershipPath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		for _, e := range m.Path {
+			l = e.Size()
+			n += 1 + l + sovRights(uint64(l))
+		}
+	}
+	if len(m.Rights) > 0 {
+		l = 0
+		for _, e := range m.Rights {
+			l += sovRights(uint64(e))
+		}
+		n += 1 + sovRights(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1367,6 +1683,16 @@ func (m *APIKey) Size() (n int) {
 		}
 		n += 1 + sovRights(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovRights(uint64(l))
+	}
+	if len(m.AllowedIPRanges) > 0 {
+		for _, s := range m.AllowedIPRanges {
+			l = len(s)
+			n += 1 + l + sovRights(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1446,7 +1772,29 @@ func (this *Rights) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForGrantedBy := "[]*MembershipPath{"
+	for _, f := range this.GrantedBy {
+		repeatedStringForGrantedBy += strings.Replace(f.String(), "MembershipPath", "MembershipPath", 1) + ","
+	}
+	repeatedStringForGrantedBy += "}"
 	s := strings.Join([]string{`&Rights{`,
+		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`GrantedBy:` + repeatedStringForGrantedBy + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MembershipPath) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPath := "[]OrganizationOrUserIdentifiers{"
+	for _, f := range this.Path {
+		repeatedStringForPath += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForPath += "}"
+	s := strings.Join([]string{`&MembershipPath{`,
+		`Path:` + repeatedStringForPath + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
 		`}`,
 	}, "")
@@ -1461,6 +1809,8 @@ func (this *APIKey) String() string {
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedIPRanges:` + fmt.Sprintf("%v", this.AllowedIPRanges) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1623,6 +1973,196 @@ func (m *Rights) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantedBy = append(m.GrantedBy, &MembershipPath{})
+			if err := m.GrantedBy[len(m.GrantedBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRights(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRights
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRights
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MembershipPath) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRights
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MembershipPath: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MembershipPath: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path, OrganizationOrUserIdentifiers{})
+			if err := m.Path[len(m.Path)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v Right
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRights
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Right(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Rights = append(m.Rights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRights
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRights
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRights
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Rights) == 0 {
+					m.Rights = make([]Right, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Right
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRights
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Right(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Rights = append(m.Rights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRights(dAtA[iNdEx:])
@@ -1841,6 +2381,74 @@ func (m *APIKey) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedIPRanges", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedIPRanges = append(m.AllowedIPRanges, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRights(dAtA[iNdEx:])
@@ -3,13 +3,26 @@
 package ttnpb
 
 var RightsFieldPathsNested = []string{
+	"granted_by",
 	"rights",
 }
 
 var RightsFieldPathsTopLevel = []string{
+	"granted_by",
+	"rights",
+}
+var MembershipPathFieldPathsNested = []string{
+	"path",
+	"rights",
+}
+
+var MembershipPathFieldPathsTopLevel = []string{
+	"path",
 	"rights",
 }
 var APIKeyFieldPathsNested = []string{
+	"allowed_ip_ranges",
+	"expires_at",
 	"id",
 	"key",
 	"name",
@@ -17,6 +30,8 @@ var APIKeyFieldPathsNested = []string{
 }
 
 var APIKeyFieldPathsTopLevel = []string{
+	"allowed_ip_ranges",
+	"expires_at",
 	"id",
 	"key",
 	"name",
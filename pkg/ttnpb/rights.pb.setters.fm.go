@@ -16,6 +16,44 @@ func (dst *Rights) SetFields(src *Rights, paths ...string) error {
 			} else {
 				dst.Rights = nil
 			}
+		case "granted_by":
+			if len(subs) > 0 {
+				return fmt.Errorf("'granted_by' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GrantedBy = src.GrantedBy
+			} else {
+				dst.GrantedBy = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MembershipPath) SetFields(src *MembershipPath, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "path":
+			if len(subs) > 0 {
+				return fmt.Errorf("'path' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Path = src.Path
+			} else {
+				dst.Path = nil
+			}
+		case "rights":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rights' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rights = src.Rights
+			} else {
+				dst.Rights = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -66,6 +104,24 @@ func (dst *APIKey) SetFields(src *APIKey, paths ...string) error {
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "allowed_ip_ranges":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allowed_ip_ranges' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowedIPRanges = src.AllowedIPRanges
+			} else {
+				dst.AllowedIPRanges = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
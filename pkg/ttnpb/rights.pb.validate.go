@@ -64,6 +64,23 @@ func (m *Rights) ValidateFields(paths ...string) error {
 
 			}
 
+		case "granted_by":
+
+			for idx, item := range m.GetGrantedBy() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return RightsValidationError{
+							field:  fmt.Sprintf("granted_by[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
 		default:
 			return RightsValidationError{
 				field:  name,
@@ -128,6 +145,116 @@ var _ interface {
 	ErrorName() string
 } = RightsValidationError{}
 
+// ValidateFields checks the field values on MembershipPath with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MembershipPath) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MembershipPathFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "path":
+
+			for idx, item := range m.GetPath() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MembershipPathValidationError{
+							field:  fmt.Sprintf("path[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "rights":
+
+			for idx, item := range m.GetRights() {
+				_, _ = idx, item
+
+				if _, ok := Right_name[int32(item)]; !ok {
+					return MembershipPathValidationError{
+						field:  fmt.Sprintf("rights[%v]", idx),
+						reason: "value must be one of the defined enum values",
+					}
+				}
+
+			}
+
+		default:
+			return MembershipPathValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MembershipPathValidationError is the validation error returned by
+// MembershipPath.ValidateFields if the designated constraints aren't met.
+type MembershipPathValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MembershipPathValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MembershipPathValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MembershipPathValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MembershipPathValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MembershipPathValidationError) ErrorName() string { return "MembershipPathValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MembershipPathValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMembershipPath.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MembershipPathValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MembershipPathValidationError{}
+
 // ValidateFields checks the field values on APIKey with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
@@ -170,6 +297,27 @@ func (m *APIKey) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return APIKeyValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_ip_ranges":
+
+			if len(m.GetAllowedIPRanges()) > 20 {
+				return APIKeyValidationError{
+					field:  "allowed_ip_ranges",
+					reason: "value must contain no more than 20 item(s)",
+				}
+			}
+
 		default:
 			return APIKeyValidationError{
 				field:  name,
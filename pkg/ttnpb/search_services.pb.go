@@ -5,17 +5,20 @@ package ttnpb
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -29,6 +32,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -146,6 +150,305 @@ func (m *SearchEntitiesRequest) GetPage() uint32 {
 	return 0
 }
 
+// LocationBoundingBox selects the locations within a rectangle of latitudes and longitudes.
+// If min_longitude is greater than max_longitude, the rectangle crosses the antimeridian.
+type LocationBoundingBox struct {
+	MinLatitude          float64  `protobuf:"fixed64,1,opt,name=min_latitude,json=minLatitude,proto3" json:"min_latitude,omitempty"`
+	MinLongitude         float64  `protobuf:"fixed64,2,opt,name=min_longitude,json=minLongitude,proto3" json:"min_longitude,omitempty"`
+	MaxLatitude          float64  `protobuf:"fixed64,3,opt,name=max_latitude,json=maxLatitude,proto3" json:"max_latitude,omitempty"`
+	MaxLongitude         float64  `protobuf:"fixed64,4,opt,name=max_longitude,json=maxLongitude,proto3" json:"max_longitude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocationBoundingBox) Reset()      { *m = LocationBoundingBox{} }
+func (*LocationBoundingBox) ProtoMessage() {}
+func (*LocationBoundingBox) Descriptor() ([]byte, []int) {
+	return fileDescriptor_584ecc2845ae2dc1, []int{1}
+}
+func (m *LocationBoundingBox) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocationBoundingBox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocationBoundingBox.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocationBoundingBox) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocationBoundingBox.Merge(m, src)
+}
+func (m *LocationBoundingBox) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocationBoundingBox) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocationBoundingBox.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocationBoundingBox proto.InternalMessageInfo
+
+func (m *LocationBoundingBox) GetMinLatitude() float64 {
+	if m != nil {
+		return m.MinLatitude
+	}
+	return 0
+}
+
+func (m *LocationBoundingBox) GetMinLongitude() float64 {
+	if m != nil {
+		return m.MinLongitude
+	}
+	return 0
+}
+
+func (m *LocationBoundingBox) GetMaxLatitude() float64 {
+	if m != nil {
+		return m.MaxLatitude
+	}
+	return 0
+}
+
+func (m *LocationBoundingBox) GetMaxLongitude() float64 {
+	if m != nil {
+		return m.MaxLongitude
+	}
+	return 0
+}
+
+// LocationRadius selects the locations within a distance of a center point.
+type LocationRadius struct {
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Distance (in meters) from the center point.
+	Radius               float64  `protobuf:"fixed64,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocationRadius) Reset()      { *m = LocationRadius{} }
+func (*LocationRadius) ProtoMessage() {}
+func (*LocationRadius) Descriptor() ([]byte, []int) {
+	return fileDescriptor_584ecc2845ae2dc1, []int{2}
+}
+func (m *LocationRadius) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LocationRadius) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LocationRadius.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LocationRadius) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocationRadius.Merge(m, src)
+}
+func (m *LocationRadius) XXX_Size() int {
+	return m.Size()
+}
+func (m *LocationRadius) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocationRadius.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocationRadius proto.InternalMessageInfo
+
+func (m *LocationRadius) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *LocationRadius) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *LocationRadius) GetRadius() float64 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+// This message is used for finding gateways in the EntityRegistrySearch service.
+type SearchGatewaysRequest struct {
+	// Find gateways where the ID contains this substring.
+	IDContains string `protobuf:"bytes,1,opt,name=id_contains,json=idContains,proto3" json:"id_contains,omitempty"`
+	// Find gateways where the name contains this substring.
+	NameContains string `protobuf:"bytes,2,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
+	// Find gateways where the description contains this substring.
+	DescriptionContains string `protobuf:"bytes,3,opt,name=description_contains,json=descriptionContains,proto3" json:"description_contains,omitempty"`
+	// Find gateways where the given attributes contain these substrings.
+	AttributesContain map[string]string `protobuf:"bytes,4,rep,name=attributes_contain,json=attributesContain,proto3" json:"attributes_contain,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FieldMask         types.FieldMask   `protobuf:"bytes,6,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	// Order the results by this field path (must be present in the field mask).
+	// Default ordering is by ID. Prepend with a minus (-) to reverse the order.
+	// If location_radius is set, the results can also be ordered by "distance" (to the nearest antenna).
+	Order string `protobuf:"bytes,7,opt,name=order,proto3" json:"order,omitempty"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page uint32 `protobuf:"varint,9,opt,name=page,proto3" json:"page,omitempty"`
+	// Find gateways that have an antenna within this bounding box.
+	LocationBoundingBox *LocationBoundingBox `protobuf:"bytes,10,opt,name=location_bounding_box,json=locationBoundingBox,proto3" json:"location_bounding_box,omitempty"`
+	// Find gateways that have an antenna within this radius.
+	LocationRadius *LocationRadius `protobuf:"bytes,11,opt,name=location_radius,json=locationRadius,proto3" json:"location_radius,omitempty"`
+	// Find gateways that use this frequency plan.
+	FrequencyPlanID string `protobuf:"bytes,12,opt,name=frequency_plan_id,json=frequencyPlanId,proto3" json:"frequency_plan_id,omitempty"`
+	// Find gateways of this brand.
+	BrandID string `protobuf:"bytes,13,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	// Find gateways of this model.
+	ModelID string `protobuf:"bytes,14,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	// Find gateways that were updated after this time.
+	UpdatedAfter         *time.Time `protobuf:"bytes,15,opt,name=updated_after,json=updatedAfter,proto3,stdtime" json:"updated_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SearchGatewaysRequest) Reset()      { *m = SearchGatewaysRequest{} }
+func (*SearchGatewaysRequest) ProtoMessage() {}
+func (*SearchGatewaysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_584ecc2845ae2dc1, []int{3}
+}
+func (m *SearchGatewaysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchGatewaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchGatewaysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchGatewaysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchGatewaysRequest.Merge(m, src)
+}
+func (m *SearchGatewaysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchGatewaysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchGatewaysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchGatewaysRequest proto.InternalMessageInfo
+
+func (m *SearchGatewaysRequest) GetIDContains() string {
+	if m != nil {
+		return m.IDContains
+	}
+	return ""
+}
+
+func (m *SearchGatewaysRequest) GetNameContains() string {
+	if m != nil {
+		return m.NameContains
+	}
+	return ""
+}
+
+func (m *SearchGatewaysRequest) GetDescriptionContains() string {
+	if m != nil {
+		return m.DescriptionContains
+	}
+	return ""
+}
+
+func (m *SearchGatewaysRequest) GetAttributesContain() map[string]string {
+	if m != nil {
+		return m.AttributesContain
+	}
+	return nil
+}
+
+func (m *SearchGatewaysRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
+func (m *SearchGatewaysRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *SearchGatewaysRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SearchGatewaysRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *SearchGatewaysRequest) GetLocationBoundingBox() *LocationBoundingBox {
+	if m != nil {
+		return m.LocationBoundingBox
+	}
+	return nil
+}
+
+func (m *SearchGatewaysRequest) GetLocationRadius() *LocationRadius {
+	if m != nil {
+		return m.LocationRadius
+	}
+	return nil
+}
+
+func (m *SearchGatewaysRequest) GetFrequencyPlanID() string {
+	if m != nil {
+		return m.FrequencyPlanID
+	}
+	return ""
+}
+
+func (m *SearchGatewaysRequest) GetBrandID() string {
+	if m != nil {
+		return m.BrandID
+	}
+	return ""
+}
+
+func (m *SearchGatewaysRequest) GetModelID() string {
+	if m != nil {
+		return m.ModelID
+	}
+	return ""
+}
+
+func (m *SearchGatewaysRequest) GetUpdatedAfter() *time.Time {
+	if m != nil {
+		return m.UpdatedAfter
+	}
+	return nil
+}
+
 type SearchEndDevicesRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	// Find end devices where the ID contains this substring.
@@ -165,19 +468,30 @@ type SearchEndDevicesRequest struct {
 	FieldMask       types.FieldMask `protobuf:"bytes,9,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
 	// Order the results by this field path (must be present in the field mask).
 	// Default ordering is by ID. Prepend with a minus (-) to reverse the order.
+	// If location_radius is set, the results can also be ordered by "distance" (to the nearest location).
 	Order string `protobuf:"bytes,10,opt,name=order,proto3" json:"order,omitempty"`
 	// Limit the number of results per page.
 	Limit uint32 `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,12,opt,name=page,proto3" json:"page,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Page uint32 `protobuf:"varint,12,opt,name=page,proto3" json:"page,omitempty"`
+	// Find end devices that have a location within this bounding box.
+	LocationBoundingBox *LocationBoundingBox `protobuf:"bytes,13,opt,name=location_bounding_box,json=locationBoundingBox,proto3" json:"location_bounding_box,omitempty"`
+	// Find end devices that have a location within this radius.
+	LocationRadius *LocationRadius `protobuf:"bytes,14,opt,name=location_radius,json=locationRadius,proto3" json:"location_radius,omitempty"`
+	// Find end devices of this brand.
+	BrandID string `protobuf:"bytes,15,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	// Find end devices of this model.
+	ModelID string `protobuf:"bytes,16,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	// Find end devices that were updated after this time.
+	UpdatedAfter         *time.Time `protobuf:"bytes,17,opt,name=updated_after,json=updatedAfter,proto3,stdtime" json:"updated_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *SearchEndDevicesRequest) Reset()      { *m = SearchEndDevicesRequest{} }
 func (*SearchEndDevicesRequest) ProtoMessage() {}
 func (*SearchEndDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_584ecc2845ae2dc1, []int{1}
+	return fileDescriptor_584ecc2845ae2dc1, []int{4}
 }
 func (m *SearchEndDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,11 +597,54 @@ func (m *SearchEndDevicesRequest) GetPage() uint32 {
 	return 0
 }
 
+func (m *SearchEndDevicesRequest) GetLocationBoundingBox() *LocationBoundingBox {
+	if m != nil {
+		return m.LocationBoundingBox
+	}
+	return nil
+}
+
+func (m *SearchEndDevicesRequest) GetLocationRadius() *LocationRadius {
+	if m != nil {
+		return m.LocationRadius
+	}
+	return nil
+}
+
+func (m *SearchEndDevicesRequest) GetBrandID() string {
+	if m != nil {
+		return m.BrandID
+	}
+	return ""
+}
+
+func (m *SearchEndDevicesRequest) GetModelID() string {
+	if m != nil {
+		return m.ModelID
+	}
+	return ""
+}
+
+func (m *SearchEndDevicesRequest) GetUpdatedAfter() *time.Time {
+	if m != nil {
+		return m.UpdatedAfter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SearchEntitiesRequest)(nil), "ttn.lorawan.v3.SearchEntitiesRequest")
 	golang_proto.RegisterType((*SearchEntitiesRequest)(nil), "ttn.lorawan.v3.SearchEntitiesRequest")
 	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.SearchEntitiesRequest.AttributesContainEntry")
 	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.SearchEntitiesRequest.AttributesContainEntry")
+	proto.RegisterType((*LocationBoundingBox)(nil), "ttn.lorawan.v3.LocationBoundingBox")
+	golang_proto.RegisterType((*LocationBoundingBox)(nil), "ttn.lorawan.v3.LocationBoundingBox")
+	proto.RegisterType((*LocationRadius)(nil), "ttn.lorawan.v3.LocationRadius")
+	golang_proto.RegisterType((*LocationRadius)(nil), "ttn.lorawan.v3.LocationRadius")
+	proto.RegisterType((*SearchGatewaysRequest)(nil), "ttn.lorawan.v3.SearchGatewaysRequest")
+	golang_proto.RegisterType((*SearchGatewaysRequest)(nil), "ttn.lorawan.v3.SearchGatewaysRequest")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.SearchGatewaysRequest.AttributesContainEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.SearchGatewaysRequest.AttributesContainEntry")
 	proto.RegisterType((*SearchEndDevicesRequest)(nil), "ttn.lorawan.v3.SearchEndDevicesRequest")
 	golang_proto.RegisterType((*SearchEndDevicesRequest)(nil), "ttn.lorawan.v3.SearchEndDevicesRequest")
 	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.SearchEndDevicesRequest.AttributesContainEntry")
@@ -302,77 +659,101 @@ func init() {
 }
 
 var fileDescriptor_584ecc2845ae2dc1 = []byte{
-	// 1109 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x68, 0x1b, 0x47,
-	0x14, 0xde, 0xb1, 0x25, 0xc7, 0x1e, 0xc5, 0xb2, 0x3c, 0xb6, 0x63, 0x21, 0x9c, 0x91, 0xd9, 0xb8,
-	0x89, 0x53, 0xac, 0x55, 0xab, 0x5c, 0xda, 0x90, 0xd6, 0x58, 0xb1, 0x5b, 0x1c, 0x28, 0x05, 0x95,
-	0x5c, 0x1a, 0x52, 0x31, 0xd2, 0x8e, 0xd7, 0x53, 0xc9, 0xbb, 0xea, 0xce, 0x48, 0xae, 0x1c, 0x02,
-	0xa1, 0xa7, 0xd0, 0x53, 0x21, 0x97, 0xfe, 0x40, 0x29, 0x3d, 0xe5, 0x98, 0x43, 0x0f, 0xa1, 0xa7,
-	0x9c, 0x8a, 0x8f, 0x86, 0x5e, 0x72, 0x32, 0xd1, 0xaa, 0x07, 0x1f, 0x73, 0x0c, 0x3e, 0x95, 0x9d,
-	0xdd, 0xd5, 0xcf, 0x4a, 0x0e, 0x0a, 0x6d, 0x6e, 0xf3, 0xf3, 0xbd, 0xef, 0x9b, 0x79, 0xfb, 0xbd,
-	0x37, 0x0b, 0xaf, 0x54, 0x2d, 0x9b, 0xec, 0x13, 0x33, 0xc3, 0x05, 0x29, 0x57, 0xb2, 0xa4, 0xc6,
-	0xb2, 0x9c, 0x12, 0xbb, 0xbc, 0x5b, 0xe4, 0xd4, 0x6e, 0xb0, 0x32, 0xe5, 0x5a, 0xcd, 0xb6, 0x84,
-	0x85, 0xe2, 0x42, 0x98, 0x9a, 0x0f, 0xd6, 0x1a, 0xd7, 0x52, 0x1b, 0x06, 0x13, 0xbb, 0xf5, 0x92,
-	0x56, 0xb6, 0xf6, 0xb2, 0xd4, 0x6c, 0x58, 0xcd, 0x9a, 0x6d, 0x7d, 0xdb, 0xcc, 0x4a, 0x70, 0x39,
-	0x63, 0x50, 0x33, 0xd3, 0x20, 0x55, 0xa6, 0x13, 0x41, 0xb3, 0x03, 0x03, 0x8f, 0x32, 0x95, 0xe9,
-	0xa1, 0x30, 0x2c, 0xc3, 0xf2, 0x82, 0x4b, 0xf5, 0x1d, 0x39, 0x93, 0x13, 0x39, 0xf2, 0xe1, 0x4b,
-	0x86, 0x65, 0x19, 0x55, 0x2a, 0xcf, 0x48, 0x4c, 0xd3, 0x12, 0x44, 0x30, 0xcb, 0xf4, 0xcf, 0x97,
-	0x5a, 0xf6, 0x77, 0x3b, 0x1c, 0x3b, 0x8c, 0x56, 0xf5, 0xe2, 0x1e, 0xe1, 0x15, 0x1f, 0x71, 0x69,
-	0xf0, 0xaa, 0xa4, 0x56, 0xab, 0xb2, 0xb2, 0xe4, 0xf1, 0x41, 0x78, 0x10, 0x54, 0xae, 0x32, 0x6a,
-	0x0a, 0x7f, 0x5f, 0x1d, 0xdc, 0xa7, 0xa6, 0x5e, 0xd4, 0xa9, 0x9b, 0x2b, 0x1f, 0x93, 0x1e, 0xc4,
-	0x18, 0x44, 0xd0, 0x7d, 0xd2, 0x3c, 0xfb, 0x24, 0x4c, 0xa7, 0xa6, 0x60, 0x3b, 0x8c, 0xda, 0xc1,
-	0x85, 0x56, 0x06, 0x41, 0x96, 0x6d, 0x10, 0x93, 0x1d, 0xf4, 0x9e, 0x77, 0x69, 0x10, 0x55, 0xe7,
-	0xd4, 0xf6, 0x76, 0xd5, 0x47, 0x11, 0xb8, 0xf0, 0x85, 0xfc, 0x9c, 0x5b, 0xa6, 0x60, 0x82, 0x51,
-	0x5e, 0xa0, 0xdf, 0xd4, 0x29, 0x17, 0x28, 0x0b, 0x63, 0x4c, 0x2f, 0x96, 0x2d, 0x53, 0x10, 0x66,
-	0xf2, 0x24, 0x58, 0x06, 0xab, 0x53, 0xf9, 0xb8, 0x73, 0x9c, 0x86, 0xdb, 0x9b, 0x37, 0xfd, 0xd5,
-	0x02, 0x64, 0x7a, 0x30, 0x46, 0x97, 0xe0, 0xb4, 0x49, 0xf6, 0x68, 0x37, 0x64, 0xcc, 0x0d, 0x29,
-	0x9c, 0x77, 0x17, 0x3b, 0xa0, 0xf7, 0xe1, 0xbc, 0x4e, 0x79, 0xd9, 0x66, 0x35, 0xf7, 0x88, 0x5d,
-	0xec, 0xb8, 0xc4, 0xce, 0xf5, 0xec, 0x75, 0x42, 0x7e, 0x02, 0x10, 0x11, 0x21, 0x6c, 0x56, 0xaa,
-	0x0b, 0xca, 0x83, 0x90, 0x64, 0x64, 0x79, 0x7c, 0x35, 0x96, 0xbb, 0xa1, 0xf5, 0xbb, 0x4e, 0x1b,
-	0x7a, 0x19, 0x6d, 0xa3, 0x13, 0xef, 0xd3, 0x6e, 0x99, 0xc2, 0x6e, 0xe6, 0xd7, 0x4e, 0xf3, 0x57,
-	0x7f, 0x06, 0x97, 0xd5, 0x15, 0x5b, 0x4d, 0xae, 0xe4, 0xf0, 0x57, 0x77, 0x48, 0xe6, 0xe0, 0xbd,
-	0xcc, 0x87, 0x77, 0x57, 0xd7, 0xaf, 0xdf, 0xc9, 0xdc, 0x5d, 0x0f, 0xa6, 0x57, 0xef, 0xe5, 0xd6,
-	0xee, 0xaf, 0x14, 0x66, 0x49, 0x98, 0x05, 0xad, 0x43, 0xd8, 0x75, 0x51, 0x72, 0x62, 0x19, 0xac,
-	0xc6, 0x72, 0x29, 0xcd, 0x33, 0x9a, 0x16, 0x18, 0x4d, 0xfb, 0xc4, 0x85, 0x7c, 0x46, 0x78, 0x25,
-	0x1f, 0x39, 0x3c, 0x4e, 0x2b, 0x85, 0xa9, 0x9d, 0x60, 0x01, 0xcd, 0xc3, 0xa8, 0x65, 0xeb, 0xd4,
-	0x4e, 0x9e, 0x93, 0x09, 0xf0, 0x26, 0x08, 0xc3, 0x68, 0x95, 0xed, 0x31, 0x91, 0x9c, 0x5c, 0x06,
-	0xab, 0xd3, 0xf9, 0xc9, 0xd3, 0x7c, 0xf4, 0xdd, 0xf1, 0xe4, 0xc9, 0xb9, 0x82, 0xb7, 0x8c, 0x10,
-	0x8c, 0xd4, 0x88, 0x41, 0x93, 0x53, 0xee, 0x76, 0x41, 0x8e, 0x53, 0x9b, 0xf0, 0xc2, 0xf0, 0x5b,
-	0xa2, 0x04, 0x1c, 0xaf, 0xd0, 0xa6, 0xf7, 0x05, 0x0b, 0xee, 0xd0, 0x55, 0x6d, 0x90, 0x6a, 0x9d,
-	0xfa, 0x9f, 0xc8, 0x9b, 0x5c, 0x1f, 0xfb, 0x00, 0xdc, 0x8a, 0x4c, 0x46, 0x13, 0x13, 0xea, 0x1f,
-	0x13, 0x70, 0x31, 0x48, 0xa4, 0xbe, 0x29, 0x9d, 0xdb, 0xf1, 0x05, 0x81, 0x33, 0x3d, 0x45, 0x51,
-	0x64, 0xba, 0xe7, 0x8d, 0x58, 0xee, 0x72, 0xf8, 0x53, 0x6c, 0x74, 0x61, 0xdb, 0x5d, 0xf3, 0xe6,
-	0x13, 0xa7, 0xf9, 0xe8, 0xf7, 0x60, 0x2c, 0x01, 0xdc, 0x5c, 0x1c, 0x1d, 0xa7, 0x41, 0x21, 0x4e,
-	0x7a, 0x91, 0x3c, 0x6c, 0xbd, 0xb1, 0x37, 0xb7, 0xde, 0xf8, 0x1b, 0x58, 0x2f, 0x72, 0xb6, 0xf5,
-	0x7e, 0x19, 0x6e, 0xbd, 0xa8, 0xb4, 0xde, 0xc7, 0x67, 0x59, 0x2f, 0x94, 0xb1, 0xb7, 0x66, 0xbe,
-	0x1b, 0x30, 0xa1, 0xd3, 0x46, 0x91, 0xd6, 0x59, 0xf7, 0x32, 0x13, 0x32, 0x57, 0xc8, 0x39, 0x4e,
-	0xc7, 0x37, 0x69, 0x63, 0xeb, 0xf6, 0x76, 0x27, 0x5f, 0x71, 0x9d, 0x36, 0xb6, 0xea, 0xac, 0x73,
-	0xb7, 0x75, 0x38, 0xfb, 0xb5, 0xc5, 0xcc, 0xfe, 0x70, 0xe9, 0xc2, 0xfc, 0x9c, 0x73, 0x9c, 0x9e,
-	0xb9, 0x65, 0x31, 0xb3, 0x37, 0x7e, 0xc6, 0x45, 0x87, 0x08, 0x5c, 0x79, 0xa2, 0xeb, 0x76, 0x97,
-	0x60, 0xb2, 0x4b, 0xb0, 0x49, 0x1b, 0x1b, 0xba, 0x6e, 0x77, 0x09, 0xf4, 0xfe, 0x85, 0x50, 0xf1,
-	0x4c, 0xfd, 0x87, 0xe2, 0x81, 0x43, 0x8b, 0x27, 0xf6, 0xfa, 0xe2, 0x39, 0xff, 0x7f, 0x17, 0x4f,
-	0xee, 0xaf, 0x08, 0x9c, 0x97, 0x9d, 0xa7, 0x59, 0xa0, 0x06, 0xe3, 0xc2, 0x6e, 0x7a, 0x96, 0x40,
-	0xfb, 0x10, 0x79, 0xa3, 0x9e, 0x92, 0xe0, 0xe8, 0x9d, 0x91, 0x7a, 0x57, 0x6a, 0xe9, 0x35, 0x75,
-	0xc5, 0xd5, 0xa5, 0xef, 0xfe, 0xfe, 0xe7, 0xd1, 0xd8, 0x05, 0x34, 0xef, 0xbf, 0xca, 0xbd, 0x2f,
-	0x16, 0x47, 0xbb, 0x70, 0xda, 0x23, 0xbd, 0x29, 0x9f, 0xa8, 0x91, 0x35, 0x17, 0xc3, 0x30, 0x3f,
-	0x5e, 0x5d, 0x94, 0x72, 0xb3, 0x68, 0x26, 0x90, 0x2b, 0xfb, 0xc4, 0x15, 0x18, 0xf7, 0xa8, 0x3e,
-	0xf5, 0x1e, 0xb2, 0x91, 0xa5, 0x92, 0x61, 0x58, 0x40, 0xa0, 0x26, 0xa5, 0x16, 0x42, 0x89, 0x40,
-	0xcb, 0x08, 0xa8, 0x0f, 0xe0, 0x9c, 0x47, 0xf6, 0x79, 0xcf, 0x7b, 0x37, 0xb2, 0xe2, 0xc5, 0x30,
-	0xac, 0x8f, 0x45, 0xbd, 0x28, 0x65, 0x17, 0xd1, 0x42, 0x20, 0x6b, 0xf5, 0x89, 0x94, 0x60, 0xcc,
-	0xa3, 0xbd, 0xcd, 0xa9, 0x3d, 0xb2, 0xe6, 0x42, 0x18, 0x26, 0xa3, 0xd5, 0x05, 0xa9, 0x35, 0x83,
-	0xa6, 0x03, 0x2d, 0xf7, 0x69, 0xe6, 0xb9, 0x3f, 0x01, 0x5c, 0xec, 0xf4, 0x91, 0x90, 0x97, 0x7e,
-	0x05, 0x30, 0x11, 0xee, 0x34, 0xe8, 0xca, 0x88, 0xbd, 0x28, 0x95, 0x0a, 0x03, 0xbb, 0x10, 0x75,
-	0x4b, 0x1e, 0x66, 0x1d, 0x7d, 0x34, 0xcc, 0x4a, 0xd9, 0x7b, 0xa1, 0xae, 0xaf, 0xf5, 0xcf, 0xef,
-	0x67, 0xbd, 0x3f, 0x1c, 0x9e, 0xff, 0x1d, 0x1c, 0xb6, 0x30, 0x38, 0x6a, 0x61, 0xf0, 0xbc, 0x85,
-	0x95, 0x17, 0x2d, 0xac, 0x9c, 0xb4, 0xb0, 0xf2, 0xb2, 0x85, 0x95, 0x57, 0x2d, 0x0c, 0x1e, 0x38,
-	0x18, 0x3c, 0x74, 0xb0, 0xf2, 0xd8, 0xc1, 0xe0, 0x89, 0x83, 0x95, 0xa7, 0x0e, 0x56, 0x9e, 0x39,
-	0x58, 0x39, 0x74, 0x30, 0x38, 0x72, 0x30, 0x78, 0xee, 0x60, 0xe5, 0x85, 0x83, 0xc1, 0x89, 0x83,
-	0x95, 0x97, 0x0e, 0x06, 0xaf, 0x1c, 0xac, 0x3c, 0x68, 0x63, 0xe5, 0x61, 0x1b, 0x83, 0x1f, 0xda,
-	0x58, 0xf9, 0xb1, 0x8d, 0xc1, 0x6f, 0x6d, 0xac, 0x3c, 0x6e, 0x63, 0xe5, 0x49, 0x1b, 0x83, 0xa7,
-	0x6d, 0x0c, 0x9e, 0xb5, 0x31, 0xf8, 0x72, 0xcd, 0xb0, 0x34, 0xb1, 0x4b, 0xc5, 0x2e, 0x33, 0x0d,
-	0xae, 0x99, 0x54, 0xec, 0x5b, 0x76, 0x25, 0xdb, 0xff, 0xf3, 0x53, 0xab, 0x18, 0x59, 0x21, 0xcc,
-	0x5a, 0xa9, 0x34, 0x21, 0xfb, 0xcb, 0xb5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x60, 0x13,
-	0xde, 0xde, 0x0a, 0x00, 0x00,
+	// 1492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4b, 0x6c, 0x13, 0x47,
+	0x1f, 0xdf, 0x75, 0x9c, 0xd7, 0x38, 0x7e, 0x64, 0x92, 0x10, 0x7f, 0xfe, 0xc2, 0x38, 0x9f, 0xc9,
+	0x07, 0xe1, 0x13, 0xb6, 0xbf, 0x06, 0x55, 0x6a, 0x11, 0x6d, 0xc8, 0x92, 0x80, 0x82, 0x40, 0x45,
+	0xdb, 0xd2, 0x4a, 0x45, 0xd4, 0x1a, 0x7b, 0xc7, 0x9b, 0x69, 0xd6, 0xbb, 0xee, 0xee, 0xd8, 0x89,
+	0x41, 0x48, 0xa8, 0x27, 0xda, 0x13, 0x12, 0x97, 0x3e, 0xa4, 0xaa, 0xe2, 0xc4, 0xa5, 0x12, 0x47,
+	0xd4, 0x53, 0x4e, 0x15, 0x47, 0xa4, 0x5e, 0x38, 0xa5, 0x64, 0xdd, 0x03, 0x47, 0x8e, 0x28, 0xbd,
+	0x54, 0x3b, 0xbb, 0xeb, 0xc7, 0x3a, 0x01, 0x43, 0xa9, 0x2a, 0xb5, 0x7b, 0x9a, 0xc7, 0xef, 0xf7,
+	0xff, 0xcd, 0xe3, 0x3f, 0xbf, 0x9d, 0x01, 0x47, 0x34, 0xc3, 0xc4, 0x1b, 0x58, 0xcf, 0x5a, 0x0c,
+	0x97, 0xd6, 0xf3, 0xb8, 0x4a, 0xf3, 0x16, 0xc1, 0x66, 0x69, 0xad, 0x60, 0x11, 0xb3, 0x4e, 0x4b,
+	0xc4, 0xca, 0x55, 0x4d, 0x83, 0x19, 0x30, 0xc6, 0x98, 0x9e, 0xf3, 0xc0, 0xb9, 0xfa, 0xf1, 0xd4,
+	0x92, 0x4a, 0xd9, 0x5a, 0xad, 0x98, 0x2b, 0x19, 0x95, 0x3c, 0xd1, 0xeb, 0x46, 0xa3, 0x6a, 0x1a,
+	0x9b, 0x8d, 0x3c, 0x07, 0x97, 0xb2, 0x2a, 0xd1, 0xb3, 0x75, 0xac, 0x51, 0x05, 0x33, 0x92, 0xef,
+	0x29, 0xb8, 0x21, 0x53, 0xd9, 0x8e, 0x10, 0xaa, 0xa1, 0x1a, 0x2e, 0xb9, 0x58, 0x2b, 0xf3, 0x1a,
+	0xaf, 0xf0, 0x92, 0x07, 0x9f, 0x51, 0x0d, 0x43, 0xd5, 0x08, 0x1f, 0x23, 0xd6, 0x75, 0x83, 0x61,
+	0x46, 0x0d, 0xdd, 0x1b, 0x5f, 0x6a, 0xd6, 0xeb, 0x6d, 0xc5, 0x28, 0x53, 0xa2, 0x29, 0x85, 0x0a,
+	0xb6, 0xd6, 0x3d, 0x44, 0x3a, 0x88, 0x60, 0xb4, 0x42, 0x2c, 0x86, 0x2b, 0x55, 0x0f, 0x70, 0xa8,
+	0x77, 0x2d, 0x70, 0xb5, 0xaa, 0xd1, 0x12, 0x17, 0xf2, 0x40, 0xa8, 0x17, 0x54, 0xd2, 0x28, 0xd1,
+	0x99, 0xd7, 0x9f, 0xe9, 0xed, 0x27, 0xba, 0x52, 0x50, 0x88, 0xb3, 0x98, 0xfe, 0x48, 0x7a, 0x31,
+	0x2a, 0x66, 0x64, 0x03, 0x37, 0xf6, 0x1f, 0x09, 0x55, 0x88, 0xce, 0x68, 0x99, 0x12, 0xd3, 0x9f,
+	0xf1, 0x5c, 0x2f, 0xc8, 0x30, 0x55, 0xac, 0xd3, 0xab, 0x9d, 0xe3, 0x9d, 0xe9, 0x45, 0xd5, 0x2c,
+	0x62, 0xba, 0xbd, 0x99, 0xdb, 0x61, 0x30, 0xf5, 0x3e, 0xdf, 0xef, 0x15, 0x9d, 0x51, 0x46, 0x89,
+	0x25, 0x93, 0xcf, 0x6a, 0xc4, 0x62, 0x30, 0x0f, 0x22, 0x54, 0x29, 0x94, 0x0c, 0x9d, 0x61, 0xaa,
+	0x5b, 0x49, 0x71, 0x56, 0x9c, 0x1f, 0x95, 0x62, 0xf6, 0x76, 0x1a, 0xac, 0x2e, 0x9f, 0xf6, 0x5a,
+	0x65, 0x40, 0x15, 0xbf, 0x0c, 0x0f, 0x81, 0xa8, 0x8e, 0x2b, 0xa4, 0x4d, 0x09, 0x39, 0x14, 0x79,
+	0xcc, 0x69, 0x6c, 0x81, 0xde, 0x00, 0x93, 0x0a, 0xb1, 0x4a, 0x26, 0xad, 0x3a, 0x43, 0x6c, 0x63,
+	0x07, 0x38, 0x76, 0xa2, 0xa3, 0xaf, 0x45, 0xf9, 0x5a, 0x04, 0x10, 0x33, 0x66, 0xd2, 0x62, 0x8d,
+	0x11, 0xcb, 0xa7, 0x24, 0xc3, 0xb3, 0x03, 0xf3, 0x91, 0x85, 0x93, 0xb9, 0xee, 0xb4, 0xcc, 0xed,
+	0x39, 0x99, 0xdc, 0x52, 0x8b, 0xef, 0x85, 0x5d, 0xd1, 0x99, 0xd9, 0x90, 0x8e, 0xed, 0x4a, 0x47,
+	0xbf, 0x11, 0x0f, 0x67, 0xe6, 0xcc, 0x4c, 0x72, 0x6e, 0x01, 0x7d, 0x72, 0x19, 0x67, 0xaf, 0xfe,
+	0x3f, 0xfb, 0xf6, 0x95, 0xf9, 0xc5, 0x13, 0x97, 0xb3, 0x57, 0x16, 0xfd, 0xea, 0xd1, 0x6b, 0x0b,
+	0xc7, 0xae, 0xcf, 0xc9, 0xe3, 0x38, 0x18, 0x05, 0x2e, 0x02, 0xd0, 0x4e, 0xb3, 0xe4, 0xd0, 0xac,
+	0x38, 0x1f, 0x59, 0x48, 0xe5, 0xdc, 0x3c, 0xcb, 0xf9, 0x79, 0x96, 0x3b, 0xe3, 0x40, 0x2e, 0x60,
+	0x6b, 0x5d, 0x0a, 0x3f, 0xd8, 0x4e, 0x0b, 0xf2, 0x68, 0xd9, 0x6f, 0x80, 0x93, 0x60, 0xd0, 0x30,
+	0x15, 0x62, 0x26, 0x87, 0xf9, 0x02, 0xb8, 0x15, 0x88, 0xc0, 0xa0, 0x46, 0x2b, 0x94, 0x25, 0x47,
+	0x66, 0xc5, 0xf9, 0xa8, 0x34, 0xb2, 0x2b, 0x0d, 0xfe, 0x6f, 0x20, 0xf9, 0x64, 0x58, 0x76, 0x9b,
+	0x21, 0x04, 0xe1, 0x2a, 0x56, 0x49, 0x72, 0xd4, 0xe9, 0x96, 0x79, 0x39, 0xb5, 0x0c, 0x0e, 0xec,
+	0x3d, 0x4b, 0x98, 0x00, 0x03, 0xeb, 0xa4, 0xe1, 0xee, 0xa0, 0xec, 0x14, 0x1d, 0xd5, 0x3a, 0xd6,
+	0x6a, 0xc4, 0xdb, 0x22, 0xb7, 0x72, 0x22, 0xf4, 0x96, 0x78, 0x2e, 0x3c, 0x32, 0x98, 0x18, 0xca,
+	0x7c, 0x11, 0x02, 0x13, 0xe7, 0x0d, 0x37, 0xed, 0x25, 0xa3, 0xa6, 0x2b, 0x54, 0x57, 0x25, 0x63,
+	0x13, 0x9e, 0x00, 0x63, 0x15, 0xaa, 0x17, 0x34, 0xcc, 0x28, 0xab, 0x29, 0x84, 0x87, 0x14, 0xa5,
+	0xe9, 0x5d, 0x69, 0x12, 0xc2, 0x7f, 0x09, 0xce, 0x77, 0xe3, 0xc3, 0x53, 0x47, 0xbd, 0xc2, 0x96,
+	0x1c, 0xa9, 0x50, 0xfd, 0xbc, 0x87, 0x85, 0x27, 0x41, 0x94, 0x73, 0x0d, 0x5d, 0x75, 0xc9, 0xa1,
+	0x5e, 0x72, 0xd9, 0x27, 0x97, 0xb7, 0x64, 0x47, 0xe9, 0xbc, 0x0f, 0xe6, 0xca, 0x78, 0xb3, 0xad,
+	0x3c, 0xf0, 0x22, 0x65, 0xbc, 0xd9, 0xa5, 0xec, 0x70, 0x5b, 0xca, 0xe1, 0x17, 0x29, 0xe3, 0xcd,
+	0x96, 0x72, 0xe6, 0x07, 0x11, 0xc4, 0xfc, 0xb5, 0x90, 0xb1, 0x42, 0x6b, 0x16, 0x3c, 0x0e, 0x46,
+	0xfa, 0x5d, 0x82, 0x16, 0x10, 0xbe, 0x09, 0x46, 0xfb, 0x9e, 0x7b, 0x1b, 0x09, 0xf3, 0x60, 0xc8,
+	0xe4, 0xaa, 0x3d, 0x53, 0xbe, 0xf8, 0xec, 0xdf, 0xd6, 0xd2, 0x7f, 0x04, 0xef, 0x93, 0x3d, 0x58,
+	0xe6, 0xce, 0xb0, 0x7f, 0xa2, 0xcf, 0xba, 0x96, 0xf2, 0xf7, 0x38, 0xd1, 0x81, 0xc9, 0xfc, 0x03,
+	0x4e, 0x34, 0xfc, 0x08, 0x4c, 0x69, 0x5e, 0xe2, 0x15, 0x8a, 0xde, 0x29, 0x2c, 0x14, 0x8d, 0xcd,
+	0x24, 0xe0, 0xa3, 0x3a, 0x14, 0x5c, 0xa8, 0x3d, 0x4e, 0xac, 0x3c, 0xa1, 0xf5, 0x36, 0xc2, 0xb3,
+	0x20, 0xde, 0x0a, 0xec, 0x25, 0x57, 0x84, 0x87, 0x44, 0xfb, 0x85, 0x74, 0x13, 0x5f, 0x8e, 0x69,
+	0xdd, 0x07, 0xe1, 0x0c, 0x18, 0x2f, 0x9b, 0xce, 0x86, 0xe8, 0xa5, 0x46, 0xa1, 0xaa, 0x61, 0xbd,
+	0x40, 0x95, 0xe4, 0x18, 0xcf, 0xab, 0xd4, 0xae, 0x14, 0x36, 0x43, 0xc9, 0x53, 0xf6, 0x76, 0x3a,
+	0x7e, 0xc6, 0xc7, 0x5c, 0xd4, 0xb0, 0xbe, 0xba, 0x2c, 0xc7, 0xcb, 0x5d, 0x0d, 0x0a, 0xcc, 0x82,
+	0x91, 0xa2, 0x89, 0x75, 0xc5, 0xa1, 0x47, 0x39, 0x1d, 0xba, 0xf4, 0x39, 0x7b, 0x3b, 0x3d, 0x2c,
+	0x39, 0x5d, 0xab, 0xcb, 0xf2, 0x30, 0xc7, 0xb8, 0xf0, 0x8a, 0xa1, 0x10, 0xcd, 0x81, 0xc7, 0x7a,
+	0xe0, 0x17, 0x9c, 0x2e, 0x07, 0xce, 0x31, 0xab, 0x0a, 0x5c, 0x01, 0xd1, 0x5a, 0x55, 0xc1, 0x8c,
+	0x28, 0x05, 0x5c, 0x66, 0xc4, 0x4c, 0xc6, 0xf7, 0xd9, 0xd5, 0x0f, 0xfc, 0xfb, 0x80, 0x14, 0xbe,
+	0xf5, 0x4b, 0x5a, 0x94, 0xc7, 0x3c, 0xda, 0x92, 0xc3, 0x7a, 0xad, 0x06, 0xfb, 0xdb, 0x08, 0x98,
+	0xf6, 0xff, 0x54, 0xca, 0x32, 0xbf, 0x1a, 0xb4, 0x8e, 0x29, 0x06, 0xf1, 0x8e, 0x5b, 0x47, 0x81,
+	0x2a, 0xee, 0x51, 0x8d, 0x2c, 0x1c, 0x0e, 0xee, 0xce, 0x52, 0x1b, 0xb6, 0xda, 0xbe, 0x1d, 0x48,
+	0x89, 0x5d, 0x69, 0xf0, 0x4b, 0x31, 0x94, 0x10, 0x9d, 0xd4, 0x7c, 0xb8, 0x9d, 0x16, 0xe5, 0x18,
+	0xee, 0x44, 0x5a, 0x41, 0x27, 0x08, 0xbd, 0xbc, 0x13, 0x0c, 0xbc, 0x84, 0x13, 0x84, 0xf7, 0x77,
+	0x82, 0x6f, 0xf7, 0x76, 0x82, 0x41, 0xee, 0x04, 0xef, 0xee, 0xf7, 0x6f, 0x0f, 0xac, 0xd8, 0x9f,
+	0xe6, 0x05, 0x27, 0x41, 0x42, 0x21, 0xf5, 0x02, 0xa9, 0xd1, 0xf6, 0x64, 0x86, 0xdc, 0x7c, 0xb3,
+	0xb7, 0xd3, 0xb1, 0x65, 0x52, 0x5f, 0xb9, 0xb4, 0xda, 0x5a, 0xaf, 0x98, 0x42, 0xea, 0x2b, 0x35,
+	0xda, 0x9a, 0xdb, 0x22, 0x18, 0xff, 0xd4, 0xa0, 0x7a, 0x37, 0x9d, 0x9b, 0x82, 0x34, 0xe1, 0x9c,
+	0x8a, 0x73, 0x06, 0xd5, 0x3b, 0xf9, 0x71, 0x07, 0x1d, 0x08, 0xe0, 0xc8, 0x63, 0x45, 0x31, 0xdb,
+	0x01, 0x46, 0xda, 0x01, 0x96, 0x49, 0x7d, 0x49, 0x51, 0xcc, 0x76, 0x00, 0xa5, 0xbb, 0x21, 0xe0,
+	0x65, 0xa3, 0x7f, 0xc0, 0xcb, 0xc0, 0x9e, 0x5e, 0x16, 0x79, 0xbe, 0x97, 0x8d, 0xf5, 0xe3, 0x65,
+	0xd1, 0xd7, 0xef, 0x65, 0xb1, 0x57, 0xf2, 0xb2, 0x4e, 0x0f, 0x8a, 0xbf, 0x9c, 0x07, 0x25, 0x5e,
+	0xc1, 0x83, 0xc6, 0xff, 0x3a, 0x0f, 0x5a, 0xf8, 0x29, 0x0c, 0x26, 0xf9, 0x0d, 0xb9, 0x21, 0x13,
+	0x95, 0x5a, 0xcc, 0x6c, 0xb8, 0x27, 0x0b, 0x6e, 0x00, 0xe8, 0x96, 0x3a, 0x9c, 0xc5, 0x82, 0xff,
+	0xed, 0xeb, 0x8e, 0x9d, 0x9a, 0x79, 0x8e, 0x3d, 0x59, 0x99, 0x99, 0xcf, 0x7f, 0xfe, 0xf5, 0x76,
+	0xe8, 0x00, 0x9c, 0xf4, 0x9e, 0x97, 0x9d, 0x2f, 0x2b, 0x0b, 0xae, 0x81, 0xa8, 0x1b, 0xf4, 0x34,
+	0x7f, 0x4a, 0xf5, 0xad, 0x39, 0x1d, 0x84, 0x79, 0xfc, 0xcc, 0x34, 0x97, 0x1b, 0x87, 0x71, 0x5f,
+	0xae, 0xe4, 0x05, 0x5e, 0x07, 0xb1, 0xee, 0x0b, 0xc5, 0x7e, 0x52, 0x81, 0x0b, 0x47, 0x2a, 0x19,
+	0x84, 0xf9, 0x80, 0x4c, 0x92, 0x6b, 0x41, 0x98, 0xf0, 0xb5, 0x54, 0x3f, 0xf4, 0x55, 0x30, 0xe1,
+	0x06, 0x7b, 0xaf, 0xe3, 0x5d, 0xd6, 0xf7, 0xe4, 0x0e, 0x06, 0x61, 0x5d, 0x51, 0x32, 0x07, 0xb9,
+	0xec, 0x34, 0x9c, 0xf2, 0x65, 0x8d, 0x2e, 0x91, 0x22, 0x88, 0xb8, 0x61, 0x2f, 0x59, 0xc4, 0xec,
+	0x5b, 0x73, 0x2a, 0x08, 0xe3, 0xec, 0xcc, 0x14, 0xd7, 0x8a, 0xc3, 0xa8, 0xaf, 0xe5, 0x3c, 0x21,
+	0xad, 0x85, 0x1f, 0x45, 0x30, 0xdd, 0xb2, 0xe3, 0x40, 0x2e, 0x7d, 0x27, 0x82, 0x44, 0xd0, 0xb0,
+	0xe1, 0x91, 0x3e, 0x2d, 0x3d, 0x95, 0x0a, 0x02, 0xdb, 0x90, 0xcc, 0x0a, 0x1f, 0xcc, 0x22, 0x7c,
+	0x67, 0xaf, 0x54, 0xca, 0x5f, 0x0b, 0xfc, 0x3c, 0x73, 0xdd, 0xf5, 0xeb, 0x79, 0xf7, 0x25, 0x6e,
+	0x49, 0x77, 0xc4, 0x07, 0x3b, 0x48, 0x7c, 0xb8, 0x83, 0xc4, 0x47, 0x3b, 0x48, 0x78, 0xbc, 0x83,
+	0x84, 0x27, 0x3b, 0x48, 0x78, 0xba, 0x83, 0x84, 0x67, 0x3b, 0x48, 0xbc, 0x61, 0x23, 0xf1, 0xa6,
+	0x8d, 0x84, 0xbb, 0x36, 0x12, 0xef, 0xd9, 0x48, 0xb8, 0x6f, 0x23, 0x61, 0xcb, 0x46, 0xc2, 0x03,
+	0x1b, 0x89, 0x0f, 0x6d, 0x24, 0x3e, 0xb2, 0x91, 0xf0, 0xd8, 0x46, 0xe2, 0x13, 0x1b, 0x09, 0x4f,
+	0x6d, 0x24, 0x3e, 0xb3, 0x91, 0x70, 0xa3, 0x89, 0x84, 0x9b, 0x4d, 0x24, 0xde, 0x6a, 0x22, 0xe1,
+	0xab, 0x26, 0x12, 0xbf, 0x6f, 0x22, 0xe1, 0x6e, 0x13, 0x09, 0xf7, 0x9a, 0x48, 0xbc, 0xdf, 0x44,
+	0xe2, 0x56, 0x13, 0x89, 0x1f, 0x1f, 0x53, 0x8d, 0x1c, 0x5b, 0x23, 0x6c, 0x8d, 0xea, 0xaa, 0x95,
+	0xd3, 0x09, 0xdb, 0x30, 0xcc, 0xf5, 0x7c, 0xf7, 0x23, 0xbd, 0xba, 0xae, 0xe6, 0x19, 0xd3, 0xab,
+	0xc5, 0xe2, 0x10, 0x37, 0x86, 0xe3, 0xbf, 0x07, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x1a, 0xde, 0x8f,
+	0xa7, 0x11, 0x00, 0x00,
 }
 
 func (this *SearchEntitiesRequest) Equal(that interface{}) bool {
@@ -425,14 +806,14 @@ func (this *SearchEntitiesRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *SearchEndDevicesRequest) Equal(that interface{}) bool {
+func (this *LocationBoundingBox) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SearchEndDevicesRequest)
+	that1, ok := that.(*LocationBoundingBox)
 	if !ok {
-		that2, ok := that.(SearchEndDevicesRequest)
+		that2, ok := that.(LocationBoundingBox)
 		if ok {
 			that1 = &that2
 		} else {
@@ -444,45 +825,199 @@ func (this *SearchEndDevicesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+	if this.MinLatitude != that1.MinLatitude {
 		return false
 	}
-	if this.IDContains != that1.IDContains {
+	if this.MinLongitude != that1.MinLongitude {
 		return false
 	}
-	if this.NameContains != that1.NameContains {
+	if this.MaxLatitude != that1.MaxLatitude {
 		return false
 	}
-	if this.DescriptionContains != that1.DescriptionContains {
+	if this.MaxLongitude != that1.MaxLongitude {
 		return false
 	}
-	if len(this.AttributesContain) != len(that1.AttributesContain) {
-		return false
+	return true
+}
+func (this *LocationRadius) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	for i := range this.AttributesContain {
-		if this.AttributesContain[i] != that1.AttributesContain[i] {
+
+	that1, ok := that.(*LocationRadius)
+	if !ok {
+		that2, ok := that.(LocationRadius)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.DevEUIContains != that1.DevEUIContains {
-		return false
-	}
-	if this.JoinEUIContains != that1.JoinEUIContains {
-		return false
-	}
-	if this.DevAddrContains != that1.DevAddrContains {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.Order != that1.Order {
+	if this.Latitude != that1.Latitude {
 		return false
 	}
-	if this.Limit != that1.Limit {
+	if this.Longitude != that1.Longitude {
 		return false
 	}
-	if this.Page != that1.Page {
+	if this.Radius != that1.Radius {
+		return false
+	}
+	return true
+}
+func (this *SearchGatewaysRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SearchGatewaysRequest)
+	if !ok {
+		that2, ok := that.(SearchGatewaysRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.IDContains != that1.IDContains {
+		return false
+	}
+	if this.NameContains != that1.NameContains {
+		return false
+	}
+	if this.DescriptionContains != that1.DescriptionContains {
+		return false
+	}
+	if len(this.AttributesContain) != len(that1.AttributesContain) {
+		return false
+	}
+	for i := range this.AttributesContain {
+		if this.AttributesContain[i] != that1.AttributesContain[i] {
+			return false
+		}
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	if this.Order != that1.Order {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
+		return false
+	}
+	if !this.LocationBoundingBox.Equal(that1.LocationBoundingBox) {
+		return false
+	}
+	if !this.LocationRadius.Equal(that1.LocationRadius) {
+		return false
+	}
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
+	}
+	if this.BrandID != that1.BrandID {
+		return false
+	}
+	if this.ModelID != that1.ModelID {
+		return false
+	}
+	if that1.UpdatedAfter == nil {
+		if this.UpdatedAfter != nil {
+			return false
+		}
+	} else if !this.UpdatedAfter.Equal(*that1.UpdatedAfter) {
+		return false
+	}
+	return true
+}
+func (this *SearchEndDevicesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SearchEndDevicesRequest)
+	if !ok {
+		that2, ok := that.(SearchEndDevicesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if this.IDContains != that1.IDContains {
+		return false
+	}
+	if this.NameContains != that1.NameContains {
+		return false
+	}
+	if this.DescriptionContains != that1.DescriptionContains {
+		return false
+	}
+	if len(this.AttributesContain) != len(that1.AttributesContain) {
+		return false
+	}
+	for i := range this.AttributesContain {
+		if this.AttributesContain[i] != that1.AttributesContain[i] {
+			return false
+		}
+	}
+	if this.DevEUIContains != that1.DevEUIContains {
+		return false
+	}
+	if this.JoinEUIContains != that1.JoinEUIContains {
+		return false
+	}
+	if this.DevAddrContains != that1.DevAddrContains {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	if this.Order != that1.Order {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
+		return false
+	}
+	if !this.LocationBoundingBox.Equal(that1.LocationBoundingBox) {
+		return false
+	}
+	if !this.LocationRadius.Equal(that1.LocationRadius) {
+		return false
+	}
+	if this.BrandID != that1.BrandID {
+		return false
+	}
+	if this.ModelID != that1.ModelID {
+		return false
+	}
+	if that1.UpdatedAfter == nil {
+		if this.UpdatedAfter != nil {
+			return false
+		}
+	} else if !this.UpdatedAfter.Equal(*that1.UpdatedAfter) {
 		return false
 	}
 	return true
@@ -502,7 +1037,7 @@ const _ = grpc.SupportPackageIsVersion4
 type EntityRegistrySearchClient interface {
 	SearchApplications(ctx context.Context, in *SearchEntitiesRequest, opts ...grpc.CallOption) (*Applications, error)
 	SearchClients(ctx context.Context, in *SearchEntitiesRequest, opts ...grpc.CallOption) (*Clients, error)
-	SearchGateways(ctx context.Context, in *SearchEntitiesRequest, opts ...grpc.CallOption) (*Gateways, error)
+	SearchGateways(ctx context.Context, in *SearchGatewaysRequest, opts ...grpc.CallOption) (*Gateways, error)
 	SearchOrganizations(ctx context.Context, in *SearchEntitiesRequest, opts ...grpc.CallOption) (*Organizations, error)
 	SearchUsers(ctx context.Context, in *SearchEntitiesRequest, opts ...grpc.CallOption) (*Users, error)
 }
@@ -533,7 +1068,7 @@ func (c *entityRegistrySearchClient) SearchClients(ctx context.Context, in *Sear
 	return out, nil
 }
 
-func (c *entityRegistrySearchClient) SearchGateways(ctx context.Context, in *SearchEntitiesRequest, opts ...grpc.CallOption) (*Gateways, error) {
+func (c *entityRegistrySearchClient) SearchGateways(ctx context.Context, in *SearchGatewaysRequest, opts ...grpc.CallOption) (*Gateways, error) {
 	out := new(Gateways)
 	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EntityRegistrySearch/SearchGateways", in, out, opts...)
 	if err != nil {
@@ -564,7 +1099,7 @@ func (c *entityRegistrySearchClient) SearchUsers(ctx context.Context, in *Search
 type EntityRegistrySearchServer interface {
 	SearchApplications(context.Context, *SearchEntitiesRequest) (*Applications, error)
 	SearchClients(context.Context, *SearchEntitiesRequest) (*Clients, error)
-	SearchGateways(context.Context, *SearchEntitiesRequest) (*Gateways, error)
+	SearchGateways(context.Context, *SearchGatewaysRequest) (*Gateways, error)
 	SearchOrganizations(context.Context, *SearchEntitiesRequest) (*Organizations, error)
 	SearchUsers(context.Context, *SearchEntitiesRequest) (*Users, error)
 }
@@ -579,7 +1114,7 @@ func (*UnimplementedEntityRegistrySearchServer) SearchApplications(ctx context.C
 func (*UnimplementedEntityRegistrySearchServer) SearchClients(ctx context.Context, req *SearchEntitiesRequest) (*Clients, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchClients not implemented")
 }
-func (*UnimplementedEntityRegistrySearchServer) SearchGateways(ctx context.Context, req *SearchEntitiesRequest) (*Gateways, error) {
+func (*UnimplementedEntityRegistrySearchServer) SearchGateways(ctx context.Context, req *SearchGatewaysRequest) (*Gateways, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchGateways not implemented")
 }
 func (*UnimplementedEntityRegistrySearchServer) SearchOrganizations(ctx context.Context, req *SearchEntitiesRequest) (*Organizations, error) {
@@ -630,7 +1165,7 @@ func _EntityRegistrySearch_SearchClients_Handler(srv interface{}, ctx context.Co
 }
 
 func _EntityRegistrySearch_SearchGateways_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchEntitiesRequest)
+	in := new(SearchGatewaysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -642,7 +1177,7 @@ func _EntityRegistrySearch_SearchGateways_Handler(srv interface{}, ctx context.C
 		FullMethod: "/ttn.lorawan.v3.EntityRegistrySearch/SearchGateways",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EntityRegistrySearchServer).SearchGateways(ctx, req.(*SearchEntitiesRequest))
+		return srv.(EntityRegistrySearchServer).SearchGateways(ctx, req.(*SearchGatewaysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -874,7 +1409,7 @@ func (m *SearchEntitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SearchEndDevicesRequest) Marshal() (dAtA []byte, err error) {
+func (m *LocationBoundingBox) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -884,32 +1419,175 @@ func (m *SearchEndDevicesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SearchEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *LocationBoundingBox) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SearchEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LocationBoundingBox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLongitude != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], math.Float64bits(float64(m.MaxLongitude)))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.MaxLatitude != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], math.Float64bits(float64(m.MaxLatitude)))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.MinLongitude != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], math.Float64bits(float64(m.MinLongitude)))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.MinLatitude != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], math.Float64bits(float64(m.MinLatitude)))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LocationRadius) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LocationRadius) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LocationRadius) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Radius != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], math.Float64bits(float64(m.Radius)))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Longitude != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], math.Float64bits(float64(m.Longitude)))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Latitude != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], math.Float64bits(float64(m.Latitude)))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchGatewaysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchGatewaysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchGatewaysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.UpdatedAfter != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAfter):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintSearchServices(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ModelID) > 0 {
+		i -= len(m.ModelID)
+		copy(dAtA[i:], m.ModelID)
+		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.ModelID)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.BrandID) > 0 {
+		i -= len(m.BrandID)
+		copy(dAtA[i:], m.BrandID)
+		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.BrandID)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.FrequencyPlanID) > 0 {
+		i -= len(m.FrequencyPlanID)
+		copy(dAtA[i:], m.FrequencyPlanID)
+		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.FrequencyPlanID)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.LocationRadius != nil {
+		{
+			size, err := m.LocationRadius.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSearchServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.LocationBoundingBox != nil {
+		{
+			size, err := m.LocationBoundingBox.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSearchServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Page != 0 {
 		i = encodeVarintSearchServices(dAtA, i, uint64(m.Page))
 		i--
-		dAtA[i] = 0x60
+		dAtA[i] = 0x48
 	}
 	if m.Limit != 0 {
 		i = encodeVarintSearchServices(dAtA, i, uint64(m.Limit))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x40
 	}
 	if len(m.Order) > 0 {
 		i -= len(m.Order)
 		copy(dAtA[i:], m.Order)
 		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.Order)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x3a
 	}
 	{
 		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
@@ -920,28 +1598,7 @@ func (m *SearchEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i = encodeVarintSearchServices(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x4a
-	if len(m.DevAddrContains) > 0 {
-		i -= len(m.DevAddrContains)
-		copy(dAtA[i:], m.DevAddrContains)
-		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.DevAddrContains)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.JoinEUIContains) > 0 {
-		i -= len(m.JoinEUIContains)
-		copy(dAtA[i:], m.JoinEUIContains)
-		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.JoinEUIContains)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.DevEUIContains) > 0 {
-		i -= len(m.DevEUIContains)
-		copy(dAtA[i:], m.DevEUIContains)
-		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.DevEUIContains)))
-		i--
-		dAtA[i] = 0x32
-	}
+	dAtA[i] = 0x32
 	if len(m.AttributesContain) > 0 {
 		for k := range m.AttributesContain {
 			v := m.AttributesContain[k]
@@ -958,7 +1615,7 @@ func (m *SearchEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			dAtA[i] = 0xa
 			i = encodeVarintSearchServices(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x22
 		}
 	}
 	if len(m.DescriptionContains) > 0 {
@@ -966,26 +1623,189 @@ func (m *SearchEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.DescriptionContains)
 		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.DescriptionContains)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
 	if len(m.NameContains) > 0 {
 		i -= len(m.NameContains)
 		copy(dAtA[i:], m.NameContains)
 		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.NameContains)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
 	if len(m.IDContains) > 0 {
 		i -= len(m.IDContains)
 		copy(dAtA[i:], m.IDContains)
 		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.IDContains)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
-	{
-		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchEndDevicesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchEndDevicesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAfter != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdatedAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAfter):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintSearchServices(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ModelID) > 0 {
+		i -= len(m.ModelID)
+		copy(dAtA[i:], m.ModelID)
+		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.ModelID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.BrandID) > 0 {
+		i -= len(m.BrandID)
+		copy(dAtA[i:], m.BrandID)
+		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.BrandID)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.LocationRadius != nil {
+		{
+			size, err := m.LocationRadius.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSearchServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.LocationBoundingBox != nil {
+		{
+			size, err := m.LocationBoundingBox.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSearchServices(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Page != 0 {
+		i = encodeVarintSearchServices(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Limit != 0 {
+		i = encodeVarintSearchServices(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Order) > 0 {
+		i -= len(m.Order)
+		copy(dAtA[i:], m.Order)
+		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.Order)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSearchServices(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.DevAddrContains) > 0 {
+		i -= len(m.DevAddrContains)
+		copy(dAtA[i:], m.DevAddrContains)
+		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.DevAddrContains)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.JoinEUIContains) > 0 {
+		i -= len(m.JoinEUIContains)
+		copy(dAtA[i:], m.JoinEUIContains)
+		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.JoinEUIContains)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DevEUIContains) > 0 {
+		i -= len(m.DevEUIContains)
+		copy(dAtA[i:], m.DevEUIContains)
+		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.DevEUIContains)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AttributesContain) > 0 {
+		for k := range m.AttributesContain {
+			v := m.AttributesContain[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintSearchServices(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSearchServices(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSearchServices(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DescriptionContains) > 0 {
+		i -= len(m.DescriptionContains)
+		copy(dAtA[i:], m.DescriptionContains)
+		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.DescriptionContains)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NameContains) > 0 {
+		i -= len(m.NameContains)
+		copy(dAtA[i:], m.NameContains)
+		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.NameContains)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IDContains) > 0 {
+		i -= len(m.IDContains)
+		copy(dAtA[i:], m.IDContains)
+		i = encodeVarintSearchServices(dAtA, i, uint64(len(m.IDContains)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
 		i -= size
 		i = encodeVarintSearchServices(dAtA, i, uint64(size))
@@ -1028,28 +1848,115 @@ func NewPopulatedSearchEntitiesRequest(r randySearchServices, easy bool) *Search
 	return this
 }
 
+func NewPopulatedLocationBoundingBox(r randySearchServices, easy bool) *LocationBoundingBox {
+	this := &LocationBoundingBox{}
+	this.MinLatitude = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.MinLatitude *= -1
+	}
+	this.MinLongitude = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.MinLongitude *= -1
+	}
+	this.MaxLatitude = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.MaxLatitude *= -1
+	}
+	this.MaxLongitude = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.MaxLongitude *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedLocationRadius(r randySearchServices, easy bool) *LocationRadius {
+	this := &LocationRadius{}
+	this.Latitude = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Latitude *= -1
+	}
+	this.Longitude = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Longitude *= -1
+	}
+	this.Radius = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Radius *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSearchGatewaysRequest(r randySearchServices, easy bool) *SearchGatewaysRequest {
+	this := &SearchGatewaysRequest{}
+	this.IDContains = randStringSearchServices(r)
+	this.NameContains = randStringSearchServices(r)
+	this.DescriptionContains = randStringSearchServices(r)
+	if r.Intn(5) != 0 {
+		v3 := r.Intn(10)
+		this.AttributesContain = make(map[string]string)
+		for i := 0; i < v3; i++ {
+			this.AttributesContain[randStringSearchServices(r)] = randStringSearchServices(r)
+		}
+	}
+	v4 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v4
+	this.Order = randStringSearchServices(r)
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.LocationBoundingBox = NewPopulatedLocationBoundingBox(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.LocationRadius = NewPopulatedLocationRadius(r, easy)
+	}
+	this.FrequencyPlanID = randStringSearchServices(r)
+	this.BrandID = randStringSearchServices(r)
+	this.ModelID = randStringSearchServices(r)
+	if r.Intn(5) != 0 {
+		this.UpdatedAfter = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedSearchEndDevicesRequest(r randySearchServices, easy bool) *SearchEndDevicesRequest {
 	this := &SearchEndDevicesRequest{}
-	v3 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v3
+	v5 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v5
 	this.IDContains = randStringSearchServices(r)
 	this.NameContains = randStringSearchServices(r)
 	this.DescriptionContains = randStringSearchServices(r)
 	if r.Intn(5) != 0 {
-		v4 := r.Intn(10)
+		v6 := r.Intn(10)
 		this.AttributesContain = make(map[string]string)
-		for i := 0; i < v4; i++ {
+		for i := 0; i < v6; i++ {
 			this.AttributesContain[randStringSearchServices(r)] = randStringSearchServices(r)
 		}
 	}
 	this.DevEUIContains = randStringSearchServices(r)
 	this.JoinEUIContains = randStringSearchServices(r)
 	this.DevAddrContains = randStringSearchServices(r)
-	v5 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v5
+	v7 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v7
 	this.Order = randStringSearchServices(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
+	if r.Intn(5) != 0 {
+		this.LocationBoundingBox = NewPopulatedLocationBoundingBox(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.LocationRadius = NewPopulatedLocationRadius(r, easy)
+	}
+	this.BrandID = randStringSearchServices(r)
+	this.ModelID = randStringSearchServices(r)
+	if r.Intn(5) != 0 {
+		this.UpdatedAfter = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1074,9 +1981,9 @@ func randUTF8RuneSearchServices(r randySearchServices) rune {
 	return rune(ru + 61)
 }
 func randStringSearchServices(r randySearchServices) string {
-	v6 := r.Intn(100)
-	tmps := make([]rune, v6)
-	for i := 0; i < v6; i++ {
+	v8 := r.Intn(100)
+	tmps := make([]rune, v8)
+	for i := 0; i < v8; i++ {
 		tmps[i] = randUTF8RuneSearchServices(r)
 	}
 	return string(tmps)
@@ -1098,11 +2005,11 @@ func randFieldSearchServices(dAtA []byte, r randySearchServices, fieldNumber int
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateSearchServices(dAtA, uint64(key))
-		v7 := r.Int63()
+		v9 := r.Int63()
 		if r.Intn(2) == 0 {
-			v7 *= -1
+			v9 *= -1
 		}
-		dAtA = encodeVarintPopulateSearchServices(dAtA, uint64(v7))
+		dAtA = encodeVarintPopulateSearchServices(dAtA, uint64(v9))
 	case 1:
 		dAtA = encodeVarintPopulateSearchServices(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1168,14 +2075,51 @@ func (m *SearchEntitiesRequest) Size() (n int) {
 	return n
 }
 
-func (m *SearchEndDevicesRequest) Size() (n int) {
+func (m *LocationBoundingBox) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinLatitude != 0 {
+		n += 9
+	}
+	if m.MinLongitude != 0 {
+		n += 9
+	}
+	if m.MaxLatitude != 0 {
+		n += 9
+	}
+	if m.MaxLongitude != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *LocationRadius) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Latitude != 0 {
+		n += 9
+	}
+	if m.Longitude != 0 {
+		n += 9
+	}
+	if m.Radius != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *SearchGatewaysRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ApplicationIdentifiers.Size()
-	n += 1 + l + sovSearchServices(uint64(l))
 	l = len(m.IDContains)
 	if l > 0 {
 		n += 1 + l + sovSearchServices(uint64(l))
@@ -1196,106 +2140,818 @@ func (m *SearchEndDevicesRequest) Size() (n int) {
 			n += mapEntrySize + 1 + sovSearchServices(uint64(mapEntrySize))
 		}
 	}
-	l = len(m.DevEUIContains)
+	l = m.FieldMask.Size()
+	n += 1 + l + sovSearchServices(uint64(l))
+	l = len(m.Order)
 	if l > 0 {
 		n += 1 + l + sovSearchServices(uint64(l))
 	}
-	l = len(m.JoinEUIContains)
+	if m.Limit != 0 {
+		n += 1 + sovSearchServices(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovSearchServices(uint64(m.Page))
+	}
+	if m.LocationBoundingBox != nil {
+		l = m.LocationBoundingBox.Size()
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	if m.LocationRadius != nil {
+		l = m.LocationRadius.Size()
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	l = len(m.FrequencyPlanID)
 	if l > 0 {
 		n += 1 + l + sovSearchServices(uint64(l))
 	}
-	l = len(m.DevAddrContains)
+	l = len(m.BrandID)
 	if l > 0 {
 		n += 1 + l + sovSearchServices(uint64(l))
 	}
-	l = m.FieldMask.Size()
+	l = len(m.ModelID)
+	if l > 0 {
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	if m.UpdatedAfter != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAfter)
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	return n
+}
+
+func (m *SearchEndDevicesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
 	n += 1 + l + sovSearchServices(uint64(l))
-	l = len(m.Order)
+	l = len(m.IDContains)
 	if l > 0 {
 		n += 1 + l + sovSearchServices(uint64(l))
 	}
-	if m.Limit != 0 {
-		n += 1 + sovSearchServices(uint64(m.Limit))
+	l = len(m.NameContains)
+	if l > 0 {
+		n += 1 + l + sovSearchServices(uint64(l))
 	}
-	if m.Page != 0 {
-		n += 1 + sovSearchServices(uint64(m.Page))
+	l = len(m.DescriptionContains)
+	if l > 0 {
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	if len(m.AttributesContain) > 0 {
+		for k, v := range m.AttributesContain {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSearchServices(uint64(len(k))) + 1 + len(v) + sovSearchServices(uint64(len(v)))
+			n += mapEntrySize + 1 + sovSearchServices(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.DevEUIContains)
+	if l > 0 {
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	l = len(m.JoinEUIContains)
+	if l > 0 {
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	l = len(m.DevAddrContains)
+	if l > 0 {
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	l = m.FieldMask.Size()
+	n += 1 + l + sovSearchServices(uint64(l))
+	l = len(m.Order)
+	if l > 0 {
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovSearchServices(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovSearchServices(uint64(m.Page))
+	}
+	if m.LocationBoundingBox != nil {
+		l = m.LocationBoundingBox.Size()
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	if m.LocationRadius != nil {
+		l = m.LocationRadius.Size()
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	l = len(m.BrandID)
+	if l > 0 {
+		n += 1 + l + sovSearchServices(uint64(l))
+	}
+	l = len(m.ModelID)
+	if l > 0 {
+		n += 2 + l + sovSearchServices(uint64(l))
+	}
+	if m.UpdatedAfter != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdatedAfter)
+		n += 2 + l + sovSearchServices(uint64(l))
+	}
+	return n
+}
+
+func sovSearchServices(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSearchServices(x uint64) (n int) {
+	return sovSearchServices((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *SearchEntitiesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAttributesContain := make([]string, 0, len(this.AttributesContain))
+	for k := range this.AttributesContain {
+		keysForAttributesContain = append(keysForAttributesContain, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributesContain)
+	mapStringForAttributesContain := "map[string]string{"
+	for _, k := range keysForAttributesContain {
+		mapStringForAttributesContain += fmt.Sprintf("%v: %v,", k, this.AttributesContain[k])
+	}
+	mapStringForAttributesContain += "}"
+	s := strings.Join([]string{`&SearchEntitiesRequest{`,
+		`IDContains:` + fmt.Sprintf("%v", this.IDContains) + `,`,
+		`NameContains:` + fmt.Sprintf("%v", this.NameContains) + `,`,
+		`DescriptionContains:` + fmt.Sprintf("%v", this.DescriptionContains) + `,`,
+		`AttributesContain:` + mapStringForAttributesContain + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LocationBoundingBox) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LocationBoundingBox{`,
+		`MinLatitude:` + fmt.Sprintf("%v", this.MinLatitude) + `,`,
+		`MinLongitude:` + fmt.Sprintf("%v", this.MinLongitude) + `,`,
+		`MaxLatitude:` + fmt.Sprintf("%v", this.MaxLatitude) + `,`,
+		`MaxLongitude:` + fmt.Sprintf("%v", this.MaxLongitude) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LocationRadius) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LocationRadius{`,
+		`Latitude:` + fmt.Sprintf("%v", this.Latitude) + `,`,
+		`Longitude:` + fmt.Sprintf("%v", this.Longitude) + `,`,
+		`Radius:` + fmt.Sprintf("%v", this.Radius) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchGatewaysRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAttributesContain := make([]string, 0, len(this.AttributesContain))
+	for k := range this.AttributesContain {
+		keysForAttributesContain = append(keysForAttributesContain, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributesContain)
+	mapStringForAttributesContain := "map[string]string{"
+	for _, k := range keysForAttributesContain {
+		mapStringForAttributesContain += fmt.Sprintf("%v: %v,", k, this.AttributesContain[k])
+	}
+	mapStringForAttributesContain += "}"
+	s := strings.Join([]string{`&SearchGatewaysRequest{`,
+		`IDContains:` + fmt.Sprintf("%v", this.IDContains) + `,`,
+		`NameContains:` + fmt.Sprintf("%v", this.NameContains) + `,`,
+		`DescriptionContains:` + fmt.Sprintf("%v", this.DescriptionContains) + `,`,
+		`AttributesContain:` + mapStringForAttributesContain + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`LocationBoundingBox:` + strings.Replace(this.LocationBoundingBox.String(), "LocationBoundingBox", "LocationBoundingBox", 1) + `,`,
+		`LocationRadius:` + strings.Replace(this.LocationRadius.String(), "LocationRadius", "LocationRadius", 1) + `,`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
+		`BrandID:` + fmt.Sprintf("%v", this.BrandID) + `,`,
+		`ModelID:` + fmt.Sprintf("%v", this.ModelID) + `,`,
+		`UpdatedAfter:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAfter), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SearchEndDevicesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAttributesContain := make([]string, 0, len(this.AttributesContain))
+	for k := range this.AttributesContain {
+		keysForAttributesContain = append(keysForAttributesContain, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributesContain)
+	mapStringForAttributesContain := "map[string]string{"
+	for _, k := range keysForAttributesContain {
+		mapStringForAttributesContain += fmt.Sprintf("%v: %v,", k, this.AttributesContain[k])
+	}
+	mapStringForAttributesContain += "}"
+	s := strings.Join([]string{`&SearchEndDevicesRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`IDContains:` + fmt.Sprintf("%v", this.IDContains) + `,`,
+		`NameContains:` + fmt.Sprintf("%v", this.NameContains) + `,`,
+		`DescriptionContains:` + fmt.Sprintf("%v", this.DescriptionContains) + `,`,
+		`AttributesContain:` + mapStringForAttributesContain + `,`,
+		`DevEUIContains:` + fmt.Sprintf("%v", this.DevEUIContains) + `,`,
+		`JoinEUIContains:` + fmt.Sprintf("%v", this.JoinEUIContains) + `,`,
+		`DevAddrContains:` + fmt.Sprintf("%v", this.DevAddrContains) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`LocationBoundingBox:` + strings.Replace(this.LocationBoundingBox.String(), "LocationBoundingBox", "LocationBoundingBox", 1) + `,`,
+		`LocationRadius:` + strings.Replace(this.LocationRadius.String(), "LocationRadius", "LocationRadius", 1) + `,`,
+		`BrandID:` + fmt.Sprintf("%v", this.BrandID) + `,`,
+		`ModelID:` + fmt.Sprintf("%v", this.ModelID) + `,`,
+		`UpdatedAfter:` + strings.Replace(fmt.Sprintf("%v", this.UpdatedAfter), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringSearchServices(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SearchEntitiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSearchServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchEntitiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchEntitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDContains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IDContains = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameContains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameContains = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescriptionContains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DescriptionContains = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributesContain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AttributesContain == nil {
+				m.AttributesContain = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSearchServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSearchServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSearchServices
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSearchServices
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSearchServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthSearchServices
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthSearchServices
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSearchServices(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthSearchServices
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AttributesContain[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSearchServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LocationBoundingBox) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSearchServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocationBoundingBox: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocationBoundingBox: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLatitude", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:])
+			iNdEx += 8
+			m.MinLatitude = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLongitude", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:])
+			iNdEx += 8
+			m.MinLongitude = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatitude", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:])
+			iNdEx += 8
+			m.MaxLatitude = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLongitude", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:])
+			iNdEx += 8
+			m.MaxLongitude = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSearchServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovSearchServices(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSearchServices(x uint64) (n int) {
-	return sovSearchServices((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *SearchEntitiesRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForAttributesContain := make([]string, 0, len(this.AttributesContain))
-	for k := range this.AttributesContain {
-		keysForAttributesContain = append(keysForAttributesContain, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributesContain)
-	mapStringForAttributesContain := "map[string]string{"
-	for _, k := range keysForAttributesContain {
-		mapStringForAttributesContain += fmt.Sprintf("%v: %v,", k, this.AttributesContain[k])
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	mapStringForAttributesContain += "}"
-	s := strings.Join([]string{`&SearchEntitiesRequest{`,
-		`IDContains:` + fmt.Sprintf("%v", this.IDContains) + `,`,
-		`NameContains:` + fmt.Sprintf("%v", this.NameContains) + `,`,
-		`DescriptionContains:` + fmt.Sprintf("%v", this.DescriptionContains) + `,`,
-		`AttributesContain:` + mapStringForAttributesContain + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
-		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
-		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *SearchEndDevicesRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForAttributesContain := make([]string, 0, len(this.AttributesContain))
-	for k := range this.AttributesContain {
-		keysForAttributesContain = append(keysForAttributesContain, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributesContain)
-	mapStringForAttributesContain := "map[string]string{"
-	for _, k := range keysForAttributesContain {
-		mapStringForAttributesContain += fmt.Sprintf("%v: %v,", k, this.AttributesContain[k])
+func (m *LocationRadius) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSearchServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LocationRadius: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LocationRadius: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latitude", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:])
+			iNdEx += 8
+			m.Latitude = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Longitude", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:])
+			iNdEx += 8
+			m.Longitude = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Radius", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:])
+			iNdEx += 8
+			m.Radius = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSearchServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	mapStringForAttributesContain += "}"
-	s := strings.Join([]string{`&SearchEndDevicesRequest{`,
-		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
-		`IDContains:` + fmt.Sprintf("%v", this.IDContains) + `,`,
-		`NameContains:` + fmt.Sprintf("%v", this.NameContains) + `,`,
-		`DescriptionContains:` + fmt.Sprintf("%v", this.DescriptionContains) + `,`,
-		`AttributesContain:` + mapStringForAttributesContain + `,`,
-		`DevEUIContains:` + fmt.Sprintf("%v", this.DevEUIContains) + `,`,
-		`JoinEUIContains:` + fmt.Sprintf("%v", this.JoinEUIContains) + `,`,
-		`DevAddrContains:` + fmt.Sprintf("%v", this.DevAddrContains) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
-		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
-		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringSearchServices(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *SearchEntitiesRequest) Unmarshal(dAtA []byte) error {
+func (m *SearchGatewaysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1318,10 +2974,10 @@ func (m *SearchEntitiesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SearchEntitiesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SearchGatewaysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SearchEntitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SearchGatewaysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1545,11 +3201,150 @@ func (m *SearchEntitiesRequest) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.AttributesContain[mapkey] = mapvalue
+			m.AttributesContain[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationBoundingBox", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LocationBoundingBox == nil {
+				m.LocationBoundingBox = &LocationBoundingBox{}
+			}
+			if err := m.LocationBoundingBox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationRadius", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1576,13 +3371,16 @@ func (m *SearchEntitiesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LocationRadius == nil {
+				m.LocationRadius = &LocationRadius{}
+			}
+			if err := m.LocationRadius.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1610,13 +3408,13 @@ func (m *SearchEntitiesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Order = string(dAtA[iNdEx:postIndex])
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrandID", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSearchServices
@@ -1626,16 +3424,29 @@ func (m *SearchEntitiesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSearchServices
 			}
-			m.Page = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrandID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSearchServices
@@ -1645,11 +3456,60 @@ func (m *SearchEntitiesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAfter == nil {
+				m.UpdatedAfter = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSearchServices(dAtA[iNdEx:])
@@ -2158,6 +4018,178 @@ func (m *SearchEndDevicesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationBoundingBox", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LocationBoundingBox == nil {
+				m.LocationBoundingBox = &LocationBoundingBox{}
+			}
+			if err := m.LocationBoundingBox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationRadius", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LocationRadius == nil {
+				m.LocationRadius = &LocationRadius{}
+			}
+			if err := m.LocationRadius.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrandID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrandID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearchServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearchServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAfter == nil {
+				m.UpdatedAfter = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.UpdatedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSearchServices(dAtA[iNdEx:])
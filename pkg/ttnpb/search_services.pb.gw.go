@@ -102,7 +102,7 @@ var (
 )
 
 func request_EntityRegistrySearch_SearchGateways_0(ctx context.Context, marshaler runtime.Marshaler, client EntityRegistrySearchClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq SearchEntitiesRequest
+	var protoReq SearchGatewaysRequest
 	var metadata runtime.ServerMetadata
 
 	if err := req.ParseForm(); err != nil {
@@ -118,7 +118,7 @@ func request_EntityRegistrySearch_SearchGateways_0(ctx context.Context, marshale
 }
 
 func local_request_EntityRegistrySearch_SearchGateways_0(ctx context.Context, marshaler runtime.Marshaler, server EntityRegistrySearchServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
-	var protoReq SearchEntitiesRequest
+	var protoReq SearchGatewaysRequest
 	var metadata runtime.ServerMetadata
 
 	if err := runtime.PopulateQueryParameters(&protoReq, req.URL.Query(), filter_EntityRegistrySearch_SearchGateways_0); err != nil {
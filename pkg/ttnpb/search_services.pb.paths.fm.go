@@ -23,10 +23,78 @@ var SearchEntitiesRequestFieldPathsTopLevel = []string{
 	"order",
 	"page",
 }
+var LocationBoundingBoxFieldPathsNested = []string{
+	"max_latitude",
+	"max_longitude",
+	"min_latitude",
+	"min_longitude",
+}
+
+var LocationBoundingBoxFieldPathsTopLevel = []string{
+	"max_latitude",
+	"max_longitude",
+	"min_latitude",
+	"min_longitude",
+}
+
+var LocationRadiusFieldPathsNested = []string{
+	"latitude",
+	"longitude",
+	"radius",
+}
+
+var LocationRadiusFieldPathsTopLevel = []string{
+	"latitude",
+	"longitude",
+	"radius",
+}
+
+var SearchGatewaysRequestFieldPathsNested = []string{
+	"attributes_contain",
+	"brand_id",
+	"description_contains",
+	"field_mask",
+	"frequency_plan_id",
+	"id_contains",
+	"limit",
+	"location_bounding_box",
+	"location_bounding_box.max_latitude",
+	"location_bounding_box.max_longitude",
+	"location_bounding_box.min_latitude",
+	"location_bounding_box.min_longitude",
+	"location_radius",
+	"location_radius.latitude",
+	"location_radius.longitude",
+	"location_radius.radius",
+	"model_id",
+	"name_contains",
+	"order",
+	"page",
+	"updated_after",
+}
+
+var SearchGatewaysRequestFieldPathsTopLevel = []string{
+	"attributes_contain",
+	"brand_id",
+	"description_contains",
+	"field_mask",
+	"frequency_plan_id",
+	"id_contains",
+	"limit",
+	"location_bounding_box",
+	"location_radius",
+	"model_id",
+	"name_contains",
+	"order",
+	"page",
+	"updated_after",
+}
+
 var SearchEndDevicesRequestFieldPathsNested = []string{
 	"application_ids",
 	"application_ids.application_id",
 	"attributes_contain",
+	"brand_id",
 	"description_contains",
 	"dev_addr_contains",
 	"dev_eui_contains",
@@ -34,14 +102,26 @@ var SearchEndDevicesRequestFieldPathsNested = []string{
 	"id_contains",
 	"join_eui_contains",
 	"limit",
+	"location_bounding_box",
+	"location_bounding_box.max_latitude",
+	"location_bounding_box.max_longitude",
+	"location_bounding_box.min_latitude",
+	"location_bounding_box.min_longitude",
+	"location_radius",
+	"location_radius.latitude",
+	"location_radius.longitude",
+	"location_radius.radius",
+	"model_id",
 	"name_contains",
 	"order",
 	"page",
+	"updated_after",
 }
 
 var SearchEndDevicesRequestFieldPathsTopLevel = []string{
 	"application_ids",
 	"attributes_contain",
+	"brand_id",
 	"description_contains",
 	"dev_addr_contains",
 	"dev_eui_contains",
@@ -49,7 +129,11 @@ var SearchEndDevicesRequestFieldPathsTopLevel = []string{
 	"id_contains",
 	"join_eui_contains",
 	"limit",
+	"location_bounding_box",
+	"location_radius",
+	"model_id",
 	"name_contains",
 	"order",
 	"page",
+	"updated_after",
 }
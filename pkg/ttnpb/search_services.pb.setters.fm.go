@@ -98,6 +98,278 @@ func (dst *SearchEntitiesRequest) SetFields(src *SearchEntitiesRequest, paths ..
 	return nil
 }
 
+func (dst *LocationBoundingBox) SetFields(src *LocationBoundingBox, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "min_latitude":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_latitude' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinLatitude = src.MinLatitude
+			} else {
+				var zero float64
+				dst.MinLatitude = zero
+			}
+		case "min_longitude":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_longitude' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinLongitude = src.MinLongitude
+			} else {
+				var zero float64
+				dst.MinLongitude = zero
+			}
+		case "max_latitude":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_latitude' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxLatitude = src.MaxLatitude
+			} else {
+				var zero float64
+				dst.MaxLatitude = zero
+			}
+		case "max_longitude":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_longitude' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxLongitude = src.MaxLongitude
+			} else {
+				var zero float64
+				dst.MaxLongitude = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *LocationRadius) SetFields(src *LocationRadius, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "latitude":
+			if len(subs) > 0 {
+				return fmt.Errorf("'latitude' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Latitude = src.Latitude
+			} else {
+				var zero float64
+				dst.Latitude = zero
+			}
+		case "longitude":
+			if len(subs) > 0 {
+				return fmt.Errorf("'longitude' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Longitude = src.Longitude
+			} else {
+				var zero float64
+				dst.Longitude = zero
+			}
+		case "radius":
+			if len(subs) > 0 {
+				return fmt.Errorf("'radius' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Radius = src.Radius
+			} else {
+				var zero float64
+				dst.Radius = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SearchGatewaysRequest) SetFields(src *SearchGatewaysRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "id_contains":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id_contains' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.IDContains = src.IDContains
+			} else {
+				var zero string
+				dst.IDContains = zero
+			}
+		case "name_contains":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name_contains' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NameContains = src.NameContains
+			} else {
+				var zero string
+				dst.NameContains = zero
+			}
+		case "description_contains":
+			if len(subs) > 0 {
+				return fmt.Errorf("'description_contains' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DescriptionContains = src.DescriptionContains
+			} else {
+				var zero string
+				dst.DescriptionContains = zero
+			}
+		case "attributes_contain":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attributes_contain' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AttributesContain = src.AttributesContain
+			} else {
+				dst.AttributesContain = nil
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
+		case "order":
+			if len(subs) > 0 {
+				return fmt.Errorf("'order' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Order = src.Order
+			} else {
+				var zero string
+				dst.Order = zero
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		case "location_bounding_box":
+			if len(subs) > 0 {
+				var newDst, newSrc *LocationBoundingBox
+				if (src == nil || src.LocationBoundingBox == nil) && dst.LocationBoundingBox == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LocationBoundingBox
+				}
+				if dst.LocationBoundingBox != nil {
+					newDst = dst.LocationBoundingBox
+				} else {
+					newDst = &LocationBoundingBox{}
+					dst.LocationBoundingBox = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LocationBoundingBox = src.LocationBoundingBox
+				} else {
+					dst.LocationBoundingBox = nil
+				}
+			}
+		case "location_radius":
+			if len(subs) > 0 {
+				var newDst, newSrc *LocationRadius
+				if (src == nil || src.LocationRadius == nil) && dst.LocationRadius == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LocationRadius
+				}
+				if dst.LocationRadius != nil {
+					newDst = dst.LocationRadius
+				} else {
+					newDst = &LocationRadius{}
+					dst.LocationRadius = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LocationRadius = src.LocationRadius
+				} else {
+					dst.LocationRadius = nil
+				}
+			}
+		case "frequency_plan_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency_plan_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FrequencyPlanID = src.FrequencyPlanID
+			} else {
+				var zero string
+				dst.FrequencyPlanID = zero
+			}
+		case "brand_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'brand_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BrandID = src.BrandID
+			} else {
+				var zero string
+				dst.BrandID = zero
+			}
+		case "model_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'model_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ModelID = src.ModelID
+			} else {
+				var zero string
+				dst.ModelID = zero
+			}
+		case "updated_after":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_after' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAfter = src.UpdatedAfter
+			} else {
+				dst.UpdatedAfter = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *SearchEndDevicesRequest) SetFields(src *SearchEndDevicesRequest, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -229,6 +501,86 @@ func (dst *SearchEndDevicesRequest) SetFields(src *SearchEndDevicesRequest, path
 				dst.Page = zero
 			}
 
+		case "location_bounding_box":
+			if len(subs) > 0 {
+				var newDst, newSrc *LocationBoundingBox
+				if (src == nil || src.LocationBoundingBox == nil) && dst.LocationBoundingBox == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LocationBoundingBox
+				}
+				if dst.LocationBoundingBox != nil {
+					newDst = dst.LocationBoundingBox
+				} else {
+					newDst = &LocationBoundingBox{}
+					dst.LocationBoundingBox = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LocationBoundingBox = src.LocationBoundingBox
+				} else {
+					dst.LocationBoundingBox = nil
+				}
+			}
+		case "location_radius":
+			if len(subs) > 0 {
+				var newDst, newSrc *LocationRadius
+				if (src == nil || src.LocationRadius == nil) && dst.LocationRadius == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LocationRadius
+				}
+				if dst.LocationRadius != nil {
+					newDst = dst.LocationRadius
+				} else {
+					newDst = &LocationRadius{}
+					dst.LocationRadius = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LocationRadius = src.LocationRadius
+				} else {
+					dst.LocationRadius = nil
+				}
+			}
+		case "brand_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'brand_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BrandID = src.BrandID
+			} else {
+				var zero string
+				dst.BrandID = zero
+			}
+		case "model_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'model_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ModelID = src.ModelID
+			} else {
+				var zero string
+				dst.ModelID = zero
+			}
+		case "updated_after":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_after' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAfter = src.UpdatedAfter
+			} else {
+				dst.UpdatedAfter = nil
+			}
+
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
 		}
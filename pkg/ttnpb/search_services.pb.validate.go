@@ -171,6 +171,431 @@ var _ interface {
 
 var _SearchEntitiesRequest_AttributesContain_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
 
+// ValidateFields checks the field values on LocationBoundingBox with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *LocationBoundingBox) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = LocationBoundingBoxFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "min_latitude":
+
+			if val := m.GetMinLatitude(); val < -90 || val > 90 {
+				return LocationBoundingBoxValidationError{
+					field:  "min_latitude",
+					reason: "value must be inside range [-90, 90]",
+				}
+			}
+
+		case "min_longitude":
+
+			if val := m.GetMinLongitude(); val < -180 || val > 180 {
+				return LocationBoundingBoxValidationError{
+					field:  "min_longitude",
+					reason: "value must be inside range [-180, 180]",
+				}
+			}
+
+		case "max_latitude":
+
+			if val := m.GetMaxLatitude(); val < -90 || val > 90 {
+				return LocationBoundingBoxValidationError{
+					field:  "max_latitude",
+					reason: "value must be inside range [-90, 90]",
+				}
+			}
+
+		case "max_longitude":
+
+			if val := m.GetMaxLongitude(); val < -180 || val > 180 {
+				return LocationBoundingBoxValidationError{
+					field:  "max_longitude",
+					reason: "value must be inside range [-180, 180]",
+				}
+			}
+
+		default:
+			return LocationBoundingBoxValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// LocationBoundingBoxValidationError is the validation error returned by
+// LocationBoundingBox.ValidateFields if the designated constraints aren't
+// met.
+type LocationBoundingBoxValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocationBoundingBoxValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocationBoundingBoxValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocationBoundingBoxValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocationBoundingBoxValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocationBoundingBoxValidationError) ErrorName() string {
+	return "LocationBoundingBoxValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LocationBoundingBoxValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocationBoundingBox.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocationBoundingBoxValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocationBoundingBoxValidationError{}
+
+// ValidateFields checks the field values on LocationRadius with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *LocationRadius) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = LocationRadiusFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "latitude":
+
+			if val := m.GetLatitude(); val < -90 || val > 90 {
+				return LocationRadiusValidationError{
+					field:  "latitude",
+					reason: "value must be inside range [-90, 90]",
+				}
+			}
+
+		case "longitude":
+
+			if val := m.GetLongitude(); val < -180 || val > 180 {
+				return LocationRadiusValidationError{
+					field:  "longitude",
+					reason: "value must be inside range [-180, 180]",
+				}
+			}
+
+		case "radius":
+
+			if val := m.GetRadius(); val <= 0 || val > 20037509 {
+				return LocationRadiusValidationError{
+					field:  "radius",
+					reason: "value must be inside range (0, 20037509]",
+				}
+			}
+
+		default:
+			return LocationRadiusValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// LocationRadiusValidationError is the validation error returned by
+// LocationRadius.ValidateFields if the designated constraints aren't met.
+type LocationRadiusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocationRadiusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocationRadiusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocationRadiusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocationRadiusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocationRadiusValidationError) ErrorName() string {
+	return "LocationRadiusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LocationRadiusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocationRadius.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocationRadiusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocationRadiusValidationError{}
+
+// ValidateFields checks the field values on SearchGatewaysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SearchGatewaysRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SearchGatewaysRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "id_contains":
+			// no validation rules for IDContains
+		case "name_contains":
+			// no validation rules for NameContains
+		case "description_contains":
+			// no validation rules for DescriptionContains
+		case "attributes_contain":
+
+			for key, val := range m.GetAttributesContain() {
+				_ = val
+
+				if utf8.RuneCountInString(key) > 36 {
+					return SearchGatewaysRequestValidationError{
+						field:  fmt.Sprintf("attributes_contain[%v]", key),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_SearchGatewaysRequest_AttributesContain_Pattern.MatchString(key) {
+					return SearchGatewaysRequestValidationError{
+						field:  fmt.Sprintf("attributes_contain[%v]", key),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+				// no validation rules for AttributesContain[key]
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SearchGatewaysRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "order":
+			// no validation rules for Order
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return SearchGatewaysRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		case "location_bounding_box":
+
+			if v, ok := interface{}(m.GetLocationBoundingBox()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SearchGatewaysRequestValidationError{
+						field:  "location_bounding_box",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "location_radius":
+
+			if v, ok := interface{}(m.GetLocationRadius()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SearchGatewaysRequestValidationError{
+						field:  "location_radius",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "frequency_plan_id":
+
+			if utf8.RuneCountInString(m.GetFrequencyPlanID()) > 64 {
+				return SearchGatewaysRequestValidationError{
+					field:  "frequency_plan_id",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "brand_id":
+
+			if utf8.RuneCountInString(m.GetBrandID()) > 36 {
+				return SearchGatewaysRequestValidationError{
+					field:  "brand_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+		case "model_id":
+
+			if utf8.RuneCountInString(m.GetModelID()) > 36 {
+				return SearchGatewaysRequestValidationError{
+					field:  "model_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+		case "updated_after":
+
+			if v, ok := interface{}(m.GetUpdatedAfter()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SearchGatewaysRequestValidationError{
+						field:  "updated_after",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SearchGatewaysRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SearchGatewaysRequestValidationError is the validation error returned by
+// SearchGatewaysRequest.ValidateFields if the designated constraints aren't met.
+type SearchGatewaysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchGatewaysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchGatewaysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchGatewaysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchGatewaysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchGatewaysRequestValidationError) ErrorName() string {
+	return "SearchGatewaysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchGatewaysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchGatewaysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchGatewaysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchGatewaysRequestValidationError{}
+
+var _SearchGatewaysRequest_AttributesContain_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
 // ValidateFields checks the field values on SearchEndDevicesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -257,6 +682,60 @@ func (m *SearchEndDevicesRequest) ValidateFields(paths ...string) error {
 
 		case "page":
 			// no validation rules for Page
+		case "location_bounding_box":
+
+			if v, ok := interface{}(m.GetLocationBoundingBox()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SearchEndDevicesRequestValidationError{
+						field:  "location_bounding_box",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "location_radius":
+
+			if v, ok := interface{}(m.GetLocationRadius()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SearchEndDevicesRequestValidationError{
+						field:  "location_radius",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "brand_id":
+
+			if utf8.RuneCountInString(m.GetBrandID()) > 36 {
+				return SearchEndDevicesRequestValidationError{
+					field:  "brand_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+		case "model_id":
+
+			if utf8.RuneCountInString(m.GetModelID()) > 36 {
+				return SearchEndDevicesRequestValidationError{
+					field:  "model_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+		case "updated_after":
+
+			if v, ok := interface{}(m.GetUpdatedAfter()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SearchEndDevicesRequestValidationError{
+						field:  "updated_after",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return SearchEndDevicesRequestValidationError{
 				field:  name,
@@ -97,6 +97,18 @@ func TestEnumMarshalers(t *testing.T) {
 	}
 	vals = append(vals, grants)
 
+	var mfaCredentialTypes []fmt.Stringer
+	for i := range MFACredentialType_name {
+		mfaCredentialTypes = append(mfaCredentialTypes, MFACredentialType(i))
+	}
+	vals = append(vals, mfaCredentialTypes)
+
+	var joinRejectReasons []fmt.Stringer
+	for i := range JoinRejectReason_name {
+		joinRejectReasons = append(joinRejectReasons, JoinRejectReason(i))
+	}
+	vals = append(vals, joinRejectReasons)
+
 	var clusterRoles []fmt.Stringer
 	for i := range ClusterRole_name {
 		clusterRoles = append(clusterRoles, ClusterRole(i))
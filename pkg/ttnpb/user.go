@@ -16,6 +16,8 @@ package ttnpb
 
 import (
 	"context"
+	"strconv"
+	"strings"
 )
 
 // ValidateContext wraps the generated validator with (optionally context-based) custom checks.
@@ -27,3 +29,37 @@ func (m *UpdateUserRequest) ValidateContext(context.Context) error {
 		"user.ids",
 	)...)
 }
+
+// MarshalText implements encoding.TextMarshaler interface.
+func (v MFACredentialType) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (v *MFACredentialType) UnmarshalText(b []byte) error {
+	s := string(b)
+	if i, ok := MFACredentialType_value[s]; ok {
+		*v = MFACredentialType(i)
+		return nil
+	}
+	if !strings.HasPrefix(s, "MFA_CREDENTIAL_") {
+		if i, ok := MFACredentialType_value["MFA_CREDENTIAL_"+s]; ok {
+			*v = MFACredentialType(i)
+			return nil
+		}
+	}
+	return errCouldNotParse("MFACredentialType")(string(b))
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (v *MFACredentialType) UnmarshalJSON(b []byte) error {
+	if len(b) > 2 && b[0] == '"' && b[len(b)-1] == '"' {
+		return v.UnmarshalText(b[1 : len(b)-1])
+	}
+	i, err := strconv.Atoi(string(b))
+	if err != nil {
+		return errCouldNotParse("MFACredentialType")(string(b)).WithCause(err)
+	}
+	*v = MFACredentialType(i)
+	return nil
+}
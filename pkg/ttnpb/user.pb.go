@@ -4,11 +4,13 @@
 package ttnpb
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	io "io"
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 	time "time"
 
@@ -34,6 +36,33 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type MFACredentialType int32
+
+const (
+	// Time-based one-time password (RFC 6238) generated by an authenticator app.
+	MFA_CREDENTIAL_TOTP MFACredentialType = 0
+	// Security key or platform authenticator using Web Authentication.
+	MFA_CREDENTIAL_WEBAUTHN MFACredentialType = 1
+	// Single-use recovery code.
+	MFA_CREDENTIAL_RECOVERY_CODE MFACredentialType = 2
+)
+
+var MFACredentialType_name = map[int32]string{
+	0: "MFA_CREDENTIAL_TOTP",
+	1: "MFA_CREDENTIAL_WEBAUTHN",
+	2: "MFA_CREDENTIAL_RECOVERY_CODE",
+}
+
+var MFACredentialType_value = map[string]int32{
+	"MFA_CREDENTIAL_TOTP":          0,
+	"MFA_CREDENTIAL_WEBAUTHN":      1,
+	"MFA_CREDENTIAL_RECOVERY_CODE": 2,
+}
+
+func (MFACredentialType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{0}
+}
+
 // User is the message that defines an user on the network.
 type User struct {
 	UserIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
@@ -316,7 +345,9 @@ type ListUsersRequest struct {
 	// Limit the number of results per page.
 	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Page uint32 `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	// Only return recently deleted users. Only admins can list deleted users.
+	Deleted              bool     `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -381,6 +412,13 @@ func (m *ListUsersRequest) GetPage() uint32 {
 	return 0
 }
 
+func (m *ListUsersRequest) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type CreateUserRequest struct {
 	User                 `protobuf:"bytes,1,opt,name=user,proto3,embedded=user" json:"user"`
 	InvitationToken      string   `protobuf:"bytes,2,opt,name=invitation_token,json=invitationToken,proto3" json:"invitation_token,omitempty"`
@@ -678,9 +716,13 @@ func (m *GetUserAPIKeyRequest) GetKeyID() string {
 }
 
 type CreateUserAPIKeyRequest struct {
-	UserIdentifiers      `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights               []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	Name            string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights          []Right `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Time after which the API key can no longer be used.
+	ExpiresAt *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// IP address ranges (in CIDR notation) from which the API key can be used.
+	AllowedIPRanges      []string `protobuf:"bytes,5,rep,name=allowed_ip_ranges,json=allowedIpRanges,proto3" json:"allowed_ip_ranges,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -731,6 +773,20 @@ func (m *CreateUserAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateUserAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateUserAPIKeyRequest) GetAllowedIPRanges() []string {
+	if m != nil {
+		return m.AllowedIPRanges
+	}
+	return nil
+}
+
 type UpdateUserAPIKeyRequest struct {
 	UserIdentifiers      `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
 	APIKey               `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
@@ -1280,229 +1336,777 @@ func (m *ListUserSessionsRequest) GetPage() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*User)(nil), "ttn.lorawan.v3.User")
-	golang_proto.RegisterType((*User)(nil), "ttn.lorawan.v3.User")
-	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.User.AttributesEntry")
-	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.User.AttributesEntry")
-	proto.RegisterType((*Users)(nil), "ttn.lorawan.v3.Users")
-	golang_proto.RegisterType((*Users)(nil), "ttn.lorawan.v3.Users")
-	proto.RegisterType((*GetUserRequest)(nil), "ttn.lorawan.v3.GetUserRequest")
-	golang_proto.RegisterType((*GetUserRequest)(nil), "ttn.lorawan.v3.GetUserRequest")
-	proto.RegisterType((*ListUsersRequest)(nil), "ttn.lorawan.v3.ListUsersRequest")
-	golang_proto.RegisterType((*ListUsersRequest)(nil), "ttn.lorawan.v3.ListUsersRequest")
-	proto.RegisterType((*CreateUserRequest)(nil), "ttn.lorawan.v3.CreateUserRequest")
-	golang_proto.RegisterType((*CreateUserRequest)(nil), "ttn.lorawan.v3.CreateUserRequest")
-	proto.RegisterType((*UpdateUserRequest)(nil), "ttn.lorawan.v3.UpdateUserRequest")
-	golang_proto.RegisterType((*UpdateUserRequest)(nil), "ttn.lorawan.v3.UpdateUserRequest")
-	proto.RegisterType((*CreateTemporaryPasswordRequest)(nil), "ttn.lorawan.v3.CreateTemporaryPasswordRequest")
-	golang_proto.RegisterType((*CreateTemporaryPasswordRequest)(nil), "ttn.lorawan.v3.CreateTemporaryPasswordRequest")
-	proto.RegisterType((*UpdateUserPasswordRequest)(nil), "ttn.lorawan.v3.UpdateUserPasswordRequest")
-	golang_proto.RegisterType((*UpdateUserPasswordRequest)(nil), "ttn.lorawan.v3.UpdateUserPasswordRequest")
-	proto.RegisterType((*ListUserAPIKeysRequest)(nil), "ttn.lorawan.v3.ListUserAPIKeysRequest")
-	golang_proto.RegisterType((*ListUserAPIKeysRequest)(nil), "ttn.lorawan.v3.ListUserAPIKeysRequest")
-	proto.RegisterType((*GetUserAPIKeyRequest)(nil), "ttn.lorawan.v3.GetUserAPIKeyRequest")
-	golang_proto.RegisterType((*GetUserAPIKeyRequest)(nil), "ttn.lorawan.v3.GetUserAPIKeyRequest")
-	proto.RegisterType((*CreateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.CreateUserAPIKeyRequest")
-	golang_proto.RegisterType((*CreateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.CreateUserAPIKeyRequest")
-	proto.RegisterType((*UpdateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.UpdateUserAPIKeyRequest")
-	golang_proto.RegisterType((*UpdateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.UpdateUserAPIKeyRequest")
-	proto.RegisterType((*Invitation)(nil), "ttn.lorawan.v3.Invitation")
-	golang_proto.RegisterType((*Invitation)(nil), "ttn.lorawan.v3.Invitation")
-	proto.RegisterType((*ListInvitationsRequest)(nil), "ttn.lorawan.v3.ListInvitationsRequest")
-	golang_proto.RegisterType((*ListInvitationsRequest)(nil), "ttn.lorawan.v3.ListInvitationsRequest")
-	proto.RegisterType((*Invitations)(nil), "ttn.lorawan.v3.Invitations")
-	golang_proto.RegisterType((*Invitations)(nil), "ttn.lorawan.v3.Invitations")
-	proto.RegisterType((*SendInvitationRequest)(nil), "ttn.lorawan.v3.SendInvitationRequest")
-	golang_proto.RegisterType((*SendInvitationRequest)(nil), "ttn.lorawan.v3.SendInvitationRequest")
-	proto.RegisterType((*DeleteInvitationRequest)(nil), "ttn.lorawan.v3.DeleteInvitationRequest")
-	golang_proto.RegisterType((*DeleteInvitationRequest)(nil), "ttn.lorawan.v3.DeleteInvitationRequest")
-	proto.RegisterType((*UserSessionIdentifiers)(nil), "ttn.lorawan.v3.UserSessionIdentifiers")
-	golang_proto.RegisterType((*UserSessionIdentifiers)(nil), "ttn.lorawan.v3.UserSessionIdentifiers")
-	proto.RegisterType((*UserSession)(nil), "ttn.lorawan.v3.UserSession")
-	golang_proto.RegisterType((*UserSession)(nil), "ttn.lorawan.v3.UserSession")
-	proto.RegisterType((*UserSessions)(nil), "ttn.lorawan.v3.UserSessions")
-	golang_proto.RegisterType((*UserSessions)(nil), "ttn.lorawan.v3.UserSessions")
-	proto.RegisterType((*ListUserSessionsRequest)(nil), "ttn.lorawan.v3.ListUserSessionsRequest")
-	golang_proto.RegisterType((*ListUserSessionsRequest)(nil), "ttn.lorawan.v3.ListUserSessionsRequest")
+// MFACredential is a second factor that a user uses to log in.
+type MFACredential struct {
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	ID              string            `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Type            MFACredentialType `protobuf:"varint,3,opt,name=type,proto3,enum=ttn.lorawan.v3.MFACredentialType" json:"type,omitempty"`
+	Name            string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt       time.Time         `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	UpdatedAt       time.Time         `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	LastUsedAt      *time.Time        `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3,stdtime" json:"last_used_at,omitempty"`
+	// Whether the enrollment of the credential is finished.
+	Confirmed bool `protobuf:"varint,8,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// The TOTP secret, the WebAuthn public key or the hashed recovery code.
+	// During WebAuthn enrollment, this is the challenge.
+	// This field is never returned by the API.
+	Secret               []byte `protobuf:"bytes,9,opt,name=secret,proto3" json:"secret,omitempty"`
+	WebAuthnCredentialID []byte `protobuf:"bytes,10,opt,name=webauthn_credential_id,json=webauthnCredentialId,proto3" json:"webauthn_credential_id,omitempty"`
+	// The signature counter of the WebAuthn authenticator, or the time step of the last accepted TOTP code.
+	SignCount            uint32   `protobuf:"varint,11,opt,name=sign_count,json=signCount,proto3" json:"sign_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("lorawan-stack/api/user.proto", fileDescriptor_5ce30de589ccb9af) }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/user.proto", fileDescriptor_5ce30de589ccb9af)
+func (m *MFACredential) Reset()      { *m = MFACredential{} }
+func (*MFACredential) ProtoMessage() {}
+func (*MFACredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{21}
+}
+func (m *MFACredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MFACredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MFACredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MFACredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MFACredential.Merge(m, src)
+}
+func (m *MFACredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *MFACredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_MFACredential.DiscardUnknown(m)
 }
 
-var fileDescriptor_5ce30de589ccb9af = []byte{
-	// 1634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xde, 0xe1, 0x9f, 0xc8, 0x47, 0x5b, 0x3f, 0x1b, 0xdb, 0xda, 0xca, 0xd5, 0x90, 0xd8, 0x0a,
-	0x85, 0x2c, 0x98, 0x54, 0x21, 0xa1, 0x6e, 0xea, 0xa6, 0x55, 0xb8, 0xb2, 0x1b, 0x08, 0x72, 0x01,
-	0x61, 0xed, 0xf4, 0xd0, 0x20, 0xdd, 0xae, 0xb8, 0x23, 0x6a, 0x40, 0x72, 0x77, 0xb3, 0x3b, 0x94,
-	0xc2, 0x14, 0x05, 0x82, 0x1e, 0x0a, 0xa3, 0x3d, 0xd4, 0x28, 0x50, 0xa0, 0x48, 0x0f, 0x2d, 0x7a,
-	0x28, 0x82, 0x9e, 0x7c, 0x4c, 0x6f, 0x41, 0x4f, 0x3e, 0x0a, 0xe8, 0x25, 0x40, 0x01, 0x35, 0x22,
-	0x2f, 0x46, 0x4f, 0x39, 0x06, 0x3a, 0x15, 0xb3, 0xb3, 0xcb, 0x5d, 0x91, 0xac, 0x2b, 0xd9, 0x22,
-	0x90, 0x13, 0xe7, 0xe7, 0x7b, 0x3f, 0xf3, 0xe6, 0x7d, 0xef, 0xcd, 0x12, 0xbe, 0xde, 0x72, 0x3c,
-	0xf3, 0xd0, 0xb4, 0x2b, 0x3e, 0x33, 0xeb, 0xcd, 0x55, 0xd3, 0xa5, 0xab, 0x1d, 0x9f, 0x78, 0x55,
-	0xd7, 0x73, 0x98, 0x23, 0x4f, 0x33, 0x66, 0x57, 0x43, 0x44, 0xf5, 0x60, 0x7d, 0xa1, 0xd6, 0xa0,
-	0x6c, 0xbf, 0xb3, 0x5b, 0xad, 0x3b, 0xed, 0x55, 0x62, 0x1f, 0x38, 0x5d, 0xd7, 0x73, 0xde, 0xef,
-	0xae, 0x06, 0xe0, 0x7a, 0xa5, 0x41, 0xec, 0xca, 0x81, 0xd9, 0xa2, 0x96, 0xc9, 0xc8, 0xea, 0xc8,
-	0x40, 0xa8, 0x5c, 0xa8, 0x24, 0x54, 0x34, 0x9c, 0x86, 0x23, 0x84, 0x77, 0x3b, 0x7b, 0xc1, 0x2c,
-	0x98, 0x04, 0xa3, 0x10, 0x5e, 0x6e, 0x38, 0x4e, 0xa3, 0x45, 0x62, 0xd4, 0x1e, 0x25, 0x2d, 0xcb,
-	0x68, 0x9b, 0x7e, 0x33, 0x44, 0x94, 0x86, 0x11, 0x8c, 0xb6, 0x89, 0xcf, 0xcc, 0xb6, 0x1b, 0x02,
-	0x96, 0x46, 0x8f, 0x58, 0x77, 0x6c, 0x66, 0xd6, 0x99, 0x41, 0xed, 0xbd, 0xc8, 0xd0, 0xe2, 0x28,
-	0x8a, 0xd8, 0x9d, 0xb6, 0x1f, 0x6e, 0x7f, 0x63, 0x74, 0x9b, 0x5a, 0xc4, 0x66, 0x74, 0x8f, 0x12,
-	0x2f, 0x02, 0x95, 0x46, 0x41, 0x2e, 0xad, 0xb3, 0x8e, 0x17, 0x1d, 0x1e, 0x8f, 0x02, 0x3c, 0xda,
-	0xd8, 0x67, 0xa1, 0x02, 0xf5, 0x3f, 0x05, 0xc8, 0xbc, 0xed, 0x13, 0x4f, 0xde, 0x84, 0x34, 0xb5,
-	0x7c, 0x05, 0x95, 0xd1, 0x72, 0x71, 0xad, 0x54, 0x3d, 0x7b, 0x0d, 0x55, 0x0e, 0xd9, 0x8a, 0xad,
-	0x6b, 0xb3, 0xa7, 0x5a, 0xf6, 0xd7, 0x28, 0x35, 0x8b, 0x9e, 0x1d, 0x97, 0xa4, 0xa3, 0xe3, 0x12,
-	0xd2, 0xb9, 0xb4, 0xbc, 0x09, 0x50, 0xf7, 0x88, 0xc9, 0x88, 0x65, 0x98, 0x4c, 0x49, 0x05, 0xba,
-	0x16, 0xaa, 0x22, 0x5c, 0xd5, 0x28, 0x5c, 0xd5, 0x47, 0x51, 0xb8, 0xb4, 0x3c, 0x17, 0x7f, 0xf2,
-	0xef, 0x12, 0xd2, 0x0b, 0xa1, 0x5c, 0x8d, 0x71, 0x25, 0x1d, 0xd7, 0x8a, 0x94, 0xa4, 0x2f, 0xa2,
-	0x24, 0x94, 0xab, 0x31, 0xf9, 0x26, 0x64, 0x6c, 0xb3, 0x4d, 0x94, 0x4c, 0x19, 0x2d, 0x17, 0xb4,
-	0xa9, 0x53, 0x2d, 0xe3, 0xa5, 0x94, 0x35, 0x3d, 0x58, 0x94, 0x57, 0xa0, 0x68, 0x11, 0xbf, 0xee,
-	0x51, 0x97, 0x51, 0xc7, 0x56, 0xb2, 0x01, 0x26, 0x7f, 0xaa, 0x65, 0xbd, 0xb4, 0x72, 0x34, 0xa3,
-	0x27, 0x37, 0x65, 0x0f, 0xc0, 0x64, 0xcc, 0xa3, 0xbb, 0x1d, 0x46, 0x7c, 0x25, 0x57, 0x4e, 0x2f,
-	0x17, 0xd7, 0x96, 0xc6, 0x85, 0xa7, 0x5a, 0x1b, 0xc0, 0xee, 0xdb, 0xcc, 0xeb, 0x6a, 0xb7, 0x4f,
-	0xb5, 0x5b, 0x1f, 0xa1, 0x6f, 0xaa, 0x4b, 0x9e, 0xaa, 0x2c, 0xad, 0xe1, 0x9f, 0xbe, 0x63, 0x56,
-	0x3e, 0xf8, 0x56, 0xe5, 0xbb, 0xef, 0x2e, 0x6f, 0xdc, 0x7d, 0xa7, 0xf2, 0xee, 0x46, 0x34, 0xbd,
-	0xf5, 0xf3, 0xb5, 0xdb, 0xbf, 0x58, 0xd2, 0x13, 0x56, 0xe4, 0x1f, 0xc0, 0x95, 0x64, 0xbe, 0x28,
-	0x53, 0x81, 0xd5, 0x9b, 0xc3, 0x56, 0x37, 0x05, 0x66, 0xcb, 0xde, 0x73, 0xf4, 0x62, 0x3d, 0x9e,
-	0xc8, 0xdf, 0x83, 0xeb, 0xae, 0x47, 0xdb, 0xa6, 0xd7, 0x35, 0x48, 0xdb, 0xa4, 0x2d, 0xc3, 0xb4,
-	0x2c, 0x8f, 0xf8, 0xbe, 0x92, 0x4f, 0x44, 0xe3, 0x67, 0x48, 0x7f, 0x2d, 0x44, 0xdd, 0xe7, 0xa0,
-	0x9a, 0xc0, 0xc8, 0x2d, 0x50, 0xc7, 0x0a, 0x1b, 0x11, 0xad, 0x82, 0x6b, 0x29, 0xfc, 0xdf, 0x6b,
-	0xc9, 0x04, 0x57, 0x82, 0xc7, 0x98, 0xf8, 0x71, 0xa4, 0xa8, 0xc6, 0xe4, 0x05, 0xc8, 0xbb, 0xa6,
-	0xef, 0x1f, 0x3a, 0x9e, 0xa5, 0x00, 0xf7, 0x4e, 0x1f, 0xcc, 0xe5, 0x1d, 0x78, 0x2d, 0x1a, 0x1b,
-	0x89, 0x8c, 0x28, 0x9e, 0xd3, 0xf4, 0x5c, 0x24, 0xfc, 0xf6, 0x20, 0x2b, 0xee, 0xc0, 0xbc, 0x47,
-	0xde, 0xeb, 0x50, 0x8f, 0x18, 0x43, 0x9a, 0x95, 0x2b, 0x65, 0xb4, 0x9c, 0xd7, 0xaf, 0x87, 0xdb,
-	0x3b, 0x67, 0x44, 0xe5, 0x6f, 0x43, 0xd6, 0x67, 0x1c, 0x75, 0xb5, 0x8c, 0x96, 0xa7, 0xd7, 0xae,
-	0x0f, 0xdf, 0xc4, 0x43, 0xbe, 0x19, 0x64, 0xd0, 0x2f, 0x39, 0x29, 0x74, 0x81, 0x96, 0xaf, 0x41,
-	0xd6, 0xb4, 0xda, 0xd4, 0x56, 0xa6, 0x03, 0xe5, 0x62, 0x22, 0x57, 0x40, 0x66, 0xa4, 0xed, 0x3a,
-	0x1e, 0x0f, 0xf1, 0xe0, 0xf0, 0x33, 0xc1, 0xe1, 0xe7, 0x06, 0x3b, 0x91, 0x07, 0x72, 0x1d, 0x16,
-	0x47, 0xe1, 0x46, 0x82, 0x66, 0xb3, 0xe7, 0x8c, 0xc7, 0xc2, 0x88, 0xee, 0xcd, 0x01, 0xe7, 0xc6,
-	0x1b, 0x21, 0xef, 0xbb, 0xd4, 0x23, 0x3e, 0x37, 0x32, 0xf7, 0xd2, 0x46, 0xee, 0x0b, 0x25, 0x35,
-	0x26, 0xbf, 0x09, 0x33, 0xae, 0xe7, 0xec, 0xd1, 0x16, 0x31, 0xc2, 0x22, 0xa5, 0xc8, 0x81, 0xda,
-	0xf9, 0xe1, 0x78, 0xee, 0x88, 0x6d, 0x7d, 0x3a, 0xc4, 0x87, 0xf3, 0x85, 0xef, 0xc3, 0xcc, 0x10,
-	0xcb, 0xe4, 0x59, 0x48, 0x37, 0x49, 0x37, 0xa8, 0x5b, 0x05, 0x9d, 0x0f, 0x79, 0xd4, 0x0f, 0xcc,
-	0x56, 0x87, 0x04, 0xf5, 0xa7, 0xa0, 0x8b, 0xc9, 0xdd, 0xd4, 0xeb, 0x48, 0x5d, 0x87, 0x2c, 0x67,
-	0xaa, 0x2f, 0xaf, 0x40, 0x96, 0xf7, 0x1c, 0x5e, 0xee, 0x38, 0xb3, 0xae, 0x8d, 0xe3, 0xb3, 0x2e,
-	0x20, 0xea, 0x9f, 0x10, 0x4c, 0xbf, 0x45, 0x58, 0xb0, 0x44, 0xde, 0xeb, 0x10, 0x9f, 0xc9, 0x0f,
-	0x20, 0xcf, 0xf7, 0x8c, 0x57, 0x2a, 0x98, 0x53, 0x9d, 0x00, 0xe2, 0xcb, 0x1b, 0x00, 0x71, 0x8b,
-	0xf9, 0x9f, 0x45, 0xf3, 0x87, 0x1c, 0xf2, 0x23, 0xd3, 0x6f, 0x6a, 0x19, 0xae, 0x42, 0x2f, 0xec,
-	0x45, 0x0b, 0xea, 0xd3, 0x14, 0xcc, 0x3e, 0xa0, 0x7e, 0xe0, 0xa2, 0x1f, 0xf9, 0x78, 0x56, 0x2b,
-	0xba, 0xb0, 0x56, 0xf9, 0xaf, 0x08, 0xb2, 0x8e, 0x67, 0x11, 0x4f, 0xc4, 0x51, 0xfb, 0x2d, 0x3a,
-	0xd5, 0x7e, 0x83, 0xbc, 0xc7, 0x48, 0x97, 0x84, 0xef, 0x06, 0xb5, 0xf4, 0x7c, 0x25, 0x1a, 0x05,
-	0x95, 0x55, 0xcf, 0x56, 0x82, 0x9f, 0xf1, 0xe5, 0x47, 0xbf, 0x51, 0x19, 0xbf, 0x2e, 0xe8, 0xa2,
-	0xe7, 0x2a, 0xe2, 0x57, 0xf0, 0x44, 0xcf, 0x55, 0xc4, 0x6f, 0xa2, 0xa5, 0xe8, 0xc5, 0x4a, 0x62,
-	0x22, 0xdc, 0x93, 0x31, 0x64, 0x5b, 0xb4, 0x4d, 0x45, 0xab, 0xb8, 0x1a, 0xb0, 0x70, 0x25, 0xad,
-	0x3c, 0x9f, 0xd2, 0xc5, 0xb2, 0x2c, 0x43, 0xc6, 0x35, 0x1b, 0xa2, 0x15, 0x5c, 0xd5, 0x83, 0xb1,
-	0xfa, 0x01, 0xcc, 0x89, 0xe4, 0x4f, 0x5e, 0xeb, 0x5d, 0xc8, 0xf0, 0xd3, 0x84, 0xc1, 0x1a, 0x9b,
-	0x14, 0x63, 0xee, 0x31, 0x90, 0x91, 0x6f, 0xc1, 0x2c, 0xb5, 0x0f, 0x28, 0x33, 0x79, 0xd3, 0x30,
-	0x98, 0xd3, 0x24, 0x76, 0x98, 0x7f, 0x33, 0xf1, 0xfa, 0x23, 0xbe, 0xac, 0x3e, 0x41, 0x30, 0x27,
-	0xea, 0xca, 0x65, 0x19, 0x7f, 0xe5, 0x0c, 0xb2, 0x01, 0x8b, 0x70, 0x3c, 0x1a, 0x66, 0xef, 0x44,
-	0x52, 0x5e, 0xfd, 0x3b, 0x82, 0xaf, 0xc5, 0x21, 0x98, 0xa8, 0x2d, 0x5e, 0x20, 0x6c, 0x72, 0x18,
-	0x5e, 0x06, 0x1f, 0xf2, 0x15, 0xa7, 0x65, 0x05, 0xe9, 0x52, 0xd0, 0xf9, 0x50, 0x5e, 0x81, 0x39,
-	0x8f, 0x1c, 0x38, 0x4d, 0x62, 0x98, 0xad, 0x96, 0x61, 0xd6, 0xeb, 0xbc, 0x59, 0x66, 0x82, 0xa2,
-	0x3d, 0x23, 0x36, 0x6a, 0xad, 0x56, 0x2d, 0x58, 0x56, 0x3f, 0x42, 0x70, 0x23, 0x62, 0x5b, 0x6d,
-	0x67, 0x6b, 0x9b, 0x74, 0xfd, 0xc9, 0x38, 0x3e, 0xc8, 0xeb, 0xd4, 0x8b, 0xf3, 0x3a, 0x9d, 0xc8,
-	0xeb, 0x5f, 0x21, 0xb8, 0x16, 0x16, 0x2b, 0xe1, 0xdb, 0x64, 0x5c, 0x2b, 0x43, 0xae, 0x49, 0xba,
-	0x06, 0xb5, 0xc2, 0xda, 0x50, 0xe8, 0x1d, 0x97, 0xb2, 0xdb, 0xa4, 0xbb, 0x75, 0x4f, 0xcf, 0x36,
-	0x49, 0x77, 0xcb, 0x52, 0xff, 0x81, 0x60, 0x3e, 0x66, 0xd8, 0x24, 0x7d, 0x89, 0x5e, 0x7a, 0xa9,
-	0x71, 0x2f, 0xbd, 0x37, 0x20, 0x27, 0x9e, 0xbb, 0x4a, 0xba, 0x9c, 0x1e, 0xd7, 0xb9, 0x75, 0xbe,
-	0xab, 0x5d, 0x3d, 0xd5, 0xe0, 0x77, 0x68, 0x4a, 0x0d, 0xdb, 0x77, 0x28, 0xa3, 0xfe, 0x0d, 0xc1,
-	0x7c, 0x9c, 0xa6, 0x93, 0x3c, 0x44, 0x0d, 0xa6, 0x4c, 0x97, 0x1a, 0xbc, 0x93, 0x09, 0xfa, 0xde,
-	0x18, 0x56, 0x26, 0xac, 0x8f, 0xd1, 0x91, 0x33, 0x5d, 0xba, 0x4d, 0xba, 0xea, 0xef, 0xd3, 0x00,
-	0x5b, 0x83, 0x52, 0x23, 0x2f, 0x42, 0x36, 0x28, 0xb2, 0xa2, 0x33, 0xc6, 0x6f, 0x3e, 0xb1, 0xca,
-	0x9b, 0x64, 0xb2, 0x48, 0x89, 0x09, 0x7f, 0x7a, 0x27, 0x7a, 0xfe, 0x85, 0x9e, 0xde, 0x64, 0xd0,
-	0xe6, 0xcf, 0x7e, 0x04, 0x64, 0x2e, 0xe3, 0x23, 0x20, 0xfb, 0x72, 0x1f, 0x01, 0x35, 0x28, 0x72,
-	0x2e, 0xbb, 0xa1, 0x96, 0xdc, 0x39, 0xdf, 0x30, 0x10, 0x09, 0x05, 0x6f, 0x96, 0x58, 0xc5, 0x6e,
-	0x57, 0x99, 0x3a, 0xd7, 0x4d, 0xc7, 0x1a, 0xb4, 0xae, 0xfa, 0x40, 0x94, 0x8b, 0xf8, 0x6a, 0x06,
-	0xe5, 0x62, 0x40, 0x70, 0xf4, 0x62, 0x82, 0xa7, 0x12, 0x04, 0xdf, 0x86, 0x62, 0x42, 0x93, 0xfc,
-	0x06, 0x14, 0xe3, 0xf6, 0x12, 0x3d, 0x67, 0x16, 0x86, 0xdd, 0x8b, 0x25, 0xf4, 0x24, 0x5c, 0xbd,
-	0x03, 0xd7, 0x1f, 0x12, 0xdb, 0x4a, 0x6c, 0x87, 0x9e, 0xbd, 0x38, 0x79, 0xd4, 0xd7, 0x61, 0xfe,
-	0x1e, 0x69, 0x11, 0x46, 0x2e, 0x2c, 0xf9, 0x47, 0x04, 0x37, 0x78, 0xb0, 0x1e, 0x12, 0xdf, 0xa7,
-	0x8e, 0x9d, 0x88, 0xd9, 0x25, 0x13, 0x6a, 0x1d, 0xc0, 0x17, 0x36, 0xe2, 0x2a, 0x75, 0x4d, 0xd4,
-	0x86, 0x37, 0x7b, 0xc7, 0xa5, 0x42, 0xe4, 0xc0, 0x3d, 0xbd, 0xe0, 0x47, 0xbe, 0xa8, 0xff, 0x4a,
-	0x41, 0x31, 0xe1, 0xdd, 0x57, 0xc0, 0xa5, 0x21, 0x32, 0xa5, 0x2f, 0x83, 0x4c, 0x99, 0x97, 0x23,
-	0xd3, 0xc6, 0x99, 0xda, 0x90, 0x3d, 0x27, 0x97, 0xe2, 0xba, 0xa0, 0xbe, 0x05, 0x57, 0x12, 0xc1,
-	0xf5, 0xe5, 0xef, 0x40, 0x3e, 0x3c, 0x67, 0x94, 0xb8, 0x37, 0xc7, 0x45, 0x37, 0xc4, 0xeb, 0x03,
-	0xb0, 0xfa, 0x4f, 0x04, 0xf3, 0x51, 0x07, 0x8e, 0xb4, 0x4d, 0xa6, 0x2c, 0xdf, 0x39, 0xfb, 0x04,
-	0x2e, 0x9f, 0x6a, 0x8b, 0xde, 0x4d, 0x5d, 0x9a, 0xc4, 0x93, 0x54, 0xfb, 0x0b, 0x7a, 0x76, 0x82,
-	0xd1, 0xd1, 0x09, 0x46, 0x9f, 0x9d, 0x60, 0xe9, 0xf3, 0x13, 0x2c, 0x3d, 0x3f, 0xc1, 0xd2, 0x17,
-	0x27, 0x58, 0xfa, 0xf2, 0x04, 0xa3, 0x0f, 0x7b, 0x18, 0x3d, 0xee, 0x61, 0xe9, 0xe3, 0x1e, 0x46,
-	0x4f, 0x7b, 0x58, 0xfa, 0xa4, 0x87, 0xa5, 0x4f, 0x7b, 0x58, 0x7a, 0xd6, 0xc3, 0xe8, 0xa8, 0x87,
-	0xd1, 0x67, 0x3d, 0x2c, 0x7d, 0xde, 0xc3, 0xe8, 0x79, 0x0f, 0x4b, 0x5f, 0xf4, 0x30, 0xfa, 0xb2,
-	0x87, 0xa5, 0x0f, 0xfb, 0x58, 0x7a, 0xdc, 0xc7, 0xe8, 0x49, 0x1f, 0x4b, 0x7f, 0xe8, 0x63, 0xf4,
-	0xe7, 0x3e, 0x96, 0x3e, 0xee, 0x63, 0xe9, 0x69, 0x1f, 0xa3, 0x4f, 0xfa, 0x18, 0x7d, 0xda, 0xc7,
-	0xe8, 0x27, 0xb7, 0x1b, 0x4e, 0x95, 0xed, 0x13, 0xb6, 0x4f, 0xed, 0x86, 0x5f, 0xb5, 0x09, 0x3b,
-	0x74, 0xbc, 0xe6, 0xea, 0xd9, 0x7f, 0x8d, 0xdc, 0x66, 0x63, 0x95, 0x31, 0xdb, 0xdd, 0xdd, 0xcd,
-	0x05, 0x17, 0xbd, 0xfe, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x10, 0x7f, 0x2b, 0x49, 0xc5, 0x13,
-	0x00, 0x00,
+var xxx_messageInfo_MFACredential proto.InternalMessageInfo
+
+func (m *MFACredential) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
 }
 
-func (this *User) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *MFACredential) GetType() MFACredentialType {
+	if m != nil {
+		return m.Type
 	}
+	return MFA_CREDENTIAL_TOTP
+}
 
-	that1, ok := that.(*User)
-	if !ok {
-		that2, ok := that.(User)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *MFACredential) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *MFACredential) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
-		return false
+	return time.Time{}
+}
+
+func (m *MFACredential) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	if !this.CreatedAt.Equal(that1.CreatedAt) {
-		return false
+	return time.Time{}
+}
+
+func (m *MFACredential) GetLastUsedAt() *time.Time {
+	if m != nil {
+		return m.LastUsedAt
 	}
-	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
-		return false
+	return nil
+}
+
+func (m *MFACredential) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
 	}
-	if this.Name != that1.Name {
-		return false
+	return false
+}
+
+func (m *MFACredential) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
 	}
-	if this.Description != that1.Description {
-		return false
+	return nil
+}
+
+func (m *MFACredential) GetWebAuthnCredentialID() []byte {
+	if m != nil {
+		return m.WebAuthnCredentialID
 	}
-	if len(this.Attributes) != len(that1.Attributes) {
-		return false
+	return nil
+}
+
+func (m *MFACredential) GetSignCount() uint32 {
+	if m != nil {
+		return m.SignCount
 	}
-	for i := range this.Attributes {
-		if this.Attributes[i] != that1.Attributes[i] {
-			return false
+	return 0
+}
+
+type MFACredentials struct {
+	Credentials          []*MFACredential `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MFACredentials) Reset()      { *m = MFACredentials{} }
+func (*MFACredentials) ProtoMessage() {}
+func (*MFACredentials) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{22}
+}
+func (m *MFACredentials) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MFACredentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MFACredentials.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.ContactInfo) != len(that1.ContactInfo) {
-		return false
+}
+func (m *MFACredentials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MFACredentials.Merge(m, src)
+}
+func (m *MFACredentials) XXX_Size() int {
+	return m.Size()
+}
+func (m *MFACredentials) XXX_DiscardUnknown() {
+	xxx_messageInfo_MFACredentials.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MFACredentials proto.InternalMessageInfo
+
+func (m *MFACredentials) GetCredentials() []*MFACredential {
+	if m != nil {
+		return m.Credentials
 	}
-	for i := range this.ContactInfo {
-		if !this.ContactInfo[i].Equal(that1.ContactInfo[i]) {
-			return false
+	return nil
+}
+
+type MFACredentialIdentifiers struct {
+	UserIdentifiers      `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	ID                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MFACredentialIdentifiers) Reset()      { *m = MFACredentialIdentifiers{} }
+func (*MFACredentialIdentifiers) ProtoMessage() {}
+func (*MFACredentialIdentifiers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{23}
+}
+func (m *MFACredentialIdentifiers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MFACredentialIdentifiers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MFACredentialIdentifiers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.PrimaryEmailAddress != that1.PrimaryEmailAddress {
-		return false
+}
+func (m *MFACredentialIdentifiers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MFACredentialIdentifiers.Merge(m, src)
+}
+func (m *MFACredentialIdentifiers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MFACredentialIdentifiers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MFACredentialIdentifiers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MFACredentialIdentifiers proto.InternalMessageInfo
+
+func (m *MFACredentialIdentifiers) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	if that1.PrimaryEmailAddressValidatedAt == nil {
-		if this.PrimaryEmailAddressValidatedAt != nil {
-			return false
+	return ""
+}
+
+type BeginMFAEnrollmentRequest struct {
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	// The type of credential to enroll. Recovery codes are created with CreateRecoveryCodes.
+	Type                 MFACredentialType `protobuf:"varint,2,opt,name=type,proto3,enum=ttn.lorawan.v3.MFACredentialType" json:"type,omitempty"`
+	Name                 string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BeginMFAEnrollmentRequest) Reset()      { *m = BeginMFAEnrollmentRequest{} }
+func (*BeginMFAEnrollmentRequest) ProtoMessage() {}
+func (*BeginMFAEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{24}
+}
+func (m *BeginMFAEnrollmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeginMFAEnrollmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeginMFAEnrollmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.PrimaryEmailAddressValidatedAt.Equal(*that1.PrimaryEmailAddressValidatedAt) {
-		return false
+		return b[:n], nil
 	}
-	if this.Password != that1.Password {
-		return false
+}
+func (m *BeginMFAEnrollmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeginMFAEnrollmentRequest.Merge(m, src)
+}
+func (m *BeginMFAEnrollmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeginMFAEnrollmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeginMFAEnrollmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeginMFAEnrollmentRequest proto.InternalMessageInfo
+
+func (m *BeginMFAEnrollmentRequest) GetType() MFACredentialType {
+	if m != nil {
+		return m.Type
 	}
-	if that1.PasswordUpdatedAt == nil {
+	return MFA_CREDENTIAL_TOTP
+}
+
+func (m *BeginMFAEnrollmentRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type MFAEnrollment struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The base32 encoded TOTP secret, to be entered in an authenticator app.
+	TOTPSecret string `protobuf:"bytes,2,opt,name=totp_secret,json=totpSecret,proto3" json:"totp_secret,omitempty"`
+	// The otpauth:// URI of the TOTP secret, typically shown as QR code.
+	TOTPURI string `protobuf:"bytes,3,opt,name=totp_uri,json=totpUri,proto3" json:"totp_uri,omitempty"`
+	// The challenge to pass to navigator.credentials.create().
+	WebAuthnChallenge    []byte   `protobuf:"bytes,4,opt,name=webauthn_challenge,json=webauthnChallenge,proto3" json:"webauthn_challenge,omitempty"`
+	WebAuthnRPID         string   `protobuf:"bytes,5,opt,name=webauthn_rp_id,json=webauthnRpId,proto3" json:"webauthn_rp_id,omitempty"`
+	WebAuthnRPName       string   `protobuf:"bytes,6,opt,name=webauthn_rp_name,json=webauthnRpName,proto3" json:"webauthn_rp_name,omitempty"`
+	WebAuthnUserHandle   []byte   `protobuf:"bytes,7,opt,name=webauthn_user_handle,json=webauthnUserHandle,proto3" json:"webauthn_user_handle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MFAEnrollment) Reset()      { *m = MFAEnrollment{} }
+func (*MFAEnrollment) ProtoMessage() {}
+func (*MFAEnrollment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{25}
+}
+func (m *MFAEnrollment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MFAEnrollment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MFAEnrollment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MFAEnrollment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MFAEnrollment.Merge(m, src)
+}
+func (m *MFAEnrollment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MFAEnrollment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MFAEnrollment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MFAEnrollment proto.InternalMessageInfo
+
+func (m *MFAEnrollment) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *MFAEnrollment) GetTOTPSecret() string {
+	if m != nil {
+		return m.TOTPSecret
+	}
+	return ""
+}
+
+func (m *MFAEnrollment) GetTOTPURI() string {
+	if m != nil {
+		return m.TOTPURI
+	}
+	return ""
+}
+
+func (m *MFAEnrollment) GetWebAuthnChallenge() []byte {
+	if m != nil {
+		return m.WebAuthnChallenge
+	}
+	return nil
+}
+
+func (m *MFAEnrollment) GetWebAuthnRPID() string {
+	if m != nil {
+		return m.WebAuthnRPID
+	}
+	return ""
+}
+
+func (m *MFAEnrollment) GetWebAuthnRPName() string {
+	if m != nil {
+		return m.WebAuthnRPName
+	}
+	return ""
+}
+
+func (m *MFAEnrollment) GetWebAuthnUserHandle() []byte {
+	if m != nil {
+		return m.WebAuthnUserHandle
+	}
+	return nil
+}
+
+type FinishMFAEnrollmentRequest struct {
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	ID              string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The current code of the authenticator app, for TOTP enrollments.
+	TOTPCode string `protobuf:"bytes,3,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	// The attestation object returned by navigator.credentials.create(), for WebAuthn enrollments.
+	WebAuthnAttestationObject []byte `protobuf:"bytes,4,opt,name=webauthn_attestation_object,json=webauthnAttestationObject,proto3" json:"webauthn_attestation_object,omitempty"`
+	// The client data JSON returned by navigator.credentials.create(), for WebAuthn enrollments.
+	WebAuthnClientDataJSON []byte   `protobuf:"bytes,5,opt,name=webauthn_client_data_json,json=webauthnClientDataJson,proto3" json:"webauthn_client_data_json,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *FinishMFAEnrollmentRequest) Reset()      { *m = FinishMFAEnrollmentRequest{} }
+func (*FinishMFAEnrollmentRequest) ProtoMessage() {}
+func (*FinishMFAEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{26}
+}
+func (m *FinishMFAEnrollmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinishMFAEnrollmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinishMFAEnrollmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinishMFAEnrollmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinishMFAEnrollmentRequest.Merge(m, src)
+}
+func (m *FinishMFAEnrollmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinishMFAEnrollmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinishMFAEnrollmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinishMFAEnrollmentRequest proto.InternalMessageInfo
+
+func (m *FinishMFAEnrollmentRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *FinishMFAEnrollmentRequest) GetTOTPCode() string {
+	if m != nil {
+		return m.TOTPCode
+	}
+	return ""
+}
+
+func (m *FinishMFAEnrollmentRequest) GetWebAuthnAttestationObject() []byte {
+	if m != nil {
+		return m.WebAuthnAttestationObject
+	}
+	return nil
+}
+
+func (m *FinishMFAEnrollmentRequest) GetWebAuthnClientDataJSON() []byte {
+	if m != nil {
+		return m.WebAuthnClientDataJSON
+	}
+	return nil
+}
+
+type RecoveryCodes struct {
+	Codes                []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecoveryCodes) Reset()      { *m = RecoveryCodes{} }
+func (*RecoveryCodes) ProtoMessage() {}
+func (*RecoveryCodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ce30de589ccb9af, []int{27}
+}
+func (m *RecoveryCodes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveryCodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveryCodes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveryCodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryCodes.Merge(m, src)
+}
+func (m *RecoveryCodes) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveryCodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryCodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryCodes proto.InternalMessageInfo
+
+func (m *RecoveryCodes) GetCodes() []string {
+	if m != nil {
+		return m.Codes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.MFACredentialType", MFACredentialType_name, MFACredentialType_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.MFACredentialType", MFACredentialType_name, MFACredentialType_value)
+	proto.RegisterType((*User)(nil), "ttn.lorawan.v3.User")
+	golang_proto.RegisterType((*User)(nil), "ttn.lorawan.v3.User")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.User.AttributesEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.User.AttributesEntry")
+	proto.RegisterType((*Users)(nil), "ttn.lorawan.v3.Users")
+	golang_proto.RegisterType((*Users)(nil), "ttn.lorawan.v3.Users")
+	proto.RegisterType((*GetUserRequest)(nil), "ttn.lorawan.v3.GetUserRequest")
+	golang_proto.RegisterType((*GetUserRequest)(nil), "ttn.lorawan.v3.GetUserRequest")
+	proto.RegisterType((*ListUsersRequest)(nil), "ttn.lorawan.v3.ListUsersRequest")
+	golang_proto.RegisterType((*ListUsersRequest)(nil), "ttn.lorawan.v3.ListUsersRequest")
+	proto.RegisterType((*CreateUserRequest)(nil), "ttn.lorawan.v3.CreateUserRequest")
+	golang_proto.RegisterType((*CreateUserRequest)(nil), "ttn.lorawan.v3.CreateUserRequest")
+	proto.RegisterType((*UpdateUserRequest)(nil), "ttn.lorawan.v3.UpdateUserRequest")
+	golang_proto.RegisterType((*UpdateUserRequest)(nil), "ttn.lorawan.v3.UpdateUserRequest")
+	proto.RegisterType((*CreateTemporaryPasswordRequest)(nil), "ttn.lorawan.v3.CreateTemporaryPasswordRequest")
+	golang_proto.RegisterType((*CreateTemporaryPasswordRequest)(nil), "ttn.lorawan.v3.CreateTemporaryPasswordRequest")
+	proto.RegisterType((*UpdateUserPasswordRequest)(nil), "ttn.lorawan.v3.UpdateUserPasswordRequest")
+	golang_proto.RegisterType((*UpdateUserPasswordRequest)(nil), "ttn.lorawan.v3.UpdateUserPasswordRequest")
+	proto.RegisterType((*ListUserAPIKeysRequest)(nil), "ttn.lorawan.v3.ListUserAPIKeysRequest")
+	golang_proto.RegisterType((*ListUserAPIKeysRequest)(nil), "ttn.lorawan.v3.ListUserAPIKeysRequest")
+	proto.RegisterType((*GetUserAPIKeyRequest)(nil), "ttn.lorawan.v3.GetUserAPIKeyRequest")
+	golang_proto.RegisterType((*GetUserAPIKeyRequest)(nil), "ttn.lorawan.v3.GetUserAPIKeyRequest")
+	proto.RegisterType((*CreateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.CreateUserAPIKeyRequest")
+	golang_proto.RegisterType((*CreateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.CreateUserAPIKeyRequest")
+	proto.RegisterType((*UpdateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.UpdateUserAPIKeyRequest")
+	golang_proto.RegisterType((*UpdateUserAPIKeyRequest)(nil), "ttn.lorawan.v3.UpdateUserAPIKeyRequest")
+	proto.RegisterType((*Invitation)(nil), "ttn.lorawan.v3.Invitation")
+	golang_proto.RegisterType((*Invitation)(nil), "ttn.lorawan.v3.Invitation")
+	proto.RegisterType((*ListInvitationsRequest)(nil), "ttn.lorawan.v3.ListInvitationsRequest")
+	golang_proto.RegisterType((*ListInvitationsRequest)(nil), "ttn.lorawan.v3.ListInvitationsRequest")
+	proto.RegisterType((*Invitations)(nil), "ttn.lorawan.v3.Invitations")
+	golang_proto.RegisterType((*Invitations)(nil), "ttn.lorawan.v3.Invitations")
+	proto.RegisterType((*SendInvitationRequest)(nil), "ttn.lorawan.v3.SendInvitationRequest")
+	golang_proto.RegisterType((*SendInvitationRequest)(nil), "ttn.lorawan.v3.SendInvitationRequest")
+	proto.RegisterType((*DeleteInvitationRequest)(nil), "ttn.lorawan.v3.DeleteInvitationRequest")
+	golang_proto.RegisterType((*DeleteInvitationRequest)(nil), "ttn.lorawan.v3.DeleteInvitationRequest")
+	proto.RegisterType((*UserSessionIdentifiers)(nil), "ttn.lorawan.v3.UserSessionIdentifiers")
+	golang_proto.RegisterType((*UserSessionIdentifiers)(nil), "ttn.lorawan.v3.UserSessionIdentifiers")
+	proto.RegisterType((*UserSession)(nil), "ttn.lorawan.v3.UserSession")
+	golang_proto.RegisterType((*UserSession)(nil), "ttn.lorawan.v3.UserSession")
+	proto.RegisterType((*UserSessions)(nil), "ttn.lorawan.v3.UserSessions")
+	golang_proto.RegisterType((*UserSessions)(nil), "ttn.lorawan.v3.UserSessions")
+	proto.RegisterType((*ListUserSessionsRequest)(nil), "ttn.lorawan.v3.ListUserSessionsRequest")
+	golang_proto.RegisterType((*ListUserSessionsRequest)(nil), "ttn.lorawan.v3.ListUserSessionsRequest")
+	proto.RegisterType((*MFACredential)(nil), "ttn.lorawan.v3.MFACredential")
+	golang_proto.RegisterType((*MFACredential)(nil), "ttn.lorawan.v3.MFACredential")
+	proto.RegisterType((*MFACredentials)(nil), "ttn.lorawan.v3.MFACredentials")
+	golang_proto.RegisterType((*MFACredentials)(nil), "ttn.lorawan.v3.MFACredentials")
+	proto.RegisterType((*MFACredentialIdentifiers)(nil), "ttn.lorawan.v3.MFACredentialIdentifiers")
+	golang_proto.RegisterType((*MFACredentialIdentifiers)(nil), "ttn.lorawan.v3.MFACredentialIdentifiers")
+	proto.RegisterType((*BeginMFAEnrollmentRequest)(nil), "ttn.lorawan.v3.BeginMFAEnrollmentRequest")
+	golang_proto.RegisterType((*BeginMFAEnrollmentRequest)(nil), "ttn.lorawan.v3.BeginMFAEnrollmentRequest")
+	proto.RegisterType((*MFAEnrollment)(nil), "ttn.lorawan.v3.MFAEnrollment")
+	golang_proto.RegisterType((*MFAEnrollment)(nil), "ttn.lorawan.v3.MFAEnrollment")
+	proto.RegisterType((*FinishMFAEnrollmentRequest)(nil), "ttn.lorawan.v3.FinishMFAEnrollmentRequest")
+	golang_proto.RegisterType((*FinishMFAEnrollmentRequest)(nil), "ttn.lorawan.v3.FinishMFAEnrollmentRequest")
+	proto.RegisterType((*RecoveryCodes)(nil), "ttn.lorawan.v3.RecoveryCodes")
+	golang_proto.RegisterType((*RecoveryCodes)(nil), "ttn.lorawan.v3.RecoveryCodes")
+}
+
+func init() { proto.RegisterFile("lorawan-stack/api/user.proto", fileDescriptor_5ce30de589ccb9af) }
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/user.proto", fileDescriptor_5ce30de589ccb9af)
+}
+
+var fileDescriptor_5ce30de589ccb9af = []byte{
+	// 2289 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcd, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
+	0x19, 0xd6, 0xf2, 0x25, 0xf2, 0x27, 0x45, 0x51, 0x6b, 0x3d, 0x68, 0xc9, 0xa2, 0xd4, 0xad, 0x1b,
+	0xc4, 0x86, 0x49, 0x05, 0x32, 0xea, 0xa6, 0x6e, 0x5a, 0x87, 0x2b, 0xc9, 0xb6, 0x1a, 0xc7, 0x56,
+	0xc7, 0x52, 0x82, 0x36, 0x70, 0xb7, 0x2b, 0x72, 0x44, 0x6d, 0x44, 0xee, 0x32, 0xbb, 0x4b, 0x29,
+	0x4a, 0x51, 0x20, 0xc8, 0x21, 0x35, 0xda, 0x43, 0x8d, 0x00, 0x05, 0x8a, 0xf4, 0xd0, 0xa2, 0x05,
+	0x8a, 0xa0, 0xa7, 0x1c, 0xd3, 0x5b, 0x8e, 0x46, 0x1f, 0x80, 0x81, 0x5e, 0x02, 0x14, 0x70, 0x13,
+	0xe7, 0x62, 0xf4, 0x94, 0xde, 0x02, 0x9d, 0xfa, 0xcf, 0xcc, 0xbe, 0x48, 0xb1, 0x8a, 0xe4, 0x48,
+	0x45, 0x0f, 0x8b, 0x79, 0x7d, 0xff, 0x3f, 0xff, 0xfc, 0xcf, 0x19, 0x12, 0xce, 0x34, 0x2d, 0x5b,
+	0xdf, 0xd1, 0xcd, 0xb2, 0xe3, 0xea, 0xb5, 0xad, 0x39, 0xbd, 0x6d, 0xcc, 0x75, 0x1c, 0x6a, 0x57,
+	0xda, 0xb6, 0xe5, 0x5a, 0x72, 0xde, 0x75, 0xcd, 0x8a, 0x87, 0xa8, 0x6c, 0x5f, 0x9c, 0xac, 0x36,
+	0x0c, 0x77, 0xb3, 0xb3, 0x5e, 0xa9, 0x59, 0xad, 0x39, 0x6a, 0x6e, 0x5b, 0xbb, 0x08, 0x7b, 0x7d,
+	0x77, 0x8e, 0x83, 0x6b, 0xe5, 0x06, 0x35, 0xcb, 0xdb, 0x7a, 0xd3, 0xa8, 0xeb, 0x2e, 0x9d, 0xdb,
+	0xd7, 0x11, 0x2c, 0x27, 0xcb, 0x11, 0x16, 0x0d, 0xab, 0x61, 0x09, 0xe2, 0xf5, 0xce, 0x06, 0x1f,
+	0xf1, 0x01, 0xef, 0x79, 0xf0, 0xd9, 0x86, 0x65, 0x35, 0x9a, 0x34, 0x44, 0x6d, 0x18, 0xb4, 0x59,
+	0xd7, 0x5a, 0xba, 0xb3, 0xe5, 0x21, 0x66, 0x7a, 0x11, 0xae, 0xd1, 0xa2, 0x78, 0x9a, 0x56, 0xdb,
+	0x03, 0x9c, 0xdd, 0x7f, 0xc4, 0x9a, 0x65, 0x62, 0xdf, 0xd5, 0x0c, 0x73, 0xc3, 0xdf, 0x68, 0x7a,
+	0x3f, 0x8a, 0x9a, 0x9d, 0x96, 0xe3, 0x2d, 0x7f, 0x75, 0xff, 0xb2, 0x51, 0xa7, 0xa6, 0x6b, 0xa0,
+	0x3c, 0xb6, 0x0f, 0x9a, 0xd9, 0x0f, 0x6a, 0x1b, 0x35, 0xb7, 0x63, 0xfb, 0x87, 0x2f, 0xed, 0x07,
+	0xd8, 0x46, 0x63, 0xd3, 0xf5, 0x18, 0x28, 0xff, 0xca, 0x40, 0x62, 0x0d, 0xd5, 0x2f, 0x2f, 0x40,
+	0xdc, 0xa8, 0x3b, 0x45, 0x69, 0x56, 0x7a, 0x3a, 0x3b, 0x3f, 0x53, 0xe9, 0x36, 0x43, 0x85, 0x41,
+	0x96, 0xc3, 0xdd, 0xd5, 0xc2, 0x9e, 0x9a, 0xfc, 0x99, 0x14, 0x2b, 0x48, 0xf7, 0x1f, 0xce, 0x0c,
+	0x3c, 0x78, 0x38, 0x23, 0x11, 0x46, 0x8d, 0x4c, 0xa0, 0x66, 0x53, 0x54, 0x7d, 0x5d, 0xd3, 0xdd,
+	0x62, 0x8c, 0xf3, 0x9a, 0xac, 0x08, 0x75, 0x55, 0x7c, 0x75, 0x55, 0x56, 0x7d, 0x75, 0xa9, 0x69,
+	0x46, 0x7e, 0xef, 0x9f, 0x48, 0x9e, 0xf1, 0xe8, 0xaa, 0x2e, 0x63, 0xd2, 0x69, 0xd7, 0x7d, 0x26,
+	0xf1, 0xa3, 0x30, 0xf1, 0xe8, 0x90, 0xc9, 0x14, 0x24, 0x4c, 0xbd, 0x45, 0x8b, 0x09, 0x24, 0xcf,
+	0xa8, 0x83, 0x7b, 0x6a, 0xc2, 0x8e, 0x15, 0xe7, 0x09, 0x9f, 0x94, 0xcf, 0x43, 0xb6, 0x4e, 0x9d,
+	0x9a, 0x6d, 0xb4, 0x5d, 0xc3, 0x32, 0x8b, 0x49, 0x8e, 0x49, 0xe3, 0x91, 0xec, 0x78, 0xf1, 0xc1,
+	0x30, 0x89, 0x2e, 0xca, 0x36, 0x80, 0xee, 0xba, 0xb6, 0xb1, 0xde, 0x71, 0xa9, 0x53, 0x4c, 0xcd,
+	0xc6, 0x51, 0x9a, 0xb3, 0xfd, 0xd4, 0x53, 0xa9, 0x06, 0xb0, 0x25, 0xd3, 0xb5, 0x77, 0xd5, 0x0b,
+	0x7b, 0xea, 0xb9, 0x77, 0xa5, 0xa7, 0x94, 0xb3, 0xb6, 0x52, 0x3c, 0x3b, 0x5f, 0xfa, 0xe1, 0x2b,
+	0x7a, 0xf9, 0x8d, 0x67, 0xca, 0xdf, 0xbc, 0xf3, 0xf4, 0x95, 0xcb, 0xaf, 0x94, 0xef, 0x5c, 0xf1,
+	0x87, 0xe7, 0x7e, 0x3c, 0x7f, 0xe1, 0x27, 0x67, 0x49, 0x64, 0x17, 0xf9, 0x3b, 0x90, 0x8b, 0xfa,
+	0x4b, 0x71, 0x90, 0xef, 0x3a, 0xd5, 0xbb, 0xeb, 0x82, 0xc0, 0x2c, 0x23, 0x84, 0x64, 0x6b, 0xe1,
+	0x40, 0xfe, 0x16, 0x8c, 0xb5, 0x6d, 0xa3, 0xa5, 0xdb, 0xbb, 0x1a, 0x6d, 0xe9, 0x46, 0x53, 0xd3,
+	0xeb, 0x75, 0x9b, 0x3a, 0x4e, 0x31, 0x1d, 0xd1, 0xc6, 0x8f, 0x24, 0x72, 0xca, 0x43, 0x2d, 0x31,
+	0x50, 0x55, 0x60, 0xe4, 0x26, 0x28, 0x7d, 0x89, 0x35, 0x3f, 0xac, 0xb8, 0x59, 0x32, 0x5f, 0x68,
+	0x96, 0x04, 0x37, 0x49, 0xa9, 0xcf, 0x16, 0x2f, 0xf9, 0x8c, 0xd0, 0x4e, 0x93, 0x90, 0x6e, 0xeb,
+	0x8e, 0xb3, 0x63, 0xd9, 0xf5, 0x22, 0x30, 0xe9, 0x48, 0x30, 0x96, 0x57, 0xe0, 0x94, 0xdf, 0xd7,
+	0x22, 0x1e, 0x91, 0x3d, 0xe4, 0xd6, 0x23, 0x3e, 0xf1, 0x5a, 0xe0, 0x15, 0x97, 0x60, 0xc2, 0xa6,
+	0xaf, 0x75, 0x0c, 0x9b, 0x6a, 0x3d, 0x9c, 0x8b, 0x39, 0xe4, 0x9a, 0x26, 0x63, 0xde, 0xf2, 0x4a,
+	0x17, 0xa9, 0xfc, 0x75, 0x48, 0x22, 0x67, 0x44, 0x0d, 0x21, 0x2a, 0x3f, 0x3f, 0xd6, 0x6b, 0x89,
+	0xdb, 0x6c, 0x91, 0x7b, 0xd0, 0x5b, 0x2c, 0x28, 0x88, 0x40, 0xcb, 0xa3, 0x90, 0xd4, 0xeb, 0x2d,
+	0xc3, 0x2c, 0xe6, 0x39, 0x73, 0x31, 0x90, 0xcb, 0x20, 0xbb, 0xb4, 0xd5, 0x46, 0x6a, 0x54, 0x71,
+	0x70, 0xf8, 0x61, 0x7e, 0xf8, 0x91, 0x60, 0xc5, 0x97, 0x40, 0xae, 0xc1, 0xf4, 0x7e, 0xb8, 0x16,
+	0x09, 0xb3, 0xc2, 0x21, 0xf5, 0x31, 0xb9, 0x8f, 0xf7, 0x42, 0x10, 0x73, 0xfd, 0x37, 0xa1, 0xaf,
+	0xb7, 0x51, 0x17, 0x0e, 0xdb, 0x64, 0xe4, 0x89, 0x37, 0x59, 0x12, 0x4c, 0x70, 0x93, 0xe7, 0x61,
+	0x18, 0xe9, 0x36, 0x8c, 0x26, 0x6a, 0x5f, 0x24, 0xa9, 0xa2, 0xcc, 0xd9, 0x4e, 0xf4, 0xea, 0x73,
+	0x45, 0x2c, 0x93, 0xbc, 0x87, 0xf7, 0xc6, 0x93, 0xdf, 0x86, 0xe1, 0x9e, 0x28, 0x93, 0x0b, 0x10,
+	0xdf, 0xa2, 0xbb, 0x3c, 0x6f, 0x65, 0x08, 0xeb, 0x32, 0xad, 0xa3, 0xab, 0x76, 0x28, 0xcf, 0x3f,
+	0x19, 0x22, 0x06, 0x97, 0x63, 0xcf, 0x4a, 0xca, 0x45, 0x48, 0xb2, 0x48, 0x75, 0x30, 0x01, 0x24,
+	0x59, 0xcd, 0x61, 0xe9, 0x8e, 0x45, 0xd6, 0x68, 0xbf, 0x78, 0x26, 0x02, 0xa2, 0xfc, 0x46, 0x82,
+	0xfc, 0x35, 0xea, 0xf2, 0x29, 0x74, 0x0e, 0x3c, 0xad, 0x7c, 0x03, 0xd2, 0x6c, 0x4d, 0xfb, 0x52,
+	0x09, 0x73, 0xb0, 0xc3, 0x21, 0x8e, 0x7c, 0x05, 0x20, 0x2c, 0x31, 0xff, 0x35, 0x69, 0x5e, 0x65,
+	0x90, 0x17, 0x11, 0xa1, 0x26, 0x18, 0x0b, 0x92, 0xd9, 0xf0, 0x27, 0x94, 0x3f, 0xc7, 0xa0, 0x70,
+	0xc3, 0x70, 0xb8, 0x88, 0x8e, 0x2f, 0x63, 0x37, 0x57, 0xe9, 0xc8, 0x5c, 0xe5, 0x3f, 0x48, 0x90,
+	0x44, 0xf3, 0x51, 0x5b, 0xe8, 0x51, 0xfd, 0x85, 0xb4, 0xa7, 0xfe, 0x5c, 0xb2, 0xef, 0x4a, 0x64,
+	0x40, 0xc8, 0x8e, 0xc7, 0x27, 0xe9, 0xb2, 0xdf, 0xe3, 0x99, 0x95, 0x24, 0xcb, 0xbc, 0xe9, 0x9f,
+	0x7e, 0xc8, 0x78, 0xb9, 0xff, 0xbc, 0x08, 0x17, 0x92, 0x2a, 0x8b, 0x56, 0xc4, 0x09, 0x0e, 0x45,
+	0x1b, 0x29, 0x29, 0x24, 0x5b, 0x8e, 0x0c, 0x84, 0x78, 0x72, 0x09, 0x92, 0x4d, 0xa3, 0x65, 0x88,
+	0x52, 0x31, 0xc4, 0xa3, 0xf0, 0x7c, 0xbc, 0xf8, 0x78, 0x90, 0x88, 0x69, 0x59, 0x86, 0x44, 0x5b,
+	0x6f, 0x88, 0x52, 0x30, 0x44, 0x78, 0x5f, 0x2e, 0xc2, 0x60, 0x9d, 0x36, 0x29, 0x32, 0xe2, 0xd9,
+	0x3f, 0x4d, 0xfc, 0xa1, 0xf2, 0x06, 0x8c, 0x88, 0xb0, 0x88, 0x1a, 0xfc, 0x32, 0x24, 0xd8, 0x39,
+	0x3d, 0x35, 0xf6, 0x75, 0x97, 0x3e, 0x16, 0xe6, 0x34, 0xf2, 0x39, 0x28, 0x18, 0xe6, 0xb6, 0x81,
+	0x27, 0xc3, 0x72, 0xa2, 0xb9, 0xd6, 0x16, 0x35, 0x3d, 0xcf, 0x1c, 0x0e, 0xe7, 0x57, 0xd9, 0xb4,
+	0x72, 0x4f, 0x82, 0x11, 0x91, 0x71, 0x8e, 0x6b, 0xf3, 0x2f, 0xed, 0x5b, 0x26, 0x94, 0x84, 0x3a,
+	0x56, 0x7b, 0xe3, 0xfa, 0x44, 0x82, 0x41, 0xf9, 0x93, 0x04, 0xa7, 0x43, 0x15, 0x9c, 0xe8, 0x5e,
+	0x2c, 0x75, 0x98, 0x74, 0xc7, 0x33, 0x06, 0xeb, 0xb2, 0x19, 0xab, 0x59, 0xe7, 0x8e, 0x84, 0x33,
+	0xd8, 0xc5, 0x4c, 0x31, 0x62, 0xd3, 0x6d, 0xb4, 0x8e, 0xa6, 0x37, 0xd1, 0x61, 0x6b, 0x35, 0x56,
+	0x46, 0x13, 0xdc, 0x65, 0x86, 0xc5, 0x42, 0xb5, 0xd9, 0xac, 0xf2, 0x69, 0xe5, 0x5d, 0x09, 0xc6,
+	0xfd, 0x38, 0xac, 0xae, 0x2c, 0xbf, 0x40, 0x77, 0x9d, 0x93, 0x11, 0x3c, 0xf0, 0xf8, 0xd8, 0xc1,
+	0x1e, 0x1f, 0x0f, 0x3d, 0x5e, 0x79, 0x5b, 0x82, 0x51, 0x2f, 0x8d, 0x09, 0xd9, 0x4e, 0x46, 0xb4,
+	0x59, 0x48, 0x61, 0x0e, 0x46, 0x66, 0x5e, 0xd6, 0xc8, 0x3c, 0x7a, 0x38, 0x93, 0xc4, 0xdd, 0x96,
+	0x17, 0x49, 0x12, 0x17, 0x96, 0xeb, 0xca, 0x5f, 0x63, 0x30, 0x11, 0x46, 0xd8, 0x49, 0xca, 0xe2,
+	0xdf, 0x01, 0x63, 0xfd, 0xee, 0x80, 0xcf, 0x41, 0x4a, 0x5c, 0x84, 0x51, 0x4b, 0xf1, 0x7e, 0x35,
+	0x9d, 0xb0, 0x55, 0x75, 0x68, 0x4f, 0x85, 0x77, 0xa4, 0x41, 0xc5, 0x2b, 0xec, 0x1e, 0x0d, 0x8b,
+	0xab, 0x48, 0x71, 0x4c, 0x1c, 0xb2, 0x38, 0x66, 0x68, 0x50, 0x0b, 0xaf, 0xc1, 0x08, 0x3a, 0x94,
+	0xb5, 0x83, 0x99, 0xcc, 0x68, 0x6b, 0xb6, 0x6e, 0x36, 0xf0, 0x76, 0x99, 0x44, 0x49, 0x32, 0xea,
+	0x14, 0x9e, 0xe8, 0x1d, 0xdc, 0x6d, 0x14, 0x55, 0x37, 0x5c, 0x15, 0xa0, 0xe5, 0x15, 0xc2, 0x21,
+	0x64, 0xd8, 0xa3, 0x5a, 0x6e, 0x8b, 0x09, 0xe5, 0x8f, 0x12, 0x4c, 0x84, 0x01, 0x73, 0x92, 0xea,
+	0xac, 0xc2, 0x20, 0x3e, 0x1f, 0x34, 0x56, 0x6d, 0x45, 0x22, 0x19, 0xef, 0x65, 0x26, 0x76, 0xef,
+	0xc3, 0x23, 0x85, 0x84, 0xb8, 0xa2, 0xfc, 0x32, 0x0e, 0xb0, 0x1c, 0x24, 0x3d, 0x79, 0x1a, 0x92,
+	0xbc, 0x10, 0x88, 0xea, 0x1d, 0xde, 0x4b, 0xc5, 0x2c, 0x2b, 0xe4, 0xd1, 0x74, 0x29, 0x06, 0xec,
+	0x79, 0x10, 0x51, 0xfd, 0x91, 0x9e, 0x07, 0xa1, 0xfa, 0xbb, 0x1f, 0x2a, 0x89, 0xe3, 0x78, 0xa8,
+	0x24, 0x9f, 0xec, 0xa1, 0x52, 0x85, 0x2c, 0xcb, 0x2a, 0x6d, 0x8f, 0x4b, 0xea, 0x90, 0xae, 0x04,
+	0x3e, 0x11, 0xbf, 0x57, 0x85, 0x2c, 0xd6, 0x77, 0xf1, 0xb5, 0x70, 0x18, 0x4b, 0x87, 0x1c, 0xd4,
+	0x5d, 0xe5, 0x86, 0x48, 0x5c, 0xa1, 0x69, 0x82, 0xc4, 0x15, 0xa4, 0x1a, 0xe9, 0xe0, 0x54, 0x13,
+	0x8b, 0xa4, 0x9a, 0x17, 0x20, 0x1b, 0xe1, 0x84, 0x91, 0x96, 0x0d, 0x0b, 0x9d, 0x7f, 0xe5, 0x9a,
+	0xec, 0x15, 0x2f, 0xa4, 0x20, 0x51, 0xb8, 0x72, 0x09, 0xc6, 0x6e, 0x53, 0xb3, 0x1e, 0x59, 0xf6,
+	0x24, 0x3b, 0xd8, 0x79, 0x94, 0x67, 0x61, 0x62, 0x91, 0x97, 0xf4, 0x23, 0x53, 0xfe, 0x1a, 0xd3,
+	0x38, 0x53, 0xd6, 0x6d, 0xcc, 0xe9, 0x48, 0x15, 0xd1, 0xd9, 0x31, 0x07, 0xd4, 0x45, 0x00, 0x47,
+	0xec, 0x11, 0xe6, 0xcb, 0x51, 0x91, 0xa5, 0x9e, 0xc7, 0xd8, 0xcf, 0xf8, 0x02, 0x2c, 0x92, 0x8c,
+	0xe3, 0xcb, 0xa2, 0xfc, 0x23, 0x06, 0xd9, 0x88, 0x74, 0xff, 0x07, 0x22, 0xf5, 0x04, 0x53, 0xfc,
+	0x38, 0x82, 0x29, 0xf1, 0x64, 0xc1, 0xd4, 0x9d, 0x96, 0x93, 0x47, 0x4e, 0xcb, 0xca, 0x35, 0xc8,
+	0x45, 0x94, 0xeb, 0xc8, 0xdf, 0x80, 0xb4, 0x77, 0x4e, 0xdf, 0x71, 0xa7, 0xfa, 0x69, 0xd7, 0xc3,
+	0x93, 0x00, 0xac, 0xfc, 0x1d, 0xd3, 0xb2, 0x7f, 0x17, 0xf0, 0xb9, 0x9d, 0x4c, 0x5a, 0xbe, 0xd4,
+	0x7d, 0x4d, 0x9f, 0xdd, 0x53, 0xa7, 0xed, 0x29, 0xbc, 0xa3, 0x9f, 0xc0, 0xb5, 0x59, 0xf9, 0x5b,
+	0x02, 0x86, 0x5e, 0xbc, 0x5a, 0xc5, 0xf2, 0xcd, 0x65, 0xd3, 0x9b, 0xc7, 0x7c, 0x96, 0x69, 0x88,
+	0x05, 0x6e, 0x37, 0x14, 0xba, 0x5d, 0x0c, 0xfd, 0x0d, 0x17, 0xd0, 0xbc, 0x09, 0x77, 0xb7, 0x2d,
+	0xee, 0x35, 0xf9, 0xf9, 0xaf, 0xf4, 0x6e, 0xd4, 0x25, 0xd9, 0x2a, 0x02, 0x23, 0x2f, 0x72, 0x4e,
+	0x78, 0xf0, 0xaf, 0x42, 0xdd, 0x6e, 0x9c, 0x3c, 0x0e, 0x37, 0x4e, 0x3d, 0x99, 0x1b, 0xab, 0x90,
+	0x6b, 0xea, 0x8e, 0xab, 0xa1, 0x5a, 0x38, 0x9b, 0xc1, 0xc3, 0x16, 0x05, 0x46, 0x85, 0xea, 0x66,
+	0x3c, 0xce, 0x40, 0xa6, 0x66, 0x99, 0x1b, 0x86, 0xdd, 0xc2, 0x37, 0x4e, 0x9a, 0x5f, 0x58, 0xc3,
+	0x09, 0x79, 0x1c, 0x52, 0x0e, 0x45, 0xa9, 0xc5, 0x0f, 0x39, 0x39, 0xe2, 0x8d, 0xe4, 0x9b, 0x30,
+	0xbe, 0x43, 0xd7, 0xf5, 0x8e, 0xbb, 0x69, 0xb2, 0x9f, 0x18, 0x3c, 0x5d, 0xb2, 0x5c, 0xc0, 0x7e,
+	0x9c, 0xc9, 0xa9, 0x45, 0xb4, 0xc6, 0xe8, 0xcb, 0x74, 0xbd, 0xca, 0x10, 0xa1, 0xb2, 0xd1, 0x3e,
+	0xa3, 0x3e, 0x5d, 0x64, 0xb6, 0x8e, 0x06, 0x05, 0xc7, 0x68, 0x20, 0x2f, 0xab, 0x63, 0x8a, 0x5f,
+	0x6e, 0x86, 0x30, 0x73, 0xe0, 0xcc, 0x02, 0x9b, 0x50, 0xbe, 0x07, 0xf9, 0x2e, 0xa3, 0xb1, 0x8b,
+	0x55, 0x36, 0xdc, 0xd7, 0x8f, 0xb9, 0xe9, 0x03, 0x2d, 0x4d, 0xa2, 0x14, 0xca, 0x4f, 0x25, 0x28,
+	0x76, 0x2d, 0x9f, 0x5c, 0xfe, 0x3e, 0xd8, 0x5b, 0x95, 0xbf, 0xe0, 0x53, 0x46, 0xa5, 0x0d, 0xc3,
+	0x44, 0x71, 0x96, 0x4c, 0xdb, 0x6a, 0x36, 0x5b, 0xc8, 0xf6, 0x64, 0x92, 0xc0, 0x82, 0x17, 0x19,
+	0xb1, 0xc3, 0x46, 0x46, 0x6e, 0x4f, 0xcd, 0xbc, 0x25, 0xa5, 0x0a, 0x52, 0x71, 0xa0, 0xd8, 0x1b,
+	0x1d, 0xf1, 0x3e, 0xd1, 0xa1, 0xbc, 0x1d, 0xe7, 0xa1, 0x1f, 0x1e, 0x04, 0x7d, 0x88, 0x1d, 0x5f,
+	0xd4, 0xd0, 0x54, 0x24, 0x4a, 0xe7, 0x20, 0xeb, 0x5a, 0x6e, 0x5b, 0xf3, 0x1c, 0x4c, 0xe8, 0x27,
+	0x8f, 0x00, 0x58, 0xbd, 0xb5, 0xba, 0x72, 0x9b, 0xcf, 0x12, 0x60, 0x10, 0xd1, 0x97, 0x9f, 0x82,
+	0x34, 0x27, 0xe8, 0xd8, 0x86, 0xb7, 0x77, 0x16, 0xd1, 0x83, 0x0c, 0xbd, 0x46, 0x96, 0xc9, 0x20,
+	0x5b, 0x5c, 0xb3, 0x0d, 0x79, 0x11, 0xe4, 0xd0, 0x39, 0x37, 0xf1, 0x22, 0x4c, 0x4d, 0x2f, 0x3f,
+	0xe5, 0xd4, 0x31, 0xa4, 0x18, 0x09, 0x1c, 0xd3, 0x5f, 0x24, 0x23, 0x81, 0x57, 0xfa, 0x53, 0x98,
+	0x2f, 0xf3, 0x01, 0x17, 0xbb, 0xcd, 0x5c, 0x5b, 0xfc, 0xfe, 0x5b, 0x40, 0x0e, 0x39, 0x9f, 0x03,
+	0x59, 0xc1, 0xc3, 0xe4, 0x7c, 0x1c, 0x69, 0xa3, 0x2b, 0x3f, 0x07, 0x85, 0x28, 0x1d, 0xd7, 0x54,
+	0x8a, 0x53, 0xca, 0x48, 0x99, 0x0f, 0x29, 0x6f, 0xb2, 0x5f, 0x40, 0xf2, 0x21, 0x2d, 0x1b, 0xcb,
+	0xd7, 0x21, 0x08, 0x10, 0x8d, 0xdb, 0x7d, 0x53, 0x37, 0xeb, 0x4d, 0xca, 0x43, 0x3b, 0xa7, 0x8e,
+	0x23, 0x07, 0xd9, 0xe7, 0xc0, 0xcc, 0x7e, 0x9d, 0xaf, 0x92, 0xe0, 0xbc, 0xe1, 0x9c, 0xf2, 0xef,
+	0x18, 0x4c, 0x5e, 0x35, 0x4c, 0xc3, 0xd9, 0xfc, 0x1f, 0xf8, 0xd5, 0x17, 0x24, 0xe4, 0x67, 0x20,
+	0xc3, 0x2d, 0x57, 0xb3, 0xea, 0xbe, 0xdb, 0x9c, 0x12, 0x28, 0x40, 0x54, 0x9a, 0x59, 0x70, 0x01,
+	0x97, 0x08, 0xb7, 0x2f, 0xeb, 0xc9, 0x77, 0x60, 0x2a, 0xd0, 0x83, 0xee, 0xba, 0x2c, 0x7d, 0xf1,
+	0xdf, 0x45, 0xac, 0xf5, 0x57, 0x69, 0xcd, 0xf5, 0x8c, 0x39, 0x8d, 0xc4, 0xa7, 0x7d, 0x75, 0x54,
+	0x43, 0xd4, 0x2d, 0x0e, 0x22, 0xa7, 0x7d, 0x0e, 0xfb, 0x96, 0xe4, 0x35, 0x38, 0x1d, 0xba, 0x48,
+	0xd3, 0xc0, 0x33, 0x6a, 0x98, 0x54, 0x75, 0xed, 0x55, 0xc7, 0xfb, 0x9d, 0x3f, 0xa7, 0x4e, 0x22,
+	0xf3, 0xf1, 0xc0, 0x53, 0x38, 0x66, 0x11, 0x21, 0xdf, 0xbd, 0x7d, 0xeb, 0x26, 0x09, 0x92, 0x5f,
+	0x64, 0x1e, 0x29, 0x95, 0xaf, 0xc1, 0x10, 0xa1, 0x35, 0x6b, 0x9b, 0xda, 0xbb, 0xec, 0x14, 0x0e,
+	0x7b, 0x9a, 0xb0, 0x33, 0x8b, 0x04, 0x85, 0x4f, 0x13, 0x3e, 0x38, 0xff, 0x1a, 0x8c, 0xec, 0x8b,
+	0x34, 0x79, 0x02, 0x4e, 0xe1, 0xa4, 0xb6, 0x40, 0x96, 0x16, 0x97, 0x6e, 0xae, 0x2e, 0x57, 0x6f,
+	0x68, 0x4c, 0x2d, 0x85, 0x01, 0x0c, 0xb7, 0x89, 0x9e, 0x85, 0x97, 0x97, 0xd4, 0xea, 0xda, 0xea,
+	0xf5, 0x9b, 0x05, 0x09, 0xdf, 0xd1, 0x67, 0x7a, 0x16, 0xc9, 0xd2, 0xc2, 0xad, 0x97, 0x96, 0xc8,
+	0xf7, 0xb5, 0x85, 0x5b, 0x8b, 0x4b, 0x85, 0xd8, 0x64, 0xe2, 0xee, 0xef, 0x4b, 0x03, 0xea, 0xef,
+	0xa4, 0xfb, 0x9f, 0x94, 0xa4, 0x07, 0xf8, 0x7d, 0xf4, 0x49, 0x69, 0xe0, 0x63, 0xfc, 0x1e, 0xe3,
+	0xf7, 0x19, 0x7e, 0x9f, 0xe3, 0xdc, 0x9b, 0x8f, 0x4a, 0xd2, 0xdd, 0x47, 0xa5, 0x81, 0xf7, 0xb0,
+	0x7d, 0x1f, 0xdb, 0x0f, 0xf0, 0xfb, 0x10, 0xbf, 0xfb, 0x38, 0x7e, 0x80, 0xdf, 0x47, 0xd8, 0xff,
+	0x18, 0xdb, 0xc7, 0xd8, 0x7e, 0x86, 0xed, 0xe7, 0xd8, 0xbe, 0xf9, 0x69, 0x69, 0xe0, 0xee, 0xa7,
+	0x25, 0xe9, 0x1e, 0xb6, 0xbf, 0xc2, 0xf6, 0xb7, 0xd8, 0xbe, 0x87, 0xdf, 0xfb, 0xd8, 0xff, 0x00,
+	0xbf, 0x0f, 0xf1, 0xfb, 0xc1, 0x85, 0x86, 0x55, 0x71, 0x37, 0xa9, 0xbb, 0x69, 0x98, 0x0d, 0xa7,
+	0x62, 0x52, 0x77, 0xc7, 0xb2, 0xb7, 0xe6, 0xba, 0xff, 0x6b, 0x6a, 0x6f, 0x35, 0xe6, 0xd0, 0xf3,
+	0xda, 0xeb, 0xeb, 0x29, 0x5e, 0xb1, 0x2e, 0xfe, 0x07, 0xcf, 0xd5, 0x67, 0x24, 0xfb, 0x1b, 0x00,
+	0x00,
+}
+
+func (x MFACredentialType) String() string {
+	s, ok := MFACredentialType_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *User) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*User)
+	if !ok {
+		that2, ok := that.(User)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Attributes) != len(that1.Attributes) {
+		return false
+	}
+	for i := range this.Attributes {
+		if this.Attributes[i] != that1.Attributes[i] {
+			return false
+		}
+	}
+	if len(this.ContactInfo) != len(that1.ContactInfo) {
+		return false
+	}
+	for i := range this.ContactInfo {
+		if !this.ContactInfo[i].Equal(that1.ContactInfo[i]) {
+			return false
+		}
+	}
+	if this.PrimaryEmailAddress != that1.PrimaryEmailAddress {
+		return false
+	}
+	if that1.PrimaryEmailAddressValidatedAt == nil {
+		if this.PrimaryEmailAddressValidatedAt != nil {
+			return false
+		}
+	} else if !this.PrimaryEmailAddressValidatedAt.Equal(*that1.PrimaryEmailAddressValidatedAt) {
+		return false
+	}
+	if this.Password != that1.Password {
+		return false
+	}
+	if that1.PasswordUpdatedAt == nil {
 		if this.PasswordUpdatedAt != nil {
 			return false
 		}
@@ -1627,6 +2231,9 @@ func (this *ListUsersRequest) Equal(that interface{}) bool {
 	if this.Page != that1.Page {
 		return false
 	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
 	return true
 }
 func (this *CreateUserRequest) Equal(that interface{}) bool {
@@ -1830,9 +2437,24 @@ func (this *CreateUserAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	return true
-}
-func (this *UpdateUserAPIKeyRequest) Equal(that interface{}) bool {
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedIPRanges) != len(that1.AllowedIPRanges) {
+		return false
+	}
+	for i := range this.AllowedIPRanges {
+		if this.AllowedIPRanges[i] != that1.AllowedIPRanges[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *UpdateUserAPIKeyRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
@@ -2138,6 +2760,257 @@ func (this *ListUserSessionsRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MFACredential) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MFACredential)
+	if !ok {
+		that2, ok := that.(MFACredential)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
+	}
+	if that1.LastUsedAt == nil {
+		if this.LastUsedAt != nil {
+			return false
+		}
+	} else if !this.LastUsedAt.Equal(*that1.LastUsedAt) {
+		return false
+	}
+	if this.Confirmed != that1.Confirmed {
+		return false
+	}
+	if !bytes.Equal(this.Secret, that1.Secret) {
+		return false
+	}
+	if !bytes.Equal(this.WebAuthnCredentialID, that1.WebAuthnCredentialID) {
+		return false
+	}
+	if this.SignCount != that1.SignCount {
+		return false
+	}
+	return true
+}
+func (this *MFACredentials) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MFACredentials)
+	if !ok {
+		that2, ok := that.(MFACredentials)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Credentials) != len(that1.Credentials) {
+		return false
+	}
+	for i := range this.Credentials {
+		if !this.Credentials[i].Equal(that1.Credentials[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MFACredentialIdentifiers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MFACredentialIdentifiers)
+	if !ok {
+		that2, ok := that.(MFACredentialIdentifiers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	return true
+}
+func (this *BeginMFAEnrollmentRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BeginMFAEnrollmentRequest)
+	if !ok {
+		that2, ok := that.(BeginMFAEnrollmentRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
+func (this *MFAEnrollment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MFAEnrollment)
+	if !ok {
+		that2, ok := that.(MFAEnrollment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.TOTPSecret != that1.TOTPSecret {
+		return false
+	}
+	if this.TOTPURI != that1.TOTPURI {
+		return false
+	}
+	if !bytes.Equal(this.WebAuthnChallenge, that1.WebAuthnChallenge) {
+		return false
+	}
+	if this.WebAuthnRPID != that1.WebAuthnRPID {
+		return false
+	}
+	if this.WebAuthnRPName != that1.WebAuthnRPName {
+		return false
+	}
+	if !bytes.Equal(this.WebAuthnUserHandle, that1.WebAuthnUserHandle) {
+		return false
+	}
+	return true
+}
+func (this *FinishMFAEnrollmentRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FinishMFAEnrollmentRequest)
+	if !ok {
+		that2, ok := that.(FinishMFAEnrollmentRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UserIdentifiers.Equal(&that1.UserIdentifiers) {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.TOTPCode != that1.TOTPCode {
+		return false
+	}
+	if !bytes.Equal(this.WebAuthnAttestationObject, that1.WebAuthnAttestationObject) {
+		return false
+	}
+	if !bytes.Equal(this.WebAuthnClientDataJSON, that1.WebAuthnClientDataJSON) {
+		return false
+	}
+	return true
+}
+func (this *RecoveryCodes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecoveryCodes)
+	if !ok {
+		that2, ok := that.(RecoveryCodes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Codes) != len(that1.Codes) {
+		return false
+	}
+	for i := range this.Codes {
+		if this.Codes[i] != that1.Codes[i] {
+			return false
+		}
+	}
+	return true
+}
 func (m *User) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2438,6 +3311,16 @@ func (m *ListUsersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Page != 0 {
 		i = encodeVarintUser(dAtA, i, uint64(m.Page))
 		i--
@@ -2744,21 +3627,40 @@ func (m *CreateUserAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedIPRanges) > 0 {
+		for iNdEx := len(m.AllowedIPRanges) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedIPRanges[iNdEx])
+			copy(dAtA[i:], m.AllowedIPRanges[iNdEx])
+			i = encodeVarintUser(dAtA, i, uint64(len(m.AllowedIPRanges[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExpiresAt != nil {
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintUser(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Rights) > 0 {
-		dAtA20 := make([]byte, len(m.Rights)*10)
-		var j19 int
+		dAtA21 := make([]byte, len(m.Rights)*10)
+		var j20 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j19++
+				j20++
 			}
-			dAtA20[j19] = uint8(num)
-			j19++
+			dAtA21[j20] = uint8(num)
+			j20++
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintUser(dAtA, i, uint64(j19))
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintUser(dAtA, i, uint64(j20))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2858,38 +3760,38 @@ func (m *Invitation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 	}
 	if m.AcceptedAt != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AcceptedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AcceptedAt):])
-		if err25 != nil {
-			return 0, err25
+		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AcceptedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AcceptedAt):])
+		if err26 != nil {
+			return 0, err26
 		}
-		i -= n25
-		i = encodeVarintUser(dAtA, i, uint64(n25))
+		i -= n26
+		i = encodeVarintUser(dAtA, i, uint64(n26))
 		i--
 		dAtA[i] = 0x32
 	}
-	n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err26 != nil {
-		return 0, err26
-	}
-	i -= n26
-	i = encodeVarintUser(dAtA, i, uint64(n26))
-	i--
-	dAtA[i] = 0x2a
-	n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
 	if err27 != nil {
 		return 0, err27
 	}
 	i -= n27
 	i = encodeVarintUser(dAtA, i, uint64(n27))
 	i--
-	dAtA[i] = 0x22
-	n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
+	dAtA[i] = 0x2a
+	n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
 	if err28 != nil {
 		return 0, err28
 	}
 	i -= n28
 	i = encodeVarintUser(dAtA, i, uint64(n28))
 	i--
+	dAtA[i] = 0x22
+	n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintUser(dAtA, i, uint64(n29))
+	i--
 	dAtA[i] = 0x1a
 	if len(m.Token) > 0 {
 		i -= len(m.Token)
@@ -3099,30 +4001,30 @@ func (m *UserSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.ExpiresAt != nil {
-		n30, err30 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
-		if err30 != nil {
-			return 0, err30
+		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err31 != nil {
+			return 0, err31
 		}
-		i -= n30
-		i = encodeVarintUser(dAtA, i, uint64(n30))
+		i -= n31
+		i = encodeVarintUser(dAtA, i, uint64(n31))
 		i--
 		dAtA[i] = 0x2a
 	}
-	n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err31 != nil {
-		return 0, err31
-	}
-	i -= n31
-	i = encodeVarintUser(dAtA, i, uint64(n31))
-	i--
-	dAtA[i] = 0x22
-	n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
 	if err32 != nil {
 		return 0, err32
 	}
 	i -= n32
 	i = encodeVarintUser(dAtA, i, uint64(n32))
 	i--
+	dAtA[i] = 0x22
+	n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err33 != nil {
+		return 0, err33
+	}
+	i -= n33
+	i = encodeVarintUser(dAtA, i, uint64(n33))
+	i--
 	dAtA[i] = 0x1a
 	if len(m.SessionID) > 0 {
 		i -= len(m.SessionID)
@@ -3231,230 +4133,468 @@ func (m *ListUserSessionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintUser(dAtA []byte, offset int, v uint64) int {
-	offset -= sovUser(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MFACredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func NewPopulatedUser(r randyUser, easy bool) *User {
-	this := &User{}
-	v1 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v1
-	v2 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v2
-	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v3
-	this.Name = randStringUser(r)
-	this.Description = randStringUser(r)
-	if r.Intn(5) != 0 {
-		v4 := r.Intn(10)
-		this.Attributes = make(map[string]string)
-		for i := 0; i < v4; i++ {
-			this.Attributes[randStringUser(r)] = randStringUser(r)
+
+func (m *MFACredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MFACredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignCount != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.SignCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.WebAuthnCredentialID) > 0 {
+		i -= len(m.WebAuthnCredentialID)
+		copy(dAtA[i:], m.WebAuthnCredentialID)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.WebAuthnCredentialID)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Confirmed {
+		i--
+		if m.Confirmed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if r.Intn(5) != 0 {
-		v5 := r.Intn(5)
-		this.ContactInfo = make([]*ContactInfo, v5)
-		for i := 0; i < v5; i++ {
-			this.ContactInfo[i] = NewPopulatedContactInfo(r, easy)
+	if m.LastUsedAt != nil {
+		n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUsedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsedAt):])
+		if err36 != nil {
+			return 0, err36
 		}
+		i -= n36
+		i = encodeVarintUser(dAtA, i, uint64(n36))
+		i--
+		dAtA[i] = 0x3a
 	}
-	this.PrimaryEmailAddress = randStringUser(r)
-	if r.Intn(5) != 0 {
-		this.PrimaryEmailAddressValidatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	n37, err37 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err37 != nil {
+		return 0, err37
 	}
-	this.Password = randStringUser(r)
-	if r.Intn(5) != 0 {
-		this.PasswordUpdatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	i -= n37
+	i = encodeVarintUser(dAtA, i, uint64(n37))
+	i--
+	dAtA[i] = 0x32
+	n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err38 != nil {
+		return 0, err38
 	}
-	this.RequirePasswordUpdate = bool(r.Intn(2) == 0)
-	this.State = State([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
-	this.Admin = bool(r.Intn(2) == 0)
-	this.TemporaryPassword = randStringUser(r)
-	if r.Intn(5) != 0 {
-		this.TemporaryPasswordCreatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	i -= n38
+	i = encodeVarintUser(dAtA, i, uint64(n38))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if r.Intn(5) != 0 {
-		this.TemporaryPasswordExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	if m.Type != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
 	}
-	if r.Intn(5) != 0 {
-		this.ProfilePicture = NewPopulatedPicture(r, easy)
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if !easy && r.Intn(10) != 0 {
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
 	}
-	return this
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedUsers(r randyUser, easy bool) *Users {
-	this := &Users{}
-	if r.Intn(5) != 0 {
-		v6 := r.Intn(5)
-		this.Users = make([]*User, v6)
-		for i := 0; i < v6; i++ {
-			this.Users[i] = NewPopulatedUser(r, easy)
-		}
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *MFACredentials) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedGetUserRequest(r randyUser, easy bool) *GetUserRequest {
-	this := &GetUserRequest{}
-	v7 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v7
-	v8 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v8
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MFACredentials) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedListUsersRequest(r randyUser, easy bool) *ListUsersRequest {
-	this := &ListUsersRequest{}
-	v9 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v9
-	this.Order = randStringUser(r)
-	this.Limit = r.Uint32()
-	this.Page = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
+func (m *MFACredentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credentials) > 0 {
+		for iNdEx := len(m.Credentials) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Credentials[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUser(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return this
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedCreateUserRequest(r randyUser, easy bool) *CreateUserRequest {
-	this := &CreateUserRequest{}
-	v10 := NewPopulatedUser(r, easy)
-	this.User = *v10
-	this.InvitationToken = randStringUser(r)
-	if !easy && r.Intn(10) != 0 {
+func (m *MFACredentialIdentifiers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedUpdateUserRequest(r randyUser, easy bool) *UpdateUserRequest {
-	this := &UpdateUserRequest{}
-	v11 := NewPopulatedUser(r, easy)
-	this.User = *v11
-	v12 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v12
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MFACredentialIdentifiers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedCreateTemporaryPasswordRequest(r randyUser, easy bool) *CreateTemporaryPasswordRequest {
-	this := &CreateTemporaryPasswordRequest{}
-	v13 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v13
-	if !easy && r.Intn(10) != 0 {
+func (m *MFACredentialIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return this
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedUpdateUserPasswordRequest(r randyUser, easy bool) *UpdateUserPasswordRequest {
-	this := &UpdateUserPasswordRequest{}
-	v14 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v14
-	this.New = randStringUser(r)
-	this.Old = randStringUser(r)
-	this.RevokeAllAccess = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
+func (m *BeginMFAEnrollmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedListUserAPIKeysRequest(r randyUser, easy bool) *ListUserAPIKeysRequest {
-	this := &ListUserAPIKeysRequest{}
-	v15 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v15
-	this.Limit = r.Uint32()
-	this.Page = r.Uint32()
-	if !easy && r.Intn(10) != 0 {
+func (m *BeginMFAEnrollmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeginMFAEnrollmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return this
+	if m.Type != 0 {
+		i = encodeVarintUser(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedGetUserAPIKeyRequest(r randyUser, easy bool) *GetUserAPIKeyRequest {
-	this := &GetUserAPIKeyRequest{}
-	v16 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v16
-	this.KeyID = randStringUser(r)
-	if !easy && r.Intn(10) != 0 {
+func (m *MFAEnrollment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedCreateUserAPIKeyRequest(r randyUser, easy bool) *CreateUserAPIKeyRequest {
-	this := &CreateUserAPIKeyRequest{}
-	v17 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v17
-	this.Name = randStringUser(r)
-	v18 := r.Intn(10)
-	this.Rights = make([]Right, v18)
-	for i := 0; i < v18; i++ {
-		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
+func (m *MFAEnrollment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MFAEnrollment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WebAuthnUserHandle) > 0 {
+		i -= len(m.WebAuthnUserHandle)
+		copy(dAtA[i:], m.WebAuthnUserHandle)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.WebAuthnUserHandle)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if !easy && r.Intn(10) != 0 {
+	if len(m.WebAuthnRPName) > 0 {
+		i -= len(m.WebAuthnRPName)
+		copy(dAtA[i:], m.WebAuthnRPName)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.WebAuthnRPName)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return this
+	if len(m.WebAuthnRPID) > 0 {
+		i -= len(m.WebAuthnRPID)
+		copy(dAtA[i:], m.WebAuthnRPID)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.WebAuthnRPID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.WebAuthnChallenge) > 0 {
+		i -= len(m.WebAuthnChallenge)
+		copy(dAtA[i:], m.WebAuthnChallenge)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.WebAuthnChallenge)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TOTPURI) > 0 {
+		i -= len(m.TOTPURI)
+		copy(dAtA[i:], m.TOTPURI)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.TOTPURI)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TOTPSecret) > 0 {
+		i -= len(m.TOTPSecret)
+		copy(dAtA[i:], m.TOTPSecret)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.TOTPSecret)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedUpdateUserAPIKeyRequest(r randyUser, easy bool) *UpdateUserAPIKeyRequest {
-	this := &UpdateUserAPIKeyRequest{}
-	v19 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v19
-	v20 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v20
-	if !easy && r.Intn(10) != 0 {
+func (m *FinishMFAEnrollmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedInvitation(r randyUser, easy bool) *Invitation {
-	this := &Invitation{}
-	this.Email = randStringUser(r)
-	this.Token = randStringUser(r)
-	v21 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ExpiresAt = *v21
-	v22 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v22
-	v23 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v23
-	if r.Intn(5) != 0 {
-		this.AcceptedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+func (m *FinishMFAEnrollmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinishMFAEnrollmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WebAuthnClientDataJSON) > 0 {
+		i -= len(m.WebAuthnClientDataJSON)
+		copy(dAtA[i:], m.WebAuthnClientDataJSON)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.WebAuthnClientDataJSON)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if r.Intn(5) != 0 {
-		this.AcceptedBy = NewPopulatedUserIdentifiers(r, easy)
+	if len(m.WebAuthnAttestationObject) > 0 {
+		i -= len(m.WebAuthnAttestationObject)
+		copy(dAtA[i:], m.WebAuthnAttestationObject)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.WebAuthnAttestationObject)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if !easy && r.Intn(10) != 0 {
+	if len(m.TOTPCode) > 0 {
+		i -= len(m.TOTPCode)
+		copy(dAtA[i:], m.TOTPCode)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.TOTPCode)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return this
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintUser(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.UserIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUser(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedListInvitationsRequest(r randyUser, easy bool) *ListInvitationsRequest {
-	this := &ListInvitationsRequest{}
-	this.Limit = r.Uint32()
-	this.Page = r.Uint32()
+func (m *RecoveryCodes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryCodes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryCodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Codes) > 0 {
+		for iNdEx := len(m.Codes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Codes[iNdEx])
+			copy(dAtA[i:], m.Codes[iNdEx])
+			i = encodeVarintUser(dAtA, i, uint64(len(m.Codes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUser(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUser(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedUser(r randyUser, easy bool) *User {
+	this := &User{}
+	v1 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v1
+	v2 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v2
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v3
+	this.Name = randStringUser(r)
+	this.Description = randStringUser(r)
+	if r.Intn(5) != 0 {
+		v4 := r.Intn(10)
+		this.Attributes = make(map[string]string)
+		for i := 0; i < v4; i++ {
+			this.Attributes[randStringUser(r)] = randStringUser(r)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v5 := r.Intn(5)
+		this.ContactInfo = make([]*ContactInfo, v5)
+		for i := 0; i < v5; i++ {
+			this.ContactInfo[i] = NewPopulatedContactInfo(r, easy)
+		}
+	}
+	this.PrimaryEmailAddress = randStringUser(r)
+	if r.Intn(5) != 0 {
+		this.PrimaryEmailAddressValidatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.Password = randStringUser(r)
+	if r.Intn(5) != 0 {
+		this.PasswordUpdatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.RequirePasswordUpdate = bool(r.Intn(2) == 0)
+	this.State = State([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.Admin = bool(r.Intn(2) == 0)
+	this.TemporaryPassword = randStringUser(r)
+	if r.Intn(5) != 0 {
+		this.TemporaryPasswordCreatedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.TemporaryPasswordExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.ProfilePicture = NewPopulatedPicture(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedInvitations(r randyUser, easy bool) *Invitations {
-	this := &Invitations{}
+func NewPopulatedUsers(r randyUser, easy bool) *Users {
+	this := &Users{}
 	if r.Intn(5) != 0 {
-		v24 := r.Intn(5)
-		this.Invitations = make([]*Invitation, v24)
-		for i := 0; i < v24; i++ {
-			this.Invitations[i] = NewPopulatedInvitation(r, easy)
+		v6 := r.Intn(5)
+		this.Users = make([]*User, v6)
+		for i := 0; i < v6; i++ {
+			this.Users[i] = NewPopulatedUser(r, easy)
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -3462,68 +4602,76 @@ func NewPopulatedInvitations(r randyUser, easy bool) *Invitations {
 	return this
 }
 
-func NewPopulatedSendInvitationRequest(r randyUser, easy bool) *SendInvitationRequest {
-	this := &SendInvitationRequest{}
-	this.Email = randStringUser(r)
+func NewPopulatedGetUserRequest(r randyUser, easy bool) *GetUserRequest {
+	this := &GetUserRequest{}
+	v7 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v7
+	v8 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v8
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedDeleteInvitationRequest(r randyUser, easy bool) *DeleteInvitationRequest {
-	this := &DeleteInvitationRequest{}
-	this.Email = randStringUser(r)
+func NewPopulatedListUsersRequest(r randyUser, easy bool) *ListUsersRequest {
+	this := &ListUsersRequest{}
+	v9 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v9
+	this.Order = randStringUser(r)
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	this.Deleted = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedUserSessionIdentifiers(r randyUser, easy bool) *UserSessionIdentifiers {
-	this := &UserSessionIdentifiers{}
-	v25 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v25
-	this.SessionID = randStringUser(r)
+func NewPopulatedCreateUserRequest(r randyUser, easy bool) *CreateUserRequest {
+	this := &CreateUserRequest{}
+	v10 := NewPopulatedUser(r, easy)
+	this.User = *v10
+	this.InvitationToken = randStringUser(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedUserSession(r randyUser, easy bool) *UserSession {
-	this := &UserSession{}
-	v26 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v26
-	this.SessionID = randStringUser(r)
-	v27 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v27
-	v28 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v28
-	if r.Intn(5) != 0 {
-		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	}
+func NewPopulatedUpdateUserRequest(r randyUser, easy bool) *UpdateUserRequest {
+	this := &UpdateUserRequest{}
+	v11 := NewPopulatedUser(r, easy)
+	this.User = *v11
+	v12 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v12
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedUserSessions(r randyUser, easy bool) *UserSessions {
-	this := &UserSessions{}
-	if r.Intn(5) != 0 {
-		v29 := r.Intn(5)
-		this.Sessions = make([]*UserSession, v29)
-		for i := 0; i < v29; i++ {
-			this.Sessions[i] = NewPopulatedUserSession(r, easy)
-		}
+func NewPopulatedCreateTemporaryPasswordRequest(r randyUser, easy bool) *CreateTemporaryPasswordRequest {
+	this := &CreateTemporaryPasswordRequest{}
+	v13 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v13
+	if !easy && r.Intn(10) != 0 {
 	}
+	return this
+}
+
+func NewPopulatedUpdateUserPasswordRequest(r randyUser, easy bool) *UpdateUserPasswordRequest {
+	this := &UpdateUserPasswordRequest{}
+	v14 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v14
+	this.New = randStringUser(r)
+	this.Old = randStringUser(r)
+	this.RevokeAllAccess = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedListUserSessionsRequest(r randyUser, easy bool) *ListUserSessionsRequest {
-	this := &ListUserSessionsRequest{}
-	v30 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v30
-	this.Order = randStringUser(r)
+func NewPopulatedListUserAPIKeysRequest(r randyUser, easy bool) *ListUserAPIKeysRequest {
+	this := &ListUserAPIKeysRequest{}
+	v15 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v15
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -3531,12 +4679,290 @@ func NewPopulatedListUserSessionsRequest(r randyUser, easy bool) *ListUserSessio
 	return this
 }
 
-type randyUser interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
+func NewPopulatedGetUserAPIKeyRequest(r randyUser, easy bool) *GetUserAPIKeyRequest {
+	this := &GetUserAPIKeyRequest{}
+	v16 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v16
+	this.KeyID = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateUserAPIKeyRequest(r randyUser, easy bool) *CreateUserAPIKeyRequest {
+	this := &CreateUserAPIKeyRequest{}
+	v17 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v17
+	this.Name = randStringUser(r)
+	v18 := r.Intn(10)
+	this.Rights = make([]Right, v18)
+	for i := 0; i < v18; i++ {
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
+	}
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v19 := r.Intn(10)
+	this.AllowedIPRanges = make([]string, v19)
+	for i := 0; i < v19; i++ {
+		this.AllowedIPRanges[i] = randStringUser(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUpdateUserAPIKeyRequest(r randyUser, easy bool) *UpdateUserAPIKeyRequest {
+	this := &UpdateUserAPIKeyRequest{}
+	v20 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v20
+	v21 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v21
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedInvitation(r randyUser, easy bool) *Invitation {
+	this := &Invitation{}
+	this.Email = randStringUser(r)
+	this.Token = randStringUser(r)
+	v22 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ExpiresAt = *v22
+	v23 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v23
+	v24 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v24
+	if r.Intn(5) != 0 {
+		this.AcceptedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.AcceptedBy = NewPopulatedUserIdentifiers(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListInvitationsRequest(r randyUser, easy bool) *ListInvitationsRequest {
+	this := &ListInvitationsRequest{}
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedInvitations(r randyUser, easy bool) *Invitations {
+	this := &Invitations{}
+	if r.Intn(5) != 0 {
+		v25 := r.Intn(5)
+		this.Invitations = make([]*Invitation, v25)
+		for i := 0; i < v25; i++ {
+			this.Invitations[i] = NewPopulatedInvitation(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSendInvitationRequest(r randyUser, easy bool) *SendInvitationRequest {
+	this := &SendInvitationRequest{}
+	this.Email = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteInvitationRequest(r randyUser, easy bool) *DeleteInvitationRequest {
+	this := &DeleteInvitationRequest{}
+	this.Email = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUserSessionIdentifiers(r randyUser, easy bool) *UserSessionIdentifiers {
+	this := &UserSessionIdentifiers{}
+	v26 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v26
+	this.SessionID = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUserSession(r randyUser, easy bool) *UserSession {
+	this := &UserSession{}
+	v27 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v27
+	this.SessionID = randStringUser(r)
+	v28 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v28
+	v29 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v29
+	if r.Intn(5) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUserSessions(r randyUser, easy bool) *UserSessions {
+	this := &UserSessions{}
+	if r.Intn(5) != 0 {
+		v30 := r.Intn(5)
+		this.Sessions = make([]*UserSession, v30)
+		for i := 0; i < v30; i++ {
+			this.Sessions[i] = NewPopulatedUserSession(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListUserSessionsRequest(r randyUser, easy bool) *ListUserSessionsRequest {
+	this := &ListUserSessionsRequest{}
+	v31 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v31
+	this.Order = randStringUser(r)
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMFACredential(r randyUser, easy bool) *MFACredential {
+	this := &MFACredential{}
+	v32 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v32
+	this.ID = randStringUser(r)
+	this.Type = MFACredentialType([]int32{0, 1, 2}[r.Intn(3)])
+	this.Name = randStringUser(r)
+	v33 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v33
+	v34 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v34
+	if r.Intn(5) != 0 {
+		this.LastUsedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.Confirmed = bool(r.Intn(2) == 0)
+	v35 := r.Intn(100)
+	this.Secret = make([]byte, v35)
+	for i := 0; i < v35; i++ {
+		this.Secret[i] = byte(r.Intn(256))
+	}
+	v36 := r.Intn(100)
+	this.WebAuthnCredentialID = make([]byte, v36)
+	for i := 0; i < v36; i++ {
+		this.WebAuthnCredentialID[i] = byte(r.Intn(256))
+	}
+	this.SignCount = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMFACredentials(r randyUser, easy bool) *MFACredentials {
+	this := &MFACredentials{}
+	if r.Intn(5) != 0 {
+		v37 := r.Intn(5)
+		this.Credentials = make([]*MFACredential, v37)
+		for i := 0; i < v37; i++ {
+			this.Credentials[i] = NewPopulatedMFACredential(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMFACredentialIdentifiers(r randyUser, easy bool) *MFACredentialIdentifiers {
+	this := &MFACredentialIdentifiers{}
+	v38 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v38
+	this.ID = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBeginMFAEnrollmentRequest(r randyUser, easy bool) *BeginMFAEnrollmentRequest {
+	this := &BeginMFAEnrollmentRequest{}
+	v39 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v39
+	this.Type = MFACredentialType([]int32{0, 1, 2}[r.Intn(3)])
+	this.Name = randStringUser(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMFAEnrollment(r randyUser, easy bool) *MFAEnrollment {
+	this := &MFAEnrollment{}
+	this.ID = randStringUser(r)
+	this.TOTPSecret = randStringUser(r)
+	this.TOTPURI = randStringUser(r)
+	v40 := r.Intn(100)
+	this.WebAuthnChallenge = make([]byte, v40)
+	for i := 0; i < v40; i++ {
+		this.WebAuthnChallenge[i] = byte(r.Intn(256))
+	}
+	this.WebAuthnRPID = randStringUser(r)
+	this.WebAuthnRPName = randStringUser(r)
+	v41 := r.Intn(100)
+	this.WebAuthnUserHandle = make([]byte, v41)
+	for i := 0; i < v41; i++ {
+		this.WebAuthnUserHandle[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFinishMFAEnrollmentRequest(r randyUser, easy bool) *FinishMFAEnrollmentRequest {
+	this := &FinishMFAEnrollmentRequest{}
+	v42 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v42
+	this.ID = randStringUser(r)
+	this.TOTPCode = randStringUser(r)
+	v43 := r.Intn(100)
+	this.WebAuthnAttestationObject = make([]byte, v43)
+	for i := 0; i < v43; i++ {
+		this.WebAuthnAttestationObject[i] = byte(r.Intn(256))
+	}
+	v44 := r.Intn(100)
+	this.WebAuthnClientDataJSON = make([]byte, v44)
+	for i := 0; i < v44; i++ {
+		this.WebAuthnClientDataJSON[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRecoveryCodes(r randyUser, easy bool) *RecoveryCodes {
+	this := &RecoveryCodes{}
+	v45 := r.Intn(10)
+	this.Codes = make([]string, v45)
+	for i := 0; i < v45; i++ {
+		this.Codes[i] = randStringUser(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyUser interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
 	Intn(n int) int
 }
 
@@ -3550,9 +4976,9 @@ func randUTF8RuneUser(r randyUser) rune {
 	return rune(ru + 61)
 }
 func randStringUser(r randyUser) string {
-	v31 := r.Intn(100)
-	tmps := make([]rune, v31)
-	for i := 0; i < v31; i++ {
+	v46 := r.Intn(100)
+	tmps := make([]rune, v46)
+	for i := 0; i < v46; i++ {
 		tmps[i] = randUTF8RuneUser(r)
 	}
 	return string(tmps)
@@ -3574,11 +5000,11 @@ func randFieldUser(dAtA []byte, r randyUser, fieldNumber int, wire int) []byte {
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
-		v32 := r.Int63()
+		v47 := r.Int63()
 		if r.Intn(2) == 0 {
-			v32 *= -1
+			v47 *= -1
 		}
-		dAtA = encodeVarintPopulateUser(dAtA, uint64(v32))
+		dAtA = encodeVarintPopulateUser(dAtA, uint64(v47))
 	case 1:
 		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3727,6 +5153,9 @@ func (m *ListUsersRequest) Size() (n int) {
 	if m.Page != 0 {
 		n += 1 + sovUser(uint64(m.Page))
 	}
+	if m.Deleted {
+		n += 2
+	}
 	return n
 }
 
@@ -3842,6 +5271,16 @@ func (m *CreateUserAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovUser(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if len(m.AllowedIPRanges) > 0 {
+		for _, s := range m.AllowedIPRanges {
+			l = len(s)
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4019,59 +5458,230 @@ func (m *ListUserSessionsRequest) Size() (n int) {
 	return n
 }
 
-func sovUser(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozUser(x uint64) (n int) {
-	return sovUser((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *User) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForContactInfo := "[]*ContactInfo{"
-	for _, f := range this.ContactInfo {
-		repeatedStringForContactInfo += strings.Replace(fmt.Sprintf("%v", f), "ContactInfo", "ContactInfo", 1) + ","
+func (m *MFACredential) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForContactInfo += "}"
-	keysForAttributes := make([]string, 0, len(this.Attributes))
-	for k := range this.Attributes {
-		keysForAttributes = append(keysForAttributes, k)
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
-	mapStringForAttributes := "map[string]string{"
-	for _, k := range keysForAttributes {
-		mapStringForAttributes += fmt.Sprintf("%v: %v,", k, this.Attributes[k])
+	if m.Type != 0 {
+		n += 1 + sovUser(uint64(m.Type))
 	}
-	mapStringForAttributes += "}"
-	s := strings.Join([]string{`&User{`,
-		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
-		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
-		`Attributes:` + mapStringForAttributes + `,`,
-		`ContactInfo:` + repeatedStringForContactInfo + `,`,
-		`PrimaryEmailAddress:` + fmt.Sprintf("%v", this.PrimaryEmailAddress) + `,`,
-		`PrimaryEmailAddressValidatedAt:` + strings.Replace(fmt.Sprintf("%v", this.PrimaryEmailAddressValidatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
-		`PasswordUpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.PasswordUpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`RequirePasswordUpdate:` + fmt.Sprintf("%v", this.RequirePasswordUpdate) + `,`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`Admin:` + fmt.Sprintf("%v", this.Admin) + `,`,
-		`TemporaryPassword:` + fmt.Sprintf("%v", this.TemporaryPassword) + `,`,
-		`TemporaryPasswordCreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.TemporaryPasswordCreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`TemporaryPasswordExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.TemporaryPasswordExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`ProfilePicture:` + strings.Replace(fmt.Sprintf("%v", this.ProfilePicture), "Picture", "Picture", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Users) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
 	}
-	repeatedStringForUsers := "[]*User{"
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovUser(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovUser(uint64(l))
+	if m.LastUsedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsedAt)
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.Confirmed {
+		n += 2
+	}
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.WebAuthnCredentialID)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if m.SignCount != 0 {
+		n += 1 + sovUser(uint64(m.SignCount))
+	}
+	return n
+}
+
+func (m *MFACredentials) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Credentials) > 0 {
+		for _, e := range m.Credentials {
+			l = e.Size()
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MFACredentialIdentifiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *BeginMFAEnrollmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	if m.Type != 0 {
+		n += 1 + sovUser(uint64(m.Type))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *MFAEnrollment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.TOTPSecret)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.TOTPURI)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.WebAuthnChallenge)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.WebAuthnRPID)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.WebAuthnRPName)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.WebAuthnUserHandle)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *FinishMFAEnrollmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserIdentifiers.Size()
+	n += 1 + l + sovUser(uint64(l))
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.TOTPCode)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.WebAuthnAttestationObject)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	l = len(m.WebAuthnClientDataJSON)
+	if l > 0 {
+		n += 1 + l + sovUser(uint64(l))
+	}
+	return n
+}
+
+func (m *RecoveryCodes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Codes) > 0 {
+		for _, s := range m.Codes {
+			l = len(s)
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovUser(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUser(x uint64) (n int) {
+	return sovUser((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *User) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContactInfo := "[]*ContactInfo{"
+	for _, f := range this.ContactInfo {
+		repeatedStringForContactInfo += strings.Replace(fmt.Sprintf("%v", f), "ContactInfo", "ContactInfo", 1) + ","
+	}
+	repeatedStringForContactInfo += "}"
+	keysForAttributes := make([]string, 0, len(this.Attributes))
+	for k := range this.Attributes {
+		keysForAttributes = append(keysForAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
+	mapStringForAttributes := "map[string]string{"
+	for _, k := range keysForAttributes {
+		mapStringForAttributes += fmt.Sprintf("%v: %v,", k, this.Attributes[k])
+	}
+	mapStringForAttributes += "}"
+	s := strings.Join([]string{`&User{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Attributes:` + mapStringForAttributes + `,`,
+		`ContactInfo:` + repeatedStringForContactInfo + `,`,
+		`PrimaryEmailAddress:` + fmt.Sprintf("%v", this.PrimaryEmailAddress) + `,`,
+		`PrimaryEmailAddressValidatedAt:` + strings.Replace(fmt.Sprintf("%v", this.PrimaryEmailAddressValidatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`PasswordUpdatedAt:` + strings.Replace(fmt.Sprintf("%v", this.PasswordUpdatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`RequirePasswordUpdate:` + fmt.Sprintf("%v", this.RequirePasswordUpdate) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Admin:` + fmt.Sprintf("%v", this.Admin) + `,`,
+		`TemporaryPassword:` + fmt.Sprintf("%v", this.TemporaryPassword) + `,`,
+		`TemporaryPasswordCreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.TemporaryPasswordCreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`TemporaryPasswordExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.TemporaryPasswordExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ProfilePicture:` + strings.Replace(fmt.Sprintf("%v", this.ProfilePicture), "Picture", "Picture", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Users) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUsers := "[]*User{"
 	for _, f := range this.Users {
 		repeatedStringForUsers += strings.Replace(f.String(), "User", "User", 1) + ","
 	}
@@ -4102,6 +5712,7 @@ func (this *ListUsersRequest) String() string {
 		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4182,6 +5793,8 @@ func (this *CreateUserAPIKeyRequest) String() string {
 		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedIPRanges:` + fmt.Sprintf("%v", this.AllowedIPRanges) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4312,6 +5925,104 @@ func (this *ListUserSessionsRequest) String() string {
 	}, "")
 	return s
 }
+func (this *MFACredential) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MFACredential{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`LastUsedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastUsedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Confirmed:` + fmt.Sprintf("%v", this.Confirmed) + `,`,
+		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
+		`WebAuthnCredentialID:` + fmt.Sprintf("%v", this.WebAuthnCredentialID) + `,`,
+		`SignCount:` + fmt.Sprintf("%v", this.SignCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MFACredentials) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCredentials := "[]*MFACredential{"
+	for _, f := range this.Credentials {
+		repeatedStringForCredentials += strings.Replace(f.String(), "MFACredential", "MFACredential", 1) + ","
+	}
+	repeatedStringForCredentials += "}"
+	s := strings.Join([]string{`&MFACredentials{`,
+		`Credentials:` + repeatedStringForCredentials + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MFACredentialIdentifiers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MFACredentialIdentifiers{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BeginMFAEnrollmentRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BeginMFAEnrollmentRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MFAEnrollment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MFAEnrollment{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`TOTPSecret:` + fmt.Sprintf("%v", this.TOTPSecret) + `,`,
+		`TOTPURI:` + fmt.Sprintf("%v", this.TOTPURI) + `,`,
+		`WebAuthnChallenge:` + fmt.Sprintf("%v", this.WebAuthnChallenge) + `,`,
+		`WebAuthnRPID:` + fmt.Sprintf("%v", this.WebAuthnRPID) + `,`,
+		`WebAuthnRPName:` + fmt.Sprintf("%v", this.WebAuthnRPName) + `,`,
+		`WebAuthnUserHandle:` + fmt.Sprintf("%v", this.WebAuthnUserHandle) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FinishMFAEnrollmentRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FinishMFAEnrollmentRequest{`,
+		`UserIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UserIdentifiers), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`TOTPCode:` + fmt.Sprintf("%v", this.TOTPCode) + `,`,
+		`WebAuthnAttestationObject:` + fmt.Sprintf("%v", this.WebAuthnAttestationObject) + `,`,
+		`WebAuthnClientDataJSON:` + fmt.Sprintf("%v", this.WebAuthnClientDataJSON) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecoveryCodes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecoveryCodes{`,
+		`Codes:` + fmt.Sprintf("%v", this.Codes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringUser(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -4346,7 +6057,1338 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: User: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUser
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUser
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthUser
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthUser
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUser
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthUser
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthUser
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipUser(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthUser
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContactInfo = append(m.ContactInfo, &ContactInfo{})
+			if err := m.ContactInfo[len(m.ContactInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryEmailAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryEmailAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryEmailAddressValidatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrimaryEmailAddressValidatedAt == nil {
+				m.PrimaryEmailAddressValidatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PrimaryEmailAddressValidatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordUpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PasswordUpdatedAt == nil {
+				m.PasswordUpdatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PasswordUpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequirePasswordUpdate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequirePasswordUpdate = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Admin = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemporaryPassword", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemporaryPassword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemporaryPasswordCreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemporaryPasswordCreatedAt == nil {
+				m.TemporaryPasswordCreatedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TemporaryPasswordCreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemporaryPasswordExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemporaryPasswordExpiresAt == nil {
+				m.TemporaryPasswordExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TemporaryPasswordExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfilePicture", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProfilePicture == nil {
+				m.ProfilePicture = &Picture{}
+			}
+			if err := m.ProfilePicture.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Users) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Users: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, &User{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListUsersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListUsersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitationToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvitationToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTemporaryPasswordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTemporaryPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4382,9 +7424,62 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateUserPasswordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateUserPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4411,15 +7506,15 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -4429,28 +7524,27 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.New = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Old", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4478,13 +7572,13 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Old = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokeAllAccess", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -4494,27 +7588,68 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.RevokeAllAccess = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthUser
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListUserAPIKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListUserAPIKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListUserAPIKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4534,114 +7669,111 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Attributes == nil {
-				m.Attributes = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowUser
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowUser
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthUser
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthUser
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowUser
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthUser
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthUser
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipUser(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthUser
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Attributes[mapkey] = mapvalue
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUserAPIKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUserAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4668,14 +7800,13 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContactInfo = append(m.ContactInfo, &ContactInfo{})
-			if err := m.ContactInfo[len(m.ContactInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryEmailAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4703,11 +7834,64 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimaryEmailAddress = string(dAtA[iNdEx:postIndex])
+			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUser
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateUserAPIKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateUserAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryEmailAddressValidatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4734,16 +7918,13 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrimaryEmailAddressValidatedAt == nil {
-				m.PrimaryEmailAddressValidatedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PrimaryEmailAddressValidatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4771,11 +7952,80 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
+			if wireType == 0 {
+				var v Right
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUser
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Right(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Rights = append(m.Rights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowUser
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthUser
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthUser
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Rights) == 0 {
+					m.Rights = make([]Right, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Right
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowUser
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Right(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Rights = append(m.Rights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordUpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4802,75 +8052,16 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordUpdatedAt == nil {
-				m.PasswordUpdatedAt = new(time.Time)
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.PasswordUpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequirePasswordUpdate", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequirePasswordUpdate = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= State(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Admin = bool(v != 0)
-		case 15:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemporaryPassword", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedIPRanges", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4898,47 +8089,64 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemporaryPassword = string(dAtA[iNdEx:postIndex])
+			m.AllowedIPRanges = append(m.AllowedIPRanges, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemporaryPasswordCreatedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUser(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthUser
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemporaryPasswordCreatedAt == nil {
-				m.TemporaryPasswordCreatedAt = new(time.Time)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUser
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TemporaryPasswordCreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 17:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateUserAPIKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateUserAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemporaryPasswordExpiresAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4965,16 +8173,13 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemporaryPasswordExpiresAt == nil {
-				m.TemporaryPasswordExpiresAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TemporaryPasswordExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProfilePicture", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5001,10 +8206,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProfilePicture == nil {
-				m.ProfilePicture = &Picture{}
-			}
-			if err := m.ProfilePicture.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5032,7 +8234,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Users) Unmarshal(dAtA []byte) error {
+func (m *Invitation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5055,15 +8257,112 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Users: wiretype end group for non-group")
+			return fmt.Errorf("proto: Invitation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Users: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Invitation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5090,67 +8389,46 @@ func (m *Users) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Users = append(m.Users, &User{})
-			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipUser(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthUser
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthUser
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowUser
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetUserRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5177,13 +8455,16 @@ func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AcceptedAt == nil {
+				m.AcceptedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AcceptedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedBy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5210,7 +8491,10 @@ func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AcceptedBy == nil {
+				m.AcceptedBy = &UserIdentifiers{}
+			}
+			if err := m.AcceptedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5238,7 +8522,7 @@ func (m *GetUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
+func (m *ListInvitationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5261,78 +8545,13 @@ func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListUsersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListInvitationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUsersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListInvitationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Order = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
@@ -5351,7 +8570,7 @@ func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
@@ -5394,7 +8613,7 @@ func (m *ListUsersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
+func (m *Invitations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5417,15 +8636,15 @@ func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Invitations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Invitations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invitations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5452,42 +8671,11 @@ func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Invitations = append(m.Invitations, &Invitation{})
+			if err := m.Invitations[len(m.Invitations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvitationToken", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InvitationToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -5512,7 +8700,7 @@ func (m *CreateUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
+func (m *SendInvitationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5535,50 +8723,17 @@ func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateUserRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SendInvitationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SendInvitationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.User.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5588,24 +8743,23 @@ func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5631,7 +8785,7 @@ func (m *UpdateUserRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteInvitationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5654,17 +8808,17 @@ func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTemporaryPasswordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteInvitationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTemporaryPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteInvitationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5674,24 +8828,23 @@ func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Email = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5717,7 +8870,7 @@ func (m *CreateTemporaryPasswordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
+func (m *UserSessionIdentifiers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5740,10 +8893,10 @@ func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateUserPasswordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserSessionIdentifiers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateUserPasswordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserSessionIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5781,39 +8934,7 @@ func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field New", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.New = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Old", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5841,28 +8962,8 @@ func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Old = string(dAtA[iNdEx:postIndex])
+			m.SessionID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevokeAllAccess", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RevokeAllAccess = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -5887,7 +8988,7 @@ func (m *UpdateUserPasswordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListUserAPIKeysRequest) Unmarshal(dAtA []byte) error {
+func (m *UserSession) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5910,10 +9011,10 @@ func (m *ListUserAPIKeysRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListUserAPIKeysRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserSession: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUserAPIKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserSession: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5950,10 +9051,10 @@ func (m *ListUserAPIKeysRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5963,16 +9064,29 @@ func (m *ListUserAPIKeysRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			m.Page = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -5982,11 +9096,94 @@ func (m *ListUserAPIKeysRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -6011,7 +9208,7 @@ func (m *ListUserAPIKeysRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *UserSessions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6034,15 +9231,15 @@ func (m *GetUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetUserAPIKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserSessions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetUserAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserSessions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6069,41 +9266,10 @@ func (m *GetUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Sessions = append(m.Sessions, &UserSession{})
+			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6129,7 +9295,7 @@ func (m *GetUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *ListUserSessionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6152,10 +9318,10 @@ func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateUserAPIKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListUserSessionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateUserAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListUserSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6193,7 +9359,7 @@ func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6221,76 +9387,45 @@ func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Order = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v Right
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowUser
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Right(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
 				}
-				m.Rights = append(m.Rights, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowUser
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthUser
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthUser
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Rights) == 0 {
-					m.Rights = make([]Right, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Right
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowUser
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Right(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Rights = append(m.Rights, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -6316,7 +9451,7 @@ func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *MFACredential) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6339,10 +9474,10 @@ func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateUserAPIKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MFACredential: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateUserAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MFACredential: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6380,9 +9515,9 @@ func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -6392,83 +9527,29 @@ func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipUser(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthUser
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthUser
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Invitation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowUser
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Invitation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Invitation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -6478,27 +9559,14 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= MFACredentialType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Email = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6526,11 +9594,11 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6557,13 +9625,13 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6590,13 +9658,13 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6623,15 +9691,38 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastUsedAt == nil {
+				m.LastUsedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUsedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Confirmed = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -6641,33 +9732,31 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AcceptedAt == nil {
-				m.AcceptedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.AcceptedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Secret = append(m.Secret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Secret == nil {
+				m.Secret = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebAuthnCredentialID", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -6677,28 +9766,45 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AcceptedBy == nil {
-				m.AcceptedBy = &UserIdentifiers{}
+			m.WebAuthnCredentialID = append(m.WebAuthnCredentialID[:0], dAtA[iNdEx:postIndex]...)
+			if m.WebAuthnCredentialID == nil {
+				m.WebAuthnCredentialID = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignCount", wireType)
 			}
-			if err := m.AcceptedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SignCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -6723,7 +9829,7 @@ func (m *Invitation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListInvitationsRequest) Unmarshal(dAtA []byte) error {
+func (m *MFACredentials) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6746,17 +9852,17 @@ func (m *ListInvitationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListInvitationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MFACredentials: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListInvitationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MFACredentials: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
 			}
-			m.Limit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -6766,30 +9872,26 @@ func (m *ListInvitationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthUser
 			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Credentials = append(m.Credentials, &MFACredential{})
+			if err := m.Credentials[len(m.Credentials)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -6814,7 +9916,7 @@ func (m *ListInvitationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Invitations) Unmarshal(dAtA []byte) error {
+func (m *MFACredentialIdentifiers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6837,15 +9939,15 @@ func (m *Invitations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Invitations: wiretype end group for non-group")
+			return fmt.Errorf("proto: MFACredentialIdentifiers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Invitations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MFACredentialIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Invitations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6872,11 +9974,42 @@ func (m *Invitations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Invitations = append(m.Invitations, &Invitation{})
-			if err := m.Invitations[len(m.Invitations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -6901,7 +10034,7 @@ func (m *Invitations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendInvitationRequest) Unmarshal(dAtA []byte) error {
+func (m *BeginMFAEnrollmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6924,15 +10057,67 @@ func (m *SendInvitationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendInvitationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BeginMFAEnrollmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendInvitationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BeginMFAEnrollmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= MFACredentialType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6960,7 +10145,7 @@ func (m *SendInvitationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6986,7 +10171,7 @@ func (m *SendInvitationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteInvitationRequest) Unmarshal(dAtA []byte) error {
+func (m *MFAEnrollment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7009,15 +10194,15 @@ func (m *DeleteInvitationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteInvitationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MFAEnrollment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteInvitationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MFAEnrollment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7045,66 +10230,111 @@ func (m *DeleteInvitationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipUser(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TOTPSecret", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UserSessionIdentifiers) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowUser
+			m.TOTPSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TOTPURI", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TOTPURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebAuthnChallenge", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UserSessionIdentifiers: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserSessionIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.WebAuthnChallenge = append(m.WebAuthnChallenge[:0], dAtA[iNdEx:postIndex]...)
+			if m.WebAuthnChallenge == nil {
+				m.WebAuthnChallenge = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebAuthnRPID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -7114,28 +10344,27 @@ func (m *UserSessionIdentifiers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WebAuthnRPID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebAuthnRPName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7163,7 +10392,41 @@ func (m *UserSessionIdentifiers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionID = string(dAtA[iNdEx:postIndex])
+			m.WebAuthnRPName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebAuthnUserHandle", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebAuthnUserHandle = append(m.WebAuthnUserHandle[:0], dAtA[iNdEx:postIndex]...)
+			if m.WebAuthnUserHandle == nil {
+				m.WebAuthnUserHandle = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7189,7 +10452,7 @@ func (m *UserSessionIdentifiers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserSession) Unmarshal(dAtA []byte) error {
+func (m *FinishMFAEnrollmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7212,10 +10475,10 @@ func (m *UserSession) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserSession: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinishMFAEnrollmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserSession: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinishMFAEnrollmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7253,7 +10516,7 @@ func (m *UserSession) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7281,13 +10544,13 @@ func (m *UserSession) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionID = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TOTPCode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -7297,30 +10560,29 @@ func (m *UserSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TOTPCode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebAuthnAttestationObject", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -7330,119 +10592,31 @@ func (m *UserSession) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.WebAuthnAttestationObject = append(m.WebAuthnAttestationObject[:0], dAtA[iNdEx:postIndex]...)
+			if m.WebAuthnAttestationObject == nil {
+				m.WebAuthnAttestationObject = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExpiresAt == nil {
-				m.ExpiresAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipUser(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthUser
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthUser
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UserSessions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowUser
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UserSessions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserSessions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sessions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WebAuthnClientDataJSON", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowUser
@@ -7452,24 +10626,24 @@ func (m *UserSessions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthUser
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthUser
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sessions = append(m.Sessions, &UserSession{})
-			if err := m.Sessions[len(m.Sessions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.WebAuthnClientDataJSON = append(m.WebAuthnClientDataJSON[:0], dAtA[iNdEx:postIndex]...)
+			if m.WebAuthnClientDataJSON == nil {
+				m.WebAuthnClientDataJSON = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -7496,7 +10670,7 @@ func (m *UserSessions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListUserSessionsRequest) Unmarshal(dAtA []byte) error {
+func (m *RecoveryCodes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7519,48 +10693,15 @@ func (m *ListUserSessionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListUserSessionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecoveryCodes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUserSessionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecoveryCodes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserIdentifiers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthUser
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthUser
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.UserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7588,46 +10729,8 @@ func (m *ListUserSessionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Order = string(dAtA[iNdEx:postIndex])
+			m.Codes = append(m.Codes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
-			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowUser
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -68,6 +68,7 @@ var GetUserRequestFieldPathsTopLevel = []string{
 	"user_ids",
 }
 var ListUsersRequestFieldPathsNested = []string{
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -75,6 +76,7 @@ var ListUsersRequestFieldPathsNested = []string{
 }
 
 var ListUsersRequestFieldPathsTopLevel = []string{
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -195,6 +197,8 @@ var GetUserAPIKeyRequestFieldPathsTopLevel = []string{
 	"user_ids",
 }
 var CreateUserAPIKeyRequestFieldPathsNested = []string{
+	"allowed_ip_ranges",
+	"expires_at",
 	"name",
 	"rights",
 	"user_ids",
@@ -203,12 +207,16 @@ var CreateUserAPIKeyRequestFieldPathsNested = []string{
 }
 
 var CreateUserAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_ip_ranges",
+	"expires_at",
 	"name",
 	"rights",
 	"user_ids",
 }
 var UpdateUserAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_ip_ranges",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
 	"api_key.name",
@@ -323,3 +331,113 @@ var ListUserSessionsRequestFieldPathsTopLevel = []string{
 	"page",
 	"user_ids",
 }
+
+var MFACredentialFieldPathsNested = []string{
+	"confirmed",
+	"created_at",
+	"id",
+	"last_used_at",
+	"name",
+	"secret",
+	"sign_count",
+	"type",
+	"updated_at",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+	"webauthn_credential_id",
+}
+
+var MFACredentialFieldPathsTopLevel = []string{
+	"confirmed",
+	"created_at",
+	"id",
+	"last_used_at",
+	"name",
+	"secret",
+	"sign_count",
+	"type",
+	"updated_at",
+	"user_ids",
+	"webauthn_credential_id",
+}
+
+var MFACredentialsFieldPathsNested = []string{
+	"credentials",
+}
+
+var MFACredentialsFieldPathsTopLevel = []string{
+	"credentials",
+}
+
+var MFACredentialIdentifiersFieldPathsNested = []string{
+	"id",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var MFACredentialIdentifiersFieldPathsTopLevel = []string{
+	"id",
+	"user_ids",
+}
+
+var BeginMFAEnrollmentRequestFieldPathsNested = []string{
+	"name",
+	"type",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var BeginMFAEnrollmentRequestFieldPathsTopLevel = []string{
+	"name",
+	"type",
+	"user_ids",
+}
+
+var MFAEnrollmentFieldPathsNested = []string{
+	"id",
+	"totp_secret",
+	"totp_uri",
+	"webauthn_challenge",
+	"webauthn_rp_id",
+	"webauthn_rp_name",
+	"webauthn_user_handle",
+}
+
+var MFAEnrollmentFieldPathsTopLevel = []string{
+	"id",
+	"totp_secret",
+	"totp_uri",
+	"webauthn_challenge",
+	"webauthn_rp_id",
+	"webauthn_rp_name",
+	"webauthn_user_handle",
+}
+
+var FinishMFAEnrollmentRequestFieldPathsNested = []string{
+	"id",
+	"totp_code",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+	"webauthn_attestation_object",
+	"webauthn_client_data_json",
+}
+
+var FinishMFAEnrollmentRequestFieldPathsTopLevel = []string{
+	"id",
+	"totp_code",
+	"user_ids",
+	"webauthn_attestation_object",
+	"webauthn_client_data_json",
+}
+
+var RecoveryCodesFieldPathsNested = []string{
+	"codes",
+}
+
+var RecoveryCodesFieldPathsTopLevel = []string{
+	"codes",
+}
@@ -319,6 +319,16 @@ func (dst *ListUsersRequest) SetFields(src *ListUsersRequest, paths ...string) e
 				var zero uint32
 				dst.Page = zero
 			}
+		case "deleted":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deleted' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Deleted = src.Deleted
+			} else {
+				var zero bool
+				dst.Deleted = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -621,6 +631,24 @@ func (dst *CreateUserAPIKeyRequest) SetFields(src *CreateUserAPIKeyRequest, path
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "allowed_ip_ranges":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allowed_ip_ranges' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowedIPRanges = src.AllowedIPRanges
+			} else {
+				dst.AllowedIPRanges = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1049,3 +1077,403 @@ func (dst *ListUserSessionsRequest) SetFields(src *ListUserSessionsRequest, path
 	}
 	return nil
 }
+
+func (dst *MFACredential) SetFields(src *MFACredential, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIdentifiers
+				}
+				newDst = &dst.UserIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIdentifiers = src.UserIdentifiers
+				} else {
+					var zero UserIdentifiers
+					dst.UserIdentifiers = zero
+				}
+			}
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ID = src.ID
+			} else {
+				var zero string
+				dst.ID = zero
+			}
+		case "type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Type = src.Type
+			} else {
+				var zero MFACredentialType
+				dst.Type = zero
+			}
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				var zero time.Time
+				dst.CreatedAt = zero
+			}
+		case "updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAt = src.UpdatedAt
+			} else {
+				var zero time.Time
+				dst.UpdatedAt = zero
+			}
+		case "last_used_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_used_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastUsedAt = src.LastUsedAt
+			} else {
+				dst.LastUsedAt = nil
+			}
+		case "confirmed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'confirmed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Confirmed = src.Confirmed
+			} else {
+				var zero bool
+				dst.Confirmed = zero
+			}
+		case "secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Secret = src.Secret
+			} else {
+				dst.Secret = nil
+			}
+		case "webauthn_credential_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'webauthn_credential_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WebAuthnCredentialID = src.WebAuthnCredentialID
+			} else {
+				dst.WebAuthnCredentialID = nil
+			}
+		case "sign_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sign_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SignCount = src.SignCount
+			} else {
+				var zero uint32
+				dst.SignCount = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MFACredentials) SetFields(src *MFACredentials, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "credentials":
+			if len(subs) > 0 {
+				return fmt.Errorf("'credentials' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Credentials = src.Credentials
+			} else {
+				dst.Credentials = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MFACredentialIdentifiers) SetFields(src *MFACredentialIdentifiers, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIdentifiers
+				}
+				newDst = &dst.UserIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIdentifiers = src.UserIdentifiers
+				} else {
+					var zero UserIdentifiers
+					dst.UserIdentifiers = zero
+				}
+			}
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ID = src.ID
+			} else {
+				var zero string
+				dst.ID = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *BeginMFAEnrollmentRequest) SetFields(src *BeginMFAEnrollmentRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIdentifiers
+				}
+				newDst = &dst.UserIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIdentifiers = src.UserIdentifiers
+				} else {
+					var zero UserIdentifiers
+					dst.UserIdentifiers = zero
+				}
+			}
+		case "type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Type = src.Type
+			} else {
+				var zero MFACredentialType
+				dst.Type = zero
+			}
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MFAEnrollment) SetFields(src *MFAEnrollment, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ID = src.ID
+			} else {
+				var zero string
+				dst.ID = zero
+			}
+		case "totp_secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'totp_secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TOTPSecret = src.TOTPSecret
+			} else {
+				var zero string
+				dst.TOTPSecret = zero
+			}
+		case "totp_uri":
+			if len(subs) > 0 {
+				return fmt.Errorf("'totp_uri' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TOTPURI = src.TOTPURI
+			} else {
+				var zero string
+				dst.TOTPURI = zero
+			}
+		case "webauthn_challenge":
+			if len(subs) > 0 {
+				return fmt.Errorf("'webauthn_challenge' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WebAuthnChallenge = src.WebAuthnChallenge
+			} else {
+				dst.WebAuthnChallenge = nil
+			}
+		case "webauthn_rp_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'webauthn_rp_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WebAuthnRPID = src.WebAuthnRPID
+			} else {
+				var zero string
+				dst.WebAuthnRPID = zero
+			}
+		case "webauthn_rp_name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'webauthn_rp_name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WebAuthnRPName = src.WebAuthnRPName
+			} else {
+				var zero string
+				dst.WebAuthnRPName = zero
+			}
+		case "webauthn_user_handle":
+			if len(subs) > 0 {
+				return fmt.Errorf("'webauthn_user_handle' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WebAuthnUserHandle = src.WebAuthnUserHandle
+			} else {
+				dst.WebAuthnUserHandle = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *FinishMFAEnrollmentRequest) SetFields(src *FinishMFAEnrollmentRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = &src.UserIdentifiers
+				}
+				newDst = &dst.UserIdentifiers
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIdentifiers = src.UserIdentifiers
+				} else {
+					var zero UserIdentifiers
+					dst.UserIdentifiers = zero
+				}
+			}
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ID = src.ID
+			} else {
+				var zero string
+				dst.ID = zero
+			}
+		case "totp_code":
+			if len(subs) > 0 {
+				return fmt.Errorf("'totp_code' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TOTPCode = src.TOTPCode
+			} else {
+				var zero string
+				dst.TOTPCode = zero
+			}
+		case "webauthn_attestation_object":
+			if len(subs) > 0 {
+				return fmt.Errorf("'webauthn_attestation_object' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WebAuthnAttestationObject = src.WebAuthnAttestationObject
+			} else {
+				dst.WebAuthnAttestationObject = nil
+			}
+		case "webauthn_client_data_json":
+			if len(subs) > 0 {
+				return fmt.Errorf("'webauthn_client_data_json' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WebAuthnClientDataJSON = src.WebAuthnClientDataJSON
+			} else {
+				dst.WebAuthnClientDataJSON = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RecoveryCodes) SetFields(src *RecoveryCodes, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "codes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'codes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Codes = src.Codes
+			} else {
+				dst.Codes = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -591,6 +591,8 @@ func (m *ListUsersRequest) ValidateFields(paths ...string) error {
 
 		case "page":
 			// no validation rules for Page
+		case "deleted":
+			// no validation rules for Deleted
 		default:
 			return ListUsersRequestValidationError{
 				field:  name,
@@ -1314,6 +1316,27 @@ func (m *CreateUserAPIKeyRequest) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateUserAPIKeyRequestValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_ip_ranges":
+
+			if len(m.GetAllowedIPRanges()) > 20 {
+				return CreateUserAPIKeyRequestValidationError{
+					field:  "allowed_ip_ranges",
+					reason: "value must contain no more than 20 item(s)",
+				}
+			}
+
 		default:
 			return CreateUserAPIKeyRequestValidationError{
 				field:  name,
@@ -2608,3 +2631,786 @@ var _ListUserSessionsRequest_Order_InLookup = map[string]struct{}{
 	"created_at":  {},
 	"-created_at": {},
 }
+
+// ValidateFields checks the field values on MFACredential with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MFACredential) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MFACredentialFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MFACredentialValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "id":
+
+			if utf8.RuneCountInString(m.GetID()) > 64 {
+				return MFACredentialValidationError{
+					field:  "id",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "type":
+
+			if _, ok := MFACredentialType_name[int32(m.GetType())]; !ok {
+				return MFACredentialValidationError{
+					field:  "type",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "name":
+
+			if utf8.RuneCountInString(m.GetName()) > 50 {
+				return MFACredentialValidationError{
+					field:  "name",
+					reason: "value length must be at most 50 runes",
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(&m.CreatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MFACredentialValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "updated_at":
+
+			if v, ok := interface{}(&m.UpdatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MFACredentialValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_used_at":
+
+			if v, ok := interface{}(m.GetLastUsedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MFACredentialValidationError{
+						field:  "last_used_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "confirmed":
+			// no validation rules for Confirmed
+		case "secret":
+			// no validation rules for Secret
+		case "webauthn_credential_id":
+			// no validation rules for WebAuthnCredentialID
+		case "sign_count":
+			// no validation rules for SignCount
+		default:
+			return MFACredentialValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MFACredentialValidationError is the validation error returned by
+// MFACredential.ValidateFields if the designated constraints aren't met.
+type MFACredentialValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MFACredentialValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MFACredentialValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MFACredentialValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MFACredentialValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MFACredentialValidationError) ErrorName() string {
+	return "MFACredentialValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MFACredentialValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMFACredential.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MFACredentialValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MFACredentialValidationError{}
+
+// ValidateFields checks the field values on MFACredentials with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MFACredentials) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MFACredentialsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "credentials":
+
+			for idx, item := range m.GetCredentials() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MFACredentialsValidationError{
+							field:  fmt.Sprintf("credentials[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return MFACredentialsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MFACredentialsValidationError is the validation error returned by
+// MFACredentials.ValidateFields if the designated constraints aren't met.
+type MFACredentialsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MFACredentialsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MFACredentialsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MFACredentialsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MFACredentialsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MFACredentialsValidationError) ErrorName() string {
+	return "MFACredentialsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MFACredentialsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMFACredentials.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MFACredentialsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MFACredentialsValidationError{}
+
+// ValidateFields checks the field values on MFACredentialIdentifiers with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MFACredentialIdentifiers) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MFACredentialIdentifiersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MFACredentialIdentifiersValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "id":
+
+			if utf8.RuneCountInString(m.GetID()) > 64 {
+				return MFACredentialIdentifiersValidationError{
+					field:  "id",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		default:
+			return MFACredentialIdentifiersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MFACredentialIdentifiersValidationError is the validation error returned by
+// MFACredentialIdentifiers.ValidateFields if the designated constraints
+// aren't met.
+type MFACredentialIdentifiersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MFACredentialIdentifiersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MFACredentialIdentifiersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MFACredentialIdentifiersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MFACredentialIdentifiersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MFACredentialIdentifiersValidationError) ErrorName() string {
+	return "MFACredentialIdentifiersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MFACredentialIdentifiersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMFACredentialIdentifiers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MFACredentialIdentifiersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MFACredentialIdentifiersValidationError{}
+
+// ValidateFields checks the field values on BeginMFAEnrollmentRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *BeginMFAEnrollmentRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = BeginMFAEnrollmentRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BeginMFAEnrollmentRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "type":
+
+			if _, ok := _BeginMFAEnrollmentRequest_Type_InLookup[m.GetType()]; !ok {
+				return BeginMFAEnrollmentRequestValidationError{
+					field:  "type",
+					reason: "value must be in list [0 1]",
+				}
+			}
+
+			if _, ok := MFACredentialType_name[int32(m.GetType())]; !ok {
+				return BeginMFAEnrollmentRequestValidationError{
+					field:  "type",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "name":
+
+			if utf8.RuneCountInString(m.GetName()) > 50 {
+				return BeginMFAEnrollmentRequestValidationError{
+					field:  "name",
+					reason: "value length must be at most 50 runes",
+				}
+			}
+
+		default:
+			return BeginMFAEnrollmentRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// BeginMFAEnrollmentRequestValidationError is the validation error returned
+// by BeginMFAEnrollmentRequest.ValidateFields if the designated constraints
+// aren't met.
+type BeginMFAEnrollmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BeginMFAEnrollmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BeginMFAEnrollmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BeginMFAEnrollmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BeginMFAEnrollmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BeginMFAEnrollmentRequestValidationError) ErrorName() string {
+	return "BeginMFAEnrollmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BeginMFAEnrollmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBeginMFAEnrollmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BeginMFAEnrollmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BeginMFAEnrollmentRequestValidationError{}
+
+var _BeginMFAEnrollmentRequest_Type_InLookup = map[MFACredentialType]struct{}{
+	0: {},
+	1: {},
+}
+
+// ValidateFields checks the field values on MFAEnrollment with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MFAEnrollment) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MFAEnrollmentFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "id":
+			// no validation rules for ID
+		case "totp_secret":
+			// no validation rules for TOTPSecret
+		case "totp_uri":
+			// no validation rules for TOTPURI
+		case "webauthn_challenge":
+			// no validation rules for WebAuthnChallenge
+		case "webauthn_rp_id":
+			// no validation rules for WebAuthnRPID
+		case "webauthn_rp_name":
+			// no validation rules for WebAuthnRPName
+		case "webauthn_user_handle":
+			// no validation rules for WebAuthnUserHandle
+		default:
+			return MFAEnrollmentValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MFAEnrollmentValidationError is the validation error returned by
+// MFAEnrollment.ValidateFields if the designated constraints aren't met.
+type MFAEnrollmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MFAEnrollmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MFAEnrollmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MFAEnrollmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MFAEnrollmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MFAEnrollmentValidationError) ErrorName() string {
+	return "MFAEnrollmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MFAEnrollmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMFAEnrollment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MFAEnrollmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MFAEnrollmentValidationError{}
+
+// ValidateFields checks the field values on FinishMFAEnrollmentRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *FinishMFAEnrollmentRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = FinishMFAEnrollmentRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if v, ok := interface{}(&m.UserIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return FinishMFAEnrollmentRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "id":
+
+			if utf8.RuneCountInString(m.GetID()) > 64 {
+				return FinishMFAEnrollmentRequestValidationError{
+					field:  "id",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "totp_code":
+
+			if utf8.RuneCountInString(m.GetTOTPCode()) > 10 {
+				return FinishMFAEnrollmentRequestValidationError{
+					field:  "totp_code",
+					reason: "value length must be at most 10 runes",
+				}
+			}
+
+		case "webauthn_attestation_object":
+			// no validation rules for WebAuthnAttestationObject
+		case "webauthn_client_data_json":
+			// no validation rules for WebAuthnClientDataJSON
+		default:
+			return FinishMFAEnrollmentRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// FinishMFAEnrollmentRequestValidationError is the validation error returned
+// by FinishMFAEnrollmentRequest.ValidateFields if the designated constraints
+// aren't met.
+type FinishMFAEnrollmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishMFAEnrollmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishMFAEnrollmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishMFAEnrollmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishMFAEnrollmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishMFAEnrollmentRequestValidationError) ErrorName() string {
+	return "FinishMFAEnrollmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishMFAEnrollmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishMFAEnrollmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishMFAEnrollmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishMFAEnrollmentRequestValidationError{}
+
+// ValidateFields checks the field values on RecoveryCodes with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RecoveryCodes) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RecoveryCodesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "codes":
+			// no validation rules for Codes
+		default:
+			return RecoveryCodesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RecoveryCodesValidationError is the validation error returned by
+// RecoveryCodes.ValidateFields if the designated constraints aren't met.
+type RecoveryCodesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecoveryCodesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecoveryCodesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecoveryCodesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecoveryCodesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecoveryCodesValidationError) ErrorName() string {
+	return "RecoveryCodesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecoveryCodesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecoveryCodes.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecoveryCodesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecoveryCodesValidationError{}
@@ -37,62 +37,75 @@ func init() {
 }
 
 var fileDescriptor_82df9ba9356987c4 = []byte{
-	// 869 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0x4f, 0x6c, 0xe3, 0x44,
-	0x14, 0xc6, 0x3d, 0xa5, 0x44, 0x30, 0x1b, 0x45, 0xec, 0xb0, 0x6c, 0xd8, 0xb4, 0x7a, 0xcb, 0x9a,
-	0xee, 0x56, 0x84, 0x8d, 0x2d, 0xd2, 0x95, 0x90, 0xf6, 0xb6, 0xfc, 0x51, 0xb5, 0x02, 0x89, 0xd5,
-	0xee, 0xf6, 0x02, 0x12, 0x91, 0x93, 0x4c, 0x5d, 0x2b, 0xad, 0x6d, 0x3c, 0xd3, 0x56, 0xa6, 0x6a,
-	0x55, 0x7a, 0xaa, 0xc4, 0x05, 0x09, 0x24, 0x38, 0x22, 0x4e, 0x3d, 0xf6, 0xd8, 0x63, 0x8f, 0x3d,
-	0x56, 0xe2, 0xd2, 0x63, 0x63, 0x73, 0xe8, 0xb1, 0xc7, 0x8a, 0x03, 0x42, 0x9e, 0xb1, 0x93, 0xc6,
-	0xb1, 0x1b, 0xdf, 0xdc, 0x37, 0x9f, 0xdf, 0xef, 0x7b, 0xcf, 0xef, 0x4d, 0x83, 0x1f, 0xae, 0x3a,
-	0x9e, 0xb1, 0x69, 0xd8, 0x0d, 0xc6, 0x8d, 0x4e, 0x4f, 0x37, 0x5c, 0x4b, 0x5f, 0x67, 0xd4, 0x6b,
-	0x31, 0xea, 0x6d, 0x58, 0x1d, 0xca, 0x34, 0xd7, 0x73, 0xb8, 0x43, 0x2a, 0x9c, 0xdb, 0x5a, 0x2c,
-	0xd5, 0x36, 0x16, 0x6a, 0xb3, 0xa6, 0xe3, 0x98, 0xab, 0x54, 0xe8, 0x0d, 0xdb, 0x76, 0xb8, 0xc1,
-	0x2d, 0xc7, 0x8e, 0xd5, 0xb5, 0x99, 0xf8, 0x54, 0xfc, 0xd5, 0x5e, 0x5f, 0xd6, 0xe9, 0x9a, 0xcb,
-	0xfd, 0xf8, 0xf0, 0xc3, 0x71, 0xa2, 0xd5, 0xa5, 0x36, 0xb7, 0x96, 0x2d, 0xea, 0x25, 0x19, 0x60,
-	0x5c, 0xe4, 0x59, 0xe6, 0x0a, 0x4f, 0xce, 0x67, 0xb3, 0x6d, 0xcb, 0xd3, 0xe6, 0xbf, 0x6f, 0xe2,
-	0xf2, 0x12, 0xa3, 0xde, 0x4b, 0x6a, 0x5a, 0x8c, 0x7b, 0x3e, 0x79, 0x8d, 0x4b, 0x9f, 0x7b, 0xd4,
-	0xe0, 0x94, 0x3c, 0xd0, 0x46, 0x2b, 0xd1, 0x64, 0x5c, 0xaa, 0x7f, 0x58, 0xa7, 0x8c, 0xd7, 0xee,
-	0xa4, 0x25, 0xd1, 0xa1, 0x7a, 0x7b, 0xef, 0xef, 0x7f, 0x7e, 0x9d, 0xba, 0xa5, 0x96, 0x04, 0x88,
-	0x3d, 0x45, 0x75, 0xf2, 0x3d, 0x7e, 0x63, 0x91, 0x72, 0x02, 0x69, 0xfd, 0x22, 0xe5, 0x93, 0xf3,
-	0x3d, 0x10, 0xf9, 0x66, 0xc8, 0x3d, 0x99, 0x4f, 0xdf, 0x12, 0x6d, 0xb7, 0xba, 0x4c, 0x8b, 0x1f,
-	0xb6, 0xc9, 0x37, 0x78, 0xfa, 0x6b, 0x8b, 0x71, 0xf2, 0x41, 0x3a, 0x41, 0x14, 0x8d, 0x92, 0xb0,
-	0x04, 0xf1, 0x5e, 0x16, 0x82, 0xa9, 0x15, 0xc1, 0x78, 0x8b, 0xc4, 0x9e, 0x89, 0x89, 0x4b, 0x4b,
-	0x6e, 0x37, 0xb3, 0x0d, 0x32, 0x3e, 0xd9, 0xf6, 0x9c, 0x48, 0x09, 0xb5, 0x11, 0xdb, 0xda, 0x75,
-	0xdb, 0x51, 0x67, 0x7e, 0x47, 0xb8, 0x2a, 0x1b, 0xfb, 0x9a, 0xae, 0xb9, 0x8e, 0x67, 0x78, 0xfe,
-	0x0b, 0x83, 0xb1, 0x4d, 0xc7, 0xeb, 0x12, 0x2d, 0xfb, 0x0b, 0x8c, 0x09, 0x13, 0x1f, 0x77, 0x35,
-	0x39, 0x4d, 0x5a, 0x32, 0x4d, 0xda, 0x97, 0xd1, 0x34, 0xa9, 0x4f, 0x84, 0x13, 0x4d, 0x7d, 0x9c,
-	0xdb, 0x40, 0x9d, 0x27, 0x39, 0x5b, 0x6e, 0x42, 0xdf, 0x43, 0xb8, 0x22, 0x6b, 0x1d, 0x18, 0xfa,
-	0x28, 0xbf, 0x17, 0x45, 0xbd, 0x34, 0x84, 0x97, 0xf9, 0x9a, 0x9a, 0xef, 0x25, 0x71, 0x10, 0xb5,
-	0xe7, 0x3b, 0x5c, 0xfa, 0x82, 0xae, 0x52, 0x4e, 0xc9, 0xfd, 0xac, 0x26, 0x3f, 0x1f, 0xae, 0x43,
-	0x2e, 0xf1, 0x7d, 0x41, 0x24, 0xf5, 0x77, 0x52, 0xc4, 0xed, 0xe6, 0x7f, 0xd3, 0x18, 0x47, 0x59,
-	0x9e, 0x75, 0x3a, 0x94, 0x31, 0xb2, 0x8c, 0x71, 0x34, 0x2e, 0x2f, 0xc5, 0xf6, 0x14, 0xe1, 0xa5,
-	0x04, 0xf2, 0x45, 0xf5, 0xbe, 0xe0, 0xdd, 0x23, 0xd5, 0x34, 0x2f, 0xde, 0x4b, 0xb2, 0x83, 0xcb,
-	0xf2, 0x43, 0x3e, 0x7b, 0xf1, 0xfc, 0x2b, 0xea, 0x93, 0xf9, 0xfc, 0x45, 0x93, 0x8a, 0x61, 0x4f,
-	0x53, 0x42, 0x79, 0x9c, 0xf4, 0x54, 0xbd, 0xa1, 0xa7, 0x86, 0x6b, 0x35, 0x7a, 0xd4, 0x17, 0xcb,
-	0xf8, 0x23, 0xbe, 0x15, 0xd5, 0x29, 0x5f, 0x66, 0xe4, 0x51, 0xde, 0xce, 0xc4, 0x82, 0x84, 0x5e,
-	0xcd, 0xa6, 0x33, 0xb5, 0x2e, 0xf0, 0x73, 0xa4, 0x00, 0x9e, 0xec, 0xe0, 0xb7, 0x17, 0x69, 0x8c,
-	0x26, 0x73, 0x39, 0xd7, 0x41, 0xb1, 0xaa, 0x17, 0x04, 0xb6, 0x41, 0x3e, 0x9e, 0x8c, 0xd5, 0xb7,
-	0x7a, 0xd4, 0x17, 0x17, 0xc5, 0xcf, 0x08, 0x97, 0xe5, 0xd0, 0xe6, 0x35, 0x7f, 0x38, 0xd2, 0xc5,
-	0x6c, 0x3c, 0x15, 0x36, 0x9e, 0xd4, 0xf4, 0x22, 0x36, 0x0c, 0xd7, 0x6a, 0xf5, 0xa8, 0xaf, 0xc9,
-	0xe5, 0x6f, 0x1e, 0x4f, 0xe1, 0xbb, 0x62, 0xac, 0xec, 0x0d, 0x4b, 0xfe, 0x5f, 0x18, 0xdc, 0xc3,
-	0x6d, 0x3c, 0xfd, 0x8a, 0xda, 0x5d, 0xf2, 0x30, 0x8d, 0x8d, 0xa2, 0xd7, 0xf5, 0xd2, 0x5d, 0x2d,
-	0x2d, 0x1b, 0x4a, 0xd4, 0xaa, 0x70, 0x78, 0x5b, 0x2d, 0xeb, 0xd6, 0x20, 0x28, 0x06, 0xc1, 0x88,
-	0x6f, 0xcd, 0xcc, 0x09, 0x18, 0x26, 0x18, 0x4c, 0xc0, 0x4c, 0x3e, 0x84, 0xa9, 0x77, 0x04, 0xa5,
-	0x42, 0x46, 0x28, 0xa4, 0x35, 0xd8, 0xdf, 0xb1, 0x46, 0xcb, 0xf8, 0x78, 0x29, 0x79, 0x7b, 0x1c,
-	0x03, 0xea, 0x23, 0x80, 0xe6, 0x6f, 0x53, 0xf8, 0xdd, 0xa8, 0x85, 0xaf, 0x28, 0x63, 0xd7, 0xfb,
-	0xe7, 0xc7, 0xb5, 0xcd, 0xe7, 0x4d, 0x77, 0xfc, 0xc2, 0xa0, 0xb8, 0xd9, 0xac, 0x7d, 0x4f, 0x44,
-	0x45, 0x66, 0x9c, 0xc5, 0x5a, 0xf2, 0x13, 0x1a, 0x14, 0xfd, 0xe8, 0x86, 0xa4, 0x45, 0xee, 0xae,
-	0x4f, 0x05, 0xf6, 0x93, 0xba, 0x3e, 0x19, 0xab, 0x6f, 0xc5, 0x4f, 0x51, 0xf4, 0xb3, 0xbf, 0xd0,
-	0x49, 0x1f, 0xd0, 0x69, 0x1f, 0xd0, 0x59, 0x1f, 0x94, 0xf3, 0x3e, 0x28, 0x17, 0x7d, 0x50, 0x2e,
-	0xfb, 0xa0, 0x5c, 0xf5, 0x01, 0xed, 0x06, 0x80, 0xf6, 0x03, 0x50, 0x0e, 0x02, 0x40, 0x87, 0x01,
-	0x28, 0x47, 0x01, 0x28, 0xc7, 0x01, 0x28, 0x27, 0x01, 0xa0, 0xd3, 0x00, 0xd0, 0x59, 0x00, 0xca,
-	0x79, 0x00, 0xe8, 0x22, 0x00, 0xe5, 0x32, 0x00, 0x74, 0x15, 0x80, 0xb2, 0x1b, 0x82, 0xb2, 0x1f,
-	0x02, 0xfa, 0x25, 0x04, 0xe5, 0x8f, 0x10, 0xd0, 0x9f, 0x21, 0x28, 0x07, 0x21, 0x28, 0x87, 0x21,
-	0xa0, 0xa3, 0x10, 0xd0, 0x71, 0x08, 0xe8, 0xdb, 0xc7, 0xa6, 0xa3, 0xf1, 0x15, 0xca, 0x57, 0x2c,
-	0xdb, 0x64, 0x9a, 0x4d, 0xf9, 0xa6, 0xe3, 0xf5, 0xf4, 0xd1, 0x5f, 0x20, 0x6e, 0xcf, 0xd4, 0x39,
-	0xb7, 0xdd, 0x76, 0xbb, 0x24, 0xaa, 0x5d, 0xf8, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x7d, 0x9e,
-	0x1c, 0x5a, 0x09, 0x00, 0x00,
+	// 1088 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x3f, 0x6c, 0x14, 0x47,
+	0x1f, 0xdd, 0xf1, 0xe7, 0xcf, 0x49, 0x06, 0xc7, 0x0a, 0x63, 0x62, 0x9b, 0xb3, 0x33, 0x98, 0xc1,
+	0xc6, 0x70, 0x70, 0xbb, 0x89, 0x8d, 0x12, 0x85, 0xce, 0x38, 0x60, 0xa1, 0x24, 0x8a, 0x65, 0xa0,
+	0x49, 0xa4, 0x5c, 0xd6, 0x77, 0xe3, 0xf5, 0xea, 0xce, 0xbb, 0x9b, 0x99, 0xb1, 0xad, 0x8b, 0x05,
+	0x21, 0x54, 0x48, 0x29, 0x88, 0x14, 0xa4, 0x44, 0x4a, 0x13, 0xa5, 0xa2, 0xa4, 0xa4, 0x74, 0x49,
+	0x89, 0x94, 0x86, 0x92, 0xdb, 0x4b, 0x41, 0x49, 0x49, 0x15, 0x45, 0x3b, 0xb3, 0x7b, 0x77, 0xfb,
+	0xef, 0x6e, 0x25, 0x2a, 0x8e, 0x99, 0x37, 0xef, 0xbd, 0xdf, 0x9b, 0xdf, 0xcc, 0xac, 0xe1, 0x62,
+	0xd3, 0x65, 0xe6, 0x81, 0xe9, 0x54, 0xb8, 0x30, 0x6b, 0x0d, 0xc3, 0xf4, 0x6c, 0x63, 0x8f, 0x53,
+	0x56, 0xe5, 0x94, 0xed, 0xdb, 0x35, 0xca, 0x75, 0x8f, 0xb9, 0xc2, 0x45, 0x13, 0x42, 0x38, 0x7a,
+	0x08, 0xd5, 0xf7, 0x57, 0x4a, 0x73, 0x96, 0xeb, 0x5a, 0x4d, 0x2a, 0xf1, 0xa6, 0xe3, 0xb8, 0xc2,
+	0x14, 0xb6, 0xeb, 0x84, 0xe8, 0xd2, 0x6c, 0x38, 0x2b, 0xff, 0xb7, 0xb5, 0xb7, 0x6d, 0xd0, 0x5d,
+	0x4f, 0xb4, 0xc2, 0xc9, 0x33, 0x69, 0x45, 0xbb, 0x4e, 0x1d, 0x61, 0x6f, 0xdb, 0x94, 0x45, 0x0c,
+	0x38, 0x0d, 0x62, 0xb6, 0xb5, 0x23, 0xa2, 0xf9, 0xb9, 0x6c, 0xdb, 0x6a, 0x76, 0xf9, 0xc1, 0xbb,
+	0x70, 0xfc, 0x16, 0xa7, 0x6c, 0x93, 0x5a, 0x36, 0x17, 0xac, 0x85, 0x6e, 0xc2, 0xb1, 0x35, 0x46,
+	0x4d, 0x41, 0xd1, 0x69, 0x3d, 0x5e, 0x89, 0xae, 0xc6, 0x15, 0xfa, 0xfb, 0x3d, 0xca, 0x45, 0xe9,
+	0x44, 0x12, 0x12, 0x4c, 0x92, 0xe3, 0xf7, 0xfe, 0xfe, 0xe7, 0xd7, 0x91, 0x63, 0x64, 0x4c, 0x0a,
+	0xf1, 0xcb, 0xa0, 0x8c, 0xbe, 0x85, 0xff, 0x5b, 0xa7, 0x02, 0xe1, 0x24, 0x7e, 0x9d, 0x8a, 0xe1,
+	0x7c, 0xa7, 0x25, 0xdf, 0x2c, 0x3a, 0xa9, 0xf8, 0x8c, 0xc3, 0xe0, 0x9f, 0xaa, 0x5d, 0xe7, 0x7a,
+	0xf8, 0xe3, 0x36, 0xfa, 0x0a, 0x8e, 0x7e, 0x61, 0x73, 0x81, 0xe6, 0x93, 0x04, 0xc1, 0x68, 0x40,
+	0xc2, 0x23, 0x89, 0xf7, 0xb3, 0x24, 0x38, 0x99, 0x90, 0x1a, 0x6f, 0xa3, 0xd0, 0x33, 0xb2, 0xe0,
+	0xd8, 0x2d, 0xaf, 0x9e, 0x19, 0x83, 0x1a, 0x1f, 0x6e, 0x7b, 0x41, 0x52, 0xe2, 0x52, 0xcc, 0xb6,
+	0xde, 0x6f, 0x3b, 0x48, 0xe6, 0x37, 0x00, 0xa7, 0x55, 0xb0, 0x37, 0xe9, 0xae, 0xe7, 0x32, 0x93,
+	0xb5, 0x36, 0x4c, 0xce, 0x0f, 0x5c, 0x56, 0x47, 0x7a, 0xf6, 0x0e, 0xa4, 0x80, 0x91, 0x8f, 0x29,
+	0x5d, 0x75, 0x93, 0x1e, 0x75, 0x93, 0x7e, 0x35, 0xe8, 0x26, 0x72, 0x49, 0x3a, 0xd1, 0xc9, 0xc5,
+	0xdc, 0x00, 0x0d, 0x11, 0x71, 0x56, 0xbd, 0x48, 0xfd, 0x1e, 0x80, 0x13, 0xaa, 0xd6, 0xae, 0xa1,
+	0xf3, 0xf9, 0x59, 0x14, 0xf5, 0x52, 0x91, 0x5e, 0x96, 0x4a, 0x24, 0xdf, 0x4b, 0xe4, 0x20, 0x88,
+	0xe7, 0x1b, 0x38, 0xf6, 0x19, 0x6d, 0x52, 0x41, 0xd1, 0xa9, 0xac, 0x90, 0xaf, 0xf7, 0x8e, 0x43,
+	0xae, 0xe2, 0x8c, 0x54, 0x44, 0xe5, 0xf7, 0x12, 0x8a, 0xb7, 0x51, 0x1d, 0xbe, 0xb5, 0x49, 0xb9,
+	0x70, 0xd9, 0x1b, 0xb0, 0xcf, 0x4b, 0xf6, 0x12, 0x99, 0x49, 0xb2, 0x1b, 0x2c, 0xa4, 0xfe, 0x0e,
+	0xfe, 0x7f, 0x63, 0x8f, 0x59, 0x6f, 0xa0, 0x81, 0xa5, 0xc6, 0x4c, 0x79, 0x2a, 0xa5, 0xe1, 0x49,
+	0xe2, 0x87, 0x00, 0xa2, 0x2b, 0xd4, 0xb2, 0x9d, 0x2f, 0xaf, 0xad, 0x5e, 0x75, 0x98, 0xdb, 0x6c,
+	0xee, 0x52, 0x47, 0xa4, 0x77, 0x2b, 0x8d, 0x89, 0x76, 0xeb, 0x83, 0x24, 0x34, 0x86, 0xea, 0x36,
+	0xd0, 0xf9, 0xfc, 0x4d, 0xdb, 0xdd, 0x36, 0x0d, 0xda, 0x5d, 0x21, 0x0f, 0xfd, 0x1f, 0x00, 0x4e,
+	0x5e, 0xb3, 0x1d, 0x9b, 0xef, 0xc4, 0x7d, 0x95, 0x93, 0x62, 0x19, 0xa0, 0x41, 0xc6, 0xd6, 0x18,
+	0x95, 0xa1, 0x99, 0x4d, 0xf2, 0xa9, 0x34, 0xb6, 0x42, 0xf4, 0xc2, 0xc6, 0x8c, 0xc3, 0xf0, 0xe0,
+	0xfd, 0x08, 0x51, 0x70, 0x39, 0xc4, 0xf8, 0xf8, 0xf0, 0x3d, 0xc2, 0x03, 0x0d, 0x71, 0x72, 0x4e,
+	0x3a, 0x22, 0x68, 0x3e, 0xb5, 0x57, 0x81, 0x91, 0x5a, 0x9f, 0xd4, 0x03, 0x00, 0x27, 0x55, 0x6f,
+	0xc7, 0x28, 0xd0, 0xb9, 0x81, 0x0a, 0x45, 0xfa, 0xe5, 0x63, 0xe9, 0xe1, 0xc3, 0xf2, 0x90, 0x54,
+	0xfa, 0xcc, 0xc8, 0x54, 0x82, 0x13, 0x3f, 0xa9, 0xae, 0x98, 0x4d, 0x5a, 0x73, 0xf7, 0x29, 0x6b,
+	0xad, 0xb9, 0x75, 0x5a, 0x20, 0x94, 0xd4, 0x2e, 0xc5, 0xd6, 0x93, 0x0b, 0xd2, 0xcf, 0x22, 0x39,
+	0x93, 0x99, 0x09, 0x0b, 0xb1, 0x95, 0x5a, 0x00, 0x5e, 0xfe, 0x77, 0x14, 0xc2, 0x80, 0x7f, 0xb5,
+	0x56, 0xa3, 0x9c, 0xa3, 0x6d, 0x08, 0x83, 0x6d, 0xda, 0x94, 0x4f, 0x5a, 0x91, 0x23, 0x94, 0x74,
+	0x22, 0x17, 0x92, 0x53, 0xd2, 0xc2, 0x49, 0x34, 0x9d, 0x3e, 0xa6, 0x8a, 0xf9, 0x0e, 0x1c, 0x57,
+	0xa5, 0xaf, 0x6e, 0x5c, 0xff, 0x9c, 0xb6, 0xd0, 0x52, 0xfe, 0xeb, 0xa7, 0x10, 0xbd, 0x8b, 0x2e,
+	0x01, 0x54, 0xd3, 0xd1, 0x45, 0x47, 0x06, 0x5c, 0x74, 0xa6, 0x67, 0x57, 0x1a, 0xb4, 0x25, 0x0f,
+	0xcb, 0x0f, 0xf0, 0x58, 0x50, 0xa7, 0x5a, 0xcc, 0xd1, 0xd9, 0xbc, 0x87, 0x2c, 0x04, 0x44, 0xea,
+	0xd3, 0xd9, 0xea, 0x9c, 0x94, 0xa5, 0xfc, 0x02, 0x2a, 0x20, 0x8f, 0xee, 0xc0, 0x77, 0xd6, 0x69,
+	0x28, 0x8d, 0x16, 0x72, 0xde, 0xe8, 0x62, 0x55, 0xaf, 0x48, 0xd9, 0x0a, 0xba, 0x30, 0x5c, 0xd6,
+	0x38, 0x6c, 0xd0, 0x56, 0x30, 0x82, 0x7e, 0x06, 0x70, 0x5c, 0xbd, 0x24, 0x79, 0xe1, 0xf7, 0xde,
+	0x99, 0x62, 0x36, 0x2e, 0x4b, 0x1b, 0x97, 0x4a, 0x46, 0x11, 0x1b, 0xa6, 0x67, 0x57, 0x1b, 0xb4,
+	0xa5, 0xab, 0x8b, 0x61, 0xf9, 0x68, 0x04, 0x4e, 0xc9, 0xb6, 0x72, 0xf6, 0x6d, 0xf5, 0xb1, 0xd6,
+	0xfd, 0x38, 0xda, 0x82, 0xa3, 0x37, 0xa8, 0x53, 0x47, 0x8b, 0x49, 0xd9, 0x60, 0xb4, 0x1f, 0xaf,
+	0xdc, 0x95, 0x92, 0xb0, 0x1e, 0x84, 0x4c, 0x4b, 0x87, 0xc7, 0xc9, 0xb8, 0x61, 0x77, 0x07, 0x65,
+	0x23, 0x98, 0xe1, 0xa7, 0x4c, 0x66, 0x07, 0xf4, 0x08, 0xba, 0x1d, 0x30, 0x9b, 0x2f, 0xc2, 0xc9,
+	0x09, 0xa9, 0x32, 0x81, 0x62, 0x2a, 0xa8, 0xda, 0x7d, 0x54, 0x53, 0x41, 0xab, 0xf1, 0x74, 0x29,
+	0x79, 0x57, 0x4d, 0x28, 0x50, 0x8e, 0x09, 0x2c, 0x3f, 0x1c, 0x81, 0x93, 0x41, 0x84, 0x37, 0x28,
+	0xe7, 0xfd, 0xf9, 0xb5, 0xc2, 0xda, 0x96, 0xf2, 0xba, 0x3b, 0x5c, 0xd0, 0x2d, 0x6e, 0x2e, 0xeb,
+	0xbc, 0x47, 0xa0, 0x22, 0x3d, 0xce, 0x43, 0x2c, 0xfa, 0x09, 0x74, 0x8b, 0x3e, 0x3b, 0x80, 0xb4,
+	0xc8, 0xf5, 0xfa, 0x89, 0x94, 0xfd, 0xa8, 0x6c, 0x0c, 0x97, 0x35, 0x0e, 0xc3, 0x5f, 0xc1, 0xe8,
+	0x95, 0xbf, 0xc0, 0xd3, 0x36, 0x06, 0xcf, 0xda, 0x18, 0x3c, 0x6f, 0x63, 0xed, 0x45, 0x1b, 0x6b,
+	0x2f, 0xdb, 0x58, 0x7b, 0xd5, 0xc6, 0xda, 0xeb, 0x36, 0x06, 0x77, 0x7d, 0x0c, 0xee, 0xfb, 0x58,
+	0x7b, 0xe4, 0x63, 0xf0, 0xd8, 0xc7, 0xda, 0x13, 0x1f, 0x6b, 0x47, 0x3e, 0xd6, 0x9e, 0xfa, 0x18,
+	0x3c, 0xf3, 0x31, 0x78, 0xee, 0x63, 0xed, 0x85, 0x8f, 0xc1, 0x4b, 0x1f, 0x6b, 0xaf, 0x7c, 0x0c,
+	0x5e, 0xfb, 0x58, 0xbb, 0xdb, 0xc1, 0xda, 0xfd, 0x0e, 0x06, 0xbf, 0x74, 0xb0, 0xf6, 0x7b, 0x07,
+	0x83, 0x3f, 0x3b, 0x58, 0x7b, 0xd4, 0xc1, 0xda, 0xe3, 0x0e, 0x06, 0x4f, 0x3a, 0x18, 0x1c, 0x75,
+	0x30, 0xf8, 0xfa, 0xa2, 0xe5, 0xea, 0x62, 0x87, 0x8a, 0x1d, 0xdb, 0xb1, 0xb8, 0xee, 0x50, 0x71,
+	0xe0, 0xb2, 0x86, 0x11, 0xff, 0xb3, 0xc0, 0x6b, 0x58, 0x86, 0x10, 0x8e, 0xb7, 0xb5, 0x35, 0x26,
+	0xab, 0x5d, 0xf9, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xef, 0xc4, 0x94, 0xef, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -120,6 +133,21 @@ type UserRegistryClient interface {
 	CreateTemporaryPassword(ctx context.Context, in *CreateTemporaryPasswordRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	UpdatePassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	Delete(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Restore a recently deleted user. Only admins can restore users.
+	Restore(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the user. This permanently deletes the user and the data that is
+	// associated with it, and releases its ID. Only admins can purge users.
+	Purge(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Begin the enrollment of a TOTP or WebAuthn second factor.
+	// The enrollment is finished with FinishMFAEnrollment.
+	BeginMFAEnrollment(ctx context.Context, in *BeginMFAEnrollmentRequest, opts ...grpc.CallOption) (*MFAEnrollment, error)
+	// Finish the enrollment of a second factor by proving possession of it.
+	FinishMFAEnrollment(ctx context.Context, in *FinishMFAEnrollmentRequest, opts ...grpc.CallOption) (*MFACredential, error)
+	ListMFACredentials(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*MFACredentials, error)
+	DeleteMFACredential(ctx context.Context, in *MFACredentialIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Create new recovery codes for logging in when other second factors are not available.
+	// This replaces any existing recovery codes.
+	CreateRecoveryCodes(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*RecoveryCodes, error)
 }
 
 type userRegistryClient struct {
@@ -193,6 +221,69 @@ func (c *userRegistryClient) Delete(ctx context.Context, in *UserIdentifiers, op
 	return out, nil
 }
 
+func (c *userRegistryClient) Restore(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRegistryClient) Purge(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRegistryClient) BeginMFAEnrollment(ctx context.Context, in *BeginMFAEnrollmentRequest, opts ...grpc.CallOption) (*MFAEnrollment, error) {
+	out := new(MFAEnrollment)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/BeginMFAEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRegistryClient) FinishMFAEnrollment(ctx context.Context, in *FinishMFAEnrollmentRequest, opts ...grpc.CallOption) (*MFACredential, error) {
+	out := new(MFACredential)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/FinishMFAEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRegistryClient) ListMFACredentials(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*MFACredentials, error) {
+	out := new(MFACredentials)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/ListMFACredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRegistryClient) DeleteMFACredential(ctx context.Context, in *MFACredentialIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/DeleteMFACredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRegistryClient) CreateRecoveryCodes(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*RecoveryCodes, error) {
+	out := new(RecoveryCodes)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/CreateRecoveryCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserRegistryServer is the server API for UserRegistry service.
 type UserRegistryServer interface {
 	// Register a new user. This method may be restricted by network settings.
@@ -208,6 +299,21 @@ type UserRegistryServer interface {
 	CreateTemporaryPassword(context.Context, *CreateTemporaryPasswordRequest) (*types.Empty, error)
 	UpdatePassword(context.Context, *UpdateUserPasswordRequest) (*types.Empty, error)
 	Delete(context.Context, *UserIdentifiers) (*types.Empty, error)
+	// Restore a recently deleted user. Only admins can restore users.
+	Restore(context.Context, *UserIdentifiers) (*types.Empty, error)
+	// Purge the user. This permanently deletes the user and the data that is
+	// associated with it, and releases its ID. Only admins can purge users.
+	Purge(context.Context, *UserIdentifiers) (*types.Empty, error)
+	// Begin the enrollment of a TOTP or WebAuthn second factor.
+	// The enrollment is finished with FinishMFAEnrollment.
+	BeginMFAEnrollment(context.Context, *BeginMFAEnrollmentRequest) (*MFAEnrollment, error)
+	// Finish the enrollment of a second factor by proving possession of it.
+	FinishMFAEnrollment(context.Context, *FinishMFAEnrollmentRequest) (*MFACredential, error)
+	ListMFACredentials(context.Context, *UserIdentifiers) (*MFACredentials, error)
+	DeleteMFACredential(context.Context, *MFACredentialIdentifiers) (*types.Empty, error)
+	// Create new recovery codes for logging in when other second factors are not available.
+	// This replaces any existing recovery codes.
+	CreateRecoveryCodes(context.Context, *UserIdentifiers) (*RecoveryCodes, error)
 }
 
 // UnimplementedUserRegistryServer can be embedded to have forward compatible implementations.
@@ -235,6 +341,27 @@ func (*UnimplementedUserRegistryServer) UpdatePassword(ctx context.Context, req
 func (*UnimplementedUserRegistryServer) Delete(ctx context.Context, req *UserIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedUserRegistryServer) Restore(ctx context.Context, req *UserIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (*UnimplementedUserRegistryServer) Purge(ctx context.Context, req *UserIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
+}
+func (*UnimplementedUserRegistryServer) BeginMFAEnrollment(ctx context.Context, req *BeginMFAEnrollmentRequest) (*MFAEnrollment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginMFAEnrollment not implemented")
+}
+func (*UnimplementedUserRegistryServer) FinishMFAEnrollment(ctx context.Context, req *FinishMFAEnrollmentRequest) (*MFACredential, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishMFAEnrollment not implemented")
+}
+func (*UnimplementedUserRegistryServer) ListMFACredentials(ctx context.Context, req *UserIdentifiers) (*MFACredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMFACredentials not implemented")
+}
+func (*UnimplementedUserRegistryServer) DeleteMFACredential(ctx context.Context, req *MFACredentialIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMFACredential not implemented")
+}
+func (*UnimplementedUserRegistryServer) CreateRecoveryCodes(ctx context.Context, req *UserIdentifiers) (*RecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecoveryCodes not implemented")
+}
 
 func RegisterUserRegistryServer(s *grpc.Server, srv UserRegistryServer) {
 	s.RegisterService(&_UserRegistry_serviceDesc, srv)
@@ -366,6 +493,132 @@ func _UserRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserRegistry_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).Restore(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRegistry_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).Purge(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRegistry_BeginMFAEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginMFAEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).BeginMFAEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/BeginMFAEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).BeginMFAEnrollment(ctx, req.(*BeginMFAEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRegistry_FinishMFAEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishMFAEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).FinishMFAEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/FinishMFAEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).FinishMFAEnrollment(ctx, req.(*FinishMFAEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRegistry_ListMFACredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).ListMFACredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/ListMFACredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).ListMFACredentials(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRegistry_DeleteMFACredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MFACredentialIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).DeleteMFACredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/DeleteMFACredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).DeleteMFACredential(ctx, req.(*MFACredentialIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRegistry_CreateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).CreateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/CreateRecoveryCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).CreateRecoveryCodes(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UserRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.UserRegistry",
 	HandlerType: (*UserRegistryServer)(nil),
@@ -398,6 +651,34 @@ var _UserRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _UserRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _UserRegistry_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _UserRegistry_Purge_Handler,
+		},
+		{
+			MethodName: "BeginMFAEnrollment",
+			Handler:    _UserRegistry_BeginMFAEnrollment_Handler,
+		},
+		{
+			MethodName: "FinishMFAEnrollment",
+			Handler:    _UserRegistry_FinishMFAEnrollment_Handler,
+		},
+		{
+			MethodName: "ListMFACredentials",
+			Handler:    _UserRegistry_ListMFACredentials_Handler,
+		},
+		{
+			MethodName: "DeleteMFACredential",
+			Handler:    _UserRegistry_DeleteMFACredential_Handler,
+		},
+		{
+			MethodName: "CreateRecoveryCodes",
+			Handler:    _UserRegistry_CreateRecoveryCodes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/user_services.proto",
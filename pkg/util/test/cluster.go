@@ -105,6 +105,7 @@ type MockCluster struct {
 	TLSFunc                func() bool
 	AuthFunc               func() grpc.CallOption
 	WithVerifiedSourceFunc func(ctx context.Context) context.Context
+	WithForwardedForFunc   func(ctx context.Context) context.Context
 }
 
 // Join calls JoinFunc if set and panics otherwise.
@@ -188,6 +189,14 @@ func (m MockCluster) WithVerifiedSource(ctx context.Context) context.Context {
 	return m.WithVerifiedSourceFunc(ctx)
 }
 
+// WithForwardedFor calls WithForwardedForFunc if set and returns ctx otherwise.
+func (m MockCluster) WithForwardedFor(ctx context.Context) context.Context {
+	if m.WithForwardedForFunc == nil {
+		return ctx
+	}
+	return m.WithForwardedForFunc(ctx)
+}
+
 type ClusterAuthRequest struct {
 	Response chan<- grpc.CallOption
 }
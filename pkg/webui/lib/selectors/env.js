@@ -44,4 +44,7 @@ export const selectLanguageConfig = () => selectApplicationConfig().language
 
 export const selectSupportLinkConfig = () => selectApplicationConfig().support_link
 
+export const selectFederatedProvidersConfig = () =>
+  selectApplicationConfig().federated_providers || []
+
 export const selectPageData = () => configSelector().PAGE_DATA
@@ -45,6 +45,18 @@ export default {
     login(credentials) {
       return instance.post(`${appRoot}/api/auth/login`, credentials)
     },
+    loginMFAChallenge() {
+      return instance.get(`${appRoot}/api/auth/login/mfa`)
+    },
+    loginMFA(factor) {
+      return instance.post(`${appRoot}/api/auth/login/mfa`, factor)
+    },
+    loginMFAEnroll() {
+      return instance.post(`${appRoot}/api/auth/login/mfa/enroll`)
+    },
+    loginMFAEnrollConfirm(code) {
+      return instance.post(`${appRoot}/api/auth/login/mfa/enroll/confirm`, code)
+    },
     logout() {
       return instance.post(`${appRoot}/api/auth/logout`)
     },
@@ -23,7 +23,11 @@ import * as Yup from 'yup'
 
 import api from '../../api'
 import sharedMessages from '../../../lib/shared-messages'
-import { selectApplicationRootPath, selectApplicationSiteName } from '../../../lib/selectors/env'
+import {
+  selectApplicationRootPath,
+  selectApplicationSiteName,
+  selectFederatedProvidersConfig,
+} from '../../../lib/selectors/env'
 import PropTypes from '../../../lib/prop-types'
 
 import Button from '../../../components/button'
@@ -41,6 +45,14 @@ const m = defineMessages({
   createAccount: 'Create an account',
   forgotPassword: 'Forgot password?',
   loginToContinue: 'Please login to continue',
+  mfaCode: 'Authentication code',
+  mfaCodeDescription: 'Enter the code shown by your authenticator app, or one of your recovery codes',
+  mfaContinue: 'Continue',
+  mfaEnroll: 'Two-factor authentication is required. Add the following secret to your authenticator app and enter the code that it shows',
+  mfaSecret: 'Secret',
+  mfaRecoveryCodes: 'Store the following recovery codes in a safe place. You can use them to login when your authenticator app is not available',
+  mfaSecurityKey: 'Use security key',
+  loginWith: 'Login with {provider}',
 })
 
 const validationSchema = Yup.object().shape({
@@ -52,6 +64,16 @@ const validationSchema = Yup.object().shape({
   password: Yup.string().required(sharedMessages.validateRequired),
 })
 
+const mfaValidationSchema = Yup.object().shape({
+  code: Yup.string().required(sharedMessages.validateRequired),
+})
+
+const decodeBase64 = value => Uint8Array.from(atob(value), c => c.charCodeAt(0))
+const encodeBase64 = value => btoa(String.fromCharCode(...new Uint8Array(value)))
+
+// Recovery codes are formatted as two groups of five characters, TOTP codes are numeric.
+const isRecoveryCode = code => !/^\d+$/.test(code.trim())
+
 @withRouter
 @connect(
   () => ({
@@ -73,12 +95,70 @@ export default class OAuth extends React.PureComponent {
     super(props)
     this.state = {
       error: '',
+      mfa: undefined,
+      enrollment: undefined,
+      recoveryCodes: undefined,
+    }
+  }
+
+  async componentDidMount() {
+    const { location } = this.props
+    const query = Query.parse(location.search)
+
+    // Federated logins redirect here when the user needs to provide a second factor.
+    if (query.mfa) {
+      try {
+        const { data } = await api.oauth.loginMFAChallenge()
+        await this.startMFA(data.mfa)
+      } catch (error) {
+        this.setState({
+          error: error.response.data,
+        })
+      }
+    }
+  }
+
+  async startMFA(mfa) {
+    let enrollment
+    if (mfa.enrollment_required) {
+      enrollment = (await api.oauth.loginMFAEnroll()).data
     }
+    this.setState({ error: '', mfa, enrollment })
   }
 
   async handleSubmit(values, { setSubmitting, setErrors }) {
     try {
-      await api.oauth.login(values)
+      const { data } = await api.oauth.login(values)
+
+      if (data && data.mfa) {
+        await this.startMFA(data.mfa)
+        return
+      }
+
+      window.location = url(this.props.location)
+    } catch (error) {
+      this.setState({
+        error: error.response.data,
+      })
+    } finally {
+      setSubmitting(false)
+    }
+  }
+
+  async handleMFASubmit(values, { setSubmitting }) {
+    const { enrollment } = this.state
+    const code = values.code.trim()
+    try {
+      if (enrollment) {
+        const { data } = await api.oauth.loginMFAEnrollConfirm({ totp_code: code })
+        this.setState({ error: '', recoveryCodes: data.recovery_codes })
+        return
+      }
+      if (isRecoveryCode(code)) {
+        await api.oauth.loginMFA({ recovery_code: code })
+      } else {
+        await api.oauth.loginMFA({ totp_code: code })
+      }
 
       window.location = url(this.props.location)
     } catch (error) {
@@ -90,6 +170,48 @@ export default class OAuth extends React.PureComponent {
     }
   }
 
+  async handleSecurityKey() {
+    const { webauthn } = this.state.mfa
+    try {
+      const assertion = await navigator.credentials.get({
+        publicKey: {
+          challenge: decodeBase64(webauthn.challenge),
+          rpId: webauthn.rp_id,
+          allowCredentials: webauthn.allow_credentials.map(id => ({
+            type: 'public-key',
+            id: decodeBase64(id),
+          })),
+          userVerification: 'discouraged',
+        },
+      })
+      await api.oauth.loginMFA({
+        webauthn: {
+          credential_id: encodeBase64(assertion.rawId),
+          client_data_json: encodeBase64(assertion.response.clientDataJSON),
+          authenticator_data: encodeBase64(assertion.response.authenticatorData),
+          signature: encodeBase64(assertion.response.signature),
+        },
+      })
+
+      window.location = url(this.props.location)
+    } catch (error) {
+      this.setState({
+        error: error.response ? error.response.data : error,
+      })
+    }
+  }
+
+  handleRecoveryCodesStored() {
+    window.location = url(this.props.location)
+  }
+
+  handleFederatedLogin(provider) {
+    const { location } = this.props
+    const query = Query.parse(location.search)
+    const params = query.n ? `?${Query.stringify({ n: query.n })}` : ''
+    window.location = `${appRoot}/login/federated/${encodeURIComponent(provider)}${params}`
+  }
+
   navigateToRegister() {
     const { replace, location } = this.props
     replace('/register', {
@@ -104,6 +226,54 @@ export default class OAuth extends React.PureComponent {
     })
   }
 
+  renderMFA() {
+    const { mfa, enrollment, recoveryCodes, error } = this.state
+
+    if (recoveryCodes) {
+      return (
+        <div>
+          <Message component="p" content={m.mfaRecoveryCodes} />
+          <pre className={style.codes}>{recoveryCodes.join('\n')}</pre>
+          <Button message={m.mfaContinue} onClick={this.handleRecoveryCodesStored} />
+        </div>
+      )
+    }
+
+    return (
+      <Form
+        onSubmit={this.handleMFASubmit}
+        initialValues={{ code: '' }}
+        error={error}
+        validationSchema={mfaValidationSchema}
+        horizontal={false}
+      >
+        {enrollment && <Message component="p" content={m.mfaEnroll} />}
+        {enrollment && (
+          <Form.Field
+            title={m.mfaSecret}
+            name="secret"
+            component={Input}
+            value={enrollment.totp_secret}
+            readOnly
+          />
+        )}
+        <Form.Field
+          title={m.mfaCode}
+          description={enrollment ? undefined : m.mfaCodeDescription}
+          name="code"
+          component={Input}
+          autoComplete="one-time-code"
+          autoFocus
+          required
+        />
+        <Form.Submit component={SubmitButton} message={sharedMessages.login} />
+        {mfa.webauthn && (
+          <Button naked message={m.mfaSecurityKey} onClick={this.handleSecurityKey} />
+        )}
+      </Form>
+    )
+  }
+
   render() {
     const initialValues = {
       user_id: '',
@@ -125,32 +295,44 @@ export default class OAuth extends React.PureComponent {
           </div>
           <div className={style.right}>
             <h1>{siteName}</h1>
-            <Form
-              onSubmit={this.handleSubmit}
-              initialValues={initialValues}
-              error={this.state.error}
-              info={info}
-              validationSchema={validationSchema}
-              horizontal={false}
-            >
-              <Form.Field
-                title={sharedMessages.userId}
-                name="user_id"
-                component={Input}
-                autoFocus
-                required
-              />
-              <Form.Field
-                title={sharedMessages.password}
-                component={Input}
-                name="password"
-                type="password"
-                required
-              />
-              <Form.Submit component={SubmitButton} message={sharedMessages.login} />
-              <Button naked message={m.createAccount} onClick={this.navigateToRegister} />
-              <Button naked message={m.forgotPassword} onClick={this.navigateToResetPassword} />
-            </Form>
+            {this.state.mfa ? (
+              this.renderMFA()
+            ) : (
+              <Form
+                onSubmit={this.handleSubmit}
+                initialValues={initialValues}
+                error={this.state.error}
+                info={info}
+                validationSchema={validationSchema}
+                horizontal={false}
+              >
+                <Form.Field
+                  title={sharedMessages.userId}
+                  name="user_id"
+                  component={Input}
+                  autoFocus
+                  required
+                />
+                <Form.Field
+                  title={sharedMessages.password}
+                  component={Input}
+                  name="password"
+                  type="password"
+                  required
+                />
+                <Form.Submit component={SubmitButton} message={sharedMessages.login} />
+                <Button naked message={m.createAccount} onClick={this.navigateToRegister} />
+                <Button naked message={m.forgotPassword} onClick={this.navigateToResetPassword} />
+                {federatedProviders.map(provider => (
+                  <Button
+                    key={provider.id}
+                    naked
+                    message={{ ...m.loginWith, values: { provider: provider.name } }}
+                    onClick={() => this.handleFederatedLogin(provider.id)}
+                  />
+                ))}
+              </Form>
+            )}
           </div>
         </div>
       </div>
@@ -159,6 +341,7 @@ export default class OAuth extends React.PureComponent {
 }
 
 const appRoot = selectApplicationRootPath()
+const federatedProviders = selectFederatedProvidersConfig()
 
 function url(location, omitQuery = false) {
   const query = Query.parse(location.search)